@@ -0,0 +1,217 @@
+package ante
+
+import (
+	"math"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// PriorityLaneTxPriority is the base mempool priority of transactions in the priority lane, to which
+// their gas price in the base denom is added so that they are ranked by fee within the lane.
+// All other transactions keep the default priority of zero.
+const PriorityLaneTxPriority = int64(1_000_000)
+
+// priorityLaneGasPriceScale is the priority added for every base denom unit paid per gas, so that
+// fractional gas prices such as 0.0025 uosmo per gas still rank transactions.
+const priorityLaneGasPriceScale = int64(1_000_000)
+
+// DefaultPriorityLaneMaxTxsPerSignerPerBlock is the default number of transactions of a signer
+// prioritized per block height.
+const DefaultPriorityLaneMaxTxsPerSignerPerBlock = uint64(1)
+
+// DefaultPriorityLaneMsgTypeURLs are the messages that are prioritized by default. These are
+// messages that must not be starved by swap spam during congestion, such as governance votes,
+// unjailing and undelegations.
+var DefaultPriorityLaneMsgTypeURLs = []string{
+	"/cosmos.gov.v1.MsgVote",
+	"/cosmos.gov.v1.MsgVoteWeighted",
+	"/cosmos.gov.v1beta1.MsgVote",
+	"/cosmos.gov.v1beta1.MsgVoteWeighted",
+	"/cosmos.slashing.v1beta1.MsgUnjail",
+	"/cosmos.staking.v1beta1.MsgUndelegate",
+	"/osmosis.superfluid.MsgSuperfluidUndelegate",
+	"/osmosis.superfluid.MsgSuperfluidUnbondLock",
+	"/osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLock",
+}
+
+type PriorityLaneOptions struct {
+	Enabled     bool
+	MsgTypeURLs map[string]struct{}
+	// MaxTxsPerSignerPerBlock is the number of transactions of a signer prioritized per block height.
+	// Zero removes the limit.
+	MaxTxsPerSignerPerBlock uint64
+}
+
+// NewPriorityLaneOptions returns the priority lane options parsed from the app config.
+func NewPriorityLaneOptions(appOpts servertypes.AppOptions) PriorityLaneOptions {
+	return PriorityLaneOptions{
+		Enabled:                 parsePriorityLaneEnabled(appOpts),
+		MsgTypeURLs:             parsePriorityLaneMsgTypeURLs(appOpts),
+		MaxTxsPerSignerPerBlock: parsePriorityLaneMaxTxsPerSignerPerBlock(appOpts),
+	}
+}
+
+// parsePriorityLaneEnabled parses whether the priority lane is enabled. Defaults to false.
+func parsePriorityLaneEnabled(opts servertypes.AppOptions) bool {
+	valueInterface := opts.Get("osmosis-mempool.priority-lane-enabled")
+	if valueInterface == nil {
+		return false
+	}
+	value, err := cast.ToBoolE(valueInterface)
+	if err != nil {
+		panic("invalidly configured osmosis-mempool.priority-lane-enabled")
+	}
+	return value
+}
+
+// parsePriorityLaneMsgTypeURLs parses the set of prioritized message type urls.
+// Defaults to DefaultPriorityLaneMsgTypeURLs.
+func parsePriorityLaneMsgTypeURLs(opts servertypes.AppOptions) map[string]struct{} {
+	msgTypeURLs := DefaultPriorityLaneMsgTypeURLs
+	if valueInterface := opts.Get("osmosis-mempool.priority-lane-msg-types"); valueInterface != nil {
+		var err error
+		msgTypeURLs, err = cast.ToStringSliceE(valueInterface)
+		if err != nil {
+			panic("invalidly configured osmosis-mempool.priority-lane-msg-types")
+		}
+	}
+
+	msgTypeURLSet := make(map[string]struct{}, len(msgTypeURLs))
+	for _, msgTypeURL := range msgTypeURLs {
+		msgTypeURLSet[msgTypeURL] = struct{}{}
+	}
+	return msgTypeURLSet
+}
+
+// parsePriorityLaneMaxTxsPerSignerPerBlock parses the number of transactions of a signer prioritized
+// per block height. Defaults to DefaultPriorityLaneMaxTxsPerSignerPerBlock.
+func parsePriorityLaneMaxTxsPerSignerPerBlock(opts servertypes.AppOptions) uint64 {
+	valueInterface := opts.Get("osmosis-mempool.priority-lane-max-txs-per-signer-per-block")
+	if valueInterface == nil {
+		return DefaultPriorityLaneMaxTxsPerSignerPerBlock
+	}
+	value, err := cast.ToUint64E(valueInterface)
+	if err != nil {
+		panic("invalidly configured osmosis-mempool.priority-lane-max-txs-per-signer-per-block")
+	}
+	return value
+}
+
+// BaseDenomGetter returns the base denom of the fees, in which the gas price of priority lane
+// transactions is measured.
+type BaseDenomGetter interface {
+	GetBaseDenom(ctx sdk.Context) (denom string, err error)
+}
+
+// PriorityLaneDecorator raises the mempool priority of transactions that only contain
+// priority lane messages, so that they are included ahead of other transactions during
+// congestion. Within the lane, transactions are ranked by their gas price in the base denom,
+// and only the first MaxTxsPerSignerPerBlock transactions of a signer checked at a given
+// block height are prioritized, so that cheap votes or undelegations cannot flood the lane.
+// It only affects the local mempool and thus is only ran on check tx.
+type PriorityLaneDecorator struct {
+	Options         PriorityLaneOptions
+	baseDenomGetter BaseDenomGetter
+	signerTxCounts  *priorityLaneSignerTxCounts
+}
+
+// priorityLaneSignerTxCounts counts the transactions of every signer prioritized at the current
+// block height. The counts are reset whenever the height changes.
+type priorityLaneSignerTxCounts struct {
+	mu     sync.Mutex
+	height int64
+	counts map[string]uint64
+}
+
+// NewPriorityLaneDecorator returns a new PriorityLaneDecorator.
+func NewPriorityLaneDecorator(options PriorityLaneOptions, baseDenomGetter BaseDenomGetter) PriorityLaneDecorator {
+	return PriorityLaneDecorator{
+		Options:         options,
+		baseDenomGetter: baseDenomGetter,
+		signerTxCounts:  &priorityLaneSignerTxCounts{counts: map[string]uint64{}},
+	}
+}
+
+// AnteHandle raises the priority of the context to PriorityLaneTxPriority plus the fee priority of the
+// transaction if it is in the priority lane and its signers did not exceed their prioritized transactions
+// for the current block height.
+func (decorator PriorityLaneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate && decorator.IsPriorityLaneTx(tx.GetMsgs()) &&
+		decorator.allowSigners(ctx.BlockHeight(), tx.GetMsgs()) {
+		ctx = ctx.WithPriority(PriorityLaneTxPriority + decorator.feePriority(ctx, tx))
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// feePriority returns the gas price of the given transaction in the base denom, scaled by
+// priorityLaneGasPriceScale and capped so that the priority of the transaction does not overflow.
+// Fees in other denoms do not count, since their value is not known here.
+func (decorator PriorityLaneDecorator) feePriority(ctx sdk.Context, tx sdk.Tx) int64 {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 || decorator.baseDenomGetter == nil {
+		return 0
+	}
+	baseDenom, err := decorator.baseDenomGetter.GetBaseDenom(ctx)
+	if err != nil {
+		return 0
+	}
+
+	maxFeePriority := math.MaxInt64 - PriorityLaneTxPriority
+	feePriority := feeTx.GetFee().AmountOf(baseDenom).MulRaw(priorityLaneGasPriceScale).Quo(sdk.NewIntFromUint64(feeTx.GetGas()))
+	if !feePriority.IsInt64() || feePriority.Int64() > maxFeePriority {
+		return maxFeePriority
+	}
+	return feePriority.Int64()
+}
+
+// allowSigners returns true if none of the signers of the given messages had MaxTxsPerSignerPerBlock
+// transactions prioritized at the given block height yet, and counts the transaction for each of them.
+func (decorator PriorityLaneDecorator) allowSigners(height int64, msgs []sdk.Msg) bool {
+	if decorator.Options.MaxTxsPerSignerPerBlock == 0 {
+		return true
+	}
+
+	signers := map[string]struct{}{}
+	for _, msg := range msgs {
+		for _, signer := range msg.GetSigners() {
+			signers[signer.String()] = struct{}{}
+		}
+	}
+
+	counts := decorator.signerTxCounts
+	counts.mu.Lock()
+	defer counts.mu.Unlock()
+	if counts.height != height {
+		counts.height = height
+		counts.counts = map[string]uint64{}
+	}
+	for signer := range signers {
+		if counts.counts[signer] >= decorator.Options.MaxTxsPerSignerPerBlock {
+			return false
+		}
+	}
+	for signer := range signers {
+		counts.counts[signer]++
+	}
+	return true
+}
+
+// IsPriorityLaneTx returns true if the priority lane is enabled and all of the given messages
+// are priority lane messages. Transactions mixing in any other message are not prioritized,
+// so that the lane cannot be used to front run spam.
+func (decorator PriorityLaneDecorator) IsPriorityLaneTx(msgs []sdk.Msg) bool {
+	if !decorator.Options.Enabled || len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if _, ok := decorator.Options.MsgTypeURLs[sdk.MsgTypeURL(msg)]; !ok {
+			return false
+		}
+	}
+	return true
+}
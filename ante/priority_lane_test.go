@@ -0,0 +1,175 @@
+package ante
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mapAppOptions map[string]interface{}
+
+func (m mapAppOptions) Get(key string) interface{} {
+	return m[key]
+}
+
+// enabledPriorityLane is the app config enabling the priority lane, which is disabled by default.
+var enabledPriorityLane = mapAppOptions{"osmosis-mempool.priority-lane-enabled": "true"}
+
+type constantBaseDenomGetter string
+
+func (g constantBaseDenomGetter) GetBaseDenom(_ sdk.Context) (string, error) {
+	return string(g), nil
+}
+
+// priorityLaneTestTx is a minimal fee tx for testing the priority lane decorator.
+type priorityLaneTestTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+	gas  uint64
+}
+
+func (tx priorityLaneTestTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx priorityLaneTestTx) ValidateBasic() error       { return nil }
+func (tx priorityLaneTestTx) GetGas() uint64             { return tx.gas }
+func (tx priorityLaneTestTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx priorityLaneTestTx) FeePayer() sdk.AccAddress   { return tx.msgs[0].GetSigners()[0] }
+func (tx priorityLaneTestTx) FeeGranter() sdk.AccAddress { return nil }
+
+func TestPriorityLaneDecorator(t *testing.T) {
+	addr := sdk.AccAddress("priority-lane-sender")
+	vote := govv1.NewMsgVote(addr, 1, govv1.OptionYes, "")
+	unjail := slashingtypes.NewMsgUnjail(sdk.ValAddress(addr))
+	send := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("test", 1)))
+
+	testCases := []struct {
+		name           string
+		appOpts        mapAppOptions
+		msgs           []sdk.Msg
+		expectPriority bool
+	}{
+		{"lane is disabled by default", mapAppOptions{}, []sdk.Msg{vote}, false},
+		{"vote is prioritized by default", enabledPriorityLane, []sdk.Msg{vote}, true},
+		{"vote and unjail are prioritized by default", enabledPriorityLane, []sdk.Msg{vote, unjail}, true},
+		{"send is not prioritized", enabledPriorityLane, []sdk.Msg{send}, false},
+		{"vote mixed with send is not prioritized", enabledPriorityLane, []sdk.Msg{vote, send}, false},
+		{"empty tx is not prioritized", enabledPriorityLane, []sdk.Msg{}, false},
+		{
+			"lane disabled",
+			mapAppOptions{"osmosis-mempool.priority-lane-enabled": "false"},
+			[]sdk.Msg{vote},
+			false,
+		},
+		{
+			"configured msg types override defaults",
+			mapAppOptions{
+				"osmosis-mempool.priority-lane-enabled":   "true",
+				"osmosis-mempool.priority-lane-msg-types": []interface{}{sdk.MsgTypeURL(send)},
+			},
+			[]sdk.Msg{send},
+			true,
+		},
+		{
+			"default msg types are not prioritized when overridden",
+			mapAppOptions{
+				"osmosis-mempool.priority-lane-enabled":   "true",
+				"osmosis-mempool.priority-lane-msg-types": []interface{}{sdk.MsgTypeURL(send)},
+			},
+			[]sdk.Msg{vote},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := NewPriorityLaneDecorator(NewPriorityLaneOptions(tc.appOpts), constantBaseDenomGetter("uosmo"))
+			require.Equal(t, tc.expectPriority, decorator.IsPriorityLaneTx(tc.msgs))
+		})
+	}
+}
+
+func TestPriorityLaneDecorator_AnteHandle(t *testing.T) {
+	addr := sdk.AccAddress("priority-lane-sender")
+	otherAddr := sdk.AccAddress("priority-lane-other")
+	vote := govv1.NewMsgVote(addr, 1, govv1.OptionYes, "")
+	otherVote := govv1.NewMsgVote(otherAddr, 1, govv1.OptionYes, "")
+	send := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("test", 1)))
+	fee := func(denom string, amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	testCases := []struct {
+		name             string
+		appOpts          mapAppOptions
+		txs              []priorityLaneTestTx
+		expectPriorities []int64
+	}{
+		{
+			"priority lane txs are ranked by gas price in the base denom",
+			enabledPriorityLane,
+			[]priorityLaneTestTx{
+				{msgs: []sdk.Msg{vote}, fee: fee("uosmo", 2_500), gas: 1_000_000},
+				{msgs: []sdk.Msg{otherVote}, fee: fee("uosmo", 5_000), gas: 1_000_000},
+			},
+			[]int64{PriorityLaneTxPriority + 2_500, PriorityLaneTxPriority + 5_000},
+		},
+		{
+			"fees in other denoms do not raise the priority",
+			enabledPriorityLane,
+			[]priorityLaneTestTx{{msgs: []sdk.Msg{vote}, fee: fee("uatom", 1_000_000), gas: 1_000_000}},
+			[]int64{PriorityLaneTxPriority},
+		},
+		{
+			"other txs keep the default priority",
+			enabledPriorityLane,
+			[]priorityLaneTestTx{{msgs: []sdk.Msg{send}, fee: fee("uosmo", 1_000_000), gas: 1_000_000}},
+			[]int64{0},
+		},
+		{
+			"only the first tx of a signer per block is prioritized by default",
+			enabledPriorityLane,
+			[]priorityLaneTestTx{
+				{msgs: []sdk.Msg{vote}, fee: fee("uosmo", 2_500), gas: 1_000_000},
+				{msgs: []sdk.Msg{vote}, fee: fee("uosmo", 2_500), gas: 1_000_000},
+				{msgs: []sdk.Msg{otherVote}, fee: fee("uosmo", 2_500), gas: 1_000_000},
+			},
+			[]int64{PriorityLaneTxPriority + 2_500, 0, PriorityLaneTxPriority + 2_500},
+		},
+		{
+			"signer limit can be removed",
+			mapAppOptions{
+				"osmosis-mempool.priority-lane-enabled":                      "true",
+				"osmosis-mempool.priority-lane-max-txs-per-signer-per-block": "0",
+			},
+			[]priorityLaneTestTx{
+				{msgs: []sdk.Msg{vote}, fee: fee("uosmo", 2_500), gas: 1_000_000},
+				{msgs: []sdk.Msg{vote}, fee: fee("uosmo", 2_500), gas: 1_000_000},
+			},
+			[]int64{PriorityLaneTxPriority + 2_500, PriorityLaneTxPriority + 2_500},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decorator := NewPriorityLaneDecorator(NewPriorityLaneOptions(tc.appOpts), constantBaseDenomGetter("uosmo"))
+			ctx := sdk.Context{}.WithIsCheckTx(true).WithBlockHeight(1)
+			for i, tx := range tc.txs {
+				newCtx, err := decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+					return ctx, nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, tc.expectPriorities[i], newCtx.Priority())
+			}
+
+			// The signer limit is reset at the next block height.
+			newCtx, err := decorator.AnteHandle(ctx.WithBlockHeight(2), tc.txs[0], false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPriorities[0], newCtx.Priority())
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+// SimulationDecorator marks the context of simulated transactions so that modules
+// can tell simulations apart from regular execution when handling messages.
+type SimulationDecorator struct{}
+
+// NewSimulationDecorator returns a new SimulationDecorator.
+func NewSimulationDecorator() SimulationDecorator {
+	return SimulationDecorator{}
+}
+
+// AnteHandle marks the context with osmoutils.WithSimulation if the transaction is being simulated.
+func (SimulationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if simulate {
+		ctx = osmoutils.WithSimulation(ctx)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,24 @@
+package ante
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+func TestSimulationDecorator(t *testing.T) {
+	for _, simulate := range []bool{true, false} {
+		decorator := NewSimulationDecorator()
+		next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx, nil
+		}
+
+		newCtx, err := decorator.AnteHandle(sdk.Context{}.WithContext(context.Background()), nil, simulate, next)
+		require.NoError(t, err)
+		require.Equal(t, simulate, osmoutils.IsSimulation(newCtx))
+	}
+}
@@ -62,6 +62,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/api"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -99,6 +100,18 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs"
 
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/common"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming"
+
+	"github.com/osmosis-labs/osmosis/v21/app/queryserver"
+	clclient "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client"
+	clgrpc "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/grpc"
+	clqueryproto "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v21/x/incentives/keeper"
+	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	poolmanagerclient "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client"
+	poolmanagergrpc "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client/grpc"
+	poolmanagerqueryproto "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client/queryproto"
 )
 
 const appName = "OsmosisApp"
@@ -156,6 +169,9 @@ type OsmosisApp struct {
 	sm           *module.SimulationManager
 	configurator module.Configurator
 	homePath     string
+
+	// QueryServer serves read-heavy queries from committed state snapshots. Nil if disabled.
+	QueryServer *queryserver.Server
 }
 
 // init sets DefaultNodeHome to default osmosisd install location.
@@ -277,6 +293,29 @@ func NewOsmosisApp(
 		app.IngestManager.RegisterIngester(sqsIngester)
 	}
 
+	streamingConfig := streaming.NewConfigFromOptions(appOpts)
+
+	// Initialize the streaming service if it is enabled.
+	if streamingConfig.IsEnabled {
+		streamingManager, err := streamingConfig.Initialize(homePath)
+		if err != nil {
+			panic(err)
+		}
+
+		// Expose the pool and position stores, as well as the transient store the swaps are recorded in,
+		// so that their writes are passed to the listener on commit.
+		storeKeys := app.GetKVStoreKey()
+		exposedStoreKeys := make([]storetypes.StoreKey, 0, len(streaming.StoreKeys)+1)
+		for _, storeKeyName := range streaming.StoreKeys {
+			exposedStoreKeys = append(exposedStoreKeys, storeKeys[storeKeyName])
+		}
+		exposedStoreKeys = append(exposedStoreKeys, app.GetTKey(streaming.TransientStoreKey))
+		app.CommitMultiStore().AddListeners(exposedStoreKeys)
+		app.StreamingSwapRecorder.Enable()
+
+		app.SetStreamingManager(streamingManager)
+	}
+
 	// TODO: There is a bug here, where we register the govRouter routes in InitNormalKeepers and then
 	// call setupHooks afterwards. Therefore, if a gov proposal needs to call a method and that method calls a
 	// hook, we will get a nil pointer dereference error due to the hooks in the keeper not being
@@ -285,6 +324,26 @@ func NewOsmosisApp(
 	// https://github.com/osmosis-labs/osmosis/issues/6580
 	app.SetupHooks()
 
+	queryServerConfig := queryserver.NewConfigFromOptions(appOpts)
+
+	// Initialize the query server if it is enabled.
+	if queryServerConfig.IsEnabled {
+		queryServer, err := queryserver.NewServer(queryServerConfig, app.CommitMultiStore(), logger)
+		if err != nil {
+			panic(err)
+		}
+
+		clqueryproto.RegisterQueryServer(queryServer, clgrpc.Querier{Q: clclient.Querier{Keeper: *app.ConcentratedLiquidityKeeper}})
+		poolmanagerqueryproto.RegisterQueryServer(queryServer, poolmanagergrpc.Querier{Q: poolmanagerclient.NewQuerier(*app.PoolManagerKeeper)})
+		incentivestypes.RegisterQueryServer(queryServer, incentiveskeeper.NewQuerier(*app.IncentivesKeeper))
+
+		if err := queryServer.Start(); err != nil {
+			panic(err)
+		}
+
+		app.QueryServer = queryServer
+	}
+
 	/****  Module Options ****/
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -418,6 +477,9 @@ func (app *OsmosisApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block.
 func (app *OsmosisApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if app.QueryServer != nil {
+		app.QueryServer.BeginBlock(ctx.BlockHeader())
+	}
 	BeginBlockForks(ctx, app)
 	return app.mm.BeginBlock(ctx, req)
 }
@@ -429,6 +491,23 @@ func (app *OsmosisApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abc
 	return app.mm.EndBlock(ctx, req)
 }
 
+// Commit commits the block and, if the query server is enabled, snapshots the committed state for it.
+func (app *OsmosisApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.QueryServer != nil {
+		app.QueryServer.Commit()
+	}
+	return res
+}
+
+// Close stops the query server, if enabled, before closing the base app on shutdown.
+func (app *OsmosisApp) Close() error {
+	if app.QueryServer != nil {
+		app.QueryServer.Stop()
+	}
+	return app.BaseApp.Close()
+}
+
 // InitChainer application update at chain initialization.
 func (app *OsmosisApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
@@ -0,0 +1,53 @@
+package clfuzz
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func TestSelectTargetTick(t *testing.T) {
+	ranges := [][]int64{{-100, 100}, {types.MaxTick - 100, types.MaxTick}, {types.MinInitializedTick, 0}}
+
+	tests := map[string]struct {
+		target        TickBoundaryTarget
+		expectedTicks []int64
+	}{
+		"exact boundary": {
+			target:        ExactTickBoundary,
+			expectedTicks: []int64{-100, 100, types.MaxTick - 100, types.MaxTick, types.MinInitializedTick, 0},
+		},
+		"below boundary, clamped to min initialized tick": {
+			target:        BelowTickBoundary,
+			expectedTicks: []int64{-101, 99, types.MaxTick - 101, types.MaxTick - 1, types.MinInitializedTick, -1},
+		},
+		"above boundary, clamped to max tick": {
+			target:        AboveTickBoundary,
+			expectedTicks: []int64{-99, 101, types.MaxTick - 99, types.MaxTick, types.MinInitializedTick + 1, 1},
+		},
+		"min tick": {
+			target:        MinTickBoundary,
+			expectedTicks: []int64{types.MinInitializedTick},
+		},
+		"max tick": {
+			target:        MaxTickBoundary,
+			expectedTicks: []int64{types.MaxTick},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(DefaultFuzzSeed))
+			selected := map[int64]bool{}
+			for i := 0; i < 100; i++ {
+				tick := selectTargetTick(r, ranges, []TickBoundaryTarget{tc.target})
+				require.Contains(t, tc.expectedTicks, tick)
+				selected[tick] = true
+			}
+			require.Len(t, selected, len(tc.expectedTicks))
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package clfuzz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+type ExpectedGlobalRewardValues struct {
+	ExpectedAdditiveSpreadRewardTolerance osmomath.Dec
+	ExpectedAdditiveIncentivesTolerance   osmomath.Dec
+	TotalSpreadRewards                    sdk.Coins
+	TotalIncentives                       sdk.Coins
+}
+
+// AssertGlobalInvariants asserts all available global invariants (i.e. invariants that should hold on all valid states).
+// These include the invariants registered with x/crisis by the concentrated liquidity module.
+// Does not persist any changes to state.
+func (h *Harness) AssertGlobalInvariants(expectedGlobalRewardValues ExpectedGlobalRewardValues) {
+	h.AssertRegisteredInvariants()
+	h.AssertTotalRewardsInvariant(expectedGlobalRewardValues)
+	h.AssertWithdrawAllInvariant()
+}
+
+// AssertRegisteredInvariants asserts that none of the concentrated liquidity module's registered invariants are broken.
+func (h *Harness) AssertRegisteredInvariants() {
+	msg, broken := cl.AllInvariants(*h.Clk)(h.Ctx)
+	h.require().False(broken, msg)
+}
+
+// getAllPositionsAndBalances returns all the positions in state alongside all the pool balances for all pools in state.
+//
+// Returns:
+// * All positions across all pools
+// * Total pool assets across all pools
+// * Total pool spread rewards across all pools
+// * Total pool incentives across all pools
+func (h *Harness) getAllPositionsAndPoolBalances(ctx sdk.Context) ([]model.Position, sdk.Coins, sdk.Coins, sdk.Coins) {
+	// Get total spread rewards distributed to all pools
+	allPools, err := h.Clk.GetPools(ctx)
+	totalPoolAssets, totalSpreadRewards, totalIncentives := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+
+	// Sum up pool balances across all pools
+	for _, pool := range allPools {
+		clPool, ok := pool.(types.ConcentratedPoolExtension)
+		h.require().True(ok)
+		totalPoolAssets = totalPoolAssets.Add(h.App.BankKeeper.GetBalance(ctx, clPool.GetAddress(), clPool.GetToken0()))
+		totalPoolAssets = totalPoolAssets.Add(h.App.BankKeeper.GetBalance(ctx, clPool.GetAddress(), clPool.GetToken1()))
+		totalSpreadRewards = totalSpreadRewards.Add(h.App.BankKeeper.GetBalance(ctx, clPool.GetSpreadRewardsAddress(), clPool.GetToken0()))
+		totalSpreadRewards = totalSpreadRewards.Add(h.App.BankKeeper.GetBalance(ctx, clPool.GetSpreadRewardsAddress(), clPool.GetToken1()))
+		totalIncentives = totalIncentives.Add(h.App.BankKeeper.GetAllBalances(ctx, clPool.GetIncentivesAddress())...)
+	}
+
+	// Get all positions in state
+	allPoolPositions, err := h.Clk.GetAllPositions(ctx)
+	h.require().NoError(err)
+
+	return allPoolPositions, totalPoolAssets, totalSpreadRewards, totalIncentives
+}
+
+// AssertTotalRewardsInvariant asserts two invariants on the current context:
+// 1. Claiming spread rewards and incentives for all positions in state yields the amount stored in pool reward addresses minus rounding errors
+// 2. Claiming spread rewards and incentives for all positions in state empties all pool reward addresses except for rounding errors
+//
+// This function operates on cached context to avoid persisting any changes to state.
+func (h *Harness) AssertTotalRewardsInvariant(expectedGlobalRewardValues ExpectedGlobalRewardValues) {
+	// Get all positions and total pool balances across all CL pools in state
+	allPositions, initialTotalPoolLiquidity, expectedTotalSpreadRewards, expectedTotalIncentives := h.getAllPositionsAndPoolBalances(h.Ctx)
+
+	if expectedGlobalRewardValues.TotalSpreadRewards != nil {
+		expectedTotalSpreadRewards = expectedGlobalRewardValues.TotalSpreadRewards
+	}
+
+	if expectedGlobalRewardValues.TotalIncentives != nil {
+		expectedTotalIncentives = expectedGlobalRewardValues.TotalIncentives
+	}
+
+	// Switch to cached context to avoid persisting any changes to state
+	cachedCtx, _ := h.Ctx.CacheContext()
+
+	// Collect through the msg server, which is the only exported entry point for claiming rewards.
+	msgServer := cl.NewMsgServerImpl(h.Clk)
+
+	// Collect spread rewards for all positions and track output
+	totalCollectedSpread, totalCollectedIncentives := sdk.NewCoins(), sdk.NewCoins()
+	for _, position := range allPositions {
+		owner, err := sdk.AccAddressFromBech32(position.Address)
+		h.require().NoError(err)
+
+		// Log initial position owner balance
+		initialBalance := h.App.BankKeeper.GetAllBalances(cachedCtx, owner)
+
+		// Collect spread rewards.
+		spreadRewardsResp, err := msgServer.CollectSpreadRewards(sdk.WrapSDKContext(cachedCtx), &types.MsgCollectSpreadRewards{
+			PositionIds: []uint64{position.PositionId},
+			Sender:      position.Address,
+		})
+		h.require().NoError(err)
+		collectedSpread := spreadRewardsResp.CollectedSpreadRewards
+
+		// Collect incentives.
+		//
+		// Since we expect forfeited coins to go to other positions who have not yet claimed, we
+		// do not include them in the sum.
+		//
+		// Balancer full range incentives are also not factored in because they are claimed and sent to
+		// gauge immediately upon distribution.
+		incentivesResp, err := msgServer.CollectIncentives(sdk.WrapSDKContext(cachedCtx), &types.MsgCollectIncentives{
+			PositionIds: []uint64{position.PositionId},
+			Sender:      position.Address,
+		})
+		h.require().NoError(err)
+		collectedIncentives := incentivesResp.CollectedIncentives
+
+		// Ensure position owner's balance was updated correctly
+		finalBalance := h.App.BankKeeper.GetAllBalances(cachedCtx, owner)
+		h.require().Equal(initialBalance.Add(collectedSpread...).Add(collectedIncentives...), finalBalance)
+
+		// Track total amounts
+		totalCollectedSpread = totalCollectedSpread.Add(collectedSpread...)
+		totalCollectedIncentives = totalCollectedIncentives.Add(collectedIncentives...)
+	}
+
+	spreadRewardAdditiveTolerance := osmomath.Dec{}
+	if !expectedGlobalRewardValues.ExpectedAdditiveSpreadRewardTolerance.IsNil() {
+		spreadRewardAdditiveTolerance = expectedGlobalRewardValues.ExpectedAdditiveSpreadRewardTolerance
+	}
+
+	incentivesAdditiveTolerance := osmomath.Dec{}
+	if !expectedGlobalRewardValues.ExpectedAdditiveIncentivesTolerance.IsNil() {
+		incentivesAdditiveTolerance = expectedGlobalRewardValues.ExpectedAdditiveSpreadRewardTolerance
+	}
+
+	// We ensure that any rounding error was in the pool's favor by rounding down.
+	// This is to allow for cases where we slightly overround, which would otherwise fail here.
+	// TODO: multiplicative tolerance to allow for
+	// tightening this check further.
+	spreadRewardErrTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: spreadRewardAdditiveTolerance,
+		RoundingDir:       osmomath.RoundDown,
+	}
+
+	incentivesErrTolerance := osmomath.ErrTolerance{
+		AdditiveTolerance: incentivesAdditiveTolerance,
+		RoundingDir:       osmomath.RoundDown,
+	}
+
+	// Assert total collected spread rewards and incentives equal to expected
+	h.require().True(spreadRewardErrTolerance.EqualCoins(expectedTotalSpreadRewards, totalCollectedSpread), "expected spread rewards vs. collected: %s vs. %s", expectedTotalSpreadRewards, totalCollectedSpread)
+	h.require().True(incentivesErrTolerance.EqualCoins(expectedTotalIncentives, totalCollectedIncentives), "expected incentives vs. collected: %s vs. %s", expectedTotalIncentives, totalCollectedIncentives)
+
+	// Refetch total pool balances across all pools
+	remainingPositions, finalTotalPoolLiquidity, remainingTotalSpreadRewards, remainingTotalIncentives := h.getAllPositionsAndPoolBalances(cachedCtx)
+
+	// Ensure pool liquidity remains unchanged
+	h.require().Equal(initialTotalPoolLiquidity, finalTotalPoolLiquidity)
+
+	// Ensure total remaining spread rewards and incentives are exactly equal to loss due to rounding
+	if expectedGlobalRewardValues.TotalSpreadRewards == nil {
+		roundingLossSpread := expectedTotalSpreadRewards.Sub(totalCollectedSpread...)
+		h.require().Equal(roundingLossSpread, remainingTotalSpreadRewards)
+	}
+
+	if expectedGlobalRewardValues.TotalIncentives == nil {
+		roundingLossIncentives := expectedTotalIncentives.Sub(totalCollectedIncentives...)
+		h.require().Equal(roundingLossIncentives, remainingTotalIncentives)
+	}
+
+	// Ensure no positions were deleted
+	h.require().Equal(len(allPositions), len(remainingPositions))
+}
+
+// AssertWithdrawAllInvariant withdraws all positions from all pools in state and asserts that all pool liquidity was removed from pool balances.
+func (h *Harness) AssertWithdrawAllInvariant() {
+	// Get all positions and pool balances across all CL pools in state
+	allPositions, expectedTotalWithdrawn, _, _ := h.getAllPositionsAndPoolBalances(h.Ctx)
+
+	// Switch to cached context to avoid persisting any changes to state
+	cachedCtx, _ := h.Ctx.CacheContext()
+
+	// Withdraw all assets for all positions and track output
+	totalWithdrawn := sdk.NewCoins()
+	for _, position := range allPositions {
+		owner, err := sdk.AccAddressFromBech32(position.Address)
+		h.require().NoError(err)
+
+		// Withdraw all assets from position
+		amt0Withdrawn, amt1Withdrawn, err := h.Clk.WithdrawPosition(cachedCtx, owner, position.PositionId, position.Liquidity)
+		h.require().NoError(err)
+
+		// Convert withdrawn assets to coins
+		positionPool, err := h.Clk.GetConcentratedPoolById(cachedCtx, position.PoolId)
+		h.require().NoError(err)
+		withdrawn := sdk.NewCoins(
+			sdk.NewCoin(positionPool.GetToken0(), amt0Withdrawn),
+			sdk.NewCoin(positionPool.GetToken1(), amt1Withdrawn),
+		)
+
+		// Track total withdrawn assets
+		totalWithdrawn = totalWithdrawn.Add(withdrawn...)
+	}
+
+	// For global invariant checks, we simply ensure that any rounding error was in the pool's favor.
+	// This is to allow for cases where we slightly overround, which would otherwise fail here.
+	// TODO: create ErrTolerance type that allows for additive OR multiplicative tolerance to allow for
+	// tightening this check further.
+	errTolerance := osmomath.ErrTolerance{
+		RoundingDir: osmomath.RoundDown,
+	}
+
+	// Assert total withdrawn assets equal to expected
+	h.require().True(errTolerance.EqualCoins(expectedTotalWithdrawn, totalWithdrawn), "expected withdrawn vs. actual: %s vs. %s", expectedTotalWithdrawn, totalWithdrawn)
+
+	// Refetch total pool balances across all pools
+	remainingPositions, finalTotalPoolAssets, remainingTotalSpreadRewards, remainingTotalIncentives := h.getAllPositionsAndPoolBalances(cachedCtx)
+
+	// Ensure no more positions exist in state
+	h.require().Equal(0, len(remainingPositions))
+
+	// Ensure pool liquidity only has rounding error left in it
+	roundingLossAssets := expectedTotalWithdrawn.Sub(totalWithdrawn...)
+	h.require().Equal(roundingLossAssets, finalTotalPoolAssets)
+
+	// Ensure spread rewards and incentives are all claimed except for rounding error
+	h.require().True(errTolerance.EqualCoins(remainingTotalSpreadRewards, sdk.NewCoins()))
+	h.require().True(errTolerance.EqualCoins(remainingTotalIncentives, sdk.NewCoins()))
+}
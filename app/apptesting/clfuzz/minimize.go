@@ -0,0 +1,207 @@
+package clfuzz
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+const (
+	// DefaultFuzzSeed is the seed used when neither FuzzSeedEnvVar nor RangeTestParams.FuzzSeed is set.
+	DefaultFuzzSeed = 2
+	// FuzzSeedEnvVar is the environment variable overriding the seed of every range test run,
+	// e.g. `CL_FUZZ_SEED=42 go test ./x/concentrated-liquidity/...`.
+	FuzzSeedEnvVar = "CL_FUZZ_SEED"
+
+	// maxMinimizeRuns bounds the number of runs spent minimizing a failure.
+	maxMinimizeRuns = 50
+)
+
+var errFailNow = errors.New("clfuzz: assertion failed")
+
+// Failure is a failing range test run, minimized as described in SetupRangesAndAssertInvariants.
+type Failure struct {
+	// Seed of the fuzzed values of the run.
+	Seed int64 `json:"seed"`
+	// Ranges and params of the smallest failing run found, with the params' FuzzSeed set to Seed.
+	Ranges [][]int64       `json:"ranges"`
+	Params RangeTestParams `json:"params"`
+	// Message of the assertion failure or panic of the smallest failing run found.
+	Message string `json:"message"`
+}
+
+// FuzzSeed returns the seed of a range test run with the given params.
+// The FuzzSeedEnvVar environment variable takes precedence over testParams.FuzzSeed, so that any
+// failure can be reproduced or explored without code changes. Defaults to DefaultFuzzSeed.
+func FuzzSeed(testParams RangeTestParams) (int64, error) {
+	if envSeed, ok := os.LookupEnv(FuzzSeedEnvVar); ok {
+		seed, err := strconv.ParseInt(envSeed, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s %q: %w", FuzzSeedEnvVar, envSeed, err)
+		}
+		return seed, nil
+	}
+	if testParams.FuzzSeed != 0 {
+		return testParams.FuzzSeed, nil
+	}
+	return DefaultFuzzSeed, nil
+}
+
+// failureRecorder implements require.TestingT, collecting failures instead of failing the test.
+type failureRecorder struct {
+	failures []string
+}
+
+func (r *failureRecorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *failureRecorder) FailNow() {
+	panic(errFailNow)
+}
+
+func (r *failureRecorder) String() string {
+	return strings.Join(r.failures, "\n")
+}
+
+// require returns the assertions used by the harness, which record failures while a run is recorded.
+func (h *Harness) require() *require.Assertions {
+	if h.recorder != nil {
+		return require.New(h.recorder)
+	}
+	return h.Require()
+}
+
+// runRecorded runs setupRanges on a cache of the current context with the given seed and returns the failure, if any.
+// If no pools are given, the pools specified by testParams are created as part of the run.
+// The state of a successful run is written to the current context if persist is true, and discarded otherwise.
+func (h *Harness) runRecorded(pools []types.ConcentratedPoolExtension, ranges [][]int64, testParams RangeTestParams, seed int64, persist bool) string {
+	baseCtx := h.Ctx
+	h.rand = rand.New(rand.NewSource(seed))
+	h.recorder = &failureRecorder{}
+	defer func() { h.recorder = nil }()
+
+	failure, written, err := osmoutils.ApplyFuncIfNoErrorAndCondition(baseCtx, func(cacheCtx sdk.Context) (string, error) {
+		h.Ctx = cacheCtx
+		return h.recoverFailure(func() {
+			if pools == nil {
+				pools = h.preparePools(ranges, testParams)
+			}
+			h.setupRanges(pools, ranges, testParams)
+		}), nil
+	}, func(failure string) bool {
+		return failure == "" && persist
+	})
+	if err != nil {
+		failure = err.Error()
+	}
+
+	if !written {
+		h.Ctx = baseCtx
+		return failure
+	}
+	h.Ctx = h.Ctx.WithMultiStore(baseCtx.MultiStore())
+	return failure
+}
+
+// recoverFailure runs the given function, converting recorded assertion failures and panics into a failure message.
+func (h *Harness) recoverFailure(run func()) (failure string) {
+	defer func() {
+		if r := recover(); r != nil {
+			failure = h.recorder.String()
+			if r != errFailNow {
+				failure = fmt.Sprintf("panic: %v", r)
+			}
+		}
+	}()
+
+	run()
+	return h.recorder.String()
+}
+
+type shrinkCandidate struct {
+	ranges     [][]int64
+	testParams RangeTestParams
+}
+
+// minimize re-runs a failing run with the same seed on smaller candidates until none of them fails,
+// returning the smallest failing ranges and params found along with their failure.
+func (h *Harness) minimize(pools []types.ConcentratedPoolExtension, ranges [][]int64, testParams RangeTestParams, seed int64, failure string) ([][]int64, RangeTestParams, string) {
+	runs := 0
+	for improved := true; improved && runs < maxMinimizeRuns; {
+		improved = false
+		for _, candidate := range shrinkCandidates(ranges, testParams, pools == nil) {
+			if runs >= maxMinimizeRuns {
+				break
+			}
+			runs++
+
+			if candidateFailure := h.runRecorded(pools, candidate.ranges, candidate.testParams, seed, false); candidateFailure != "" {
+				ranges, testParams, failure, improved = candidate.ranges, candidate.testParams, candidateFailure, true
+				break
+			}
+		}
+	}
+
+	return ranges, testParams, failure
+}
+
+// shrinkCandidates returns the runs that are strictly smaller than the given one, from the most to the least reduced:
+// 1. Removing one of the ranges
+// 2. Halving the number of positions per range
+// 3. Halving the number of pools, if they are created by the run
+// 4. Removing the swaps
+// 5. Removing the incentives
+// 6. Removing the withdrawals
+func shrinkCandidates(ranges [][]int64, testParams RangeTestParams, createsPools bool) []shrinkCandidate {
+	candidates := []shrinkCandidate{}
+
+	if len(ranges) > 1 {
+		for i := range ranges {
+			fewerRanges := make([][]int64, 0, len(ranges)-1)
+			fewerRanges = append(fewerRanges, ranges[:i]...)
+			fewerRanges = append(fewerRanges, ranges[i+1:]...)
+			candidates = append(candidates, shrinkCandidate{ranges: fewerRanges, testParams: testParams})
+		}
+	}
+
+	if testParams.BaseNumPositions > 1 {
+		fewerPositions := testParams
+		fewerPositions.BaseNumPositions /= 2
+		candidates = append(candidates, shrinkCandidate{ranges: ranges, testParams: fewerPositions})
+	}
+
+	if createsPools && testParams.NumPools > 1 {
+		fewerPools := testParams
+		fewerPools.NumPools /= 2
+		candidates = append(candidates, shrinkCandidate{ranges: ranges, testParams: fewerPools})
+	}
+
+	if testParams.BaseSwapAmount != (osmomath.Int{}) {
+		candidates = append(candidates, shrinkCandidate{ranges: ranges, testParams: WithNoSwap(testParams)})
+	}
+
+	if testParams.BaseIncentiveAmount != (osmomath.Int{}) {
+		noIncentives := testParams
+		noIncentives.BaseIncentiveAmount = osmomath.Int{}
+		candidates = append(candidates, shrinkCandidate{ranges: ranges, testParams: noIncentives})
+	}
+
+	if testParams.WithdrawBetweenJoins {
+		noWithdrawals := testParams
+		noWithdrawals.WithdrawBetweenJoins = false
+		candidates = append(candidates, shrinkCandidate{ranges: ranges, testParams: noWithdrawals})
+	}
+
+	return candidates
+}
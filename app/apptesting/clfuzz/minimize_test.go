@@ -0,0 +1,73 @@
+package clfuzz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func TestFuzzSeed(t *testing.T) {
+	tests := map[string]struct {
+		envSeed      string
+		paramsSeed   int64
+		expectedSeed int64
+		expectErr    bool
+	}{
+		"default":                   {expectedSeed: DefaultFuzzSeed},
+		"params seed":               {paramsSeed: 7, expectedSeed: 7},
+		"env seed overrides params": {envSeed: "42", paramsSeed: 7, expectedSeed: 42},
+		"invalid env seed":          {envSeed: "abc", expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if tc.envSeed != "" {
+				t.Setenv(FuzzSeedEnvVar, tc.envSeed)
+			}
+
+			seed, err := FuzzSeed(WithFuzzSeed(DefaultRangeTestParams, tc.paramsSeed))
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSeed, seed)
+		})
+	}
+}
+
+func TestShrinkCandidates(t *testing.T) {
+	ranges := [][]int64{{-100, 100}, {0, 200}, {-200, 0}}
+
+	candidates := shrinkCandidates(ranges, DefaultRangeTestParams, true)
+	// 3 ranges removed one at a time, halved positions, no swaps and no incentives.
+	require.Len(t, candidates, 6)
+	for i := 0; i < 3; i++ {
+		require.Len(t, candidates[i].ranges, 2)
+		require.NotContains(t, candidates[i].ranges, ranges[i])
+	}
+	require.Equal(t, DefaultRangeTestParams.BaseNumPositions/2, candidates[3].testParams.BaseNumPositions)
+	require.Equal(t, osmomath.Int{}, candidates[4].testParams.BaseSwapAmount)
+	require.Equal(t, osmomath.Int{}, candidates[5].testParams.BaseIncentiveAmount)
+
+	// A single range with a single position, no swaps and no incentives cannot be shrunk further.
+	minimal := DefaultRangeTestParams
+	minimal.BaseNumPositions = 1
+	minimal.BaseSwapAmount = osmomath.Int{}
+	minimal.BaseIncentiveAmount = osmomath.Int{}
+	require.Empty(t, shrinkCandidates(ranges[:1], minimal, true))
+
+	// The number of pools is only shrunk if the pools are created by the run.
+	multiPool := WithNumPools(minimal, 3)
+	require.Empty(t, shrinkCandidates(ranges[:1], multiPool, false))
+	candidates = shrinkCandidates(ranges[:1], multiPool, true)
+	require.Len(t, candidates, 1)
+	require.Equal(t, 1, candidates[0].testParams.NumPools)
+
+	// Withdrawals are removed last.
+	candidates = shrinkCandidates(ranges[:1], WithWithdrawals(minimal), true)
+	require.Len(t, candidates, 1)
+	require.False(t, candidates[0].testParams.WithdrawBetweenJoins)
+}
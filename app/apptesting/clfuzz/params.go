@@ -0,0 +1,236 @@
+// Package clfuzz exposes the concentrated liquidity range test fuzzing harness and the
+// global CL invariants, so that packages outside of x/concentrated-liquidity (e.g. CosmWasm
+// pool integrations) can run the same invariant battery against their own state.
+package clfuzz
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+)
+
+// RangeTestParams specifies the state set up by SetupRangesAndAssertInvariants on a set of ranges.
+type RangeTestParams struct {
+	// -- Base amounts --
+
+	// Base number of assets for each position
+	BaseAssets sdk.Coins
+	// Base number of positions for each range
+	BaseNumPositions int
+	// Base amount to swap for each swap
+	BaseSwapAmount osmomath.Int
+	// Base amount to add after each new position
+	BaseTimeBetweenJoins time.Duration
+	// Base incentive amount to have on each incentive record
+	BaseIncentiveAmount osmomath.Int
+	// Base emission rate per second for incentive
+	BaseEmissionRate osmomath.Dec
+	// Base denom for each incentive record (ID appended to this)
+	BaseIncentiveDenom string
+	// List of addresses to swap from (randomly selected for each swap)
+	NumSwapAddresses int
+
+	// -- Pool params --
+
+	SpreadFactor osmomath.Dec
+	TickSpacing  uint64
+	// Number of concurrently active pools set up by SetupPoolsAndAssertInvariants. Defaults to 1 if zero.
+	NumPools int
+
+	// -- Fuzz params --
+
+	// Seed of the fuzzed values. DefaultFuzzSeed is used if zero. See FuzzSeed.
+	FuzzSeed             int64
+	FuzzAssets           bool
+	FuzzNumPositions     bool
+	FuzzSwapAmounts      bool
+	FuzzTimeBetweenJoins bool
+	FuzzIncentiveRecords bool
+	// Randomly select the spread factor and tick spacing of each pool set up by SetupPoolsAndAssertInvariants
+	// out of the authorized ones.
+	FuzzSpreadFactor bool
+	FuzzTickSpacing  bool
+
+	// -- Optional additional test dimensions --
+
+	// Have a single address for all positions in each range
+	SingleAddrPerRange bool
+	// Create new active incentive records between each join
+	NewActiveIncentivesBetweenJoins bool
+	// Create new inactive incentive records between each join
+	NewInactiveIncentivesBetweenJoins bool
+	// Fund each position address with double the expected amount of assets.
+	// Should only be used for cases where join amount gets pushed up due to
+	// precision near min tick.
+	DoubleFundPositionAddr bool
+	// Adjust input amounts for first position to set the starting current tick
+	// to the given value.
+	StartingCurrentTick int64
+	// Replace each swap with one that moves the current tick to a fuzzed tick boundary
+	// (see TickBoundaryTarget) instead of swapping a fuzzed amount.
+	SwapToTickBoundary bool
+	// Kinds of tick boundaries targeted when SwapToTickBoundary is set, one of which is
+	// randomly selected for each swap. AllTickBoundaryTargets is used if empty.
+	TickBoundaryTargets []TickBoundaryTarget
+	// Withdraw from randomly selected positions on each pool after each swap (see WithdrawalKind).
+	WithdrawBetweenJoins bool
+	// Kinds of withdrawals executed when WithdrawBetweenJoins is set, one of which is
+	// randomly selected for each withdrawal. AllWithdrawalKinds is used if empty.
+	WithdrawalKinds []WithdrawalKind
+}
+
+// WithdrawalKind is the kind of withdrawal executed when RangeTestParams.WithdrawBetweenJoins is set.
+type WithdrawalKind int
+
+const (
+	// FullWithdrawal withdraws all the liquidity of a position, deleting it.
+	FullWithdrawal WithdrawalKind = iota
+	// PartialWithdrawal withdraws a fuzzed fraction of the liquidity of a position.
+	PartialWithdrawal
+	// EmptyRangeWithdrawal fully withdraws all the positions on the range of a position, emptying its ticks
+	// unless they are shared with other ranges.
+	EmptyRangeWithdrawal
+)
+
+// AllWithdrawalKinds are the kinds of withdrawals executed by default when RangeTestParams.WithdrawBetweenJoins is set.
+var AllWithdrawalKinds = []WithdrawalKind{FullWithdrawal, PartialWithdrawal, EmptyRangeWithdrawal}
+
+// TickBoundaryTarget is the kind of tick targeted by a swap when RangeTestParams.SwapToTickBoundary is set.
+type TickBoundaryTarget int
+
+const (
+	// ExactTickBoundary targets the lower or upper tick of one of the ranges set up so far.
+	ExactTickBoundary TickBoundaryTarget = iota
+	// BelowTickBoundary targets one tick below the lower or upper tick of one of the ranges set up so far.
+	BelowTickBoundary
+	// AboveTickBoundary targets one tick above the lower or upper tick of one of the ranges set up so far.
+	AboveTickBoundary
+	// MinTickBoundary targets the min initialized tick.
+	MinTickBoundary
+	// MaxTickBoundary targets the max tick.
+	MaxTickBoundary
+)
+
+// AllTickBoundaryTargets are the tick boundaries targeted by default when RangeTestParams.SwapToTickBoundary is set.
+var AllTickBoundaryTargets = []TickBoundaryTarget{ExactTickBoundary, BelowTickBoundary, AboveTickBoundary, MinTickBoundary, MaxTickBoundary}
+
+var (
+	DefaultSpreadFactor = osmomath.NewDecWithPrec(2, 3)
+
+	DefaultRangeTestParams = RangeTestParams{
+		// Base amounts
+		BaseNumPositions:     10,
+		BaseAssets:           sdk.NewCoins(sdk.NewCoin(apptesting.ETH, osmomath.NewInt(5000000000)), sdk.NewCoin(apptesting.USDC, osmomath.NewInt(5000000000))),
+		BaseTimeBetweenJoins: time.Hour,
+		BaseSwapAmount:       osmomath.NewInt(10000000),
+		NumSwapAddresses:     10,
+		BaseIncentiveAmount:  osmomath.NewInt(1000000000000000000),
+		BaseEmissionRate:     osmomath.NewDec(1),
+		BaseIncentiveDenom:   "incentiveDenom",
+
+		// Pool params
+		SpreadFactor: DefaultSpreadFactor,
+		TickSpacing:  uint64(1),
+
+		// Fuzz params
+		FuzzNumPositions:     true,
+		FuzzAssets:           true,
+		FuzzSwapAmounts:      true,
+		FuzzTimeBetweenJoins: true,
+	}
+	RangeTestParamsLargeSwap = RangeTestParams{
+		// Base amounts
+		BaseNumPositions:     10,
+		BaseAssets:           sdk.NewCoins(sdk.NewCoin(apptesting.ETH, osmomath.NewInt(5000000000)), sdk.NewCoin(apptesting.USDC, osmomath.NewInt(5000000000))),
+		BaseTimeBetweenJoins: time.Hour,
+		BaseSwapAmount:       osmomath.Int(osmomath.MustNewDecFromStr("100000000000000000000000000000000000000")),
+		NumSwapAddresses:     10,
+		BaseIncentiveAmount:  osmomath.NewInt(1000000000000000000),
+		BaseEmissionRate:     osmomath.NewDec(1),
+		BaseIncentiveDenom:   "incentiveDenom",
+
+		// Pool params
+		SpreadFactor: DefaultSpreadFactor,
+		TickSpacing:  uint64(100),
+
+		// Fuzz params
+		FuzzNumPositions:     true,
+		FuzzAssets:           true,
+		FuzzTimeBetweenJoins: true,
+	}
+	RangeTestParamsNoFuzzNoSwap = RangeTestParams{
+		// Base amounts
+		BaseNumPositions:     1,
+		BaseAssets:           sdk.NewCoins(sdk.NewCoin(apptesting.ETH, osmomath.NewInt(5000000000)), sdk.NewCoin(apptesting.USDC, osmomath.NewInt(5000000000))),
+		BaseTimeBetweenJoins: time.Hour,
+		BaseIncentiveAmount:  osmomath.NewInt(1000000000000000000),
+		BaseEmissionRate:     osmomath.NewDec(1),
+		BaseIncentiveDenom:   "incentiveDenom",
+
+		// Pool params
+		SpreadFactor: DefaultSpreadFactor,
+		TickSpacing:  uint64(1),
+	}
+)
+
+func WithFuzzSeed(params RangeTestParams, seed int64) RangeTestParams {
+	params.FuzzSeed = seed
+	return params
+}
+
+func WithDoubleFundedLP(params RangeTestParams) RangeTestParams {
+	params.DoubleFundPositionAddr = true
+	return params
+}
+
+func WithCurrentTick(params RangeTestParams, tick int64) RangeTestParams {
+	params.StartingCurrentTick = tick
+	return params
+}
+
+func WithTickSpacing(params RangeTestParams, tickSpacing uint64) RangeTestParams {
+	params.TickSpacing = tickSpacing
+	return params
+}
+
+func WithNoSwap(params RangeTestParams) RangeTestParams {
+	params.BaseSwapAmount = osmomath.Int{}
+	return params
+}
+
+func WithNumPools(params RangeTestParams, numPools int) RangeTestParams {
+	params.NumPools = numPools
+	return params
+}
+
+func WithFuzzedPools(params RangeTestParams) RangeTestParams {
+	params.FuzzSpreadFactor = true
+	params.FuzzTickSpacing = true
+	return params
+}
+
+func WithNewIncentivesBetweenJoins(params RangeTestParams, active, inactive bool) RangeTestParams {
+	params.NewActiveIncentivesBetweenJoins = active
+	params.NewInactiveIncentivesBetweenJoins = inactive
+	return params
+}
+
+func WithFuzzedIncentiveRecords(params RangeTestParams) RangeTestParams {
+	params.FuzzIncentiveRecords = true
+	return params
+}
+
+func WithWithdrawals(params RangeTestParams, kinds ...WithdrawalKind) RangeTestParams {
+	params.WithdrawBetweenJoins = true
+	params.WithdrawalKinds = kinds
+	return params
+}
+
+func WithSwapToTickBoundary(params RangeTestParams, targets ...TickBoundaryTarget) RangeTestParams {
+	params.SwapToTickBoundary = true
+	params.TickBoundaryTargets = targets
+	return params
+}
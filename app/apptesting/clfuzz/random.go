@@ -0,0 +1,43 @@
+package clfuzz
+
+import (
+	"math/rand"
+)
+
+const (
+	// maxRandomRanges is the maximum number of ranges of a randomized range test.
+	maxRandomRanges = 4
+	// maxRandomPools is the maximum number of pools of a randomized range test.
+	maxRandomPools = 3
+	// randomRangeTickSpacing is the tick spacing that all the ticks of randomized ranges are divisible by.
+	randomRangeTickSpacing = 100
+	// maxRandomRangeTickSpacings bounds the lower tick of randomized ranges to +/- this many tick spacings,
+	// as well as their width.
+	maxRandomRangeTickSpacings = 200
+)
+
+// RandomizedRangeTest returns the ranges and params of a range test derived from the given seed, which also
+// seeds the fuzzed values of the run. Each of the optional test dimensions (swaps to tick boundaries, incentive
+// records between joins, withdrawals and multiple fuzzed pools) is randomly enabled on top of DefaultRangeTestParams.
+func RandomizedRangeTest(seed int64) ([][]int64, RangeTestParams) {
+	r := rand.New(rand.NewSource(seed))
+
+	ranges := make([][]int64, r.Intn(maxRandomRanges)+1)
+	for i := range ranges {
+		lowerTick := (r.Int63n(2*maxRandomRangeTickSpacings+1) - maxRandomRangeTickSpacings) * randomRangeTickSpacing
+		upperTick := lowerTick + (r.Int63n(maxRandomRangeTickSpacings)+1)*randomRangeTickSpacing
+		ranges[i] = []int64{lowerTick, upperTick}
+	}
+
+	testParams := WithFuzzSeed(DefaultRangeTestParams, seed)
+	testParams.SwapToTickBoundary = r.Intn(2) == 0
+	testParams.NewActiveIncentivesBetweenJoins = r.Intn(2) == 0
+	testParams.NewInactiveIncentivesBetweenJoins = r.Intn(2) == 0
+	testParams.FuzzIncentiveRecords = r.Intn(2) == 0
+	testParams.WithdrawBetweenJoins = r.Intn(2) == 0
+	testParams.NumPools = r.Intn(maxRandomPools) + 1
+	testParams.FuzzSpreadFactor = r.Intn(2) == 0
+	testParams.FuzzTickSpacing = r.Intn(2) == 0
+
+	return ranges, testParams
+}
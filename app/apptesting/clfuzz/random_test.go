@@ -0,0 +1,28 @@
+package clfuzz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomizedRangeTest(t *testing.T) {
+	for seed := int64(1); seed <= 100; seed++ {
+		ranges, testParams := RandomizedRangeTest(seed)
+
+		// The same seed always yields the same test.
+		sameRanges, sameTestParams := RandomizedRangeTest(seed)
+		require.Equal(t, ranges, sameRanges)
+		require.Equal(t, testParams, sameTestParams)
+
+		require.Equal(t, seed, testParams.FuzzSeed)
+		require.GreaterOrEqual(t, testParams.NumPools, 1)
+		require.LessOrEqual(t, testParams.NumPools, maxRandomPools)
+		require.NotEmpty(t, ranges)
+		require.LessOrEqual(t, len(ranges), maxRandomRanges)
+		for _, tickRange := range ranges {
+			require.Less(t, tickRange[0], tickRange[1])
+			require.True(t, tickSpacingDividesRanges(randomRangeTickSpacing, [][]int64{tickRange}))
+		}
+	}
+}
@@ -871,6 +871,41 @@ func (s *KeeperTestHelper) PrepareCustomConcentratedPool(owner sdk.AccAddress, d
 	return pool
 }
 
+// PrepareConcentratedPoolWithInitializedTicks sets up an eth usdc concentrated liquidity pool with the given tick spacing,
+// zero spread factor and a full range position at a price of one. Additionally, it creates numTicks adjacent positions,
+// each spanning a single tick spacing, right below the current tick, so that the swaps of eth for usdc cross
+// an initialized tick at every tick spacing.
+// Returns the pool, an eth amount large enough to cross all of the positions and the price limit at which
+// the swap of that amount stops after crossing exactly numTicks initialized ticks.
+// The tick spacing must be greater than one for the price limit to fall in between two initialized ticks.
+// This is useful for benchmarking the swap path against a known number of tick crossings.
+func (s *KeeperTestHelper) PrepareConcentratedPoolWithInitializedTicks(tickSpacing uint64, numTicks int) (types.ConcentratedPoolExtension, sdk.Coin, osmomath.BigDec) {
+	s.Require().Greater(tickSpacing, uint64(1))
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, tickSpacing, osmomath.ZeroDec())
+	s.CreateFullRangePosition(pool, sdk.NewCoins(sdk.NewCoin(ETH, DefaultCoinAmount), sdk.NewCoin(USDC, DefaultCoinAmount)))
+
+	spacing := int64(tickSpacing)
+	positionCoins := sdk.NewCoins(sdk.NewCoin(USDC, DefaultCoinAmount))
+	for i := int64(1); i <= int64(numTicks); i++ {
+		s.FundAcc(s.TestAccs[0], positionCoins)
+		_, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[0], positionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), -(i+1)*spacing, -i*spacing)
+		s.Require().NoError(err)
+	}
+
+	// Stop in between the last crossed tick and the lower tick of the last position.
+	priceLimit, err := clmath.TickToPrice(-int64(numTicks)*spacing - spacing/2)
+	s.Require().NoError(err)
+
+	// Every position holds DefaultCoinAmount of usdc at a price close to one, so twice that amount of eth
+	// per position, plus one for the full range position, overshoots the price limit.
+	tokenIn := sdk.NewCoin(ETH, DefaultCoinAmount.MulRaw(2*(int64(numTicks)+1)))
+
+	pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	return pool, tokenIn, priceLimit
+}
+
 // PrepareMultipleConcentratedPools returns X cl pool's with X being provided by the user.
 func (s *KeeperTestHelper) PrepareMultipleConcentratedPools(poolsToCreate uint16) []uint64 {
 	var poolIds []uint64
@@ -365,6 +365,11 @@ func (s *KeeperTestHelper) SetupMultipleValidators(numValidator int) []string {
 
 // BeginNewBlock starts a new block.
 func (s *KeeperTestHelper) BeginNewBlock(executeNextEpoch bool) {
+	s.BeginNewBlockWithProposer(executeNextEpoch, s.defaultProposer())
+}
+
+// defaultProposer returns the address of the first validator, setting up a bonded validator if none exists.
+func (s *KeeperTestHelper) defaultProposer() sdk.ValAddress {
 	var valAddr []byte
 
 	validators := s.App.StakingKeeper.GetAllValidators(s.Ctx)
@@ -379,11 +384,23 @@ func (s *KeeperTestHelper) BeginNewBlock(executeNextEpoch bool) {
 		valAddr = valAddr2.Bytes()
 	}
 
-	s.BeginNewBlockWithProposer(executeNextEpoch, valAddr)
+	return valAddr
 }
 
 // BeginNewBlockWithProposer begins a new block with a proposer.
 func (s *KeeperTestHelper) BeginNewBlockWithProposer(executeNextEpoch bool, proposer sdk.ValAddress) {
+	epochIdentifier := s.App.SuperfluidKeeper.GetEpochIdentifier(s.Ctx)
+	epoch := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, epochIdentifier)
+	newBlockTime := s.Ctx.BlockTime().Add(5 * time.Second)
+	if executeNextEpoch {
+		newBlockTime = s.Ctx.BlockTime().Add(epoch.Duration).Add(time.Second)
+	}
+
+	s.beginNewBlockAt(newBlockTime, proposer)
+}
+
+// beginNewBlockAt begins a new block with a proposer at the given block time.
+func (s *KeeperTestHelper) beginNewBlockAt(newBlockTime time.Time, proposer sdk.ValAddress) {
 	validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, proposer)
 	s.Assert().True(found)
 
@@ -392,13 +409,6 @@ func (s *KeeperTestHelper) BeginNewBlockWithProposer(executeNextEpoch bool, prop
 
 	valAddr := valConsAddr.Bytes()
 
-	epochIdentifier := s.App.SuperfluidKeeper.GetEpochIdentifier(s.Ctx)
-	epoch := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, epochIdentifier)
-	newBlockTime := s.Ctx.BlockTime().Add(5 * time.Second)
-	if executeNextEpoch {
-		newBlockTime = s.Ctx.BlockTime().Add(epoch.Duration).Add(time.Second)
-	}
-
 	header := tmtypes.Header{Height: s.Ctx.BlockHeight() + 1, Time: newBlockTime}
 	newCtx := s.Ctx.WithBlockTime(newBlockTime).WithBlockHeight(s.Ctx.BlockHeight() + 1)
 	s.Ctx = newCtx
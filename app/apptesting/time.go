@@ -0,0 +1,78 @@
+package apptesting
+
+import (
+	"sort"
+	"time"
+)
+
+// epochBlockOffset is how long after an epoch end the block ending it is produced, as in BeginNewBlock.
+const epochBlockOffset = time.Second
+
+// AdvanceBlockTime advances the block time by the given duration through full blocks, as opposed to only
+// mutating the context's block time. The current block is ended and a new block is begun right after each
+// epoch end crossed along the way, so that every epoch end fires the epoch hooks (e.g. incentive distribution
+// and superfluid rewards) at the time it would on a live chain. A final block is begun at the target time.
+func (s *KeeperTestHelper) AdvanceBlockTime(duration time.Duration) {
+	targetTime := s.Ctx.BlockTime().Add(duration)
+	proposer := s.defaultProposer()
+
+	for _, blockTime := range s.epochBlockTimesUntil(targetTime) {
+		s.EndBlock()
+		s.beginNewBlockAt(blockTime, proposer)
+	}
+
+	s.EndBlock()
+	s.beginNewBlockAt(targetTime, proposer)
+}
+
+// AdvanceEpochs advances the block time through full blocks until the epoch with the given identifier
+// has ended numEpochs times, including the current one. See AdvanceBlockTime.
+func (s *KeeperTestHelper) AdvanceEpochs(identifier string, numEpochs int) {
+	epochInfo := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, identifier)
+	s.Require().NotEqual(time.Duration(0), epochInfo.Duration, "epoch with identifier %s not found", identifier)
+
+	// Epochs that have not started counting start with the first block at or after their start time.
+	epochStartTime := epochInfo.StartTime
+	if epochInfo.EpochCountingStarted {
+		epochStartTime = epochInfo.CurrentEpochStartTime
+	}
+	lastEpochEnd := epochStartTime.Add(time.Duration(numEpochs) * epochInfo.Duration)
+
+	s.AdvanceBlockTime(lastEpochEnd.Add(epochBlockOffset).Sub(s.Ctx.BlockTime()))
+}
+
+// epochBlockTimesUntil returns the sorted block times, strictly before the given time, at which blocks need
+// to be produced for all epochs to end at their own pace.
+func (s *KeeperTestHelper) epochBlockTimesUntil(targetTime time.Time) []time.Time {
+	blockTimes := []time.Time{}
+	seen := map[time.Time]bool{}
+	addBlockTime := func(blockTime time.Time) {
+		if blockTime.After(s.Ctx.BlockTime()) && blockTime.Before(targetTime) && !seen[blockTime] {
+			blockTimes = append(blockTimes, blockTime)
+			seen[blockTime] = true
+		}
+	}
+
+	for _, epochInfo := range s.App.EpochsKeeper.AllEpochInfos(s.Ctx) {
+		// Epochs that have not started counting start with the first block at or after their start time.
+		epochStartTime := epochInfo.CurrentEpochStartTime
+		if !epochInfo.EpochCountingStarted {
+			epochStartTime = epochInfo.StartTime
+			addBlockTime(epochStartTime)
+		}
+
+		epochEnd := epochStartTime.Add(epochInfo.Duration)
+
+		// Skip over the epoch ends that are already past, which are ended by the first block produced.
+		for epochEnd.Before(s.Ctx.BlockTime()) {
+			epochEnd = epochEnd.Add(s.Ctx.BlockTime().Sub(epochEnd) / epochInfo.Duration * epochInfo.Duration).Add(epochInfo.Duration)
+		}
+
+		for ; epochEnd.Before(targetTime); epochEnd = epochEnd.Add(epochInfo.Duration) {
+			addBlockTime(epochEnd.Add(epochBlockOffset))
+		}
+	}
+
+	sort.Slice(blockTimes, func(i, j int) bool { return blockTimes[i].Before(blockTimes[j]) })
+	return blockTimes
+}
@@ -0,0 +1,57 @@
+package apptesting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TimeTestSuite struct {
+	KeeperTestHelper
+}
+
+func TestTimeTestSuite(t *testing.T) {
+	suite.Run(t, new(TimeTestSuite))
+}
+
+func (s *TimeTestSuite) SetupTest() {
+	s.Setup()
+	s.SetEpochStartTime()
+
+	// Start counting all epochs.
+	s.AdvanceBlockTime(time.Second)
+}
+
+func (s *TimeTestSuite) TestAdvanceEpochs() {
+	initialEpochs := map[string]int64{}
+	for _, epochInfo := range s.App.EpochsKeeper.AllEpochInfos(s.Ctx) {
+		s.Require().True(epochInfo.EpochCountingStarted)
+		initialEpochs[epochInfo.Identifier] = epochInfo.CurrentEpoch
+	}
+	initialHeight := s.Ctx.BlockHeight()
+
+	s.AdvanceEpochs("day", 2)
+
+	// Every epoch ended at its own pace, with one block per hour epoch end.
+	s.Require().Equal(initialEpochs["day"]+2, s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "day").CurrentEpoch)
+	s.Require().Equal(initialEpochs["hour"]+48, s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "hour").CurrentEpoch)
+	s.Require().Equal(initialEpochs["week"], s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "week").CurrentEpoch)
+	s.Require().Equal(initialHeight+48, s.Ctx.BlockHeight())
+}
+
+func (s *TimeTestSuite) TestAdvanceBlockTime() {
+	initialTime, initialHeight := s.Ctx.BlockTime(), s.Ctx.BlockHeight()
+	initialHourEpoch := s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "hour").CurrentEpoch
+
+	// No epoch ends before the target time, so a single block is produced.
+	s.AdvanceBlockTime(time.Minute)
+	s.Require().Equal(initialTime.Add(time.Minute), s.Ctx.BlockTime())
+	s.Require().Equal(initialHeight+1, s.Ctx.BlockHeight())
+	s.Require().Equal(initialHourEpoch, s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "hour").CurrentEpoch)
+
+	// Crossing three hour epoch ends produces a block for each of them, in addition to the final block.
+	s.AdvanceBlockTime(3*time.Hour + time.Minute)
+	s.Require().Equal(initialHeight+5, s.Ctx.BlockHeight())
+	s.Require().Equal(initialHourEpoch+3, s.App.EpochsKeeper.GetEpochInfo(s.Ctx, "hour").CurrentEpoch)
+}
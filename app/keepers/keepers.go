@@ -49,7 +49,9 @@ import (
 	ibcratelimittypes "github.com/osmosis-labs/osmosis/v21/x/ibc-rate-limit/types"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo"
 	ibchooks "github.com/osmosis-labs/osmosis/x/ibc-hooks"
 	ibchookskeeper "github.com/osmosis-labs/osmosis/x/ibc-hooks/keeper"
 	ibchookstypes "github.com/osmosis-labs/osmosis/x/ibc-hooks/types"
@@ -67,6 +69,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 
 	"github.com/osmosis-labs/osmosis/v21/ingest"
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming"
 
 	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
 	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
@@ -162,9 +165,15 @@ type AppKeepers struct {
 	ValidatorSetPreferenceKeeper *valsetpref.Keeper
 	ConcentratedLiquidityKeeper  *concentratedliquidity.Keeper
 	CosmwasmPoolKeeper           *cosmwasmpool.Keeper
+	PortfolioKeeper              *portfolio.Keeper
+	StoreInfoKeeper              *storeinfo.Keeper
 
 	IngestManager ingest.IngestManager
 
+	// StreamingSwapRecorder records the swaps of every block for the streaming service.
+	// It only records swaps if the streaming service is enabled.
+	StreamingSwapRecorder *streaming.SwapRecorder
+
 	// IBC modules
 	// transfer module
 	RawIcs20TransferAppModule transfer.AppModule
@@ -241,6 +250,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 
 	appKeepers.DowntimeKeeper = downtimedetector.NewKeeper(
 		appKeepers.keys[downtimetypes.StoreKey],
+		appKeepers.GetSubspace(downtimetypes.ModuleName),
 	)
 
 	slashingKeeper := slashingkeeper.NewKeeper(
@@ -370,6 +380,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.ProtoRevKeeper,
 	)
 	appKeepers.PoolManagerKeeper.SetStakingKeeper(appKeepers.StakingKeeper)
+	appKeepers.PoolManagerKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 	appKeepers.GAMMKeeper.SetPoolManager(appKeepers.PoolManagerKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
 	appKeepers.CosmwasmPoolKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
@@ -379,8 +390,14 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
 		appKeepers.PoolManagerKeeper)
+	appKeepers.PoolManagerKeeper.SetSwapCircuitBreaker(appKeepers.TwapKeeper)
+
+	appKeepers.StreamingSwapRecorder = streaming.NewSwapRecorder(appKeepers.tkeys[streaming.TransientStoreKey])
 
-	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(appKeepers.keys[epochstypes.StoreKey])
+	appKeepers.EpochsKeeper = epochskeeper.NewKeeper(
+		appKeepers.keys[epochstypes.StoreKey],
+		appKeepers.GetSubspace(epochstypes.ModuleName),
+	)
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
@@ -452,6 +469,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.IncentivesKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
 	appKeepers.GAMMKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
+	appKeepers.LockupKeeper.SetLockableDurationsKeepers(appKeepers.IncentivesKeeper, appKeepers.PoolIncentivesKeeper)
 
 	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
 		appKeepers.keys[tokenfactorytypes.StoreKey],
@@ -477,6 +495,20 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		*appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.StakingKeeper, appKeepers.DistrKeeper, appKeepers.EpochsKeeper, appKeepers.LockupKeeper, appKeepers.GAMMKeeper, appKeepers.IncentivesKeeper,
 		lockupkeeper.NewMsgServerImpl(appKeepers.LockupKeeper), appKeepers.ConcentratedLiquidityKeeper, appKeepers.PoolManagerKeeper, appKeepers.ValidatorSetPreferenceKeeper)
 
+	appKeepers.PortfolioKeeper = portfolio.NewKeeper(
+		appKeepers.BankKeeper,
+		appKeepers.LockupKeeper,
+		appKeepers.ConcentratedLiquidityKeeper,
+		appKeepers.StakingKeeper,
+		superfluidkeeper.NewQuerier(*appKeepers.SuperfluidKeeper),
+	)
+
+	storeInfoKeys := make(map[string]storetypes.StoreKey, len(appKeepers.keys))
+	for name, key := range appKeepers.keys {
+		storeInfoKeys[name] = key
+	}
+	appKeepers.StoreInfoKeeper = storeinfo.NewKeeper(storeInfoKeys, storeinfo.DefaultNamedPrefixes())
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_4"
@@ -510,6 +542,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// Pass the contract keeper to all the structs (generally ICS4Wrappers for ibc middlewares) that need it
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
 	appKeepers.RateLimitingICS4Wrapper.ContractKeeper = appKeepers.ContractKeeper
+	appKeepers.RateLimitingICS4Wrapper.WasmKeeper = appKeepers.WasmKeeper
 	appKeepers.Ics20WasmHooks.ContractKeeper = appKeepers.WasmKeeper
 	appKeepers.CosmwasmPoolKeeper.SetContractKeeper(appKeepers.ContractKeeper)
 	appKeepers.IBCHooksKeeper.ContractKeeper = appKeepers.ContractKeeper
@@ -711,6 +744,8 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName).WithKeyTable(packetforwardtypes.ParamKeyTable())
 	paramsKeeper.Subspace(cosmwasmpooltypes.ModuleName)
 	paramsKeeper.Subspace(ibchookstypes.ModuleName)
+	paramsKeeper.Subspace(downtimetypes.ModuleName)
+	paramsKeeper.Subspace(epochstypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -742,6 +777,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.PoolIncentivesKeeper.Hooks(),
 			appKeepers.TwapKeeper.GammHooks(),
 			appKeepers.ProtoRevKeeper.Hooks(),
+			appKeepers.StreamingSwapRecorder,
 		),
 	)
 
@@ -750,6 +786,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.TwapKeeper.ConcentratedLiquidityListener(),
 			appKeepers.PoolIncentivesKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.Hooks(),
+			appKeepers.StreamingSwapRecorder,
 		),
 	)
 
@@ -782,6 +819,7 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
 			appKeepers.ProtoRevKeeper.EpochHooks(),
+			appKeepers.ConcentratedLiquidityKeeper.EpochHooks(),
 		),
 	)
 
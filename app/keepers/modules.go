@@ -50,7 +50,9 @@ import (
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v21/x/pool-incentives/client"
 	poolmanagerclient "github.com/osmosis-labs/osmosis/v21/x/poolmanager/client"
 	poolmanager "github.com/osmosis-labs/osmosis/v21/x/poolmanager/module"
+	portfoliomodule "github.com/osmosis-labs/osmosis/v21/x/portfolio/module"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev"
+	storeinfomodule "github.com/osmosis-labs/osmosis/v21/x/storeinfo/module"
 	superfluid "github.com/osmosis-labs/osmosis/v21/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v21/x/superfluid/client"
 	"github.com/osmosis-labs/osmosis/v21/x/tokenfactory"
@@ -90,8 +92,11 @@ var AppModuleBasics = []module.AppModuleBasic{
 			gammclient.SetScalingFactorControllerProposalHandler,
 			clclient.CreateConcentratedLiquidityPoolProposalHandler,
 			clclient.TickSpacingDecreaseProposalHandler,
+			clclient.SweepRoundingRemaindersProposalHandler,
+			clclient.PositionMigrationWindowProposalHandler,
 			cwpoolclient.UploadCodeIdAndWhitelistProposalHandler,
 			cwpoolclient.MigratePoolContractsProposalHandler,
+			cwpoolclient.WhiteListCodeIdProposalHandler,
 			txfeesclient.SubmitUpdateFeeTokenProposalHandler,
 			poolmanagerclient.DenomPairTakerFeeProposalHandler,
 			incentivesclient.HandleCreateGroupsProposal,
@@ -127,5 +132,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	ibcratelimitmodule.AppModuleBasic{},
 	packetforward.AppModuleBasic{},
 	cosmwasmpoolmodule.AppModuleBasic{},
+	portfoliomodule.AppModuleBasic{},
+	storeinfomodule.AppModuleBasic{},
 	tendermint.AppModuleBasic{},
 }
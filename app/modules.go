@@ -83,8 +83,12 @@ import (
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v21/x/pool-incentives/types"
 	poolmanager "github.com/osmosis-labs/osmosis/v21/x/poolmanager/module"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	portfoliomodule "github.com/osmosis-labs/osmosis/v21/x/portfolio/module"
+	portfoliotypes "github.com/osmosis-labs/osmosis/v21/x/portfolio/types"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev"
 	protorevtypes "github.com/osmosis-labs/osmosis/v21/x/protorev/types"
+	storeinfomodule "github.com/osmosis-labs/osmosis/v21/x/storeinfo/module"
+	storeinfotypes "github.com/osmosis-labs/osmosis/v21/x/storeinfo/types"
 	superfluid "github.com/osmosis-labs/osmosis/v21/x/superfluid"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 	"github.com/osmosis-labs/osmosis/v21/x/tokenfactory"
@@ -189,6 +193,8 @@ func appModules(
 		icq.NewAppModule(*app.AppKeepers.ICQKeeper, app.GetSubspace(icqtypes.ModuleName)),
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		cwpoolmodule.NewAppModule(appCodec, *app.CosmwasmPoolKeeper),
+		portfoliomodule.NewAppModule(*app.PortfolioKeeper),
+		storeinfomodule.NewAppModule(*app.StoreInfoKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
 	}
 }
@@ -278,6 +284,8 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		icqtypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		cosmwasmpooltypes.ModuleName,
+		portfoliotypes.ModuleName,
+		storeinfotypes.ModuleName,
 	}
 }
 
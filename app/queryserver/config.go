@@ -0,0 +1,108 @@
+package queryserver
+
+import (
+	"fmt"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+// DefaultMethods are the read-heavy gRPC methods served by default.
+var DefaultMethods = []string{
+	"/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
+	"/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
+	"/osmosis.poolmanager.v1beta1.Query/AllPools",
+	"/osmosis.incentives.Query/Gauges",
+	"/osmosis.incentives.Query/ActiveGauges",
+}
+
+// Config defines the config for the query server.
+type Config struct {
+	// IsEnabled defines if the query server is enabled.
+	IsEnabled bool `mapstructure:"is-enabled"`
+
+	// Address is the address the gRPC query server listens on.
+	Address string `mapstructure:"address"`
+
+	// SnapshotRetention is the number of most recent heights whose read-only
+	// multistore snapshots are kept for serving queries.
+	SnapshotRetention int `mapstructure:"snapshot-retention"`
+
+	// MaxConcurrentQueries is the maximum number of queries served at once.
+	// Queries exceeding it wait until a slot frees up or the request is cancelled.
+	MaxConcurrentQueries int `mapstructure:"max-concurrent-queries"`
+
+	// QueryGasLimit is the maximum gas a single query may consume before it is aborted.
+	QueryGasLimit int `mapstructure:"query-gas-limit"`
+
+	// Methods are the full names of the gRPC methods served by the query server.
+	Methods []string `mapstructure:"methods"`
+}
+
+const groupOptName = "osmosis-query-server"
+
+// DefaultConfig defines the default config for the query server.
+var DefaultConfig = Config{
+	IsEnabled: false,
+
+	Address: "localhost:9095",
+
+	SnapshotRetention:    2,
+	MaxConcurrentQueries: 8,
+	QueryGasLimit:        50_000_000,
+
+	Methods: DefaultMethods,
+}
+
+// NewConfigFromOptions returns a new query server config from the given options.
+func NewConfigFromOptions(opts servertypes.AppOptions) Config {
+	isEnabled := osmoutils.ParseBool(opts, groupOptName, "is-enabled", false)
+
+	if !isEnabled {
+		return Config{
+			IsEnabled: false,
+		}
+	}
+
+	return Config{
+		IsEnabled:            isEnabled,
+		Address:              osmoutils.ParseString(opts, groupOptName, "address"),
+		SnapshotRetention:    osmoutils.ParseInt(opts, groupOptName, "snapshot-retention"),
+		MaxConcurrentQueries: osmoutils.ParseInt(opts, groupOptName, "max-concurrent-queries"),
+		QueryGasLimit:        osmoutils.ParseInt(opts, groupOptName, "query-gas-limit"),
+		Methods:              osmoutils.ParseStringSlice(opts, groupOptName, "methods"),
+	}
+}
+
+// Validate returns an error if the config is invalid.
+func (c Config) Validate() error {
+	if c.SnapshotRetention <= 0 {
+		return fmt.Errorf("%s.snapshot-retention must be positive, got %d", groupOptName, c.SnapshotRetention)
+	}
+	if c.MaxConcurrentQueries <= 0 {
+		return fmt.Errorf("%s.max-concurrent-queries must be positive, got %d", groupOptName, c.MaxConcurrentQueries)
+	}
+	if c.QueryGasLimit <= 0 {
+		return fmt.Errorf("%s.query-gas-limit must be positive, got %d", groupOptName, c.QueryGasLimit)
+	}
+	if len(c.Methods) == 0 {
+		return fmt.Errorf("%s.methods must not be empty", groupOptName)
+	}
+	return nil
+}
+
+// ValidatePruning returns an error if pruning may delete the versions of the snapshots
+// retained by the query server, which must therefore not exceed the number of recent
+// versions kept by pruning.
+func (c Config) ValidatePruning(pruning pruningtypes.PruningOptions) error {
+	if pruning.Strategy == pruningtypes.PruningNothing {
+		return nil
+	}
+	if uint64(c.SnapshotRetention) > pruning.KeepRecent {
+		return fmt.Errorf("%s.snapshot-retention (%d) must not exceed the number of recent versions kept by pruning (%d)",
+			groupOptName, c.SnapshotRetention, pruning.KeepRecent)
+	}
+	return nil
+}
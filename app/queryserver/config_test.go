@@ -0,0 +1,55 @@
+package queryserver_test
+
+import (
+	"testing"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/store/pruning/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/app/queryserver"
+)
+
+func TestConfigValidatePruning(t *testing.T) {
+	tests := map[string]struct {
+		snapshotRetention int
+		pruning           pruningtypes.PruningOptions
+		expectErr         bool
+	}{
+		"pruning nothing": {
+			snapshotRetention: 100,
+			pruning:           pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		},
+		"pruning everything, retention equal to keep recent": {
+			snapshotRetention: 2,
+			pruning:           pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+		},
+		"pruning everything, retention above keep recent": {
+			snapshotRetention: 3,
+			pruning:           pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
+			expectErr:         true,
+		},
+		"custom pruning, retention below keep recent": {
+			snapshotRetention: 5,
+			pruning:           pruningtypes.NewCustomPruningOptions(10, 10),
+		},
+		"custom pruning, retention above keep recent": {
+			snapshotRetention: 11,
+			pruning:           pruningtypes.NewCustomPruningOptions(10, 10),
+			expectErr:         true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := queryserver.DefaultConfig
+			config.SnapshotRetention = tc.snapshotRetention
+
+			err := config.ValidatePruning(tc.pruning)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
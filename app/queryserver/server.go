@@ -0,0 +1,186 @@
+package queryserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server is a gRPC server that serves selected read-heavy queries from read-only multistore
+// snapshots of recently committed heights. Queries run on the gRPC server's goroutines, bounded
+// by MaxConcurrentQueries and QueryGasLimit, so that large queries do not contend with block
+// execution and commit.
+type Server struct {
+	config    Config
+	logger    log.Logger
+	snapshots *SnapshotStore
+	methods   map[string]struct{}
+
+	grpcServer *grpc.Server
+	// slots bounds the number of queries served at once.
+	slots chan struct{}
+
+	mu sync.Mutex
+	// header is the header of the block being executed, snapshotted once it is committed.
+	header tmproto.Header
+}
+
+var _ gogogrpc.Server = &Server{}
+
+// NewServer returns a new query server serving snapshots of the given commit multistore.
+// Returns an error if the config is invalid or if the snapshots it retains may be pruned.
+// Query services must be registered with RegisterService before calling Start.
+func NewServer(config Config, cms storetypes.CommitMultiStore, logger log.Logger) (*Server, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if err := config.ValidatePruning(cms.GetPruning()); err != nil {
+		return nil, err
+	}
+
+	methods := make(map[string]struct{}, len(config.Methods))
+	for _, method := range config.Methods {
+		methods[method] = struct{}{}
+	}
+
+	s := &Server{
+		config:    config,
+		logger:    logger.With("module", "query-server"),
+		snapshots: NewSnapshotStore(cms, config.SnapshotRetention),
+		methods:   methods,
+		slots:     make(chan struct{}, config.MaxConcurrentQueries),
+	}
+	s.grpcServer = grpc.NewServer(grpc.UnaryInterceptor(s.interceptor))
+	return s, nil
+}
+
+// RegisterService implements gogogrpc.Server so that module query servers can be registered
+// with their generated RegisterQueryServer functions.
+func (s *Server) RegisterService(sd *grpc.ServiceDesc, ss interface{}) {
+	s.grpcServer.RegisterService(sd, ss)
+}
+
+// Start starts serving queries on the configured address in a separate goroutine.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.config.Address, err)
+	}
+
+	go func() {
+		s.logger.Info("starting query server", "address", s.config.Address)
+		if err := s.grpcServer.Serve(listener); err != nil {
+			s.logger.Error("query server stopped", "err", err)
+		}
+	}()
+	return nil
+}
+
+// Stop stops the server, waiting for in-flight queries to finish.
+func (s *Server) Stop() {
+	s.grpcServer.GracefulStop()
+}
+
+// BeginBlock records the header of the block being executed.
+func (s *Server) BeginBlock(header tmproto.Header) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.header = header
+}
+
+// Commit snapshots the multistore at the height of the block that was just committed.
+// Failing to snapshot only affects the query server, so the error is logged and not returned.
+func (s *Server) Commit() {
+	s.mu.Lock()
+	header := s.header
+	s.mu.Unlock()
+
+	if err := s.snapshots.Add(header); err != nil {
+		s.logger.Error("failed to snapshot committed state", "height", header.Height, "err", err)
+	}
+}
+
+// interceptor rejects methods that are not configured, waits for a free slot and attaches an
+// sdk.Context backed by the snapshot at the requested height to the request context.
+// The height is read from the x-cosmos-block-height header and defaults to the latest snapshot.
+func (s *Server) interceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	if _, ok := s.methods[info.FullMethod]; !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method %s is not served by the query server", info.FullMethod)
+	}
+
+	select {
+	case s.slots <- struct{}{}:
+		defer func() { <-s.slots }()
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+
+	height, err := heightFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Every query opens its own view of the snapshot, so writes made by the query handler are discarded
+	// and the view is released once the query completes.
+	header, store, err := s.snapshots.Open(height)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// A panicking query must not take down the node.
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				resp, err = nil, status.Errorf(codes.ResourceExhausted, "query exceeded gas limit of %d: out of gas in location: %s", s.config.QueryGasLimit, outOfGas.Descriptor)
+				return
+			}
+			s.logger.Error("query panicked", "method", info.FullMethod, "height", header.Height, "panic", r)
+			resp, err = nil, status.Errorf(codes.Internal, "query panicked: %v", r)
+		}
+	}()
+
+	sdkCtx := sdk.NewContext(store, header, false, s.logger).
+		WithGasMeter(storetypes.NewGasMeter(storetypes.Gas(s.config.QueryGasLimit)))
+	ctx = context.WithValue(ctx, sdk.SdkContextKey, sdkCtx)
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(header.Height, 10))); err != nil {
+		s.logger.Error("failed to set gRPC header", "err", err)
+	}
+
+	return handler(ctx, req)
+}
+
+// heightFromMetadata returns the height requested in the gRPC metadata, or zero if none is set.
+func heightFromMetadata(ctx context.Context) (int64, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+
+	heightHeaders := md.Get(grpctypes.GRPCBlockHeightHeader)
+	if len(heightHeaders) != 1 {
+		return 0, nil
+	}
+
+	height, err := strconv.ParseInt(heightHeaders[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid height header %q: %w", grpctypes.GRPCBlockHeightHeader, err)
+	}
+	if height < 0 {
+		return 0, fmt.Errorf("height must not be negative, got %d", height)
+	}
+	return height, nil
+}
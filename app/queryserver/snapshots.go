@@ -0,0 +1,96 @@
+package queryserver
+
+import (
+	"fmt"
+	"sync"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// SnapshotStore retains the headers of the most recently committed heights, from which queries
+// open read-only views of the immutable versions of the commit multistore. Every query opens its
+// own view, so queries never share state with each other or with the block being executed or
+// committed, and the memory of a view is released once its query completes.
+type SnapshotStore struct {
+	mu        sync.RWMutex
+	cms       storetypes.CommitMultiStore
+	retention int
+	// headers are ordered by ascending height.
+	headers []tmproto.Header
+}
+
+// NewSnapshotStore returns a snapshot store that keeps the snapshots of the last retention heights.
+func NewSnapshotStore(cms storetypes.CommitMultiStore, retention int) *SnapshotStore {
+	return &SnapshotStore{
+		cms:       cms,
+		retention: retention,
+	}
+}
+
+// Add snapshots the multistore at the height of the given header, which must be committed.
+// The oldest snapshot is dropped once more than retention snapshots are kept.
+func (s *SnapshotStore) Add(header tmproto.Header) error {
+	if lastHeight := s.cms.LastCommitID().Version; header.Height > lastHeight {
+		return fmt.Errorf("failed to snapshot multistore at height %d, last committed height is %d", header.Height, lastHeight)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.headers = append(s.headers, header)
+	if len(s.headers) > s.retention {
+		s.headers = s.headers[len(s.headers)-s.retention:]
+	}
+	return nil
+}
+
+// Open returns the header of the snapshot at the given height, or of the latest snapshot if height is zero,
+// along with a new read-only view of the multistore at that height. Writes to the view are discarded.
+func (s *SnapshotStore) Open(height int64) (tmproto.Header, storetypes.CacheMultiStore, error) {
+	header, err := s.getHeader(height)
+	if err != nil {
+		return tmproto.Header{}, nil, err
+	}
+
+	store, err := s.cms.CacheMultiStoreWithVersion(header.Height)
+	if err != nil {
+		return tmproto.Header{}, nil, fmt.Errorf("failed to open multistore at height %d: %w", header.Height, err)
+	}
+	return header, store, nil
+}
+
+// getHeader returns the header of the snapshot at the given height, or of the latest snapshot if height is zero.
+func (s *SnapshotStore) getHeader(height int64) (tmproto.Header, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.headers) == 0 {
+		return tmproto.Header{}, fmt.Errorf("no snapshot available yet")
+	}
+
+	if height == 0 {
+		return s.headers[len(s.headers)-1], nil
+	}
+
+	for _, header := range s.headers {
+		if header.Height == height {
+			return header, nil
+		}
+	}
+
+	return tmproto.Header{}, fmt.Errorf("no snapshot at height %d, available heights are %d to %d",
+		height, s.headers[0].Height, s.headers[len(s.headers)-1].Height)
+}
+
+// Heights returns the heights of the retained snapshots in ascending order.
+func (s *SnapshotStore) Heights() []int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	heights := make([]int64, len(s.headers))
+	for i, header := range s.headers {
+		heights[i] = header.Height
+	}
+	return heights
+}
@@ -0,0 +1,71 @@
+package queryserver_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/app/queryserver"
+)
+
+var testKey = []byte("key")
+
+func TestSnapshotStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("test")
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	const retention = 2
+	snapshots := queryserver.NewSnapshotStore(cms, retention)
+
+	_, _, err := snapshots.Open(0)
+	require.Error(t, err, "no snapshot is available before the first commit")
+
+	// Commit three heights, writing the height as the value of the test key.
+	for height := int64(1); height <= 3; height++ {
+		cms.GetKVStore(storeKey).Set(testKey, []byte{byte(height)})
+		commitID := cms.Commit()
+		require.Equal(t, height, commitID.Version)
+
+		require.NoError(t, snapshots.Add(tmproto.Header{Height: height}))
+	}
+
+	// Only the last retention heights are kept.
+	require.Equal(t, []int64{2, 3}, snapshots.Heights())
+
+	_, _, err = snapshots.Open(1)
+	require.Error(t, err)
+
+	for _, height := range []int64{2, 3} {
+		header, snap, err := snapshots.Open(height)
+		require.NoError(t, err)
+		require.Equal(t, height, header.Height)
+		require.Equal(t, []byte{byte(height)}, snap.GetKVStore(storeKey).Get(testKey))
+	}
+
+	// Height zero returns the latest snapshot.
+	header, latest, err := snapshots.Open(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), header.Height)
+	require.Equal(t, []byte{3}, latest.GetKVStore(storeKey).Get(testKey))
+
+	// Writes to the commit multistore after the snapshot are not visible in it.
+	cms.GetKVStore(storeKey).Set(testKey, []byte{4})
+	require.Equal(t, []byte{3}, latest.GetKVStore(storeKey).Get(testKey))
+
+	// Every open returns a separate view, so writes made to one view are not visible in the others.
+	latest.GetKVStore(storeKey).Set(testKey, []byte{5})
+	_, other, err := snapshots.Open(0)
+	require.NoError(t, err)
+	require.Equal(t, []byte{3}, other.GetKVStore(storeKey).Get(testKey))
+
+	// Snapshotting a height that is not committed fails.
+	require.Error(t, snapshots.Add(tmproto.Header{Height: 5}))
+}
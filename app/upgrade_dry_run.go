@@ -0,0 +1,176 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v21/app/upgrades"
+)
+
+// UpgradeDryRunReport is the outcome of running an upgrade handler against a state without committing it.
+type UpgradeDryRunReport struct {
+	UpgradeName string
+	Height      int64
+	// Duration is the time spent in the upgrade handler, including the store migrations it ran.
+	Duration time.Duration
+	// Migrations are the store migrations run by the upgrade handler, in execution order.
+	Migrations []ModuleMigrationTiming
+	// Diffs are the modules whose exported state changed with the upgrade, in alphabetical order.
+	// Empty if the state was not diffed.
+	Diffs []ModuleStateDiff
+}
+
+// ModuleMigrationTiming is the time spent in the store migration of a module from a consensus version.
+type ModuleMigrationTiming struct {
+	ModuleName  string
+	FromVersion uint64
+	Duration    time.Duration
+}
+
+// ModuleStateDiff lists the top level fields of the exported genesis of a module that changed with an upgrade.
+type ModuleStateDiff struct {
+	ModuleName    string
+	ChangedFields []string
+}
+
+// DryRunUpgrade runs the handler of the given upgrade, including the store migrations it runs, against the state
+// of ctx and reports the time spent in every store migration.
+// The upgrade is run on a branch of ctx, so that nothing is written to the state of ctx.
+// If diffState is true, the exported genesis of every module is compared before and after the upgrade,
+// which can be slow for large states.
+// Returns error if the upgrade does not exist or its handler fails.
+func (app *OsmosisApp) DryRunUpgrade(ctx sdk.Context, upgradeName string, diffState bool) (UpgradeDryRunReport, error) {
+	var upgrade *upgrades.Upgrade
+	for i := range Upgrades {
+		if Upgrades[i].UpgradeName == upgradeName {
+			upgrade = &Upgrades[i]
+		}
+	}
+	if upgrade == nil {
+		return UpgradeDryRunReport{}, fmt.Errorf("upgrade %s not found", upgradeName)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	// The store migrations are registered to a separate configurator, timing them as they are run.
+	// Its services are discarded, since they are already registered to the routers of the app.
+	configurator := &timedConfigurator{
+		Configurator: module.NewConfigurator(app.appCodec, discardServiceRegistrar{}, discardServiceRegistrar{}),
+	}
+	app.mm.RegisterServices(configurator)
+
+	var stateBefore map[string]json.RawMessage
+	if diffState {
+		stateBefore = app.mm.ExportGenesis(cacheCtx, app.appCodec)
+	}
+
+	handler := upgrade.CreateUpgradeHandler(app.mm, configurator.Configurator, app.BaseApp, &app.AppKeepers)
+	plan := upgradetypes.Plan{Name: upgradeName, Height: ctx.BlockHeight()}
+	start := time.Now()
+	if _, err := handler(cacheCtx, plan, app.UpgradeKeeper.GetModuleVersionMap(cacheCtx)); err != nil {
+		return UpgradeDryRunReport{}, fmt.Errorf("upgrade %s failed: %w", upgradeName, err)
+	}
+
+	report := UpgradeDryRunReport{
+		UpgradeName: upgradeName,
+		Height:      ctx.BlockHeight(),
+		Duration:    time.Since(start),
+		Migrations:  configurator.migrations,
+	}
+
+	if diffState {
+		diffs, err := diffModuleStates(stateBefore, app.mm.ExportGenesis(cacheCtx, app.appCodec))
+		if err != nil {
+			return UpgradeDryRunReport{}, err
+		}
+		report.Diffs = diffs
+	}
+
+	return report, nil
+}
+
+// timedConfigurator records the time spent in every store migration registered to it.
+type timedConfigurator struct {
+	module.Configurator
+
+	migrations []ModuleMigrationTiming
+}
+
+// RegisterMigration registers the given migration handler to the underlying configurator,
+// timing it when it is run.
+func (c *timedConfigurator) RegisterMigration(moduleName string, fromVersion uint64, handler module.MigrationHandler) error {
+	return c.Configurator.RegisterMigration(moduleName, fromVersion, func(ctx sdk.Context) error {
+		start := time.Now()
+		err := handler(ctx)
+		c.migrations = append(c.migrations, ModuleMigrationTiming{ModuleName: moduleName, FromVersion: fromVersion, Duration: time.Since(start)})
+		return err
+	})
+}
+
+// discardServiceRegistrar discards the services registered to it.
+type discardServiceRegistrar struct{}
+
+func (discardServiceRegistrar) RegisterService(*grpc.ServiceDesc, interface{}) {}
+
+// diffModuleStates returns the modules whose exported genesis differs between before and after,
+// along with the top level fields of their genesis that differ, in alphabetical order.
+func diffModuleStates(before, after map[string]json.RawMessage) ([]ModuleStateDiff, error) {
+	diffs := []ModuleStateDiff{}
+	for _, moduleName := range sortedUnionKeys(before, after) {
+		if bytes.Equal(before[moduleName], after[moduleName]) {
+			continue
+		}
+
+		fieldsBefore, err := unmarshalGenesisFields(before[moduleName])
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis before upgrade: %w", moduleName, err)
+		}
+		fieldsAfter, err := unmarshalGenesisFields(after[moduleName])
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s genesis after upgrade: %w", moduleName, err)
+		}
+
+		changedFields := []string{}
+		for _, field := range sortedUnionKeys(fieldsBefore, fieldsAfter) {
+			if !bytes.Equal(fieldsBefore[field], fieldsAfter[field]) {
+				changedFields = append(changedFields, field)
+			}
+		}
+		diffs = append(diffs, ModuleStateDiff{ModuleName: moduleName, ChangedFields: changedFields})
+	}
+	return diffs, nil
+}
+
+// unmarshalGenesisFields returns the top level fields of the given genesis. A missing genesis has no fields.
+func unmarshalGenesisFields(genesis json.RawMessage) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(genesis) == 0 {
+		return fields, nil
+	}
+	if err := json.Unmarshal(genesis, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func sortedUnionKeys(a, b map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
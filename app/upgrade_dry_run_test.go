@@ -0,0 +1,32 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffModuleStates(t *testing.T) {
+	before := map[string]json.RawMessage{
+		"bank":    json.RawMessage(`{"params":{"default_send_enabled":true},"balances":[]}`),
+		"gamm":    json.RawMessage(`{"pools":[],"next_pool_number":"1","params":{}}`),
+		"removed": json.RawMessage(`{"params":{}}`),
+	}
+	after := map[string]json.RawMessage{
+		"bank":  json.RawMessage(`{"params":{"default_send_enabled":true},"balances":[]}`),
+		"gamm":  json.RawMessage(`{"pools":[],"next_pool_number":"2","params":{"pool_creation_fee":[]}}`),
+		"added": json.RawMessage(`{"params":{}}`),
+	}
+
+	diffs, err := diffModuleStates(before, after)
+	require.NoError(t, err)
+	require.Equal(t, []ModuleStateDiff{
+		{ModuleName: "added", ChangedFields: []string{"params"}},
+		{ModuleName: "gamm", ChangedFields: []string{"next_pool_number", "params"}},
+		{ModuleName: "removed", ChangedFields: []string{"params"}},
+	}, diffs)
+
+	_, err = diffModuleStates(before, map[string]json.RawMessage{"bank": json.RawMessage(`[]`)})
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	osmominttypes "github.com/osmosis-labs/osmosis/v21/x/mint/types"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v21/x/pool-incentives/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	protorevtypes "github.com/osmosis-labs/osmosis/v21/x/protorev/types"
@@ -158,6 +159,43 @@ func CreateUpgradeHandler(
 
 		// Set CL param:
 		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyHookGasLimit, concentratedliquiditytypes.DefaultContractHookGasLimit)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxIncentiveRecordsPerPool, concentratedliquiditytypes.DefaultMaxIncentiveRecordsPerPool)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxIncentiveRecordsPerUptime, concentratedliquiditytypes.DefaultMaxIncentiveRecordsPerUptime)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxPositionsPerWithdrawAll, concentratedliquiditytypes.DefaultMaxPositionsPerWithdrawAll)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyAuthorizedLienholders, concentratedliquiditytypes.DefaultAuthorizedLienholders)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyAuthorizedPositionRebalancers, concentratedliquiditytypes.DefaultAuthorizedPositionRebalancers)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyManagedPositionRebalanceFee, concentratedliquiditytypes.DefaultManagedPositionRebalanceFee)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyManagedPositionRebalanceEpoch, concentratedliquiditytypes.DefaultManagedPositionRebalanceEpochIdentifier)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxManagedPositionRebalances, concentratedliquiditytypes.DefaultMaxManagedPositionRebalancesPerEpoch)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyPositionHistoryRetentionBlocks, concentratedliquiditytypes.DefaultPositionHistoryRetentionBlocks)
+		keepers.ConcentratedLiquidityKeeper.SetParam(ctx, concentratedliquiditytypes.KeyMaxLimitOrderFillsPerBlock, concentratedliquiditytypes.DefaultMaxLimitOrderFillsPerBlock)
+
+		// Prune CL ticks that were left in state with zero gross liquidity.
+		if _, err := keepers.ConcentratedLiquidityKeeper.PruneEmptyTicksForAllPools(ctx); err != nil {
+			return nil, err
+		}
+
+		// Scale the CL uptime accumulators, and the uptime growth tracked by ticks, so that small
+		// incentive emissions on pools with large liquidity no longer truncate to zero.
+		if _, err := keepers.ConcentratedLiquidityKeeper.MigrateUptimeAccumulatorsToScalingFactor(ctx); err != nil {
+			return nil, err
+		}
+
+		// Set poolmanager param:
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyStakedOsmoTakerFeeDiscountTiers, []poolmanagertypes.TakerFeeDiscountTier{})
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyDenomAliases, []poolmanagertypes.DenomAlias{})
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyMaxHops, poolmanagertypes.DefaultMaxHops)
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyMaxRoutesPerTx, poolmanagertypes.DefaultMaxRoutesPerTx)
+		keepers.PoolManagerKeeper.SetParam(ctx, poolmanagertypes.KeyPausedDenomPairs, []poolmanagertypes.PausedDenomPair{})
+
+		// Set mint param:
+		keepers.MintKeeper.SetParam(ctx, osmominttypes.KeyCommunityPoolFundingStreams, []osmominttypes.FundingStream{})
+
+		// Set incentives param:
+		keepers.IncentivesKeeper.SetParam(ctx, incentivestypes.KeyRefundUnfilledPerpetualGauges, false)
+
+		// Start tracking the running totals of superfluid stake per asset and per validator.
+		keepers.SuperfluidKeeper.InitializeSuperfluidStakeTotals(ctx)
 
 		// Add protorev to the taker fee exclusion list:
 		protorevModuleAccount := keepers.AccountKeeper.GetModuleAccount(ctx, protorevtypes.ModuleName)
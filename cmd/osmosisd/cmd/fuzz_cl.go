@@ -0,0 +1,212 @@
+//go:build fuzzcl
+
+package cmd
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting/clfuzz"
+)
+
+const (
+	flagFuzzDuration         = "duration"
+	flagFuzzSeed             = "seed"
+	flagFuzzOutputDir        = "output-dir"
+	flagFuzzSnapshotInterval = "snapshot-interval"
+	flagFuzzStopOnFailure    = "stop-on-failure"
+
+	fuzzSnapshotFile = "snapshot.json"
+	fuzzStateFile    = "state.json"
+)
+
+// fuzzSnapshot is the progress of a fuzz-cl run, periodically written to the output directory.
+type fuzzSnapshot struct {
+	StartTime  time.Time `json:"start_time"`
+	Elapsed    string    `json:"elapsed"`
+	Iterations int64     `json:"iterations"`
+	StartSeed  int64     `json:"start_seed"`
+	NextSeed   int64     `json:"next_seed"`
+	Failures   []string  `json:"failures"`
+}
+
+func fuzzCommands() []*cobra.Command {
+	return []*cobra.Command{FuzzCLCmd()}
+}
+
+// FuzzCLCmd returns a command that runs randomized concentrated liquidity range tests with the global
+// invariants asserted at each step, for a configurable wall-clock duration.
+func FuzzCLCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fuzz-cl",
+		Short: "Run randomized concentrated liquidity state machine tests against the global invariants",
+		Long: `Run randomized concentrated liquidity state machine tests against the global invariants.
+Each iteration runs a range test derived from its seed on a fresh in-memory app, with seeds incremented from --seed.
+Progress is written to snapshot.json and the concentrated liquidity state of the latest successful iteration
+to state.json in the output directory every --snapshot-interval. Each failure is minimized and dumped as
+failure-<seed>.json, which contains the ranges and params reproducing it (e.g. through clfuzz.Harness).
+The command exits with an error if any failure was found. It is meant for nightly fuzz farms, not for validators.
+Note that the CL_FUZZ_SEED environment variable must not be set, as it overrides the seed of every iteration.
+Only available in binaries built with the fuzzcl build tag, e.g. make build BUILD_TAGS=fuzzcl.
+Example:
+	osmosisd fuzz-cl --duration 8h --seed 1 --output-dir ./fuzz-cl
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			duration, err := cmd.Flags().GetDuration(flagFuzzDuration)
+			if err != nil {
+				return err
+			}
+
+			seed, err := cmd.Flags().GetInt64(flagFuzzSeed)
+			if err != nil {
+				return err
+			}
+			if seed == 0 {
+				seed = time.Now().UnixNano()
+			}
+
+			outputDir, err := cmd.Flags().GetString(flagFuzzOutputDir)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(outputDir, 0o755); err != nil {
+				return err
+			}
+
+			snapshotInterval, err := cmd.Flags().GetDuration(flagFuzzSnapshotInterval)
+			if err != nil {
+				return err
+			}
+
+			stopOnFailure, err := cmd.Flags().GetBool(flagFuzzStopOnFailure)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := os.LookupEnv(clfuzz.FuzzSeedEnvVar); ok {
+				return fmt.Errorf("%s must not be set when running fuzz-cl", clfuzz.FuzzSeedEnvVar)
+			}
+
+			snapshot := fuzzSnapshot{StartTime: time.Now(), StartSeed: seed, NextSeed: seed, Failures: []string{}}
+			runErr := runFuzzCL(cmd, &snapshot, duration, snapshotInterval, outputDir, stopOnFailure)
+
+			if err := writeFuzzSnapshot(outputDir, snapshot); err != nil {
+				return err
+			}
+			if runErr != nil {
+				return runErr
+			}
+			if len(snapshot.Failures) > 0 {
+				return fmt.Errorf("found %d failures after %d iterations, see %s", len(snapshot.Failures), snapshot.Iterations, outputDir)
+			}
+
+			cmd.Printf("no failures found after %d iterations\n", snapshot.Iterations)
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration(flagFuzzDuration, time.Hour, "wall-clock duration to run the fuzzer for")
+	cmd.Flags().Int64(flagFuzzSeed, 0, "seed of the first iteration, derived from the current time if zero")
+	cmd.Flags().String(flagFuzzOutputDir, "fuzz-cl", "directory to write snapshots and failure repro dumps to")
+	cmd.Flags().Duration(flagFuzzSnapshotInterval, 5*time.Minute, "interval between progress and state snapshots")
+	cmd.Flags().Bool(flagFuzzStopOnFailure, false, "stop at the first failure instead of running for the whole duration")
+
+	return cmd
+}
+
+// runFuzzCL runs randomized range tests until the duration elapses, updating the given snapshot as it goes.
+// The range test harness relies on a *testing.T, so the iterations are run as a single test through testing.MainStart.
+func runFuzzCL(cmd *cobra.Command, snapshot *fuzzSnapshot, duration, snapshotInterval time.Duration, outputDir string, stopOnFailure bool) (err error) {
+	fuzzTest := func(t *testing.T) {
+		s := &apptesting.ConcentratedKeeperTestHelper{}
+		s.SetT(t)
+
+		deadline, nextSnapshot := snapshot.StartTime.Add(duration), time.Now().Add(snapshotInterval)
+		for time.Now().Before(deadline) {
+			seed := snapshot.NextSeed
+			ranges, testParams := clfuzz.RandomizedRangeTest(seed)
+
+			s.SetupTest()
+			h := clfuzz.NewHarness(s)
+			failure, findErr := h.FindPoolsFailure(ranges, testParams)
+			if findErr != nil {
+				err = findErr
+				return
+			}
+
+			snapshot.Iterations++
+			snapshot.NextSeed++
+
+			if failure != nil {
+				path, dumpErr := writeFuzzFailure(outputDir, failure)
+				if dumpErr != nil {
+					err = dumpErr
+					return
+				}
+
+				snapshot.Failures = append(snapshot.Failures, path)
+				cmd.Printf("failure with seed %d, repro written to %s\n", failure.Seed, path)
+				if stopOnFailure {
+					return
+				}
+			}
+
+			if time.Now().After(nextSnapshot) {
+				if err = writeFuzzSnapshot(outputDir, *snapshot); err != nil {
+					return
+				}
+				if failure == nil {
+					stateBz := s.App.AppCodec().MustMarshalJSON(s.Clk.ExportGenesis(s.Ctx))
+					if err = os.WriteFile(filepath.Join(outputDir, fuzzStateFile), stateBz, 0o644); err != nil {
+						return
+					}
+				}
+
+				cmd.Printf("%d iterations, %d failures\n", snapshot.Iterations, len(snapshot.Failures))
+				nextSnapshot = time.Now().Add(snapshotInterval)
+			}
+		}
+	}
+
+	m := testing.MainStart(fuzzTestDeps{}, []testing.InternalTest{{Name: "FuzzCL", F: fuzzTest}}, nil, nil, nil)
+
+	// The test flags registered by MainStart are left to their defaults, as the command line belongs to cobra.
+	if err := flag.CommandLine.Parse(nil); err != nil {
+		return err
+	}
+
+	if code := m.Run(); code != 0 && err == nil {
+		err = fmt.Errorf("fuzz-cl test harness failed after %d iterations", snapshot.Iterations)
+	}
+
+	return err
+}
+
+func writeFuzzSnapshot(outputDir string, snapshot fuzzSnapshot) error {
+	snapshot.Elapsed = time.Since(snapshot.StartTime).String()
+	bz, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outputDir, fuzzSnapshotFile), bz, 0o644)
+}
+
+func writeFuzzFailure(outputDir string, failure *clfuzz.Failure) (string, error) {
+	bz, err := json.MarshalIndent(failure, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(outputDir, fmt.Sprintf("failure-%d.json", failure.Seed))
+	return path, os.WriteFile(path, bz, 0o644)
+}
@@ -0,0 +1,10 @@
+//go:build !fuzzcl
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+// fuzzCommands returns no commands, as fuzz-cl is only available in binaries built with the fuzzcl build tag.
+func fuzzCommands() []*cobra.Command {
+	return nil
+}
@@ -0,0 +1,58 @@
+//go:build fuzzcl
+
+package cmd
+
+// DONTCOVER
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"time"
+)
+
+// fuzzCorpusEntry mirrors the corpus entry type of the testing package's test dependencies.
+type fuzzCorpusEntry = struct {
+	Parent     string
+	Path       string
+	Data       []byte
+	Values     []any
+	Generation int
+	IsSeed     bool
+}
+
+var errFuzzTestDepsUnsupported = errors.New("not supported by fuzz-cl")
+
+// fuzzTestDeps are the dependencies of testing.MainStart, which are normally generated by go test.
+// fuzz-cl only runs a single test, so profiling, coverage and native fuzzing are left unsupported.
+// Note that MainStart is not covered by the Go 1 compatibility promise, so these may need to be
+// updated alongside the Go version.
+type fuzzTestDeps struct{}
+
+func (fuzzTestDeps) ImportPath() string                          { return "" }
+func (fuzzTestDeps) ModulePath() string                          { return "" }
+func (fuzzTestDeps) MatchString(_, _ string) (bool, error)       { return true, nil }
+func (fuzzTestDeps) SetPanicOnExit0(bool)                        {}
+func (fuzzTestDeps) StartCPUProfile(io.Writer) error             { return errFuzzTestDepsUnsupported }
+func (fuzzTestDeps) StopCPUProfile()                             {}
+func (fuzzTestDeps) StartTestLog(io.Writer)                      {}
+func (fuzzTestDeps) StopTestLog() error                          { return nil }
+func (fuzzTestDeps) WriteProfileTo(string, io.Writer, int) error { return errFuzzTestDepsUnsupported }
+func (fuzzTestDeps) CheckCorpus([]any, []reflect.Type) error     { return nil }
+func (fuzzTestDeps) ResetCoverage()                              {}
+func (fuzzTestDeps) SnapshotCoverage()                           {}
+func (fuzzTestDeps) RunFuzzWorker(func(fuzzCorpusEntry) error) error {
+	return errFuzzTestDepsUnsupported
+}
+
+func (fuzzTestDeps) ReadCorpus(string, []reflect.Type) ([]fuzzCorpusEntry, error) {
+	return nil, nil
+}
+
+func (fuzzTestDeps) CoordinateFuzzing(time.Duration, int64, time.Duration, int64, int, []fuzzCorpusEntry, []reflect.Type, string, string) error {
+	return errFuzzTestDepsUnsupported
+}
+
+func (fuzzTestDeps) InitRuntimeCoverage() (string, func(string, string) (string, error), func() float64) {
+	return "", nil, nil
+}
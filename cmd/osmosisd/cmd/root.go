@@ -21,6 +21,9 @@ import (
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/params"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs"
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming"
+
+	"github.com/osmosis-labs/osmosis/v21/app/queryserver"
 
 	tmcfg "github.com/cometbft/cometbft/config"
 	tmcli "github.com/cometbft/cometbft/libs/cli"
@@ -427,6 +430,10 @@ func initAppConfig() (string, interface{}) {
 		OsmosisMempoolConfig OsmosisMempoolConfig `mapstructure:"osmosis-mempool"`
 
 		SidecarQueryServerConfig sqs.Config `mapstructure:"osmosis-sqs"`
+
+		StreamingConfig streaming.Config `mapstructure:"osmosis-streaming"`
+
+		QueryServerConfig queryserver.Config `mapstructure:"osmosis-query-server"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -444,7 +451,11 @@ func initAppConfig() (string, interface{}) {
 
 	sqsConfig := sqs.DefaultConfig
 
-	OsmosisAppCfg := CustomAppConfig{Config: *srvCfg, OsmosisMempoolConfig: memCfg, SidecarQueryServerConfig: sqsConfig}
+	streamingConfig := streaming.DefaultConfig
+
+	queryServerConfig := queryserver.DefaultConfig
+
+	OsmosisAppCfg := CustomAppConfig{Config: *srvCfg, OsmosisMempoolConfig: memCfg, SidecarQueryServerConfig: sqsConfig, StreamingConfig: streamingConfig, QueryServerConfig: queryServerConfig}
 
 	OsmosisAppTemplate := serverconfig.DefaultConfigTemplate + `
 ###############################################################################
@@ -467,6 +478,29 @@ min-gas-price-for-high-gas-tx = ".0025"
 # This parameter enables EIP-1559 like fee market logic in the mempool
 adaptive-fee-enabled = "true"
 
+# This parameter enables the priority lane, which raises the mempool priority of txs that only
+# contain the messages listed in priority-lane-msg-types, so that they are not starved by spam
+# during congestion. Txs in the lane are ranked by their gas price in the base denom.
+# Prioritization requires the priority mempool (version = "v1" in config.toml).
+priority-lane-enabled = "false"
+
+# This is the number of txs of a signer prioritized by the priority lane per block height, so that
+# a single account cannot flood the lane with cheap txs. Zero removes the limit.
+priority-lane-max-txs-per-signer-per-block = "1"
+
+# These are the messages prioritized by the priority lane.
+priority-lane-msg-types = [
+  "/cosmos.gov.v1.MsgVote",
+  "/cosmos.gov.v1.MsgVoteWeighted",
+  "/cosmos.gov.v1beta1.MsgVote",
+  "/cosmos.gov.v1beta1.MsgVoteWeighted",
+  "/cosmos.slashing.v1beta1.MsgUnjail",
+  "/cosmos.staking.v1beta1.MsgUndelegate",
+  "/osmosis.superfluid.MsgSuperfluidUndelegate",
+  "/osmosis.superfluid.MsgSuperfluidUnbondLock",
+  "/osmosis.superfluid.MsgSuperfluidUndelegateAndUnbondLock",
+]
+
 ###############################################################################
 ###              Osmosis Sidecar Query Server Configuration                 ###
 ###############################################################################
@@ -480,6 +514,14 @@ is-enabled = "false"
 db-host = "{{ .SidecarQueryServerConfig.StorageHost }}"
 db-port = "{{ .SidecarQueryServerConfig.StoragePort }}"
 
+# The chain ID of the node. If set, the endpoints of the node's network are also served
+# under the /{chain-id} path prefix, e.g. /osmosis-1/quote.
+chain-id = "{{ .SidecarQueryServerConfig.ChainID }}"
+
+# The index of the redis database that the node's network data is ingested into.
+# Each network served from the same redis instance must use a different database.
+db-index = "{{ .SidecarQueryServerConfig.RedisDB }}"
+
 # Defines the web server configuration.
 server-address = "{{ .SidecarQueryServerConfig.ServerAddress }}"
 timeout-duration-secs = "{{ .SidecarQueryServerConfig.ServerTimeoutDurationSecs }}"
@@ -492,6 +534,9 @@ logger-level = "{{ .SidecarQueryServerConfig.LoggerLevel }}"
 # Defines the gRPC gateway endpoint of the chain.
 grpc-gateway-endpoint = "{{ .SidecarQueryServerConfig.ChainGRPCGatewayEndpoint }}"
 
+# Defines the gRPC address of the node that account data queries are passed through to.
+node-grpc-address = "{{ .SidecarQueryServerConfig.NodeGRPCAddress }}"
+
 # The list of preferred poold IDs in the router.
 # These pools will be prioritized in the candidate route selection, ignoring all other
 # heuristics such as TVL.
@@ -519,6 +564,126 @@ route-update-height-interval = "{{ .SidecarQueryServerConfig.Router.RouteUpdateH
 
 # Whether to enable candidate route caching in Redis.
 route-cache-enabled = "{{ .SidecarQueryServerConfig.Router.RouteCacheEnabled }}"
+
+# The code IDs of the CosmWasm orderbook contracts whose resting limit orders
+# are ingested and routed through alongside the AMM pools.
+orderbook-code-ids = "{{ .SidecarQueryServerConfig.Router.OrderbookCodeIDs }}"
+
+# The code IDs of the CosmWasm alloyed transmuter contracts whose per-asset
+# composition and limiters are ingested alongside the pool.
+alloy-transmuter-code-ids = "{{ .SidecarQueryServerConfig.Router.AlloyTransmuterCodeIDs }}"
+
+# The IDs of the pools that are excluded from route search, e.g. exploited or deprecated pools.
+excluded-pool-ids = "{{ .SidecarQueryServerConfig.Router.RouteRestrictions.ExcludedPoolIDs }}"
+
+# The denoms whose pools are excluded from route search.
+excluded-denoms = "{{ .SidecarQueryServerConfig.Router.RouteRestrictions.ExcludedDenoms }}"
+
+# The pools that are exclusively routed through when swapping between their denoms,
+# as a comma-separated list of entries in the denom0|denom1|poolID format.
+canonical-pools = "{{ .SidecarQueryServerConfig.Router.RouteRestrictions.CanonicalPools }}"
+
+# Whether quotes keep being served from the last ingested state, flagged as stale,
+# when ingestion lags behind the chain by more than max-ingest-lag-secs.
+# If disabled, quotes error instead until ingestion catches up.
+stale-quotes-enabled = "{{ .SidecarQueryServerConfig.Router.StaleQuotes.Enabled }}"
+
+# The max number of seconds that the block time of the latest ingested height may lag
+# behind the current time before quotes are considered stale. Zero disables the check.
+max-ingest-lag-secs = "{{ .SidecarQueryServerConfig.Router.StaleQuotes.MaxIngestLagSecs }}"
+
+# The slippage tolerance in basis points recommended in quotes.
+default-slippage-tolerance-bps = "{{ .SidecarQueryServerConfig.Router.StaleQuotes.DefaultSlippageToleranceBps }}"
+
+# The widened slippage tolerance in basis points recommended in stale quotes.
+stale-slippage-tolerance-bps = "{{ .SidecarQueryServerConfig.Router.StaleQuotes.StaleSlippageToleranceBps }}"
+
+# Whether to cache the optimal routes of quotes and their split proportions in memory,
+# per denom pair and logarithmic bucket of the token in amount.
+optimal-route-cache-enabled = "{{ .SidecarQueryServerConfig.Router.OptimalRouteCache.Enabled }}"
+
+# The max change in basis points of the liquidity of any pool of cached optimal routes
+# before the routes are invalidated and recomputed.
+optimal-route-cache-max-liquidity-change-bps = "{{ .SidecarQueryServerConfig.Router.OptimalRouteCache.MaxLiquidityChangeBps }}"
+
+# The denom that token prices are quoted in by default.
+default-quote-denom = "{{ .SidecarQueryServerConfig.Pricing.DefaultQuoteDenom }}"
+
+# The denoms to price through, in order, when a token has no route to the quote denom.
+fallback-denoms = "{{ .SidecarQueryServerConfig.Pricing.FallbackDenoms }}"
+
+# The paths of the config files of the networks, e.g. testnet, that are served in addition to
+# the node's network, under their /{chain-id} path prefix. Each file configures a single network
+# with its chain_id, redis_db, node_grpc_address and [router] and [pricing] tables.
+# The data of each network is ingested into its redis database by a node of that network.
+# Requires chain-id to be set.
+network-config-files = "{{ .SidecarQueryServerConfig.NetworkConfigFiles }}"
+
+###############################################################################
+###                   Osmosis Streaming Service Configuration               ###
+###############################################################################
+
+[osmosis-streaming]
+
+# Streaming service is disabled by default.
+is-enabled = "false"
+
+# Whether to halt the node if a block update fails to be published.
+stop-node-on-err = "{{ .StreamingConfig.StopNodeOnErr }}"
+
+# The sink block updates are published to. One of "file", "redis" or "nats".
+sink = "{{ .StreamingConfig.SinkType }}"
+
+# The path of the file sink. Relative paths are relative to the node home.
+file-path = "{{ .StreamingConfig.FilePath }}"
+
+# The hostname and port of the redis sink.
+redis-host = "{{ .StreamingConfig.RedisHost }}"
+redis-port = "{{ .StreamingConfig.RedisPort }}"
+
+# The redis stream block updates are added to.
+redis-stream = "{{ .StreamingConfig.RedisStream }}"
+
+# The approximate maximum number of entries kept in the redis stream. Zero disables trimming.
+redis-max-len = "{{ .StreamingConfig.RedisMaxLen }}"
+
+# The hostname and port of the nats server of the nats sink.
+nats-host = "{{ .StreamingConfig.NATSHost }}"
+nats-port = "{{ .StreamingConfig.NATSPort }}"
+
+# The subject block updates are published to. A JetStream stream must be bound to it.
+nats-subject = "{{ .StreamingConfig.NATSSubject }}"
+
+# The timeout in milliseconds of connecting to the nats server and of every JetStream acknowledgement.
+nats-timeout-ms = "{{ .StreamingConfig.NATSTimeoutMs }}"
+
+###############################################################################
+###                   Osmosis Query Server Configuration                   ###
+###############################################################################
+
+[osmosis-query-server]
+
+# The query server serves read-heavy queries from read-only snapshots of recently
+# committed state so that they do not contend with block execution and commit.
+# It is disabled by default.
+is-enabled = "false"
+
+# The address the gRPC query server listens on.
+address = "{{ .QueryServerConfig.Address }}"
+
+# The number of most recent heights whose snapshots are kept. Queries may select a
+# retained height with the x-cosmos-block-height header, defaulting to the latest.
+# Must not exceed the number of recent versions kept by pruning, which is checked on startup.
+snapshot-retention = "{{ .QueryServerConfig.SnapshotRetention }}"
+
+# The maximum number of queries served at once.
+max-concurrent-queries = "{{ .QueryServerConfig.MaxConcurrentQueries }}"
+
+# The maximum gas a single query may consume before it is aborted.
+query-gas-limit = "{{ .QueryServerConfig.QueryGasLimit }}"
+
+# The full names of the gRPC methods served by the query server.
+methods = "{{ .QueryServerConfig.Methods }}"
 `
 
 	return OsmosisAppTemplate, OsmosisAppCfg
@@ -541,6 +706,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	rootCmd.AddCommand(
 		// genutilcli.InitCmd(osmosis.ModuleBasics, osmosis.DefaultNodeHome),
 		forceprune(),
+		VerifyCLStateCmd(),
+		TestUpgradeCmd(),
 		InitCmd(osmosis.ModuleBasics, osmosis.DefaultNodeHome),
 		genutilcli.CollectGenTxsCmd(banktypes.GenesisBalancesIterator{}, osmosis.DefaultNodeHome, gentxModule.GenTxValidator),
 		genutilcli.MigrateGenesisCmd(),
@@ -560,6 +727,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		UpdateAssetListCmd(osmosis.DefaultNodeHome, osmosis.ModuleBasics),
 	)
 
+	rootCmd.AddCommand(fuzzCommands()...)
+
 	server.AddCommands(rootCmd, osmosis.DefaultNodeHome, newApp, createOsmosisAppAndExport, addModuleInitFlags)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
@@ -509,6 +509,12 @@ func GetStructAndFill(queryPath, module, structName string, structArguments ...s
 		case "RecoveredSinceDowntimeOfLengthRequest":
 			v := &downtimequerytypes.RecoveredSinceDowntimeOfLengthRequest{}
 			return v, nil
+		case "RecoveredSinceDowntimeOfWindowRequest":
+			v := &downtimequerytypes.RecoveredSinceDowntimeOfWindowRequest{}
+			return v, nil
+		case "ParamsRequest":
+			v := &downtimequerytypes.ParamsRequest{}
+			return v, nil
 		}
 	case "concentrated-liquidity":
 		switch structName {
@@ -0,0 +1,147 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	cometbftdb "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	osmosis "github.com/osmosis-labs/osmosis/v21/app"
+)
+
+const (
+	flagTestUpgradeHeight   = "height"
+	flagTestUpgradeGenesis  = "genesis"
+	flagTestUpgradeSkipDiff = "skip-diff"
+)
+
+// TestUpgradeCmd returns a command that runs an upgrade handler and its store migrations in memory,
+// against the state in the application database or an exported genesis, and reports how long
+// every store migration took and which module states the upgrade changed.
+func TestUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test-upgrade [upgrade-name]",
+		Short: "Dry run an upgrade handler and its store migrations against the state of the node or an exported genesis",
+		Long: `Dry run an upgrade handler and its store migrations against the state of the node or an exported genesis.
+The upgrade is run in memory, without writing to the application database, and the time spent in every
+store migration is reported, along with the top level fields of the module states changed by the upgrade.
+By default, the state at the given height of the application database is used. One needs to shut down the node
+before running this command. With --genesis, the state is initialized from the given exported genesis instead.
+Since a genesis initializes every module at its latest consensus version, no store migrations are run against it.
+Example:
+	osmosisd test-upgrade v21 --height 12345678
+	osmosisd test-upgrade v21 --genesis exported_genesis.json --skip-diff
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(flagTestUpgradeHeight)
+			if err != nil {
+				return err
+			}
+
+			genesisFile, err := cmd.Flags().GetString(flagTestUpgradeGenesis)
+			if err != nil {
+				return err
+			}
+
+			skipDiff, err := cmd.Flags().GetBool(flagTestUpgradeSkipDiff)
+			if err != nil {
+				return err
+			}
+
+			chainId, err := cmd.Flags().GetString(flags.FlagChainID)
+			if err != nil {
+				return err
+			}
+
+			var app *osmosis.OsmosisApp
+			if genesisFile != "" {
+				genDoc, err := tmtypes.GenesisDocFromFile(genesisFile)
+				if err != nil {
+					return err
+				}
+				chainId = genDoc.ChainID
+
+				// Wasm code in the genesis is written to the home directory, use a temporary one to leave the node's untouched.
+				homeDir, err := os.MkdirTemp("", "osmosisd-test-upgrade")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(homeDir)
+
+				app = osmosis.NewOsmosisApp(serverCtx.Logger, cometbftdb.NewMemDB(), nil, true, map[int64]bool{}, homeDir, 0, serverCtx.Viper, osmosis.EmptyWasmOpts)
+				consensusParams := genDoc.ConsensusParams.ToProto()
+				app.InitChain(abci.RequestInitChain{
+					Time:            genDoc.GenesisTime,
+					ChainId:         genDoc.ChainID,
+					ConsensusParams: &consensusParams,
+					AppStateBytes:   genDoc.AppState,
+					InitialHeight:   genDoc.InitialHeight,
+				})
+				app.Commit()
+			} else {
+				db, err := cometbftdb.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(clientCtx.HomeDir, "data"))
+				if err != nil {
+					return err
+				}
+				defer db.Close()
+
+				loadLatest := height == -1
+				app = osmosis.NewOsmosisApp(serverCtx.Logger, db, nil, loadLatest, map[int64]bool{}, clientCtx.HomeDir, 0, serverCtx.Viper, osmosis.EmptyWasmOpts)
+				if !loadLatest {
+					if err := app.LoadHeight(height); err != nil {
+						return err
+					}
+				}
+			}
+
+			// The upgrade runs at the beginning of the block following the loaded state.
+			ctx := app.NewContext(true, tmproto.Header{
+				ChainID: chainId,
+				Height:  app.LastBlockHeight() + 1,
+				Time:    time.Now().UTC(),
+			}).WithIsCheckTx(false)
+
+			report, err := app.DryRunUpgrade(ctx, args[0], !skipDiff)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("upgrade %s ran in %s at height %d\n", report.UpgradeName, report.Duration, report.Height)
+			cmd.Printf("store migrations: %d\n", len(report.Migrations))
+			for _, migration := range report.Migrations {
+				cmd.Printf("  %s from version %d: %s\n", migration.ModuleName, migration.FromVersion, migration.Duration)
+			}
+			if !skipDiff {
+				cmd.Printf("changed module states: %d\n", len(report.Diffs))
+				for _, diff := range report.Diffs {
+					cmd.Printf("  %s: %s\n", diff.ModuleName, strings.Join(diff.ChangedFields, ", "))
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagTestUpgradeHeight, -1, "Height of the application database state to run the upgrade against, -1 for the latest height")
+	cmd.Flags().String(flagTestUpgradeGenesis, "", "Exported genesis to run the upgrade against, instead of the application database state")
+	cmd.Flags().Bool(flagTestUpgradeSkipDiff, false, "Skip diffing the module states before and after the upgrade, which is slow for large states")
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID of the application database state, used by upgrade handlers with chain specific logic")
+
+	return cmd
+}
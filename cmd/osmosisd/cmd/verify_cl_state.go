@@ -0,0 +1,103 @@
+package cmd
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	cometbftdb "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	osmosis "github.com/osmosis-labs/osmosis/v21/app"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+const (
+	flagVerifyHeight = "height"
+	flagVerifyPoolId = "pool-id"
+)
+
+// VerifyCLStateCmd returns a command that recomputes the derived concentrated liquidity state
+// from the positions persisted in the application database and reports any discrepancies.
+func VerifyCLStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-cl-state",
+		Short: "Verify that the concentrated liquidity state in the application database is internally consistent",
+		Long: `Verify that the concentrated liquidity state in the application database is internally consistent.
+The pool liquidity, tick liquidity and accumulator shares are recomputed from the raw positions in the store
+and compared against the stored values, and the accumulators and pool balances are checked.
+One needs to shut down the node before running this command.
+It is meant to be run after state-sync or upgrades, before serving traffic. To verify a single pool
+on a running node, use "osmosisd q concentratedliquidity verify-pool" instead.
+Example:
+	osmosisd verify-cl-state --height 12345678 --pool-id 1066
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			height, err := cmd.Flags().GetInt64(flagVerifyHeight)
+			if err != nil {
+				return err
+			}
+
+			poolId, err := cmd.Flags().GetUint64(flagVerifyPoolId)
+			if err != nil {
+				return err
+			}
+
+			db, err := cometbftdb.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(clientCtx.HomeDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			loadLatest := height == -1
+			app := osmosis.NewOsmosisApp(serverCtx.Logger, db, nil, loadLatest, map[int64]bool{}, clientCtx.HomeDir, 0, serverCtx.Viper, osmosis.EmptyWasmOpts)
+			if !loadLatest {
+				if err := app.LoadHeight(height); err != nil {
+					return err
+				}
+			}
+
+			ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+			clKeeper := app.ConcentratedLiquidityKeeper
+			verify := clKeeper.VerifyPoolsState
+			if poolId != 0 {
+				verify = func(ctx sdk.Context) ([]cltypes.StateDiscrepancy, error) {
+					return clKeeper.VerifyPoolState(ctx, poolId)
+				}
+			}
+
+			discrepancies, err := verify(ctx)
+			if err != nil {
+				return err
+			}
+
+			for _, discrepancy := range discrepancies {
+				cmd.Println(discrepancy.String())
+			}
+
+			if len(discrepancies) > 0 {
+				return fmt.Errorf("found %d concentrated liquidity state discrepancies at height %d", len(discrepancies), ctx.BlockHeight())
+			}
+
+			cmd.Printf("concentrated liquidity state is consistent at height %d\n", ctx.BlockHeight())
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagVerifyHeight, -1, "Height to verify the state at, -1 for the latest height")
+	cmd.Flags().Uint64(flagVerifyPoolId, 0, "Concentrated pool ID to verify, 0 for all pools")
+
+	return cmd
+}
@@ -10,7 +10,7 @@ import (
 
 // chainInfoIngester is an ingester for blockchain information.
 // It implements ingest.Ingester.
-// It reads the latest blockchain height and writes it to the chainInfo repository.
+// It reads the latest blockchain height and its block time and writes them to the chainInfo repository.
 type chainInfoIngester struct {
 	chainInfoRepo     mvc.ChainInfoRepository
 	repositoryManager mvc.TxManager
@@ -26,7 +26,7 @@ func NewChainInfoIngester(chainInfoRepo mvc.ChainInfoRepository, repositoryManag
 }
 
 // ProcessBlock implements ingest.Ingester.
-// It reads the latest blockchain height and stores it in Redis.
+// It reads the latest blockchain height and its block time and stores them in Redis.
 func (ci *chainInfoIngester) ProcessBlock(ctx sdk.Context, tx mvc.Tx) error {
 	height := ctx.BlockHeight()
 
@@ -38,6 +38,12 @@ func (ci *chainInfoIngester) ProcessBlock(ctx sdk.Context, tx mvc.Tx) error {
 		return err
 	}
 
+	err = ci.chainInfoRepo.StoreLatestBlockTime(sdk.WrapSDKContext(ctx), tx, ctx.BlockTime())
+	if err != nil {
+		ci.logger.Error("failed to ingest latest block time", zap.Error(err))
+		return err
+	}
+
 	return nil
 }
 
@@ -20,9 +20,10 @@ type TimeWrapper struct {
 }
 
 const (
-	latestHeightKey     = "latestHeight"
-	latestHeightField   = "height"
-	latestHeightTimeKey = "timeLatestHeight"
+	latestHeightKey      = "latestHeight"
+	latestHeightField    = "height"
+	latestBlockTimeField = "blockTime"
+	latestHeightTimeKey  = "timeLatestHeight"
 )
 
 // NewChainInfoRepo creates a new repository for chain information
@@ -83,6 +84,59 @@ func (r *chainInfoRepo) GetLatestHeight(ctx context.Context) (uint64, error) {
 	return height, nil
 }
 
+// StoreLatestBlockTime implements mvc.ChainInfoRepository.
+// It stores the block time of the latest blockchain height alongside the height.
+func (r *chainInfoRepo) StoreLatestBlockTime(ctx context.Context, tx mvc.Tx, blockTime time.Time) error {
+	redisTx, err := tx.AsRedisTx()
+	if err != nil {
+		return err
+	}
+
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(TimeWrapper{Time: blockTime.UTC()})
+	if err != nil {
+		return err
+	}
+
+	cmd := pipeliner.HSet(ctx, latestHeightKey, latestBlockTimeField, bz)
+	if err := cmd.Err(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetLatestBlockTime implements mvc.ChainInfoRepository.
+func (r *chainInfoRepo) GetLatestBlockTime(ctx context.Context) (time.Time, error) {
+	tx := r.repositoryManager.StartTx()
+	redisTx, err := tx.AsRedisTx()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	pipeliner, err := redisTx.GetPipeliner(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	cmd := pipeliner.HGet(ctx, latestHeightKey, latestBlockTimeField)
+
+	if err := tx.Exec(ctx); err != nil {
+		return time.Time{}, err
+	}
+
+	var timeWrapper TimeWrapper
+	if err := json.Unmarshal([]byte(cmd.Val()), &timeWrapper); err != nil {
+		return time.Time{}, err
+	}
+
+	return timeWrapper.Time, nil
+}
+
 // GetLatestHeightRetrievalTime implements mvc.ChainInfoRepository.
 func (r *chainInfoRepo) GetLatestHeightRetrievalTime(ctx context.Context) (time.Time, error) {
 	tx := r.repositoryManager.StartTx()
@@ -78,3 +78,39 @@ func (p *chainInfoUseCase) GetLatestHeight(ctx context.Context) (uint64, error)
 
 	return latestHeight, nil
 }
+
+func (p *chainInfoUseCase) GetIngestedHeight(ctx context.Context, minBlockHeight uint64) (uint64, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.contextTimeout)
+	defer cancel()
+
+	ingestedHeight, err := p.chainInfoRepository.GetLatestHeight(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if ingestedHeight < minBlockHeight {
+		return 0, domain.MinBlockHeightNotReachedError{
+			MinBlockHeight: minBlockHeight,
+			IngestedHeight: ingestedHeight,
+		}
+	}
+
+	return ingestedHeight, nil
+}
+
+func (p *chainInfoUseCase) GetIngestLag(ctx context.Context) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.contextTimeout)
+	defer cancel()
+
+	latestBlockTime, err := p.chainInfoRepository.GetLatestBlockTime(ctx)
+	if err != nil {
+		// The block time is unknown until the first block is ingested after an upgrade.
+		if err.Error() == redis.Nil.Error() {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return time.Now().UTC().Sub(latestBlockTime), nil
+}
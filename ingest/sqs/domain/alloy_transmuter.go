@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// AlloyTransmuterAsset is the state of a single asset of an alloyed transmuter pool
+// that is needed to avoid quoting swaps rejected by the contract's limiters.
+type AlloyTransmuterAsset struct {
+	Denom string `json:"denom"`
+	// Amount is the pool liquidity of the asset.
+	Amount osmomath.Int `json:"amount"`
+	// NormalizationFactor scales the amount of the asset to the common unit of the pool.
+	NormalizationFactor osmomath.Int `json:"normalization_factor"`
+	// Weight is the share of the asset in the normalized pool liquidity, between 0 and 1.
+	Weight osmomath.Dec `json:"weight"`
+	// UpperLimit is the tightest static limiter on the weight of the asset.
+	// Nil if the asset has no static limiter.
+	UpperLimit *osmomath.Dec `json:"upper_limit,omitempty"`
+	// RemainingCapacity is the amount of the asset that can be swapped into the pool
+	// before its weight reaches UpperLimit. Nil if the asset has no static limiter.
+	RemainingCapacity *osmomath.Int `json:"remaining_capacity,omitempty"`
+}
+
+// AlloyTransmuterModel is the composition and limits of an alloyed transmuter pool.
+// Note that change limiters, which bound the weight of an asset relative to its moving
+// average, are not reflected in the remaining capacity.
+type AlloyTransmuterModel struct {
+	Assets []AlloyTransmuterAsset `json:"assets"`
+}
+
+// GetAsset returns the asset with the given denom and true if found. False otherwise.
+func (m *AlloyTransmuterModel) GetAsset(denom string) (AlloyTransmuterAsset, bool) {
+	for _, asset := range m.Assets {
+		if asset.Denom == denom {
+			return asset, true
+		}
+	}
+	return AlloyTransmuterAsset{}, false
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "strconv"
+
+const (
+	// BlockHeightHeader is the response header containing the ingested block height
+	// that the response was computed against.
+	BlockHeightHeader = "X-Block-Height"
+	// MinBlockHeightQueryParam is the optional query parameter that allows clients
+	// to request that the response is computed against at least the given height.
+	MinBlockHeightQueryParam = "min_block_height"
+	// StaleHeader is the response header that is set to true when the response was computed
+	// against ingested state that lags behind the chain, see StaleQuoteConfig.
+	StaleHeader = "X-Stale"
+)
+
+// ParseMinBlockHeight parses the value of the optional min block height query parameter.
+// Returns zero if the parameter is not set.
+// Returns InvalidQueryParamError if the value is not a valid height.
+func ParseMinBlockHeight(minBlockHeightStr string) (uint64, error) {
+	if len(minBlockHeightStr) == 0 {
+		return 0, nil
+	}
+
+	minBlockHeight, err := strconv.ParseUint(minBlockHeightStr, 10, 64)
+	if err != nil {
+		return 0, InvalidQueryParamError{Param: MinBlockHeightQueryParam, Value: minBlockHeightStr, Err: err}
+	}
+
+	return minBlockHeight, nil
+}
@@ -0,0 +1,38 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+)
+
+// TestParseMinBlockHeight tests parsing the optional min block height query parameter.
+func TestParseMinBlockHeight(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          string
+		expectedHeight uint64
+		expectedError  bool
+	}{
+		{"not set", "", 0, false},
+		{"valid height", "12345", 12345, false},
+		{"negative height", "-1", 0, true},
+		{"invalid height", "abc", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualHeight, err := domain.ParseMinBlockHeight(tc.input)
+
+			if tc.expectedError {
+				require.ErrorAs(t, err, &domain.InvalidQueryParamError{})
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHeight, actualHeight)
+		})
+	}
+}
@@ -112,6 +112,26 @@ func (e ConcentratedTickModelNotSetError) Error() string {
 	return fmt.Sprintf("tick model is not set on pool (%d)", e.PoolId)
 }
 
+type OrderbookNotEnoughLiquidityToCompleteSwapError struct {
+	PoolId   uint64
+	AmountIn string
+}
+
+func (e OrderbookNotEnoughLiquidityToCompleteSwapError) Error() string {
+	return fmt.Sprintf("not enough resting orders to complete swap in orderbook pool (%d) with amount in (%s)", e.PoolId, e.AmountIn)
+}
+
+type AlloyTransmuterCapacityExceededError struct {
+	PoolId            uint64
+	Denom             string
+	RemainingCapacity string
+	Amount            string
+}
+
+func (e AlloyTransmuterCapacityExceededError) Error() string {
+	return fmt.Sprintf("amount in (%s) of denom (%s) exceeds the remaining capacity (%s) of alloyed transmuter pool (%d)", e.Amount, e.Denom, e.RemainingCapacity, e.PoolId)
+}
+
 type TransmuterInsufficientBalanceError struct {
 	Denom         string
 	BalanceAmount string
@@ -131,3 +151,61 @@ type StaleHeightError struct {
 func (e StaleHeightError) Error() string {
 	return fmt.Sprintf("stored height (%d) is stale, time since last update (%d), max allowed seconds (%d)", e.StoredHeight, e.TimeSinceLastUpdate, e.MaxAllowedTimeDeltaSecs)
 }
+
+type StaleIngestError struct {
+	IngestedHeight   uint64
+	IngestLagSecs    int
+	MaxIngestLagSecs int
+}
+
+func (e StaleIngestError) Error() string {
+	return fmt.Sprintf("ingested height (%d) is stale, ingestion lags behind by (%d) seconds, max allowed seconds (%d)", e.IngestedHeight, e.IngestLagSecs, e.MaxIngestLagSecs)
+}
+
+// InvalidQueryParamError is returned when a query parameter supplied by the client cannot be parsed.
+type InvalidQueryParamError struct {
+	Param string
+	Value string
+	Err   error
+}
+
+func (e InvalidQueryParamError) Error() string {
+	return fmt.Sprintf("%s (%s) is invalid: %s", e.Param, e.Value, e.Err)
+}
+
+type MinBlockHeightNotReachedError struct {
+	MinBlockHeight uint64
+	IngestedHeight uint64
+}
+
+func (e MinBlockHeightNotReachedError) Error() string {
+	return fmt.Sprintf("ingested height (%d) is below the requested min block height (%d)", e.IngestedHeight, e.MinBlockHeight)
+}
+
+type NoRouteSatisfiesQuoteFilterError struct {
+	TokenInDenom        string
+	TokenOutDenom       string
+	MaxPriceImpactBps   uint64
+	MinPoolLiquidityCap uint64
+}
+
+func (e NoRouteSatisfiesQuoteFilterError) Error() string {
+	return fmt.Sprintf("no route from (%s) to (%s) satisfies max price impact (%d bps) and min pool liquidity cap (%d)", e.TokenInDenom, e.TokenOutDenom, e.MaxPriceImpactBps, e.MinPoolLiquidityCap)
+}
+
+type NoPriceFoundError struct {
+	BaseDenom  string
+	QuoteDenom string
+}
+
+func (e NoPriceFoundError) Error() string {
+	return fmt.Sprintf("no price found for (%s) in (%s)", e.BaseDenom, e.QuoteDenom)
+}
+
+type TokenPrecisionNotFoundError struct {
+	Denom string
+}
+
+func (e TokenPrecisionNotFoundError) Error() string {
+	return fmt.Sprintf("token precision not found for (%s)", e.Denom)
+}
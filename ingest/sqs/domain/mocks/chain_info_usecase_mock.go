@@ -0,0 +1,37 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+)
+
+type ChainInfoUsecaseMock struct {
+	LatestHeight uint64
+	IngestLag    time.Duration
+}
+
+// GetLatestHeight implements mvc.ChainInfoUsecase.
+func (c *ChainInfoUsecaseMock) GetLatestHeight(ctx context.Context) (uint64, error) {
+	return c.LatestHeight, nil
+}
+
+// GetIngestedHeight implements mvc.ChainInfoUsecase.
+func (c *ChainInfoUsecaseMock) GetIngestedHeight(ctx context.Context, minBlockHeight uint64) (uint64, error) {
+	if c.LatestHeight < minBlockHeight {
+		return 0, domain.MinBlockHeightNotReachedError{
+			MinBlockHeight: minBlockHeight,
+			IngestedHeight: c.LatestHeight,
+		}
+	}
+	return c.LatestHeight, nil
+}
+
+// GetIngestLag implements mvc.ChainInfoUsecase.
+func (c *ChainInfoUsecaseMock) GetIngestLag(ctx context.Context) (time.Duration, error) {
+	return c.IngestLag, nil
+}
+
+var _ mvc.ChainInfoUsecase = &ChainInfoUsecaseMock{}
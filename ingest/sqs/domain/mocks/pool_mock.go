@@ -66,6 +66,17 @@ func (mp *MockRoutablePool) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Co
 	return balancerPool.CalcOutAmtGivenIn(sdk.Context{}, sdk.NewCoins(tokenIn), mp.TokenOutDenom, mp.SpreadFactor)
 }
 
+// SpotPrice implements domain.RoutablePool.
+func (mp *MockRoutablePool) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	// Cast to balancer
+	balancerPool, ok := mp.ChainPoolModel.(*balancer.Pool)
+	if !ok {
+		panic("not a balancer pool")
+	}
+
+	return balancerPool.SpotPrice(sdk.Context{}, quoteDenom, baseDenom)
+}
+
 // String implements domain.RoutablePool.
 func (*MockRoutablePool) String() string {
 	panic("unimplemented")
@@ -54,6 +54,17 @@ func (pm *PoolsUsecaseMock) GetAllPools(ctx context.Context) ([]domain.PoolI, er
 	return pm.Pools, nil
 }
 
+// GetPools implements mvc.PoolsUsecase.
+func (pm *PoolsUsecaseMock) GetPools(ctx context.Context, poolIDs map[uint64]struct{}) (map[uint64]domain.PoolI, error) {
+	result := make(map[uint64]domain.PoolI, len(poolIDs))
+	for _, pool := range pm.Pools {
+		if _, ok := poolIDs[pool.GetId()]; ok {
+			result[pool.GetId()] = pool
+		}
+	}
+	return result, nil
+}
+
 // GetTickModelMap implements mvc.PoolsUsecase.
 func (pm *PoolsUsecaseMock) GetTickModelMap(ctx context.Context, poolIDs []uint64) (map[uint64]domain.TickModel, error) {
 	return pm.TickModelMap, nil
@@ -7,12 +7,12 @@ import (
 )
 
 type TokensUseCaseMock struct {
-	tokenPrecisionMap map[string]int
+	TokenPrecisionMap map[string]int
 }
 
 // GetDenomPrecisions implements domain.TokensUsecase.
 func (tu *TokensUseCaseMock) GetDenomPrecisions(ctx context.Context) (map[string]int, error) {
-	return tu.tokenPrecisionMap, nil
+	return tu.TokenPrecisionMap, nil
 }
 
 var _ domain.TokensUsecase = &TokensUseCaseMock{}
@@ -13,6 +13,12 @@ type ChainInfoRepository interface {
 	// GetLatestHeight retrieves the latest blockchain height
 	GetLatestHeight(ctx context.Context) (uint64, error)
 
+	// StoreLatestBlockTime stores the block time of the latest blockchain height
+	StoreLatestBlockTime(ctx context.Context, tx Tx, blockTime time.Time) error
+
+	// GetLatestBlockTime retrieves the block time of the latest blockchain height
+	GetLatestBlockTime(ctx context.Context) (time.Time, error)
+
 	// GetLatestHeightRetrievalTime retrieves the latest blockchain height retrieval time.
 	GetLatestHeightRetrievalTime(ctx context.Context) (time.Time, error)
 
@@ -22,4 +28,12 @@ type ChainInfoRepository interface {
 
 type ChainInfoUsecase interface {
 	GetLatestHeight(ctx context.Context) (uint64, error)
+
+	// GetIngestedHeight returns the latest ingested height without validating its staleness.
+	// Returns domain.MinBlockHeightNotReachedError if the ingested height is below minBlockHeight.
+	GetIngestedHeight(ctx context.Context, minBlockHeight uint64) (uint64, error)
+
+	// GetIngestLag returns the time elapsed since the block time of the latest ingested height.
+	// Returns zero if the block time of the latest ingested height is unknown.
+	GetIngestLag(ctx context.Context) (time.Duration, error)
 }
@@ -0,0 +1,10 @@
+package mvc
+
+import "github.com/labstack/echo"
+
+// HTTPRouter registers HTTP endpoints. It is implemented by *echo.Echo for the endpoints served
+// at the root path, and by *echo.Group for the endpoints of a network served under its path prefix.
+type HTTPRouter interface {
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
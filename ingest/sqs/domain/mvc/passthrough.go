@@ -0,0 +1,25 @@
+package mvc
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// PassthroughUsecase represents the usecases that pass queries for account data through to the node.
+// The results are cached until the next ingested block.
+type PassthroughUsecase interface {
+	// GetBalances returns the bank balances of the given address.
+	GetBalances(ctx context.Context, address string) (sdk.Coins, error)
+	// GetLocks returns both the locked and the unlocking locks of the given address.
+	GetLocks(ctx context.Context, address string) ([]lockuptypes.PeriodLock, error)
+	// GetConcentratedPositions returns the concentrated liquidity positions of the given address
+	// together with their claimable spread rewards and incentives.
+	GetConcentratedPositions(ctx context.Context, address string) ([]clmodel.FullPositionBreakdown, error)
+	// GetPortfolio returns all of the above for the given address in a single response.
+	GetPortfolio(ctx context.Context, address string) (domain.AccountPortfolio, error)
+}
@@ -29,6 +29,10 @@ type PoolsRepository interface {
 type PoolsUsecase interface {
 	GetAllPools(ctx context.Context) ([]domain.PoolI, error)
 
+	// GetPools returns the pools with the given IDs.
+	// Note that this does NOT return tick models for the concentrated pools
+	GetPools(ctx context.Context, poolIDs map[uint64]struct{}) (map[uint64]domain.PoolI, error)
+
 	// GetRoutesFromCandidates converts candidate routes to routes intrusmented with all the data necessary for estimating
 	// a swap. This data entails the pool data, the taker fee.
 	GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error)
@@ -0,0 +1,20 @@
+package mvc
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// PricingUsecase represents the pricing usecases
+type PricingUsecase interface {
+	// GetPrice returns the price of one unit of the base denom in units of the quote denom,
+	// accounting for the precisions of both denoms.
+	// If quoteDenom is empty, the configured default quote denom is used.
+	// Returns domain.NoPriceFoundError if there is no route from the base denom to the quote denom,
+	// neither directly nor through any of the fallback denoms.
+	GetPrice(ctx context.Context, baseDenom, quoteDenom string) (osmomath.BigDec, error)
+	// GetPrices returns the prices of the given base denoms in the quote denom, keyed by base denom.
+	// The base denoms that cannot be priced are omitted from the result.
+	GetPrices(ctx context.Context, baseDenoms []string, quoteDenom string) (map[string]osmomath.BigDec, error)
+}
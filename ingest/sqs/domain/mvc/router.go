@@ -33,7 +33,8 @@ type RouterRepository interface {
 // RouterUsecase represent the router's usecases
 type RouterUsecase interface {
 	// GetOptimalQuote returns the optimal quote for the given tokenIn and tokenOutDenom.
-	GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error)
+	// Only the routes satisfying the given filter are considered.
+	GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, filter domain.QuoteFilter) (domain.Quote, error)
 	// GetBestSingleRouteQuote returns the best single route quote for the given tokenIn and tokenOutDenom.
 	GetBestSingleRouteQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error)
 	// GetCustomQuote returns the custom quote for the given tokenIn, tokenOutDenom and poolIDs.
@@ -44,4 +45,6 @@ type RouterUsecase interface {
 	GetCandidateRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string) (route.CandidateRoutes, error)
 	// StoreRoutes stores all router state in the files locally. Used for debugging.
 	StoreRouterStateFiles(ctx context.Context) error
+	// GetRouteRestrictions returns the route restrictions currently enforced in route search.
+	GetRouteRestrictions() domain.RouteRestrictions
 }
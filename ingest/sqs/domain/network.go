@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NetworkConfig configures a network, e.g. testnet, that is served by the sidecar query server
+// in addition to the network of the node it runs in. The data of the network is ingested into
+// its own Redis database by a node of that network, and its endpoints are served under the
+// /{chain-id} path prefix.
+type NetworkConfig struct {
+	// ChainID is the chain ID of the network, used as the path prefix of its endpoints.
+	ChainID string `mapstructure:"chain_id"`
+	// RedisDB is the index of the Redis database that the network data is ingested into.
+	// Every network must use a different database so that their keyspaces are isolated.
+	RedisDB int `mapstructure:"redis_db"`
+	// NodeGRPCAddress is the gRPC address of a node of the network that passthrough queries are sent to.
+	// If empty, the passthrough endpoints are not served for the network.
+	NodeGRPCAddress string `mapstructure:"node_grpc_address"`
+	// Router is the router config of the network.
+	Router RouterConfig `mapstructure:"router"`
+	// Pricing is the pricing config of the network.
+	Pricing PricingConfig `mapstructure:"pricing"`
+}
+
+// Validate returns error if the chain ID is empty, the Redis database index is negative
+// or the router config is invalid.
+func (c NetworkConfig) Validate() error {
+	if len(c.ChainID) == 0 {
+		return errors.New("chain ID is empty")
+	}
+	if c.RedisDB < 0 {
+		return fmt.Errorf("network %s redis db (%d) is negative", c.ChainID, c.RedisDB)
+	}
+	if err := c.Router.RouteRestrictions.Validate(); err != nil {
+		return fmt.Errorf("network %s: %w", c.ChainID, err)
+	}
+	if err := c.Router.StaleQuotes.Validate(); err != nil {
+		return fmt.Errorf("network %s: %w", c.ChainID, err)
+	}
+	if err := c.Router.OptimalRouteCache.Validate(); err != nil {
+		return fmt.Errorf("network %s: %w", c.ChainID, err)
+	}
+	return nil
+}
+
+// ValidateNetworks returns error if any of the given networks is invalid, or if any two of them,
+// including the network of the node with the given chain ID and Redis database index, share
+// a chain ID or a Redis database.
+func ValidateNetworks(nodeChainID string, nodeRedisDB int, networks []NetworkConfig) error {
+	if len(networks) > 0 && len(nodeChainID) == 0 {
+		return errors.New("chain ID of the node must be set to serve additional networks")
+	}
+
+	chainIDs := map[string]struct{}{nodeChainID: {}}
+	redisDBs := map[int]struct{}{nodeRedisDB: {}}
+	for _, network := range networks {
+		if err := network.Validate(); err != nil {
+			return err
+		}
+		if _, ok := chainIDs[network.ChainID]; ok {
+			return fmt.Errorf("chain ID %s is configured more than once", network.ChainID)
+		}
+		if _, ok := redisDBs[network.RedisDB]; ok {
+			return fmt.Errorf("redis db %d of network %s is used by another network", network.RedisDB, network.ChainID)
+		}
+		chainIDs[network.ChainID] = struct{}{}
+		redisDBs[network.RedisDB] = struct{}{}
+	}
+	return nil
+}
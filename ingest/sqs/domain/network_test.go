@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+)
+
+// TestValidateNetworks tests the validation of the networks served in addition to the node's network.
+func TestValidateNetworks(t *testing.T) {
+	testnet := domain.NetworkConfig{ChainID: "osmo-test-5", RedisDB: 1}
+	devnet := domain.NetworkConfig{ChainID: "osmo-dev-1", RedisDB: 2}
+
+	testCases := []struct {
+		name          string
+		nodeChainID   string
+		networks      []domain.NetworkConfig
+		expectedError bool
+	}{
+		{
+			name: "no networks without node chain ID",
+		},
+		{
+			name:        "distinct networks",
+			nodeChainID: "osmosis-1",
+			networks:    []domain.NetworkConfig{testnet, devnet},
+		},
+		{
+			name:          "networks without node chain ID",
+			networks:      []domain.NetworkConfig{testnet},
+			expectedError: true,
+		},
+		{
+			name:          "empty chain ID",
+			nodeChainID:   "osmosis-1",
+			networks:      []domain.NetworkConfig{{RedisDB: 1}},
+			expectedError: true,
+		},
+		{
+			name:          "negative redis db",
+			nodeChainID:   "osmosis-1",
+			networks:      []domain.NetworkConfig{{ChainID: "osmo-test-5", RedisDB: -1}},
+			expectedError: true,
+		},
+		{
+			name:          "chain ID of the node",
+			nodeChainID:   "osmo-test-5",
+			networks:      []domain.NetworkConfig{testnet},
+			expectedError: true,
+		},
+		{
+			name:          "redis db of the node",
+			nodeChainID:   "osmosis-1",
+			networks:      []domain.NetworkConfig{{ChainID: "osmo-test-5", RedisDB: 0}},
+			expectedError: true,
+		},
+		{
+			name:          "duplicate redis db",
+			nodeChainID:   "osmosis-1",
+			networks:      []domain.NetworkConfig{testnet, {ChainID: "osmo-dev-1", RedisDB: 1}},
+			expectedError: true,
+		},
+		{
+			name:        "invalid router config",
+			nodeChainID: "osmosis-1",
+			networks: []domain.NetworkConfig{{
+				ChainID: "osmo-test-5",
+				RedisDB: 1,
+				Router: domain.RouterConfig{
+					OptimalRouteCache: domain.OptimalRouteCacheConfig{MaxLiquidityChangeBps: -1},
+				},
+			}},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := domain.ValidateNetworks(tc.nodeChainID, 0, tc.networks)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
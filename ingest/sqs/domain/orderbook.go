@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+// OrderbookLevel is the total quantity of the resting limit orders at a single price of an orderbook.
+type OrderbookLevel struct {
+	// Price is the price of the base denom in terms of the quote denom.
+	Price osmomath.Dec `json:"price"`
+	// Quantity is the amount of the base denom offered (asks) or requested (bids) at the price.
+	Quantity osmomath.Int `json:"quantity"`
+}
+
+// OrderbookModel is the state of the resting limit orders of an orderbook contract
+// that is needed for routing.
+type OrderbookModel struct {
+	BaseDenom  string `json:"base_denom"`
+	QuoteDenom string `json:"quote_denom"`
+	// Bids are the orders buying the base denom, sorted by price in descending order.
+	Bids []OrderbookLevel `json:"bids"`
+	// Asks are the orders selling the base denom, sorted by price in ascending order.
+	Asks []OrderbookLevel `json:"asks"`
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// AccountPortfolio aggregates the on-chain data of an account
+// so that clients do not have to query the node directly.
+type AccountPortfolio struct {
+	Balances sdk.Coins `json:"balances"`
+	// Locks contains both the locked and the unlocking locks.
+	Locks []lockuptypes.PeriodLock `json:"locks"`
+	// ConcentratedPositions contains the concentrated liquidity positions
+	// together with their claimable spread rewards and incentives.
+	ConcentratedPositions []clmodel.FullPositionBreakdown `json:"concentrated_positions"`
+	// TotalClaimable is the sum of the claimable spread rewards and incentives
+	// across all concentrated liquidity positions.
+	TotalClaimable sdk.Coins `json:"total_claimable"`
+}
@@ -49,6 +49,10 @@ type SQSPool struct {
 	Balances     sdk.Coins    `json:"balances"`
 	PoolDenoms   []string     `json:"pool_denoms"`
 	SpreadFactor osmomath.Dec `json:"spread_factor"`
+	// Only set for CosmWasm pools of whitelisted orderbook contracts.
+	Orderbook *OrderbookModel `json:"orderbook,omitempty"`
+	// Only set for CosmWasm pools of whitelisted alloyed transmuter contracts.
+	AlloyTransmuter *AlloyTransmuterModel `json:"alloy_transmuter,omitempty"`
 }
 
 type LiquidityDepthsWithRange = clqueryproto.LiquidityDepthWithRange
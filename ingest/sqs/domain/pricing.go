@@ -0,0 +1,18 @@
+package domain
+
+const (
+	// PricesBaseDenomsQueryParam is the query parameter containing the comma-separated denoms to price.
+	PricesBaseDenomsQueryParam = "base"
+	// PricesQuoteDenomQueryParam is the optional query parameter containing the denom to price in.
+	// Defaults to PricingConfig.DefaultQuoteDenom.
+	PricesQuoteDenomQueryParam = "quote"
+)
+
+type PricingConfig struct {
+	// The denom that prices are quoted in if none is requested, e.g. USDC.
+	DefaultQuoteDenom string `mapstructure:"default_quote_denom"`
+	// The denoms to route through, in order, when there is no route from a denom to the quote denom.
+	// For example, with uosmo as a fallback, a denom that only trades against OSMO is priced
+	// as its price in OSMO multiplied by the price of OSMO in the quote denom.
+	FallbackDenoms []string `mapstructure:"fallback_denoms"`
+}
@@ -2,8 +2,11 @@ package domain
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,6 +33,10 @@ type RoutablePool interface {
 
 	GetSpreadFactor() osmomath.Dec
 
+	// SpotPrice returns the spot price of the base denom in terms of the quote denom,
+	// i.e. the amount of quote denom received for one unit of base denom.
+	SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error)
+
 	String() string
 }
 
@@ -74,6 +81,13 @@ type Quote interface {
 	// it with the data formatted for output to the client.
 	PrepareResult() ([]SplitRoute, osmomath.Dec)
 
+	// SetBlockHeight sets the ingested block height that the quote was computed against.
+	SetBlockHeight(height uint64)
+
+	// SetStale flags whether the quote was computed against stale ingested state
+	// and sets the slippage tolerance recommended to clients accordingly.
+	SetStale(isStale bool, slippageTolerance osmomath.Dec)
+
 	String() string
 }
 
@@ -87,6 +101,187 @@ type RouterConfig struct {
 	MinOSMOLiquidity          int  `mapstructure:"min_osmo_liquidity"`
 	RouteUpdateHeightInterval int  `mapstructure:"route_update_height_interval"`
 	RouteCacheEnabled         bool `mapstructure:"route_cache_enabled"`
+	// The code IDs of the CosmWasm orderbook contracts whose resting limit orders are ingested for routing.
+	OrderbookCodeIDs []uint64 `mapstructure:"orderbook_code_ids"`
+	// The code IDs of the CosmWasm alloyed transmuter contracts whose composition and limiters are ingested.
+	AlloyTransmuterCodeIDs []uint64 `mapstructure:"alloy_transmuter_code_ids"`
+	// The pools and denoms that are excluded from route search and the canonical pools pinned for denom pairs.
+	RouteRestrictions RouteRestrictions `mapstructure:"route_restrictions"`
+	// The degradation of quotes when ingestion lags behind the chain.
+	StaleQuotes StaleQuoteConfig `mapstructure:"stale_quotes"`
+	// The caching of optimal routes per denom pair and amount bucket.
+	OptimalRouteCache OptimalRouteCacheConfig `mapstructure:"optimal_route_cache"`
+}
+
+// OptimalRouteCacheConfig configures the in-memory caching of the optimal routes of quotes and their
+// split proportions. Since the optimal split varies with the swap size, routes are cached per denom pair
+// and per logarithmic bucket of the token in amount, e.g. 1-9, 10-99 and 100-999.
+type OptimalRouteCacheConfig struct {
+	// Enabled defines whether the optimal routes are cached.
+	Enabled bool `mapstructure:"enabled"`
+	// MaxLiquidityChangeBps is the max change in basis points of the liquidity of any pool of cached routes,
+	// relative to its liquidity when the routes were cached, before the routes are invalidated.
+	MaxLiquidityChangeBps int `mapstructure:"max_liquidity_change_bps"`
+}
+
+// Validate returns error if the max liquidity change is negative.
+func (c OptimalRouteCacheConfig) Validate() error {
+	if c.MaxLiquidityChangeBps < 0 {
+		return fmt.Errorf("max liquidity change (%d bps) is negative", c.MaxLiquidityChangeBps)
+	}
+	return nil
+}
+
+// StaleQuoteConfig configures how quotes are served when ingestion stalls.
+// Ingestion is considered stale once the block time of the latest ingested height lags
+// behind the current time by more than MaxIngestLagSecs.
+type StaleQuoteConfig struct {
+	// Enabled defines whether quotes keep being served from the last ingested state,
+	// flagged as stale, while ingestion is stale. If disabled, quoting errors instead.
+	Enabled bool `mapstructure:"enabled"`
+	// MaxIngestLagSecs is the max number of seconds that ingestion may lag behind the chain.
+	// Zero disables the staleness check.
+	MaxIngestLagSecs int `mapstructure:"max_ingest_lag_secs"`
+	// DefaultSlippageToleranceBps is the slippage tolerance in basis points recommended in quotes.
+	DefaultSlippageToleranceBps int `mapstructure:"default_slippage_tolerance_bps"`
+	// StaleSlippageToleranceBps is the widened slippage tolerance in basis points recommended in stale quotes.
+	StaleSlippageToleranceBps int `mapstructure:"stale_slippage_tolerance_bps"`
+}
+
+// IsStale returns true if the given ingest lag exceeds the max ingest lag.
+func (c StaleQuoteConfig) IsStale(ingestLag time.Duration) bool {
+	return c.MaxIngestLagSecs > 0 && ingestLag > time.Duration(c.MaxIngestLagSecs)*time.Second
+}
+
+// SlippageTolerance returns the slippage tolerance recommended in quotes that are stale or not.
+func (c StaleQuoteConfig) SlippageTolerance(isStale bool) osmomath.Dec {
+	slippageToleranceBps := c.DefaultSlippageToleranceBps
+	if isStale {
+		slippageToleranceBps = c.StaleSlippageToleranceBps
+	}
+	return osmomath.NewDec(int64(slippageToleranceBps)).QuoInt64(10_000)
+}
+
+// Validate returns error if the max ingest lag or the default slippage tolerance is negative
+// or if the stale slippage tolerance is narrower than the default one.
+func (c StaleQuoteConfig) Validate() error {
+	if c.MaxIngestLagSecs < 0 {
+		return fmt.Errorf("max ingest lag secs (%d) is negative", c.MaxIngestLagSecs)
+	}
+	if c.DefaultSlippageToleranceBps < 0 {
+		return fmt.Errorf("default slippage tolerance (%d bps) is negative", c.DefaultSlippageToleranceBps)
+	}
+	if c.StaleSlippageToleranceBps < c.DefaultSlippageToleranceBps {
+		return fmt.Errorf("stale slippage tolerance (%d bps) is narrower than the default slippage tolerance (%d bps)", c.StaleSlippageToleranceBps, c.DefaultSlippageToleranceBps)
+	}
+	return nil
+}
+
+// RouteRestrictions are the operator-defined constraints on the pools that candidate routes go through.
+type RouteRestrictions struct {
+	// ExcludedPoolIDs are the IDs of the pools that are never routed through, e.g. exploited or deprecated pools.
+	ExcludedPoolIDs []uint64 `mapstructure:"excluded_pool_ids" json:"excluded_pool_ids"`
+	// ExcludedDenoms are the denoms whose pools are never routed through.
+	ExcludedDenoms []string `mapstructure:"excluded_denoms" json:"excluded_denoms"`
+	// CanonicalPools are the pools that are exclusively routed through when swapping between their denoms.
+	CanonicalPools []CanonicalPool `mapstructure:"canonical_pools" json:"canonical_pools"`
+}
+
+// CanonicalPool pins the pool that a hop between the two denoms must go through, in either direction.
+type CanonicalPool struct {
+	Denom0 string `mapstructure:"denom0" json:"denom0"`
+	Denom1 string `mapstructure:"denom1" json:"denom1"`
+	PoolID uint64 `mapstructure:"pool_id" json:"pool_id"`
+}
+
+// canonicalPoolSeparator separates the fields of the string representation of a canonical pool.
+// It is not a valid denom character.
+const canonicalPoolSeparator = "|"
+
+// String returns the canonical pool in the denom0|denom1|poolID format of the canonical-pools config.
+func (p CanonicalPool) String() string {
+	return strings.Join([]string{p.Denom0, p.Denom1, strconv.FormatUint(p.PoolID, 10)}, canonicalPoolSeparator)
+}
+
+// ParseCanonicalPool parses a canonical pool from the denom0|denom1|poolID format.
+// Returns error if the format is invalid.
+func ParseCanonicalPool(s string) (CanonicalPool, error) {
+	parts := strings.Split(s, canonicalPoolSeparator)
+	if len(parts) != 3 {
+		return CanonicalPool{}, fmt.Errorf("canonical pool (%s) is not in the denom0%sdenom1%spoolID format", s, canonicalPoolSeparator, canonicalPoolSeparator)
+	}
+
+	poolID, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return CanonicalPool{}, fmt.Errorf("canonical pool (%s) has invalid pool ID: %w", s, err)
+	}
+
+	return CanonicalPool{Denom0: parts[0], Denom1: parts[1], PoolID: poolID}, nil
+}
+
+// Validate returns error if the route restrictions are inconsistent. That is, if
+// - a canonical pool has a zero pool ID, an empty denom or the same denom twice
+// - more than one canonical pool is pinned for the same denom pair
+// - a canonical pool is excluded by ID or by any of its pinned denoms
+func (r RouteRestrictions) Validate() error {
+	excludedPoolIDs := make(map[uint64]struct{}, len(r.ExcludedPoolIDs))
+	for _, poolID := range r.ExcludedPoolIDs {
+		excludedPoolIDs[poolID] = struct{}{}
+	}
+
+	excludedDenoms := make(map[string]struct{}, len(r.ExcludedDenoms))
+	for _, denom := range r.ExcludedDenoms {
+		if len(denom) == 0 {
+			return errors.New("excluded denom is empty")
+		}
+		excludedDenoms[denom] = struct{}{}
+	}
+
+	pinnedPairs := make(map[DenomPair]struct{}, len(r.CanonicalPools))
+	for _, canonicalPool := range r.CanonicalPools {
+		if canonicalPool.PoolID == 0 {
+			return fmt.Errorf("canonical pool (%s) has zero pool ID", canonicalPool)
+		}
+		if len(canonicalPool.Denom0) == 0 || len(canonicalPool.Denom1) == 0 {
+			return fmt.Errorf("canonical pool (%s) has empty denom", canonicalPool)
+		}
+		if canonicalPool.Denom0 == canonicalPool.Denom1 {
+			return fmt.Errorf("canonical pool (%s) has the same denom twice", canonicalPool)
+		}
+
+		pair := NewDenomPair(canonicalPool.Denom0, canonicalPool.Denom1)
+		if _, ok := pinnedPairs[pair]; ok {
+			return fmt.Errorf("more than one canonical pool is pinned for (%s) and (%s)", pair.Denom0, pair.Denom1)
+		}
+		pinnedPairs[pair] = struct{}{}
+
+		if _, ok := excludedPoolIDs[canonicalPool.PoolID]; ok {
+			return fmt.Errorf("canonical pool (%s) is excluded", canonicalPool)
+		}
+		for _, denom := range []string{canonicalPool.Denom0, canonicalPool.Denom1} {
+			if _, ok := excludedDenoms[denom]; ok {
+				return fmt.Errorf("canonical pool (%s) has excluded denom (%s)", canonicalPool, denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+const (
+	// MaxPriceImpactBpsQueryParam is the optional quote query parameter that sets QuoteFilter.MaxPriceImpactBps.
+	MaxPriceImpactBpsQueryParam = "maxPriceImpactBps"
+	// MinPoolLiquidityCapQueryParam is the optional quote query parameter that sets QuoteFilter.MinPoolLiquidityCap.
+	MinPoolLiquidityCapQueryParam = "minPoolLiquidityCap"
+)
+
+// QuoteFilter encapsulates the optional constraints that every route of a quote must satisfy.
+// A zero value disables the respective constraint.
+type QuoteFilter struct {
+	// MaxPriceImpactBps is the maximum price impact of a route in basis points.
+	MaxPriceImpactBps uint64
+	// Denominated in OSMO (not uosmo)
+	MinPoolLiquidityCap uint64
 }
 
 // DenomPair encapsulates a pair of denoms.
@@ -97,6 +292,16 @@ type DenomPair struct {
 	Denom1 string
 }
 
+// NewDenomPair returns the denom pair of the given denoms, sorted lexicographically.
+func NewDenomPair(denom0, denom1 string) DenomPair {
+	// Ensure increasing lexicographic order.
+	if denom1 < denom0 {
+		denom0, denom1 = denom1, denom0
+	}
+
+	return DenomPair{Denom0: denom0, Denom1: denom1}
+}
+
 // TakerFeeMap is a map of DenomPair to taker fee.
 // It sorts the denoms lexicographically before looking up the taker fee.
 type TakerFeeMap map[DenomPair]osmomath.Dec
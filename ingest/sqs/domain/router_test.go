@@ -0,0 +1,99 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+)
+
+// TestStaleQuoteConfig tests the staleness check and the recommended slippage tolerance of stale quotes.
+func TestStaleQuoteConfig(t *testing.T) {
+	config := domain.StaleQuoteConfig{
+		Enabled:                     true,
+		MaxIngestLagSecs:            30,
+		DefaultSlippageToleranceBps: 50,
+		StaleSlippageToleranceBps:   200,
+	}
+
+	require.False(t, config.IsStale(0))
+	require.False(t, config.IsStale(30*time.Second))
+	require.True(t, config.IsStale(31*time.Second))
+
+	require.Equal(t, osmomath.MustNewDecFromStr("0.005"), config.SlippageTolerance(false))
+	require.Equal(t, osmomath.MustNewDecFromStr("0.02"), config.SlippageTolerance(true))
+
+	// A zero max ingest lag disables the staleness check.
+	disabledConfig := config
+	disabledConfig.MaxIngestLagSecs = 0
+	require.False(t, disabledConfig.IsStale(time.Hour))
+}
+
+// TestStaleQuoteConfigValidate tests the validation of the stale quote config.
+func TestStaleQuoteConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		config        domain.StaleQuoteConfig
+		expectedError bool
+	}{
+		{"valid", domain.StaleQuoteConfig{MaxIngestLagSecs: 30, DefaultSlippageToleranceBps: 50, StaleSlippageToleranceBps: 200}, false},
+		{"valid: same slippage tolerance", domain.StaleQuoteConfig{MaxIngestLagSecs: 30, DefaultSlippageToleranceBps: 50, StaleSlippageToleranceBps: 50}, false},
+		{"valid: zero values", domain.StaleQuoteConfig{}, false},
+		{"negative max ingest lag", domain.StaleQuoteConfig{MaxIngestLagSecs: -1}, true},
+		{"negative default slippage tolerance", domain.StaleQuoteConfig{DefaultSlippageToleranceBps: -1}, true},
+		{"stale slippage tolerance narrower than default", domain.StaleQuoteConfig{DefaultSlippageToleranceBps: 50, StaleSlippageToleranceBps: 20}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.config.Validate()
+
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestOptimalRouteCacheConfigValidate tests the validation of the optimal route cache config.
+func TestOptimalRouteCacheConfigValidate(t *testing.T) {
+	require.NoError(t, domain.OptimalRouteCacheConfig{Enabled: true, MaxLiquidityChangeBps: 100}.Validate())
+	require.NoError(t, domain.OptimalRouteCacheConfig{}.Validate())
+	require.Error(t, domain.OptimalRouteCacheConfig{MaxLiquidityChangeBps: -1}.Validate())
+}
+
+// TestRouteRestrictionsValidate tests the validation of the route restrictions.
+func TestRouteRestrictionsValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		restrictions  domain.RouteRestrictions
+		expectedError bool
+	}{
+		{"valid", domain.RouteRestrictions{ExcludedPoolIDs: []uint64{1}, CanonicalPools: []domain.CanonicalPool{{Denom0: "uosmo", Denom1: "uion", PoolID: 2}}}, false},
+		{"valid: zero values", domain.RouteRestrictions{}, false},
+		{"canonical pool with zero pool ID", domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: "uosmo", Denom1: "uion"}}}, true},
+		{"canonical pool with the same denom twice", domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: "uosmo", Denom1: "uosmo", PoolID: 2}}}, true},
+		{"two canonical pools for the same pair", domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: "uosmo", Denom1: "uion", PoolID: 2}, {Denom0: "uion", Denom1: "uosmo", PoolID: 3}}}, true},
+		{"excluded canonical pool", domain.RouteRestrictions{ExcludedPoolIDs: []uint64{2}, CanonicalPools: []domain.CanonicalPool{{Denom0: "uosmo", Denom1: "uion", PoolID: 2}}}, true},
+		{"canonical pool with excluded denom", domain.RouteRestrictions{ExcludedDenoms: []string{"uion"}, CanonicalPools: []domain.CanonicalPool{{Denom0: "uosmo", Denom1: "uion", PoolID: 2}}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.restrictions.Validate()
+
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo"
+	"github.com/sirupsen/logrus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+)
+
+// ResponseError represent the response error struct
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+// PassthroughHandler  represent the httphandler for the passthrough queries
+type PassthroughHandler struct {
+	PTUsecase mvc.PassthroughUsecase
+}
+
+// NewPassthroughHandler will initialize the passthrough/ resources endpoint
+func NewPassthroughHandler(e mvc.HTTPRouter, ptu mvc.PassthroughUsecase) {
+	handler := &PassthroughHandler{
+		PTUsecase: ptu,
+	}
+	e.GET("/passthrough/balances/:address", handler.GetBalances)
+	e.GET("/passthrough/locks/:address", handler.GetLocks)
+	e.GET("/passthrough/concentrated-positions/:address", handler.GetConcentratedPositions)
+	e.GET("/passthrough/portfolio/:address", handler.GetPortfolio)
+}
+
+// GetBalances returns the bank balances of the given address.
+func (a *PassthroughHandler) GetBalances(c echo.Context) error {
+	address, err := getValidAddress(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	balances, err := a.PTUsecase.GetBalances(c.Request().Context(), address)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, balances)
+}
+
+// GetLocks returns the locked and unlocking locks of the given address.
+func (a *PassthroughHandler) GetLocks(c echo.Context) error {
+	address, err := getValidAddress(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	locks, err := a.PTUsecase.GetLocks(c.Request().Context(), address)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, locks)
+}
+
+// GetConcentratedPositions returns the concentrated liquidity positions of the given address
+// together with their claimable spread rewards and incentives.
+func (a *PassthroughHandler) GetConcentratedPositions(c echo.Context) error {
+	address, err := getValidAddress(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	positions, err := a.PTUsecase.GetConcentratedPositions(c.Request().Context(), address)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, positions)
+}
+
+// GetPortfolio returns the balances, locks and concentrated liquidity positions
+// of the given address in a single response.
+func (a *PassthroughHandler) GetPortfolio(c echo.Context) error {
+	address, err := getValidAddress(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	portfolio, err := a.PTUsecase.GetPortfolio(c.Request().Context(), address)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, portfolio)
+}
+
+// getValidAddress returns the address path parameter if it is a valid bech32 account address.
+func getValidAddress(c echo.Context) (string, error) {
+	address := c.Param("address")
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return "", fmt.Errorf("address (%s) is invalid: %w", address, err)
+	}
+	return address, nil
+}
+
+func getStatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	logrus.Error(err)
+
+	switch err {
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrNotFound:
+		return http.StatusNotFound
+	case domain.ErrConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
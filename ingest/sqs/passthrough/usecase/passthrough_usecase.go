@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+type passthroughUseCase struct {
+	bankQueryClient         banktypes.QueryClient
+	lockupQueryClient       lockuptypes.QueryClient
+	concentratedQueryClient queryproto.QueryClient
+	chainInfoUseCase        mvc.ChainInfoUsecase
+
+	balancesCache              *heightCache[sdk.Coins]
+	locksCache                 *heightCache[[]lockuptypes.PeriodLock]
+	concentratedPositionsCache *heightCache[[]clmodel.FullPositionBreakdown]
+}
+
+var _ mvc.PassthroughUsecase = &passthroughUseCase{}
+
+// NewPassthroughUsecase will create a new passthrough use case object
+func NewPassthroughUsecase(bankQueryClient banktypes.QueryClient, lockupQueryClient lockuptypes.QueryClient, concentratedQueryClient queryproto.QueryClient, chainInfoUseCase mvc.ChainInfoUsecase) mvc.PassthroughUsecase {
+	return &passthroughUseCase{
+		bankQueryClient:         bankQueryClient,
+		lockupQueryClient:       lockupQueryClient,
+		concentratedQueryClient: concentratedQueryClient,
+		chainInfoUseCase:        chainInfoUseCase,
+
+		balancesCache:              newHeightCache[sdk.Coins](),
+		locksCache:                 newHeightCache[[]lockuptypes.PeriodLock](),
+		concentratedPositionsCache: newHeightCache[[]clmodel.FullPositionBreakdown](),
+	}
+}
+
+// GetBalances implements mvc.PassthroughUsecase.
+func (p *passthroughUseCase) GetBalances(ctx context.Context, address string) (sdk.Coins, error) {
+	return getCached(ctx, p, p.balancesCache, address, func() (sdk.Coins, error) {
+		balances := sdk.Coins{}
+
+		var nextKey []byte
+		for {
+			response, err := p.bankQueryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+				Address:    address,
+				Pagination: &query.PageRequest{Key: nextKey},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			balances = balances.Add(response.Balances...)
+
+			if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+				return balances, nil
+			}
+			nextKey = response.Pagination.NextKey
+		}
+	})
+}
+
+// GetLocks implements mvc.PassthroughUsecase.
+func (p *passthroughUseCase) GetLocks(ctx context.Context, address string) ([]lockuptypes.PeriodLock, error) {
+	return getCached(ctx, p, p.locksCache, address, func() ([]lockuptypes.PeriodLock, error) {
+		// Zero duration matches all locks of the account, including the unlocking ones.
+		response, err := p.lockupQueryClient.AccountLockedLongerDuration(ctx, &lockuptypes.AccountLockedLongerDurationRequest{
+			Owner: address,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return response.Locks, nil
+	})
+}
+
+// GetConcentratedPositions implements mvc.PassthroughUsecase.
+func (p *passthroughUseCase) GetConcentratedPositions(ctx context.Context, address string) ([]clmodel.FullPositionBreakdown, error) {
+	return getCached(ctx, p, p.concentratedPositionsCache, address, func() ([]clmodel.FullPositionBreakdown, error) {
+		positions := []clmodel.FullPositionBreakdown{}
+
+		var nextKey []byte
+		for {
+			// Zero pool ID matches the positions in all pools.
+			response, err := p.concentratedQueryClient.UserPositions(ctx, &queryproto.UserPositionsRequest{
+				Address:    address,
+				Pagination: &query.PageRequest{Key: nextKey},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			positions = append(positions, response.Positions...)
+
+			if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+				return positions, nil
+			}
+			nextKey = response.Pagination.NextKey
+		}
+	})
+}
+
+// GetPortfolio implements mvc.PassthroughUsecase.
+func (p *passthroughUseCase) GetPortfolio(ctx context.Context, address string) (domain.AccountPortfolio, error) {
+	balances, err := p.GetBalances(ctx, address)
+	if err != nil {
+		return domain.AccountPortfolio{}, err
+	}
+
+	locks, err := p.GetLocks(ctx, address)
+	if err != nil {
+		return domain.AccountPortfolio{}, err
+	}
+
+	positions, err := p.GetConcentratedPositions(ctx, address)
+	if err != nil {
+		return domain.AccountPortfolio{}, err
+	}
+
+	totalClaimable := sdk.NewCoins()
+	for _, position := range positions {
+		totalClaimable = totalClaimable.Add(position.ClaimableSpreadRewards...).Add(position.ClaimableIncentives...)
+	}
+
+	return domain.AccountPortfolio{
+		Balances:              balances,
+		Locks:                 locks,
+		ConcentratedPositions: positions,
+		TotalClaimable:        totalClaimable,
+	}, nil
+}
+
+// getCached returns the value cached for the given address at the latest ingested height.
+// If there is none, it is queried from the node and cached.
+func getCached[T any](ctx context.Context, p *passthroughUseCase, cache *heightCache[T], address string, queryFn func() (T, error)) (T, error) {
+	height, err := p.chainInfoUseCase.GetIngestedHeight(ctx, 0)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	if value, ok := cache.get(height, address); ok {
+		return value, nil
+	}
+
+	value, err := queryFn()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	cache.set(height, address, value)
+
+	return value, nil
+}
+
+// heightCache caches values by key until the next ingested height.
+type heightCache[T any] struct {
+	// mu guards the fields below.
+	mu     sync.Mutex
+	height uint64
+	values map[string]T
+}
+
+func newHeightCache[T any]() *heightCache[T] {
+	return &heightCache[T]{
+		values: make(map[string]T),
+	}
+}
+
+// get returns the value cached for the given key if it was cached at the given height.
+func (c *heightCache[T]) get(height uint64, key string) (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height != c.height {
+		var zero T
+		return zero, false
+	}
+
+	value, ok := c.values[key]
+	return value, ok
+}
+
+// set caches the value for the given key at the given height.
+// The values cached at a lower height are evicted. Values for a height lower
+// than that of the cache are not cached.
+func (c *heightCache[T]) set(height uint64, key string, value T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if height < c.height {
+		return
+	}
+
+	if height > c.height {
+		c.height = height
+		c.values = make(map[string]T)
+	}
+
+	c.values[key] = value
+}
@@ -0,0 +1,126 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/passthrough/usecase"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+const defaultAddress = "osmo1test"
+
+// bankQueryClientMock serves the balances in pages of a single coin.
+type bankQueryClientMock struct {
+	banktypes.QueryClient
+	balances  sdk.Coins
+	callCount int
+}
+
+func (b *bankQueryClientMock) AllBalances(ctx context.Context, req *banktypes.QueryAllBalancesRequest, opts ...grpc.CallOption) (*banktypes.QueryAllBalancesResponse, error) {
+	b.callCount++
+
+	page := 0
+	if req.Pagination != nil && len(req.Pagination.Key) > 0 {
+		page = int(req.Pagination.Key[0])
+	}
+
+	response := &banktypes.QueryAllBalancesResponse{
+		Balances:   sdk.NewCoins(b.balances[page]),
+		Pagination: &query.PageResponse{},
+	}
+	if page+1 < len(b.balances) {
+		response.Pagination.NextKey = []byte{byte(page + 1)}
+	}
+	return response, nil
+}
+
+type lockupQueryClientMock struct {
+	lockuptypes.QueryClient
+	locks []lockuptypes.PeriodLock
+}
+
+func (l *lockupQueryClientMock) AccountLockedLongerDuration(ctx context.Context, req *lockuptypes.AccountLockedLongerDurationRequest, opts ...grpc.CallOption) (*lockuptypes.AccountLockedLongerDurationResponse, error) {
+	return &lockuptypes.AccountLockedLongerDurationResponse{Locks: l.locks}, nil
+}
+
+type concentratedQueryClientMock struct {
+	queryproto.QueryClient
+	positions []clmodel.FullPositionBreakdown
+}
+
+func (c *concentratedQueryClientMock) UserPositions(ctx context.Context, req *queryproto.UserPositionsRequest, opts ...grpc.CallOption) (*queryproto.UserPositionsResponse, error) {
+	return &queryproto.UserPositionsResponse{Positions: c.positions}, nil
+}
+
+// Tests that the portfolio aggregates the paginated balances, the locks and the positions
+// together with their total claimable rewards.
+func TestGetPortfolio(t *testing.T) {
+	var (
+		balances = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uosmo", 20))
+		locks    = []lockuptypes.PeriodLock{{ID: 1, Owner: defaultAddress, Coins: sdk.NewCoins(sdk.NewInt64Coin("gamm/pool/1", 100))}}
+
+		positions = []clmodel.FullPositionBreakdown{
+			{
+				Position:               clmodel.Position{PositionId: 1},
+				ClaimableSpreadRewards: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1)),
+				ClaimableIncentives:    sdk.NewCoins(sdk.NewInt64Coin("uion", 2)),
+			},
+			{
+				Position:               clmodel.Position{PositionId: 2},
+				ClaimableSpreadRewards: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 3)),
+			},
+		}
+	)
+
+	passthroughUseCase := usecase.NewPassthroughUsecase(
+		&bankQueryClientMock{balances: balances},
+		&lockupQueryClientMock{locks: locks},
+		&concentratedQueryClientMock{positions: positions},
+		&mocks.ChainInfoUsecaseMock{LatestHeight: 1},
+	)
+
+	portfolio, err := passthroughUseCase.GetPortfolio(context.Background(), defaultAddress)
+	require.NoError(t, err)
+
+	require.Equal(t, domain.AccountPortfolio{
+		Balances:              balances,
+		Locks:                 locks,
+		ConcentratedPositions: positions,
+		TotalClaimable:        sdk.NewCoins(sdk.NewInt64Coin("uion", 2), sdk.NewInt64Coin("uosmo", 4)),
+	}, portfolio)
+}
+
+// Tests that the query results are cached until the next ingested height.
+func TestGetBalances_CachedPerHeight(t *testing.T) {
+	bankQueryClient := &bankQueryClientMock{balances: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 20))}
+	chainInfoUseCase := &mocks.ChainInfoUsecaseMock{LatestHeight: 1}
+
+	passthroughUseCase := usecase.NewPassthroughUsecase(bankQueryClient, &lockupQueryClientMock{}, &concentratedQueryClientMock{}, chainInfoUseCase)
+
+	_, err := passthroughUseCase.GetBalances(context.Background(), defaultAddress)
+	require.NoError(t, err)
+	require.Equal(t, 1, bankQueryClient.callCount)
+
+	// Cached at the same height.
+	_, err = passthroughUseCase.GetBalances(context.Background(), defaultAddress)
+	require.NoError(t, err)
+	require.Equal(t, 1, bankQueryClient.callCount)
+
+	// Queried again at the next height.
+	chainInfoUseCase.LatestHeight = 2
+
+	_, err = passthroughUseCase.GetBalances(context.Background(), defaultAddress)
+	require.NoError(t, err)
+	require.Equal(t, 2, bankQueryClient.callCount)
+}
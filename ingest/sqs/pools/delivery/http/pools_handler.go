@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo"
 	"github.com/sirupsen/logrus"
@@ -17,13 +18,15 @@ type ResponseError struct {
 
 // PoolsHandler  represent the httphandler for pools
 type PoolsHandler struct {
-	PUsecase mvc.PoolsUsecase
+	PUsecase  mvc.PoolsUsecase
+	CIUsecase mvc.ChainInfoUsecase
 }
 
 // NewPoolsHandler will initialize the pools/ resources endpoint
-func NewPoolsHandler(e *echo.Echo, us mvc.PoolsUsecase) {
+func NewPoolsHandler(e mvc.HTTPRouter, us mvc.PoolsUsecase, ciu mvc.ChainInfoUsecase) {
 	handler := &PoolsHandler{
-		PUsecase: us,
+		PUsecase:  us,
+		CIUsecase: ciu,
 	}
 	e.GET("/all-pools", handler.GetAllPools)
 }
@@ -33,6 +36,10 @@ func NewPoolsHandler(e *echo.Echo, us mvc.PoolsUsecase) {
 func (a *PoolsHandler) GetAllPools(c echo.Context) error {
 	ctx := c.Request().Context()
 
+	if err := a.setIngestedHeight(c); err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
 	pools, err := a.PUsecase.GetAllPools(ctx)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
@@ -41,12 +48,40 @@ func (a *PoolsHandler) GetAllPools(c echo.Context) error {
 	return c.JSON(http.StatusOK, pools)
 }
 
+// setIngestedHeight sets the latest ingested block height in the response header.
+// Returns domain.InvalidQueryParamError if the optional min block height query parameter supplied
+// by the client is invalid, and domain.MinBlockHeightNotReachedError if the ingested height is below it.
+func (a *PoolsHandler) setIngestedHeight(c echo.Context) error {
+	minBlockHeight, err := domain.ParseMinBlockHeight(c.QueryParam(domain.MinBlockHeightQueryParam))
+	if err != nil {
+		return err
+	}
+
+	blockHeight, err := a.CIUsecase.GetIngestedHeight(c.Request().Context(), minBlockHeight)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(domain.BlockHeightHeader, strconv.FormatUint(blockHeight, 10))
+
+	return nil
+}
+
 func getStatusCode(err error) int {
 	if err == nil {
 		return http.StatusOK
 	}
 
 	logrus.Error(err)
+
+	if _, ok := err.(domain.InvalidQueryParamError); ok {
+		return http.StatusBadRequest
+	}
+
+	if _, ok := err.(domain.MinBlockHeightNotReachedError); ok {
+		return http.StatusConflict
+	}
+
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
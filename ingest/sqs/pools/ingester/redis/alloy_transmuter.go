@@ -0,0 +1,183 @@
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cosmwasmutils "github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	cwpoolmodel "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/model"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+type (
+	getTotalPoolLiquidityQueryMsg struct {
+		GetTotalPoolLiquidity struct{} `json:"get_total_pool_liquidity"`
+	}
+	getTotalPoolLiquidityResponse struct {
+		TotalPoolLiquidity sdk.Coins `json:"total_pool_liquidity"`
+	}
+
+	getAssetConfigsQueryMsg struct {
+		GetAssetConfigs struct{} `json:"get_asset_configs"`
+	}
+	getAssetConfigsResponse struct {
+		AssetConfigs []struct {
+			Denom               string       `json:"denom"`
+			NormalizationFactor osmomath.Int `json:"normalization_factor"`
+		} `json:"asset_configs"`
+	}
+
+	listLimitersQueryMsg struct {
+		ListLimiters struct{} `json:"list_limiters"`
+	}
+	// Every limiter is a tuple of its (denom, label) key and the limiter itself.
+	listLimitersResponse struct {
+		Limiters [][2]json.RawMessage `json:"limiters"`
+	}
+	limiter struct {
+		StaticLimiter *struct {
+			UpperLimit osmomath.Dec `json:"upper_limit"`
+		} `json:"static_limiter,omitempty"`
+	}
+)
+
+// getAlloyTransmuterModel returns the alloy transmuter model of the given pool if it is a CosmWasm pool
+// instantiated from one of the whitelisted alloyed transmuter code IDs. Returns nil otherwise,
+// including when any of the contract queries fails, in which case the pool is ingested as a regular
+// transmuter pool until the next successful ingest.
+// Note that this must precede the conversion of the pool to its serializable form
+// since the queries require the wasm keeper.
+func (pi *poolIngester) getAlloyTransmuterModel(ctx sdk.Context, pool poolmanagertypes.PoolI) *domain.AlloyTransmuterModel {
+	if pool.GetType() != poolmanagertypes.CosmWasm {
+		return nil
+	}
+
+	cosmWasmPool, ok := pool.(*cwpoolmodel.Pool)
+	if !ok {
+		return nil
+	}
+
+	if _, isAlloyTransmuter := pi.alloyTransmuterCodeIDs[cosmWasmPool.CodeId]; !isAlloyTransmuter {
+		return nil
+	}
+
+	model, err := queryAlloyTransmuterModel(ctx, cosmWasmPool)
+	if err != nil {
+		pi.logger.Error("error getting alloy transmuter state", zap.Uint64("pool_id", pool.GetId()), zap.Error(err))
+		return nil
+	}
+
+	return model
+}
+
+// queryAlloyTransmuterModel queries the liquidity, asset configs and limiters of the alloyed transmuter contract
+// of the given pool and computes its model from them.
+func queryAlloyTransmuterModel(ctx sdk.Context, cosmWasmPool *cwpoolmodel.Pool) (*domain.AlloyTransmuterModel, error) {
+	liquidity, err := cosmwasmutils.Query[getTotalPoolLiquidityQueryMsg, getTotalPoolLiquidityResponse](ctx, cosmWasmPool.WasmKeeper, cosmWasmPool.ContractAddress, getTotalPoolLiquidityQueryMsg{})
+	if err != nil {
+		return nil, err
+	}
+
+	assetConfigs, err := cosmwasmutils.Query[getAssetConfigsQueryMsg, getAssetConfigsResponse](ctx, cosmWasmPool.WasmKeeper, cosmWasmPool.ContractAddress, getAssetConfigsQueryMsg{})
+	if err != nil {
+		return nil, err
+	}
+
+	normalizationFactors := make(map[string]osmomath.Int, len(assetConfigs.AssetConfigs))
+	for _, config := range assetConfigs.AssetConfigs {
+		normalizationFactors[config.Denom] = config.NormalizationFactor
+	}
+
+	limiters, err := cosmwasmutils.Query[listLimitersQueryMsg, listLimitersResponse](ctx, cosmWasmPool.WasmKeeper, cosmWasmPool.ContractAddress, listLimitersQueryMsg{})
+	if err != nil {
+		return nil, err
+	}
+
+	upperLimits, err := parseStaticUpperLimits(limiters.Limiters)
+	if err != nil {
+		return nil, err
+	}
+
+	return computeAlloyTransmuterModel(liquidity.TotalPoolLiquidity, normalizationFactors, upperLimits)
+}
+
+// parseStaticUpperLimits returns the tightest static limiter upper limit of every denom that has one.
+// Other limiter types are skipped.
+func parseStaticUpperLimits(limiters [][2]json.RawMessage) (map[string]osmomath.Dec, error) {
+	upperLimits := make(map[string]osmomath.Dec)
+	for _, entry := range limiters {
+		var key [2]string
+		if err := json.Unmarshal(entry[0], &key); err != nil {
+			return nil, fmt.Errorf("failed to parse limiter key %s: %w", entry[0], err)
+		}
+
+		var l limiter
+		if err := json.Unmarshal(entry[1], &l); err != nil {
+			return nil, fmt.Errorf("failed to parse limiter %s: %w", entry[1], err)
+		}
+		if l.StaticLimiter == nil {
+			continue
+		}
+
+		denom := key[0]
+		if current, ok := upperLimits[denom]; !ok || l.StaticLimiter.UpperLimit.LT(current) {
+			upperLimits[denom] = l.StaticLimiter.UpperLimit
+		}
+	}
+	return upperLimits, nil
+}
+
+// computeAlloyTransmuterModel computes the weight of every asset of the pool in its normalized liquidity
+// and, for the assets with a static limiter, the amount that can be swapped in before reaching the limit.
+// Since swaps exchange assets one-to-one in normalized terms, they keep the normalized liquidity constant,
+// so the remaining capacity of an asset is (upperLimit * totalNormalized - normalizedAmount) * normalizationFactor.
+// Returns error if an asset has no positive normalization factor.
+func computeAlloyTransmuterModel(liquidity sdk.Coins, normalizationFactors map[string]osmomath.Int, upperLimits map[string]osmomath.Dec) (*domain.AlloyTransmuterModel, error) {
+	normalizedAmounts := make([]osmomath.Dec, len(liquidity))
+	totalNormalized := osmomath.ZeroDec()
+	for i, coin := range liquidity {
+		normalizationFactor, ok := normalizationFactors[coin.Denom]
+		if !ok || normalizationFactor.IsNil() || !normalizationFactor.IsPositive() {
+			return nil, fmt.Errorf("no positive normalization factor for denom (%s)", coin.Denom)
+		}
+
+		normalizedAmounts[i] = coin.Amount.ToLegacyDec().QuoInt(normalizationFactor)
+		totalNormalized = totalNormalized.Add(normalizedAmounts[i])
+	}
+
+	assets := make([]domain.AlloyTransmuterAsset, 0, len(liquidity))
+	for i, coin := range liquidity {
+		normalizationFactor := normalizationFactors[coin.Denom]
+
+		weight := osmomath.ZeroDec()
+		if totalNormalized.IsPositive() {
+			weight = normalizedAmounts[i].Quo(totalNormalized)
+		}
+
+		asset := domain.AlloyTransmuterAsset{
+			Denom:               coin.Denom,
+			Amount:              coin.Amount,
+			NormalizationFactor: normalizationFactor,
+			Weight:              weight,
+		}
+
+		if upperLimit, ok := upperLimits[coin.Denom]; ok {
+			remainingCapacity := osmomath.ZeroInt()
+			if remainingNormalized := upperLimit.Mul(totalNormalized).Sub(normalizedAmounts[i]); remainingNormalized.IsPositive() {
+				remainingCapacity = remainingNormalized.MulInt(normalizationFactor).TruncateInt()
+			}
+
+			asset.UpperLimit = &upperLimit
+			asset.RemainingCapacity = &remainingCapacity
+		}
+
+		assets = append(assets, asset)
+	}
+
+	return &domain.AlloyTransmuterModel{Assets: assets}, nil
+}
@@ -0,0 +1,75 @@
+package redis_test
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	redisingester "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/ingester/redis"
+)
+
+// Tests that only static limiters are parsed and that the tightest one is kept per denom.
+func (s *IngesterTestSuite) TestParseStaticUpperLimits() {
+	var limiters [][2]json.RawMessage
+	err := json.Unmarshal([]byte(`[
+		[["usdc", "static"], {"static_limiter": {"upper_limit": "0.7"}}],
+		[["usdc", "tighter"], {"static_limiter": {"upper_limit": "0.6"}}],
+		[["usdt", "1h"], {"change_limiter": {"boundary_offset": "0.2", "latest_value": "0.5", "window_config": {"window_size": "3600000000000", "division_count": "5"}, "divisions": []}}]
+	]`), &limiters)
+	s.Require().NoError(err)
+
+	upperLimits, err := redisingester.ParseStaticUpperLimits(limiters)
+	s.Require().NoError(err)
+	s.Require().Len(upperLimits, 1)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.6"), upperLimits[USDC])
+
+	_, err = redisingester.ParseStaticUpperLimits([][2]json.RawMessage{{json.RawMessage(`"usdc"`), json.RawMessage(`{}`)}})
+	s.Require().Error(err)
+}
+
+func (s *IngesterTestSuite) TestComputeAlloyTransmuterModel() {
+	// USDT has 12 decimals while USDC and USDW have 6, so its amount is divided by 10^6 when normalized.
+	liquidity := sdk.NewCoins(
+		sdk.NewCoin(USDC, osmomath.NewInt(300_000_000)),
+		sdk.NewCoin(USDT, osmomath.NewInt(600_000_000_000_000)),
+		sdk.NewCoin(USDW, osmomath.NewInt(100_000_000)),
+	)
+	normalizationFactors := map[string]osmomath.Int{
+		USDC: osmomath.OneInt(),
+		USDT: osmomath.NewInt(1_000_000),
+		USDW: osmomath.OneInt(),
+	}
+	upperLimits := map[string]osmomath.Dec{
+		// 400 out of 1000 normalized, so 100 USDC can be swapped in.
+		USDC: osmomath.MustNewDecFromStr("0.4"),
+		// Already above the limit, so no USDT can be swapped in.
+		USDT: osmomath.MustNewDecFromStr("0.5"),
+	}
+
+	model, err := redisingester.ComputeAlloyTransmuterModel(liquidity, normalizationFactors, upperLimits)
+	s.Require().NoError(err)
+	s.Require().Len(model.Assets, 3)
+
+	usdc, found := model.GetAsset(USDC)
+	s.Require().True(found)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.3"), usdc.Weight)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.4"), *usdc.UpperLimit)
+	s.Require().Equal(osmomath.NewInt(100_000_000), *usdc.RemainingCapacity)
+
+	usdt, found := model.GetAsset(USDT)
+	s.Require().True(found)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.6"), usdt.Weight)
+	s.Require().Equal(osmomath.ZeroInt(), *usdt.RemainingCapacity)
+
+	usdw, found := model.GetAsset(USDW)
+	s.Require().True(found)
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.1"), usdw.Weight)
+	s.Require().Nil(usdw.UpperLimit)
+	s.Require().Nil(usdw.RemainingCapacity)
+
+	// Every asset must have a normalization factor.
+	delete(normalizationFactors, USDW)
+	_, err = redisingester.ComputeAlloyTransmuterModel(liquidity, normalizationFactors, upperLimits)
+	s.Require().Error(err)
+}
@@ -1,8 +1,11 @@
 package redis
 
 import (
+	"encoding/json"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/common"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -27,3 +30,19 @@ func (pi *poolIngester) ConvertPool(ctx sdk.Context, pool poolmanagertypes.PoolI
 func RetrieveTakerFeeToMapIfNotExists(ctx sdk.Context, denoms []string, denomPairToTakerFeeMap domain.TakerFeeMap, poolManagerKeeper common.PoolManagerKeeper) error {
 	return retrieveTakerFeeToMapIfNotExists(ctx, denoms, denomPairToTakerFeeMap, poolManagerKeeper)
 }
+
+func SortOrderbookLevels(levels []domain.OrderbookLevel, isDescending bool) []domain.OrderbookLevel {
+	return sortOrderbookLevels(levels, isDescending)
+}
+
+func IsValidOrderbookDenoms(orderbook domain.OrderbookModel, poolDenoms []string) bool {
+	return isValidOrderbookDenoms(orderbook, poolDenoms)
+}
+
+func ParseStaticUpperLimits(limiters [][2]json.RawMessage) (map[string]osmomath.Dec, error) {
+	return parseStaticUpperLimits(limiters)
+}
+
+func ComputeAlloyTransmuterModel(liquidity sdk.Coins, normalizationFactors map[string]osmomath.Int, upperLimits map[string]osmomath.Dec) (*domain.AlloyTransmuterModel, error) {
+	return computeAlloyTransmuterModel(liquidity, normalizationFactors, upperLimits)
+}
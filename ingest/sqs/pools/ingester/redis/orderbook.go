@@ -0,0 +1,88 @@
+package redis
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+
+	cosmwasmutils "github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	cwpoolmodel "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/model"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// orderbookLevelsQueryMsg queries an orderbook contract for its resting limit orders
+// aggregated by price level.
+type orderbookLevelsQueryMsg struct {
+	OrderbookLevels struct{} `json:"orderbook_levels"`
+}
+
+// getOrderbookModel returns the orderbook model of the given pool if it is a CosmWasm pool
+// instantiated from one of the whitelisted orderbook code IDs. Returns nil otherwise.
+// If the contract query fails, an orderbook model with no orders is returned
+// so that the pool is not routed through until the next successful ingest.
+// Note that this must precede the conversion of the pool to its serializable form
+// since the query requires the wasm keeper.
+func (pi *poolIngester) getOrderbookModel(ctx sdk.Context, pool poolmanagertypes.PoolI, poolDenoms []string) *domain.OrderbookModel {
+	if pool.GetType() != poolmanagertypes.CosmWasm {
+		return nil
+	}
+
+	cosmWasmPool, ok := pool.(*cwpoolmodel.Pool)
+	if !ok {
+		return nil
+	}
+
+	if _, isOrderbook := pi.orderbookCodeIDs[cosmWasmPool.CodeId]; !isOrderbook {
+		return nil
+	}
+
+	orderbook, err := cosmwasmutils.Query[orderbookLevelsQueryMsg, domain.OrderbookModel](ctx, cosmWasmPool.WasmKeeper, cosmWasmPool.ContractAddress, orderbookLevelsQueryMsg{})
+	if err != nil || !isValidOrderbookDenoms(orderbook, poolDenoms) {
+		pi.logger.Error("error getting orderbook levels", zap.Uint64("pool_id", pool.GetId()), zap.Error(err))
+		return &domain.OrderbookModel{}
+	}
+
+	orderbook.Bids = sortOrderbookLevels(orderbook.Bids, true)
+	orderbook.Asks = sortOrderbookLevels(orderbook.Asks, false)
+
+	return &orderbook
+}
+
+// isValidOrderbookDenoms returns true if the base and quote denoms of the orderbook
+// are the two denoms of the pool.
+func isValidOrderbookDenoms(orderbook domain.OrderbookModel, poolDenoms []string) bool {
+	if len(poolDenoms) != 2 || orderbook.BaseDenom == orderbook.QuoteDenom {
+		return false
+	}
+
+	for _, denom := range []string{orderbook.BaseDenom, orderbook.QuoteDenom} {
+		if denom != poolDenoms[0] && denom != poolDenoms[1] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sortOrderbookLevels removes the levels with a non-positive price or quantity
+// and sorts the rest by price in descending order if isDescending is true. Ascending otherwise.
+func sortOrderbookLevels(levels []domain.OrderbookLevel, isDescending bool) []domain.OrderbookLevel {
+	validLevels := make([]domain.OrderbookLevel, 0, len(levels))
+	for _, level := range levels {
+		if level.Price.IsNil() || level.Quantity.IsNil() || !level.Price.IsPositive() || !level.Quantity.IsPositive() {
+			continue
+		}
+		validLevels = append(validLevels, level)
+	}
+
+	sort.SliceStable(validLevels, func(i, j int) bool {
+		if isDescending {
+			return validLevels[i].Price.GT(validLevels[j].Price)
+		}
+		return validLevels[i].Price.LT(validLevels[j].Price)
+	})
+
+	return validLevels
+}
@@ -0,0 +1,61 @@
+package redis_test
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	redisingester "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/ingester/redis"
+)
+
+// Tests that the ingested orderbook levels are sorted from the best price
+// and that the levels with no orders are removed.
+func (s *IngesterTestSuite) TestSortOrderbookLevels() {
+	level := func(price, quantity int64) domain.OrderbookLevel {
+		return domain.OrderbookLevel{Price: osmomath.NewDec(price), Quantity: osmomath.NewInt(quantity)}
+	}
+
+	levels := []domain.OrderbookLevel{
+		level(2, 5),
+		level(3, 0),
+		level(1, 7),
+		level(0, 2),
+		level(4, 1),
+	}
+
+	// Bids
+	s.Require().Equal([]domain.OrderbookLevel{level(4, 1), level(2, 5), level(1, 7)}, redisingester.SortOrderbookLevels(levels, true))
+
+	// Asks
+	s.Require().Equal([]domain.OrderbookLevel{level(1, 7), level(2, 5), level(4, 1)}, redisingester.SortOrderbookLevels(levels, false))
+}
+
+func (s *IngesterTestSuite) TestIsValidOrderbookDenoms() {
+	tests := map[string]struct {
+		orderbook  domain.OrderbookModel
+		poolDenoms []string
+		expected   bool
+	}{
+		"valid": {
+			orderbook:  domain.OrderbookModel{BaseDenom: USDT, QuoteDenom: USDC},
+			poolDenoms: []string{USDC, USDT},
+			expected:   true,
+		},
+		"base denom not in pool": {
+			orderbook:  domain.OrderbookModel{BaseDenom: USDW, QuoteDenom: USDC},
+			poolDenoms: []string{USDC, USDT},
+		},
+		"same base and quote denoms": {
+			orderbook:  domain.OrderbookModel{BaseDenom: USDC, QuoteDenom: USDC},
+			poolDenoms: []string{USDC, USDT},
+		},
+		"more than two pool denoms": {
+			orderbook:  domain.OrderbookModel{BaseDenom: USDT, QuoteDenom: USDC},
+			poolDenoms: []string{USDC, USDT, USDW},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Require().Equal(tc.expected, redisingester.IsValidOrderbookDenoms(tc.orderbook, tc.poolDenoms))
+		})
+	}
+}
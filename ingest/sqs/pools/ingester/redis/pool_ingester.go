@@ -46,6 +46,11 @@ type poolIngester struct {
 	logger             log.Logger
 
 	routerConfig domain.RouterConfig
+
+	// orderbookCodeIDs is the set of whitelisted orderbook contract code IDs.
+	orderbookCodeIDs map[uint64]struct{}
+	// alloyTransmuterCodeIDs is the set of whitelisted alloyed transmuter contract code IDs.
+	alloyTransmuterCodeIDs map[uint64]struct{}
 }
 
 // denomRoutingInfo encapsulates the routing information for a pool.
@@ -71,18 +76,30 @@ var uosmoPrecisionBigDec = osmomath.NewBigDec(uosmoPrecision)
 
 // NewPoolIngester returns a new pool ingester.
 func NewPoolIngester(poolsRepository mvc.PoolsRepository, routerRepository mvc.RouterRepository, tokensUseCase domain.TokensUsecase, repositoryManager mvc.TxManager, routerConfig domain.RouterConfig, keepers common.SQSIngestKeepers) mvc.AtomicIngester {
+	orderbookCodeIDs := make(map[uint64]struct{}, len(routerConfig.OrderbookCodeIDs))
+	for _, codeID := range routerConfig.OrderbookCodeIDs {
+		orderbookCodeIDs[codeID] = struct{}{}
+	}
+
+	alloyTransmuterCodeIDs := make(map[uint64]struct{}, len(routerConfig.AlloyTransmuterCodeIDs))
+	for _, codeID := range routerConfig.AlloyTransmuterCodeIDs {
+		alloyTransmuterCodeIDs[codeID] = struct{}{}
+	}
+
 	return &poolIngester{
-		poolsRepository:    poolsRepository,
-		routerRepository:   routerRepository,
-		tokensUseCase:      tokensUseCase,
-		repositoryManager:  repositoryManager,
-		gammKeeper:         keepers.GammKeeper,
-		concentratedKeeper: keepers.ConcentratedKeeper,
-		cosmWasmKeeper:     keepers.CosmWasmPoolKeeper,
-		bankKeeper:         keepers.BankKeeper,
-		protorevKeeper:     keepers.ProtorevKeeper,
-		poolManagerKeeper:  keepers.PoolManagerKeeper,
-		routerConfig:       routerConfig,
+		poolsRepository:        poolsRepository,
+		routerRepository:       routerRepository,
+		tokensUseCase:          tokensUseCase,
+		repositoryManager:      repositoryManager,
+		gammKeeper:             keepers.GammKeeper,
+		concentratedKeeper:     keepers.ConcentratedKeeper,
+		cosmWasmKeeper:         keepers.CosmWasmPoolKeeper,
+		bankKeeper:             keepers.BankKeeper,
+		protorevKeeper:         keepers.ProtorevKeeper,
+		poolManagerKeeper:      keepers.PoolManagerKeeper,
+		routerConfig:           routerConfig,
+		orderbookCodeIDs:       orderbookCodeIDs,
+		alloyTransmuterCodeIDs: alloyTransmuterCodeIDs,
 	}
 }
 
@@ -272,7 +289,10 @@ func (pi *poolIngester) convertPool(
 
 	spreadFactor := pool.GetSpreadFactor(ctx)
 
-	// Note that this must follow the call to GetPoolDenoms() and GetSpreadFactor.
+	orderbook := pi.getOrderbookModel(ctx, pool, poolDenoms)
+	alloyTransmuter := pi.getAlloyTransmuterModel(ctx, pool)
+
+	// Note that this must follow the call to GetPoolDenoms(), GetSpreadFactor, getOrderbookModel and getAlloyTransmuterModel.
 	// Otherwise, the CosmWasmPool model panics.
 	pool = pool.AsSerializablePool()
 
@@ -384,6 +404,8 @@ func (pi *poolIngester) convertPool(
 			Balances:              balances,
 			PoolDenoms:            denoms,
 			SpreadFactor:          spreadFactor,
+			Orderbook:             orderbook,
+			AlloyTransmuter:       alloyTransmuter,
 		},
 		TickModel: tickModel,
 	}, nil
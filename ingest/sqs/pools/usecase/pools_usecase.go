@@ -42,6 +42,14 @@ func (p *poolsUseCase) GetAllPools(ctx context.Context) ([]domain.PoolI, error)
 	return pools, nil
 }
 
+// GetPools implements mvc.PoolsUsecase.
+func (p *poolsUseCase) GetPools(ctx context.Context, poolIDs map[uint64]struct{}) (map[uint64]domain.PoolI, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.contextTimeout)
+	defer cancel()
+
+	return p.poolsRepository.GetPools(ctx, poolIDs)
+}
+
 // GetRoutesFromCandidates implements mvc.PoolsUsecase.
 func (p *poolsUseCase) GetRoutesFromCandidates(ctx context.Context, candidateRoutes route.CandidateRoutes, takerFeeMap domain.TakerFeeMap, tokenInDenom, tokenOutDenom string) ([]route.RouteImpl, error) {
 	// Get all pools
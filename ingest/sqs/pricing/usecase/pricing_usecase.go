@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+)
+
+// pricePair is the cache key of the price of the base denom in the quote denom.
+type pricePair struct {
+	baseDenom  string
+	quoteDenom string
+}
+
+type pricingUseCase struct {
+	routerUseCase    mvc.RouterUsecase
+	chainInfoUseCase mvc.ChainInfoUsecase
+	tokensUseCase    domain.TokensUsecase
+	config           domain.PricingConfig
+	logger           log.Logger
+
+	// mu guards the fields below.
+	mu sync.Mutex
+	// cacheHeight is the ingested height that the cached prices were computed against.
+	cacheHeight uint64
+	// cache contains the prices computed against cacheHeight.
+	cache map[pricePair]osmomath.BigDec
+	// denomPrecisions are loaded once on first use since the asset list changes rarely.
+	denomPrecisions map[string]int
+}
+
+var _ mvc.PricingUsecase = &pricingUseCase{}
+
+var tenBigDec = osmomath.NewBigDec(10)
+
+// NewPricingUsecase will create a new pricing use case object
+func NewPricingUsecase(routerUseCase mvc.RouterUsecase, chainInfoUseCase mvc.ChainInfoUsecase, tokensUseCase domain.TokensUsecase, config domain.PricingConfig, logger log.Logger) mvc.PricingUsecase {
+	return &pricingUseCase{
+		routerUseCase:    routerUseCase,
+		chainInfoUseCase: chainInfoUseCase,
+		tokensUseCase:    tokensUseCase,
+		config:           config,
+		logger:           logger,
+
+		cache: make(map[pricePair]osmomath.BigDec),
+	}
+}
+
+// GetPrice implements mvc.PricingUsecase.
+func (p *pricingUseCase) GetPrice(ctx context.Context, baseDenom string, quoteDenom string) (osmomath.BigDec, error) {
+	height, err := p.chainInfoUseCase.GetIngestedHeight(ctx, 0)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return p.getPrice(ctx, height, baseDenom, p.getQuoteDenom(quoteDenom))
+}
+
+// GetPrices implements mvc.PricingUsecase.
+func (p *pricingUseCase) GetPrices(ctx context.Context, baseDenoms []string, quoteDenom string) (map[string]osmomath.BigDec, error) {
+	height, err := p.chainInfoUseCase.GetIngestedHeight(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteDenom = p.getQuoteDenom(quoteDenom)
+
+	prices := make(map[string]osmomath.BigDec, len(baseDenoms))
+	for _, baseDenom := range baseDenoms {
+		price, err := p.getPrice(ctx, height, baseDenom, quoteDenom)
+		if err != nil {
+			p.logger.Debug("failed to compute price", zap.String("base_denom", baseDenom), zap.String("quote_denom", quoteDenom), zap.Error(err))
+			continue
+		}
+
+		prices[baseDenom] = price
+	}
+
+	return prices, nil
+}
+
+// getQuoteDenom returns the given quote denom or the default quote denom if it is empty.
+func (p *pricingUseCase) getQuoteDenom(quoteDenom string) string {
+	if len(quoteDenom) == 0 {
+		return p.config.DefaultQuoteDenom
+	}
+	return quoteDenom
+}
+
+// getPrice returns the price of the base denom in the quote denom at the given height.
+// The price is computed over the best route from the base denom to the quote denom.
+// If there is no such route, it is computed through the first fallback denom that can be priced.
+// Returns domain.NoPriceFoundError if neither succeeds.
+func (p *pricingUseCase) getPrice(ctx context.Context, height uint64, baseDenom, quoteDenom string) (osmomath.BigDec, error) {
+	if baseDenom == quoteDenom {
+		return osmomath.OneBigDec(), nil
+	}
+
+	if price, ok := p.getCachedPrice(height, baseDenom, quoteDenom); ok {
+		return price, nil
+	}
+
+	price, err := p.computeRoutePrice(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		p.logger.Debug("failed to compute route price, trying fallback denoms", zap.String("base_denom", baseDenom), zap.String("quote_denom", quoteDenom), zap.Error(err))
+
+		price, err = p.computeFallbackPrice(ctx, height, baseDenom, quoteDenom)
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+	}
+
+	p.setCachedPrice(height, baseDenom, quoteDenom, price)
+
+	return price, nil
+}
+
+// computeFallbackPrice computes the price of the base denom in the quote denom as its price in
+// the first fallback denom that can be priced multiplied by the price of that fallback denom.
+// Returns domain.NoPriceFoundError if none of the fallback denoms can be priced.
+func (p *pricingUseCase) computeFallbackPrice(ctx context.Context, height uint64, baseDenom, quoteDenom string) (osmomath.BigDec, error) {
+	for _, fallbackDenom := range p.config.FallbackDenoms {
+		if fallbackDenom == baseDenom || fallbackDenom == quoteDenom {
+			continue
+		}
+
+		// The price of the fallback denom is shared by all denoms priced through it.
+		fallbackPrice, ok := p.getCachedPrice(height, fallbackDenom, quoteDenom)
+		if !ok {
+			var err error
+			fallbackPrice, err = p.computeRoutePrice(ctx, fallbackDenom, quoteDenom)
+			if err != nil {
+				continue
+			}
+
+			p.setCachedPrice(height, fallbackDenom, quoteDenom, fallbackPrice)
+		}
+
+		basePrice, err := p.computeRoutePrice(ctx, baseDenom, fallbackDenom)
+		if err != nil {
+			continue
+		}
+
+		return basePrice.Mul(fallbackPrice), nil
+	}
+
+	return osmomath.BigDec{}, domain.NoPriceFoundError{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+	}
+}
+
+// computeRoutePrice computes the price of the base denom in the quote denom from the spot prices
+// of the pools in the best single route for swapping one unit of the base denom.
+// Returns error if the precision of either denom is unknown or if there is no route.
+func (p *pricingUseCase) computeRoutePrice(ctx context.Context, baseDenom, quoteDenom string) (osmomath.BigDec, error) {
+	basePrecision, err := p.getPrecision(ctx, baseDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	quotePrecision, err := p.getPrecision(ctx, quoteDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	tokenIn := sdk.NewCoin(baseDenom, osmomath.NewIntWithDecimal(1, basePrecision))
+
+	quote, err := p.routerUseCase.GetBestSingleRouteQuote(ctx, tokenIn, quoteDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	routes := quote.GetRoute()
+	if len(routes) == 0 {
+		return osmomath.BigDec{}, domain.NoPriceFoundError{
+			BaseDenom:  baseDenom,
+			QuoteDenom: quoteDenom,
+		}
+	}
+
+	spotPrice, err := routeSpotPrice(routes[0].GetPools(), baseDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return scalePrice(spotPrice, basePrecision, quotePrecision), nil
+}
+
+// routeSpotPrice returns the product of the spot prices of the pools in the route
+// starting from the base denom, i.e. the amount of the final token out denom received
+// for one base unit of the base denom, before fees and price impact.
+// Returns error if fails to compute the spot price of any pool.
+func routeSpotPrice(pools []domain.RoutablePool, baseDenom string) (osmomath.BigDec, error) {
+	spotPrice := osmomath.OneBigDec()
+	previousTokenOutDenom := baseDenom
+	for _, pool := range pools {
+		poolSpotPrice, err := pool.SpotPrice(pool.GetTokenOutDenom(), previousTokenOutDenom)
+		if err != nil {
+			return osmomath.BigDec{}, err
+		}
+
+		spotPrice = spotPrice.Mul(poolSpotPrice)
+		previousTokenOutDenom = pool.GetTokenOutDenom()
+	}
+
+	return spotPrice, nil
+}
+
+// scalePrice converts a price in base units of both denoms, e.g. uatom per uosmo,
+// to the price in whole units of both denoms, e.g. ATOM per OSMO, given their precisions.
+func scalePrice(price osmomath.BigDec, basePrecision, quotePrecision int) osmomath.BigDec {
+	if basePrecision >= quotePrecision {
+		return price.Mul(tenBigDec.PowerInteger(uint64(basePrecision - quotePrecision)))
+	}
+	return price.Quo(tenBigDec.PowerInteger(uint64(quotePrecision - basePrecision)))
+}
+
+// getPrecision returns the precision of the given denom.
+// Returns domain.TokenPrecisionNotFoundError if the denom is not in the asset list.
+func (p *pricingUseCase) getPrecision(ctx context.Context, denom string) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.denomPrecisions == nil {
+		denomPrecisions, err := p.tokensUseCase.GetDenomPrecisions(ctx)
+		if err != nil {
+			return 0, err
+		}
+		p.denomPrecisions = denomPrecisions
+	}
+
+	precision, ok := p.denomPrecisions[denom]
+	if !ok {
+		return 0, domain.TokenPrecisionNotFoundError{Denom: denom}
+	}
+
+	return precision, nil
+}
+
+// getCachedPrice returns the cached price of the base denom in the quote denom
+// if it was computed at the given height.
+func (p *pricingUseCase) getCachedPrice(height uint64, baseDenom, quoteDenom string) (osmomath.BigDec, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if height != p.cacheHeight {
+		return osmomath.BigDec{}, false
+	}
+
+	price, ok := p.cache[pricePair{baseDenom: baseDenom, quoteDenom: quoteDenom}]
+	return price, ok
+}
+
+// setCachedPrice caches the price of the base denom in the quote denom computed at the given height.
+// The prices cached at a lower height are evicted. Prices computed at a height lower than
+// that of the cache are not cached.
+func (p *pricingUseCase) setCachedPrice(height uint64, baseDenom, quoteDenom string, price osmomath.BigDec) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if height < p.cacheHeight {
+		return
+	}
+
+	if height > p.cacheHeight {
+		p.cacheHeight = height
+		p.cache = make(map[pricePair]osmomath.BigDec)
+	}
+
+	p.cache[pricePair{baseDenom: baseDenom, quoteDenom: quoteDenom}] = price
+}
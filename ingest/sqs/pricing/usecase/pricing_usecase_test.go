@@ -0,0 +1,186 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/pricing/usecase"
+	routerusecase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/routertesting"
+)
+
+type PricingTestSuite struct {
+	routertesting.RouterTestHelper
+}
+
+var (
+	DenomOne   = routertesting.DenomOne
+	DenomTwo   = routertesting.DenomTwo
+	DenomThree = routertesting.DenomThree
+	DenomFour  = routertesting.DenomFour
+
+	// Routes are limited to a single pool so that the fallback denoms are exercised.
+	singlePoolRouterConfig = domain.RouterConfig{
+		PreferredPoolIDs:   []uint64{},
+		MaxRoutes:          4,
+		MaxPoolsPerRoute:   1,
+		MaxSplitRoutes:     4,
+		MaxSplitIterations: 10,
+		MinOSMOLiquidity:   0,
+	}
+
+	defaultPricingConfig = domain.PricingConfig{
+		DefaultQuoteDenom: DenomThree,
+		FallbackDenoms:    []string{DenomTwo},
+	}
+
+	defaultDenomPrecisions = map[string]int{
+		DenomOne:   6,
+		DenomTwo:   6,
+		DenomThree: 8,
+		DenomFour:  6,
+	}
+)
+
+func TestPricingTestSuite(t *testing.T) {
+	suite.Run(t, new(PricingTestSuite))
+}
+
+// setupPools creates the following pools:
+// - DenomOne/DenomTwo with a spot price of 2 DenomTwo per DenomOne
+// - DenomTwo/DenomThree with a spot price of 4 DenomThree per DenomTwo
+// DenomFour is not in any pool.
+func (s *PricingTestSuite) setupPools() []domain.PoolI {
+	s.Setup()
+
+	poolOneID := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin(DenomOne, sdk.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(DenomTwo, sdk.NewInt(2_000_000_000_000)),
+	)
+	poolOne, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, poolOneID)
+	s.Require().NoError(err)
+
+	poolTwoID := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin(DenomTwo, sdk.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(DenomThree, sdk.NewInt(4_000_000_000_000)),
+	)
+	poolTwo, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, poolTwoID)
+	s.Require().NoError(err)
+
+	return []domain.PoolI{
+		mocks.WithChainPoolModel(mocks.WithDenoms(mocks.WithPoolID(routertesting.DefaultPool, poolOneID), []string{DenomOne, DenomTwo}), poolOne),
+		mocks.WithChainPoolModel(mocks.WithDenoms(mocks.WithPoolID(routertesting.DefaultPool, poolTwoID), []string{DenomTwo, DenomThree}), poolTwo),
+	}
+}
+
+// Tests that prices are computed over the best route, scaled by the precisions of the denoms,
+// and that the fallback denoms are used when there is no route to the quote denom.
+func (s *PricingTestSuite) TestGetPrices() {
+	tests := map[string]struct {
+		baseDenoms []string
+		quoteDenom string
+
+		expectedPrices map[string]osmomath.BigDec
+	}{
+		"direct route, scaled by precisions": {
+			baseDenoms: []string{DenomTwo},
+
+			// 4 * 10^(6 - 8)
+			expectedPrices: map[string]osmomath.BigDec{
+				DenomTwo: osmomath.MustNewBigDecFromStr("0.04"),
+			},
+		},
+		"no direct route, priced through fallback denom": {
+			baseDenoms: []string{DenomOne},
+
+			// 2 * 0.04
+			expectedPrices: map[string]osmomath.BigDec{
+				DenomOne: osmomath.MustNewBigDecFromStr("0.08"),
+			},
+		},
+		"quote denom is priced at one": {
+			baseDenoms: []string{DenomThree},
+
+			expectedPrices: map[string]osmomath.BigDec{
+				DenomThree: osmomath.OneBigDec(),
+			},
+		},
+		"denom without route is omitted": {
+			baseDenoms: []string{DenomTwo, DenomFour},
+
+			expectedPrices: map[string]osmomath.BigDec{
+				DenomTwo: osmomath.MustNewBigDecFromStr("0.04"),
+			},
+		},
+		"custom quote denom": {
+			baseDenoms: []string{DenomOne},
+			quoteDenom: DenomTwo,
+
+			expectedPrices: map[string]osmomath.BigDec{
+				DenomOne: osmomath.NewBigDec(2),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			pools := s.setupPools()
+
+			pricingUseCase := s.newPricingUsecase(&mocks.PoolsUsecaseMock{Pools: pools}, &mocks.ChainInfoUsecaseMock{LatestHeight: 1})
+
+			prices, err := pricingUseCase.GetPrices(context.Background(), tc.baseDenoms, tc.quoteDenom)
+			s.Require().NoError(err)
+
+			s.Require().Len(prices, len(tc.expectedPrices))
+			for denom, expectedPrice := range tc.expectedPrices {
+				price, ok := prices[denom]
+				s.Require().True(ok, denom)
+
+				// Allow for the rounding of the spot price.
+				s.Require().True(price.Sub(expectedPrice).Abs().LT(osmomath.MustNewBigDecFromStr("0.000000001")), price.String())
+			}
+		})
+	}
+}
+
+// Tests that prices are cached per ingested height.
+func (s *PricingTestSuite) TestGetPrice_CachedPerHeight() {
+	pools := s.setupPools()
+
+	poolsUseCaseMock := &mocks.PoolsUsecaseMock{Pools: pools}
+	chainInfoUseCaseMock := &mocks.ChainInfoUsecaseMock{LatestHeight: 1}
+	pricingUseCase := s.newPricingUsecase(poolsUseCaseMock, chainInfoUseCaseMock)
+
+	price, err := pricingUseCase.GetPrice(context.Background(), DenomTwo, "")
+	s.Require().NoError(err)
+
+	// Remove all pools. The price is served from the cache at the same height.
+	poolsUseCaseMock.Pools = []domain.PoolI{}
+
+	cachedPrice, err := pricingUseCase.GetPrice(context.Background(), DenomTwo, "")
+	s.Require().NoError(err)
+	s.Require().Equal(price, cachedPrice)
+
+	// The cache is invalidated at the next height.
+	chainInfoUseCaseMock.LatestHeight = 2
+
+	_, err = pricingUseCase.GetPrice(context.Background(), DenomTwo, "")
+	s.Require().ErrorIs(err, domain.NoPriceFoundError{BaseDenom: DenomTwo, QuoteDenom: DenomThree})
+}
+
+func (s *PricingTestSuite) newPricingUsecase(poolsUseCase *mocks.PoolsUsecaseMock, chainInfoUseCase *mocks.ChainInfoUsecaseMock) mvc.PricingUsecase {
+	routerUseCase := routerusecase.NewRouterUsecase(time.Second, &mocks.RedisRouterRepositoryMock{}, poolsUseCase, singlePoolRouterConfig, &log.NoOpLogger{})
+	tokensUseCase := &mocks.TokensUseCaseMock{TokenPrecisionMap: defaultDenomPrecisions}
+
+	return usecase.NewPricingUsecase(routerUseCase, chainInfoUseCase, tokensUseCase, defaultPricingConfig, &log.NoOpLogger{})
+}
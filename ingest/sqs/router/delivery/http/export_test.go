@@ -1,5 +1,15 @@
 package http
 
+import (
+	"github.com/labstack/echo"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+)
+
 func ParseNumbers(numbersParam string) ([]uint64, error) {
 	return parseNumbers(numbersParam)
 }
+
+func GetQuoteFilter(c echo.Context) (domain.QuoteFilter, error) {
+	return getQuoteFilter(c)
+}
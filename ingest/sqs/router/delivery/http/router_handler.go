@@ -24,8 +24,10 @@ type ResponseError struct {
 
 // RouterHandler  represent the httphandler for the router
 type RouterHandler struct {
-	RUsecase mvc.RouterUsecase
-	logger   log.Logger
+	RUsecase         mvc.RouterUsecase
+	CIUsecase        mvc.ChainInfoUsecase
+	staleQuoteConfig domain.StaleQuoteConfig
+	logger           log.Logger
 }
 
 // Define a regular expression pattern to match sdk.Coin where the first part is the amount and second is the denom name
@@ -35,16 +37,19 @@ type RouterHandler struct {
 var coinPattern = regexp.MustCompile(`([0-9]+)(([a-z]+)(\/([A-Z0-9]+))*)`)
 
 // NewRouterHandler will initialize the pools/ resources endpoint
-func NewRouterHandler(e *echo.Echo, us mvc.RouterUsecase, logger log.Logger) {
+func NewRouterHandler(e mvc.HTTPRouter, us mvc.RouterUsecase, ciu mvc.ChainInfoUsecase, staleQuoteConfig domain.StaleQuoteConfig, logger log.Logger) {
 	handler := &RouterHandler{
-		RUsecase: us,
-		logger:   logger,
+		RUsecase:         us,
+		CIUsecase:        ciu,
+		staleQuoteConfig: staleQuoteConfig,
+		logger:           logger,
 	}
 	e.GET("/quote", handler.GetOptimalQuote)
 	e.GET("/single-quote", handler.GetBestSingleRouteQuote)
 	e.GET("/routes", handler.GetCandidateRoutes)
 	e.GET("/custom-quote", handler.GetCustomQuote)
 	e.POST("/store-state", handler.StoreRouterStateInFiles)
+	e.GET("/route-restrictions", handler.GetRouteRestrictions)
 }
 
 // GetOptimalQuote will determine the optimal quote for a given tokenIn and tokenOutDenom
@@ -57,12 +62,24 @@ func (a *RouterHandler) GetOptimalQuote(c echo.Context) error {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
 	}
 
-	quote, err := a.RUsecase.GetOptimalQuote(ctx, tokenIn, tokenOutDenom)
+	filter, err := getQuoteFilter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	blockHeight, isStale, err := a.getIngestedState(c)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	quote, err := a.RUsecase.GetOptimalQuote(ctx, tokenIn, tokenOutDenom, filter)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
 	}
 
 	quote.PrepareResult()
+	quote.SetBlockHeight(blockHeight)
+	quote.SetStale(isStale, a.staleQuoteConfig.SlippageTolerance(isStale))
 
 	err = c.JSON(http.StatusOK, quote)
 	if err != nil {
@@ -81,12 +98,19 @@ func (a *RouterHandler) GetBestSingleRouteQuote(c echo.Context) error {
 		return err
 	}
 
+	blockHeight, isStale, err := a.getIngestedState(c)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
 	quote, err := a.RUsecase.GetBestSingleRouteQuote(ctx, tokenIn, tokenOutDenom)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
 	}
 
 	quote.PrepareResult()
+	quote.SetBlockHeight(blockHeight)
+	quote.SetStale(isStale, a.staleQuoteConfig.SlippageTolerance(isStale))
 
 	return c.JSON(http.StatusOK, quote)
 }
@@ -112,12 +136,19 @@ func (a *RouterHandler) GetCustomQuote(c echo.Context) error {
 	}
 
 	// Quote
+	blockHeight, isStale, err := a.getIngestedState(c)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
 	quote, err := a.RUsecase.GetCustomQuote(ctx, tokenIn, tokenOutDenom, poolIDs)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
 	}
 
 	quote.PrepareResult()
+	quote.SetBlockHeight(blockHeight)
+	quote.SetStale(isStale, a.staleQuoteConfig.SlippageTolerance(isStale))
 
 	return c.JSON(http.StatusOK, quote)
 }
@@ -131,6 +162,10 @@ func (a *RouterHandler) GetCandidateRoutes(c echo.Context) error {
 		return err
 	}
 
+	if _, err := a.getIngestedHeight(c); err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
 	routes, err := a.RUsecase.GetCandidateRoutes(ctx, tokenIn, tokenOutDenom)
 	if err != nil {
 		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
@@ -153,12 +188,34 @@ func (a *RouterHandler) StoreRouterStateInFiles(c echo.Context) error {
 	return c.JSON(http.StatusOK, "Router state stored in files")
 }
 
+// GetRouteRestrictions returns the route restrictions currently enforced in route search.
+func (a *RouterHandler) GetRouteRestrictions(c echo.Context) error {
+	return c.JSON(http.StatusOK, a.RUsecase.GetRouteRestrictions())
+}
+
 func getStatusCode(err error) int {
 	if err == nil {
 		return http.StatusOK
 	}
 
 	logrus.Error(err)
+
+	if _, ok := err.(domain.InvalidQueryParamError); ok {
+		return http.StatusBadRequest
+	}
+
+	if _, ok := err.(domain.MinBlockHeightNotReachedError); ok {
+		return http.StatusConflict
+	}
+
+	if _, ok := err.(domain.StaleIngestError); ok {
+		return http.StatusServiceUnavailable
+	}
+
+	if _, ok := err.(domain.NoRouteSatisfiesQuoteFilterError); ok {
+		return http.StatusNotFound
+	}
+
 	switch err {
 	case domain.ErrInternalServerError:
 		return http.StatusInternalServerError
@@ -171,6 +228,88 @@ func getStatusCode(err error) int {
 	}
 }
 
+// getIngestedHeight returns the latest ingested block height and sets it in the response header.
+// Returns domain.InvalidQueryParamError if the optional min block height query parameter supplied
+// by the client is invalid, and domain.MinBlockHeightNotReachedError if the ingested height is below it.
+func (a *RouterHandler) getIngestedHeight(c echo.Context) (uint64, error) {
+	minBlockHeight, err := domain.ParseMinBlockHeight(c.QueryParam(domain.MinBlockHeightQueryParam))
+	if err != nil {
+		return 0, err
+	}
+
+	blockHeight, err := a.CIUsecase.GetIngestedHeight(c.Request().Context(), minBlockHeight)
+	if err != nil {
+		return 0, err
+	}
+
+	c.Response().Header().Set(domain.BlockHeightHeader, strconv.FormatUint(blockHeight, 10))
+
+	return blockHeight, nil
+}
+
+// getIngestedState returns the latest ingested block height, see getIngestedHeight, and whether
+// ingestion lags behind the chain by more than the configured max ingest lag.
+// If so, the stale response header is set so that clients may degrade gracefully.
+// Returns domain.StaleIngestError if ingestion is stale and stale quotes are disabled.
+func (a *RouterHandler) getIngestedState(c echo.Context) (uint64, bool, error) {
+	blockHeight, err := a.getIngestedHeight(c)
+	if err != nil {
+		return 0, false, err
+	}
+
+	ingestLag, err := a.CIUsecase.GetIngestLag(c.Request().Context())
+	if err != nil {
+		return 0, false, err
+	}
+
+	isStale := a.staleQuoteConfig.IsStale(ingestLag)
+	if isStale && !a.staleQuoteConfig.Enabled {
+		return 0, false, domain.StaleIngestError{
+			IngestedHeight:   blockHeight,
+			IngestLagSecs:    int(ingestLag.Seconds()),
+			MaxIngestLagSecs: a.staleQuoteConfig.MaxIngestLagSecs,
+		}
+	}
+
+	c.Response().Header().Set(domain.StaleHeader, strconv.FormatBool(isStale))
+
+	return blockHeight, isStale, nil
+}
+
+// getQuoteFilter returns the quote filter from the optional quote query parameters.
+// The constraints of the parameters that are not set are disabled.
+func getQuoteFilter(c echo.Context) (domain.QuoteFilter, error) {
+	maxPriceImpactBps, err := parseOptionalUint(c.QueryParam(domain.MaxPriceImpactBpsQueryParam), domain.MaxPriceImpactBpsQueryParam)
+	if err != nil {
+		return domain.QuoteFilter{}, err
+	}
+
+	minPoolLiquidityCap, err := parseOptionalUint(c.QueryParam(domain.MinPoolLiquidityCapQueryParam), domain.MinPoolLiquidityCapQueryParam)
+	if err != nil {
+		return domain.QuoteFilter{}, err
+	}
+
+	return domain.QuoteFilter{
+		MaxPriceImpactBps:   maxPriceImpactBps,
+		MinPoolLiquidityCap: minPoolLiquidityCap,
+	}, nil
+}
+
+// parseOptionalUint parses the value of the given optional query parameter.
+// Returns zero if the parameter is not set.
+func parseOptionalUint(valueStr, paramName string) (uint64, error) {
+	if len(valueStr) == 0 {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 64)
+	if err != nil {
+		return 0, domain.InvalidQueryParamError{Param: paramName, Value: valueStr, Err: err}
+	}
+
+	return value, nil
+}
+
 // getValidRoutingParameters returns the tokenIn and tokenOutDenom from server context if they are valid.
 func getValidRoutingParameters(c echo.Context) (string, sdk.Coin, error) {
 	tokenOutStr, tokenInStr, err := getValidTokenInTokenOutStr(c)
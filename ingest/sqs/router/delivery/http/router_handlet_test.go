@@ -1,11 +1,15 @@
 package http_test
 
 import (
+	nethttp "net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 
+	"github.com/labstack/echo"
 	"github.com/stretchr/testify/require"
 
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/delivery/http"
 )
 
@@ -40,3 +44,37 @@ func TestParseNumbers(t *testing.T) {
 		}
 	}
 }
+
+// TestGetQuoteFilter tests parsing the optional quote filter query parameters.
+func TestGetQuoteFilter(t *testing.T) {
+	testCases := []struct {
+		name           string
+		query          string
+		expectedFilter domain.QuoteFilter
+		expectedError  bool
+	}{
+		{"not set", "", domain.QuoteFilter{}, false},
+		{"max price impact only", "maxPriceImpactBps=50", domain.QuoteFilter{MaxPriceImpactBps: 50}, false},
+		{"min pool liquidity cap only", "minPoolLiquidityCap=1000", domain.QuoteFilter{MinPoolLiquidityCap: 1000}, false},
+		{"both set", "maxPriceImpactBps=50&minPoolLiquidityCap=1000", domain.QuoteFilter{MaxPriceImpactBps: 50, MinPoolLiquidityCap: 1000}, false},
+		{"invalid max price impact", "maxPriceImpactBps=abc", domain.QuoteFilter{}, true},
+		{"negative min pool liquidity cap", "minPoolLiquidityCap=-1", domain.QuoteFilter{}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(nethttp.MethodGet, "/quote?"+tc.query, nil)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			actualFilter, err := http.GetQuoteFilter(c)
+
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedFilter, actualFilter)
+		})
+	}
+}
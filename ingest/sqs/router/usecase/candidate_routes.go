@@ -44,6 +44,10 @@ func (r Router) GetCandidateRoutes(tokenInDenom, tokenOutDenom string) (route.Ca
 				continue
 			}
 
+			if r.isPoolExcluded(pool.GetId(), pool.GetPoolDenoms()) {
+				continue
+			}
+
 			poolDenoms := pool.GetPoolDenoms()
 			hasTokenIn := false
 			hasTokenOut := false
@@ -79,6 +83,9 @@ func (r Router) GetCandidateRoutes(tokenInDenom, tokenOutDenom string) (route.Ca
 				if hasTokenOut && denom != tokenOutDenom {
 					continue
 				}
+				if !r.isCanonicalPoolForHop(currentPoolID, currenTokenInDenom, denom) {
+					continue
+				}
 
 				if lastPoolID == uint64(0) || lastPoolID != currentPoolID {
 					newPath := make([]candidatePoolWrapper, len(currentRoute), len(currentRoute)+1)
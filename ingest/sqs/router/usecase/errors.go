@@ -9,6 +9,7 @@ var (
 	ErrNilCurrentRoute     = errors.New("currentRoute cannot be nil")
 	ErrNilRouterRepository = errors.New("router repository is not set")
 	ErrNilPoolsRepository  = errors.New("pools repository is not set")
+	ErrZeroSpotAmountOut   = errors.New("spot amount out must be positive")
 )
 
 type SortedPoolsAndPoolsUsedLengthMismatchError struct {
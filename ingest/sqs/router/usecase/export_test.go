@@ -4,7 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
 )
@@ -52,3 +54,24 @@ func (r Router) GetSortedPoolIDs() []uint64 {
 	}
 	return sortedPoolIDs
 }
+
+func (r *routerUseCaseImpl) FilterCandidateRoutesByLiquidityCap(ctx context.Context, candidateRoutes route.CandidateRoutes, minPoolLiquidityCap uint64) (route.CandidateRoutes, error) {
+	return r.filterCandidateRoutesByLiquidityCap(ctx, candidateRoutes, minPoolLiquidityCap)
+}
+
+func FilterRoutesByPriceImpact(routes []route.RouteImpl, tokenIn sdk.Coin, maxPriceImpactBps uint64) []route.RouteImpl {
+	return filterRoutesByPriceImpact(routes, tokenIn, maxPriceImpactBps)
+}
+
+func RoutePriceImpact(route route.RouteImpl, tokenIn sdk.Coin) (osmomath.Dec, error) {
+	return routePriceImpact(route, tokenIn)
+}
+
+func GetAmountBucket(amount osmomath.Int) int {
+	return getAmountBucket(amount)
+}
+
+// GetOptimalRouteCacheMetrics returns the values of the optimal route cache hit, miss and invalidation counters.
+func GetOptimalRouteCacheMetrics() (hits, misses, invalidations float64) {
+	return testutil.ToFloat64(optimalRouteCacheHits), testutil.ToFloat64(optimalRouteCacheMisses), testutil.ToFloat64(optimalRouteCacheInvalidations)
+}
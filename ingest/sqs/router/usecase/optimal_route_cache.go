@@ -0,0 +1,301 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// maxOptimalRouteCacheEntries is the max number of entries in the optimal route cache.
+// The cache is cleared once it is full so that its memory stays bounded.
+const maxOptimalRouteCacheEntries = 10_000
+
+var (
+	optimalRouteCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sqs_optimal_route_cache_hits_total",
+			Help: "Total number of quotes served from the optimal route cache.",
+		},
+	)
+
+	optimalRouteCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sqs_optimal_route_cache_misses_total",
+			Help: "Total number of quotes with no valid optimal routes cached.",
+		},
+	)
+
+	optimalRouteCacheInvalidations = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sqs_optimal_route_cache_invalidations_total",
+			Help: "Total number of cached optimal routes invalidated by pool liquidity changes or failed estimates.",
+		},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(optimalRouteCacheHits)
+	prometheus.MustRegister(optimalRouteCacheMisses)
+	prometheus.MustRegister(optimalRouteCacheInvalidations)
+}
+
+// optimalRouteCacheKey is the cache key of the optimal routes for swapping amounts of the token in
+// within the amount bucket to the token out.
+type optimalRouteCacheKey struct {
+	tokenInDenom  string
+	tokenOutDenom string
+	amountBucket  int
+}
+
+// cachedOptimalRoutes are the routes of an optimal quote along with the proportion of the amount in
+// swapped over each of them.
+type cachedOptimalRoutes struct {
+	// candidateRoutes are the routes of the quote in the order of increments.
+	candidateRoutes route.CandidateRoutes
+	// increments are the number of increments, out of totalIncrements, of the amount in swapped over each route.
+	increments []uint8
+	// poolLiquidity is the liquidity of every pool of the routes when the routes were cached.
+	poolLiquidity map[uint64]osmomath.Int
+}
+
+// optimalRouteCache caches the optimal routes per denom pair and amount bucket.
+// It is safe for concurrent use.
+type optimalRouteCache struct {
+	mu      sync.Mutex
+	entries map[optimalRouteCacheKey]cachedOptimalRoutes
+}
+
+func newOptimalRouteCache() *optimalRouteCache {
+	return &optimalRouteCache{
+		entries: make(map[optimalRouteCacheKey]cachedOptimalRoutes),
+	}
+}
+
+func (c *optimalRouteCache) get(key optimalRouteCacheKey) (cachedOptimalRoutes, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	return entry, ok
+}
+
+// set caches the entry under the given key, clearing the cache first if it is full.
+func (c *optimalRouteCache) set(key optimalRouteCacheKey, entry cachedOptimalRoutes) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxOptimalRouteCacheEntries {
+		c.entries = make(map[optimalRouteCacheKey]cachedOptimalRoutes)
+	}
+	c.entries[key] = entry
+}
+
+func (c *optimalRouteCache) delete(key optimalRouteCacheKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// getAmountBucket returns the logarithmic bucket of the given amount. That is, the number of its decimal digits
+// minus one, so that amounts from 1 to 9 are in bucket 0, amounts from 10 to 99 are in bucket 1 and so on.
+func getAmountBucket(amount osmomath.Int) int {
+	return len(amount.Abs().String()) - 1
+}
+
+// newOptimalRouteCacheKey returns the cache key of the optimal routes for swapping the token in to the token out.
+func newOptimalRouteCacheKey(tokenIn sdk.Coin, tokenOutDenom string) optimalRouteCacheKey {
+	return optimalRouteCacheKey{
+		tokenInDenom:  tokenIn.Denom,
+		tokenOutDenom: tokenOutDenom,
+		amountBucket:  getAmountBucket(tokenIn.Amount),
+	}
+}
+
+// hasSignificantLiquidityChange returns true if any pool with cached liquidity is missing from the current pools
+// or its liquidity changed by more than maxLiquidityChangeBps relative to the cached liquidity. False otherwise.
+func hasSignificantLiquidityChange(cachedLiquidity map[uint64]osmomath.Int, currentPools map[uint64]domain.PoolI, maxLiquidityChangeBps int) bool {
+	maxLiquidityChange := osmomath.NewDec(int64(maxLiquidityChangeBps)).Quo(basisPointsDenominator)
+
+	for poolID, liquidity := range cachedLiquidity {
+		pool, ok := currentPools[poolID]
+		if !ok {
+			return true
+		}
+
+		currentLiquidity := pool.GetTotalValueLockedUOSMO()
+		if liquidity.IsZero() {
+			if !currentLiquidity.IsZero() {
+				return true
+			}
+			continue
+		}
+
+		liquidityChange := currentLiquidity.Sub(liquidity).Abs().ToLegacyDec().QuoInt(liquidity)
+		if liquidityChange.GT(maxLiquidityChange) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getCachedOptimalQuote returns the quote for swapping the token in over the optimal routes cached for its
+// amount bucket, using the cached split proportions. Returns false if no routes are cached or if the cached
+// routes are invalidated, either because the liquidity of any of their pools changed significantly since
+// they were cached or because the quote cannot be estimated over them.
+func (r *routerUseCaseImpl) getCachedOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, bool) {
+	key := newOptimalRouteCacheKey(tokenIn, tokenOutDenom)
+
+	cachedRoutes, ok := r.optimalRouteCache.get(key)
+	if !ok {
+		optimalRouteCacheMisses.Inc()
+		return nil, false
+	}
+
+	quote, err := r.estimateCachedOptimalQuote(ctx, cachedRoutes, tokenIn, tokenOutDenom)
+	if err != nil {
+		r.logger.Debug("invalidating cached optimal routes", zap.String("token_in", tokenIn.String()), zap.String("token_out_denom", tokenOutDenom), zap.Error(err))
+
+		r.optimalRouteCache.delete(key)
+		optimalRouteCacheInvalidations.Inc()
+		optimalRouteCacheMisses.Inc()
+		return nil, false
+	}
+
+	optimalRouteCacheHits.Inc()
+	return quote, true
+}
+
+// estimateCachedOptimalQuote estimates the quote for swapping the token in over the cached routes.
+// Returns error if the liquidity of any pool of the routes changed significantly since they were cached
+// or if the quote cannot be estimated over the routes.
+func (r *routerUseCaseImpl) estimateCachedOptimalQuote(ctx context.Context, cachedRoutes cachedOptimalRoutes, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error) {
+	currentPools, err := r.poolsUsecase.GetPools(ctx, cachedRoutes.candidateRoutes.UniquePoolIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if hasSignificantLiquidityChange(cachedRoutes.poolLiquidity, currentPools, r.config.OptimalRouteCache.MaxLiquidityChangeBps) {
+		return nil, errors.New("liquidity of cached route pools changed significantly")
+	}
+
+	takerFees, err := r.routerRepository.GetAllTakerFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := r.poolsUsecase.GetRoutesFromCandidates(ctx, cachedRoutes.candidateRoutes, takerFees, tokenIn.Denom, tokenOutDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return estimateQuoteFromIncrements(routes, cachedRoutes.increments, tokenIn)
+}
+
+// setCachedOptimalQuote caches the routes of the given optimal quote and their split proportions under the
+// amount bucket of its token in, along with the current liquidity of their pools.
+// The quote is not cached if its split proportions do not add up to totalIncrements.
+// Returns error if fails to retrieve the pools of the routes.
+func (r *routerUseCaseImpl) setCachedOptimalQuote(ctx context.Context, quote domain.Quote, tokenOutDenom string) error {
+	tokenIn := quote.GetAmountIn()
+	splitRoutes := quote.GetRoute()
+
+	candidateRoutes := route.CandidateRoutes{
+		Routes:        make([]route.CandidateRoute, 0, len(splitRoutes)),
+		UniquePoolIDs: make(map[uint64]struct{}),
+	}
+	increments := make([]uint8, 0, len(splitRoutes))
+	incrementsSum := 0
+	for _, splitRoute := range splitRoutes {
+		candidateRoute := route.CandidateRoute{
+			Pools: make([]route.CandidatePool, 0, len(splitRoute.GetPools())),
+		}
+		for _, pool := range splitRoute.GetPools() {
+			candidateRoute.Pools = append(candidateRoute.Pools, route.CandidatePool{
+				ID:            pool.GetId(),
+				TokenOutDenom: pool.GetTokenOutDenom(),
+			})
+			candidateRoutes.UniquePoolIDs[pool.GetId()] = struct{}{}
+		}
+		candidateRoutes.Routes = append(candidateRoutes.Routes, candidateRoute)
+
+		// The split amounts in are truncated, so the increments are rounded to recover them.
+		increment := splitRoute.GetAmountIn().ToLegacyDec().MulInt64(int64(totalIncrements)).QuoInt(tokenIn.Amount).RoundInt64()
+		increments = append(increments, uint8(increment))
+		incrementsSum += int(increment)
+	}
+
+	if incrementsSum != int(totalIncrements) {
+		r.logger.Debug("not caching optimal routes with unexpected increments", zap.Int("increments", incrementsSum))
+		return nil
+	}
+
+	pools, err := r.poolsUsecase.GetPools(ctx, candidateRoutes.UniquePoolIDs)
+	if err != nil {
+		return err
+	}
+
+	poolLiquidity := make(map[uint64]osmomath.Int, len(pools))
+	for poolID, pool := range pools {
+		poolLiquidity[poolID] = pool.GetTotalValueLockedUOSMO()
+	}
+
+	r.optimalRouteCache.set(newOptimalRouteCacheKey(tokenIn, tokenOutDenom), cachedOptimalRoutes{
+		candidateRoutes: candidateRoutes,
+		increments:      increments,
+		poolLiquidity:   poolLiquidity,
+	})
+
+	return nil
+}
+
+// estimateQuoteFromIncrements returns the quote for swapping the token in over the given routes, where the amount
+// swapped over each route is its number of increments out of totalIncrements of the amount in.
+// Routes with no increments are skipped.
+// Returns error if the number of routes and increments differ or if the amount out over any route is zero.
+func estimateQuoteFromIncrements(routes []route.RouteImpl, increments []uint8, tokenIn sdk.Coin) (domain.Quote, error) {
+	if len(routes) != len(increments) {
+		return nil, fmt.Errorf("number of routes (%d) does not match number of increments (%d)", len(routes), len(increments))
+	}
+
+	splitRoutes := make([]domain.SplitRoute, 0, len(routes))
+	totalAmountOut := osmomath.ZeroInt()
+	for i, currentRoute := range routes {
+		if increments[i] == 0 {
+			continue
+		}
+
+		inAmount := tokenIn.Amount.ToLegacyDec().MulInt64(int64(increments[i])).QuoInt64(int64(totalIncrements)).TruncateInt()
+		coinOut, err := currentRoute.CalculateTokenOutByTokenIn(sdk.NewCoin(tokenIn.Denom, inAmount))
+		if err != nil {
+			return nil, err
+		}
+
+		if coinOut.Amount.IsNil() || coinOut.Amount.IsZero() {
+			return nil, fmt.Errorf("out amount is zero when in is (%s), route index (%d)", inAmount, i)
+		}
+
+		splitRoutes = append(splitRoutes, &RouteWithOutAmount{
+			RouteImpl: currentRoute,
+			InAmount:  inAmount,
+			OutAmount: coinOut.Amount,
+		})
+		totalAmountOut = totalAmountOut.Add(coinOut.Amount)
+	}
+
+	return &quoteImpl{
+		AmountIn:  tokenIn,
+		AmountOut: totalAmountOut,
+		Route:     splitRoutes,
+	}, nil
+}
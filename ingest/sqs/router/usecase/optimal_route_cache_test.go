@@ -0,0 +1,122 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+)
+
+// Tests that amounts are bucketed by their number of decimal digits.
+func (s *RouterTestSuite) TestGetAmountBucket() {
+	tests := map[string]struct {
+		amount         osmomath.Int
+		expectedBucket int
+	}{
+		"1":          {osmomath.NewInt(1), 0},
+		"9":          {osmomath.NewInt(9), 0},
+		"10":         {osmomath.NewInt(10), 1},
+		"99":         {osmomath.NewInt(99), 1},
+		"100":        {osmomath.NewInt(100), 2},
+		"1_000_000":  {osmomath.NewInt(1_000_000), 6},
+		"9_999_999":  {osmomath.NewInt(9_999_999), 6},
+		"10_000_000": {osmomath.NewInt(10_000_000), 7},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.Require().Equal(tc.expectedBucket, usecase.GetAmountBucket(tc.amount))
+		})
+	}
+}
+
+// Tests that optimal quotes are served from the cache for amounts in the same bucket,
+// and that the cached routes are invalidated once the liquidity of their pools changes significantly.
+func (s *RouterTestSuite) TestGetOptimalQuote_OptimalRouteCache() {
+	const (
+		tokenInDenom  = "uosmo"
+		tokenOutDenom = "uion"
+	)
+
+	balancerCoins := sdk.NewCoins(
+		sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(tokenOutDenom, osmomath.NewInt(1_000_000_000_000)),
+	)
+	balancerPoolID := s.PrepareBalancerPoolWithCoins(balancerCoins...)
+	balancerPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+
+	pool := &domain.PoolWrapper{
+		ChainModel: balancerPool,
+		SQSModel: domain.SQSPool{
+			TotalValueLockedUSDC: osmomath.NewInt(1_000_000_000),
+			PoolDenoms:           []string{tokenInDenom, tokenOutDenom},
+			Balances:             balancerCoins,
+			SpreadFactor:         DefaultSpreadFactor,
+		},
+	}
+
+	config := domain.RouterConfig{
+		MaxPoolsPerRoute: 4,
+		MaxRoutes:        4,
+		MaxSplitRoutes:   3,
+		OptimalRouteCache: domain.OptimalRouteCacheConfig{
+			Enabled:               true,
+			MaxLiquidityChangeBps: 100,
+		},
+	}
+
+	poolsUseCaseMock := &mocks.PoolsUsecaseMock{Pools: []domain.PoolI{pool}}
+	routerUseCase := usecase.NewRouterUsecase(time.Second, &mocks.RedisRouterRepositoryMock{}, poolsUseCaseMock, config, &log.NoOpLogger{})
+
+	uncachedConfig := config
+	uncachedConfig.OptimalRouteCache.Enabled = false
+	uncachedRouterUseCase := usecase.NewRouterUsecase(time.Second, &mocks.RedisRouterRepositoryMock{}, poolsUseCaseMock, uncachedConfig, &log.NoOpLogger{})
+
+	// requireQuote gets the quote and validates that it matches the uncached quote and that the cache counters
+	// were incremented by the expected amounts.
+	requireQuote := func(tokenIn sdk.Coin, filter domain.QuoteFilter, expectedHits, expectedMisses, expectedInvalidations float64) {
+		hitsBefore, missesBefore, invalidationsBefore := usecase.GetOptimalRouteCacheMetrics()
+
+		quote, err := routerUseCase.GetOptimalQuote(context.Background(), tokenIn, tokenOutDenom, filter)
+		s.Require().NoError(err)
+
+		hits, misses, invalidations := usecase.GetOptimalRouteCacheMetrics()
+		s.Require().Equal(expectedHits, hits-hitsBefore)
+		s.Require().Equal(expectedMisses, misses-missesBefore)
+		s.Require().Equal(expectedInvalidations, invalidations-invalidationsBefore)
+
+		uncachedQuote, err := uncachedRouterUseCase.GetOptimalQuote(context.Background(), tokenIn, tokenOutDenom, filter)
+		s.Require().NoError(err)
+		s.Require().Equal(uncachedQuote.GetAmountOut(), quote.GetAmountOut())
+		s.Require().Len(quote.GetRoute(), len(uncachedQuote.GetRoute()))
+	}
+
+	// No routes cached yet.
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000)), domain.QuoteFilter{}, 0, 1, 0)
+
+	// Same amount bucket is served from the cache.
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(5_000_000)), domain.QuoteFilter{}, 1, 0, 0)
+
+	// Different amount bucket is not cached yet.
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(10_000_000)), domain.QuoteFilter{}, 0, 1, 0)
+
+	// Filtered quotes do not use the cache.
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000)), domain.QuoteFilter{MaxPriceImpactBps: 10_000}, 0, 0, 0)
+
+	// A liquidity change within the max change keeps the cached routes.
+	pool.SQSModel.TotalValueLockedUSDC = osmomath.NewInt(1_009_000_000)
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000)), domain.QuoteFilter{}, 1, 0, 0)
+
+	// A liquidity change above the max change invalidates the cached routes, which are then recomputed.
+	pool.SQSModel.TotalValueLockedUSDC = osmomath.NewInt(1_020_000_000)
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000)), domain.QuoteFilter{}, 0, 1, 1)
+	requireQuote(sdk.NewCoin(tokenInDenom, osmomath.NewInt(1_000_000)), domain.QuoteFilter{}, 1, 0, 0)
+}
@@ -5,4 +5,5 @@ type (
 	RoutableConcentratedPoolImpl = routableConcentratedPoolImpl
 	RoutableTransmuterPoolImpl   = routableTransmuterPoolImpl
 	RoutableResultPoolImpl       = routableResultPoolImpl
+	RoutableOrderbookPoolImpl    = routableOrderbookPoolImpl
 )
@@ -68,12 +68,24 @@ func NewRoutablePool(pool domain.PoolI, tokenOutDenom string, takerFee osmomath.
 
 		sqsPoolModel := pool.GetSQSPoolModel().SpreadFactor
 
+		// CosmWasm pools of whitelisted orderbook contracts have their resting orders ingested.
+		if orderbook := pool.GetSQSPoolModel().Orderbook; orderbook != nil {
+			return &routableOrderbookPoolImpl{
+				ChainPool:     cosmwasmPool,
+				Orderbook:     orderbook,
+				TokenOutDenom: tokenOutDenom,
+				TakerFee:      takerFee,
+				SpreadFactor:  sqsPoolModel,
+			}
+		}
+
 		return &routableTransmuterPoolImpl{
-			ChainPool:     cosmwasmPool,
-			Balances:      pool.GetSQSPoolModel().Balances,
-			TokenOutDenom: tokenOutDenom,
-			TakerFee:      takerFee,
-			SpreadFactor:  sqsPoolModel,
+			ChainPool:       cosmwasmPool,
+			Balances:        pool.GetSQSPoolModel().Balances,
+			TokenOutDenom:   tokenOutDenom,
+			TakerFee:        takerFee,
+			SpreadFactor:    sqsPoolModel,
+			AlloyTransmuter: pool.GetSQSPoolModel().AlloyTransmuter,
 		}
 	}
 
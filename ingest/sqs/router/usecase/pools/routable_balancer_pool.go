@@ -79,3 +79,8 @@ func (r *routableBalancerPoolImpl) GetPoolDenoms() []string {
 func (*routableBalancerPoolImpl) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Balancer
 }
+
+// SpotPrice implements domain.RoutablePool.
+func (r *routableBalancerPoolImpl) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	return r.ChainPool.SpotPrice(sdk.Context{}, quoteDenom, baseDenom)
+}
@@ -190,3 +190,8 @@ func (r *routableConcentratedPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (
 func (r *routableConcentratedPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
 	r.TokenOutDenom = tokenOutDenom
 }
+
+// SpotPrice implements domain.RoutablePool.
+func (r *routableConcentratedPoolImpl) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	return r.ChainPool.SpotPrice(sdk.Context{}, quoteDenom, baseDenom)
+}
@@ -0,0 +1,149 @@
+package pools
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	cwpoolmodel "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/model"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+var _ domain.RoutablePool = &routableOrderbookPoolImpl{}
+
+type routableOrderbookPoolImpl struct {
+	ChainPool     *cwpoolmodel.CosmWasmPool "json:\"pool\""
+	Orderbook     *domain.OrderbookModel    "json:\"orderbook\""
+	TokenOutDenom string                    "json:\"token_out_denom\""
+	TakerFee      osmomath.Dec              "json:\"taker_fee\""
+	SpreadFactor  osmomath.Dec              "json:\"spread_factor\""
+}
+
+// GetId implements domain.RoutablePool.
+func (r *routableOrderbookPoolImpl) GetId() uint64 {
+	return r.ChainPool.PoolId
+}
+
+// GetPoolDenoms implements domain.RoutablePool.
+func (r *routableOrderbookPoolImpl) GetPoolDenoms() []string {
+	return []string{r.Orderbook.BaseDenom, r.Orderbook.QuoteDenom}
+}
+
+// GetType implements domain.RoutablePool.
+func (*routableOrderbookPoolImpl) GetType() poolmanagertypes.PoolType {
+	return poolmanagertypes.CosmWasm
+}
+
+// GetSpreadFactor implements domain.RoutablePool.
+func (r *routableOrderbookPoolImpl) GetSpreadFactor() math.LegacyDec {
+	return r.SpreadFactor
+}
+
+// CalculateTokenOutByTokenIn implements domain.RoutablePool.
+// It calculates the amount of token out given the amount of token in by filling
+// the resting limit orders of the orderbook, starting from the best price.
+// Buying the base denom fills the asks in ascending price order while selling
+// the base denom fills the bids in descending price order.
+// The spread factor is charged on the token in before filling the orders.
+// Returns error if:
+// - the token in or token out denom is not one of the orderbook denoms
+// - the resting orders are not enough to fill the token in
+func (r *routableOrderbookPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, error) {
+	orderbook := r.Orderbook
+
+	isBuyingBase := tokenIn.Denom == orderbook.QuoteDenom && r.TokenOutDenom == orderbook.BaseDenom
+	isSellingBase := tokenIn.Denom == orderbook.BaseDenom && r.TokenOutDenom == orderbook.QuoteDenom
+	if !isBuyingBase && !isSellingBase {
+		return sdk.Coin{}, fmt.Errorf("orderbook pool (%d) does not trade (%s) for (%s)", r.GetId(), tokenIn.Denom, r.TokenOutDenom)
+	}
+
+	remainingIn := tokenIn.Amount.ToLegacyDec().MulTruncate(osmomath.OneDec().Sub(r.SpreadFactor))
+	amountOut := osmomath.ZeroDec()
+
+	if isBuyingBase {
+		for _, ask := range orderbook.Asks {
+			if !remainingIn.IsPositive() {
+				break
+			}
+
+			// The amount of quote needed to fill the entire level.
+			levelCost := ask.Quantity.ToLegacyDec().Mul(ask.Price)
+			if remainingIn.GTE(levelCost) {
+				amountOut.AddMut(ask.Quantity.ToLegacyDec())
+				remainingIn.SubMut(levelCost)
+				continue
+			}
+
+			amountOut.AddMut(remainingIn.Quo(ask.Price))
+			remainingIn = osmomath.ZeroDec()
+		}
+	} else {
+		for _, bid := range orderbook.Bids {
+			if !remainingIn.IsPositive() {
+				break
+			}
+
+			filledQuantity := osmomath.MinDec(remainingIn, bid.Quantity.ToLegacyDec())
+			amountOut.AddMut(filledQuantity.Mul(bid.Price))
+			remainingIn = remainingIn.Sub(filledQuantity)
+		}
+	}
+
+	if remainingIn.IsPositive() {
+		return sdk.Coin{}, domain.OrderbookNotEnoughLiquidityToCompleteSwapError{
+			PoolId:   r.GetId(),
+			AmountIn: sdk.NewCoins(tokenIn).String(),
+		}
+	}
+
+	return sdk.NewCoin(r.TokenOutDenom, amountOut.TruncateInt()), nil
+}
+
+// GetTokenOutDenom implements RoutablePool.
+func (r *routableOrderbookPoolImpl) GetTokenOutDenom() string {
+	return r.TokenOutDenom
+}
+
+// String implements domain.RoutablePool.
+func (r *routableOrderbookPoolImpl) String() string {
+	return fmt.Sprintf("pool (%d), pool type (%d), orderbook (%s/%s), token out (%s)", r.ChainPool.PoolId, poolmanagertypes.CosmWasm, r.Orderbook.BaseDenom, r.Orderbook.QuoteDenom, r.TokenOutDenom)
+}
+
+// ChargeTakerFeeExactIn implements domain.RoutablePool.
+// Charges the taker fee for the given token in and returns the token in after the fee has been charged.
+func (r *routableOrderbookPoolImpl) ChargeTakerFeeExactIn(tokenIn sdk.Coin) (tokenInAfterFee sdk.Coin) {
+	tokenInAfterTakerFee, _ := poolmanager.CalcTakerFeeExactIn(tokenIn, r.TakerFee)
+	return tokenInAfterTakerFee
+}
+
+// GetTakerFee implements domain.RoutablePool.
+func (r *routableOrderbookPoolImpl) GetTakerFee() math.LegacyDec {
+	return r.TakerFee
+}
+
+// SetTokenOutDenom implements domain.RoutablePool.
+func (r *routableOrderbookPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
+	r.TokenOutDenom = tokenOutDenom
+}
+
+// SpotPrice implements domain.RoutablePool.
+// Returns the price at the top of the book in the direction of the swap from base to quote denom.
+// That is, the best bid when selling the orderbook base denom and the inverse of the best ask
+// when buying it.
+func (r *routableOrderbookPoolImpl) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	orderbook := r.Orderbook
+
+	if baseDenom == orderbook.BaseDenom && quoteDenom == orderbook.QuoteDenom && len(orderbook.Bids) > 0 {
+		return osmomath.BigDecFromDec(orderbook.Bids[0].Price), nil
+	}
+
+	if baseDenom == orderbook.QuoteDenom && quoteDenom == orderbook.BaseDenom && len(orderbook.Asks) > 0 {
+		return osmomath.OneBigDec().Quo(osmomath.BigDecFromDec(orderbook.Asks[0].Price)), nil
+	}
+
+	return osmomath.BigDec{}, fmt.Errorf("orderbook pool (%d) has no orders to swap (%s) for (%s)", r.GetId(), baseDenom, quoteDenom)
+}
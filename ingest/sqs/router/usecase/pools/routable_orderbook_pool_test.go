@@ -0,0 +1,143 @@
+package pools_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/pools"
+)
+
+// Tests quotes that fill the resting limit orders of an orderbook pool.
+func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_Orderbook() {
+	defaultOrderbook := &domain.OrderbookModel{
+		BaseDenom:  ETH,
+		QuoteDenom: USDC,
+		Bids: []domain.OrderbookLevel{
+			{Price: osmomath.NewDec(1900), Quantity: osmomath.NewInt(10)},
+			{Price: osmomath.NewDec(1800), Quantity: osmomath.NewInt(5)},
+		},
+		Asks: []domain.OrderbookLevel{
+			{Price: osmomath.NewDec(2000), Quantity: osmomath.NewInt(10)},
+			{Price: osmomath.NewDec(2100), Quantity: osmomath.NewInt(5)},
+		},
+	}
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		spreadFactor  osmomath.Dec
+
+		expectedTokenOut sdk.Coin
+		expectError      error
+	}{
+		"buy base within the best ask": {
+			tokenIn:       sdk.NewCoin(USDC, osmomath.NewInt(10_000)),
+			tokenOutDenom: ETH,
+
+			expectedTokenOut: sdk.NewCoin(ETH, osmomath.NewInt(5)),
+		},
+		"buy base across asks": {
+			// 10 * 2000 + 2 * 2100
+			tokenIn:       sdk.NewCoin(USDC, osmomath.NewInt(24_200)),
+			tokenOutDenom: ETH,
+
+			expectedTokenOut: sdk.NewCoin(ETH, osmomath.NewInt(12)),
+		},
+		"sell base across bids": {
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(12)),
+			tokenOutDenom: USDC,
+
+			// 10 * 1900 + 2 * 1800
+			expectedTokenOut: sdk.NewCoin(USDC, osmomath.NewInt(22_600)),
+		},
+		"sell base with spread factor": {
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(10)),
+			tokenOutDenom: USDC,
+			spreadFactor:  osmomath.MustNewDecFromStr("0.01"),
+
+			// 9.9 * 1900
+			expectedTokenOut: sdk.NewCoin(USDC, osmomath.NewInt(18_810)),
+		},
+		"error: not enough resting orders": {
+			tokenIn:       sdk.NewCoin(ETH, osmomath.NewInt(16)),
+			tokenOutDenom: USDC,
+
+			expectError: domain.OrderbookNotEnoughLiquidityToCompleteSwapError{
+				PoolId:   defaultPoolID,
+				AmountIn: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(16))).String(),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			cosmwasmPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{ETH, USDC})
+
+			spreadFactor := osmomath.ZeroDec()
+			if !tc.spreadFactor.IsNil() {
+				spreadFactor = tc.spreadFactor
+			}
+
+			pool := &domain.PoolWrapper{
+				ChainModel: cosmwasmPool.AsSerializablePool(),
+				SQSModel: domain.SQSPool{
+					SpreadFactor: spreadFactor,
+					Orderbook:    defaultOrderbook,
+				},
+			}
+
+			routablePool := pools.NewRoutablePool(pool, tc.tokenOutDenom, noTakerFee)
+			_, isOrderbook := routablePool.(*pools.RoutableOrderbookPoolImpl)
+			s.Require().True(isOrderbook)
+
+			tokenOut, err := routablePool.CalculateTokenOutByTokenIn(tc.tokenIn)
+
+			if tc.expectError != nil {
+				s.Require().Error(err)
+				s.Require().ErrorIs(err, tc.expectError)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedTokenOut, tokenOut)
+		})
+	}
+}
+
+// Tests that the spot price of an orderbook pool is the top of the book in the direction of the swap.
+func (s *RoutablePoolTestSuite) TestSpotPrice_Orderbook() {
+	s.Setup()
+
+	cosmwasmPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{ETH, USDC})
+
+	pool := &domain.PoolWrapper{
+		ChainModel: cosmwasmPool.AsSerializablePool(),
+		SQSModel: domain.SQSPool{
+			SpreadFactor: osmomath.ZeroDec(),
+			Orderbook: &domain.OrderbookModel{
+				BaseDenom:  ETH,
+				QuoteDenom: USDC,
+				Bids:       []domain.OrderbookLevel{{Price: osmomath.NewDec(1900), Quantity: osmomath.NewInt(10)}},
+				Asks:       []domain.OrderbookLevel{{Price: osmomath.NewDec(2000), Quantity: osmomath.NewInt(10)}},
+			},
+		},
+	}
+
+	routablePool := pools.NewRoutablePool(pool, USDC, noTakerFee)
+
+	// Selling ETH fills the best bid.
+	spotPrice, err := routablePool.SpotPrice(USDC, ETH)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewBigDec(1900), spotPrice)
+
+	// Buying ETH fills the best ask.
+	spotPrice, err = routablePool.SpotPrice(ETH, USDC)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.OneBigDec().Quo(osmomath.NewBigDec(2000)), spotPrice)
+
+	_, err = routablePool.SpotPrice(USDT, ETH)
+	s.Require().Error(err)
+}
@@ -131,3 +131,9 @@ func (r *routableResultPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
 func (r *routableResultPoolImpl) GetSpreadFactor() math.LegacyDec {
 	return r.SpreadFactor
 }
+
+// SpotPrice implements domain.RoutablePool.
+// Result pools do not carry the pool state needed to compute the spot price.
+func (r *routableResultPoolImpl) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	return osmomath.BigDec{}, errors.New("not implemented")
+}
@@ -79,3 +79,8 @@ func (r *routableStableswapPoolImpl) GetPoolDenoms() []string {
 func (*routableStableswapPoolImpl) GetType() poolmanagertypes.PoolType {
 	return poolmanagertypes.Balancer
 }
+
+// SpotPrice implements domain.RoutablePool.
+func (r *routableStableswapPoolImpl) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	return r.ChainPool.SpotPrice(sdk.Context{}, quoteDenom, baseDenom)
+}
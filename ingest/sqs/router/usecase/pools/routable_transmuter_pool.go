@@ -21,6 +21,8 @@ type routableTransmuterPoolImpl struct {
 	TokenOutDenom string                    "json:\"token_out_denom\""
 	TakerFee      osmomath.Dec              "json:\"taker_fee\""
 	SpreadFactor  osmomath.Dec              "json:\"spread_factor\""
+	// Only set for alloyed transmuter pools.
+	AlloyTransmuter *domain.AlloyTransmuterModel "json:\"alloy_transmuter,omitempty\""
 }
 
 // GetId implements domain.RoutablePool.
@@ -50,6 +52,7 @@ func (r *routableTransmuterPoolImpl) GetSpreadFactor() math.LegacyDec {
 // - the underlying chain pool set on the routable pool is not of transmuter type
 // - the token in amount is greater than the balance of the token in
 // - the token in amount is greater than the balance of the token out
+// - the token in amount is greater than the remaining capacity of the token in of an alloyed transmuter pool
 func (r *routableTransmuterPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin) (sdk.Coin, error) {
 	poolType := r.GetType()
 
@@ -70,6 +73,11 @@ func (r *routableTransmuterPoolImpl) CalculateTokenOutByTokenIn(tokenIn sdk.Coin
 		return sdk.Coin{}, err
 	}
 
+	// Validate that the swap does not exceed the static limiter of the token in
+	if err := r.validateRemainingCapacity(tokenIn); err != nil {
+		return sdk.Coin{}, err
+	}
+
 	// No slippage swaps - just return the same amount of token out as token in
 	// as long as there is enough liquidity in the pool.
 	return sdk.NewCoin(r.TokenOutDenom, tokenIn.Amount), nil
@@ -106,6 +114,31 @@ func validateBalance(tokenInAmount osmomath.Int, balances sdk.Coins, denomToVali
 	return nil
 }
 
+// validateRemainingCapacity validates that the token in amount does not exceed the remaining capacity
+// of the token in denom if this is an alloyed transmuter pool with a static limiter on it.
+// Returns nil on success, error otherwise.
+func (r *routableTransmuterPoolImpl) validateRemainingCapacity(tokenIn sdk.Coin) error {
+	if r.AlloyTransmuter == nil {
+		return nil
+	}
+
+	asset, ok := r.AlloyTransmuter.GetAsset(tokenIn.Denom)
+	if !ok || asset.RemainingCapacity == nil {
+		return nil
+	}
+
+	if tokenIn.Amount.GT(*asset.RemainingCapacity) {
+		return domain.AlloyTransmuterCapacityExceededError{
+			PoolId:            r.GetId(),
+			Denom:             tokenIn.Denom,
+			RemainingCapacity: asset.RemainingCapacity.String(),
+			Amount:            tokenIn.Amount.String(),
+		}
+	}
+
+	return nil
+}
+
 // GetTakerFee implements domain.RoutablePool.
 func (r *routableTransmuterPoolImpl) GetTakerFee() math.LegacyDec {
 	return r.TakerFee
@@ -115,3 +148,16 @@ func (r *routableTransmuterPoolImpl) GetTakerFee() math.LegacyDec {
 func (r *routableTransmuterPoolImpl) SetTokenOutDenom(tokenOutDenom string) {
 	r.TokenOutDenom = tokenOutDenom
 }
+
+// SpotPrice implements domain.RoutablePool.
+// Transmuter pools swap one-to-one, so the spot price is always one
+// as long as both denoms are in the pool.
+func (r *routableTransmuterPoolImpl) SpotPrice(quoteDenom, baseDenom string) (osmomath.BigDec, error) {
+	for _, denom := range []string{quoteDenom, baseDenom} {
+		if !r.Balances.AmountOf(denom).IsPositive() {
+			return osmomath.BigDec{}, fmt.Errorf("denom (%s) is not in transmuter pool (%d)", denom, r.GetId())
+		}
+	}
+
+	return osmomath.OneBigDec(), nil
+}
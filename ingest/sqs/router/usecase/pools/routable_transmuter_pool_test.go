@@ -7,6 +7,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/pools"
+	cwpoolmodel "github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/model"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -83,3 +84,70 @@ func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_Transmuter() {
 		})
 	}
 }
+
+// Tests that quotes exceeding the remaining capacity of the token in of an alloyed transmuter pool are rejected.
+func (s *RoutablePoolTestSuite) TestCalculateTokenOutByTokenIn_AlloyTransmuter() {
+	defaultAmount := DefaultAmt0
+	remainingCapacity := defaultAmount.QuoRaw(2)
+	upperLimit := osmomath.MustNewDecFromStr("0.75")
+
+	alloyTransmuter := &domain.AlloyTransmuterModel{
+		Assets: []domain.AlloyTransmuterAsset{
+			{Denom: USDC, Amount: defaultAmount, UpperLimit: &upperLimit, RemainingCapacity: &remainingCapacity},
+			{Denom: ETH, Amount: defaultAmount},
+		},
+	}
+
+	tests := map[string]struct {
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		expectError   error
+	}{
+		"within remaining capacity": {
+			tokenIn:       sdk.NewCoin(USDC, remainingCapacity),
+			tokenOutDenom: ETH,
+		},
+		"no static limiter on token in": {
+			tokenIn:       sdk.NewCoin(ETH, defaultAmount),
+			tokenOutDenom: USDC,
+		},
+		"error: exceeds remaining capacity": {
+			tokenIn:       sdk.NewCoin(USDC, remainingCapacity.Add(osmomath.OneInt())),
+			tokenOutDenom: ETH,
+
+			expectError: domain.AlloyTransmuterCapacityExceededError{
+				Denom:             USDC,
+				RemainingCapacity: remainingCapacity.String(),
+				Amount:            remainingCapacity.Add(osmomath.OneInt()).String(),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+
+			cosmwasmPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{USDC, ETH})
+			chainPool, ok := cosmwasmPool.AsSerializablePool().(*cwpoolmodel.CosmWasmPool)
+			s.Require().True(ok)
+
+			routablePool := &pools.RoutableTransmuterPoolImpl{
+				ChainPool:       chainPool,
+				Balances:        sdk.NewCoins(sdk.NewCoin(USDC, defaultAmount), sdk.NewCoin(ETH, defaultAmount)),
+				TokenOutDenom:   tc.tokenOutDenom,
+				TakerFee:        noTakerFee,
+				AlloyTransmuter: alloyTransmuter,
+			}
+
+			tokenOut, err := routablePool.CalculateTokenOutByTokenIn(tc.tokenIn)
+
+			if expectedErr, ok := tc.expectError.(domain.AlloyTransmuterCapacityExceededError); ok {
+				expectedErr.PoolId = cosmwasmPool.GetId()
+				s.Require().ErrorIs(err, expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.tokenIn.Amount, tokenOut.Amount)
+		})
+	}
+}
@@ -11,10 +11,13 @@ import (
 )
 
 type quoteImpl struct {
-	AmountIn     sdk.Coin            "json:\"amount_in\""
-	AmountOut    osmomath.Int        "json:\"amount_out\""
-	Route        []domain.SplitRoute "json:\"route\""
-	EffectiveFee osmomath.Dec        "json:\"effective_fee\""
+	AmountIn          sdk.Coin            "json:\"amount_in\""
+	AmountOut         osmomath.Int        "json:\"amount_out\""
+	Route             []domain.SplitRoute "json:\"route\""
+	EffectiveFee      osmomath.Dec        "json:\"effective_fee\""
+	BlockHeight       uint64              "json:\"block_height\""
+	Stale             bool                "json:\"stale\""
+	SlippageTolerance osmomath.Dec        "json:\"slippage_tolerance\""
 }
 
 // PrepareResult implements domain.Quote.
@@ -77,6 +80,17 @@ func (q *quoteImpl) GetEffectiveSpreadFactor() osmomath.Dec {
 	return q.EffectiveFee
 }
 
+// SetBlockHeight implements domain.Quote.
+func (q *quoteImpl) SetBlockHeight(height uint64) {
+	q.BlockHeight = height
+}
+
+// SetStale implements domain.Quote.
+func (q *quoteImpl) SetStale(isStale bool, slippageTolerance osmomath.Dec) {
+	q.Stale = isStale
+	q.SlippageTolerance = slippageTolerance
+}
+
 // String implements domain.Quote.
 func (q *quoteImpl) String() string {
 	var builder strings.Builder
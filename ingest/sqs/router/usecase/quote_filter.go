@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// basisPointsDenominator is the number of basis points in one.
+var basisPointsDenominator = osmomath.NewDec(10_000)
+
+// filterCandidateRoutesByLiquidityCap returns the candidate routes where every pool has
+// a liquidity cap of at least minPoolLiquidityCap, denominated in OSMO.
+// The unique pool IDs of the result are recomputed from the remaining routes.
+// Returns error if fails to retrieve the pools.
+func (r *routerUseCaseImpl) filterCandidateRoutesByLiquidityCap(ctx context.Context, candidateRoutes route.CandidateRoutes, minPoolLiquidityCap uint64) (route.CandidateRoutes, error) {
+	allPools, err := r.poolsUsecase.GetAllPools(ctx)
+	if err != nil {
+		return route.CandidateRoutes{}, err
+	}
+
+	minLiquidityCapUOSMO := osmomath.NewIntFromUint64(minPoolLiquidityCap).MulRaw(osmoPrecisionMultiplier)
+
+	liquidPoolIDs := make(map[uint64]struct{}, len(allPools))
+	for _, pool := range allPools {
+		if pool.GetTotalValueLockedUOSMO().GTE(minLiquidityCapUOSMO) {
+			liquidPoolIDs[pool.GetId()] = struct{}{}
+		}
+	}
+
+	filteredRoutes := route.CandidateRoutes{
+		Routes:        make([]route.CandidateRoute, 0, len(candidateRoutes.Routes)),
+		UniquePoolIDs: make(map[uint64]struct{}),
+	}
+
+	for _, candidateRoute := range candidateRoutes.Routes {
+		if !allPoolsInSet(candidateRoute, liquidPoolIDs) {
+			continue
+		}
+
+		filteredRoutes.Routes = append(filteredRoutes.Routes, candidateRoute)
+		for _, pool := range candidateRoute.Pools {
+			filteredRoutes.UniquePoolIDs[pool.ID] = struct{}{}
+		}
+	}
+
+	return filteredRoutes, nil
+}
+
+// allPoolsInSet returns true if every pool of the candidate route is in the given set.
+func allPoolsInSet(candidateRoute route.CandidateRoute, poolIDs map[uint64]struct{}) bool {
+	for _, pool := range candidateRoute.Pools {
+		if _, ok := poolIDs[pool.ID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// filterRoutesByPriceImpact returns the routes where swapping the full tokenIn
+// results in a price impact of at most maxPriceImpactBps.
+// Routes for which the price impact cannot be estimated are pruned.
+func filterRoutesByPriceImpact(routes []route.RouteImpl, tokenIn sdk.Coin, maxPriceImpactBps uint64) []route.RouteImpl {
+	maxPriceImpact := osmomath.NewDecFromInt(osmomath.NewIntFromUint64(maxPriceImpactBps)).Quo(basisPointsDenominator)
+
+	filteredRoutes := make([]route.RouteImpl, 0, len(routes))
+	for _, route := range routes {
+		priceImpact, err := routePriceImpact(route, tokenIn)
+		if err != nil || priceImpact.GT(maxPriceImpact) {
+			continue
+		}
+
+		filteredRoutes = append(filteredRoutes, route)
+	}
+
+	return filteredRoutes
+}
+
+// routePriceImpact returns the price impact of swapping tokenIn over the route.
+// That is, the relative difference between the amount out at the spot prices of the pools
+// and the actual amount out. Spread factors are deducted from the spot amount out
+// so that only the impact of the swap size on the pool prices is measured.
+// Returns error if fails to compute the spot price of any pool or the actual amount out.
+func routePriceImpact(route route.RouteImpl, tokenIn sdk.Coin) (osmomath.Dec, error) {
+	tokenOut, err := route.CalculateTokenOutByTokenIn(tokenIn)
+	if err != nil {
+		return osmomath.Dec{}, err
+	}
+
+	spotAmountOut := osmomath.BigDecFromDec(tokenIn.Amount.ToLegacyDec())
+	previousTokenOutDenom := tokenIn.Denom
+	for _, pool := range route.GetPools() {
+		spotPrice, err := pool.SpotPrice(pool.GetTokenOutDenom(), previousTokenOutDenom)
+		if err != nil {
+			return osmomath.Dec{}, err
+		}
+
+		spreadFactorComplement := osmomath.BigDecFromDec(osmomath.OneDec().Sub(pool.GetSpreadFactor()))
+		spotAmountOut = spotAmountOut.Mul(spotPrice).Mul(spreadFactorComplement)
+
+		previousTokenOutDenom = pool.GetTokenOutDenom()
+	}
+
+	if !spotAmountOut.IsPositive() {
+		return osmomath.Dec{}, ErrZeroSpotAmountOut
+	}
+
+	priceImpact := osmomath.OneBigDec().Sub(osmomath.BigDecFromDec(tokenOut.Amount.ToLegacyDec()).Quo(spotAmountOut))
+
+	// Rounding may make the amount out exceed the spot amount out by a negligible margin.
+	if priceImpact.IsNegative() {
+		return osmomath.ZeroDec(), nil
+	}
+
+	return priceImpact.Dec(), nil
+}
@@ -0,0 +1,156 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// Tests that the candidate routes containing a pool with a liquidity cap
+// below the minimum are pruned.
+func (s *RouterTestSuite) TestFilterCandidateRoutesByLiquidityCap() {
+	var (
+		liquidPool   = mocks.WithPoolID(DefaultMockPool, 1)
+		illiquidPool = mocks.WithPoolID(DefaultMockPool, 2)
+
+		singleHopRoute = WithCandidateRoutePools(EmptyCandidateRoute, []route.CandidatePool{
+			{ID: liquidPool.GetId(), TokenOutDenom: DenomTwo},
+		})
+		multiHopRoute = WithCandidateRoutePools(EmptyCandidateRoute, []route.CandidatePool{
+			{ID: liquidPool.GetId(), TokenOutDenom: DenomTwo},
+			{ID: illiquidPool.GetId(), TokenOutDenom: DenomOne},
+		})
+
+		candidateRoutes = route.CandidateRoutes{
+			Routes: []route.CandidateRoute{singleHopRoute, multiHopRoute},
+			UniquePoolIDs: map[uint64]struct{}{
+				liquidPool.GetId():   {},
+				illiquidPool.GetId(): {},
+			},
+		}
+	)
+
+	// Liquidity caps are in uosmo while the filter is in OSMO.
+	liquidPool.TotalValueLockedUSDC = osmomath.NewInt(20 * usecase.OsmoPrecisionMultiplier)
+	illiquidPool.TotalValueLockedUSDC = osmomath.NewInt(5 * usecase.OsmoPrecisionMultiplier)
+
+	tests := map[string]struct {
+		minPoolLiquidityCap uint64
+
+		expectedRoutes route.CandidateRoutes
+	}{
+		"all pools satisfy the min liquidity cap": {
+			minPoolLiquidityCap: 5,
+
+			expectedRoutes: candidateRoutes,
+		},
+		"route with illiquid pool is pruned": {
+			minPoolLiquidityCap: 10,
+
+			expectedRoutes: route.CandidateRoutes{
+				Routes: []route.CandidateRoute{singleHopRoute},
+				UniquePoolIDs: map[uint64]struct{}{
+					liquidPool.GetId(): {},
+				},
+			},
+		},
+		"all routes are pruned": {
+			minPoolLiquidityCap: 21,
+
+			expectedRoutes: route.CandidateRoutes{
+				Routes:        []route.CandidateRoute{},
+				UniquePoolIDs: map[uint64]struct{}{},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			poolsUseCaseMock := &mocks.PoolsUsecaseMock{
+				Pools: []domain.PoolI{liquidPool, illiquidPool},
+			}
+
+			routerUseCase := usecase.NewRouterUsecase(time.Second, &mocks.RedisRouterRepositoryMock{}, poolsUseCaseMock, domain.RouterConfig{}, &log.NoOpLogger{})
+			routerUseCaseImpl, ok := routerUseCase.(*usecase.RouterUseCaseImpl)
+			s.Require().True(ok)
+
+			actualRoutes, err := routerUseCaseImpl.FilterCandidateRoutesByLiquidityCap(context.Background(), candidateRoutes, tc.minPoolLiquidityCap)
+			s.Require().NoError(err)
+
+			s.Require().Equal(tc.expectedRoutes, actualRoutes)
+		})
+	}
+}
+
+// Tests that the routes where swapping the full token in results in a price impact
+// above the maximum are pruned.
+func (s *RouterTestSuite) TestFilterRoutesByPriceImpact() {
+	s.Setup()
+
+	balancerPoolID := s.PrepareBalancerPoolWithCoins(
+		sdk.NewCoin(DenomOne, sdk.NewInt(1_000_000_000_000)),
+		sdk.NewCoin(DenomTwo, sdk.NewInt(2_000_000_000_000)),
+	)
+	balancerPool, err := s.App.PoolManagerKeeper.GetPool(s.Ctx, balancerPoolID)
+	s.Require().NoError(err)
+
+	routes := []route.RouteImpl{
+		WithRoutePools(route.RouteImpl{}, []domain.RoutablePool{
+			mocks.WithChainPoolModel(mocks.WithTokenOutDenom(DefaultMockPool, DenomTwo), balancerPool),
+		}),
+	}
+
+	tests := map[string]struct {
+		tokenIn           sdk.Coin
+		maxPriceImpactBps uint64
+
+		expectedPriceImpact osmomath.Dec
+		expectedRoutes      []route.RouteImpl
+	}{
+		"small swap has negligible price impact": {
+			tokenIn:           sdk.NewCoin(DenomOne, sdk.NewInt(1_000_000)),
+			maxPriceImpactBps: 1,
+
+			expectedPriceImpact: osmomath.MustNewDecFromStr("0.000001"),
+			expectedRoutes:      routes,
+		},
+		"swap of ~10% of the pool reserves exceeds max price impact": {
+			tokenIn:           sdk.NewCoin(DenomOne, sdk.NewInt(111_669_458_403)),
+			maxPriceImpactBps: 900,
+
+			// in * (1 - spread factor) / (reserve in + in * (1 - spread factor)) = 10%
+			expectedPriceImpact: osmomath.MustNewDecFromStr("0.1"),
+			expectedRoutes:      []route.RouteImpl{},
+		},
+		"swap of ~10% of the pool reserves is within max price impact": {
+			tokenIn:           sdk.NewCoin(DenomOne, sdk.NewInt(111_669_458_403)),
+			maxPriceImpactBps: 1001,
+
+			expectedPriceImpact: osmomath.MustNewDecFromStr("0.1"),
+			expectedRoutes:      routes,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			priceImpact, err := usecase.RoutePriceImpact(routes[0], tc.tokenIn)
+			s.Require().NoError(err)
+
+			// Allow for the rounding of the amount out.
+			s.Require().True(priceImpact.Sub(tc.expectedPriceImpact).Abs().LT(osmomath.MustNewDecFromStr("0.000001")), priceImpact.String())
+
+			actualRoutes := usecase.FilterRoutesByPriceImpact(routes, tc.tokenIn, tc.maxPriceImpactBps)
+			s.Require().Equal(tc.expectedRoutes, actualRoutes)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+// WithRouteRestrictions instruments router by setting the given route restrictions on it and returns the router.
+// The candidate routes found by the router never go through the excluded pools or pools with excluded denoms,
+// and every hop between the denoms of a canonical pool goes through that pool.
+func WithRouteRestrictions(router *Router, restrictions domain.RouteRestrictions) *Router {
+	router.excludedPoolIDs = make(map[uint64]struct{}, len(restrictions.ExcludedPoolIDs))
+	for _, poolID := range restrictions.ExcludedPoolIDs {
+		router.excludedPoolIDs[poolID] = struct{}{}
+	}
+
+	router.excludedDenoms = make(map[string]struct{}, len(restrictions.ExcludedDenoms))
+	for _, denom := range restrictions.ExcludedDenoms {
+		router.excludedDenoms[denom] = struct{}{}
+	}
+
+	router.canonicalPoolIDs = make(map[domain.DenomPair]uint64, len(restrictions.CanonicalPools))
+	for _, canonicalPool := range restrictions.CanonicalPools {
+		router.canonicalPoolIDs[domain.NewDenomPair(canonicalPool.Denom0, canonicalPool.Denom1)] = canonicalPool.PoolID
+	}
+
+	return router
+}
+
+// hasRouteRestrictions returns true if any route restriction is set on the router. False otherwise.
+func (r Router) hasRouteRestrictions() bool {
+	return len(r.excludedPoolIDs) > 0 || len(r.excludedDenoms) > 0 || len(r.canonicalPoolIDs) > 0
+}
+
+// isPoolExcluded returns true if the pool with the given ID and denoms is excluded from routing,
+// either by its ID or by any of its denoms. False otherwise.
+func (r Router) isPoolExcluded(poolID uint64, poolDenoms []string) bool {
+	if _, ok := r.excludedPoolIDs[poolID]; ok {
+		return true
+	}
+
+	for _, denom := range poolDenoms {
+		if _, ok := r.excludedDenoms[denom]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isCanonicalPoolForHop returns true if a hop from tokenInDenom to tokenOutDenom may go through the given pool.
+// That is, if no canonical pool is pinned for the denoms or the given pool is the pinned one.
+func (r Router) isCanonicalPoolForHop(poolID uint64, tokenInDenom, tokenOutDenom string) bool {
+	canonicalPoolID, ok := r.canonicalPoolIDs[domain.NewDenomPair(tokenInDenom, tokenOutDenom)]
+	return !ok || canonicalPoolID == poolID
+}
+
+// isCandidateRouteRestricted returns true if the candidate route starting at tokenInDenom goes through
+// an excluded pool or through a pool other than the canonical one for any of its hops. False otherwise.
+// poolDenoms are the denoms of every pool by ID. Pools that are missing from it are considered excluded.
+func (r Router) isCandidateRouteRestricted(candidateRoute route.CandidateRoute, tokenInDenom string, poolDenoms map[uint64][]string) bool {
+	previousTokenOutDenom := tokenInDenom
+	for _, pool := range candidateRoute.Pools {
+		denoms, ok := poolDenoms[pool.ID]
+		if !ok || r.isPoolExcluded(pool.ID, denoms) {
+			return true
+		}
+
+		if !r.isCanonicalPoolForHop(pool.ID, previousTokenOutDenom, pool.TokenOutDenom) {
+			return true
+		}
+
+		previousTokenOutDenom = pool.TokenOutDenom
+	}
+	return false
+}
+
+// filterCandidateRoutesByRestrictions returns the candidate routes starting at tokenInDenom that
+// satisfy the route restrictions of the router.
+// This is necessary for the cached routes that were computed before the restrictions were updated.
+// The unique pool IDs of the result are recomputed from the remaining routes.
+// Returns error if fails to retrieve the pools.
+func (r *routerUseCaseImpl) filterCandidateRoutesByRestrictions(ctx context.Context, router *Router, candidateRoutes route.CandidateRoutes, tokenInDenom string) (route.CandidateRoutes, error) {
+	allPools, err := r.poolsUsecase.GetAllPools(ctx)
+	if err != nil {
+		return route.CandidateRoutes{}, err
+	}
+
+	poolDenoms := make(map[uint64][]string, len(allPools))
+	for _, pool := range allPools {
+		poolDenoms[pool.GetId()] = pool.GetPoolDenoms()
+	}
+
+	filteredRoutes := route.CandidateRoutes{
+		Routes:        make([]route.CandidateRoute, 0, len(candidateRoutes.Routes)),
+		UniquePoolIDs: make(map[uint64]struct{}),
+	}
+
+	for _, candidateRoute := range candidateRoutes.Routes {
+		if router.isCandidateRouteRestricted(candidateRoute, tokenInDenom, poolDenoms) {
+			continue
+		}
+
+		filteredRoutes.Routes = append(filteredRoutes.Routes, candidateRoute)
+		for _, pool := range candidateRoute.Pools {
+			filteredRoutes.UniquePoolIDs[pool.ID] = struct{}{}
+		}
+	}
+
+	return filteredRoutes, nil
+}
+
+// GetRouteRestrictions implements mvc.RouterUsecase.
+func (r *routerUseCaseImpl) GetRouteRestrictions() domain.RouteRestrictions {
+	return r.config.RouteRestrictions
+}
@@ -0,0 +1,171 @@
+package usecase_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mocks"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase/route"
+)
+
+var (
+	// Two direct pools and a two-hop route through DenomThree between DenomOne and DenomTwo.
+	restrictionsDirectPoolOne = mocks.WithPoolID(DefaultMockPool, 1)
+	restrictionsDirectPoolTwo = mocks.WithPoolID(DefaultMockPool, 2)
+	restrictionsFirstHopPool  = mocks.WithDenoms(mocks.WithPoolID(DefaultMockPool, 3), []string{DenomOne, DenomThree})
+	restrictionsSecondHopPool = mocks.WithDenoms(mocks.WithPoolID(DefaultMockPool, 4), []string{DenomThree, DenomTwo})
+
+	restrictionsPools = []domain.PoolI{restrictionsDirectPoolOne, restrictionsDirectPoolTwo, restrictionsFirstHopPool, restrictionsSecondHopPool}
+)
+
+// Tests that the candidate routes do not go through excluded pools or pools with excluded denoms
+// and that hops between the denoms of a canonical pool only go through that pool.
+func (s *RouterTestSuite) TestGetCandidateRoutes_RouteRestrictions() {
+	tests := map[string]struct {
+		restrictions domain.RouteRestrictions
+
+		expectedRoutePoolIDs [][]uint64
+	}{
+		"no restrictions": {
+			expectedRoutePoolIDs: [][]uint64{{1}, {2}, {3, 4}},
+		},
+		"excluded pool": {
+			restrictions: domain.RouteRestrictions{ExcludedPoolIDs: []uint64{1}},
+
+			expectedRoutePoolIDs: [][]uint64{{2}, {3, 4}},
+		},
+		"excluded intermediary denom": {
+			restrictions: domain.RouteRestrictions{ExcludedDenoms: []string{DenomThree}},
+
+			expectedRoutePoolIDs: [][]uint64{{1}, {2}},
+		},
+		"excluded token out denom": {
+			restrictions: domain.RouteRestrictions{ExcludedDenoms: []string{DenomTwo}},
+
+			expectedRoutePoolIDs: [][]uint64{},
+		},
+		"canonical pool for token in and token out": {
+			restrictions: domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: DenomOne, Denom1: DenomTwo, PoolID: 2}}},
+
+			expectedRoutePoolIDs: [][]uint64{{2}, {3, 4}},
+		},
+		"canonical pool for intermediary hop in reverse denom order": {
+			restrictions: domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: DenomTwo, Denom1: DenomThree, PoolID: 4}}},
+
+			expectedRoutePoolIDs: [][]uint64{{1}, {2}, {3, 4}},
+		},
+		"canonical pool that does not exist": {
+			restrictions: domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: DenomOne, Denom1: DenomThree, PoolID: 5}}},
+
+			expectedRoutePoolIDs: [][]uint64{{1}, {2}},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			router := usecase.NewRouter([]uint64{}, 2, 4, 4, 10, 0, &log.NoOpLogger{})
+			router = usecase.WithSortedPools(router, restrictionsPools)
+			router = usecase.WithRouteRestrictions(router, tc.restrictions)
+
+			candidateRoutes, err := router.GetCandidateRoutes(DenomOne, DenomTwo)
+			s.Require().NoError(err)
+
+			s.Require().ElementsMatch(tc.expectedRoutePoolIDs, candidateRoutePoolIDs(candidateRoutes))
+		})
+	}
+}
+
+// Tests that the cached routes that do not satisfy the route restrictions are pruned
+// and that the routes are recomputed if none remain.
+func (s *RouterTestSuite) TestHandleRoutes_RouteRestrictions() {
+	var (
+		directRoute = WithCandidateRoutePools(EmptyCandidateRoute, []route.CandidatePool{
+			{ID: restrictionsDirectPoolOne.GetId(), TokenOutDenom: DenomTwo},
+		})
+		twoHopRoute = WithCandidateRoutePools(EmptyCandidateRoute, []route.CandidatePool{
+			{ID: restrictionsFirstHopPool.GetId(), TokenOutDenom: DenomThree},
+			{ID: restrictionsSecondHopPool.GetId(), TokenOutDenom: DenomTwo},
+		})
+
+		cachedRoutes = route.CandidateRoutes{
+			Routes: []route.CandidateRoute{directRoute, twoHopRoute},
+			UniquePoolIDs: map[uint64]struct{}{
+				restrictionsDirectPoolOne.GetId(): {},
+				restrictionsFirstHopPool.GetId():  {},
+				restrictionsSecondHopPool.GetId(): {},
+			},
+		}
+	)
+
+	tests := map[string]struct {
+		restrictions domain.RouteRestrictions
+
+		expectedRoutePoolIDs [][]uint64
+	}{
+		"no restrictions -> cached routes are used": {
+			expectedRoutePoolIDs: [][]uint64{{1}, {3, 4}},
+		},
+		"excluded denom -> cached route through its pool is pruned": {
+			restrictions: domain.RouteRestrictions{ExcludedDenoms: []string{DenomThree}},
+
+			expectedRoutePoolIDs: [][]uint64{{1}},
+		},
+		"canonical pool -> cached routes through other pools for the pair are pruned": {
+			restrictions: domain.RouteRestrictions{CanonicalPools: []domain.CanonicalPool{{Denom0: DenomOne, Denom1: DenomThree, PoolID: 5}}},
+
+			expectedRoutePoolIDs: [][]uint64{{1}},
+		},
+		"all cached routes are pruned -> routes are recomputed": {
+			restrictions: domain.RouteRestrictions{ExcludedPoolIDs: []uint64{1, 3}},
+
+			expectedRoutePoolIDs: [][]uint64{{2}},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			routerRepositoryMock := &mocks.RedisRouterRepositoryMock{
+				Routes: map[domain.DenomPair]route.CandidateRoutes{
+					domain.NewDenomPair(DenomOne, DenomTwo): cachedRoutes,
+				},
+			}
+
+			poolsUseCaseMock := &mocks.PoolsUsecaseMock{
+				Pools: restrictionsPools,
+			}
+
+			routerUseCase := usecase.NewRouterUsecase(time.Second, routerRepositoryMock, poolsUseCaseMock, domain.RouterConfig{
+				MaxPoolsPerRoute:  2,
+				MaxRoutes:         4,
+				RouteCacheEnabled: true,
+				RouteRestrictions: tc.restrictions,
+			}, &log.NoOpLogger{})
+
+			s.Require().Equal(tc.restrictions, routerUseCase.GetRouteRestrictions())
+
+			// System under test
+			candidateRoutes, err := routerUseCase.GetCandidateRoutes(context.Background(), DenomOne, DenomTwo)
+			s.Require().NoError(err)
+
+			s.Require().ElementsMatch(tc.expectedRoutePoolIDs, candidateRoutePoolIDs(candidateRoutes))
+		})
+	}
+}
+
+// candidateRoutePoolIDs returns the IDs of the pools of every candidate route.
+func candidateRoutePoolIDs(candidateRoutes route.CandidateRoutes) [][]uint64 {
+	routePoolIDs := make([][]uint64, 0, len(candidateRoutes.Routes))
+	for _, candidateRoute := range candidateRoutes.Routes {
+		poolIDs := make([]uint64, 0, len(candidateRoute.Pools))
+		for _, pool := range candidateRoute.Pools {
+			poolIDs = append(poolIDs, pool.ID)
+		}
+		routePoolIDs = append(routePoolIDs, poolIDs)
+	}
+	return routePoolIDs
+}
@@ -29,6 +29,13 @@ type Router struct {
 
 	minOSMOTVL int
 
+	// The pools that are never routed through.
+	excludedPoolIDs map[uint64]struct{}
+	// The denoms whose pools are never routed through.
+	excludedDenoms map[string]struct{}
+	// The pools that are exclusively routed through for hops between their denoms.
+	canonicalPoolIDs map[domain.DenomPair]uint64
+
 	routerRepository mvc.RouterRepository
 
 	poolsUsecase mvc.PoolsUsecase
@@ -181,7 +188,8 @@ func sortPools(pools []domain.PoolI, totalTVL osmomath.Int, preferredPoolIDsMap
 		}
 
 		// Transmuter pools get a boost equal to 3/2 of total value locked across all pools
-		if isTransmuter := pool.GetType() == poolmanagertypes.CosmWasm; isTransmuter {
+		// Note that orderbook pools are also CosmWasm pools but swaps against them incur slippage.
+		if isTransmuter := pool.GetType() == poolmanagertypes.CosmWasm && pool.GetSQSPoolModel().Orderbook == nil; isTransmuter {
 			rating = rating.Add(totalTVL.MulRaw(3).QuoRaw(2))
 		}
 
@@ -24,6 +24,9 @@ type routerUseCaseImpl struct {
 	poolsUsecase     mvc.PoolsUsecase
 	config           domain.RouterConfig
 	logger           log.Logger
+
+	// optimalRouteCache caches the optimal routes of unfiltered quotes per denom pair and amount bucket.
+	optimalRouteCache *optimalRouteCache
 }
 
 // NewRouterUsecase will create a new pools use case object
@@ -34,12 +37,26 @@ func NewRouterUsecase(timeout time.Duration, routerRepository mvc.RouterReposito
 		poolsUsecase:     poolsUsecase,
 		config:           config,
 		logger:           logger,
+
+		optimalRouteCache: newOptimalRouteCache(),
 	}
 }
 
 // GetOptimalQuote returns the optimal quote by estimating the optimal route(s) through pools
 // on the osmosis network.
-func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string) (domain.Quote, error) {
+// The routes that do not satisfy the given filter are pruned before estimating the quote.
+// Returns domain.NoRouteSatisfiesQuoteFilterError if the filter prunes all routes.
+// If the optimal route cache is enabled, unfiltered quotes are estimated over the routes and split proportions
+// cached for the amount bucket of the token in, if any. Otherwise, the optimal routes found are cached.
+func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coin, tokenOutDenom string, filter domain.QuoteFilter) (domain.Quote, error) {
+	// Filtered quotes consider a subset of routes, so they neither use nor populate the cache.
+	isOptimalRouteCacheUsed := r.config.OptimalRouteCache.Enabled && filter == (domain.QuoteFilter{})
+	if isOptimalRouteCacheUsed {
+		if quote, ok := r.getCachedOptimalQuote(ctx, tokenIn, tokenOutDenom); ok {
+			return quote, nil
+		}
+	}
+
 	router := r.initializeRouter()
 
 	candidateRoutes, err := r.handleRoutes(ctx, router, tokenIn.Denom, tokenOutDenom)
@@ -48,6 +65,16 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 		return nil, err
 	}
 
+	// Distinguishes between no routes existing at all and the filter pruning all of them.
+	hasUnfilteredRoutes := len(candidateRoutes.Routes) > 0
+
+	if filter.MinPoolLiquidityCap > 0 {
+		candidateRoutes, err = r.filterCandidateRoutesByLiquidityCap(ctx, candidateRoutes, filter.MinPoolLiquidityCap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for _, route := range candidateRoutes.Routes {
 		r.logger.Info("filtered_candidate_route", zap.Any("route", route))
 	}
@@ -64,7 +91,32 @@ func (r *routerUseCaseImpl) GetOptimalQuote(ctx context.Context, tokenIn sdk.Coi
 		return nil, err
 	}
 
-	return router.getOptimalQuote(tokenIn, routes)
+	if filter.MaxPriceImpactBps > 0 {
+		routes = filterRoutesByPriceImpact(routes, tokenIn, filter.MaxPriceImpactBps)
+	}
+
+	if len(routes) == 0 && hasUnfilteredRoutes {
+		return nil, domain.NoRouteSatisfiesQuoteFilterError{
+			TokenInDenom:        tokenIn.Denom,
+			TokenOutDenom:       tokenOutDenom,
+			MaxPriceImpactBps:   filter.MaxPriceImpactBps,
+			MinPoolLiquidityCap: filter.MinPoolLiquidityCap,
+		}
+	}
+
+	quote, err := router.getOptimalQuote(tokenIn, routes)
+	if err != nil {
+		return nil, err
+	}
+
+	if isOptimalRouteCacheUsed {
+		// Failing to cache the routes does not fail the quote.
+		if err := r.setCachedOptimalQuote(ctx, quote, tokenOutDenom); err != nil {
+			r.logger.Error("error caching optimal routes", zap.Error(err))
+		}
+	}
+
+	return quote, nil
 }
 
 // GetBestSingleRouteQuote returns the best single route quote to be done directly without a split.
@@ -182,6 +234,7 @@ func (r *routerUseCaseImpl) initializeRouter() *Router {
 	router := NewRouter([]uint64{}, r.config.MaxPoolsPerRoute, r.config.MaxRoutes, r.config.MaxSplitRoutes, r.config.MaxSplitIterations, r.config.MinOSMOLiquidity, r.logger)
 	router = WithRouterRepository(router, r.routerRepository)
 	router = WithPoolsUsecase(router, r.poolsUsecase)
+	router = WithRouteRestrictions(router, r.GetRouteRestrictions())
 
 	r.logger.Info("sorted pools", zap.Int("num_pools", len(router.sortedPools)))
 	for _, pool := range router.sortedPools {
@@ -193,6 +246,7 @@ func (r *routerUseCaseImpl) initializeRouter() *Router {
 
 // handleRoutes attempts to retrieve routes from the cache. If no routes are cached, it will
 // compute, persist in cache and return them.
+// Cached routes that do not satisfy the route restrictions are pruned. If none remain, the routes are recomputed.
 // Returns routes on success
 // Errors if:
 // - there is an error retrieving routes from cache
@@ -207,6 +261,14 @@ func (r *routerUseCaseImpl) handleRoutes(ctx context.Context, router *Router, to
 		if err != nil {
 			return route.CandidateRoutes{}, err
 		}
+
+		// Cached routes may predate the current route restrictions.
+		if len(candidateRoutes.Routes) > 0 && router.hasRouteRestrictions() {
+			candidateRoutes, err = r.filterCandidateRoutesByRestrictions(ctx, router, candidateRoutes, tokenInDenom)
+			if err != nil {
+				return route.CandidateRoutes{}, err
+			}
+		}
 	}
 
 	// TODO: swithch to debug
@@ -13,6 +13,10 @@ import (
 	"github.com/labstack/echo"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	chainInfoRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/repository/redis"
 	chainInfoUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/chain_info/usecase"
@@ -20,17 +24,24 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/log"
 	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/middleware"
+	passthroughHttpDelivery "github.com/osmosis-labs/osmosis/v21/ingest/sqs/passthrough/delivery/http"
+	passthroughUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/passthrough/usecase"
 	poolsHttpDelivery "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/delivery/http"
 	poolsRedisRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/repository/redis"
 	poolsUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pools/usecase"
+	pricingUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/pricing/usecase"
 	redisrepo "github.com/osmosis-labs/osmosis/v21/ingest/sqs/repository/redis"
 	routerRedisRepository "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/repository/redis"
+	tokensHttpDelivery "github.com/osmosis-labs/osmosis/v21/ingest/sqs/tokens/delivery/http"
 	tokensUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/tokens/usecase"
 
 	routerHttpDelivery "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/delivery/http"
 	routerUseCase "github.com/osmosis-labs/osmosis/v21/ingest/sqs/router/usecase"
 
 	systemhttpdelivery "github.com/osmosis-labs/osmosis/v21/ingest/sqs/system/delivery/http"
+
+	concentratedqueryproto "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 )
 
 // SideCarQueryServer defines an interface for sidecar query server (SQS).
@@ -84,7 +95,10 @@ func (sqs *sideCarQueryServer) GetLogger() log.Logger {
 }
 
 // NewSideCarQueryServer creates a new sidecar query server (SQS).
-func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfig, dbHost, dbPort, sideCarQueryServerAddress, grpcAddress string, useCaseTimeoutDuration int, logger log.Logger) (SideCarQueryServer, error) {
+// The endpoints of the network of the node are served at the root path and, if chainID is set, under
+// the /{chain-id} path prefix. The endpoints of each of the additional networks are served under
+// their own path prefix, from their own Redis database.
+func NewSideCarQueryServer(appCodec codec.Codec, chainID string, redisDB int, routerConfig domain.RouterConfig, pricingConfig domain.PricingConfig, networks []domain.NetworkConfig, dbHost, dbPort, sideCarQueryServerAddress, grpcAddress, nodeGRPCAddress string, useCaseTimeoutDuration int, logger log.Logger) (SideCarQueryServer, error) {
 	// Handle SIGINT and SIGTERM signals to initiate shutdown
 	exitChan := make(chan os.Signal, 1)
 	signal.Notify(exitChan, os.Interrupt, syscall.SIGTERM)
@@ -117,13 +131,77 @@ func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfi
 		os.Exit(0)
 	}()
 
-	// Create redis client and ensure that it is up.
 	redisAddress := fmt.Sprintf("%s:%s", dbHost, dbPort)
-	logger.Info("Pinging redis", zap.String("redis_address", redisAddress))
+	timeoutContext := time.Duration(useCaseTimeoutDuration) * time.Second
+
+	// Initialize the network of the node. Its data is ingested by this node.
+	httpRouters := []mvc.HTTPRouter{e}
+	if chainID != "" {
+		httpRouters = append(httpRouters, e.Group("/"+chainID))
+	}
+	nodeNetwork, err := newNetwork(ctx, appCodec, redisAddress, redisDB, routerConfig, pricingConfig, nodeGRPCAddress, timeoutContext, logger, httpRouters...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize the additional networks. Their data is ingested by nodes of these networks.
+	for _, network := range networks {
+		if _, err := newNetwork(ctx, appCodec, redisAddress, network.RedisDB, network.Router, network.Pricing, network.NodeGRPCAddress, timeoutContext, logger, e.Group("/"+network.ChainID)); err != nil {
+			return nil, fmt.Errorf("error while initializing network %s: %w", network.ChainID, err)
+		}
+	}
+
+	// Initialize system handler
+	systemhttpdelivery.NewSystemHandler(e, redisAddress, grpcAddress, logger, nodeNetwork.chainInfoUseCase)
+
+	// Start server in a separate goroutine
+	go func() {
+		logger.Info("Starting sidecar query server", zap.String("address", sideCarQueryServerAddress))
+		err = e.Start(sideCarQueryServerAddress)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	go func() {
+		logger.Info("Starting profiling server")
+		err = http.ListenAndServe("localhost:6061", nil)
+		if err != nil {
+			panic(err)
+		}
+	}()
+
+	return &sideCarQueryServer{
+		txManager:           nodeNetwork.txManager,
+		poolsRepository:     nodeNetwork.poolsRepository,
+		chainInfoRepository: nodeNetwork.chainInfoRepository,
+		routerRepository:    nodeNetwork.routerRepository,
+		tokensUseCase:       nodeNetwork.tokensUseCase,
+		logger:              logger,
+	}, nil
+}
+
+// network encapsulates the repositories and use cases of a network served by the sidecar query server.
+type network struct {
+	txManager           mvc.TxManager
+	poolsRepository     mvc.PoolsRepository
+	chainInfoRepository mvc.ChainInfoRepository
+	routerRepository    mvc.RouterRepository
+	tokensUseCase       domain.TokensUsecase
+	chainInfoUseCase    mvc.ChainInfoUsecase
+}
+
+// newNetwork creates the repositories and use cases of a network whose data is stored in the given Redis database,
+// and registers its HTTP handlers with each of the given routers.
+// The passthrough handler is only registered if the gRPC address of a node of the network is given.
+// Returns error if Redis is not up or the connection to the node cannot be set up.
+func newNetwork(ctx context.Context, appCodec codec.Codec, redisAddress string, redisDB int, routerConfig domain.RouterConfig, pricingConfig domain.PricingConfig, nodeGRPCAddress string, timeoutContext time.Duration, logger log.Logger, httpRouters ...mvc.HTTPRouter) (*network, error) {
+	// Create redis client and ensure that it is up.
+	logger.Info("Pinging redis", zap.String("redis_address", redisAddress), zap.Int("redis_db", redisDB))
 	redisClient := redis.NewClient(&redis.Options{
 		Addr:     redisAddress,
 		Password: "", // no password set
-		DB:       0,  // use default DB
+		DB:       redisDB,
 	})
 	redisStatus := redisClient.Ping(ctx)
 	_, err := redisStatus.Result()
@@ -134,48 +212,56 @@ func NewSideCarQueryServer(appCodec codec.Codec, routerConfig domain.RouterConfi
 	// Creare repository manager
 	redisTxManager := redisrepo.NewTxManager(redisClient)
 
-	// Initialize pools repository, usecase and HTTP handler
+	// Initialize pools repository and usecase
 	poolsRepository := poolsRedisRepository.NewRedisPoolsRepo(appCodec, redisTxManager)
-	timeoutContext := time.Duration(useCaseTimeoutDuration) * time.Second
 	poolsUseCase := poolsUseCase.NewPoolsUsecase(timeoutContext, poolsRepository, redisTxManager)
-	poolsHttpDelivery.NewPoolsHandler(e, poolsUseCase)
-
-	// Initialize router repository, usecase and HTTP handler
-	routerRepository := routerRedisRepository.NewRedisRouterRepo(redisTxManager)
-	routerUsecase := routerUseCase.NewRouterUsecase(timeoutContext, routerRepository, poolsUseCase, routerConfig, logger)
-	routerHttpDelivery.NewRouterHandler(e, routerUsecase, logger)
 
-	// Initialize system handler
+	// Initialize chain info repository and usecase
 	chainInfoRepository := chainInfoRepository.NewChainInfoRepo(redisTxManager)
 	chainInfoUseCase := chainInfoUseCase.NewChainInfoUsecase(timeoutContext, chainInfoRepository, redisTxManager)
-	systemhttpdelivery.NewSystemHandler(e, redisAddress, grpcAddress, logger, chainInfoUseCase)
+
+	// Initialize router repository and usecase
+	routerRepository := routerRedisRepository.NewRedisRouterRepo(redisTxManager)
+	routerUsecase := routerUseCase.NewRouterUsecase(timeoutContext, routerRepository, poolsUseCase, routerConfig, logger)
 
 	// Initialized tokens usecase
 	tokensUseCase := tokensUseCase.NewTokensUsecase(timeoutContext)
 
-	// Start server in a separate goroutine
-	go func() {
-		logger.Info("Starting sidecar query server", zap.String("address", sideCarQueryServerAddress))
-		err = e.Start(sideCarQueryServerAddress)
+	// Initialize pricing usecase
+	pricingUseCase := pricingUseCase.NewPricingUsecase(routerUsecase, chainInfoUseCase, tokensUseCase, pricingConfig, logger)
+
+	// Initialize passthrough usecase.
+	// Note that the connection to the node is established lazily.
+	var passthroughUsecase mvc.PassthroughUsecase
+	if nodeGRPCAddress != "" {
+		nodeGRPCConn, err := grpc.Dial(nodeGRPCAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
-	}()
+		passthroughUsecase = passthroughUseCase.NewPassthroughUsecase(
+			banktypes.NewQueryClient(nodeGRPCConn),
+			lockuptypes.NewQueryClient(nodeGRPCConn),
+			concentratedqueryproto.NewQueryClient(nodeGRPCConn),
+			chainInfoUseCase,
+		)
+	}
 
-	go func() {
-		logger.Info("Starting profiling server")
-		err = http.ListenAndServe("localhost:6061", nil)
-		if err != nil {
-			panic(err)
+	// Initialize HTTP handlers
+	for _, httpRouter := range httpRouters {
+		poolsHttpDelivery.NewPoolsHandler(httpRouter, poolsUseCase, chainInfoUseCase)
+		routerHttpDelivery.NewRouterHandler(httpRouter, routerUsecase, chainInfoUseCase, routerConfig.StaleQuotes, logger)
+		tokensHttpDelivery.NewTokensHandler(httpRouter, pricingUseCase, chainInfoUseCase, pricingConfig.DefaultQuoteDenom)
+		if passthroughUsecase != nil {
+			passthroughHttpDelivery.NewPassthroughHandler(httpRouter, passthroughUsecase)
 		}
-	}()
+	}
 
-	return &sideCarQueryServer{
+	return &network{
 		txManager:           redisTxManager,
 		poolsRepository:     poolsRepository,
 		chainInfoRepository: chainInfoRepository,
 		routerRepository:    routerRepository,
 		tokensUseCase:       tokensUseCase,
-		logger:              logger,
+		chainInfoUseCase:    chainInfoUseCase,
 	}, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/viper"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/ingest"
@@ -24,6 +25,12 @@ type Config struct {
 	StorageHost string `mapstructure:"db-host"`
 	StoragePort string `mapstructure:"db-port"`
 
+	// ChainID is the chain ID of the node. If set, the endpoints of the node's network are also
+	// served under the /{chain-id} path prefix.
+	ChainID string `mapstructure:"chain-id"`
+	// RedisDB is the index of the Redis database that the node's network data is ingested into.
+	RedisDB int `mapstructure:"db-index"`
+
 	// Defines the web server configuration.
 	ServerAddress             string `mapstructure:"server-address"`
 	ServerTimeoutDurationSecs int    `mapstructure:"timeout-duration-secs"`
@@ -35,8 +42,20 @@ type Config struct {
 
 	ChainGRPCGatewayEndpoint string `mapstructure:"grpc-gateway-endpoint"`
 
+	// NodeGRPCAddress is the gRPC address of the node that passthrough queries are sent to.
+	NodeGRPCAddress string `mapstructure:"node-grpc-address"`
+
 	// Router encapsulates the router config.
 	Router *domain.RouterConfig `mapstructure:"router"`
+
+	// Pricing encapsulates the pricing config.
+	Pricing *domain.PricingConfig `mapstructure:"pricing"`
+
+	// NetworkConfigFiles are the paths of the config files of the networks served in addition to the node's network.
+	NetworkConfigFiles []string `mapstructure:"network-config-files"`
+
+	// Networks are the networks served in addition to the node's network, loaded from NetworkConfigFiles.
+	Networks []domain.NetworkConfig `mapstructure:"-"`
 }
 
 const groupOptName = "osmosis-sqs"
@@ -49,6 +68,9 @@ var DefaultConfig = Config{
 	StorageHost: "localhost",
 	StoragePort: "6379",
 
+	ChainID: "",
+	RedisDB: 0,
+
 	ServerAddress:             ":9092",
 	ServerTimeoutDurationSecs: 2,
 
@@ -58,6 +80,8 @@ var DefaultConfig = Config{
 
 	ChainGRPCGatewayEndpoint: "http://localhost:26657",
 
+	NodeGRPCAddress: "localhost:9090",
+
 	Router: &domain.RouterConfig{
 		PreferredPoolIDs:          []uint64{},
 		MaxPoolsPerRoute:          4,
@@ -67,7 +91,32 @@ var DefaultConfig = Config{
 		MinOSMOLiquidity:          10000, // 10_000 OSMO
 		RouteUpdateHeightInterval: 0,
 		RouteCacheEnabled:         false,
+		OrderbookCodeIDs:          []uint64{},
+		AlloyTransmuterCodeIDs:    []uint64{},
+		RouteRestrictions: domain.RouteRestrictions{
+			ExcludedPoolIDs: []uint64{},
+			ExcludedDenoms:  []string{},
+			CanonicalPools:  []domain.CanonicalPool{},
+		},
+		StaleQuotes: domain.StaleQuoteConfig{
+			Enabled:                     true,
+			MaxIngestLagSecs:            30,
+			DefaultSlippageToleranceBps: 50,
+			StaleSlippageToleranceBps:   200,
+		},
+		OptimalRouteCache: domain.OptimalRouteCacheConfig{
+			Enabled:               false,
+			MaxLiquidityChangeBps: 100,
+		},
+	},
+
+	Pricing: &domain.PricingConfig{
+		// USDC.axl
+		DefaultQuoteDenom: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858",
+		FallbackDenoms:    []string{"uosmo"},
 	},
+
+	NetworkConfigFiles: []string{},
 }
 
 // NewConfigFromOptions returns a new sidecar query server config from the given options.
@@ -80,12 +129,20 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 		}
 	}
 
+	chainID := osmoutils.ParseString(opts, groupOptName, "chain-id")
+	redisDB := osmoutils.ParseInt(opts, groupOptName, "db-index")
+	networkConfigFiles := osmoutils.ParseStringSlice(opts, groupOptName, "network-config-files")
+	networks := parseNetworks(chainID, redisDB, networkConfigFiles)
+
 	return Config{
 		IsEnabled: isEnabled,
 
 		StorageHost: osmoutils.ParseString(opts, groupOptName, "db-host"),
 		StoragePort: osmoutils.ParseString(opts, groupOptName, "db-port"),
 
+		ChainID: chainID,
+		RedisDB: redisDB,
+
 		ServerAddress:             osmoutils.ParseString(opts, groupOptName, "server-address"),
 		ServerTimeoutDurationSecs: osmoutils.ParseInt(opts, groupOptName, "timeout-duration-secs"),
 
@@ -95,6 +152,8 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 
 		ChainGRPCGatewayEndpoint: osmoutils.ParseString(opts, groupOptName, "grpc-gateway-endpoint"),
 
+		NodeGRPCAddress: osmoutils.ParseString(opts, groupOptName, "node-grpc-address"),
+
 		Router: &domain.RouterConfig{
 			PreferredPoolIDs: osmoutils.ParseUint64Slice(opts, groupOptName, "preferred-pool-ids"),
 
@@ -111,10 +170,119 @@ func NewConfigFromOptions(opts servertypes.AppOptions) Config {
 			RouteUpdateHeightInterval: osmoutils.ParseInt(opts, groupOptName, "route-update-height-interval"),
 
 			RouteCacheEnabled: osmoutils.ParseBool(opts, groupOptName, "route-cache-enabled", false),
+
+			OrderbookCodeIDs: osmoutils.ParseUint64Slice(opts, groupOptName, "orderbook-code-ids"),
+
+			AlloyTransmuterCodeIDs: osmoutils.ParseUint64Slice(opts, groupOptName, "alloy-transmuter-code-ids"),
+
+			RouteRestrictions: parseRouteRestrictions(opts),
+
+			StaleQuotes: parseStaleQuoteConfig(opts),
+
+			OptimalRouteCache: parseOptimalRouteCacheConfig(opts),
+		},
+
+		Pricing: &domain.PricingConfig{
+			DefaultQuoteDenom: osmoutils.ParseString(opts, groupOptName, "default-quote-denom"),
+
+			FallbackDenoms: osmoutils.ParseStringSlice(opts, groupOptName, "fallback-denoms"),
 		},
+
+		NetworkConfigFiles: networkConfigFiles,
+
+		Networks: networks,
 	}
 }
 
+// parseNetworks loads the networks served in addition to the node's network from the given config files.
+// Each file configures a single network, with the keys of domain.NetworkConfig. The router and pricing
+// configs default to DefaultConfig for the keys that are not set.
+// Panics if a file cannot be loaded or the networks are invalidly configured.
+func parseNetworks(chainID string, redisDB int, networkConfigFiles []string) []domain.NetworkConfig {
+	networks := make([]domain.NetworkConfig, 0, len(networkConfigFiles))
+	for _, networkConfigFile := range networkConfigFiles {
+		v := viper.New()
+		v.SetConfigFile(networkConfigFile)
+		if err := v.ReadInConfig(); err != nil {
+			panic(fmt.Sprintf("failed to read %s network config file %s, err= %v", groupOptName, networkConfigFile, err))
+		}
+
+		network := domain.NetworkConfig{
+			Router:  *DefaultConfig.Router,
+			Pricing: *DefaultConfig.Pricing,
+		}
+		if err := v.Unmarshal(&network); err != nil {
+			panic(fmt.Sprintf("invalidly configured %s network config file %s, err= %v", groupOptName, networkConfigFile, err))
+		}
+		networks = append(networks, network)
+	}
+
+	if err := domain.ValidateNetworks(chainID, redisDB, networks); err != nil {
+		panic(fmt.Sprintf("invalidly configured %s networks, err= %v", groupOptName, err))
+	}
+
+	return networks
+}
+
+// parseRouteRestrictions parses the route restrictions from the server options.
+// Canonical pools are configured as a list of entries in the denom0|denom1|poolID format.
+// Panics if the restrictions are invalidly configured.
+func parseRouteRestrictions(opts servertypes.AppOptions) domain.RouteRestrictions {
+	canonicalPoolStrs := osmoutils.ParseStringSlice(opts, groupOptName, "canonical-pools")
+	canonicalPools := make([]domain.CanonicalPool, 0, len(canonicalPoolStrs))
+	for _, canonicalPoolStr := range canonicalPoolStrs {
+		canonicalPool, err := domain.ParseCanonicalPool(canonicalPoolStr)
+		if err != nil {
+			panic(fmt.Sprintf("invalidly configured %s.canonical-pools, err= %v", groupOptName, err))
+		}
+		canonicalPools = append(canonicalPools, canonicalPool)
+	}
+
+	restrictions := domain.RouteRestrictions{
+		ExcludedPoolIDs: osmoutils.ParseUint64Slice(opts, groupOptName, "excluded-pool-ids"),
+		ExcludedDenoms:  osmoutils.ParseStringSlice(opts, groupOptName, "excluded-denoms"),
+		CanonicalPools:  canonicalPools,
+	}
+
+	if err := restrictions.Validate(); err != nil {
+		panic(fmt.Sprintf("invalidly configured %s route restrictions, err= %v", groupOptName, err))
+	}
+
+	return restrictions
+}
+
+// parseStaleQuoteConfig parses the stale quote config from the server options.
+// Panics if the config is invalid.
+func parseStaleQuoteConfig(opts servertypes.AppOptions) domain.StaleQuoteConfig {
+	staleQuoteConfig := domain.StaleQuoteConfig{
+		Enabled:                     osmoutils.ParseBool(opts, groupOptName, "stale-quotes-enabled", true),
+		MaxIngestLagSecs:            osmoutils.ParseInt(opts, groupOptName, "max-ingest-lag-secs"),
+		DefaultSlippageToleranceBps: osmoutils.ParseInt(opts, groupOptName, "default-slippage-tolerance-bps"),
+		StaleSlippageToleranceBps:   osmoutils.ParseInt(opts, groupOptName, "stale-slippage-tolerance-bps"),
+	}
+
+	if err := staleQuoteConfig.Validate(); err != nil {
+		panic(fmt.Sprintf("invalidly configured %s stale quotes, err= %v", groupOptName, err))
+	}
+
+	return staleQuoteConfig
+}
+
+// parseOptimalRouteCacheConfig parses the optimal route cache config from the server options.
+// Panics if the config is invalid.
+func parseOptimalRouteCacheConfig(opts servertypes.AppOptions) domain.OptimalRouteCacheConfig {
+	optimalRouteCacheConfig := domain.OptimalRouteCacheConfig{
+		Enabled:               osmoutils.ParseBool(opts, groupOptName, "optimal-route-cache-enabled", false),
+		MaxLiquidityChangeBps: osmoutils.ParseInt(opts, groupOptName, "optimal-route-cache-max-liquidity-change-bps"),
+	}
+
+	if err := optimalRouteCacheConfig.Validate(); err != nil {
+		panic(fmt.Sprintf("invalidly configured %s optimal route cache, err= %v", groupOptName, err))
+	}
+
+	return optimalRouteCacheConfig
+}
+
 // Initialize initializes the sidecar query server and returns the ingester.
 func (c Config) Initialize(appCodec codec.Codec, keepers common.SQSIngestKeepers) (ingest.Ingester, error) {
 	// logger
@@ -127,11 +295,16 @@ func (c Config) Initialize(appCodec codec.Codec, keepers common.SQSIngestKeepers
 	// Create sidecar query server
 	sidecarQueryServer, err := NewSideCarQueryServer(
 		appCodec,
+		c.ChainID,
+		c.RedisDB,
 		*c.Router,
+		*c.Pricing,
+		c.Networks,
 		c.StorageHost,
 		c.StoragePort,
 		c.ServerAddress,
 		c.ChainGRPCGatewayEndpoint,
+		c.NodeGRPCAddress,
 		c.ServerTimeoutDurationSecs,
 		logger)
 	if err != nil {
@@ -0,0 +1,138 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo"
+	"github.com/sirupsen/logrus"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain"
+	"github.com/osmosis-labs/osmosis/v21/ingest/sqs/domain/mvc"
+)
+
+// ResponseError represent the response error struct
+type ResponseError struct {
+	Message string `json:"message"`
+}
+
+// PricesResponse represents the prices of the requested denoms in the quote denom.
+// The denoms that cannot be priced are omitted.
+type PricesResponse struct {
+	QuoteDenom string                     `json:"quote_denom"`
+	Prices     map[string]osmomath.BigDec `json:"prices"`
+}
+
+// TokensHandler  represent the httphandler for tokens
+type TokensHandler struct {
+	PUsecase          mvc.PricingUsecase
+	CIUsecase         mvc.ChainInfoUsecase
+	defaultQuoteDenom string
+}
+
+// NewTokensHandler will initialize the tokens/ resources endpoint
+func NewTokensHandler(e mvc.HTTPRouter, pu mvc.PricingUsecase, ciu mvc.ChainInfoUsecase, defaultQuoteDenom string) {
+	handler := &TokensHandler{
+		PUsecase:          pu,
+		CIUsecase:         ciu,
+		defaultQuoteDenom: defaultQuoteDenom,
+	}
+	e.GET("/tokens/prices", handler.GetPrices)
+}
+
+// GetPrices returns the prices of the comma-separated base denoms in the quote denom.
+// If the quote denom is not specified, the configured default quote denom is used.
+func (a *TokensHandler) GetPrices(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	baseDenoms, err := parseDenoms(c.QueryParam(domain.PricesBaseDenomsQueryParam))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, ResponseError{Message: err.Error()})
+	}
+
+	quoteDenom := c.QueryParam(domain.PricesQuoteDenomQueryParam)
+	if len(quoteDenom) == 0 {
+		quoteDenom = a.defaultQuoteDenom
+	}
+
+	if err := a.setIngestedHeight(c); err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	prices, err := a.PUsecase.GetPrices(ctx, baseDenoms, quoteDenom)
+	if err != nil {
+		return c.JSON(getStatusCode(err), ResponseError{Message: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, PricesResponse{
+		QuoteDenom: quoteDenom,
+		Prices:     prices,
+	})
+}
+
+// setIngestedHeight sets the latest ingested block height in the response header.
+// Returns domain.InvalidQueryParamError if the optional min block height query parameter supplied
+// by the client is invalid, and domain.MinBlockHeightNotReachedError if the ingested height is below it.
+func (a *TokensHandler) setIngestedHeight(c echo.Context) error {
+	minBlockHeight, err := domain.ParseMinBlockHeight(c.QueryParam(domain.MinBlockHeightQueryParam))
+	if err != nil {
+		return err
+	}
+
+	blockHeight, err := a.CIUsecase.GetIngestedHeight(c.Request().Context(), minBlockHeight)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(domain.BlockHeightHeader, strconv.FormatUint(blockHeight, 10))
+
+	return nil
+}
+
+// parseDenoms parses a comma-separated list of denoms, ignoring empty entries.
+// Returns error if the list contains no denoms.
+func parseDenoms(denomsParam string) ([]string, error) {
+	var denoms []string
+	for _, denom := range strings.Split(denomsParam, ",") {
+		denom = strings.TrimSpace(denom)
+		if len(denom) > 0 {
+			denoms = append(denoms, denom)
+		}
+	}
+
+	if len(denoms) == 0 {
+		return nil, errors.New(domain.PricesBaseDenomsQueryParam + " is required")
+	}
+
+	return denoms, nil
+}
+
+func getStatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	logrus.Error(err)
+
+	if _, ok := err.(domain.InvalidQueryParamError); ok {
+		return http.StatusBadRequest
+	}
+
+	if _, ok := err.(domain.MinBlockHeightNotReachedError); ok {
+		return http.StatusConflict
+	}
+
+	switch err {
+	case domain.ErrInternalServerError:
+		return http.StatusInternalServerError
+	case domain.ErrNotFound:
+		return http.StatusNotFound
+	case domain.ErrConflict:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
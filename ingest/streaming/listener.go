@@ -0,0 +1,183 @@
+package streaming
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	concentratedtypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+)
+
+// StoreKeys are the names of the module stores that must be exposed to the
+// streaming listener for it to observe pool and position changes.
+// The transient store named TransientStoreKey must be exposed as well for it to observe swaps.
+var StoreKeys = []string{gammtypes.StoreKey, concentratedtypes.StoreKey}
+
+// concentratedPoolTypeURL is the type URL of concentrated liquidity pools. Concentrated
+// pools are stored without an Any wrapper, so the listener wraps them itself.
+var concentratedPoolTypeURL = "/" + proto.MessageName(&model.Pool{})
+
+// blockListener is an ABCI listener that publishes the pool, position and swap changes
+// of a block to a sink as a single BlockUpdate on commit.
+// All changes are read from the change set of the block, swaps included: they are recorded
+// in the streaming transient store by the SwapRecorder.
+type blockListener struct {
+	sink Sink
+
+	height    int64
+	blockTime time.Time
+}
+
+var _ storetypes.ABCIListener = &blockListener{}
+
+// NewBlockListener returns an ABCI listener that publishes a BlockUpdate to the sink
+// for every committed block.
+func NewBlockListener(sink Sink) storetypes.ABCIListener {
+	return &blockListener{
+		sink: sink,
+	}
+}
+
+// ListenBeginBlock implements storetypes.ABCIListener.
+func (l *blockListener) ListenBeginBlock(_ context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	l.height = req.Header.Height
+	l.blockTime = req.Header.Time
+	return nil
+}
+
+// ListenDeliverTx implements storetypes.ABCIListener.
+func (l *blockListener) ListenDeliverTx(_ context.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock implements storetypes.ABCIListener.
+func (l *blockListener) ListenEndBlock(_ context.Context, _ abci.RequestEndBlock, _ abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+// The change set contains all writes to the exposed stores during the block, in order.
+func (l *blockListener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	pools, positions, swaps, err := parseChangeSet(changeSet)
+	if err != nil {
+		return err
+	}
+
+	update := &types.BlockUpdate{
+		Version:   types.StreamVersion,
+		Height:    l.height,
+		BlockTime: l.blockTime,
+		Pools:     pools,
+		Positions: positions,
+		Swaps:     swaps,
+	}
+
+	return l.sink.Publish(ctx, update)
+}
+
+// parseChangeSet returns the final state of every pool and position written in the change set,
+// sorted by id, and the swaps recorded in it, in execution order. Writes to other keys of the
+// exposed stores are ignored.
+func parseChangeSet(changeSet []*storetypes.StoreKVPair) ([]types.PoolUpdate, []types.PositionUpdate, []types.Swap, error) {
+	// Only the last write to a key in the block is relevant.
+	poolWrites := map[uint64]*storetypes.StoreKVPair{}
+	positionWrites := map[uint64]*storetypes.StoreKVPair{}
+	isConcentratedPool := map[uint64]bool{}
+	swapWrites := map[uint64]*storetypes.StoreKVPair{}
+
+	for _, pair := range changeSet {
+		switch pair.StoreKey {
+		case gammtypes.StoreKey:
+			if len(pair.Key) != len(gammtypes.KeyPrefixPools)+8 || !bytes.HasPrefix(pair.Key, gammtypes.KeyPrefixPools) {
+				continue
+			}
+			poolId := sdk.BigEndianToUint64(pair.Key[len(gammtypes.KeyPrefixPools):])
+			poolWrites[poolId] = pair
+		case concentratedtypes.StoreKey:
+			if id, ok := parseDecimalKey(pair.Key, concentratedtypes.PoolPrefix); ok {
+				poolWrites[id] = pair
+				isConcentratedPool[id] = true
+			} else if id, ok := parseDecimalKey(pair.Key, concentratedtypes.PositionIdPrefix); ok {
+				positionWrites[id] = pair
+			}
+		case TransientStoreKey:
+			if len(pair.Key) != len(swapPrefix)+8 || !bytes.HasPrefix(pair.Key, swapPrefix) || pair.Delete {
+				continue
+			}
+			sequence := sdk.BigEndianToUint64(pair.Key[len(swapPrefix):])
+			swapWrites[sequence] = pair
+		}
+	}
+
+	pools := make([]types.PoolUpdate, 0, len(poolWrites))
+	for poolId, pair := range poolWrites {
+		update := types.PoolUpdate{PoolId: poolId, Deleted: pair.Delete}
+		if !pair.Delete {
+			if isConcentratedPool[poolId] {
+				update.Pool = &codectypes.Any{TypeUrl: concentratedPoolTypeURL, Value: pair.Value}
+			} else {
+				update.Pool = &codectypes.Any{}
+				if err := update.Pool.Unmarshal(pair.Value); err != nil {
+					return nil, nil, nil, fmt.Errorf("failed to unmarshal pool %d: %w", poolId, err)
+				}
+			}
+		}
+		pools = append(pools, update)
+	}
+	sort.Slice(pools, func(i, j int) bool { return pools[i].PoolId < pools[j].PoolId })
+
+	positions := make([]types.PositionUpdate, 0, len(positionWrites))
+	for positionId, pair := range positionWrites {
+		update := types.PositionUpdate{PositionId: positionId, Deleted: pair.Delete}
+		if !pair.Delete {
+			update.Position = &model.Position{}
+			if err := update.Position.Unmarshal(pair.Value); err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to unmarshal position %d: %w", positionId, err)
+			}
+		}
+		positions = append(positions, update)
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i].PositionId < positions[j].PositionId })
+
+	sequences := make([]uint64, 0, len(swapWrites))
+	for sequence := range swapWrites {
+		sequences = append(sequences, sequence)
+	}
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+
+	swaps := make([]types.Swap, 0, len(sequences))
+	for _, sequence := range sequences {
+		swap := types.Swap{}
+		if err := swap.Unmarshal(swapWrites[sequence].Value); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to unmarshal swap %d: %w", sequence, err)
+		}
+		swaps = append(swaps, swap)
+	}
+
+	return pools, positions, swaps, nil
+}
+
+// parseDecimalKey parses keys of the form prefix | decimal id, as used by the concentrated
+// liquidity module for pools and positions. Returns false if the key is not of this form.
+func parseDecimalKey(key []byte, prefix []byte) (uint64, bool) {
+	if !bytes.HasPrefix(key, prefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
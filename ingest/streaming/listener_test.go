@@ -0,0 +1,72 @@
+package streaming
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	concentratedtypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+)
+
+func TestParseChangeSet(t *testing.T) {
+	balancerAny := codectypes.Any{TypeUrl: "/osmosis.gamm.v1beta1.Pool", Value: []byte{0x1}}
+	balancerBz, err := balancerAny.Marshal()
+	require.NoError(t, err)
+
+	position := model.Position{PositionId: 5, PoolId: 2, Address: "osmo1addr"}
+	positionBz, err := position.Marshal()
+	require.NoError(t, err)
+
+	swaps := []types.Swap{
+		{PoolId: 3, Sender: "osmo1sender", TokensIn: sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100)), TokensOut: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)), TxHash: "AB"},
+		{PoolId: 2, Sender: "osmo1sender", TokensIn: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)), TokensOut: sdk.NewCoins(sdk.NewInt64Coin("uion", 20))},
+	}
+	swapsBz := make([][]byte, len(swaps))
+	for i := range swaps {
+		swapsBz[i], err = swaps[i].Marshal()
+		require.NoError(t, err)
+	}
+
+	changeSet := []*storetypes.StoreKVPair{
+		// Overwritten below, only the last write must be kept.
+		{StoreKey: gammtypes.StoreKey, Key: gammtypes.GetKeyPrefixPools(3), Value: []byte{0xff}},
+		{StoreKey: gammtypes.StoreKey, Key: gammtypes.GetKeyPrefixPools(3), Value: balancerBz},
+		{StoreKey: concentratedtypes.StoreKey, Key: concentratedtypes.KeyPool(2), Value: []byte{0x2}},
+		{StoreKey: concentratedtypes.StoreKey, Key: concentratedtypes.KeyPositionId(5), Value: positionBz},
+		{StoreKey: concentratedtypes.StoreKey, Key: concentratedtypes.KeyPositionId(4), Delete: true},
+		// Unrelated keys are ignored.
+		{StoreKey: gammtypes.StoreKey, Key: gammtypes.KeyNextGlobalPoolId, Value: []byte{0x3}},
+		{StoreKey: concentratedtypes.StoreKey, Key: []byte{0x42}, Value: []byte{0x4}},
+		{StoreKey: TransientStoreKey, Key: swapSequenceKey, Value: sdk.Uint64ToBigEndian(2)},
+		// Swaps are ordered by sequence rather than by write order.
+		{StoreKey: TransientStoreKey, Key: swapKey(1), Value: swapsBz[1]},
+		{StoreKey: TransientStoreKey, Key: swapKey(0), Value: swapsBz[0]},
+	}
+
+	pools, positions, parsedSwaps, err := parseChangeSet(changeSet)
+	require.NoError(t, err)
+
+	require.Len(t, pools, 2)
+	require.Equal(t, uint64(2), pools[0].PoolId)
+	require.Equal(t, concentratedPoolTypeURL, pools[0].Pool.TypeUrl)
+	require.Equal(t, []byte{0x2}, pools[0].Pool.Value)
+	require.Equal(t, uint64(3), pools[1].PoolId)
+	require.Equal(t, balancerAny.TypeUrl, pools[1].Pool.TypeUrl)
+	require.Equal(t, balancerAny.Value, pools[1].Pool.Value)
+
+	require.Len(t, positions, 2)
+	require.Equal(t, uint64(4), positions[0].PositionId)
+	require.True(t, positions[0].Deleted)
+	require.Nil(t, positions[0].Position)
+	require.Equal(t, uint64(5), positions[1].PositionId)
+	require.False(t, positions[1].Deleted)
+	require.Equal(t, position.Address, positions[1].Position.Address)
+
+	require.Equal(t, swaps, parsedSwaps)
+}
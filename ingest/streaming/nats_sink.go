@@ -0,0 +1,288 @@
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+)
+
+// natsSink is a Sink that publishes block updates to a NATS JetStream stream.
+// It implements the subset of the NATS client protocol needed to publish messages and
+// receive their JetStream acknowledgements, so that the node does not depend on a NATS
+// client library. TLS and authentication are not supported.
+//
+// Every update is published with its height as JetStream message id, so that the stream
+// discards the duplicate of an update that is published again after a lost acknowledgement.
+type natsSink struct {
+	address string
+	subject string
+	timeout time.Duration
+
+	// conn is nil until the first publish and after a failed one.
+	conn   net.Conn
+	reader *bufio.Reader
+	// inbox is the subject that JetStream acknowledgements are received on.
+	inbox string
+}
+
+var _ Sink = &natsSink{}
+
+// natsConnectOptions are the options sent to the NATS server when connecting.
+// Headers are required for message ids, and no responders makes the server fail a publish
+// immediately if no stream is bound to the subject, instead of letting it time out.
+type natsConnectOptions struct {
+	Verbose      bool   `json:"verbose"`
+	Pedantic     bool   `json:"pedantic"`
+	Headers      bool   `json:"headers"`
+	NoResponders bool   `json:"no_responders"`
+	Name         string `json:"name"`
+	Lang         string `json:"lang"`
+	Version      string `json:"version"`
+	Protocol     int    `json:"protocol"`
+}
+
+// natsPubAck is the acknowledgement of a message published to a JetStream stream.
+type natsPubAck struct {
+	Stream string `json:"stream"`
+	Seq    uint64 `json:"seq"`
+	Error  *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+// NewNATSSink returns a sink that publishes block updates to the given subject of the NATS
+// server at the given address. A JetStream stream must be bound to the subject.
+// The timeout applies to connecting and to every acknowledgement.
+func NewNATSSink(address, subject string, timeout time.Duration) Sink {
+	return &natsSink{
+		address: address,
+		subject: subject,
+		timeout: timeout,
+	}
+}
+
+// Publish implements Sink.
+// It only returns once the update is acknowledged by JetStream, i.e. stored in the stream.
+func (s *natsSink) Publish(ctx context.Context, update *types.BlockUpdate) error {
+	bz, err := update.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if err := s.publish(ctx, update.Height, bz); err != nil {
+		// The connection is in an unknown state, so a new one is opened on the next publish.
+		s.closeConn()
+		return fmt.Errorf("failed to publish block update at height %d: %w", update.Height, err)
+	}
+
+	return nil
+}
+
+// Close implements Sink.
+func (s *natsSink) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
+
+// publish publishes the given encoded update and waits for its acknowledgement.
+func (s *natsSink) publish(ctx context.Context, height int64, bz []byte) error {
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := s.conn.SetDeadline(s.deadline(ctx)); err != nil {
+		return err
+	}
+
+	header := fmt.Sprintf("NATS/1.0\r\nNats-Msg-Id: %d\r\n\r\n", height)
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "HPUB %s %s %d %d\r\n", s.subject, s.inbox, len(header), len(header)+len(bz))
+	msg.WriteString(header)
+	msg.Write(bz)
+	msg.WriteString("\r\n")
+	if _, err := s.conn.Write(msg.Bytes()); err != nil {
+		return err
+	}
+
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case strings.HasPrefix(line, "MSG "), strings.HasPrefix(line, "HMSG "):
+			return s.readPubAck(line)
+		case line == "PING":
+			if _, err := io.WriteString(s.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case line == "PONG", line == "+OK", strings.HasPrefix(line, "INFO "):
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		default:
+			return fmt.Errorf("unexpected nats protocol message: %q", line)
+		}
+	}
+}
+
+// connect opens a connection to the NATS server and subscribes to a new inbox.
+func (s *natsSink) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: s.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+
+	if err := s.conn.SetDeadline(s.deadline(ctx)); err != nil {
+		return err
+	}
+
+	line, err := s.readLine()
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("expected nats server info, got %q", line)
+	}
+	var info struct {
+		Headers bool `json:"headers"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "INFO ")), &info); err != nil {
+		return fmt.Errorf("invalid nats server info: %w", err)
+	}
+	if !info.Headers {
+		return fmt.Errorf("nats server at %s does not support headers", s.address)
+	}
+
+	inboxId := make([]byte, 16)
+	if _, err := rand.Read(inboxId); err != nil {
+		return err
+	}
+	s.inbox = "_INBOX." + hex.EncodeToString(inboxId)
+
+	connectOptions, err := json.Marshal(natsConnectOptions{
+		Headers:      true,
+		NoResponders: true,
+		Name:         "osmosis-streaming",
+		Lang:         "go",
+		Version:      strconv.FormatUint(uint64(types.StreamVersion), 10),
+		Protocol:     1,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The PING makes the server answer once it processed the CONNECT and SUB.
+	if _, err := fmt.Fprintf(s.conn, "CONNECT %s\r\nSUB %s 1\r\nPING\r\n", connectOptions, s.inbox); err != nil {
+		return err
+	}
+	for {
+		line, err := s.readLine()
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "+OK", strings.HasPrefix(line, "INFO "):
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats server error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		default:
+			return fmt.Errorf("unexpected nats protocol message: %q", line)
+		}
+	}
+}
+
+// readPubAck reads the payload of the given MSG or HMSG protocol message, which is the
+// acknowledgement of the last published update, and returns an error if it is negative.
+func (s *natsSink) readPubAck(line string) error {
+	// MSG <subject> <sid> [reply-to] <#bytes>
+	// HMSG <subject> <sid> [reply-to] <#header bytes> <#total bytes>
+	fields := strings.Fields(line)
+	totalLen, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return fmt.Errorf("invalid nats message size in %q", line)
+	}
+	headerLen := 0
+	if fields[0] == "HMSG" {
+		if len(fields) < 5 {
+			return fmt.Errorf("invalid nats message %q", line)
+		}
+		headerLen, err = strconv.Atoi(fields[len(fields)-2])
+		if err != nil || headerLen > totalLen {
+			return fmt.Errorf("invalid nats message header size in %q", line)
+		}
+	}
+
+	// The payload is followed by a CRLF.
+	msg := make([]byte, totalLen+2)
+	if _, err := io.ReadFull(s.reader, msg); err != nil {
+		return err
+	}
+
+	// A status in the header, such as 503 if no stream is bound to the subject, fails the publish.
+	if headerLen > 0 {
+		statusLine, _, _ := strings.Cut(string(msg[:headerLen]), "\r\n")
+		if status := strings.TrimSpace(strings.TrimPrefix(statusLine, "NATS/1.0")); status != "" {
+			return fmt.Errorf("publish to %s failed with status %s", s.subject, status)
+		}
+	}
+
+	var ack natsPubAck
+	if err := json.Unmarshal(msg[headerLen:totalLen], &ack); err != nil {
+		return fmt.Errorf("invalid jetstream acknowledgement: %w", err)
+	}
+	if ack.Error != nil {
+		return fmt.Errorf("jetstream rejected the update with code %d: %s", ack.Error.Code, ack.Error.Description)
+	}
+	if ack.Stream == "" {
+		return fmt.Errorf("jetstream acknowledgement without stream: %s", msg[headerLen:totalLen])
+	}
+
+	return nil
+}
+
+// readLine reads a protocol line without its trailing CRLF.
+func (s *natsSink) readLine() (string, error) {
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// deadline returns the deadline of the next exchange with the server.
+func (s *natsSink) deadline(ctx context.Context) time.Time {
+	deadline := time.Now().Add(s.timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		return ctxDeadline
+	}
+	return deadline
+}
+
+// closeConn closes the connection, ignoring errors since it is discarded either way.
+func (s *natsSink) closeConn() {
+	_ = s.Close()
+}
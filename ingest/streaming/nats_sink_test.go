@@ -0,0 +1,143 @@
+package streaming
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+)
+
+// natsPublishedMsg is a message received by the fake NATS server.
+type natsPublishedMsg struct {
+	subject string
+	replyTo string
+	header  string
+	payload []byte
+}
+
+// serveFakeJetStream accepts a single connection on the given listener, receives a single
+// published message and answers it with the reply built from the subscribed inbox.
+func serveFakeJetStream(listener net.Listener, reply func(inbox string) string, published chan<- natsPublishedMsg) {
+	defer close(published)
+
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	if _, err := io.WriteString(conn, "INFO {\"server_id\":\"test\",\"headers\":true}\r\n"); err != nil {
+		return
+	}
+
+	// CONNECT, SUB and PING.
+	lines := make([]string, 3)
+	for i := range lines {
+		if lines[i], err = reader.ReadString('\n'); err != nil {
+			return
+		}
+	}
+	if _, err := io.WriteString(conn, "PONG\r\n"); err != nil {
+		return
+	}
+	inbox := strings.Fields(lines[1])[1]
+
+	// HPUB <subject> <reply-to> <#header bytes> <#total bytes>
+	hpub, err := reader.ReadString('\n')
+	if err != nil {
+		return
+	}
+	fields := strings.Fields(hpub)
+	headerLen, _ := strconv.Atoi(fields[3])
+	totalLen, _ := strconv.Atoi(fields[4])
+	msg := make([]byte, totalLen+2)
+	if _, err := io.ReadFull(reader, msg); err != nil {
+		return
+	}
+	published <- natsPublishedMsg{
+		subject: fields[1],
+		replyTo: fields[2],
+		header:  string(msg[:headerLen]),
+		payload: msg[headerLen:totalLen],
+	}
+
+	_, _ = io.WriteString(conn, reply(inbox))
+}
+
+func TestNATSSink(t *testing.T) {
+	tests := map[string]struct {
+		reply       func(inbox string) string
+		expectedErr string
+	}{
+		"acknowledged": {
+			reply: func(inbox string) string {
+				ack := `{"stream":"osmosis","seq":1}`
+				return fmt.Sprintf("PING\r\nMSG %s 1 %d\r\n%s\r\n", inbox, len(ack), ack)
+			},
+		},
+		"rejected by jetstream": {
+			reply: func(inbox string) string {
+				ack := `{"error":{"code":400,"description":"maximum messages exceeded"}}`
+				return fmt.Sprintf("MSG %s 1 %d\r\n%s\r\n", inbox, len(ack), ack)
+			},
+			expectedErr: "jetstream rejected the update with code 400: maximum messages exceeded",
+		},
+		"no stream bound to the subject": {
+			reply: func(inbox string) string {
+				header := "NATS/1.0 503\r\n\r\n"
+				return fmt.Sprintf("HMSG %s 1 %d %d\r\n%s\r\n", inbox, len(header), len(header), header)
+			},
+			expectedErr: "publish to osmosis.blocks failed with status 503",
+		},
+		"server error": {
+			reply: func(inbox string) string {
+				return "-ERR 'Permissions Violation for Publish to osmosis.blocks'\r\n"
+			},
+			expectedErr: "nats server error: 'Permissions Violation for Publish to osmosis.blocks'",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer listener.Close()
+
+			published := make(chan natsPublishedMsg, 1)
+			go serveFakeJetStream(listener, tc.reply, published)
+
+			sink := NewNATSSink(listener.Addr().String(), "osmosis.blocks", time.Second)
+			defer sink.Close()
+
+			update := &types.BlockUpdate{Version: types.StreamVersion, Height: 10, BlockTime: time.Unix(1, 0).UTC()}
+			err = sink.Publish(context.Background(), update)
+
+			msg, ok := <-published
+			require.True(t, ok)
+			require.Equal(t, "osmosis.blocks", msg.subject)
+			require.True(t, strings.HasPrefix(msg.replyTo, "_INBOX."))
+			require.Contains(t, msg.header, "Nats-Msg-Id: 10\r\n")
+			expectedPayload, marshalErr := update.Marshal()
+			require.NoError(t, marshalErr)
+			require.Equal(t, expectedPayload, msg.payload)
+
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				// The connection is reset after a failed publish.
+				require.Nil(t, sink.(*natsSink).conn)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
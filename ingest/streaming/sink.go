@@ -0,0 +1,124 @@
+package streaming
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+)
+
+// Sink is a destination that block updates are published to.
+type Sink interface {
+	// Publish publishes the block update. It must only return once the update
+	// has been handed off to the sink so that a published update is never lost
+	// on a subsequent node crash.
+	Publish(ctx context.Context, update *types.BlockUpdate) error
+	// Close releases any resources held by the sink.
+	Close() error
+}
+
+// fileSink is a Sink that appends length-delimited block updates to a file.
+// Every update is prefixed with its size encoded as an unsigned varint.
+type fileSink struct {
+	file *os.File
+}
+
+var _ Sink = &fileSink{}
+
+// NewFileSink returns a sink that appends block updates to the file at the given path,
+// creating the file and its parent directories if they do not exist.
+func NewFileSink(path string) (Sink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSink{file: file}, nil
+}
+
+// Publish implements Sink.
+func (s *fileSink) Publish(_ context.Context, update *types.BlockUpdate) error {
+	bz, err := update.Marshal()
+	if err != nil {
+		return err
+	}
+
+	sizePrefix := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(sizePrefix, uint64(len(bz)))
+
+	// Write the prefix and the message in a single call so that a partially
+	// written update is never followed by another one.
+	if _, err := s.file.Write(append(sizePrefix[:n], bz...)); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+// Close implements Sink.
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+// redisStreamDataField is the field of a redis stream entry that holds the encoded block update.
+const redisStreamDataField = "data"
+
+// redisStreamSink is a Sink that adds block updates to a redis stream.
+// Every entry holds the block height and the proto encoded block update.
+type redisStreamSink struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+var _ Sink = &redisStreamSink{}
+
+// NewRedisStreamSink returns a sink that adds block updates to the given redis stream.
+// If maxLen is positive, the stream is approximately trimmed to maxLen entries.
+func NewRedisStreamSink(client *redis.Client, stream string, maxLen int64) Sink {
+	return &redisStreamSink{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+// Publish implements Sink.
+func (s *redisStreamSink) Publish(ctx context.Context, update *types.BlockUpdate) error {
+	bz, err := update.Marshal()
+	if err != nil {
+		return err
+	}
+
+	args := &redis.XAddArgs{
+		Stream: s.stream,
+		Values: map[string]interface{}{
+			"height":             update.Height,
+			redisStreamDataField: bz,
+		},
+	}
+	if s.maxLen > 0 {
+		args.MaxLen = s.maxLen
+		args.Approx = true
+	}
+
+	if err := s.client.XAdd(ctx, args).Err(); err != nil {
+		return fmt.Errorf("failed to publish block update at height %d: %w", update.Height, err)
+	}
+
+	return nil
+}
+
+// Close implements Sink.
+func (s *redisStreamSink) Close() error {
+	return s.client.Close()
+}
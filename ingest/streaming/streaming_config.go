@@ -0,0 +1,146 @@
+package streaming
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+const (
+	// SinkTypeFile appends length-delimited block updates to a file.
+	SinkTypeFile = "file"
+	// SinkTypeRedis adds block updates to a redis stream.
+	SinkTypeRedis = "redis"
+	// SinkTypeNATS publishes block updates to a NATS JetStream stream.
+	SinkTypeNATS = "nats"
+)
+
+// Config defines the config for the streaming service.
+type Config struct {
+	// IsEnabled defines if the streaming service is enabled.
+	IsEnabled bool `mapstructure:"enabled"`
+
+	// StopNodeOnErr defines if the node halts when an update fails to be published.
+	// If false, the error is logged and the update is dropped.
+	StopNodeOnErr bool `mapstructure:"stop-node-on-err"`
+
+	// SinkType defines the sink block updates are published to. One of "file", "redis" or "nats".
+	SinkType string `mapstructure:"sink"`
+
+	// FilePath is the path of the file sink. Relative paths are relative to the node home.
+	FilePath string `mapstructure:"file-path"`
+
+	// Defines the redis sink configuration.
+	RedisHost   string `mapstructure:"redis-host"`
+	RedisPort   string `mapstructure:"redis-port"`
+	RedisStream string `mapstructure:"redis-stream"`
+	// RedisMaxLen is the approximate maximum number of entries kept in the stream.
+	// Zero means the stream is never trimmed.
+	RedisMaxLen int `mapstructure:"redis-max-len"`
+
+	// Defines the nats sink configuration.
+	NATSHost    string `mapstructure:"nats-host"`
+	NATSPort    string `mapstructure:"nats-port"`
+	NATSSubject string `mapstructure:"nats-subject"`
+	// NATSTimeoutMs is the timeout in milliseconds of connecting to the server and of
+	// waiting for the JetStream acknowledgement of an update.
+	NATSTimeoutMs int `mapstructure:"nats-timeout-ms"`
+}
+
+const groupOptName = "osmosis-streaming"
+
+// DefaultConfig defines the default config for the streaming service.
+var DefaultConfig = Config{
+	IsEnabled:     false,
+	StopNodeOnErr: false,
+
+	SinkType: SinkTypeFile,
+	FilePath: "data/streaming/blocks.bin",
+
+	RedisHost:   "localhost",
+	RedisPort:   "6379",
+	RedisStream: "osmosis:blocks",
+	RedisMaxLen: 0,
+
+	NATSHost:      "localhost",
+	NATSPort:      "4222",
+	NATSSubject:   "osmosis.blocks",
+	NATSTimeoutMs: 5000,
+}
+
+// NewConfigFromOptions returns a new streaming service config from the given options.
+func NewConfigFromOptions(opts servertypes.AppOptions) Config {
+	isEnabled := osmoutils.ParseBool(opts, groupOptName, "is-enabled", false)
+
+	if !isEnabled {
+		return Config{
+			IsEnabled: false,
+		}
+	}
+
+	config := Config{
+		IsEnabled:     isEnabled,
+		StopNodeOnErr: osmoutils.ParseBool(opts, groupOptName, "stop-node-on-err", false),
+		SinkType:      osmoutils.ParseString(opts, groupOptName, "sink"),
+	}
+
+	switch config.SinkType {
+	case SinkTypeFile:
+		config.FilePath = osmoutils.ParseString(opts, groupOptName, "file-path")
+	case SinkTypeRedis:
+		config.RedisHost = osmoutils.ParseString(opts, groupOptName, "redis-host")
+		config.RedisPort = osmoutils.ParseString(opts, groupOptName, "redis-port")
+		config.RedisStream = osmoutils.ParseString(opts, groupOptName, "redis-stream")
+		config.RedisMaxLen = osmoutils.ParseInt(opts, groupOptName, "redis-max-len")
+	case SinkTypeNATS:
+		config.NATSHost = osmoutils.ParseString(opts, groupOptName, "nats-host")
+		config.NATSPort = osmoutils.ParseString(opts, groupOptName, "nats-port")
+		config.NATSSubject = osmoutils.ParseString(opts, groupOptName, "nats-subject")
+		config.NATSTimeoutMs = osmoutils.ParseInt(opts, groupOptName, "nats-timeout-ms")
+	}
+
+	return config
+}
+
+// Initialize creates the configured sink and returns the streaming manager publishing to it.
+// The stores named in StoreKeys must be exposed to the commit multi store for the listener
+// to observe pool and position changes.
+func (c Config) Initialize(homePath string) (storetypes.StreamingManager, error) {
+	var sink Sink
+	switch c.SinkType {
+	case SinkTypeFile:
+		path := c.FilePath
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(homePath, path)
+		}
+
+		var err error
+		sink, err = NewFileSink(path)
+		if err != nil {
+			return storetypes.StreamingManager{}, fmt.Errorf("error while creating streaming file sink: %s", err)
+		}
+	case SinkTypeRedis:
+		client := redis.NewClient(&redis.Options{
+			Addr: fmt.Sprintf("%s:%s", c.RedisHost, c.RedisPort),
+		})
+		sink = NewRedisStreamSink(client, c.RedisStream, int64(c.RedisMaxLen))
+	case SinkTypeNATS:
+		if c.NATSTimeoutMs <= 0 {
+			return storetypes.StreamingManager{}, fmt.Errorf("streaming nats timeout must be positive, got %d", c.NATSTimeoutMs)
+		}
+		sink = NewNATSSink(fmt.Sprintf("%s:%s", c.NATSHost, c.NATSPort), c.NATSSubject, time.Duration(c.NATSTimeoutMs)*time.Millisecond)
+	default:
+		return storetypes.StreamingManager{}, fmt.Errorf("unknown streaming sink %q, expected one of %q, %q or %q", c.SinkType, SinkTypeFile, SinkTypeRedis, SinkTypeNATS)
+	}
+
+	return storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{NewBlockListener(sink)},
+		StopNodeOnErr: c.StopNodeOnErr,
+	}, nil
+}
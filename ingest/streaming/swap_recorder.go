@@ -0,0 +1,123 @@
+package streaming
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+	concentratedtypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+)
+
+// TransientStoreKey is the name of the transient store that the swaps of a block are recorded in.
+// It is exposed to the listener like the module stores, so that swaps are read from the change set
+// of the block rather than parsed from events. Writes of failed transactions and of discarded cache
+// contexts never reach the change set, and neither do the swaps they executed.
+const TransientStoreKey = "transient_streaming"
+
+var (
+	// swapSequenceKey is the key of the number of swaps recorded in the current block.
+	swapSequenceKey = []byte{0x01}
+
+	// swapPrefix is the prefix of the swaps recorded in the current block, keyed by their
+	// big endian sequence number so that they are ordered by execution.
+	swapPrefix = []byte{0x02}
+)
+
+// SwapRecorder records every swap executed in a block in the streaming transient store.
+// It is registered as a gamm hook and a concentrated liquidity listener, and only records
+// swaps once enabled, i.e. if the streaming service is enabled on the node.
+type SwapRecorder struct {
+	storeKey storetypes.StoreKey
+	enabled  bool
+}
+
+var (
+	_ gammtypes.GammHooks                             = &SwapRecorder{}
+	_ concentratedtypes.ConcentratedLiquidityListener = &SwapRecorder{}
+)
+
+// NewSwapRecorder returns a disabled swap recorder writing to the given transient store.
+func NewSwapRecorder(storeKey storetypes.StoreKey) *SwapRecorder {
+	return &SwapRecorder{storeKey: storeKey}
+}
+
+// Enable makes the recorder record swaps.
+func (r *SwapRecorder) Enable() {
+	r.enabled = true
+}
+
+// AfterCFMMPoolCreated implements gammtypes.GammHooks.
+func (r *SwapRecorder) AfterCFMMPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+// AfterJoinPool implements gammtypes.GammHooks.
+func (r *SwapRecorder) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount osmomath.Int) {
+}
+
+// AfterExitPool implements gammtypes.GammHooks.
+func (r *SwapRecorder) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, exitCoins sdk.Coins) {
+}
+
+// AfterCFMMSwap implements gammtypes.GammHooks.
+func (r *SwapRecorder) AfterCFMMSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	r.recordSwap(ctx, sender, poolId, input, output)
+}
+
+// AfterConcentratedPoolCreated implements concentratedtypes.ConcentratedLiquidityListener.
+func (r *SwapRecorder) AfterConcentratedPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+// AfterInitialPoolPositionCreated implements concentratedtypes.ConcentratedLiquidityListener.
+func (r *SwapRecorder) AfterInitialPoolPositionCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+// AfterLastPoolPositionRemoved implements concentratedtypes.ConcentratedLiquidityListener.
+func (r *SwapRecorder) AfterLastPoolPositionRemoved(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+// AfterConcentratedPoolSwap implements concentratedtypes.ConcentratedLiquidityListener.
+func (r *SwapRecorder) AfterConcentratedPoolSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	r.recordSwap(ctx, sender, poolId, input, output)
+}
+
+// recordSwap records the given swap as the next swap of the block.
+// Swaps of CheckTx and simulations are never committed, so they are not recorded.
+// The store is accessed with an infinite gas meter: the gas used by a transaction must not
+// depend on whether the node it is executed on streams blocks.
+func (r *SwapRecorder) recordSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	if !r.enabled || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return
+	}
+
+	store := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).TransientStore(r.storeKey)
+
+	sequence := uint64(0)
+	if bz := store.Get(swapSequenceKey); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+
+	swap := types.Swap{
+		PoolId:    poolId,
+		Sender:    sender.String(),
+		TokensIn:  input,
+		TokensOut: output,
+	}
+	// Swaps executed in begin or end block have no transaction.
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		swap.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+
+	osmoutils.MustSet(store, swapKey(sequence), &swap)
+	store.Set(swapSequenceKey, sdk.Uint64ToBigEndian(sequence+1))
+}
+
+// swapKey returns the key of the swap with the given sequence number in the current block.
+func swapKey(sequence uint64) []byte {
+	return append(append([]byte{}, swapPrefix...), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,59 @@
+package streaming
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/ingest/streaming/types"
+)
+
+func TestSwapRecorder(t *testing.T) {
+	tkey := sdk.NewTransientStoreKey(TransientStoreKey)
+	ctx := testutil.DefaultContext(sdk.NewKVStoreKey("test"), tkey)
+	sender := sdk.AccAddress([]byte("sender"))
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin("uosmo", 100))
+	tokensOut := sdk.NewCoins(sdk.NewInt64Coin("uatom", 50))
+
+	recordedSwaps := func() []types.Swap {
+		swaps := []types.Swap{}
+		iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(tkey), swapPrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			swap := types.Swap{}
+			require.NoError(t, swap.Unmarshal(iterator.Value()))
+			swaps = append(swaps, swap)
+		}
+		return swaps
+	}
+
+	recorder := NewSwapRecorder(tkey)
+
+	// Disabled recorders do not record swaps.
+	recorder.AfterCFMMSwap(ctx, sender, 1, tokensIn, tokensOut)
+	require.Empty(t, recordedSwaps())
+
+	recorder.Enable()
+
+	// Swaps of CheckTx are never committed.
+	recorder.AfterCFMMSwap(ctx.WithIsCheckTx(true), sender, 1, tokensIn, tokensOut)
+	require.Empty(t, recordedSwaps())
+
+	// Recording does not consume the gas of the transaction.
+	gasMeter := sdk.NewGasMeter(1_000_000)
+	recorder.AfterCFMMSwap(ctx.WithGasMeter(gasMeter).WithTxBytes([]byte{0x1}), sender, 1, tokensIn, tokensOut)
+	recorder.AfterConcentratedPoolSwap(ctx.WithGasMeter(gasMeter), sender, 2, tokensOut, tokensIn)
+	require.Zero(t, gasMeter.GasConsumed())
+
+	swaps := recordedSwaps()
+	require.Len(t, swaps, 2)
+	require.Equal(t, uint64(1), swaps[0].PoolId)
+	require.Equal(t, sender.String(), swaps[0].Sender)
+	require.Equal(t, tokensIn, swaps[0].TokensIn)
+	require.Equal(t, tokensOut, swaps[0].TokensOut)
+	require.Len(t, swaps[0].TxHash, 64)
+	require.Equal(t, uint64(2), swaps[1].PoolId)
+	require.Empty(t, swaps[1].TxHash)
+}
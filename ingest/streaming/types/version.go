@@ -0,0 +1,6 @@
+package types
+
+// StreamVersion is the version of the stream format set on every published BlockUpdate.
+// It must be incremented on any change to the format that consumers must handle explicitly,
+// such as a change in the semantics of an existing field.
+const StreamVersion uint32 = 1
@@ -268,12 +268,20 @@ func (d BigDec) AddMut(d2 BigDec) BigDec {
 
 // subtraction
 func (d BigDec) Sub(d2 BigDec) BigDec {
-	res := new(big.Int).Sub(d.i, d2.i)
+	copy := d.Clone()
+	copy.SubMut(d2)
+	return copy
+}
 
-	if res.BitLen() > maxDecBitLen {
+// mutative subtraction
+func (d BigDec) SubMut(d2 BigDec) BigDec {
+	d.i.Sub(d.i, d2.i)
+
+	if d.i.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
-	return BigDec{res}
+
+	return d
 }
 
 // Clone performs a deep copy of the receiver
@@ -306,24 +314,38 @@ func (d BigDec) MulMut(d2 BigDec) BigDec {
 
 // multiplication truncate
 func (d BigDec) MulTruncate(d2 BigDec) BigDec {
-	mul := new(big.Int).Mul(d.i, d2.i)
-	chopped := chopPrecisionAndTruncate(mul)
+	copy := d.Clone()
+	copy.MulTruncateMut(d2)
+	return copy
+}
 
-	if chopped.BitLen() > maxDecBitLen {
+// multiplication truncate (mutative)
+func (d BigDec) MulTruncateMut(d2 BigDec) BigDec {
+	d.i.Mul(d.i, d2.i)
+	chopPrecisionAndTruncateMut(d.i)
+
+	if d.i.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
-	return BigDec{chopped}
+	return d
 }
 
 // multiplication round up
 func (d BigDec) MulRoundUp(d2 BigDec) BigDec {
-	mul := new(big.Int).Mul(d.i, d2.i)
-	chopped := chopPrecisionAndRoundUpBigDec(mul)
+	copy := d.Clone()
+	copy.MulRoundUpMut(d2)
+	return copy
+}
 
-	if chopped.BitLen() > maxDecBitLen {
+// multiplication round up (mutative)
+func (d BigDec) MulRoundUpMut(d2 BigDec) BigDec {
+	d.i.Mul(d.i, d2.i)
+	chopPrecisionAndRoundUpMut(d.i, precisionReuse)
+
+	if d.i.BitLen() > maxDecBitLen {
 		panic("Int overflow")
 	}
-	return BigDec{chopped}
+	return d
 }
 
 // multiplication
@@ -1109,6 +1131,16 @@ func (x BigDec) CustomBaseLog(base BigDec) BigDec {
 // PowerInteger takes a given decimal to an integer power
 // and returns the result. Non-mutative. Uses square and multiply
 // algorithm for performing the calculation.
+//
+// Error bounds: the algorithm performs at most 2 * floor(log_2(power)) + 1
+// multiplications, each of which rounds its result to the nearest unit of
+// 10^-36 (bankers rounding). The result is therefore within that many
+// rounding steps of the exact value, and is exact whenever all intermediate
+// values are representable in 36 decimal places (e.g. integer bases).
+//
+// Monotonicity: for a fixed power and a non-negative base, the result is
+// non-decreasing in the base. Each step multiplies non-negative values and
+// rounds to nearest, and both operations are non-decreasing in their inputs.
 func (d BigDec) PowerInteger(power uint64) BigDec {
 	clone := d.Clone()
 	return clone.PowerIntegerMut(power)
@@ -1117,6 +1149,7 @@ func (d BigDec) PowerInteger(power uint64) BigDec {
 // PowerIntegerMut takes a given decimal to an integer power
 // and returns the result. Mutative. Uses square and multiply
 // algorithm for performing the calculation.
+// Has the same error bounds and monotonicity guarantees as PowerInteger.
 func (d BigDec) PowerIntegerMut(power uint64) BigDec {
 	if power == 0 {
 		return OneBigDec()
@@ -1135,43 +1168,56 @@ func (d BigDec) PowerIntegerMut(power uint64) BigDec {
 }
 
 // Power returns a result of raising the given big dec to
-// a positive decimal power. Panics if the power is negative.
-// Panics if the base is negative. Does not mutate the receiver.
-// The max supported exponent is defined by the global maxSupportedExponent.
-// If a greater exponent is given, the function panics.
-// The error is not bounded but expected to be around 10^-18, use with care.
-// See the underlying Exp2, LogBase2 and Mul for the details of their bounds.
-// WARNING: This function is broken for base < 1. The reason is that logarithm function is
-// negative between zero and 1, and the Exp2(k) is undefined for negative k.
-// As a result, this function panics if called for d < 1.
+// a decimal power. Does not mutate the receiver.
+// Panics if the base is negative, or if the base is zero and the power is negative.
+// The max supported absolute exponent is defined by the global maxSupportedExponent.
+// If a greater exponent is given, the function panics. The function also panics if
+// |power * log_2(base)| exceeds maxSupportedExponent, since the result is then
+// out of the supported range.
+//
+// Integer powers are delegated to PowerInteger and carry its error bounds and
+// monotonicity guarantees. Negative powers are computed as 1 / d^|power|.
+//
+// Fractional powers are computed as d^power = 2^(power * log_2(d)).
+// For d < 1, log_2(d) is negative, so the result is computed as 1 / 2^|power * log_2(d)|.
+// Error bounds: log_2(d) is accurate up to 32 decimal digits and rounds down,
+// which results in a multiplicative error of at most |power| * 10^-32 * ln(2) in the result.
+// Exp2 adds a multiplicative error of at most 10^-18. Therefore, the result of a fractional
+// power is within a multiplicative factor of [1 - 10^-18, 1 + 10^-18] of the exact value
+// for all supported exponents.
+// Monotonicity is only guaranteed for integer powers. For fractional powers,
+// the result is non-decreasing in the base up to the error bound above.
 func (d BigDec) Power(power BigDec) BigDec {
 	if d.IsNegative() {
 		panic(fmt.Sprintf("negative base is not supported for Power(), base was (%s)", d))
 	}
-	if power.IsNegative() {
-		panic(fmt.Sprintf("negative power is not supported for Power(), power was (%s)", power))
-	}
 	if power.Abs().GT(maxSupportedExponent) {
 		panic(fmt.Sprintf("integer exponent %s is too large, max (%s)", power, maxSupportedExponent))
 	}
+	if power.IsNegative() {
+		if d.IsZero() {
+			panic(fmt.Sprintf("zero base is not supported for negative power in Power(), power was (%s)", power))
+		}
+		return OneBigDec().Quo(d.Power(power.Neg()))
+	}
 	if power.IsInteger() {
 		return d.PowerInteger(power.TruncateInt().Uint64())
 	}
-	if power.IsZero() {
-		return OneBigDec()
-	}
 	if d.IsZero() {
 		return ZeroBigDec()
 	}
-	if d.LT(OneBigDec()) {
-		panic(fmt.Sprintf("Power() is not supported for base < 1, base was (%s)", d))
-	}
 	if d.Equal(twoBigDec) {
 		return Exp2(power)
 	}
 
 	// d^power = exp2(power * log_2{base})
-	result := Exp2(d.LogBase2().Mul(power))
+	exponent := d.LogBase2().Mul(power)
 
-	return result
+	// For base < 1, log_2{base} is negative and Exp2 is only defined for
+	// non-negative exponents. Use d^power = 1 / exp2(|power * log_2{base}|).
+	if exponent.IsNegative() {
+		return OneBigDec().Quo(Exp2(exponent.Neg()))
+	}
+
+	return Exp2(exponent)
 }
@@ -68,6 +68,30 @@ func (s *decimalTestSuite) TestAddMut() {
 	}
 }
 
+func (s *decimalTestSuite) TestSubMut() {
+	toSub := osmomath.MustNewBigDecFromStr("10")
+	tests := map[string]struct {
+		startValue        osmomath.BigDec
+		expectedMutResult osmomath.BigDec
+	}{
+		"0":  {osmomath.NewBigDec(0), osmomath.NewBigDec(-10)},
+		"1":  {osmomath.NewBigDec(1), osmomath.NewBigDec(-9)},
+		"10": {osmomath.NewBigDec(10), osmomath.NewBigDec(0)},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			startMut := tc.startValue.Clone()
+			startNonMut := tc.startValue.Clone()
+
+			resultMut := startMut.SubMut(toSub)
+			resultNonMut := startNonMut.Sub(toSub)
+
+			s.assertMutResult(tc.expectedMutResult, tc.startValue, resultMut, resultNonMut, startMut, startNonMut)
+		})
+	}
+}
+
 func (s *decimalTestSuite) TestQuoMut() {
 	quoBy := osmomath.MustNewBigDecFromStr("2")
 	tests := map[string]struct {
@@ -1347,6 +1371,80 @@ func (s *decimalTestSuite) TestMul_Mutation() {
 	}
 }
 
+// TestMulTruncate_Mutation tests that MulTruncateMut mutates the receiver
+// while MulTruncate is not.
+func (s *decimalTestSuite) TestMulTruncate_Mutation() {
+	mulBy := osmomath.MustNewBigDecFromStr("0.000000000000000000000000000000000003")
+
+	tests := map[string]struct {
+		startValue        osmomath.BigDec
+		expectedMulResult osmomath.BigDec
+	}{
+		"0.5": {
+			startValue:        osmomath.MustNewBigDecFromStr("0.5"),
+			expectedMulResult: osmomath.SmallestBigDec(),
+		},
+		"-0.5": {
+			startValue:        osmomath.MustNewBigDecFromStr("-0.5"),
+			expectedMulResult: osmomath.SmallestBigDec().Neg(),
+		},
+		"0": {
+			startValue:        osmomath.ZeroBigDec(),
+			expectedMulResult: osmomath.ZeroBigDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			startMut := tc.startValue.Clone()
+			startNonMut := tc.startValue.Clone()
+
+			resultMut := startMut.MulTruncateMut(mulBy)
+			resultNonMut := startNonMut.MulTruncate(mulBy)
+
+			s.assertMutResult(tc.expectedMulResult, tc.startValue, resultMut, resultNonMut, startMut, startNonMut)
+		})
+	}
+}
+
+// TestMulRoundUp_Mutation tests that MulRoundUpMut mutates the receiver
+// while MulRoundUp is not.
+func (s *decimalTestSuite) TestMulRoundUp_Mutation() {
+	mulBy := osmomath.MustNewBigDecFromStr("0.000000000000000000000000000000000003")
+
+	tests := map[string]struct {
+		startValue        osmomath.BigDec
+		expectedMulResult osmomath.BigDec
+	}{
+		"0.5": {
+			startValue:        osmomath.MustNewBigDecFromStr("0.5"),
+			expectedMulResult: osmomath.MustNewBigDecFromStr("0.000000000000000000000000000000000002"),
+		},
+		"-0.5": {
+			startValue:        osmomath.MustNewBigDecFromStr("-0.5"),
+			expectedMulResult: osmomath.SmallestBigDec().Neg(),
+		},
+		"0": {
+			startValue:        osmomath.ZeroBigDec(),
+			expectedMulResult: osmomath.ZeroBigDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			startMut := tc.startValue.Clone()
+			startNonMut := tc.startValue.Clone()
+
+			resultMut := startMut.MulRoundUpMut(mulBy)
+			resultNonMut := startNonMut.MulRoundUp(mulBy)
+
+			s.assertMutResult(tc.expectedMulResult, tc.startValue, resultMut, resultNonMut, startMut, startNonMut)
+		})
+	}
+}
+
 // TestPowerInteger_Mutation tests that PowerIntegerMut mutates the receiver
 // while PowerInteger is not.
 func (s *decimalTestSuite) TestPowerInteger_Mutation() {
@@ -1388,6 +1486,30 @@ func (s *decimalTestSuite) TestPowerInteger_Mutation() {
 	}
 }
 
+// TestPowerInteger_Monotonicity tests that for a fixed power, PowerInteger
+// is non-decreasing in the base, as documented.
+func (s *decimalTestSuite) TestPowerInteger_Monotonicity() {
+	bases := []osmomath.BigDec{
+		osmomath.ZeroBigDec(),
+		osmomath.SmallestBigDec(),
+		osmomath.MustNewBigDecFromStr("0.000000000000000001"),
+		osmomath.MustNewBigDecFromStr("0.5").Sub(osmomath.SmallestBigDec()),
+		osmomath.MustNewBigDecFromStr("0.5"),
+		osmomath.MustNewBigDecFromStr("0.999999999999999999999999999999999999"),
+		osmomath.OneBigDec(),
+		osmomath.OneBigDec().Add(osmomath.SmallestBigDec()),
+		osmomath.MustNewBigDecFromStr("1.0001"),
+		osmomath.MustNewBigDecFromStr("3.3"),
+	}
+
+	for _, power := range []uint64{1, 2, 3, 7, 10, 31, 64} {
+		for i := 1; i < len(bases); i++ {
+			lower, upper := bases[i-1].PowerInteger(power), bases[i].PowerInteger(power)
+			s.Require().True(lower.LTE(upper), "power %d: %s^%d = %s > %s^%d = %s", power, bases[i-1], power, lower, bases[i], power, upper)
+		}
+	}
+}
+
 func (s *decimalTestSuite) TestPower() {
 	tests := map[string]struct {
 		base           osmomath.BigDec
@@ -1478,15 +1600,70 @@ func (s *decimalTestSuite) TestPower() {
 
 			expectPanic: true,
 		},
-		"negative exponent - panic": {
-			base:     osmomath.NewBigDec(1),
-			exponent: osmomath.MustNewBigDecFromStr("-4"),
+		"2^-1 (negative integer exponent)": {
+			base:     osmomath.NewBigDec(2),
+			exponent: osmomath.MustNewBigDecFromStr("-1"),
+
+			expectedResult: osmomath.MustNewBigDecFromStr("0.5"),
+
+			errTolerance: zeroAdditiveErrTolerance,
+		},
+		"3^-0.33 (negative non-integer exponent)": {
+			base:     osmomath.NewBigDec(3),
+			exponent: osmomath.MustNewBigDecFromStr("-0.33"),
+
+			// https://www.wolframalpha.com/input?i=3%5E-0.33+37+digits
+			expectedResult: osmomath.MustNewBigDecFromStr("0.695905046595227634712077469897544151"),
+
+			errTolerance: osmomath.ErrTolerance{
+				AdditiveTolerance: minDecTolerance,
+				RoundingDir:       osmomath.RoundUnconstrained,
+			},
+		},
+		"0.5^0.5 (base < 1 and non-integer exponent)": {
+			base:     osmomath.MustNewBigDecFromStr("0.5"),
+			exponent: osmomath.MustNewBigDecFromStr("0.5"),
+
+			// https://www.wolframalpha.com/input?i=0.5%5E0.5+37+digits
+			expectedResult: osmomath.MustNewBigDecFromStr("0.707106781186547524400844362104849039"),
+
+			errTolerance: osmomath.ErrTolerance{
+				AdditiveTolerance: minDecTolerance,
+				RoundingDir:       osmomath.RoundUnconstrained,
+			},
+		},
+		"0.9^0.3 (base < 1 and non-integer exponent)": {
+			base:     osmomath.MustNewBigDecFromStr("0.9"),
+			exponent: osmomath.MustNewBigDecFromStr("0.3"),
+
+			// https://www.wolframalpha.com/input?i=0.9%5E0.3+37+digits
+			expectedResult: osmomath.MustNewBigDecFromStr("0.968886161197263365897544624642878431"),
+
+			errTolerance: osmomath.ErrTolerance{
+				AdditiveTolerance: minDecTolerance,
+				RoundingDir:       osmomath.RoundUnconstrained,
+			},
+		},
+		"0.25^1.5 (base < 1 with exact logarithm)": {
+			base:     osmomath.MustNewBigDecFromStr("0.25"),
+			exponent: osmomath.MustNewBigDecFromStr("1.5"),
+
+			expectedResult: osmomath.MustNewBigDecFromStr("0.125"),
+
+			errTolerance: osmomath.ErrTolerance{
+				AdditiveTolerance: minDecTolerance,
+				RoundingDir:       osmomath.RoundUnconstrained,
+			},
+		},
+		"zero base with negative exponent - panic": {
+			base:     osmomath.ZeroBigDec(),
+			exponent: osmomath.MustNewBigDecFromStr("-0.5"),
 
 			expectPanic: true,
 		},
-		"base < 1 - panic (see godoc)": {
-			base:     osmomath.NewBigDec(1).Sub(osmomath.SmallestBigDec()),
-			exponent: osmomath.OneBigDec(),
+		"exponent too large in magnitude - panic": {
+			base:     osmomath.NewBigDec(2),
+			exponent: osmomath.MustNewBigDecFromStr("-1024.5"),
 
 			expectPanic: true,
 		},
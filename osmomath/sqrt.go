@@ -18,6 +18,19 @@ var oneBigInt = big.NewInt(1)
 // the returned root r, will be such that r^2 >= d
 // This function is monotonic, i.e. if d1 >= d2, then sqrt(d1) >= sqrt(d2)
 func MonotonicSqrt(d Dec) (Dec, error) {
+	return MonotonicSqrtWithRounding(d, RoundUp)
+}
+
+// MonotonicSqrtWithRounding returns the square root of d, rounded to 18 decimal
+// places in the given direction. Only RoundUp and RoundDown are supported.
+// It returns an error if d is negative or if the rounding direction is unsupported.
+//
+// With RoundDown, the returned root r is such that r^2 <= d < (r + 1ulp)^2.
+// With RoundUp, the returned root r is such that (r - 1ulp)^2 < d <= r^2.
+//
+// Both directions are monotonic, i.e. if d1 >= d2, then sqrt(d1) >= sqrt(d2).
+// See monotonicSqrtMut for the proof.
+func MonotonicSqrtWithRounding(d Dec, roundingDir RoundingDirection) (Dec, error) {
 	if d.IsNegative() {
 		return d, errors.New("cannot take square root of negative number")
 	}
@@ -30,53 +43,65 @@ func MonotonicSqrt(d Dec) (Dec, error) {
 	// since sqrt(10^18 * v) = 10^9 * sqrt(v) = 10^18 * sqrt(d), we get the answer we want.
 	//
 	// We can than interpret sqrt(10^18 * v) as our resulting decimal and return it.
-	// monotonicity is guaranteed by correctness of integer square root.
-	dBi := d.BigInt()
-	r := big.NewInt(0).Mul(dBi, tenTo18)
-	r.Sqrt(r)
-	// However this square root r is s.t. r^2 <= d. We want to flip this to be r^2 >= d.
-	// To do so, we check that if r^2 < d, do r += 1. Then by correctness we will be in the case we want.
-	// To compare r^2 and d, we can just compare r^2 and 10^18 * v. (recall r = 10^18 * sqrt(d), v = 10^18 * d)
-	check := big.NewInt(0).Mul(r, r)
 	// dBi is a copy of d, so we can modify it.
-	shiftedD := dBi.Mul(dBi, tenTo18)
-	if check.Cmp(shiftedD) == -1 {
-		r.Add(r, oneBigInt)
+	r, err := monotonicSqrtMut(d.BigInt(), tenTo18, roundingDir)
+	if err != nil {
+		return Dec{}, err
 	}
-	root := NewDecFromBigIntWithPrec(r, 18)
-
-	return root, nil
+	return NewDecFromBigIntWithPrec(r, 18), nil
 }
 
+// MonotonicSqrtBigDec returns the square root of d, rounded up to 36 decimal places.
+// It has the same guarantees as MonotonicSqrt.
 func MonotonicSqrtBigDec(d BigDec) (BigDec, error) {
+	return MonotonicSqrtBigDecWithRounding(d, RoundUp)
+}
+
+// MonotonicSqrtBigDecWithRounding returns the square root of d, rounded to 36 decimal
+// places in the given direction. Only RoundUp and RoundDown are supported.
+// It has the same guarantees as MonotonicSqrtWithRounding.
+func MonotonicSqrtBigDecWithRounding(d BigDec, roundingDir RoundingDirection) (BigDec, error) {
 	if d.IsNegative() {
 		return d, errors.New("cannot take square root of negative number")
 	}
 
-	// A decimal value of d, is represented as an integer of value v = 10^18 * d.
-	// We have an integer square root function, and we'd like to get the square root of d.
-	// recall integer square root is floor(sqrt(x)), hence its accurate up to 1 integer.
-	// we want sqrt d accurate to 18 decimal places.
-	// So first we multiply our current value by 10^18, then we take the integer square root.
-	// since sqrt(10^18 * v) = 10^9 * sqrt(v) = 10^18 * sqrt(d), we get the answer we want.
-	//
-	// We can than interpret sqrt(10^18 * v) as our resulting decimal and return it.
-	// monotonicity is guaranteed by correctness of integer square root.
-	dBi := d.BigInt()
-	r := big.NewInt(0).Mul(dBi, tenTo36)
-	r.Sqrt(r)
-	// However this square root r is s.t. r^2 <= d. We want to flip this to be r^2 >= d.
-	// To do so, we check that if r^2 < d, do r += 1. Then by correctness we will be in the case we want.
-	// To compare r^2 and d, we can just compare r^2 and 10^18 * v. (recall r = 10^18 * sqrt(d), v = 10^18 * d)
-	check := big.NewInt(0).Mul(r, r)
+	// Same as MonotonicSqrtWithRounding, except that the value of d is represented as
+	// an integer v = 10^36 * d, so we shift by 10^36 before taking the integer square root.
 	// dBi is a copy of d, so we can modify it.
-	shiftedD := dBi.Mul(dBi, tenTo36)
-	if check.Cmp(shiftedD) == -1 {
-		r.Add(r, oneBigInt)
+	r, err := monotonicSqrtMut(d.BigInt(), tenTo36, roundingDir)
+	if err != nil {
+		return BigDec{}, err
+	}
+	return NewBigDecFromBigIntWithPrec(r, 36), nil
+}
+
+// monotonicSqrtMut returns the integer square root of v * shift, rounded in the given direction.
+// v is mutated and must not be used by the caller afterwards.
+//
+// Let x = v * shift. big.Int.Sqrt returns r = floor(sqrt(x)), i.e. the unique r with r^2 <= x < (r+1)^2.
+//   - RoundDown returns r. If x1 <= x2, then r1^2 <= x1 <= x2 < (r2+1)^2, so r1 < r2+1, i.e. r1 <= r2.
+//   - RoundUp returns r' = r if r^2 == x, and r + 1 otherwise, i.e. the unique r' with (r'-1)^2 < x <= r'^2.
+//     If x1 <= x2, then (r1'-1)^2 < x1 <= x2 <= r2'^2, so r1'-1 < r2', i.e. r1' <= r2'.
+//
+// Hence both directions are monotonic in v.
+func monotonicSqrtMut(v *big.Int, shift *big.Int, roundingDir RoundingDirection) (*big.Int, error) {
+	if roundingDir != RoundUp && roundingDir != RoundDown {
+		return nil, errors.New("unsupported rounding direction for square root, must be RoundUp or RoundDown")
 	}
-	root := NewBigDecFromBigIntWithPrec(r, 36)
 
-	return root, nil
+	shiftedV := v.Mul(v, shift)
+	r := big.NewInt(0).Sqrt(shiftedV)
+	if roundingDir == RoundDown {
+		return r, nil
+	}
+
+	// However this square root r is s.t. r^2 <= x. We want to flip this to be r^2 >= x.
+	// To do so, we check that if r^2 < x, do r += 1. Then by correctness we will be in the case we want.
+	check := big.NewInt(0).Mul(r, r)
+	if check.Cmp(shiftedV) == -1 {
+		r.Add(r, oneBigInt)
+	}
+	return r, nil
 }
 
 // MustMonotonicSqrt returns the output of MonotonicSqrt, panicking on error.
@@ -88,7 +113,7 @@ func MustMonotonicSqrt(d Dec) Dec {
 	return sqrt
 }
 
-// MustMonotonicSqrt returns the output of MonotonicSqrt, panicking on error.
+// MustMonotonicSqrtBigDec returns the output of MonotonicSqrtBigDec, panicking on error.
 func MustMonotonicSqrtBigDec(d BigDec) BigDec {
 	sqrt, err := MonotonicSqrtBigDec(d)
 	if err != nil {
@@ -96,3 +121,21 @@ func MustMonotonicSqrtBigDec(d BigDec) BigDec {
 	}
 	return sqrt
 }
+
+// MustMonotonicSqrtWithRounding returns the output of MonotonicSqrtWithRounding, panicking on error.
+func MustMonotonicSqrtWithRounding(d Dec, roundingDir RoundingDirection) Dec {
+	sqrt, err := MonotonicSqrtWithRounding(d, roundingDir)
+	if err != nil {
+		panic(err)
+	}
+	return sqrt
+}
+
+// MustMonotonicSqrtBigDecWithRounding returns the output of MonotonicSqrtBigDecWithRounding, panicking on error.
+func MustMonotonicSqrtBigDecWithRounding(d BigDec, roundingDir RoundingDirection) BigDec {
+	sqrt, err := MonotonicSqrtBigDecWithRounding(d, roundingDir)
+	if err != nil {
+		panic(err)
+	}
+	return sqrt
+}
@@ -150,6 +150,101 @@ func TestSqrtRounding(t *testing.T) {
 	}
 }
 
+func TestSqrtWithRounding(t *testing.T) {
+	testCases := []Dec{
+		ZeroDec(),
+		smallestDec,
+		NewDec(2),
+		NewDec(100),
+		MustNewDecFromStr("120.120060020005000001"),
+	}
+	r := rand.New(rand.NewSource(rand.Int63()))
+	testCases = append(testCases, generateRandomDecForEachBitlenDec(r, 10)...)
+	for _, i := range testCases {
+		sqrtUp, err := MonotonicSqrtWithRounding(i, RoundUp)
+		require.NoError(t, err, "input: %s", i)
+		sqrtDown, err := MonotonicSqrtWithRounding(i, RoundDown)
+		require.NoError(t, err, "input: %s", i)
+
+		// RoundUp matches the default behavior.
+		sqrt, err := MonotonicSqrt(i)
+		require.NoError(t, err)
+		require.Equal(t, sqrt, sqrtUp)
+
+		// sqrtDown^2 <= input <= sqrtUp^2
+		assert.True(t, sqrtDown.Mul(sqrtDown).LTE(i), "sqrtDown %s, original: %s", sqrtDown, i)
+		assert.True(t, sqrtUp.Mul(sqrtUp).GTE(i), "sqrtUp %s, original: %s", sqrtUp, i)
+
+		// Both directions differ by at most 1 ulp, and are equal iff the root is exact.
+		diff := sqrtUp.Sub(sqrtDown)
+		assert.True(t, diff.IsZero() || diff.Equal(smallestDec), "sqrtUp %s, sqrtDown %s, original: %s", sqrtUp, sqrtDown, i)
+	}
+}
+
+func TestSqrtWithRounding_Monotonicity(t *testing.T) {
+	r := rand.New(rand.NewSource(rand.Int63()))
+	testCases := generateRandomDecForEachBitlenDec(r, 10)
+	for i := 0; i < 1024; i++ {
+		testCases = append(testCases, NewDecWithPrec(int64(i), 18))
+	}
+
+	for _, roundingDir := range []RoundingDirection{RoundUp, RoundDown} {
+		for _, smaller := range testCases {
+			bigger := smaller.Add(smallestDec)
+			sqrtSmaller, err := MonotonicSqrtWithRounding(smaller, roundingDir)
+			require.NoError(t, err, "smaller: %s", smaller)
+			sqrtBigger, err := MonotonicSqrtWithRounding(bigger, roundingDir)
+			require.NoError(t, err, "bigger: %s", bigger)
+			assert.True(t, sqrtSmaller.LTE(sqrtBigger), "rounding: %d, sqrtSmaller: %s, sqrtBigger: %s", roundingDir, sqrtSmaller, sqrtBigger)
+		}
+	}
+}
+
+func TestSqrtBigDecWithRounding(t *testing.T) {
+	smallestBigDec := SmallestBigDec()
+	testCases := []BigDec{
+		ZeroBigDec(),
+		smallestBigDec,
+		NewBigDec(2),
+		MustNewBigDecFromStr("0.000000000000000000000000000000000015"),
+	}
+	r := rand.New(rand.NewSource(rand.Int63()))
+	testCases = append(testCases, generateRandomDecForEachBitlen(r, 10, NewBigDecFromBigIntWithPrec, BigDecPrecision)...)
+	for _, i := range testCases {
+		sqrtUp, err := MonotonicSqrtBigDecWithRounding(i, RoundUp)
+		require.NoError(t, err, "input: %s", i)
+		sqrtDown, err := MonotonicSqrtBigDecWithRounding(i, RoundDown)
+		require.NoError(t, err, "input: %s", i)
+
+		sqrt, err := MonotonicSqrtBigDec(i)
+		require.NoError(t, err)
+		require.Equal(t, sqrt, sqrtUp)
+
+		assert.True(t, sqrtDown.Mul(sqrtDown).LTE(i), "sqrtDown %s, original: %s", sqrtDown, i)
+		assert.True(t, sqrtUp.MulRoundUp(sqrtUp).GTE(i), "sqrtUp %s, original: %s", sqrtUp, i)
+
+		diff := sqrtUp.Sub(sqrtDown)
+		assert.True(t, diff.IsZero() || diff.Equal(smallestBigDec), "sqrtUp %s, sqrtDown %s, original: %s", sqrtUp, sqrtDown, i)
+	}
+}
+
+func TestSqrtWithRounding_Errors(t *testing.T) {
+	for _, roundingDir := range []RoundingDirection{RoundUnconstrained, RoundBankers} {
+		_, err := MonotonicSqrtWithRounding(NewDec(2), roundingDir)
+		require.Error(t, err)
+		_, err = MonotonicSqrtBigDecWithRounding(NewBigDec(2), roundingDir)
+		require.Error(t, err)
+	}
+
+	_, err := MonotonicSqrtWithRounding(NewDec(-1), RoundDown)
+	require.Error(t, err)
+	_, err = MonotonicSqrtBigDecWithRounding(NewBigDec(-1), RoundDown)
+	require.Error(t, err)
+
+	require.Panics(t, func() { MustMonotonicSqrtWithRounding(NewDec(2), RoundBankers) })
+	require.Panics(t, func() { MustMonotonicSqrtBigDecWithRounding(NewBigDec(2), RoundBankers) })
+}
+
 // benchmarks the SDK square root across bit-lengths, for comparison with the new square root.
 func BenchmarkSqrt(b *testing.B) {
 	r := rand.New(rand.NewSource(1))
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -26,6 +27,10 @@ type AccumulatorObject struct {
 
 	// Accumulator's total shares across all positions
 	totalShares osmomath.Dec
+
+	// Factor that the accumulator's values are scaled up by internally.
+	// Equals one for accumulators without scaling.
+	scalingFactor osmomath.Dec
 }
 
 // Makes a new accumulator at store/accum/{accumName}
@@ -43,23 +48,54 @@ func MakeAccumulator(accumStore store.KVStore, accumName string) error {
 	initAccumValue := sdk.NewDecCoins()
 	initTotalShares := osmomath.ZeroDec()
 
-	newAccum := &AccumulatorObject{accumStore, accumName, initAccumValue, initTotalShares}
+	newAccum := &AccumulatorObject{accumStore, accumName, initAccumValue, initTotalShares, osmomath.OneDec()}
 
 	// Stores accumulator in state
 	return setAccumulator(newAccum, initAccumValue, initTotalShares)
 }
 
+// MakeAccumulatorWithScalingFactor makes a new accumulator at store/accum/{accumName} whose values are
+// scaled up internally by the given scaling factor.
+// Rewards added with AddToUnclaimedRewards are scaled up by the factor and claimed rewards are scaled down
+// by it. Callers adding to the accumulator value directly with AddToAccumulator must scale the amount up
+// themselves, prior to dividing it by the number of shares. This avoids truncation of per-share
+// values to zero when small amounts are distributed across a large number of shares.
+// Returns error if:
+// * accumName already exists
+// * theres some overlapping keys
+// * Accumulator name contains "||"
+// * scaling factor is not positive
+func MakeAccumulatorWithScalingFactor(accumStore store.KVStore, accumName string, scalingFactor osmomath.Dec) error {
+	return MakeAccumulatorWithValueShareAndScalingFactor(accumStore, accumName, sdk.NewDecCoins(), osmomath.ZeroDec(), scalingFactor)
+}
+
 // Makes a new accumulator at store/accum/{accumName}
 // Returns error if:
 // * accumName already exists
 // * theres some overlapping keys
 // * Accumulator name contains "||"
 func MakeAccumulatorWithValueAndShare(accumStore store.KVStore, accumName string, accumValue sdk.DecCoins, totalShares osmomath.Dec) error {
+	return MakeAccumulatorWithValueShareAndScalingFactor(accumStore, accumName, accumValue, totalShares, osmomath.OneDec())
+}
+
+// Makes a new accumulator at store/accum/{accumName} with the given value and total shares,
+// whose values are scaled up internally by the given scaling factor.
+// The accumulator value is expected to already be scaled.
+// Returns error if:
+// * accumName already exists
+// * theres some overlapping keys
+// * Accumulator name contains "||"
+// * scaling factor is not positive
+func MakeAccumulatorWithValueShareAndScalingFactor(accumStore store.KVStore, accumName string, accumValue sdk.DecCoins, totalShares osmomath.Dec, scalingFactor osmomath.Dec) error {
+	if scalingFactor.IsNil() || !scalingFactor.IsPositive() {
+		return NonPositiveScalingFactorError{ScalingFactor: scalingFactor}
+	}
+
 	if accumStore.Has(formatAccumPrefixKey(accumName)) {
 		return errors.New("Accumulator with given name already exists in store")
 	}
 
-	newAccum := AccumulatorObject{accumStore, accumName, accumValue, totalShares}
+	newAccum := AccumulatorObject{accumStore, accumName, accumValue, totalShares, scalingFactor}
 
 	// Stores accumulator in state
 	return setAccumulator(&newAccum, accumValue, totalShares)
@@ -76,7 +112,13 @@ func GetAccumulator(accumStore store.KVStore, accumName string) (*AccumulatorObj
 		return &AccumulatorObject{}, AccumDoesNotExistError{AccumName: accumName}
 	}
 
-	accum := AccumulatorObject{accumStore, accumName, accumContent.AccumValue, accumContent.TotalShares}
+	// Accumulators created without scaling do not have the scaling factor set.
+	scalingFactor := osmomath.OneDec()
+	if accumContent.ScalingFactor != nil {
+		scalingFactor = *accumContent.ScalingFactor
+	}
+
+	accum := AccumulatorObject{accumStore, accumName, accumContent.AccumValue, accumContent.TotalShares, scalingFactor}
 
 	return &accum, nil
 }
@@ -106,7 +148,13 @@ func setAccumulator(accum *AccumulatorObject, value sdk.DecCoins, shares osmomat
 	if strings.Contains(accum.name, KeySeparator) {
 		return fmt.Errorf("Accumulator name cannot contain '%s', provided name %s", KeySeparator, accum.name)
 	}
-	newAccum := AccumulatorContent{value, shares}
+	newAccum := AccumulatorContent{AccumValue: value, TotalShares: shares}
+	// The scaling factor is only persisted if it differs from one so that
+	// the encoding of accumulators without scaling stays unchanged.
+	if !accum.scalingFactor.Equal(one) {
+		scalingFactor := accum.scalingFactor
+		newAccum.ScalingFactor = &scalingFactor
+	}
 	osmoutils.MustSet(accum.store, formatAccumPrefixKey(accum.name), &newAccum)
 	return nil
 }
@@ -114,6 +162,7 @@ func setAccumulator(accum *AccumulatorObject, value sdk.DecCoins, shares osmomat
 // AddToAccumulator updates the accumulator's value by amt.
 // It does so by increasing the value of the accumulator by
 // the given amount. Persists to store. Mutates the receiver.
+// The given amount must already be scaled up by the accumulator's scaling factor.
 func (accum *AccumulatorObject) AddToAccumulator(amt sdk.DecCoins) {
 	accum.valuePerShare = accum.valuePerShare.Add(amt...)
 	// its safe to ignore error here.
@@ -388,6 +437,7 @@ func (accum AccumulatorObject) GetValue() sdk.DecCoins {
 // Upon claiming the rewards, the position at the current address is reset to have no
 // unclaimed rewards. The position's accumulator is also set to the current accumulator value.
 // The position state is removed if the position shares is equal to zero.
+// The returned rewards and dust are scaled down by the accumulator's scaling factor.
 //
 // Returns error if
 // - no position exists for the given address
@@ -398,7 +448,9 @@ func (accum *AccumulatorObject) ClaimRewards(positionName string) (sdk.Coins, sd
 		return sdk.Coins{}, sdk.DecCoins{}, NoPositionError{positionName}
 	}
 
-	totalRewards := GetTotalRewards(accum, position)
+	// Scale the rewards down from the accumulator's internal scale, truncating
+	// to ensure we never over distribute.
+	totalRewards := scaleDownTruncate(GetTotalRewards(accum, position), accum.scalingFactor)
 
 	// Return the integer coins to the user
 	// The remaining change is thrown away.
@@ -416,6 +468,52 @@ func (accum *AccumulatorObject) ClaimRewards(positionName string) (sdk.Coins, sd
 	return truncatedRewardsTotal, dust, nil
 }
 
+// GetScalingFactor returns the factor that the accumulator's values are scaled up by internally.
+// Returns one for accumulators without scaling.
+func (accum AccumulatorObject) GetScalingFactor() osmomath.Dec {
+	return accum.scalingFactor
+}
+
+// MigrateToScalingFactor scales up the value of an accumulator without scaling, along with the
+// accumulator value snapshots and unclaimed rewards of all of its positions, by the given scaling
+// factor, and sets the scaling factor on the accumulator. The rewards claimable by each position
+// are unchanged by the migration. Persists to store. Mutates the receiver.
+// Returns error if:
+// * the accumulator already has a scaling factor other than one
+// * scaling factor is not positive
+func (accum *AccumulatorObject) MigrateToScalingFactor(scalingFactor osmomath.Dec) error {
+	if scalingFactor.IsNil() || !scalingFactor.IsPositive() {
+		return NonPositiveScalingFactorError{ScalingFactor: scalingFactor}
+	}
+	if !accum.scalingFactor.Equal(one) {
+		return ScalingFactorAlreadySetError{AccumName: accum.name, ScalingFactor: accum.scalingFactor}
+	}
+
+	type keyedPosition struct {
+		key      []byte
+		position Record
+	}
+	// Positions are gathered before being rewritten to avoid mutating the store under an open iterator.
+	positions, err := osmoutils.GatherValuesFromStorePrefixWithKeyParser(accum.store, FormatPositionPrefixKey(accum.name, ""), func(key []byte, value []byte) (keyedPosition, error) {
+		position := Record{}
+		err := proto.Unmarshal(value, &position)
+		return keyedPosition{key: key, position: position}, err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range positions {
+		p.position.AccumValuePerShare = scaleUp(p.position.AccumValuePerShare, scalingFactor)
+		p.position.UnclaimedRewardsTotal = scaleUp(p.position.UnclaimedRewardsTotal, scalingFactor)
+		osmoutils.MustSet(accum.store, p.key, &p.position)
+	}
+
+	accum.valuePerShare = scaleUp(accum.valuePerShare, scalingFactor)
+	accum.scalingFactor = scalingFactor
+	return setAccumulator(accum, accum.valuePerShare, accum.totalShares)
+}
+
 // GetTotalShares returns the total number of shares in the accumulator
 func (accum AccumulatorObject) GetTotalShares() osmomath.Dec {
 	return accum.totalShares
@@ -424,6 +522,7 @@ func (accum AccumulatorObject) GetTotalShares() osmomath.Dec {
 // AddToUnclaimedRewards adds the given amount of rewards to the unclaimed rewards
 // for the given position. Returns error if no position exists for the given position name.
 // Returns error if any database errors occur or if neggative rewards are provided.
+// The given rewards are scaled up by the accumulator's scaling factor before being stored.
 func (accum *AccumulatorObject) AddToUnclaimedRewards(positionName string, rewardsToAddTotal sdk.DecCoins) error {
 	position, err := GetPosition(accum, positionName)
 	if err != nil {
@@ -436,7 +535,8 @@ func (accum *AccumulatorObject) AddToUnclaimedRewards(positionName string, rewar
 
 	// Update the user's position with the new unclaimed rewards. The accumulator, options, and
 	// the number of shares stays the same as in the original position.
-	initOrUpdatePosition(accum, position.AccumValuePerShare, positionName, position.NumShares, position.UnclaimedRewardsTotal.Add(rewardsToAddTotal...), position.Options)
+	scaledRewardsToAddTotal := scaleUp(rewardsToAddTotal, accum.scalingFactor)
+	initOrUpdatePosition(accum, position.AccumValuePerShare, positionName, position.NumShares, position.UnclaimedRewardsTotal.Add(scaledRewardsToAddTotal...), position.Options)
 
 	return nil
 }
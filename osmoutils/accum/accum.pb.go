@@ -32,6 +32,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type AccumulatorContent struct {
 	AccumValue  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=accum_value,json=accumValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accum_value"`
 	TotalShares cosmossdk_io_math.LegacyDec                 `protobuf:"bytes,2,opt,name=total_shares,json=totalShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_shares"`
+	// scaling_factor is the factor that the values of the accumulator are
+	// scaled up by internally. Rewards are scaled up by it on deposit and scaled
+	// down by it on claim. This prevents truncation of small per-share reward
+	// amounts to zero. Unset for accumulators without scaling, in which case the
+	// scaling factor is one.
+	ScalingFactor *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=scaling_factor,json=scalingFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"scaling_factor,omitempty"`
 }
 
 func (m *AccumulatorContent) Reset()         { *m = AccumulatorContent{} }
@@ -206,34 +212,35 @@ func init() {
 func init() { proto.RegisterFile("osmosis/accum/v1beta1/accum.proto", fileDescriptor_4866f7c74a169dc2) }
 
 var fileDescriptor_4866f7c74a169dc2 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0x0d, 0x6a, 0x95, 0x0b, 0xd3, 0x89, 0x08, 0xab, 0x20, 0x27, 0x84, 0x25, 0x12,
-	0xea, 0x9d, 0xda, 0x2e, 0xac, 0xa4, 0x15, 0x12, 0x12, 0x12, 0xc8, 0x20, 0x06, 0x16, 0xeb, 0x7c,
-	0x3e, 0x25, 0xa7, 0xda, 0xf7, 0x46, 0xf7, 0xa7, 0xa8, 0x3b, 0x0b, 0x1b, 0x2b, 0x5f, 0x81, 0x4f,
-	0xd2, 0x31, 0x23, 0x62, 0x28, 0x28, 0xf9, 0x22, 0xc8, 0x77, 0x76, 0xe9, 0xc0, 0xc0, 0x92, 0xe9,
-	0xfc, 0xda, 0xcf, 0xfb, 0x7b, 0x5e, 0x3d, 0x7e, 0x0f, 0x3f, 0x01, 0x5b, 0x83, 0x55, 0x96, 0x71,
-	0x21, 0x7c, 0xcd, 0x2e, 0x8f, 0x0b, 0xe9, 0xf8, 0x71, 0xac, 0xe8, 0xca, 0x80, 0x03, 0x32, 0x6a,
-	0x25, 0x34, 0xbe, 0x6c, 0x25, 0x87, 0x0f, 0x16, 0xb0, 0x80, 0xa0, 0x60, 0xcd, 0x53, 0x14, 0x1f,
-	0xa6, 0x22, 0xa8, 0x59, 0xc1, 0xad, 0xbc, 0xa5, 0x09, 0x50, 0x3a, 0x7e, 0x9f, 0xae, 0x11, 0x26,
-	0x2f, 0x1a, 0x8e, 0xaf, 0xb8, 0x03, 0x73, 0x06, 0xda, 0x49, 0xed, 0x88, 0xc1, 0xc3, 0x40, 0xcf,
-	0x2f, 0x79, 0xe5, 0x65, 0x82, 0x26, 0xfd, 0xd9, 0xf0, 0xe4, 0x31, 0x8d, 0x30, 0xda, 0xc0, 0x3a,
-	0x5f, 0x7a, 0x2e, 0xc5, 0x19, 0x28, 0x3d, 0x3f, 0xbd, 0xbe, 0x19, 0xf7, 0xbe, 0xff, 0x1a, 0x3f,
-	0x5b, 0x28, 0xb7, 0xf4, 0x05, 0x15, 0x50, 0xb3, 0xd6, 0x3c, 0x1e, 0x47, 0xb6, 0xbc, 0x60, 0xee,
-	0x6a, 0x25, 0x6d, 0xd7, 0x63, 0x33, 0x1c, 0x5c, 0x3e, 0x34, 0x26, 0xe4, 0x25, 0xbe, 0xef, 0xc0,
-	0xf1, 0x2a, 0xb7, 0x4b, 0x6e, 0xa4, 0x4d, 0xf6, 0x26, 0x68, 0x36, 0x98, 0x3f, 0x6d, 0xb0, 0x3f,
-	0x6f, 0xc6, 0x8f, 0x22, 0xc4, 0x96, 0x17, 0x54, 0x01, 0xab, 0xb9, 0x5b, 0xd2, 0xd7, 0x72, 0xc1,
-	0xc5, 0xd5, 0xb9, 0x14, 0xd9, 0x30, 0x34, 0xbe, 0x0b, 0x7d, 0xd3, 0x01, 0x3e, 0x78, 0xb3, 0x72,
-	0x0a, 0xb4, 0x9d, 0x7e, 0xeb, 0xe3, 0xfd, 0x4c, 0x0a, 0x30, 0x25, 0x99, 0x63, 0xac, 0x7d, 0xdd,
-	0xb1, 0xd1, 0xff, 0xb3, 0x07, 0xda, 0xd7, 0x91, 0x4c, 0x3e, 0x23, 0x3c, 0xba, 0x13, 0x4b, 0xbe,
-	0x92, 0x26, 0x02, 0x93, 0xbd, 0x5d, 0x05, 0x44, 0xfe, 0x06, 0xf4, 0x56, 0x9a, 0x30, 0x07, 0xf9,
-	0x82, 0xf0, 0x43, 0xaf, 0x45, 0xc5, 0x55, 0x2d, 0xcb, 0xdc, 0xc8, 0x4f, 0xdc, 0x94, 0x36, 0x0f,
-	0x11, 0x24, 0xfd, 0x5d, 0x0d, 0x32, 0xba, 0x75, 0xcc, 0xa2, 0xe1, 0xfb, 0xc6, 0x8f, 0x3c, 0xc7,
-	0x07, 0x10, 0xc3, 0x4e, 0xee, 0x4d, 0xd0, 0x6c, 0x78, 0x92, 0xd2, 0x7f, 0xae, 0x27, 0x6d, 0x7f,
-	0x49, 0xd6, 0xc9, 0xe7, 0xaf, 0xae, 0x37, 0x29, 0x5a, 0x6f, 0x52, 0xf4, 0x7b, 0x93, 0xa2, 0xaf,
-	0xdb, 0xb4, 0xb7, 0xde, 0xa6, 0xbd, 0x1f, 0xdb, 0xb4, 0xf7, 0x91, 0xdd, 0x99, 0xab, 0x85, 0x1d,
-	0x55, 0xbc, 0xb0, 0x5d, 0x11, 0x4e, 0xef, 0x54, 0xd5, 0xde, 0x92, 0x62, 0x3f, 0xec, 0xf2, 0xe9,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x19, 0x52, 0x8b, 0x3d, 0x03, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xb5, 0x93, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x1b, 0x2b, 0x95, 0x5e, 0xd5, 0x21, 0x58, 0x2c, 0x55, 0x5a, 0xad, 0x4b, 0x41, 0x7a,
+	0xa1, 0x76, 0x71, 0xb5, 0x15, 0x41, 0x10, 0x94, 0x28, 0x0e, 0x2e, 0xe1, 0x72, 0x3d, 0xd3, 0x60,
+	0x92, 0x0b, 0x77, 0x17, 0xa5, 0xbb, 0x8b, 0x9b, 0xa3, 0xfe, 0x05, 0x7f, 0x89, 0xa3, 0xa3, 0x38,
+	0xa8, 0xe8, 0x1f, 0xf1, 0x72, 0x77, 0x55, 0x07, 0x41, 0x17, 0x87, 0x8f, 0xcb, 0x25, 0xef, 0xf7,
+	0xbc, 0x5f, 0xde, 0xe4, 0xc0, 0x2a, 0xe5, 0x31, 0xe5, 0x21, 0x77, 0x10, 0xc6, 0x59, 0xec, 0x9c,
+	0x77, 0x7d, 0x22, 0x50, 0x57, 0xef, 0x60, 0xca, 0xa8, 0xa0, 0x76, 0xd5, 0x48, 0xa0, 0xbe, 0x69,
+	0x24, 0xf5, 0x85, 0x80, 0x06, 0x54, 0x29, 0x9c, 0xfc, 0x4a, 0x8b, 0xeb, 0x0d, 0xac, 0xd4, 0x8e,
+	0x8f, 0x38, 0xf9, 0xa4, 0x61, 0x1a, 0x26, 0xfa, 0x79, 0xeb, 0x66, 0x0a, 0xd8, 0x5b, 0x39, 0x27,
+	0x8b, 0x90, 0xa0, 0x6c, 0x40, 0x13, 0x41, 0x12, 0x61, 0x33, 0x50, 0x51, 0x74, 0xef, 0x1c, 0x45,
+	0x19, 0xa9, 0x59, 0x2b, 0xc5, 0x76, 0x65, 0x63, 0x19, 0x6a, 0x18, 0xcc, 0x61, 0x13, 0x5f, 0xb8,
+	0x4d, 0xf0, 0x40, 0xf2, 0xfa, 0xbd, 0xfb, 0xe7, 0x66, 0xe1, 0xee, 0xa5, 0xb9, 0x1e, 0x84, 0x62,
+	0x94, 0xf9, 0x52, 0x1b, 0x3b, 0xc6, 0x5c, 0x2f, 0x1d, 0x3e, 0x3c, 0x73, 0xc4, 0x38, 0x25, 0x7c,
+	0xd2, 0xc3, 0x5d, 0xa0, 0x5c, 0x8e, 0x73, 0x13, 0x7b, 0x07, 0xcc, 0x0a, 0x2a, 0x50, 0xe4, 0xf1,
+	0x11, 0x62, 0x84, 0xd7, 0xa6, 0x56, 0xac, 0x76, 0xb9, 0xbf, 0x96, 0x63, 0x9f, 0x9e, 0x9b, 0x4b,
+	0x1a, 0x22, 0x19, 0x30, 0xa4, 0x4e, 0x8c, 0xc4, 0x08, 0xee, 0x91, 0x00, 0xe1, 0xb1, 0x64, 0xb9,
+	0x15, 0xd5, 0x78, 0xa8, 0xfa, 0x24, 0x67, 0x9e, 0x63, 0x14, 0x85, 0x49, 0xe0, 0x9d, 0x22, 0x2c,
+	0x5f, 0xaa, 0x56, 0x54, 0xa4, 0xe6, 0x6f, 0x94, 0x39, 0xd3, 0xb6, 0xa3, 0xba, 0x5a, 0x65, 0x30,
+	0xb3, 0x9f, 0x8a, 0x90, 0x26, 0xbc, 0x75, 0x5b, 0x04, 0x25, 0x97, 0x60, 0xca, 0x86, 0x76, 0x1f,
+	0x80, 0x44, 0xe6, 0x62, 0x66, 0xb4, 0xfe, 0x3e, 0x63, 0x59, 0xb6, 0x99, 0x09, 0x2f, 0x2d, 0x50,
+	0xfd, 0x16, 0xaf, 0x97, 0x12, 0xa6, 0x81, 0xf2, 0x9d, 0xff, 0x29, 0x68, 0xfb, 0x2b, 0xe8, 0x03,
+	0xc2, 0xd4, 0x1c, 0xf6, 0x95, 0x05, 0x16, 0xb3, 0x04, 0x47, 0x28, 0x8c, 0xc9, 0xd0, 0x63, 0xe4,
+	0x02, 0xb1, 0x21, 0xf7, 0x54, 0x94, 0x32, 0xb2, 0x7f, 0x1a, 0xa4, 0xfa, 0xe9, 0xe8, 0x6a, 0xc3,
+	0xa3, 0xdc, 0xcf, 0xde, 0x04, 0x33, 0x54, 0x87, 0x5d, 0x9b, 0x96, 0x99, 0x56, 0x36, 0x1a, 0xf0,
+	0xc7, 0xdf, 0x1c, 0x9a, 0x4f, 0xe2, 0x4e, 0xe4, 0xfd, 0xdd, 0xfb, 0xb7, 0x86, 0xf5, 0x20, 0xeb,
+	0x55, 0xd6, 0xf5, 0x7b, 0xa3, 0xf0, 0x20, 0xeb, 0x51, 0xd6, 0x89, 0xf3, 0x6d, 0x2e, 0x03, 0xeb,
+	0x44, 0xc8, 0xe7, 0x93, 0x8d, 0x5a, 0x33, 0x11, 0x46, 0xe6, 0xb4, 0xf9, 0x25, 0x75, 0x26, 0x7a,
+	0x1f, 0x36, 0x04, 0x19, 0x74, 0x85, 0x03, 0x00, 0x00,
 }
 
 func (m *AccumulatorContent) Marshal() (dAtA []byte, err error) {
@@ -256,6 +263,18 @@ func (m *AccumulatorContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ScalingFactor != nil {
+		{
+			size := m.ScalingFactor.Size()
+			i -= size
+			if _, err := m.ScalingFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAccum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -404,6 +423,10 @@ func (m *AccumulatorContent) Size() (n int) {
 	}
 	l = m.TotalShares.Size()
 	n += 1 + l + sovAccum(uint64(l))
+	if m.ScalingFactor != nil {
+		l = m.ScalingFactor.Size()
+		n += 1 + l + sovAccum(uint64(l))
+	}
 	return n
 }
 
@@ -546,6 +569,42 @@ func (m *AccumulatorContent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.ScalingFactor = &v
+			if err := m.ScalingFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccum(dAtA[iNdEx:])
@@ -43,3 +43,21 @@ func GetTotalRewards(accum *AccumulatorObject, position Record) sdk.DecCoins {
 
 	return totalRewards
 }
+
+// scaleUp scales the given amount up by the scaling factor.
+// Returns the amount unchanged if the scaling factor is one.
+func scaleUp(amt sdk.DecCoins, scalingFactor osmomath.Dec) sdk.DecCoins {
+	if scalingFactor.Equal(one) {
+		return amt
+	}
+	return amt.MulDec(scalingFactor)
+}
+
+// scaleDownTruncate scales the given amount down by the scaling factor, truncating the result.
+// Returns the amount unchanged if the scaling factor is one.
+func scaleDownTruncate(amt sdk.DecCoins, scalingFactor osmomath.Dec) sdk.DecCoins {
+	if scalingFactor.Equal(one) {
+		return amt
+	}
+	return amt.QuoDecTruncate(scalingFactor)
+}
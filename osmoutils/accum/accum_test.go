@@ -1744,3 +1744,170 @@ func (suite *AccumTestSuite) TestDeletePosition() {
 		})
 	}
 }
+
+func (suite *AccumTestSuite) TestMakeAccumulatorWithScalingFactor() {
+	tests := map[string]struct {
+		scalingFactor osmomath.Dec
+		expectedError error
+	}{
+		"scaling factor of one": {
+			scalingFactor: osmomath.OneDec(),
+		},
+		"large scaling factor": {
+			scalingFactor: osmomath.NewDec(1_000_000_000_000_000),
+		},
+		"error: zero scaling factor": {
+			scalingFactor: osmomath.ZeroDec(),
+			expectedError: accumPackage.NonPositiveScalingFactorError{ScalingFactor: osmomath.ZeroDec()},
+		},
+		"error: negative scaling factor": {
+			scalingFactor: osmomath.NewDec(-1),
+			expectedError: accumPackage.NonPositiveScalingFactorError{ScalingFactor: osmomath.NewDec(-1)},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			err := accumPackage.MakeAccumulatorWithScalingFactor(suite.store, testNameOne, tc.scalingFactor)
+			if tc.expectedError != nil {
+				suite.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			accum := suite.GetAccumulator(testNameOne)
+			suite.Require().Equal(tc.scalingFactor.String(), accum.GetScalingFactor().String())
+
+			// Scaling factor survives subsequent writes of the accumulator.
+			accum.AddToAccumulator(initialCoinsDenomOne)
+			accum = suite.GetAccumulator(testNameOne)
+			suite.Require().Equal(tc.scalingFactor.String(), accum.GetScalingFactor().String())
+		})
+	}
+}
+
+// TestScalingFactor_UnscaledAccumEncoding checks that accumulators without scaling
+// keep their encoding, so that existing accumulators in state remain unchanged.
+func (suite *AccumTestSuite) TestScalingFactor_UnscaledAccumEncoding() {
+	suite.SetupTest()
+
+	accum := suite.MakeAndGetAccumulator(testNameOne)
+	accum.AddToAccumulator(initialCoinsDenomOne)
+
+	content := accumPackage.AccumulatorContent{}
+	osmoutils.MustGet(suite.store, []byte("accum||acc||"+testNameOne), &content)
+	suite.Require().Nil(content.ScalingFactor)
+	suite.Require().Equal(osmomath.OneDec(), accum.GetScalingFactor())
+}
+
+// TestScalingFactor_ClaimRewards checks that rewards that would truncate to zero per share
+// without scaling are accumulated and claimed by accumulators with a scaling factor.
+func (suite *AccumTestSuite) TestScalingFactor_ClaimRewards() {
+	var (
+		scalingFactor = osmomath.NewDec(1_000_000_000_000_000)
+		// A very large number of shares such that distributing a small
+		// amount across them truncates to zero per share.
+		numShares   = osmomath.MustNewDecFromStr("1000000000000000000000000000")
+		totalReward = osmomath.NewDec(1000)
+	)
+
+	addRewards := func(accum *accumPackage.AccumulatorObject) {
+		rewardPerShare := totalReward.MulTruncate(accum.GetScalingFactor()).QuoTruncate(numShares)
+		accum.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, rewardPerShare)))
+	}
+
+	suite.SetupTest()
+
+	// Without scaling, the reward per share truncates to zero and nothing can be claimed.
+	unscaledAccum := suite.MakeAndGetAccumulator(testNameOne)
+	suite.Require().NoError(unscaledAccum.NewPosition(testAddressOne, numShares, nil))
+	addRewards(unscaledAccum)
+
+	claimed, _, err := unscaledAccum.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().True(claimed.IsZero())
+
+	// With scaling, the full reward is claimed.
+	err = accumPackage.MakeAccumulatorWithScalingFactor(suite.store, testNameTwo, scalingFactor)
+	suite.Require().NoError(err)
+	scaledAccum := suite.GetAccumulator(testNameTwo)
+	suite.Require().NoError(scaledAccum.NewPosition(testAddressOne, numShares, nil))
+	addRewards(scaledAccum)
+
+	claimed, dust, err := scaledAccum.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denomOne, totalReward.TruncateInt())), claimed)
+	suite.Require().True(dust.IsZero())
+
+	// Unclaimed rewards are added unscaled and claimed unscaled.
+	suite.Require().NoError(scaledAccum.AddToUnclaimedRewards(testAddressOne, initialCoinsDenomOne))
+	position := scaledAccum.MustGetPosition(testAddressOne)
+	suite.Require().Equal(initialCoinsDenomOne.MulDec(scalingFactor), position.UnclaimedRewardsTotal)
+
+	claimed, dust, err = scaledAccum.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	expectedClaimed, expectedDust := initialCoinsDenomOne.TruncateDecimal()
+	suite.Require().Equal(expectedClaimed, claimed)
+	suite.Require().Equal(expectedDust, dust)
+}
+
+// TestMigrateToScalingFactor checks that migrating an accumulator without scaling to a scaling factor
+// scales up its value and positions while leaving the rewards claimable by each position unchanged.
+func (suite *AccumTestSuite) TestMigrateToScalingFactor() {
+	scalingFactor := osmomath.NewDec(1_000_000_000_000_000)
+
+	// setupAccum creates an accumulator with two positions, one of which has unclaimed rewards
+	// and accumulator growth that happened before it was created.
+	setupAccum := func(name string) *accumPackage.AccumulatorObject {
+		accum := suite.MakeAndGetAccumulator(name)
+		suite.Require().NoError(accum.NewPosition(testAddressOne, osmomath.NewDec(100), nil))
+		accum.AddToAccumulator(initialCoinsDenomOne)
+		suite.Require().NoError(accum.NewPosition(testAddressTwo, osmomath.NewDec(50), nil))
+		accum.AddToAccumulator(initialCoinsDenomOne)
+		suite.Require().NoError(accum.AddToUnclaimedRewards(testAddressTwo, initialCoinsDenomOne))
+		return accum
+	}
+
+	suite.SetupTest()
+
+	unmigratedAccum := setupAccum(testNameOne)
+	migratedAccum := setupAccum(testNameTwo)
+	unmigratedValue := unmigratedAccum.GetValue()
+
+	err := migratedAccum.MigrateToScalingFactor(scalingFactor)
+	suite.Require().NoError(err)
+
+	// The value and the scaling factor are persisted.
+	migratedAccum = suite.GetAccumulator(testNameTwo)
+	suite.Require().Equal(scalingFactor.String(), migratedAccum.GetScalingFactor().String())
+	suite.Require().Equal(unmigratedValue.MulDec(scalingFactor), migratedAccum.GetValue())
+	suite.Require().Equal(unmigratedAccum.GetTotalShares(), migratedAccum.GetTotalShares())
+
+	// Positions are scaled up, while those of other accumulators are not touched.
+	suite.Require().Equal(initialCoinsDenomOne.MulDec(scalingFactor), migratedAccum.MustGetPosition(testAddressTwo).AccumValuePerShare)
+	suite.Require().Equal(initialCoinsDenomOne.MulDec(scalingFactor), migratedAccum.MustGetPosition(testAddressTwo).UnclaimedRewardsTotal)
+	suite.Require().Equal(initialCoinsDenomOne, unmigratedAccum.MustGetPosition(testAddressTwo).AccumValuePerShare)
+	suite.Require().Equal(osmomath.OneDec(), suite.GetAccumulator(testNameOne).GetScalingFactor())
+
+	// Accumulator growth after the migration is added scaled.
+	unmigratedAccum.AddToAccumulator(initialCoinsDenomOne)
+	migratedAccum.AddToAccumulator(initialCoinsDenomOne.MulDec(scalingFactor))
+
+	for _, positionName := range []string{testAddressOne, testAddressTwo} {
+		expectedClaimed, expectedDust, err := unmigratedAccum.ClaimRewards(positionName)
+		suite.Require().NoError(err)
+		claimed, dust, err := migratedAccum.ClaimRewards(positionName)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedClaimed, claimed)
+		suite.Require().Equal(expectedDust, dust)
+	}
+
+	// An accumulator can only be migrated once.
+	err = migratedAccum.MigrateToScalingFactor(scalingFactor)
+	suite.Require().ErrorContains(err, accumPackage.ScalingFactorAlreadySetError{AccumName: testNameTwo, ScalingFactor: scalingFactor}.Error())
+
+	err = unmigratedAccum.MigrateToScalingFactor(osmomath.ZeroDec())
+	suite.Require().ErrorContains(err, accumPackage.NonPositiveScalingFactorError{ScalingFactor: osmomath.ZeroDec()}.Error())
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
 )
 
 var (
@@ -51,3 +53,20 @@ type NegativeRewardsAdditionError struct {
 func (e NegativeRewardsAdditionError) Error() string {
 	return fmt.Sprintf("Attempted to add negative rewards to position %s of the accumulator %s", e.PositionName, e.AccumName)
 }
+
+type NonPositiveScalingFactorError struct {
+	ScalingFactor osmomath.Dec
+}
+
+func (e NonPositiveScalingFactorError) Error() string {
+	return fmt.Sprintf("scaling factor must be positive, was (%s)", e.ScalingFactor)
+}
+
+type ScalingFactorAlreadySetError struct {
+	AccumName     string
+	ScalingFactor osmomath.Dec
+}
+
+func (e ScalingFactorAlreadySetError) Error() string {
+	return fmt.Sprintf("accumulator %s already has scaling factor (%s)", e.AccumName, e.ScalingFactor)
+}
@@ -31,6 +31,7 @@ func MakeTestAccumulator(store store.KVStore, name string, value sdk.DecCoins, t
 		name:          name,
 		valuePerShare: value,
 		totalShares:   totalShares,
+		scalingFactor: osmomath.OneDec(),
 	}
 	setAccumulator(&acc, value, totalShares)
 	return &acc
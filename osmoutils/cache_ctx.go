@@ -18,6 +18,24 @@ import (
 // If its an out of gas panic, this function will also panic like in normal tx execution flow.
 // This is still safe for beginblock / endblock code though, as they do not have out of gas panics.
 func ApplyFuncIfNoError(ctx sdk.Context, f func(ctx sdk.Context) error) (err error) {
+	_, _, err = ApplyFuncIfNoErrorAndCondition(ctx, func(cacheCtx sdk.Context) (struct{}, error) {
+		return struct{}{}, f(cacheCtx)
+	}, nil)
+	if err != nil {
+		ctx.Logger().Error(err.Error())
+	}
+	return err
+}
+
+// ApplyFuncIfNoErrorAndCondition runs the function f in a cache context and only writes its state changes
+// and emits its events to ctx if f returns no error and condition returns true on its result.
+// A nil condition always passes.
+// Returns the result of f, whether its state changes were written and its error.
+// The error is not logged, it is left to the caller.
+//
+// Panics in f are recovered and returned as an error, except for out of gas panics,
+// which are re-raised like in the normal tx execution flow.
+func ApplyFuncIfNoErrorAndCondition[T any](ctx sdk.Context, f func(cacheCtx sdk.Context) (T, error), condition func(result T) bool) (result T, written bool, err error) {
 	// Add a panic safeguard
 	defer func() {
 		if recoveryError := recover(); recoveryError != nil {
@@ -32,15 +50,22 @@ func ApplyFuncIfNoError(ctx sdk.Context, f func(ctx sdk.Context) error) (err err
 	}()
 	// makes a new cache context, which all state changes get wrapped inside of.
 	cacheCtx, write := ctx.CacheContext()
-	err = f(cacheCtx)
-	if err != nil {
-		ctx.Logger().Error(err.Error())
-	} else {
-		// no error, write the output of f
-		write()
-		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	result, err = f(cacheCtx)
+	if err != nil || (condition != nil && !condition(result)) {
+		return result, false, err
 	}
-	return err
+	// no error and the condition passes, write the output of f
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return result, true, nil
+}
+
+// DryRunFunc runs the function f in a cache context whose state changes and events are always discarded,
+// and returns its result. It is meant for estimating the outcome of state machine operations, such as swaps,
+// without committing them.
+func DryRunFunc[T any](ctx sdk.Context, f func(cacheCtx sdk.Context) (T, error)) (T, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return f(cacheCtx)
 }
 
 // Frustratingly, this has to return the error descriptor, not an actual error itself
@@ -1,6 +1,8 @@
 package osmoutils_test
 
 import (
+	"errors"
+
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -57,3 +59,67 @@ func (s *TestSuite) TestCacheCtxConsumeGas() {
 		})
 	}
 }
+
+func (s *TestSuite) TestApplyFuncIfNoErrorAndCondition() {
+	key := []byte("key")
+	testcases := map[string]struct {
+		funcErr     error
+		condition   func(result uint64) bool
+		expectWrite bool
+	}{
+		"no error, condition passes": {
+			condition:   func(result uint64) bool { return result == 1 },
+			expectWrite: true,
+		},
+		"no error, nil condition": {
+			expectWrite: true,
+		},
+		"no error, condition fails": {
+			condition:   func(result uint64) bool { return result > 1 },
+			expectWrite: false,
+		},
+		"error": {
+			funcErr:     errors.New("my func"),
+			condition:   func(result uint64) bool { return true },
+			expectWrite: false,
+		},
+	}
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+			result, written, err := osmoutils.ApplyFuncIfNoErrorAndCondition(ctx, func(cacheCtx sdk.Context) (uint64, error) {
+				cacheCtx.KVStore(s.authStoreKey).Set(key, []byte{1})
+				cacheCtx.EventManager().EmitEvent(sdk.NewEvent("my_event"))
+				return 1, tc.funcErr
+			}, tc.condition)
+
+			s.Require().ErrorIs(err, tc.funcErr)
+			s.Require().Equal(uint64(1), result)
+			s.Require().Equal(tc.expectWrite, written)
+			s.Require().Equal(tc.expectWrite, ctx.KVStore(s.authStoreKey).Has(key))
+			if tc.expectWrite {
+				s.Require().Len(ctx.EventManager().Events(), 1)
+			} else {
+				s.Require().Empty(ctx.EventManager().Events())
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestDryRunFunc() {
+	key := []byte("key")
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+	result, err := osmoutils.DryRunFunc(ctx, func(cacheCtx sdk.Context) (string, error) {
+		cacheCtx.KVStore(s.authStoreKey).Set(key, []byte{1})
+		cacheCtx.EventManager().EmitEvent(sdk.NewEvent("my_event"))
+		return "result", nil
+	})
+
+	s.Require().NoError(err)
+	s.Require().Equal("result", result)
+	s.Require().False(ctx.KVStore(s.authStoreKey).Has(key))
+	s.Require().Empty(ctx.EventManager().Events())
+}
@@ -1,7 +1,9 @@
 package osmocli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
@@ -23,6 +25,7 @@ var DefaultGovAuthority = sdk.AccAddress(address.Module("gov"))
 const (
 	FlagIsExpedited = "is-expedited"
 	FlagAuthority   = "authority"
+	FlagMetadata    = "metadata"
 )
 
 // Parses arguments 1-1 from args
@@ -172,6 +175,14 @@ func parseFieldFromDirectlySetFlag(fVal reflect.Value, fType reflect.StructField
 			fVal.SetUint(u)
 			return nil
 		}
+		if t == "bool" {
+			b, err := flags.GetBool(flagName)
+			if err != nil {
+				return err
+			}
+			fVal.SetBool(b)
+			return nil
+		}
 	}
 	return ParseFieldFromArg(fVal, fType, s)
 }
@@ -233,6 +244,19 @@ func ParseFieldFromArg(fVal reflect.Value, fType reflect.StructField, arg string
 		fVal.SetString(s)
 		return nil
 	case reflect.Ptr:
+		typeStr := fType.Type.String()
+		if typeStr == "*time.Time" {
+			// An empty argument leaves the optional time unset.
+			if arg == "" {
+				return nil
+			}
+			t, err := ParseUnixTime(arg, fType.Name)
+			if err != nil {
+				return err
+			}
+			fVal.Set(reflect.ValueOf(&t))
+			return nil
+		}
 	case reflect.Slice:
 		typeStr := fType.Type.String()
 		if typeStr == "[]uint64" {
@@ -403,53 +427,103 @@ func ParseUint64SliceToString(values []uint64) string {
 	return strings.Join(strs, ", ")
 }
 
-func GetProposalInfo(cmd *cobra.Command) (client.Context, string, string, sdk.Coins, bool, sdk.AccAddress, error) {
+// proposalInfo contains the fields common to all proposals.
+// It may be supplied as a JSON file with the --proposal flag in place of the respective flags.
+type proposalInfo struct {
+	Title     string `json:"title"`
+	Summary   string `json:"summary"`
+	Metadata  string `json:"metadata"`
+	Deposit   string `json:"deposit"`
+	Expedited bool   `json:"expedited"`
+}
+
+// proposalInfoFlags are the flags that are superseded by the --proposal flag.
+var proposalInfoFlags = []string{cli.FlagTitle, cli.FlagSummary, FlagMetadata, cli.FlagDeposit, FlagIsExpedited}
+
+// GetProposalInfo returns the client context and the title, summary, metadata, deposit, expedited flag and authority of a proposal.
+// These are read from the JSON file supplied with the --proposal flag if set, and from the respective flags otherwise.
+// Returns error if the --proposal flag is set alongside any of the respective flags.
+func GetProposalInfo(cmd *cobra.Command) (client.Context, string, string, string, sdk.Coins, bool, sdk.AccAddress, error) {
 	clientCtx, err := client.GetClientTxContext(cmd)
 	if err != nil {
-		return client.Context{}, "", "", nil, false, nil, err
+		return client.Context{}, "", "", "", nil, false, nil, err
 	}
 
-	proposalTitle, err := cmd.Flags().GetString(cli.FlagTitle)
+	proposal, err := parseProposalInfo(cmd.Flags())
 	if err != nil {
-		return clientCtx, proposalTitle, "", nil, false, nil, err
+		return client.Context{}, "", "", "", nil, false, nil, err
 	}
 
-	summary, err := cmd.Flags().GetString(cli.FlagSummary)
+	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return client.Context{}, proposalTitle, summary, nil, false, nil, err
+		return client.Context{}, "", "", "", nil, false, nil, err
 	}
 
-	depositArg, err := cmd.Flags().GetString(cli.FlagDeposit)
+	authorityString, err := cmd.Flags().GetString(FlagAuthority)
 	if err != nil {
-		return client.Context{}, proposalTitle, summary, nil, false, nil, err
+		return client.Context{}, "", "", "", nil, false, nil, err
 	}
-
-	deposit, err := sdk.ParseCoinsNormalized(depositArg)
+	authority, err := sdk.AccAddressFromBech32(authorityString)
 	if err != nil {
-		return client.Context{}, proposalTitle, summary, deposit, false, nil, err
+		return client.Context{}, "", "", "", nil, false, nil, err
 	}
 
-	isExpedited, err := cmd.Flags().GetBool(FlagIsExpedited)
+	return clientCtx, proposal.Title, proposal.Summary, proposal.Metadata, deposit, proposal.Expedited, authority, nil
+}
+
+// parseProposalInfo parses the proposal info from the JSON file supplied with the --proposal flag if set,
+// and from the respective flags otherwise.
+func parseProposalInfo(fs *pflag.FlagSet) (proposalInfo, error) {
+	proposalFile, err := fs.GetString(cli.FlagProposal)
 	if err != nil {
-		return client.Context{}, proposalTitle, summary, deposit, false, nil, err
+		return proposalInfo{}, err
 	}
 
-	authorityString, err := cmd.Flags().GetString(FlagAuthority)
-	if err != nil {
-		return client.Context{}, proposalTitle, summary, deposit, false, nil, err
+	if proposalFile == "" {
+		proposal := proposalInfo{}
+		if proposal.Title, err = fs.GetString(cli.FlagTitle); err != nil {
+			return proposalInfo{}, err
+		}
+		if proposal.Summary, err = fs.GetString(cli.FlagSummary); err != nil {
+			return proposalInfo{}, err
+		}
+		if proposal.Metadata, err = fs.GetString(FlagMetadata); err != nil {
+			return proposalInfo{}, err
+		}
+		if proposal.Deposit, err = fs.GetString(cli.FlagDeposit); err != nil {
+			return proposalInfo{}, err
+		}
+		if proposal.Expedited, err = fs.GetBool(FlagIsExpedited); err != nil {
+			return proposalInfo{}, err
+		}
+		return proposal, nil
 	}
-	authority, err := sdk.AccAddressFromBech32(authorityString)
+
+	for _, flag := range proposalInfoFlags {
+		if fs.Changed(flag) {
+			return proposalInfo{}, fmt.Errorf("--%s flag provided alongside --%s, which is a noop", flag, cli.FlagProposal)
+		}
+	}
+
+	contents, err := os.ReadFile(proposalFile)
 	if err != nil {
-		return client.Context{}, proposalTitle, summary, deposit, false, nil, err
+		return proposalInfo{}, err
+	}
+
+	proposal := proposalInfo{}
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposalInfo{}, err
 	}
 
-	return clientCtx, proposalTitle, summary, deposit, isExpedited, authority, nil
+	return proposal, nil
 }
 
 func AddCommonProposalFlags(cmd *cobra.Command) {
 	cmd.Flags().String(cli.FlagTitle, "", "Title of proposal")
 	cmd.Flags().String(cli.FlagSummary, "", "Summary of proposal")
+	cmd.Flags().String(FlagMetadata, "", "Metadata of proposal, e.g. an IPFS CID of a JSON document")
 	cmd.Flags().String(cli.FlagDeposit, "", "Deposit of proposal")
 	cmd.Flags().Bool(FlagIsExpedited, false, "Whether the proposal is expedited")
 	cmd.Flags().String(FlagAuthority, DefaultGovAuthority.String(), "The address of the governance account. Default is the sdk gov module account")
+	cmd.Flags().String(cli.FlagProposal, "", "Path to a JSON file with the title, summary, metadata, deposit and expedited fields of the proposal, in place of the respective flags")
 }
@@ -1,11 +1,14 @@
 package osmocli
 
 import (
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -21,6 +24,7 @@ type testingStruct struct {
 	Slice    sdk.Coins
 	Struct   interface{}
 	Dec      osmomath.Dec
+	Time     *time.Time
 }
 
 func TestParseFieldFromArg(t *testing.T) {
@@ -140,6 +144,18 @@ func TestParseFieldFromArg(t *testing.T) {
 				Struct: sdk.NewCoin("bar", osmomath.NewInt(10)),
 			},
 		},
+		"Time pointer from unix timestamp": {
+			testingStruct:  testingStruct{},
+			arg:            "1700000000",
+			fieldIndex:     9,
+			expectedStruct: testingStruct{Time: timePointer(time.Unix(1700000000, 0))},
+		},
+		"Time pointer left unset for empty argument": {
+			testingStruct:  testingStruct{},
+			arg:            "",
+			fieldIndex:     9,
+			expectedStruct: testingStruct{},
+		},
 		"Dec struct": {
 			testingStruct:  testingStruct{Dec: osmomath.MustNewDecFromStr("100")},
 			arg:            "10",
@@ -168,6 +184,10 @@ func TestParseFieldFromArg(t *testing.T) {
 	}
 }
 
+func timePointer(t time.Time) *time.Time {
+	return &t
+}
+
 func TestParseUint64SliceToString(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -205,3 +225,74 @@ func TestParseUint64SliceToString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProposalInfo(t *testing.T) {
+	proposalJSON := `{
+	"title": "File title",
+	"summary": "File summary",
+	"metadata": "ipfs://CID",
+	"deposit": "1000uosmo",
+	"expedited": true
+}`
+
+	tests := map[string]struct {
+		args          []string
+		proposalFile  string
+		expected      proposalInfo
+		expectedError bool
+	}{
+		"from flags": {
+			args: []string{"--title=Flag title", "--summary=Flag summary", "--metadata=ipfs://CID", "--deposit=10uosmo", "--is-expedited"},
+			expected: proposalInfo{
+				Title:     "Flag title",
+				Summary:   "Flag summary",
+				Metadata:  "ipfs://CID",
+				Deposit:   "10uosmo",
+				Expedited: true,
+			},
+		},
+		"from proposal file": {
+			proposalFile: proposalJSON,
+			expected: proposalInfo{
+				Title:     "File title",
+				Summary:   "File summary",
+				Metadata:  "ipfs://CID",
+				Deposit:   "1000uosmo",
+				Expedited: true,
+			},
+		},
+		"proposal file alongside flag": {
+			args:          []string{"--title=Flag title"},
+			proposalFile:  proposalJSON,
+			expectedError: true,
+		},
+		"invalid proposal file": {
+			proposalFile:  "{",
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			AddCommonProposalFlags(cmd)
+
+			args := tc.args
+			if tc.proposalFile != "" {
+				path := filepath.Join(t.TempDir(), "proposal.json")
+				require.NoError(t, os.WriteFile(path, []byte(tc.proposalFile), 0o600))
+				args = append(args, "--proposal="+path)
+			}
+			require.NoError(t, cmd.Flags().Parse(args))
+
+			proposal, err := parseProposalInfo(cmd.Flags())
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, proposal)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package osmoutils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// simulationCtxKey is the context key under which the simulation flag is stored.
+type simulationCtxKey struct{}
+
+// WithSimulation returns a copy of the context marked as executing a transaction simulation.
+// Modules may use this to surface information that is only useful to clients estimating gas,
+// without emitting it during regular transaction execution.
+func WithSimulation(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(simulationCtxKey{}, true)
+}
+
+// IsSimulation returns true if the context was marked by WithSimulation.
+func IsSimulation(ctx sdk.Context) bool {
+	isSimulation, ok := ctx.Value(simulationCtxKey{}).(bool)
+	return ok && isSimulation
+}
@@ -7,6 +7,7 @@ import (
 	db "github.com/cometbft/cometbft-db"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 
@@ -57,6 +58,87 @@ func GatherValuesFromStorePrefixWithKeyParser[T any](storeObj store.KVStore, pre
 	return gatherValuesFromIteratorWithKeyParser(iterator, parse, noStopFn)
 }
 
+// GatherValuesFromStorePaginated is a decorator around GatherValuesFromStorePrefixWithKeyParserPaginated. It overwrites the parse function to
+// disable parsing keys, only keeping values
+func GatherValuesFromStorePaginated[T any](storeObj store.KVStore, keyPrefix []byte, pagination *query.PageRequest, parseValue func([]byte) (T, error)) ([]T, *query.PageResponse, error) {
+	parseOnlyValue := func(_ []byte, value []byte) (T, error) {
+		return parseValue(value)
+	}
+	return GatherValuesFromStorePrefixWithKeyParserPaginated(storeObj, keyPrefix, pagination, parseOnlyValue)
+}
+
+// GatherValuesFromStorePrefixWithKeyParserPaginated gathers the values under the given store prefix, limited by the
+// given pagination request. The keys passed to the parse function have keyPrefix stripped, matching the keys returned
+// in the page response. Only the values in the page are parsed.
+// Returns error if:
+// - the parse function returns an error.
+// - the pagination request is invalid (e.g. both offset and key are set).
+func GatherValuesFromStorePrefixWithKeyParserPaginated[T any](storeObj store.KVStore, keyPrefix []byte, pagination *query.PageRequest, parse func(key []byte, value []byte) (T, error)) ([]T, *query.PageResponse, error) {
+	prefixStore := prefix.NewStore(storeObj, keyPrefix)
+
+	values := []T{}
+	pageRes, err := query.Paginate(prefixStore, pagination, func(key []byte, value []byte) error {
+		val, err := parse(key, value)
+		if err != nil {
+			return err
+		}
+		values = append(values, val)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return values, pageRes, nil
+}
+
+// GatherValuesFromStorePrefixFilteredPaginated gathers the values under the given store prefix whose raw entries
+// satisfy the filter, limited by the given pagination request. Entries that are rejected by the filter do not count
+// towards the page limit or the total. The filter is applied to every entry iterated over, so it is expected to be
+// cheap, e.g. a check on the key. Only the values in the page are parsed. The keys passed to the parse and filter
+// functions have keyPrefix stripped.
+// Returns error if:
+// - the parse or filter function returns an error.
+// - the pagination request is invalid (e.g. both offset and key are set).
+func GatherValuesFromStorePrefixFilteredPaginated[T any](storeObj store.KVStore, keyPrefix []byte, pagination *query.PageRequest, parse func(key []byte, value []byte) (T, error), filter func(key []byte, value []byte) (bool, error)) ([]T, *query.PageResponse, error) {
+	prefixStore := prefix.NewStore(storeObj, keyPrefix)
+
+	values := []T{}
+	pageRes, err := query.FilteredPaginate(prefixStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		ok, err := filter(key, value)
+		if err != nil || !ok {
+			return false, err
+		}
+		if accumulate {
+			val, err := parse(key, value)
+			if err != nil {
+				return false, err
+			}
+			values = append(values, val)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return values, pageRes, nil
+}
+
+// ProtoValueParser returns a parse function that unmarshals a value into a new proto message of type T.
+// It is meant to be passed to the GatherValues family of functions, e.g.
+// GatherValuesFromStorePaginated(store, prefix, pagination, ProtoValueParser[types.Gauge]()).
+func ProtoValueParser[T any, PT interface {
+	*T
+	proto.Message
+}]() func([]byte) (T, error) {
+	return func(value []byte) (T, error) {
+		var result T
+		if err := proto.Unmarshal(value, PT(&result)); err != nil {
+			return result, err
+		}
+		return result, nil
+	}
+}
+
 func GetValuesUntilDerivedStop[T any](storeObj store.KVStore, keyStart []byte, stopFn func([]byte) bool, parseValue func([]byte) (T, error)) ([]T, error) {
 	// SDK iterator is broken for nil end time, and non-nil start time
 	// https://github.com/cosmos/cosmos-sdk/issues/12661
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/suite"
 
@@ -448,6 +449,203 @@ func (s *TestSuite) TestGatherValuesFromStorePrefixWithKeyParser() {
 	}
 }
 
+func (s *TestSuite) TestGatherValuesFromStorePrefixFilteredPaginated() {
+	acceptAll := func([]byte, []byte) (bool, error) { return true, nil }
+	rejectKeyB := func(key []byte, _ []byte) (bool, error) { return string(key) != keyB, nil }
+	filterWithError := func([]byte, []byte) (bool, error) { return false, mockError }
+	// parseOnlyKeyB fails to parse any other key, asserting that the entries outside the page are not parsed.
+	parseOnlyKeyB := func(key []byte, value []byte) (string, error) {
+		if string(key) != keyB {
+			return "", mockError
+		}
+		return mockParseWithKey(key, value)
+	}
+
+	testcases := map[string]struct {
+		preSetKeys []string
+		prefix     []byte
+		pagination *query.PageRequest
+		parseFn    func(key []byte, value []byte) (string, error)
+		filterFn   func(key []byte, value []byte) (bool, error)
+
+		expectedErr     error
+		expectedValues  []string
+		expectedNextKey []byte
+		expectedTotal   uint64
+	}{
+		"nil pagination, all values under prefix": {
+			preSetKeys: oneABtwoAB,
+			prefix:     []byte(prefixOne),
+			parseFn:    mockParseWithKey,
+			filterFn:   acceptAll,
+
+			// keys are stripped of the prefix
+			expectedValues: []string{keyA + "0", keyB + "1"},
+		},
+		"limit smaller than number of values": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Limit: 2},
+			parseFn:    mockParseWithKey,
+			filterFn:   acceptAll,
+
+			expectedValues:  []string{keyA + "0", keyB + "1"},
+			expectedNextKey: []byte(keyC),
+		},
+		"start from key": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Key: []byte(keyB), Limit: 10},
+			parseFn:    mockParseWithKey,
+			filterFn:   acceptAll,
+
+			expectedValues: []string{keyB + "1", keyC + "2"},
+		},
+		"offset with count total": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+			parseFn:    mockParseWithKey,
+			filterFn:   acceptAll,
+
+			expectedValues: []string{keyB + "1"},
+			expectedTotal:  3,
+		},
+		"only the values in the page are parsed": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+			parseFn:    parseOnlyKeyB,
+			filterFn:   acceptAll,
+
+			expectedValues: []string{keyB + "1"},
+			expectedTotal:  3,
+		},
+		"filtered values do not count towards the limit": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Limit: 2},
+			parseFn:    mockParseWithKey,
+			filterFn:   rejectKeyB,
+
+			expectedValues: []string{keyA + "0", keyC + "2"},
+		},
+		"filtered values do not count towards the total": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+			parseFn:    mockParseWithKey,
+			filterFn:   rejectKeyB,
+
+			expectedValues: []string{keyA + "0", keyC + "2"},
+			expectedTotal:  2,
+		},
+		"prefix doesn't exist": {
+			preSetKeys: twoAB,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Limit: 10},
+			parseFn:    mockParseWithKey,
+			filterFn:   acceptAll,
+
+			expectedValues: []string{},
+		},
+		"parse with error": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Limit: 10},
+			parseFn:    mockParseWithKeyError,
+			filterFn:   acceptAll,
+
+			expectedErr: mockError,
+		},
+		"filter with error": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Limit: 10},
+			parseFn:    mockParseWithKey,
+			filterFn:   filterWithError,
+
+			expectedErr: mockError,
+		},
+		"invalid pagination, both offset and key set": {
+			preSetKeys: oneABC,
+			prefix:     []byte(prefixOne),
+			pagination: &query.PageRequest{Key: []byte(keyB), Offset: 1},
+			parseFn:    mockParseWithKey,
+			filterFn:   acceptAll,
+
+			expectedErr: errors.New("invalid request, either offset or key is expected, got both"),
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.SetupTest()
+			for i, key := range tc.preSetKeys {
+				s.store.Set([]byte(key), []byte(fmt.Sprintf("%v", i)))
+			}
+
+			actualValues, pageRes, err := osmoutils.GatherValuesFromStorePrefixFilteredPaginated(s.store, tc.prefix, tc.pagination, tc.parseFn, tc.filterFn)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				s.Require().Nil(actualValues)
+				s.Require().Nil(pageRes)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedValues, actualValues)
+			s.Require().Equal(tc.expectedNextKey, pageRes.NextKey)
+			s.Require().Equal(tc.expectedTotal, pageRes.Total)
+		})
+	}
+}
+
+func (s *TestSuite) TestGatherValuesFromStorePaginated() {
+	s.SetupTest()
+	for i, key := range oneABtwoAB {
+		s.store.Set([]byte(key), []byte(fmt.Sprintf("%v", i)))
+	}
+
+	actualValues, pageRes, err := osmoutils.GatherValuesFromStorePaginated(s.store, []byte(prefixTwo), &query.PageRequest{Limit: 1}, mockParseValue)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"2"}, actualValues)
+	s.Require().Equal([]byte(keyB), pageRes.NextKey)
+
+	actualValues, pageRes, err = osmoutils.GatherValuesFromStorePaginated(s.store, []byte(prefixTwo), &query.PageRequest{Key: pageRes.NextKey, Limit: 1}, mockParseValue)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"3"}, actualValues)
+	s.Require().Nil(pageRes.NextKey)
+
+	// Only the values in the page are parsed, including when counting the total.
+	parseOnlyThree := func(value []byte) (string, error) {
+		if string(value) != "3" {
+			return "", mockError
+		}
+		return mockParseValue(value)
+	}
+	actualValues, pageRes, err = osmoutils.GatherValuesFromStorePaginated(s.store, []byte(prefixTwo), &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}, parseOnlyThree)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{"3"}, actualValues)
+	s.Require().Equal(uint64(2), pageRes.Total)
+}
+
+func (s *TestSuite) TestProtoValueParser() {
+	s.SetupTest()
+	expected := sdk.DecProto{Dec: osmomath.NewDec(5)}
+	osmoutils.MustSet(s.store, []byte(keyA), &expected)
+
+	actualValues, err := osmoutils.GatherValuesFromStorePrefix(s.store, []byte(keyA), osmoutils.ProtoValueParser[sdk.DecProto]())
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.DecProto{expected}, actualValues)
+
+	// a value that is not a valid proto encoding fails to parse.
+	s.store.Set([]byte(keyB), []byte{0xff})
+	_, err = osmoutils.GatherValuesFromStorePrefix(s.store, []byte(keyB), osmoutils.ProtoValueParser[sdk.DecProto]())
+	s.Require().Error(err)
+}
+
 func (s *TestSuite) TestGetFirstValueAfterPrefixInclusive() {
 	testcases := map[string]struct {
 		prefix     []byte
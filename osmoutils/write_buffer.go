@@ -0,0 +1,101 @@
+package osmoutils
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// WriteBuffer holds writes to a parent KVStore in memory until Flush is called.
+// Repeated writes to the same key are coalesced, so that every key is written
+// to the parent at most once per flush. Reads through the buffer observe the
+// buffered writes first and fall back to the parent store.
+//
+// Iterators over the parent store do not observe buffered writes. Callers must only
+// buffer writes to keys that are not read back through an iterator before Flush.
+type WriteBuffer struct {
+	parent store.KVStore
+	// pending maps a key to its buffered value. A nil value marks a buffered delete.
+	pending map[string][]byte
+}
+
+// NewWriteBuffer returns an empty write buffer on top of the given parent store.
+func NewWriteBuffer(parent store.KVStore) *WriteBuffer {
+	return &WriteBuffer{
+		parent:  parent,
+		pending: map[string][]byte{},
+	}
+}
+
+// Get returns the buffered value for key if one exists, otherwise it reads from the parent store.
+// Returns nil if the key has a buffered delete.
+func (b *WriteBuffer) Get(key []byte) []byte {
+	if value, ok := b.pending[string(key)]; ok {
+		return value
+	}
+	return b.parent.Get(key)
+}
+
+// Has returns true if the key has a buffered value or exists in the parent store
+// without a buffered delete.
+func (b *WriteBuffer) Has(key []byte) bool {
+	if value, ok := b.pending[string(key)]; ok {
+		return value != nil
+	}
+	return b.parent.Has(key)
+}
+
+// Set buffers a write of value under key, replacing any previously buffered write for the key.
+// Panics on nil value, matching the behavior of the SDK stores.
+func (b *WriteBuffer) Set(key []byte, value []byte) {
+	if value == nil {
+		panic("value is nil")
+	}
+	b.pending[string(key)] = value
+}
+
+// MustSet marshals value and buffers a write of it under key.
+// Panics on any error.
+func (b *WriteBuffer) MustSet(key []byte, value proto.Message) {
+	bz, err := proto.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	b.Set(key, bz)
+}
+
+// Delete buffers a delete of key, replacing any previously buffered write for the key.
+func (b *WriteBuffer) Delete(key []byte) {
+	b.pending[string(key)] = nil
+}
+
+// Len returns the number of distinct keys with buffered writes.
+func (b *WriteBuffer) Len() int {
+	return len(b.pending)
+}
+
+// Flush writes all buffered writes to the parent store and empties the buffer.
+// Keys are written in ascending byte order so that the resulting sequence of store
+// operations is deterministic regardless of the order the writes were buffered in.
+func (b *WriteBuffer) Flush() {
+	keys := make([][]byte, 0, len(b.pending))
+	for key := range b.pending {
+		keys = append(keys, []byte(key))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	for _, key := range keys {
+		value := b.pending[string(key)]
+		if value == nil {
+			b.parent.Delete(key)
+		} else {
+			b.parent.Set(key, value)
+		}
+	}
+	b.pending = map[string][]byte{}
+}
@@ -0,0 +1,84 @@
+package osmoutils_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+func (s *TestSuite) TestWriteBuffer() {
+	s.SetupTest()
+	s.store.Set([]byte(keyA), []byte("0"))
+	s.store.Set([]byte(keyC), []byte("2"))
+
+	buffer := osmoutils.NewWriteBuffer(s.store)
+
+	// repeated writes to the same key are coalesced.
+	buffer.Set([]byte(keyA), []byte("1"))
+	buffer.Set([]byte(keyA), []byte("3"))
+	buffer.Set([]byte(keyB), []byte("4"))
+	buffer.Delete([]byte(keyC))
+	s.Require().Equal(3, buffer.Len())
+
+	// reads through the buffer observe buffered writes.
+	s.Require().Equal([]byte("3"), buffer.Get([]byte(keyA)))
+	s.Require().Equal([]byte("4"), buffer.Get([]byte(keyB)))
+	s.Require().Nil(buffer.Get([]byte(keyC)))
+	s.Require().False(buffer.Has([]byte(keyC)))
+
+	// the parent store is untouched until flush.
+	s.Require().Equal([]byte("0"), s.store.Get([]byte(keyA)))
+	s.Require().False(s.store.Has([]byte(keyB)))
+	s.Require().Equal([]byte("2"), s.store.Get([]byte(keyC)))
+
+	buffer.Flush()
+
+	s.Require().Equal(0, buffer.Len())
+	s.Require().Equal([]byte("3"), s.store.Get([]byte(keyA)))
+	s.Require().Equal([]byte("4"), s.store.Get([]byte(keyB)))
+	s.Require().False(s.store.Has([]byte(keyC)))
+}
+
+func (s *TestSuite) TestWriteBuffer_MustSet() {
+	s.SetupTest()
+	buffer := osmoutils.NewWriteBuffer(s.store)
+
+	expected := sdk.DecProto{Dec: osmomath.NewDec(5)}
+	buffer.MustSet([]byte(keyA), &expected)
+	buffer.Flush()
+
+	actual := sdk.DecProto{}
+	osmoutils.MustGet(s.store, []byte(keyA), &actual)
+	s.Require().Equal(expected, actual)
+}
+
+// TestWriteBuffer_FlushDeterminism checks that flushing the same set of writes buffered
+// in different orders results in the same gas consumption and store state.
+func (s *TestSuite) TestWriteBuffer_FlushDeterminism() {
+	writes := [][2]string{{keyC, "0"}, {keyA, "1"}, {keyB, "2"}, {keyA, "3"}}
+	reversed := [][2]string{{keyA, "3"}, {keyB, "2"}, {keyC, "0"}}
+
+	flushWrites := func(writes [][2]string) (sdk.Gas, []string) {
+		s.SetupTest()
+		buffer := osmoutils.NewWriteBuffer(s.store)
+		for _, write := range writes {
+			buffer.Set([]byte(write[0]), []byte(write[1]))
+		}
+
+		gasBefore := s.ctx.GasMeter().GasConsumed()
+		buffer.Flush()
+		gasUsed := s.ctx.GasMeter().GasConsumed() - gasBefore
+
+		values, err := osmoutils.GatherValuesFromStorePrefix(s.store, []byte{}, mockParseValue)
+		s.Require().NoError(err)
+		return gasUsed, values
+	}
+
+	gasUsed, values := flushWrites(writes)
+	reversedGasUsed, reversedValues := flushWrites(reversed)
+
+	s.Require().Equal(gasUsed, reversedGasUsed)
+	s.Require().Equal([]string{"3", "2", "0"}, values)
+	s.Require().Equal(values, reversedValues)
+}
@@ -0,0 +1,64 @@
+#!/usr/bin/env bash
+
+# Compares the output of `go test -bench -benchmem` against a baseline produced the same way and
+# fails if the average ns/op or allocs/op of any benchmark present in both regressed by more than
+# the given threshold percentage.
+#
+# Usage: bench-regression.sh <baseline> <current> [threshold_percent]
+
+set -eo pipefail
+
+BASELINE=$1
+CURRENT=$2
+THRESHOLD=${3:-10}
+
+if [ ! -f "$BASELINE" ]; then
+    echo "Baseline $BASELINE not found, generate it with 'make test-benchmark-cl-baseline' on the base branch"
+    exit 1
+fi
+
+if [ ! -f "$CURRENT" ]; then
+    echo "Benchmark output $CURRENT not found"
+    exit 1
+fi
+
+# Prints "<benchmark> <avg ns/op> <avg allocs/op>" for every benchmark in the given output,
+# averaging over the runs of -count and dropping the GOMAXPROCS suffix.
+averages() {
+    awk '
+        /^Benchmark/ {
+            name = $1
+            sub(/-[0-9]+$/, "", name)
+            for (i = 2; i < NF; i++) {
+                if ($(i+1) == "ns/op") ns[name] += $i
+                if ($(i+1) == "allocs/op") allocs[name] += $i
+            }
+            runs[name]++
+        }
+        END {
+            for (name in runs) printf "%s %f %f\n", name, ns[name] / runs[name], allocs[name] / runs[name]
+        }
+    ' "$1" | sort
+}
+
+join <(averages "$BASELINE") <(averages "$CURRENT") | awk -v threshold="$THRESHOLD" '
+    function delta(old, new) {
+        return old > 0 ? (new - old) / old * 100 : 0
+    }
+    {
+        nsDelta = delta($2, $4)
+        allocsDelta = delta($3, $5)
+        status = "ok"
+        if (nsDelta > threshold || allocsDelta > threshold) {
+            status = "REGRESSION"
+            failed = 1
+        }
+        printf "%-60s ns/op %+7.2f%%  allocs/op %+7.2f%%  %s\n", $1, nsDelta, allocsDelta, status
+    }
+    END {
+        if (failed) {
+            printf "\nBenchmarks regressed by more than %s%%\n", threshold
+            exit 1
+        }
+    }
+'
@@ -7,6 +7,12 @@ PACKAGES_E2E := $(shell go list ./... | grep '/e2e' | awk -F'/e2e' '{print $$1 "
 PACKAGES_SIM=$(shell go list ./... | grep '/tests/simulator')
 TEST_PACKAGES=./...
 
+CL_BENCHMARKS='^Benchmark(SwapCrossingTicks|PositionChurn)$$'
+CL_BENCH_COUNT?=5
+CL_BENCH_OUTPUT?=cl_bench_output.txt
+CL_BENCH_BASELINE?=cl_bench_baseline.txt
+CL_BENCH_THRESHOLD?=10
+
 test-help:
 	@echo "test subcommands"
 	@echo ""
@@ -29,6 +35,9 @@ test-help:
 	@echo "  e2e-short          Run e2e short tests"
 	@echo "  mutation           Run mutation tests"
 	@echo "  benchmark          Run benchmark tests"
+	@echo "  benchmark-cl       Run CL swap and position benchmarks"
+	@echo "  benchmark-cl-baseline  Save CL benchmark results as the baseline"
+	@echo "  benchmark-cl-check Fail if CL benchmarks regressed from the baseline"
 
 test: test-help
 
@@ -89,3 +98,18 @@ test-mutation:
 
 test-benchmark:
 	@go test -mod=readonly -bench=. $(PACKAGES_UNIT)
+
+# test-benchmark-cl runs the CL swap and position benchmarks, reporting ns/op and allocations.
+test-benchmark-cl:
+	@go test -mod=readonly -run=^$$ -bench=$(CL_BENCHMARKS) -benchmem -count=$(CL_BENCH_COUNT) ./x/concentrated-liquidity/ > $(CL_BENCH_OUTPUT); \
+	status=$$?; cat $(CL_BENCH_OUTPUT); exit $$status
+
+# test-benchmark-cl-baseline saves the CL benchmark results to compare later changes against.
+# Run it on the base branch before performance-motivated refactors.
+test-benchmark-cl-baseline:
+	@$(MAKE) test-benchmark-cl CL_BENCH_OUTPUT=$(CL_BENCH_BASELINE)
+
+# test-benchmark-cl-check fails if the ns/op or allocs/op of any CL benchmark regressed
+# by more than CL_BENCH_THRESHOLD percent from the baseline.
+test-benchmark-cl-check: test-benchmark-cl
+	@bash scripts/bench-regression.sh $(CL_BENCH_BASELINE) $(CL_BENCH_OUTPUT) $(CL_BENCH_THRESHOLD)
@@ -6,7 +6,7 @@ type OsmosisMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
 	/// A contract may create any number of independent sub-denoms.
 	CreateDenom *CreateDenom `json:"create_denom,omitempty"`
-	/// Contracts can change the admin of a denom that they are the admin of.
+	/// Contracts can propose a new admin for a denom that they are the admin of.
 	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
 	/// Contracts can mint native tokens for an existing factory denom
 	/// that they are the admin of.
@@ -27,8 +27,8 @@ type CreateDenom struct {
 	Subdenom string `json:"subdenom"`
 }
 
-// ChangeAdmin changes the admin for a factory denom.
-// If the NewAdminAddress is empty, the denom has no admin.
+// ChangeAdmin proposes a new admin for a factory denom.
+// The new admin must accept the adminship with MsgAcceptAdmin for it to be transferred.
 type ChangeAdmin struct {
 	Denom           string `json:"denom"`
 	NewAdminAddress string `json:"new_admin_address"`
@@ -141,6 +141,8 @@ func (m *CustomMessenger) changeAdmin(ctx sdk.Context, contractAddr sdk.AccAddre
 }
 
 // ChangeAdmin is used with changeAdmin to validate changeAdmin messages and to dispatch.
+// The adminship is not transferred directly: the new admin is proposed, and only becomes
+// the admin of the denom once it accepts the adminship with MsgAcceptAdmin.
 func ChangeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, changeAdmin *bindings.ChangeAdmin) error {
 	if changeAdmin == nil {
 		return wasmvmtypes.InvalidRequest{Err: "changeAdmin is nil"}
@@ -150,13 +152,13 @@ func ChangeAdmin(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk
 		return err
 	}
 
-	changeAdminMsg := tokenfactorytypes.NewMsgChangeAdmin(contractAddr.String(), changeAdmin.Denom, newAdminAddr.String())
-	if err := changeAdminMsg.ValidateBasic(); err != nil {
+	proposeAdminTransferMsg := tokenfactorytypes.NewMsgProposeAdminTransfer(contractAddr.String(), changeAdmin.Denom, newAdminAddr.String())
+	if err := proposeAdminTransferMsg.ValidateBasic(); err != nil {
 		return err
 	}
 
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
-	_, err = msgServer.ChangeAdmin(sdk.WrapSDKContext(ctx), changeAdminMsg)
+	_, err = msgServer.ProposeAdminTransfer(sdk.WrapSDKContext(ctx), proposeAdminTransferMsg)
 	if err != nil {
 		return errorsmod.Wrap(err, "failed changing admin from message")
 	}
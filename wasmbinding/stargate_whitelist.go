@@ -123,6 +123,8 @@ func init() {
 	setWhitelistedQuery("/osmosis.lockup.Query/LockedByID", &lockuptypes.LockedResponse{})
 	setWhitelistedQuery("/osmosis.lockup.Query/NextLockID", &lockuptypes.NextLockIDResponse{})
 	setWhitelistedQuery("/osmosis.lockup.Query/LockRewardReceiver", &lockuptypes.LockRewardReceiverResponse{})
+	// Warning: it iterates over every single lock account has, which means this query can have unbounded gas
+	setWhitelistedQuery("/osmosis.lockup.Query/AccountLockedDurationBuckets", &lockuptypes.AccountLockedDurationBucketsResponse{})
 
 	// mint
 	setWhitelistedQuery("/osmosis.mint.v1beta1.Query/EpochProvisions", &minttypes.QueryEpochProvisionsResponse{})
@@ -149,6 +151,8 @@ func init() {
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/Params", &poolmanagerqueryproto.ParamsResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TradingPairTakerFee", &poolmanagerqueryproto.TradingPairTakerFeeResponse{})
 	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/EstimateTradeBasedOnPriceImpact", &poolmanagerqueryproto.EstimateTradeBasedOnPriceImpactResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/TradingPairVolume", &poolmanagerqueryproto.TradingPairVolumeResponse{})
+	setWhitelistedQuery("/osmosis.poolmanager.v1beta1.Query/PoolTypeVolumes", &poolmanagerqueryproto.PoolTypeVolumesResponse{})
 
 	// txfees
 	setWhitelistedQuery("/osmosis.txfees.v1beta1.Query/FeeTokens", &txfeestypes.QueryFeeTokensResponse{})
@@ -170,6 +174,8 @@ func init() {
 
 	// downtime-detector
 	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfLength", &downtimequerytypes.RecoveredSinceDowntimeOfLengthResponse{})
+	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfWindow", &downtimequerytypes.RecoveredSinceDowntimeOfWindowResponse{})
+	setWhitelistedQuery("/osmosis.downtimedetector.v1beta1.Query/Params", &downtimequerytypes.ParamsResponse{})
 
 	// concentrated-liquidity
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", &concentratedliquidityquery.UserPositionsResponse{})
@@ -182,6 +188,17 @@ func init() {
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", &concentratedliquidityquery.IncentiveRecordsResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", &concentratedliquidityquery.TickAccumulatorTrackersResponse{})
 	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", &concentratedliquidityquery.CFMMPoolIdLinkFromConcentratedPoolIdResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionValueInQuoteDenom", &concentratedliquidityquery.PositionValueInQuoteDenomResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/CreatePositionEstimate", &concentratedliquidityquery.CreatePositionEstimateResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PoolSwapStats", &concentratedliquidityquery.PoolSwapStatsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecordSlots", &concentratedliquidityquery.IncentiveRecordSlotsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionLiens", &concentratedliquidityquery.PositionLiensResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/ManagedPositions", &concentratedliquidityquery.ManagedPositionsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/IncentivesPreview", &concentratedliquidityquery.IncentivesPreviewResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionHistory", &concentratedliquidityquery.PositionHistoryResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/PositionMigrationWindows", &concentratedliquidityquery.PositionMigrationWindowsResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/OpenLimitOrders", &concentratedliquidityquery.OpenLimitOrdersResponse{})
+	setWhitelistedQuery("/osmosis.concentratedliquidity.v1beta1.Query/ClaimableLimitOrders", &concentratedliquidityquery.ClaimableLimitOrdersResponse{})
 }
 
 // GetWhitelistedQuery returns the whitelisted query at the provided path.
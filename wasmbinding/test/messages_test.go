@@ -157,6 +157,12 @@ func TestChangeAdmin(t *testing.T) {
 				return
 			}
 			require.NoError(t, err)
+
+			// the new admin is only proposed until it accepts the adminship.
+			authorityMetadata, err := osmosis.TokenFactoryKeeper.GetAuthorityMetadata(ctx, spec.changeAdmin.Denom)
+			require.NoError(t, err)
+			require.Equal(t, tokenCreator.String(), authorityMetadata.Admin)
+			require.Equal(t, spec.changeAdmin.NewAdminAddress, authorityMetadata.PendingAdmin)
 		})
 	}
 }
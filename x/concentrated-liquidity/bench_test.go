@@ -281,3 +281,73 @@ func BenchmarkGetTickLiquidityForFullRange(b *testing.B) {
 		fmt.Println("num_ticks_traversed", len(liquidityNet))
 	})
 }
+
+// BenchmarkSwapCrossingTicks benchmarks swaps that cross a known number of initialized ticks.
+// Every iteration swaps in a cached context so that it starts from the same pool state.
+// Run with -benchmem, or via `make test-benchmark-cl`, to also measure allocations.
+func BenchmarkSwapCrossingTicks(b *testing.B) {
+	for _, numTicks := range []int{1, 10, 100, 1000} {
+		b.Run(fmt.Sprintf("ticks=%d", numTicks), func(b *testing.B) {
+			s := BenchTestSuite{}
+			s.Setup()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			pool, tokenIn, priceLimit := s.PrepareConcentratedPoolWithInitializedTicks(apptesting.DefaultTickSpacing, numTicks)
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+			// Sanity check that the swap crosses the expected number of ticks.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			swapResult, _, err := clKeeper.ComputeOutAmtGivenIn(cacheCtx, pool.GetId(), tokenIn, apptesting.USDC, pool.GetSpreadFactor(cacheCtx), priceLimit)
+			noError(b, err)
+			require.Equal(b, uint64(numTicks), swapResult.TicksCrossed)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				cacheCtx, _ := s.Ctx.CacheContext()
+				pool, err := clKeeper.GetConcentratedPoolById(cacheCtx, pool.GetId())
+				noError(b, err)
+				b.StartTimer()
+
+				// System under test
+				_, _, _, err = clKeeper.SwapOutAmtGivenIn(cacheCtx, s.TestAccs[0], pool, tokenIn, apptesting.USDC, pool.GetSpreadFactor(cacheCtx), priceLimit)
+				noError(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkPositionChurn benchmarks creating a position around the current tick and fully withdrawing it
+// in a pool with initialized ticks.
+func BenchmarkPositionChurn(b *testing.B) {
+	const numTicks = 100
+
+	s := BenchTestSuite{}
+	s.Setup()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool, _, _ := s.PrepareConcentratedPoolWithInitializedTicks(apptesting.DefaultTickSpacing, numTicks)
+
+	var (
+		owner         = s.TestAccs[1]
+		positionCoins = sdk.NewCoins(sdk.NewCoin(apptesting.ETH, apptesting.DefaultCoinAmount), sdk.NewCoin(apptesting.USDC, apptesting.DefaultCoinAmount))
+		spacing       = int64(apptesting.DefaultTickSpacing)
+		lowerTick     = -numTicks / 2 * spacing
+		upperTick     = numTicks / 2 * spacing
+	)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s.FundAcc(owner, positionCoins)
+		b.StartTimer()
+
+		// System under test
+		positionData, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), owner, positionCoins, osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+		noError(b, err)
+		_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionData.ID, positionData.Liquidity)
+		noError(b, err)
+	}
+}
@@ -0,0 +1,111 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// SetClaimAllowance authorizes the grantee to collect the spread rewards and incentives of all of the owner's
+// positions on the owner's behalf until the given expiration. A nil expiration never expires.
+// Overwrites any existing allowance granted by the owner to the grantee.
+// Returns error if the expiration is not after the current block time.
+func (k Keeper) SetClaimAllowance(ctx sdk.Context, owner, grantee sdk.AccAddress, expiration *time.Time) error {
+	if expiration != nil && !expiration.After(ctx.BlockTime()) {
+		return types.InvalidClaimAllowanceExpirationError{Expiration: *expiration, BlockTime: ctx.BlockTime()}
+	}
+
+	k.setClaimAllowance(ctx, types.ClaimAllowance{
+		Owner:      owner.String(),
+		Grantee:    grantee.String(),
+		Expiration: expiration,
+	})
+	return nil
+}
+
+// setClaimAllowance writes the claim allowance to state.
+func (k Keeper) setClaimAllowance(ctx sdk.Context, allowance types.ClaimAllowance) {
+	key := types.KeyClaimAllowance(sdk.MustAccAddressFromBech32(allowance.Owner), sdk.MustAccAddressFromBech32(allowance.Grantee))
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), key, &allowance)
+}
+
+// GetClaimAllowance returns the claim allowance granted by the owner to the grantee.
+// Returns types.ErrClaimAllowanceNotFound if there is none.
+func (k Keeper) GetClaimAllowance(ctx sdk.Context, owner, grantee sdk.AccAddress) (types.ClaimAllowance, error) {
+	allowance := types.ClaimAllowance{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyClaimAllowance(owner, grantee), &allowance)
+	if err != nil {
+		return types.ClaimAllowance{}, err
+	}
+	if !found {
+		return types.ClaimAllowance{}, types.ErrClaimAllowanceNotFound
+	}
+	return allowance, nil
+}
+
+// GetAllClaimAllowances returns all claim allowances in state.
+func (k Keeper) GetAllClaimAllowances(ctx sdk.Context) ([]types.ClaimAllowance, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.ClaimAllowancePrefix, osmoutils.ProtoValueParser[types.ClaimAllowance]())
+}
+
+// RevokeClaimAllowance deletes the claim allowance granted by the owner to the grantee.
+// Returns types.ErrClaimAllowanceNotFound if there is none.
+func (k Keeper) RevokeClaimAllowance(ctx sdk.Context, owner, grantee sdk.AccAddress) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyClaimAllowance(owner, grantee)
+	if !store.Has(key) {
+		return types.ErrClaimAllowanceNotFound
+	}
+	store.Delete(key)
+	return nil
+}
+
+// getRewardsRecipient returns the owner of the given position if the sender is allowed to collect
+// its spread rewards and incentives. That is the case if the sender is either the owner, has been
+// granted a claim allowance by the owner that has not expired, or holds a lien on the position that
+// redirects its rewards. Note that the rewards collected by the owner are forwarded to the lienholder
+// in the latter case, see redirectRewardsToLienholder.
+// Also returns whether the sender is only allowed to collect through a claim allowance.
+// Returns types.NotPositionOwnerError if the sender is none of the above,
+// and types.ClaimAllowanceExpiredError if the claim allowance has expired.
+func (k Keeper) getRewardsRecipient(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (owner sdk.AccAddress, isGrantee bool, err error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	owner, err = sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if owner.Equals(sender) {
+		return owner, false, nil
+	}
+
+	isRewardsLienholder, err := k.isRewardsLienholder(ctx, sender, positionId)
+	if err != nil {
+		return nil, false, err
+	}
+	if isRewardsLienholder {
+		return owner, false, nil
+	}
+
+	allowance, err := k.GetClaimAllowance(ctx, owner, sender)
+	if errors.Is(err, types.ErrClaimAllowanceNotFound) {
+		return nil, false, types.NotPositionOwnerError{PositionId: positionId, Address: sender.String()}
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if allowance.Expiration != nil && !allowance.Expiration.After(ctx.BlockTime()) {
+		return nil, false, types.ClaimAllowanceExpiredError{Owner: allowance.Owner, Grantee: allowance.Grantee, Expiration: *allowance.Expiration}
+	}
+
+	return owner, true, nil
+}
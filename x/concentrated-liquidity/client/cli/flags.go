@@ -8,6 +8,14 @@ const (
 	FlagPoolId                     = "pool-id"
 	FlagPoolIdToTickSpacingRecords = "pool-tick-spacing-records"
 	FlagPoolRecords                = "pool-records"
+	FlagPoolIds                    = "pool-ids"
+	FlagBestEffort                 = "best-effort"
+	FlagExpiration                 = "expiration"
+	FlagTokenOutMinAmount0         = "token-out-min-amount0"
+	FlagTokenOutMinAmount1         = "token-out-min-amount1"
+	FlagRedirectRewards            = "redirect-rewards"
+	FlagLienholder                 = "lienholder"
+	FlagOwner                      = "owner"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -15,3 +23,40 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetBestEffort() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagBestEffort, false, "Collect from as many of the positions as possible instead of failing if any of them fails")
+	return fs
+}
+
+func FlagSetExpiration() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagExpiration, "", "The unix timestamp or sortable time after which the allowance expires. Never expires if unset")
+	return fs
+}
+
+func FlagSetRedirectRewards() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagRedirectRewards, false, "Send the spread rewards and incentives of the position to the lienholder while it is locked")
+	return fs
+}
+
+func FlagSetLienholder() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagLienholder, "", "Only return the liens held by this address")
+	return fs
+}
+
+func FlagSetOwner() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagOwner, "", "Only return the managed positions owned by this address")
+	return fs
+}
+
+func FlagSetTokenOutMinAmounts() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagTokenOutMinAmount0, "0", "The minimum amount of token0 to withdraw, fails the withdrawal if less is withdrawn")
+	fs.String(FlagTokenOutMinAmount1, "0", "The minimum amount of token1 to withdraw, fails the withdrawal if less is withdrawn")
+	return fs
+}
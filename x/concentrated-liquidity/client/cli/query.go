@@ -23,6 +23,20 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolAccumulatorRewards)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetTickAccumulatorTrackers)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetLiquidityPerTickRange)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRoundingRemainders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionsByPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionValueInQuoteDenom)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCreatePositionEstimate)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPoolSwapStats)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentiveRecordSlots)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionLiens)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetManagedPositions)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetIncentivesPreview)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionHistory)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetPositionMigrationWindows)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetVerifyPool)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetOpenLimitOrders)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetClaimableLimitOrders)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -146,3 +160,139 @@ func GetTickAccumulatorTrackers() (*osmocli.QueryDescriptor, *queryproto.TickAcc
 {{.CommandPrefix}} tick-accumulator-trackers 1 "[-18000000]"`,
 	}, &queryproto.TickAccumulatorTrackersRequest{}
 }
+
+func GetRoundingRemainders() (*osmocli.QueryDescriptor, *queryproto.RoundingRemaindersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rounding-remainders",
+		Short: "Query the cumulative rounding remainders of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} rounding-remainders 1`,
+	}, &queryproto.RoundingRemaindersRequest{}
+}
+
+func GetPositionsByPool() (*osmocli.QueryDescriptor, *queryproto.PositionsByPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "positions-by-pool",
+		Short: "Query the positions of a pool with their share of the in-range liquidity",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} positions-by-pool 1`,
+	}, &queryproto.PositionsByPoolRequest{}
+}
+
+func GetPositionValueInQuoteDenom() (*osmocli.QueryDescriptor, *queryproto.PositionValueInQuoteDenomRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-value",
+		Short: "Query the underlying assets of a position and their total value in a quote denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-value 1 uosmo`,
+	}, &queryproto.PositionValueInQuoteDenomRequest{}
+}
+
+func GetCreatePositionEstimate() (*osmocli.QueryDescriptor, *queryproto.CreatePositionEstimateRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "create-position-estimate",
+		Short: "Query the amounts used, liquidity created and leftover tokens of creating a position",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} create-position-estimate 1 [-69082] 69082 10000uosmo,10000uion`,
+	}, &queryproto.CreatePositionEstimateRequest{}
+}
+
+func GetPoolSwapStats() (*osmocli.QueryDescriptor, *queryproto.PoolSwapStatsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-swap-stats",
+		Short: "Query the cumulative swap volume and spread rewards collected of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-swap-stats 1`,
+	}, &queryproto.PoolSwapStatsRequest{}
+}
+
+func GetIncentiveRecordSlots() (*osmocli.QueryDescriptor, *queryproto.IncentiveRecordSlotsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "incentive-record-slots",
+		Short: "Query the number of incentive records that can still be created for a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} incentive-record-slots 1`,
+	}, &queryproto.IncentiveRecordSlotsRequest{}
+}
+
+func GetPositionLiens() (*osmocli.QueryDescriptor, *queryproto.PositionLiensRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-liens",
+		Short: "Query the liens on positions locked as collateral, optionally held by a lienholder",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-liens --lienholder osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetLienholder()}},
+		CustomFlagOverrides: lienholderFlagOverride,
+	}, &queryproto.PositionLiensRequest{}
+}
+
+func GetManagedPositions() (*osmocli.QueryDescriptor, *queryproto.ManagedPositionsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "managed-positions",
+		Short: "Query the positions opted into automatic rebalancing, optionally owned by an address",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} managed-positions --owner osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetOwner()}},
+		CustomFlagOverrides: ownerFlagOverride,
+	}, &queryproto.ManagedPositionsRequest{}
+}
+
+func GetIncentivesPreview() (*osmocli.QueryDescriptor, *queryproto.IncentivesPreviewRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "incentives-preview",
+		Short: "Query the incentives per day a position with the given range, liquidity and uptime would earn in a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} incentives-preview 1 [-69082] 69082 1000000 168h`,
+	}, &queryproto.IncentivesPreviewRequest{}
+}
+
+func GetPositionHistory() (*osmocli.QueryDescriptor, *queryproto.PositionHistoryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-history",
+		Short: "Query the creation, modifications and withdrawal of a position within the history retention period",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-history 53`,
+	}, &queryproto.PositionHistoryRequest{}
+}
+
+func GetPositionMigrationWindows() (*osmocli.QueryDescriptor, *queryproto.PositionMigrationWindowsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-migration-windows",
+		Short: "Query the open windows during which positions can be moved from deprecated pools to their successor pools",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-migration-windows`,
+	}, &queryproto.PositionMigrationWindowsRequest{}
+}
+
+func GetVerifyPool() (*osmocli.QueryDescriptor, *queryproto.VerifyPoolRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "verify-pool",
+		Short: "Check the tick liquidity, accumulators and balances of a pool against its positions at the current height",
+		Long: `{{.Short}}
+Reports every discrepancy found, e.g. tick liquidity that does not sum up from the positions,
+negative accumulators or a pool balance that does not cover its positions. Use the --height flag
+to verify the pool at a past height on an archive node.
+{{.ExampleHeader}}
+{{.CommandPrefix}} verify-pool 1066`,
+	}, &queryproto.VerifyPoolRequest{}
+}
+
+func GetOpenLimitOrders() (*osmocli.QueryDescriptor, *queryproto.OpenLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "open-limit-orders",
+		Short: "Query the limit orders of a pool that are not filled yet, optionally owned by an address",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} open-limit-orders 1066 --owner osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetOwner()}},
+		CustomFlagOverrides: ownerFlagOverride,
+	}, &queryproto.OpenLimitOrdersRequest{}
+}
+
+func GetClaimableLimitOrders() (*osmocli.QueryDescriptor, *queryproto.ClaimableLimitOrdersRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "claimable-limit-orders",
+		Short: "Query the filled limit orders of an address that are not claimed yet",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} claimable-limit-orders osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq`,
+	}, &queryproto.ClaimableLimitOrdersRequest{}
+}
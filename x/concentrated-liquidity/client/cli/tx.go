@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/tx"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -16,6 +18,7 @@ import (
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
@@ -31,6 +34,19 @@ func NewTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewFungifyChargedPositionsCmd)
 	osmocli.AddTxCmd(txCmd, NewTransferPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewSetClaimAllowanceCmd)
+	osmocli.AddTxCmd(txCmd, NewRevokeClaimAllowanceCmd)
+	osmocli.AddTxCmd(txCmd, NewWrapPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewUnwrapPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewWithdrawAllPoolPositionsCmd)
+	osmocli.AddTxCmd(txCmd, NewLockPositionForCollateralCmd)
+	osmocli.AddTxCmd(txCmd, NewUnlockPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewEnableManagedPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewDisableManagedPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewRebalanceManagedPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewMigratePositionToSuccessorPoolCmd)
+	osmocli.AddTxCmd(txCmd, NewPlaceLimitOrderCmd)
+	osmocli.AddTxCmd(txCmd, NewClaimLimitOrderCmd)
 	return txCmd
 }
 
@@ -38,6 +54,31 @@ var poolIdFlagOverride = map[string]string{
 	"poolid": FlagPoolId,
 }
 
+var bestEffortFlagOverride = map[string]string{
+	"besteffort": FlagBestEffort,
+}
+
+var redirectRewardsFlagOverride = map[string]string{
+	"redirectrewards": FlagRedirectRewards,
+}
+
+var lienholderFlagOverride = map[string]string{
+	"lienholder": FlagLienholder,
+}
+
+var ownerFlagOverride = map[string]string{
+	"owner": FlagOwner,
+}
+
+var expirationFlagOverride = map[string]string{
+	"expiration": FlagExpiration,
+}
+
+var tokenOutMinAmountsFlagOverride = map[string]string{
+	"tokenoutminamount0": FlagTokenOutMinAmount0,
+	"tokenoutminamount1": FlagTokenOutMinAmount1,
+}
+
 func NewCreateConcentratedPoolCmd() (*osmocli.TxCliDesc, *clmodel.MsgCreateConcentratedPool) {
 	return &osmocli.TxCliDesc{
 		Use:     "create-pool",
@@ -65,25 +106,31 @@ func NewAddToPositionCmd() (*osmocli.TxCliDesc, *types.MsgAddToPosition) {
 
 func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
 	return &osmocli.TxCliDesc{
-		Use:     "withdraw-position",
-		Short:   "withdraw from an existing concentrated liquidity position",
-		Example: "osmosisd tx concentratedliquidity withdraw-position 1 1000 --from val --chain-id localosmosis --keyring-backend=test --fees=1000uosmo",
+		Use:                 "withdraw-position",
+		Short:               "withdraw from an existing concentrated liquidity position",
+		Example:             "osmosisd tx concentratedliquidity withdraw-position 1 1000 --token-out-min-amount0 100 --from val --chain-id localosmosis --keyring-backend=test --fees=1000uosmo",
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetTokenOutMinAmounts()}},
+		CustomFlagOverrides: tokenOutMinAmountsFlagOverride,
 	}, &types.MsgWithdrawPosition{}
 }
 
 func NewCollectSpreadRewardsCmd() (*osmocli.TxCliDesc, *types.MsgCollectSpreadRewards) {
 	return &osmocli.TxCliDesc{
-		Use:     "collect-spread-rewards",
-		Short:   "collect spread rewards from liquidity position(s)",
-		Example: "osmosisd tx concentratedliquidity collect-spread-rewards 998 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000000uosmo",
+		Use:                 "collect-spread-rewards",
+		Short:               "collect spread rewards from liquidity position(s)",
+		Example:             "osmosisd tx concentratedliquidity collect-spread-rewards 998 --from val --chain-id localosmosis -b block --keyring-backend test --fees 1000000uosmo",
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetBestEffort()}},
+		CustomFlagOverrides: bestEffortFlagOverride,
 	}, &types.MsgCollectSpreadRewards{}
 }
 
 func NewCollectIncentivesCmd() (*osmocli.TxCliDesc, *types.MsgCollectIncentives) {
 	return &osmocli.TxCliDesc{
-		Use:     "collect-incentives",
-		Short:   "collect incentives from liquidity position(s)",
-		Example: "osmosisd tx concentratedliquidity collect-incentives 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+		Use:                 "collect-incentives",
+		Short:               "collect incentives from liquidity position(s)",
+		Example:             "osmosisd tx concentratedliquidity collect-incentives 1 --from val --chain-id localosmosis -b block --keyring-backend test --fees 10000uosmo",
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetBestEffort()}},
+		CustomFlagOverrides: bestEffortFlagOverride,
 	}, &types.MsgCollectIncentives{}
 }
 
@@ -103,6 +150,51 @@ func NewTransferPositionsCmd() (*osmocli.TxCliDesc, *types.MsgTransferPositions)
 	}, &types.MsgTransferPositions{}
 }
 
+func NewSetClaimAllowanceCmd() (*osmocli.TxCliDesc, *types.MsgSetClaimAllowance) {
+	return &osmocli.TxCliDesc{
+		Use:                 "set-claim-allowance",
+		Short:               "authorize an address to collect the spread rewards and incentives of all of your positions on your behalf",
+		Long:                "The collected rewards are always sent to the position owner. The allowance is valid until revoked unless an expiration is set.",
+		Example:             "osmosisd tx concentratedliquidity set-claim-allowance osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq --expiration 1735689600 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetExpiration()}},
+		CustomFlagOverrides: expirationFlagOverride,
+	}, &types.MsgSetClaimAllowance{}
+}
+
+func NewRevokeClaimAllowanceCmd() (*osmocli.TxCliDesc, *types.MsgRevokeClaimAllowance) {
+	return &osmocli.TxCliDesc{
+		Use:     "revoke-claim-allowance",
+		Short:   "revoke the claim allowance granted to an address",
+		Example: "osmosisd tx concentratedliquidity revoke-claim-allowance osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRevokeClaimAllowance{}
+}
+
+func NewWrapPositionCmd() (*osmocli.TxCliDesc, *types.MsgWrapPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "wrap-position",
+		Short:   "lock a concentrated liquidity position to the position wrapper in exchange for a cl/position/{position-id} token",
+		Long:    "Whoever holds the wrapper token can unwrap the position. Spread rewards and incentives accrued while wrapped are paid out upon unwrapping.",
+		Example: "osmosisd tx concentratedliquidity wrap-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgWrapPosition{}
+}
+
+func NewUnwrapPositionCmd() (*osmocli.TxCliDesc, *types.MsgUnwrapPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "unwrap-position",
+		Short:   "burn the wrapper token of a concentrated liquidity position and take ownership of the position",
+		Example: "osmosisd tx concentratedliquidity unwrap-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgUnwrapPosition{}
+}
+
+func NewWithdrawAllPoolPositionsCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawAllPoolPositions) {
+	return &osmocli.TxCliDesc{
+		Use:     "withdraw-all-pool-positions",
+		Short:   "fully withdraw all of the sender's positions in a concentrated liquidity pool, collecting their rewards",
+		Long:    "At most max_positions_per_withdraw_all positions are withdrawn per message. Positions with an underlying lock that is not mature are skipped.",
+		Example: "osmosisd tx concentratedliquidity withdraw-all-pool-positions 1 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgWithdrawAllPoolPositions{}
+}
+
 // NewCmdCreateConcentratedLiquidityPoolsProposal implements a command handler for create concentrated liquidity pool proposal
 func NewCmdCreateConcentratedLiquidityPoolsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -118,7 +210,7 @@ Ex) --pool-records=uion,uosmo,100,0.003,stake,uosmo,1000,0.005 ->
 
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -132,7 +224,7 @@ Ex) --pool-records=uion,uosmo,100,0.003,stake,uosmo,1000,0.005 ->
 			if err != nil {
 				return err
 			}
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -162,7 +254,7 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -179,7 +271,7 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -196,6 +288,99 @@ Note: The new tick spacing value must be less than the current tick spacing valu
 	return cmd
 }
 
+func NewSweepRoundingRemaindersProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-rounding-remainders-proposal [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Submit a proposal to sweep the rounding remainders of pools to the community pool",
+		Long: strings.TrimSpace(`Submit a proposal to sweep the rounding remainders of pools to the community pool.
+
+Passing in FlagPoolIds separated by commas would be parsed automatically to the list of pool ids to sweep.
+Ex) --pool-ids=1,5 -> [1, 5]
+Note: Only the whole units of the positive rounding remainders of each pool are swept.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseSweepRoundingRemaindersArgsToContent(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().String(FlagPoolIds, "", "The pool IDs to sweep the rounding remainders of")
+
+	return cmd
+}
+
+func NewPositionMigrationWindowProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position-migration-window-proposal [from-pool-id] [to-pool-id] [duration] [flags]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a proposal to let the positions in a deprecated pool be moved to a successor pool",
+		Long: strings.TrimSpace(`Submit a proposal to open a window during which the positions in a deprecated pool can be moved to a successor pool with the same ticks, without swapping.
+
+The successor pool must have the same token0 and token1 as the deprecated pool.
+Ex) position-migration-window-proposal 1 5 168h -> positions in pool 1 can be moved to pool 5 for a week
+Note: A zero duration closes the open window of the deprecated pool.
+
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parsePositionMigrationWindowArgsToContent(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
 func parseCreateConcentratedLiquidityPoolArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
 	title, err := cmd.Flags().GetString(govcli.FlagTitle)
 	if err != nil {
@@ -245,6 +430,71 @@ func parsePoolIdToTickSpacingRecordsArgsToContent(cmd *cobra.Command) (govtypesv
 	return content, nil
 }
 
+func parseSweepRoundingRemaindersArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIdsStr, err := cmd.Flags().GetString(FlagPoolIds)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds, err := osmoutils.ParseUint64SliceFromString(poolIdsStr, ",")
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.SweepRoundingRemaindersProposal{
+		Title:       title,
+		Description: description,
+		PoolIds:     poolIds,
+	}
+	return content, nil
+}
+
+func parsePositionMigrationWindowArgsToContent(cmd *cobra.Command, args []string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	fromPoolId, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from pool id (%s): %w", args[0], err)
+	}
+
+	toPoolId, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to pool id (%s): %w", args[1], err)
+	}
+
+	duration, err := time.ParseDuration(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration (%s): %w", args[2], err)
+	}
+
+	content := &types.PositionMigrationWindowProposal{
+		Title:       title,
+		Description: description,
+		FromPoolId:  fromPoolId,
+		ToPoolId:    toPoolId,
+		Duration:    duration,
+	}
+	return content, nil
+}
+
 func parsePoolIdToTickSpacingRecords(cmd *cobra.Command) ([]types.PoolIdToTickSpacingRecord, error) {
 	assetsStr, err := cmd.Flags().GetString(FlagPoolIdToTickSpacingRecords)
 	if err != nil {
@@ -323,3 +573,73 @@ func parsePoolRecords(cmd *cobra.Command) ([]types.PoolRecord, error) {
 
 	return finalPoolRecords, nil
 }
+
+func NewLockPositionForCollateralCmd() (*osmocli.TxCliDesc, *types.MsgLockPositionForCollateral) {
+	return &osmocli.TxCliDesc{
+		Use:                 "lock-position-for-collateral",
+		Short:               "lock a concentrated liquidity position as collateral of an authorized lienholder",
+		Long:                "The position can neither be withdrawn from nor transferred until the lienholder unlocks it. With --redirect-rewards, the spread rewards and incentives of the position are sent to the lienholder. The transaction must be signed by both the owner and the lienholder, e.g. by generating it with --generate-only and signing it with each key.",
+		Example:             "osmosisd tx concentratedliquidity lock-position-for-collateral 56 osmo10fhdy8zhepstpwsr9l4a8yxuyggqmpqx4ktheq --redirect-rewards --from val --chain-id osmosis-1 --generate-only > lock.json",
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetRedirectRewards()}},
+		CustomFlagOverrides: redirectRewardsFlagOverride,
+	}, &types.MsgLockPositionForCollateral{}
+}
+
+func NewUnlockPositionCmd() (*osmocli.TxCliDesc, *types.MsgUnlockPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "unlock-position",
+		Short:   "release your lien on a concentrated liquidity position locked as collateral",
+		Example: "osmosisd tx concentratedliquidity unlock-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgUnlockPosition{}
+}
+
+func NewEnableManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgEnableManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "enable-managed-position",
+		Short:   "opt a concentrated liquidity position into automatic rebalancing",
+		Long:    "Once the current tick exits the range of the position, it is withdrawn and recreated as a single sided position of [range-width] ticks adjacent to the current tick, as long as the rebalance fee does not exceed [max-rebalance-fee]. Every rebalance forfeits the incentives of the uptimes the position has not reached yet and resets its join time.",
+		Example: "osmosisd tx concentratedliquidity enable-managed-position 56 1000 0.005 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgEnableManagedPosition{}
+}
+
+func NewDisableManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgDisableManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "disable-managed-position",
+		Short:   "opt a concentrated liquidity position out of automatic rebalancing",
+		Example: "osmosisd tx concentratedliquidity disable-managed-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgDisableManagedPosition{}
+}
+
+func NewRebalanceManagedPositionCmd() (*osmocli.TxCliDesc, *types.MsgRebalanceManagedPosition) {
+	return &osmocli.TxCliDesc{
+		Use:     "rebalance-managed-position",
+		Short:   "rebalance a managed concentrated liquidity position out of range, as an authorized rebalancer",
+		Example: "osmosisd tx concentratedliquidity rebalance-managed-position 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgRebalanceManagedPosition{}
+}
+
+func NewMigratePositionToSuccessorPoolCmd() (*osmocli.TxCliDesc, *types.MsgMigratePositionToSuccessorPool) {
+	return &osmocli.TxCliDesc{
+		Use:     "migrate-position-to-successor-pool",
+		Short:   "move a concentrated liquidity position out of a deprecated pool into its successor pool, while a migration window is open",
+		Long:    "The position is fully withdrawn and a position with the same ticks is created in the successor pool with the withdrawn amounts, without swapping. The amounts not needed by the new position stay with the owner.",
+		Example: "osmosisd tx concentratedliquidity migrate-position-to-successor-pool 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgMigratePositionToSuccessorPool{}
+}
+
+func NewPlaceLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgPlaceLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "place-limit-order",
+		Short:   "place a limit order selling a single token in the tick spacing starting at [tick-index]",
+		Long:    "Orders selling token0 must be above the current tick and orders selling token1 below it. Once the current tick crosses the order, it is withdrawn into escrow, converted to the other token, until claimed with claim-limit-order. Pass -- before the arguments to use a negative tick index.",
+		Example: "osmosisd tx concentratedliquidity place-limit-order 1 69100 10000uosmo --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgPlaceLimitOrder{}
+}
+
+func NewClaimLimitOrderCmd() (*osmocli.TxCliDesc, *types.MsgClaimLimitOrder) {
+	return &osmocli.TxCliDesc{
+		Use:     "claim-limit-order",
+		Short:   "claim the amount a filled limit order converted to",
+		Example: "osmosisd tx concentratedliquidity claim-limit-order 56 --from val --chain-id osmosis-1 -b block --keyring-backend test --fees 1000uosmo",
+	}, &types.MsgClaimLimitOrder{}
+}
@@ -51,6 +51,146 @@ func (q Querier) TickAccumulatorTrackers(grpcCtx context.Context,
 	return q.Q.TickAccumulatorTrackers(ctx, *req)
 }
 
+func (q Querier) RoundingRemainders(grpcCtx context.Context,
+	req *queryproto.RoundingRemaindersRequest,
+) (*queryproto.RoundingRemaindersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RoundingRemainders(ctx, *req)
+}
+
+func (q Querier) PositionsByPool(grpcCtx context.Context,
+	req *queryproto.PositionsByPoolRequest,
+) (*queryproto.PositionsByPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionsByPool(ctx, *req)
+}
+
+func (q Querier) PositionValueInQuoteDenom(grpcCtx context.Context,
+	req *queryproto.PositionValueInQuoteDenomRequest,
+) (*queryproto.PositionValueInQuoteDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionValueInQuoteDenom(ctx, *req)
+}
+
+func (q Querier) CreatePositionEstimate(grpcCtx context.Context,
+	req *queryproto.CreatePositionEstimateRequest,
+) (*queryproto.CreatePositionEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.CreatePositionEstimate(ctx, *req)
+}
+
+func (q Querier) PoolSwapStats(grpcCtx context.Context,
+	req *queryproto.PoolSwapStatsRequest,
+) (*queryproto.PoolSwapStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolSwapStats(ctx, *req)
+}
+
+func (q Querier) IncentiveRecordSlots(grpcCtx context.Context,
+	req *queryproto.IncentiveRecordSlotsRequest,
+) (*queryproto.IncentiveRecordSlotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.IncentiveRecordSlots(ctx, *req)
+}
+
+func (q Querier) PositionLiens(grpcCtx context.Context,
+	req *queryproto.PositionLiensRequest,
+) (*queryproto.PositionLiensResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionLiens(ctx, *req)
+}
+
+func (q Querier) ManagedPositions(grpcCtx context.Context,
+	req *queryproto.ManagedPositionsRequest,
+) (*queryproto.ManagedPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ManagedPositions(ctx, *req)
+}
+
+func (q Querier) IncentivesPreview(grpcCtx context.Context,
+	req *queryproto.IncentivesPreviewRequest,
+) (*queryproto.IncentivesPreviewResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.IncentivesPreview(ctx, *req)
+}
+
+func (q Querier) PositionHistory(grpcCtx context.Context,
+	req *queryproto.PositionHistoryRequest,
+) (*queryproto.PositionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionHistory(ctx, *req)
+}
+
+func (q Querier) PositionMigrationWindows(grpcCtx context.Context,
+	req *queryproto.PositionMigrationWindowsRequest,
+) (*queryproto.PositionMigrationWindowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PositionMigrationWindows(ctx, *req)
+}
+
+func (q Querier) VerifyPool(grpcCtx context.Context,
+	req *queryproto.VerifyPoolRequest,
+) (*queryproto.VerifyPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.VerifyPool(ctx, *req)
+}
+
+func (q Querier) OpenLimitOrders(grpcCtx context.Context,
+	req *queryproto.OpenLimitOrdersRequest,
+) (*queryproto.OpenLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.OpenLimitOrders(ctx, *req)
+}
+
+func (q Querier) ClaimableLimitOrders(grpcCtx context.Context,
+	req *queryproto.ClaimableLimitOrdersRequest,
+) (*queryproto.ClaimableLimitOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.ClaimableLimitOrders(ctx, *req)
+}
+
 func (q Querier) PositionById(grpcCtx context.Context,
 	req *queryproto.PositionByIdRequest,
 ) (*queryproto.PositionByIdResponse, error) {
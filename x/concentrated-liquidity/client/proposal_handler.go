@@ -9,4 +9,6 @@ import (
 var (
 	TickSpacingDecreaseProposalHandler             = govclient.NewProposalHandler(cli.NewTickSpacingDecreaseProposal)
 	CreateConcentratedLiquidityPoolProposalHandler = govclient.NewProposalHandler(cli.NewCmdCreateConcentratedLiquidityPoolsProposal)
+	SweepRoundingRemaindersProposalHandler         = govclient.NewProposalHandler(cli.NewSweepRoundingRemaindersProposal)
+	PositionMigrationWindowProposalHandler         = govclient.NewProposalHandler(cli.NewPositionMigrationWindowProposal)
 )
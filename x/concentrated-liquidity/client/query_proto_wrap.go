@@ -318,3 +318,252 @@ func (q Querier) NumNextInitializedTicks(ctx sdk.Context, req clquery.NumNextIni
 
 	return &clquery.NumNextInitializedTicksResponse{LiquidityDepths: liquidityDepths, CurrentLiquidity: pool.GetLiquidity(), CurrentTick: pool.GetCurrentTick()}, nil
 }
+
+// RoundingRemainders returns the cumulative rounding remainders of the given pool.
+func (q Querier) RoundingRemainders(ctx sdk.Context, req clquery.RoundingRemaindersRequest) (*clquery.RoundingRemaindersResponse, error) {
+	remainders, err := q.Keeper.GetRoundingRemainders(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &clquery.RoundingRemaindersResponse{Remainders: remainders}, nil
+}
+
+// PositionsByPool returns a page of the positions of the given pool, along with their share of the pool's current tick liquidity.
+func (q Querier) PositionsByPool(ctx sdk.Context, req clquery.PositionsByPoolRequest) (*clquery.PositionsByPoolResponse, error) {
+	positions, pageRes, err := q.Keeper.GetPositionsByPool(ctx, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionsByPoolResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PositionValueInQuoteDenom returns the underlying assets of the given position and their total value in the quote denom.
+func (q Querier) PositionValueInQuoteDenom(ctx sdk.Context, req clquery.PositionValueInQuoteDenomRequest) (*clquery.PositionValueInQuoteDenomResponse, error) {
+	if req.QuoteDenom == "" {
+		return nil, status.Error(codes.InvalidArgument, "quote denom is empty")
+	}
+
+	asset0, asset1, value, err := q.Keeper.PositionValueInQuoteDenom(ctx, req.PositionId, req.QuoteDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionValueInQuoteDenomResponse{
+		Asset0: asset0,
+		Asset1: asset1,
+		Value:  value,
+	}, nil
+}
+
+// CreatePositionEstimate returns the amounts of each token that creating a position with the given tokens
+// and tick range would use, the liquidity it would create and the leftover tokens.
+func (q Querier) CreatePositionEstimate(ctx sdk.Context, req clquery.CreatePositionEstimateRequest) (*clquery.CreatePositionEstimateResponse, error) {
+	if err := req.TokensProvided.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	estimate, leftover, err := q.Keeper.EstimateCreatePosition(ctx, req.PoolId, req.TokensProvided, req.LowerTick, req.UpperTick)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.CreatePositionEstimateResponse{
+		Amount0:          estimate.Amount0,
+		Amount1:          estimate.Amount1,
+		LiquidityCreated: estimate.Liquidity,
+		LowerTick:        estimate.LowerTick,
+		UpperTick:        estimate.UpperTick,
+		Leftover:         leftover,
+	}, nil
+}
+
+// PoolSwapStats returns the cumulative swap volume and spread rewards collected of the given pool.
+func (q Querier) PoolSwapStats(ctx sdk.Context, req clquery.PoolSwapStatsRequest) (*clquery.PoolSwapStatsResponse, error) {
+	swapVolume, err := q.Keeper.GetPoolSwapVolume(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	spreadRewardsCollected, err := q.Keeper.GetPoolSpreadRewardsCollected(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &clquery.PoolSwapStatsResponse{
+		SwapVolume:             swapVolume,
+		SpreadRewardsCollected: spreadRewardsCollected,
+	}, nil
+}
+
+// IncentiveRecordSlots returns the number of incentive records that can still be created for the given pool,
+// in total and for each authorized uptime.
+func (q Querier) IncentiveRecordSlots(ctx sdk.Context, req clquery.IncentiveRecordSlotsRequest) (*clquery.IncentiveRecordSlotsResponse, error) {
+	remainingPoolSlots, err := q.Keeper.GetRemainingPoolIncentiveRecordSlots(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	authorizedUptimes := q.Keeper.GetParams(ctx).AuthorizedUptimes
+	remainingUptimeSlots := make([]clquery.UptimeIncentiveRecordSlots, 0, len(authorizedUptimes))
+	for _, uptime := range authorizedUptimes {
+		remainingSlots, err := q.Keeper.GetRemainingIncentiveRecordSlots(ctx, req.PoolId, uptime)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		remainingUptimeSlots = append(remainingUptimeSlots, clquery.UptimeIncentiveRecordSlots{
+			Uptime:         uptime,
+			RemainingSlots: remainingSlots,
+		})
+	}
+
+	return &clquery.IncentiveRecordSlotsResponse{
+		RemainingPoolSlots:   remainingPoolSlots,
+		RemainingUptimeSlots: remainingUptimeSlots,
+	}, nil
+}
+
+// PositionLiens returns the liens on positions locked as collateral, filtered by lienholder if one is given.
+func (q Querier) PositionLiens(ctx sdk.Context, req clquery.PositionLiensRequest) (*clquery.PositionLiensResponse, error) {
+	if req.Lienholder == "" {
+		liens, err := q.Keeper.GetAllPositionLiens(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &clquery.PositionLiensResponse{Liens: liens}, nil
+	}
+
+	lienholder, err := sdk.AccAddressFromBech32(req.Lienholder)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	liens, err := q.Keeper.GetPositionLiensByLienholder(ctx, lienholder)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clquery.PositionLiensResponse{Liens: liens}, nil
+}
+
+// ManagedPositions returns the positions opted into automatic rebalancing, filtered by owner if one is given.
+func (q Querier) ManagedPositions(ctx sdk.Context, req clquery.ManagedPositionsRequest) (*clquery.ManagedPositionsResponse, error) {
+	if req.Owner == "" {
+		managedPositions, err := q.Keeper.GetAllManagedPositions(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &clquery.ManagedPositionsResponse{ManagedPositions: managedPositions}, nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	managedPositions, err := q.Keeper.GetManagedPositionsByOwner(ctx, owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &clquery.ManagedPositionsResponse{ManagedPositions: managedPositions}, nil
+}
+
+// IncentivesPreview returns the incentives per day that a position with the given tick range, liquidity and uptime
+// would earn in the given pool, along with whether the position would be in range.
+func (q Querier) IncentivesPreview(ctx sdk.Context, req clquery.IncentivesPreviewRequest) (*clquery.IncentivesPreviewResponse, error) {
+	if req.Liquidity.IsNil() || !req.Liquidity.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "liquidity must be positive")
+	}
+	if req.Uptime < 0 {
+		return nil, status.Error(codes.InvalidArgument, "uptime must not be negative")
+	}
+
+	incentivesPerDay, inRange, err := q.Keeper.GetIncentivesPreview(ctx, req.PoolId, req.LowerTick, req.UpperTick, req.Liquidity, req.Uptime)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &clquery.IncentivesPreviewResponse{
+		IncentivesPerDay: incentivesPerDay,
+		InRange:          inRange,
+	}, nil
+}
+
+// PositionHistory returns the lifecycle events of the given position within the retention period,
+// in chronological order. The events are returned even if the position no longer exists.
+func (q Querier) PositionHistory(ctx sdk.Context, req clquery.PositionHistoryRequest) (*clquery.PositionHistoryResponse, error) {
+	entries, err := q.Keeper.GetPositionHistory(ctx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionHistoryResponse{Entries: entries}, nil
+}
+
+// PositionMigrationWindows returns the open windows during which positions can be moved from deprecated pools
+// to their successor pools.
+func (q Querier) PositionMigrationWindows(ctx sdk.Context, req clquery.PositionMigrationWindowsRequest) (*clquery.PositionMigrationWindowsResponse, error) {
+	windows, err := q.Keeper.GetAllPositionMigrationWindows(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.PositionMigrationWindowsResponse{Windows: windows}, nil
+}
+
+// VerifyPool recomputes the state of the given pool derived from its positions at the current height and returns
+// the discrepancies against the stored state. Since every position and initialized tick of the pool is read,
+// the query can be expensive for large pools and is bounded by the query gas limit of the node.
+func (q Querier) VerifyPool(ctx sdk.Context, req clquery.VerifyPoolRequest) (*clquery.VerifyPoolResponse, error) {
+	discrepancies, err := q.Keeper.VerifyPoolState(ctx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &clquery.VerifyPoolResponse{
+		Height:        ctx.BlockHeight(),
+		Discrepancies: make([]clquery.StateDiscrepancy, 0, len(discrepancies)),
+	}
+	for _, discrepancy := range discrepancies {
+		resp.Discrepancies = append(resp.Discrepancies, clquery.StateDiscrepancy{
+			Check:    discrepancy.Check,
+			Subject:  discrepancy.Subject,
+			Expected: discrepancy.Expected,
+			Actual:   discrepancy.Actual,
+		})
+	}
+	return resp, nil
+}
+
+// OpenLimitOrders returns the limit orders of the given pool that are not filled yet, optionally filtered by owner.
+func (q Querier) OpenLimitOrders(ctx sdk.Context, req clquery.OpenLimitOrdersRequest) (*clquery.OpenLimitOrdersResponse, error) {
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	limitOrders, err := q.Keeper.GetOpenLimitOrders(ctx, req.PoolId, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.OpenLimitOrdersResponse{LimitOrders: limitOrders}, nil
+}
+
+// ClaimableLimitOrders returns the filled limit orders of the given owner that are not claimed yet.
+func (q Querier) ClaimableLimitOrders(ctx sdk.Context, req clquery.ClaimableLimitOrdersRequest) (*clquery.ClaimableLimitOrdersResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limitOrders, err := q.Keeper.GetClaimableLimitOrders(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.ClaimableLimitOrdersResponse{LimitOrders: limitOrders}, nil
+}
@@ -15,6 +15,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	github_com_osmosis_labs_osmosis_osmomath "github.com/osmosis-labs/osmosis/osmomath"
 	model "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	types1 "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
@@ -27,12 +28,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -1631,2597 +1634,10056 @@ func (m *NumNextInitializedTicksResponse) GetCurrentTick() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
-	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
-	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
-	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
-	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
-	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
-	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
-	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
-	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
-	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
-	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
-	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
-	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
-	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
-	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
-	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
-	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
-	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
-	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
-	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
-	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
-	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
-	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
-	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
-	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
-	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
-	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
-	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+type RoundingRemaindersRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
+func (m *RoundingRemaindersRequest) Reset()         { *m = RoundingRemaindersRequest{} }
+func (m *RoundingRemaindersRequest) String() string { return proto.CompactTextString(m) }
+func (*RoundingRemaindersRequest) ProtoMessage()    {}
+func (*RoundingRemaindersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{32}
 }
-
-var fileDescriptor_5da291368ba4d8e3 = []byte{
-	// 2335 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x4d, 0x6c, 0x1c, 0x49,
-	0xf5, 0x4f, 0x39, 0x1f, 0x9b, 0x79, 0x71, 0xbe, 0xca, 0x8e, 0xed, 0x4c, 0x92, 0x99, 0x6c, 0xfd,
-	0xff, 0x61, 0x2d, 0x92, 0xcc, 0x90, 0x2f, 0x42, 0xbe, 0x36, 0xeb, 0xb1, 0xd7, 0xd1, 0x68, 0x1d,
-	0xaf, 0xd3, 0x89, 0x01, 0x71, 0xa0, 0xb7, 0xa7, 0xbb, 0x3c, 0x6e, 0x4d, 0x4f, 0xd7, 0xb8, 0xbb,
-	0x3a, 0x89, 0x59, 0x22, 0xad, 0x76, 0x8f, 0x48, 0xb0, 0x88, 0x2b, 0x42, 0x42, 0x5c, 0xd0, 0x8a,
-	0x23, 0x17, 0xb8, 0x20, 0x38, 0xa0, 0x88, 0xc3, 0x6a, 0x25, 0x84, 0x84, 0xf6, 0x30, 0x0b, 0x09,
-	0x07, 0xa4, 0x05, 0x24, 0xcc, 0x85, 0x23, 0xea, 0xea, 0xaa, 0x9e, 0x9e, 0x71, 0x8f, 0xdd, 0x33,
-	0x36, 0x27, 0x4e, 0x33, 0xd5, 0xaf, 0xde, 0x7b, 0xbf, 0xf7, 0x51, 0xaf, 0xeb, 0xbd, 0x19, 0xb8,
-	0xc4, 0xfc, 0x26, 0xf3, 0x6d, 0xbf, 0x6c, 0x32, 0xd7, 0xa4, 0x2e, 0xf7, 0x0c, 0x4e, 0x2d, 0xc7,
-	0x5e, 0x0b, 0x6c, 0xcb, 0xe6, 0xeb, 0xe5, 0xc7, 0x97, 0x6a, 0x94, 0x1b, 0x97, 0xca, 0x6b, 0x01,
-	0xf5, 0xd6, 0x4b, 0x2d, 0x8f, 0x71, 0x86, 0xcf, 0x49, 0x96, 0x52, 0x2a, 0x4b, 0x49, 0xb2, 0xe4,
-	0xc7, 0xeb, 0xac, 0xce, 0x04, 0x47, 0x39, 0xfc, 0x16, 0x31, 0xe7, 0xbf, 0xb8, 0xb5, 0xbe, 0x96,
-	0xe1, 0x19, 0x4d, 0x5f, 0xee, 0xbd, 0x9a, 0x0d, 0x1b, 0xb7, 0xcd, 0x46, 0xd5, 0x5d, 0x51, 0x1a,
-	0x0a, 0xa6, 0x60, 0x2b, 0xd7, 0x0c, 0x9f, 0xc6, 0x7b, 0x4c, 0x66, 0xbb, 0x0a, 0x41, 0x92, 0x2e,
-	0xec, 0x8a, 0x77, 0xb5, 0x8c, 0xba, 0xed, 0x1a, 0xdc, 0x66, 0x6a, 0xef, 0xe9, 0x3a, 0x63, 0x75,
-	0x87, 0x96, 0x8d, 0x96, 0x5d, 0x36, 0x5c, 0x97, 0x71, 0x41, 0x54, 0xf8, 0x4e, 0x4a, 0xaa, 0x58,
-	0xd5, 0x82, 0x95, 0xb2, 0xe1, 0xae, 0x2b, 0x52, 0xa4, 0x44, 0x8f, 0xec, 0x8f, 0x16, 0x92, 0x54,
-	0xec, 0xe5, 0xe2, 0x76, 0x93, 0xfa, 0xdc, 0x68, 0xb6, 0x94, 0x01, 0xbd, 0x1b, 0xac, 0xc0, 0x4b,
-	0x82, 0xca, 0xe8, 0x96, 0x16, 0xf3, 0xed, 0x04, 0xd7, 0xed, 0x6c, 0x5c, 0xb6, 0x20, 0xda, 0x8f,
-	0xa9, 0xee, 0x51, 0x93, 0x79, 0x56, 0xc4, 0x4d, 0x7e, 0x81, 0x60, 0x7c, 0xd9, 0xa7, 0xde, 0x92,
-	0x14, 0xea, 0x6b, 0x74, 0x2d, 0xa0, 0x3e, 0xc7, 0x17, 0xe0, 0x15, 0xc3, 0xb2, 0x3c, 0xea, 0xfb,
-	0x53, 0xe8, 0x2c, 0x9a, 0xce, 0x55, 0xf0, 0x46, 0xbb, 0x78, 0x64, 0xdd, 0x68, 0x3a, 0x37, 0x89,
-	0x24, 0x10, 0x4d, 0x6d, 0xc1, 0xe7, 0xe1, 0x95, 0x16, 0x63, 0x8e, 0x6e, 0x5b, 0x53, 0x23, 0x67,
-	0xd1, 0xf4, 0xbe, 0xe4, 0x6e, 0x49, 0x20, 0xda, 0x81, 0xf0, 0x5b, 0xd5, 0xc2, 0xf3, 0x00, 0x9d,
-	0x80, 0x4c, 0xed, 0x3d, 0x8b, 0xa6, 0x0f, 0x5d, 0xfe, 0x42, 0x49, 0xfa, 0x32, 0x8c, 0x5e, 0x29,
-	0xca, 0x4a, 0x09, 0xbd, 0xb4, 0x64, 0xd4, 0xa9, 0x84, 0xa5, 0x25, 0x38, 0xc9, 0x6f, 0x10, 0x9c,
-	0xe8, 0xc1, 0xee, 0xb7, 0x98, 0xeb, 0x53, 0xfc, 0x0e, 0xe4, 0x94, 0x97, 0x42, 0xf8, 0x7b, 0xa7,
-	0x0f, 0x5d, 0xbe, 0x5d, 0xca, 0x94, 0xdd, 0xa5, 0xf9, 0xc0, 0x71, 0x94, 0xc0, 0x8a, 0x47, 0x8d,
-	0x86, 0xc5, 0x9e, 0xb8, 0x95, 0x7d, 0xcf, 0xdb, 0xc5, 0x3d, 0x5a, 0x47, 0x28, 0xbe, 0xd7, 0x65,
-	0xc3, 0x88, 0xb0, 0xe1, 0xb5, 0x6d, 0x6d, 0x88, 0xe0, 0x75, 0x19, 0xb1, 0x08, 0x63, 0xb1, 0xba,
-	0xf5, 0xaa, 0xa5, 0xdc, 0x7f, 0x1d, 0x0e, 0x29, 0x65, 0xa1, 0x53, 0x91, 0x70, 0xea, 0xc4, 0x46,
-	0xbb, 0x88, 0x95, 0x53, 0x63, 0x22, 0xd1, 0x40, 0xad, 0xaa, 0x16, 0x79, 0x0c, 0xe3, 0xdd, 0xf2,
-	0xa4, 0x4b, 0xbe, 0x09, 0x07, 0xd5, 0x2e, 0x21, 0x6d, 0x77, 0x3c, 0x12, 0xcb, 0x24, 0x5f, 0x85,
-	0xd1, 0x25, 0xc6, 0x9c, 0x38, 0x7f, 0xe6, 0x53, 0x1c, 0x34, 0x4c, 0x90, 0xbf, 0x87, 0xe0, 0xb0,
-	0x14, 0x2c, 0x2d, 0xb9, 0x06, 0xfb, 0xc3, 0x44, 0x52, 0x81, 0x1d, 0x2f, 0x45, 0xc7, 0xaa, 0xa4,
-	0x8e, 0x55, 0x69, 0xc6, 0x5d, 0xaf, 0xe4, 0x7e, 0xf7, 0xf3, 0x8b, 0xfb, 0x43, 0xbe, 0xaa, 0x16,
-	0xed, 0xde, 0xbd, 0x88, 0x1d, 0x85, 0xc3, 0x4b, 0xa2, 0x9a, 0x49, 0xb8, 0x64, 0x19, 0x8e, 0xa8,
-	0x07, 0x12, 0xe2, 0x2c, 0x1c, 0x88, 0x0a, 0x9e, 0x74, 0xf5, 0xb9, 0x6d, 0x5c, 0x1d, 0xb1, 0x4b,
-	0x9f, 0x4a, 0x56, 0xf2, 0x11, 0x82, 0x63, 0x8f, 0x6c, 0xb3, 0xb1, 0xa0, 0xb6, 0x2d, 0x52, 0x8e,
-	0xdf, 0x81, 0xc3, 0x31, 0x9b, 0xee, 0x52, 0x2e, 0x0f, 0xe7, 0xad, 0x90, 0xf3, 0xd3, 0x76, 0xf1,
-	0x54, 0x64, 0x8f, 0x6f, 0x35, 0x4a, 0x36, 0x2b, 0x37, 0x0d, 0xbe, 0x5a, 0x5a, 0xa0, 0x75, 0xc3,
-	0x5c, 0x9f, 0xa3, 0xe6, 0x46, 0xbb, 0x38, 0x1e, 0x25, 0x4f, 0x97, 0x04, 0xa2, 0x8d, 0x3a, 0x49,
-	0x0d, 0x57, 0x01, 0xc2, 0xc2, 0xab, 0xdb, 0xae, 0x45, 0x9f, 0x0a, 0x3f, 0xed, 0xad, 0x9c, 0xd8,
-	0x68, 0x17, 0x8f, 0x47, 0xbc, 0x1d, 0x1a, 0xd1, 0x72, 0x51, 0x85, 0x0e, 0xbf, 0xff, 0x1d, 0xc1,
-	0x64, 0x0c, 0x74, 0x8e, 0xb6, 0xf8, 0xea, 0xd7, 0x6c, 0xbe, 0xaa, 0x19, 0x6e, 0x9d, 0xe2, 0x15,
-	0x38, 0xd6, 0xd1, 0x68, 0x34, 0x59, 0xe0, 0xee, 0x0a, 0xec, 0xa3, 0xf1, 0x7a, 0x46, 0xc8, 0x0c,
-	0x91, 0x3b, 0xec, 0x09, 0xf5, 0xf4, 0x10, 0xd6, 0x66, 0xe4, 0x1d, 0x1a, 0xd1, 0x72, 0x62, 0x11,
-	0x7a, 0x37, 0xe4, 0x0a, 0x5a, 0x2d, 0xc5, 0xb5, 0xb7, 0x97, 0xab, 0x43, 0x23, 0x5a, 0x4e, 0x2c,
-	0x42, 0x2e, 0xf2, 0xd9, 0x08, 0x14, 0x92, 0x81, 0xa9, 0xba, 0x73, 0xb6, 0x47, 0xcd, 0x30, 0x41,
-	0xd4, 0x09, 0x48, 0xd4, 0x44, 0xb4, 0x6d, 0x4d, 0x2c, 0xc1, 0x41, 0xce, 0x1a, 0xd4, 0xd5, 0xed,
-	0x28, 0x37, 0x73, 0x95, 0xb1, 0x8d, 0x76, 0xf1, 0xa8, 0xf4, 0xb9, 0xa4, 0x10, 0xed, 0x15, 0xf1,
-	0xb5, 0xea, 0x86, 0xa8, 0x7d, 0x6e, 0x78, 0xbc, 0x0f, 0xea, 0x0e, 0x8d, 0x68, 0x39, 0xb1, 0x10,
-	0xb6, 0xde, 0x80, 0xd1, 0xc0, 0xa7, 0xba, 0x19, 0x48, 0x6b, 0xf7, 0x9d, 0x45, 0xd3, 0x07, 0x2b,
-	0x93, 0x1b, 0xed, 0xe2, 0x98, 0xb4, 0x36, 0x41, 0x25, 0x1a, 0x04, 0x3e, 0x9d, 0x0d, 0x62, 0x37,
-	0xd5, 0x58, 0xe0, 0x5a, 0x11, 0xe3, 0xfe, 0x5e, 0x85, 0x1d, 0x1a, 0xd1, 0x72, 0x62, 0x91, 0x54,
-	0xe8, 0x32, 0x5d, 0x3c, 0x9b, 0x3a, 0x90, 0xa6, 0x50, 0x51, 0x23, 0x85, 0x8b, 0xac, 0x22, 0x16,
-	0x3f, 0xde, 0x0b, 0xc5, 0xbe, 0x1e, 0x96, 0xe7, 0x6c, 0x35, 0x99, 0x59, 0x56, 0x98, 0x75, 0xaa,
-	0x2a, 0x5c, 0xcf, 0x58, 0xdc, 0x7a, 0x0f, 0x98, 0x3c, 0x83, 0x9d, 0xdc, 0x12, 0xb9, 0xec, 0xe3,
-	0x57, 0x61, 0xd4, 0x0c, 0x3c, 0x8f, 0xba, 0x3c, 0x91, 0x5d, 0xda, 0x21, 0xf9, 0x4c, 0xd8, 0xea,
-	0xc0, 0x71, 0xb5, 0x25, 0xe6, 0x16, 0x91, 0xc9, 0x55, 0xee, 0x66, 0xcb, 0xf3, 0xa9, 0xc8, 0x27,
-	0x9b, 0xa4, 0x10, 0xed, 0x98, 0x7c, 0x16, 0x43, 0xc5, 0xef, 0x23, 0xc0, 0x6a, 0xa3, 0xbf, 0xe6,
-	0x71, 0xbd, 0xe5, 0xd9, 0x26, 0x15, 0x11, 0xcd, 0x55, 0x1e, 0x49, 0x7d, 0xe5, 0xba, 0xcd, 0x57,
-	0x83, 0x5a, 0xc9, 0x64, 0xcd, 0xb2, 0xf4, 0xc7, 0x45, 0xc7, 0xa8, 0xf9, 0x6a, 0x21, 0x3e, 0x05,
-	0x8c, 0x8a, 0x5d, 0x8f, 0x30, 0x9c, 0xec, 0xc6, 0xd0, 0x11, 0xdd, 0x01, 0xf1, 0x70, 0xcd, 0xe3,
-	0x4b, 0xe2, 0xd1, 0x5b, 0x70, 0x3a, 0x46, 0xb4, 0x14, 0x9d, 0x0c, 0x71, 0xe4, 0x87, 0x39, 0x02,
-	0xe4, 0x57, 0x08, 0xce, 0xf4, 0x91, 0x26, 0xc3, 0x5d, 0x83, 0x5c, 0xc7, 0xb3, 0x51, 0x9c, 0x5f,
-	0xcf, 0x18, 0xe7, 0x3e, 0xb5, 0x49, 0xbd, 0xd8, 0x63, 0x06, 0x7c, 0x13, 0x46, 0x6b, 0x81, 0xd9,
-	0xa0, 0xbc, 0xab, 0x00, 0x26, 0x32, 0x36, 0x49, 0x25, 0xda, 0xa1, 0x68, 0x19, 0x15, 0xc1, 0xaf,
-	0xc3, 0x99, 0x59, 0xc7, 0xb0, 0x9b, 0x46, 0xcd, 0xa1, 0x0f, 0x5b, 0x1e, 0x35, 0x2c, 0x8d, 0x3e,
-	0x31, 0x3c, 0xcb, 0xdf, 0xf1, 0x5b, 0xfd, 0x47, 0x08, 0x0a, 0xfd, 0x44, 0x4b, 0xe7, 0x7c, 0x1b,
-	0xa6, 0x4c, 0xb5, 0x43, 0xf7, 0xc5, 0x16, 0xdd, 0x8b, 0xf6, 0x48, 0x5f, 0x9d, 0xec, 0x7a, 0xdb,
-	0x29, 0xcf, 0xcc, 0x32, 0xdb, 0xad, 0xbc, 0x16, 0xba, 0x61, 0xa3, 0x5d, 0x2c, 0xca, 0xe8, 0xf7,
-	0x11, 0x44, 0xb4, 0x09, 0x33, 0x15, 0x05, 0x59, 0x86, 0x7c, 0x8c, 0xaf, 0xaa, 0xae, 0x9a, 0x3b,
-	0xb7, 0xfb, 0x83, 0x11, 0x38, 0x95, 0x2a, 0x57, 0x1a, 0xbd, 0x06, 0xe3, 0x1d, 0xac, 0xf1, 0x15,
-	0x37, 0x83, 0xc1, 0xff, 0x27, 0x0d, 0x3e, 0xd5, 0x6b, 0x70, 0x47, 0x08, 0xd1, 0xc6, 0xcc, 0xcd,
-	0xaa, 0x43, 0x95, 0x2b, 0xcc, 0x5b, 0xa1, 0x36, 0xa7, 0x56, 0x52, 0xe5, 0xc8, 0x80, 0x2a, 0xd3,
-	0x84, 0x10, 0x6d, 0x2c, 0x7e, 0xdc, 0x51, 0x49, 0x16, 0xe0, 0x4c, 0x78, 0x95, 0x99, 0x31, 0xcd,
-	0xa0, 0x19, 0x38, 0x06, 0x67, 0x5e, 0x4f, 0x5e, 0x0d, 0x74, 0xce, 0x7e, 0x3d, 0x02, 0x85, 0x7e,
-	0xe2, 0xa4, 0x5b, 0x3f, 0x44, 0x70, 0xaa, 0x2b, 0xf2, 0x7a, 0xdd, 0x63, 0x4f, 0xf8, 0xaa, 0x5e,
-	0x77, 0x58, 0xcd, 0x70, 0xa4, 0x7b, 0x4f, 0xa7, 0xda, 0x3a, 0x47, 0x4d, 0x61, 0xee, 0x95, 0xd0,
-	0xdc, 0x8f, 0x3e, 0x2b, 0x9e, 0x4f, 0xd4, 0x20, 0xd9, 0xa1, 0x45, 0x1f, 0x17, 0x7d, 0xab, 0x51,
-	0xe6, 0xeb, 0x2d, 0xea, 0x2b, 0x1e, 0x5f, 0x9b, 0xf2, 0x13, 0x59, 0x75, 0x4f, 0xe8, 0xbc, 0x27,
-	0x54, 0xe2, 0xef, 0x20, 0x18, 0x0f, 0x5a, 0x61, 0x4b, 0xd5, 0x83, 0x25, 0xf2, 0xfb, 0xd5, 0x8c,
-	0x75, 0x60, 0x59, 0x88, 0x78, 0xe4, 0x19, 0x66, 0x83, 0x7a, 0xbd, 0x21, 0x49, 0x93, 0x4f, 0x34,
-	0x1c, 0x3d, 0x4e, 0xa2, 0x21, 0x1f, 0x20, 0x28, 0x84, 0xf5, 0x29, 0xe1, 0x43, 0x29, 0x73, 0xa8,
-	0x98, 0x0c, 0x79, 0xe9, 0xfa, 0x7c, 0x04, 0x8a, 0x7d, 0x51, 0xc8, 0x50, 0x3e, 0x47, 0x70, 0x23,
-	0x35, 0x94, 0xac, 0x25, 0xce, 0x19, 0xd5, 0x2d, 0xf5, 0x5a, 0xd5, 0xd9, 0x8a, 0xee, 0x18, 0x3e,
-	0xd7, 0xb9, 0x67, 0x3c, 0xa6, 0x9e, 0xff, 0xdf, 0x0c, 0xf4, 0xe5, 0xcd, 0x81, 0x7e, 0x5b, 0x02,
-	0x8a, 0x5f, 0xf3, 0x6f, 0xaf, 0x2c, 0x18, 0x3e, 0x7f, 0xa4, 0xc0, 0xe0, 0x67, 0x70, 0x54, 0x46,
-	0x88, 0x4b, 0x2b, 0x77, 0x14, 0xfc, 0x82, 0x0c, 0xfe, 0x44, 0x57, 0xf0, 0x95, 0x68, 0xa2, 0x1d,
-	0x09, 0x92, 0xdb, 0x7d, 0xf2, 0x5d, 0x04, 0x93, 0xf1, 0xa1, 0xd4, 0x44, 0x13, 0x3d, 0x5c, 0xb0,
-	0x77, 0xab, 0x35, 0xfa, 0x18, 0xc1, 0xd4, 0x66, 0x40, 0x32, 0xee, 0x36, 0x1c, 0xef, 0x6d, 0xf9,
-	0x55, 0x59, 0xfc, 0x72, 0x46, 0x77, 0xf5, 0xc8, 0x96, 0xef, 0xca, 0x63, 0x76, 0x8f, 0xca, 0xdd,
-	0xeb, 0xac, 0xde, 0x43, 0x70, 0x7e, 0x76, 0xfe, 0xfe, 0x7d, 0xd1, 0xb7, 0x59, 0x0b, 0xb6, 0xdb,
-	0x98, 0xf7, 0x58, 0x73, 0x36, 0x01, 0x32, 0xa2, 0x28, 0xaf, 0x3f, 0x80, 0xf1, 0xa4, 0x05, 0x7a,
-	0x77, 0x08, 0x8a, 0x89, 0xf2, 0x9e, 0xb2, 0x8b, 0x68, 0xd8, 0xdc, 0x24, 0x99, 0xd8, 0x70, 0x21,
-	0x1b, 0x02, 0xe9, 0xe6, 0x1b, 0x30, 0x6a, 0xae, 0x34, 0x9b, 0x3d, 0xaa, 0x13, 0xd7, 0x85, 0x24,
-	0x95, 0x68, 0x10, 0x2e, 0xa5, 0xaa, 0xfb, 0x70, 0x66, 0xd9, 0xa7, 0xde, 0xb2, 0x5b, 0x63, 0xae,
-	0x65, 0xbb, 0xf5, 0x9d, 0x8d, 0x60, 0xc8, 0x4f, 0x10, 0x14, 0xfa, 0xc9, 0x93, 0x60, 0xdf, 0x43,
-	0x90, 0x8f, 0x47, 0x18, 0xfa, 0x13, 0x9b, 0xaf, 0xea, 0x2d, 0xea, 0xd9, 0xcc, 0xd2, 0x1d, 0x66,
-	0x36, 0x64, 0x76, 0xdc, 0xc9, 0x98, 0x1d, 0x4a, 0x7c, 0x78, 0x97, 0x5a, 0x12, 0x52, 0x16, 0x98,
-	0xd9, 0x90, 0x49, 0x32, 0x19, 0xab, 0xe9, 0x26, 0x93, 0x3c, 0x4c, 0xdd, 0xa3, 0xfc, 0x11, 0xe3,
-	0x86, 0x13, 0x5f, 0xc9, 0x54, 0x1f, 0xfd, 0x7d, 0x04, 0x27, 0x53, 0x88, 0x12, 0x3c, 0x87, 0xa3,
-	0x3c, 0xa4, 0xe8, 0xbd, 0x57, 0xc0, 0x2d, 0x5e, 0xb9, 0x5f, 0x92, 0xa5, 0x69, 0x3a, 0x43, 0x69,
-	0x8a, 0xea, 0xd2, 0x11, 0xde, 0xa5, 0x9d, 0x6c, 0x20, 0x28, 0x2c, 0x06, 0xcd, 0x45, 0xfa, 0x94,
-	0x57, 0x5d, 0x9b, 0xdb, 0x86, 0x63, 0x7f, 0x8b, 0x8a, 0xde, 0x66, 0xb8, 0xb3, 0x7f, 0x17, 0x8e,
-	0xa8, 0x6e, 0x4e, 0xb7, 0xa8, 0xcb, 0x9a, 0xb2, 0xdb, 0x3b, 0xb9, 0xd1, 0x2e, 0x9e, 0xe8, 0xee,
-	0xf6, 0x22, 0x3a, 0xd1, 0x46, 0x65, 0xcf, 0x37, 0x17, 0x2e, 0x71, 0x0d, 0xf2, 0x6e, 0xd0, 0xd4,
-	0x5d, 0xfa, 0x34, 0xbc, 0x83, 0xc6, 0x88, 0x44, 0x57, 0xe2, 0x8b, 0x76, 0x63, 0x5f, 0xe5, 0xdc,
-	0x46, 0xbb, 0xf8, 0x6a, 0x24, 0xac, 0xff, 0x5e, 0xa2, 0x4d, 0xba, 0xe9, 0x86, 0x91, 0x1f, 0x8e,
-	0x40, 0xb1, 0xaf, 0xd1, 0xff, 0xf3, 0xad, 0xd7, 0xe5, 0x7f, 0xe6, 0x61, 0xff, 0x83, 0xb0, 0xa2,
-	0xe1, 0x9f, 0x22, 0x10, 0x43, 0x26, 0x1f, 0x5f, 0xc9, 0x7c, 0x6a, 0x3a, 0x33, 0xb2, 0xfc, 0xd5,
-	0xc1, 0x98, 0x22, 0xcf, 0x93, 0xab, 0xef, 0xff, 0xfe, 0x2f, 0x3f, 0x18, 0x29, 0xe1, 0x0b, 0xe5,
-	0xac, 0xf3, 0xe2, 0x10, 0xe0, 0xcf, 0x10, 0x1c, 0x88, 0xc6, 0x4c, 0x38, 0xb3, 0xda, 0xe4, 0x94,
-	0x2b, 0x7f, 0x6d, 0x40, 0x2e, 0x89, 0xf6, 0x9a, 0x40, 0x5b, 0xc6, 0x17, 0xb3, 0xa2, 0x8d, 0x30,
-	0x7e, 0x8c, 0xe0, 0x70, 0xd7, 0x6c, 0x17, 0xdf, 0xca, 0xfa, 0x92, 0x4f, 0x99, 0x66, 0xe7, 0x6f,
-	0x0f, 0xc7, 0x2c, 0x6d, 0xa8, 0x08, 0x1b, 0x6e, 0xe3, 0x9b, 0xe5, 0xc1, 0x26, 0xf4, 0x7e, 0xf9,
-	0x5d, 0x59, 0x9d, 0x9f, 0xe1, 0xcf, 0x11, 0x9c, 0x48, 0xed, 0x6e, 0xf1, 0xec, 0xa0, 0x2d, 0x6c,
-	0x4a, 0xa7, 0x9d, 0x9f, 0xdb, 0x99, 0x10, 0x69, 0xe8, 0x3d, 0x61, 0xe8, 0x0c, 0xbe, 0x9b, 0xd1,
-	0xd0, 0x4e, 0x05, 0x50, 0x43, 0x32, 0xdd, 0x13, 0x36, 0xfd, 0x2b, 0x39, 0x0e, 0xec, 0x1e, 0xde,
-	0xe0, 0x37, 0x07, 0x85, 0x9a, 0x3a, 0x5e, 0xcb, 0xcf, 0xef, 0x54, 0x8c, 0xb4, 0xb9, 0x2a, 0x6c,
-	0x9e, 0xc5, 0x33, 0x03, 0xdb, 0xec, 0x8a, 0x31, 0x40, 0xe7, 0xfe, 0x8c, 0xff, 0x81, 0x60, 0x22,
-	0xbd, 0x4b, 0xc7, 0x59, 0xe3, 0xb3, 0xe5, 0xfc, 0x20, 0xff, 0xe6, 0x0e, 0xa5, 0x0c, 0x19, 0xe6,
-	0x7e, 0xe3, 0x00, 0xfc, 0x67, 0x04, 0x63, 0x29, 0xed, 0x39, 0x9e, 0x19, 0x14, 0xe7, 0xa6, 0x91,
-	0x41, 0xbe, 0xb2, 0x13, 0x11, 0xd2, 0xce, 0x59, 0x61, 0xe7, 0x1d, 0x7c, 0x6b, 0x60, 0x3b, 0x3b,
-	0x2d, 0x39, 0xfe, 0x2d, 0x82, 0xd1, 0xe4, 0x2f, 0x2a, 0xf8, 0xe6, 0x80, 0x17, 0xa4, 0xc4, 0xcf,
-	0x3a, 0xf9, 0x5b, 0x43, 0xf1, 0x4a, 0x73, 0xee, 0x08, 0x73, 0xae, 0xe3, 0x6b, 0x03, 0x96, 0x21,
-	0xbd, 0xb6, 0xae, 0xdb, 0x16, 0xfe, 0x2b, 0x82, 0x89, 0xf4, 0xbe, 0x3f, 0x73, 0x76, 0x6e, 0x39,
-	0x85, 0xc8, 0x9c, 0x9d, 0x5b, 0x0f, 0x1f, 0xc8, 0x8c, 0x30, 0xf3, 0x16, 0xbe, 0x31, 0xc0, 0xfb,
-	0x4d, 0x37, 0x42, 0x79, 0x71, 0x5e, 0xfe, 0x01, 0xc1, 0xb1, 0xde, 0xce, 0x08, 0xbf, 0x3e, 0x5c,
-	0xdb, 0x13, 0x9b, 0x77, 0x77, 0x68, 0x7e, 0x69, 0xd8, 0x1b, 0xc2, 0xb0, 0x9b, 0xf8, 0x2b, 0xe5,
-	0xe1, 0x7e, 0xb2, 0xf5, 0xf1, 0xdf, 0x10, 0x4c, 0xf6, 0x69, 0xf8, 0x33, 0x97, 0xd5, 0xad, 0xc7,
-	0x16, 0x99, 0xcb, 0xea, 0x36, 0x73, 0x87, 0x81, 0xdf, 0x99, 0xe2, 0xe5, 0x11, 0x45, 0x51, 0xb5,
-	0xe0, 0xf8, 0x97, 0x23, 0xf0, 0xff, 0x59, 0xba, 0x31, 0xac, 0x65, 0x2d, 0x16, 0xd9, 0x9b, 0xcb,
-	0xfc, 0xc3, 0x5d, 0x95, 0x29, 0xbd, 0x62, 0x0b, 0xaf, 0x98, 0xd8, 0xc8, 0x5a, 0x91, 0x12, 0xdd,
-	0xa3, 0xee, 0xd8, 0x6e, 0x43, 0x5f, 0xf1, 0x58, 0x53, 0x4f, 0x32, 0x95, 0xdf, 0x4d, 0xeb, 0x6e,
-	0x9f, 0xe1, 0x7f, 0x23, 0x98, 0x48, 0xef, 0x07, 0x33, 0x1f, 0xf7, 0x2d, 0xdb, 0xd3, 0xcc, 0xc7,
-	0x7d, 0xeb, 0xa6, 0x94, 0x3c, 0x10, 0x2e, 0x79, 0x0b, 0x57, 0x33, 0xba, 0x24, 0xf0, 0xa9, 0xa7,
-	0x07, 0x4a, 0x9e, 0x9e, 0x76, 0xd7, 0xfa, 0x14, 0xc1, 0xf1, 0x4d, 0x8d, 0x24, 0xce, 0x7a, 0x7e,
-	0xfb, 0xf5, 0xa7, 0xf9, 0x37, 0x86, 0x17, 0x30, 0xe4, 0xa1, 0xa8, 0x53, 0xae, 0xf7, 0x34, 0xbd,
-	0xe2, 0x6a, 0xd5, 0xa7, 0x39, 0xcb, 0x5c, 0x03, 0xb6, 0xee, 0x68, 0x33, 0xd7, 0x80, 0x6d, 0x7a,
-	0xc4, 0x81, 0xaf, 0x56, 0xfd, 0x9b, 0xd5, 0xca, 0xea, 0xf3, 0x17, 0x05, 0xf4, 0xc9, 0x8b, 0x02,
-	0xfa, 0xd3, 0x8b, 0x02, 0xfa, 0xf0, 0x65, 0x61, 0xcf, 0x27, 0x2f, 0x0b, 0x7b, 0xfe, 0xf8, 0xb2,
-	0xb0, 0xe7, 0x1b, 0x8b, 0xdb, 0xfd, 0xc6, 0xf5, 0xf8, 0xf2, 0xa5, 0xf2, 0xd3, 0x2e, 0xcd, 0x17,
-	0x3b, 0xaa, 0x4d, 0xc7, 0xa6, 0x2e, 0x8f, 0xfe, 0x2e, 0x14, 0xfd, 0x81, 0xe0, 0x80, 0xf8, 0xb8,
-	0xf2, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x97, 0xdd, 0xc0, 0x41, 0x25, 0x00, 0x00,
+func (m *RoundingRemaindersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundingRemaindersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundingRemaindersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoundingRemaindersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundingRemaindersRequest.Merge(m, src)
+}
+func (m *RoundingRemaindersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundingRemaindersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundingRemaindersRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_RoundingRemaindersRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
-	// NumNextInitializedTicks returns the provided number of next initialized
-	// ticks in the direction of swapping the token in denom.
-	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+func (m *RoundingRemaindersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type RoundingRemaindersResponse struct {
+	Remainders github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=remainders,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainders" yaml:"remainders"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *RoundingRemaindersResponse) Reset()         { *m = RoundingRemaindersResponse{} }
+func (m *RoundingRemaindersResponse) String() string { return proto.CompactTextString(m) }
+func (*RoundingRemaindersResponse) ProtoMessage()    {}
+func (*RoundingRemaindersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{33}
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
-	out := new(PoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RoundingRemaindersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RoundingRemaindersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundingRemaindersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RoundingRemaindersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundingRemaindersResponse.Merge(m, src)
+}
+func (m *RoundingRemaindersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundingRemaindersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundingRemaindersResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
-	out := new(UserPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RoundingRemaindersResponse proto.InternalMessageInfo
+
+func (m *RoundingRemaindersResponse) GetRemainders() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainders
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
-	out := new(LiquidityPerTickRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PositionWithLiquidityShare is a position along with its share of the
+// in-range liquidity of its pool.
+type PositionWithLiquidityShare struct {
+	Position model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	// in_range is true if the position is active at the current tick of the pool.
+	InRange bool `protobuf:"varint,2,opt,name=in_range,json=inRange,proto3" json:"in_range,omitempty" yaml:"in_range"`
+	// liquidity_share is the share of the position in the liquidity of the pool
+	// at the current tick, between 0 and 1. It is zero if the position is out of
+	// range.
+	LiquidityShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidity_share,json=liquidityShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_share" yaml:"liquidity_share"`
 }
 
-func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
-	out := new(LiquidityNetInDirectionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PositionWithLiquidityShare) Reset()         { *m = PositionWithLiquidityShare{} }
+func (m *PositionWithLiquidityShare) String() string { return proto.CompactTextString(m) }
+func (*PositionWithLiquidityShare) ProtoMessage()    {}
+func (*PositionWithLiquidityShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{34}
+}
+func (m *PositionWithLiquidityShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionWithLiquidityShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionWithLiquidityShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PositionWithLiquidityShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionWithLiquidityShare.Merge(m, src)
+}
+func (m *PositionWithLiquidityShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionWithLiquidityShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionWithLiquidityShare.DiscardUnknown(m)
 }
 
-func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
-	out := new(ClaimableSpreadRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionWithLiquidityShare proto.InternalMessageInfo
+
+func (m *PositionWithLiquidityShare) GetPosition() model.Position {
+	if m != nil {
+		return m.Position
 	}
-	return out, nil
+	return model.Position{}
 }
 
-func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
-	out := new(ClaimableIncentivesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PositionWithLiquidityShare) GetInRange() bool {
+	if m != nil {
+		return m.InRange
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
-	out := new(PositionByIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PositionsByPoolRequest struct {
+	PoolId     uint64             `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
-	out := new(PoolAccumulatorRewardsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PositionsByPoolRequest) Reset()         { *m = PositionsByPoolRequest{} }
+func (m *PositionsByPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionsByPoolRequest) ProtoMessage()    {}
+func (*PositionsByPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{35}
+}
+func (m *PositionsByPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionsByPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionsByPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PositionsByPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionsByPoolRequest.Merge(m, src)
+}
+func (m *PositionsByPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionsByPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionsByPoolRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
-	out := new(IncentiveRecordsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionsByPoolRequest proto.InternalMessageInfo
+
+func (m *PositionsByPoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
-	out := new(TickAccumulatorTrackersResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PositionsByPoolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type PositionsByPoolResponse struct {
+	Positions  []PositionWithLiquidityShare `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	Pagination *query.PageResponse          `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
-	out := new(UserUnbondingPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *PositionsByPoolResponse) Reset()         { *m = PositionsByPoolResponse{} }
+func (m *PositionsByPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionsByPoolResponse) ProtoMessage()    {}
+func (*PositionsByPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{36}
 }
-
-func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
-	out := new(GetTotalLiquidityResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PositionsByPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionsByPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionsByPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PositionsByPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionsByPoolResponse.Merge(m, src)
+}
+func (m *PositionsByPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionsByPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionsByPoolResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error) {
-	out := new(NumNextInitializedTicksResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionsByPoolResponse proto.InternalMessageInfo
+
+func (m *PositionsByPoolResponse) GetPositions() []PositionWithLiquidityShare {
+	if m != nil {
+		return m.Positions
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
-	// LiquidityPerTickRange returns the amount of liquidity per every tick range
-	// existing within the given pool
-	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
-	// ClaimableSpreadRewards returns the amount of spread rewards that can be
-	// claimed by a position with the given id.
-	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
-	// ClaimableIncentives returns the amount of incentives that can be claimed
-	// and how many would be forfeited by a position with the given id.
-	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
-	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
-	// Contains spread factor rewards and uptime rewards.
-	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
-	// IncentiveRecords returns the incentive records for a given poolId
-	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
-	// TickAccumulatorTrackers returns the tick accumulator trackers.
-	// Contains spread factor and uptime accumulator trackers.
-	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
-	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
-	// pool that is linked with the given concentrated pool.
-	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
-	// UserUnbondingPositions returns the position and lock info of unbonding
-	// positions of the given address.
-	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
-	// GetTotalLiquidity returns total liquidity across all cl pools.
-	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
-	// NumNextInitializedTicks returns the provided number of next initialized
-	// ticks in the direction of swapping the token in denom.
-	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+func (m *PositionsByPoolResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type PositionValueInQuoteDenomRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom"`
 }
 
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+func (m *PositionValueInQuoteDenomRequest) Reset()         { *m = PositionValueInQuoteDenomRequest{} }
+func (m *PositionValueInQuoteDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionValueInQuoteDenomRequest) ProtoMessage()    {}
+func (*PositionValueInQuoteDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{37}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *PositionValueInQuoteDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+func (m *PositionValueInQuoteDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionValueInQuoteDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+func (m *PositionValueInQuoteDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionValueInQuoteDenomRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+func (m *PositionValueInQuoteDenomRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+func (m *PositionValueInQuoteDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionValueInQuoteDenomRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+
+var xxx_messageInfo_PositionValueInQuoteDenomRequest proto.InternalMessageInfo
+
+func (m *PositionValueInQuoteDenomRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+
+func (m *PositionValueInQuoteDenomRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+
+type PositionValueInQuoteDenomResponse struct {
+	Asset0 types2.Coin `protobuf:"bytes,1,opt,name=asset0,proto3" json:"asset0" yaml:"asset0"`
+	Asset1 types2.Coin `protobuf:"bytes,2,opt,name=asset1,proto3" json:"asset1" yaml:"asset1"`
+	// value is the total value of both assets in terms of the quote denom.
+	Value types2.Coin `protobuf:"bytes,3,opt,name=value,proto3" json:"value" yaml:"value"`
 }
-func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+
+func (m *PositionValueInQuoteDenomResponse) Reset()         { *m = PositionValueInQuoteDenomResponse{} }
+func (m *PositionValueInQuoteDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionValueInQuoteDenomResponse) ProtoMessage()    {}
+func (*PositionValueInQuoteDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{38}
 }
-func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+func (m *PositionValueInQuoteDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+func (m *PositionValueInQuoteDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionValueInQuoteDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+func (m *PositionValueInQuoteDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionValueInQuoteDenomResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+func (m *PositionValueInQuoteDenomResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
+func (m *PositionValueInQuoteDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionValueInQuoteDenomResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_PositionValueInQuoteDenomResponse proto.InternalMessageInfo
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
+func (m *PositionValueInQuoteDenomResponse) GetAsset0() types2.Coin {
+	if m != nil {
+		return m.Asset0
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.Coin{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+func (m *PositionValueInQuoteDenomResponse) GetAsset1() types2.Coin {
+	if m != nil {
+		return m.Asset1
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.Coin{}
 }
 
-func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
+func (m *PositionValueInQuoteDenomResponse) GetValue() types2.Coin {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return types2.Coin{}
 }
 
-func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityPerTickRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CreatePositionEstimateRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64  `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// tokens_provided is the amount of tokens that would be provided for the
+	// position.
+	TokensProvided github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=tokens_provided,json=tokensProvided,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_provided" yaml:"tokens_provided"`
 }
 
-func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LiquidityNetInDirectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CreatePositionEstimateRequest) Reset()         { *m = CreatePositionEstimateRequest{} }
+func (m *CreatePositionEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePositionEstimateRequest) ProtoMessage()    {}
+func (*CreatePositionEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{39}
+}
+func (m *CreatePositionEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePositionEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePositionEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
+}
+func (m *CreatePositionEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePositionEstimateRequest.Merge(m, src)
+}
+func (m *CreatePositionEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePositionEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePositionEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePositionEstimateRequest proto.InternalMessageInfo
+
+func (m *CreatePositionEstimateRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
+	return 0
+}
+
+func (m *CreatePositionEstimateRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
+	return 0
+}
+
+func (m *CreatePositionEstimateRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableSpreadRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+func (m *CreatePositionEstimateRequest) GetTokensProvided() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TokensProvided
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClaimableIncentivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableIncentives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type CreatePositionEstimateResponse struct {
+	Amount0          cosmossdk_io_math.Int       `protobuf:"bytes,1,opt,name=amount0,proto3,customtype=cosmossdk.io/math.Int" json:"amount0" yaml:"amount0"`
+	Amount1          cosmossdk_io_math.Int       `protobuf:"bytes,2,opt,name=amount1,proto3,customtype=cosmossdk.io/math.Int" json:"amount1" yaml:"amount1"`
+	LiquidityCreated cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity_created" yaml:"liquidity_created"`
+	// lower_tick and upper_tick are the canonical ticks the position would be
+	// created with.
+	LowerTick int64 `protobuf:"varint,4,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,5,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// leftover is the part of tokens_provided that would not be used.
+	Leftover github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=leftover,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"leftover" yaml:"leftover"`
 }
 
-func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PositionByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PositionById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+func (m *CreatePositionEstimateResponse) Reset()         { *m = CreatePositionEstimateResponse{} }
+func (m *CreatePositionEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePositionEstimateResponse) ProtoMessage()    {}
+func (*CreatePositionEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{40}
+}
+func (m *CreatePositionEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreatePositionEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreatePositionEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *CreatePositionEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePositionEstimateResponse.Merge(m, src)
+}
+func (m *CreatePositionEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreatePositionEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePositionEstimateResponse.DiscardUnknown(m)
 }
 
-func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PoolAccumulatorRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
+var xxx_messageInfo_CreatePositionEstimateResponse proto.InternalMessageInfo
+
+func (m *CreatePositionEstimateResponse) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IncentiveRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IncentiveRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+func (m *CreatePositionEstimateResponse) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TickAccumulatorTrackersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+func (m *CreatePositionEstimateResponse) GetLeftover() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Leftover
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type PoolSwapStatsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserUnbondingPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+func (m *PoolSwapStatsRequest) Reset()         { *m = PoolSwapStatsRequest{} }
+func (m *PoolSwapStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolSwapStatsRequest) ProtoMessage()    {}
+func (*PoolSwapStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{41}
+}
+func (m *PoolSwapStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSwapStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSwapStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PoolSwapStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSwapStatsRequest.Merge(m, src)
+}
+func (m *PoolSwapStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSwapStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSwapStatsRequest.DiscardUnknown(m)
 }
 
-func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTotalLiquidityRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
+var xxx_messageInfo_PoolSwapStatsRequest proto.InternalMessageInfo
+
+func (m *PoolSwapStatsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
+	return 0
+}
+
+type PoolSwapStatsResponse struct {
+	// swap_volume is the cumulative amount of each token swapped into the pool,
+	// spread rewards included.
+	SwapVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=swap_volume,json=swapVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_volume" yaml:"swap_volume"`
+	// spread_rewards_collected is the cumulative amount of spread rewards
+	// charged by swaps in the pool.
+	SpreadRewardsCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spread_rewards_collected,json=spreadRewardsCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spread_rewards_collected" yaml:"spread_rewards_collected"`
+}
+
+func (m *PoolSwapStatsResponse) Reset()         { *m = PoolSwapStatsResponse{} }
+func (m *PoolSwapStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolSwapStatsResponse) ProtoMessage()    {}
+func (*PoolSwapStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{42}
+}
+func (m *PoolSwapStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolSwapStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolSwapStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *PoolSwapStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolSwapStatsResponse.Merge(m, src)
+}
+func (m *PoolSwapStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolSwapStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolSwapStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NumNextInitializedTicksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_PoolSwapStatsResponse proto.InternalMessageInfo
+
+func (m *PoolSwapStatsResponse) GetSwapVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapVolume
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).NumNextInitializedTicks(ctx, in)
+	return nil
+}
+
+func (m *PoolSwapStatsResponse) GetSpreadRewardsCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpreadRewardsCollected
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks",
+	return nil
+}
+
+type IncentiveRecordSlotsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *IncentiveRecordSlotsRequest) Reset()         { *m = IncentiveRecordSlotsRequest{} }
+func (m *IncentiveRecordSlotsRequest) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordSlotsRequest) ProtoMessage()    {}
+func (*IncentiveRecordSlotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{43}
+}
+func (m *IncentiveRecordSlotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentiveRecordSlotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordSlotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NumNextInitializedTicks(ctx, req.(*NumNextInitializedTicksRequest))
+}
+func (m *IncentiveRecordSlotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordSlotsRequest.Merge(m, src)
+}
+func (m *IncentiveRecordSlotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordSlotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordSlotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordSlotsRequest proto.InternalMessageInfo
+
+func (m *IncentiveRecordSlotsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "LiquidityPerTickRange",
-			Handler:    _Query_LiquidityPerTickRange_Handler,
-		},
-		{
-			MethodName: "LiquidityNetInDirection",
-			Handler:    _Query_LiquidityNetInDirection_Handler,
-		},
-		{
-			MethodName: "ClaimableSpreadRewards",
-			Handler:    _Query_ClaimableSpreadRewards_Handler,
-		},
-		{
-			MethodName: "ClaimableIncentives",
-			Handler:    _Query_ClaimableIncentives_Handler,
-		},
-		{
-			MethodName: "PositionById",
-			Handler:    _Query_PositionById_Handler,
-		},
-		{
-			MethodName: "PoolAccumulatorRewards",
-			Handler:    _Query_PoolAccumulatorRewards_Handler,
-		},
-		{
-			MethodName: "IncentiveRecords",
-			Handler:    _Query_IncentiveRecords_Handler,
-		},
-		{
-			MethodName: "TickAccumulatorTrackers",
-			Handler:    _Query_TickAccumulatorTrackers_Handler,
-		},
-		{
-			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
-			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
-		},
-		{
-			MethodName: "UserUnbondingPositions",
-			Handler:    _Query_UserUnbondingPositions_Handler,
-		},
-		{
-			MethodName: "GetTotalLiquidity",
-			Handler:    _Query_GetTotalLiquidity_Handler,
-		},
-		{
-			MethodName: "NumNextInitializedTicks",
-			Handler:    _Query_NumNextInitializedTicks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
+type IncentiveRecordSlotsResponse struct {
+	// remaining_pool_slots is the number of incentive records that can still be
+	// created for the pool across all uptimes.
+	RemainingPoolSlots uint64 `protobuf:"varint,1,opt,name=remaining_pool_slots,json=remainingPoolSlots,proto3" json:"remaining_pool_slots,omitempty" yaml:"remaining_pool_slots"`
+	// remaining_uptime_slots is the number of incentive records that can still be
+	// created for each authorized uptime, bounded by remaining_pool_slots.
+	RemainingUptimeSlots []UptimeIncentiveRecordSlots `protobuf:"bytes,2,rep,name=remaining_uptime_slots,json=remainingUptimeSlots,proto3" json:"remaining_uptime_slots" yaml:"remaining_uptime_slots"`
 }
 
-func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *IncentiveRecordSlotsResponse) Reset()         { *m = IncentiveRecordSlotsResponse{} }
+func (m *IncentiveRecordSlotsResponse) String() string { return proto.CompactTextString(m) }
+func (*IncentiveRecordSlotsResponse) ProtoMessage()    {}
+func (*IncentiveRecordSlotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{44}
 }
-
-func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IncentiveRecordSlotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *IncentiveRecordSlotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentiveRecordSlotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *IncentiveRecordSlotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentiveRecordSlotsResponse.Merge(m, src)
+}
+func (m *IncentiveRecordSlotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentiveRecordSlotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentiveRecordSlotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentiveRecordSlotsResponse proto.InternalMessageInfo
+
+func (m *IncentiveRecordSlotsResponse) GetRemainingPoolSlots() uint64 {
+	if m != nil {
+		return m.RemainingPoolSlots
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IncentiveRecordSlotsResponse) GetRemainingUptimeSlots() []UptimeIncentiveRecordSlots {
+	if m != nil {
+		return m.RemainingUptimeSlots
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UptimeIncentiveRecordSlots struct {
+	Uptime         time.Duration `protobuf:"bytes,1,opt,name=uptime,proto3,stdduration" json:"uptime" yaml:"uptime"`
+	RemainingSlots uint64        `protobuf:"varint,2,opt,name=remaining_slots,json=remainingSlots,proto3" json:"remaining_slots,omitempty" yaml:"remaining_slots"`
 }
 
-func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *UptimeIncentiveRecordSlots) Reset()         { *m = UptimeIncentiveRecordSlots{} }
+func (m *UptimeIncentiveRecordSlots) String() string { return proto.CompactTextString(m) }
+func (*UptimeIncentiveRecordSlots) ProtoMessage()    {}
+func (*UptimeIncentiveRecordSlots) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{45}
+}
+func (m *UptimeIncentiveRecordSlots) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UptimeIncentiveRecordSlots) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UptimeIncentiveRecordSlots.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UptimeIncentiveRecordSlots) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UptimeIncentiveRecordSlots.Merge(m, src)
 }
-
-func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UptimeIncentiveRecordSlots) XXX_Size() int {
+	return m.Size()
+}
+func (m *UptimeIncentiveRecordSlots) XXX_DiscardUnknown() {
+	xxx_messageInfo_UptimeIncentiveRecordSlots.DiscardUnknown(m)
 }
 
-func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_UptimeIncentiveRecordSlots proto.InternalMessageInfo
+
+func (m *UptimeIncentiveRecordSlots) GetUptime() time.Duration {
+	if m != nil {
+		return m.Uptime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UptimeIncentiveRecordSlots) GetRemainingSlots() uint64 {
+	if m != nil {
+		return m.RemainingSlots
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PositionLiensRequest struct {
+	// lienholder optionally restricts the liens returned to the ones held by
+	// the given address.
+	Lienholder string `protobuf:"bytes,1,opt,name=lienholder,proto3" json:"lienholder,omitempty" yaml:"lienholder"`
 }
 
-func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+func (m *PositionLiensRequest) Reset()         { *m = PositionLiensRequest{} }
+func (m *PositionLiensRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionLiensRequest) ProtoMessage()    {}
+func (*PositionLiensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{46}
+}
+func (m *PositionLiensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionLiensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionLiensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PositionLiensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionLiensRequest.Merge(m, src)
+}
+func (m *PositionLiensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionLiensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionLiensRequest.DiscardUnknown(m)
 }
 
-func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionLiensRequest proto.InternalMessageInfo
+
+func (m *PositionLiensRequest) GetLienholder() string {
+	if m != nil {
+		return m.Lienholder
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PositionLiensResponse struct {
+	Liens []types1.PositionLien `protobuf:"bytes,1,rep,name=liens,proto3" json:"liens" yaml:"liens"`
 }
 
-func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *PositionLiensResponse) Reset()         { *m = PositionLiensResponse{} }
+func (m *PositionLiensResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionLiensResponse) ProtoMessage()    {}
+func (*PositionLiensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{47}
+}
+func (m *PositionLiensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionLiensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionLiensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PositionLiensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionLiensResponse.Merge(m, src)
+}
+func (m *PositionLiensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionLiensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionLiensResponse.DiscardUnknown(m)
 }
 
-func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionLiensResponse proto.InternalMessageInfo
+
+func (m *PositionLiensResponse) GetLiens() []types1.PositionLien {
+	if m != nil {
+		return m.Liens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ManagedPositionsRequest struct {
+	// owner optionally restricts the managed positions returned to the ones
+	// owned by the given address.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
-func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ManagedPositionsRequest) Reset()         { *m = ManagedPositionsRequest{} }
+func (m *ManagedPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ManagedPositionsRequest) ProtoMessage()    {}
+func (*ManagedPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{48}
+}
+func (m *ManagedPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagedPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ManagedPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedPositionsRequest.Merge(m, src)
+}
+func (m *ManagedPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedPositionsRequest.DiscardUnknown(m)
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ManagedPositionsRequest proto.InternalMessageInfo
+
+func (m *ManagedPositionsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ManagedPositionsResponse struct {
+	ManagedPositions []types1.ManagedPosition `protobuf:"bytes,1,rep,name=managed_positions,json=managedPositions,proto3" json:"managed_positions" yaml:"managed_positions"`
 }
 
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ManagedPositionsResponse) Reset()         { *m = ManagedPositionsResponse{} }
+func (m *ManagedPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ManagedPositionsResponse) ProtoMessage()    {}
+func (*ManagedPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{49}
 }
-
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManagedPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManagedPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ManagedPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedPositionsResponse.Merge(m, src)
+}
+func (m *ManagedPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedPositionsResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ManagedPositionsResponse proto.InternalMessageInfo
+
+func (m *ManagedPositionsResponse) GetManagedPositions() []types1.ManagedPosition {
+	if m != nil {
+		return m.ManagedPositions
+	}
+	return nil
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+type IncentivesPreviewRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64  `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// liquidity is the liquidity of the hypothetical position.
+	Liquidity cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=liquidity,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidity" yaml:"liquidity"`
+	// uptime is the duration the hypothetical position would be kept for. Only
+	// incentives with a min uptime of at most uptime are projected.
+	Uptime time.Duration `protobuf:"bytes,5,opt,name=uptime,proto3,stdduration" json:"uptime" yaml:"uptime"`
+}
+
+func (m *IncentivesPreviewRequest) Reset()         { *m = IncentivesPreviewRequest{} }
+func (m *IncentivesPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*IncentivesPreviewRequest) ProtoMessage()    {}
+func (*IncentivesPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{50}
+}
+func (m *IncentivesPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivesPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivesPreviewRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *IncentivesPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivesPreviewRequest.Merge(m, src)
+}
+func (m *IncentivesPreviewRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivesPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivesPreviewRequest.DiscardUnknown(m)
 }
 
-func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IncentivesPreviewRequest proto.InternalMessageInfo
+
+func (m *IncentivesPreviewRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IncentivesPreviewRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
 }
 
-func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.LiquidityNet.Size()
-		i -= size
-		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *IncentivesPreviewRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IncentivesPreviewRequest) GetUptime() time.Duration {
+	if m != nil {
+		return m.Uptime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IncentivesPreviewResponse struct {
+	// incentives_per_day is the projected amount of incentives earned by the
+	// position per day at the current emission rates and active liquidity.
+	IncentivesPerDay github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=incentives_per_day,json=incentivesPerDay,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"incentives_per_day" yaml:"incentives_per_day"`
+	// in_range is whether the current tick of the pool is within the range of
+	// the position. Positions out of range earn no incentives.
+	InRange bool `protobuf:"varint,2,opt,name=in_range,json=inRange,proto3" json:"in_range,omitempty" yaml:"in_range"`
 }
 
-func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpperTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LowerTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *IncentivesPreviewResponse) Reset()         { *m = IncentivesPreviewResponse{} }
+func (m *IncentivesPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*IncentivesPreviewResponse) ProtoMessage()    {}
+func (*IncentivesPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{51}
+}
+func (m *IncentivesPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivesPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivesPreviewResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *IncentivesPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivesPreviewResponse.Merge(m, src)
+}
+func (m *IncentivesPreviewResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivesPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivesPreviewResponse.DiscardUnknown(m)
 }
 
-func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_IncentivesPreviewResponse proto.InternalMessageInfo
+
+func (m *IncentivesPreviewResponse) GetIncentivesPerDay() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.IncentivesPerDay
+	}
+	return nil
 }
 
-func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UseNoBound {
-		i--
-		if m.UseNoBound {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.BoundTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.UseCurTick {
-		i--
-		if m.UseCurTick {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *IncentivesPreviewResponse) GetInRange() bool {
+	if m != nil {
+		return m.InRange
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type PositionHistoryRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PositionHistoryRequest) Reset()         { *m = PositionHistoryRequest{} }
+func (m *PositionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionHistoryRequest) ProtoMessage()    {}
+func (*PositionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{52}
 }
-
-func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentSqrtPrice.Size()
-		i -= size
-		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PositionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PositionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionHistoryRequest.Merge(m, src)
+}
+func (m *PositionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionHistoryRequest proto.InternalMessageInfo
+
+func (m *PositionHistoryRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PositionHistoryResponse struct {
+	// entries are the lifecycle events of the position within the retention
+	// period, in chronological order.
+	Entries []types1.PositionHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries" yaml:"entries"`
 }
 
-func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *PositionHistoryResponse) Reset()         { *m = PositionHistoryResponse{} }
+func (m *PositionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionHistoryResponse) ProtoMessage()    {}
+func (*PositionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{53}
+}
+func (m *PositionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PositionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionHistoryResponse.Merge(m, src)
+}
+func (m *PositionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionHistoryResponse proto.InternalMessageInfo
+
+func (m *PositionHistoryResponse) GetEntries() []types1.PositionHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PositionMigrationWindowsRequest struct {
 }
 
-func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BucketIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BucketIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *PositionMigrationWindowsRequest) Reset()         { *m = PositionMigrationWindowsRequest{} }
+func (m *PositionMigrationWindowsRequest) String() string { return proto.CompactTextString(m) }
+func (*PositionMigrationWindowsRequest) ProtoMessage()    {}
+func (*PositionMigrationWindowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{54}
+}
+func (m *PositionMigrationWindowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionMigrationWindowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionMigrationWindowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PositionMigrationWindowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionMigrationWindowsRequest.Merge(m, src)
+}
+func (m *PositionMigrationWindowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionMigrationWindowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionMigrationWindowsRequest.DiscardUnknown(m)
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PositionMigrationWindowsRequest proto.InternalMessageInfo
+
+type PositionMigrationWindowsResponse struct {
+	Windows []types1.PositionMigrationWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows" yaml:"windows"`
 }
 
-func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func (m *PositionMigrationWindowsResponse) Reset()         { *m = PositionMigrationWindowsResponse{} }
+func (m *PositionMigrationWindowsResponse) String() string { return proto.CompactTextString(m) }
+func (*PositionMigrationWindowsResponse) ProtoMessage()    {}
+func (*PositionMigrationWindowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{55}
+}
+func (m *PositionMigrationWindowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionMigrationWindowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionMigrationWindowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PositionMigrationWindowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionMigrationWindowsResponse.Merge(m, src)
+}
+func (m *PositionMigrationWindowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionMigrationWindowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionMigrationWindowsResponse.DiscardUnknown(m)
 }
 
-func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PositionMigrationWindowsResponse proto.InternalMessageInfo
+
+func (m *PositionMigrationWindowsResponse) GetWindows() []types1.PositionMigrationWindow {
+	if m != nil {
+		return m.Windows
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VerifyPoolRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *VerifyPoolRequest) Reset()         { *m = VerifyPoolRequest{} }
+func (m *VerifyPoolRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyPoolRequest) ProtoMessage()    {}
+func (*VerifyPoolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{56}
+}
+func (m *VerifyPoolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyPoolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyPoolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VerifyPoolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPoolRequest.Merge(m, src)
+}
+func (m *VerifyPoolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyPoolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPoolRequest.DiscardUnknown(m)
 }
 
-func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyPoolRequest proto.InternalMessageInfo
+
+func (m *VerifyPoolRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VerifyPoolResponse struct {
+	// height is the height of the state that was verified.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// discrepancies are the failed checks. Empty if the pool state is consistent.
+	Discrepancies []StateDiscrepancy `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies" yaml:"discrepancies"`
 }
 
-func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func (m *VerifyPoolResponse) Reset()         { *m = VerifyPoolResponse{} }
+func (m *VerifyPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyPoolResponse) ProtoMessage()    {}
+func (*VerifyPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{57}
+}
+func (m *VerifyPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VerifyPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPoolResponse.Merge(m, src)
+}
+func (m *VerifyPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPoolResponse.DiscardUnknown(m)
 }
 
-func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VerifyPoolResponse proto.InternalMessageInfo
+
+func (m *VerifyPoolResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VerifyPoolResponse) GetDiscrepancies() []StateDiscrepancy {
+	if m != nil {
+		return m.Discrepancies
+	}
+	return nil
 }
 
-func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ForfeitedIncentives) > 0 {
-		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ClaimableIncentives) > 0 {
-		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type StateDiscrepancy struct {
+	// check is the name of the check that failed.
+	Check string `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty" yaml:"check"`
+	// subject identifies the state entry that failed the check within the pool,
+	// if any.
+	Subject  string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty" yaml:"subject"`
+	Expected string `protobuf:"bytes,3,opt,name=expected,proto3" json:"expected,omitempty" yaml:"expected"`
+	Actual   string `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty" yaml:"actual"`
+}
+
+func (m *StateDiscrepancy) Reset()         { *m = StateDiscrepancy{} }
+func (m *StateDiscrepancy) String() string { return proto.CompactTextString(m) }
+func (*StateDiscrepancy) ProtoMessage()    {}
+func (*StateDiscrepancy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{58}
+}
+func (m *StateDiscrepancy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiscrepancy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateDiscrepancy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StateDiscrepancy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiscrepancy.Merge(m, src)
+}
+func (m *StateDiscrepancy) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiscrepancy) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiscrepancy.DiscardUnknown(m)
 }
 
-func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StateDiscrepancy proto.InternalMessageInfo
+
+func (m *StateDiscrepancy) GetCheck() string {
+	if m != nil {
+		return m.Check
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateDiscrepancy) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
 }
 
-func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (m *StateDiscrepancy) GetExpected() string {
+	if m != nil {
+		return m.Expected
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateDiscrepancy) GetActual() string {
+	if m != nil {
+		return m.Actual
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OpenLimitOrdersRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// owner optionally restricts the orders returned to the ones owned by the
+	// given address.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
-func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *OpenLimitOrdersRequest) Reset()         { *m = OpenLimitOrdersRequest{} }
+func (m *OpenLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenLimitOrdersRequest) ProtoMessage()    {}
+func (*OpenLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{59}
+}
+func (m *OpenLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *OpenLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenLimitOrdersRequest.Merge(m, src)
+}
+func (m *OpenLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenLimitOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *OpenLimitOrdersRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OpenLimitOrdersRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type OpenLimitOrdersResponse struct {
+	LimitOrders []types1.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
 }
 
-func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TickIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *OpenLimitOrdersResponse) Reset()         { *m = OpenLimitOrdersResponse{} }
+func (m *OpenLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*OpenLimitOrdersResponse) ProtoMessage()    {}
+func (*OpenLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{60}
 }
-
-func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OpenLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UptimeTrackers) > 0 {
-		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *OpenLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *OpenLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenLimitOrdersResponse.Merge(m, src)
+}
+func (m *OpenLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenLimitOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_OpenLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *OpenLimitOrdersResponse) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ClaimableLimitOrdersRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
-func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ClaimableLimitOrdersRequest) Reset()         { *m = ClaimableLimitOrdersRequest{} }
+func (m *ClaimableLimitOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimableLimitOrdersRequest) ProtoMessage()    {}
+func (*ClaimableLimitOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{61}
+}
+func (m *ClaimableLimitOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableLimitOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableLimitOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ClaimableLimitOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableLimitOrdersRequest.Merge(m, src)
+}
+func (m *ClaimableLimitOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableLimitOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableLimitOrdersRequest.DiscardUnknown(m)
 }
 
-func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClaimableLimitOrdersRequest proto.InternalMessageInfo
+
+func (m *ClaimableLimitOrdersRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ClaimableLimitOrdersResponse struct {
+	LimitOrders []types1.LimitOrder `protobuf:"bytes,1,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders" yaml:"limit_orders"`
 }
 
-func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IncentiveRecords) > 0 {
-		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ClaimableLimitOrdersResponse) Reset()         { *m = ClaimableLimitOrdersResponse{} }
+func (m *ClaimableLimitOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimableLimitOrdersResponse) ProtoMessage()    {}
+func (*ClaimableLimitOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5da291368ba4d8e3, []int{62}
+}
+func (m *ClaimableLimitOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableLimitOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableLimitOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ClaimableLimitOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableLimitOrdersResponse.Merge(m, src)
 }
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ClaimableLimitOrdersResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConcentratedPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *ClaimableLimitOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableLimitOrdersResponse.DiscardUnknown(m)
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClaimableLimitOrdersResponse proto.InternalMessageInfo
+
+func (m *ClaimableLimitOrdersResponse) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*UserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsRequest")
+	proto.RegisterType((*UserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserPositionsResponse")
+	proto.RegisterType((*PositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdRequest")
+	proto.RegisterType((*PositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionByIdResponse")
+	proto.RegisterType((*PoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsRequest")
+	proto.RegisterType((*PoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolsResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ParamsResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*LiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionRequest")
+	proto.RegisterType((*LiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityNetInDirectionResponse")
+	proto.RegisterType((*LiquidityPerTickRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeRequest")
+	proto.RegisterType((*LiquidityPerTickRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityPerTickRangeResponse")
+	proto.RegisterType((*ClaimableSpreadRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsRequest")
+	proto.RegisterType((*ClaimableSpreadRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableSpreadRewardsResponse")
+	proto.RegisterType((*ClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesRequest")
+	proto.RegisterType((*ClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableIncentivesResponse")
+	proto.RegisterType((*PoolAccumulatorRewardsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsRequest")
+	proto.RegisterType((*PoolAccumulatorRewardsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolAccumulatorRewardsResponse")
+	proto.RegisterType((*TickAccumulatorTrackersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersRequest")
+	proto.RegisterType((*TickAccumulatorTrackersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.TickAccumulatorTrackersResponse")
+	proto.RegisterType((*IncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsRequest")
+	proto.RegisterType((*IncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordsResponse")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdRequest")
+	proto.RegisterType((*CFMMPoolIdLinkFromConcentratedPoolIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CFMMPoolIdLinkFromConcentratedPoolIdResponse")
+	proto.RegisterType((*UserUnbondingPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsRequest")
+	proto.RegisterType((*UserUnbondingPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.UserUnbondingPositionsResponse")
+	proto.RegisterType((*GetTotalLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityRequest")
+	proto.RegisterType((*GetTotalLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.GetTotalLiquidityResponse")
+	proto.RegisterType((*NumNextInitializedTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksRequest")
+	proto.RegisterType((*NumNextInitializedTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.NumNextInitializedTicksResponse")
+	proto.RegisterType((*RoundingRemaindersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.RoundingRemaindersRequest")
+	proto.RegisterType((*RoundingRemaindersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.RoundingRemaindersResponse")
+	proto.RegisterType((*PositionWithLiquidityShare)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithLiquidityShare")
+	proto.RegisterType((*PositionsByPoolRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionsByPoolRequest")
+	proto.RegisterType((*PositionsByPoolResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionsByPoolResponse")
+	proto.RegisterType((*PositionValueInQuoteDenomRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionValueInQuoteDenomRequest")
+	proto.RegisterType((*PositionValueInQuoteDenomResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionValueInQuoteDenomResponse")
+	proto.RegisterType((*CreatePositionEstimateRequest)(nil), "osmosis.concentratedliquidity.v1beta1.CreatePositionEstimateRequest")
+	proto.RegisterType((*CreatePositionEstimateResponse)(nil), "osmosis.concentratedliquidity.v1beta1.CreatePositionEstimateResponse")
+	proto.RegisterType((*PoolSwapStatsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PoolSwapStatsRequest")
+	proto.RegisterType((*PoolSwapStatsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PoolSwapStatsResponse")
+	proto.RegisterType((*IncentiveRecordSlotsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordSlotsRequest")
+	proto.RegisterType((*IncentiveRecordSlotsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentiveRecordSlotsResponse")
+	proto.RegisterType((*UptimeIncentiveRecordSlots)(nil), "osmosis.concentratedliquidity.v1beta1.UptimeIncentiveRecordSlots")
+	proto.RegisterType((*PositionLiensRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionLiensRequest")
+	proto.RegisterType((*PositionLiensResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionLiensResponse")
+	proto.RegisterType((*ManagedPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ManagedPositionsRequest")
+	proto.RegisterType((*ManagedPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ManagedPositionsResponse")
+	proto.RegisterType((*IncentivesPreviewRequest)(nil), "osmosis.concentratedliquidity.v1beta1.IncentivesPreviewRequest")
+	proto.RegisterType((*IncentivesPreviewResponse)(nil), "osmosis.concentratedliquidity.v1beta1.IncentivesPreviewResponse")
+	proto.RegisterType((*PositionHistoryRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionHistoryRequest")
+	proto.RegisterType((*PositionHistoryResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionHistoryResponse")
+	proto.RegisterType((*PositionMigrationWindowsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.PositionMigrationWindowsRequest")
+	proto.RegisterType((*PositionMigrationWindowsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.PositionMigrationWindowsResponse")
+	proto.RegisterType((*VerifyPoolRequest)(nil), "osmosis.concentratedliquidity.v1beta1.VerifyPoolRequest")
+	proto.RegisterType((*VerifyPoolResponse)(nil), "osmosis.concentratedliquidity.v1beta1.VerifyPoolResponse")
+	proto.RegisterType((*StateDiscrepancy)(nil), "osmosis.concentratedliquidity.v1beta1.StateDiscrepancy")
+	proto.RegisterType((*OpenLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.OpenLimitOrdersRequest")
+	proto.RegisterType((*OpenLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.OpenLimitOrdersResponse")
+	proto.RegisterType((*ClaimableLimitOrdersRequest)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableLimitOrdersRequest")
+	proto.RegisterType((*ClaimableLimitOrdersResponse)(nil), "osmosis.concentratedliquidity.v1beta1.ClaimableLimitOrdersResponse")
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("osmosis/concentratedliquidity/v1beta1/query.proto", fileDescriptor_5da291368ba4d8e3)
 }
 
-func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_5da291368ba4d8e3 = []byte{
+	// 3981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xe5, 0x1c, 0x5b, 0x6c, 0x1c, 0x57,
+	0x95, 0x71, 0x6c, 0x27, 0xbe, 0x79, 0x38, 0xbe, 0x71, 0x1c, 0x7b, 0xf3, 0x70, 0x33, 0x90, 0xb6,
+	0x90, 0x66, 0xb7, 0xce, 0x83, 0x34, 0x71, 0x5e, 0xde, 0xf5, 0x23, 0x6e, 0x9d, 0xc4, 0x5e, 0x27,
+	0x29, 0xe2, 0x83, 0x61, 0xbc, 0x3b, 0x5e, 0x0f, 0x99, 0xdd, 0xd9, 0xec, 0xcc, 0xda, 0x71, 0x43,
+	0xa4, 0xaa, 0x15, 0x08, 0x89, 0x57, 0x0b, 0x7c, 0xf0, 0x51, 0x55, 0x02, 0x84, 0x84, 0x2a, 0x24,
+	0x7e, 0xf8, 0x01, 0x21, 0x21, 0x10, 0xa2, 0x2d, 0x1f, 0x55, 0x81, 0x22, 0xa1, 0x0a, 0xb5, 0xbc,
+	0x04, 0x85, 0x02, 0x42, 0x45, 0x42, 0x48, 0x48, 0x15, 0xe7, 0xde, 0x7b, 0xe6, 0xb1, 0xb3, 0xb3,
+	0xeb, 0x99, 0xd9, 0x94, 0x22, 0xf1, 0x61, 0xed, 0xcc, 0xdc, 0x7b, 0xce, 0x3d, 0xe7, 0xdc, 0x73,
+	0xcf, 0xe3, 0x9e, 0x93, 0x90, 0x31, 0xd3, 0x2a, 0x9b, 0x96, 0x6e, 0x65, 0x0a, 0x66, 0xa5, 0xa0,
+	0x55, 0xec, 0x9a, 0x6a, 0x6b, 0x45, 0x43, 0xbf, 0x59, 0xd7, 0x8b, 0xba, 0xbd, 0x9e, 0x59, 0x1d,
+	0x5b, 0xd2, 0x6c, 0x75, 0x2c, 0x73, 0xb3, 0xae, 0xd5, 0xd6, 0xd3, 0xd5, 0x9a, 0x69, 0x9b, 0xf4,
+	0x10, 0x82, 0xa4, 0x43, 0x41, 0xd2, 0x08, 0x92, 0x1a, 0x2c, 0x99, 0x25, 0x93, 0x43, 0x64, 0xd8,
+	0x93, 0x00, 0x4e, 0x7d, 0xa0, 0xfd, 0x7a, 0x55, 0xb5, 0xa6, 0x96, 0x2d, 0x9c, 0x7b, 0x3c, 0x1a,
+	0x6d, 0xb6, 0x5e, 0xb8, 0x31, 0x5b, 0x59, 0x76, 0x56, 0x38, 0x50, 0xe0, 0x60, 0x99, 0x25, 0xd5,
+	0xd2, 0xdc, 0x39, 0x05, 0x53, 0xaf, 0x38, 0x14, 0xf8, 0xc7, 0x39, 0x5f, 0xee, 0xac, 0xaa, 0x5a,
+	0xd2, 0x2b, 0xaa, 0xad, 0x9b, 0xce, 0xdc, 0x7d, 0x25, 0xd3, 0x2c, 0x19, 0x5a, 0x46, 0xad, 0xea,
+	0x19, 0xb5, 0x52, 0x31, 0x6d, 0x3e, 0xe8, 0xd0, 0x37, 0x82, 0xa3, 0xfc, 0x6d, 0xa9, 0xbe, 0x0c,
+	0x53, 0xd6, 0x9d, 0x21, 0xb1, 0x88, 0x22, 0xf8, 0x17, 0x2f, 0x38, 0x34, 0x1a, 0x84, 0xb2, 0xf5,
+	0xb2, 0x66, 0xd9, 0x6a, 0xb9, 0xea, 0x30, 0x10, 0x9c, 0x50, 0xac, 0xd7, 0xfc, 0x44, 0x45, 0x14,
+	0x4b, 0x15, 0xe6, 0xf8, 0xa0, 0xce, 0x44, 0x83, 0xd2, 0xf9, 0xa0, 0xbe, 0xaa, 0x29, 0x35, 0xad,
+	0x60, 0xd6, 0x8a, 0x08, 0x7d, 0x2a, 0xde, 0x9a, 0x8a, 0xa1, 0x6b, 0x31, 0x17, 0x2e, 0xab, 0x15,
+	0xb5, 0xa4, 0x15, 0x95, 0x64, 0x64, 0xbb, 0x0b, 0xaf, 0xe8, 0x96, 0x6d, 0x3a, 0xaa, 0x9a, 0x3a,
+	0x17, 0x13, 0xba, 0xac, 0x97, 0x1a, 0x44, 0x7d, 0x32, 0x1a, 0xbc, 0xa1, 0x97, 0x75, 0x5b, 0x01,
+	0x69, 0x69, 0x35, 0x01, 0x28, 0x7f, 0x47, 0x22, 0x83, 0xd7, 0x2c, 0xad, 0x36, 0x8f, 0x98, 0xad,
+	0xbc, 0x06, 0xaa, 0x66, 0xd9, 0xf4, 0x01, 0xb2, 0x59, 0x2d, 0x16, 0x6b, 0x9a, 0x65, 0x0d, 0x4b,
+	0xf7, 0x48, 0xf7, 0xf7, 0x65, 0xe9, 0x5b, 0xaf, 0x8d, 0xee, 0x58, 0x57, 0xcb, 0xc6, 0x69, 0x19,
+	0x07, 0xe4, 0xbc, 0x33, 0x85, 0x1e, 0x26, 0x9b, 0xab, 0xa6, 0x69, 0x28, 0x7a, 0x71, 0xb8, 0x0b,
+	0x66, 0x77, 0xfb, 0x67, 0xe3, 0x80, 0x9c, 0xef, 0x65, 0x4f, 0xb3, 0x45, 0x3a, 0x4d, 0x88, 0xa7,
+	0xc0, 0xc3, 0x9b, 0x60, 0xfe, 0xd6, 0xa3, 0xf7, 0xa6, 0x51, 0xf7, 0x98, 0xb6, 0xa7, 0xc5, 0x29,
+	0x46, 0xaa, 0xd3, 0xf3, 0x20, 0x6f, 0x24, 0x2b, 0xef, 0x83, 0x94, 0x7f, 0x28, 0x91, 0xdd, 0x01,
+	0xda, 0xad, 0x2a, 0xfc, 0x68, 0xf4, 0xa3, 0xa4, 0xcf, 0x11, 0x15, 0x23, 0x7f, 0x13, 0x2c, 0x70,
+	0x26, 0x1d, 0xc9, 0x1a, 0xa4, 0xa7, 0xeb, 0x86, 0xe1, 0x20, 0xcc, 0xd6, 0x34, 0xf5, 0x46, 0xd1,
+	0x5c, 0xab, 0x64, 0xbb, 0x5f, 0x78, 0x6d, 0xf4, 0x3d, 0x79, 0x0f, 0x29, 0x9d, 0x69, 0xe0, 0xa1,
+	0x8b, 0xf3, 0x70, 0xdf, 0x86, 0x3c, 0x08, 0xf2, 0x1a, 0x98, 0xb8, 0x4c, 0x76, 0xb9, 0xcb, 0xad,
+	0xcf, 0x16, 0x1d, 0xf1, 0x9f, 0x24, 0x5b, 0xdd, 0xcd, 0x06, 0xa1, 0x4a, 0x5c, 0xa8, 0x43, 0x20,
+	0x54, 0xea, 0x08, 0xd5, 0x1d, 0x94, 0x01, 0x1f, 0xbe, 0xcd, 0x16, 0xe5, 0x55, 0x32, 0xd8, 0x88,
+	0x0f, 0x45, 0xf2, 0x11, 0xb2, 0xc5, 0x99, 0xc5, 0xb1, 0xdd, 0x1d, 0x89, 0xb8, 0x38, 0xe5, 0xeb,
+	0x64, 0xdb, 0x3c, 0x6c, 0xaf, 0xab, 0x3f, 0xd3, 0x21, 0x02, 0x4a, 0xb2, 0xc9, 0x9f, 0x97, 0xc8,
+	0x76, 0x44, 0x8c, 0x9c, 0x9c, 0x20, 0x3d, 0x4c, 0x91, 0x9c, 0x8d, 0x1d, 0x4c, 0x0b, 0x33, 0x94,
+	0x76, 0xcc, 0x50, 0x7a, 0xa2, 0xb2, 0x9e, 0xed, 0xfb, 0xc9, 0xb7, 0x8f, 0xf4, 0x30, 0xb8, 0xd9,
+	0xbc, 0x98, 0x7d, 0xf7, 0x76, 0xac, 0x1f, 0x08, 0xe2, 0xd6, 0x1f, 0xc9, 0x95, 0xaf, 0x91, 0x1d,
+	0xce, 0x07, 0x24, 0x31, 0x47, 0x7a, 0x85, 0x83, 0x40, 0x51, 0x1f, 0xda, 0x40, 0xd4, 0x02, 0x1c,
+	0x65, 0x8a, 0xa0, 0xf2, 0x73, 0x12, 0xd9, 0x79, 0x15, 0x5c, 0xc6, 0x9c, 0x33, 0xed, 0xb2, 0x66,
+	0x83, 0x66, 0x6f, 0x77, 0xc1, 0x94, 0x8a, 0x66, 0xe3, 0xe1, 0x1c, 0x67, 0x90, 0xaf, 0xbe, 0x36,
+	0xba, 0x57, 0xf0, 0x63, 0x15, 0x6f, 0xa4, 0x75, 0x13, 0x4c, 0x95, 0xbd, 0x92, 0x9e, 0xd3, 0x4a,
+	0x6a, 0x61, 0x7d, 0x52, 0x2b, 0x80, 0xf2, 0x0c, 0x0a, 0xe5, 0x69, 0xc0, 0x20, 0xe7, 0xb7, 0x19,
+	0xfe, 0x15, 0x8e, 0x13, 0xc2, 0x1c, 0x95, 0xa2, 0x57, 0x8a, 0xda, 0x2d, 0x2e, 0xa7, 0x4d, 0xd9,
+	0xdd, 0x00, 0x3b, 0x20, 0x60, 0xbd, 0x31, 0x39, 0xdf, 0x27, 0x3c, 0x1a, 0x7b, 0xfe, 0xab, 0x44,
+	0xf6, 0xb8, 0x84, 0x4e, 0x6a, 0x55, 0x7b, 0xe5, 0x51, 0xdd, 0x5e, 0xc9, 0xab, 0x95, 0x92, 0x46,
+	0x97, 0xc9, 0x4e, 0x6f, 0x45, 0xb5, 0x6c, 0xd6, 0x2b, 0x77, 0x85, 0xec, 0x7e, 0xf7, 0x7d, 0x82,
+	0xe3, 0x64, 0x94, 0x1b, 0xe6, 0x9a, 0x56, 0x53, 0x18, 0x59, 0xcd, 0x94, 0x7b, 0x63, 0x40, 0x39,
+	0x7f, 0x61, 0xd2, 0x65, 0x50, 0xf5, 0x6a, 0xd5, 0x81, 0xda, 0x14, 0x84, 0xf2, 0xc6, 0x00, 0x8a,
+	0xbf, 0x30, 0x28, 0xf9, 0xf5, 0x2e, 0x72, 0xc0, 0xbf, 0x31, 0xb3, 0x95, 0x49, 0x1d, 0x1c, 0x11,
+	0x53, 0x10, 0xe7, 0x04, 0xf8, 0x6c, 0xa2, 0xb4, 0xa1, 0x4d, 0x4c, 0x93, 0x2d, 0xb6, 0x79, 0x43,
+	0x83, 0xf3, 0x2c, 0x74, 0xb3, 0x2f, 0xbb, 0x0b, 0x66, 0xf7, 0xa3, 0xcc, 0x71, 0x04, 0x0c, 0x2e,
+	0x7f, 0x9c, 0xad, 0x30, 0xaa, 0xc1, 0x15, 0xd7, 0xec, 0x16, 0x54, 0x7b, 0x63, 0x40, 0x35, 0x7f,
+	0xe1, 0xbc, 0x9e, 0x22, 0xdb, 0xea, 0x96, 0xa6, 0x14, 0xea, 0xc8, 0x6d, 0x37, 0xc0, 0x6d, 0xc9,
+	0xee, 0x01, 0xb8, 0x5d, 0xc8, 0xad, 0x6f, 0x14, 0xec, 0x0a, 0xbc, 0xe6, 0xea, 0xae, 0x98, 0x96,
+	0x40, 0xca, 0x45, 0x01, 0xd8, 0x13, 0x5c, 0xd0, 0x1b, 0x83, 0x05, 0xf9, 0x8b, 0x7f, 0xc1, 0x8a,
+	0xa9, 0xf0, 0x6f, 0xc3, 0xbd, 0x61, 0x0b, 0x3a, 0xa3, 0x62, 0xc1, 0xcb, 0x66, 0x96, 0xbf, 0x7c,
+	0x65, 0x13, 0x19, 0x6d, 0x29, 0x61, 0x3c, 0x67, 0x2b, 0x7e, 0xcd, 0x2a, 0x32, 0xad, 0x73, 0xac,
+	0xc2, 0xc9, 0x88, 0xc6, 0x2d, 0x78, 0xc0, 0xf0, 0x0c, 0x7a, 0xba, 0xc5, 0x75, 0xd9, 0xa2, 0x07,
+	0xc9, 0x36, 0x90, 0x4b, 0x0d, 0x10, 0xf9, 0xb4, 0x2b, 0xbf, 0x15, 0xbf, 0x71, 0x5e, 0x0d, 0x32,
+	0xe0, 0x4c, 0x71, 0xa1, 0xf9, 0xce, 0xf4, 0x65, 0xcf, 0x47, 0xd3, 0xf3, 0x61, 0x21, 0x93, 0x26,
+	0x2c, 0x72, 0x7e, 0x27, 0x7e, 0x73, 0x49, 0xa5, 0x4f, 0x48, 0x84, 0x3a, 0x13, 0xad, 0x9b, 0xb0,
+	0xd9, 0xd5, 0x9a, 0x5e, 0xd0, 0xf8, 0x8e, 0xf6, 0x65, 0xaf, 0xe2, 0x7a, 0x99, 0x12, 0x1c, 0xc2,
+	0xfa, 0x12, 0xc8, 0xa0, 0x9c, 0x41, 0x79, 0x1c, 0x31, 0xd4, 0x25, 0xcb, 0x79, 0xe1, 0xbf, 0x9c,
+	0x8c, 0xac, 0x5e, 0x12, 0x34, 0x8c, 0x34, 0xd2, 0xe0, 0xa1, 0xf6, 0x88, 0x58, 0x84, 0x6f, 0xf3,
+	0xfc, 0xd3, 0x23, 0x64, 0x9f, 0x4b, 0xd1, 0xbc, 0x38, 0x19, 0xfc, 0xc8, 0x27, 0x39, 0x02, 0xf2,
+	0xf7, 0x25, 0xb2, 0xbf, 0x05, 0x36, 0xdc, 0xee, 0x25, 0xd2, 0xe7, 0x49, 0x56, 0xec, 0xf3, 0xb9,
+	0x88, 0xfb, 0xdc, 0xc2, 0x36, 0x39, 0x8e, 0xdd, 0x05, 0xa0, 0xa7, 0xc9, 0xb6, 0xa5, 0x7a, 0xe1,
+	0x86, 0x66, 0x37, 0x18, 0x40, 0x9f, 0xc6, 0xfa, 0x47, 0xe5, 0xfc, 0x56, 0xf1, 0x2a, 0x8c, 0xe0,
+	0x87, 0xc8, 0xfe, 0x9c, 0xa1, 0xea, 0x65, 0x75, 0xc9, 0xd0, 0x16, 0xab, 0xe0, 0x2a, 0xc1, 0xfd,
+	0xae, 0xa9, 0xb5, 0xa2, 0xd5, 0xb1, 0x57, 0x7f, 0x56, 0x22, 0x07, 0x5a, 0xa1, 0x46, 0xe1, 0x7c,
+	0x9c, 0x0c, 0x17, 0x9c, 0x19, 0x8a, 0xc5, 0xa7, 0x40, 0x68, 0xcc, 0xe7, 0xa0, 0xac, 0x46, 0x1a,
+	0xbc, 0x9d, 0x23, 0x99, 0x1c, 0x64, 0x1c, 0xd9, 0xfb, 0x98, 0x18, 0x80, 0x8e, 0x51, 0xdc, 0xfd,
+	0x16, 0x88, 0xe4, 0xfc, 0x50, 0x21, 0x94, 0x0a, 0xf0, 0x81, 0x29, 0x97, 0xbe, 0x59, 0x27, 0x34,
+	0xef, 0x9c, 0xef, 0x27, 0xbb, 0xc8, 0xde, 0x50, 0xbc, 0xc8, 0xf4, 0x4d, 0x32, 0xe8, 0xd1, 0xea,
+	0xa6, 0x04, 0x11, 0x18, 0x7e, 0x2f, 0x32, 0xbc, 0x37, 0xc8, 0xb0, 0x87, 0x44, 0xce, 0xef, 0x2a,
+	0x34, 0x2f, 0xcd, 0x96, 0x5c, 0x36, 0x6b, 0xcb, 0x9a, 0x0e, 0x7a, 0xe6, 0x5f, 0xb2, 0x2b, 0xe6,
+	0x92, 0x61, 0x48, 0x60, 0x49, 0xf7, 0xb3, 0xb7, 0xa4, 0x3c, 0x47, 0xf6, 0xb3, 0x50, 0x66, 0xa2,
+	0x50, 0xa8, 0x97, 0xeb, 0x86, 0x0a, 0x79, 0x43, 0x40, 0xaf, 0x62, 0x9d, 0xb3, 0x1f, 0x80, 0xeb,
+	0x6a, 0x85, 0x0e, 0xc5, 0xfa, 0x94, 0x44, 0xf6, 0x36, 0xec, 0xbc, 0x52, 0xaa, 0x99, 0x6b, 0xf6,
+	0x8a, 0x52, 0x32, 0xcc, 0x25, 0xd5, 0x40, 0xf1, 0xee, 0x0b, 0xe5, 0x15, 0xcc, 0x08, 0x67, 0xf7,
+	0x18, 0x63, 0xf7, 0xb9, 0xd7, 0x47, 0x0f, 0xfb, 0x6c, 0x10, 0x66, 0xb4, 0xe2, 0xe7, 0x08, 0x98,
+	0xc1, 0x8c, 0xbd, 0x5e, 0xd5, 0x2c, 0x07, 0xc6, 0xca, 0x0f, 0x5b, 0x3e, 0xad, 0x9a, 0xe1, 0x6b,
+	0xce, 0xf0, 0x25, 0xe9, 0xa7, 0x21, 0x51, 0xa9, 0x57, 0x59, 0x0a, 0x1a, 0xa0, 0x45, 0xc8, 0xfd,
+	0x78, 0x44, 0x3b, 0x70, 0x8d, 0xa3, 0xb8, 0x5a, 0x53, 0xe1, 0xd4, 0xd6, 0x82, 0x5b, 0x12, 0x86,
+	0x5f, 0xce, 0x53, 0xf1, 0xd9, 0x4f, 0x8d, 0xfc, 0x24, 0x9c, 0x47, 0x66, 0x9f, 0x7c, 0x32, 0x44,
+	0x9c, 0x89, 0xf6, 0x24, 0x61, 0xd0, 0xf5, 0x66, 0x17, 0x19, 0x6d, 0x49, 0x05, 0x6e, 0xe5, 0x0b,
+	0x12, 0x39, 0x15, 0xba, 0x95, 0x66, 0x95, 0x9f, 0x33, 0x4d, 0x29, 0x3a, 0x6e, 0x55, 0x31, 0x97,
+	0x15, 0x43, 0xb5, 0xc0, 0xc3, 0xd5, 0xd4, 0x55, 0xc0, 0xf1, 0x4e, 0x6e, 0xf4, 0xd1, 0xe6, 0x8d,
+	0xbe, 0x82, 0x04, 0xb9, 0x6e, 0xfe, 0xca, 0xf2, 0x1c, 0x50, 0x73, 0xd5, 0x21, 0x86, 0xde, 0x21,
+	0xfd, 0xb8, 0x43, 0x36, 0x72, 0xd9, 0xd1, 0xe6, 0x1f, 0xc0, 0xcd, 0x1f, 0x6a, 0xd8, 0x7c, 0x07,
+	0xb5, 0x9c, 0xdf, 0x51, 0xf7, 0x4f, 0xb7, 0xe4, 0xcf, 0x41, 0x88, 0xeb, 0x1e, 0xca, 0x3c, 0xbf,
+	0x74, 0x48, 0xb6, 0xd9, 0x77, 0x2b, 0x35, 0x7a, 0x49, 0x22, 0xc3, 0xcd, 0x04, 0xe1, 0xbe, 0xeb,
+	0x64, 0x20, 0x78, 0x45, 0xe2, 0x98, 0xc5, 0x0f, 0x46, 0x14, 0x57, 0x00, 0x37, 0xfa, 0xca, 0x9d,
+	0x7a, 0x60, 0xc9, 0xbb, 0x97, 0x59, 0x3d, 0x2e, 0x91, 0xc3, 0xb9, 0xe9, 0x4b, 0x97, 0x78, 0xde,
+	0x56, 0x9c, 0xd3, 0x2b, 0x37, 0xa6, 0x6b, 0x66, 0x39, 0xe7, 0x23, 0x52, 0x8c, 0x38, 0x52, 0x5f,
+	0x00, 0xeb, 0xef, 0x1b, 0x54, 0x1a, 0xb7, 0x60, 0xd4, 0x67, 0xde, 0x43, 0x66, 0xc1, 0xc1, 0x2e,
+	0x34, 0x61, 0x96, 0x75, 0xf2, 0x40, 0x34, 0x0a, 0x50, 0xcc, 0x10, 0xe0, 0x16, 0x96, 0xcb, 0xe5,
+	0xc0, 0xd2, 0xbe, 0x70, 0xc1, 0x3f, 0x0a, 0xbe, 0x8d, 0xbd, 0xe2, 0x52, 0x97, 0xc8, 0x7e, 0x76,
+	0x7b, 0x71, 0xad, 0xb2, 0x64, 0x56, 0x8a, 0x7a, 0xa5, 0xd4, 0xd9, 0x15, 0x8c, 0xfc, 0x35, 0x30,
+	0x49, 0xad, 0xf0, 0x21, 0xb1, 0x20, 0xdf, 0x94, 0x7b, 0x85, 0xa1, 0xac, 0xc1, 0x71, 0x55, 0x20,
+	0x9f, 0xd1, 0xcd, 0xa2, 0x62, 0x98, 0x10, 0xd3, 0x0a, 0xed, 0x38, 0x1b, 0x51, 0x3b, 0x1c, 0xf4,
+	0x2c, 0x96, 0x9a, 0xe7, 0x58, 0xe6, 0x00, 0x09, 0x2a, 0xc9, 0x1e, 0x77, 0x99, 0xc6, 0x61, 0x39,
+	0x45, 0x86, 0x67, 0x34, 0xfb, 0xaa, 0x69, 0xab, 0x86, 0x1b, 0x92, 0x39, 0x79, 0xf4, 0xd3, 0x12,
+	0x19, 0x09, 0x19, 0x44, 0xe2, 0x6d, 0xd2, 0x6f, 0xb3, 0x11, 0x25, 0x18, 0x02, 0xb6, 0x71, 0xb9,
+	0x0f, 0xa2, 0x69, 0xba, 0x3f, 0x82, 0x69, 0x12, 0x76, 0x69, 0x87, 0xdd, 0xb0, 0xba, 0xfc, 0x16,
+	0x48, 0xf5, 0x72, 0xbd, 0x7c, 0x59, 0xbb, 0x05, 0x31, 0x1e, 0x70, 0xa4, 0x1a, 0xfa, 0x63, 0x1a,
+	0xcf, 0x6d, 0x92, 0x9d, 0xfd, 0xf3, 0x64, 0x87, 0x93, 0xcd, 0x41, 0xc2, 0x52, 0x31, 0xcb, 0x98,
+	0xed, 0x8d, 0x00, 0xcc, 0xee, 0xc6, 0x6c, 0x4f, 0x8c, 0x43, 0x7a, 0x8e, 0x39, 0xdf, 0x24, 0x7b,
+	0x85, 0x18, 0x38, 0x55, 0xa9, 0x97, 0x21, 0x03, 0xbe, 0xc5, 0x62, 0x50, 0x97, 0x22, 0x9e, 0x95,
+	0x58, 0x3c, 0xdd, 0xe8, 0xce, 0x1e, 0x02, 0x64, 0x07, 0x05, 0xb2, 0xd6, 0x73, 0xe5, 0xfc, 0x9e,
+	0x4a, 0x38, 0x63, 0xf2, 0x33, 0xe0, 0x57, 0x5a, 0x32, 0xfd, 0x7f, 0x9f, 0x7a, 0xc9, 0x17, 0xc9,
+	0x48, 0x9e, 0xa5, 0xa8, 0x70, 0xc6, 0xf2, 0x5a, 0x59, 0x65, 0x7e, 0x39, 0x99, 0xdb, 0x97, 0xbf,
+	0x0e, 0x07, 0x32, 0x0c, 0x15, 0xca, 0xf8, 0x93, 0x12, 0x21, 0x35, 0xf7, 0x73, 0x24, 0x67, 0x7c,
+	0x11, 0x9d, 0x1a, 0x06, 0x0e, 0x1e, 0xb4, 0x1c, 0xd7, 0x43, 0xfb, 0x56, 0x66, 0x61, 0x78, 0xca,
+	0x7f, 0xde, 0x5d, 0x59, 0x2c, 0xae, 0xa8, 0x35, 0x0d, 0xec, 0x70, 0xf0, 0x6e, 0x31, 0x13, 0xd3,
+	0x88, 0x04, 0xaf, 0x13, 0xd9, 0x7d, 0x08, 0x9c, 0x80, 0x1a, 0xcb, 0xd1, 0xf8, 0x86, 0x6f, 0xf1,
+	0xdf, 0x87, 0x38, 0x23, 0x60, 0xfd, 0xf4, 0x8a, 0xb8, 0x63, 0x5a, 0x22, 0x9e, 0xde, 0x28, 0x16,
+	0xa3, 0x0a, 0xf7, 0xff, 0xd4, 0xc6, 0x7b, 0x3f, 0x14, 0xbc, 0x5e, 0xe2, 0xf0, 0x10, 0x00, 0x18,
+	0x0d, 0x6c, 0xca, 0x9f, 0x95, 0xc8, 0x90, 0x6b, 0x54, 0xb3, 0xeb, 0xcc, 0x8c, 0xbf, 0xab, 0xfe,
+	0xff, 0x45, 0x08, 0x48, 0x9a, 0xe8, 0x41, 0xd5, 0xd1, 0x9a, 0x6f, 0xc0, 0x27, 0x12, 0x18, 0xf6,
+	0xc6, 0x8d, 0x7e, 0x07, 0xaf, 0xc1, 0xbf, 0x24, 0x91, 0x7b, 0x9c, 0x85, 0xaf, 0xab, 0x46, 0x1d,
+	0x32, 0xae, 0x85, 0xba, 0x09, 0xc1, 0x20, 0x33, 0x7a, 0x9d, 0xa6, 0x91, 0x0c, 0xf0, 0x26, 0xc3,
+	0xd6, 0x60, 0x72, 0x7d, 0x80, 0xbe, 0x41, 0x00, 0xbc, 0xe9, 0x2e, 0x2c, 0xbf, 0x2d, 0x91, 0x83,
+	0x6d, 0xc8, 0x42, 0x61, 0x5f, 0x24, 0xbd, 0xaa, 0x65, 0x69, 0xf6, 0x83, 0xa8, 0xfd, 0x6d, 0x3c,
+	0xd2, 0x6e, 0x3c, 0x9f, 0xdb, 0xd1, 0x8d, 0x73, 0x30, 0x50, 0x0d, 0xf1, 0xe0, 0x62, 0x1a, 0x43,
+	0x59, 0xc6, 0xc4, 0x34, 0xe6, 0x60, 0x1a, 0xa3, 0x53, 0xa4, 0x67, 0x95, 0x11, 0x8c, 0xf5, 0x95,
+	0x36, 0x88, 0x06, 0x11, 0xd1, 0x36, 0x81, 0x88, 0x43, 0xc9, 0x79, 0x01, 0x2d, 0xbf, 0xd8, 0x45,
+	0xf6, 0xe7, 0x20, 0x52, 0xb7, 0x35, 0x47, 0x0c, 0x53, 0x16, 0x44, 0xc5, 0xf0, 0x9e, 0x34, 0xcf,
+	0xf9, 0x6f, 0x5d, 0xd1, 0x52, 0x88, 0xd7, 0xfb, 0xb9, 0xeb, 0xe4, 0xd5, 0xcd, 0x55, 0xbd, 0xa8,
+	0x15, 0x87, 0xbb, 0x37, 0x8a, 0x18, 0x1e, 0x6e, 0x4c, 0x0a, 0x02, 0xf0, 0x72, 0xdc, 0x58, 0x82,
+	0x41, 0xcf, 0x3b, 0xc0, 0x8f, 0x77, 0x93, 0x03, 0xad, 0x64, 0x89, 0x9a, 0x34, 0x05, 0x21, 0x1f,
+	0xbf, 0xcc, 0x7e, 0x10, 0x43, 0xbe, 0xc3, 0x60, 0xbe, 0x76, 0x37, 0x9b, 0xaf, 0xd9, 0x8a, 0xed,
+	0x8b, 0x05, 0x05, 0x04, 0x8b, 0x05, 0xc5, 0x93, 0x87, 0x66, 0x0c, 0x75, 0x3d, 0x3a, 0x9a, 0x31,
+	0x17, 0xcd, 0x18, 0xf8, 0xf8, 0x01, 0xcf, 0x28, 0x16, 0x38, 0xe5, 0x45, 0x34, 0xab, 0xe3, 0x91,
+	0x5d, 0x6a, 0x13, 0x06, 0x70, 0xa9, 0xee, 0x37, 0x21, 0x8e, 0xa0, 0x5e, 0x74, 0x27, 0xd2, 0x8b,
+	0x9e, 0x88, 0x7a, 0xf1, 0x18, 0xd9, 0x62, 0x68, 0xcb, 0xb6, 0x09, 0x59, 0xe5, 0x70, 0xef, 0x46,
+	0xfa, 0x90, 0x43, 0x7d, 0x40, 0xcf, 0xe3, 0x00, 0xc6, 0x53, 0x04, 0x77, 0x3d, 0x39, 0xc7, 0xaa,
+	0x73, 0xa6, 0xb1, 0xb8, 0xa6, 0x56, 0x17, 0x6d, 0xd5, 0x4e, 0x16, 0x35, 0x3c, 0xdf, 0x45, 0x76,
+	0x07, 0xb0, 0xa0, 0xfa, 0x3c, 0x21, 0x91, 0xad, 0x16, 0x7c, 0x55, 0x56, 0x4d, 0xa3, 0x5e, 0xd6,
+	0x36, 0x0e, 0x90, 0xa7, 0x91, 0x3d, 0xb4, 0x83, 0x3e, 0xd8, 0x78, 0x1c, 0x12, 0x06, 0x79, 0x9d,
+	0x03, 0xd2, 0x6f, 0x40, 0x5a, 0xda, 0x78, 0x6d, 0xa8, 0x14, 0x4c, 0xc3, 0x80, 0x9c, 0x5e, 0x2b,
+	0x6e, 0x7c, 0x4b, 0xb6, 0xd8, 0x78, 0x13, 0xd9, 0x0a, 0x51, 0x3c, 0xf2, 0x86, 0xfc, 0xb7, 0x0d,
+	0x56, 0xce, 0x45, 0xf2, 0x30, 0xd9, 0x1b, 0x48, 0x72, 0x17, 0x0d, 0x33, 0xe1, 0xae, 0x7c, 0xa1,
+	0x8b, 0xec, 0x0b, 0x47, 0x86, 0x9b, 0x03, 0xd9, 0xaa, 0x08, 0xa9, 0x20, 0xd8, 0x13, 0x19, 0xa1,
+	0xc5, 0xc6, 0x9b, 0xb3, 0xd5, 0xb0, 0x59, 0x90, 0xad, 0xba, 0x9f, 0xf9, 0xde, 0xb3, 0x8f, 0xf4,
+	0x59, 0x88, 0x48, 0xbc, 0xd9, 0x78, 0x83, 0x21, 0xb0, 0x76, 0xc5, 0xf2, 0xf9, 0xe2, 0x66, 0x24,
+	0x8c, 0xfc, 0xec, 0x21, 0xdc, 0x90, 0xfd, 0x41, 0xe2, 0xfc, 0xcb, 0xc9, 0x79, 0x8f, 0x37, 0x81,
+	0x8b, 0x03, 0xcb, 0xdf, 0x82, 0x00, 0xb7, 0x35, 0x6e, 0x3a, 0x47, 0x7a, 0x05, 0x16, 0xd7, 0x71,
+	0x06, 0x6b, 0xb9, 0x93, 0xd8, 0x52, 0x92, 0x1d, 0x69, 0x74, 0x77, 0x02, 0x4c, 0xfe, 0xf2, 0xeb,
+	0xa3, 0x52, 0x1e, 0x71, 0xd0, 0x1c, 0xe9, 0xf7, 0xa8, 0x73, 0xa4, 0xc0, 0x64, 0x9b, 0xf2, 0x0c,
+	0x7a, 0x60, 0x02, 0x04, 0x79, 0xee, 0x17, 0x41, 0xf1, 0x25, 0xaf, 0x7e, 0x3e, 0xa7, 0x6b, 0x5e,
+	0x32, 0x7e, 0x02, 0x2c, 0x14, 0xbc, 0xaf, 0x98, 0x06, 0x44, 0xc4, 0x68, 0x9c, 0xfd, 0x16, 0xca,
+	0x1d, 0x83, 0x00, 0xc2, 0xf7, 0x72, 0x8b, 0x1d, 0xd5, 0x06, 0x74, 0xa8, 0x0d, 0x0a, 0xe9, 0x61,
+	0xd3, 0x9c, 0xe0, 0xec, 0x58, 0xcc, 0xe0, 0x8c, 0x21, 0x0b, 0x7a, 0x6e, 0x8e, 0x0f, 0x3c, 0xb7,
+	0xf8, 0x9d, 0x20, 0x7b, 0x2e, 0x89, 0x56, 0x95, 0xa6, 0x8b, 0x85, 0x7b, 0x49, 0x8f, 0xb9, 0x56,
+	0x71, 0xd9, 0xd8, 0xe9, 0xa1, 0xe0, 0x9f, 0x01, 0x85, 0xf8, 0xfd, 0x2a, 0x9c, 0xe4, 0x66, 0x1c,
+	0xc8, 0xc0, 0x27, 0x24, 0x32, 0x10, 0xec, 0x85, 0x89, 0x7b, 0xc3, 0x14, 0x40, 0x9e, 0xbd, 0x07,
+	0x19, 0x42, 0xd7, 0xd1, 0x84, 0x1e, 0x5c, 0x47, 0x39, 0x40, 0x8f, 0xfc, 0x4a, 0x97, 0xef, 0x16,
+	0x0c, 0x9c, 0xad, 0xb6, 0xaa, 0x6b, 0x6b, 0xff, 0xf3, 0xc1, 0xc9, 0x82, 0xbf, 0x94, 0x25, 0x8a,
+	0x76, 0xc7, 0x36, 0x76, 0xa9, 0x3b, 0x03, 0x2e, 0x55, 0xf6, 0x57, 0xae, 0xbc, 0xc3, 0xd4, 0xd3,
+	0xf9, 0x61, 0x92, 0xff, 0x2c, 0x91, 0x91, 0x10, 0xb1, 0xe2, 0xe6, 0x3f, 0x23, 0x11, 0xea, 0x95,
+	0x2d, 0xd8, 0x2d, 0x92, 0x52, 0x54, 0xd7, 0x23, 0x65, 0xa8, 0xf3, 0xb8, 0xf6, 0x88, 0x93, 0xcb,
+	0x05, 0xb1, 0xc4, 0xce, 0x54, 0xbd, 0x1b, 0x49, 0x6b, 0x5e, 0xab, 0x4d, 0xaa, 0xeb, 0x71, 0xb3,
+	0x47, 0x79, 0xc1, 0x4b, 0xec, 0x2e, 0x8a, 0xbe, 0xac, 0x8e, 0x2b, 0x57, 0x9f, 0xf2, 0x25, 0x67,
+	0x2e, 0x4e, 0x94, 0x5e, 0x99, 0x6c, 0x66, 0x67, 0x42, 0x77, 0x0b, 0x55, 0xe3, 0x31, 0x4f, 0x3f,
+	0x22, 0x9c, 0x82, 0x89, 0xeb, 0xd9, 0x21, 0x14, 0x28, 0xaa, 0x35, 0x62, 0x06, 0xee, 0x9c, 0xa7,
+	0x83, 0x64, 0xd4, 0x01, 0xbc, 0xe4, 0xf4, 0x8d, 0x3d, 0x0a, 0x99, 0xbd, 0xb9, 0xe6, 0xb6, 0xb0,
+	0xf8, 0xd3, 0xaf, 0xe6, 0x39, 0x48, 0x76, 0x95, 0x6c, 0x5e, 0x13, 0x9f, 0x62, 0x16, 0x5f, 0x5b,
+	0x60, 0x0e, 0x52, 0x8e, 0xc8, 0x81, 0x72, 0xe7, 0xe9, 0x02, 0x19, 0xb8, 0xae, 0xd5, 0xf4, 0xe5,
+	0xc4, 0xb9, 0xb6, 0xfc, 0x3d, 0x50, 0x54, 0x3f, 0x0a, 0x64, 0xe5, 0xfd, 0xa4, 0x77, 0x45, 0xd3,
+	0x4b, 0x2b, 0xa2, 0x11, 0x65, 0x53, 0x76, 0xc0, 0x3b, 0x0c, 0xe2, 0x3b, 0x60, 0x10, 0x0f, 0xf4,
+	0x36, 0xd9, 0x5e, 0xd4, 0x2d, 0x88, 0x5d, 0xab, 0x6a, 0xa5, 0xa0, 0xbb, 0x85, 0xbe, 0xa8, 0xb7,
+	0x5c, 0x2c, 0x40, 0xd3, 0x26, 0x5d, 0x04, 0xeb, 0xd9, 0x7d, 0xc8, 0x34, 0x36, 0xb5, 0x34, 0xe0,
+	0x96, 0xf3, 0x8d, 0x6b, 0xc9, 0x3f, 0x92, 0xc8, 0xce, 0x20, 0x06, 0x66, 0xbe, 0x0b, 0x2b, 0x1a,
+	0xbf, 0xb0, 0x0d, 0x98, 0x6f, 0xfe, 0x19, 0xcc, 0x37, 0xff, 0x65, 0xf7, 0xc7, 0x56, 0x7d, 0xe9,
+	0x63, 0x10, 0xea, 0x60, 0x16, 0xe0, 0x13, 0x14, 0x0e, 0x80, 0xac, 0xf1, 0x89, 0x66, 0xc8, 0x16,
+	0xed, 0x56, 0x55, 0x44, 0x69, 0x9b, 0x82, 0x1d, 0x28, 0xce, 0x88, 0x9c, 0x77, 0x27, 0x31, 0x19,
+	0xaa, 0x05, 0xbb, 0xae, 0x1a, 0x68, 0xbf, 0x7c, 0x32, 0x14, 0xdf, 0x59, 0x32, 0x2a, 0x1e, 0xca,
+	0x64, 0xe8, 0x4a, 0x55, 0x03, 0xa7, 0x55, 0xd6, 0xed, 0x2b, 0xb5, 0xa4, 0xd7, 0x65, 0x9e, 0xdf,
+	0xea, 0x6a, 0xef, 0xb7, 0x3e, 0x03, 0x67, 0xaf, 0x69, 0x3d, 0xb7, 0x62, 0xbc, 0xcd, 0xd7, 0x05,
+	0xe9, 0x68, 0xf2, 0x58, 0xe4, 0x36, 0x02, 0x07, 0x63, 0x76, 0x2f, 0xee, 0xe3, 0x2e, 0xc7, 0x26,
+	0x7b, 0x48, 0xe5, 0xfc, 0x56, 0xc3, 0x5b, 0x5a, 0x9e, 0xf2, 0xd5, 0xb0, 0x43, 0x44, 0x10, 0xd5,
+	0x1b, 0x3f, 0x2d, 0x91, 0x7d, 0xe1, 0x78, 0xde, 0x35, 0xd6, 0x8e, 0xbe, 0x71, 0x8c, 0xf4, 0x2c,
+	0xb0, 0x1b, 0x1e, 0x16, 0xf5, 0xf3, 0x7e, 0x3b, 0x8b, 0x46, 0x0f, 0x65, 0xbc, 0x76, 0xc1, 0xd4,
+	0xf1, 0x78, 0x40, 0x82, 0x63, 0xf9, 0xf8, 0x13, 0x3f, 0xff, 0xfd, 0x17, 0xbb, 0xd2, 0xf4, 0x81,
+	0x4c, 0xd4, 0xfe, 0x59, 0x46, 0xe0, 0x37, 0x25, 0xd2, 0x2b, 0x3a, 0xee, 0x68, 0xe4, 0x65, 0xfd,
+	0x0d, 0x7f, 0xa9, 0x13, 0x31, 0xa1, 0x90, 0xda, 0x13, 0x9c, 0xda, 0x0c, 0x3d, 0x12, 0x95, 0x5a,
+	0x41, 0xe3, 0x4b, 0x12, 0xd9, 0xde, 0xd0, 0xe6, 0x4a, 0xa3, 0xba, 0x8b, 0xb0, 0xc6, 0xde, 0xd4,
+	0x99, 0x64, 0xc0, 0xc8, 0x43, 0x96, 0xf3, 0x70, 0x86, 0x9e, 0xce, 0xc4, 0xeb, 0x58, 0xb6, 0x32,
+	0xb7, 0xb1, 0x50, 0x75, 0x87, 0xbe, 0x29, 0x91, 0xdd, 0xa1, 0x8d, 0x3e, 0x34, 0x17, 0xb7, 0x9b,
+	0x27, 0xa4, 0xe9, 0x28, 0x35, 0xd9, 0x19, 0x12, 0x64, 0x74, 0x86, 0x33, 0x3a, 0x41, 0xcf, 0x67,
+	0xa2, 0xb6, 0x56, 0x3b, 0xd7, 0x1c, 0x4e, 0xbc, 0x27, 0xa2, 0x0c, 0xfa, 0x0f, 0x7f, 0x67, 0x64,
+	0x63, 0x1f, 0x1b, 0x9d, 0x8a, 0x4b, 0x6a, 0x68, 0xa7, 0x61, 0x6a, 0xba, 0x53, 0x34, 0xc8, 0xf3,
+	0x2c, 0xe7, 0x39, 0x47, 0x27, 0x62, 0xf3, 0x5c, 0xe1, 0x1d, 0x51, 0x5e, 0x2b, 0x01, 0xfd, 0x1b,
+	0x64, 0xa6, 0xe1, 0x0d, 0x4b, 0x34, 0xea, 0xfe, 0xb4, 0x6d, 0xa5, 0x4a, 0x4d, 0x75, 0x88, 0x25,
+	0xe1, 0x36, 0xb7, 0xea, 0x8c, 0xa2, 0xbf, 0x91, 0xc8, 0xae, 0x90, 0x4e, 0x25, 0x3a, 0x11, 0x97,
+	0xce, 0xa6, 0xee, 0xa9, 0x54, 0xb6, 0x13, 0x14, 0xc8, 0x67, 0x8e, 0xf3, 0x79, 0x96, 0x8e, 0xc7,
+	0xe6, 0xd3, 0x0b, 0xae, 0xe9, 0x8f, 0x25, 0xd6, 0xe4, 0xed, 0x35, 0x97, 0xd3, 0xd3, 0x71, 0xcb,
+	0x3c, 0x5e, 0x87, 0x7b, 0x6a, 0x3c, 0x11, 0x2c, 0xb2, 0x73, 0x96, 0xb3, 0x73, 0x92, 0x9e, 0x88,
+	0x69, 0x86, 0x94, 0xa5, 0x75, 0x88, 0x28, 0xe8, 0x1b, 0xbc, 0x92, 0x13, 0xd6, 0x02, 0x15, 0x59,
+	0x3b, 0xdb, 0x36, 0x64, 0x45, 0xd6, 0xce, 0xf6, 0x7d, 0x58, 0xf2, 0x04, 0x67, 0x73, 0x9c, 0x9e,
+	0x8a, 0xe1, 0xdf, 0x14, 0x95, 0xe1, 0x73, 0xf5, 0xf2, 0x17, 0x10, 0x41, 0x06, 0x9b, 0x44, 0xe8,
+	0xb9, 0x64, 0x1d, 0x20, 0x2e, 0x7b, 0xe7, 0x13, 0xc3, 0x23, 0x63, 0x17, 0x38, 0x63, 0xa7, 0xe9,
+	0x43, 0x99, 0x64, 0xff, 0xda, 0xc7, 0xa2, 0x7f, 0x01, 0xb3, 0xda, 0xa2, 0xf7, 0x29, 0xb2, 0x59,
+	0x6d, 0xdf, 0xc1, 0x15, 0xd9, 0xac, 0x6e, 0xd0, 0x82, 0x15, 0xdb, 0x67, 0x72, 0xe7, 0x21, 0x76,
+	0xd1, 0xe9, 0x46, 0xa2, 0xdf, 0xed, 0x22, 0xef, 0x8b, 0xd2, 0x98, 0x42, 0xf3, 0x51, 0x8d, 0x45,
+	0xf4, 0x3e, 0x9b, 0xd4, 0xe2, 0x5d, 0xc5, 0x89, 0x52, 0xd1, 0xb9, 0x54, 0x0a, 0x54, 0x8d, 0x6a,
+	0x91, 0x7c, 0x8d, 0x34, 0x8a, 0x01, 0xf8, 0x95, 0x65, 0x58, 0x40, 0xf1, 0x03, 0x65, 0x6e, 0x87,
+	0x35, 0xfa, 0xdc, 0xa1, 0xff, 0x82, 0xe3, 0x1e, 0xde, 0x1a, 0x13, 0xf9, 0xb8, 0xb7, 0xed, 0xd4,
+	0x89, 0x7c, 0xdc, 0xdb, 0xf7, 0xe7, 0xc8, 0x0b, 0x5c, 0x24, 0x8f, 0xd0, 0xd9, 0x88, 0x22, 0xa9,
+	0x03, 0x3a, 0xa5, 0xee, 0xe0, 0x53, 0xc2, 0x62, 0xad, 0x57, 0x25, 0x32, 0xd0, 0xd4, 0x53, 0x43,
+	0xa3, 0x9e, 0xdf, 0x56, 0xad, 0x3a, 0xa9, 0x0b, 0xc9, 0x11, 0x24, 0x3c, 0x14, 0x25, 0x88, 0x30,
+	0x02, 0xfd, 0x3f, 0x3c, 0xb4, 0x6a, 0xd1, 0xa7, 0x12, 0xd9, 0x06, 0xb4, 0x6f, 0xee, 0x89, 0x6c,
+	0x03, 0x36, 0x68, 0x97, 0x89, 0x1d, 0x5a, 0xb5, 0xee, 0xdb, 0xa1, 0x7f, 0x94, 0x08, 0x6d, 0x6e,
+	0x1a, 0xa1, 0x51, 0xb7, 0xa4, 0x65, 0xeb, 0x4a, 0x6a, 0xa2, 0x03, 0x0c, 0xc8, 0xe6, 0x1c, 0x67,
+	0x73, 0x9a, 0x4e, 0x46, 0x64, 0xb3, 0x86, 0xa8, 0x14, 0xaf, 0xd9, 0x24, 0x73, 0xdb, 0x3d, 0xb7,
+	0xbf, 0x92, 0x48, 0x7f, 0xa0, 0xc1, 0x81, 0xc6, 0x6d, 0x4f, 0x6b, 0x6c, 0xd4, 0x48, 0x9d, 0x4b,
+	0x0a, 0x8e, 0x0c, 0x3e, 0xcc, 0x19, 0x9c, 0xa4, 0xd9, 0xb8, 0xf9, 0x0f, 0x8b, 0x3c, 0x18, 0x63,
+	0x3e, 0xf6, 0xfe, 0x2d, 0x91, 0x91, 0x96, 0xcd, 0x05, 0x74, 0x26, 0x26, 0xa5, 0xad, 0xba, 0x26,
+	0x52, 0x17, 0x3b, 0x47, 0x84, 0xcc, 0x3f, 0xc2, 0x99, 0x9f, 0xa2, 0xb9, 0xb8, 0x51, 0x17, 0xef,
+	0x25, 0x60, 0x9c, 0xbb, 0x97, 0xa5, 0x77, 0xe8, 0xdb, 0x2c, 0x43, 0x08, 0xad, 0x86, 0x47, 0xcf,
+	0x10, 0xda, 0x35, 0x26, 0x44, 0xcf, 0x10, 0xda, 0x96, 0xe4, 0xe5, 0x47, 0x39, 0xd3, 0x0b, 0xf4,
+	0x4a, 0x9c, 0x3b, 0x06, 0x6f, 0x97, 0x33, 0xa2, 0xea, 0xed, 0x1a, 0x67, 0x45, 0x73, 0xb8, 0x7c,
+	0x05, 0xff, 0x65, 0xa3, 0x5b, 0xc6, 0xa5, 0xe3, 0x31, 0xa2, 0xc6, 0x60, 0x09, 0x39, 0x72, 0x5e,
+	0x1f, 0x5a, 0x39, 0x96, 0x2f, 0x72, 0x2e, 0xb3, 0xf4, 0x42, 0x9c, 0x48, 0x93, 0x97, 0x8b, 0x2d,
+	0x86, 0xc7, 0xa7, 0xd5, 0x7f, 0x97, 0xc8, 0x60, 0x68, 0xb1, 0x2f, 0x9b, 0x2c, 0x68, 0xf4, 0x57,
+	0x64, 0x53, 0xb9, 0x8e, 0x70, 0x20, 0xaf, 0x57, 0x38, 0xaf, 0xb3, 0x74, 0x26, 0x61, 0xf0, 0x29,
+	0x4a, 0x87, 0x3e, 0x96, 0x5f, 0xe4, 0x3b, 0xe9, 0xab, 0xf2, 0xd1, 0xf1, 0x04, 0xe5, 0xbc, 0x04,
+	0x3b, 0x19, 0x52, 0x58, 0x4c, 0x9e, 0x1a, 0xf1, 0xb2, 0x21, 0xcf, 0x17, 0x82, 0x35, 0xbf, 0xc8,
+	0xf9, 0x42, 0x8b, 0x82, 0x63, 0xe4, 0x7c, 0xa1, 0x55, 0xb1, 0x31, 0x76, 0xbe, 0xd0, 0x54, 0x39,
+	0xa4, 0x7f, 0x82, 0x40, 0xa8, 0xa9, 0x9e, 0x45, 0x63, 0x27, 0x32, 0x81, 0x02, 0x63, 0xe4, 0x40,
+	0xa8, 0x65, 0x29, 0x2d, 0x76, 0xd0, 0x17, 0xb4, 0x2f, 0xfe, 0x02, 0x1a, 0x72, 0xf5, 0x53, 0x9f,
+	0xdf, 0xc4, 0x52, 0x51, 0x6c, 0xbf, 0xd9, 0x58, 0x07, 0x8b, 0xed, 0x37, 0x03, 0x25, 0x2f, 0xf9,
+	0x3c, 0xe7, 0xf2, 0x14, 0x3d, 0x99, 0x49, 0xf6, 0xff, 0x24, 0xd0, 0x7f, 0x4a, 0x64, 0xb8, 0x55,
+	0x85, 0x8a, 0x4e, 0x77, 0x56, 0x88, 0x72, 0xf5, 0x74, 0xa6, 0x63, 0x3c, 0x09, 0xc3, 0xbd, 0xe6,
+	0xff, 0xd8, 0x41, 0xc1, 0x1a, 0x18, 0x7d, 0x5e, 0x22, 0xc4, 0xab, 0x60, 0xd1, 0x87, 0x22, 0x92,
+	0xd8, 0x54, 0x37, 0x4b, 0x9d, 0x4a, 0x00, 0x89, 0xec, 0x4c, 0x72, 0x76, 0xce, 0xd1, 0x33, 0x11,
+	0xd9, 0x59, 0xe5, 0x28, 0x82, 0xf1, 0xce, 0xcf, 0x40, 0x2d, 0x03, 0x65, 0x99, 0xc8, 0x6a, 0x19,
+	0x5e, 0x3e, 0x8a, 0xac, 0x96, 0x2d, 0xaa, 0x41, 0xb1, 0xed, 0x8a, 0x09, 0x78, 0x14, 0x7f, 0x3d,
+	0x84, 0xfe, 0x01, 0xdc, 0x5d, 0x58, 0x55, 0x86, 0xc6, 0xbe, 0xb5, 0x0b, 0x61, 0x2f, 0xd7, 0x11,
+	0x0e, 0xe4, 0x71, 0x8a, 0xf3, 0x78, 0x9e, 0x9e, 0x8d, 0x7d, 0xf5, 0xe7, 0x67, 0x34, 0xbb, 0xf2,
+	0xc2, 0x6f, 0x0f, 0x48, 0x2f, 0xc3, 0xdf, 0xaf, 0xe1, 0xef, 0xa9, 0xdf, 0x1d, 0x78, 0xcf, 0xcb,
+	0xf0, 0xf7, 0x4b, 0xf8, 0xfb, 0xf0, 0xe5, 0x8d, 0xfe, 0x95, 0xf1, 0xea, 0xd1, 0xb1, 0xcc, 0xad,
+	0x86, 0x55, 0x8f, 0x78, 0xcb, 0x16, 0x98, 0xdf, 0xb1, 0xc5, 0x7f, 0x70, 0x23, 0x3a, 0x15, 0x7a,
+	0xf9, 0xcf, 0xb1, 0xff, 0x00, 0xed, 0xa7, 0xa0, 0xf4, 0xf3, 0x47, 0x00, 0x00,
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error)
+	// NumNextInitializedTicks returns the provided number of next initialized
+	// ticks in the direction of swapping the token in denom.
+	NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error)
+	// RoundingRemainders returns the cumulative rounding remainders of a pool
+	// per denom. Positive amounts were kept by the pool due to rounding in its
+	// favor, negative amounts were lost due to rounding in its users' favor.
+	RoundingRemainders(ctx context.Context, in *RoundingRemaindersRequest, opts ...grpc.CallOption) (*RoundingRemaindersResponse, error)
+	// PositionsByPool returns the positions of a pool along with their share of
+	// the pool's liquidity at the current tick, ordered by position id.
+	PositionsByPool(ctx context.Context, in *PositionsByPoolRequest, opts ...grpc.CallOption) (*PositionsByPoolResponse, error)
+	// PositionValueInQuoteDenom returns the underlying assets of a position along
+	// with their total value in terms of the quote denom, priced with spot prices.
+	PositionValueInQuoteDenom(ctx context.Context, in *PositionValueInQuoteDenomRequest, opts ...grpc.CallOption) (*PositionValueInQuoteDenomResponse, error)
+	// CreatePositionEstimate returns the amounts of each token that creating a
+	// position with the given tokens and tick range would use, along with the
+	// liquidity it would create and the leftover tokens, without changing state.
+	CreatePositionEstimate(ctx context.Context, in *CreatePositionEstimateRequest, opts ...grpc.CallOption) (*CreatePositionEstimateResponse, error)
+	// PoolSwapStats returns the cumulative swap volume and spread rewards
+	// collected of a pool since the statistics started being tracked.
+	PoolSwapStats(ctx context.Context, in *PoolSwapStatsRequest, opts ...grpc.CallOption) (*PoolSwapStatsResponse, error)
+	// IncentiveRecordSlots returns the number of incentive records that can still
+	// be created for a pool before reaching the incentive record caps.
+	IncentiveRecordSlots(ctx context.Context, in *IncentiveRecordSlotsRequest, opts ...grpc.CallOption) (*IncentiveRecordSlotsResponse, error)
+	// PositionLiens returns the liens on positions locked as collateral,
+	// optionally filtered by lienholder.
+	PositionLiens(ctx context.Context, in *PositionLiensRequest, opts ...grpc.CallOption) (*PositionLiensResponse, error)
+	// ManagedPositions returns the positions opted into automatic rebalancing,
+	// optionally filtered by owner.
+	ManagedPositions(ctx context.Context, in *ManagedPositionsRequest, opts ...grpc.CallOption) (*ManagedPositionsResponse, error)
+	// IncentivesPreview returns the incentives a hypothetical position would earn
+	// per day given the current incentive records and active liquidity of the
+	// pool, following the emission math of the uptime accumulators.
+	IncentivesPreview(ctx context.Context, in *IncentivesPreviewRequest, opts ...grpc.CallOption) (*IncentivesPreviewResponse, error)
+	// PositionHistory returns the lifecycle events (creation, modifications and
+	// final withdrawal) of the position with the given id that are within the
+	// retention period, even if the position no longer exists.
+	PositionHistory(ctx context.Context, in *PositionHistoryRequest, opts ...grpc.CallOption) (*PositionHistoryResponse, error)
+	// PositionMigrationWindows returns the open windows during which positions
+	// can be migrated from deprecated pools to their successor pools.
+	PositionMigrationWindows(ctx context.Context, in *PositionMigrationWindowsRequest, opts ...grpc.CallOption) (*PositionMigrationWindowsResponse, error)
+	// VerifyPool checks the tick liquidity, accumulators and balances of a pool
+	// against its positions at the current height and returns the discrepancies
+	// found.
+	VerifyPool(ctx context.Context, in *VerifyPoolRequest, opts ...grpc.CallOption) (*VerifyPoolResponse, error)
+	// OpenLimitOrders returns the limit orders of a pool that are not filled
+	// yet, in ascending order of position id, optionally filtered by owner.
+	OpenLimitOrders(ctx context.Context, in *OpenLimitOrdersRequest, opts ...grpc.CallOption) (*OpenLimitOrdersResponse, error)
+	// ClaimableLimitOrders returns the filled limit orders of an owner that are
+	// not claimed yet.
+	ClaimableLimitOrders(ctx context.Context, in *ClaimableLimitOrdersRequest, opts ...grpc.CallOption) (*ClaimableLimitOrdersResponse, error)
 }
 
-func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
+	out := new(PoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) UserPositions(ctx context.Context, in *UserPositionsRequest, opts ...grpc.CallOption) (*UserPositionsResponse, error) {
+	out := new(UserPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPerTickRange(ctx context.Context, in *LiquidityPerTickRangeRequest, opts ...grpc.CallOption) (*LiquidityPerTickRangeResponse, error) {
+	out := new(LiquidityPerTickRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *LiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*LiquidityNetInDirectionResponse, error) {
+	out := new(LiquidityNetInDirectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) ClaimableSpreadRewards(ctx context.Context, in *ClaimableSpreadRewardsRequest, opts ...grpc.CallOption) (*ClaimableSpreadRewardsResponse, error) {
+	out := new(ClaimableSpreadRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimableIncentives(ctx context.Context, in *ClaimableIncentivesRequest, opts ...grpc.CallOption) (*ClaimableIncentivesResponse, error) {
+	out := new(ClaimableIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PositionById(ctx context.Context, in *PositionByIdRequest, opts ...grpc.CallOption) (*PositionByIdResponse, error) {
+	out := new(PositionByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PoolAccumulatorRewards(ctx context.Context, in *PoolAccumulatorRewardsRequest, opts ...grpc.CallOption) (*PoolAccumulatorRewardsResponse, error) {
+	out := new(PoolAccumulatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IncentiveRecords(ctx context.Context, in *IncentiveRecordsRequest, opts ...grpc.CallOption) (*IncentiveRecordsResponse, error) {
+	out := new(IncentiveRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TickAccumulatorTrackers(ctx context.Context, in *TickAccumulatorTrackersRequest, opts ...grpc.CallOption) (*TickAccumulatorTrackersResponse, error) {
+	out := new(TickAccumulatorTrackersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumNextInitializedTicks != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenInDenom) > 0 {
-		i -= len(m.TokenInDenom)
-		copy(dAtA[i:], m.TokenInDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, in *CFMMPoolIdLinkFromConcentratedPoolIdRequest, opts ...grpc.CallOption) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	out := new(CFMMPoolIdLinkFromConcentratedPoolIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return out, nil
+}
+
+func (c *queryClient) UserUnbondingPositions(ctx context.Context, in *UserUnbondingPositionsRequest, opts ...grpc.CallOption) (*UserUnbondingPositionsResponse, error) {
+	out := new(UserUnbondingPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTotalLiquidity(ctx context.Context, in *GetTotalLiquidityRequest, opts ...grpc.CallOption) (*GetTotalLiquidityResponse, error) {
+	out := new(GetTotalLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NumNextInitializedTicks(ctx context.Context, in *NumNextInitializedTicksRequest, opts ...grpc.CallOption) (*NumNextInitializedTicksResponse, error) {
+	out := new(NumNextInitializedTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) RoundingRemainders(ctx context.Context, in *RoundingRemaindersRequest, opts ...grpc.CallOption) (*RoundingRemaindersResponse, error) {
+	out := new(RoundingRemaindersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/RoundingRemainders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) PositionsByPool(ctx context.Context, in *PositionsByPoolRequest, opts ...grpc.CallOption) (*PositionsByPoolResponse, error) {
+	out := new(PositionsByPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionsByPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *UserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+func (c *queryClient) PositionValueInQuoteDenom(ctx context.Context, in *PositionValueInQuoteDenomRequest, opts ...grpc.CallOption) (*PositionValueInQuoteDenomResponse, error) {
+	out := new(PositionValueInQuoteDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionValueInQuoteDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *UserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) CreatePositionEstimate(ctx context.Context, in *CreatePositionEstimateRequest, opts ...grpc.CallOption) (*CreatePositionEstimateResponse, error) {
+	out := new(CreatePositionEstimateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/CreatePositionEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+func (c *queryClient) PoolSwapStats(ctx context.Context, in *PoolSwapStatsRequest, opts ...grpc.CallOption) (*PoolSwapStatsResponse, error) {
+	out := new(PoolSwapStatsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolSwapStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) IncentiveRecordSlots(ctx context.Context, in *IncentiveRecordSlotsRequest, opts ...grpc.CallOption) (*IncentiveRecordSlotsResponse, error) {
+	out := new(IncentiveRecordSlotsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecordSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *PoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PositionLiens(ctx context.Context, in *PositionLiensRequest, opts ...grpc.CallOption) (*PositionLiensResponse, error) {
+	out := new(PositionLiensResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionLiens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ManagedPositions(ctx context.Context, in *ManagedPositionsRequest, opts ...grpc.CallOption) (*ManagedPositionsResponse, error) {
+	out := new(ManagedPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ManagedPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) IncentivesPreview(ctx context.Context, in *IncentivesPreviewRequest, opts ...grpc.CallOption) (*IncentivesPreviewResponse, error) {
+	out := new(IncentivesPreviewResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IncentivesPreview", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PositionHistory(ctx context.Context, in *PositionHistoryRequest, opts ...grpc.CallOption) (*PositionHistoryResponse, error) {
+	out := new(PositionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+func (c *queryClient) PositionMigrationWindows(ctx context.Context, in *PositionMigrationWindowsRequest, opts ...grpc.CallOption) (*PositionMigrationWindowsResponse, error) {
+	out := new(PositionMigrationWindowsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionMigrationWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LowerTick != 0 {
-		n += 1 + sovQuery(uint64(m.LowerTick))
-	}
-	if m.UpperTick != 0 {
-		n += 1 + sovQuery(uint64(m.UpperTick))
+func (c *queryClient) VerifyPool(ctx context.Context, in *VerifyPoolRequest, opts ...grpc.CallOption) (*VerifyPoolResponse, error) {
+	out := new(VerifyPoolResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/VerifyPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LiquidityNetInDirectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.StartTick != 0 {
-		n += 1 + sovQuery(uint64(m.StartTick))
-	}
-	if m.UseCurTick {
-		n += 2
-	}
-	if m.BoundTick != 0 {
-		n += 1 + sovQuery(uint64(m.BoundTick))
-	}
-	if m.UseNoBound {
-		n += 2
+func (c *queryClient) OpenLimitOrders(ctx context.Context, in *OpenLimitOrdersRequest, opts ...grpc.CallOption) (*OpenLimitOrdersResponse, error) {
+	out := new(OpenLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/OpenLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *LiquidityNetInDirectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
+func (c *queryClient) ClaimableLimitOrders(ctx context.Context, in *ClaimableLimitOrdersRequest, opts ...grpc.CallOption) (*ClaimableLimitOrdersResponse, error) {
+	out := new(ClaimableLimitOrdersResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableLimitOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.CurrentSqrtPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *LiquidityPerTickRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(context.Context, *PoolsRequest) (*PoolsResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(context.Context, *UserPositionsRequest) (*UserPositionsResponse, error)
+	// LiquidityPerTickRange returns the amount of liquidity per every tick range
+	// existing within the given pool
+	LiquidityPerTickRange(context.Context, *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(context.Context, *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error)
+	// ClaimableSpreadRewards returns the amount of spread rewards that can be
+	// claimed by a position with the given id.
+	ClaimableSpreadRewards(context.Context, *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// and how many would be forfeited by a position with the given id.
+	ClaimableIncentives(context.Context, *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id.
+	PositionById(context.Context, *PositionByIdRequest) (*PositionByIdResponse, error)
+	// PoolAccumulatorRewards returns the pool-global accumulator rewards.
+	// Contains spread factor rewards and uptime rewards.
+	PoolAccumulatorRewards(context.Context, *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error)
+	// IncentiveRecords returns the incentive records for a given poolId
+	IncentiveRecords(context.Context, *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error)
+	// TickAccumulatorTrackers returns the tick accumulator trackers.
+	// Contains spread factor and uptime accumulator trackers.
+	TickAccumulatorTrackers(context.Context, *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error)
+	// CFMMPoolIdLinkFromConcentratedPoolId returns the pool id of the CFMM
+	// pool that is linked with the given concentrated pool.
+	CFMMPoolIdLinkFromConcentratedPoolId(context.Context, *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error)
+	// UserUnbondingPositions returns the position and lock info of unbonding
+	// positions of the given address.
+	UserUnbondingPositions(context.Context, *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error)
+	// GetTotalLiquidity returns total liquidity across all cl pools.
+	GetTotalLiquidity(context.Context, *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error)
+	// NumNextInitializedTicks returns the provided number of next initialized
+	// ticks in the direction of swapping the token in denom.
+	NumNextInitializedTicks(context.Context, *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error)
+	// RoundingRemainders returns the cumulative rounding remainders of a pool
+	// per denom. Positive amounts were kept by the pool due to rounding in its
+	// favor, negative amounts were lost due to rounding in its users' favor.
+	RoundingRemainders(context.Context, *RoundingRemaindersRequest) (*RoundingRemaindersResponse, error)
+	// PositionsByPool returns the positions of a pool along with their share of
+	// the pool's liquidity at the current tick, ordered by position id.
+	PositionsByPool(context.Context, *PositionsByPoolRequest) (*PositionsByPoolResponse, error)
+	// PositionValueInQuoteDenom returns the underlying assets of a position along
+	// with their total value in terms of the quote denom, priced with spot prices.
+	PositionValueInQuoteDenom(context.Context, *PositionValueInQuoteDenomRequest) (*PositionValueInQuoteDenomResponse, error)
+	// CreatePositionEstimate returns the amounts of each token that creating a
+	// position with the given tokens and tick range would use, along with the
+	// liquidity it would create and the leftover tokens, without changing state.
+	CreatePositionEstimate(context.Context, *CreatePositionEstimateRequest) (*CreatePositionEstimateResponse, error)
+	// PoolSwapStats returns the cumulative swap volume and spread rewards
+	// collected of a pool since the statistics started being tracked.
+	PoolSwapStats(context.Context, *PoolSwapStatsRequest) (*PoolSwapStatsResponse, error)
+	// IncentiveRecordSlots returns the number of incentive records that can still
+	// be created for a pool before reaching the incentive record caps.
+	IncentiveRecordSlots(context.Context, *IncentiveRecordSlotsRequest) (*IncentiveRecordSlotsResponse, error)
+	// PositionLiens returns the liens on positions locked as collateral,
+	// optionally filtered by lienholder.
+	PositionLiens(context.Context, *PositionLiensRequest) (*PositionLiensResponse, error)
+	// ManagedPositions returns the positions opted into automatic rebalancing,
+	// optionally filtered by owner.
+	ManagedPositions(context.Context, *ManagedPositionsRequest) (*ManagedPositionsResponse, error)
+	// IncentivesPreview returns the incentives a hypothetical position would earn
+	// per day given the current incentive records and active liquidity of the
+	// pool, following the emission math of the uptime accumulators.
+	IncentivesPreview(context.Context, *IncentivesPreviewRequest) (*IncentivesPreviewResponse, error)
+	// PositionHistory returns the lifecycle events (creation, modifications and
+	// final withdrawal) of the position with the given id that are within the
+	// retention period, even if the position no longer exists.
+	PositionHistory(context.Context, *PositionHistoryRequest) (*PositionHistoryResponse, error)
+	// PositionMigrationWindows returns the open windows during which positions
+	// can be migrated from deprecated pools to their successor pools.
+	PositionMigrationWindows(context.Context, *PositionMigrationWindowsRequest) (*PositionMigrationWindowsResponse, error)
+	// VerifyPool checks the tick liquidity, accumulators and balances of a pool
+	// against its positions at the current height and returns the discrepancies
+	// found.
+	VerifyPool(context.Context, *VerifyPoolRequest) (*VerifyPoolResponse, error)
+	// OpenLimitOrders returns the limit orders of a pool that are not filled
+	// yet, in ascending order of position id, optionally filtered by owner.
+	OpenLimitOrders(context.Context, *OpenLimitOrdersRequest) (*OpenLimitOrdersResponse, error)
+	// ClaimableLimitOrders returns the filled limit orders of an owner that are
+	// not claimed yet.
+	ClaimableLimitOrders(context.Context, *ClaimableLimitOrdersRequest) (*ClaimableLimitOrdersResponse, error)
 }
 
-func (m *LiquidityPerTickRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.BucketIndex != 0 {
-		n += 1 + sovQuery(uint64(m.BucketIndex))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
-	}
-	return n
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *PoolsRequest) (*PoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *UserPositionsRequest) (*UserPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPerTickRange(ctx context.Context, req *LiquidityPerTickRangeRequest) (*LiquidityPerTickRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPerTickRange not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *LiquidityNetInDirectionRequest) (*LiquidityNetInDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableSpreadRewards(ctx context.Context, req *ClaimableSpreadRewardsRequest) (*ClaimableSpreadRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableSpreadRewards not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *ClaimableIncentivesRequest) (*ClaimableIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+}
+func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *PositionByIdRequest) (*PositionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+}
+func (*UnimplementedQueryServer) PoolAccumulatorRewards(ctx context.Context, req *PoolAccumulatorRewardsRequest) (*PoolAccumulatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolAccumulatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveRecords(ctx context.Context, req *IncentiveRecordsRequest) (*IncentiveRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecords not implemented")
+}
+func (*UnimplementedQueryServer) TickAccumulatorTrackers(ctx context.Context, req *TickAccumulatorTrackersRequest) (*TickAccumulatorTrackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TickAccumulatorTrackers not implemented")
+}
+func (*UnimplementedQueryServer) CFMMPoolIdLinkFromConcentratedPoolId(ctx context.Context, req *CFMMPoolIdLinkFromConcentratedPoolIdRequest) (*CFMMPoolIdLinkFromConcentratedPoolIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFMMPoolIdLinkFromConcentratedPoolId not implemented")
+}
+func (*UnimplementedQueryServer) UserUnbondingPositions(ctx context.Context, req *UserUnbondingPositionsRequest) (*UserUnbondingPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserUnbondingPositions not implemented")
+}
+func (*UnimplementedQueryServer) GetTotalLiquidity(ctx context.Context, req *GetTotalLiquidityRequest) (*GetTotalLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) NumNextInitializedTicks(ctx context.Context, req *NumNextInitializedTicksRequest) (*NumNextInitializedTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NumNextInitializedTicks not implemented")
+}
+func (*UnimplementedQueryServer) RoundingRemainders(ctx context.Context, req *RoundingRemaindersRequest) (*RoundingRemaindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoundingRemainders not implemented")
+}
+func (*UnimplementedQueryServer) PositionsByPool(ctx context.Context, req *PositionsByPoolRequest) (*PositionsByPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionsByPool not implemented")
+}
+func (*UnimplementedQueryServer) PositionValueInQuoteDenom(ctx context.Context, req *PositionValueInQuoteDenomRequest) (*PositionValueInQuoteDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionValueInQuoteDenom not implemented")
+}
+func (*UnimplementedQueryServer) CreatePositionEstimate(ctx context.Context, req *CreatePositionEstimateRequest) (*CreatePositionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePositionEstimate not implemented")
+}
+func (*UnimplementedQueryServer) PoolSwapStats(ctx context.Context, req *PoolSwapStatsRequest) (*PoolSwapStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolSwapStats not implemented")
+}
+func (*UnimplementedQueryServer) IncentiveRecordSlots(ctx context.Context, req *IncentiveRecordSlotsRequest) (*IncentiveRecordSlotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentiveRecordSlots not implemented")
+}
+func (*UnimplementedQueryServer) PositionLiens(ctx context.Context, req *PositionLiensRequest) (*PositionLiensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionLiens not implemented")
+}
+func (*UnimplementedQueryServer) ManagedPositions(ctx context.Context, req *ManagedPositionsRequest) (*ManagedPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ManagedPositions not implemented")
+}
+func (*UnimplementedQueryServer) IncentivesPreview(ctx context.Context, req *IncentivesPreviewRequest) (*IncentivesPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncentivesPreview not implemented")
+}
+func (*UnimplementedQueryServer) PositionHistory(ctx context.Context, req *PositionHistoryRequest) (*PositionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionHistory not implemented")
+}
+func (*UnimplementedQueryServer) PositionMigrationWindows(ctx context.Context, req *PositionMigrationWindowsRequest) (*PositionMigrationWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionMigrationWindows not implemented")
+}
+func (*UnimplementedQueryServer) VerifyPool(ctx context.Context, req *VerifyPoolRequest) (*VerifyPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPool not implemented")
+}
+func (*UnimplementedQueryServer) OpenLimitOrders(ctx context.Context, req *OpenLimitOrdersRequest) (*OpenLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenLimitOrders not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableLimitOrders(ctx context.Context, req *ClaimableLimitOrdersRequest) (*ClaimableLimitOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableLimitOrders not implemented")
 }
 
-func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ClaimableSpreadRewards) > 0 {
-		for _, e := range m.ClaimableSpreadRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *ClaimableIncentivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*PoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClaimableIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ClaimableIncentives) > 0 {
-		for _, e := range m.ClaimableIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.ForfeitedIncentives) > 0 {
-		for _, e := range m.ForfeitedIncentives {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).UserPositions(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPositions(ctx, req.(*UserPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidityPerTickRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityPerTickRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SpreadRewardGrowthGlobal) > 0 {
-		for _, e := range m.SpreadRewardGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, in)
 	}
-	if len(m.UptimeGrowthGlobal) > 0 {
-		for _, e := range m.UptimeGrowthGlobal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityPerTickRange",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPerTickRange(ctx, req.(*LiquidityPerTickRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiquidityNetInDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
 	}
-	if m.TickIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TickIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*LiquidityNetInDirectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TickAccumulatorTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClaimableSpreadRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableSpreadRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
-		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, in)
 	}
-	if len(m.UptimeTrackers) > 0 {
-		for _, e := range m.UptimeTrackers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableSpreadRewards",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableSpreadRewards(ctx, req.(*ClaimableSpreadRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IncentiveRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncentives(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*ClaimableIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *IncentiveRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.IncentiveRecords) > 0 {
-		for _, e := range m.IncentiveRecords {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*PositionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolAccumulatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolAccumulatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ConcentratedPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, in)
 	}
-	return n
-}
-
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolAccumulatorRewards",
 	}
-	var l int
-	_ = l
-	if m.CfmmPoolId != 0 {
-		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolAccumulatorRewards(ctx, req.(*PoolAccumulatorRewardsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserUnbondingPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_IncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentiveRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveRecords(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveRecords(ctx, req.(*IncentiveRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserUnbondingPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TickAccumulatorTrackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TickAccumulatorTrackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PositionsWithPeriodLock) > 0 {
-		for _, e := range m.PositionsWithPeriodLock {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TickAccumulatorTrackers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TickAccumulatorTrackers(ctx, req.(*TickAccumulatorTrackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTotalLiquidityRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CFMMPoolIdLinkFromConcentratedPoolIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CFMMPoolIdLinkFromConcentratedPoolId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CFMMPoolIdLinkFromConcentratedPoolId(ctx, req.(*CFMMPoolIdLinkFromConcentratedPoolIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTotalLiquidityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_UserUnbondingPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserUnbondingPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TotalLiquidity) > 0 {
-		for _, e := range m.TotalLiquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserUnbondingPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserUnbondingPositions(ctx, req.(*UserUnbondingPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumNextInitializedTicksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetTotalLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, in)
 	}
-	l = len(m.TokenInDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/GetTotalLiquidity",
 	}
-	if m.NumNextInitializedTicks != 0 {
-		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalLiquidity(ctx, req.(*GetTotalLiquidityRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NumNextInitializedTicksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func _Query_NumNextInitializedTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NumNextInitializedTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NumNextInitializedTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NumNextInitializedTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NumNextInitializedTicks(ctx, req.(*NumNextInitializedTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoundingRemainders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RoundingRemaindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoundingRemainders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/RoundingRemainders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoundingRemainders(ctx, req.(*RoundingRemaindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionsByPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionsByPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionsByPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionsByPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionsByPool(ctx, req.(*PositionsByPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionValueInQuoteDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionValueInQuoteDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionValueInQuoteDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionValueInQuoteDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionValueInQuoteDenom(ctx, req.(*PositionValueInQuoteDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreatePositionEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePositionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreatePositionEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/CreatePositionEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreatePositionEstimate(ctx, req.(*CreatePositionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolSwapStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolSwapStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolSwapStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolSwapStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolSwapStats(ctx, req.(*PoolSwapStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentiveRecordSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentiveRecordSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentiveRecordSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentiveRecordSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentiveRecordSlots(ctx, req.(*IncentiveRecordSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionLiens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionLiensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionLiens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionLiens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionLiens(ctx, req.(*PositionLiensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ManagedPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManagedPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ManagedPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ManagedPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ManagedPositions(ctx, req.(*ManagedPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IncentivesPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncentivesPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IncentivesPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IncentivesPreview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IncentivesPreview(ctx, req.(*IncentivesPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionHistory(ctx, req.(*PositionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionMigrationWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PositionMigrationWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionMigrationWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionMigrationWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionMigrationWindows(ctx, req.(*PositionMigrationWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/VerifyPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyPool(ctx, req.(*VerifyPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/OpenLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenLimitOrders(ctx, req.(*OpenLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableLimitOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimableLimitOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableLimitOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableLimitOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableLimitOrders(ctx, req.(*ClaimableLimitOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "LiquidityPerTickRange",
+			Handler:    _Query_LiquidityPerTickRange_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "ClaimableSpreadRewards",
+			Handler:    _Query_ClaimableSpreadRewards_Handler,
+		},
+		{
+			MethodName: "ClaimableIncentives",
+			Handler:    _Query_ClaimableIncentives_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
+		{
+			MethodName: "PoolAccumulatorRewards",
+			Handler:    _Query_PoolAccumulatorRewards_Handler,
+		},
+		{
+			MethodName: "IncentiveRecords",
+			Handler:    _Query_IncentiveRecords_Handler,
+		},
+		{
+			MethodName: "TickAccumulatorTrackers",
+			Handler:    _Query_TickAccumulatorTrackers_Handler,
+		},
+		{
+			MethodName: "CFMMPoolIdLinkFromConcentratedPoolId",
+			Handler:    _Query_CFMMPoolIdLinkFromConcentratedPoolId_Handler,
+		},
+		{
+			MethodName: "UserUnbondingPositions",
+			Handler:    _Query_UserUnbondingPositions_Handler,
+		},
+		{
+			MethodName: "GetTotalLiquidity",
+			Handler:    _Query_GetTotalLiquidity_Handler,
+		},
+		{
+			MethodName: "NumNextInitializedTicks",
+			Handler:    _Query_NumNextInitializedTicks_Handler,
+		},
+		{
+			MethodName: "RoundingRemainders",
+			Handler:    _Query_RoundingRemainders_Handler,
+		},
+		{
+			MethodName: "PositionsByPool",
+			Handler:    _Query_PositionsByPool_Handler,
+		},
+		{
+			MethodName: "PositionValueInQuoteDenom",
+			Handler:    _Query_PositionValueInQuoteDenom_Handler,
+		},
+		{
+			MethodName: "CreatePositionEstimate",
+			Handler:    _Query_CreatePositionEstimate_Handler,
+		},
+		{
+			MethodName: "PoolSwapStats",
+			Handler:    _Query_PoolSwapStats_Handler,
+		},
+		{
+			MethodName: "IncentiveRecordSlots",
+			Handler:    _Query_IncentiveRecordSlots_Handler,
+		},
+		{
+			MethodName: "PositionLiens",
+			Handler:    _Query_PositionLiens_Handler,
+		},
+		{
+			MethodName: "ManagedPositions",
+			Handler:    _Query_ManagedPositions_Handler,
+		},
+		{
+			MethodName: "IncentivesPreview",
+			Handler:    _Query_IncentivesPreview_Handler,
+		},
+		{
+			MethodName: "PositionHistory",
+			Handler:    _Query_PositionHistory_Handler,
+		},
+		{
+			MethodName: "PositionMigrationWindows",
+			Handler:    _Query_PositionMigrationWindows_Handler,
+		},
+		{
+			MethodName: "VerifyPool",
+			Handler:    _Query_VerifyPool_Handler,
+		},
+		{
+			MethodName: "OpenLimitOrders",
+			Handler:    _Query_OpenLimitOrders_Handler,
+		},
+		{
+			MethodName: "ClaimableLimitOrders",
+			Handler:    _Query_ClaimableLimitOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentratedliquidity/v1beta1/query.proto",
+}
+
+func (m *UserPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UseNoBound {
+		i--
+		if m.UseNoBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BoundTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BoundTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UseCurTick {
+		i--
+		if m.UseCurTick {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPerTickRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPerTickRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPerTickRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityPerTickRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityPerTickRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityPerTickRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BucketIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableSpreadRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableSpreadRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableSpreadRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableSpreadRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableSpreadRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableSpreadRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for iNdEx := len(m.ClaimableSpreadRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableSpreadRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClaimableIncentives) > 0 {
+		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAccumulatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolAccumulatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolAccumulatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for iNdEx := len(m.UptimeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickAccumulatorTrackersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickAccumulatorTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickAccumulatorTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TickAccumulatorTrackersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickAccumulatorTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickAccumulatorTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeTrackers) > 0 {
+		for iNdEx := len(m.UptimeTrackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeTrackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for iNdEx := len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConcentratedPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CfmmPoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbondingPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserUnbondingPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserUnbondingPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for iNdEx := len(m.PositionsWithPeriodLock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionsWithPeriodLock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for iNdEx := len(m.TotalLiquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalLiquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumNextInitializedTicksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumNextInitializedTicks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumNextInitializedTicks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumNextInitializedTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumNextInitializedTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundingRemaindersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundingRemaindersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundingRemaindersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundingRemaindersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundingRemaindersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundingRemaindersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remainders) > 0 {
+		for iNdEx := len(m.Remainders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionWithLiquidityShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionWithLiquidityShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionWithLiquidityShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityShare.Size()
+		i -= size
+		if _, err := m.LiquidityShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.InRange {
+		i--
+		if m.InRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionsByPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionsByPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionsByPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionsByPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionsByPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionsByPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionValueInQuoteDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionValueInQuoteDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionValueInQuoteDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionValueInQuoteDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionValueInQuoteDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionValueInQuoteDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Asset1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Asset0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePositionEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePositionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePositionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensProvided) > 0 {
+		for iNdEx := len(m.TokensProvided) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensProvided[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreatePositionEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreatePositionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreatePositionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Leftover) > 0 {
+		for iNdEx := len(m.Leftover) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Leftover[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSwapStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSwapStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSwapStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolSwapStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolSwapStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolSwapStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpreadRewardsCollected) > 0 {
+		for iNdEx := len(m.SpreadRewardsCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardsCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SwapVolume) > 0 {
+		for iNdEx := len(m.SwapVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveRecordSlotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRecordSlotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordSlotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentiveRecordSlotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentiveRecordSlotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentiveRecordSlotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingUptimeSlots) > 0 {
+		for iNdEx := len(m.RemainingUptimeSlots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingUptimeSlots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RemainingPoolSlots != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingPoolSlots))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UptimeIncentiveRecordSlots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UptimeIncentiveRecordSlots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UptimeIncentiveRecordSlots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingSlots != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingSlots))
+		i--
+		dAtA[i] = 0x10
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Uptime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Uptime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionLiensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLiensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lienholder) > 0 {
+		i -= len(m.Lienholder)
+		copy(dAtA[i:], m.Lienholder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Lienholder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionLiensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLiensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liens) > 0 {
+		for iNdEx := len(m.Liens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagedPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManagedPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManagedPositions) > 0 {
+		for iNdEx := len(m.ManagedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivesPreviewRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivesPreviewRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivesPreviewRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Uptime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Uptime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivesPreviewResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivesPreviewResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivesPreviewResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InRange {
+		i--
+		if m.InRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IncentivesPerDay) > 0 {
+		for iNdEx := len(m.IncentivesPerDay) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentivesPerDay[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionMigrationWindowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionMigrationWindowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionMigrationWindowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionMigrationWindowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionMigrationWindowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionMigrationWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Discrepancies) > 0 {
+		for iNdEx := len(m.Discrepancies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Discrepancies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateDiscrepancy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateDiscrepancy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateDiscrepancy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actual) > 0 {
+		i -= len(m.Actual)
+		copy(dAtA[i:], m.Actual)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Actual)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Expected) > 0 {
+		i -= len(m.Expected)
+		copy(dAtA[i:], m.Expected)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Expected)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Check) > 0 {
+		i -= len(m.Check)
+		copy(dAtA[i:], m.Check)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Check)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableLimitOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableLimitOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableLimitOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableLimitOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableLimitOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableLimitOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.UseCurTick {
+		n += 2
+	}
+	if m.BoundTick != 0 {
+		n += 1 + sovQuery(uint64(m.BoundTick))
+	}
+	if m.UseNoBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *LiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityPerTickRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *LiquidityPerTickRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BucketIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BucketIndex))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableSpreadRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableSpreadRewards) > 0 {
+		for _, e := range m.ClaimableSpreadRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *ClaimableIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolAccumulatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthGlobal) > 0 {
+		for _, e := range m.SpreadRewardGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeGrowthGlobal) > 0 {
+		for _, e := range m.UptimeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	return n
+}
+
+func (m *TickAccumulatorTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal) > 0 {
+		for _, e := range m.SpreadRewardGrowthOppositeDirectionOfLastTraversal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UptimeTrackers) > 0 {
+		for _, e := range m.UptimeTrackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IncentiveRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConcentratedPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.ConcentratedPoolId))
+	}
+	return n
+}
+
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CfmmPoolId != 0 {
+		n += 1 + sovQuery(uint64(m.CfmmPoolId))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserUnbondingPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionsWithPeriodLock) > 0 {
+		for _, e := range m.PositionsWithPeriodLock {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetTotalLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTotalLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalLiquidity) > 0 {
+		for _, e := range m.TotalLiquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumNextInitializedTicks != 0 {
+		n += 1 + sovQuery(uint64(m.NumNextInitializedTicks))
+	}
+	return n
+}
+
+func (m *NumNextInitializedTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.LiquidityDepths) > 0 {
 		for _, e := range m.LiquidityDepths {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RoundingRemaindersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *RoundingRemaindersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remainders) > 0 {
+		for _, e := range m.Remainders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PositionWithLiquidityShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InRange {
+		n += 2
+	}
+	l = m.LiquidityShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PositionsByPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionsByPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionValueInQuoteDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionValueInQuoteDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Asset0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Asset1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CreatePositionEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	if len(m.TokensProvided) > 0 {
+		for _, e := range m.TokensProvided {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreatePositionEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount0.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	if len(m.Leftover) > 0 {
+		for _, e := range m.Leftover {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolSwapStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolSwapStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SwapVolume) > 0 {
+		for _, e := range m.SwapVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SpreadRewardsCollected) > 0 {
+		for _, e := range m.SpreadRewardsCollected {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentiveRecordSlotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *IncentiveRecordSlotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingPoolSlots != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingPoolSlots))
+	}
+	if len(m.RemainingUptimeSlots) > 0 {
+		for _, e := range m.RemainingUptimeSlots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UptimeIncentiveRecordSlots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Uptime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.RemainingSlots != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingSlots))
+	}
+	return n
+}
+
+func (m *PositionLiensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Lienholder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PositionLiensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liens) > 0 {
+		for _, e := range m.Liens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ManagedPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ManagedPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ManagedPositions) > 0 {
+		for _, e := range m.ManagedPositions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IncentivesPreviewRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	l = m.Liquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Uptime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *IncentivesPreviewResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentivesPerDay) > 0 {
+		for _, e := range m.IncentivesPerDay {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.InRange {
+		n += 2
+	}
+	return n
+}
+
+func (m *PositionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *PositionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PositionMigrationWindowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PositionMigrationWindowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VerifyPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *VerifyPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Discrepancies) > 0 {
+		for _, e := range m.Discrepancies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateDiscrepancy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Check)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Expected)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Actual)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OpenLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OpenLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableLimitOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimableLimitOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseCurTick = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			m.BoundTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BoundTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNoBound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
+			}
+			m.BucketIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
+			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
+			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
+			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
+			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+			}
+			m.ConcentratedPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+			}
+			m.CfmmPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
+			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
+			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
+			}
+			m.NumNextInitializedTicks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundingRemaindersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundingRemaindersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundingRemaindersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RoundingRemaindersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundingRemaindersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundingRemaindersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainders = append(m.Remainders, types2.DecCoin{})
+			if err := m.Remainders[len(m.Remainders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PositionWithLiquidityShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionWithLiquidityShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionWithLiquidityShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InRange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InRange = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *PositionsByPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4244,45 +11706,13 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionsByPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionsByPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
@@ -4301,7 +11731,7 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4358,7 +11788,8 @@ func (m *UserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *PositionsByPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4381,10 +11812,10 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionsByPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionsByPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4416,7 +11847,7 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, model.FullPositionBreakdown{})
+			m.Positions = append(m.Positions, PositionWithLiquidityShare{})
 			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4478,7 +11909,8 @@ func (m *UserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PositionValueInQuoteDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4501,10 +11933,10 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionValueInQuoteDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionValueInQuoteDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4526,6 +11958,38 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4547,7 +12011,8 @@ func (m *PositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
+
+func (m *PositionValueInQuoteDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4570,15 +12035,15 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionValueInQuoteDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionValueInQuoteDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4605,7 +12070,73 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Asset0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4630,7 +12161,8 @@ func (m *PositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *CreatePositionEstimateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4653,15 +12185,72 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatePositionEstimateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatePositionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensProvided", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4688,10 +12277,8 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokensProvided = append(m.TokensProvided, types2.Coin{})
+			if err := m.TokensProvided[len(m.TokensProvided)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4716,7 +12303,8 @@ func (m *PoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *CreatePositionEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4739,17 +12327,17 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreatePositionEstimateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreatePositionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4759,31 +12347,31 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4793,131 +12381,101 @@ func (m *PoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leftover", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4944,7 +12502,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Leftover = append(m.Leftover, types2.Coin{})
+			if err := m.Leftover[len(m.Leftover)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4969,7 +12528,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+
+func (m *PoolSwapStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4992,51 +12552,17 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSwapStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSwapStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.TickIndex = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5046,7 +12572,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5072,7 +12598,8 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+
+func (m *PoolSwapStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5095,17 +12622,17 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolSwapStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolSwapStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5115,50 +12642,31 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
-			}
-			m.LowerTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			m.SwapVolume = append(m.SwapVolume, types2.Coin{})
+			if err := m.SwapVolume[len(m.SwapVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UpperTick = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardsCollected", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5168,11 +12676,26 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardsCollected = append(m.SpreadRewardsCollected, types2.Coin{})
+			if err := m.SpreadRewardsCollected[len(m.SpreadRewardsCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5194,7 +12717,8 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+
+func (m *IncentiveRecordSlotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5217,10 +12741,10 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentiveRecordSlotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentiveRecordSlotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5242,43 +12766,62 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IncentiveRecordSlotsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentiveRecordSlotsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentiveRecordSlotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingPoolSlots", wireType)
 			}
-			m.StartTick = 0
+			m.RemainingPoolSlots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5288,16 +12831,16 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartTick |= int64(b&0x7F) << shift
+				m.RemainingPoolSlots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseCurTick", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUptimeSlots", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5307,51 +12850,26 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseCurTick = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.BoundTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BoundTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseNoBound", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.RemainingUptimeSlots = append(m.RemainingUptimeSlots, UptimeIncentiveRecordSlots{})
+			if err := m.RemainingUptimeSlots[len(m.RemainingUptimeSlots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UseNoBound = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5373,7 +12891,8 @@ func (m *LiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+
+func (m *UptimeIncentiveRecordSlots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5396,15 +12915,15 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UptimeIncentiveRecordSlots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UptimeIncentiveRecordSlots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5431,69 +12950,15 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Uptime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSlots", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
-			}
-			var stringLen uint64
+			m.RemainingSlots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5503,26 +12968,11 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RemainingSlots |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5544,7 +12994,8 @@ func (m *LiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PositionLiensRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5567,17 +13018,17 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionLiensRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionLiensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lienholder", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5587,11 +13038,24 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lienholder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5613,7 +13077,8 @@ func (m *LiquidityPerTickRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
+
+func (m *PositionLiensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5636,15 +13101,15 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionLiensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityPerTickRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionLiensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5671,30 +13136,11 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Liens = append(m.Liens, types1.PositionLien{})
+			if err := m.Liens[len(m.Liens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketIndex", wireType)
-			}
-			m.BucketIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BucketIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5716,7 +13162,8 @@ func (m *LiquidityPerTickRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *ManagedPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5739,17 +13186,17 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.PositionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5759,11 +13206,24 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5785,7 +13245,8 @@ func (m *ClaimableSpreadRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *ManagedPositionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5808,15 +13269,15 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedPositionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableSpreadRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableSpreadRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5843,8 +13304,8 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableSpreadRewards = append(m.ClaimableSpreadRewards, types2.Coin{})
-			if err := m.ClaimableSpreadRewards[len(m.ClaimableSpreadRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ManagedPositions = append(m.ManagedPositions, types1.ManagedPosition{})
+			if err := m.ManagedPositions[len(m.ManagedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5869,7 +13330,8 @@ func (m *ClaimableSpreadRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+
+func (m *IncentivesPreviewRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5892,17 +13354,17 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentivesPreviewRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentivesPreviewRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5912,11 +13374,116 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Uptime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5938,7 +13505,8 @@ func (m *ClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+
+func (m *IncentivesPreviewResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5961,15 +13529,15 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncentivesPreviewResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncentivesPreviewResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivesPerDay", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5996,16 +13564,16 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableIncentives = append(m.ClaimableIncentives, types2.Coin{})
-			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IncentivesPerDay = append(m.IncentivesPerDay, types2.DecCoin{})
+			if err := m.IncentivesPerDay[len(m.IncentivesPerDay)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InRange", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6015,26 +13583,12 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types2.Coin{})
-			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.InRange = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6056,7 +13610,8 @@ func (m *ClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PositionHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6079,17 +13634,17 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6099,7 +13654,7 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6125,7 +13680,8 @@ func (m *PoolAccumulatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *PositionHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6145,52 +13701,18 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 				break
 			}
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolAccumulatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthGlobal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpreadRewardGrowthGlobal = append(m.SpreadRewardGrowthGlobal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthGlobal[len(m.SpreadRewardGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeGrowthGlobal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6217,8 +13739,8 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeGrowthGlobal = append(m.UptimeGrowthGlobal, model.UptimeTracker{})
-			if err := m.UptimeGrowthGlobal[len(m.UptimeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, types1.PositionHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6243,7 +13765,8 @@ func (m *PoolAccumulatorRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PositionMigrationWindowsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6266,50 +13789,12 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionMigrationWindowsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionMigrationWindowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
-			}
-			m.TickIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TickIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6331,7 +13816,8 @@ func (m *TickAccumulatorTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
+
+func (m *PositionMigrationWindowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6354,49 +13840,15 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PositionMigrationWindowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickAccumulatorTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PositionMigrationWindowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardGrowthOppositeDirectionOfLastTraversal", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpreadRewardGrowthOppositeDirectionOfLastTraversal = append(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal, types2.DecCoin{})
-			if err := m.SpreadRewardGrowthOppositeDirectionOfLastTraversal[len(m.SpreadRewardGrowthOppositeDirectionOfLastTraversal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UptimeTrackers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6423,8 +13875,8 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UptimeTrackers = append(m.UptimeTrackers, model.UptimeTracker{})
-			if err := m.UptimeTrackers[len(m.UptimeTrackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, types1.PositionMigrationWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6449,7 +13901,8 @@ func (m *TickAccumulatorTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *VerifyPoolRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6472,10 +13925,10 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyPoolRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6497,42 +13950,6 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6554,7 +13971,8 @@ func (m *IncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *VerifyPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6577,17 +13995,17 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6597,29 +14015,14 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IncentiveRecords = append(m.IncentiveRecords, types1.IncentiveRecord{})
-			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discrepancies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6646,10 +14049,8 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Discrepancies = append(m.Discrepancies, StateDiscrepancy{})
+			if err := m.Discrepancies[len(m.Discrepancies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6674,7 +14075,8 @@ func (m *IncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) error {
+
+func (m *StateDiscrepancy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6697,17 +14099,17 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateDiscrepancy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateDiscrepancy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedPoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
-			m.ConcentratedPoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6717,66 +14119,61 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConcentratedPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Check = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CFMMPoolIdLinkFromConcentratedPoolIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CfmmPoolId", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.CfmmPoolId = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expected", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6786,64 +14183,27 @@ func (m *CFMMPoolIdLinkFromConcentratedPoolIdResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CfmmPoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Expected = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6871,7 +14231,7 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Actual = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6894,7 +14254,8 @@ func (m *UserUnbondingPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
+
+func (m *OpenLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6917,17 +14278,36 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserUnbondingPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionsWithPeriodLock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6937,25 +14317,23 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PositionsWithPeriodLock = append(m.PositionsWithPeriodLock, model.PositionWithPeriodLock{})
-			if err := m.PositionsWithPeriodLock[len(m.PositionsWithPeriodLock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6978,57 +14356,8 @@ func (m *UserUnbondingPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTotalLiquidityRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
+func (m *OpenLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7051,15 +14380,15 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTotalLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7086,8 +14415,8 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalLiquidity = append(m.TotalLiquidity, types2.Coin{})
-			if err := m.TotalLiquidity[len(m.TotalLiquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7112,7 +14441,8 @@ func (m *GetTotalLiquidityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
+
+func (m *ClaimableLimitOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7135,34 +14465,15 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableLimitOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableLimitOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7190,27 +14501,8 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumNextInitializedTicks", wireType)
-			}
-			m.NumNextInitializedTicks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumNextInitializedTicks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7232,7 +14524,8 @@ func (m *NumNextInitializedTicksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
+
+func (m *ClaimableLimitOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7255,15 +14548,15 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableLimitOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NumNextInitializedTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableLimitOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7290,61 +14583,8 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7369,6 +14609,7 @@ func (m *NumNextInitializedTicksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
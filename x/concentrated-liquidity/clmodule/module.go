@@ -96,7 +96,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	queryproto.RegisterQueryServer(cfg.QueryServer(), grpc.Querier{Q: clclient.Querier{Keeper: am.keeper}})
 }
 
+// RegisterInvariants registers the concentrated liquidity module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	clkeeper.RegisterInvariants(ir, am.keeper)
 }
 
 // QuerierRoute returns the gamm module's querier route name.
@@ -124,8 +126,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock performs a no-op.
+// EndBlock fills the limit orders crossed by the swaps, prunes the position history entries
+// older than the retention period and deletes the ended position migration windows.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if _, err := am.keeper.FillPendingLimitOrders(ctx); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Error filling the pending limit orders: %s", err))
+	}
+	if _, err := am.keeper.PrunePositionHistory(ctx); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Error pruning the position history: %s", err))
+	}
+	if err := am.keeper.DeleteEndedPositionMigrationWindows(ctx); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Error deleting the ended position migration windows: %s", err))
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -145,7 +157,10 @@ func (am AppModule) Actions() []simtypes.Action {
 		simtypes.NewMsgBasedAction("CreateConcentratedPool", am.keeper, simulation.RandomMsgCreateConcentratedPool),
 		simtypes.NewMsgBasedAction("CreatePosition", am.keeper, simulation.RandMsgCreatePosition),
 		simtypes.NewMsgBasedAction("WithdrawPosition", am.keeper, simulation.RandMsgWithdrawPosition),
+		simtypes.NewMsgBasedAction("AddToPosition", am.keeper, simulation.RandMsgAddToPosition),
 		simtypes.NewMsgBasedAction("CollectSpreadRewards", am.keeper, simulation.RandMsgCollectSpreadRewards),
 		simtypes.NewMsgBasedAction("CollectIncentives", am.keeper, simulation.RandMsgCollectIncentives),
+		simtypes.NewMsgBasedAction("SwapExactAmountIn", am.keeper, simulation.RandMsgSwapExactAmountIn),
+		simtypes.NewMsgBasedAction("SwapExactAmountOut", am.keeper, simulation.RandMsgSwapExactAmountOut),
 	}
 }
@@ -0,0 +1,34 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+var _ epochtypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks of the module, which rebalance the managed positions
+// out of range at the end of the rebalance epoch.
+func (k Keeper) EpochHooks() epochtypes.EpochHooks {
+	return &epochhook{k}
+}
+
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == hook.k.GetParams(ctx).ManagedPositionRebalanceEpochIdentifier {
+		if err := hook.k.rebalanceManagedPositions(ctx); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("Error rebalancing managed positions at the epoch end: %s", err))
+		}
+	}
+	return nil
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
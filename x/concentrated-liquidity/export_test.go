@@ -29,6 +29,30 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.ConcentratedPoolExtension) e
 	return k.setPool(ctx, pool)
 }
 
+func (k Keeper) AddRoundingRemainders(ctx sdk.Context, poolId uint64, remainders ...sdk.DecCoin) {
+	k.addRoundingRemainders(ctx, poolId, remainders...)
+}
+
+func RoundingRemainder(denom string, exactAmount osmomath.Dec, amount osmomath.Int) sdk.DecCoin {
+	return roundingRemainder(denom, exactAmount, amount)
+}
+
+func SwapTokenInRoundingRemainder(denom string, exactAmountIn osmomath.Dec, amountIn osmomath.Int, spreadRewards osmomath.Dec) sdk.DecCoin {
+	return swapTokenInRoundingRemainder(denom, exactAmountIn, amountIn, spreadRewards)
+}
+
+func (k Keeper) FullWithdrawalAmounts(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := k.getPoolPositions(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return fullWithdrawalAmounts(ctx, pool, positions)
+}
+
 func (k Keeper) HasPosition(ctx sdk.Context, positionId uint64) bool {
 	return k.hasPosition(ctx, positionId)
 }
@@ -231,8 +255,8 @@ func (k Keeper) CreateUptimeAccumulators(ctx sdk.Context, poolId uint64) error {
 	return k.createUptimeAccumulators(ctx, poolId)
 }
 
-func CalcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, qualifyingLiquidity osmomath.Dec, timeElapsed osmomath.Dec, poolIncentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord, error) {
-	return calcAccruedIncentivesForAccum(ctx, accumUptime, qualifyingLiquidity, timeElapsed, poolIncentiveRecords)
+func CalcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, qualifyingLiquidity osmomath.Dec, accumScalingFactor osmomath.Dec, timeElapsed osmomath.Dec, poolIncentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord, error) {
+	return calcAccruedIncentivesForAccum(ctx, accumUptime, qualifyingLiquidity, accumScalingFactor, timeElapsed, poolIncentiveRecords)
 }
 
 func (k Keeper) UpdateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool types.ConcentratedPoolExtension, uptimeAccums []*accum.AccumulatorObject) error {
@@ -279,10 +303,6 @@ func FindUptimeIndex(uptime time.Duration) (int, error) {
 	return findUptimeIndex(uptime)
 }
 
-func (k Keeper) GetAllPositions(ctx sdk.Context) ([]model.Position, error) {
-	return k.getAllPositions(ctx)
-}
-
 func (k Keeper) UpdatePoolForSwap(ctx sdk.Context, pool types.ConcentratedPoolExtension, swapDetails SwapDetails, poolUpdates PoolUpdates, totalSpreadRewards osmomath.Dec) error {
 	return k.updatePoolForSwap(ctx, pool, swapDetails, poolUpdates, totalSpreadRewards)
 }
@@ -333,6 +353,14 @@ func (k Keeper) TransferPositions(ctx sdk.Context, positionIds []uint64, sender
 	return k.transferPositions(ctx, positionIds, sender, recipient)
 }
 
+func (k Keeper) WrapPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (string, error) {
+	return k.wrapPosition(ctx, sender, positionId)
+}
+
+func (k Keeper) UnwrapPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, error) {
+	return k.unwrapPosition(ctx, sender, positionId)
+}
+
 func (k Keeper) SetPoolHookContract(ctx sdk.Context, poolID uint64, actionPrefix string, cosmwasmAddress string) error {
 	return k.setPoolHookContract(ctx, poolID, actionPrefix, cosmwasmAddress)
 }
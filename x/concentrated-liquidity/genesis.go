@@ -57,7 +57,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 
 		// set up incentive accumulators
 		for _, incentiveAccum := range poolData.IncentivesAccumulators {
-			err = accum.MakeAccumulatorWithValueAndShare(store, incentiveAccum.GetName(), incentiveAccum.AccumContent.AccumValue, incentiveAccum.AccumContent.TotalShares)
+			scalingFactor := osmomath.OneDec()
+			if incentiveAccum.AccumContent.ScalingFactor != nil {
+				scalingFactor = *incentiveAccum.AccumContent.ScalingFactor
+			}
+			err = accum.MakeAccumulatorWithValueShareAndScalingFactor(store, incentiveAccum.GetName(), incentiveAccum.AccumContent.AccumValue, incentiveAccum.AccumContent.TotalShares, scalingFactor)
 			if err != nil {
 				panic(err)
 			}
@@ -68,6 +72,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+
+		// set rounding remainders
+		k.addRoundingRemainders(ctx, poolId, poolData.RoundingRemainders...)
+
+		// set swap statistics
+		for _, coin := range poolData.SwapVolume {
+			addToCumulativeAmount(store, types.KeySwapVolume(poolId, coin.Denom), coin.Amount)
+		}
+		for _, coin := range poolData.SpreadRewardsCollected {
+			addToCumulativeAmount(store, types.KeySpreadRewardsCollected(poolId, coin.Denom), coin.Amount)
+		}
 	}
 
 	// set positions for pool
@@ -101,6 +116,46 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 		}
 	}
 
+	// set claim allowances
+	for _, allowance := range genState.ClaimAllowances {
+		k.setClaimAllowance(ctx, allowance)
+	}
+
+	// set position liens
+	for _, lien := range genState.PositionLiens {
+		if !k.hasPosition(ctx, lien.PositionId) {
+			panic(fmt.Sprintf("found lien on position id (%d) but there is no position with such id that exists", lien.PositionId))
+		}
+		k.setPositionLien(ctx, lien)
+	}
+
+	// set managed positions
+	for _, managedPosition := range genState.ManagedPositions {
+		if !k.hasPosition(ctx, managedPosition.PositionId) {
+			panic(fmt.Sprintf("found managed position id (%d) but there is no position with such id that exists", managedPosition.PositionId))
+		}
+		k.setManagedPosition(ctx, managedPosition)
+	}
+
+	// set position history, the entries of each position being exported in chronological order
+	for _, entry := range genState.PositionHistory {
+		k.addPositionHistoryEntry(ctx, entry)
+	}
+
+	// set position migration windows
+	for _, window := range genState.PositionMigrationWindows {
+		k.setPositionMigrationWindow(ctx, window)
+	}
+
+	// set limit orders
+	for _, limitOrder := range genState.LimitOrders {
+		k.setLimitOrder(ctx, limitOrder)
+		// The pools are rechecked at the end of the first block in case some open orders are already crossed.
+		if !limitOrder.Filled {
+			ctx.KVStore(k.storeKey).Set(types.KeyPendingLimitOrderFills(limitOrder.PoolId), []byte{})
+		}
+	}
+
 	// set total liquidity
 	k.setTotalLiquidity(ctx, totalLiquidity)
 }
@@ -163,19 +218,42 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 					TotalShares: incentiveAccumTotalShares,
 				},
 			}
+			// The scaling factor is only exported for accumulators with scaling,
+			// matching how it is persisted in state.
+			if scalingFactor := incentiveAccum.GetScalingFactor(); !scalingFactor.Equal(osmomath.OneDec()) {
+				genesisAccum.AccumContent.ScalingFactor = &scalingFactor
+			}
 			incentivesAccumObject[i] = genesisAccum
 		}
 
+		roundingRemainders, err := k.GetRoundingRemainders(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
+		swapVolume, err := k.GetPoolSwapVolume(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
+		spreadRewardsCollected, err := k.GetPoolSpreadRewardsCollected(ctx, poolId)
+		if err != nil {
+			panic(err)
+		}
+
 		poolData = append(poolData, genesis.PoolData{
 			Pool:                    &anyCopy,
 			Ticks:                   ticks,
 			SpreadRewardAccumulator: spreadRewardAccumObject,
 			IncentivesAccumulators:  incentivesAccumObject,
 			IncentiveRecords:        incentiveRecordsForPool,
+			RoundingRemainders:      roundingRemainders,
+			SwapVolume:              swapVolume,
+			SpreadRewardsCollected:  spreadRewardsCollected,
 		})
 	}
 
-	positions, err := k.getAllPositions(ctx)
+	positions, err := k.GetAllPositions(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -230,12 +308,48 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *genesis.GenesisState {
 		})
 	}
 
+	claimAllowances, err := k.GetAllClaimAllowances(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionLiens, err := k.GetAllPositionLiens(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	managedPositions, err := k.GetAllManagedPositions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionHistory, err := k.GetAllPositionHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	positionMigrationWindows, err := k.GetAllPositionMigrationWindows(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis.GenesisState{
-		Params:                k.GetParams(ctx),
-		PoolData:              poolData,
-		PositionData:          positionData,
-		NextPositionId:        k.GetNextPositionId(ctx),
-		NextIncentiveRecordId: k.GetNextIncentiveRecordId(ctx),
+		Params:                   k.GetParams(ctx),
+		PoolData:                 poolData,
+		PositionData:             positionData,
+		NextPositionId:           k.GetNextPositionId(ctx),
+		NextIncentiveRecordId:    k.GetNextIncentiveRecordId(ctx),
+		ClaimAllowances:          claimAllowances,
+		PositionLiens:            positionLiens,
+		ManagedPositions:         managedPositions,
+		PositionHistory:          positionHistory,
+		PositionMigrationWindows: positionMigrationWindows,
+		LimitOrders:              limitOrders,
 	}
 }
 
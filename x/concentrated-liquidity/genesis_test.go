@@ -27,6 +27,9 @@ type singlePoolGenesisEntry struct {
 	spreadFactorAccumValues genesis.AccumObject
 	incentiveAccumulators   []genesis.AccumObject
 	incentiveRecords        []types.IncentiveRecord
+	roundingRemainders      sdk.DecCoins
+	swapVolume              sdk.Coins
+	spreadRewardsCollected  sdk.Coins
 }
 
 var (
@@ -111,6 +114,11 @@ func withPositionId(position model.Position, positionId uint64) *model.Position
 	return &position
 }
 
+func withClaimAllowances(genesis genesis.GenesisState, allowances ...types.ClaimAllowance) genesis.GenesisState {
+	genesis.ClaimAllowances = allowances
+	return genesis
+}
+
 func incentiveAccumsWithPoolId(poolId uint64) []genesis.AccumObject {
 	return []genesis.AccumObject{
 		{
@@ -168,7 +176,7 @@ func incentiveAccumsWithPoolId(poolId uint64) []genesis.AccumObject {
 // The function iterates over the poolGenesisEntries, and for each entry, it creates a new Any type using
 // the pool's data, then appends a new PoolData structure containing the pool and its corresponding
 // ticks to the baseGenesis.PoolData. It also appends the corresponding positions to the
-// baseGenesis.Positions, along with the incentive records and accumulator values for spread rewards and incentives.
+// baseGenesis.Positions, along with the incentive records, rounding remainders and accumulator values for spread rewards and incentives.
 func setupGenesis(baseGenesis genesis.GenesisState, poolGenesisEntries []singlePoolGenesisEntry) genesis.GenesisState {
 	for _, poolGenesisEntry := range poolGenesisEntries {
 		poolCopy := poolGenesisEntry.pool
@@ -182,6 +190,9 @@ func setupGenesis(baseGenesis genesis.GenesisState, poolGenesisEntries []singleP
 			SpreadRewardAccumulator: poolGenesisEntry.spreadFactorAccumValues,
 			IncentivesAccumulators:  poolGenesisEntry.incentiveAccumulators,
 			IncentiveRecords:        poolGenesisEntry.incentiveRecords,
+			RoundingRemainders:      poolGenesisEntry.roundingRemainders,
+			SwapVolume:              poolGenesisEntry.swapVolume,
+			SpreadRewardsCollected:  poolGenesisEntry.spreadRewardsCollected,
 		})
 		baseGenesis.PositionData = append(baseGenesis.PositionData, poolGenesisEntry.positionData...)
 		baseGenesis.NextPositionId = uint64(len(poolGenesisEntry.positionData))
@@ -612,14 +623,16 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 	defaultTime1 := time.Unix(100, 100)
 	defaultTime2 := time.Unix(300, 100)
+	// UTC to match the time unmarshaled from state.
+	allowanceExpiration := defaultTime2.UTC()
 
 	testCase := []struct {
 		name    string
 		genesis genesis.GenesisState
 	}{
 		{
-			name: "one pool, one position, two ticks, one accumulator, two incentive records",
-			genesis: setupGenesis(baseGenesis, []singlePoolGenesisEntry{
+			name: "one pool, one position, two ticks, one accumulator, two incentive records, two rounding remainders",
+			genesis: withClaimAllowances(setupGenesis(baseGenesis, []singlePoolGenesisEntry{
 				{
 					pool: *poolOne,
 					tick: []genesis.FullTick{
@@ -664,7 +677,17 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 							IncentiveId: 2,
 						},
 					},
+					roundingRemainders: sdk.DecCoins{
+						{Denom: "bar", Amount: osmomath.MustNewDecFromStr("-0.000000000000000001")},
+						{Denom: "foo", Amount: osmomath.MustNewDecFromStr("1.5")},
+					},
+					swapVolume:             sdk.NewCoins(sdk.NewCoin("bar", osmomath.NewInt(100)), sdk.NewCoin("foo", osmomath.NewInt(50))),
+					spreadRewardsCollected: sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(3))),
 				},
+			}), types.ClaimAllowance{
+				Owner:      testAddressOne.String(),
+				Grantee:    testAddressTwo.String(),
+				Expiration: &allowanceExpiration,
 			}),
 		},
 		{
@@ -798,6 +821,13 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 					s.Require().Equal(incentiveRecord.IncentiveRecordBody.RemainingCoin.String(), expectedPoolData.IncentiveRecords[i].IncentiveRecordBody.RemainingCoin.String())
 					s.Require().True(incentiveRecord.IncentiveRecordBody.StartTime.Equal(expectedPoolData.IncentiveRecords[i].IncentiveRecordBody.StartTime))
 				}
+
+				// Validate rounding remainders
+				s.Require().Equal(expectedPoolData.RoundingRemainders.String(), actualPoolData.RoundingRemainders.String())
+
+				// Validate swap stats
+				s.Require().Equal(expectedPoolData.SwapVolume.String(), actualPoolData.SwapVolume.String())
+				s.Require().Equal(expectedPoolData.SpreadRewardsCollected.String(), actualPoolData.SpreadRewardsCollected.String())
 			}
 
 			// Validate uptime accumulators
@@ -814,6 +844,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 
 			// Validate next position id.
 			s.Require().Equal(tc.genesis.NextPositionId, actualExported.NextPositionId)
+
+			// Validate claim allowances.
+			s.Require().ElementsMatch(tc.genesis.ClaimAllowances, actualExported.ClaimAllowances)
 		})
 	}
 }
@@ -30,6 +30,16 @@ func (k Keeper) HandleTickSpacingDecreaseProposal(ctx sdk.Context, p *types.Tick
 	return k.DecreaseConcentratedPoolTickSpacing(ctx, p.PoolIdToTickSpacingRecords)
 }
 
+// HandleSweepRoundingRemaindersProposal handles a sweep rounding remainders proposal to the corresponding keeper method.
+func (k Keeper) HandleSweepRoundingRemaindersProposal(ctx sdk.Context, p *types.SweepRoundingRemaindersProposal) error {
+	return k.SweepRoundingRemainders(ctx, p.PoolIds)
+}
+
+// HandlePositionMigrationWindowProposal handles a position migration window proposal to the corresponding keeper method.
+func (k Keeper) HandlePositionMigrationWindowProposal(ctx sdk.Context, p *types.PositionMigrationWindowProposal) error {
+	return k.SetPositionMigrationWindow(ctx, p.FromPoolId, p.ToPoolId, p.Duration)
+}
+
 func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 	return func(ctx sdk.Context, content govtypesv1.Content) error {
 		switch c := content.(type) {
@@ -37,6 +47,10 @@ func NewConcentratedLiquidityProposalHandler(k Keeper) govtypesv1.Handler {
 			return k.HandleTickSpacingDecreaseProposal(ctx, c)
 		case *types.CreateConcentratedLiquidityPoolsProposal:
 			return k.HandleCreateConcentratedLiquidityPoolsProposal(ctx, c)
+		case *types.SweepRoundingRemaindersProposal:
+			return k.HandleSweepRoundingRemaindersProposal(ctx, c)
+		case *types.PositionMigrationWindowProposal:
+			return k.HandlePositionMigrationWindowProposal(ctx, c)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"time"
 
-	sdkprefix "github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"golang.org/x/exp/slices"
@@ -19,10 +18,10 @@ import (
 )
 
 // createUptimeAccumulators creates accumulator objects in store for each supported uptime for the given poolId.
-// The accumulators are initialized with the default (zero) values.
+// The accumulators are initialized with the default (zero) values and scaled by types.PerUnitLiqScalingFactor.
 func (k Keeper) createUptimeAccumulators(ctx sdk.Context, poolId uint64) error {
 	for uptimeIndex := range types.SupportedUptimes {
-		err := accum.MakeAccumulator(ctx.KVStore(k.storeKey), types.KeyUptimeAccumulator(poolId, uint64(uptimeIndex)))
+		err := accum.MakeAccumulatorWithScalingFactor(ctx.KVStore(k.storeKey), types.KeyUptimeAccumulator(poolId, uint64(uptimeIndex)), types.PerUnitLiqScalingFactor)
 		if err != nil {
 			return err
 		}
@@ -31,6 +30,53 @@ func (k Keeper) createUptimeAccumulators(ctx sdk.Context, poolId uint64) error {
 	return nil
 }
 
+// MigrateUptimeAccumulatorsToScalingFactor migrates the uptime accumulators of every concentrated liquidity pool
+// that were created without scaling to types.PerUnitLiqScalingFactor. The uptime growth outside of every tick
+// of the migrated pools is scaled up by the same factor so that it remains consistent with the accumulators.
+// Pools whose uptime accumulators are already scaled are skipped.
+// Returns the number of pools migrated.
+func (k Keeper) MigrateUptimeAccumulatorsToScalingFactor(ctx sdk.Context) (int, error) {
+	pools, err := k.getConcentratedPools(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for _, pool := range pools {
+		poolId := pool.GetId()
+		uptimeAccums, err := k.GetUptimeAccumulators(ctx, poolId)
+		if err != nil {
+			return 0, err
+		}
+
+		// All uptime accumulators of a pool are created and migrated together.
+		if !uptimeAccums[0].GetScalingFactor().Equal(osmomath.OneDec()) {
+			continue
+		}
+
+		for _, uptimeAccum := range uptimeAccums {
+			if err := uptimeAccum.MigrateToScalingFactor(types.PerUnitLiqScalingFactor); err != nil {
+				return 0, err
+			}
+		}
+
+		ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+		if err != nil {
+			return 0, err
+		}
+		for _, tick := range ticks {
+			tickInfo := tick.Info
+			for i, uptimeTracker := range tickInfo.UptimeTrackers.List {
+				tickInfo.UptimeTrackers.List[i].UptimeGrowthOutside = uptimeTracker.UptimeGrowthOutside.MulDec(types.PerUnitLiqScalingFactor)
+			}
+			k.SetTickInfo(ctx, poolId, tick.TickIndex, &tickInfo)
+		}
+
+		migrated++
+	}
+	return migrated, nil
+}
+
 // getUptimeTrackerValues extracts the values of an array of uptime trackers
 func getUptimeTrackerValues(uptimeTrackers []model.UptimeTracker) []sdk.DecCoins {
 	trackerValues := []sdk.DecCoins{}
@@ -189,7 +235,7 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 		for uptimeIndex := range uptimeAccums {
 			// Get relevant uptime-level values
 			curUptimeDuration := types.SupportedUptimes[uptimeIndex]
-			incentivesToAddToCurAccum, updatedPoolRecords, err := calcAccruedIncentivesForAccum(ctx, curUptimeDuration, qualifyingLiquidity, timeElapsedSec, poolIncentiveRecords)
+			incentivesToAddToCurAccum, updatedPoolRecords, err := calcAccruedIncentivesForAccum(ctx, curUptimeDuration, qualifyingLiquidity, uptimeAccums[uptimeIndex].GetScalingFactor(), timeElapsedSec, poolIncentiveRecords)
 			if err != nil {
 				return err
 			}
@@ -221,8 +267,9 @@ func (k Keeper) updateGivenPoolUptimeAccumulatorsToNow(ctx sdk.Context, pool typ
 // This function is non-mutative. It operates on and returns an updated _copy_ of the passed in incentives records.
 // Returns the IncentivesPerLiquidity value and an updated list of IncentiveRecords that
 // reflect emitted incentives
+// The IncentivesPerLiquidity value is scaled up by the given scaling factor of the accumulator it is added to.
 // Returns error if the qualifying liquidity/time elapsed are zero.
-func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, liquidityInAccum osmomath.Dec, timeElapsed osmomath.Dec, poolIncentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord, error) {
+func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, liquidityInAccum osmomath.Dec, accumScalingFactor osmomath.Dec, timeElapsed osmomath.Dec, poolIncentiveRecords []types.IncentiveRecord) (sdk.DecCoins, []types.IncentiveRecord, error) {
 	if !liquidityInAccum.IsPositive() || !timeElapsed.IsPositive() {
 		return sdk.DecCoins{}, []types.IncentiveRecord{}, types.QualifyingLiquidityOrTimeElapsedNotPositiveError{QualifyingLiquidity: liquidityInAccum, TimeElapsed: timeElapsed}
 	}
@@ -241,9 +288,10 @@ func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, l
 		// Total amount emitted = time elapsed * emission
 		totalEmittedAmount := timeElapsed.Mul(incentiveRecordBody.EmissionRate)
 
-		// Incentives to emit per unit of qualifying liquidity = total emitted / liquidityInAccum
+		// Incentives to emit per unit of qualifying liquidity = total emitted * accum scaling factor / liquidityInAccum
+		// The amount is scaled up prior to dividing so that small emissions on large liquidity do not truncate to zero.
 		// Note that we truncate to ensure we do not overdistribute incentives
-		incentivesPerLiquidity := totalEmittedAmount.QuoTruncate(liquidityInAccum)
+		incentivesPerLiquidity := totalEmittedAmount.MulTruncate(accumScalingFactor).QuoTruncate(liquidityInAccum)
 		emittedIncentivesPerLiquidity := sdk.NewDecCoinFromDec(incentiveRecordBody.RemainingCoin.Denom, incentivesPerLiquidity)
 
 		// Ensure that we only emit if there are enough incentives remaining to be emitted
@@ -261,7 +309,7 @@ func calcAccruedIncentivesForAccum(ctx sdk.Context, accumUptime time.Duration, l
 		} else {
 			// If there are not enough incentives remaining to be emitted, we emit the remaining rewards.
 			// When the returned records are set in state, all records with remaining rewards of zero will be cleared.
-			remainingIncentivesPerLiquidity := remainingRewards.QuoTruncate(liquidityInAccum)
+			remainingIncentivesPerLiquidity := remainingRewards.MulTruncate(accumScalingFactor).QuoTruncate(liquidityInAccum)
 			emittedIncentivesPerLiquidity = sdk.NewDecCoinFromDec(incentiveRecordBody.RemainingCoin.Denom, remainingIncentivesPerLiquidity)
 			incentivesToAddToCurAccum = incentivesToAddToCurAccum.Add(emittedIncentivesPerLiquidity)
 
@@ -357,35 +405,21 @@ func (k Keeper) GetAllIncentiveRecordsForPool(ctx sdk.Context, poolId uint64) ([
 
 // GetIncentiveRecordSerialized gets incentive records based on limit set by pagination request.
 func (k Keeper) GetIncentiveRecordSerialized(ctx sdk.Context, poolId uint64, pagination *query.PageRequest) ([]types.IncentiveRecord, *query.PageResponse, error) {
-	incentivesRecordStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPoolIncentiveRecords(poolId))
-
-	incentiveRecords := []types.IncentiveRecord{}
-	pageRes, err := query.Paginate(incentivesRecordStore, pagination, func(key, value []byte) error {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParserPaginated(ctx.KVStore(k.storeKey), types.KeyPoolIncentiveRecords(poolId), pagination, func(key, _ []byte) (types.IncentiveRecord, error) {
 		parts := bytes.Split(key, []byte(types.KeySeparator))
 
 		minUptimeIndex, err := strconv.ParseUint(string(parts[0]), 10, 64)
 		if err != nil {
-			return err
+			return types.IncentiveRecord{}, err
 		}
 
 		incentiveRecordId, err := strconv.ParseUint(string(parts[1]), 10, 64)
 		if err != nil {
-			return err
-		}
-
-		incRecord, err := k.GetIncentiveRecord(ctx, poolId, types.SupportedUptimes[minUptimeIndex], incentiveRecordId)
-		if err != nil {
-			return err
+			return types.IncentiveRecord{}, err
 		}
 
-		incentiveRecords = append(incentiveRecords, incRecord)
-
-		return nil
+		return k.GetIncentiveRecord(ctx, poolId, types.SupportedUptimes[minUptimeIndex], incentiveRecordId)
 	})
-	if err != nil {
-		return nil, nil, err
-	}
-	return incentiveRecords, pageRes, err
 }
 
 // getAllIncentiveRecordsForUptime gets all the incentive records for the given poolId and minUptime
@@ -405,6 +439,117 @@ func (k Keeper) getAllIncentiveRecordsForUptime(ctx sdk.Context, poolId uint64,
 	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyUptimeIncentiveRecords(poolId, uptimeIndex), ParseFullIncentiveRecordFromBz)
 }
 
+// GetRemainingPoolIncentiveRecordSlots returns the number of incentive records that can still be created
+// for the given pool across all uptimes before reaching the per pool incentive record cap.
+// Returns error if the pool does not exist.
+func (k Keeper) GetRemainingPoolIncentiveRecordSlots(ctx sdk.Context, poolId uint64) (uint64, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return 0, err
+	}
+
+	numRecords := k.countIncentiveRecords(ctx, types.KeyPoolIncentiveRecords(poolId))
+	return remainingIncentiveRecordSlots(k.GetParams(ctx).MaxIncentiveRecordsPerPool, numRecords), nil
+}
+
+// GetRemainingIncentiveRecordSlots returns the number of incentive records that can still be created
+// for the given pool and uptime before reaching either the per pool or the per uptime incentive record cap.
+// Returns error if the pool does not exist or the uptime is not supported.
+func (k Keeper) GetRemainingIncentiveRecordSlots(ctx sdk.Context, poolId uint64, minUptime time.Duration) (uint64, error) {
+	poolSlots, err := k.GetRemainingPoolIncentiveRecordSlots(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	uptimeIndex, err := findUptimeIndex(minUptime)
+	if err != nil {
+		return 0, err
+	}
+
+	numRecords := k.countIncentiveRecords(ctx, types.KeyUptimeIncentiveRecords(poolId, uptimeIndex))
+	uptimeSlots := remainingIncentiveRecordSlots(k.GetParams(ctx).MaxIncentiveRecordsPerUptime, numRecords)
+	if uptimeSlots < poolSlots {
+		return uptimeSlots, nil
+	}
+	return poolSlots, nil
+}
+
+// countIncentiveRecords returns the number of incentive records under the given prefix
+// without unmarshalling them.
+func (k Keeper) countIncentiveRecords(ctx sdk.Context, prefix []byte) uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	numRecords := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		numRecords++
+	}
+	return numRecords
+}
+
+// remainingIncentiveRecordSlots returns the number of records that can be added to the given number of records
+// before reaching the given cap. Returns zero if the cap has already been reached, e.g. because it was lowered.
+func remainingIncentiveRecordSlots(maxRecords, numRecords uint64) uint64 {
+	if numRecords >= maxRecords {
+		return 0
+	}
+	return maxRecords - numRecords
+}
+
+// secondsPerDay is the period over which GetIncentivesPreview projects incentives.
+var secondsPerDay = osmomath.NewDec(int64(24 * time.Hour / time.Second))
+
+// GetIncentivesPreview returns the incentives that a position with the given tick range and liquidity would earn per day
+// in the given pool if it was kept for at least the given uptime, along with whether the position would be in range.
+// The projection follows the emission of the uptime accumulators at the current block time: every incentive record that
+// has started emitting and has a min uptime of at most the given uptime emits its daily amount, capped by its remaining
+// incentives, pro rata to the active liquidity of the pool including the position. Positions out of range earn nothing.
+// Returns error if the pool does not exist, the tick range is invalid or the liquidity is not positive.
+func (k Keeper) GetIncentivesPreview(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, liquidity osmomath.Dec, uptime time.Duration) (sdk.DecCoins, bool, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return nil, false, err
+	}
+
+	if !liquidity.IsPositive() {
+		return nil, false, types.NotPositiveRequireAmountError{Amount: liquidity.String()}
+	}
+
+	incentivesPerDay := sdk.NewDecCoins()
+	if !pool.IsCurrentTickInRange(lowerTick, upperTick) {
+		return incentivesPerDay, false, nil
+	}
+
+	// As in updateGivenPoolUptimeAccumulatorsToNow, all liquidity on the active tick qualifies,
+	// and no incentives are emitted while it is below one.
+	qualifyingLiquidity := pool.GetLiquidity().Add(liquidity)
+	if qualifyingLiquidity.LT(osmomath.OneDec()) {
+		return incentivesPerDay, true, nil
+	}
+
+	poolIncentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, poolId)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, incentiveRecord := range poolIncentiveRecords {
+		incentiveRecordBody := incentiveRecord.IncentiveRecordBody
+		if !incentiveRecordBody.StartTime.UTC().Before(ctx.BlockTime().UTC()) || incentiveRecord.MinUptime > uptime {
+			// Records that have not started emitting or require a longer uptime do not contribute.
+			continue
+		}
+
+		emittedAmount := osmomath.MinDec(incentiveRecordBody.EmissionRate.Mul(secondsPerDay), incentiveRecordBody.RemainingCoin.Amount)
+		positionIncentives := emittedAmount.Mul(liquidity).QuoTruncate(qualifyingLiquidity)
+		incentivesPerDay = incentivesPerDay.Add(sdk.NewDecCoinFromDec(incentiveRecordBody.RemainingCoin.Denom, positionIncentives))
+	}
+
+	return incentivesPerDay, true, nil
+}
+
 // GetUptimeGrowthInsideRange returns the uptime growth within the given tick range for all supported uptimes.
 // UptimeGrowthInside tracks the incentives accured by a specific LP within a pool. It keeps track of the cumulative amount of incentives
 // collected by a specific LP within a pool. This function also measures the growth of incentives accured by a particular LP since the last
@@ -648,6 +793,13 @@ func moveRewardsToNewPositionAndDeleteOldAcc(accum *accum.AccumulatorObject, old
 //
 // Returns error if the position/uptime accumulators don't exist, or if there is an issue that arises while claiming.
 func (k Keeper) prepareClaimAllIncentivesForPosition(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, error) {
+	return k.prepareClaimIncentivesForPosition(ctx, positionId, true)
+}
+
+// prepareClaimIncentivesForPosition is like prepareClaimAllIncentivesForPosition, except that if forfeitUnmatured is false,
+// the incentives of the uptimes the position has not reached yet are not claimed but left in their accumulators,
+// so that they can still be collected once the position reaches these uptimes.
+func (k Keeper) prepareClaimIncentivesForPosition(ctx sdk.Context, positionId uint64, forfeitUnmatured bool) (sdk.Coins, sdk.Coins, error) {
 	// Retrieve the position with the given ID.
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
@@ -696,12 +848,17 @@ func (k Keeper) prepareClaimAllIncentivesForPosition(ctx sdk.Context, positionId
 
 		// If the accumulator contains the position, claim the position's incentives.
 		if hasPosition {
+			isUptimeReached := positionAge >= supportedUptimes[uptimeIndex]
+			if !isUptimeReached && !forfeitUnmatured {
+				continue
+			}
+
 			collectedIncentivesForUptime, _, err := updateAccumAndClaimRewards(uptimeAccum, positionName, uptimeGrowthOutside[uptimeIndex])
 			if err != nil {
 				return sdk.Coins{}, sdk.Coins{}, err
 			}
 
-			if positionAge < supportedUptimes[uptimeIndex] {
+			if !isUptimeReached {
 				// If the age of the position is less than the current uptime we are iterating through, then the position's
 				// incentives are forfeited to the community pool. The parent function does the actual bank send.
 				forfeitedIncentivesForPosition = forfeitedIncentivesForPosition.Add(collectedIncentivesForUptime...)
@@ -729,6 +886,13 @@ func (k Keeper) GetClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.
 // - position with the given id does not exist
 // - other internal database or math errors.
 func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, error) {
+	return k.collectIncentivesForPosition(ctx, sender, positionId, true)
+}
+
+// collectIncentivesForPosition is like collectIncentives, except that if forfeitUnmatured is false,
+// the incentives of the uptimes the position has not reached yet are neither collected nor forfeited,
+// but left to be collected once the position reaches these uptimes.
+func (k Keeper) collectIncentivesForPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64, forfeitUnmatured bool) (sdk.Coins, sdk.Coins, error) {
 	// Retrieve the position with the given ID.
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
@@ -743,7 +907,7 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 	}
 
 	// Claim all incentives for the position.
-	collectedIncentivesForPosition, forfeitedIncentivesForPosition, err := k.prepareClaimAllIncentivesForPosition(ctx, position.PositionId)
+	collectedIncentivesForPosition, forfeitedIncentivesForPosition, err := k.prepareClaimIncentivesForPosition(ctx, position.PositionId, forfeitUnmatured)
 	if err != nil {
 		return sdk.Coins{}, sdk.Coins{}, err
 	}
@@ -797,6 +961,7 @@ func (k Keeper) collectIncentives(ctx sdk.Context, sender sdk.AccAddress, positi
 // - emissionRate is invalid (zero or negative)
 // - startTime is < blockTime.
 // - minUptime is not an authorizedUptime.
+// - the pool has reached the cap on active incentive records for the pool or for minUptime.
 // - other internal database or math errors.
 // WARNING: this method may mutate the pool, make sure to refetch the pool after calling this method.
 func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAddress, incentiveCoin sdk.Coin, emissionRate osmomath.Dec, startTime time.Time, minUptime time.Duration) (types.IncentiveRecord, error) {
@@ -851,6 +1016,18 @@ func (k Keeper) CreateIncentive(ctx sdk.Context, poolId uint64, sender sdk.AccAd
 		return types.IncentiveRecord{}, err
 	}
 
+	// Ensure the pool has not reached the caps on active incentive records, which bound the iteration
+	// over them when updating the uptime accumulators. This is checked after syncing the accumulators
+	// so that the records that finished emitting are removed first.
+	remainingSlots, err := k.GetRemainingIncentiveRecordSlots(ctx, poolId, minUptime)
+	if err != nil {
+		return types.IncentiveRecord{}, err
+	}
+	if remainingSlots == 0 {
+		params := k.GetParams(ctx)
+		return types.IncentiveRecord{}, types.IncentiveRecordCapReachedError{PoolId: poolId, MinUptime: minUptime, MaxIncentiveRecordsPerPool: params.MaxIncentiveRecordsPerPool, MaxIncentiveRecordsPerUptime: params.MaxIncentiveRecordsPerUptime}
+	}
+
 	// Get an ID unique to this incentive record
 	incentiveRecordId := k.GetNextIncentiveRecordId(ctx)
 	k.SetNextIncentiveRecordId(ctx, incentiveRecordId+1)
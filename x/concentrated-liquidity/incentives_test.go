@@ -9,6 +9,7 @@ import (
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
@@ -176,6 +177,15 @@ func expectedIncentivesFromRate(denom string, rate osmomath.Dec, timeElapsed tim
 	return sdk.NewDecCoinFromDec(denom, amount)
 }
 
+// expectedScaledIncentivesFromRate calculates the amount of incentives per unit of liquidity we expect to be added to
+// an uptime accumulator based on the rate and time elapsed, scaled up by the scaling factor of uptime accumulators.
+func expectedScaledIncentivesFromRate(denom string, rate osmomath.Dec, timeElapsed time.Duration, qualifyingLiquidity osmomath.Dec) sdk.DecCoin {
+	timeInSec := osmomath.NewDec(int64(timeElapsed)).Quo(osmomath.MustNewDecFromStr("1000000000"))
+	amount := rate.Mul(timeInSec).MulTruncate(types.PerUnitLiqScalingFactor).QuoTruncate(qualifyingLiquidity)
+
+	return sdk.NewDecCoinFromDec(denom, amount)
+}
+
 // expectedIncentivesFromUptimeGrowth calculates the amount of incentives we expect to accrue based on uptime accumulator growth.
 //
 // Assumes `uptimeGrowths` represents the growths for all global uptime accums and only counts growth that `timeInPool` qualifies for
@@ -218,6 +228,17 @@ func addToUptimeAccums(ctx sdk.Context, poolId uint64, clKeeper *cl.Keeper, addV
 	return nil
 }
 
+// Helper for scaling uptime growth per unit of liquidity up by the scaling factor of uptime accumulators,
+// matching how incentives are added to the accumulators when they are emitted.
+func scaleUptimeGrowth(uptimeGrowth []sdk.DecCoins) []sdk.DecCoins {
+	scaledUptimeGrowth := make([]sdk.DecCoins, len(uptimeGrowth))
+	for uptimeIndex, growth := range uptimeGrowth {
+		scaledUptimeGrowth[uptimeIndex] = growth.MulDec(types.PerUnitLiqScalingFactor)
+	}
+
+	return scaledUptimeGrowth
+}
+
 func withDenom(record types.IncentiveRecord, denom string) types.IncentiveRecord {
 	record.IncentiveRecordBody.RemainingCoin.Denom = denom
 
@@ -500,6 +521,7 @@ func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
 		poolId               uint64
 		accumUptime          time.Duration
 		qualifyingLiquidity  osmomath.Dec
+		accumScalingFactor   osmomath.Dec
 		timeElapsed          time.Duration
 		poolIncentiveRecords []types.IncentiveRecord
 		recordsCleared       bool
@@ -697,6 +719,20 @@ func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
 			},
 			expectedPass: true,
 		},
+		"small emission on large liquidity with scaled accumulator": {
+			poolId:               defaultPoolId,
+			accumUptime:          types.SupportedUptimes[0],
+			qualifyingLiquidity:  osmomath.NewDec(100_000_000_000_000_000),
+			accumScalingFactor:   osmomath.NewDec(1_000_000_000_000_000),
+			timeElapsed:          time.Hour,
+			poolIncentiveRecords: []types.IncentiveRecord{incentiveRecordOne},
+
+			expectedResult: sdk.DecCoins{
+				expectedIncentivesFromRate(incentiveRecordOne.IncentiveRecordBody.RemainingCoin.Denom, incentiveRecordOne.IncentiveRecordBody.EmissionRate.Mul(osmomath.NewDec(1_000_000_000_000_000)), time.Hour, osmomath.NewDec(100_000_000_000_000_000)),
+			},
+			expectedIncentiveRecords: []types.IncentiveRecord{chargeIncentiveRecord(incentiveRecordOne, time.Hour)},
+			expectedPass:             true,
+		},
 	}
 
 	s.runMultipleAuthorizedUptimes(func() {
@@ -708,8 +744,13 @@ func (s *KeeperTestSuite) TestCalcAccruedIncentivesForAccum() {
 
 				s.PrepareConcentratedPool()
 
+				accumScalingFactor := tc.accumScalingFactor
+				if accumScalingFactor.IsNil() {
+					accumScalingFactor = osmomath.OneDec()
+				}
+
 				// system under test
-				actualResult, updatedPoolRecords, err := cl.CalcAccruedIncentivesForAccum(s.Ctx, tc.accumUptime, tc.qualifyingLiquidity, osmomath.NewDec(int64(tc.timeElapsed)).Quo(osmomath.MustNewDecFromStr("1000000000")), tc.poolIncentiveRecords)
+				actualResult, updatedPoolRecords, err := cl.CalcAccruedIncentivesForAccum(s.Ctx, tc.accumUptime, tc.qualifyingLiquidity, accumScalingFactor, osmomath.NewDec(int64(tc.timeElapsed)).Quo(osmomath.MustNewDecFromStr("1000000000")), tc.poolIncentiveRecords)
 				if tc.expectedPass {
 					s.Require().NoError(err)
 
@@ -793,7 +834,7 @@ func (s *KeeperTestSuite) TestUpdateUptimeAccumulatorsToNow() {
 			for _, poolRecord := range tc.poolIncentiveRecords {
 				if poolRecord.MinUptime == curSupportedUptime {
 					// We set the expected accrued incentives based on the total time that has elapsed since position creation
-					curUptimeAccruedIncentives = curUptimeAccruedIncentives.Add(sdk.NewDecCoins(expectedIncentivesFromRate(poolRecord.IncentiveRecordBody.RemainingCoin.Denom, poolRecord.IncentiveRecordBody.EmissionRate, defaultTestUptime+tc.timeElapsed, qualifyingLiquidity))...)
+					curUptimeAccruedIncentives = curUptimeAccruedIncentives.Add(sdk.NewDecCoins(expectedScaledIncentivesFromRate(poolRecord.IncentiveRecordBody.RemainingCoin.Denom, poolRecord.IncentiveRecordBody.EmissionRate, defaultTestUptime+tc.timeElapsed, qualifyingLiquidity))...)
 				}
 			}
 			expectedUptimeDeltas = append(expectedUptimeDeltas, curUptimeAccruedIncentives)
@@ -2600,6 +2641,202 @@ func (s *KeeperTestSuite) TestCreateIncentive() {
 	})
 }
 
+// TestCreateIncentive_RecordCap tests that incentive records can only be created while the pool has
+// remaining slots under both the per pool and the per uptime incentive record caps, and that the
+// slots of the records that finished emitting are freed.
+func (s *KeeperTestSuite) TestCreateIncentive_RecordCap() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	clParams := clKeeper.GetParams(s.Ctx)
+	clParams.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Minute}
+	clParams.MaxIncentiveRecordsPerPool = 3
+	clParams.MaxIncentiveRecordsPerUptime = 2
+	clKeeper.SetParams(s.Ctx, clParams)
+
+	pool := s.PrepareConcentratedPool()
+	s.CreateFullRangePosition(pool, DefaultCoins)
+
+	incentiveCoin := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, incentiveCoin.Amount.MulRaw(4))))
+
+	createIncentive := func(minUptime time.Duration) error {
+		_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], incentiveCoin, osmomath.NewDec(1000), s.Ctx.BlockTime(), minUptime)
+		return err
+	}
+	requireRemainingSlots := func(expectedPoolSlots, expectedNanosecondSlots, expectedMinuteSlots uint64) {
+		poolSlots, err := clKeeper.GetRemainingPoolIncentiveRecordSlots(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		s.Require().Equal(expectedPoolSlots, poolSlots)
+
+		nanosecondSlots, err := clKeeper.GetRemainingIncentiveRecordSlots(s.Ctx, pool.GetId(), time.Nanosecond)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedNanosecondSlots, nanosecondSlots)
+
+		minuteSlots, err := clKeeper.GetRemainingIncentiveRecordSlots(s.Ctx, pool.GetId(), time.Minute)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedMinuteSlots, minuteSlots)
+	}
+	capReachedError := func(minUptime time.Duration) error {
+		return types.IncentiveRecordCapReachedError{PoolId: pool.GetId(), MinUptime: minUptime, MaxIncentiveRecordsPerPool: 3, MaxIncentiveRecordsPerUptime: 2}
+	}
+
+	requireRemainingSlots(3, 2, 2)
+
+	s.Require().NoError(createIncentive(time.Nanosecond))
+	requireRemainingSlots(2, 1, 2)
+
+	s.Require().NoError(createIncentive(time.Nanosecond))
+	requireRemainingSlots(1, 0, 1)
+
+	// The per uptime cap is reached.
+	s.Require().ErrorIs(createIncentive(time.Nanosecond), capReachedError(time.Nanosecond))
+
+	s.Require().NoError(createIncentive(time.Minute))
+	requireRemainingSlots(0, 0, 0)
+
+	// The per pool cap is reached.
+	s.Require().ErrorIs(createIncentive(time.Minute), capReachedError(time.Minute))
+
+	// Once the records finish emitting, they are removed and free their slots.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(createIncentive(time.Minute))
+	requireRemainingSlots(2, 2, 1)
+
+	// The remaining slots of a pool that does not exist cannot be queried.
+	_, err := clKeeper.GetRemainingIncentiveRecordSlots(s.Ctx, pool.GetId()+1, time.Nanosecond)
+	s.Require().Error(err)
+}
+
+// TestGetIncentivesPreview tests that the incentives per day projected for a hypothetical position are the
+// daily emissions of the started records it qualifies for, capped by their remaining incentives and shared
+// pro rata with the active liquidity of the pool.
+func (s *KeeperTestSuite) TestGetIncentivesPreview() {
+	const (
+		longUptimeDenom = "ufoo"
+		notStartedDenom = "ubar"
+		cappedDenom     = "ubaz"
+	)
+	liquidity := osmomath.NewDec(1_000_000)
+
+	tests := map[string]struct {
+		poolId            uint64
+		lowerTick         int64
+		upperTick         int64
+		liquidity         osmomath.Dec
+		uptime            time.Duration
+		expectedInRange   bool
+		expectedEmissions sdk.DecCoins
+		expectedError     bool
+	}{
+		"in range, uptime qualifying for the shortest records only": {
+			lowerTick:       DefaultLowerTick,
+			upperTick:       DefaultUpperTick,
+			liquidity:       liquidity,
+			uptime:          time.Hour,
+			expectedInRange: true,
+			expectedEmissions: sdk.NewDecCoins(
+				sdk.NewDecCoin(sdk.DefaultBondDenom, osmomath.NewInt(86_400)),
+				sdk.NewDecCoin(cappedDenom, osmomath.NewInt(100)),
+			),
+		},
+		"in range, uptime qualifying for all records": {
+			lowerTick:       DefaultLowerTick,
+			upperTick:       DefaultUpperTick,
+			liquidity:       liquidity,
+			uptime:          24 * time.Hour,
+			expectedInRange: true,
+			expectedEmissions: sdk.NewDecCoins(
+				sdk.NewDecCoin(sdk.DefaultBondDenom, osmomath.NewInt(86_400)),
+				sdk.NewDecCoin(cappedDenom, osmomath.NewInt(100)),
+				sdk.NewDecCoin(longUptimeDenom, osmomath.NewInt(172_800)),
+			),
+		},
+		"out of range": {
+			lowerTick:         DefaultUpperTick,
+			upperTick:         DefaultUpperTick + 10000,
+			liquidity:         liquidity,
+			uptime:            24 * time.Hour,
+			expectedEmissions: sdk.NewDecCoins(),
+		},
+		"error: invalid tick range": {
+			lowerTick:     DefaultUpperTick,
+			upperTick:     DefaultLowerTick,
+			liquidity:     liquidity,
+			expectedError: true,
+		},
+		"error: liquidity not positive": {
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			liquidity:     osmomath.ZeroDec(),
+			expectedError: true,
+		},
+		"error: pool does not exist": {
+			poolId:        2,
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			liquidity:     liquidity,
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			clParams := clKeeper.GetParams(s.Ctx)
+			clParams.AuthorizedUptimes = []time.Duration{time.Nanosecond, 24 * time.Hour}
+			clKeeper.SetParams(s.Ctx, clParams)
+
+			pool := s.PrepareConcentratedPool()
+			s.CreateFullRangePosition(pool, DefaultCoins)
+
+			incentiveAmount := osmomath.NewInt(1_000_000_000)
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(
+				sdk.NewCoin(sdk.DefaultBondDenom, incentiveAmount),
+				sdk.NewCoin(longUptimeDenom, incentiveAmount),
+				sdk.NewCoin(notStartedDenom, incentiveAmount),
+				sdk.NewCoin(cappedDenom, osmomath.NewInt(100)),
+			))
+			createIncentive := func(coin sdk.Coin, emissionRate int64, startTime time.Time, minUptime time.Duration) {
+				_, err := clKeeper.CreateIncentive(s.Ctx, pool.GetId(), s.TestAccs[0], coin, osmomath.NewDec(emissionRate), startTime, minUptime)
+				s.Require().NoError(err)
+			}
+			createIncentive(sdk.NewCoin(sdk.DefaultBondDenom, incentiveAmount), 1, s.Ctx.BlockTime(), time.Nanosecond)
+			createIncentive(sdk.NewCoin(longUptimeDenom, incentiveAmount), 2, s.Ctx.BlockTime(), 24*time.Hour)
+			createIncentive(sdk.NewCoin(notStartedDenom, incentiveAmount), 1, s.Ctx.BlockTime().Add(time.Hour), time.Nanosecond)
+			// Emits 86400 per day at this rate, but only 100 remain.
+			createIncentive(sdk.NewCoin(cappedDenom, osmomath.NewInt(100)), 1, s.Ctx.BlockTime(), time.Nanosecond)
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+
+			poolId := tc.poolId
+			if poolId == 0 {
+				poolId = pool.GetId()
+			}
+			incentivesPerDay, inRange, err := clKeeper.GetIncentivesPreview(s.Ctx, poolId, tc.lowerTick, tc.upperTick, tc.liquidity, tc.uptime)
+
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedInRange, inRange)
+
+			// The daily emissions are shared with the active liquidity of the pool.
+			pool, err = clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			qualifyingLiquidity := pool.GetLiquidity().Add(tc.liquidity)
+			expectedIncentives := sdk.NewDecCoins()
+			for _, emission := range tc.expectedEmissions {
+				expectedIncentives = expectedIncentives.Add(sdk.NewDecCoinFromDec(emission.Denom, emission.Amount.Mul(tc.liquidity).QuoTruncate(qualifyingLiquidity)))
+			}
+			s.Require().Equal(expectedIncentives, incentivesPerDay)
+		})
+	}
+}
+
 // TestCreateIncentive_NewId tests that the next incentive record id is incremented
 // when and a completely new incentive record is created even when the
 // exact same parameters are used.
@@ -3019,7 +3256,8 @@ func (s *KeeperTestSuite) TestPrepareClaimAllIncentivesForPosition() {
 						position, err := accum.GetPosition(uptimeAccum, newPositionName)
 						s.Require().NoError(err)
 
-						outstandingRewards := accum.GetTotalRewards(uptimeAccum, position)
+						// Rewards are scaled down from the accumulator's scale when claimed.
+						outstandingRewards := accum.GetTotalRewards(uptimeAccum, position).QuoDecTruncate(uptimeAccum.GetScalingFactor())
 						collectedIncentivesForUptime, _ := outstandingRewards.TruncateDecimal()
 
 						for _, coin := range collectedIncentivesForUptime {
@@ -3548,3 +3786,78 @@ func (s *KeeperTestSuite) TestGetIncentiveRecordSerialized() {
 		})
 	}
 }
+
+// TestMigrateUptimeAccumulatorsToScalingFactor tests that the uptime accumulators of pools created without scaling
+// are migrated to the scaling factor, along with the uptime growth of their ticks, without changing the incentives
+// claimable by positions.
+func (s *KeeperTestSuite) TestMigrateUptimeAccumulatorsToScalingFactor() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(defaultStartTime)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Replace the uptime accumulators of the pool with accumulators without scaling,
+	// as they were created prior to the introduction of the scaling factor.
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+	for uptimeIndex := range types.SupportedUptimes {
+		accumKey := []byte("accum||acc||" + types.KeyUptimeAccumulator(poolId, uint64(uptimeIndex)))
+		osmoutils.MustSet(store, accumKey, &accum.AccumulatorContent{AccumValue: sdk.NewDecCoins(), TotalShares: osmomath.ZeroDec()})
+	}
+
+	err := clKeeper.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{withEmissionRate(incentiveRecordOne, osmomath.NewDec(1000))})
+	s.Require().NoError(err)
+
+	// The first position accrues incentives before the second position initializes ticks below the
+	// current tick, which start tracking the uptime growth accrued so far.
+	_, positionIdOne := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+	s.AddBlockTime(time.Hour * 24)
+	_, positionIdTwo := s.SetupPosition(poolId, s.TestAccs[1], DefaultCoins, DefaultLowerTick+DefaultTickSpacing, DefaultUpperTick, false)
+	s.AddBlockTime(time.Hour * 24)
+	err = clKeeper.UpdatePoolUptimeAccumulatorsToNow(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	uptimeAccumValuesPreMigration, err := clKeeper.GetUptimeAccumulatorValues(s.Ctx, poolId)
+	s.Require().NoError(err)
+	ticksPreMigration, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	claimablePreMigration := []sdk.Coins{}
+	for _, positionId := range []uint64{positionIdOne, positionIdTwo} {
+		claimable, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().False(claimable.IsZero())
+		claimablePreMigration = append(claimablePreMigration, claimable)
+	}
+
+	// System under test
+	migrated, err := clKeeper.MigrateUptimeAccumulatorsToScalingFactor(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, migrated)
+
+	uptimeAccums, err := clKeeper.GetUptimeAccumulators(s.Ctx, poolId)
+	s.Require().NoError(err)
+	for uptimeIndex, uptimeAccum := range uptimeAccums {
+		s.Require().Equal(types.PerUnitLiqScalingFactor, uptimeAccum.GetScalingFactor())
+		s.Require().Equal(uptimeAccumValuesPreMigration[uptimeIndex].MulDec(types.PerUnitLiqScalingFactor), uptimeAccum.GetValue())
+	}
+
+	ticksPostMigration, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(len(ticksPreMigration), len(ticksPostMigration))
+	for i, tick := range ticksPostMigration {
+		expectedUptimeGrowth := scaleUptimeGrowth(cl.GetUptimeTrackerValues(ticksPreMigration[i].Info.UptimeTrackers.List))
+		s.Require().Equal(expectedUptimeGrowth, cl.GetUptimeTrackerValues(tick.Info.UptimeTrackers.List))
+	}
+
+	for i, positionId := range []uint64{positionIdOne, positionIdTwo} {
+		claimable, _, err := clKeeper.GetClaimableIncentives(s.Ctx, positionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimablePreMigration[i], claimable)
+	}
+
+	// Pools that are already scaled are not migrated again.
+	migrated, err = clKeeper.MigrateUptimeAccumulatorsToScalingFactor(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(0, migrated)
+}
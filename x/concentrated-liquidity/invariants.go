@@ -0,0 +1,285 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+const (
+	poolBalanceInvariantName      = "pool-balance-covers-positions"
+	poolRewardsInvariantName      = "pool-reward-balances-cover-claimable"
+	tickLiquidityInvariantName    = "tick-liquidity-net-sums-to-zero"
+	accumulatorsInvariantName     = "accumulators-non-negative"
+	wrappedPositionsInvariantName = "wrapped-positions-backed-by-wrapper-token"
+	emptyTicksInvariantName       = "no-empty-ticks-in-state"
+)
+
+// RegisterInvariants registers all concentrated liquidity invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolBalanceInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, poolRewardsInvariantName, PoolRewardsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, tickLiquidityInvariantName, TickLiquidityInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, accumulatorsInvariantName, AccumulatorNonNegativeInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, wrappedPositionsInvariantName, WrappedPositionsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, emptyTicksInvariantName, EmptyTicksInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the concentrated liquidity module.
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			PoolBalanceInvariant(keeper),
+			PoolRewardsInvariant(keeper),
+			TickLiquidityInvariant(keeper),
+			AccumulatorNonNegativeInvariant(keeper),
+			WrappedPositionsInvariant(keeper),
+			EmptyTicksInvariant(keeper),
+		} {
+			if msg, broken := invariant(ctx); broken {
+				return msg, broken
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "all", "\tall concentrated liquidity invariants passed\n"), false
+	}
+}
+
+// invariantCheck is an invariant that may fail to read the state it checks.
+type invariantCheck func(ctx sdk.Context) (msg string, broken bool, err error)
+
+// newInvariant adapts the given check into an sdk.Invariant.
+// Failing to read state does not mean that the invariant is broken, so errors are not reported
+// as a broken invariant. Instead, they panic so that the crisis module surfaces them as the error
+// of the invariant check.
+func newInvariant(name string, check invariantCheck) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken, err := check(ctx)
+		if err != nil {
+			panic(fmt.Errorf("failed to check cl invariant %s: %w", name, err))
+		}
+		return msg, broken
+	}
+}
+
+// verifiedStateInvariant returns an invariant that verifies the state of every pool with VerifyPoolState
+// and is broken by the first discrepancy of the given check.
+func verifiedStateInvariant(keeper Keeper, name, check, passedMsg string) sdk.Invariant {
+	return newInvariant(name, func(ctx sdk.Context) (string, bool, error) {
+		pools, err := keeper.getConcentratedPools(ctx)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, pool := range pools {
+			discrepancies, err := keeper.VerifyPoolState(ctx, pool.GetId())
+			if err != nil {
+				return "", false, err
+			}
+			for _, discrepancy := range discrepancies {
+				if discrepancy.Check == check {
+					return sdk.FormatInvariant(types.ModuleName, name, fmt.Sprintf("\t%s\n", discrepancy)), true, nil
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, name, passedMsg), false, nil
+	})
+}
+
+// PoolBalanceInvariant checks that the balance of every pool account covers the amounts
+// that would be returned if every position in the pool was fully withdrawn.
+// It is broken by the pool balance discrepancies reported by VerifyPoolState.
+func PoolBalanceInvariant(keeper Keeper) sdk.Invariant {
+	return verifiedStateInvariant(keeper, poolBalanceInvariantName, types.CheckPoolBalance,
+		"\tall cl pool balances cover their positions\n")
+}
+
+// PoolRewardsInvariant checks that the spread rewards and incentives accounts of every pool
+// hold at least the spread rewards and incentives claimable (or forfeitable) by its positions.
+func PoolRewardsInvariant(keeper Keeper) sdk.Invariant {
+	return newInvariant(poolRewardsInvariantName, func(ctx sdk.Context) (string, bool, error) {
+		pools, positionsByPool, err := keeper.getPoolsAndPositions(ctx)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, pool := range pools {
+			claimableSpreadRewards, claimableIncentives := sdk.NewCoins(), sdk.NewCoins()
+			for _, position := range positionsByPool[pool.GetId()] {
+				spreadRewards, err := keeper.GetClaimableSpreadRewards(ctx, position.PositionId)
+				if err != nil {
+					return "", false, err
+				}
+				incentives, forfeitedIncentives, err := keeper.GetClaimableIncentives(ctx, position.PositionId)
+				if err != nil {
+					return "", false, err
+				}
+				claimableSpreadRewards = claimableSpreadRewards.Add(spreadRewards...)
+				claimableIncentives = claimableIncentives.Add(incentives...).Add(forfeitedIncentives...)
+			}
+
+			spreadRewardsBalance := keeper.bankKeeper.GetAllBalances(ctx, pool.GetSpreadRewardsAddress())
+			if !spreadRewardsBalance.IsAllGTE(claimableSpreadRewards) {
+				return sdk.FormatInvariant(types.ModuleName, poolRewardsInvariantName,
+					fmt.Sprintf("\tcl pool id %d\n\tclaimable spread rewards: %s\n\tspread rewards account coins: %s\n",
+						pool.GetId(), claimableSpreadRewards, spreadRewardsBalance)), true, nil
+			}
+
+			incentivesBalance := keeper.bankKeeper.GetAllBalances(ctx, pool.GetIncentivesAddress())
+			if !incentivesBalance.IsAllGTE(claimableIncentives) {
+				return sdk.FormatInvariant(types.ModuleName, poolRewardsInvariantName,
+					fmt.Sprintf("\tcl pool id %d\n\tclaimable incentives: %s\n\tincentives account coins: %s\n",
+						pool.GetId(), claimableIncentives, incentivesBalance)), true, nil
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolRewardsInvariantName,
+			"\tall cl pool reward balances cover claimable rewards\n"), false, nil
+	})
+}
+
+// TickLiquidityInvariant checks that the net liquidity of all initialized ticks of every pool sums to zero
+// and that the net liquidity of the ticks at or below the current tick sums to the pool's active liquidity.
+func TickLiquidityInvariant(keeper Keeper) sdk.Invariant {
+	return newInvariant(tickLiquidityInvariantName, func(ctx sdk.Context) (string, bool, error) {
+		pools, err := keeper.getConcentratedPools(ctx)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, pool := range pools {
+			ticks, err := keeper.GetAllInitializedTicksForPool(ctx, pool.GetId())
+			if err != nil {
+				return "", false, err
+			}
+
+			totalLiquidityNet, activeLiquidity := osmomath.ZeroDec(), osmomath.ZeroDec()
+			for _, tick := range ticks {
+				totalLiquidityNet = totalLiquidityNet.Add(tick.Info.LiquidityNet)
+				if tick.TickIndex <= pool.GetCurrentTick() {
+					activeLiquidity = activeLiquidity.Add(tick.Info.LiquidityNet)
+				}
+			}
+
+			if !totalLiquidityNet.IsZero() {
+				return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+					fmt.Sprintf("\tcl pool id %d\n\tnet liquidity across all ticks: %s\n",
+						pool.GetId(), totalLiquidityNet)), true, nil
+			}
+
+			if !activeLiquidity.Equal(pool.GetLiquidity()) {
+				return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+					fmt.Sprintf("\tcl pool id %d\n\tnet liquidity at or below current tick %d: %s\n\tpool liquidity: %s\n",
+						pool.GetId(), pool.GetCurrentTick(), activeLiquidity, pool.GetLiquidity())), true, nil
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, tickLiquidityInvariantName,
+			"\tall cl pool tick liquidity is consistent\n"), false, nil
+	})
+}
+
+// AccumulatorNonNegativeInvariant checks that the global spread reward and uptime accumulators of every pool,
+// as well as the growth trackers of every initialized tick, hold no negative values.
+// It is broken by the accumulator value discrepancies reported by VerifyPoolState.
+func AccumulatorNonNegativeInvariant(keeper Keeper) sdk.Invariant {
+	return verifiedStateInvariant(keeper, accumulatorsInvariantName, types.CheckAccumulatorValue,
+		"\tall cl pool accumulators are non-negative\n")
+}
+
+// WrappedPositionsInvariant checks that the supply of the wrapper denom of every position is exactly one
+// if the position is owned by the position wrapper and zero otherwise.
+func WrappedPositionsInvariant(keeper Keeper) sdk.Invariant {
+	return newInvariant(wrappedPositionsInvariantName, func(ctx sdk.Context) (string, bool, error) {
+		positions, err := keeper.GetAllPositions(ctx)
+		if err != nil {
+			return "", false, err
+		}
+
+		wrapperAddress := types.PositionWrapperAddress.String()
+		for _, position := range positions {
+			expectedSupply := osmomath.ZeroInt()
+			if position.Address == wrapperAddress {
+				expectedSupply = osmomath.OneInt()
+			}
+
+			supply := keeper.bankKeeper.GetSupply(ctx, types.GetPositionWrapperDenom(position.PositionId))
+			if !supply.Amount.Equal(expectedSupply) {
+				return sdk.FormatInvariant(types.ModuleName, wrappedPositionsInvariantName,
+					fmt.Sprintf("\tposition id %d owned by %s\n\texpected wrapper token supply: %s\n\twrapper token supply: %s\n",
+						position.PositionId, position.Address, expectedSupply, supply.Amount)), true, nil
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, wrappedPositionsInvariantName,
+			"\tall wrapped positions are backed by a single wrapper token\n"), false, nil
+	})
+}
+
+// EmptyTicksInvariant checks that no pool has a tick with zero gross liquidity in state,
+// i.e. that ticks are removed once the last position referencing them is withdrawn.
+func EmptyTicksInvariant(keeper Keeper) sdk.Invariant {
+	return newInvariant(emptyTicksInvariantName, func(ctx sdk.Context) (string, bool, error) {
+		pools, err := keeper.getConcentratedPools(ctx)
+		if err != nil {
+			return "", false, err
+		}
+
+		for _, pool := range pools {
+			ticks, err := keeper.GetAllInitializedTicksForPool(ctx, pool.GetId())
+			if err != nil {
+				return "", false, err
+			}
+			for _, tick := range ticks {
+				if tick.Info.LiquidityGross.IsZero() {
+					return sdk.FormatInvariant(types.ModuleName, emptyTicksInvariantName,
+						fmt.Sprintf("\tcl pool id %d\n\ttick %d has zero gross liquidity\n", pool.GetId(), tick.TickIndex)), true, nil
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, emptyTicksInvariantName,
+			"\tno empty ticks in state\n"), false, nil
+	})
+}
+
+// getConcentratedPools returns all concentrated liquidity pools in state.
+func (k Keeper) getConcentratedPools(ctx sdk.Context) ([]types.ConcentratedPoolExtension, error) {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clPools := make([]types.ConcentratedPoolExtension, 0, len(pools))
+	for _, pool := range pools {
+		clPool, ok := pool.(types.ConcentratedPoolExtension)
+		if !ok {
+			return nil, fmt.Errorf("pool id %d is not a concentrated liquidity pool", pool.GetId())
+		}
+		clPools = append(clPools, clPool)
+	}
+	return clPools, nil
+}
+
+// getPoolsAndPositions returns all concentrated liquidity pools in state alongside all positions grouped by pool id.
+func (k Keeper) getPoolsAndPositions(ctx sdk.Context) ([]types.ConcentratedPoolExtension, map[uint64][]model.Position, error) {
+	pools, err := k.getConcentratedPools(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	positions, err := k.GetAllPositions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	positionsByPool := make(map[uint64][]model.Position, len(pools))
+	for _, position := range positions {
+		positionsByPool[position.PoolId] = append(positionsByPool[position.PoolId], position)
+	}
+	return pools, positionsByPool, nil
+}
@@ -8,6 +8,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
@@ -152,3 +153,10 @@ func (k Keeper) SetAuthorizedQuoteDenoms(ctx sdk.Context, authorizedQuoteDenoms
 	params.AuthorizedQuoteDenoms = authorizedQuoteDenoms
 	k.poolmanagerKeeper.SetParams(ctx, params)
 }
+
+// GetTradingPairTakerFee gets the taker fee for the given trading pair from the poolmanager keeper.
+// This method is meant to be used for getting access to x/poolmanager taker fees
+// for use in sim_msgs.go for the CL module.
+func (k Keeper) GetTradingPairTakerFee(ctx sdk.Context, denom0, denom1 string) (osmomath.Dec, error) {
+	return k.poolmanagerKeeper.GetTradingPairTakerFee(ctx, denom0, denom1)
+}
@@ -2,7 +2,6 @@ package concentrated_liquidity_test
 
 import (
 	"fmt"
-	"math/rand"
 	"testing"
 	"time"
 
@@ -238,6 +237,9 @@ func (s *KeeperTestSuite) addLiquidityToUptimeAccumulators(ctx sdk.Context, pool
 func (s *KeeperTestSuite) addUptimeGrowthInsideRange(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, currentTick, lowerTick, upperTick int64, uptimeGrowthToAdd []sdk.DecCoins) {
 	s.Require().True(lowerTick <= upperTick)
 
+	// The growth is given per unit of liquidity and scaled as it would be when emitted.
+	uptimeGrowthToAdd = scaleUptimeGrowth(uptimeGrowthToAdd)
+
 	// Note that we process adds to global accums at the end to ensure that they don't affect the behavior of uninitialized ticks.
 	if currentTick < lowerTick {
 		// Add to lower tick's uptime trackers
@@ -283,6 +285,9 @@ func (s *KeeperTestSuite) addUptimeGrowthInsideRange(ctx sdk.Context, poolId uin
 func (s *KeeperTestSuite) addUptimeGrowthOutsideRange(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, currentTick, lowerTick, upperTick int64, uptimeGrowthToAdd []sdk.DecCoins) {
 	s.Require().True(lowerTick <= upperTick)
 
+	// The growth is given per unit of liquidity and scaled as it would be when emitted.
+	uptimeGrowthToAdd = scaleUptimeGrowth(uptimeGrowthToAdd)
+
 	// Note that we process adds to global accums at the end to ensure that they don't affect the behavior of uninitialized ticks.
 	if currentTick < lowerTick || upperTick <= currentTick {
 		// Add to lower tick uptime trackers
@@ -477,14 +482,8 @@ func (s *KeeperTestSuite) runFungifySetup(address sdk.AccAddress, numPositions i
 
 // runMultiplePositionRanges runs various test constructions and invariants on the given position ranges.
 func (s *KeeperTestSuite) runMultiplePositionRanges(ranges [][]int64, rangeTestParams RangeTestParams) {
-	// Preset seed to ensure deterministic test runs.
-	rand.Seed(2)
-
-	// TODO: add pool-related fuzz params (spread factor & number of pools)
-	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, rangeTestParams.tickSpacing, rangeTestParams.spreadFactor)
-
-	// Run full state determined by params while asserting invariants at each intermediate step
-	s.setupRangesAndAssertInvariants(pool, ranges, rangeTestParams)
+	// Create pools and run full state determined by params while asserting invariants at each intermediate step
+	s.setupPoolsAndAssertInvariants(ranges, rangeTestParams)
 
 	// Assert global invariants on final state
 	s.assertGlobalInvariants(ExpectedGlobalRewardValues{})
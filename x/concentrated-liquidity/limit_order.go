@@ -0,0 +1,439 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// A limit order is a position of a single tick spacing that only holds the token it sells. Once the current tick
+// of the pool crosses the position, the position only holds the other token, at a price within the tick spacing.
+// The position is then withdrawn into escrow, so that the order is not converted back if the price returns.
+// Swaps that cross orders only mark their pool, and the crossed orders are filled at the end of the block, up to
+// MaxLimitOrderFillsPerBlock per block, so that the fills do not add to the gas of swaps. The gas of the fill is
+// charged when placing the order instead. The orders left open are filled in the following blocks or when claimed.
+// Filled orders are held in escrow until their owner claims them. Withdrawing the position in full, or transferring
+// it, cancels the order.
+
+// PlaceLimitOrder creates a position of a single tick spacing starting at tickIndex, providing only tokenIn,
+// and records it as a limit order of the owner. Orders selling token0 must be above the current tick, and orders
+// selling token1 at or below it, so that the position only holds tokenIn.
+// Returns the created position.
+// Returns error if:
+// - the pool does not exist
+// - tokenIn is neither token0 nor token1 of the pool
+// - the range of the order is not entirely on the side of the current tick of tokenIn
+// - the position cannot be created, e.g. because the tick index is not a multiple of the tick spacing
+func (k Keeper) PlaceLimitOrder(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, tickIndex int64, tokenIn sdk.Coin) (CreatePositionData, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if tokenIn.Denom != pool.GetToken0() && tokenIn.Denom != pool.GetToken1() {
+		return CreatePositionData{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenIn.Denom}
+	}
+
+	zeroForOne := getZeroForOne(tokenIn.Denom, pool.GetToken0())
+	lowerTick := tickIndex
+	upperTick := tickIndex + int64(pool.GetTickSpacing())
+	currentTick := pool.GetCurrentTick()
+	if (zeroForOne && currentTick >= lowerTick) || (!zeroForOne && currentTick < upperTick) {
+		return CreatePositionData{}, types.LimitOrderTickRangeError{
+			PoolId:      poolId,
+			LowerTick:   lowerTick,
+			UpperTick:   upperTick,
+			CurrentTick: currentTick,
+			ZeroForOne:  zeroForOne,
+		}
+	}
+
+	// The order is filled at the end of a block, outside of any transaction, so its placer prepays the gas.
+	ctx.GasMeter().ConsumeGas(types.LimitOrderFillGasCost, "cl limit order fill")
+
+	position, err := k.CreatePosition(ctx, poolId, owner, sdk.NewCoins(tokenIn), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	amountIn := position.Amount1
+	if zeroForOne {
+		amountIn = position.Amount0
+	}
+	limitOrder := types.LimitOrder{
+		PositionId: position.ID,
+		PoolId:     poolId,
+		Owner:      owner.String(),
+		LowerTick:  position.LowerTick,
+		UpperTick:  position.UpperTick,
+		ZeroForOne: zeroForOne,
+		TokenIn:    sdk.NewCoin(tokenIn.Denom, amountIn),
+	}
+	k.setLimitOrder(ctx, limitOrder)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPlaceLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(position.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(position.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(position.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, limitOrder.TokenIn.String()),
+	))
+
+	return position, nil
+}
+
+// ClaimLimitOrder sends the amount the given filled limit order converted to from escrow to its owner, and deletes
+// the order. An open order that is crossed by the current tick is filled first.
+// Returns the claimed amount.
+// Returns error if:
+// - the position holds no limit order
+// - the owner does not own the order
+// - the order is not crossed by the current tick yet
+func (k Keeper) ClaimLimitOrder(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		return nil, err
+	}
+	if limitOrder.Owner != owner.String() {
+		return nil, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if !limitOrder.Filled {
+		pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		if !isLimitOrderCrossed(limitOrder, pool.GetCurrentTick()) {
+			return nil, types.LimitOrderNotFilledError{PositionId: positionId}
+		}
+		if limitOrder, err = k.fillLimitOrder(ctx, limitOrder); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, types.LimitOrderEscrowAddress, owner, limitOrder.Claimable); err != nil {
+		return nil, err
+	}
+	ctx.KVStore(k.storeKey).Delete(types.KeyLimitOrder(positionId))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtClaimLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(limitOrder.PoolId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, limitOrder.Claimable.String()),
+	))
+
+	return limitOrder.Claimable, nil
+}
+
+// markCrossedLimitOrdersPending marks the given pool for its open limit orders to be filled at the end of the
+// block if any of them is crossed by the given current tick.
+func (k Keeper) markCrossedLimitOrdersPending(ctx sdk.Context, poolId uint64, currentTick int64) {
+	if len(k.getCrossedLimitOrderKeys(ctx, poolId, currentTick, 1)) > 0 {
+		ctx.KVStore(k.storeKey).Set(types.KeyPendingLimitOrderFills(poolId), []byte{})
+	}
+}
+
+// FillPendingLimitOrders fills the open limit orders crossed by the current tick of the pools marked by swaps, in
+// ascending order of pool id, up to MaxLimitOrderFillsPerBlock orders in total. A pool is unmarked once it has no
+// crossed open orders left, so the orders beyond the limit are filled in the following blocks if they are still
+// crossed by then. The orders that fail to be filled are logged and parked, so that they are not retried in every
+// block. Parked orders stay open, and can still be claimed once crossed or cancelled by their owner.
+// Returns the number of orders filled.
+func (k Keeper) FillPendingLimitOrders(ctx sdk.Context) (int, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingLimitOrderFillsPrefix)
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		poolId, err := types.ParsePendingLimitOrderFillsKey(iterator.Key()[len(types.PendingLimitOrderFillsPrefix):])
+		if err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+	iterator.Close()
+
+	remainingFills := k.GetParams(ctx).MaxLimitOrderFillsPerBlock
+	filled := 0
+	for _, poolId := range poolIds {
+		if remainingFills == 0 {
+			break
+		}
+
+		pool, err := k.getPoolById(ctx, poolId)
+		if err != nil {
+			return filled, err
+		}
+
+		prefixLen := len(types.KeyOpenLimitOrdersPrefix(poolId))
+		indexKeys := k.getCrossedLimitOrderKeys(ctx, poolId, pool.GetCurrentTick(), remainingFills)
+		for _, indexKey := range indexKeys {
+			if k.fillOrParkLimitOrder(ctx, poolId, indexKey, indexKey[prefixLen:]) {
+				filled++
+			}
+		}
+
+		// Fewer crossed orders than the remaining fills means that the pool has none left.
+		if uint64(len(indexKeys)) < remainingFills {
+			store.Delete(types.KeyPendingLimitOrderFills(poolId))
+		}
+		remainingFills -= uint64(len(indexKeys))
+	}
+	return filled, nil
+}
+
+// fillOrParkLimitOrder fills the open limit order indexed by the given key. If the order cannot be filled, its index
+// key is replaced by a KeyParkedLimitOrder so that it is not retried, and the failure is logged.
+// Returns true if the order was filled.
+func (k Keeper) fillOrParkLimitOrder(ctx sdk.Context, poolId uint64, indexKey, strippedIndexKey []byte) bool {
+	store := ctx.KVStore(k.storeKey)
+	positionId, err := types.ParseOpenLimitOrderByTriggerTickKey(strippedIndexKey)
+	if err != nil {
+		ctx.Logger().Error(err.Error())
+		store.Delete(indexKey)
+		return false
+	}
+
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to get limit order of position id (%d): %s", positionId, err))
+		store.Delete(indexKey)
+		return false
+	}
+
+	err = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		_, err := k.fillLimitOrder(cacheCtx, limitOrder)
+		return err
+	})
+	if err != nil {
+		store.Delete(indexKey)
+		store.Set(types.KeyParkedLimitOrder(poolId, positionId), []byte{})
+		return false
+	}
+	return true
+}
+
+// getCrossedLimitOrderKeys returns the index keys of up to limit open limit orders of the given pool that are
+// crossed by the given current tick, in the order of the ticks that fill them. Orders selling token0 are crossed
+// once the current tick reaches their upper tick, and orders selling token1 once it goes below their lower tick.
+func (k Keeper) getCrossedLimitOrderKeys(ctx sdk.Context, poolId uint64, currentTick int64, limit uint64) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+	zeroForOnePrefix := types.KeyOpenLimitOrdersByDirectionPrefix(poolId, true)
+	oneForZeroPrefix := types.KeyOpenLimitOrdersByDirectionPrefix(poolId, false)
+	ranges := [][2][]byte{
+		{zeroForOnePrefix, append(zeroForOnePrefix, types.TickIndexToBytes(currentTick+1)...)},
+		{append(oneForZeroPrefix, types.TickIndexToBytes(currentTick+1)...), sdk.PrefixEndBytes(oneForZeroPrefix)},
+	}
+
+	indexKeys := [][]byte{}
+	for _, r := range ranges {
+		iterator := store.Iterator(r[0], r[1])
+		for ; iterator.Valid() && uint64(len(indexKeys)) < limit; iterator.Next() {
+			indexKeys = append(indexKeys, iterator.Key())
+		}
+		iterator.Close()
+	}
+	return indexKeys
+}
+
+// fillLimitOrder fully withdraws the position of the given open limit order into escrow and marks the order as
+// filled, with the withdrawn amount as claimable. The spread rewards and incentives of the position are collected
+// to the owner.
+func (k Keeper) fillLimitOrder(ctx sdk.Context, limitOrder types.LimitOrder) (types.LimitOrder, error) {
+	position, err := k.GetPosition(ctx, limitOrder.PositionId)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	pool, err := k.getPoolById(ctx, limitOrder.PoolId)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	// Deletes the open order along with the position.
+	amount0, amount1, err := k.withdrawPosition(ctx, owner, types.LimitOrderEscrowAddress, limitOrder.PositionId, position.Liquidity)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+
+	limitOrder.Filled = true
+	limitOrder.Claimable = sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	k.setLimitOrder(ctx, limitOrder)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtFillLimitOrder,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(limitOrder.PositionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(limitOrder.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, limitOrder.TokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyClaimable, limitOrder.Claimable.String()),
+	))
+
+	return limitOrder, nil
+}
+
+// isLimitOrderCrossed returns true if the given current tick crosses the given limit order.
+func isLimitOrderCrossed(limitOrder types.LimitOrder, currentTick int64) bool {
+	if limitOrder.ZeroForOne {
+		return currentTick >= limitOrder.UpperTick
+	}
+	return currentTick < limitOrder.LowerTick
+}
+
+// limitOrderTriggerTickKey returns the key indexing the given open limit order by the tick that fills it.
+func limitOrderTriggerTickKey(limitOrder types.LimitOrder) []byte {
+	triggerTick := limitOrder.LowerTick
+	if limitOrder.ZeroForOne {
+		triggerTick = limitOrder.UpperTick
+	}
+	return types.KeyOpenLimitOrderByTriggerTick(limitOrder.PoolId, limitOrder.ZeroForOne, triggerTick, limitOrder.PositionId)
+}
+
+// validatePositionNotLimitOrder returns types.PositionIsLimitOrderError if the given position holds a limit order.
+// Nil otherwise.
+func (k Keeper) validatePositionNotLimitOrder(ctx sdk.Context, positionId uint64) error {
+	_, err := k.GetLimitOrder(ctx, positionId)
+	if errors.Is(err, types.ErrLimitOrderNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return types.PositionIsLimitOrderError{PositionId: positionId}
+}
+
+// setLimitOrder writes the limit order to state, indexing it by the tick that fills it if it is open.
+func (k Keeper) setLimitOrder(ctx sdk.Context, limitOrder types.LimitOrder) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyLimitOrder(limitOrder.PositionId), &limitOrder)
+	if !limitOrder.Filled {
+		store.Set(limitOrderTriggerTickKey(limitOrder), []byte{})
+	}
+}
+
+// deleteOpenLimitOrder deletes the limit order held by the given position id, if any, unless it is filled.
+func (k Keeper) deleteOpenLimitOrder(ctx sdk.Context, positionId uint64) error {
+	limitOrder, err := k.GetLimitOrder(ctx, positionId)
+	if errors.Is(err, types.ErrLimitOrderNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if limitOrder.Filled {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLimitOrder(positionId))
+	store.Delete(limitOrderTriggerTickKey(limitOrder))
+	store.Delete(types.KeyParkedLimitOrder(limitOrder.PoolId, positionId))
+	return nil
+}
+
+// GetLimitOrder returns the limit order held by the given position id.
+// Returns types.ErrLimitOrderNotFound if the position holds no limit order.
+func (k Keeper) GetLimitOrder(ctx sdk.Context, positionId uint64) (types.LimitOrder, error) {
+	limitOrder := types.LimitOrder{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyLimitOrder(positionId), &limitOrder)
+	if err != nil {
+		return types.LimitOrder{}, err
+	}
+	if !found {
+		return types.LimitOrder{}, types.ErrLimitOrderNotFound
+	}
+	return limitOrder, nil
+}
+
+// GetAllLimitOrders returns all open and unclaimed filled limit orders in state.
+func (k Keeper) GetAllLimitOrders(ctx sdk.Context) ([]types.LimitOrder, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.LimitOrderPrefix, osmoutils.ProtoValueParser[types.LimitOrder]())
+}
+
+// GetOpenLimitOrders returns the open limit orders of the given pool, including the parked ones, in ascending order
+// of position id. If owner is not empty, only the orders of the owner are returned.
+func (k Keeper) GetOpenLimitOrders(ctx sdk.Context, poolId uint64, owner string) ([]types.LimitOrder, error) {
+	positionIds, err := k.getOpenLimitOrderPositionIds(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	limitOrders := []types.LimitOrder{}
+	for _, positionId := range positionIds {
+		limitOrder, err := k.GetLimitOrder(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		if owner != "" && limitOrder.Owner != owner {
+			continue
+		}
+		limitOrders = append(limitOrders, limitOrder)
+	}
+
+	sort.Slice(limitOrders, func(i, j int) bool {
+		return limitOrders[i].PositionId < limitOrders[j].PositionId
+	})
+	return limitOrders, nil
+}
+
+// getOpenLimitOrderPositionIds returns the position ids of the open limit orders of the given pool, indexed either
+// by the tick that fills them or as parked.
+func (k Keeper) getOpenLimitOrderPositionIds(ctx sdk.Context, poolId uint64) ([]uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	positionIds := []uint64{}
+
+	openPrefix := types.KeyOpenLimitOrdersPrefix(poolId)
+	openIterator := sdk.KVStorePrefixIterator(store, openPrefix)
+	defer openIterator.Close()
+	for ; openIterator.Valid(); openIterator.Next() {
+		positionId, err := types.ParseOpenLimitOrderByTriggerTickKey(openIterator.Key()[len(openPrefix):])
+		if err != nil {
+			return nil, err
+		}
+		positionIds = append(positionIds, positionId)
+	}
+
+	parkedPrefix := types.KeyParkedLimitOrdersPrefix(poolId)
+	parkedIterator := sdk.KVStorePrefixIterator(store, parkedPrefix)
+	defer parkedIterator.Close()
+	for ; parkedIterator.Valid(); parkedIterator.Next() {
+		positionIds = append(positionIds, sdk.BigEndianToUint64(parkedIterator.Key()[len(parkedPrefix):]))
+	}
+	return positionIds, nil
+}
+
+// GetClaimableLimitOrders returns the filled limit orders of the given owner that are not claimed yet, in ascending
+// order of position id.
+func (k Keeper) GetClaimableLimitOrders(ctx sdk.Context, owner string) ([]types.LimitOrder, error) {
+	limitOrders, err := k.GetAllLimitOrders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimableLimitOrders := []types.LimitOrder{}
+	for _, limitOrder := range limitOrders {
+		if limitOrder.Filled && limitOrder.Owner == owner {
+			claimableLimitOrders = append(claimableLimitOrders, limitOrder)
+		}
+	}
+
+	sort.Slice(claimableLimitOrders, func(i, j int) bool {
+		return claimableLimitOrders[i].PositionId < claimableLimitOrders[j].PositionId
+	})
+	return claimableLimitOrders, nil
+}
@@ -0,0 +1,314 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+var (
+	// Limit orders right above and below the current tick of the default pool.
+	limitOrderToken0    = sdk.NewCoin(ETH, osmomath.NewInt(1_000))
+	limitOrderTick0     = DefaultCurrTick + int64(DefaultTickSpacing)
+	limitOrderToken1    = sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))
+	limitOrderTick1     = DefaultCurrTick - 2*int64(DefaultTickSpacing)
+	swapAboveLimitOrder = sdk.NewCoin(USDC, osmomath.NewInt(1_000_000_000))
+	swapBelowLimitOrder = sdk.NewCoin(ETH, osmomath.NewInt(200_000))
+)
+
+// setupLimitOrder creates a default pool with a default position and places a limit order of the given owner.
+func (s *KeeperTestSuite) setupLimitOrder(owner sdk.AccAddress, tickIndex int64, tokenIn sdk.Coin) (types.ConcentratedPoolExtension, uint64) {
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.FundAcc(owner, sdk.NewCoins(tokenIn))
+	position, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), tickIndex, tokenIn)
+	s.Require().NoError(err)
+	return pool, position.ID
+}
+
+// swapExactAmountIn swaps the given amount into the given pool from an account funded for it.
+func (s *KeeperTestSuite) swapExactAmountIn(poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) {
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.FundAcc(s.TestAccs[4], sdk.NewCoins(tokenIn))
+	_, err = s.App.ConcentratedLiquidityKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[4], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPlaceLimitOrder() {
+	tests := map[string]struct {
+		tickIndex     int64
+		tokenIn       sdk.Coin
+		expectedError error
+	}{
+		"token0 above the current tick": {
+			tickIndex: limitOrderTick0,
+			tokenIn:   limitOrderToken0,
+		},
+		"token1 below the current tick": {
+			tickIndex: limitOrderTick1,
+			tokenIn:   limitOrderToken1,
+		},
+		"token1 right below the current tick": {
+			tickIndex: DefaultCurrTick - int64(DefaultTickSpacing),
+			tokenIn:   limitOrderToken1,
+		},
+		"error: token0 at the current tick": {
+			tickIndex:     DefaultCurrTick,
+			tokenIn:       limitOrderToken0,
+			expectedError: types.LimitOrderTickRangeError{},
+		},
+		"error: token1 at the current tick": {
+			tickIndex:     DefaultCurrTick,
+			tokenIn:       limitOrderToken1,
+			expectedError: types.LimitOrderTickRangeError{},
+		},
+		"error: token not in pool": {
+			tickIndex:     limitOrderTick0,
+			tokenIn:       sdk.NewCoin("uosmo", osmomath.NewInt(1_000)),
+			expectedError: types.TokenInDenomNotInPoolError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner := s.TestAccs[1]
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+
+			resp, err := msgServer.PlaceLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgPlaceLimitOrder{
+				Sender:    owner.String(),
+				PoolId:    pool.GetId(),
+				TickIndex: tc.tickIndex,
+				TokenIn:   tc.tokenIn,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The position only holds the token sold.
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, resp.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.tickIndex, position.LowerTick)
+			s.Require().Equal(tc.tickIndex+int64(DefaultTickSpacing), position.UpperTick)
+			s.Require().Equal(resp.LiquidityCreated, position.Liquidity)
+
+			limitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, resp.PositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), limitOrder.Owner)
+			s.Require().Equal(tc.tokenIn.Denom == ETH, limitOrder.ZeroForOne)
+			s.Require().Equal(tc.tokenIn.Denom, limitOrder.TokenIn.Denom)
+			s.Require().True(limitOrder.TokenIn.Amount.IsPositive())
+			s.Require().True(limitOrder.TokenIn.Amount.LTE(tc.tokenIn.Amount))
+			s.Require().False(limitOrder.Filled)
+			s.Require().Equal(tc.tokenIn.Sub(limitOrder.TokenIn).Amount, s.App.BankKeeper.GetBalance(s.Ctx, owner, tc.tokenIn.Denom).Amount)
+			s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed(), uint64(types.LimitOrderFillGasCost))
+
+			openLimitOrders, err := s.App.ConcentratedLiquidityKeeper.GetOpenLimitOrders(s.Ctx, pool.GetId(), owner.String())
+			s.Require().NoError(err)
+			s.Require().Equal([]types.LimitOrder{limitOrder}, openLimitOrders)
+		})
+	}
+}
+
+// validates that limit orders crossed by a swap are withdrawn into escrow at the end of the block, and that only
+// their owner can claim them.
+func (s *KeeperTestSuite) TestLimitOrderFilledBySwap() {
+	tests := map[string]struct {
+		tickIndex     int64
+		tokenIn       sdk.Coin
+		swapIn        sdk.Coin
+		swapOutDenom  string
+		expectedDenom string
+	}{
+		"token0 filled by a swap moving the price up": {
+			tickIndex:     limitOrderTick0,
+			tokenIn:       limitOrderToken0,
+			swapIn:        swapAboveLimitOrder,
+			swapOutDenom:  ETH,
+			expectedDenom: USDC,
+		},
+		"token1 filled by a swap moving the price down": {
+			tickIndex:     limitOrderTick1,
+			tokenIn:       limitOrderToken1,
+			swapIn:        swapBelowLimitOrder,
+			swapOutDenom:  USDC,
+			expectedDenom: ETH,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner := s.TestAccs[1]
+			pool, positionId := s.setupLimitOrder(owner, tc.tickIndex, tc.tokenIn)
+
+			// Orders that are not crossed yet cannot be claimed.
+			_, err := s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, positionId)
+			s.Require().ErrorAs(err, &types.LimitOrderNotFilledError{})
+
+			s.swapExactAmountIn(pool.GetId(), tc.swapIn, tc.swapOutDenom)
+
+			// The swap does not fill the order itself.
+			limitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().False(limitOrder.Filled)
+
+			filled, err := s.App.ConcentratedLiquidityKeeper.FillPendingLimitOrders(s.Ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(1, filled)
+
+			// The position is withdrawn into escrow, converted to the other token.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().Error(err)
+			limitOrder, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().True(limitOrder.Filled)
+			s.Require().Len(limitOrder.Claimable, 1)
+			s.Require().Equal(tc.expectedDenom, limitOrder.Claimable[0].Denom)
+			s.Require().True(limitOrder.Claimable[0].Amount.IsPositive())
+			s.Require().Equal(limitOrder.Claimable, s.App.BankKeeper.GetAllBalances(s.Ctx, types.LimitOrderEscrowAddress))
+
+			openLimitOrders, err := s.App.ConcentratedLiquidityKeeper.GetOpenLimitOrders(s.Ctx, pool.GetId(), "")
+			s.Require().NoError(err)
+			s.Require().Empty(openLimitOrders)
+			claimableLimitOrders, err := s.App.ConcentratedLiquidityKeeper.GetClaimableLimitOrders(s.Ctx, owner.String())
+			s.Require().NoError(err)
+			s.Require().Equal([]types.LimitOrder{limitOrder}, claimableLimitOrders)
+
+			// Only the owner can claim the order.
+			_, err = msgServer.ClaimLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimLimitOrder{Sender: s.TestAccs[2].String(), PositionId: positionId})
+			s.Require().ErrorAs(err, &types.NotPositionOwnerError{})
+
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			resp, err := msgServer.ClaimLimitOrder(sdk.WrapSDKContext(s.Ctx), &types.MsgClaimLimitOrder{Sender: owner.String(), PositionId: positionId})
+			s.Require().NoError(err)
+			s.Require().Equal(limitOrder.Claimable, resp.Claimed)
+			s.Require().Equal(balanceBefore.Add(resp.Claimed...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.LimitOrderEscrowAddress).Empty())
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+		})
+	}
+}
+
+// validates that the crossed orders beyond MaxLimitOrderFillsPerBlock are left open, in the order of the ticks that
+// fill them, and are filled in the following blocks or when claimed.
+func (s *KeeperTestSuite) TestFillPendingLimitOrders_MaxFills() {
+	s.SetupTest()
+	params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	params.MaxLimitOrderFillsPerBlock = 1
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+
+	owner := s.TestAccs[1]
+	pool, farPositionId := s.setupLimitOrder(owner, limitOrderTick0+2*int64(DefaultTickSpacing), limitOrderToken0)
+	s.FundAcc(owner, sdk.NewCoins(limitOrderToken0, limitOrderToken0))
+	midPosition, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), limitOrderTick0+int64(DefaultTickSpacing), limitOrderToken0)
+	s.Require().NoError(err)
+	nearPosition, err := s.App.ConcentratedLiquidityKeeper.PlaceLimitOrder(s.Ctx, owner, pool.GetId(), limitOrderTick0, limitOrderToken0)
+	s.Require().NoError(err)
+
+	s.swapExactAmountIn(pool.GetId(), swapAboveLimitOrder, ETH)
+
+	filled, err := s.App.ConcentratedLiquidityKeeper.FillPendingLimitOrders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, filled)
+	nearLimitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, nearPosition.ID)
+	s.Require().NoError(err)
+	s.Require().True(nearLimitOrder.Filled)
+	midLimitOrder, err := s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, midPosition.ID)
+	s.Require().NoError(err)
+	s.Require().False(midLimitOrder.Filled)
+
+	// The order left open can be filled by claiming it.
+	claimed, err := s.App.ConcentratedLiquidityKeeper.ClaimLimitOrder(s.Ctx, owner, farPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(USDC, claimed[0].Denom)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, farPositionId)
+	s.Require().Error(err)
+
+	// The other one is filled in the next block, without another swap.
+	filled, err = s.App.ConcentratedLiquidityKeeper.FillPendingLimitOrders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, filled)
+	midLimitOrder, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, midPosition.ID)
+	s.Require().NoError(err)
+	s.Require().True(midLimitOrder.Filled)
+
+	filled, err = s.App.ConcentratedLiquidityKeeper.FillPendingLimitOrders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(filled)
+}
+
+// validates that the orders that fail to be filled are parked rather than retried in every block, and stay open.
+func (s *KeeperTestSuite) TestFillPendingLimitOrders_ParksFailedFills() {
+	s.SetupTest()
+	owner := s.TestAccs[1]
+	pool, positionId := s.setupLimitOrder(owner, limitOrderTick0, limitOrderToken0)
+
+	// Corrupt the position so that withdrawing it fails.
+	store := s.Ctx.KVStore(s.App.GetKey(types.StoreKey))
+	store.Delete(types.KeyPositionId(positionId))
+
+	s.swapExactAmountIn(pool.GetId(), swapAboveLimitOrder, ETH)
+
+	filled, err := s.App.ConcentratedLiquidityKeeper.FillPendingLimitOrders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(filled)
+	s.Require().False(store.Has(types.KeyPendingLimitOrderFills(pool.GetId())))
+	s.Require().True(store.Has(types.KeyParkedLimitOrder(pool.GetId(), positionId)))
+
+	// The parked order is still open, but no longer crossed as far as the end blocker is concerned.
+	openLimitOrders, err := s.App.ConcentratedLiquidityKeeper.GetOpenLimitOrders(s.Ctx, pool.GetId(), owner.String())
+	s.Require().NoError(err)
+	s.Require().Len(openLimitOrders, 1)
+	s.Require().Equal(positionId, openLimitOrders[0].PositionId)
+
+	s.swapExactAmountIn(pool.GetId(), swapAboveLimitOrder, ETH)
+	s.Require().False(store.Has(types.KeyPendingLimitOrderFills(pool.GetId())))
+}
+
+// validates that withdrawing the position of an open limit order in full cancels it, while a partial withdrawal
+// leaves it open.
+func (s *KeeperTestSuite) TestLimitOrderCancelledByWithdrawal() {
+	s.SetupTest()
+	owner := s.TestAccs[1]
+	pool, positionId := s.setupLimitOrder(owner, limitOrderTick0, limitOrderToken0)
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	// Positions holding an order cannot be managed, since rebalancing would cancel it.
+	err = s.App.ConcentratedLiquidityKeeper.EnableManagedPosition(s.Ctx, owner, positionId, DefaultTickSpacing, types.DefaultManagedPositionRebalanceFee)
+	s.Require().ErrorAs(err, &types.PositionIsLimitOrderError{})
+
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity.QuoInt64(2))
+	s.Require().NoError(err)
+	openLimitOrders, err := s.App.ConcentratedLiquidityKeeper.GetOpenLimitOrders(s.Ctx, pool.GetId(), "")
+	s.Require().NoError(err)
+	s.Require().Len(openLimitOrders, 1)
+
+	position, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetLimitOrder(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.ErrLimitOrderNotFound)
+	openLimitOrders, err = s.App.ConcentratedLiquidityKeeper.GetOpenLimitOrders(s.Ctx, pool.GetId(), "")
+	s.Require().NoError(err)
+	s.Require().Empty(openLimitOrders)
+
+	// The swap crossing the tick of the cancelled order does not fill it.
+	s.swapExactAmountIn(pool.GetId(), swapAboveLimitOrder, ETH)
+	_, err = s.App.ConcentratedLiquidityKeeper.FillPendingLimitOrders(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.LimitOrderEscrowAddress).Empty())
+}
@@ -171,6 +171,8 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 	event.emit(ctx)
 
+	k.recordPositionHistory(ctx, types.PositionEventType_Created, positionId, owner, poolId, liquidityDelta, liquidityDelta)
+
 	if !hasPositions {
 		// N.B. calling this listener propagates to x/twap for twap record creation.
 		// This is done after initial pool position only because only the first position
@@ -205,6 +207,84 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}, nil
 }
 
+// EstimateCreatePosition returns the amounts of each token that CreatePosition would use, the liquidity it
+// would create and the canonical ticks of the position, given the same pool, tokens and tick range.
+// It also returns the leftover tokens, i.e. the part of tokensProvided that would not be used.
+// No state is changed: if the pool has no positions yet, the initial spot price that the position
+// would set is only applied to a branch of the state that is discarded.
+// Returns error under the same conditions as CreatePosition, except for the minimum amount and balance checks.
+func (k Keeper) EstimateCreatePosition(ctx sdk.Context, poolId uint64, tokensProvided sdk.Coins, lowerTick, upperTick int64) (CreatePositionData, sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, nil, err
+	}
+
+	for _, token := range tokensProvided {
+		if token.Denom != pool.GetToken0() && token.Denom != pool.GetToken1() {
+			return CreatePositionData{}, nil, errors.New("token provided is not one of the pool tokens")
+		}
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), lowerTick, upperTick); err != nil {
+		return CreatePositionData{}, nil, err
+	}
+	amount0Desired := tokensProvided.AmountOf(pool.GetToken0())
+	amount1Desired := tokensProvided.AmountOf(pool.GetToken1())
+	if amount0Desired.IsZero() && amount1Desired.IsZero() {
+		return CreatePositionData{}, nil, errors.New("cannot create a position with zero amounts of both pool tokens")
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick)
+	if err != nil {
+		return CreatePositionData{}, nil, err
+	}
+
+	lowerTick, upperTick, err = roundTickToCanonicalPriceTick(lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, pool.GetTickSpacing())
+	if err != nil {
+		return CreatePositionData{}, nil, err
+	}
+
+	hasPositions, err := k.HasAnyPositionForPool(ctx, poolId)
+	if err != nil {
+		return CreatePositionData{}, nil, err
+	}
+
+	// The first position of a pool sets its spot price. The pool is updated in a discarded cache context
+	// so that the estimate uses the same spot price as CreatePosition.
+	if !hasPositions {
+		cacheCtx, _ := ctx.CacheContext()
+		if err := k.initializeInitialPositionForPool(cacheCtx, pool, amount0Desired, amount1Desired); err != nil {
+			return CreatePositionData{}, nil, err
+		}
+	}
+
+	liquidityDelta := math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	if liquidityDelta.IsZero() {
+		return CreatePositionData{}, nil, fmt.Errorf("failed to translate amount0 (%d) and amount1 (%d) to positive liquidity in tick range [%d, %d]",
+			amount0Desired, amount1Desired, lowerTick, upperTick)
+	}
+
+	// The amounts are computed and rounded down the same way as in UpdatePosition.
+	amount0, amount1, err := pool.CalcActualAmounts(ctx, lowerTick, upperTick, liquidityDelta)
+	if err != nil {
+		return CreatePositionData{}, nil, err
+	}
+	actualAmount0, actualAmount1 := amount0.TruncateInt(), amount1.TruncateInt()
+
+	leftover := tokensProvided.Sub(sdk.NewCoins(
+		sdk.NewCoin(pool.GetToken0(), actualAmount0),
+		sdk.NewCoin(pool.GetToken1(), actualAmount1),
+	)...)
+
+	return CreatePositionData{
+		Amount0:   actualAmount0,
+		Amount1:   actualAmount1,
+		Liquidity: liquidityDelta,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+	}, leftover, nil
+}
+
 // WithdrawPosition attempts to withdraw liquidityAmount from a position with the given pool id in the given tick range.
 // On success, returns a positive amount of each token withdrawn.
 // If we are attempting to withdraw all liquidity available in the position, we also collect spread factors and incentives for the position.
@@ -218,12 +298,20 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 // - the provided owner does not own the position being withdrawn
 // - there is no position in the given tick ranges
 // - if the position's underlying lock is not mature
+// - if the position is locked as collateral
 // - if tick ranges are invalid
 // - if attempts to withdraw an amount higher than originally provided in createPosition for a given range.
 //
 // BeforeWithdrawPosition hook is triggered after validation logic but before any state changes are made.
 // AfterWithdrawPosition hook is triggered after state changes are complete if no errors have occurred.
 func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw osmomath.Dec) (amtDenom0, amtDenom1 osmomath.Int, err error) {
+	return k.withdrawPosition(ctx, owner, owner, positionId, requestedLiquidityAmountToWithdraw)
+}
+
+// withdrawPosition withdraws liquidity from the position as described in WithdrawPosition, sending the withdrawn
+// amounts to the given recipient instead of the owner. The spread rewards and incentives are still collected to
+// the owner.
+func (k Keeper) withdrawPosition(ctx sdk.Context, owner, recipient sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw osmomath.Dec) (amtDenom0, amtDenom1 osmomath.Int, err error) {
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
 		return osmomath.Int{}, osmomath.Int{}, err
@@ -251,6 +339,11 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		return osmomath.Int{}, osmomath.Int{}, types.LockNotMatureError{PositionId: position.PositionId, LockId: lockId}
 	}
 
+	// Positions locked as collateral cannot be withdrawn from until the lienholder unlocks them.
+	if err := k.validatePositionNotLockedForCollateral(ctx, positionId); err != nil {
+		return osmomath.Int{}, osmomath.Int{}, err
+	}
+
 	// Retrieve the pool associated with the given pool ID.
 	pool, err := k.getPoolById(ctx, position.PoolId)
 	if err != nil {
@@ -285,8 +378,8 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
 
-	// Transfer the actual amounts of tokens 0 and 1 from the pool to the position owner.
-	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0.Abs(), updateData.Amount1.Abs(), pool.GetAddress(), owner)
+	// Transfer the actual amounts of tokens 0 and 1 from the pool to the recipient.
+	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), updateData.Amount0.Abs(), updateData.Amount1.Abs(), pool.GetAddress(), recipient)
 	if err != nil {
 		return osmomath.Int{}, osmomath.Int{}, err
 	}
@@ -358,6 +451,12 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	}
 	event.emit(ctx)
 
+	historyEventType := types.PositionEventType_Modified
+	if requestedLiquidityAmountToWithdraw.Equal(position.Liquidity) {
+		historyEventType = types.PositionEventType_Withdrawn
+	}
+	k.recordPositionHistory(ctx, historyEventType, positionId, owner, position.PoolId, liquidityDelta, position.Liquidity.Add(liquidityDelta))
+
 	// Trigger after hook for WithdrawPosition.
 	// If no contract is set, this will be a no-op.
 	err = k.AfterWithdrawPosition(ctx, position.PoolId, owner, positionId, requestedLiquidityAmountToWithdraw)
@@ -368,6 +467,62 @@ func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	return updateData.Amount0.Neg(), updateData.Amount1.Neg(), nil
 }
 
+// withdrawAllPoolPositions fully withdraws the positions of the owner in the given pool in ascending order of
+// position id, collecting their spread rewards and incentives, up to the MaxPositionsPerWithdrawAll param.
+// Positions with an underlying lock that is not mature or locked as collateral cannot be withdrawn and are skipped.
+// Returns the ids of the positions withdrawn, the total amounts of token0 and token1 withdrawn and the number of
+// positions that were left in the pool because of the param.
+// Returns error if
+// - the pool does not exist
+// - the owner has no positions that can be withdrawn in the pool
+// - withdrawing any of the positions fails
+func (k Keeper) withdrawAllPoolPositions(ctx sdk.Context, owner sdk.AccAddress, poolId uint64) (positionIds []uint64, amount0, amount1 osmomath.Int, remainingPositions uint64, err error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, osmomath.Int{}, osmomath.Int{}, 0, err
+	}
+
+	positions, err := k.GetUserPositions(ctx, owner, poolId)
+	if err != nil {
+		return nil, osmomath.Int{}, osmomath.Int{}, 0, err
+	}
+
+	maxPositions := k.GetParams(ctx).MaxPositionsPerWithdrawAll
+	positionIds = []uint64{}
+	amount0, amount1 = osmomath.ZeroInt(), osmomath.ZeroInt()
+	for _, position := range positions {
+		hasActiveUnderlyingLock, _, err := k.PositionHasActiveUnderlyingLock(ctx, position.PositionId)
+		if err != nil {
+			return nil, osmomath.Int{}, osmomath.Int{}, 0, err
+		}
+		if hasActiveUnderlyingLock {
+			continue
+		}
+		if k.hasPositionLien(ctx, position.PositionId) {
+			continue
+		}
+
+		if uint64(len(positionIds)) >= maxPositions {
+			remainingPositions++
+			continue
+		}
+
+		withdrawn0, withdrawn1, err := k.WithdrawPosition(ctx, owner, position.PositionId, position.Liquidity)
+		if err != nil {
+			return nil, osmomath.Int{}, osmomath.Int{}, 0, err
+		}
+
+		positionIds = append(positionIds, position.PositionId)
+		amount0 = amount0.Add(withdrawn0)
+		amount1 = amount1.Add(withdrawn1)
+	}
+
+	if len(positionIds) == 0 {
+		return nil, osmomath.Int{}, osmomath.Int{}, 0, types.NoWithdrawablePositionsError{PoolId: poolId, Address: owner.String()}
+	}
+
+	return positionIds, amount0, amount1, remainingPositions, nil
+}
+
 // addToPosition attempts to add amount0Added and amount1Added to a position with the given position id.
 // For the sake of backwards-compatibility with future implementations of charging, this function deletes the old position and creates
 // a new one with the resulting amount after addition. Note that due to truncation after `withdrawPosition`, there is some rounding error
@@ -527,9 +682,17 @@ func (k Keeper) UpdatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	}
 
 	// The returned amounts are rounded down to avoid returning more to clients than they actually deposited.
+	amount0, amount1 := actualAmount0.TruncateInt(), actualAmount1.TruncateInt()
+
+	// Rounding down is in the pool's favor when withdrawing and in the owner's favor when depositing.
+	k.addRoundingRemainders(ctx, poolId,
+		roundingRemainder(pool.GetToken0(), actualAmount0, amount0),
+		roundingRemainder(pool.GetToken1(), actualAmount1, amount1),
+	)
+
 	return types.UpdatePositionData{
-		Amount0:          actualAmount0.TruncateInt(),
-		Amount1:          actualAmount1.TruncateInt(),
+		Amount0:          amount0,
+		Amount1:          amount1,
 		LowerTickIsEmpty: lowerTickIsEmpty,
 		UpperTickIsEmpty: upperTickIsEmpty,
 	}, nil
@@ -580,7 +580,7 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			s.AddToSpreadRewardAccumulator(pool.GetId(), globalSpreadRewardGrowth)
 
 			// Add global uptime growth
-			err = addToUptimeAccums(s.Ctx, pool.GetId(), concentratedLiquidityKeeper, defaultUptimeGrowth)
+			err = addToUptimeAccums(s.Ctx, pool.GetId(), concentratedLiquidityKeeper, scaleUptimeGrowth(defaultUptimeGrowth))
 			s.Require().NoError(err)
 
 			// Determine the liquidity expected to remain after the withdraw.
@@ -1706,6 +1706,89 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestEstimateCreatePosition() {
+	tests := map[string]struct {
+		hasExistingPosition bool
+		tokensProvided      sdk.Coins
+		lowerTick           int64
+		upperTick           int64
+		expectedError       bool
+	}{
+		"initial position of the pool": {
+			tokensProvided: DefaultCoins,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+		},
+		"existing position, excess token0 is left over": {
+			hasExistingPosition: true,
+			tokensProvided:      sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0.MulRaw(2)), sdk.NewCoin(USDC, DefaultAmt1)),
+			lowerTick:           DefaultLowerTick,
+			upperTick:           DefaultUpperTick,
+		},
+		"existing position, single sided above current tick": {
+			hasExistingPosition: true,
+			tokensProvided:      sdk.NewCoins(sdk.NewCoin(ETH, DefaultAmt0), sdk.NewCoin(USDC, DefaultAmt1)),
+			lowerTick:           DefaultCurrTick + 100,
+			upperTick:           DefaultUpperTick,
+		},
+		"error: token not in pool": {
+			hasExistingPosition: true,
+			tokensProvided:      sdk.NewCoins(sdk.NewCoin("foo", DefaultAmt0)),
+			lowerTick:           DefaultLowerTick,
+			upperTick:           DefaultUpperTick,
+			expectedError:       true,
+		},
+		"error: invalid tick range": {
+			hasExistingPosition: true,
+			tokensProvided:      DefaultCoins,
+			lowerTick:           DefaultUpperTick,
+			upperTick:           DefaultLowerTick,
+			expectedError:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+
+			pool := s.PrepareConcentratedPool()
+			if tc.hasExistingPosition {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+			poolBefore, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test
+			estimate, leftover, err := s.clk.EstimateCreatePosition(s.Ctx, pool.GetId(), tc.tokensProvided, tc.lowerTick, tc.upperTick)
+			if tc.expectedError {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The estimate does not change the pool.
+			poolAfter, err := s.clk.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBefore, poolAfter)
+
+			// The estimate matches the position actually created.
+			balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[1])
+			s.FundAcc(s.TestAccs[1], tc.tokensProvided)
+			positionData, err := s.clk.CreatePosition(s.Ctx, pool.GetId(), s.TestAccs[1], tc.tokensProvided, osmomath.ZeroInt(), osmomath.ZeroInt(), tc.lowerTick, tc.upperTick)
+			s.Require().NoError(err)
+
+			s.Require().Equal(positionData.Amount0, estimate.Amount0)
+			s.Require().Equal(positionData.Amount1, estimate.Amount1)
+			s.Require().Equal(positionData.Liquidity, estimate.Liquidity)
+			s.Require().Equal(positionData.LowerTick, estimate.LowerTick)
+			s.Require().Equal(positionData.UpperTick, estimate.UpperTick)
+
+			s.Require().Equal(balanceBefore.Add(leftover...), s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[1]))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestInitializeInitialPositionForPool() {
 	sqrt := func(x int64) osmomath.BigDec {
 		sqrt, err := osmomath.MonotonicSqrt(osmomath.NewDec(x))
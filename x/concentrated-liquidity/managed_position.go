@@ -0,0 +1,358 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// EnableManagedPosition opts the given position into automatic rebalancing. Once the current tick of the pool
+// exits the range of the position, the position is withdrawn and recreated as a single sided position of
+// rangeWidth ticks adjacent to the current tick, either at the end of the rebalance epoch or by an authorized
+// rebalancer, as long as the rebalance fee does not exceed maxRebalanceFee. Since every rebalance fully withdraws
+// the position, the incentives of the uptimes it has not reached yet are forfeited and its join time is reset.
+// Overwrites the rebalancing configuration of a position that is already managed.
+// Returns error if:
+// - the owner does not own the position
+// - the range width is not a positive multiple of the tick spacing of the pool
+// - the position has an active underlying lock
+// - the position is locked as collateral
+// - the position holds a limit order
+func (k Keeper) EnableManagedPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, rangeWidth uint64, maxRebalanceFee osmomath.Dec) error {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if position.Address != owner.String() {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return err
+	}
+	if rangeWidth == 0 || rangeWidth%pool.GetTickSpacing() != 0 {
+		return types.InvalidManagedPositionRangeWidthError{RangeWidth: rangeWidth, TickSpacing: pool.GetTickSpacing()}
+	}
+
+	// Positions that cannot be withdrawn from cannot be rebalanced either.
+	hasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if hasActiveUnderlyingLock {
+		return types.LockNotMatureError{PositionId: positionId, LockId: lockId}
+	}
+	if err := k.validatePositionNotLockedForCollateral(ctx, positionId); err != nil {
+		return err
+	}
+	// Rebalancing would cancel the order.
+	if err := k.validatePositionNotLimitOrder(ctx, positionId); err != nil {
+		return err
+	}
+
+	rebalanceCount := uint64(0)
+	managedPosition, err := k.GetManagedPosition(ctx, positionId)
+	if err == nil {
+		rebalanceCount = managedPosition.RebalanceCount
+	} else if !errors.Is(err, types.ErrManagedPositionNotFound) {
+		return err
+	}
+
+	k.setManagedPosition(ctx, types.ManagedPosition{
+		PositionId:      positionId,
+		Owner:           owner.String(),
+		RangeWidth:      rangeWidth,
+		MaxRebalanceFee: maxRebalanceFee,
+		RebalanceCount:  rebalanceCount,
+	})
+	return nil
+}
+
+// DisableManagedPosition opts the given position out of automatic rebalancing.
+// Returns types.ErrManagedPositionNotFound if the position is not managed
+// and types.NotPositionOwnerError if the owner does not own the position.
+func (k Keeper) DisableManagedPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) error {
+	managedPosition, err := k.GetManagedPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if managedPosition.Owner != owner.String() {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	k.deleteManagedPosition(ctx, positionId)
+	return nil
+}
+
+// RebalanceManagedPosition rebalances the given managed position on behalf of its owner, see rebalanceManagedPosition.
+// The rebalance fee is sent to the rebalancer.
+// Returns the id of the recreated position and the rebalance fee.
+// Returns error if the rebalancer is not one of the authorized position rebalancers or the rebalance fails.
+func (k Keeper) RebalanceManagedPosition(ctx sdk.Context, rebalancer sdk.AccAddress, positionId uint64) (uint64, sdk.Coins, error) {
+	if !k.isAuthorizedPositionRebalancer(ctx, rebalancer) {
+		return 0, sdk.Coins{}, types.UnauthorizedPositionRebalancerError{Rebalancer: rebalancer.String()}
+	}
+
+	managedPosition, err := k.GetManagedPosition(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+
+	return k.rebalanceManagedPosition(ctx, managedPosition, rebalancer)
+}
+
+// rebalanceManagedPosition fully withdraws the given managed position, whose range the current tick of the pool
+// exited, and recreates it as a single sided position of the range width of the managed position, adjacent to the
+// current tick. Since the withdrawn position holds a single token, the new position is placed on the side of the
+// current tick where it only holds that token: right above the current tick if the price fell below the position,
+// right below it if the price rose above the position.
+// The rebalance fee is charged on the withdrawn amounts and sent to the fee recipient, or to the community pool if
+// the fee recipient is nil. The spread rewards and incentives of the withdrawn position are collected to the owner.
+// The recreated position keeps being managed with the same configuration.
+// Returns the id of the recreated position and the rebalance fee.
+// Returns error if:
+// - the position is in range
+// - the rebalance fee is higher than the max rebalance fee accepted by the owner
+// - the position cannot be withdrawn from, e.g. because it is locked as collateral
+// - the position cannot be recreated, e.g. because it was the last position in the pool
+func (k Keeper) rebalanceManagedPosition(ctx sdk.Context, managedPosition types.ManagedPosition, feeRecipient sdk.AccAddress) (uint64, sdk.Coins, error) {
+	positionId := managedPosition.PositionId
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+
+	currentTick := pool.GetCurrentTick()
+	if pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick) {
+		return 0, sdk.Coins{}, types.ManagedPositionInRangeError{PositionId: positionId, CurrentTick: currentTick, LowerTick: position.LowerTick, UpperTick: position.UpperTick}
+	}
+
+	rebalanceFeeRate := k.GetParams(ctx).ManagedPositionRebalanceFee
+	if rebalanceFeeRate.GT(managedPosition.MaxRebalanceFee) {
+		return 0, sdk.Coins{}, types.ManagedPositionRebalanceFeeTooHighError{PositionId: positionId, RebalanceFee: rebalanceFeeRate, MaxRebalanceFee: managedPosition.MaxRebalanceFee}
+	}
+
+	lowerTick, upperTick, err := managedPositionRebalanceRange(currentTick, pool.GetTickSpacing(), managedPosition.RangeWidth, currentTick >= position.UpperTick)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+	withdrawn := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+
+	rebalanceFee := sdk.Coins{}
+	for _, coin := range withdrawn {
+		feeAmount := rebalanceFeeRate.MulInt(coin.Amount).TruncateInt()
+		if feeAmount.IsPositive() {
+			rebalanceFee = rebalanceFee.Add(sdk.NewCoin(coin.Denom, feeAmount))
+		}
+	}
+	if !rebalanceFee.IsZero() {
+		if feeRecipient == nil {
+			err = k.communityPoolKeeper.FundCommunityPool(ctx, rebalanceFee, owner)
+		} else {
+			err = k.bankKeeper.SendCoins(ctx, owner, feeRecipient, rebalanceFee)
+		}
+		if err != nil {
+			return 0, sdk.Coins{}, err
+		}
+	}
+
+	newPosition, err := k.CreatePosition(ctx, position.PoolId, owner, withdrawn.Sub(rebalanceFee...), osmomath.ZeroInt(), osmomath.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Coins{}, err
+	}
+
+	// The withdrawal deleted the managed position along with the position, so it is written again under the new id.
+	managedPosition.PositionId = newPosition.ID
+	managedPosition.RebalanceCount++
+	k.setManagedPosition(ctx, managedPosition)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPosition.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeLowerTick, strconv.FormatInt(newPosition.LowerTick, 10)),
+		sdk.NewAttribute(types.AttributeUpperTick, strconv.FormatInt(newPosition.UpperTick, 10)),
+		sdk.NewAttribute(types.AttributeKeyRebalanceFee, rebalanceFee.String()),
+	}
+	if feeRecipient != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyRebalancer, feeRecipient.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.TypeEvtRebalanceManagedPosition, attributes...))
+
+	return newPosition.ID, rebalanceFee, nil
+}
+
+// managedPositionRebalanceRange returns the range of rangeWidth ticks adjacent to the current tick, rounded to the
+// tick spacing, in which a position only holds token1 if aboveRange is true, that is right below the current tick,
+// or only holds token0 otherwise, that is right above the current tick.
+func managedPositionRebalanceRange(currentTick int64, tickSpacing uint64, rangeWidth uint64, aboveRange bool) (int64, int64, error) {
+	currentTickRounded, err := math.RoundDownTickToSpacing(currentTick, int64(tickSpacing))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// A position holds token1 only if the current tick is at or above its upper tick.
+	if aboveRange {
+		return currentTickRounded - int64(rangeWidth), currentTickRounded, nil
+	}
+
+	// A position holds token0 only if the current tick is below its lower tick.
+	lowerTick := currentTickRounded + int64(tickSpacing)
+	return lowerTick, lowerTick + int64(rangeWidth), nil
+}
+
+// rebalanceManagedPositions rebalances the managed positions whose range the current tick of their pool exited.
+// Every epoch resumes from the managed position following the last one checked by the previous epoch, and checks
+// up to MaxManagedPositionsCheckedPerEpoch managed positions, attempting up to MaxManagedPositionRebalancesPerEpoch
+// rebalances. Failed attempts count towards the limit, so that positions that always fail to be rebalanced cannot
+// make the epoch hook unbounded. Once the last managed position is checked, the next epoch starts over.
+// The rebalance fees are sent to the community pool. Positions that fail to be rebalanced, e.g. because they are
+// locked as collateral or their owner does not accept the current rebalance fee, are skipped and their error is
+// logged.
+func (k Keeper) rebalanceManagedPositions(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	start := types.ManagedPositionPrefix
+	if cursor := store.Get(types.KeyManagedPositionRebalanceCursor); cursor != nil {
+		start = append(cursor, 0x00)
+	}
+
+	iterator := store.Iterator(start, sdk.PrefixEndBytes(types.ManagedPositionPrefix))
+	parseValue := osmoutils.ProtoValueParser[types.ManagedPosition]()
+	managedPositions := []types.ManagedPosition{}
+	for ; iterator.Valid() && len(managedPositions) < types.MaxManagedPositionsCheckedPerEpoch; iterator.Next() {
+		managedPosition, err := parseValue(iterator.Value())
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		managedPositions = append(managedPositions, managedPosition)
+	}
+	hasMore := iterator.Valid()
+	iterator.Close()
+
+	maxAttempts := k.GetParams(ctx).MaxManagedPositionRebalancesPerEpoch
+	attempts := uint64(0)
+	checked := 0
+	for _, managedPosition := range managedPositions {
+		if attempts >= maxAttempts {
+			break
+		}
+		checked++
+
+		isOutOfRange, err := k.isManagedPositionOutOfRange(ctx, managedPosition.PositionId)
+		if err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to check range of managed position id (%d): %s", managedPosition.PositionId, err))
+			continue
+		}
+		if !isOutOfRange {
+			continue
+		}
+
+		attempts++
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			_, _, err := k.rebalanceManagedPosition(cacheCtx, managedPosition, nil)
+			return err
+		})
+	}
+
+	if checked == len(managedPositions) && !hasMore {
+		store.Delete(types.KeyManagedPositionRebalanceCursor)
+	} else if checked > 0 {
+		store.Set(types.KeyManagedPositionRebalanceCursor, types.KeyManagedPosition(managedPositions[checked-1].PositionId))
+	}
+	return nil
+}
+
+// isManagedPositionOutOfRange returns true if the current tick of the pool of the given position is outside of its range.
+func (k Keeper) isManagedPositionOutOfRange(ctx sdk.Context, positionId uint64) (bool, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return false, err
+	}
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return false, err
+	}
+	return !pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick), nil
+}
+
+// setManagedPosition writes the managed position to state.
+func (k Keeper) setManagedPosition(ctx sdk.Context, managedPosition types.ManagedPosition) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyManagedPosition(managedPosition.PositionId), &managedPosition)
+}
+
+// deleteManagedPosition deletes the rebalancing configuration of the given position, if any.
+func (k Keeper) deleteManagedPosition(ctx sdk.Context, positionId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyManagedPosition(positionId))
+}
+
+// GetManagedPosition returns the rebalancing configuration of the given position.
+// Returns types.ErrManagedPositionNotFound if the position is not managed.
+func (k Keeper) GetManagedPosition(ctx sdk.Context, positionId uint64) (types.ManagedPosition, error) {
+	managedPosition := types.ManagedPosition{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyManagedPosition(positionId), &managedPosition)
+	if err != nil {
+		return types.ManagedPosition{}, err
+	}
+	if !found {
+		return types.ManagedPosition{}, types.ErrManagedPositionNotFound
+	}
+	return managedPosition, nil
+}
+
+// GetAllManagedPositions returns all managed positions in state.
+func (k Keeper) GetAllManagedPositions(ctx sdk.Context) ([]types.ManagedPosition, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.ManagedPositionPrefix, osmoutils.ProtoValueParser[types.ManagedPosition]())
+}
+
+// GetManagedPositionsByOwner returns the managed positions owned by the given address.
+func (k Keeper) GetManagedPositionsByOwner(ctx sdk.Context, owner sdk.AccAddress) ([]types.ManagedPosition, error) {
+	managedPositions, err := k.GetAllManagedPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerManagedPositions := []types.ManagedPosition{}
+	for _, managedPosition := range managedPositions {
+		if managedPosition.Owner == owner.String() {
+			ownerManagedPositions = append(ownerManagedPositions, managedPosition)
+		}
+	}
+	return ownerManagedPositions, nil
+}
+
+// isAuthorizedPositionRebalancer returns true if the given address is one of the authorized position rebalancers.
+// False otherwise.
+func (k Keeper) isAuthorizedPositionRebalancer(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, addr := range k.GetParams(ctx).AuthorizedPositionRebalancers {
+		// okay to use MustAccAddressFromBech32 because already validated in params
+		if sdk.MustAccAddressFromBech32(addr).Equals(address) {
+			return true
+		}
+	}
+	return false
+}
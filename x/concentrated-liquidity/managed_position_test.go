@@ -0,0 +1,305 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+const (
+	// Range of a position above the current tick of the default pool, only holding token0.
+	managedPositionLowerTick = int64(31600000)
+	managedPositionUpperTick = int64(31700000)
+	managedPositionWidth     = uint64(100000)
+)
+
+// authorizePositionRebalancer adds the given address to the authorized position rebalancers.
+func (s *KeeperTestSuite) authorizePositionRebalancer(rebalancer sdk.AccAddress) {
+	params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	params.AuthorizedPositionRebalancers = append(params.AuthorizedPositionRebalancers, rebalancer.String())
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+}
+
+// setupOutOfRangeManagedPosition creates a default pool with a default position and a managed position
+// of the given owner above the current tick.
+func (s *KeeperTestSuite) setupOutOfRangeManagedPosition(owner sdk.AccAddress, maxRebalanceFee osmomath.Dec) (types.ConcentratedPoolExtension, uint64) {
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	_, positionId := s.SetupPosition(pool.GetId(), owner, sdk.NewCoins(DefaultCoin0), managedPositionLowerTick, managedPositionUpperTick, false)
+	err := s.App.ConcentratedLiquidityKeeper.EnableManagedPosition(s.Ctx, owner, positionId, managedPositionWidth, maxRebalanceFee)
+	s.Require().NoError(err)
+	return pool, positionId
+}
+
+func (s *KeeperTestSuite) TestEnableManagedPosition() {
+	tests := map[string]struct {
+		sender         int
+		rangeWidth     uint64
+		lockedPosition bool
+		lienedPosition bool
+		expectedError  error
+	}{
+		"happy path": {
+			rangeWidth: managedPositionWidth,
+		},
+		"error: sender is not the owner": {
+			sender:        1,
+			rangeWidth:    managedPositionWidth,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: range width is not a multiple of the tick spacing": {
+			rangeWidth:    1,
+			expectedError: types.InvalidManagedPositionRangeWidthError{},
+		},
+		"error: position has an active underlying lock": {
+			rangeWidth:     managedPositionWidth,
+			lockedPosition: true,
+			expectedError:  types.LockNotMatureError{},
+		},
+		"error: position is locked as collateral": {
+			rangeWidth:     managedPositionWidth,
+			lienedPosition: true,
+			expectedError:  types.PositionLockedForCollateralError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, sender := s.TestAccs[0], s.TestAccs[tc.sender]
+
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, 100, osmomath.ZeroDec())
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			if tc.lockedPosition {
+				s.FundAcc(owner, DefaultCoins)
+				positionData, _, err := s.App.ConcentratedLiquidityKeeper.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, time.Hour)
+				s.Require().NoError(err)
+				positionId = positionData.ID
+			}
+			if tc.lienedPosition {
+				s.authorizeLienholder(s.TestAccs[2])
+				err := s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, s.TestAccs[2], positionId, false)
+				s.Require().NoError(err)
+			}
+
+			maxRebalanceFee := osmomath.MustNewDecFromStr("0.01")
+			_, err := msgServer.EnableManagedPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgEnableManagedPosition{
+				Sender:          sender.String(),
+				PositionId:      positionId,
+				RangeWidth:      tc.rangeWidth,
+				MaxRebalanceFee: maxRebalanceFee,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+				s.Require().ErrorIs(err, types.ErrManagedPositionNotFound)
+				return
+			}
+			s.Require().NoError(err)
+
+			managedPosition, err := s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.ManagedPosition{
+				PositionId:      positionId,
+				Owner:           owner.String(),
+				RangeWidth:      tc.rangeWidth,
+				MaxRebalanceFee: maxRebalanceFee,
+			}, managedPosition)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestDisableManagedPosition() {
+	s.SetupTest()
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	owner := s.TestAccs[0]
+	_, positionId := s.setupOutOfRangeManagedPosition(owner, osmomath.OneDec())
+
+	_, err := msgServer.DisableManagedPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgDisableManagedPosition{Sender: s.TestAccs[1].String(), PositionId: positionId})
+	s.Require().ErrorAs(err, &types.NotPositionOwnerError{})
+
+	_, err = msgServer.DisableManagedPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgDisableManagedPosition{Sender: owner.String(), PositionId: positionId})
+	s.Require().NoError(err)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.ErrManagedPositionNotFound)
+
+	_, err = msgServer.DisableManagedPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgDisableManagedPosition{Sender: owner.String(), PositionId: positionId})
+	s.Require().ErrorIs(err, types.ErrManagedPositionNotFound)
+}
+
+func (s *KeeperTestSuite) TestRebalanceManagedPosition() {
+	tests := map[string]struct {
+		unauthorized    bool
+		inRange         bool
+		maxRebalanceFee osmomath.Dec
+		expectedError   error
+	}{
+		"happy path": {
+			maxRebalanceFee: types.DefaultManagedPositionRebalanceFee,
+		},
+		"error: rebalancer is not authorized": {
+			unauthorized:    true,
+			maxRebalanceFee: types.DefaultManagedPositionRebalanceFee,
+			expectedError:   types.UnauthorizedPositionRebalancerError{},
+		},
+		"error: position is in range": {
+			inRange:         true,
+			maxRebalanceFee: types.DefaultManagedPositionRebalanceFee,
+			expectedError:   types.ManagedPositionInRangeError{},
+		},
+		"error: rebalance fee is higher than the max rebalance fee": {
+			maxRebalanceFee: osmomath.ZeroDec(),
+			expectedError:   types.ManagedPositionRebalanceFeeTooHighError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, rebalancer := s.TestAccs[0], s.TestAccs[1]
+			if !tc.unauthorized {
+				s.authorizePositionRebalancer(rebalancer)
+			}
+
+			pool, positionId := s.setupOutOfRangeManagedPosition(owner, tc.maxRebalanceFee)
+			if tc.inRange {
+				s.Require().NoError(s.App.ConcentratedLiquidityKeeper.DisableManagedPosition(s.Ctx, owner, positionId))
+				positionId = s.SetupDefaultPositionAcc(pool.GetId(), owner)
+				err := s.App.ConcentratedLiquidityKeeper.EnableManagedPosition(s.Ctx, owner, positionId, managedPositionWidth, tc.maxRebalanceFee)
+				s.Require().NoError(err)
+			}
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			rebalancerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, rebalancer)
+
+			resp, err := msgServer.RebalanceManagedPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgRebalanceManagedPosition{
+				Sender:     rebalancer.String(),
+				PositionId: positionId,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The old position is deleted along with its managed position.
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().Error(err)
+			_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.ErrManagedPositionNotFound)
+
+			// The new position is placed right above the current tick, with the range width of the managed position.
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			newPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, resp.NewPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), newPosition.Address)
+			currentTickRounded, err := math.RoundDownTickToSpacing(pool.GetCurrentTick(), int64(pool.GetTickSpacing()))
+			s.Require().NoError(err)
+			s.Require().Equal(currentTickRounded+int64(pool.GetTickSpacing()), newPosition.LowerTick)
+			s.Require().Equal(newPosition.LowerTick+int64(managedPositionWidth), newPosition.UpperTick)
+			s.Require().True(newPosition.LowerTick > pool.GetCurrentTick())
+			s.Require().NotEqual(position.LowerTick, newPosition.LowerTick)
+
+			managedPosition, err := s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, resp.NewPositionId)
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), managedPosition.RebalanceCount)
+
+			// The rebalance fee is charged on the withdrawn token0 and sent to the rebalancer.
+			s.Require().Len(resp.RebalanceFee, 1)
+			s.Require().Equal(ETH, resp.RebalanceFee[0].Denom)
+			s.Require().True(resp.RebalanceFee[0].Amount.IsPositive())
+			s.Require().Equal(rebalancerBalanceBefore.Add(resp.RebalanceFee...), s.App.BankKeeper.GetAllBalances(s.Ctx, rebalancer))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRebalanceManagedPositions_EpochHook() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	pool, positionId := s.setupOutOfRangeManagedPosition(owner, types.DefaultManagedPositionRebalanceFee)
+	inRangePositionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	err := s.App.ConcentratedLiquidityKeeper.EnableManagedPosition(s.Ctx, owner, inRangePositionId, managedPositionWidth, types.DefaultManagedPositionRebalanceFee)
+	s.Require().NoError(err)
+
+	hooks := s.App.ConcentratedLiquidityKeeper.EpochHooks()
+	epochIdentifier := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx).ManagedPositionRebalanceEpochIdentifier
+
+	// Positions are not rebalanced at the end of other epochs.
+	s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, "other", 1))
+	_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	communityPoolAddress := s.App.AccountKeeper.GetModuleAddress(distributiontypes.ModuleName)
+	communityPoolBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, communityPoolAddress)
+	s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, epochIdentifier, 1))
+
+	// Only the out of range position is rebalanced.
+	_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.ErrManagedPositionNotFound)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+
+	managedPositions, err := s.App.ConcentratedLiquidityKeeper.GetManagedPositionsByOwner(s.Ctx, owner)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 2)
+
+	// The rebalance fee of positions rebalanced at epoch end is sent to the community pool.
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, communityPoolAddress).IsAllGT(communityPoolBefore))
+}
+
+// validates that failed rebalances count towards MaxManagedPositionRebalancesPerEpoch, and that every epoch resumes
+// after the last managed position checked by the previous one.
+func (s *KeeperTestSuite) TestRebalanceManagedPositions_BoundsAttempts() {
+	s.SetupTest()
+	params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	params.MaxManagedPositionRebalancesPerEpoch = 1
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+
+	// Two positions whose owner does not accept the rebalance fee, which always fail to be rebalanced,
+	// ahead of a position that can be rebalanced.
+	owner := s.TestAccs[0]
+	pool, _ := s.setupOutOfRangeManagedPosition(owner, osmomath.ZeroDec())
+	_, failingPositionId := s.SetupPosition(pool.GetId(), owner, sdk.NewCoins(DefaultCoin0), managedPositionLowerTick, managedPositionUpperTick, false)
+	err := s.App.ConcentratedLiquidityKeeper.EnableManagedPosition(s.Ctx, owner, failingPositionId, managedPositionWidth, osmomath.ZeroDec())
+	s.Require().NoError(err)
+	_, positionId := s.SetupPosition(pool.GetId(), owner, sdk.NewCoins(DefaultCoin0), managedPositionLowerTick, managedPositionUpperTick, false)
+	err = s.App.ConcentratedLiquidityKeeper.EnableManagedPosition(s.Ctx, owner, positionId, managedPositionWidth, types.DefaultManagedPositionRebalanceFee)
+	s.Require().NoError(err)
+
+	hooks := s.App.ConcentratedLiquidityKeeper.EpochHooks()
+	epochIdentifier := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx).ManagedPositionRebalanceEpochIdentifier
+
+	// Each of the first two epochs only attempts one of the failing positions.
+	for epoch := int64(1); epoch <= 2; epoch++ {
+		s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, epochIdentifier, epoch))
+		_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(hooks.AfterEpochEnd(s.Ctx, epochIdentifier, 3))
+	_, err = s.App.ConcentratedLiquidityKeeper.GetManagedPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.ErrManagedPositionNotFound)
+
+	managedPositions, err := s.App.ConcentratedLiquidityKeeper.GetManagedPositionsByOwner(s.Ctx, owner)
+	s.Require().NoError(err)
+	s.Require().Len(managedPositions, 3)
+	rebalanced := 0
+	for _, managedPosition := range managedPositions {
+		if managedPosition.RebalanceCount > 0 {
+			rebalanced++
+		}
+	}
+	s.Require().Equal(1, rebalanced)
+}
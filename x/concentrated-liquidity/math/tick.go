@@ -29,6 +29,8 @@ func TicksToSqrtPrice(lowerTick, upperTick int64) (osmomath.BigDec, osmomath.Big
 // TickToSqrtPrice returns the sqrtPrice given a tickIndex
 // If tickIndex is zero, the function returns osmomath.OneDec().
 // It is the combination of calling TickToPrice followed by Sqrt.
+// The square root is always rounded up so that the tick's sqrt price squared
+// is never below its price, and so that it is monotonic in the tick index.
 func TickToSqrtPrice(tickIndex int64) (osmomath.BigDec, error) {
 	priceBigDec, err := TickToPrice(tickIndex)
 	if err != nil {
@@ -45,7 +47,7 @@ func TickToSqrtPrice(tickIndex int64) (osmomath.BigDec, error) {
 		// As a result, there is no data loss.
 		price := priceBigDec.Dec()
 
-		sqrtPrice, err := osmomath.MonotonicSqrt(price)
+		sqrtPrice, err := osmomath.MonotonicSqrtWithRounding(price, osmomath.RoundUp)
 		if err != nil {
 			return osmomath.BigDec{}, err
 		}
@@ -54,7 +56,7 @@ func TickToSqrtPrice(tickIndex int64) (osmomath.BigDec, error) {
 
 	// For the newly extended range of [tick(MinSpotPriceV2), MinInitializedTick), we use the new math
 	// based on 36 precision decimal.
-	sqrtPrice, err := osmomath.MonotonicSqrtBigDec(priceBigDec)
+	sqrtPrice, err := osmomath.MonotonicSqrtBigDecWithRounding(priceBigDec, osmomath.RoundUp)
 	if err != nil {
 		return osmomath.BigDec{}, err
 	}
@@ -2,10 +2,14 @@ package concentrated_liquidity
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	clmodel "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
@@ -116,6 +120,14 @@ func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWi
 		return nil, err
 	}
 
+	// The withdrawal is reverted along with the rest of the message if any of the bounds is not met.
+	if !msg.TokenOutMinAmount0.IsNil() && amount0.LT(msg.TokenOutMinAmount0) {
+		return nil, types.InsufficientAmountWithdrawnError{Actual: amount0, Minimum: msg.TokenOutMinAmount0, IsTokenZero: true}
+	}
+	if !msg.TokenOutMinAmount1.IsNil() && amount1.LT(msg.TokenOutMinAmount1) {
+		return nil, types.InsufficientAmountWithdrawnError{Actual: amount1, Minimum: msg.TokenOutMinAmount1}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -129,8 +141,12 @@ func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}, nil
 }
 
-// CollectSpreadRewards collects the fees earned by each position ID provided and sends them to the owner's account.
-// Returns error if one of the provided position IDs do not exist or if the function fails to get the fee accumulator.
+// CollectSpreadRewards collects the fees earned by each position ID provided and sends them to the owner's account,
+// or to the lienholder of positions locked as collateral with their rewards redirected.
+// The sender must either own the positions, have been granted a claim allowance by their owner or hold a lien
+// on them that redirects their rewards.
+// Returns error if one of the provided position IDs do not exist or if the function fails to get the fee accumulator,
+// unless best effort is requested, in which case the failed positions are skipped and reported in the response.
 func (server msgServer) CollectSpreadRewards(goCtx context.Context, msg *types.MsgCollectSpreadRewards) (*types.MsgCollectSpreadRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -140,12 +156,37 @@ func (server msgServer) CollectSpreadRewards(goCtx context.Context, msg *types.M
 	}
 
 	totalCollectedSpreadRewards := sdk.NewCoins()
+	results := make([]types.CollectSpreadRewardsResult, 0, len(msg.PositionIds))
 	for _, positionId := range msg.PositionIds {
-		collectedFees, err := server.keeper.collectSpreadRewards(ctx, sender, positionId)
+		result := types.CollectSpreadRewardsResult{PositionId: positionId}
+		collect := func(ctx sdk.Context) error {
+			owner, _, err := server.keeper.getRewardsRecipient(ctx, sender, positionId)
+			if err != nil {
+				return err
+			}
+			collectedFees, err := server.keeper.collectSpreadRewards(ctx, owner, positionId)
+			if err != nil {
+				return err
+			}
+			result.CollectedSpreadRewards = collectedFees
+			return server.keeper.redirectRewardsToLienholder(ctx, owner, positionId, collectedFees)
+		}
+
+		var err error
+		if msg.BestEffort {
+			err = collectBestEffort(ctx, positionId, collect)
+		} else {
+			err = collect(ctx)
+		}
 		if err != nil {
-			return nil, err
+			if !msg.BestEffort {
+				return nil, err
+			}
+			result.CollectedSpreadRewards = sdk.NewCoins()
+			result.Error = err.Error()
 		}
-		totalCollectedSpreadRewards = totalCollectedSpreadRewards.Add(collectedFees...)
+		totalCollectedSpreadRewards = totalCollectedSpreadRewards.Add(result.CollectedSpreadRewards...)
+		results = append(results, result)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -162,10 +203,27 @@ func (server msgServer) CollectSpreadRewards(goCtx context.Context, msg *types.M
 		),
 	})
 
-	return &types.MsgCollectSpreadRewardsResponse{CollectedSpreadRewards: totalCollectedSpreadRewards}, nil
+	return &types.MsgCollectSpreadRewardsResponse{CollectedSpreadRewards: totalCollectedSpreadRewards, Results: results}, nil
 }
 
-// CollectIncentives collects incentives for all positions in given range that belong to sender
+// collectBestEffort runs collect in a cache context, whose state changes are discarded if it fails so that
+// the remaining positions can still be collected from. Failures are expected in best effort mode and are
+// reported in the response, so they are only logged at debug level.
+func collectBestEffort(ctx sdk.Context, positionId uint64, collect func(ctx sdk.Context) error) error {
+	_, _, err := osmoutils.ApplyFuncIfNoErrorAndCondition(ctx, func(cacheCtx sdk.Context) (struct{}, error) {
+		return struct{}{}, collect(cacheCtx)
+	}, nil)
+	if err != nil {
+		ctx.Logger().Debug("skipping position in best effort collection", "position_id", positionId, "error", err)
+	}
+	return err
+}
+
+// CollectIncentives collects incentives for all positions in given range that belong to sender,
+// whose owner granted the sender a claim allowance or on which the sender holds a lien that redirects rewards.
+// The incentives are sent to the owner, unless the position is locked as collateral with its rewards redirected,
+// in which case they are sent to the lienholder.
+// If best effort is requested, the positions that fail to be collected from are skipped and reported in the response.
 func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgCollectIncentives) (*types.MsgCollectIncentivesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -176,13 +234,40 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 
 	totalCollectedIncentives := sdk.NewCoins()
 	totalForefeitedIncentives := sdk.NewCoins()
+	results := make([]types.CollectIncentivesResult, 0, len(msg.PositionIds))
 	for _, positionId := range msg.PositionIds {
-		collectedIncentives, forfeitedIncentives, err := server.keeper.collectIncentives(ctx, sender, positionId)
+		result := types.CollectIncentivesResult{PositionId: positionId}
+		collect := func(ctx sdk.Context) error {
+			owner, isGrantee, err := server.keeper.getRewardsRecipient(ctx, sender, positionId)
+			if err != nil {
+				return err
+			}
+			// A grantee only collects the incentives of the uptimes the position has reached,
+			// so that it cannot make the owner forfeit the incentives of the other uptimes.
+			collectedIncentives, forfeitedIncentives, err := server.keeper.collectIncentivesForPosition(ctx, owner, positionId, !isGrantee)
+			if err != nil {
+				return err
+			}
+			result.CollectedIncentives, result.ForfeitedIncentives = collectedIncentives, forfeitedIncentives
+			return server.keeper.redirectRewardsToLienholder(ctx, owner, positionId, collectedIncentives)
+		}
+
+		var err error
+		if msg.BestEffort {
+			err = collectBestEffort(ctx, positionId, collect)
+		} else {
+			err = collect(ctx)
+		}
 		if err != nil {
-			return nil, err
+			if !msg.BestEffort {
+				return nil, err
+			}
+			result.CollectedIncentives, result.ForfeitedIncentives = sdk.NewCoins(), sdk.NewCoins()
+			result.Error = err.Error()
 		}
-		totalCollectedIncentives = totalCollectedIncentives.Add(collectedIncentives...)
-		totalForefeitedIncentives = totalForefeitedIncentives.Add(forfeitedIncentives...)
+		totalCollectedIncentives = totalCollectedIncentives.Add(result.CollectedIncentives...)
+		totalForefeitedIncentives = totalForefeitedIncentives.Add(result.ForfeitedIncentives...)
+		results = append(results, result)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -199,7 +284,7 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 		),
 	})
 
-	return &types.MsgCollectIncentivesResponse{CollectedIncentives: totalCollectedIncentives, ForfeitedIncentives: totalForefeitedIncentives}, nil
+	return &types.MsgCollectIncentivesResponse{CollectedIncentives: totalCollectedIncentives, ForfeitedIncentives: totalForefeitedIncentives, Results: results}, nil
 }
 
 func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgTransferPositions) (*types.MsgTransferPositionsResponse, error) {
@@ -237,3 +322,437 @@ func (server msgServer) TransferPositions(goCtx context.Context, msg *types.MsgT
 
 	return &types.MsgTransferPositionsResponse{}, nil
 }
+
+// UpdateParams overwrites the module parameters, e.g. to authorize new tick spacings or uptimes
+// without an upgrade. Only the governance module account is authorized.
+func (server msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if msg.Authority != govAddr {
+		return nil, types.UnauthorizedAuthorityError{ExpectedAuthority: govAddr, ActualAuthority: msg.Authority}
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	server.keeper.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetClaimAllowance authorizes the grantee to collect the spread rewards and incentives of all of the sender's
+// positions on the sender's behalf, e.g. for an auto-compounding service. The rewards are always sent to the sender.
+func (server msgServer) SetClaimAllowance(goCtx context.Context, msg *types.MsgSetClaimAllowance) (*types.MsgSetClaimAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.SetClaimAllowance(ctx, sender, grantee, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	expiration := ""
+	if msg.Expiration != nil {
+		expiration = msg.Expiration.String()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtSetClaimAllowance,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+			sdk.NewAttribute(types.AttributeKeyExpiration, expiration),
+		),
+	})
+
+	return &types.MsgSetClaimAllowanceResponse{}, nil
+}
+
+// RevokeClaimAllowance revokes the claim allowance granted by the sender to the grantee.
+func (server msgServer) RevokeClaimAllowance(goCtx context.Context, msg *types.MsgRevokeClaimAllowance) (*types.MsgRevokeClaimAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.RevokeClaimAllowance(ctx, sender, grantee); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtRevokeClaimAllowance,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee),
+		),
+	})
+
+	return &types.MsgRevokeClaimAllowanceResponse{}, nil
+}
+
+// WrapPosition locks a position owned by the sender to the position wrapper and mints a single token
+// of the position's wrapper denom to the sender.
+func (server msgServer) WrapPosition(goCtx context.Context, msg *types.MsgWrapPosition) (*types.MsgWrapPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	denom, err := server.keeper.wrapPosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtWrapPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+	})
+
+	return &types.MsgWrapPositionResponse{Denom: denom}, nil
+}
+
+// UnwrapPosition burns the sender's wrapper token of a wrapped position and returns ownership of the
+// position to the sender, along with the spread rewards and incentives accrued while it was wrapped.
+func (server msgServer) UnwrapPosition(goCtx context.Context, msg *types.MsgUnwrapPosition) (*types.MsgUnwrapPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	collectedSpreadRewards, collectedIncentives, err := server.keeper.unwrapPosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtUnwrapPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyDenom, types.GetPositionWrapperDenom(msg.PositionId)),
+		),
+	})
+
+	return &types.MsgUnwrapPositionResponse{CollectedSpreadRewards: collectedSpreadRewards, CollectedIncentives: collectedIncentives}, nil
+}
+
+// WithdrawAllPoolPositions fully withdraws the sender's positions in a pool, collecting their spread rewards and incentives,
+// up to the MaxPositionsPerWithdrawAll param. The positions left can be withdrawn by sending the message again.
+func (server msgServer) WithdrawAllPoolPositions(goCtx context.Context, msg *types.MsgWithdrawAllPoolPositions) (*types.MsgWithdrawAllPoolPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionIds, amount0, amount1, remainingPositions, err := server.keeper.withdrawAllPoolPositions(ctx, sender, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: withdraw position events are emitted in keeper.WithdrawPosition(...) for every position.
+
+	return &types.MsgWithdrawAllPoolPositionsResponse{
+		PositionIds:        positionIds,
+		Amount0:            amount0,
+		Amount1:            amount1,
+		RemainingPositions: remainingPositions,
+	}, nil
+}
+
+// LockPositionForCollateral freezes a position owned by the sender as collateral of an authorized lienholder.
+// The position can neither be withdrawn from nor transferred until the lienholder unlocks it.
+// The message is signed by both the sender and the lienholder.
+func (server msgServer) LockPositionForCollateral(goCtx context.Context, msg *types.MsgLockPositionForCollateral) (*types.MsgLockPositionForCollateralResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	lienholder, err := sdk.AccAddressFromBech32(msg.Lienholder)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.LockPositionForCollateral(ctx, sender, lienholder, msg.PositionId, msg.RedirectRewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtLockPositionForCollateral,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyLienholder, msg.Lienholder),
+			sdk.NewAttribute(types.AttributeKeyRedirectRewards, strconv.FormatBool(msg.RedirectRewards)),
+		),
+	})
+
+	return &types.MsgLockPositionForCollateralResponse{}, nil
+}
+
+// UnlockPosition releases the lien of the sender on a position.
+func (server msgServer) UnlockPosition(goCtx context.Context, msg *types.MsgUnlockPosition) (*types.MsgUnlockPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.UnlockPosition(ctx, sender, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtUnlockPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		),
+	})
+
+	return &types.MsgUnlockPositionResponse{}, nil
+}
+
+// EnableManagedPosition opts a position owned by the sender into automatic rebalancing once the current tick
+// exits its range, or updates its rebalancing configuration.
+func (server msgServer) EnableManagedPosition(goCtx context.Context, msg *types.MsgEnableManagedPosition) (*types.MsgEnableManagedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.EnableManagedPosition(ctx, sender, msg.PositionId, msg.RangeWidth, msg.MaxRebalanceFee); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtEnableManagedPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRangeWidth, strconv.FormatUint(msg.RangeWidth, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaxRebalanceFee, msg.MaxRebalanceFee.String()),
+		),
+	})
+
+	return &types.MsgEnableManagedPositionResponse{}, nil
+}
+
+// DisableManagedPosition opts a position owned by the sender out of automatic rebalancing.
+func (server msgServer) DisableManagedPosition(goCtx context.Context, msg *types.MsgDisableManagedPosition) (*types.MsgDisableManagedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.DisableManagedPosition(ctx, sender, msg.PositionId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+		sdk.NewEvent(
+			types.TypeEvtDisableManagedPosition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(msg.PositionId, 10)),
+		),
+	})
+
+	return &types.MsgDisableManagedPositionResponse{}, nil
+}
+
+// RebalanceManagedPosition rebalances a managed position out of range on behalf of its owner.
+// Only authorized rebalancers can send it, and they receive the rebalance fee.
+func (server msgServer) RebalanceManagedPosition(goCtx context.Context, msg *types.MsgRebalanceManagedPosition) (*types.MsgRebalanceManagedPositionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newPositionId, rebalanceFee, err := server.keeper.RebalanceManagedPosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgRebalanceManagedPositionResponse{NewPositionId: newPositionId, RebalanceFee: rebalanceFee}, nil
+}
+
+// MigratePositionToSuccessorPool moves a position owned by the sender out of a deprecated pool into its successor pool,
+// while a position migration window is open.
+func (server msgServer) MigratePositionToSuccessorPool(goCtx context.Context, msg *types.MsgMigratePositionToSuccessorPool) (*types.MsgMigratePositionToSuccessorPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newPosition, err := server.keeper.MigratePositionToSuccessorPool(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigratePositionToSuccessorPoolResponse{
+		NewPositionId:    newPosition.ID,
+		Amount0:          newPosition.Amount0,
+		Amount1:          newPosition.Amount1,
+		LiquidityCreated: newPosition.Liquidity,
+	}, nil
+}
+
+// PlaceLimitOrder creates a single tick spacing position owned by the sender that sells a single token, and is
+// converted and withdrawn into escrow once the current tick crosses it.
+func (server msgServer) PlaceLimitOrder(goCtx context.Context, msg *types.MsgPlaceLimitOrder) (*types.MsgPlaceLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err := server.keeper.PlaceLimitOrder(ctx, sender, msg.PoolId, msg.TickIndex, msg.TokenIn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgPlaceLimitOrderResponse{PositionId: position.ID, LiquidityCreated: position.Liquidity}, nil
+}
+
+// ClaimLimitOrder sends the amount a filled limit order of the sender converted to to the sender.
+func (server msgServer) ClaimLimitOrder(goCtx context.Context, msg *types.MsgClaimLimitOrder) (*types.MsgClaimLimitOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := server.keeper.ClaimLimitOrder(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgClaimLimitOrderResponse{Claimed: claimed}, nil
+}
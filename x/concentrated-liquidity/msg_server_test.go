@@ -5,6 +5,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
@@ -219,6 +221,72 @@ func (s *KeeperTestSuite) TestAddToPosition_Events() {
 
 // TestCollectSpreadRewards_Events tests that events are correctly emitted
 // when calling CollectSpreadRewards.
+func (s *KeeperTestSuite) TestWithdrawPositionMsg_TokenOutMinAmounts() {
+	tests := map[string]struct {
+		setTokenOutMinAmounts bool
+		// added to the amounts withdrawn to get the minimums.
+		tokenOutMinAmount0Delta int64
+		tokenOutMinAmount1Delta int64
+		expectedError           error
+	}{
+		"no minimums": {},
+		"minimums equal to the amounts withdrawn": {
+			setTokenOutMinAmounts: true,
+		},
+		"error: token0 withdrawn below minimum": {
+			setTokenOutMinAmounts:   true,
+			tokenOutMinAmount0Delta: 1,
+			expectedError:           types.InsufficientAmountWithdrawnError{IsTokenZero: true},
+		},
+		"error: token1 withdrawn below minimum": {
+			setTokenOutMinAmounts:   true,
+			tokenOutMinAmount1Delta: 1,
+			expectedError:           types.InsufficientAmountWithdrawnError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner := s.TestAccs[0]
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			liquidityToWithdraw := position.Liquidity.QuoInt64(2)
+
+			// Determine the amounts withdrawn without any bounds.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			expectedAmount0, expectedAmount1, err := s.App.ConcentratedLiquidityKeeper.WithdrawPosition(cacheCtx, owner, positionId, liquidityToWithdraw)
+			s.Require().NoError(err)
+
+			msg := &types.MsgWithdrawPosition{
+				PositionId:      positionId,
+				Sender:          owner.String(),
+				LiquidityAmount: liquidityToWithdraw,
+			}
+			if tc.setTokenOutMinAmounts {
+				msg.TokenOutMinAmount0 = expectedAmount0.AddRaw(tc.tokenOutMinAmount0Delta)
+				msg.TokenOutMinAmount1 = expectedAmount1.AddRaw(tc.tokenOutMinAmount1Delta)
+			}
+
+			resp, err := msgServer.WithdrawPosition(sdk.WrapSDKContext(s.Ctx), msg)
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				s.Require().Equal(tc.expectedError.(types.InsufficientAmountWithdrawnError).IsTokenZero, err.(types.InsufficientAmountWithdrawnError).IsTokenZero)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(expectedAmount0, resp.Amount0)
+			s.Require().Equal(expectedAmount1, resp.Amount1)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestCollectSpreadRewards_Events() {
 	testcases := map[string]struct {
 		upperTick                              int64
@@ -418,7 +486,7 @@ func (s *KeeperTestSuite) TestCollectIncentives_Events() {
 			positionAge := ctx.BlockTime().Sub(position.JoinTime)
 
 			// Set up accrued incentives
-			err = addToUptimeAccums(ctx, pool.GetId(), s.App.ConcentratedLiquidityKeeper, uptimeHelper.hundredTokensMultiDenom)
+			err = addToUptimeAccums(ctx, pool.GetId(), s.App.ConcentratedLiquidityKeeper, scaleUptimeGrowth(uptimeHelper.hundredTokensMultiDenom))
 			s.Require().NoError(err)
 
 			numPositions := osmomath.NewInt(int64(len(tc.positionIds)))
@@ -459,6 +527,80 @@ func (s *KeeperTestSuite) TestCollectIncentives_Events() {
 	}
 }
 
+// TestCollect_BestEffort tests that collecting spread rewards and incentives in best effort mode
+// skips the positions that fail and reports them in the response, while atomic mode fails as a whole.
+func (s *KeeperTestSuite) TestCollect_BestEffort() {
+	for _, bestEffort := range []bool{true, false} {
+		s.Run(fmt.Sprintf("best effort: %t", bestEffort), func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+
+			pool := s.PrepareConcentratedPool()
+			ownedPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+			unownedPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+			secondOwnedPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[0])
+			positionIds := []uint64{ownedPositionId, unownedPositionId, secondOwnedPositionId}
+
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			totalClaimable := sdk.NewCoins()
+			for _, positionId := range positionIds {
+				claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+				s.Require().NoError(err)
+				totalClaimable = totalClaimable.Add(claimable...)
+			}
+			s.FundAcc(pool.GetSpreadRewardsAddress(), totalClaimable)
+
+			spreadRewardsResponse, err := msgServer.CollectSpreadRewards(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectSpreadRewards{
+				Sender:      s.TestAccs[0].String(),
+				PositionIds: positionIds,
+				BestEffort:  bestEffort,
+			})
+			if !bestEffort {
+				s.Require().ErrorAs(err, &types.NotPositionOwnerError{})
+			} else {
+				s.Require().NoError(err)
+				s.Require().Len(spreadRewardsResponse.Results, len(positionIds))
+
+				totalCollected := sdk.NewCoins()
+				for i, result := range spreadRewardsResponse.Results {
+					s.Require().Equal(positionIds[i], result.PositionId)
+					if result.PositionId == unownedPositionId {
+						s.Require().NotEmpty(result.Error)
+						s.Require().True(result.CollectedSpreadRewards.IsZero())
+						continue
+					}
+					s.Require().Empty(result.Error)
+					s.Require().False(result.CollectedSpreadRewards.IsZero())
+					totalCollected = totalCollected.Add(result.CollectedSpreadRewards...)
+				}
+				s.Require().Equal(totalCollected, spreadRewardsResponse.CollectedSpreadRewards)
+
+				// the failed position can still be collected from by its owner.
+				claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, unownedPositionId)
+				s.Require().NoError(err)
+				s.Require().False(claimable.IsZero())
+			}
+
+			// non-existent positions fail in the same way.
+			nonExistentPositionId := secondOwnedPositionId + 1
+			incentivesResponse, err := msgServer.CollectIncentives(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectIncentives{
+				Sender:      s.TestAccs[0].String(),
+				PositionIds: []uint64{ownedPositionId, nonExistentPositionId},
+				BestEffort:  bestEffort,
+			})
+			if !bestEffort {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(incentivesResponse.Results, 2)
+			s.Require().Empty(incentivesResponse.Results[0].Error)
+			s.Require().Equal(nonExistentPositionId, incentivesResponse.Results[1].PositionId)
+			s.Require().NotEmpty(incentivesResponse.Results[1].Error)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestFungify_Events() {
 
 	s.T().Skip("TODO: re-enable fungify test if message is restored")
@@ -685,3 +827,500 @@ func (s *KeeperTestSuite) TestTransferPositions_Events() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	newParams := types.DefaultParams()
+	newParams.AuthorizedTickSpacing = append(newParams.AuthorizedTickSpacing, 2000)
+	newParams.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Hour * 24}
+
+	tests := map[string]struct {
+		authority     string
+		expectedError error
+	}{
+		"governance updates params": {
+			authority: govAddr,
+		},
+		"error: unauthorized sender": {
+			authority:     s.TestAccs[0].String(),
+			expectedError: types.UnauthorizedAuthorityError{ExpectedAuthority: govAddr, ActualAuthority: s.TestAccs[0].String()},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			paramsBefore := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateParams(tc.authority, newParams))
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().Equal(paramsBefore, s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(newParams, s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx))
+
+			// pools can be created with the newly authorized tick spacing without an upgrade.
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, 2000, osmomath.ZeroDec())
+			s.Require().Equal(uint64(2000), pool.GetTickSpacing())
+		})
+	}
+}
+
+// TestCollect_ClaimAllowance tests that a grantee can collect the spread rewards and incentives of the
+// owner's positions only while the claim allowance is valid, and that the rewards are sent to the owner.
+func (s *KeeperTestSuite) TestCollect_ClaimAllowance() {
+	tests := map[string]struct {
+		setAllowance  bool
+		expiration    time.Duration
+		timeElapsed   time.Duration
+		revoke        bool
+		expectedError error
+	}{
+		"allowance without expiration": {
+			setAllowance: true,
+			timeElapsed:  time.Hour * 24 * 365,
+		},
+		"allowance before expiration": {
+			setAllowance: true,
+			expiration:   time.Hour,
+			timeElapsed:  time.Minute,
+		},
+		"error: no allowance": {
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: expired allowance": {
+			setAllowance:  true,
+			expiration:    time.Hour,
+			timeElapsed:   time.Hour,
+			expectedError: types.ClaimAllowanceExpiredError{},
+		},
+		"error: revoked allowance": {
+			setAllowance:  true,
+			revoke:        true,
+			expectedError: types.NotPositionOwnerError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, grantee := s.TestAccs[0], s.TestAccs[1]
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+			if tc.setAllowance {
+				msg := &types.MsgSetClaimAllowance{Sender: owner.String(), Grantee: grantee.String()}
+				if tc.expiration != 0 {
+					expiration := s.Ctx.BlockTime().Add(tc.expiration)
+					msg.Expiration = &expiration
+				}
+				_, err := msgServer.SetClaimAllowance(sdk.WrapSDKContext(s.Ctx), msg)
+				s.Require().NoError(err)
+			}
+			if tc.revoke {
+				_, err := msgServer.RevokeClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgRevokeClaimAllowance{Sender: owner.String(), Grantee: grantee.String()})
+				s.Require().NoError(err)
+			}
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(tc.timeElapsed))
+
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), claimable)
+
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			granteeBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, grantee)
+
+			_, err = msgServer.CollectSpreadRewards(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectSpreadRewards{
+				Sender:      grantee.String(),
+				PositionIds: []uint64{positionId},
+			})
+			_, incentivesErr := msgServer.CollectIncentives(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectIncentives{
+				Sender:      grantee.String(),
+				PositionIds: []uint64{positionId},
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				s.Require().IsType(tc.expectedError, incentivesErr)
+				s.Require().Equal(ownerBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NoError(incentivesErr)
+
+			// the rewards are sent to the owner rather than the grantee.
+			s.Require().Equal(ownerBalanceBefore.Add(claimable...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().Equal(granteeBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, grantee))
+		})
+	}
+}
+
+// TestCollectIncentives_ClaimAllowanceDoesNotForfeit tests that a grantee only collects the incentives
+// of the uptimes the owner's position has reached, and leaves the others to be collected once it reaches them
+// rather than forfeiting them.
+func (s *KeeperTestSuite) TestCollectIncentives_ClaimAllowanceDoesNotForfeit() {
+	s.SetupTest()
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	owner, grantee := s.TestAccs[0], s.TestAccs[1]
+
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+
+	_, err := msgServer.SetClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgSetClaimAllowance{Sender: owner.String(), Grantee: grantee.String()})
+	s.Require().NoError(err)
+
+	err = s.Clk.SetMultipleIncentiveRecords(s.Ctx, []types.IncentiveRecord{{
+		PoolId: pool.GetId(),
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingCoin: sdk.NewDecCoinFromDec(USDC, osmomath.NewDec(10_000)),
+			EmissionRate:  osmomath.NewDec(1),
+			StartTime:     s.Ctx.BlockTime(),
+		},
+		MinUptime: time.Hour * 24,
+	}})
+	s.Require().NoError(err)
+	s.FundAcc(pool.GetIncentivesAddress(), sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(10_000))))
+
+	collect := func() *types.MsgCollectIncentivesResponse {
+		resp, err := msgServer.CollectIncentives(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectIncentives{
+			Sender:      grantee.String(),
+			PositionIds: []uint64{positionId},
+		})
+		s.Require().NoError(err)
+		return resp
+	}
+
+	// Before the position reaches the uptime, the grantee neither collects nor forfeits its incentives.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	resp := collect()
+	s.Require().True(resp.CollectedIncentives.IsZero())
+	s.Require().True(resp.ForfeitedIncentives.IsZero())
+	s.Require().Equal(ownerBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+	_, unmaturedIncentives, err := s.App.ConcentratedLiquidityKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(unmaturedIncentives.IsZero())
+
+	// Once the position reaches the uptime, the grantee collects all of its incentives for the owner.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour * 23))
+	claimable, forfeitable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(forfeitable.IsZero())
+	s.Require().True(claimable.IsAllGT(unmaturedIncentives))
+
+	resp = collect()
+	s.Require().Equal(claimable, resp.CollectedIncentives)
+	s.Require().True(resp.ForfeitedIncentives.IsZero())
+	s.Require().Equal(ownerBalanceBefore.Add(claimable...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+}
+
+func (s *KeeperTestSuite) TestSetAndRevokeClaimAllowance() {
+	s.SetupTest()
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	owner, grantee := s.TestAccs[0], s.TestAccs[1]
+
+	// expiration must be in the future.
+	expiration := s.Ctx.BlockTime()
+	_, err := msgServer.SetClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgSetClaimAllowance{Sender: owner.String(), Grantee: grantee.String(), Expiration: &expiration})
+	s.Require().ErrorAs(err, &types.InvalidClaimAllowanceExpirationError{})
+
+	// setting again overwrites the expiration.
+	expiration = s.Ctx.BlockTime().Add(time.Hour)
+	_, err = msgServer.SetClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgSetClaimAllowance{Sender: owner.String(), Grantee: grantee.String(), Expiration: &expiration})
+	s.Require().NoError(err)
+	_, err = msgServer.SetClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgSetClaimAllowance{Sender: owner.String(), Grantee: grantee.String()})
+	s.Require().NoError(err)
+
+	allowance, err := s.App.ConcentratedLiquidityKeeper.GetClaimAllowance(s.Ctx, owner, grantee)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ClaimAllowance{Owner: owner.String(), Grantee: grantee.String()}, allowance)
+
+	allowances, err := s.App.ConcentratedLiquidityKeeper.GetAllClaimAllowances(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ClaimAllowance{allowance}, allowances)
+
+	// the allowance is directional.
+	_, err = s.App.ConcentratedLiquidityKeeper.GetClaimAllowance(s.Ctx, grantee, owner)
+	s.Require().ErrorIs(err, types.ErrClaimAllowanceNotFound)
+
+	_, err = msgServer.RevokeClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgRevokeClaimAllowance{Sender: owner.String(), Grantee: grantee.String()})
+	s.Require().NoError(err)
+	_, err = s.App.ConcentratedLiquidityKeeper.GetClaimAllowance(s.Ctx, owner, grantee)
+	s.Require().ErrorIs(err, types.ErrClaimAllowanceNotFound)
+
+	// revoking again fails.
+	_, err = msgServer.RevokeClaimAllowance(sdk.WrapSDKContext(s.Ctx), &types.MsgRevokeClaimAllowance{Sender: owner.String(), Grantee: grantee.String()})
+	s.Require().ErrorIs(err, types.ErrClaimAllowanceNotFound)
+}
+
+func (s *KeeperTestSuite) TestWrapPosition() {
+	tests := map[string]struct {
+		sender        int
+		lastPosition  bool
+		expectedError error
+	}{
+		"happy path": {},
+		"error: sender is not the owner": {
+			sender:        1,
+			expectedError: types.PositionOwnerMismatchError{},
+		},
+		"error: last position in pool": {
+			lastPosition:  true,
+			expectedError: types.LastPositionTransferError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, sender := s.TestAccs[0], s.TestAccs[tc.sender]
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			if !tc.lastPosition {
+				s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+			}
+
+			// Accrue spread rewards that are collected to the owner upon wrapping.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), claimable)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			resp, err := msgServer.WrapPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgWrapPosition{
+				Sender:     sender.String(),
+				PositionId: positionId,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			wrapperDenom := types.GetPositionWrapperDenom(positionId)
+			s.Require().Equal(wrapperDenom, resp.Denom)
+
+			// The position is owned by the wrapper and the owner holds a single wrapper token.
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.PositionWrapperAddress.String(), position.Address)
+			s.Require().Equal(osmomath.OneInt(), s.App.BankKeeper.GetSupply(s.Ctx, wrapperDenom).Amount)
+
+			expectedOwnerBalance := ownerBalanceBefore.Add(claimable...).Add(sdk.NewCoin(wrapperDenom, osmomath.OneInt()))
+			s.Require().Equal(expectedOwnerBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+			_, broken := cl.WrappedPositionsInvariant(*s.Clk)(s.Ctx)
+			s.Require().False(broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestUnwrapPosition() {
+	tests := map[string]struct {
+		wrap             bool
+		sendWrapperToken bool
+		withdrawOthers   bool
+		expectedError    error
+	}{
+		"unwrap by original owner": {
+			wrap: true,
+		},
+		"unwrap by holder of transferred wrapper token": {
+			wrap:             true,
+			sendWrapperToken: true,
+		},
+		"unwrap last position in pool": {
+			wrap:           true,
+			withdrawOthers: true,
+		},
+		"error: position is not wrapped": {
+			expectedError: types.PositionNotWrappedError{},
+		},
+		"error: sender does not hold the wrapper token": {
+			wrap:             true,
+			sendWrapperToken: true,
+			expectedError:    types.PositionWrapperTokenNotHeldError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, holder := s.TestAccs[0], s.TestAccs[1]
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			otherPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+
+			wrapperDenom := types.GetPositionWrapperDenom(positionId)
+			if tc.wrap {
+				_, err := s.App.ConcentratedLiquidityKeeper.WrapPosition(s.Ctx, owner, positionId)
+				s.Require().NoError(err)
+			}
+			if tc.sendWrapperToken {
+				s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, owner, holder, sdk.NewCoins(sdk.NewCoin(wrapperDenom, osmomath.OneInt()))))
+			}
+			if tc.withdrawOthers {
+				otherPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, otherPositionId)
+				s.Require().NoError(err)
+				_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, s.TestAccs[2], otherPositionId, otherPosition.Liquidity)
+				s.Require().NoError(err)
+			}
+
+			// Accrue spread rewards while the position is wrapped.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), claimable)
+
+			// The original owner unwraps unless the wrapper token was sent to the holder.
+			// In the error case, the owner attempts to unwrap after sending the token away.
+			sender := owner
+			if tc.sendWrapperToken && tc.expectedError == nil {
+				sender = holder
+			}
+			senderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+
+			resp, err := msgServer.UnwrapPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgUnwrapPosition{
+				Sender:     sender.String(),
+				PositionId: positionId,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(claimable, resp.CollectedSpreadRewards)
+
+			// The sender owns the position, the wrapper token is burned and the accrued rewards are forwarded to the sender.
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(sender.String(), position.Address)
+			s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, wrapperDenom).Amount.IsZero())
+
+			expectedSenderBalance := senderBalanceBefore.Add(claimable...).Sub(sdk.NewCoins(sdk.NewCoin(wrapperDenom, osmomath.OneInt()))...)
+			s.Require().Equal(expectedSenderBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, types.PositionWrapperAddress).IsZero())
+
+			_, broken := cl.WrappedPositionsInvariant(*s.Clk)(s.Ctx)
+			s.Require().False(broken)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawAllPoolPositions() {
+	tests := map[string]struct {
+		numPositions               int
+		lockedPosition             bool
+		maxPositionsPerWithdrawAll uint64
+		poolId                     uint64
+		expectedWithdrawn          int
+		expectedRemaining          uint64
+		expectedError              error
+	}{
+		"withdraw all positions": {
+			numPositions:      3,
+			expectedWithdrawn: 3,
+		},
+		"withdrawn positions capped by param": {
+			numPositions:               3,
+			maxPositionsPerWithdrawAll: 2,
+			expectedWithdrawn:          2,
+			expectedRemaining:          1,
+		},
+		"position with active underlying lock is skipped": {
+			numPositions:      2,
+			lockedPosition:    true,
+			expectedWithdrawn: 2,
+		},
+		"error: no positions in pool": {
+			expectedError: types.NoWithdrawablePositionsError{},
+		},
+		"error: only position is locked": {
+			lockedPosition: true,
+			expectedError:  types.NoWithdrawablePositionsError{},
+		},
+		"error: pool does not exist": {
+			numPositions:  1,
+			poolId:        2,
+			expectedError: types.PoolNotFoundError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner := s.TestAccs[0]
+
+			if tc.maxPositionsPerWithdrawAll != 0 {
+				params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+				params.MaxPositionsPerWithdrawAll = tc.maxPositionsPerWithdrawAll
+				s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+			}
+
+			pool := s.PrepareConcentratedPool()
+			positionIds := make([]uint64, tc.numPositions)
+			for i := range positionIds {
+				positionIds[i] = s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			}
+			// Another account's position is left untouched.
+			otherPositionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+
+			var lockedPositionId uint64
+			if tc.lockedPosition {
+				s.FundAcc(owner, DefaultCoins)
+				positionData, _, err := s.App.ConcentratedLiquidityKeeper.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, time.Hour)
+				s.Require().NoError(err)
+				lockedPositionId = positionData.ID
+			}
+
+			poolId := pool.GetId()
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			resp, err := msgServer.WithdrawAllPoolPositions(sdk.WrapSDKContext(s.Ctx), &types.MsgWithdrawAllPoolPositions{
+				Sender: owner.String(),
+				PoolId: poolId,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Positions are withdrawn in ascending order of id.
+			s.Require().Equal(positionIds[:tc.expectedWithdrawn], resp.PositionIds)
+			s.Require().Equal(tc.expectedRemaining, resp.RemainingPositions)
+			for _, positionId := range resp.PositionIds {
+				_, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+				s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+			}
+			for _, positionId := range positionIds[tc.expectedWithdrawn:] {
+				_, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+				s.Require().NoError(err)
+			}
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, otherPositionId)
+			s.Require().NoError(err)
+			if tc.lockedPosition {
+				_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, lockedPositionId)
+				s.Require().NoError(err)
+			}
+
+			// No rewards were accrued, so the owner receives exactly the amounts withdrawn.
+			expectedOwnerBalance := ownerBalanceBefore.Add(sdk.NewCoin(ETH, resp.Amount0), sdk.NewCoin(USDC, resp.Amount1))
+			s.Require().Equal(expectedOwnerBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+		})
+	}
+}
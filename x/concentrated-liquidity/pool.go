@@ -289,6 +289,8 @@ func (k Keeper) GetSerializedPools(ctx sdk.Context, pagination *query.PageReques
 // DecreaseConcentratedPoolTickSpacing decreases the tick spacing of the given pools to the given tick spacings.
 // This effectively increases the number of initializable ticks in the pool by reducing the number of ticks we skip over when traversing up and down.
 // It returns an error if the tick spacing is not one of the authorized tick spacings or is not less than the current tick spacing of the respective pool.
+// It also returns an error if any of the initialized ticks of the pool is not divisible by the new tick spacing, so that
+// existing positions remain valid without having to migrate their liquidity.
 func (k Keeper) DecreaseConcentratedPoolTickSpacing(ctx sdk.Context, poolIdToTickSpacingRecord []types.PoolIdToTickSpacingRecord) error {
 	for _, poolIdToTickSpacingRecord := range poolIdToTickSpacingRecord {
 		pool, err := k.GetConcentratedPoolById(ctx, poolIdToTickSpacingRecord.PoolId)
@@ -301,6 +303,10 @@ func (k Keeper) DecreaseConcentratedPoolTickSpacing(ctx sdk.Context, poolIdToTic
 			return fmt.Errorf("tick spacing %d is not valid", poolIdToTickSpacingRecord.NewTickSpacing)
 		}
 
+		if err := k.validateInitializedTicksForTickSpacing(ctx, pool.GetId(), poolIdToTickSpacingRecord.NewTickSpacing); err != nil {
+			return err
+		}
+
 		pool.SetTickSpacing(poolIdToTickSpacingRecord.NewTickSpacing)
 		err = k.setPool(ctx, pool)
 		if err != nil {
@@ -334,6 +340,23 @@ func (k Keeper) validateTickSpacingUpdate(pool types.ConcentratedPoolExtension,
 	return false
 }
 
+// validateInitializedTicksForTickSpacing returns an error if any of the initialized ticks of the given pool
+// is not divisible by the given tick spacing. Such ticks would no longer be valid position bounds after
+// updating the pool to the given tick spacing.
+func (k Keeper) validateInitializedTicksForTickSpacing(ctx sdk.Context, poolId uint64, tickSpacing uint64) error {
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	for _, tick := range ticks {
+		if tick.TickIndex%int64(tickSpacing) != 0 {
+			return types.IncompatibleTickSpacingError{PoolId: poolId, TickSpacing: tickSpacing, TickIndex: tick.TickIndex}
+		}
+	}
+	return nil
+}
+
 // validateSpreadFactor returns true if the given spread factor is one of the authorized spread factors set in the
 // params. False otherwise.
 func (k Keeper) validateSpreadFactor(params types.Params, spreadFactor osmomath.Dec) bool {
@@ -164,6 +164,7 @@ func (s *KeeperTestSuite) TestInitializePool() {
 				s.Require().Equal(len(types.SupportedUptimes), len(uptimeAccumulators))
 				for _, uptimeAccumulator := range uptimeAccumulators {
 					s.Require().Equal(cl.EmptyCoins, uptimeAccumulator.GetValue())
+					s.Require().Equal(types.PerUnitLiqScalingFactor, uptimeAccumulator.GetScalingFactor())
 				}
 
 				s.validateListenerCallCount(1, 0, 0, 0)
@@ -504,6 +505,12 @@ func (s *KeeperTestSuite) TestDecreaseConcentratedPoolTickSpacing() {
 			position:                   positionRange{lowerTick: -10, upperTick: 10},
 			expectedDecreaseSpacingErr: fmt.Errorf("tick spacing %d is not valid", 1000),
 		},
+		{
+			name:                       "error: existing initialized tick not divisible by new tick spacing",
+			poolIdToTickSpacingRecord:  []types.PoolIdToTickSpacingRecord{{PoolId: 1, NewTickSpacing: 30}},
+			position:                   positionRange{lowerTick: -30, upperTick: 30},
+			expectedDecreaseSpacingErr: types.IncompatibleTickSpacingError{PoolId: 1, TickSpacing: 30, TickIndex: -100},
+		},
 		{
 			name:                      "error: cant create position whose lower tick is not divisible by new tick spacing",
 			poolIdToTickSpacingRecord: []types.PoolIdToTickSpacingRecord{{PoolId: 1, NewTickSpacing: 10}},
@@ -523,6 +530,11 @@ func (s *KeeperTestSuite) TestDecreaseConcentratedPoolTickSpacing() {
 			s.SetupTest()
 			owner := s.TestAccs[0]
 
+			// Authorize a tick spacing that is not a divisor of the pool's tick spacing.
+			params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+			params.AuthorizedTickSpacing = append(params.AuthorizedTickSpacing, 30)
+			s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+
 			// Create OSMO <> USDC pool with tick spacing of 100
 			concentratedPool := s.PrepareConcentratedPoolWithCoinsAndFullRangePosition(ETH, USDC)
 
@@ -7,7 +7,6 @@ import (
 	"strconv"
 	"time"
 
-	sdkprefix "github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -211,61 +210,54 @@ func (k Keeper) GetUserPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress,
 		prefix = types.KeyAddressAndPoolId(addr, poolId)
 	}
 
-	positionsStore := sdkprefix.NewStore(ctx.KVStore(k.storeKey), prefix)
-
-	fullPositions := []model.FullPositionBreakdown{}
-
-	pageRes, err := query.Paginate(positionsStore, pagination, func(key, value []byte) error {
+	fullPositions, pageRes, err := osmoutils.GatherValuesFromStorePrefixWithKeyParserPaginated(ctx.KVStore(k.storeKey), prefix, pagination, func(key, _ []byte) (model.FullPositionBreakdown, error) {
 		// Extract the components from the key
 		parts := bytes.Split(key, []byte(types.KeySeparator))
 		if len(parts) != expectedKeyPartCount {
-			return fmt.Errorf("invalid key format: %s", key)
+			return model.FullPositionBreakdown{}, fmt.Errorf("invalid key format: %s", key)
 		}
 
 		// Parse the positionId from the key
 		positionId, err := strconv.ParseUint(string(parts[expectedKeyPartCount-1]), 10, 64)
 		if err != nil {
-			return fmt.Errorf("failed to parse positionId: %w", err)
+			return model.FullPositionBreakdown{}, fmt.Errorf("failed to parse positionId: %w", err)
 		}
 
 		// Retrieve the position from the store using its ID and add it to the result slice.
 		position, err := k.GetPosition(ctx, positionId)
 		if err != nil {
-			return err
+			return model.FullPositionBreakdown{}, err
 		}
 
 		// get the pool from the position
 		pool, err := k.GetConcentratedPoolById(ctx, position.PoolId)
 		if err != nil {
-			return err
+			return model.FullPositionBreakdown{}, err
 		}
 
 		asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
 		if err != nil {
-			return err
+			return model.FullPositionBreakdown{}, err
 		}
 
 		claimableSpreadRewards, err := k.GetClaimableSpreadRewards(ctx, position.PositionId)
 		if err != nil {
-			return err
+			return model.FullPositionBreakdown{}, err
 		}
 
 		claimableIncentives, forfeitedIncentives, err := k.GetClaimableIncentives(ctx, position.PositionId)
 		if err != nil {
-			return err
+			return model.FullPositionBreakdown{}, err
 		}
 
-		// Append the position and underlying assets to the positions slice
-		fullPositions = append(fullPositions, model.FullPositionBreakdown{
+		return model.FullPositionBreakdown{
 			Position:               position,
 			Asset0:                 asset0,
 			Asset1:                 asset1,
 			ClaimableSpreadRewards: claimableSpreadRewards,
 			ClaimableIncentives:    claimableIncentives,
 			ForfeitedIncentives:    forfeitedIncentives,
-		})
-
-		return nil
+		}, nil
 	})
 	if err != nil {
 		return nil, nil, err
@@ -345,6 +337,8 @@ func (k Keeper) SetPosition(ctx sdk.Context,
 // - owner-pool-id-position-id to position id
 // - pool-id-position-id to position id
 // - position-id to underlying lock id if such mapping exists
+// - position-id to managed position if the position is managed
+// - position-id to limit order if the position holds a limit order that is not filled yet
 // Returns error if:
 // - the position with the given id does not exist.
 // - the owner-pool-id-position-id to position id mapping does not exist.
@@ -389,6 +383,16 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 		store.Delete(lockIdPositionKey)
 	}
 
+	// Remove the rebalancing configuration of the position (if it exists), so that it is not
+	// carried over to a new owner. A rebalanced position writes it again under its new ID.
+	k.deleteManagedPosition(ctx, positionId)
+
+	// Cancel the limit order held by the position (if it is not filled yet). Filled orders are kept
+	// until claimed.
+	if err := k.deleteOpenLimitOrder(ctx, positionId); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -670,7 +674,7 @@ func (k Keeper) updateFullRangeLiquidityInPool(ctx sdk.Context, poolId uint64, l
 // It first checks if the provided position IDs are unique. If not, it returns a DuplicatePositionIdsError.
 // For each position ID, it retrieves the corresponding position and checks if the sender is the owner of the position.
 // If the sender is not the owner, it returns an error.
-// It then checks if the position has an active underlying lock, and if so, returns an error.
+// It then checks if the position has an active underlying lock or is locked as collateral, and if so, returns an error.
 // It then collects any outstanding incentives and rewards for the position, deletes the KVStore entries for the position,
 // and restores the position under the recipient's account.
 // If any of these operations fail, it returns the corresponding error.
@@ -704,6 +708,11 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 			return types.LockNotMatureError{PositionId: position.PositionId, LockId: lockId}
 		}
 
+		// Positions locked as collateral cannot be transferred until the lienholder unlocks them.
+		if err := k.validatePositionNotLockedForCollateral(ctx, positionId); err != nil {
+			return err
+		}
+
 		// Collect any outstanding incentives and rewards for the position.
 		if _, err := k.collectSpreadRewards(ctx, sender, positionId); err != nil {
 			return err
@@ -734,6 +743,8 @@ func (k Keeper) transferPositions(ctx sdk.Context, positionIds []uint64, sender
 		if err != nil {
 			return err
 		}
+
+		k.recordPositionHistory(ctx, types.PositionEventType_Modified, positionId, recipient, position.PoolId, osmomath.ZeroDec(), position.Liquidity)
 	}
 
 	return nil
@@ -767,3 +778,62 @@ func (k Keeper) UnderlyingPositionsValue(ctx sdk.Context, positionIds []uint64)
 
 	return underlyingAssets, nil
 }
+
+// PositionValueInQuoteDenom returns the underlying assets of the given position along with their total value
+// in terms of the quote denom, so that positions can be valued on-chain, e.g. as collateral.
+// An asset of the position's pool is priced with the spot price of the pool itself if the quote denom is the other
+// asset of the pool, and with the spot price of the most liquid pool between the asset and the quote denom
+// as tracked by protorev otherwise. The value is truncated.
+// Note that spot prices can be moved within a block, so callers relying on the value should account for manipulation.
+// Returns error if the position does not exist or if a non-zero asset of the position cannot be priced.
+func (k Keeper) PositionValueInQuoteDenom(ctx sdk.Context, positionId uint64, quoteDenom string) (asset0, asset1, value sdk.Coin, err error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	actualAmount0, actualAmount1, err := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, position.Liquidity)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	asset0 = sdk.NewCoin(pool.GetToken0(), actualAmount0.TruncateInt())
+	asset1 = sdk.NewCoin(pool.GetToken1(), actualAmount1.TruncateInt())
+
+	totalValue := osmomath.ZeroInt()
+	for _, asset := range []sdk.Coin{asset0, asset1} {
+		assetValue, err := k.valueInQuoteDenom(ctx, pool, asset, quoteDenom)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+		totalValue = totalValue.Add(assetValue)
+	}
+
+	return asset0, asset1, sdk.NewCoin(quoteDenom, totalValue), nil
+}
+
+// valueInQuoteDenom returns the truncated value of the given asset of the pool in terms of the quote denom.
+func (k Keeper) valueInQuoteDenom(ctx sdk.Context, pool types.ConcentratedPoolExtension, asset sdk.Coin, quoteDenom string) (osmomath.Int, error) {
+	if asset.Denom == quoteDenom || asset.Amount.IsZero() {
+		return asset.Amount, nil
+	}
+
+	var (
+		price osmomath.BigDec
+		err   error
+	)
+	if pool.GetToken0() == quoteDenom || pool.GetToken1() == quoteDenom {
+		price, err = k.CalculateSpotPrice(ctx, pool.GetId(), quoteDenom, asset.Denom)
+	} else {
+		price, err = k.poolmanagerKeeper.RouteCalculateSpotPriceForDenomPair(ctx, quoteDenom, asset.Denom)
+	}
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	return osmomath.BigDecFromSDKInt(asset.Amount).Mul(price).Dec().TruncateInt(), nil
+}
@@ -0,0 +1,104 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// The lifecycle events of positions (creation, modifications and final withdrawal) are recorded under the
+// position id, so that they can be queried even after the position is deleted. Every entry is also indexed
+// by the block height it was recorded at, and the entries older than the PositionHistoryRetentionBlocks param
+// are pruned at the end of every block.
+
+// getPositionHistoryRetentionBlocks returns the PositionHistoryRetentionBlocks param, or zero if it is not set.
+func (k Keeper) getPositionHistoryRetentionBlocks(ctx sdk.Context) uint64 {
+	var retentionBlocks uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyPositionHistoryRetentionBlocks, &retentionBlocks)
+	return retentionBlocks
+}
+
+// recordPositionHistory records a lifecycle event of the given position in the current block, where owner is the
+// owner of the position and liquidity is its liquidity after the event.
+// No-op if the position history is disabled by a zero PositionHistoryRetentionBlocks param.
+func (k Keeper) recordPositionHistory(ctx sdk.Context, eventType types.PositionEventType, positionId uint64, owner sdk.AccAddress, poolId uint64, liquidityDelta, liquidity osmomath.Dec) {
+	if k.getPositionHistoryRetentionBlocks(ctx) == 0 {
+		return
+	}
+
+	k.addPositionHistoryEntry(ctx, types.PositionHistoryEntry{
+		PositionId:     positionId,
+		EventType:      eventType,
+		BlockHeight:    ctx.BlockHeight(),
+		BlockTime:      ctx.BlockTime(),
+		Address:        owner.String(),
+		PoolId:         poolId,
+		LiquidityDelta: liquidityDelta,
+		Liquidity:      liquidity,
+	})
+}
+
+// addPositionHistoryEntry writes the given entry to state after the entries of its position recorded
+// at the same block height, and indexes it by block height.
+func (k Keeper) addPositionHistoryEntry(ctx sdk.Context, entry types.PositionHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	// The index orders the entries of the position recorded in the same block.
+	index := uint64(0)
+	heightPrefix := append(types.KeyPositionHistoryPrefix(entry.PositionId), sdk.Uint64ToBigEndian(uint64(entry.BlockHeight))...)
+	iterator := sdk.KVStorePrefixIterator(store, heightPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		index++
+	}
+	iterator.Close()
+
+	osmoutils.MustSet(store, types.KeyPositionHistory(entry.PositionId, entry.BlockHeight, index), &entry)
+	store.Set(types.KeyPositionHistoryByHeight(entry.BlockHeight, entry.PositionId, index), []byte{})
+}
+
+// GetPositionHistory returns the entries of the given position within the retention period, in chronological order.
+// The entries are returned even if the position no longer exists.
+func (k Keeper) GetPositionHistory(ctx sdk.Context, positionId uint64) ([]types.PositionHistoryEntry, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPositionHistoryPrefix(positionId), osmoutils.ProtoValueParser[types.PositionHistoryEntry]())
+}
+
+// GetAllPositionHistory returns the entries of all positions in state.
+func (k Keeper) GetAllPositionHistory(ctx sdk.Context) ([]types.PositionHistoryEntry, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionHistoryPrefix, osmoutils.ProtoValueParser[types.PositionHistoryEntry]())
+}
+
+// PrunePositionHistory deletes the position history entries recorded more than PositionHistoryRetentionBlocks
+// blocks ago, oldest first, up to MaxPositionHistoryEntriesPrunedPerBlock entries. The remaining entries are
+// pruned in the following blocks. A zero retention prunes all entries, since the position history is disabled.
+// Returns the number of entries deleted.
+func (k Keeper) PrunePositionHistory(ctx sdk.Context) (int, error) {
+	cutoffHeight := ctx.BlockHeight() - int64(k.getPositionHistoryRetentionBlocks(ctx))
+	if cutoffHeight <= 0 {
+		return 0, nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexPrefix := types.KeyPositionHistoryByHeightPrefix()
+	// Entries recorded at or before the cutoff height are out of the retention period.
+	end := append(types.KeyPositionHistoryByHeightPrefix(), sdk.Uint64ToBigEndian(uint64(cutoffHeight+1))...)
+
+	iterator := store.Iterator(indexPrefix, end)
+	indexKeys := [][]byte{}
+	for ; iterator.Valid() && len(indexKeys) < types.MaxPositionHistoryEntriesPrunedPerBlock; iterator.Next() {
+		indexKeys = append(indexKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, indexKey := range indexKeys {
+		blockHeight, positionId, index, err := types.ParsePositionHistoryByHeightKey(indexKey[len(indexPrefix):])
+		if err != nil {
+			return 0, err
+		}
+		store.Delete(types.KeyPositionHistory(positionId, blockHeight, index))
+		store.Delete(indexKey)
+	}
+
+	return len(indexKeys), nil
+}
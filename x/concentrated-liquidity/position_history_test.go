@@ -0,0 +1,132 @@
+package concentrated_liquidity_test
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// setPositionHistoryRetentionBlocks sets the PositionHistoryRetentionBlocks param.
+func (s *KeeperTestSuite) setPositionHistoryRetentionBlocks(retentionBlocks uint64) {
+	params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	params.PositionHistoryRetentionBlocks = retentionBlocks
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+}
+
+// validates that the creation, partial withdrawal, transfer and full withdrawal of a position are recorded in its history,
+// which is kept after the position is deleted.
+func (s *KeeperTestSuite) TestPositionHistory_Lifecycle() {
+	s.SetupTest()
+	owner, recipient := s.TestAccs[0], s.TestAccs[1]
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	liquidity, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	halfLiquidity := liquidity.QuoInt64(2)
+	_, _, err := s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, halfLiquidity)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	err = s.App.ConcentratedLiquidityKeeper.TransferPositions(s.Ctx, []uint64{positionId}, owner, recipient)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	remainingLiquidity := liquidity.Sub(halfLiquidity)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, recipient, positionId, remainingLiquidity)
+	s.Require().NoError(err)
+
+	entries, err := s.App.ConcentratedLiquidityKeeper.GetPositionHistory(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 4)
+
+	// The history is kept after the position is deleted.
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().Error(err)
+
+	expectedEntries := []struct {
+		eventType      types.PositionEventType
+		address        string
+		liquidityDelta osmomath.Dec
+		liquidity      osmomath.Dec
+	}{
+		{types.PositionEventType_Created, owner.String(), liquidity, liquidity},
+		{types.PositionEventType_Modified, owner.String(), halfLiquidity.Neg(), remainingLiquidity},
+		{types.PositionEventType_Modified, recipient.String(), osmomath.ZeroDec(), remainingLiquidity},
+		{types.PositionEventType_Withdrawn, recipient.String(), remainingLiquidity.Neg(), osmomath.ZeroDec()},
+	}
+	for i, expected := range expectedEntries {
+		s.Require().Equal(positionId, entries[i].PositionId)
+		s.Require().Equal(pool.GetId(), entries[i].PoolId)
+		s.Require().Equal(expected.eventType, entries[i].EventType)
+		s.Require().Equal(expected.address, entries[i].Address)
+		s.Require().Equal(expected.liquidityDelta, entries[i].LiquidityDelta)
+		s.Require().Equal(expected.liquidity, entries[i].Liquidity)
+		s.Require().Equal(s.Ctx.BlockHeight()-int64(len(expectedEntries)-1-i), entries[i].BlockHeight)
+	}
+}
+
+// validates that the entries of a position recorded in the same block are kept in order.
+func (s *KeeperTestSuite) TestPositionHistory_SameBlock() {
+	s.SetupTest()
+	owner := s.TestAccs[0]
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	liquidity, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	withdrawAmount := liquidity.QuoInt64(4)
+	for i := 0; i < 2; i++ {
+		_, _, err := s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, withdrawAmount)
+		s.Require().NoError(err)
+	}
+
+	entries, err := s.App.ConcentratedLiquidityKeeper.GetPositionHistory(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 3)
+	s.Require().Equal(types.PositionEventType_Created, entries[0].EventType)
+	s.Require().Equal(liquidity.Sub(withdrawAmount), entries[1].Liquidity)
+	s.Require().Equal(liquidity.Sub(withdrawAmount.MulInt64(2)), entries[2].Liquidity)
+}
+
+// validates that the entries are pruned once they are out of the retention period, and that
+// no entries are recorded with a zero retention.
+func (s *KeeperTestSuite) TestPrunePositionHistory() {
+	s.SetupTest()
+	s.setPositionHistoryRetentionBlocks(10)
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	recordHeight := s.Ctx.BlockHeight()
+
+	s.Ctx = s.Ctx.WithBlockHeight(recordHeight + 5)
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[1])
+
+	allEntries, err := s.App.ConcentratedLiquidityKeeper.GetAllPositionHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(allEntries, 2)
+
+	// The first entry is still within the retention period.
+	s.Ctx = s.Ctx.WithBlockHeight(recordHeight + 9)
+	pruned, err := s.App.ConcentratedLiquidityKeeper.PrunePositionHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(0, pruned)
+
+	s.Ctx = s.Ctx.WithBlockHeight(recordHeight + 10)
+	pruned, err = s.App.ConcentratedLiquidityKeeper.PrunePositionHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, pruned)
+
+	allEntries, err = s.App.ConcentratedLiquidityKeeper.GetAllPositionHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(allEntries, 1)
+	s.Require().Equal(positionId, allEntries[0].PositionId)
+
+	// With a zero retention, new positions are not recorded and the remaining entries are pruned.
+	s.setPositionHistoryRetentionBlocks(0)
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+	pruned, err = s.App.ConcentratedLiquidityKeeper.PrunePositionHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, pruned)
+
+	allEntries, err = s.App.ConcentratedLiquidityKeeper.GetAllPositionHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(allEntries)
+}
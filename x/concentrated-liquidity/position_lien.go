@@ -0,0 +1,217 @@
+package concentrated_liquidity
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// LockPositionForCollateral freezes the given position as collateral of the lienholder, e.g. the account
+// of a lending module or contract. While the position is locked, it can neither be withdrawn from nor
+// transferred, which includes wrapping it. If redirectRewards is true, the spread rewards and incentives
+// collected from the position are sent to the lienholder instead of the owner. The rewards accrued before
+// the lien are collected to the owner first.
+// The caller is responsible for ensuring that the owner consents to the lien.
+// Returns error if:
+// - the lienholder is not one of the authorized lienholders
+// - the owner does not own the position
+// - the position is already locked as collateral
+// - the position has an active underlying lock
+// - the position holds a limit order, which could not be filled while locked
+func (k Keeper) LockPositionForCollateral(ctx sdk.Context, owner, lienholder sdk.AccAddress, positionId uint64, redirectRewards bool) error {
+	if !k.isAuthorizedLienholder(ctx, lienholder) {
+		return types.UnauthorizedLienholderError{Lienholder: lienholder.String()}
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if position.Address != owner.String() {
+		return types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	if err := k.validatePositionNotLockedForCollateral(ctx, positionId); err != nil {
+		return err
+	}
+	if err := k.validatePositionNotLimitOrder(ctx, positionId); err != nil {
+		return err
+	}
+
+	// Positions with an underlying lock are already frozen by the lockup module and may be slashed
+	// if superfluid staked, so they cannot back a loan.
+	hasActiveUnderlyingLock, lockId, err := k.positionHasActiveUnderlyingLockAndUpdate(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if hasActiveUnderlyingLock {
+		return types.CollateralPositionHasUnderlyingLockError{PositionId: positionId, LockId: lockId}
+	}
+
+	if redirectRewards {
+		if _, err := k.collectSpreadRewards(ctx, owner, positionId); err != nil {
+			return err
+		}
+		if _, _, err := k.collectIncentives(ctx, owner, positionId); err != nil {
+			return err
+		}
+	}
+
+	k.setPositionLien(ctx, types.PositionLien{
+		PositionId:      positionId,
+		Lienholder:      lienholder.String(),
+		RedirectRewards: redirectRewards,
+	})
+	return nil
+}
+
+// UnlockPosition releases the lien of the lienholder on the given position. If the lien redirects rewards,
+// the rewards accrued while the position was locked are collected and sent to the lienholder first.
+// A lienholder can release its liens even after it is no longer authorized, so that positions cannot get stuck.
+// Returns types.ErrPositionLienNotFound if the position is not locked as collateral
+// and types.NotLienholderError if the lien is held by another address.
+func (k Keeper) UnlockPosition(ctx sdk.Context, lienholder sdk.AccAddress, positionId uint64) error {
+	lien, err := k.GetPositionLien(ctx, positionId)
+	if err != nil {
+		return err
+	}
+	if lien.Lienholder != lienholder.String() {
+		return types.NotLienholderError{PositionId: positionId, Address: lienholder.String()}
+	}
+
+	if lien.RedirectRewards {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return err
+		}
+		owner, err := sdk.AccAddressFromBech32(position.Address)
+		if err != nil {
+			return err
+		}
+
+		collectedSpreadRewards, err := k.collectSpreadRewards(ctx, owner, positionId)
+		if err != nil {
+			return err
+		}
+		collectedIncentives, _, err := k.collectIncentives(ctx, owner, positionId)
+		if err != nil {
+			return err
+		}
+		if err := k.redirectRewardsToLienholder(ctx, owner, positionId, collectedSpreadRewards.Add(collectedIncentives...)); err != nil {
+			return err
+		}
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionLien(positionId))
+	return nil
+}
+
+// redirectRewardsToLienholder sends the rewards collected by the owner of the given position to the lienholder
+// if the position is locked as collateral with its rewards redirected. No-op otherwise.
+func (k Keeper) redirectRewardsToLienholder(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, collected sdk.Coins) error {
+	if collected.IsZero() {
+		return nil
+	}
+
+	lien, err := k.GetPositionLien(ctx, positionId)
+	if errors.Is(err, types.ErrPositionLienNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !lien.RedirectRewards {
+		return nil
+	}
+
+	lienholder, err := sdk.AccAddressFromBech32(lien.Lienholder)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, owner, lienholder, collected)
+}
+
+// isRewardsLienholder returns true if the given address holds a lien on the given position
+// that redirects its rewards. False otherwise.
+func (k Keeper) isRewardsLienholder(ctx sdk.Context, address sdk.AccAddress, positionId uint64) (bool, error) {
+	lien, err := k.GetPositionLien(ctx, positionId)
+	if errors.Is(err, types.ErrPositionLienNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return lien.RedirectRewards && lien.Lienholder == address.String(), nil
+}
+
+// setPositionLien writes the position lien to state.
+func (k Keeper) setPositionLien(ctx sdk.Context, lien types.PositionLien) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionLien(lien.PositionId), &lien)
+}
+
+// GetPositionLien returns the lien on the given position.
+// Returns types.ErrPositionLienNotFound if the position is not locked as collateral.
+func (k Keeper) GetPositionLien(ctx sdk.Context, positionId uint64) (types.PositionLien, error) {
+	lien := types.PositionLien{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionLien(positionId), &lien)
+	if err != nil {
+		return types.PositionLien{}, err
+	}
+	if !found {
+		return types.PositionLien{}, types.ErrPositionLienNotFound
+	}
+	return lien, nil
+}
+
+// GetAllPositionLiens returns all position liens in state.
+func (k Keeper) GetAllPositionLiens(ctx sdk.Context) ([]types.PositionLien, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionLienPrefix, osmoutils.ProtoValueParser[types.PositionLien]())
+}
+
+// GetPositionLiensByLienholder returns the liens held by the given lienholder.
+func (k Keeper) GetPositionLiensByLienholder(ctx sdk.Context, lienholder sdk.AccAddress) ([]types.PositionLien, error) {
+	liens, err := k.GetAllPositionLiens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lienholderLiens := []types.PositionLien{}
+	for _, lien := range liens {
+		if lien.Lienholder == lienholder.String() {
+			lienholderLiens = append(lienholderLiens, lien)
+		}
+	}
+	return lienholderLiens, nil
+}
+
+// hasPositionLien returns true if the given position is locked as collateral. False otherwise.
+func (k Keeper) hasPositionLien(ctx sdk.Context, positionId uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.KeyPositionLien(positionId))
+}
+
+// validatePositionNotLockedForCollateral returns types.PositionLockedForCollateralError
+// if the given position is locked as collateral. Nil otherwise.
+func (k Keeper) validatePositionNotLockedForCollateral(ctx sdk.Context, positionId uint64) error {
+	lien, err := k.GetPositionLien(ctx, positionId)
+	if errors.Is(err, types.ErrPositionLienNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return types.PositionLockedForCollateralError{PositionId: positionId, Lienholder: lien.Lienholder}
+}
+
+// isAuthorizedLienholder returns true if the given address is one of the authorized lienholders. False otherwise.
+func (k Keeper) isAuthorizedLienholder(ctx sdk.Context, address sdk.AccAddress) bool {
+	for _, addr := range k.GetParams(ctx).AuthorizedLienholders {
+		// okay to use MustAccAddressFromBech32 because already validated in params
+		if sdk.MustAccAddressFromBech32(addr).Equals(address) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,317 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// authorizeLienholder adds the given address to the authorized lienholders.
+func (s *KeeperTestSuite) authorizeLienholder(lienholder sdk.AccAddress) {
+	params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+	params.AuthorizedLienholders = append(params.AuthorizedLienholders, lienholder.String())
+	s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+}
+
+func (s *KeeperTestSuite) TestLockPositionForCollateral() {
+	tests := map[string]struct {
+		sender          int
+		notAuthorized   bool
+		alreadyLocked   bool
+		lockedPosition  bool
+		redirectRewards bool
+		expectedError   error
+	}{
+		"happy path": {},
+		"happy path: redirect rewards": {
+			redirectRewards: true,
+		},
+		"error: lienholder is not authorized": {
+			notAuthorized: true,
+			expectedError: types.UnauthorizedLienholderError{},
+		},
+		"error: sender is not the owner": {
+			sender:        2,
+			expectedError: types.NotPositionOwnerError{},
+		},
+		"error: position is already locked as collateral": {
+			alreadyLocked: true,
+			expectedError: types.PositionLockedForCollateralError{},
+		},
+		"error: position has an active underlying lock": {
+			lockedPosition: true,
+			expectedError:  types.CollateralPositionHasUnderlyingLockError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, lienholder, sender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[tc.sender]
+			if !tc.notAuthorized {
+				s.authorizeLienholder(lienholder)
+			}
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			if tc.lockedPosition {
+				s.FundAcc(owner, DefaultCoins)
+				positionData, _, err := s.App.ConcentratedLiquidityKeeper.CreateFullRangePositionLocked(s.Ctx, pool.GetId(), owner, DefaultCoins, time.Hour)
+				s.Require().NoError(err)
+				positionId = positionData.ID
+			}
+			if tc.alreadyLocked {
+				err := s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholder, positionId, false)
+				s.Require().NoError(err)
+			}
+
+			// Accrue spread rewards that are collected to the owner before redirecting rewards.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), claimable)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			_, err = msgServer.LockPositionForCollateral(sdk.WrapSDKContext(s.Ctx), &types.MsgLockPositionForCollateral{
+				Sender:          sender.String(),
+				PositionId:      positionId,
+				Lienholder:      lienholder.String(),
+				RedirectRewards: tc.redirectRewards,
+			})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			lien, err := s.App.ConcentratedLiquidityKeeper.GetPositionLien(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(types.PositionLien{PositionId: positionId, Lienholder: lienholder.String(), RedirectRewards: tc.redirectRewards}, lien)
+
+			expectedOwnerBalance := ownerBalanceBefore
+			if tc.redirectRewards {
+				expectedOwnerBalance = expectedOwnerBalance.Add(claimable...)
+			}
+			s.Require().Equal(expectedOwnerBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPositionLockedForCollateral_CannotBeWithdrawnOrTransferred() {
+	s.SetupTest()
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	owner, lienholder := s.TestAccs[0], s.TestAccs[1]
+	s.authorizeLienholder(lienholder)
+
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.SetupDefaultPositionAcc(pool.GetId(), s.TestAccs[2])
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	err = s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholder, positionId, false)
+	s.Require().NoError(err)
+
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().ErrorAs(err, &types.PositionLockedForCollateralError{})
+
+	_, err = msgServer.TransferPositions(sdk.WrapSDKContext(s.Ctx), &types.MsgTransferPositions{
+		PositionIds: []uint64{positionId},
+		Sender:      owner.String(),
+		NewOwner:    s.TestAccs[3].String(),
+	})
+	s.Require().ErrorAs(err, &types.PositionLockedForCollateralError{})
+
+	_, err = msgServer.WrapPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgWrapPosition{Sender: owner.String(), PositionId: positionId})
+	s.Require().ErrorAs(err, &types.PositionLockedForCollateralError{})
+
+	// Locked positions are skipped when withdrawing all positions of the pool.
+	_, err = msgServer.WithdrawAllPoolPositions(sdk.WrapSDKContext(s.Ctx), &types.MsgWithdrawAllPoolPositions{PoolId: pool.GetId(), Sender: owner.String()})
+	s.Require().ErrorAs(err, &types.NoWithdrawablePositionsError{})
+
+	// Once unlocked, the position can be withdrawn.
+	_, err = msgServer.UnlockPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgUnlockPosition{Sender: lienholder.String(), PositionId: positionId})
+	s.Require().NoError(err)
+	_, _, err = s.App.ConcentratedLiquidityKeeper.WithdrawPosition(s.Ctx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestUnlockPosition() {
+	tests := map[string]struct {
+		lock            bool
+		redirectRewards bool
+		sender          int
+		deauthorize     bool
+		expectedError   error
+	}{
+		"happy path": {
+			lock:   true,
+			sender: 1,
+		},
+		"happy path: redirected rewards are sent to the lienholder": {
+			lock:            true,
+			redirectRewards: true,
+			sender:          1,
+		},
+		"happy path: lienholder is no longer authorized": {
+			lock:        true,
+			sender:      1,
+			deauthorize: true,
+		},
+		"error: position is not locked": {
+			sender:        1,
+			expectedError: types.ErrPositionLienNotFound,
+		},
+		"error: sender is the owner rather than the lienholder": {
+			lock:          true,
+			sender:        0,
+			expectedError: types.NotLienholderError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, lienholder, sender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[tc.sender]
+			s.authorizeLienholder(lienholder)
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			if tc.lock {
+				err := s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholder, positionId, tc.redirectRewards)
+				s.Require().NoError(err)
+			}
+			if tc.deauthorize {
+				params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+				params.AuthorizedLienholders = []string{}
+				s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+			}
+
+			// Accrue spread rewards while the position is locked.
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), claimable)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			lienholderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, lienholder)
+
+			_, err = msgServer.UnlockPosition(sdk.WrapSDKContext(s.Ctx), &types.MsgUnlockPosition{Sender: sender.String(), PositionId: positionId})
+			if tc.expectedError != nil {
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPositionLien(s.Ctx, positionId)
+			s.Require().ErrorIs(err, types.ErrPositionLienNotFound)
+
+			// Rewards accrued while locked are only paid out upon unlocking if they are redirected.
+			expectedLienholderBalance := lienholderBalanceBefore
+			if tc.redirectRewards {
+				expectedLienholderBalance = expectedLienholderBalance.Add(claimable...)
+			}
+			s.Require().Equal(ownerBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().Equal(expectedLienholderBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, lienholder))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCollect_PositionLockedForCollateral() {
+	tests := map[string]struct {
+		redirectRewards bool
+		sender          int
+	}{
+		"owner collects without redirection": {
+			sender: 0,
+		},
+		"owner collects with redirection": {
+			redirectRewards: true,
+			sender:          0,
+		},
+		"lienholder collects with redirection": {
+			redirectRewards: true,
+			sender:          1,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+			owner, lienholder, sender := s.TestAccs[0], s.TestAccs[1], s.TestAccs[tc.sender]
+			s.authorizeLienholder(lienholder)
+
+			pool := s.PrepareConcentratedPool()
+			positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+			err := s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholder, positionId, tc.redirectRewards)
+			s.Require().NoError(err)
+
+			s.AddToSpreadRewardAccumulator(pool.GetId(), sdk.NewDecCoin(ETH, osmomath.NewInt(1)))
+			claimable, err := s.App.ConcentratedLiquidityKeeper.GetClaimableSpreadRewards(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.FundAcc(pool.GetSpreadRewardsAddress(), claimable)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			lienholderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, lienholder)
+
+			resp, err := msgServer.CollectSpreadRewards(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectSpreadRewards{
+				Sender:      sender.String(),
+				PositionIds: []uint64{positionId},
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(claimable, resp.CollectedSpreadRewards)
+
+			expectedOwnerBalance, expectedLienholderBalance := ownerBalanceBefore.Add(claimable...), lienholderBalanceBefore
+			if tc.redirectRewards {
+				expectedOwnerBalance, expectedLienholderBalance = ownerBalanceBefore, lienholderBalanceBefore.Add(claimable...)
+			}
+			s.Require().Equal(expectedOwnerBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().Equal(expectedLienholderBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, lienholder))
+		})
+	}
+
+	// A lienholder cannot collect the rewards of a position whose rewards are not redirected.
+	s.SetupTest()
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	owner, lienholder := s.TestAccs[0], s.TestAccs[1]
+	s.authorizeLienholder(lienholder)
+	pool := s.PrepareConcentratedPool()
+	positionId := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	err := s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholder, positionId, false)
+	s.Require().NoError(err)
+	_, err = msgServer.CollectSpreadRewards(sdk.WrapSDKContext(s.Ctx), &types.MsgCollectSpreadRewards{
+		Sender:      lienholder.String(),
+		PositionIds: []uint64{positionId},
+	})
+	s.Require().ErrorAs(err, &types.NotPositionOwnerError{})
+}
+
+func (s *KeeperTestSuite) TestGetPositionLiensByLienholder() {
+	s.SetupTest()
+	owner, lienholderA, lienholderB := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	s.authorizeLienholder(lienholderA)
+	s.authorizeLienholder(lienholderB)
+
+	pool := s.PrepareConcentratedPool()
+	positionIdA := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	positionIdB := s.SetupDefaultPositionAcc(pool.GetId(), owner)
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholderA, positionIdA, true))
+	s.Require().NoError(s.App.ConcentratedLiquidityKeeper.LockPositionForCollateral(s.Ctx, owner, lienholderB, positionIdB, false))
+
+	liens, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiensByLienholder(s.Ctx, lienholderA)
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PositionLien{{PositionId: positionIdA, Lienholder: lienholderA.String(), RedirectRewards: true}}, liens)
+
+	allLiens, err := s.App.ConcentratedLiquidityKeeper.GetAllPositionLiens(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(allLiens, 2)
+
+	liens, err = s.App.ConcentratedLiquidityKeeper.GetPositionLiensByLienholder(s.Ctx, s.TestAccs[3])
+	s.Require().NoError(err)
+	s.Require().Empty(liens)
+}
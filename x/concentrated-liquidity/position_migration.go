@@ -0,0 +1,184 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// Governance can open a position migration window for a deprecated pool, e.g. one with a mispriced spread factor,
+// designating a successor pool with the same denoms. Until the window ends, the owners of positions in the deprecated
+// pool can move them to the successor pool with the same ticks. The position is fully withdrawn and the withdrawn
+// amounts are provided to the successor pool directly, so that no swap is needed and no spread factor is paid.
+// The windows are deleted at the end of the block in which they end.
+
+// SetPositionMigrationWindow opens a position migration window from the given deprecated pool to the given successor
+// pool, ending after the given duration. Overwrites the open window of the deprecated pool, if any. A zero duration
+// closes the open window of the deprecated pool.
+// Returns error if:
+// - either pool does not exist
+// - the pools do not have the same token0 and token1
+// - the duration is negative or longer than types.MaxPositionMigrationWindowDuration
+func (k Keeper) SetPositionMigrationWindow(ctx sdk.Context, fromPoolId, toPoolId uint64, duration time.Duration) error {
+	if duration < 0 || duration > types.MaxPositionMigrationWindowDuration {
+		return fmt.Errorf("duration (%s) must be between 0 and %s", duration, types.MaxPositionMigrationWindowDuration)
+	}
+
+	fromPool, err := k.getPoolById(ctx, fromPoolId)
+	if err != nil {
+		return err
+	}
+
+	if duration == 0 {
+		k.deletePositionMigrationWindow(ctx, fromPoolId)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSetPositionMigrationWindow,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyFromPoolId, strconv.FormatUint(fromPoolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, ctx.BlockTime().String()),
+		))
+		return nil
+	}
+
+	if fromPoolId == toPoolId {
+		return fmt.Errorf("successor pool id must differ from the deprecated pool id: %d", fromPoolId)
+	}
+	toPool, err := k.getPoolById(ctx, toPoolId)
+	if err != nil {
+		return err
+	}
+	if fromPool.GetToken0() != toPool.GetToken0() || fromPool.GetToken1() != toPool.GetToken1() {
+		return types.SuccessorPoolDenomMismatchError{
+			FromPoolId:     fromPoolId,
+			ToPoolId:       toPoolId,
+			FromPoolDenoms: []string{fromPool.GetToken0(), fromPool.GetToken1()},
+			ToPoolDenoms:   []string{toPool.GetToken0(), toPool.GetToken1()},
+		}
+	}
+
+	window := types.PositionMigrationWindow{
+		FromPoolId: fromPoolId,
+		ToPoolId:   toPoolId,
+		EndTime:    ctx.BlockTime().Add(duration),
+	}
+	k.setPositionMigrationWindow(ctx, window)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSetPositionMigrationWindow,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyFromPoolId, strconv.FormatUint(fromPoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyToPoolId, strconv.FormatUint(toPoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyEndTime, window.EndTime.String()),
+	))
+	return nil
+}
+
+// MigratePositionToSuccessorPool fully withdraws the given position from its deprecated pool and creates a position
+// with the same ticks in the successor pool of the open position migration window, with the withdrawn amounts.
+// No swap is performed, so no spread factor is paid. Since the successor pool may be at a different price, only the
+// amounts needed for the liquidity of the new position are used, and the remainder stays with the owner. The spread
+// rewards and incentives of the withdrawn position are collected to the owner.
+// Returns the created position.
+// Returns error if:
+// - the owner does not own the position
+// - the pool of the position has no position migration window, or the window has ended
+// - the position cannot be withdrawn from, e.g. because it has an active underlying lock or is locked as collateral
+// - the ticks of the position are not compatible with the tick spacing of the successor pool
+func (k Keeper) MigratePositionToSuccessorPool(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (CreatePositionData, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if position.Address != owner.String() {
+		return CreatePositionData{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	window, err := k.GetPositionMigrationWindow(ctx, position.PoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	if !ctx.BlockTime().Before(window.EndTime) {
+		return CreatePositionData{}, types.PositionMigrationWindowClosedError{FromPoolId: window.FromPoolId, EndTime: window.EndTime}
+	}
+
+	toPool, err := k.getPoolById(ctx, window.ToPoolId)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	amount0, amount1, err := k.WithdrawPosition(ctx, owner, positionId, position.Liquidity)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+	withdrawn := sdk.NewCoins(sdk.NewCoin(toPool.GetToken0(), amount0), sdk.NewCoin(toPool.GetToken1(), amount1))
+
+	newPosition, err := k.CreatePosition(ctx, window.ToPoolId, owner, withdrawn, osmomath.ZeroInt(), osmomath.ZeroInt(), position.LowerTick, position.UpperTick)
+	if err != nil {
+		return CreatePositionData{}, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtMigratePositionToSuccessorPool,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewPositionId, strconv.FormatUint(newPosition.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyFromPoolId, strconv.FormatUint(window.FromPoolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyToPoolId, strconv.FormatUint(window.ToPoolId, 10)),
+		sdk.NewAttribute(types.AttributeAmount0, newPosition.Amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, newPosition.Amount1.String()),
+		sdk.NewAttribute(types.AttributeLiquidity, newPosition.Liquidity.String()),
+	))
+
+	return newPosition, nil
+}
+
+// DeleteEndedPositionMigrationWindows deletes the position migration windows that ended at or before the current block time.
+func (k Keeper) DeleteEndedPositionMigrationWindows(ctx sdk.Context) error {
+	windows, err := k.GetAllPositionMigrationWindows(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, window := range windows {
+		if !ctx.BlockTime().Before(window.EndTime) {
+			k.deletePositionMigrationWindow(ctx, window.FromPoolId)
+		}
+	}
+	return nil
+}
+
+// setPositionMigrationWindow writes the position migration window to state.
+func (k Keeper) setPositionMigrationWindow(ctx sdk.Context, window types.PositionMigrationWindow) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPositionMigrationWindow(window.FromPoolId), &window)
+}
+
+// deletePositionMigrationWindow deletes the position migration window of the given deprecated pool, if any.
+func (k Keeper) deletePositionMigrationWindow(ctx sdk.Context, fromPoolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyPositionMigrationWindow(fromPoolId))
+}
+
+// GetPositionMigrationWindow returns the position migration window of the given deprecated pool.
+// Returns types.ErrPositionMigrationWindowNotFound if the pool has no window.
+func (k Keeper) GetPositionMigrationWindow(ctx sdk.Context, fromPoolId uint64) (types.PositionMigrationWindow, error) {
+	window := types.PositionMigrationWindow{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionMigrationWindow(fromPoolId), &window)
+	if err != nil {
+		return types.PositionMigrationWindow{}, err
+	}
+	if !found {
+		return types.PositionMigrationWindow{}, types.ErrPositionMigrationWindowNotFound
+	}
+	return window, nil
+}
+
+// GetAllPositionMigrationWindows returns all position migration windows in state.
+func (k Keeper) GetAllPositionMigrationWindows(ctx sdk.Context) ([]types.PositionMigrationWindow, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.PositionMigrationWindowPrefix, osmoutils.ProtoValueParser[types.PositionMigrationWindow]())
+}
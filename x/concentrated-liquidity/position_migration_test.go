@@ -0,0 +1,179 @@
+package concentrated_liquidity_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// setupPositionMigrationPools creates a deprecated pool with a mispriced spread factor and its successor pool,
+// both with a default position, and opens a position migration window of the given duration between them.
+func (s *KeeperTestSuite) setupPositionMigrationPools(duration time.Duration) (types.ConcentratedPoolExtension, types.ConcentratedPoolExtension) {
+	fromPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.0005"))
+	s.SetupDefaultPosition(fromPool.GetId())
+	toPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, osmomath.MustNewDecFromStr("0.0001"))
+	s.SetupDefaultPosition(toPool.GetId())
+
+	err := s.App.ConcentratedLiquidityKeeper.SetPositionMigrationWindow(s.Ctx, fromPool.GetId(), toPool.GetId(), duration)
+	s.Require().NoError(err)
+	return fromPool, toPool
+}
+
+func (s *KeeperTestSuite) TestSetPositionMigrationWindow() {
+	tests := map[string]struct {
+		toPoolDenom0   string
+		toPoolId       uint64
+		sameAsFromPool bool
+		duration       time.Duration
+		expectErr      bool
+	}{
+		"happy path": {
+			duration: time.Hour,
+		},
+		"error: successor pool has different denoms": {
+			toPoolDenom0: "uosmo",
+			duration:     time.Hour,
+			expectErr:    true,
+		},
+		"error: successor pool does not exist": {
+			toPoolId:  100,
+			duration:  time.Hour,
+			expectErr: true,
+		},
+		"error: successor pool is the deprecated pool": {
+			sameAsFromPool: true,
+			duration:       time.Hour,
+			expectErr:      true,
+		},
+		"error: duration is longer than the max duration": {
+			duration:  types.MaxPositionMigrationWindowDuration + time.Second,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			fromPool := s.PrepareConcentratedPool()
+			toPoolDenom0 := ETH
+			if tc.toPoolDenom0 != "" {
+				toPoolDenom0 = tc.toPoolDenom0
+			}
+			toPool := s.PrepareConcentratedPoolWithCoins(toPoolDenom0, USDC)
+			toPoolId := toPool.GetId()
+			if tc.toPoolId != 0 {
+				toPoolId = tc.toPoolId
+			}
+			if tc.sameAsFromPool {
+				toPoolId = fromPool.GetId()
+			}
+
+			err := s.App.ConcentratedLiquidityKeeper.SetPositionMigrationWindow(s.Ctx, fromPool.GetId(), toPoolId, tc.duration)
+			if tc.expectErr {
+				s.Require().Error(err)
+				_, err = s.App.ConcentratedLiquidityKeeper.GetPositionMigrationWindow(s.Ctx, fromPool.GetId())
+				s.Require().ErrorIs(err, types.ErrPositionMigrationWindowNotFound)
+				return
+			}
+			s.Require().NoError(err)
+
+			window, err := s.App.ConcentratedLiquidityKeeper.GetPositionMigrationWindow(s.Ctx, fromPool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(types.PositionMigrationWindow{
+				FromPoolId: fromPool.GetId(),
+				ToPoolId:   toPoolId,
+				EndTime:    s.Ctx.BlockTime().Add(tc.duration),
+			}, window)
+
+			// A zero duration closes the window.
+			err = s.App.ConcentratedLiquidityKeeper.SetPositionMigrationWindow(s.Ctx, fromPool.GetId(), toPoolId, 0)
+			s.Require().NoError(err)
+			_, err = s.App.ConcentratedLiquidityKeeper.GetPositionMigrationWindow(s.Ctx, fromPool.GetId())
+			s.Require().ErrorIs(err, types.ErrPositionMigrationWindowNotFound)
+		})
+	}
+}
+
+// validates that a migrated position is recreated in the successor pool with the same ticks and the withdrawn amounts,
+// the amounts not used by the new position staying with the owner.
+func (s *KeeperTestSuite) TestMigratePositionToSuccessorPool() {
+	s.SetupTest()
+	msgServer := cl.NewMsgServerImpl(s.App.ConcentratedLiquidityKeeper)
+	owner := s.TestAccs[1]
+	fromPool, toPool := s.setupPositionMigrationPools(time.Hour)
+	_, positionId := s.SetupPosition(fromPool.GetId(), owner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+	balanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+	// Only the owner can migrate the position.
+	_, err := msgServer.MigratePositionToSuccessorPool(sdk.WrapSDKContext(s.Ctx), &types.MsgMigratePositionToSuccessorPool{Sender: s.TestAccs[2].String(), PositionId: positionId})
+	s.Require().ErrorAs(err, &types.NotPositionOwnerError{})
+
+	// The withdrawn amounts are the amounts of a full withdrawal of the position.
+	cacheCtx, _ := s.Ctx.CacheContext()
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	withdrawn0, withdrawn1, err := s.App.ConcentratedLiquidityKeeper.WithdrawPosition(cacheCtx, owner, positionId, position.Liquidity)
+	s.Require().NoError(err)
+
+	resp, err := msgServer.MigratePositionToSuccessorPool(sdk.WrapSDKContext(s.Ctx), &types.MsgMigratePositionToSuccessorPool{Sender: owner.String(), PositionId: positionId})
+	s.Require().NoError(err)
+
+	_, err = s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().Error(err)
+
+	newPosition, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, resp.NewPositionId)
+	s.Require().NoError(err)
+	s.Require().Equal(toPool.GetId(), newPosition.PoolId)
+	s.Require().Equal(owner.String(), newPosition.Address)
+	s.Require().Equal(DefaultLowerTick, newPosition.LowerTick)
+	s.Require().Equal(DefaultUpperTick, newPosition.UpperTick)
+	s.Require().Equal(resp.LiquidityCreated, newPosition.Liquidity)
+
+	// No more than the withdrawn amounts are used, and the remainder is returned to the owner.
+	s.Require().True(resp.Amount0.LTE(withdrawn0))
+	s.Require().True(resp.Amount1.LTE(withdrawn1))
+	expectedBalance := balanceBefore.
+		Add(sdk.NewCoin(ETH, withdrawn0.Sub(resp.Amount0)), sdk.NewCoin(USDC, withdrawn1.Sub(resp.Amount1)))
+	s.Require().Equal(expectedBalance.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+}
+
+// validates that positions can only be migrated while the window of their pool is open, and that the ended windows
+// are deleted.
+func (s *KeeperTestSuite) TestMigratePositionToSuccessorPool_Window() {
+	s.SetupTest()
+	owner := s.TestAccs[1]
+	fromPool, toPool := s.setupPositionMigrationPools(time.Hour)
+	_, positionId := s.SetupPosition(fromPool.GetId(), owner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	// Positions in the successor pool have no window.
+	_, toPoolPositionId := s.SetupPosition(toPool.GetId(), owner, DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+	_, err := s.App.ConcentratedLiquidityKeeper.MigratePositionToSuccessorPool(s.Ctx, owner, toPoolPositionId)
+	s.Require().ErrorIs(err, types.ErrPositionMigrationWindowNotFound)
+
+	// The window is not deleted before it ends.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour - time.Second))
+	err = s.App.ConcentratedLiquidityKeeper.DeleteEndedPositionMigrationWindows(s.Ctx)
+	s.Require().NoError(err)
+	windows, err := s.App.ConcentratedLiquidityKeeper.GetAllPositionMigrationWindows(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(windows, 1)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	_, err = s.App.ConcentratedLiquidityKeeper.MigratePositionToSuccessorPool(s.Ctx, owner, positionId)
+	s.Require().ErrorAs(err, &types.PositionMigrationWindowClosedError{})
+
+	err = s.App.ConcentratedLiquidityKeeper.DeleteEndedPositionMigrationWindows(s.Ctx)
+	s.Require().NoError(err)
+	windows, err = s.App.ConcentratedLiquidityKeeper.GetAllPositionMigrationWindows(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(windows)
+
+	// The position is left untouched.
+	position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(fromPool.GetId(), position.PoolId)
+}
@@ -12,11 +12,13 @@ import (
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting/clfuzz"
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/math"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 const (
@@ -71,7 +73,7 @@ func (s *KeeperTestSuite) GetTotalAccruedRewardsByAccumulator(positionId uint64,
 			position, err := accum.GetPosition(uptimeAccum, newPositionName)
 			s.Require().NoError(err)
 
-			unclaimedRewardsForPosition := accum.GetTotalRewards(uptimeAccum, position)
+			unclaimedRewardsForPosition := accum.GetTotalRewards(uptimeAccum, position).QuoDecTruncate(uptimeAccum.GetScalingFactor())
 
 			unclaimedRewardsForEachUptimeNewPosition[i] = unclaimedRewardsForEachUptimeNewPosition[i].Add(unclaimedRewardsForPosition...)
 		}
@@ -314,7 +316,7 @@ func (s *KeeperTestSuite) TestInitOrUpdatePosition() {
 					// Track how much the current uptime accum has grown by
 					actualUptimeAccumDelta[uptimeIndex] = newUptimeAccumValues[uptimeIndex].Sub(initUptimeAccumValues[uptimeIndex])
 					if timeElapsedSec.IsPositive() {
-						expectedGrowthCurAccum, _, err := cl.CalcAccruedIncentivesForAccum(s.Ctx, uptime, test.param.liquidityDelta, timeElapsedSec, expectedIncentiveRecords)
+						expectedGrowthCurAccum, _, err := cl.CalcAccruedIncentivesForAccum(s.Ctx, uptime, test.param.liquidityDelta, types.PerUnitLiqScalingFactor, timeElapsedSec, expectedIncentiveRecords)
 						s.Require().NoError(err)
 						expectedUptimeAccumValueGrowth[uptimeIndex] = expectedGrowthCurAccum
 					}
@@ -1961,6 +1963,67 @@ func (s *KeeperTestSuite) TestMultipleRanges() {
 			},
 			rangeTestParams: withNoSwap(withCurrentTick(DefaultRangeTestParams, 109)),
 		},
+		"three overlapping ranges with swaps to range boundaries": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{0, 20000},
+				{-7300, 12345},
+			},
+			rangeTestParams: withSwapToTickBoundary(DefaultRangeTestParams, clfuzz.ExactTickBoundary, clfuzz.BelowTickBoundary, clfuzz.AboveTickBoundary),
+		},
+		"three overlapping ranges with swaps to all tick boundaries": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{0, 20000},
+				{-7300, 12345},
+			},
+			rangeTestParams: withSwapToTickBoundary(DefaultRangeTestParams),
+		},
+		"two adjacent ranges with new active and inactive incentive records between joins": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{10000, 20000},
+			},
+			rangeTestParams: withNewIncentivesBetweenJoins(DefaultRangeTestParams, true, true),
+		},
+		"two overlapping ranges with new fuzzed incentive records between joins": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{0, 20000},
+			},
+			rangeTestParams: withFuzzedIncentiveRecords(withNewIncentivesBetweenJoins(DefaultRangeTestParams, true, true)),
+		},
+		"two adjacent ranges on three pools": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{10000, 20000},
+			},
+			rangeTestParams: withNumPools(DefaultRangeTestParams, 3),
+		},
+		"three overlapping ranges on three pools with fuzzed spread factors and tick spacings": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{0, 20000},
+				{-7300, 12300},
+			},
+			rangeTestParams: withFuzzedPools(withNumPools(DefaultRangeTestParams, 3)),
+		},
+		"three overlapping ranges with full and partial withdrawals": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{0, 20000},
+				{-7300, 12345},
+			},
+			rangeTestParams: withWithdrawals(DefaultRangeTestParams, clfuzz.FullWithdrawal, clfuzz.PartialWithdrawal),
+		},
+		"three ranges sharing ticks with withdrawals that empty ranges": {
+			tickRanges: [][]int64{
+				{-10000, 10000},
+				{10000, 20000},
+				{-10000, 20000},
+			},
+			rangeTestParams: withWithdrawals(withNewIncentivesBetweenJoins(DefaultRangeTestParams, true, false), clfuzz.EmptyRangeWithdrawal),
+		},
 		/* TODO: uncomment when infinite loop bug is fixed
 		"one range on max tick": {
 			tickRanges: [][]int64{
@@ -2553,3 +2616,52 @@ func (s *KeeperTestSuite) fundSpreadRewardsAddr(ctx sdk.Context, spreadRewardsAd
 	}
 	return
 }
+
+func (s *KeeperTestSuite) TestPositionValueInQuoteDenom() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	_, positionId := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+
+	underlyingAssets, err := s.App.ConcentratedLiquidityKeeper.UnderlyingPositionsValue(s.Ctx, []uint64{positionId})
+	s.Require().NoError(err)
+	expectedAsset0 := sdk.NewCoin(ETH, underlyingAssets.AmountOf(ETH))
+	expectedAsset1 := sdk.NewCoin(USDC, underlyingAssets.AmountOf(USDC))
+
+	// quoted in one of the assets of the pool, the other asset is priced with the pool itself.
+	for _, quoteDenom := range []string{ETH, USDC} {
+		baseAsset := expectedAsset0
+		quoteAsset := expectedAsset1
+		if quoteDenom == ETH {
+			baseAsset, quoteAsset = expectedAsset1, expectedAsset0
+		}
+		spotPrice, err := s.App.ConcentratedLiquidityKeeper.CalculateSpotPrice(s.Ctx, pool.GetId(), quoteDenom, baseAsset.Denom)
+		s.Require().NoError(err)
+		expectedValue := quoteAsset.Amount.Add(osmomath.BigDecFromSDKInt(baseAsset.Amount).Mul(spotPrice).Dec().TruncateInt())
+
+		asset0, asset1, value, err := s.App.ConcentratedLiquidityKeeper.PositionValueInQuoteDenom(s.Ctx, positionId, quoteDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedAsset0, asset0)
+		s.Require().Equal(expectedAsset1, asset1)
+		s.Require().Equal(sdk.NewCoin(quoteDenom, expectedValue), value)
+	}
+
+	// quoted in a denom outside of the pool, without any pool tracked by protorev.
+	_, _, _, err = s.App.ConcentratedLiquidityKeeper.PositionValueInQuoteDenom(s.Ctx, positionId, apptesting.UOSMO)
+	s.Require().Error(err)
+
+	// 1 eth corresponds to 10000 uosmo and 1 usdc corresponds to 2 uosmo.
+	ethOsmoPoolId := s.CreatePoolFromTypeWithCoins(poolmanagertypes.Balancer, sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(100)), sdk.NewCoin(apptesting.UOSMO, osmomath.NewInt(1000000))))
+	usdcOsmoPoolId := s.CreatePoolFromTypeWithCoins(poolmanagertypes.Balancer, sdk.NewCoins(sdk.NewCoin(USDC, osmomath.NewInt(100)), sdk.NewCoin(apptesting.UOSMO, osmomath.NewInt(200))))
+	s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, apptesting.UOSMO, ETH, ethOsmoPoolId)
+	s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, apptesting.UOSMO, USDC, usdcOsmoPoolId)
+
+	asset0, asset1, value, err := s.App.ConcentratedLiquidityKeeper.PositionValueInQuoteDenom(s.Ctx, positionId, apptesting.UOSMO)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedAsset0, asset0)
+	s.Require().Equal(expectedAsset1, asset1)
+	s.Require().Equal(sdk.NewCoin(apptesting.UOSMO, expectedAsset0.Amount.MulRaw(10000).Add(expectedAsset1.Amount.MulRaw(2))), value)
+
+	// non-existent position.
+	_, _, _, err = s.App.ConcentratedLiquidityKeeper.PositionValueInQuoteDenom(s.Ctx, positionId+1, USDC)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId + 1})
+}
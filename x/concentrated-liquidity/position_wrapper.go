@@ -0,0 +1,93 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// wrapPosition locks the given position to the position wrapper and mints a single token of the
+// position's wrapper denom (cl/position/{positionId}) to the sender. Whoever holds the token can
+// unwrap the position, which allows positions to be transferred and used as collateral like any other token.
+// Any outstanding spread rewards and incentives are collected to the sender before the position is wrapped.
+// Returns the wrapper denom of the position.
+// Returns error if:
+// - the sender is not the owner of the position
+// - the position has an active underlying lock
+// - the position is the last position in its pool
+func (k Keeper) wrapPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (string, error) {
+	if err := k.transferPositions(ctx, []uint64{positionId}, sender, types.PositionWrapperAddress); err != nil {
+		return "", err
+	}
+
+	// The wrapper token is minted via the lockup module account, same as the cl shares of full range positions.
+	wrapperDenom := types.GetPositionWrapperDenom(positionId)
+	wrapperToken := sdk.NewCoins(sdk.NewCoin(wrapperDenom, osmomath.OneInt()))
+	if err := k.bankKeeper.MintCoins(ctx, lockuptypes.ModuleName, wrapperToken); err != nil {
+		return "", err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, lockuptypes.ModuleName, sender, wrapperToken); err != nil {
+		return "", err
+	}
+
+	return wrapperDenom, nil
+}
+
+// unwrapPosition burns the sender's wrapper token of the given position and returns ownership of the position
+// to the sender. The spread rewards and incentives accrued while the position was wrapped are sent to the sender.
+// Returns the collected spread rewards and incentives.
+// Returns error if:
+// - the position is not wrapped
+// - the sender does not hold the wrapper token of the position
+func (k Keeper) unwrapPosition(ctx sdk.Context, sender sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	wrapperAddress := types.PositionWrapperAddress
+	if position.Address != wrapperAddress.String() {
+		return sdk.Coins{}, sdk.Coins{}, types.PositionNotWrappedError{PositionId: positionId}
+	}
+
+	wrapperToken := sdk.NewCoin(types.GetPositionWrapperDenom(positionId), osmomath.OneInt())
+	if !k.bankKeeper.HasBalance(ctx, sender, wrapperToken) {
+		return sdk.Coins{}, sdk.Coins{}, types.PositionWrapperTokenNotHeldError{PositionId: positionId, Address: sender.String()}
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, lockuptypes.ModuleName, sdk.NewCoins(wrapperToken)); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, lockuptypes.ModuleName, sdk.NewCoins(wrapperToken)); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	// The rewards accrued while the position was wrapped belong to the holder of the wrapper token.
+	// They are collected to the wrapper address and forwarded to the sender.
+	collectedSpreadRewards, err := k.collectSpreadRewards(ctx, wrapperAddress, positionId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	collectedIncentives, _, err := k.collectIncentives(ctx, wrapperAddress, positionId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	if collected := collectedSpreadRewards.Add(collectedIncentives...); !collected.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, wrapperAddress, sender, collected); err != nil {
+			return sdk.Coins{}, sdk.Coins{}, err
+		}
+	}
+
+	// Unlike transferPositions, we allow unwrapping the last position in a pool.
+	// Otherwise, the position would remain locked to the wrapper if all other positions were withdrawn.
+	if err := k.deletePosition(ctx, positionId, wrapperAddress, position.PoolId); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+	err = k.SetPosition(ctx, position.PoolId, sender, position.LowerTick, position.UpperTick, position.JoinTime, position.Liquidity, position.PositionId, 0)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	return collectedSpreadRewards, collectedIncentives, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -364,3 +365,41 @@ func (k Keeper) GetNumNextInitializedTicks(ctx sdk.Context, poolId, numberOfNext
 
 	return liquidityDepths, nil
 }
+
+// GetPositionsByPool returns a page of the positions of the given pool, ordered by position id.
+// Each position is returned with whether it is in range at the current tick of the pool and
+// its share of the pool's current tick liquidity. The share is zero for out of range positions.
+// Returns error if the pool does not exist or if any of the positions fails to be retrieved.
+func (k Keeper) GetPositionsByPool(ctx sdk.Context, poolId uint64, pagination *query.PageRequest) ([]queryproto.PositionWithLiquidityShare, *query.PageResponse, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentTickLiquidity := pool.GetLiquidity()
+
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParserPaginated(ctx.KVStore(k.storeKey), types.KeyPoolPosition(poolId), pagination, func(key, _ []byte) (queryproto.PositionWithLiquidityShare, error) {
+		// The key is the separator followed by the big endian position id.
+		if len(key) != len(types.KeySeparator)+uint64Bytes {
+			return queryproto.PositionWithLiquidityShare{}, fmt.Errorf("invalid key format: %s", key)
+		}
+		positionId := sdk.BigEndianToUint64(key[len(types.KeySeparator):])
+
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return queryproto.PositionWithLiquidityShare{}, err
+		}
+
+		inRange := pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick)
+		liquidityShare := osmomath.ZeroDec()
+		if inRange && currentTickLiquidity.IsPositive() {
+			liquidityShare = position.Liquidity.Quo(currentTickLiquidity)
+		}
+
+		return queryproto.PositionWithLiquidityShare{
+			Position:       position,
+			InRange:        inRange,
+			LiquidityShare: liquidityShare,
+		}, nil
+	})
+}
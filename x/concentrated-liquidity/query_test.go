@@ -2,6 +2,7 @@ package concentrated_liquidity_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/client/queryproto"
@@ -1017,3 +1018,54 @@ func (s *KeeperTestSuite) TestGetNumNextInitializedTicks() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetPositionsByPool() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	otherPool := s.PrepareConcentratedPool()
+
+	defaultLiquidity, defaultPositionId := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoins, DefaultLowerTick, DefaultUpperTick, false)
+	fullRangeLiquidity, fullRangePositionId := s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoins, DefaultMinTick, DefaultMaxTick, false)
+	// above the current tick, so only token0 is deposited.
+	_, outOfRangePositionId := s.SetupPosition(pool.GetId(), s.TestAccs[2], DefaultCoins, DefaultUpperTick, DefaultUpperTick+10000, false)
+	// positions of other pools are not returned.
+	s.SetupDefaultPosition(otherPool.GetId())
+
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	currentTickLiquidity := pool.GetLiquidity()
+	s.Require().Equal(defaultLiquidity.Add(fullRangeLiquidity).String(), currentTickLiquidity.String())
+
+	positions, pageRes, err := s.App.ConcentratedLiquidityKeeper.GetPositionsByPool(s.Ctx, pool.GetId(), nil)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), pageRes.Total)
+	s.Require().Len(positions, 3)
+
+	// ordered by position id.
+	s.Require().Equal(defaultPositionId, positions[0].Position.PositionId)
+	s.Require().True(positions[0].InRange)
+	s.Require().Equal(defaultLiquidity.Quo(currentTickLiquidity).String(), positions[0].LiquidityShare.String())
+
+	s.Require().Equal(fullRangePositionId, positions[1].Position.PositionId)
+	s.Require().True(positions[1].InRange)
+	s.Require().Equal(fullRangeLiquidity.Quo(currentTickLiquidity).String(), positions[1].LiquidityShare.String())
+
+	s.Require().Equal(outOfRangePositionId, positions[2].Position.PositionId)
+	s.Require().False(positions[2].InRange)
+	s.Require().True(positions[2].LiquidityShare.IsZero())
+
+	// paginated.
+	positions, pageRes, err = s.App.ConcentratedLiquidityKeeper.GetPositionsByPool(s.Ctx, pool.GetId(), &query.PageRequest{Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(positions, 2)
+	s.Require().NotNil(pageRes.NextKey)
+
+	positions, _, err = s.App.ConcentratedLiquidityKeeper.GetPositionsByPool(s.Ctx, pool.GetId(), &query.PageRequest{Key: pageRes.NextKey})
+	s.Require().NoError(err)
+	s.Require().Len(positions, 1)
+	s.Require().Equal(outOfRangePositionId, positions[0].Position.PositionId)
+
+	// non-existent pool.
+	_, _, err = s.App.ConcentratedLiquidityKeeper.GetPositionsByPool(s.Ctx, otherPool.GetId()+1, nil)
+	s.Require().Error(err)
+}
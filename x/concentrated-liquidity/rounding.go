@@ -0,0 +1,130 @@
+package concentrated_liquidity
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// addRoundingRemainders adds the given remainders to the cumulative rounding remainders of the pool.
+// A remainder is the amount the pool received minus the exact amount it was due, for a single denom.
+// It is positive when rounding was in the pool's favor, e.g. when rounding up the amount in of a swap,
+// and negative when it was in the user's favor, e.g. when rounding down the amounts deposited into a position.
+// Zero remainders are skipped to avoid unnecessary store writes.
+func (k Keeper) addRoundingRemainders(ctx sdk.Context, poolId uint64, remainders ...sdk.DecCoin) {
+	store := ctx.KVStore(k.storeKey)
+	for _, remainder := range remainders {
+		if remainder.Amount.IsZero() {
+			continue
+		}
+
+		key := types.KeyRoundingRemainder(poolId, remainder.Denom)
+		total := remainder.Amount
+		if store.Has(key) {
+			total = total.Add(osmoutils.MustGetDec(store, key))
+		}
+
+		if total.IsZero() {
+			store.Delete(key)
+			continue
+		}
+		osmoutils.MustSetDec(store, key, total)
+	}
+}
+
+// roundingRemainder returns the remainder of rounding the exact amount received by a pool to the given amount.
+func roundingRemainder(denom string, exactAmount osmomath.Dec, amount osmomath.Int) sdk.DecCoin {
+	return sdk.DecCoin{Denom: denom, Amount: amount.ToLegacyDec().Sub(exactAmount)}
+}
+
+// swapTokenInRoundingRemainder returns the remainder of rounding the token in of a swap kept by the pool address.
+// The spread rewards are rounded up and sent to the spread rewards address, see updatePoolForSwap, so the pool
+// address only receives the amount in minus the rounded up spread rewards, while it is due the exact amount in
+// minus the exact spread rewards.
+func swapTokenInRoundingRemainder(denom string, exactAmountIn osmomath.Dec, amountIn osmomath.Int, spreadRewards osmomath.Dec) sdk.DecCoin {
+	return roundingRemainder(denom, exactAmountIn.Sub(spreadRewards), amountIn.Sub(spreadRewards.Ceil().TruncateInt()))
+}
+
+// GetRoundingRemainders returns the cumulative rounding remainders of the given pool, sorted by denom.
+// Unlike regular coins, the amounts may be negative if rounding was in the users' favor overall.
+// Returns error if the pool does not exist.
+func (k Keeper) GetRoundingRemainders(ctx sdk.Context, poolId uint64) (sdk.DecCoins, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	prefix := types.KeyPoolRoundingRemainders(poolId)
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), prefix, func(key []byte, value []byte) (sdk.DecCoin, error) {
+		amount := sdk.DecProto{}
+		if err := proto.Unmarshal(value, &amount); err != nil {
+			return sdk.DecCoin{}, err
+		}
+		return sdk.DecCoin{Denom: string(key[len(prefix):]), Amount: amount.Dec}, nil
+	})
+}
+
+// SweepRoundingRemainders sends the whole units of the positive rounding remainders of the given pools
+// from the pool addresses to the community pool, and deducts them from the remainders.
+// The fractional parts of the remainders, as well as the negative remainders, are left untouched.
+// The amount swept is capped at the pool balance in excess of the amounts that would be returned if every
+// position of the pool was fully withdrawn, so that sweeping never takes from the liquidity of the positions.
+// Returns error if any of the pools does not exist or if the transfer to the community pool fails.
+func (k Keeper) SweepRoundingRemainders(ctx sdk.Context, poolIds []uint64) error {
+	for _, poolId := range poolIds {
+		pool, err := k.getPoolById(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		remainders, err := k.GetRoundingRemainders(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		positions, err := k.getPoolPositions(ctx, poolId)
+		if err != nil {
+			return err
+		}
+
+		withdrawableCoins, err := fullWithdrawalAmounts(ctx, pool, positions)
+		if err != nil {
+			return err
+		}
+
+		poolBalance := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+		swept := sdk.NewCoins()
+		for _, remainder := range remainders {
+			surplus := poolBalance.AmountOf(remainder.Denom).Sub(withdrawableCoins.AmountOf(remainder.Denom))
+			amount := osmomath.MinInt(remainder.Amount.TruncateInt(), surplus)
+			if !amount.IsPositive() {
+				continue
+			}
+			swept = swept.Add(sdk.NewCoin(remainder.Denom, amount))
+		}
+
+		if swept.IsZero() {
+			continue
+		}
+
+		if err := k.communityPoolKeeper.FundCommunityPool(ctx, swept, pool.GetAddress()); err != nil {
+			return err
+		}
+
+		for _, coin := range swept {
+			k.addRoundingRemainders(ctx, poolId, sdk.DecCoin{Denom: coin.Denom, Amount: coin.Amount.ToLegacyDec().Neg()})
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtSweepRoundingRemainders,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, swept.String()),
+		))
+	}
+	return nil
+}
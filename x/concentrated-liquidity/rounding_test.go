@@ -0,0 +1,228 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestRoundingRemainder() {
+	// pool receives less than it is due, e.g. when truncating a deposit.
+	remainder := cl.RoundingRemainder(ETH, osmomath.MustNewDecFromStr("10.7"), osmomath.NewInt(10))
+	s.Require().Equal(ETH, remainder.Denom)
+	s.Require().Equal(osmomath.MustNewDecFromStr("-0.7").String(), remainder.Amount.String())
+
+	// pool receives more than it is due, e.g. when rounding up the amount in of a swap.
+	remainder = cl.RoundingRemainder(USDC, osmomath.MustNewDecFromStr("10.3"), osmomath.NewInt(11))
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.7").String(), remainder.Amount.String())
+
+	// the spread rewards of a swap are rounded up and sent to the spread rewards address,
+	// so the pool address receives 11 - 1 = 10 while it is due 10.3 - 0.4 = 9.9.
+	remainder = cl.SwapTokenInRoundingRemainder(USDC, osmomath.MustNewDecFromStr("10.3"), osmomath.NewInt(11), osmomath.MustNewDecFromStr("0.4"))
+	s.Require().Equal(osmomath.MustNewDecFromStr("0.1").String(), remainder.Amount.String())
+
+	// the pool address may receive less than it is due if rounding up the spread rewards outweighs rounding up the amount in.
+	remainder = cl.SwapTokenInRoundingRemainder(USDC, osmomath.MustNewDecFromStr("10.9"), osmomath.NewInt(11), osmomath.MustNewDecFromStr("0.2"))
+	s.Require().Equal(osmomath.MustNewDecFromStr("-0.7").String(), remainder.Amount.String())
+}
+
+func (s *KeeperTestSuite) TestAddRoundingRemainders() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	clKeeper.AddRoundingRemainders(s.Ctx, pool.GetId(),
+		sdk.DecCoin{Denom: USDC, Amount: osmomath.MustNewDecFromStr("0.4")},
+		sdk.DecCoin{Denom: ETH, Amount: osmomath.MustNewDecFromStr("-0.25")},
+	)
+	clKeeper.AddRoundingRemainders(s.Ctx, pool.GetId(),
+		sdk.DecCoin{Denom: USDC, Amount: osmomath.MustNewDecFromStr("0.7")},
+		sdk.DecCoin{Denom: ETH, Amount: osmomath.ZeroDec()},
+	)
+
+	remainders, err := clKeeper.GetRoundingRemainders(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(remainders, 2)
+	// sorted by denom.
+	s.Require().Equal(ETH, remainders[0].Denom)
+	s.Require().Equal(osmomath.MustNewDecFromStr("-0.25").String(), remainders[0].Amount.String())
+	s.Require().Equal(USDC, remainders[1].Denom)
+	s.Require().Equal(osmomath.MustNewDecFromStr("1.1").String(), remainders[1].Amount.String())
+
+	// remainders that cancel out are removed.
+	clKeeper.AddRoundingRemainders(s.Ctx, pool.GetId(), sdk.DecCoin{Denom: ETH, Amount: osmomath.MustNewDecFromStr("0.25")})
+	remainders, err = clKeeper.GetRoundingRemainders(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(remainders, 1)
+	s.Require().Equal(USDC, remainders[0].Denom)
+
+	// other pools are unaffected.
+	otherPool := s.PrepareConcentratedPool()
+	remainders, err = clKeeper.GetRoundingRemainders(s.Ctx, otherPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(remainders)
+
+	_, err = clKeeper.GetRoundingRemainders(s.Ctx, otherPool.GetId()+1)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestSwap_AccruesRoundingRemainders() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(1000))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	_, _, _, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(
+		s.Ctx, s.TestAccs[0], pool,
+		tokenIn, USDC,
+		osmomath.ZeroDec(), osmomath.ZeroBigDec(),
+	)
+	s.Require().NoError(err)
+
+	remainders, err := s.App.ConcentratedLiquidityKeeper.GetRoundingRemainders(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().NotEmpty(remainders)
+	// rounding during swaps is always in the pool's favor.
+	for _, remainder := range remainders {
+		s.Require().True(remainder.Amount.IsPositive(), remainder.String())
+	}
+}
+
+// TestSweepRoundingRemainders_PoolBalanceInvariant checks that sweeping the remainders accrued by swaps
+// with a spread factor never takes funds owed to the pool's positions.
+func (s *KeeperTestSuite) TestSweepRoundingRemainders_PoolBalanceInvariant() {
+	s.SetupTest()
+	pool := s.PreparePoolWithCustSpread(osmomath.MustNewDecFromStr("0.003"))
+	s.SetupDefaultPosition(pool.GetId())
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	for i := 0; i < 20; i++ {
+		tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(1001))
+		tokenOutDenom := USDC
+		if i%2 == 1 {
+			tokenIn = sdk.NewCoin(USDC, osmomath.NewInt(5000003))
+			tokenOutDenom = ETH
+		}
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+		_, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[1], pool, tokenIn, tokenOutDenom, pool.GetSpreadFactor(s.Ctx), osmomath.ZeroBigDec())
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(clKeeper.SweepRoundingRemainders(s.Ctx, []uint64{pool.GetId()}))
+
+	_, broken := cl.PoolBalanceInvariant(*clKeeper)(s.Ctx)
+	s.Require().False(broken)
+}
+
+func (s *KeeperTestSuite) TestSweepRoundingRemainders() {
+	tests := map[string]struct {
+		remainders              []sdk.DecCoin
+		poolSurplus             sdk.Coins
+		expectedSwept           sdk.Coins
+		expectedRemainingAmount sdk.DecCoins
+		invalidPoolId           bool
+		expectedErr             error
+	}{
+		"whole units of positive remainders are swept": {
+			remainders: []sdk.DecCoin{
+				{Denom: ETH, Amount: osmomath.MustNewDecFromStr("2.5")},
+				{Denom: USDC, Amount: osmomath.MustNewDecFromStr("-1.3")},
+			},
+			poolSurplus:   sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(2))),
+			expectedSwept: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(2))),
+			expectedRemainingAmount: sdk.DecCoins{
+				{Denom: ETH, Amount: osmomath.MustNewDecFromStr("0.5")},
+				{Denom: USDC, Amount: osmomath.MustNewDecFromStr("-1.3")},
+			},
+		},
+		"whole remainders are removed once swept": {
+			remainders: []sdk.DecCoin{
+				{Denom: ETH, Amount: osmomath.NewDec(3)},
+				{Denom: USDC, Amount: osmomath.NewDec(7)},
+			},
+			poolSurplus:             sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(3)), sdk.NewCoin(USDC, osmomath.NewInt(10))),
+			expectedSwept:           sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(3)), sdk.NewCoin(USDC, osmomath.NewInt(7))),
+			expectedRemainingAmount: sdk.DecCoins{},
+		},
+		"sweep is capped at the pool balance in excess of the positions": {
+			remainders: []sdk.DecCoin{
+				{Denom: ETH, Amount: osmomath.MustNewDecFromStr("5.5")},
+				{Denom: USDC, Amount: osmomath.NewDec(4)},
+			},
+			poolSurplus:   sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(2))),
+			expectedSwept: sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(2))),
+			expectedRemainingAmount: sdk.DecCoins{
+				{Denom: ETH, Amount: osmomath.MustNewDecFromStr("3.5")},
+				{Denom: USDC, Amount: osmomath.NewDec(4)},
+			},
+		},
+		"nothing to sweep": {
+			remainders: []sdk.DecCoin{
+				{Denom: ETH, Amount: osmomath.MustNewDecFromStr("0.9")},
+			},
+			expectedSwept: sdk.NewCoins(),
+			expectedRemainingAmount: sdk.DecCoins{
+				{Denom: ETH, Amount: osmomath.MustNewDecFromStr("0.9")},
+			},
+		},
+		"error: pool does not exist": {
+			invalidPoolId: true,
+			expectedErr:   types.PoolNotFoundError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			clKeeper.AddRoundingRemainders(s.Ctx, pool.GetId(), tc.remainders...)
+
+			// Leave the pool with exactly the given surplus over the amounts owed to its positions.
+			withdrawableCoins, err := clKeeper.FullWithdrawalAmounts(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			existingSurplus := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()).Sub(withdrawableCoins...)
+			s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, pool.GetAddress(), s.TestAccs[2], existingSurplus))
+			s.FundAcc(pool.GetAddress(), tc.poolSurplus)
+
+			poolId := pool.GetId()
+			if tc.invalidPoolId {
+				poolId = poolId + 1
+			}
+
+			poolBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+			communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+
+			err = clKeeper.SweepRoundingRemainders(s.Ctx, []uint64{poolId})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			poolBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+			s.Require().Equal(poolBalanceBefore.Sub(tc.expectedSwept...).String(), poolBalanceAfter.String())
+
+			communityPoolAfter := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+			s.Require().Equal(communityPoolBefore.Add(sdk.NewDecCoinsFromCoins(tc.expectedSwept...)...).String(), communityPoolAfter.String())
+
+			remainders, err := clKeeper.GetRoundingRemainders(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRemainingAmount.String(), remainders.String())
+
+			if tc.expectedSwept.IsZero() {
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtSweepRoundingRemainders, 0)
+			} else {
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtSweepRoundingRemainders, 1)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@ import (
 	clmodeltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	minttypes "github.com/osmosis-labs/osmosis/v21/x/mint/types"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 // preparePoolConfig defines the parameters for creating a new pool
@@ -151,6 +153,71 @@ func RandMsgWithdrawPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sd
 	}, nil
 }
 
+func RandMsgAddToPosition(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgAddToPosition, error) {
+	rand := sim.GetRand()
+	// get random pool
+	clPool, _, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Utilize the PoolId to PositionId mapping
+	positionIds, err := k.GetAllPositionIdsForPoolId(ctx, cltypes.PositionPrefix, clPool.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// adding to the last position in a pool is not allowed
+	if len(positionIds) < 2 {
+		return nil, fmt.Errorf("pool does not have enough positions to add to")
+	}
+
+	randPositionId := positionIds[rand.Intn(len(positionIds))]
+	position, err := k.GetPosition(ctx, randPositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := sim.FindAccount(owner); !found {
+		return nil, fmt.Errorf("position owner is not a simulation account")
+	}
+
+	balance0 := sim.BankKeeper().GetBalance(ctx, owner, clPool.GetToken0()).Amount
+	balance1 := sim.BankKeeper().GetBalance(ctx, owner, clPool.GetToken1()).Amount
+	if !balance0.IsPositive() || !balance1.IsPositive() {
+		return nil, fmt.Errorf("owner does not have pool tokens to add")
+	}
+
+	amount0, amount1 := sim.RandomAmount(balance0), sim.RandomAmount(balance1)
+	if amount0.IsZero() && amount1.IsZero() {
+		return nil, fmt.Errorf("owner does not have pool tokens to add")
+	}
+
+	msg := &cltypes.MsgAddToPosition{
+		PositionId:      position.PositionId,
+		Sender:          position.Address,
+		Amount0:         amount0,
+		Amount1:         amount1,
+		TokenMinAmount0: osmomath.ZeroInt(),
+		TokenMinAmount1: osmomath.ZeroInt(),
+	}
+
+	// adding to a position may fail for reasons that are hard to predict upfront
+	// (e.g. the position being superfluid staked or the added amounts being too small),
+	// so we dry run the message on a cacheCtx and discard its writes.
+	cacheCtx, _ := ctx.CacheContext()
+	if _, err := clkeeper.NewMsgServerImpl(&k).AddToPosition(sdk.WrapSDKContext(cacheCtx), msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
 func RandMsgCollectSpreadRewards(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*cltypes.MsgCollectSpreadRewards, error) {
 	// get random pool
 	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
@@ -254,6 +321,103 @@ func RandMsgCollectIncentives(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx s
 	}, nil
 }
 
+// RandMsgSwapExactAmountIn swaps a random amount of one of the tokens of a random pool for the other.
+func RandMsgSwapExactAmountIn(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*poolmanagertypes.MsgSwapExactAmountIn, error) {
+	// get random pool, randomly select one of the pool denoms to be the coinIn, other is coinOut
+	clPool, denomIn, denomOut, err := getRandCLPoolWithSwapDenoms(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// find an address that has a balance of the coinIn
+	sender, accCoinIn, senderExists := sim.SelAddrWithDenom(ctx, denomIn)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denom %s exists", denomIn)
+	}
+
+	// select a random amount that is upper-bound by the address's balance of coinIn
+	if accCoinIn.Amount.LT(osmomath.NewInt(2)) {
+		return nil, fmt.Errorf("sender has insufficient %s to swap", denomIn)
+	}
+	tokenIn := sdk.NewCoin(denomIn, sim.RandPositiveInt(accCoinIn.Amount))
+
+	// N.B. Calling MsgSwapExactAmountIn executes the swap via the pool manager, which charges the taker fee.
+	// We therefore need to remove the taker fee from the amountIn before calling the calc method.
+	takerFee, err := k.GetTradingPairTakerFee(ctx, denomIn, denomOut)
+	if err != nil {
+		return nil, err
+	}
+	tokenInAfterSubTakerFee, _ := poolmanager.CalcTakerFeeExactIn(tokenIn, takerFee)
+
+	tokenOut, err := k.CalcOutAmtGivenIn(ctx, clPool, tokenInAfterSubTakerFee, denomOut, clPool.GetSpreadFactor(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if !tokenOut.Amount.IsPositive() {
+		return nil, fmt.Errorf("swap amount in %s yields no tokens out", tokenIn)
+	}
+
+	return &poolmanagertypes.MsgSwapExactAmountIn{
+		Sender: sender.Address.String(),
+		Routes: []poolmanagertypes.SwapAmountInRoute{{
+			PoolId:        clPool.GetId(),
+			TokenOutDenom: denomOut,
+		}},
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: tokenOut.Amount,
+	}, nil
+}
+
+// RandMsgSwapExactAmountOut swaps one of the tokens of a random pool for a random amount of the other.
+func RandMsgSwapExactAmountOut(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (*poolmanagertypes.MsgSwapExactAmountOut, error) {
+	// get random pool, randomly select one of the pool denoms to be the coinIn, other is coinOut
+	clPool, denomIn, denomOut, err := getRandCLPoolWithSwapDenoms(k, sim, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// find an address that has a balance of the coinIn
+	sender, accCoinIn, senderExists := sim.SelAddrWithDenom(ctx, denomIn)
+	if !senderExists {
+		return nil, fmt.Errorf("no sender with denom %s exists", denomIn)
+	}
+
+	// select a random amount of coinOut that is upper-bound by the pool's balance of coinOut
+	poolBalanceOut := sim.BankKeeper().GetBalance(ctx, clPool.GetAddress(), denomOut)
+	if poolBalanceOut.Amount.LT(osmomath.NewInt(2)) {
+		return nil, fmt.Errorf("pool %d has insufficient %s to swap out", clPool.GetId(), denomOut)
+	}
+	tokenOut := sdk.NewCoin(denomOut, sim.RandPositiveInt(poolBalanceOut.Amount))
+
+	tokenIn, err := k.CalcInAmtGivenOut(ctx, clPool, tokenOut, denomIn, clPool.GetSpreadFactor(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// N.B. Calling MsgSwapExactAmountOut executes the swap via the pool manager, which charges the taker fee.
+	// We therefore need to add the taker fee to the amountIn after calling the calc method.
+	takerFee, err := k.GetTradingPairTakerFee(ctx, denomIn, denomOut)
+	if err != nil {
+		return nil, err
+	}
+	tokenInMax, _ := poolmanager.CalcTakerFeeExactOut(tokenIn, takerFee)
+
+	if tokenInMax.Amount.GT(accCoinIn.Amount) {
+		return nil, fmt.Errorf("sender has insufficient %s to swap out %s", denomIn, tokenOut)
+	}
+
+	return &poolmanagertypes.MsgSwapExactAmountOut{
+		Sender: sender.Address.String(),
+		Routes: []poolmanagertypes.SwapAmountOutRoute{{
+			PoolId:       clPool.GetId(),
+			TokenInDenom: denomIn,
+		}},
+		TokenInMaxAmount: tokenInMax.Amount,
+		TokenOut:         tokenOut,
+	}, nil
+}
+
 // createPoolRestriction creates specific restriction for the creation of a pool.
 func createPoolRestriction(sim *osmosimtypes.SimCtx, ctx sdk.Context) osmosimtypes.SimAccountConstraint {
 	return func(acc legacysimulationtype.Account) bool {
@@ -290,6 +454,22 @@ func getRandCLPool(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context)
 	return randClPool, poolDenoms, err
 }
 
+// getRandCLPoolWithSwapDenoms gets a concentrated liquidity pool with active liquidity,
+// randomly selecting one of its denoms to be swapped in and the other to be swapped out.
+func getRandCLPoolWithSwapDenoms(k clkeeper.Keeper, sim *osmosimtypes.SimCtx, ctx sdk.Context) (cltypes.ConcentratedPoolExtension, string, string, error) {
+	clPool, poolDenoms, err := getRandCLPool(k, sim, ctx)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	if !clPool.GetLiquidity().IsPositive() {
+		return nil, "", "", fmt.Errorf("pool %d has no active liquidity", clPool.GetId())
+	}
+
+	inIndex := sim.GetRand().Intn(len(poolDenoms))
+	return clPool, poolDenoms[inIndex], poolDenoms[1-inIndex], nil
+}
+
 // getRandomTickPositions returns random lowerTick and upperTick divisible by tickSpacing value.
 func getRandomTickPositions(sim *osmosimtypes.SimCtx, minTick, maxTick int64, tickSpacing uint64) (int64, int64, error) {
 	lowerTick, err := RandomTickDivisibility(sim, minTick, maxTick, tickSpacing)
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
@@ -208,9 +209,10 @@ func (k Keeper) collectSpreadRewards(ctx sdk.Context, sender sdk.AccAddress, pos
 // - position given by pool id, owner, lower tick and upper tick does not exist
 // - other internal database or math errors.
 func (k Keeper) GetClaimableSpreadRewards(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
-	// Since this is a query, we don't want to modify the state and therefore use a cache context.
-	cacheCtx, _ := ctx.CacheContext()
-	return k.prepareClaimableSpreadRewards(cacheCtx, positionId)
+	// Since this is a query, we don't want to modify the state and therefore dry run it.
+	return osmoutils.DryRunFunc(ctx, func(cacheCtx sdk.Context) (sdk.Coins, error) {
+		return k.prepareClaimableSpreadRewards(cacheCtx, positionId)
+	})
 }
 
 // prepareClaimableSpreadRewards returns the amount of spread rewards that a position is eligible to claim.
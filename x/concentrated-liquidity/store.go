@@ -21,8 +21,8 @@ const (
 	uint64Bytes                 = 8
 )
 
-// getAllPositions gets all CL positions for export genesis.
-func (k Keeper) getAllPositions(ctx sdk.Context) ([]model.Position, error) {
+// GetAllPositions gets all CL positions across all pools.
+func (k Keeper) GetAllPositions(ctx sdk.Context) ([]model.Position, error) {
 	return osmoutils.GatherValuesFromStorePrefix(
 		ctx.KVStore(k.storeKey), types.PositionIdPrefix, ParsePositionFromBz)
 }
@@ -0,0 +1,73 @@
+package concentrated_liquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+// addSwapStats adds the amount swapped into the pool, spread rewards included, to its cumulative swap volume
+// and the spread rewards charged by the swap to its cumulative spread rewards collected.
+func (k Keeper) addSwapStats(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, spreadRewards sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	addToCumulativeAmount(store, types.KeySwapVolume(poolId, tokenIn.Denom), tokenIn.Amount)
+	addToCumulativeAmount(store, types.KeySpreadRewardsCollected(poolId, spreadRewards.Denom), spreadRewards.Amount)
+}
+
+// addToCumulativeAmount adds the given amount to the amount stored under the given key.
+// Zero amounts are skipped to avoid unnecessary store writes.
+func addToCumulativeAmount(store sdk.KVStore, key []byte, amount osmomath.Int) {
+	if amount.IsZero() {
+		return
+	}
+
+	total := amount
+	if bz := store.Get(key); bz != nil {
+		var stored osmomath.Int
+		if err := stored.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		total = total.Add(stored)
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// GetPoolSwapVolume returns the cumulative amount of each token swapped into the given pool,
+// spread rewards included, sorted by denom.
+// Returns error if the pool does not exist.
+func (k Keeper) GetPoolSwapVolume(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	return k.getCumulativeAmounts(ctx, poolId, types.KeyPoolSwapVolume(poolId))
+}
+
+// GetPoolSpreadRewardsCollected returns the cumulative spread rewards charged by swaps in the given pool,
+// sorted by denom.
+// Returns error if the pool does not exist.
+func (k Keeper) GetPoolSpreadRewardsCollected(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	return k.getCumulativeAmounts(ctx, poolId, types.KeyPoolSpreadRewardsCollected(poolId))
+}
+
+// getCumulativeAmounts returns the amounts stored per denom under the given pool prefix.
+func (k Keeper) getCumulativeAmounts(ctx sdk.Context, poolId uint64, prefix []byte) (sdk.Coins, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	coins, err := osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), prefix, func(key []byte, value []byte) (sdk.Coin, error) {
+		var amount osmomath.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(string(key[len(prefix):]), amount), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sdk.NewCoins(coins...), nil
+}
@@ -0,0 +1,57 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+func (s *KeeperTestSuite) TestSwap_TracksSwapStats() {
+	s.SetupTest()
+	pool := s.PreparePoolWithCustSpread(osmomath.MustNewDecFromStr("0.01"))
+	s.SetupDefaultPosition(pool.GetId())
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	swapVolume, err := clKeeper.GetPoolSwapVolume(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(swapVolume)
+
+	// Swap in both directions, twice in ETH.
+	swapsIn := []sdk.Coin{
+		sdk.NewCoin(ETH, osmomath.NewInt(1_000_000)),
+		sdk.NewCoin(USDC, osmomath.NewInt(5_000_000)),
+		sdk.NewCoin(ETH, osmomath.NewInt(2_000_000)),
+	}
+	for _, tokenIn := range swapsIn {
+		pool, err := clKeeper.GetConcentratedPoolById(s.Ctx, pool.GetId())
+		s.Require().NoError(err)
+
+		tokenOutDenom := USDC
+		if tokenIn.Denom == USDC {
+			tokenOutDenom = ETH
+		}
+
+		s.FundAcc(s.TestAccs[1], sdk.NewCoins(tokenIn))
+		_, err = clKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[1], pool, tokenIn, tokenOutDenom, osmomath.OneInt(), pool.GetSpreadFactor(s.Ctx))
+		s.Require().NoError(err)
+	}
+
+	swapVolume, err = clKeeper.GetPoolSwapVolume(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(ETH, osmomath.NewInt(3_000_000)), sdk.NewCoin(USDC, osmomath.NewInt(5_000_000))), swapVolume)
+
+	// No spread rewards have been claimed, so all spread rewards collected are held by the spread rewards account.
+	spreadRewardsCollected, err := clKeeper.GetPoolSpreadRewardsCollected(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Len(spreadRewardsCollected, 2)
+	s.Require().Equal(s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetSpreadRewardsAddress()), spreadRewardsCollected)
+
+	// Other pools are unaffected.
+	otherPool := s.PrepareConcentratedPool()
+	swapVolume, err = clKeeper.GetPoolSwapVolume(s.Ctx, otherPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(swapVolume)
+
+	_, err = clKeeper.GetPoolSpreadRewardsCollected(s.Ctx, otherPool.GetId()+1)
+	s.Require().Error(err)
+}
@@ -2,6 +2,7 @@ package concentrated_liquidity
 
 import (
 	fmt "fmt"
+	"strconv"
 
 	db "github.com/cometbft/cometbft-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -57,6 +58,16 @@ type SwapState struct {
 	// global spread reward growth
 	globalSpreadRewardGrowth osmomath.Dec
 
+	// Number of initialized ticks crossed.
+	// Initialized to zero.
+	// Updated each time a tick is crossed.
+	ticksCrossed uint64
+
+	// Indexes of the initialized ticks crossed, in the order they were crossed.
+	// Initialized to empty.
+	// Updated each time a tick is crossed.
+	crossedTicks []int64
+
 	swapStrategy swapstrategy.SwapStrategy
 }
 
@@ -66,6 +77,12 @@ type SwapResult struct {
 	AmountIn      osmomath.Int
 	AmountOut     osmomath.Int
 	SpreadRewards osmomath.Dec
+	TicksCrossed  uint64
+	// CrossedTicks are the indexes of the initialized ticks crossed by the swap, in the order they were crossed.
+	CrossedTicks []int64
+	// RoundingRemainders are the amounts kept by the pool due to rounding the amounts in and out.
+	// The token in remainder may be negative since the spread rewards are rounded up as well.
+	RoundingRemainders sdk.DecCoins
 }
 
 // swapNoProgressLimit is the maximum number of iterations that can be performed
@@ -262,6 +279,13 @@ func (k Keeper) swapOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
+	k.addRoundingRemainders(ctx, pool.GetId(), swapResult.RoundingRemainders...)
+	events.EmitConcentratedSwapExecutionEvent(ctx, pool.GetId(), swapResult.CrossedTicks, poolUpdates.NewCurrentTick)
+	emitSwapGasHintIfSimulation(ctx, pool.GetId(), swapResult.TicksCrossed)
+
+	// The limit orders crossed by the swap are filled at the end of the block.
+	k.markCrossedLimitOrdersPending(ctx, pool.GetId(), poolUpdates.NewCurrentTick)
+
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
@@ -294,6 +318,13 @@ func (k *Keeper) swapInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, PoolUpdates{}, err
 	}
 
+	k.addRoundingRemainders(ctx, pool.GetId(), swapResult.RoundingRemainders...)
+	events.EmitConcentratedSwapExecutionEvent(ctx, pool.GetId(), swapResult.CrossedTicks, poolUpdates.NewCurrentTick)
+	emitSwapGasHintIfSimulation(ctx, pool.GetId(), swapResult.TicksCrossed)
+
+	// The limit orders crossed by the swap are filled at the end of the block.
+	k.markCrossedLimitOrdersPending(ctx, pool.GetId(), poolUpdates.NewCurrentTick)
+
 	return tokenIn, tokenOut, poolUpdates, nil
 }
 
@@ -304,12 +335,13 @@ func (k Keeper) CalcOutAmtGivenIn(
 	tokenOutDenom string,
 	spreadFactor osmomath.Dec,
 ) (tokenOut sdk.Coin, err error) {
-	cacheCtx, _ := ctx.CacheContext()
-	swapResult, _, err := k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, osmomath.ZeroBigDec())
-	if err != nil {
-		return sdk.Coin{}, err
-	}
-	return sdk.NewCoin(tokenOutDenom, swapResult.AmountOut), nil
+	return osmoutils.DryRunFunc(ctx, func(cacheCtx sdk.Context) (sdk.Coin, error) {
+		swapResult, _, err := k.computeOutAmtGivenIn(cacheCtx, poolI.GetId(), tokenIn, tokenOutDenom, spreadFactor, osmomath.ZeroBigDec())
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(tokenOutDenom, swapResult.AmountOut), nil
+	})
 }
 
 func (k Keeper) CalcInAmtGivenOut(
@@ -319,12 +351,13 @@ func (k Keeper) CalcInAmtGivenOut(
 	tokenInDenom string,
 	spreadFactor osmomath.Dec,
 ) (sdk.Coin, error) {
-	cacheCtx, _ := ctx.CacheContext()
-	swapResult, _, err := k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, osmomath.ZeroBigDec(), poolI.GetId())
-	if err != nil {
-		return sdk.Coin{}, err
-	}
-	return sdk.NewCoin(tokenInDenom, swapResult.AmountIn), nil
+	return osmoutils.DryRunFunc(ctx, func(cacheCtx sdk.Context) (sdk.Coin, error) {
+		swapResult, _, err := k.computeInAmtGivenOut(cacheCtx, tokenOut, tokenInDenom, spreadFactor, osmomath.ZeroBigDec(), poolI.GetId())
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		return sdk.NewCoin(tokenInDenom, swapResult.AmountIn), nil
+	})
 }
 
 func (k Keeper) swapSetup(ctx sdk.Context,
@@ -485,7 +518,8 @@ func (k Keeper) computeOutAmtGivenIn(
 
 	// Coin amounts require int values
 	// Round amountIn up to avoid under charging
-	amountIn := (tokenInMin.Amount.ToLegacyDec().Sub(swapState.amountSpecifiedRemaining)).Ceil().TruncateInt()
+	exactAmountIn := tokenInMin.Amount.ToLegacyDec().Sub(swapState.amountSpecifiedRemaining)
+	amountIn := exactAmountIn.Ceil().TruncateInt()
 	// Round amountOut down to avoid over distributing.
 	amountOut := swapState.amountCalculated.TruncateInt()
 
@@ -496,6 +530,12 @@ func (k Keeper) computeOutAmtGivenIn(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		TicksCrossed:  swapState.ticksCrossed,
+		CrossedTicks:  swapState.crossedTicks,
+		RoundingRemainders: sdk.DecCoins{
+			swapTokenInRoundingRemainder(tokenInMin.Denom, exactAmountIn, amountIn, swapState.globalSpreadRewardGrowth),
+			roundingRemainder(tokenOutDenom, swapState.amountCalculated.Neg(), amountOut.Neg()),
+		},
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -609,7 +649,8 @@ func (k Keeper) computeInAmtGivenOut(
 	amountIn := swapState.amountCalculated.Ceil().TruncateInt()
 
 	// Round amount out down to avoid over charging the pool.
-	amountOut := desiredTokenOut.Amount.ToLegacyDec().Sub(swapState.amountSpecifiedRemaining).TruncateInt()
+	exactAmountOut := desiredTokenOut.Amount.ToLegacyDec().Sub(swapState.amountSpecifiedRemaining)
+	amountOut := exactAmountOut.TruncateInt()
 
 	ctx.Logger().Debug("final amount in", amountIn)
 	ctx.Logger().Debug("final amount out", amountOut)
@@ -618,6 +659,12 @@ func (k Keeper) computeInAmtGivenOut(
 		AmountIn:      amountIn,
 		AmountOut:     amountOut,
 		SpreadRewards: swapState.globalSpreadRewardGrowth,
+		TicksCrossed:  swapState.ticksCrossed,
+		CrossedTicks:  swapState.crossedTicks,
+		RoundingRemainders: sdk.DecCoins{
+			swapTokenInRoundingRemainder(tokenInDenom, swapState.amountCalculated, amountIn, swapState.globalSpreadRewardGrowth),
+			roundingRemainder(desiredTokenOut.Denom, exactAmountOut.Neg(), amountOut.Neg()),
+		},
 	}, PoolUpdates{swapState.tick, swapState.liquidity, swapState.sqrtPrice}, nil
 }
 
@@ -630,6 +677,26 @@ func emitSwapDebugLogs(ctx sdk.Context, swapState SwapState, reachedPrice osmoma
 	ctx.Logger().Debug("spreadRewardChargeTotal", spreadCharge)
 }
 
+// emitSwapGasHintIfSimulation emits the number of ticks crossed by a swap along with a suggested gas adjustment
+// when the swap is executed as part of a transaction simulation. It is a no-op otherwise.
+//
+// The gas used by a swap grows with the number of ticks it crosses, which can change between the simulation
+// and the execution of the transaction if the price moves. Clients should therefore scale the simulated gas by
+// the largest suggested adjustment among the emitted hints, rather than by a fixed adjustment.
+func emitSwapGasHintIfSimulation(ctx sdk.Context, poolId uint64, ticksCrossed uint64) {
+	if !osmoutils.IsSimulation(ctx) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSwapGasHint,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTicksCrossed, strconv.FormatUint(ticksCrossed, 10)),
+		sdk.NewAttribute(types.AttributeKeySuggestedGasAdjustment, types.SuggestedSwapGasAdjustment(ticksCrossed).String()),
+	))
+}
+
 // logic for crossing a tick during a swap
 func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 	swapState SwapState, strategy swapstrategy.SwapStrategy,
@@ -661,6 +728,8 @@ func (k Keeper) swapCrossTickLogic(ctx sdk.Context,
 
 	// Update the swapState's tick with the tick we retrieved liquidity from
 	swapState.tick = strategy.UpdateTickAfterCrossing(nextInitializedTick)
+	swapState.ticksCrossed++
+	swapState.crossedTicks = append(swapState.crossedTicks, nextInitializedTick)
 
 	return swapState, nil
 }
@@ -694,6 +763,9 @@ func (k Keeper) updatePoolForSwap(
 	// Spread factors should already be rounded up to a whole number dec, but we do this as a precaution
 	spreadFactorsRoundedUp := sdk.NewCoin(swapDetails.TokenIn.Denom, totalSpreadFactors.Ceil().TruncateInt())
 
+	// Record the swap volume, spread rewards included, and the spread rewards charged.
+	k.addSwapStats(ctx, poolId, swapDetails.TokenIn, spreadFactorsRoundedUp)
+
 	// Remove the spread factors from the input token
 	swapDetails.TokenIn.Amount = swapDetails.TokenIn.Amount.Sub(spreadFactorsRoundedUp.Amount)
 
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
@@ -2080,6 +2081,97 @@ func (s *KeeperTestSuite) TestSwap_NoPositions() {
 	s.Require().ErrorIs(err, types.NoSpotPriceWhenNoLiquidityError{PoolId: pool.GetId()})
 }
 
+func (s *KeeperTestSuite) TestSwap_GasHintOnlyInSimulation() {
+	tests := map[string]struct {
+		isSimulation  bool
+		expectedEvent bool
+	}{
+		"simulation: gas hint is emitted": {
+			isSimulation:  true,
+			expectedEvent: true,
+		},
+		"regular execution: no gas hint": {
+			isSimulation:  false,
+			expectedEvent: false,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			if tc.isSimulation {
+				ctx = osmoutils.WithSimulation(ctx)
+			}
+
+			// small swap that stays within the default position, crossing no ticks.
+			tokenIn := sdk.NewCoin(ETH, osmomath.NewInt(1000))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+			_, _, _, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(
+				ctx, s.TestAccs[0], pool,
+				tokenIn, USDC,
+				osmomath.ZeroDec(), osmomath.ZeroBigDec(),
+			)
+			s.Require().NoError(err)
+
+			if !tc.expectedEvent {
+				s.AssertEventEmitted(ctx, types.TypeEvtSwapGasHint, 0)
+				return
+			}
+
+			s.AssertEventEmitted(ctx, types.TypeEvtSwapGasHint, 1)
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.TypeEvtSwapGasHint {
+					continue
+				}
+				ticksCrossed, ok := event.GetAttribute(types.AttributeKeyTicksCrossed)
+				s.Require().True(ok)
+				s.Require().Equal("0", ticksCrossed.Value)
+				adjustment, ok := event.GetAttribute(types.AttributeKeySuggestedGasAdjustment)
+				s.Require().True(ok)
+				s.Require().Equal(types.BaseSwapGasAdjustment.String(), adjustment.Value)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_CrossedTicks() {
+	const numTicks = 3
+	s.SetupTest()
+	pool, tokenIn, priceLimit := s.PrepareConcentratedPoolWithInitializedTicks(DefaultTickSpacing, numTicks)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+	// The swap moves left through the upper ticks of the positions and the lower tick of the last one is never reached.
+	spacing := int64(DefaultTickSpacing)
+	expectedCrossedTicks := []int64{-spacing, -2 * spacing, -3 * spacing}
+
+	cacheCtx, _ := s.Ctx.CacheContext()
+	swapResult, _, err := s.App.ConcentratedLiquidityKeeper.ComputeOutAmtGivenIn(cacheCtx, pool.GetId(), tokenIn, USDC, pool.GetSpreadFactor(cacheCtx), priceLimit)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(numTicks), swapResult.TicksCrossed)
+	s.Require().Equal(expectedCrossedTicks, swapResult.CrossedTicks)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, _, poolUpdates, err := s.App.ConcentratedLiquidityKeeper.SwapOutAmtGivenIn(ctx, s.TestAccs[0], pool, tokenIn, USDC, pool.GetSpreadFactor(ctx), priceLimit)
+	s.Require().NoError(err)
+
+	s.AssertEventEmitted(ctx, poolmanagertypes.TypeEvtConcentratedSwap, 1)
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != poolmanagertypes.TypeEvtConcentratedSwap {
+			continue
+		}
+		crossedTicks, ok := event.GetAttribute(poolmanagertypes.AttributeKeyCrossedTicks)
+		s.Require().True(ok)
+		s.Require().Equal(fmt.Sprintf("%d,%d,%d", -spacing, -2*spacing, -3*spacing), crossedTicks.Value)
+		finalTick, ok := event.GetAttribute(poolmanagertypes.AttributeKeyFinalTick)
+		s.Require().True(ok)
+		s.Require().Equal(fmt.Sprint(poolUpdates.NewCurrentTick), finalTick.Value)
+	}
+}
+
 func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_TickUpdates() {
 	tests := makeTests(swapOutGivenInCases)
 	for name, test := range tests {
@@ -3346,8 +3438,8 @@ func (s *KeeperTestSuite) TestInfiniteSwapLoop_OutGivenIn() {
 	positionOwner := testAccs[0]
 
 	// Create position near min tick
-	s.FundAcc(positionOwner, DefaultRangeTestParams.baseAssets.Add(DefaultRangeTestParams.baseAssets...))
-	_, err := s.Clk.CreatePosition(s.Ctx, pool.GetId(), positionOwner, DefaultRangeTestParams.baseAssets, osmomath.ZeroInt(), osmomath.ZeroInt(), -108000000, -107999900)
+	s.FundAcc(positionOwner, DefaultRangeTestParams.BaseAssets.Add(DefaultRangeTestParams.BaseAssets...))
+	_, err := s.Clk.CreatePosition(s.Ctx, pool.GetId(), positionOwner, DefaultRangeTestParams.BaseAssets, osmomath.ZeroInt(), osmomath.ZeroInt(), -108000000, -107999900)
 	s.Require().NoError(err)
 
 	// Swap small amount to get current tick to position above, triggering the problematic function/branch (CalcAmount0Delta)
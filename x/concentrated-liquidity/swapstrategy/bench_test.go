@@ -0,0 +1,55 @@
+package swapstrategy_test
+
+import (
+	"testing"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/swapstrategy"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+var (
+	benchSqrtPriceCurrent = osmomath.MustNewBigDecFromStr("70.710678118654752440084436210484903928")
+	benchLiquidity        = osmomath.MustNewDecFromStr("1517882343.751510417627556287")
+	benchSpreadFactor     = osmomath.MustNewDecFromStr("0.003")
+)
+
+// benchmarkComputeSwapWithinBucket runs computeSwap against a strategy in the given direction.
+// The store key is not needed since computing a swap step does not access state.
+func benchmarkComputeSwapWithinBucket(b *testing.B, zeroForOne bool, computeSwap func(strategy swapstrategy.SwapStrategy, sqrtPriceTarget osmomath.BigDec)) {
+	sqrtPriceLimit, sqrtPriceTarget := types.MaxSqrtPriceBigDec, osmomath.MustNewBigDecFromStr("70.8")
+	if zeroForOne {
+		sqrtPriceLimit, sqrtPriceTarget = types.MinSqrtPriceBigDec, osmomath.MustNewBigDecFromStr("70.6")
+	}
+	strategy := swapstrategy.New(zeroForOne, sqrtPriceLimit, nil, benchSpreadFactor)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		computeSwap(strategy, sqrtPriceTarget)
+	}
+}
+
+func BenchmarkComputeSwapWithinBucketOutGivenIn_ZeroForOne(b *testing.B) {
+	benchmarkComputeSwapWithinBucket(b, true, func(strategy swapstrategy.SwapStrategy, sqrtPriceTarget osmomath.BigDec) {
+		strategy.ComputeSwapWithinBucketOutGivenIn(benchSqrtPriceCurrent, sqrtPriceTarget, benchLiquidity, osmomath.NewDec(13370))
+	})
+}
+
+func BenchmarkComputeSwapWithinBucketOutGivenIn_OneForZero(b *testing.B) {
+	benchmarkComputeSwapWithinBucket(b, false, func(strategy swapstrategy.SwapStrategy, sqrtPriceTarget osmomath.BigDec) {
+		strategy.ComputeSwapWithinBucketOutGivenIn(benchSqrtPriceCurrent, sqrtPriceTarget, benchLiquidity, osmomath.NewDec(42000000))
+	})
+}
+
+func BenchmarkComputeSwapWithinBucketInGivenOut_ZeroForOne(b *testing.B) {
+	benchmarkComputeSwapWithinBucket(b, true, func(strategy swapstrategy.SwapStrategy, sqrtPriceTarget osmomath.BigDec) {
+		strategy.ComputeSwapWithinBucketInGivenOut(benchSqrtPriceCurrent, sqrtPriceTarget, benchLiquidity, osmomath.NewDec(42000000))
+	})
+}
+
+func BenchmarkComputeSwapWithinBucketInGivenOut_OneForZero(b *testing.B) {
+	benchmarkComputeSwapWithinBucket(b, false, func(strategy swapstrategy.SwapStrategy, sqrtPriceTarget osmomath.BigDec) {
+		strategy.ComputeSwapWithinBucketInGivenOut(benchSqrtPriceCurrent, sqrtPriceTarget, benchLiquidity, osmomath.NewDec(13370))
+	})
+}
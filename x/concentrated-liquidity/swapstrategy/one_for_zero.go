@@ -67,7 +67,8 @@ func (s oneForZeroStrategy) ComputeSwapWithinBucketOutGivenIn(sqrtPriceCurrent,
 	amountOneIn := math.CalcAmount1Delta(liquidityBigDec, sqrtPriceTarget, sqrtPriceCurrent, true)
 
 	// Calculate sqrtPriceNext on the amount of token remaining after spread reward.
-	amountOneInRemainingLessSpreadReward := amountOneInRemainingBigDec.MulTruncate(oneBigDec.Sub(osmomath.BigDecFromDec(s.spreadFactor)))
+	// N.B.: the multiplication mutates the freshly allocated (1 - spread factor) to avoid an extra allocation.
+	amountOneInRemainingLessSpreadReward := oneBigDec.Sub(osmomath.BigDecFromDec(s.spreadFactor)).MulTruncateMut(amountOneInRemainingBigDec)
 
 	var sqrtPriceNext osmomath.BigDec
 	// If have more of the amount remaining after spread reward than estimated until target,
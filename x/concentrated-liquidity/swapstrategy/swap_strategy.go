@@ -139,7 +139,7 @@ func GetSqrtPriceLimit(priceLimit osmomath.BigDec, zeroForOne bool) (osmomath.Bi
 	if priceLimit.GTE(types.MinSpotPriceBigDec) {
 		// Truncation is fine since previous Osmosis version only supported
 		// 18 decimal price ranges.
-		sqrtPriceLimit, err := osmomath.MonotonicSqrt(priceLimit.Dec())
+		sqrtPriceLimit, err := osmomath.MonotonicSqrtWithRounding(priceLimit.Dec(), osmomath.RoundUp)
 		if err != nil {
 			return osmomath.BigDec{}, err
 		}
@@ -148,7 +148,7 @@ func GetSqrtPriceLimit(priceLimit osmomath.BigDec, zeroForOne bool) (osmomath.Bi
 
 	// On the newly extended lower price range, utilize the 36 decimal
 	// sqrt.
-	sqrtPriceLimit, err := osmomath.MonotonicSqrtBigDec(priceLimit)
+	sqrtPriceLimit, err := osmomath.MonotonicSqrtBigDecWithRounding(priceLimit, osmomath.RoundUp)
 	if err != nil {
 		return osmomath.BigDec{}, err
 	}
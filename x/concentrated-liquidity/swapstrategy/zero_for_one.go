@@ -67,7 +67,8 @@ func (s zeroForOneStrategy) ComputeSwapWithinBucketOutGivenIn(sqrtPriceCurrent,
 	amountZeroIn := math.CalcAmount0Delta(liquidityBigDec, sqrtPriceTarget, sqrtPriceCurrent, true) // N.B.: if this is false, causes infinite loop
 
 	// Calculate sqrtPriceNext on the amount of token remaining after spread reward.
-	amountZeroInRemainingLessSpreadReward := amountZeroInRemainingBigDec.Mul(oneBigDec.Sub(osmomath.BigDecFromDec(s.spreadFactor)))
+	// N.B.: the multiplication mutates the freshly allocated (1 - spread factor) to avoid an extra allocation.
+	amountZeroInRemainingLessSpreadReward := oneBigDec.Sub(osmomath.BigDecFromDec(s.spreadFactor)).MulMut(amountZeroInRemainingBigDec)
 
 	var sqrtPriceNext osmomath.BigDec
 	// If have more of the amount remaining after spread reward than estimated until target,
@@ -173,6 +173,48 @@ func (k Keeper) GetAllInitializedTicksForPool(ctx sdk.Context, poolId uint64) ([
 	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(poolId), ParseFullTickFromBytes)
 }
 
+// PruneEmptyTicks removes the ticks of the given pool that have no gross liquidity but are still in state.
+// Such ticks are not referenced by any position, so removing them does not change swap or reward behavior.
+// Returns the number of ticks removed.
+func (k Keeper) PruneEmptyTicks(ctx sdk.Context, poolId uint64) (int, error) {
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	pruned := 0
+	for _, tick := range ticks {
+		if !tick.Info.LiquidityGross.IsZero() {
+			continue
+		}
+		k.RemoveTickInfo(ctx, poolId, tick.TickIndex)
+		pruned++
+	}
+	return pruned, nil
+}
+
+// PruneEmptyTicksForAllPools removes the empty ticks of every concentrated liquidity pool.
+// See PruneEmptyTicks for details. Returns the total number of ticks removed.
+func (k Keeper) PruneEmptyTicksForAllPools(ctx sdk.Context) (int, error) {
+	pools, err := k.getConcentratedPools(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, pool := range pools {
+		pruned, err := k.PruneEmptyTicks(ctx, pool.GetId())
+		if err != nil {
+			return 0, err
+		}
+		if pruned > 0 {
+			ctx.Logger().Info("pruned empty ticks", "pool_id", pool.GetId(), "count", pruned)
+		}
+		total += pruned
+	}
+	return total, nil
+}
+
 // validateTickInRangeIsValid validates that given ticks are valid. That is:
 // - both lower and upper ticks are divisible by the tick spacing
 // - both lower and upper ticks are within MinTick and MaxTick range
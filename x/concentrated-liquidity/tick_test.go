@@ -813,3 +813,58 @@ func (s *KeeperTestSuite) TestValidateTickRangeIsValid() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestPruneEmptyTicks() {
+	s.SetupTest()
+
+	// Set up two pools with a position each, so that their lower and upper ticks hold liquidity.
+	poolIds := []uint64{}
+	for i := 0; i < 2; i++ {
+		pool := s.PrepareConcentratedPool()
+		s.SetupDefaultPosition(pool.GetId())
+		poolIds = append(poolIds, pool.GetId())
+	}
+
+	// Store an empty tick in both pools, and a second one in the first pool.
+	emptyTicks := map[uint64][]int64{
+		poolIds[0]: {DefaultCurrTick, DefaultUpperTick + 100},
+		poolIds[1]: {DefaultCurrTick},
+	}
+	for poolId, tickIndexes := range emptyTicks {
+		for _, tickIndex := range tickIndexes {
+			tickInfo, err := s.Clk.GetTickInfo(s.Ctx, poolId, tickIndex)
+			s.Require().NoError(err)
+			s.Require().True(tickInfo.LiquidityGross.IsZero())
+			s.Clk.SetTickInfo(s.Ctx, poolId, tickIndex, &tickInfo)
+		}
+	}
+
+	msg, broken := cl.EmptyTicksInvariant(*s.Clk)(s.Ctx)
+	s.Require().True(broken, msg)
+
+	// Pruning a single pool only removes its own empty ticks.
+	pruned, err := s.Clk.PruneEmptyTicks(s.Ctx, poolIds[1])
+	s.Require().NoError(err)
+	s.Require().Equal(1, pruned)
+
+	pruned, err = s.Clk.PruneEmptyTicksForAllPools(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(2, pruned)
+
+	// Only the ticks of the positions remain.
+	for _, poolId := range poolIds {
+		ticks, err := s.Clk.GetAllInitializedTicksForPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		s.Require().Len(ticks, 2)
+		s.Require().Equal(DefaultLowerTick, ticks[0].TickIndex)
+		s.Require().Equal(DefaultUpperTick, ticks[1].TickIndex)
+	}
+
+	msg, broken = cl.EmptyTicksInvariant(*s.Clk)(s.Ctx)
+	s.Require().False(broken, msg)
+
+	// Pruning again is a no-op.
+	pruned, err = s.Clk.PruneEmptyTicksForAllPools(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(0, pruned)
+}
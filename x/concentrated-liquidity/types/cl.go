@@ -4,13 +4,25 @@ import (
 	fmt "fmt"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 )
 
+// PositionWrapperAddress is the address that owns all wrapped positions.
+var PositionWrapperAddress = osmoutils.NewModuleAddressWithPrefix(ModuleName, "positionWrapper", nil)
+
+// LimitOrderEscrowAddress is the address that holds the amounts filled limit orders converted to until they are claimed.
+var LimitOrderEscrowAddress = osmoutils.NewModuleAddressWithPrefix(ModuleName, "limitOrderEscrow", nil)
+
 // GetConcentratedLockupDenomFromPoolId returns the concentrated lockup denom for a given pool id.
 func GetConcentratedLockupDenomFromPoolId(poolId uint64) string {
 	return fmt.Sprintf("%s/%d", ConcentratedLiquidityTokenPrefix, poolId)
 }
 
+// GetPositionWrapperDenom returns the denom of the token representing a wrapped position.
+func GetPositionWrapperDenom(positionId uint64) string {
+	return fmt.Sprintf("%s/%d", PositionWrapperTokenPrefix, positionId)
+}
+
 // CreateFullRangePositionData represents the return data from any method
 // that creates a full range position. We have multipl variants to
 // account for varying locking scenarios.
@@ -30,3 +42,22 @@ type UpdatePositionData struct {
 	LowerTickIsEmpty bool
 	UpperTickIsEmpty bool
 }
+
+// StateDiscrepancy represents a mismatch between the concentrated liquidity state
+// persisted in the store and the same state recomputed from the positions.
+type StateDiscrepancy struct {
+	PoolId uint64
+	// Check is the name of the check that failed.
+	Check string
+	// Subject identifies the state entry that failed the check within the pool, if any.
+	Subject  string
+	Expected string
+	Actual   string
+}
+
+func (d StateDiscrepancy) String() string {
+	if d.Subject == "" {
+		return fmt.Sprintf("pool (%d): %s mismatch, expected (%s), actual (%s)", d.PoolId, d.Check, d.Expected, d.Actual)
+	}
+	return fmt.Sprintf("pool (%d): %s mismatch for %s, expected (%s), actual (%s)", d.PoolId, d.Check, d.Subject, d.Expected, d.Actual)
+}
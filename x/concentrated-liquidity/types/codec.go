@@ -19,10 +19,26 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCollectSpreadRewards{}, "osmosis/cl-col-sp-rewards", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgFungifyChargedPositions{}, "osmosis/cl-fungify-charged-positions", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "osmosis/cl-update-params", nil)
+	cdc.RegisterConcrete(&MsgSetClaimAllowance{}, "osmosis/cl-set-claim-allowance", nil)
+	cdc.RegisterConcrete(&MsgRevokeClaimAllowance{}, "osmosis/cl-revoke-claim-allowance", nil)
+	cdc.RegisterConcrete(&MsgWrapPosition{}, "osmosis/cl-wrap-position", nil)
+	cdc.RegisterConcrete(&MsgUnwrapPosition{}, "osmosis/cl-unwrap-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawAllPoolPositions{}, "osmosis/cl-withdraw-all-pool-positions", nil)
+	cdc.RegisterConcrete(&MsgLockPositionForCollateral{}, "osmosis/cl-lock-position-for-collateral", nil)
+	cdc.RegisterConcrete(&MsgUnlockPosition{}, "osmosis/cl-unlock-position", nil)
+	cdc.RegisterConcrete(&MsgEnableManagedPosition{}, "osmosis/cl-enable-managed-position", nil)
+	cdc.RegisterConcrete(&MsgDisableManagedPosition{}, "osmosis/cl-disable-managed-position", nil)
+	cdc.RegisterConcrete(&MsgRebalanceManagedPosition{}, "osmosis/cl-rebalance-managed-position", nil)
+	cdc.RegisterConcrete(&MsgMigratePositionToSuccessorPool{}, "osmosis/cl-migrate-position-to-successor-pool", nil)
+	cdc.RegisterConcrete(&MsgPlaceLimitOrder{}, "osmosis/cl-place-limit-order", nil)
+	cdc.RegisterConcrete(&MsgClaimLimitOrder{}, "osmosis/cl-claim-limit-order", nil)
 
 	// gov proposals
 	cdc.RegisterConcrete(&CreateConcentratedLiquidityPoolsProposal{}, "osmosis/create-cl-pools-proposal", nil)
 	cdc.RegisterConcrete(&TickSpacingDecreaseProposal{}, "osmosis/cl-tick-spacing-dec-prop", nil)
+	cdc.RegisterConcrete(&SweepRoundingRemaindersProposal{}, "osmosis/cl-sweep-rounding-rem-prop", nil)
+	cdc.RegisterConcrete(&PositionMigrationWindowProposal{}, "osmosis/cl-position-migration-window-prop", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -39,12 +55,28 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCollectSpreadRewards{},
 		&MsgCollectIncentives{},
 		&MsgFungifyChargedPositions{},
+		&MsgUpdateParams{},
+		&MsgSetClaimAllowance{},
+		&MsgRevokeClaimAllowance{},
+		&MsgWrapPosition{},
+		&MsgUnwrapPosition{},
+		&MsgWithdrawAllPoolPositions{},
+		&MsgLockPositionForCollateral{},
+		&MsgUnlockPosition{},
+		&MsgEnableManagedPosition{},
+		&MsgDisableManagedPosition{},
+		&MsgRebalanceManagedPosition{},
+		&MsgMigratePositionToSuccessorPool{},
+		&MsgPlaceLimitOrder{},
+		&MsgClaimLimitOrder{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypesv1.Content)(nil),
 		&CreateConcentratedLiquidityPoolsProposal{},
 		&TickSpacingDecreaseProposal{},
+		&SweepRoundingRemaindersProposal{},
+		&PositionMigrationWindowProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
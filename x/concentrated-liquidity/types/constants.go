@@ -30,6 +30,17 @@ const (
 	BaseGasFeeForTransferPosition       = 10_000
 )
 
+// Names of the state verification checks reported in StateDiscrepancy.
+const (
+	CheckCurrentLiquidity   = "current liquidity"
+	CheckTickLiquidityNet   = "tick liquidity net"
+	CheckTickLiquidityGross = "tick liquidity gross"
+	CheckTickInitialized    = "tick initialized"
+	CheckAccumulatorShares  = "accumulator total shares"
+	CheckAccumulatorValue   = "accumulator value"
+	CheckPoolBalance        = "pool balance"
+)
+
 var (
 	MaxSpotPrice       = osmomath.MustNewDecFromStr("100000000000000000000000000000000000000")
 	MaxSpotPriceBigDec = osmomath.BigDecFromDec(MaxSpotPrice)
@@ -43,6 +54,10 @@ var (
 	MaxSqrtPriceBigDec = osmomath.BigDecFromDec(MaxSqrtPrice)
 	MinSqrtPriceBigDec = osmomath.BigDecFromDec(MinSqrtPrice)
 
+	// Factor that the values of uptime accumulators are scaled up by, so that small emissions on
+	// large qualifying liquidity do not truncate to zero incentives per unit of liquidity.
+	PerUnitLiqScalingFactor = osmomath.MustNewDecFromStr("1000000000000000000000000000") // 10^27
+
 	// Supported uptimes preset to 1 ns, 1 min, 1 hr, 1D, 1W, 2W
 	SupportedUptimes        = []time.Duration{time.Nanosecond, time.Minute, time.Hour, time.Hour * 24, time.Hour * 24 * 7, time.Hour * 24 * 7 * 2}
 	AuthorizedTickSpacing   = []uint64{1, 10, 100, 1000}
@@ -69,4 +84,71 @@ var (
 	// 2M gas is enough to execute tens of expensive CL operations and is only set this high
 	// to accommodate position withdrawals, which are unusually expensive.
 	DefaultContractHookGasLimit = uint64(2_000_000)
+
+	// DefaultMaxIncentiveRecordsPerPool and DefaultMaxIncentiveRecordsPerUptime cap the number of active
+	// incentive records of a pool, since every record is iterated over when updating the uptime accumulators,
+	// which happens on every swap, position update and incentive creation.
+	DefaultMaxIncentiveRecordsPerPool   = uint64(100)
+	DefaultMaxIncentiveRecordsPerUptime = uint64(50)
+
+	// DefaultMaxPositionsPerWithdrawAll bounds the number of positions withdrawn by a single
+	// MsgWithdrawAllPoolPositions, since every full withdrawal also claims rewards and may delete ticks.
+	DefaultMaxPositionsPerWithdrawAll = uint64(25)
+
+	// DefaultAuthorizedLienholders is empty, so positions cannot be locked as collateral
+	// until governance authorizes a lending module or contract.
+	DefaultAuthorizedLienholders = []string{}
+
+	// DefaultAuthorizedPositionRebalancers is empty, so managed positions are only rebalanced
+	// at the end of the rebalance epoch until governance authorizes keeper bots.
+	DefaultAuthorizedPositionRebalancers           = []string{}
+	DefaultManagedPositionRebalanceFee             = osmomath.MustNewDecFromStr("0.001")
+	DefaultManagedPositionRebalanceEpochIdentifier = "day"
+	// DefaultMaxManagedPositionRebalancesPerEpoch bounds the work done at the end of the rebalance epoch,
+	// since every rebalance attempt withdraws and recreates a position.
+	DefaultMaxManagedPositionRebalancesPerEpoch = uint64(100)
+	// MaxManagedPositionsCheckedPerEpoch bounds the number of managed positions whose range is checked at
+	// the end of the rebalance epoch. The following epochs resume where the previous one stopped.
+	MaxManagedPositionsCheckedPerEpoch = 1_000
+	// MaxManagedPositionRebalanceFee is the highest rebalance fee governance can set.
+	MaxManagedPositionRebalanceFee = osmomath.MustNewDecFromStr("0.05")
+
+	// DefaultPositionHistoryRetentionBlocks keeps the lifecycle events of positions for roughly two weeks
+	// at 6 second blocks.
+	DefaultPositionHistoryRetentionBlocks = uint64(201_600)
+	// MaxPositionHistoryEntriesPrunedPerBlock bounds the work done at the end of every block to prune
+	// the position history, e.g. after governance shortens the retention period.
+	MaxPositionHistoryEntriesPrunedPerBlock = 1_000
+
+	// MaxPositionMigrationWindowDuration is the longest position migration window governance can open,
+	// so that a deprecated pool cannot stay linked to its successor pool indefinitely.
+	MaxPositionMigrationWindowDuration = time.Hour * 24 * 30
+
+	// DefaultMaxLimitOrderFillsPerBlock bounds the work done at the end of every block to fill the crossed
+	// limit orders, since every fill withdraws a position. The orders left open are filled in the following
+	// blocks or when claimed.
+	DefaultMaxLimitOrderFillsPerBlock = uint64(100)
+	// LimitOrderFillGasCost is the gas charged for placing a limit order, which prepays the withdrawal of its
+	// position when it is filled at the end of a block, so that the fills do not add to the gas of swaps.
+	LimitOrderFillGasCost = 100_000
+
+	// MaxPositionIdsPerCollect is the maximum number of positions that rewards can be collected
+	// from in a single best effort MsgCollectSpreadRewards or MsgCollectIncentives, since every
+	// position collected from in a cache context adds its own store writes and result. Collections
+	// that are not best effort fail as a whole on the first error and are not bounded.
+	MaxPositionIdsPerCollect = 500
+
+	// BaseSwapGasAdjustment is the gas adjustment suggested to clients simulating a swap that crosses no ticks.
+	BaseSwapGasAdjustment = osmomath.MustNewDecFromStr("1.1")
+	// SwapGasAdjustmentPerTickCrossed is added to the suggested gas adjustment for every tick a simulated swap crosses.
+	SwapGasAdjustmentPerTickCrossed = osmomath.MustNewDecFromStr("0.02")
+	// MaxSwapGasAdjustment caps the gas adjustment suggested to clients simulating a swap.
+	MaxSwapGasAdjustment = osmomath.MustNewDecFromStr("2")
 )
+
+// SuggestedSwapGasAdjustment returns the gas adjustment suggested to clients for a swap
+// that crossed the given number of ticks during simulation.
+func SuggestedSwapGasAdjustment(ticksCrossed uint64) osmomath.Dec {
+	adjustment := BaseSwapGasAdjustment.Add(SwapGasAdjustmentPerTickCrossed.MulInt64(int64(ticksCrossed)))
+	return osmomath.MinDec(adjustment, MaxSwapGasAdjustment)
+}
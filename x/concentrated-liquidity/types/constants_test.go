@@ -0,0 +1,40 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func TestSuggestedSwapGasAdjustment(t *testing.T) {
+	testCases := []struct {
+		name               string
+		ticksCrossed       uint64
+		expectedAdjustment osmomath.Dec
+	}{
+		{
+			name:               "no ticks crossed",
+			ticksCrossed:       0,
+			expectedAdjustment: types.BaseSwapGasAdjustment,
+		},
+		{
+			name:               "ten ticks crossed",
+			ticksCrossed:       10,
+			expectedAdjustment: osmomath.MustNewDecFromStr("1.3"),
+		},
+		{
+			name:               "capped at max",
+			ticksCrossed:       1000,
+			expectedAdjustment: types.MaxSwapGasAdjustment,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectedAdjustment.String(), types.SuggestedSwapGasAdjustment(tc.ticksCrossed).String())
+		})
+	}
+}
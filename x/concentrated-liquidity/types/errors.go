@@ -19,6 +19,11 @@ var (
 	ErrZeroLiquidity                      = errors.New("liquidity cannot be 0")
 	ErrNextTickInfoNil                    = errors.New("next tick info cannot be nil")
 	ErrPoolNil                            = errors.New("pool cannot be nil")
+	ErrClaimAllowanceNotFound             = errors.New("claim allowance not found")
+	ErrPositionLienNotFound               = errors.New("position lien not found")
+	ErrManagedPositionNotFound            = errors.New("managed position not found")
+	ErrPositionMigrationWindowNotFound    = errors.New("position migration window not found")
+	ErrLimitOrderNotFound                 = errors.New("limit order not found")
 )
 
 // x/concentrated-liquidity module sentinel errors.
@@ -139,6 +144,20 @@ func (e InsufficientLiquidityCreatedError) Error() string {
 	return fmt.Sprintf("slippage bound: insufficient amount of token %d created. Actual: (%s). Minimum estimated: (%s)", tokenNum, e.Actual, e.Minimum)
 }
 
+type InsufficientAmountWithdrawnError struct {
+	Actual      osmomath.Int
+	Minimum     osmomath.Int
+	IsTokenZero bool
+}
+
+func (e InsufficientAmountWithdrawnError) Error() string {
+	tokenNum := uint8(0)
+	if !e.IsTokenZero {
+		tokenNum = 1
+	}
+	return fmt.Sprintf("slippage bound: insufficient amount of token %d withdrawn. Actual: (%s). Minimum: (%s)", tokenNum, e.Actual, e.Minimum)
+}
+
 type NegativeLiquidityError struct {
 	Liquidity osmomath.Dec
 }
@@ -210,6 +229,16 @@ func (e TickSpacingError) Error() string {
 	return fmt.Sprintf("lowerTick (%d) and upperTick (%d) must be divisible by the pool's tickSpacing parameter (%d)", e.LowerTick, e.UpperTick, e.TickSpacing)
 }
 
+type IncompatibleTickSpacingError struct {
+	PoolId      uint64
+	TickSpacing uint64
+	TickIndex   int64
+}
+
+func (e IncompatibleTickSpacingError) Error() string {
+	return fmt.Sprintf("pool (%d) has initialized tick (%d) that is not divisible by the new tick spacing (%d)", e.PoolId, e.TickIndex, e.TickSpacing)
+}
+
 type TickSpacingBoundaryError struct {
 	TickSpacing        uint64
 	TickSpacingMinimum uint64
@@ -338,6 +367,17 @@ func (e IncentiveInsufficientBalanceError) Error() string {
 	return fmt.Sprintf("sender has insufficient balance to create this incentive record. Pool id (%d), incentive denom (%s), incentive amount needed (%s)", e.PoolId, e.IncentiveDenom, e.IncentiveAmount)
 }
 
+type IncentiveRecordCapReachedError struct {
+	PoolId                       uint64
+	MinUptime                    time.Duration
+	MaxIncentiveRecordsPerPool   uint64
+	MaxIncentiveRecordsPerUptime uint64
+}
+
+func (e IncentiveRecordCapReachedError) Error() string {
+	return fmt.Sprintf("pool (%d) has reached the cap on active incentive records for min uptime (%s). Max incentive records per pool (%d), max incentive records per uptime (%d)", e.PoolId, e.MinUptime, e.MaxIncentiveRecordsPerPool, e.MaxIncentiveRecordsPerUptime)
+}
+
 type ErrInvalidBalancerPoolLiquidityError struct {
 	ClPoolId              uint64
 	BalancerPoolId        uint64
@@ -930,3 +970,190 @@ type InvalidActionPrefixError struct {
 func (e InvalidActionPrefixError) Error() string {
 	return fmt.Sprintf("invalid action prefix (%s). Valid actions: %s", e.ActionPrefix, e.ValidActions)
 }
+
+type TooManyPositionIdsError struct {
+	NumPositionIds int
+	MaxPositionIds int
+}
+
+func (e TooManyPositionIdsError) Error() string {
+	return fmt.Sprintf("too many position ids (%d), maximum is %d", e.NumPositionIds, e.MaxPositionIds)
+}
+
+type UnauthorizedAuthorityError struct {
+	ExpectedAuthority string
+	ActualAuthority   string
+}
+
+func (e UnauthorizedAuthorityError) Error() string {
+	return fmt.Sprintf("unauthorized authority, expected (%s), got (%s)", e.ExpectedAuthority, e.ActualAuthority)
+}
+
+type ClaimAllowanceExpiredError struct {
+	Owner      string
+	Grantee    string
+	Expiration time.Time
+}
+
+func (e ClaimAllowanceExpiredError) Error() string {
+	return fmt.Sprintf("claim allowance granted by (%s) to (%s) expired at %s", e.Owner, e.Grantee, e.Expiration)
+}
+
+type InvalidClaimAllowanceExpirationError struct {
+	Expiration time.Time
+	BlockTime  time.Time
+}
+
+func (e InvalidClaimAllowanceExpirationError) Error() string {
+	return fmt.Sprintf("claim allowance expiration (%s) must be after the block time (%s)", e.Expiration, e.BlockTime)
+}
+
+type PositionNotWrappedError struct {
+	PositionId uint64
+}
+
+func (e PositionNotWrappedError) Error() string {
+	return fmt.Sprintf("position id (%d) is not wrapped", e.PositionId)
+}
+
+type PositionWrapperTokenNotHeldError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e PositionWrapperTokenNotHeldError) Error() string {
+	return fmt.Sprintf("address (%s) does not hold the wrapper token of position id (%d)", e.Address, e.PositionId)
+}
+
+type NoWithdrawablePositionsError struct {
+	PoolId  uint64
+	Address string
+}
+
+func (e NoWithdrawablePositionsError) Error() string {
+	return fmt.Sprintf("address (%s) has no positions that can be withdrawn in pool id (%d)", e.Address, e.PoolId)
+}
+
+type UnauthorizedLienholderError struct {
+	Lienholder string
+}
+
+func (e UnauthorizedLienholderError) Error() string {
+	return fmt.Sprintf("address (%s) is not an authorized lienholder", e.Lienholder)
+}
+
+type PositionLockedForCollateralError struct {
+	PositionId uint64
+	Lienholder string
+}
+
+func (e PositionLockedForCollateralError) Error() string {
+	return fmt.Sprintf("position id (%d) is locked as collateral by (%s)", e.PositionId, e.Lienholder)
+}
+
+type NotLienholderError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e NotLienholderError) Error() string {
+	return fmt.Sprintf("address (%s) does not hold the lien on position id (%d)", e.Address, e.PositionId)
+}
+
+type CollateralPositionHasUnderlyingLockError struct {
+	PositionId uint64
+	LockId     uint64
+}
+
+func (e CollateralPositionHasUnderlyingLockError) Error() string {
+	return fmt.Sprintf("position id (%d) has an active underlying lock (%d) and cannot be locked as collateral", e.PositionId, e.LockId)
+}
+
+type UnauthorizedPositionRebalancerError struct {
+	Rebalancer string
+}
+
+func (e UnauthorizedPositionRebalancerError) Error() string {
+	return fmt.Sprintf("address (%s) is not an authorized position rebalancer", e.Rebalancer)
+}
+
+type InvalidManagedPositionRangeWidthError struct {
+	RangeWidth  uint64
+	TickSpacing uint64
+}
+
+func (e InvalidManagedPositionRangeWidthError) Error() string {
+	return fmt.Sprintf("managed position range width (%d) must be a positive multiple of the tick spacing (%d)", e.RangeWidth, e.TickSpacing)
+}
+
+type ManagedPositionInRangeError struct {
+	PositionId  uint64
+	CurrentTick int64
+	LowerTick   int64
+	UpperTick   int64
+}
+
+func (e ManagedPositionInRangeError) Error() string {
+	return fmt.Sprintf("managed position id (%d) is in range, current tick (%d) is within [%d, %d)", e.PositionId, e.CurrentTick, e.LowerTick, e.UpperTick)
+}
+
+type ManagedPositionRebalanceFeeTooHighError struct {
+	PositionId      uint64
+	RebalanceFee    osmomath.Dec
+	MaxRebalanceFee osmomath.Dec
+}
+
+func (e ManagedPositionRebalanceFeeTooHighError) Error() string {
+	return fmt.Sprintf("rebalance fee (%s) is higher than the max rebalance fee (%s) accepted by the owner of managed position id (%d)", e.RebalanceFee, e.MaxRebalanceFee, e.PositionId)
+}
+
+type PositionMigrationWindowClosedError struct {
+	FromPoolId uint64
+	EndTime    time.Time
+}
+
+func (e PositionMigrationWindowClosedError) Error() string {
+	return fmt.Sprintf("position migration window of pool id (%d) closed at (%s)", e.FromPoolId, e.EndTime)
+}
+
+type SuccessorPoolDenomMismatchError struct {
+	FromPoolId     uint64
+	ToPoolId       uint64
+	FromPoolDenoms []string
+	ToPoolDenoms   []string
+}
+
+func (e SuccessorPoolDenomMismatchError) Error() string {
+	return fmt.Sprintf("successor pool id (%d) denoms (%s) do not match the denoms (%s) of pool id (%d)", e.ToPoolId, e.ToPoolDenoms, e.FromPoolDenoms, e.FromPoolId)
+}
+
+type LimitOrderTickRangeError struct {
+	PoolId      uint64
+	LowerTick   int64
+	UpperTick   int64
+	CurrentTick int64
+	ZeroForOne  bool
+}
+
+func (e LimitOrderTickRangeError) Error() string {
+	if e.ZeroForOne {
+		return fmt.Sprintf("limit order selling token0 in pool id (%d) must be above the current tick (%d), got lower tick (%d)", e.PoolId, e.CurrentTick, e.LowerTick)
+	}
+	return fmt.Sprintf("limit order selling token1 in pool id (%d) must be at or below the current tick (%d), got upper tick (%d)", e.PoolId, e.CurrentTick, e.UpperTick)
+}
+
+type LimitOrderNotFilledError struct {
+	PositionId uint64
+}
+
+func (e LimitOrderNotFilledError) Error() string {
+	return fmt.Sprintf("limit order of position id (%d) is not filled yet", e.PositionId)
+}
+
+type PositionIsLimitOrderError struct {
+	PositionId uint64
+}
+
+func (e PositionIsLimitOrderError) Error() string {
+	return fmt.Sprintf("position id (%d) holds a limit order", e.PositionId)
+}
@@ -1,18 +1,34 @@
 package types
 
 const (
-	TypeEvtCreatePosition            = "create_position"
-	TypeEvtWithdrawPosition          = "withdraw_position"
-	TypeEvtAddToPosition             = "add_to_position"
-	TypeEvtTotalCollectSpreadRewards = "total_collect_spread_rewards"
-	TypeEvtCollectSpreadRewards      = "collect_spread_rewards"
-	TypeEvtTotalCollectIncentives    = "total_collect_incentives"
-	TypeEvtCollectIncentives         = "collect_incentives"
-	TypeEvtCreateIncentive           = "create_incentive"
-	TypeEvtFungifyChargedPosition    = "fungify_charged_position"
-	TypeEvtMoveRewards               = "move_rewards"
-	TypeEvtCrossTick                 = "cross_tick"
-	TypeEvtTransferPositions         = "transfer_positions"
+	TypeEvtCreatePosition                 = "create_position"
+	TypeEvtWithdrawPosition               = "withdraw_position"
+	TypeEvtAddToPosition                  = "add_to_position"
+	TypeEvtTotalCollectSpreadRewards      = "total_collect_spread_rewards"
+	TypeEvtCollectSpreadRewards           = "collect_spread_rewards"
+	TypeEvtTotalCollectIncentives         = "total_collect_incentives"
+	TypeEvtCollectIncentives              = "collect_incentives"
+	TypeEvtCreateIncentive                = "create_incentive"
+	TypeEvtFungifyChargedPosition         = "fungify_charged_position"
+	TypeEvtMoveRewards                    = "move_rewards"
+	TypeEvtCrossTick                      = "cross_tick"
+	TypeEvtTransferPositions              = "transfer_positions"
+	TypeEvtSwapGasHint                    = "swap_gas_hint"
+	TypeEvtSweepRoundingRemainders        = "sweep_rounding_remainders"
+	TypeEvtSetClaimAllowance              = "set_claim_allowance"
+	TypeEvtRevokeClaimAllowance           = "revoke_claim_allowance"
+	TypeEvtWrapPosition                   = "wrap_position"
+	TypeEvtUnwrapPosition                 = "unwrap_position"
+	TypeEvtLockPositionForCollateral      = "lock_position_for_collateral"
+	TypeEvtUnlockPosition                 = "unlock_position"
+	TypeEvtEnableManagedPosition          = "enable_managed_position"
+	TypeEvtDisableManagedPosition         = "disable_managed_position"
+	TypeEvtRebalanceManagedPosition       = "rebalance_managed_position"
+	TypeEvtSetPositionMigrationWindow     = "set_position_migration_window"
+	TypeEvtMigratePositionToSuccessorPool = "migrate_position_to_successor_pool"
+	TypeEvtPlaceLimitOrder                = "place_limit_order"
+	TypeEvtFillLimitOrder                 = "fill_limit_order"
+	TypeEvtClaimLimitOrder                = "claim_limit_order"
 
 	AttributeValueCategory                                         = ModuleName
 	AttributeKeyPositionId                                         = "position_id"
@@ -48,4 +64,20 @@ const (
 	AttributeKeySpreadRewardGrowthOppositeDirectionOfLastTraversal = "spread_reward_growth"
 	AttributeKeyUptimeGrowthOppositeDirectionOfLastTraversal       = "uptime_growth"
 	AttributeNewOwner                                              = "new_owner"
+	AttributeKeyTicksCrossed                                       = "ticks_crossed"
+	AttributeKeySuggestedGasAdjustment                             = "suggested_gas_adjustment"
+	AttributeKeyGrantee                                            = "grantee"
+	AttributeKeyExpiration                                         = "expiration"
+	AttributeKeyDenom                                              = "denom"
+	AttributeKeyLienholder                                         = "lienholder"
+	AttributeKeyRedirectRewards                                    = "redirect_rewards"
+	AttributeKeyRangeWidth                                         = "range_width"
+	AttributeKeyMaxRebalanceFee                                    = "max_rebalance_fee"
+	AttributeKeyRebalancer                                         = "rebalancer"
+	AttributeKeyRebalanceFee                                       = "rebalance_fee"
+	AttributeKeyFromPoolId                                         = "from_pool_id"
+	AttributeKeyToPoolId                                           = "to_pool_id"
+	AttributeKeyEndTime                                            = "end_time"
+	AttributeKeyTokenIn                                            = "token_in"
+	AttributeKeyClaimable                                          = "claimable"
 )
@@ -7,3 +7,11 @@ func ValidateTicks(i interface{}) error {
 func ValidateBalancerSharesDiscount(i interface{}) error {
 	return validateBalancerSharesDiscount(i)
 }
+
+func ValidateMaxIncentiveRecords(i interface{}) error {
+	return validateMaxIncentiveRecords(i)
+}
+
+func ValidateMaxPositionsPerWithdrawAll(i interface{}) error {
+	return validateMaxPositionsPerWithdrawAll(i)
+}
@@ -1,6 +1,10 @@
 package genesis
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 )
 
@@ -25,5 +29,86 @@ func (gs GenesisState) Validate() error {
 	if gs.NextIncentiveRecordId == 0 {
 		return types.InvalidNextIncentiveRecordIdError{NextIncentiveRecordId: gs.NextIncentiveRecordId}
 	}
+	for _, poolData := range gs.PoolData {
+		for _, remainder := range poolData.RoundingRemainders {
+			if err := sdk.ValidateDenom(remainder.Denom); err != nil {
+				return fmt.Errorf("invalid rounding remainder denom (%s): %w", remainder.Denom, err)
+			}
+			if remainder.Amount.IsNil() || remainder.Amount.IsZero() {
+				return fmt.Errorf("rounding remainder of denom (%s) has invalid amount (%s)", remainder.Denom, remainder.Amount)
+			}
+		}
+		if err := poolData.SwapVolume.Validate(); err != nil {
+			return fmt.Errorf("invalid swap volume: %w", err)
+		}
+		if err := poolData.SpreadRewardsCollected.Validate(); err != nil {
+			return fmt.Errorf("invalid spread rewards collected: %w", err)
+		}
+	}
+	for _, allowance := range gs.ClaimAllowances {
+		if _, err := sdk.AccAddressFromBech32(allowance.Owner); err != nil {
+			return fmt.Errorf("invalid claim allowance owner address (%s): %w", allowance.Owner, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(allowance.Grantee); err != nil {
+			return fmt.Errorf("invalid claim allowance grantee address (%s): %w", allowance.Grantee, err)
+		}
+	}
+	seenLienPositionIds := make(map[uint64]struct{}, len(gs.PositionLiens))
+	for _, lien := range gs.PositionLiens {
+		if _, err := sdk.AccAddressFromBech32(lien.Lienholder); err != nil {
+			return fmt.Errorf("invalid position lien lienholder address (%s): %w", lien.Lienholder, err)
+		}
+		if _, ok := seenLienPositionIds[lien.PositionId]; ok {
+			return fmt.Errorf("duplicate lien on position id (%d)", lien.PositionId)
+		}
+		seenLienPositionIds[lien.PositionId] = struct{}{}
+	}
+	seenManagedPositionIds := make(map[uint64]struct{}, len(gs.ManagedPositions))
+	for _, managedPosition := range gs.ManagedPositions {
+		if _, err := sdk.AccAddressFromBech32(managedPosition.Owner); err != nil {
+			return fmt.Errorf("invalid managed position owner address (%s): %w", managedPosition.Owner, err)
+		}
+		if managedPosition.RangeWidth == 0 {
+			return fmt.Errorf("managed position id (%d) has zero range width", managedPosition.PositionId)
+		}
+		if managedPosition.MaxRebalanceFee.IsNil() || managedPosition.MaxRebalanceFee.IsNegative() {
+			return fmt.Errorf("managed position id (%d) has invalid max rebalance fee (%s)", managedPosition.PositionId, managedPosition.MaxRebalanceFee)
+		}
+		if _, ok := seenManagedPositionIds[managedPosition.PositionId]; ok {
+			return fmt.Errorf("duplicate managed position id (%d)", managedPosition.PositionId)
+		}
+		seenManagedPositionIds[managedPosition.PositionId] = struct{}{}
+	}
+	for _, entry := range gs.PositionHistory {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return fmt.Errorf("invalid position history address (%s): %w", entry.Address, err)
+		}
+		if entry.LiquidityDelta.IsNil() || entry.Liquidity.IsNil() || entry.Liquidity.IsNegative() {
+			return fmt.Errorf("position id (%d) has invalid history entry liquidity (%s)", entry.PositionId, entry.Liquidity)
+		}
+	}
+	seenMigrationWindowPoolIds := make(map[uint64]struct{}, len(gs.PositionMigrationWindows))
+	for _, window := range gs.PositionMigrationWindows {
+		if window.FromPoolId == window.ToPoolId {
+			return fmt.Errorf("position migration window of pool id (%d) has the same successor pool", window.FromPoolId)
+		}
+		if _, ok := seenMigrationWindowPoolIds[window.FromPoolId]; ok {
+			return fmt.Errorf("duplicate position migration window of pool id (%d)", window.FromPoolId)
+		}
+		seenMigrationWindowPoolIds[window.FromPoolId] = struct{}{}
+	}
+	seenLimitOrderPositionIds := make(map[uint64]struct{}, len(gs.LimitOrders))
+	for _, limitOrder := range gs.LimitOrders {
+		if _, ok := seenLimitOrderPositionIds[limitOrder.PositionId]; ok {
+			return fmt.Errorf("duplicate limit order of position id (%d)", limitOrder.PositionId)
+		}
+		seenLimitOrderPositionIds[limitOrder.PositionId] = struct{}{}
+		if limitOrder.LowerTick >= limitOrder.UpperTick {
+			return fmt.Errorf("limit order of position id (%d) has invalid ticks (%d, %d)", limitOrder.PositionId, limitOrder.LowerTick, limitOrder.UpperTick)
+		}
+		if !limitOrder.Filled && !limitOrder.Claimable.Empty() {
+			return fmt.Errorf("open limit order of position id (%d) has claimable amount (%s)", limitOrder.PositionId, limitOrder.Claimable)
+		}
+	}
 	return nil
 }
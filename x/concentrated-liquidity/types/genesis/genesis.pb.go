@@ -7,7 +7,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types2 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	accum "github.com/osmosis-labs/osmosis/osmoutils/accum"
@@ -105,6 +106,13 @@ type PoolData struct {
 	IncentivesAccumulators  []AccumObject `protobuf:"bytes,4,rep,name=incentives_accumulators,json=incentivesAccumulators,proto3" json:"incentives_accumulators" yaml:"incentives_accumulator"`
 	// incentive records to be set
 	IncentiveRecords []types1.IncentiveRecord `protobuf:"bytes,5,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records"`
+	// cumulative rounding remainders of the pool, which may be negative.
+	RoundingRemainders github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=rounding_remainders,json=roundingRemainders,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rounding_remainders"`
+	// cumulative amount of each token swapped into the pool, spread rewards
+	// included.
+	SwapVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=swap_volume,json=swapVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_volume"`
+	// cumulative spread rewards charged by swaps in the pool.
+	SpreadRewardsCollected github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=spread_rewards_collected,json=spreadRewardsCollected,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spread_rewards_collected"`
 }
 
 func (m *PoolData) Reset()         { *m = PoolData{} }
@@ -175,6 +183,27 @@ func (m *PoolData) GetIncentiveRecords() []types1.IncentiveRecord {
 	return nil
 }
 
+func (m *PoolData) GetRoundingRemainders() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RoundingRemainders
+	}
+	return nil
+}
+
+func (m *PoolData) GetSwapVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SwapVolume
+	}
+	return nil
+}
+
+func (m *PoolData) GetSpreadRewardsCollected() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpreadRewardsCollected
+	}
+	return nil
+}
+
 type PositionData struct {
 	Position                *model.Position `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
 	LockId                  uint64          `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
@@ -252,6 +281,18 @@ type GenesisState struct {
 	PositionData          []PositionData `protobuf:"bytes,3,rep,name=position_data,json=positionData,proto3" json:"position_data"`
 	NextPositionId        uint64         `protobuf:"varint,4,opt,name=next_position_id,json=nextPositionId,proto3" json:"next_position_id,omitempty" yaml:"next_position_id"`
 	NextIncentiveRecordId uint64         `protobuf:"varint,5,opt,name=next_incentive_record_id,json=nextIncentiveRecordId,proto3" json:"next_incentive_record_id,omitempty" yaml:"next_incentive_record_id"`
+	// claim allowances granted by position owners.
+	ClaimAllowances []types1.ClaimAllowance `protobuf:"bytes,6,rep,name=claim_allowances,json=claimAllowances,proto3" json:"claim_allowances"`
+	// liens on positions locked as collateral.
+	PositionLiens []types1.PositionLien `protobuf:"bytes,7,rep,name=position_liens,json=positionLiens,proto3" json:"position_liens"`
+	// positions opted into automatic rebalancing.
+	ManagedPositions []types1.ManagedPosition `protobuf:"bytes,8,rep,name=managed_positions,json=managedPositions,proto3" json:"managed_positions"`
+	// lifecycle events of positions within the retention period.
+	PositionHistory []types1.PositionHistoryEntry `protobuf:"bytes,9,rep,name=position_history,json=positionHistory,proto3" json:"position_history"`
+	// open windows for migrating positions to successor pools.
+	PositionMigrationWindows []types1.PositionMigrationWindow `protobuf:"bytes,10,rep,name=position_migration_windows,json=positionMigrationWindows,proto3" json:"position_migration_windows"`
+	// open and unclaimed filled limit orders.
+	LimitOrders []types1.LimitOrder `protobuf:"bytes,11,rep,name=limit_orders,json=limitOrders,proto3" json:"limit_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -322,6 +363,48 @@ func (m *GenesisState) GetNextIncentiveRecordId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetClaimAllowances() []types1.ClaimAllowance {
+	if m != nil {
+		return m.ClaimAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionLiens() []types1.PositionLien {
+	if m != nil {
+		return m.PositionLiens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetManagedPositions() []types1.ManagedPosition {
+	if m != nil {
+		return m.ManagedPositions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionHistory() []types1.PositionHistoryEntry {
+	if m != nil {
+		return m.PositionHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPositionMigrationWindows() []types1.PositionMigrationWindow {
+	if m != nil {
+		return m.PositionMigrationWindows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLimitOrders() []types1.LimitOrder {
+	if m != nil {
+		return m.LimitOrders
+	}
+	return nil
+}
+
 type AccumObject struct {
 	// Accumulator's name (pulled from AccumulatorContent)
 	Name         string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -388,62 +471,82 @@ func init() {
 }
 
 var fileDescriptor_4cdf50d18c43a7c5 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0x23, 0x35,
-	0x18, 0xee, 0x34, 0x69, 0x69, 0x9d, 0xec, 0xd2, 0xb5, 0xba, 0x74, 0xb6, 0x68, 0x93, 0xe0, 0x55,
-	0xa5, 0x02, 0xea, 0x8c, 0x9a, 0xae, 0x38, 0x20, 0x2e, 0x9d, 0xe5, 0x43, 0x01, 0x09, 0x2a, 0xb3,
-	0x5c, 0xf8, 0x0a, 0xce, 0xd8, 0x0d, 0x66, 0x67, 0xc6, 0xc3, 0xd8, 0x29, 0xcd, 0x95, 0x5f, 0x80,
-	0x38, 0xf1, 0x23, 0x38, 0x22, 0x71, 0xe6, 0xb6, 0x42, 0x1c, 0xf6, 0xc8, 0x29, 0x42, 0xed, 0x3f,
-	0xc8, 0x2f, 0x40, 0xe3, 0x8f, 0x64, 0x52, 0xba, 0x90, 0x72, 0xb3, 0xf3, 0xbc, 0xcf, 0xf3, 0x3e,
-	0xf6, 0xfb, 0xbe, 0x9e, 0x80, 0x23, 0x21, 0x53, 0x21, 0xb9, 0x0c, 0x63, 0x91, 0xc5, 0x2c, 0x53,
-	0x05, 0x51, 0x8c, 0x26, 0xfc, 0xdb, 0x11, 0xa7, 0x5c, 0x8d, 0xc3, 0xb3, 0xc3, 0x01, 0x53, 0xe4,
-	0x30, 0x1c, 0xb2, 0x8c, 0x49, 0x2e, 0x83, 0xbc, 0x10, 0x4a, 0xc0, 0x3d, 0x4b, 0x0a, 0xae, 0x25,
-	0x05, 0x96, 0xb4, 0xbb, 0x3d, 0x14, 0x43, 0xa1, 0x19, 0x61, 0xb9, 0x32, 0xe4, 0xdd, 0x7b, 0xb1,
-	0x66, 0xf7, 0x0d, 0x60, 0x36, 0x16, 0x6a, 0x99, 0x5d, 0x38, 0x20, 0x92, 0xcd, 0x52, 0xc7, 0x82,
-	0x67, 0x8e, 0x3a, 0x14, 0x62, 0x98, 0xb0, 0x50, 0xef, 0x06, 0xa3, 0xd3, 0x90, 0x64, 0x63, 0x0b,
-	0xbd, 0xe2, 0xce, 0x41, 0xe2, 0x78, 0x94, 0xce, 0xc8, 0x7a, 0x67, 0x43, 0x5e, 0xfb, 0xf7, 0xa3,
-	0xe6, 0xa4, 0x20, 0xa9, 0x73, 0xf2, 0x70, 0xb9, 0x6b, 0xc9, 0x85, 0xe4, 0x8a, 0x8b, 0xec, 0x66,
-	0x2c, 0xc5, 0xe3, 0x27, 0xbd, 0xec, 0xd4, 0x5d, 0xc8, 0x5b, 0xcb, 0xb1, 0xb8, 0x06, 0xf9, 0x19,
-	0xeb, 0x17, 0x2c, 0x16, 0x05, 0x35, 0x6c, 0xf4, 0x87, 0x07, 0x36, 0xde, 0x1d, 0x25, 0xc9, 0x63,
-	0x1e, 0x3f, 0x81, 0xaf, 0x83, 0x17, 0x72, 0x21, 0x92, 0x3e, 0xa7, 0xbe, 0xd7, 0xf1, 0xf6, 0xeb,
-	0x11, 0x9c, 0x4e, 0xda, 0xb7, 0xc7, 0x24, 0x4d, 0xde, 0x44, 0x16, 0x40, 0x78, 0xbd, 0x5c, 0xf5,
-	0x28, 0x7c, 0x08, 0x40, 0xe9, 0xa4, 0xcf, 0x33, 0xca, 0xce, 0xfd, 0xd5, 0x8e, 0xb7, 0x5f, 0x8b,
-	0xee, 0x4e, 0x27, 0xed, 0x3b, 0x26, 0x7e, 0x8e, 0x21, 0xbc, 0x69, 0x2c, 0x53, 0x76, 0x0e, 0xbf,
-	0x00, 0x75, 0x9e, 0x9d, 0x0a, 0xbf, 0xd6, 0xf1, 0xf6, 0x1b, 0xdd, 0x30, 0x58, 0xaa, 0x15, 0x82,
-	0xc7, 0xf6, 0xc8, 0x91, 0xff, 0x74, 0xd2, 0x5e, 0x99, 0x4e, 0xda, 0x5b, 0x0b, 0x49, 0x4e, 0x05,
-	0xc2, 0x5a, 0x16, 0xfd, 0x5a, 0x07, 0x1b, 0x27, 0x42, 0x24, 0x6f, 0x13, 0x45, 0xe0, 0x11, 0xa8,
-	0x97, 0x5e, 0xf5, 0x59, 0x1a, 0xdd, 0xed, 0xc0, 0x94, 0x3f, 0x70, 0xe5, 0x0f, 0x8e, 0xb3, 0x71,
-	0xb4, 0xf9, 0xfb, 0x2f, 0x07, 0x6b, 0x25, 0xa3, 0x87, 0x75, 0x30, 0xfc, 0x0c, 0xac, 0x95, 0xaa,
-	0xd2, 0x5f, 0xed, 0xd4, 0x6e, 0xe0, 0xd0, 0xdd, 0x61, 0xb4, 0x6d, 0x1d, 0x36, 0xe7, 0x0e, 0x25,
-	0xc2, 0x46, 0x13, 0xfe, 0xe4, 0x81, 0x7b, 0x32, 0x2f, 0x18, 0xa1, 0xfd, 0x82, 0x7d, 0x47, 0x0a,
-	0xda, 0xd7, 0x1d, 0x36, 0x4a, 0x88, 0x12, 0x85, 0xbd, 0x93, 0xee, 0x92, 0x19, 0x8f, 0x4b, 0xe6,
-	0x47, 0x83, 0x6f, 0x58, 0xac, 0xa2, 0x7d, 0x9b, 0xb4, 0x63, 0x92, 0x3e, 0x37, 0x05, 0xc2, 0x3b,
-	0x06, 0xc3, 0x1a, 0x3a, 0x9e, 0x23, 0xf0, 0x47, 0x0f, 0xec, 0xcc, 0x7a, 0x44, 0x56, 0x49, 0xd2,
-	0xaf, 0xeb, 0xab, 0xf8, 0x3f, 0xc6, 0xf6, 0xac, 0xb1, 0xfb, 0xc6, 0xd8, 0xf5, 0x09, 0x10, 0x7e,
-	0x69, 0x0e, 0x54, 0x3c, 0x49, 0xc8, 0xc1, 0x9d, 0xab, 0x7d, 0x2b, 0xfd, 0x35, 0xed, 0xe6, 0x8d,
-	0x25, 0xdd, 0xf4, 0x1c, 0x1f, 0x6b, 0x7a, 0x54, 0x2f, 0x1d, 0xe1, 0x2d, 0xbe, 0xf8, 0xb3, 0x44,
-	0xbf, 0xad, 0x82, 0xe6, 0x89, 0x9d, 0x47, 0xdd, 0x3d, 0x1f, 0x80, 0x0d, 0x37, 0x9f, 0xb6, 0x83,
-	0x96, 0xed, 0x05, 0x27, 0x83, 0x67, 0x02, 0xe5, 0x64, 0x25, 0xa2, 0xec, 0x55, 0xaa, 0x27, 0x65,
-	0x61, 0xb2, 0x2c, 0x80, 0xf0, 0x7a, 0xb9, 0xea, 0x51, 0xf8, 0x15, 0xd8, 0xbd, 0xa6, 0x82, 0xf6,
-	0xfc, 0xb6, 0x4b, 0xee, 0xcf, 0xbc, 0x98, 0x37, 0xca, 0xe5, 0x5e, 0x38, 0xe5, 0x3f, 0x8b, 0x6d,
-	0x60, 0xf8, 0x09, 0xd8, 0x1e, 0xe5, 0x8a, 0xa7, 0x6c, 0x41, 0xda, 0x15, 0x7a, 0x29, 0x6d, 0x68,
-	0x04, 0x2a, 0xaa, 0x12, 0xfd, 0x5c, 0x03, 0xcd, 0xf7, 0xcc, 0x53, 0xff, 0xb1, 0x22, 0x8a, 0xc1,
-	0x47, 0x60, 0xdd, 0xbc, 0x8b, 0xf6, 0x06, 0xf7, 0xfe, 0xe3, 0x06, 0x4f, 0x74, 0xb0, 0xcd, 0x60,
-	0xa9, 0x10, 0x83, 0x4d, 0xfd, 0xf8, 0x50, 0xa2, 0xc8, 0x0d, 0xa7, 0xd2, 0x3d, 0x05, 0x56, 0x71,
-	0x23, 0x77, 0x4f, 0xc3, 0x97, 0xe0, 0x96, 0xab, 0x8d, 0xd1, 0xad, 0x69, 0xdd, 0xa3, 0x1b, 0x56,
-	0xb8, 0xa2, 0xdd, 0xcc, 0xab, 0xcd, 0xf3, 0x0e, 0xd8, 0xca, 0xd8, 0xb9, 0xea, 0xcf, 0x92, 0x70,
-	0xea, 0xd7, 0x75, 0xe1, 0x5f, 0x9e, 0x4e, 0xda, 0x3b, 0xa6, 0xf0, 0x57, 0x23, 0x10, 0xbe, 0x5d,
-	0xfe, 0xe4, 0xc4, 0x7b, 0x14, 0x7e, 0x0e, 0x7c, 0x1d, 0x74, 0x75, 0x08, 0x4a, 0xb9, 0x35, 0x2d,
-	0xf7, 0x60, 0x3a, 0x69, 0xb7, 0x2b, 0x72, 0xd7, 0x44, 0x22, 0x7c, 0xb7, 0x84, 0xae, 0x0c, 0x42,
-	0x8f, 0xa2, 0xef, 0x3d, 0xd0, 0xa8, 0x0c, 0x2b, 0x7c, 0x00, 0xea, 0x19, 0x49, 0x99, 0xae, 0xd5,
-	0x66, 0xf4, 0xe2, 0x74, 0xd2, 0x6e, 0x58, 0x65, 0x92, 0x32, 0x84, 0x35, 0x08, 0x3f, 0x04, 0xb7,
-	0x4c, 0xcf, 0xc4, 0x22, 0x53, 0x2c, 0x53, 0xba, 0x9f, 0x1b, 0xdd, 0x57, 0x9f, 0xd3, 0x33, 0x95,
-	0x71, 0x7e, 0x64, 0x08, 0xb8, 0xa9, 0x23, 0xec, 0x2e, 0xa2, 0x4f, 0x2f, 0x5a, 0xde, 0xb3, 0x8b,
-	0x96, 0xf7, 0xd7, 0x45, 0xcb, 0xfb, 0xe1, 0xb2, 0xb5, 0xf2, 0xec, 0xb2, 0xb5, 0xf2, 0xe7, 0x65,
-	0x6b, 0xe5, 0xd3, 0xf7, 0x87, 0x5c, 0x7d, 0x3d, 0x1a, 0x04, 0xb1, 0x48, 0x43, 0x2b, 0x7e, 0x90,
-	0x90, 0x81, 0x74, 0x9b, 0xf0, 0xac, 0x7b, 0x18, 0x9e, 0x2f, 0x7c, 0xf6, 0x0e, 0xe6, 0xdf, 0x3d,
-	0x35, 0xce, 0x99, 0x74, 0x7f, 0x3c, 0x06, 0xeb, 0xfa, 0xd1, 0x3f, 0xfa, 0x3b, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x6e, 0x5f, 0x4a, 0xb0, 0x08, 0x00, 0x00,
+	// 1199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xad, 0x57, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0xee, 0x36, 0x9b, 0x64, 0x33, 0xbb, 0x6d, 0x53, 0x93, 0x36, 0x4e, 0xa0, 0x49, 0x71, 0x15,
+	0x29, 0x50, 0x65, 0x4d, 0x92, 0x02, 0x82, 0x56, 0x95, 0xb2, 0x69, 0x81, 0x40, 0x4b, 0x23, 0x53,
+	0x40, 0x2a, 0x3f, 0x66, 0xd6, 0x76, 0xb6, 0x43, 0x6d, 0x8f, 0xd9, 0xf1, 0x26, 0xd9, 0x5b, 0x24,
+	0xee, 0x11, 0x57, 0x3c, 0x03, 0x57, 0x08, 0xf1, 0x02, 0xdc, 0x55, 0x88, 0x8b, 0x5e, 0x72, 0x55,
+	0x10, 0xbc, 0x01, 0x4f, 0xc0, 0x99, 0x3f, 0xaf, 0xbd, 0xdd, 0x82, 0x8d, 0xb8, 0xb0, 0xd6, 0x9e,
+	0x73, 0xbe, 0xf3, 0x9d, 0x39, 0x73, 0xe6, 0x9b, 0x59, 0xb4, 0x4d, 0x59, 0x44, 0x19, 0x61, 0xb6,
+	0x47, 0x63, 0x2f, 0x88, 0xd3, 0x3e, 0x4e, 0x03, 0x3f, 0x24, 0x5f, 0x0c, 0x88, 0x4f, 0xd2, 0xa1,
+	0x7d, 0xb8, 0xd9, 0x0d, 0x52, 0xbc, 0x69, 0xf7, 0x82, 0x38, 0x00, 0xaf, 0x76, 0xd2, 0xa7, 0x29,
+	0x35, 0xd6, 0x14, 0xa8, 0x3d, 0x11, 0xd4, 0x56, 0xa0, 0xe5, 0x85, 0x1e, 0xed, 0x51, 0x81, 0xb0,
+	0xf9, 0x9b, 0x04, 0x2f, 0x2f, 0x79, 0x02, 0xed, 0x4a, 0x83, 0xfc, 0x50, 0xa6, 0x15, 0xf9, 0x65,
+	0x77, 0x31, 0x0b, 0x32, 0x6a, 0x8f, 0x92, 0x58, 0x43, 0x7b, 0x94, 0xf6, 0xc2, 0xc0, 0x16, 0x5f,
+	0xdd, 0xc1, 0x81, 0x8d, 0xe3, 0xa1, 0x32, 0x3d, 0xaf, 0xe7, 0x81, 0x3d, 0x6f, 0x10, 0x65, 0x60,
+	0xf1, 0xa5, 0x5c, 0x5e, 0xfc, 0xe7, 0xa9, 0x26, 0xb8, 0x8f, 0x23, 0x9d, 0xc9, 0x95, 0x72, 0x65,
+	0x49, 0xc0, 0x27, 0x25, 0x34, 0xae, 0x86, 0x4a, 0x89, 0xf7, 0x60, 0x2f, 0x3e, 0xd0, 0x05, 0xb9,
+	0x56, 0x0e, 0x45, 0x84, 0x91, 0x1c, 0x06, 0x6e, 0x3f, 0xf0, 0x68, 0xdf, 0x57, 0xe8, 0xab, 0xe5,
+	0xd0, 0x5e, 0x88, 0x49, 0xe4, 0xe2, 0x30, 0xa4, 0x47, 0x18, 0xfc, 0x14, 0xf8, 0xb5, 0x6a, 0xd3,
+	0x74, 0x43, 0x12, 0xc4, 0xd5, 0xb2, 0x8e, 0x70, 0x8c, 0x7b, 0x81, 0xef, 0x8e, 0x55, 0xea, 0x5a,
+	0x45, 0xe2, 0xfb, 0x84, 0xa5, 0xb4, 0xaf, 0x17, 0xfb, 0x7a, 0x45, 0x74, 0x44, 0x7a, 0xe0, 0x32,
+	0x62, 0x7f, 0xb5, 0x1c, 0x3e, 0x24, 0x11, 0x49, 0x5d, 0x28, 0x75, 0xd0, 0x97, 0x40, 0xeb, 0x97,
+	0x1a, 0x6a, 0xbc, 0x31, 0x08, 0xc3, 0xbb, 0xb0, 0x82, 0xc6, 0x65, 0x34, 0x9b, 0x50, 0x1a, 0xba,
+	0xc4, 0x37, 0x6b, 0x17, 0x6b, 0xeb, 0xf5, 0x8e, 0xf1, 0xd7, 0xe3, 0xd5, 0xd3, 0x43, 0x1c, 0x85,
+	0xaf, 0x5b, 0xca, 0x60, 0x39, 0x33, 0xfc, 0x6d, 0xcf, 0x37, 0xae, 0x20, 0xc4, 0x97, 0xdd, 0x25,
+	0xb1, 0x1f, 0x1c, 0x9b, 0x27, 0xc1, 0x7f, 0xaa, 0x73, 0x0e, 0xfc, 0xcf, 0x4a, 0xff, 0x91, 0xcd,
+	0x72, 0xe6, 0x64, 0x7f, 0xc0, 0xbb, 0xf1, 0x09, 0xaa, 0x13, 0x68, 0x14, 0x73, 0x0a, 0xfc, 0x9b,
+	0x5b, 0x76, 0xbb, 0xd4, 0xbe, 0x6b, 0xdf, 0x55, 0xfd, 0xd5, 0x31, 0x1f, 0x3e, 0x5e, 0x3d, 0x01,
+	0x24, 0xf3, 0x05, 0x92, 0x03, 0x6a, 0x39, 0x22, 0xac, 0xf5, 0xc3, 0x2c, 0x6a, 0xec, 0x43, 0x7e,
+	0x37, 0x70, 0x8a, 0x8d, 0x6d, 0x54, 0xe7, 0xb9, 0x8a, 0xb9, 0x34, 0xb7, 0x16, 0xda, 0x72, 0xaf,
+	0xb5, 0xf5, 0x5e, 0x6b, 0xef, 0xc4, 0xc3, 0xce, 0xdc, 0xcf, 0x3f, 0x6e, 0x4c, 0x73, 0xc4, 0x9e,
+	0x23, 0x9c, 0x8d, 0x8f, 0xd0, 0x34, 0x8f, 0xca, 0x60, 0x46, 0x53, 0x15, 0x32, 0xd4, 0x35, 0xec,
+	0x2c, 0xa8, 0x0c, 0x5b, 0xa3, 0x0c, 0x99, 0xe5, 0xc8, 0x98, 0xc6, 0xb7, 0x35, 0xb4, 0xc4, 0x92,
+	0x7e, 0x80, 0x7d, 0x68, 0xf9, 0x23, 0xdc, 0xf7, 0x5d, 0xb1, 0x9d, 0x07, 0x21, 0x86, 0x5e, 0x50,
+	0x35, 0xd9, 0x2a, 0xc9, 0xb8, 0xc3, 0x91, 0x77, 0xba, 0x9f, 0x07, 0x5e, 0xda, 0x59, 0x57, 0xa4,
+	0x17, 0x25, 0xe9, 0x53, 0x29, 0x2c, 0x67, 0x51, 0xda, 0x1c, 0x61, 0xda, 0x19, 0x59, 0x8c, 0x6f,
+	0x6a, 0x68, 0x31, 0xdb, 0x90, 0x2c, 0x0f, 0x62, 0x66, 0x5d, 0x94, 0xe2, 0xbf, 0x24, 0xb6, 0xa6,
+	0x12, 0xbb, 0x20, 0x13, 0x9b, 0x4c, 0x60, 0x39, 0xe7, 0x47, 0x86, 0x5c, 0x4e, 0xcc, 0x20, 0xe8,
+	0xec, 0xb8, 0x48, 0x30, 0x73, 0x5a, 0x64, 0xf3, 0x4a, 0xc9, 0x6c, 0xf6, 0x34, 0xde, 0x11, 0xf0,
+	0x4e, 0x9d, 0x67, 0xe4, 0xcc, 0x93, 0xe2, 0x30, 0x33, 0xbe, 0xac, 0xa1, 0x67, 0xfa, 0x74, 0x10,
+	0xfb, 0x24, 0xee, 0x01, 0x55, 0x84, 0x79, 0xef, 0xc2, 0xdc, 0x67, 0x04, 0xdb, 0x73, 0x6d, 0x25,
+	0xeb, 0x5c, 0xc8, 0xb3, 0xd8, 0x37, 0x02, 0x6f, 0x17, 0xb4, 0xbc, 0xb3, 0xcd, 0x63, 0x7e, 0xf7,
+	0xdb, 0xea, 0xe5, 0x1e, 0x49, 0xef, 0x0f, 0xba, 0xe0, 0x1b, 0xa9, 0x63, 0x40, 0xfd, 0x6c, 0x30,
+	0xff, 0x81, 0x9d, 0x0e, 0x93, 0x80, 0x69, 0x0c, 0x73, 0x0c, 0xcd, 0xe6, 0x64, 0x64, 0x46, 0x88,
+	0x9a, 0xec, 0x08, 0x27, 0xee, 0x21, 0x0d, 0x07, 0x51, 0x60, 0xce, 0x0a, 0xee, 0xa5, 0x89, 0xdc,
+	0x82, 0xf8, 0x25, 0x45, 0xbc, 0x5e, 0x82, 0x58, 0xb2, 0x22, 0x1e, 0xff, 0x03, 0x11, 0xde, 0xf8,
+	0xaa, 0x86, 0xcc, 0x42, 0xab, 0x30, 0xd7, 0xa3, 0x61, 0x08, 0xeb, 0x16, 0xf8, 0x66, 0xe3, 0xff,
+	0xe7, 0x3e, 0x9f, 0xef, 0x3d, 0xb6, 0xab, 0xa9, 0xac, 0x9f, 0x4e, 0xa2, 0xd6, 0xbe, 0x52, 0x36,
+	0xb1, 0x71, 0xdf, 0x41, 0x0d, 0xad, 0x74, 0x6a, 0xf3, 0x96, 0xdd, 0x86, 0x3a, 0x8c, 0x93, 0x05,
+	0xe0, 0xa2, 0x16, 0x52, 0x2e, 0x13, 0xbe, 0x10, 0xa9, 0x82, 0xa8, 0x29, 0x03, 0x88, 0x1a, 0x7f,
+	0x03, 0x51, 0xfb, 0x0c, 0x2d, 0x4f, 0xd8, 0x3c, 0xaa, 0xf5, 0xd4, 0x06, 0xbd, 0x90, 0xe5, 0x22,
+	0xcf, 0x62, 0xcd, 0x5d, 0x68, 0xb0, 0x27, 0xf7, 0x99, 0x34, 0x1b, 0xef, 0xa3, 0x85, 0x41, 0x92,
+	0x92, 0x28, 0x28, 0x84, 0xd6, 0x7b, 0xac, 0x54, 0x6c, 0x43, 0x06, 0xc8, 0x45, 0x65, 0xd6, 0xf7,
+	0x0d, 0xd4, 0x7a, 0x53, 0x5e, 0x69, 0xde, 0x4b, 0xa1, 0x36, 0xc6, 0x2e, 0x9a, 0x91, 0xe7, 0xbf,
+	0xaa, 0xe0, 0xda, 0xbf, 0x54, 0x70, 0x5f, 0x38, 0x2b, 0x06, 0x05, 0x35, 0x1c, 0x34, 0x27, 0x74,
+	0xdf, 0x87, 0x55, 0xa9, 0x28, 0x88, 0x5a, 0x85, 0x55, 0xc4, 0x46, 0xa2, 0x55, 0xf9, 0x53, 0x74,
+	0x2a, 0x3b, 0xc6, 0x44, 0xdc, 0x29, 0x11, 0x77, 0xbb, 0xe2, 0x0a, 0xe7, 0x62, 0xb7, 0x92, 0x7c,
+	0xf3, 0xdc, 0x44, 0xf3, 0x71, 0x70, 0x9c, 0x66, 0xe7, 0x33, 0x5f, 0xf8, 0xba, 0x58, 0xf8, 0x67,
+	0x61, 0xe1, 0x17, 0xe5, 0xc2, 0x8f, 0x7b, 0x58, 0xce, 0x69, 0x3e, 0xa4, 0x83, 0x43, 0x27, 0x7c,
+	0x8c, 0x4c, 0xe1, 0x34, 0xae, 0x3f, 0x3c, 0xdc, 0xb4, 0x08, 0x77, 0x09, 0xc2, 0xad, 0xe6, 0xc2,
+	0x4d, 0xf0, 0xb4, 0x9c, 0x73, 0xdc, 0x34, 0xa6, 0x41, 0x10, 0xfd, 0x00, 0xcd, 0x8f, 0xdd, 0x5f,
+	0xb4, 0xd2, 0xbc, 0x5c, 0xb2, 0x0e, 0xbb, 0x1c, 0xbe, 0xa3, 0xd1, 0xaa, 0x12, 0x67, 0xbc, 0xc2,
+	0x28, 0x83, 0x7e, 0x3e, 0x5d, 0xb8, 0xea, 0x30, 0xa5, 0x29, 0x55, 0xab, 0x7d, 0x0b, 0xb0, 0x8a,
+	0x23, 0x5b, 0x3d, 0x3e, 0x26, 0x24, 0x7a, 0xfc, 0x46, 0xc4, 0x94, 0x78, 0x94, 0x95, 0xe8, 0xdb,
+	0x12, 0xaf, 0xb9, 0xb4, 0x44, 0x47, 0xc5, 0x61, 0xae, 0x8e, 0xf3, 0xe3, 0xd7, 0x27, 0x73, 0x4e,
+	0x30, 0x5d, 0xad, 0x38, 0x9d, 0xb7, 0x24, 0xfa, 0x26, 0x38, 0x0e, 0x75, 0xe9, 0x92, 0xa2, 0x8d,
+	0x1f, 0x08, 0xcb, 0x4f, 0xde, 0xb7, 0xdc, 0x23, 0x90, 0x6a, 0x7a, 0xc4, 0x4c, 0x24, 0x88, 0xaf,
+	0x57, 0x24, 0xbe, 0xad, 0xe3, 0x7c, 0x28, 0xc2, 0x28, 0x6e, 0x33, 0x99, 0x6c, 0x66, 0xc6, 0x3d,
+	0xd4, 0xca, 0xdd, 0xd9, 0x98, 0xd9, 0x14, 0xac, 0x9b, 0x25, 0x59, 0x6f, 0x71, 0xe8, 0x1d, 0x8e,
+	0x54, 0x44, 0xcd, 0x30, 0x1b, 0x61, 0x16, 0x4c, 0xb0, 0x99, 0x3b, 0xab, 0x8d, 0x4b, 0xa8, 0x1e,
+	0x63, 0x38, 0x75, 0xb8, 0x5e, 0xcc, 0x75, 0xce, 0x40, 0x77, 0x37, 0x55, 0x77, 0xc3, 0x28, 0x5c,
+	0xb0, 0xf8, 0x8f, 0xf1, 0x2e, 0x3a, 0x25, 0x75, 0x0b, 0x98, 0x53, 0x60, 0x16, 0x9a, 0xda, 0xdc,
+	0x7a, 0xe1, 0x29, 0xba, 0x95, 0x3b, 0xcd, 0x77, 0x25, 0xc0, 0x69, 0x09, 0x0f, 0xf5, 0xd5, 0xf1,
+	0x1f, 0xfe, 0xb1, 0x52, 0x7b, 0x04, 0xcf, 0xef, 0xf0, 0x7c, 0xfd, 0xe7, 0xca, 0x89, 0x47, 0xf0,
+	0xfc, 0x0a, 0xcf, 0xbd, 0xb7, 0x73, 0xe7, 0x8a, 0x0a, 0xbe, 0x11, 0xe2, 0x2e, 0xd3, 0x1f, 0xf6,
+	0xe1, 0xd6, 0xa6, 0x7d, 0x5c, 0xb8, 0xf0, 0x6e, 0x8c, 0x6e, 0xbc, 0xe2, 0xdc, 0xd1, 0x7f, 0xf2,
+	0xba, 0x33, 0xe2, 0xce, 0xb7, 0xfd, 0x37, 0x2c, 0xaa, 0x6c, 0x6f, 0x1c, 0x0e, 0x00, 0x00,
 }
 
 func (m *FullTick) Marshal() (dAtA []byte, err error) {
@@ -509,6 +612,48 @@ func (m *PoolData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpreadRewardsCollected) > 0 {
+		for iNdEx := len(m.SpreadRewardsCollected) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpreadRewardsCollected[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SwapVolume) > 0 {
+		for iNdEx := len(m.SwapVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RoundingRemainders) > 0 {
+		for iNdEx := len(m.RoundingRemainders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundingRemainders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.IncentiveRecords) > 0 {
 		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -660,6 +805,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LimitOrders) > 0 {
+		for iNdEx := len(m.LimitOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PositionMigrationWindows) > 0 {
+		for iNdEx := len(m.PositionMigrationWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionMigrationWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PositionHistory) > 0 {
+		for iNdEx := len(m.PositionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ManagedPositions) > 0 {
+		for iNdEx := len(m.ManagedPositions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedPositions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PositionLiens) > 0 {
+		for iNdEx := len(m.PositionLiens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PositionLiens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ClaimAllowances) > 0 {
+		for iNdEx := len(m.ClaimAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NextIncentiveRecordId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextIncentiveRecordId))
 		i--
@@ -811,6 +1040,24 @@ func (m *PoolData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RoundingRemainders) > 0 {
+		for _, e := range m.RoundingRemainders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SwapVolume) > 0 {
+		for _, e := range m.SwapVolume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpreadRewardsCollected) > 0 {
+		for _, e := range m.SpreadRewardsCollected {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -864,6 +1111,42 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextIncentiveRecordId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextIncentiveRecordId))
 	}
+	if len(m.ClaimAllowances) > 0 {
+		for _, e := range m.ClaimAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionLiens) > 0 {
+		for _, e := range m.PositionLiens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ManagedPositions) > 0 {
+		for _, e := range m.ManagedPositions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionHistory) > 0 {
+		for _, e := range m.PositionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PositionMigrationWindows) > 0 {
+		for _, e := range m.PositionMigrationWindows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrders) > 0 {
+		for _, e := range m.LimitOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1211,6 +1494,108 @@ func (m *PoolData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundingRemainders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundingRemainders = append(m.RoundingRemainders, types2.DecCoin{})
+			if err := m.RoundingRemainders[len(m.RoundingRemainders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapVolume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapVolume = append(m.SwapVolume, types2.Coin{})
+			if err := m.SwapVolume[len(m.SwapVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpreadRewardsCollected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpreadRewardsCollected = append(m.SpreadRewardsCollected, types2.Coin{})
+			if err := m.SpreadRewardsCollected[len(m.SpreadRewardsCollected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1572,6 +1957,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimAllowances = append(m.ClaimAllowances, types1.ClaimAllowance{})
+			if err := m.ClaimAllowances[len(m.ClaimAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionLiens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionLiens = append(m.PositionLiens, types1.PositionLien{})
+			if err := m.PositionLiens[len(m.PositionLiens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedPositions = append(m.ManagedPositions, types1.ManagedPosition{})
+			if err := m.ManagedPositions[len(m.ManagedPositions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionHistory = append(m.PositionHistory, types1.PositionHistoryEntry{})
+			if err := m.PositionHistory[len(m.PositionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionMigrationWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PositionMigrationWindows = append(m.PositionMigrationWindows, types1.PositionMigrationWindow{})
+			if err := m.PositionMigrationWindows[len(m.PositionMigrationWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrders = append(m.LimitOrders, types1.LimitOrder{})
+			if err := m.LimitOrders[len(m.LimitOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
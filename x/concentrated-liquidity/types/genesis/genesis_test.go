@@ -3,6 +3,7 @@ package genesis_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
@@ -50,6 +51,59 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			exepectedError: true,
 		},
+		{
+			name: "invalid claim allowance grantee",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              genesis.DefaultGenesis().PoolData,
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+				ClaimAllowances: []types.ClaimAllowance{
+					{Owner: sdk.AccAddress([]byte("owner")).String(), Grantee: "invalid"},
+				},
+			},
+			exepectedError: true,
+		},
+		{
+			name: "negative rounding remainder",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{RoundingRemainders: sdk.DecCoins{{Denom: "foo", Amount: sdk.NewDecWithPrec(-1, 18)}}}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: false,
+		},
+		{
+			name: "zero rounding remainder",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{RoundingRemainders: sdk.DecCoins{{Denom: "foo", Amount: sdk.ZeroDec()}}}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: true,
+		},
+		{
+			name: "invalid swap volume",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{SwapVolume: sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: true,
+		},
+		{
+			name: "invalid spread rewards collected",
+			genesis: *&genesis.GenesisState{
+				Params:                genesis.DefaultGenesis().GetParams(),
+				PoolData:              []genesis.PoolData{{SpreadRewardsCollected: sdk.Coins{{Denom: "foo", Amount: sdk.ZeroInt()}}}},
+				NextPositionId:        genesis.DefaultGenesis().GetNextPositionId(),
+				NextIncentiveRecordId: genesis.DefaultGenesis().GetNextIncentiveRecordId(),
+			},
+			exepectedError: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -14,16 +15,22 @@ import (
 const (
 	ProposalTypeCreateConcentratedLiquidityPool = "CreateConcentratedLiquidityPool"
 	ProposalTypeTickSpacingDecrease             = "TickSpacingDecrease"
+	ProposalTypeSweepRoundingRemainders         = "SweepRoundingRemainders"
+	ProposalTypePositionMigrationWindow         = "PositionMigrationWindow"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeCreateConcentratedLiquidityPool)
 	govtypesv1.RegisterProposalType(ProposalTypeTickSpacingDecrease)
+	govtypesv1.RegisterProposalType(ProposalTypeSweepRoundingRemainders)
+	govtypesv1.RegisterProposalType(ProposalTypePositionMigrationWindow)
 }
 
 var (
 	_ govtypesv1.Content = &CreateConcentratedLiquidityPoolsProposal{}
 	_ govtypesv1.Content = &TickSpacingDecreaseProposal{}
+	_ govtypesv1.Content = &SweepRoundingRemaindersProposal{}
+	_ govtypesv1.Content = &PositionMigrationWindowProposal{}
 )
 
 // NewCreateConcentratedLiquidityPoolsProposal returns a new instance of a create concentrated liquidity pool proposal struct.
@@ -155,3 +162,114 @@ Records:     %s
 `, p.Title, p.Description, recordsStr))
 	return b.String()
 }
+
+func NewSweepRoundingRemaindersProposal(title, description string, poolIds []uint64) govtypesv1.Content {
+	return &SweepRoundingRemaindersProposal{
+		Title:       title,
+		Description: description,
+		PoolIds:     poolIds,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *SweepRoundingRemaindersProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *SweepRoundingRemaindersProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *SweepRoundingRemaindersProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SweepRoundingRemaindersProposal) ProposalType() string {
+	return ProposalTypeSweepRoundingRemainders
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *SweepRoundingRemaindersProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.PoolIds) == 0 {
+		return fmt.Errorf("empty pool ids")
+	}
+
+	seen := make(map[uint64]struct{}, len(p.PoolIds))
+	for _, poolId := range p.PoolIds {
+		if poolId == 0 {
+			return fmt.Errorf("pool id must be positive")
+		}
+		if _, ok := seen[poolId]; ok {
+			return fmt.Errorf("duplicate pool id: %d", poolId)
+		}
+		seen[poolId] = struct{}{}
+	}
+	return nil
+}
+
+// String returns a string containing the sweep rounding remainders proposal.
+func (p SweepRoundingRemaindersProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Sweep Rounding Remainders Proposal:
+Title:       %s
+Description: %s
+Pool IDs:    %v
+`, p.Title, p.Description, p.PoolIds))
+	return b.String()
+}
+
+func NewPositionMigrationWindowProposal(title, description string, fromPoolId, toPoolId uint64, duration time.Duration) govtypesv1.Content {
+	return &PositionMigrationWindowProposal{
+		Title:       title,
+		Description: description,
+		FromPoolId:  fromPoolId,
+		ToPoolId:    toPoolId,
+		Duration:    duration,
+	}
+}
+
+// GetTitle gets the title of the proposal
+func (p *PositionMigrationWindowProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *PositionMigrationWindowProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *PositionMigrationWindowProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *PositionMigrationWindowProposal) ProposalType() string {
+	return ProposalTypePositionMigrationWindow
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents.
+func (p *PositionMigrationWindowProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.FromPoolId == 0 || p.ToPoolId == 0 {
+		return fmt.Errorf("pool id must be positive")
+	}
+	if p.FromPoolId == p.ToPoolId {
+		return fmt.Errorf("successor pool id must differ from the deprecated pool id: %d", p.FromPoolId)
+	}
+	if p.Duration < 0 || p.Duration > MaxPositionMigrationWindowDuration {
+		return fmt.Errorf("duration (%s) must be between 0 and %s", p.Duration, MaxPositionMigrationWindowDuration)
+	}
+	return nil
+}
+
+// String returns a string containing the position migration window proposal.
+func (p PositionMigrationWindowProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Position Migration Window Proposal:
+Title:        %s
+Description:  %s
+From Pool ID: %d
+To Pool ID:   %d
+Duration:     %s
+`, p.Title, p.Description, p.FromPoolId, p.ToPoolId, p.Duration))
+	return b.String()
+}
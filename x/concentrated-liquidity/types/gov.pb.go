@@ -8,15 +8,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -224,11 +228,101 @@ func (m *PoolRecord) GetTickSpacing() uint64 {
 	return 0
 }
 
+// SweepRoundingRemaindersProposal is a gov Content type for sweeping the
+// whole units of the rounding remainders of pools to the community pool.
+// The rounding remainders are the amounts a pool kept due to rounding in its
+// favor, net of the amounts it lost due to rounding in its users' favor.
+// The proposal will fail if one of the pools does not exist.
+type SweepRoundingRemaindersProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PoolIds     []uint64 `protobuf:"varint,3,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *SweepRoundingRemaindersProposal) Reset()      { *m = SweepRoundingRemaindersProposal{} }
+func (*SweepRoundingRemaindersProposal) ProtoMessage() {}
+func (*SweepRoundingRemaindersProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{4}
+}
+func (m *SweepRoundingRemaindersProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SweepRoundingRemaindersProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SweepRoundingRemaindersProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SweepRoundingRemaindersProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepRoundingRemaindersProposal.Merge(m, src)
+}
+func (m *SweepRoundingRemaindersProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SweepRoundingRemaindersProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepRoundingRemaindersProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepRoundingRemaindersProposal proto.InternalMessageInfo
+
+// PositionMigrationWindowProposal is a gov Content type for opening a window
+// during which the positions in a deprecated pool can be moved to a designated
+// successor pool without swapping. The successor pool must have the same
+// token0 and token1. A zero duration closes the open window of the pool, if
+// any. The proposal will fail if one of the pools does not exist.
+type PositionMigrationWindowProposal struct {
+	Title       string        `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string        `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	FromPoolId  uint64        `protobuf:"varint,3,opt,name=from_pool_id,json=fromPoolId,proto3" json:"from_pool_id,omitempty" yaml:"from_pool_id"`
+	ToPoolId    uint64        `protobuf:"varint,4,opt,name=to_pool_id,json=toPoolId,proto3" json:"to_pool_id,omitempty" yaml:"to_pool_id"`
+	Duration    time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+}
+
+func (m *PositionMigrationWindowProposal) Reset()      { *m = PositionMigrationWindowProposal{} }
+func (*PositionMigrationWindowProposal) ProtoMessage() {}
+func (*PositionMigrationWindowProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a96adc35f4989ef7, []int{5}
+}
+func (m *PositionMigrationWindowProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionMigrationWindowProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionMigrationWindowProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionMigrationWindowProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionMigrationWindowProposal.Merge(m, src)
+}
+func (m *PositionMigrationWindowProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionMigrationWindowProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionMigrationWindowProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionMigrationWindowProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CreateConcentratedLiquidityPoolsProposal)(nil), "osmosis.concentratedliquidity.v1beta1.CreateConcentratedLiquidityPoolsProposal")
 	proto.RegisterType((*TickSpacingDecreaseProposal)(nil), "osmosis.concentratedliquidity.v1beta1.TickSpacingDecreaseProposal")
 	proto.RegisterType((*PoolIdToTickSpacingRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIdToTickSpacingRecord")
 	proto.RegisterType((*PoolRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolRecord")
+	proto.RegisterType((*SweepRoundingRemaindersProposal)(nil), "osmosis.concentratedliquidity.v1beta1.SweepRoundingRemaindersProposal")
+	proto.RegisterType((*PositionMigrationWindowProposal)(nil), "osmosis.concentratedliquidity.v1beta1.PositionMigrationWindowProposal")
 }
 
 func init() {
@@ -236,42 +330,51 @@ func init() {
 }
 
 var fileDescriptor_a96adc35f4989ef7 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x5b, 0x27, 0xc0, 0x25, 0x45, 0xc5, 0x44, 0x6a, 0x68, 0x24, 0x3b, 0xb2, 0x84, 0x14,
-	0x86, 0xda, 0xb8, 0x6c, 0x61, 0x41, 0x69, 0x85, 0x04, 0xea, 0x50, 0x99, 0x4e, 0x08, 0x29, 0x5c,
-	0xce, 0x87, 0x7b, 0x8a, 0xe3, 0xe7, 0xfa, 0xae, 0x29, 0xf9, 0x0f, 0x90, 0x60, 0x60, 0x64, 0xcc,
-	0x9f, 0xd3, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xb0, 0x92, 0xbf, 0x00, 0xe5, 0x2e, 0x3f, 0x9c,
-	0x42, 0x25, 0x10, 0x9b, 0xef, 0xee, 0xfb, 0xbe, 0xf7, 0x7d, 0xef, 0xf9, 0x21, 0x0f, 0x78, 0x0f,
-	0x38, 0xe3, 0x1e, 0x81, 0x84, 0xd0, 0x44, 0x64, 0x58, 0xd0, 0x30, 0x66, 0x67, 0xe7, 0x2c, 0x64,
-	0x62, 0xe0, 0xf5, 0xfd, 0x0e, 0x15, 0xd8, 0xf7, 0x22, 0xe8, 0xbb, 0x69, 0x06, 0x02, 0xcc, 0x87,
-	0x73, 0x82, 0xfb, 0x47, 0x82, 0x3b, 0x27, 0xec, 0x56, 0x22, 0x88, 0x40, 0x32, 0xbc, 0xd9, 0x97,
-	0x22, 0x3b, 0x13, 0x1d, 0x35, 0x0e, 0x32, 0x8a, 0x05, 0x3d, 0xc8, 0xb1, 0x8f, 0x16, 0xec, 0x63,
-	0x80, 0x98, 0x1f, 0x67, 0x90, 0x02, 0xc7, 0xb1, 0x59, 0x41, 0x05, 0xc1, 0x44, 0x4c, 0xab, 0x7a,
-	0x5d, 0x6f, 0xdc, 0x09, 0xd4, 0xc1, 0xac, 0xa3, 0x52, 0x48, 0x39, 0xc9, 0x58, 0x2a, 0x18, 0x24,
-	0xd5, 0x0d, 0xf9, 0x96, 0xbf, 0x32, 0xcf, 0x50, 0x39, 0x05, 0x88, 0xdb, 0x19, 0x25, 0x90, 0x85,
-	0xbc, 0xba, 0x59, 0xdf, 0x6c, 0x94, 0xf6, 0x7d, 0xf7, 0xaf, 0x8c, 0xbb, 0x33, 0x0f, 0x81, 0x64,
-	0xb6, 0x6a, 0x97, 0x23, 0x5b, 0x9b, 0x8e, 0xec, 0xfb, 0x03, 0xdc, 0x8b, 0x9b, 0x4e, 0x5e, 0xd4,
-	0x09, 0x4a, 0xe9, 0x12, 0xc8, 0x9b, 0xe5, 0x0f, 0x43, 0x5b, 0xfb, 0x32, 0xb4, 0xb5, 0x1f, 0x43,
-	0x5b, 0x77, 0x7e, 0xea, 0xa8, 0x76, 0xc2, 0x48, 0xf7, 0x55, 0x8a, 0x09, 0x4b, 0xa2, 0x43, 0x4a,
-	0x32, 0x8a, 0x39, 0xfd, 0xef, 0x60, 0x1f, 0x75, 0x64, 0x4b, 0x13, 0x2c, 0x6c, 0x0b, 0x68, 0x0b,
-	0x46, 0xba, 0x6d, 0xae, 0x6a, 0x5c, 0x0b, 0xfb, 0xec, 0x1f, 0xc2, 0xbe, 0x08, 0x4f, 0x20, 0xe7,
-	0x76, 0x9e, 0xdd, 0x98, 0x65, 0x0f, 0x76, 0xd3, 0x9b, 0x00, 0xd7, 0x33, 0x87, 0xe8, 0xc1, 0x8d,
-	0x62, 0xe6, 0x0e, 0xba, 0x35, 0xf7, 0x2d, 0x23, 0x1b, 0x41, 0x51, 0xe9, 0x9a, 0x0d, 0xb4, 0x9d,
-	0xd0, 0x8b, 0xb5, 0x24, 0x32, 0xb8, 0x11, 0xdc, 0x4d, 0xe8, 0x45, 0x4e, 0xa8, 0x69, 0xc8, 0x2a,
-	0x9f, 0x36, 0x10, 0x5a, 0x0d, 0xc8, 0x7c, 0x84, 0x8a, 0x21, 0x4d, 0xa0, 0xf7, 0x58, 0x75, 0xb2,
-	0x75, 0x6f, 0x3a, 0xb2, 0xb7, 0xd4, 0xb0, 0xd4, 0xbd, 0x13, 0xcc, 0x01, 0x4b, 0xa8, 0xaf, 0x1a,
-	0xfb, 0x1b, 0xd4, 0x5f, 0x40, 0x7d, 0xb3, 0x89, 0xca, 0x6b, 0x86, 0x36, 0x67, 0x86, 0x5a, 0x3b,
-	0xab, 0x1f, 0x21, 0xff, 0xea, 0x04, 0x25, 0xb1, 0xb2, 0x69, 0xbe, 0x45, 0x5b, 0x3c, 0xcd, 0x28,
-	0x0e, 0xdb, 0xef, 0x30, 0x11, 0x90, 0x55, 0x0b, 0xb2, 0xda, 0xd3, 0x59, 0x37, 0xbf, 0x8d, 0xec,
-	0x1a, 0x91, 0x73, 0xe1, 0x61, 0xd7, 0x65, 0xe0, 0xf5, 0xb0, 0x38, 0x75, 0x8f, 0x68, 0x84, 0xc9,
-	0xe0, 0x90, 0x92, 0xe9, 0xc8, 0xae, 0x28, 0xfd, 0x35, 0x05, 0x27, 0x28, 0xab, 0xf3, 0x73, 0x79,
-	0x54, 0x8d, 0x78, 0x69, 0xdc, 0x36, 0xb6, 0x0b, 0xad, 0x37, 0x97, 0x63, 0x4b, 0xbf, 0x1a, 0x5b,
-	0xfa, 0xf7, 0xb1, 0xa5, 0x7f, 0x9e, 0x58, 0xda, 0xd5, 0xc4, 0xd2, 0xbe, 0x4e, 0x2c, 0xed, 0x75,
-	0x2b, 0x62, 0xe2, 0xf4, 0xbc, 0xe3, 0x12, 0xe8, 0x2d, 0x36, 0x7c, 0x2f, 0xc6, 0x1d, 0xbe, 0x5c,
-	0xf7, 0xfe, 0xbe, 0xef, 0xbd, 0x5f, 0x5b, 0xfa, 0xbd, 0xd5, 0xd6, 0x8b, 0x41, 0x4a, 0x79, 0xa7,
-	0x28, 0x77, 0xf6, 0xc9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x29, 0x5e, 0x54, 0x68, 0x23, 0x04,
-	0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xa5, 0x54, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xa6, 0x50, 0x60, 0x9d, 0x5d, 0x14, 0x0a, 0x86, 0x05, 0x12, 0x4a, 0x9a, 0x98, 0xac, 0x07,
+	0x5a, 0x0b, 0x27, 0xd7, 0x8b, 0x59, 0x88, 0x89, 0x06, 0x13, 0x52, 0x48, 0x4c, 0x8c, 0xc9, 0xda,
+	0x6d, 0x87, 0x32, 0xa1, 0xed, 0x2b, 0x9d, 0x59, 0x70, 0xff, 0x81, 0x89, 0x1e, 0xbc, 0xc9, 0x91,
+	0xbb, 0x7f, 0x84, 0x23, 0x47, 0xe3, 0x01, 0x8d, 0x5c, 0xbc, 0xea, 0x2f, 0x70, 0x3a, 0xd3, 0xee,
+	0x76, 0x41, 0x13, 0x0d, 0x87, 0x49, 0x3a, 0x9d, 0xf7, 0x7d, 0xf3, 0x7d, 0xef, 0xbd, 0x79, 0xc8,
+	0x02, 0x1a, 0x01, 0x25, 0xd4, 0xf2, 0x20, 0xf6, 0x70, 0xcc, 0x52, 0x97, 0x61, 0x3f, 0x24, 0x87,
+	0x5d, 0xe2, 0x13, 0xd6, 0xb3, 0x8e, 0xec, 0x0e, 0x66, 0xae, 0x6d, 0x05, 0x70, 0x64, 0x26, 0x29,
+	0x30, 0xd0, 0xee, 0xe5, 0x00, 0xf3, 0x8f, 0x00, 0x33, 0x07, 0x2c, 0xce, 0x05, 0x10, 0x80, 0x40,
+	0x58, 0xd9, 0x97, 0x04, 0x2f, 0x2e, 0x07, 0x00, 0x41, 0x88, 0x2d, 0xb1, 0xeb, 0x74, 0xf7, 0x2c,
+	0xbf, 0xcb, 0xf1, 0x04, 0x62, 0x79, 0x6e, 0x5c, 0x2a, 0xa8, 0xb1, 0x91, 0x62, 0x4e, 0xb9, 0x51,
+	0x62, 0xdf, 0x2a, 0xd8, 0xb7, 0x01, 0x42, 0xba, 0x9d, 0x42, 0x02, 0xd4, 0x0d, 0xb5, 0x39, 0x34,
+	0xce, 0x08, 0x0b, 0x71, 0x5d, 0x59, 0x51, 0x1a, 0xb7, 0x1c, 0xb9, 0xd1, 0x56, 0x50, 0xd5, 0xc7,
+	0xd4, 0x4b, 0x49, 0x92, 0xf1, 0xd6, 0x47, 0xc5, 0x59, 0xf9, 0x97, 0x76, 0x88, 0x6a, 0x09, 0x27,
+	0x6a, 0xa7, 0xd8, 0x83, 0xd4, 0xa7, 0xf5, 0xb1, 0x95, 0xb1, 0x46, 0x75, 0xcd, 0x36, 0xff, 0xc9,
+	0x98, 0x99, 0x69, 0x70, 0x04, 0xb2, 0xb5, 0x74, 0x76, 0xa1, 0x8f, 0xfc, 0xba, 0xd0, 0x67, 0x7b,
+	0x6e, 0x14, 0x36, 0x8d, 0x32, 0xa9, 0xe1, 0x54, 0x93, 0x7e, 0x20, 0x6d, 0xd6, 0xde, 0x9e, 0xea,
+	0x23, 0x27, 0x7c, 0xfd, 0x38, 0xd5, 0x15, 0xe3, 0xa7, 0x82, 0x96, 0x76, 0x89, 0x77, 0xb0, 0x93,
+	0xb8, 0x1e, 0x89, 0x83, 0x4d, 0xec, 0x71, 0xcb, 0x14, 0xdf, 0xd8, 0xd8, 0x3b, 0x05, 0xe9, 0x42,
+	0x04, 0xf1, 0xdb, 0x0c, 0xda, 0x8c, 0x5f, 0xd1, 0xa6, 0xf2, 0x8e, 0x2b, 0x66, 0x1f, 0xff, 0x87,
+	0xd9, 0xa7, 0xfe, 0x2e, 0x94, 0xd4, 0xe6, 0xde, 0xd5, 0xcc, 0xbb, 0xb3, 0x98, 0xfc, 0x2d, 0xe0,
+	0xaa, 0x67, 0x1f, 0x2d, 0xfc, 0x95, 0x4c, 0x9b, 0x47, 0x93, 0xb9, 0x6e, 0x61, 0x59, 0x75, 0x26,
+	0x24, 0xaf, 0xd6, 0x40, 0xd3, 0x31, 0x3e, 0x1e, 0x72, 0x22, 0x8c, 0xab, 0xce, 0x6d, 0xfe, 0xbf,
+	0x44, 0xd4, 0x54, 0xc5, 0x2d, 0xef, 0x47, 0x11, 0x1a, 0x14, 0x48, 0xbb, 0x8f, 0x26, 0x7c, 0x1c,
+	0x43, 0xf4, 0x40, 0x66, 0xb2, 0x35, 0xc3, 0x8b, 0x35, 0x25, 0x8b, 0x25, 0xff, 0x1b, 0x4e, 0x1e,
+	0xd0, 0x0f, 0xb5, 0x65, 0x62, 0xaf, 0x85, 0xda, 0x45, 0xa8, 0xad, 0x35, 0x51, 0x6d, 0x48, 0xd0,
+	0x58, 0x26, 0xa8, 0x35, 0x3f, 0x68, 0x84, 0xf2, 0x29, 0x6f, 0x04, 0x36, 0x90, 0xa9, 0xbd, 0x46,
+	0x53, 0x34, 0xe1, 0xd5, 0xf6, 0xdb, 0x7b, 0xae, 0xc7, 0x20, 0xad, 0x8f, 0x8b, 0xdb, 0x1e, 0x65,
+	0xd9, 0xfc, 0x72, 0xa1, 0x2f, 0x79, 0xa2, 0x2e, 0xd4, 0x3f, 0x30, 0x09, 0x58, 0x91, 0xcb, 0xf6,
+	0xcd, 0x2d, 0x1c, 0xb8, 0x5e, 0x8f, 0xf7, 0x08, 0xe7, 0x9f, 0x93, 0xfc, 0x43, 0x0c, 0x86, 0x53,
+	0x93, 0xfb, 0x27, 0x62, 0x2b, 0x13, 0xf1, 0x4c, 0xad, 0xa8, 0xd3, 0xe3, 0xc6, 0x47, 0xde, 0x10,
+	0x3b, 0xc7, 0x18, 0x27, 0x0e, 0x74, 0x63, 0x5f, 0xe4, 0x3b, 0x72, 0x49, 0xec, 0xe3, 0xf4, 0xe6,
+	0xaf, 0xc8, 0x44, 0x95, 0xbc, 0x66, 0xb2, 0xa9, 0xd4, 0xd6, 0x2c, 0x57, 0x78, 0xa7, 0xf4, 0x14,
+	0x48, 0xf6, 0x0c, 0x26, 0x65, 0x25, 0xaf, 0xb6, 0xc3, 0xa7, 0x51, 0xa4, 0x6f, 0xf3, 0x06, 0xcc,
+	0xa8, 0x9e, 0x93, 0x40, 0x0e, 0x81, 0x17, 0x5c, 0x1a, 0x1c, 0xdf, 0x58, 0xd9, 0x43, 0x54, 0xdb,
+	0x4b, 0x21, 0x6a, 0x17, 0x2d, 0x75, 0xad, 0x3e, 0xe5, 0x53, 0xc3, 0x41, 0xd9, 0x56, 0xf6, 0xa6,
+	0xb6, 0x8e, 0x10, 0x7f, 0x38, 0x05, 0x50, 0x15, 0xc0, 0xbb, 0x1c, 0x38, 0x93, 0x17, 0x16, 0x06,
+	0xb0, 0x0a, 0x83, 0x1c, 0xe4, 0xa0, 0x4a, 0x31, 0xc6, 0x44, 0x39, 0xab, 0x6b, 0x0b, 0xa6, 0x9c,
+	0x73, 0x66, 0x31, 0xe7, 0xcc, 0xcd, 0x3c, 0xa0, 0x3f, 0x33, 0xf2, 0x44, 0x15, 0x40, 0xe3, 0xe4,
+	0xab, 0xae, 0x38, 0x7d, 0x9e, 0xe1, 0x6c, 0xb5, 0x5e, 0x9d, 0x7d, 0x5f, 0x56, 0xce, 0xf9, 0xfa,
+	0xc6, 0xd7, 0x87, 0xcb, 0xe5, 0x91, 0x73, 0xbe, 0x3e, 0xf3, 0xf5, 0xb2, 0x15, 0x10, 0xb6, 0xdf,
+	0xed, 0xf0, 0x67, 0x1c, 0x15, 0x93, 0x7c, 0x35, 0x74, 0x3b, 0xb4, 0x3f, 0xd6, 0x8f, 0xd6, 0x6c,
+	0xeb, 0xcd, 0xd0, 0x70, 0x5f, 0x1d, 0x4c, 0x77, 0xd6, 0x4b, 0x30, 0xed, 0x4c, 0x08, 0x95, 0xeb,
+	0xbf, 0x01, 0x3e, 0xba, 0x2d, 0x92, 0x0b, 0x06, 0x00, 0x00,
 }
 
 func (this *CreateConcentratedLiquidityPoolsProposal) Equal(that interface{}) bool {
@@ -404,6 +507,77 @@ func (this *PoolRecord) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SweepRoundingRemaindersProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SweepRoundingRemaindersProposal)
+	if !ok {
+		that2, ok := that.(SweepRoundingRemaindersProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if len(this.PoolIds) != len(that1.PoolIds) {
+		return false
+	}
+	for i := range this.PoolIds {
+		if this.PoolIds[i] != that1.PoolIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PositionMigrationWindowProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PositionMigrationWindowProposal)
+	if !ok {
+		that2, ok := that.(PositionMigrationWindowProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.FromPoolId != that1.FromPoolId {
+		return false
+	}
+	if this.ToPoolId != that1.ToPoolId {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
 func (m *CreateConcentratedLiquidityPoolsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -591,6 +765,116 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SweepRoundingRemaindersProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SweepRoundingRemaindersProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SweepRoundingRemaindersProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA2 := make([]byte, len(m.PoolIds)*10)
+		var j1 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGov(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionMigrationWindowProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionMigrationWindowProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionMigrationWindowProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGov(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.ToPoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ToPoolId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromPoolId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.FromPoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -685,6 +969,55 @@ func (m *PoolRecord) Size() (n int) {
 	return n
 }
 
+func (m *SweepRoundingRemaindersProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovGov(uint64(e))
+		}
+		n += 1 + sovGov(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PositionMigrationWindowProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.FromPoolId != 0 {
+		n += 1 + sovGov(uint64(m.FromPoolId))
+	}
+	if m.ToPoolId != 0 {
+		n += 1 + sovGov(uint64(m.ToPoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1242,6 +1575,383 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SweepRoundingRemaindersProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SweepRoundingRemaindersProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SweepRoundingRemaindersProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGov
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGov
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGov
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGov
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PositionMigrationWindowProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionMigrationWindowProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionMigrationWindowProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPoolId", wireType)
+			}
+			m.FromPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPoolId", wireType)
+			}
+			m.ToPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -189,3 +190,105 @@ func TestCreateConcentratedLiquidityPoolsProposal_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestSweepRoundingRemaindersProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		poolIds    []uint64
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			poolIds:    []uint64{1, 5},
+			expectPass: true,
+		},
+		{
+			name:       "empty pool ids",
+			poolIds:    []uint64{},
+			expectPass: false,
+		},
+		{
+			name:       "zero pool id",
+			poolIds:    []uint64{1, 0},
+			expectPass: false,
+		},
+		{
+			name:       "duplicate pool id",
+			poolIds:    []uint64{1, 5, 1},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		sweepProposal := types.NewSweepRoundingRemaindersProposal("title", "description", test.poolIds)
+
+		if test.expectPass {
+			require.NoError(t, sweepProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, sweepProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestPositionMigrationWindowProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		fromPoolId uint64
+		toPoolId   uint64
+		duration   time.Duration
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			fromPoolId: 1,
+			toPoolId:   5,
+			duration:   time.Hour * 24 * 7,
+			expectPass: true,
+		},
+		{
+			name:       "zero duration closes the window",
+			fromPoolId: 1,
+			toPoolId:   5,
+			duration:   0,
+			expectPass: true,
+		},
+		{
+			name:       "zero pool id",
+			fromPoolId: 0,
+			toPoolId:   5,
+			duration:   time.Hour,
+			expectPass: false,
+		},
+		{
+			name:       "same pool ids",
+			fromPoolId: 1,
+			toPoolId:   1,
+			duration:   time.Hour,
+			expectPass: false,
+		},
+		{
+			name:       "negative duration",
+			fromPoolId: 1,
+			toPoolId:   5,
+			duration:   -time.Hour,
+			expectPass: false,
+		},
+		{
+			name:       "duration longer than the max duration",
+			fromPoolId: 1,
+			toPoolId:   5,
+			duration:   types.MaxPositionMigrationWindowDuration + time.Second,
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		migrationProposal := types.NewPositionMigrationWindowProposal("title", "description", test.fromPoolId, test.toPoolId, test.duration)
+
+		if test.expectPass {
+			require.NoError(t, migrationProposal.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, migrationProposal.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -19,6 +19,7 @@ const (
 	base10         = 10
 
 	ConcentratedLiquidityTokenPrefix = "cl/pool"
+	PositionWrapperTokenPrefix       = "cl/position"
 )
 
 // Key prefixes
@@ -50,6 +51,28 @@ var (
 	KeyTotalLiquidity     = []byte{0x13}
 	KeyContractHookPrefix = []byte{0x14}
 
+	RoundingRemainderPrefix = []byte{0x15}
+
+	ClaimAllowancePrefix = []byte{0x16}
+
+	SwapVolumePrefix             = []byte{0x17}
+	SpreadRewardsCollectedPrefix = []byte{0x18}
+
+	PositionLienPrefix    = []byte{0x19}
+	ManagedPositionPrefix = []byte{0x1A}
+
+	PositionHistoryPrefix         = []byte{0x1B}
+	PositionHistoryByHeightPrefix = []byte{0x1C}
+
+	PositionMigrationWindowPrefix = []byte{0x1D}
+
+	LimitOrderPrefix                  = []byte{0x1E}
+	OpenLimitOrderByTriggerTickPrefix = []byte{0x1F}
+	PendingLimitOrderFillsPrefix      = []byte{0x20}
+	ParkedLimitOrderPrefix            = []byte{0x21}
+
+	KeyManagedPositionRebalanceCursor = []byte{0x22}
+
 	// TickPrefix + pool id
 	KeyTickPrefixByPoolIdLengthBytes = len(TickPrefix) + uint64ByteSize
 	// TickPrefix + pool id + sign byte(negative / positive prefix) + tick index: 18bytes in total
@@ -267,6 +290,183 @@ func KeyBalancerFullRange(clPoolId, balancerPoolId, uptimeIndex uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s%d%s%d", BalancerFullRangePrefix, KeySeparator, clPoolId, KeySeparator, balancerPoolId, KeySeparator, uptimeIndex))
 }
 
+// Rounding Remainder Prefix Keys
+
+// KeyPoolRoundingRemainders returns the prefix key for the rounding remainders of the given pool id.
+// This can be used to iterate over the rounding remainders of every denom of the pool.
+func KeyPoolRoundingRemainders(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", RoundingRemainderPrefix, KeySeparator, poolId, KeySeparator))
+}
+
+// KeyRoundingRemainder is the key used to store the rounding remainder of a denom in the given pool id.
+func KeyRoundingRemainder(poolId uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s%s", RoundingRemainderPrefix, KeySeparator, poolId, KeySeparator, denom))
+}
+
+// Pool Swap Statistics Prefix Keys
+
+// KeyPoolSwapVolume returns the prefix key for the cumulative swap volume of the given pool id.
+// This can be used to iterate over the swap volume of every denom of the pool.
+func KeyPoolSwapVolume(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", SwapVolumePrefix, KeySeparator, poolId, KeySeparator))
+}
+
+// KeySwapVolume is the key used to store the cumulative swap volume of a denom in the given pool id.
+func KeySwapVolume(poolId uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s%s", SwapVolumePrefix, KeySeparator, poolId, KeySeparator, denom))
+}
+
+// KeyPoolSpreadRewardsCollected returns the prefix key for the cumulative spread rewards collected by the given pool id.
+// This can be used to iterate over the spread rewards collected in every denom of the pool.
+func KeyPoolSpreadRewardsCollected(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", SpreadRewardsCollectedPrefix, KeySeparator, poolId, KeySeparator))
+}
+
+// KeySpreadRewardsCollected is the key used to store the cumulative spread rewards collected in a denom by the given pool id.
+func KeySpreadRewardsCollected(poolId uint64, denom string) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s%s", SpreadRewardsCollectedPrefix, KeySeparator, poolId, KeySeparator, denom))
+}
+
+// Claim Allowance Prefix Keys
+
+// KeyClaimAllowance is the key used to store the claim allowance granted by the owner to the grantee.
+func KeyClaimAllowance(owner, grantee sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf("%s%s%x%s%x", ClaimAllowancePrefix, KeySeparator, owner.Bytes(), KeySeparator, grantee.Bytes()))
+}
+
+// Position Lien Prefix Keys
+
+// KeyPositionLien is the key used to store the lien on the given position id.
+func KeyPositionLien(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", PositionLienPrefix, KeySeparator, positionId))
+}
+
+// Managed Position Prefix Keys
+
+// KeyManagedPosition is the key used to store the rebalancing configuration of the given managed position id.
+func KeyManagedPosition(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", ManagedPositionPrefix, KeySeparator, positionId))
+}
+
+// Position Migration Window Prefix Keys
+
+// KeyPositionMigrationWindow is the key used to store the open position migration window of the given deprecated pool id.
+func KeyPositionMigrationWindow(fromPoolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", PositionMigrationWindowPrefix, KeySeparator, fromPoolId))
+}
+
+// Limit Order Prefix Keys
+
+// KeyLimitOrder is the key used to store the limit order held by the given position id.
+func KeyLimitOrder(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", LimitOrderPrefix, KeySeparator, positionId))
+}
+
+// KeyOpenLimitOrdersPrefix returns the prefix key of the index of the open limit orders of the given pool id.
+func KeyOpenLimitOrdersPrefix(poolId uint64) []byte {
+	key := []byte(fmt.Sprintf("%s%s", OpenLimitOrderByTriggerTickPrefix, KeySeparator))
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyOpenLimitOrdersByDirectionPrefix returns the prefix key of the index of the open limit orders of the given
+// pool id selling in the given direction. Keys under it are ordered by the tick that fills the orders.
+func KeyOpenLimitOrdersByDirectionPrefix(poolId uint64, zeroForOne bool) []byte {
+	key := KeyOpenLimitOrdersPrefix(poolId)
+	if zeroForOne {
+		return append(key, 0x01)
+	}
+	return append(key, 0x00)
+}
+
+// KeyOpenLimitOrderByTriggerTick is the key used to index the open limit order held by the given position id
+// by the tick that fills it, which is the upper tick of orders selling token0 and the lower tick of orders
+// selling token1.
+func KeyOpenLimitOrderByTriggerTick(poolId uint64, zeroForOne bool, triggerTick int64, positionId uint64) []byte {
+	key := KeyOpenLimitOrdersByDirectionPrefix(poolId, zeroForOne)
+	key = append(key, TickIndexToBytes(triggerTick)...)
+	return append(key, sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// ParseOpenLimitOrderByTriggerTickKey returns the position id of the open limit order indexed by the given key,
+// stripped of the KeyOpenLimitOrdersPrefix.
+func ParseOpenLimitOrderByTriggerTickKey(key []byte) (uint64, error) {
+	// direction byte + sign byte + tick index + position id
+	if len(key) != 2+2*uint64ByteSize {
+		return 0, fmt.Errorf("invalid open limit order by trigger tick key length: %d", len(key))
+	}
+	return sdk.BigEndianToUint64(key[2+uint64ByteSize:]), nil
+}
+
+// KeyPendingLimitOrderFills is the key used to mark the given pool id as having open limit orders crossed by a
+// swap, which are filled at the end of the block. Keys under PendingLimitOrderFillsPrefix are ordered by pool id.
+func KeyPendingLimitOrderFills(poolId uint64) []byte {
+	return append(PendingLimitOrderFillsPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// ParsePendingLimitOrderFillsKey returns the pool id marked by the given key, stripped of the
+// PendingLimitOrderFillsPrefix.
+func ParsePendingLimitOrderFillsKey(key []byte) (uint64, error) {
+	if len(key) != uint64ByteSize {
+		return 0, fmt.Errorf("invalid pending limit order fills key length: %d", len(key))
+	}
+	return sdk.BigEndianToUint64(key), nil
+}
+
+// KeyParkedLimitOrdersPrefix returns the prefix key of the open limit orders of the given pool id that failed to
+// be filled at the end of a block.
+func KeyParkedLimitOrdersPrefix(poolId uint64) []byte {
+	return append(ParkedLimitOrderPrefix, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyParkedLimitOrder is the key used to index the open limit order held by the given position id once it failed
+// to be filled at the end of a block, in place of its KeyOpenLimitOrderByTriggerTick.
+func KeyParkedLimitOrder(poolId uint64, positionId uint64) []byte {
+	return append(KeyParkedLimitOrdersPrefix(poolId), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// Position History Prefix Keys
+
+// KeyPositionHistoryPrefix returns the prefix key of the history entries of the given position id.
+// This can be used to iterate over the entries of the position in chronological order.
+func KeyPositionHistoryPrefix(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d%s", PositionHistoryPrefix, KeySeparator, positionId, KeySeparator))
+}
+
+// KeyPositionHistory is the key used to store the history entry of the given position id recorded
+// at the given block height, where index orders the entries of the position recorded in the same block.
+func KeyPositionHistory(positionId uint64, blockHeight int64, index uint64) []byte {
+	key := KeyPositionHistoryPrefix(positionId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(index)...)
+}
+
+// KeyPositionHistoryByHeightPrefix returns the prefix key of the index of the position history entries
+// by block height. Keys under it are ordered by block height, so that old entries can be pruned.
+func KeyPositionHistoryByHeightPrefix() []byte {
+	return []byte(fmt.Sprintf("%s%s", PositionHistoryByHeightPrefix, KeySeparator))
+}
+
+// KeyPositionHistoryByHeight is the key used to index the history entry of the given position id
+// by the block height it was recorded at.
+func KeyPositionHistoryByHeight(blockHeight int64, positionId uint64, index uint64) []byte {
+	key := KeyPositionHistoryByHeightPrefix()
+	key = append(key, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+	key = append(key, sdk.Uint64ToBigEndian(positionId)...)
+	return append(key, sdk.Uint64ToBigEndian(index)...)
+}
+
+// ParsePositionHistoryByHeightKey returns the block height, position id and index of the position history
+// entry indexed by the given key, stripped of the KeyPositionHistoryByHeightPrefix.
+func ParsePositionHistoryByHeightKey(key []byte) (blockHeight int64, positionId uint64, index uint64, err error) {
+	if len(key) != 3*uint64ByteSize {
+		return 0, 0, 0, fmt.Errorf("invalid position history by height key length: %d", len(key))
+	}
+	blockHeight = int64(sdk.BigEndianToUint64(key[:uint64ByteSize]))
+	positionId = sdk.BigEndianToUint64(key[uint64ByteSize : 2*uint64ByteSize])
+	index = sdk.BigEndianToUint64(key[2*uint64ByteSize:])
+	return blockHeight, positionId, index, nil
+}
+
 // Helper Functions
 func GetPoolIdFromShareDenom(denom string) (uint64, error) {
 	if !strings.HasPrefix(denom, ConcentratedLiquidityTokenPrefix) {
@@ -12,13 +12,27 @@ import (
 
 // constants.
 const (
-	TypeMsgCreatePosition          = "create-position"
-	TypeAddToPosition              = "add-to-position"
-	TypeMsgWithdrawPosition        = "withdraw-position"
-	TypeMsgCollectSpreadRewards    = "collect-spread-rewards"
-	TypeMsgCollectIncentives       = "collect-incentives"
-	TypeMsgFungifyChargedPositions = "fungify-charged-positions"
-	TypeMsgTransferPositions       = "transfer-positions"
+	TypeMsgCreatePosition                 = "create-position"
+	TypeAddToPosition                     = "add-to-position"
+	TypeMsgWithdrawPosition               = "withdraw-position"
+	TypeMsgCollectSpreadRewards           = "collect-spread-rewards"
+	TypeMsgCollectIncentives              = "collect-incentives"
+	TypeMsgFungifyChargedPositions        = "fungify-charged-positions"
+	TypeMsgTransferPositions              = "transfer-positions"
+	TypeMsgUpdateParams                   = "update-params"
+	TypeMsgSetClaimAllowance              = "set-claim-allowance"
+	TypeMsgRevokeClaimAllowance           = "revoke-claim-allowance"
+	TypeMsgWrapPosition                   = "wrap-position"
+	TypeMsgUnwrapPosition                 = "unwrap-position"
+	TypeMsgWithdrawAllPoolPositions       = "withdraw-all-pool-positions"
+	TypeMsgLockPositionForCollateral      = "lock-position-for-collateral"
+	TypeMsgUnlockPosition                 = "unlock-position"
+	TypeMsgEnableManagedPosition          = "enable-managed-position"
+	TypeMsgDisableManagedPosition         = "disable-managed-position"
+	TypeMsgRebalanceManagedPosition       = "rebalance-managed-position"
+	TypeMsgMigratePositionToSuccessorPool = "migrate-position-to-successor-pool"
+	TypeMsgPlaceLimitOrder                = "place-limit-order"
+	TypeMsgClaimLimitOrder                = "claim-limit-order"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -132,6 +146,13 @@ func (msg MsgWithdrawPosition) ValidateBasic() error {
 		return NotPositiveRequireAmountError{Amount: msg.LiquidityAmount.String()}
 	}
 
+	if !msg.TokenOutMinAmount0.IsNil() && msg.TokenOutMinAmount0.IsNegative() {
+		return fmt.Errorf("Amount 0 cannot be negative, given token out min amount: %s", msg.TokenOutMinAmount0.String())
+	}
+	if !msg.TokenOutMinAmount1.IsNil() && msg.TokenOutMinAmount1.IsNegative() {
+		return fmt.Errorf("Amount 1 cannot be negative, given token out min amount: %s", msg.TokenOutMinAmount1.String())
+	}
+
 	return nil
 }
 
@@ -157,6 +178,10 @@ func (msg MsgCollectSpreadRewards) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
+	if msg.BestEffort && len(msg.PositionIds) > MaxPositionIdsPerCollect {
+		return TooManyPositionIdsError{NumPositionIds: len(msg.PositionIds), MaxPositionIds: MaxPositionIdsPerCollect}
+	}
+
 	return nil
 }
 
@@ -182,6 +207,10 @@ func (msg MsgCollectIncentives) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
+	if msg.BestEffort && len(msg.PositionIds) > MaxPositionIdsPerCollect {
+		return TooManyPositionIdsError{NumPositionIds: len(msg.PositionIds), MaxPositionIds: MaxPositionIdsPerCollect}
+	}
+
 	return nil
 }
 
@@ -267,3 +296,408 @@ func (msg MsgTransferPositions) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return fmt.Errorf("Invalid authority address (%s)", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetClaimAllowance{}
+
+func (msg MsgSetClaimAllowance) Route() string { return RouterKey }
+func (msg MsgSetClaimAllowance) Type() string  { return TypeMsgSetClaimAllowance }
+func (msg MsgSetClaimAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return fmt.Errorf("Invalid grantee address (%s)", err)
+	}
+
+	if msg.Sender == msg.Grantee {
+		return fmt.Errorf("Sender and grantee cannot be the same (%s)", msg.Sender)
+	}
+
+	return nil
+}
+
+func (msg MsgSetClaimAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetClaimAllowance) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeClaimAllowance{}
+
+func (msg MsgRevokeClaimAllowance) Route() string { return RouterKey }
+func (msg MsgRevokeClaimAllowance) Type() string  { return TypeMsgRevokeClaimAllowance }
+func (msg MsgRevokeClaimAllowance) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return fmt.Errorf("Invalid grantee address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgRevokeClaimAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRevokeClaimAllowance) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWrapPosition{}
+
+func (msg MsgWrapPosition) Route() string { return RouterKey }
+func (msg MsgWrapPosition) Type() string  { return TypeMsgWrapPosition }
+func (msg MsgWrapPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgWrapPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWrapPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUnwrapPosition{}
+
+func (msg MsgUnwrapPosition) Route() string { return RouterKey }
+func (msg MsgUnwrapPosition) Type() string  { return TypeMsgUnwrapPosition }
+func (msg MsgUnwrapPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgUnwrapPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnwrapPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawAllPoolPositions{}
+
+func (msg MsgWithdrawAllPoolPositions) Route() string { return RouterKey }
+func (msg MsgWithdrawAllPoolPositions) Type() string  { return TypeMsgWithdrawAllPoolPositions }
+func (msg MsgWithdrawAllPoolPositions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawAllPoolPositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawAllPoolPositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgLockPositionForCollateral{}
+
+func (msg MsgLockPositionForCollateral) Route() string { return RouterKey }
+func (msg MsgLockPositionForCollateral) Type() string  { return TypeMsgLockPositionForCollateral }
+func (msg MsgLockPositionForCollateral) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Lienholder)
+	if err != nil {
+		return fmt.Errorf("Invalid lienholder address (%s)", err)
+	}
+
+	if msg.Sender == msg.Lienholder {
+		return fmt.Errorf("Sender and lienholder cannot be the same (%s)", msg.Sender)
+	}
+
+	return nil
+}
+
+func (msg MsgLockPositionForCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns both the owner of the position and the lienholder, so that a lien
+// is only created with the consent of both.
+func (msg MsgLockPositionForCollateral) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	lienholder, err := sdk.AccAddressFromBech32(msg.Lienholder)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender, lienholder}
+}
+
+var _ sdk.Msg = &MsgUnlockPosition{}
+
+func (msg MsgUnlockPosition) Route() string { return RouterKey }
+func (msg MsgUnlockPosition) Type() string  { return TypeMsgUnlockPosition }
+func (msg MsgUnlockPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgUnlockPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUnlockPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgEnableManagedPosition{}
+
+func (msg MsgEnableManagedPosition) Route() string { return RouterKey }
+func (msg MsgEnableManagedPosition) Type() string  { return TypeMsgEnableManagedPosition }
+func (msg MsgEnableManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.RangeWidth == 0 {
+		return fmt.Errorf("Range width must be positive")
+	}
+
+	if msg.MaxRebalanceFee.IsNil() || msg.MaxRebalanceFee.IsNegative() {
+		return fmt.Errorf("Max rebalance fee must be non-negative (%s)", msg.MaxRebalanceFee)
+	}
+
+	return nil
+}
+
+func (msg MsgEnableManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgEnableManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgDisableManagedPosition{}
+
+func (msg MsgDisableManagedPosition) Route() string { return RouterKey }
+func (msg MsgDisableManagedPosition) Type() string  { return TypeMsgDisableManagedPosition }
+func (msg MsgDisableManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgDisableManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDisableManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRebalanceManagedPosition{}
+
+func (msg MsgRebalanceManagedPosition) Route() string { return RouterKey }
+func (msg MsgRebalanceManagedPosition) Type() string  { return TypeMsgRebalanceManagedPosition }
+func (msg MsgRebalanceManagedPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgRebalanceManagedPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRebalanceManagedPosition) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMigratePositionToSuccessorPool{}
+
+func (msg MsgMigratePositionToSuccessorPool) Route() string { return RouterKey }
+func (msg MsgMigratePositionToSuccessorPool) Type() string {
+	return TypeMsgMigratePositionToSuccessorPool
+}
+func (msg MsgMigratePositionToSuccessorPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgMigratePositionToSuccessorPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigratePositionToSuccessorPool) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPlaceLimitOrder{}
+
+func (msg MsgPlaceLimitOrder) Route() string { return RouterKey }
+func (msg MsgPlaceLimitOrder) Type() string  { return TypeMsgPlaceLimitOrder }
+func (msg MsgPlaceLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() {
+		return fmt.Errorf("Invalid coin (%s)", msg.TokenIn.String())
+	}
+
+	if !msg.TokenIn.IsPositive() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenIn.Amount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgPlaceLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPlaceLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgClaimLimitOrder{}
+
+func (msg MsgClaimLimitOrder) Route() string { return RouterKey }
+func (msg MsgClaimLimitOrder) Type() string  { return TypeMsgClaimLimitOrder }
+func (msg MsgClaimLimitOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgClaimLimitOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClaimLimitOrder) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
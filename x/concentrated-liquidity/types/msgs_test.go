@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -289,6 +290,125 @@ func TestMsgFungifyChargedPositions(t *testing.T) {
 	}
 }
 
+func TestMsgCollectSpreadRewards(t *testing.T) {
+	tooManyPositionIds := make([]uint64, types.MaxPositionIdsPerCollect+1)
+	for i := range tooManyPositionIds {
+		tooManyPositionIds[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCollectSpreadRewards
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCollectSpreadRewards{
+				Sender:      addr1,
+				PositionIds: []uint64{1, 2},
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg: best effort",
+			msg: types.MsgCollectSpreadRewards{
+				Sender:      addr1,
+				PositionIds: []uint64{1, 2},
+				BestEffort:  true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg: max position ids with best effort",
+			msg: types.MsgCollectSpreadRewards{
+				Sender:      addr1,
+				PositionIds: tooManyPositionIds[:types.MaxPositionIdsPerCollect],
+				BestEffort:  true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgCollectSpreadRewards{
+				Sender:      invalidAddr.String(),
+				PositionIds: []uint64{1, 2},
+			},
+			expectPass: false,
+		},
+		{
+			name: "proper msg: too many position ids without best effort",
+			msg: types.MsgCollectSpreadRewards{
+				Sender:      addr1,
+				PositionIds: tooManyPositionIds,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: too many position ids with best effort",
+			msg: types.MsgCollectSpreadRewards{
+				Sender:      addr1,
+				PositionIds: tooManyPositionIds,
+				BestEffort:  true,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCollectSpreadRewards)
+	}
+}
+
+func TestMsgCollectIncentives(t *testing.T) {
+	tooManyPositionIds := make([]uint64, types.MaxPositionIdsPerCollect+1)
+	for i := range tooManyPositionIds {
+		tooManyPositionIds[i] = uint64(i + 1)
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCollectIncentives
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgCollectIncentives{
+				Sender:      addr1,
+				PositionIds: []uint64{1, 2},
+				BestEffort:  true,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid sender",
+			msg: types.MsgCollectIncentives{
+				Sender:      invalidAddr.String(),
+				PositionIds: []uint64{1, 2},
+			},
+			expectPass: false,
+		},
+		{
+			name: "proper msg: too many position ids without best effort",
+			msg: types.MsgCollectIncentives{
+				Sender:      addr1,
+				PositionIds: tooManyPositionIds,
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: too many position ids with best effort",
+			msg: types.MsgCollectIncentives{
+				Sender:      addr1,
+				PositionIds: tooManyPositionIds,
+				BestEffort:  true,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgCollectIncentives)
+	}
+}
+
 func TestMsgWithdrawPosition(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -313,6 +433,37 @@ func TestMsgWithdrawPosition(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "proper msg with token out minimums",
+			msg: types.MsgWithdrawPosition{
+				PositionId:         1,
+				Sender:             addr1,
+				LiquidityAmount:    osmomath.OneDec(),
+				TokenOutMinAmount0: osmomath.OneInt(),
+				TokenOutMinAmount1: osmomath.ZeroInt(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative token out min amount0",
+			msg: types.MsgWithdrawPosition{
+				PositionId:         1,
+				Sender:             addr1,
+				LiquidityAmount:    osmomath.OneDec(),
+				TokenOutMinAmount0: osmomath.NewInt(-1),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative token out min amount1",
+			msg: types.MsgWithdrawPosition{
+				PositionId:         1,
+				Sender:             addr1,
+				LiquidityAmount:    osmomath.OneDec(),
+				TokenOutMinAmount1: osmomath.NewInt(-1),
+			},
+			expectPass: false,
+		},
 	}
 	for _, test := range tests {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgWithdrawPosition)
@@ -434,3 +585,314 @@ func TestMsgTransferPositions(t *testing.T) {
 		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgTransferPositions)
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	withParams := func(modify func(*types.Params)) types.Params {
+		params := types.DefaultParams()
+		modify(&params)
+		return params
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgUpdateParams
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgUpdateParams{
+				Authority: addr1,
+				Params: withParams(func(p *types.Params) {
+					p.AuthorizedTickSpacing = []uint64{1, 10, 100, 1000, 2000}
+					p.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Hour}
+				}),
+			},
+			expectPass: true,
+		},
+		{
+			name: "error: invalid authority",
+			msg: types.MsgUpdateParams{
+				Authority: invalidAddr.String(),
+				Params:    types.DefaultParams(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: duplicate tick spacing",
+			msg: types.MsgUpdateParams{
+				Authority: addr1,
+				Params: withParams(func(p *types.Params) {
+					p.AuthorizedTickSpacing = []uint64{1, 10, 10}
+				}),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: tick spacing does not divide max tick",
+			msg: types.MsgUpdateParams{
+				Authority: addr1,
+				Params: withParams(func(p *types.Params) {
+					p.AuthorizedTickSpacing = []uint64{1, 7}
+				}),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: unsupported uptime",
+			msg: types.MsgUpdateParams{
+				Authority: addr1,
+				Params: withParams(func(p *types.Params) {
+					p.AuthorizedUptimes = []time.Duration{time.Nanosecond, time.Second}
+				}),
+			},
+			expectPass: false,
+		},
+		{
+			name: "error: duplicate uptime",
+			msg: types.MsgUpdateParams{
+				Authority: addr1,
+				Params: withParams(func(p *types.Params) {
+					p.AuthorizedUptimes = []time.Duration{time.Hour, time.Hour}
+				}),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgUpdateParams)
+	}
+}
+
+func TestMsgSetClaimAllowance(t *testing.T) {
+	expiration := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSetClaimAllowance
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgSetClaimAllowance{
+				Sender:  addr1,
+				Grantee: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "proper msg with expiration",
+			msg: types.MsgSetClaimAllowance{
+				Sender:     addr1,
+				Grantee:    addr2,
+				Expiration: &expiration,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgSetClaimAllowance{
+				Sender:  invalidAddr.String(),
+				Grantee: addr2,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid grantee",
+			msg: types.MsgSetClaimAllowance{
+				Sender:  addr1,
+				Grantee: invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "sender and grantee are the same",
+			msg: types.MsgSetClaimAllowance{
+				Sender:  addr1,
+				Grantee: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgSetClaimAllowance)
+	}
+}
+
+func TestMsgRevokeClaimAllowance(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgRevokeClaimAllowance
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgRevokeClaimAllowance{
+				Sender:  addr1,
+				Grantee: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgRevokeClaimAllowance{
+				Sender:  invalidAddr.String(),
+				Grantee: addr2,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid grantee",
+			msg: types.MsgRevokeClaimAllowance{
+				Sender:  addr1,
+				Grantee: invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgRevokeClaimAllowance)
+	}
+}
+
+func TestMsgWrapPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgWrapPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgWrapPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgWrapPosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgWrapPosition)
+	}
+}
+
+func TestMsgUnwrapPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgUnwrapPosition
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgUnwrapPosition{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgUnwrapPosition{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgUnwrapPosition)
+	}
+}
+
+func TestMsgWithdrawAllPoolPositions(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgWithdrawAllPoolPositions
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgWithdrawAllPoolPositions{
+				PoolId: 1,
+				Sender: addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgWithdrawAllPoolPositions{
+				PoolId: 1,
+				Sender: invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		runValidateBasicTest(t, test.name, &test.msg, test.expectPass, types.TypeMsgWithdrawAllPoolPositions)
+	}
+}
+
+func TestMsgLockPositionForCollateral(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        types.MsgLockPositionForCollateral
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgLockPositionForCollateral{
+				Sender:     addr1,
+				PositionId: 1,
+				Lienholder: addr2,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgLockPositionForCollateral{
+				Sender:     invalidAddr.String(),
+				PositionId: 1,
+				Lienholder: addr2,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid lienholder",
+			msg: types.MsgLockPositionForCollateral{
+				Sender:     addr1,
+				PositionId: 1,
+				Lienholder: invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "sender is lienholder",
+			msg: types.MsgLockPositionForCollateral{
+				Sender:     addr1,
+				PositionId: 1,
+				Lienholder: addr1,
+			},
+			expectPass: false,
+		},
+	}
+	for _, test := range tests {
+		if !test.expectPass {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			continue
+		}
+		require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		require.Equal(t, types.TypeMsgLockPositionForCollateral, test.msg.Type())
+
+		// Both the owner and the lienholder sign the message.
+		signers := test.msg.GetSigners()
+		require.Len(t, signers, 2)
+		require.Equal(t, addr1, signers[0].String())
+		require.Equal(t, addr2, signers[1].String())
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/osmoassert"
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
 
 // Parameter store keys.
@@ -21,6 +23,16 @@ var (
 	KeyIsPermisionlessPoolCreationEnabled = []byte("IsPermisionlessPoolCreationEnabled")
 	KeyUnrestrictedPoolCreatorWhitelist   = []byte("UnrestrictedPoolCreatorWhitelist")
 	KeyHookGasLimit                       = []byte("HookGasLimit")
+	KeyMaxIncentiveRecordsPerPool         = []byte("MaxIncentiveRecordsPerPool")
+	KeyMaxIncentiveRecordsPerUptime       = []byte("MaxIncentiveRecordsPerUptime")
+	KeyMaxPositionsPerWithdrawAll         = []byte("MaxPositionsPerWithdrawAll")
+	KeyAuthorizedLienholders              = []byte("AuthorizedLienholders")
+	KeyAuthorizedPositionRebalancers      = []byte("AuthorizedPositionRebalancers")
+	KeyManagedPositionRebalanceFee        = []byte("ManagedPositionRebalanceFee")
+	KeyManagedPositionRebalanceEpoch      = []byte("ManagedPositionRebalanceEpoch")
+	KeyMaxManagedPositionRebalances       = []byte("MaxManagedPositionRebalances")
+	KeyPositionHistoryRetentionBlocks     = []byte("PositionHistoryRetentionBlocks")
+	KeyMaxLimitOrderFillsPerBlock         = []byte("MaxLimitOrderFillsPerBlock")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -30,16 +42,26 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSpreadFactors []osmomath.Dec, discountRate osmomath.Dec, authorizedQuoteDenoms []string, authorizedUptimes []time.Duration, isPermissionlessPoolCreationEnabled bool, unrestrictedPoolCreatorWhitelist []string, hookGasLimit uint64, maxIncentiveRecordsPerPool uint64, maxIncentiveRecordsPerUptime uint64, maxPositionsPerWithdrawAll uint64, authorizedLienholders []string, authorizedPositionRebalancers []string, managedPositionRebalanceFee osmomath.Dec, managedPositionRebalanceEpochIdentifier string, maxManagedPositionRebalancesPerEpoch uint64, positionHistoryRetentionBlocks uint64, maxLimitOrderFillsPerBlock uint64) Params {
 	return Params{
-		AuthorizedTickSpacing:               authorizedTickSpacing,
-		AuthorizedSpreadFactors:             authorizedSpreadFactors,
-		AuthorizedQuoteDenoms:               authorizedQuoteDenoms,
-		BalancerSharesRewardDiscount:        discountRate,
-		AuthorizedUptimes:                   authorizedUptimes,
-		IsPermissionlessPoolCreationEnabled: isPermissionlessPoolCreationEnabled,
-		UnrestrictedPoolCreatorWhitelist:    unrestrictedPoolCreatorWhitelist,
-		HookGasLimit:                        hookGasLimit,
+		AuthorizedTickSpacing:                   authorizedTickSpacing,
+		AuthorizedSpreadFactors:                 authorizedSpreadFactors,
+		AuthorizedQuoteDenoms:                   authorizedQuoteDenoms,
+		BalancerSharesRewardDiscount:            discountRate,
+		AuthorizedUptimes:                       authorizedUptimes,
+		IsPermissionlessPoolCreationEnabled:     isPermissionlessPoolCreationEnabled,
+		UnrestrictedPoolCreatorWhitelist:        unrestrictedPoolCreatorWhitelist,
+		HookGasLimit:                            hookGasLimit,
+		MaxIncentiveRecordsPerPool:              maxIncentiveRecordsPerPool,
+		MaxIncentiveRecordsPerUptime:            maxIncentiveRecordsPerUptime,
+		MaxPositionsPerWithdrawAll:              maxPositionsPerWithdrawAll,
+		AuthorizedLienholders:                   authorizedLienholders,
+		AuthorizedPositionRebalancers:           authorizedPositionRebalancers,
+		ManagedPositionRebalanceFee:             managedPositionRebalanceFee,
+		ManagedPositionRebalanceEpochIdentifier: managedPositionRebalanceEpochIdentifier,
+		MaxManagedPositionRebalancesPerEpoch:    maxManagedPositionRebalancesPerEpoch,
+		PositionHistoryRetentionBlocks:          positionHistoryRetentionBlocks,
+		MaxLimitOrderFillsPerBlock:              maxLimitOrderFillsPerBlock,
 	}
 }
 
@@ -54,11 +76,21 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
-		BalancerSharesRewardDiscount:        DefaultBalancerSharesDiscount,
-		AuthorizedUptimes:                   DefaultAuthorizedUptimes,
-		IsPermissionlessPoolCreationEnabled: false,
-		UnrestrictedPoolCreatorWhitelist:    DefaultUnrestrictedPoolCreatorWhitelist,
-		HookGasLimit:                        DefaultContractHookGasLimit,
+		BalancerSharesRewardDiscount:            DefaultBalancerSharesDiscount,
+		AuthorizedUptimes:                       DefaultAuthorizedUptimes,
+		IsPermissionlessPoolCreationEnabled:     false,
+		UnrestrictedPoolCreatorWhitelist:        DefaultUnrestrictedPoolCreatorWhitelist,
+		HookGasLimit:                            DefaultContractHookGasLimit,
+		MaxIncentiveRecordsPerPool:              DefaultMaxIncentiveRecordsPerPool,
+		MaxIncentiveRecordsPerUptime:            DefaultMaxIncentiveRecordsPerUptime,
+		MaxPositionsPerWithdrawAll:              DefaultMaxPositionsPerWithdrawAll,
+		AuthorizedLienholders:                   DefaultAuthorizedLienholders,
+		AuthorizedPositionRebalancers:           DefaultAuthorizedPositionRebalancers,
+		ManagedPositionRebalanceFee:             DefaultManagedPositionRebalanceFee,
+		ManagedPositionRebalanceEpochIdentifier: DefaultManagedPositionRebalanceEpochIdentifier,
+		MaxManagedPositionRebalancesPerEpoch:    DefaultMaxManagedPositionRebalancesPerEpoch,
+		PositionHistoryRetentionBlocks:          DefaultPositionHistoryRetentionBlocks,
+		MaxLimitOrderFillsPerBlock:              DefaultMaxLimitOrderFillsPerBlock,
 	}
 }
 
@@ -88,6 +120,36 @@ func (p Params) Validate() error {
 	if err := validateHookGasLimit(p.HookGasLimit); err != nil {
 		return err
 	}
+	if err := validateMaxIncentiveRecords(p.MaxIncentiveRecordsPerPool); err != nil {
+		return err
+	}
+	if err := validateMaxIncentiveRecords(p.MaxIncentiveRecordsPerUptime); err != nil {
+		return err
+	}
+	if err := validateMaxPositionsPerWithdrawAll(p.MaxPositionsPerWithdrawAll); err != nil {
+		return err
+	}
+	if err := osmoutils.ValidateAddressList(p.AuthorizedLienholders); err != nil {
+		return err
+	}
+	if err := osmoutils.ValidateAddressList(p.AuthorizedPositionRebalancers); err != nil {
+		return err
+	}
+	if err := validateManagedPositionRebalanceFee(p.ManagedPositionRebalanceFee); err != nil {
+		return err
+	}
+	if err := epochtypes.ValidateEpochIdentifierString(p.ManagedPositionRebalanceEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateMaxManagedPositionRebalances(p.MaxManagedPositionRebalancesPerEpoch); err != nil {
+		return err
+	}
+	if err := validatePositionHistoryRetentionBlocks(p.PositionHistoryRetentionBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxLimitOrderFillsPerBlock(p.MaxLimitOrderFillsPerBlock); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -102,6 +164,16 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyAuthorizedUptimes, &p.AuthorizedUptimes, validateAuthorizedUptimes),
 		paramtypes.NewParamSetPair(KeyUnrestrictedPoolCreatorWhitelist, &p.UnrestrictedPoolCreatorWhitelist, osmoutils.ValidateAddressList),
 		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validateHookGasLimit),
+		paramtypes.NewParamSetPair(KeyMaxIncentiveRecordsPerPool, &p.MaxIncentiveRecordsPerPool, validateMaxIncentiveRecords),
+		paramtypes.NewParamSetPair(KeyMaxIncentiveRecordsPerUptime, &p.MaxIncentiveRecordsPerUptime, validateMaxIncentiveRecords),
+		paramtypes.NewParamSetPair(KeyMaxPositionsPerWithdrawAll, &p.MaxPositionsPerWithdrawAll, validateMaxPositionsPerWithdrawAll),
+		paramtypes.NewParamSetPair(KeyAuthorizedLienholders, &p.AuthorizedLienholders, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyAuthorizedPositionRebalancers, &p.AuthorizedPositionRebalancers, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyManagedPositionRebalanceFee, &p.ManagedPositionRebalanceFee, validateManagedPositionRebalanceFee),
+		paramtypes.NewParamSetPair(KeyManagedPositionRebalanceEpoch, &p.ManagedPositionRebalanceEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaxManagedPositionRebalances, &p.MaxManagedPositionRebalancesPerEpoch, validateMaxManagedPositionRebalances),
+		paramtypes.NewParamSetPair(KeyPositionHistoryRetentionBlocks, &p.PositionHistoryRetentionBlocks, validatePositionHistoryRetentionBlocks),
+		paramtypes.NewParamSetPair(KeyMaxLimitOrderFillsPerBlock, &p.MaxLimitOrderFillsPerBlock, validateMaxLimitOrderFillsPerBlock),
 	}
 }
 
@@ -114,6 +186,10 @@ func validateTicks(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if !osmoassert.Uint64ArrayValuesAreUnique(authorizedTickSpacing) {
+		return fmt.Errorf("authorized tick spacings must be unique, got %v", authorizedTickSpacing)
+	}
+
 	// Both max and min ticks must be multiple of every authorized tick spacing.
 	// Otherwise, might end up running into edge cases when setting full range positions
 	// and not being able to reach max and min ticks.
@@ -231,8 +307,14 @@ func validateAuthorizedUptimes(i interface{}) error {
 		return fmt.Errorf("authorized uptimes cannot be empty")
 	}
 
-	// Check if each passed in uptime is in the list of supported uptimes
+	// Check if each passed in uptime is unique and in the list of supported uptimes
+	seenUptimes := make(map[time.Duration]struct{}, len(authorizedUptimes))
 	for _, uptime := range authorizedUptimes {
+		if _, ok := seenUptimes[uptime]; ok {
+			return fmt.Errorf("duplicate authorized uptime (%s)", uptime)
+		}
+		seenUptimes[uptime] = struct{}{}
+
 		supported := false
 		for _, supportedUptime := range SupportedUptimes {
 			if uptime == supportedUptime {
@@ -260,3 +342,86 @@ func validateHookGasLimit(i interface{}) error {
 
 	return nil
 }
+
+// validateMaxIncentiveRecords validates that the given incentive record cap is a positive uint64.
+// A zero cap is rejected since it would prevent any incentives from being created.
+func validateMaxIncentiveRecords(i interface{}) error {
+	maxIncentiveRecords, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for max incentive records: %T", i)
+	}
+
+	if maxIncentiveRecords == 0 {
+		return fmt.Errorf("max incentive records must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxPositionsPerWithdrawAll validates that the given parameter is a positive uint64.
+func validateMaxPositionsPerWithdrawAll(i interface{}) error {
+	maxPositions, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for max positions per withdraw all: %T", i)
+	}
+
+	if maxPositions == 0 {
+		return fmt.Errorf("max positions per withdraw all must be positive")
+	}
+
+	return nil
+}
+
+// validateManagedPositionRebalanceFee validates that the given parameter is a decimal
+// between 0 and MaxManagedPositionRebalanceFee.
+func validateManagedPositionRebalanceFee(i interface{}) error {
+	fee, ok := i.(osmomath.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for managed position rebalance fee: %T", i)
+	}
+
+	if fee.IsNil() || fee.IsNegative() || fee.GT(MaxManagedPositionRebalanceFee) {
+		return fmt.Errorf("managed position rebalance fee must be between 0 and %s, got %s", MaxManagedPositionRebalanceFee, fee)
+	}
+
+	return nil
+}
+
+// validateMaxManagedPositionRebalances validates that the given parameter is a positive uint64.
+func validateMaxManagedPositionRebalances(i interface{}) error {
+	maxRebalances, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for max managed position rebalances per epoch: %T", i)
+	}
+
+	if maxRebalances == 0 {
+		return fmt.Errorf("max managed position rebalances per epoch must be positive")
+	}
+
+	return nil
+}
+
+// validatePositionHistoryRetentionBlocks validates that the given parameter is a uint64.
+// Zero is allowed and disables the position history.
+func validatePositionHistoryRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for position history retention blocks: %T", i)
+	}
+
+	return nil
+}
+
+// validateMaxLimitOrderFillsPerBlock validates that the given parameter is a positive uint64.
+func validateMaxLimitOrderFillsPerBlock(i interface{}) error {
+	maxFills, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for max limit order fills per block: %T", i)
+	}
+
+	if maxFills == 0 {
+		return fmt.Errorf("max limit order fills per block must be positive")
+	}
+
+	return nil
+}
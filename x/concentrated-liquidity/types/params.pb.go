@@ -64,6 +64,42 @@ type Params struct {
 	// double creation of pools, etc.
 	UnrestrictedPoolCreatorWhitelist []string `protobuf:"bytes,7,rep,name=unrestricted_pool_creator_whitelist,json=unrestrictedPoolCreatorWhitelist,proto3" json:"unrestricted_pool_creator_whitelist,omitempty" yaml:"unrestricted_pool_creator_whitelist"`
 	HookGasLimit                     uint64   `protobuf:"varint,8,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
+	// max_incentive_records_per_pool is the maximum number of active incentive
+	// records that a pool can have across all uptimes. It bounds the iteration
+	// over incentive records when updating the uptime accumulators of the pool.
+	MaxIncentiveRecordsPerPool uint64 `protobuf:"varint,9,opt,name=max_incentive_records_per_pool,json=maxIncentiveRecordsPerPool,proto3" json:"max_incentive_records_per_pool,omitempty" yaml:"max_incentive_records_per_pool"`
+	// max_incentive_records_per_uptime is the maximum number of active incentive
+	// records that a pool can have for a single uptime.
+	MaxIncentiveRecordsPerUptime uint64 `protobuf:"varint,10,opt,name=max_incentive_records_per_uptime,json=maxIncentiveRecordsPerUptime,proto3" json:"max_incentive_records_per_uptime,omitempty" yaml:"max_incentive_records_per_uptime"`
+	// max_positions_per_withdraw_all is the maximum number of positions that a
+	// single MsgWithdrawAllPoolPositions withdraws.
+	MaxPositionsPerWithdrawAll uint64 `protobuf:"varint,11,opt,name=max_positions_per_withdraw_all,json=maxPositionsPerWithdrawAll,proto3" json:"max_positions_per_withdraw_all,omitempty" yaml:"max_positions_per_withdraw_all"`
+	// authorized_lienholders is a list of addresses, e.g. the accounts of
+	// lending modules or contracts, that positions can be locked to as
+	// collateral.
+	AuthorizedLienholders []string `protobuf:"bytes,12,rep,name=authorized_lienholders,json=authorizedLienholders,proto3" json:"authorized_lienholders,omitempty" yaml:"authorized_lienholders"`
+	// authorized_position_rebalancers is a list of addresses, e.g. keeper bots,
+	// that can rebalance managed positions out of range at any time, in
+	// exchange for the rebalance fee.
+	AuthorizedPositionRebalancers []string `protobuf:"bytes,13,rep,name=authorized_position_rebalancers,json=authorizedPositionRebalancers,proto3" json:"authorized_position_rebalancers,omitempty" yaml:"authorized_position_rebalancers"`
+	// managed_position_rebalance_fee is the fraction of the withdrawn amounts
+	// of a managed position charged when it is rebalanced. It is sent to the
+	// rebalancer, or to the community pool when rebalanced at epoch end.
+	ManagedPositionRebalanceFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=managed_position_rebalance_fee,json=managedPositionRebalanceFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"managed_position_rebalance_fee" yaml:"managed_position_rebalance_fee"`
+	// managed_position_rebalance_epoch_identifier is the identifier of the
+	// epoch at the end of which managed positions out of range are rebalanced.
+	ManagedPositionRebalanceEpochIdentifier string `protobuf:"bytes,15,opt,name=managed_position_rebalance_epoch_identifier,json=managedPositionRebalanceEpochIdentifier,proto3" json:"managed_position_rebalance_epoch_identifier,omitempty" yaml:"managed_position_rebalance_epoch_identifier"`
+	// max_managed_position_rebalances_per_epoch is the maximum number of
+	// rebalances attempted at the end of a rebalance epoch, including the
+	// ones that fail.
+	MaxManagedPositionRebalancesPerEpoch uint64 `protobuf:"varint,16,opt,name=max_managed_position_rebalances_per_epoch,json=maxManagedPositionRebalancesPerEpoch,proto3" json:"max_managed_position_rebalances_per_epoch,omitempty" yaml:"max_managed_position_rebalances_per_epoch"`
+	// position_history_retention_blocks is the number of blocks for which the
+	// lifecycle events of positions are kept. Zero disables the position history.
+	PositionHistoryRetentionBlocks uint64 `protobuf:"varint,17,opt,name=position_history_retention_blocks,json=positionHistoryRetentionBlocks,proto3" json:"position_history_retention_blocks,omitempty" yaml:"position_history_retention_blocks"`
+	// max_limit_order_fills_per_block is the maximum number of crossed limit
+	// orders filled at the end of a block. The remaining crossed orders are
+	// filled in the following blocks or when claimed.
+	MaxLimitOrderFillsPerBlock uint64 `protobuf:"varint,18,opt,name=max_limit_order_fills_per_block,json=maxLimitOrderFillsPerBlock,proto3" json:"max_limit_order_fills_per_block,omitempty" yaml:"max_limit_order_fills_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -141,6 +177,69 @@ func (m *Params) GetHookGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxIncentiveRecordsPerPool() uint64 {
+	if m != nil {
+		return m.MaxIncentiveRecordsPerPool
+	}
+	return 0
+}
+
+func (m *Params) GetMaxIncentiveRecordsPerUptime() uint64 {
+	if m != nil {
+		return m.MaxIncentiveRecordsPerUptime
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPositionsPerWithdrawAll() uint64 {
+	if m != nil {
+		return m.MaxPositionsPerWithdrawAll
+	}
+	return 0
+}
+
+func (m *Params) GetAuthorizedLienholders() []string {
+	if m != nil {
+		return m.AuthorizedLienholders
+	}
+	return nil
+}
+
+func (m *Params) GetAuthorizedPositionRebalancers() []string {
+	if m != nil {
+		return m.AuthorizedPositionRebalancers
+	}
+	return nil
+}
+
+func (m *Params) GetManagedPositionRebalanceEpochIdentifier() string {
+	if m != nil {
+		return m.ManagedPositionRebalanceEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxManagedPositionRebalancesPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxManagedPositionRebalancesPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetPositionHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.PositionHistoryRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLimitOrderFillsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxLimitOrderFillsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -150,47 +249,70 @@ func init() {
 }
 
 var fileDescriptor_42a3f6981164624c = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x4f, 0x6b, 0xd4, 0x4e,
-	0x18, 0xc7, 0x37, 0xbf, 0xed, 0xaf, 0x7f, 0xa2, 0x08, 0x06, 0x8b, 0xd9, 0xaa, 0x49, 0x48, 0x41,
-	0x97, 0x62, 0x13, 0xac, 0x37, 0x3d, 0x08, 0x71, 0xb5, 0x97, 0x0a, 0x35, 0x55, 0x84, 0x22, 0x0c,
-	0xb3, 0x93, 0x69, 0x76, 0xd8, 0x24, 0x4f, 0x3a, 0x33, 0xb1, 0xae, 0xe0, 0x49, 0x04, 0x8f, 0x1e,
-	0x3c, 0xf8, 0x32, 0x7c, 0x19, 0x3d, 0xf6, 0x28, 0x1e, 0xa2, 0xb4, 0x37, 0x8f, 0xfb, 0x0a, 0x64,
-	0x33, 0x59, 0xbb, 0x6b, 0x2b, 0xee, 0x2d, 0xcf, 0xf3, 0xfd, 0x3c, 0x7f, 0xf2, 0xe5, 0x61, 0xf4,
-	0x35, 0x10, 0x29, 0x08, 0x26, 0x7c, 0x02, 0x19, 0xa1, 0x99, 0xe4, 0x58, 0xd2, 0x28, 0x61, 0xfb,
-	0x05, 0x8b, 0x98, 0x1c, 0xf8, 0x39, 0xe6, 0x38, 0x15, 0x5e, 0xce, 0x41, 0x82, 0x71, 0xa3, 0x66,
-	0xbd, 0x73, 0xd9, 0x95, 0x2b, 0x31, 0xc4, 0x50, 0x91, 0xfe, 0xe8, 0x4b, 0x15, 0xad, 0xb4, 0x48,
-	0x55, 0x85, 0x94, 0xa0, 0x82, 0x5a, 0xb2, 0x62, 0x80, 0x38, 0xa1, 0x7e, 0x15, 0x75, 0x8b, 0x3d,
-	0x3f, 0x2a, 0x38, 0x96, 0x0c, 0x32, 0xa5, 0xbb, 0x5f, 0x16, 0xf4, 0xf9, 0xed, 0x6a, 0x01, 0x63,
-	0x57, 0xbf, 0x8a, 0x0b, 0xd9, 0x03, 0xce, 0xde, 0xd0, 0x08, 0x49, 0x46, 0xfa, 0x48, 0xe4, 0x98,
-	0xb0, 0x2c, 0x36, 0x35, 0xa7, 0xd9, 0x9e, 0x0b, 0xdc, 0x61, 0x69, 0x5b, 0x03, 0x9c, 0x26, 0xf7,
-	0xdc, 0xbf, 0x80, 0x6e, 0xb8, 0x7c, 0xaa, 0x3c, 0x63, 0xa4, 0xbf, 0xa3, 0xf2, 0xc6, 0x3b, 0x4d,
-	0x6f, 0x4d, 0xd4, 0x88, 0x9c, 0x53, 0x1c, 0xa1, 0x3d, 0x4c, 0x24, 0x70, 0x61, 0xfe, 0xe7, 0x34,
-	0xdb, 0x4b, 0xc1, 0xe6, 0x61, 0x69, 0x37, 0xbe, 0x95, 0xf6, 0x35, 0xf5, 0x03, 0x22, 0xea, 0x7b,
-	0x0c, 0xfc, 0x14, 0xcb, 0x9e, 0xb7, 0x45, 0x63, 0x4c, 0x06, 0x1d, 0x4a, 0x86, 0xa5, 0xed, 0x9c,
-	0xd9, 0x60, 0xba, 0x9b, 0x1b, 0x4e, 0xfc, 0xc6, 0x4e, 0x25, 0x3d, 0x56, 0x8a, 0xf1, 0x49, 0xd3,
-	0xed, 0x2e, 0x4e, 0x70, 0x46, 0x28, 0x47, 0xa2, 0x87, 0x39, 0x15, 0x88, 0xd3, 0x03, 0xcc, 0x23,
-	0x14, 0x31, 0x41, 0xa0, 0xc8, 0xa4, 0xd9, 0x74, 0xb4, 0xf6, 0x52, 0xf0, 0x64, 0xb6, 0x5d, 0x6e,
-	0xaa, 0x5d, 0xfe, 0xd1, 0xd3, 0x0d, 0xaf, 0x8f, 0x89, 0x9d, 0x0a, 0x08, 0x2b, 0xbd, 0x53, 0xcb,
-	0x7f, 0x18, 0xbf, 0x5f, 0x80, 0xa4, 0x28, 0xa2, 0x19, 0xa4, 0xc2, 0x9c, 0xab, 0x9c, 0x39, 0xdf,
-	0xf8, 0x49, 0x70, 0xca, 0xf8, 0xa7, 0x23, 0xa1, 0x53, 0xe5, 0x8d, 0xf7, 0x9a, 0x6e, 0x4c, 0xd4,
-	0x14, 0xb9, 0x64, 0x29, 0x15, 0xe6, 0xff, 0x4e, 0xb3, 0x7d, 0x61, 0xa3, 0xe5, 0xa9, 0xeb, 0xf0,
-	0xc6, 0xd7, 0xe1, 0x75, 0xea, 0xeb, 0x08, 0xee, 0x8f, 0x0c, 0xf8, 0x59, 0xda, 0xc6, 0xf8, 0x5e,
-	0x6e, 0x43, 0xca, 0x24, 0x4d, 0x73, 0x39, 0x18, 0x96, 0x76, 0xeb, 0xcc, 0x32, 0x75, 0x63, 0xf7,
-	0xf3, 0x77, 0x5b, 0x0b, 0x2f, 0x9f, 0x0a, 0xcf, 0x55, 0xde, 0xf8, 0xa0, 0xe9, 0xb7, 0x98, 0x40,
-	0x39, 0xe5, 0x29, 0x13, 0x82, 0x41, 0x96, 0x50, 0x21, 0x50, 0x0e, 0x90, 0x20, 0xc2, 0x69, 0x35,
-	0x01, 0xd1, 0x0c, 0x77, 0x13, 0x1a, 0x99, 0xf3, 0x8e, 0xd6, 0x5e, 0x0c, 0x36, 0x86, 0xa5, 0xed,
-	0xa9, 0x39, 0x33, 0x16, 0xba, 0xe1, 0x2a, 0x13, 0xdb, 0x53, 0xe0, 0x36, 0x40, 0xf2, 0xb0, 0xc6,
-	0x1e, 0x29, 0xca, 0x78, 0xab, 0xaf, 0x16, 0x19, 0xa7, 0x42, 0x72, 0x46, 0x24, 0x8d, 0x26, 0x7a,
-	0x01, 0x47, 0x07, 0x3d, 0x26, 0x69, 0xc2, 0x84, 0x34, 0x17, 0x2a, 0xeb, 0xbd, 0x61, 0x69, 0xaf,
-	0xa9, 0x2d, 0x66, 0x28, 0x72, 0x43, 0x67, 0x92, 0xfa, 0x3d, 0x1d, 0xf8, 0x8b, 0x31, 0x62, 0x3c,
-	0xd0, 0x2f, 0xf5, 0x00, 0xfa, 0x28, 0xc6, 0x02, 0x25, 0x2c, 0x65, 0xd2, 0x5c, 0x74, 0xb4, 0xf6,
-	0x5c, 0xd0, 0x1a, 0x96, 0xf6, 0xb2, 0x9a, 0x34, 0xad, 0xbb, 0xe1, 0xc5, 0x51, 0x62, 0x13, 0x8b,
-	0xad, 0x51, 0x18, 0xbc, 0x3c, 0x3c, 0xb6, 0xb4, 0xa3, 0x63, 0x4b, 0xfb, 0x71, 0x6c, 0x69, 0x1f,
-	0x4f, 0xac, 0xc6, 0xd1, 0x89, 0xd5, 0xf8, 0x7a, 0x62, 0x35, 0x76, 0x83, 0x98, 0xc9, 0x5e, 0xd1,
-	0xf5, 0x08, 0xa4, 0x7e, 0xfd, 0x8e, 0xac, 0x27, 0xb8, 0x2b, 0xc6, 0x81, 0xff, 0x6a, 0xe3, 0x8e,
-	0xff, 0x7a, 0xea, 0x19, 0x5a, 0x3f, 0x7d, 0x87, 0xe4, 0x20, 0xa7, 0xa2, 0x3b, 0x5f, 0xdd, 0xc2,
-	0xdd, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x56, 0xb5, 0x87, 0xb5, 0x04, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x8d, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xae, 0x69, 0x08, 0xad, 0x5b, 0xfa, 0x63, 0x44, 0xc1, 0x9b, 0x92, 0xdd, 0xad, 0x5b, 0x48,
+	0x9b, 0xb6, 0x36, 0x04, 0xc4, 0x01, 0x0e, 0x88, 0x25, 0x4d, 0x5b, 0x29, 0x15, 0xc1, 0xa1, 0x2a,
+	0xaa, 0x90, 0xac, 0x59, 0x7b, 0x76, 0x3d, 0xca, 0xd8, 0xe3, 0xce, 0xd8, 0xdd, 0x2e, 0x12, 0x27,
+	0x84, 0xc4, 0x05, 0x89, 0x03, 0x07, 0xfe, 0xa4, 0x1e, 0x7b, 0x44, 0x1c, 0x02, 0x82, 0x1b, 0xc7,
+	0xfe, 0x05, 0xbc, 0x99, 0xb1, 0xb3, 0xde, 0x66, 0x37, 0x9b, 0x83, 0xa5, 0x9d, 0xf9, 0xbe, 0xf7,
+	0xde, 0xf7, 0xde, 0xbc, 0x79, 0x3b, 0xf6, 0x3a, 0x97, 0x29, 0x97, 0x54, 0xfa, 0x11, 0xcf, 0x22,
+	0x92, 0x15, 0x02, 0x17, 0x24, 0x66, 0xf4, 0x49, 0x49, 0x63, 0x5a, 0x8c, 0xfd, 0x1c, 0x0b, 0x9c,
+	0x4a, 0x2f, 0x17, 0xbc, 0xe0, 0x68, 0xb5, 0xe2, 0x7a, 0x33, 0xb9, 0x2b, 0x6f, 0x0d, 0xf9, 0x90,
+	0x6b, 0xa6, 0xaf, 0x7e, 0x19, 0xa3, 0x95, 0x56, 0xa4, 0xad, 0x42, 0x03, 0x98, 0x45, 0x05, 0xb5,
+	0x87, 0x9c, 0x0f, 0x19, 0xf1, 0xf5, 0xaa, 0x5f, 0x0e, 0xfc, 0xb8, 0x04, 0x97, 0x94, 0x67, 0x06,
+	0x77, 0x5f, 0x5e, 0xb0, 0x97, 0x77, 0xb4, 0x00, 0xf4, 0xd8, 0x7e, 0x07, 0x97, 0x45, 0xc2, 0x05,
+	0xfd, 0x9e, 0xc4, 0x61, 0x41, 0xa3, 0xbd, 0x50, 0xe6, 0x38, 0xa2, 0xd9, 0xd0, 0xb1, 0xba, 0x27,
+	0xaf, 0x2f, 0xf5, 0xdc, 0x97, 0xfb, 0x9d, 0xf6, 0x18, 0xa7, 0xec, 0x53, 0x77, 0x0e, 0xd1, 0x0d,
+	0x2e, 0x4d, 0x90, 0x6f, 0x00, 0xd8, 0x35, 0xfb, 0xe8, 0x47, 0xcb, 0x6e, 0x35, 0x6c, 0x64, 0x2e,
+	0x08, 0x8e, 0xc3, 0x01, 0x8e, 0x0a, 0x2e, 0xa4, 0xf3, 0x1a, 0xb8, 0x3f, 0xdd, 0xbb, 0xfb, 0x7c,
+	0xbf, 0x73, 0xe2, 0xcf, 0xfd, 0xce, 0x65, 0x93, 0x80, 0x8c, 0xf7, 0x3c, 0xca, 0xfd, 0x14, 0x17,
+	0x89, 0xb7, 0x4d, 0x86, 0x38, 0x1a, 0x6f, 0x92, 0x08, 0x14, 0x74, 0x0f, 0x29, 0x98, 0xf6, 0xe6,
+	0x06, 0x8d, 0x34, 0x76, 0x35, 0xb4, 0x65, 0x10, 0xf4, 0x9b, 0x65, 0x77, 0xfa, 0x98, 0x61, 0xa8,
+	0xac, 0x08, 0x65, 0x82, 0x05, 0x91, 0xa1, 0x20, 0x23, 0x2c, 0xe2, 0x30, 0xa6, 0x32, 0xe2, 0x65,
+	0x56, 0x38, 0x27, 0xbb, 0x16, 0x68, 0x79, 0x70, 0x3c, 0x2d, 0xef, 0x1b, 0x2d, 0x0b, 0x7c, 0xba,
+	0xc1, 0xbb, 0x35, 0x63, 0x57, 0x13, 0x02, 0x8d, 0x6f, 0x56, 0xf0, 0x2b, 0x85, 0x7f, 0x52, 0xf2,
+	0x82, 0x84, 0x31, 0xc9, 0x78, 0x2a, 0x9d, 0x25, 0x5d, 0x99, 0xd9, 0x85, 0x6f, 0x12, 0xa7, 0x0a,
+	0xff, 0xb5, 0x02, 0x36, 0xf5, 0x3e, 0xfa, 0xc9, 0xb2, 0x51, 0xc3, 0xa6, 0xcc, 0x0b, 0x9a, 0x12,
+	0xe9, 0xbc, 0x0e, 0x7e, 0xcf, 0x6c, 0xb4, 0x3c, 0xd3, 0x1d, 0x5e, 0xdd, 0x1d, 0xde, 0x66, 0xd5,
+	0x1d, 0xbd, 0xcf, 0x54, 0x01, 0xfe, 0xdb, 0xef, 0xa0, 0xba, 0x5f, 0x6e, 0xf1, 0x94, 0x16, 0x24,
+	0xcd, 0x8b, 0x31, 0x88, 0x69, 0x1d, 0x12, 0x53, 0x39, 0x76, 0x7f, 0xff, 0xab, 0x63, 0x05, 0x17,
+	0x27, 0xc0, 0x43, 0xb3, 0x8f, 0x7e, 0xb6, 0xec, 0x35, 0x0a, 0x1d, 0x4a, 0x44, 0x4a, 0xa5, 0x04,
+	0x7f, 0x8c, 0x48, 0x58, 0x72, 0xce, 0xc2, 0x08, 0x8e, 0x48, 0x45, 0x08, 0x49, 0x86, 0xfb, 0x8c,
+	0xc4, 0xce, 0x32, 0x1c, 0xc1, 0xa9, 0xde, 0x06, 0xc4, 0xf1, 0x4c, 0x9c, 0x63, 0x1a, 0xba, 0xc1,
+	0x55, 0x2a, 0x77, 0xa6, 0x88, 0x3b, 0xc0, 0xfb, 0xb2, 0xa2, 0xdd, 0x31, 0x2c, 0xf4, 0x83, 0x7d,
+	0xb5, 0xcc, 0xe0, 0x14, 0x0a, 0x41, 0x23, 0xb8, 0x5c, 0x0d, 0x5f, 0x5c, 0x84, 0xa3, 0x04, 0xb2,
+	0x64, 0x54, 0x16, 0xce, 0x1b, 0xba, 0xf4, 0x1e, 0xa8, 0x58, 0x37, 0x2a, 0x8e, 0x61, 0xe4, 0x06,
+	0xdd, 0x26, 0xeb, 0x20, 0x3a, 0x17, 0x8f, 0x6a, 0x0a, 0xfa, 0xdc, 0x3e, 0x97, 0x70, 0xbe, 0x17,
+	0x0e, 0xb1, 0x0c, 0x19, 0x85, 0xa2, 0x3a, 0xa7, 0x20, 0xdf, 0xa5, 0x5e, 0x0b, 0x22, 0x5d, 0x32,
+	0x91, 0xa6, 0x71, 0x37, 0x38, 0xab, 0x36, 0xee, 0x62, 0xb9, 0xad, 0x96, 0x28, 0xb5, 0xdb, 0x29,
+	0x7e, 0x16, 0x52, 0x3d, 0x1f, 0xe8, 0x53, 0x02, 0xed, 0x16, 0x71, 0x11, 0xeb, 0x1a, 0x69, 0x5d,
+	0xce, 0x69, 0xed, 0xf0, 0x06, 0x38, 0x7c, 0xcf, 0x38, 0x3c, 0x9a, 0xef, 0x06, 0x2b, 0x40, 0xb8,
+	0x5f, 0xe3, 0x81, 0x81, 0xa1, 0x90, 0x4a, 0x3f, 0x92, 0x76, 0x77, 0xbe, 0xb9, 0x39, 0x76, 0xc7,
+	0xd6, 0x01, 0x6f, 0x42, 0xc0, 0xb5, 0x45, 0x01, 0x8d, 0x05, 0x5c, 0x89, 0xd9, 0x21, 0x4d, 0xbf,
+	0xd4, 0x39, 0xe6, 0x30, 0x0a, 0xd5, 0xd1, 0x19, 0xd3, 0x11, 0x2d, 0x92, 0x58, 0xe0, 0x51, 0x88,
+	0x19, 0x73, 0xce, 0xcc, 0xca, 0x71, 0x3e, 0xdf, 0xe4, 0xb8, 0x53, 0xe3, 0x10, 0xe9, 0x51, 0x85,
+	0x7e, 0xc1, 0x18, 0xfa, 0xd6, 0x7e, 0xbb, 0xd1, 0xcb, 0x8c, 0x92, 0x2c, 0xe1, 0x2c, 0x26, 0x30,
+	0x9a, 0xce, 0xea, 0x2e, 0xb8, 0x02, 0x61, 0x56, 0x0f, 0xf5, 0x7c, 0x83, 0x37, 0x75, 0xff, 0xb6,
+	0x27, 0xfb, 0x48, 0xd8, 0x9d, 0x86, 0x45, 0xad, 0x0f, 0x2a, 0x52, 0x4f, 0x04, 0xe9, 0xbc, 0xa9,
+	0x43, 0xac, 0x4f, 0xc6, 0xc9, 0x02, 0x03, 0x37, 0x58, 0x9d, 0x30, 0xea, 0x8c, 0x82, 0x09, 0x8e,
+	0x7e, 0xb1, 0x54, 0xf5, 0x32, 0x3c, 0x9c, 0xe9, 0x20, 0x1c, 0x10, 0xe2, 0x9c, 0xd3, 0x53, 0xee,
+	0xde, 0xe2, 0x09, 0x77, 0x50, 0xdc, 0xa3, 0xdc, 0xb9, 0xc1, 0xe5, 0x8a, 0x70, 0x48, 0xce, 0x16,
+	0x21, 0x6a, 0xec, 0xde, 0x3c, 0xc2, 0x01, 0xc9, 0x79, 0x94, 0x84, 0x34, 0x56, 0xad, 0x30, 0xa0,
+	0x44, 0x38, 0xe7, 0xb5, 0xb8, 0x4f, 0x20, 0xfa, 0xc6, 0xc2, 0xe8, 0xaf, 0x1a, 0xbb, 0xc1, 0xda,
+	0x3c, 0x29, 0x77, 0x14, 0xf5, 0xfe, 0x01, 0x53, 0x95, 0xe9, 0x86, 0x6a, 0x9a, 0xf9, 0xde, 0x4d,
+	0x1b, 0xe9, 0x10, 0xce, 0x05, 0xdd, 0x6f, 0x1f, 0x83, 0xa8, 0x0f, 0x26, 0xfd, 0x76, 0x2c, 0x53,
+	0x37, 0xb8, 0x06, 0xdc, 0x07, 0x73, 0x54, 0xa9, 0x56, 0xd4, 0xca, 0xd0, 0xc8, 0xbe, 0x72, 0xe0,
+	0x27, 0x81, 0x49, 0xc1, 0xc5, 0x18, 0xfc, 0x15, 0x4a, 0x2f, 0xec, 0xf4, 0x19, 0x8f, 0xf6, 0xa4,
+	0x73, 0x51, 0xcb, 0xb8, 0x05, 0x32, 0xae, 0x1b, 0x19, 0x0b, 0x4d, 0xdc, 0xa0, 0x5d, 0x73, 0xee,
+	0x19, 0x4a, 0x50, 0x33, 0x7a, 0x9a, 0x80, 0xb8, 0xdd, 0x51, 0xc9, 0xe8, 0x61, 0x13, 0xc2, 0x3d,
+	0x04, 0xe1, 0x03, 0xca, 0x98, 0x49, 0x41, 0x3b, 0x71, 0x90, 0x0e, 0xdb, 0xe8, 0xd1, 0x05, 0x06,
+	0xe6, 0xba, 0xe9, 0x79, 0xf5, 0x95, 0xc2, 0xb7, 0x14, 0x0c, 0x99, 0xea, 0x88, 0xbd, 0xef, 0x9e,
+	0xff, 0xd3, 0xb6, 0x5e, 0xc0, 0xf7, 0x37, 0x7c, 0xbf, 0xfe, 0xdb, 0x3e, 0xf1, 0x02, 0xbe, 0x3f,
+	0xe0, 0x7b, 0xdc, 0x1b, 0xc2, 0xad, 0x2c, 0xfb, 0xf0, 0xfa, 0x49, 0xfd, 0xea, 0x25, 0x74, 0x9b,
+	0xe1, 0xbe, 0xac, 0x17, 0xfe, 0xd3, 0x8d, 0x0f, 0xfd, 0x67, 0x53, 0x0f, 0xa9, 0xdb, 0x93, 0x97,
+	0x54, 0x31, 0xce, 0x89, 0xec, 0x2f, 0xeb, 0x7f, 0xb3, 0x8f, 0xfe, 0x07, 0xb0, 0xf4, 0xfe, 0xbd,
+	0x77, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -213,6 +335,77 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxLimitOrderFillsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLimitOrderFillsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.PositionHistoryRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PositionHistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxManagedPositionRebalancesPerEpoch != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxManagedPositionRebalancesPerEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ManagedPositionRebalanceEpochIdentifier) > 0 {
+		i -= len(m.ManagedPositionRebalanceEpochIdentifier)
+		copy(dAtA[i:], m.ManagedPositionRebalanceEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ManagedPositionRebalanceEpochIdentifier)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.ManagedPositionRebalanceFee.Size()
+		i -= size
+		if _, err := m.ManagedPositionRebalanceFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.AuthorizedPositionRebalancers) > 0 {
+		for iNdEx := len(m.AuthorizedPositionRebalancers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizedPositionRebalancers[iNdEx])
+			copy(dAtA[i:], m.AuthorizedPositionRebalancers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AuthorizedPositionRebalancers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AuthorizedLienholders) > 0 {
+		for iNdEx := len(m.AuthorizedLienholders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizedLienholders[iNdEx])
+			copy(dAtA[i:], m.AuthorizedLienholders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AuthorizedLienholders[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxPositionsPerWithdrawAll != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPositionsPerWithdrawAll))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxIncentiveRecordsPerUptime != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIncentiveRecordsPerUptime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxIncentiveRecordsPerPool != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxIncentiveRecordsPerPool))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.HookGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
 		i--
@@ -239,12 +432,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.AuthorizedUptimes) > 0 {
 		for iNdEx := len(m.AuthorizedUptimes) - 1; iNdEx >= 0; iNdEx-- {
-			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorizedUptimes[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorizedUptimes[iNdEx]):])
-			if err != nil {
-				return 0, err
+			n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AuthorizedUptimes[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AuthorizedUptimes[iNdEx]):])
+			if err1 != nil {
+				return 0, err1
 			}
-			i -= n
-			i = encodeVarintParams(dAtA, i, uint64(n))
+			i -= n1
+			i = encodeVarintParams(dAtA, i, uint64(n1))
 			i--
 			dAtA[i] = 0x2a
 		}
@@ -283,20 +476,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.AuthorizedTickSpacing) > 0 {
-		dAtA2 := make([]byte, len(m.AuthorizedTickSpacing)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.AuthorizedTickSpacing)*10)
+		var j2 int
 		for _, num := range m.AuthorizedTickSpacing {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintParams(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -359,6 +552,42 @@ func (m *Params) Size() (n int) {
 	if m.HookGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.HookGasLimit))
 	}
+	if m.MaxIncentiveRecordsPerPool != 0 {
+		n += 1 + sovParams(uint64(m.MaxIncentiveRecordsPerPool))
+	}
+	if m.MaxIncentiveRecordsPerUptime != 0 {
+		n += 1 + sovParams(uint64(m.MaxIncentiveRecordsPerUptime))
+	}
+	if m.MaxPositionsPerWithdrawAll != 0 {
+		n += 1 + sovParams(uint64(m.MaxPositionsPerWithdrawAll))
+	}
+	if len(m.AuthorizedLienholders) > 0 {
+		for _, s := range m.AuthorizedLienholders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AuthorizedPositionRebalancers) > 0 {
+		for _, s := range m.AuthorizedPositionRebalancers {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.ManagedPositionRebalanceFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = len(m.ManagedPositionRebalanceEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxManagedPositionRebalancesPerEpoch != 0 {
+		n += 2 + sovParams(uint64(m.MaxManagedPositionRebalancesPerEpoch))
+	}
+	if m.PositionHistoryRetentionBlocks != 0 {
+		n += 2 + sovParams(uint64(m.PositionHistoryRetentionBlocks))
+	}
+	if m.MaxLimitOrderFillsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxLimitOrderFillsPerBlock))
+	}
 	return n
 }
 
@@ -680,6 +909,250 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncentiveRecordsPerPool", wireType)
+			}
+			m.MaxIncentiveRecordsPerPool = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncentiveRecordsPerPool |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncentiveRecordsPerUptime", wireType)
+			}
+			m.MaxIncentiveRecordsPerUptime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncentiveRecordsPerUptime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionsPerWithdrawAll", wireType)
+			}
+			m.MaxPositionsPerWithdrawAll = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPositionsPerWithdrawAll |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedLienholders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedLienholders = append(m.AuthorizedLienholders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedPositionRebalancers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedPositionRebalancers = append(m.AuthorizedPositionRebalancers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositionRebalanceFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ManagedPositionRebalanceFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedPositionRebalanceEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ManagedPositionRebalanceEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxManagedPositionRebalancesPerEpoch", wireType)
+			}
+			m.MaxManagedPositionRebalancesPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxManagedPositionRebalancesPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionHistoryRetentionBlocks", wireType)
+			}
+			m.PositionHistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionHistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLimitOrderFillsPerBlock", wireType)
+			}
+			m.MaxLimitOrderFillsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLimitOrderFillsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
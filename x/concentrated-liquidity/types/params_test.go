@@ -17,6 +17,10 @@ func TestValidateTicks(t *testing.T) {
 		"happy path": {
 			i: []uint64{1, 100},
 		},
+		"error: duplicate tick spacing": {
+			i:           []uint64{1, 100, 1},
+			expectError: true,
+		},
 		"error: zero tick spacing": {
 			i:           []uint64{1, 0},
 			expectError: true,
@@ -107,3 +111,73 @@ func TestValidateBalancerSharesDiscount(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMaxIncentiveRecords(t *testing.T) {
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: types.DefaultMaxIncentiveRecordsPerPool,
+		},
+		"one": {
+			i: uint64(1),
+		},
+		"error: zero": {
+			i:           uint64(0),
+			expectError: true,
+		},
+		"error: wrong type": {
+			i:           int64(1),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateMaxIncentiveRecords(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateMaxPositionsPerWithdrawAll(t *testing.T) {
+	tests := map[string]struct {
+		i           interface{}
+		expectError bool
+	}{
+		"happy path": {
+			i: types.DefaultMaxPositionsPerWithdrawAll,
+		},
+		"one": {
+			i: uint64(1),
+		},
+		"error: zero": {
+			i:           uint64(0),
+			expectError: true,
+		},
+		"error: wrong type": {
+			i:           int64(1),
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := types.ValidateMaxPositionsPerWithdrawAll(tc.i)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types/genesis"
+)
+
+// VerifyPoolsState recomputes the state derived from positions for every concentrated pool
+// and compares it against the values persisted in the store.
+// It is meant to be run by node operators after state-sync or upgrades to validate that
+// the node's concentrated liquidity state is internally consistent.
+//
+// The following is checked for every pool:
+// - the pool's current liquidity equals the sum of liquidity of all positions whose range contains the current tick.
+// - every initialized tick's liquidity net and gross equal the values recomputed from the positions with this tick as a boundary.
+// - every position boundary tick is initialized.
+// - the total shares of the spread reward and all uptime accumulators equal the sum of liquidity of all positions.
+// - the spread reward and uptime accumulators, as well as the growth trackers of every initialized tick, are non-negative.
+// - the pool balance covers the amounts that would be returned if every position was fully withdrawn.
+//
+// Does not mutate state. Returns all discrepancies found or an error if state fails to be read.
+func (k Keeper) VerifyPoolsState(ctx sdk.Context) ([]types.StateDiscrepancy, error) {
+	pools, err := k.GetPools(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	allPositions, err := k.GetAllPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	positionsByPoolId := make(map[uint64][]model.Position, len(pools))
+	for _, position := range allPositions {
+		positionsByPoolId[position.PoolId] = append(positionsByPoolId[position.PoolId], position)
+	}
+
+	discrepancies := []types.StateDiscrepancy{}
+	for _, poolI := range pools {
+		pool, err := asConcentrated(poolI)
+		if err != nil {
+			return nil, err
+		}
+
+		poolDiscrepancies, err := k.verifyPoolState(ctx, pool, positionsByPoolId[pool.GetId()])
+		if err != nil {
+			return nil, err
+		}
+
+		discrepancies = append(discrepancies, poolDiscrepancies...)
+	}
+
+	return discrepancies, nil
+}
+
+// VerifyPoolState performs the same checks as VerifyPoolsState for a single pool.
+func (k Keeper) VerifyPoolState(ctx sdk.Context, poolId uint64) ([]types.StateDiscrepancy, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := k.getPoolPositions(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.verifyPoolState(ctx, pool, positions)
+}
+
+// getPoolPositions returns all positions of the given pool.
+func (k Keeper) getPoolPositions(ctx sdk.Context, poolId uint64) ([]model.Position, error) {
+	positionIds, err := k.GetAllPositionIdsForPoolId(ctx, types.PositionPrefix, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]model.Position, 0, len(positionIds))
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return nil, err
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// verifyPoolState recomputes the derived state of the given pool from the given positions
+// and returns the discrepancies against the stored values.
+func (k Keeper) verifyPoolState(ctx sdk.Context, pool types.ConcentratedPoolExtension, positions []model.Position) ([]types.StateDiscrepancy, error) {
+	poolId := pool.GetId()
+	discrepancies := []types.StateDiscrepancy{}
+
+	totalLiquidity := osmomath.ZeroDec()
+	activeLiquidity := osmomath.ZeroDec()
+	expectedLiquidityNet := map[int64]osmomath.Dec{}
+	expectedLiquidityGross := map[int64]osmomath.Dec{}
+	for _, position := range positions {
+		totalLiquidity = totalLiquidity.Add(position.Liquidity)
+
+		if pool.IsCurrentTickInRange(position.LowerTick, position.UpperTick) {
+			activeLiquidity = activeLiquidity.Add(position.Liquidity)
+		}
+
+		addToTickLiquidity(expectedLiquidityNet, position.LowerTick, position.Liquidity)
+		addToTickLiquidity(expectedLiquidityNet, position.UpperTick, position.Liquidity.Neg())
+		addToTickLiquidity(expectedLiquidityGross, position.LowerTick, position.Liquidity)
+		addToTickLiquidity(expectedLiquidityGross, position.UpperTick, position.Liquidity)
+	}
+
+	if !activeLiquidity.Equal(pool.GetLiquidity()) {
+		discrepancies = append(discrepancies, types.StateDiscrepancy{
+			PoolId:   poolId,
+			Check:    types.CheckCurrentLiquidity,
+			Expected: activeLiquidity.String(),
+			Actual:   pool.GetLiquidity().String(),
+		})
+	}
+
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	initializedTicks := make(map[int64]struct{}, len(ticks))
+	for _, tick := range ticks {
+		initializedTicks[tick.TickIndex] = struct{}{}
+
+		expectedNet, ok := expectedLiquidityNet[tick.TickIndex]
+		if !ok {
+			expectedNet = osmomath.ZeroDec()
+		}
+		if !expectedNet.Equal(tick.Info.LiquidityNet) {
+			discrepancies = append(discrepancies, types.StateDiscrepancy{
+				PoolId:   poolId,
+				Check:    types.CheckTickLiquidityNet,
+				Subject:  fmt.Sprintf("tick %d", tick.TickIndex),
+				Expected: expectedNet.String(),
+				Actual:   tick.Info.LiquidityNet.String(),
+			})
+		}
+
+		expectedGross, ok := expectedLiquidityGross[tick.TickIndex]
+		if !ok {
+			expectedGross = osmomath.ZeroDec()
+		}
+		if !expectedGross.Equal(tick.Info.LiquidityGross) {
+			discrepancies = append(discrepancies, types.StateDiscrepancy{
+				PoolId:   poolId,
+				Check:    types.CheckTickLiquidityGross,
+				Subject:  fmt.Sprintf("tick %d", tick.TickIndex),
+				Expected: expectedGross.String(),
+				Actual:   tick.Info.LiquidityGross.String(),
+			})
+		}
+	}
+
+	// Iterate over sorted ticks for deterministic output.
+	expectedTicks := make([]int64, 0, len(expectedLiquidityGross))
+	for tickIndex := range expectedLiquidityGross {
+		expectedTicks = append(expectedTicks, tickIndex)
+	}
+	sort.Slice(expectedTicks, func(i, j int) bool { return expectedTicks[i] < expectedTicks[j] })
+
+	for _, tickIndex := range expectedTicks {
+		if _, ok := initializedTicks[tickIndex]; !ok {
+			discrepancies = append(discrepancies, types.StateDiscrepancy{
+				PoolId:   poolId,
+				Check:    types.CheckTickInitialized,
+				Subject:  fmt.Sprintf("tick %d", tickIndex),
+				Expected: expectedLiquidityGross[tickIndex].String(),
+				Actual:   "not initialized",
+			})
+		}
+	}
+
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !totalLiquidity.Equal(spreadRewardAccumulator.GetTotalShares()) {
+		discrepancies = append(discrepancies, types.StateDiscrepancy{
+			PoolId:   poolId,
+			Check:    types.CheckAccumulatorShares,
+			Subject:  spreadRewardAccumulator.GetName(),
+			Expected: totalLiquidity.String(),
+			Actual:   spreadRewardAccumulator.GetTotalShares().String(),
+		})
+	}
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, uptimeAccumulator := range uptimeAccumulators {
+		if !totalLiquidity.Equal(uptimeAccumulator.GetTotalShares()) {
+			discrepancies = append(discrepancies, types.StateDiscrepancy{
+				PoolId:   poolId,
+				Check:    types.CheckAccumulatorShares,
+				Subject:  uptimeAccumulator.GetName(),
+				Expected: totalLiquidity.String(),
+				Actual:   uptimeAccumulator.GetTotalShares().String(),
+			})
+		}
+	}
+
+	accumulatorDiscrepancies, err := k.verifyAccumulatorValues(ctx, poolId, ticks)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies = append(discrepancies, accumulatorDiscrepancies...)
+
+	balanceDiscrepancies, err := k.verifyPoolBalance(ctx, pool, positions)
+	if err != nil {
+		return nil, err
+	}
+	discrepancies = append(discrepancies, balanceDiscrepancies...)
+
+	return discrepancies, nil
+}
+
+// verifyAccumulatorValues returns a discrepancy for the spread reward accumulator, every uptime accumulator
+// and every growth tracker of the given ticks that holds a negative value.
+func (k Keeper) verifyAccumulatorValues(ctx sdk.Context, poolId uint64, ticks []genesis.FullTick) ([]types.StateDiscrepancy, error) {
+	discrepancies := []types.StateDiscrepancy{}
+	addIfNegative := func(subject string, value sdk.DecCoins) {
+		if value.IsAnyNegative() {
+			discrepancies = append(discrepancies, types.StateDiscrepancy{
+				PoolId:   poolId,
+				Check:    types.CheckAccumulatorValue,
+				Subject:  subject,
+				Expected: "non-negative",
+				Actual:   value.String(),
+			})
+		}
+	}
+
+	spreadRewardAccumulator, err := k.GetSpreadRewardAccumulator(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	addIfNegative(spreadRewardAccumulator.GetName(), spreadRewardAccumulator.GetValue())
+
+	uptimeAccumulators, err := k.GetUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	for _, uptimeAccumulator := range uptimeAccumulators {
+		addIfNegative(uptimeAccumulator.GetName(), uptimeAccumulator.GetValue())
+	}
+
+	for _, tick := range ticks {
+		addIfNegative(fmt.Sprintf("spread reward growth of tick %d", tick.TickIndex), tick.Info.SpreadRewardGrowthOppositeDirectionOfLastTraversal)
+		for i, tracker := range tick.Info.UptimeTrackers.List {
+			addIfNegative(fmt.Sprintf("uptime %d growth of tick %d", i, tick.TickIndex), tracker.UptimeGrowthOutside)
+		}
+	}
+
+	return discrepancies, nil
+}
+
+// verifyPoolBalance returns a discrepancy if the balance of the pool account does not cover the amounts
+// that would be returned if every given position was fully withdrawn.
+// Withdrawn amounts are rounded down, so the pool balance may exceed them by rounding error.
+func (k Keeper) verifyPoolBalance(ctx sdk.Context, pool types.ConcentratedPoolExtension, positions []model.Position) ([]types.StateDiscrepancy, error) {
+	expectedCoins, err := fullWithdrawalAmounts(ctx, pool, positions)
+	if err != nil {
+		return nil, err
+	}
+
+	actualCoins := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+	if actualCoins.IsAllGTE(expectedCoins) {
+		return []types.StateDiscrepancy{}, nil
+	}
+	return []types.StateDiscrepancy{{
+		PoolId:   pool.GetId(),
+		Check:    types.CheckPoolBalance,
+		Expected: fmt.Sprintf("at least %s", expectedCoins),
+		Actual:   actualCoins.String(),
+	}}, nil
+}
+
+// fullWithdrawalAmounts returns the amounts that would be returned by the given pool if every given position
+// was fully withdrawn, rounded down like withdrawals.
+func fullWithdrawalAmounts(ctx sdk.Context, pool types.ConcentratedPoolExtension, positions []model.Position) (sdk.Coins, error) {
+	amounts := sdk.NewCoins()
+	for _, position := range positions {
+		if position.Liquidity.IsZero() {
+			continue
+		}
+		amount0, amount1, err := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, position.Liquidity.Neg())
+		if err != nil {
+			return nil, err
+		}
+		amounts = amounts.Add(
+			sdk.NewCoin(pool.GetToken0(), amount0.TruncateInt().Abs()),
+			sdk.NewCoin(pool.GetToken1(), amount1.TruncateInt().Abs()),
+		)
+	}
+	return amounts, nil
+}
+
+// addToTickLiquidity adds the given liquidity delta to the tick's entry in the given map.
+func addToTickLiquidity(tickLiquidity map[int64]osmomath.Dec, tickIndex int64, liquidityDelta osmomath.Dec) {
+	current, ok := tickLiquidity[tickIndex]
+	if !ok {
+		current = osmomath.ZeroDec()
+	}
+	tickLiquidity[tickIndex] = current.Add(liquidityDelta)
+}
@@ -0,0 +1,101 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestVerifyPoolsState() {
+	tests := map[string]struct {
+		corruptState           func(poolId uint64)
+		expectedDiscrepancyFor []string
+	}{
+		"consistent state": {},
+		"corrupted current liquidity": {
+			corruptState: func(poolId uint64) {
+				pool, err := s.Clk.GetPoolById(s.Ctx, poolId)
+				s.Require().NoError(err)
+
+				pool.UpdateLiquidity(osmomath.OneDec())
+				s.Require().NoError(s.Clk.SetPool(s.Ctx, pool))
+			},
+			expectedDiscrepancyFor: []string{types.CheckCurrentLiquidity},
+		},
+		"corrupted tick liquidity": {
+			corruptState: func(poolId uint64) {
+				tickInfo, err := s.Clk.GetTickInfo(s.Ctx, poolId, DefaultLowerTick)
+				s.Require().NoError(err)
+
+				tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(osmomath.OneDec())
+				tickInfo.LiquidityGross = tickInfo.LiquidityGross.Add(osmomath.OneDec())
+				s.Clk.SetTickInfo(s.Ctx, poolId, DefaultLowerTick, &tickInfo)
+			},
+			expectedDiscrepancyFor: []string{types.CheckTickLiquidityNet, types.CheckTickLiquidityGross},
+		},
+		"removed tick": {
+			corruptState: func(poolId uint64) {
+				s.Clk.RemoveTickInfo(s.Ctx, poolId, DefaultUpperTick)
+			},
+			expectedDiscrepancyFor: []string{types.CheckTickInitialized},
+		},
+		"corrupted accumulator shares": {
+			corruptState: func(poolId uint64) {
+				spreadRewardAccumulator, err := s.Clk.GetSpreadRewardAccumulator(s.Ctx, poolId)
+				s.Require().NoError(err)
+
+				s.Require().NoError(spreadRewardAccumulator.NewPosition("dangling", osmomath.OneDec(), nil))
+			},
+			expectedDiscrepancyFor: []string{types.CheckAccumulatorShares},
+		},
+		"negative tick growth": {
+			corruptState: func(poolId uint64) {
+				tickInfo, err := s.Clk.GetTickInfo(s.Ctx, poolId, DefaultLowerTick)
+				s.Require().NoError(err)
+
+				tickInfo.SpreadRewardGrowthOppositeDirectionOfLastTraversal = sdk.DecCoins{{Denom: ETH, Amount: osmomath.OneDec().Neg()}}
+				s.Clk.SetTickInfo(s.Ctx, poolId, DefaultLowerTick, &tickInfo)
+			},
+			expectedDiscrepancyFor: []string{types.CheckAccumulatorValue},
+		},
+		"pool balance does not cover positions": {
+			corruptState: func(poolId uint64) {
+				pool, err := s.Clk.GetPoolById(s.Ctx, poolId)
+				s.Require().NoError(err)
+
+				poolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+				s.Require().NoError(s.App.BankKeeper.SendCoins(s.Ctx, pool.GetAddress(), s.TestAccs[0], poolBalance))
+			},
+			expectedDiscrepancyFor: []string{types.CheckPoolBalance},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.TestAccs = apptesting.CreateRandomAccounts(4)
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPositions(pool.GetId())
+
+			if tc.corruptState != nil {
+				tc.corruptState(pool.GetId())
+			}
+
+			discrepancies, err := s.Clk.VerifyPoolsState(s.Ctx)
+			s.Require().NoError(err)
+
+			poolDiscrepancies, err := s.Clk.VerifyPoolState(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(discrepancies, poolDiscrepancies)
+
+			actualChecks := make([]string, 0, len(discrepancies))
+			for _, discrepancy := range discrepancies {
+				s.Require().Equal(pool.GetId(), discrepancy.PoolId)
+				actualChecks = append(actualChecks, discrepancy.Check)
+			}
+			s.Require().ElementsMatch(tc.expectedDiscrepancyFor, actualChecks)
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/types"
 )
 
+const FlagCheckPoolStateHashes = "check-pool-state-hashes"
+
 func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	osmocli.AddTxCmd(txCmd, NewCreateCWPoolCmd)
@@ -70,7 +72,7 @@ func NewCmdUploadCodeIdAndWhitelistProposal() *cobra.Command {
 		Short:   "Submit an upload code id and whitelist proposal",
 		Example: "osmosisd tx gov submit-proposal upload-code-id-and-whitelist x/cosmwasmpool/bytecode/transmuter.wasm --from lo-test1 --keyring-backend test --title \"Test\" --summary \"Test\" -b=block --chain-id localosmosis --fees=100000uosmo --gas=20000000",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -87,7 +89,7 @@ func NewCmdUploadCodeIdAndWhitelistProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -158,7 +160,7 @@ func NewCmdMigratePoolContractsProposal() *cobra.Command {
 		Args:  cobra.ExactArgs(3),
 		Short: "Submit a migrate cw pool contracts proposal",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -175,7 +177,7 @@ func NewCmdMigratePoolContractsProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -187,6 +189,7 @@ func NewCmdMigratePoolContractsProposal() *cobra.Command {
 		},
 	}
 	osmocli.AddCommonProposalFlags(cmd)
+	cmd.Flags().Bool(FlagCheckPoolStateHashes, false, "Fail the proposal if the state of any pool changes during its migration")
 
 	return cmd
 }
@@ -228,13 +231,84 @@ func parseMigratePoolContractsProposal(cmd *cobra.Command, args []string) (govty
 		return nil, err
 	}
 
+	checkPoolStateHashes, err := cmd.Flags().GetBool(FlagCheckPoolStateHashes)
+	if err != nil {
+		return nil, err
+	}
+
 	content := &types.MigratePoolContractsProposal{
-		Title:        title,
-		Description:  description,
-		PoolIds:      poolIds,
-		NewCodeId:    newCodeId,
-		WASMByteCode: wasm,
-		MigrateMsg:   emptyMigrateMsg,
+		Title:                title,
+		Description:          description,
+		PoolIds:              poolIds,
+		NewCodeId:            newCodeId,
+		WASMByteCode:         wasm,
+		MigrateMsg:           emptyMigrateMsg,
+		CheckPoolStateHashes: checkPoolStateHashes,
+	}
+
+	return content, nil
+}
+
+func NewCmdWhiteListCodeIdProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "whitelist-cw-pool-code-id [code-id] [flags]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to whitelist an already uploaded code id for cw pools",
+		Example: "osmosisd tx gov submit-proposal whitelist-cw-pool-code-id 1 --from lo-test1 --keyring-backend test --title \"Test\" --summary \"Test\" -b=block --chain-id localosmosis --fees=100000uosmo --gas=20000000",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			content, err := parseWhiteListCodeIdProposal(cmd, args[0])
+			if err != nil {
+				return err
+			}
+
+			contentMsg, err := v1.NewLegacyContent(content, authority.String())
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+	}
+	osmocli.AddCommonProposalFlags(cmd)
+
+	return cmd
+}
+
+func parseWhiteListCodeIdProposal(cmd *cobra.Command, codeIdStr string) (govtypesv1beta1.Content, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+
+	description, err := cmd.Flags().GetString(govcli.FlagSummary)
+	if err != nil {
+		return nil, err
+	}
+
+	codeId, err := strconv.ParseUint(codeIdStr, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &types.WhiteListCosmWasmPoolCodeIdProposal{
+		Title:       title,
+		Description: description,
+		CodeId:      codeId,
 	}
 
 	return content, nil
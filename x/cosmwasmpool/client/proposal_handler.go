@@ -9,4 +9,5 @@ import (
 var (
 	UploadCodeIdAndWhitelistProposalHandler = govclient.NewProposalHandler(cli.NewCmdUploadCodeIdAndWhitelistProposal)
 	MigratePoolContractsProposalHandler     = govclient.NewProposalHandler(cli.NewCmdMigratePoolContractsProposal)
+	WhiteListCodeIdProposalHandler          = govclient.NewProposalHandler(cli.NewCmdWhiteListCodeIdProposal)
 )
@@ -14,6 +14,10 @@ func (k Keeper) UploadCodeIdAndWhitelist(ctx sdk.Context, byteCode []byte) (uint
 	return k.uploadCodeIdAndWhitelist(ctx, byteCode)
 }
 
-func (k Keeper) MigrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte) (err error) {
-	return k.migrateCosmwasmPools(ctx, poolIds, newCodeId, uploadByteCode, migrateMsg)
+func (k Keeper) MigrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte, checkPoolStateHashes bool) (err error) {
+	return k.migrateCosmwasmPools(ctx, poolIds, newCodeId, uploadByteCode, migrateMsg, checkPoolStateHashes)
+}
+
+func (k Keeper) WhitelistUploadedCodeId(ctx sdk.Context, codeId uint64) error {
+	return k.whitelistUploadedCodeId(ctx, codeId)
 }
@@ -1,6 +1,8 @@
 package cosmwasmpool
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"strconv"
 
@@ -9,6 +11,8 @@ import (
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	cosmwasmutils "github.com/osmosis-labs/osmosis/osmoutils/cosmwasm"
+	"github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/cosmwasm/msg"
 	"github.com/osmosis-labs/osmosis/v21/x/cosmwasmpool/types"
 )
 
@@ -19,7 +23,9 @@ func NewCosmWasmPoolProposalHandler(k Keeper) govtypesv1.Handler {
 			_, err := k.uploadCodeIdAndWhitelist(ctx, c.WASMByteCode)
 			return err
 		case *types.MigratePoolContractsProposal:
-			return k.migrateCosmwasmPools(ctx, c.PoolIds, c.NewCodeId, c.WASMByteCode, c.MigrateMsg)
+			return k.migrateCosmwasmPools(ctx, c.PoolIds, c.NewCodeId, c.WASMByteCode, c.MigrateMsg, c.CheckPoolStateHashes)
+		case *types.WhiteListCosmWasmPoolCodeIdProposal:
+			return k.whitelistUploadedCodeId(ctx, c.CodeId)
 		default:
 			return fmt.Errorf("unrecognized concentrated liquidity proposal content type: %T", c)
 		}
@@ -60,6 +66,32 @@ func (k Keeper) uploadCodeIdAndWhitelist(ctx sdk.Context, byteCode []byte) (uint
 	return codeID, nil
 }
 
+// whitelistUploadedCodeId whitelists the given code id that is already uploaded to the wasmvm,
+// e.g. with a x/wasm store code proposal. Emits an event with the code id and checksum.
+// Returns error if the code id does not exist or if the cosmwasm pool module is not allowed
+// to instantiate it, since pools could not be created from it.
+func (k Keeper) whitelistUploadedCodeId(ctx sdk.Context, codeId uint64) error {
+	codeInfo := k.wasmKeeper.GetCodeInfo(ctx, codeId)
+	if codeInfo == nil {
+		return types.CodeIdNotFoundError{CodeId: codeId}
+	}
+
+	cosmwasmPoolModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	if !codeInfo.InstantiateConfig.Allowed(cosmwasmPoolModuleAddress) {
+		return types.CodeInstantiationNotAllowedError{CodeId: codeId}
+	}
+
+	k.WhitelistCodeId(ctx, codeId)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtWhitelistedCosmwasmPoolCode,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeId, 10)),
+		sdk.NewAttribute(types.AttributeKeyChecksum, string(codeInfo.CodeHash)),
+	))
+
+	return nil
+}
+
 // migrateComswasmPools migrates all given cw pool contracts specified by their IDs.
 // It has two options to perform the migration.
 //
@@ -79,7 +111,10 @@ func (k Keeper) uploadCodeIdAndWhitelist(ctx sdk.Context, byteCode []byte) (uint
 // poolD count to be submitted at once is gated by a governance paramets (20 at launch).
 // The proposal fails if more. Note that 20 was chosen arbitrarily to have a constant bound on the number of pools migrated
 // at once. This size will be configured by a module parameter so it can be changed by a constant.
-func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte) (err error) {
+//
+// If checkPoolStateHashes is true, the state hash of each pool is computed before and after its migration,
+// and the proposal fails if they differ. This guards against migrations unexpectedly altering pool state.
+func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeId uint64, uploadByteCode []byte, migrateMsg []byte, checkPoolStateHashes bool) (err error) {
 	cosmwasmPoolModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
 
 	if err := types.ValidateMigrationProposalConfiguration(poolIds, newCodeId, uploadByteCode); err != nil {
@@ -118,10 +153,28 @@ func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeI
 			return err
 		}
 
+		var preMigrationHash []byte
+		if checkPoolStateHashes {
+			preMigrationHash, err = k.poolStateHash(ctx, cwPool)
+			if err != nil {
+				return err
+			}
+		}
+
 		_, err = k.contractKeeper.Migrate(ctx, sdk.MustAccAddressFromBech32(cwPool.GetContractAddress()), cosmwasmPoolModuleAddress, newCodeId, migrateMsg)
 		if err != nil {
 			return err
 		}
+
+		if checkPoolStateHashes {
+			postMigrationHash, err := k.poolStateHash(ctx, cwPool)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(preMigrationHash, postMigrationHash) {
+				return types.PoolStateHashMismatchError{PoolId: poolId, PreMigrationHash: preMigrationHash, PostMigrationHash: postMigrationHash}
+			}
+		}
 	}
 
 	// Whitelist new code id. No-op if already whitelisted.
@@ -135,3 +188,22 @@ func (k Keeper) migrateCosmwasmPools(ctx sdk.Context, poolIds []uint64, newCodeI
 
 	return nil
 }
+
+// poolStateHash returns the hash of the state of the given pool as reported by its contract.
+// It covers the total liquidity and spread factor of the pool.
+func (k Keeper) poolStateHash(ctx sdk.Context, cwPool types.CosmWasmExtension) ([]byte, error) {
+	contractAddress := cwPool.GetContractAddress()
+
+	liquidity, err := cosmwasmutils.Query[msg.GetTotalPoolLiquidityQueryMsg, msg.GetTotalPoolLiquidityQueryMsgResponse](ctx, k.wasmKeeper, contractAddress, msg.GetTotalPoolLiquidityQueryMsg{})
+	if err != nil {
+		return nil, err
+	}
+
+	swapFee, err := cosmwasmutils.Query[msg.GetSwapFeeQueryMsg, msg.GetSwapFeeQueryMsgResponse](ctx, k.wasmKeeper, contractAddress, msg.GetSwapFeeQueryMsg{})
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s/%s", sdk.Coins(liquidity.TotalPoolLiquidity), swapFee.SwapFee)))
+	return hash[:], nil
+}
@@ -170,6 +170,7 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 		expectedCodeId                           uint64
 		shouldWhitelistCWPoolModuleAccountUpload bool
 		poolIdLimitOverwrite                     uint64
+		checkPoolStateHashes                     bool
 
 		expectedErr bool
 	}{
@@ -183,6 +184,17 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 
 			expectedCodeId: validCodeId,
 		},
+		{
+			name:                 "happy path with pool state hash checks",
+			poolCountToPreCreate: defaultPoolCountToPreCreate,
+			poolIdsToMigrate:     defaultPoolIdsToMigrate,
+			newCodeId:            preUploadCodeIdPlaceholder,
+			byteCode:             emptyByteCode,
+			migrateMsg:           emptyMigrateMsg,
+			checkPoolStateHashes: true,
+
+			expectedCodeId: validCodeId,
+		},
 		{
 			name:                                     "happy path with code id to upload",
 			poolCountToPreCreate:                     defaultPoolCountToPreCreate,
@@ -286,7 +298,7 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 			}
 
 			// System under test.
-			err := cosmwasmPoolKeeper.MigrateCosmwasmPools(s.Ctx, tc.poolIdsToMigrate, tc.newCodeId, tc.byteCode, tc.migrateMsg)
+			err := cosmwasmPoolKeeper.MigrateCosmwasmPools(s.Ctx, tc.poolIdsToMigrate, tc.newCodeId, tc.byteCode, tc.migrateMsg, tc.checkPoolStateHashes)
 
 			if tc.expectedErr {
 				s.Require().Error(err)
@@ -303,3 +315,63 @@ func (s *CWPoolGovSuite) TestMigrateCosmwasmPools() {
 		})
 	}
 }
+
+func (s *CWPoolGovSuite) TestWhitelistUploadedCodeId() {
+	tests := []struct {
+		name                string
+		shouldStoreCode     bool
+		restrictInstantiate bool
+		expectedErr         error
+	}{
+		{
+			name:            "happy path",
+			shouldStoreCode: true,
+		},
+		{
+			name:            "error: code id does not exist",
+			shouldStoreCode: false,
+			expectedErr:     types.CodeIdNotFoundError{CodeId: validCodeId},
+		},
+		{
+			name:                "error: cw pool module account is not allowed to instantiate code",
+			shouldStoreCode:     true,
+			restrictInstantiate: true,
+			expectedErr:         types.CodeInstantiationNotAllowedError{CodeId: validCodeId},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.Setup()
+
+			cosmwasmPoolKeeper := s.App.CosmwasmPoolKeeper
+
+			// Reset the event manager for each test case.
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			if tc.shouldStoreCode {
+				codeId := s.StoreCosmWasmPoolContractCode(apptesting.TransmuterContractName)
+				s.Require().Equal(validCodeId, codeId)
+			}
+
+			if tc.restrictInstantiate {
+				err := s.App.ContractKeeper.SetAccessConfig(s.Ctx, validCodeId, s.App.AccountKeeper.GetModuleAddress(types.ModuleName), wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeNobody})
+				s.Require().NoError(err)
+			}
+
+			// System under test.
+			err := cosmwasmPoolKeeper.WhitelistUploadedCodeId(s.Ctx, validCodeId)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().False(cosmwasmPoolKeeper.IsWhitelisted(s.Ctx, validCodeId))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(cosmwasmPoolKeeper.IsWhitelisted(s.Ctx, validCodeId))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtWhitelistedCosmwasmPoolCode, 1)
+		})
+	}
+}
@@ -15,6 +15,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	// gov proposals
 	cdc.RegisterConcrete(&UploadCosmWasmPoolCodeAndWhiteListProposal{}, "osmosis/upload-cw-pool-code", nil)
 	cdc.RegisterConcrete(&MigratePoolContractsProposal{}, "osmosis/migrate-pool-contracts", nil)
+	cdc.RegisterConcrete(&WhiteListCosmWasmPoolCodeIdProposal{}, "osmosis/whitelist-cw-pool-code-id", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -27,6 +28,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*govtypesv1.Content)(nil),
 		&UploadCosmWasmPoolCodeAndWhiteListProposal{},
 		&MigratePoolContractsProposal{},
+		&WhiteListCosmWasmPoolCodeIdProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -37,6 +37,32 @@ func (e CodeIdNotWhitelistedError) Error() string {
 	return fmt.Sprintf("cannot create coswasm pool with the given code id (%d). Please whitelist it via governance", e.CodeId)
 }
 
+type CodeIdNotFoundError struct {
+	CodeId uint64
+}
+
+func (e CodeIdNotFoundError) Error() string {
+	return fmt.Sprintf("code id (%d) does not exist", e.CodeId)
+}
+
+type CodeInstantiationNotAllowedError struct {
+	CodeId uint64
+}
+
+func (e CodeInstantiationNotAllowedError) Error() string {
+	return fmt.Sprintf("cosmwasm pool module is not allowed to instantiate code id (%d)", e.CodeId)
+}
+
+type PoolStateHashMismatchError struct {
+	PoolId            uint64
+	PreMigrationHash  []byte
+	PostMigrationHash []byte
+}
+
+func (e PoolStateHashMismatchError) Error() string {
+	return fmt.Sprintf("state hash of pool (%d) changed during migration. pre migration hash = %X, post migration hash = %X", e.PoolId, e.PreMigrationHash, e.PostMigrationHash)
+}
+
 type NegativeExcessiveTokenInAmountError struct {
 	TokenInMaxAmount       osmomath.Int
 	TokenInRequiredAmount  osmomath.Int
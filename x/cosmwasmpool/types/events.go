@@ -1,8 +1,9 @@
 package types
 
 const (
-	TypeEvtUploadedCosmwasmPoolCode = "uploaded_cosmwasm_pool_code"
-	TypeEvtMigratedCosmwasmPoolCode = "migrated_cosmwasm_pool_code"
+	TypeEvtUploadedCosmwasmPoolCode    = "uploaded_cosmwasm_pool_code"
+	TypeEvtMigratedCosmwasmPoolCode    = "migrated_cosmwasm_pool_code"
+	TypeEvtWhitelistedCosmwasmPoolCode = "whitelisted_cosmwasm_pool_code"
 
 	AttributeValueCategory      = ModuleName
 	AttributeKeyCodeID          = "code_id"
@@ -56,4 +56,5 @@ type WasmKeeper interface {
 	QueryGasLimit() storetypes.Gas
 
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmtypes.CodeInfo
 }
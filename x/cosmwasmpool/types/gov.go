@@ -10,16 +10,19 @@ import (
 const (
 	ProposalTypeUploadCosmWasmPoolCodeAndWhiteList = "UploadCosmWasmPoolCodeAndWhiteListProposal"
 	ProposalTypeMigratePoolContractsProposal       = "MigratePoolContractsProposal"
+	ProposalTypeWhiteListCosmWasmPoolCodeId        = "WhiteListCosmWasmPoolCodeIdProposal"
 )
 
 func init() {
 	govtypesv1.RegisterProposalType(ProposalTypeUploadCosmWasmPoolCodeAndWhiteList)
 	govtypesv1.RegisterProposalType(ProposalTypeMigratePoolContractsProposal)
+	govtypesv1.RegisterProposalType(ProposalTypeWhiteListCosmWasmPoolCodeId)
 }
 
 var (
 	_ govtypesv1.Content = &UploadCosmWasmPoolCodeAndWhiteListProposal{}
 	_ govtypesv1.Content = &MigratePoolContractsProposal{}
+	_ govtypesv1.Content = &WhiteListCosmWasmPoolCodeIdProposal{}
 )
 
 // NewUploadCosmWasmPoolCodeAndWhiteListProposal returns a new instance of an upload cosmwasm pool code and whitelist proposal struct.
@@ -115,7 +118,55 @@ Description: %s
 PoolIds: %v
 NewCodeId:   %d
 Upload Wasm Code Given: %t
-`, p.Title, p.Description, p.PoolIds, p.NewCodeId, len(p.WASMByteCode) > 0))
+Check Pool State Hashes: %t
+`, p.Title, p.Description, p.PoolIds, p.NewCodeId, len(p.WASMByteCode) > 0, p.CheckPoolStateHashes))
+	return b.String()
+}
+
+// NewWhiteListCosmWasmPoolCodeIdProposal returns a new instance of a whitelist cosmwasm pool code id proposal struct.
+func NewWhiteListCosmWasmPoolCodeIdProposal(title, description string, codeId uint64) govtypesv1.Content {
+	return &WhiteListCosmWasmPoolCodeIdProposal{
+		Title:       title,
+		Description: description,
+		CodeId:      codeId,
+	}
+}
+
+func (p *WhiteListCosmWasmPoolCodeIdProposal) GetTitle() string { return p.Title }
+
+// GetDescription gets the description of the proposal
+func (p *WhiteListCosmWasmPoolCodeIdProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the router key for the proposal
+func (p *WhiteListCosmWasmPoolCodeIdProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *WhiteListCosmWasmPoolCodeIdProposal) ProposalType() string {
+	return ProposalTypeWhiteListCosmWasmPoolCodeId
+}
+
+// ValidateBasic validates a governance proposal's abstract and basic contents
+func (p *WhiteListCosmWasmPoolCodeIdProposal) ValidateBasic() error {
+	err := govtypesv1.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.CodeId == 0 {
+		return fmt.Errorf("code id cannot be 0")
+	}
+
+	return nil
+}
+
+// String returns a string containing the whitelist cosmwasm pool code id proposal.
+func (p WhiteListCosmWasmPoolCodeIdProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`WhiteList CosmWasm Pool Code Id Proposal:
+Title:       %s
+Description: %s
+CodeId:      %d
+`, p.Title, p.Description, p.CodeId))
 	return b.String()
 }
 
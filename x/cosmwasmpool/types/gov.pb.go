@@ -111,6 +111,11 @@ type MigratePoolContractsProposal struct {
 	WASMByteCode []byte `protobuf:"bytes,5,opt,name=wasm_byte_code,json=wasmByteCode,proto3" json:"wasm_byte_code,omitempty"`
 	// MigrateMsg migrate message to be used for migrating the pool contracts.
 	MigrateMsg []byte `protobuf:"bytes,6,opt,name=migrate_msg,json=migrateMsg,proto3" json:"migrate_msg,omitempty"`
+	// check_pool_state_hashes, when set, makes the proposal fail if the state
+	// hash of any migrated pool differs before and after the migration. The
+	// state hash covers the total liquidity and spread factor of the pool as
+	// reported by its contract.
+	CheckPoolStateHashes bool `protobuf:"varint,7,opt,name=check_pool_state_hashes,json=checkPoolStateHashes,proto3" json:"check_pool_state_hashes,omitempty"`
 }
 
 func (m *MigratePoolContractsProposal) Reset()      { *m = MigratePoolContractsProposal{} }
@@ -145,9 +150,54 @@ func (m *MigratePoolContractsProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MigratePoolContractsProposal proto.InternalMessageInfo
 
+// WhiteListCosmWasmPoolCodeIdProposal is a gov Content type for whitelisting a
+// contract code that is already uploaded to chain, e.g. with a x/wasm store
+// code proposal. Only whitelisted code ids are eligible for being
+// x/cosmwasmpool pools. The x/cosmwasmpool module account must be allowed to
+// instantiate the code.
+type WhiteListCosmWasmPoolCodeIdProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// code_id is the id of the already uploaded contract code to whitelist.
+	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *WhiteListCosmWasmPoolCodeIdProposal) Reset()      { *m = WhiteListCosmWasmPoolCodeIdProposal{} }
+func (*WhiteListCosmWasmPoolCodeIdProposal) ProtoMessage() {}
+func (*WhiteListCosmWasmPoolCodeIdProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c184a48c55bbcf5c, []int{2}
+}
+func (m *WhiteListCosmWasmPoolCodeIdProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhiteListCosmWasmPoolCodeIdProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhiteListCosmWasmPoolCodeIdProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhiteListCosmWasmPoolCodeIdProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhiteListCosmWasmPoolCodeIdProposal.Merge(m, src)
+}
+func (m *WhiteListCosmWasmPoolCodeIdProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhiteListCosmWasmPoolCodeIdProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhiteListCosmWasmPoolCodeIdProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhiteListCosmWasmPoolCodeIdProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*UploadCosmWasmPoolCodeAndWhiteListProposal)(nil), "osmosis.cosmwasmpool.v1beta1.UploadCosmWasmPoolCodeAndWhiteListProposal")
 	proto.RegisterType((*MigratePoolContractsProposal)(nil), "osmosis.cosmwasmpool.v1beta1.MigratePoolContractsProposal")
+	proto.RegisterType((*WhiteListCosmWasmPoolCodeIdProposal)(nil), "osmosis.cosmwasmpool.v1beta1.WhiteListCosmWasmPoolCodeIdProposal")
 }
 
 func init() {
@@ -155,32 +205,35 @@ func init() {
 }
 
 var fileDescriptor_c184a48c55bbcf5c = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xb1, 0x8b, 0xd4, 0x40,
-	0x14, 0xc6, 0x33, 0xee, 0xde, 0xe9, 0xcd, 0x06, 0x91, 0x70, 0x45, 0x94, 0x23, 0x09, 0x57, 0x48,
-	0x10, 0x4c, 0x58, 0x05, 0x11, 0xbb, 0xdb, 0xab, 0x0e, 0x5c, 0x38, 0x22, 0xb2, 0x60, 0x13, 0x26,
-	0x99, 0x21, 0x37, 0x90, 0xc9, 0x0b, 0x79, 0xe3, 0xae, 0xfb, 0x1f, 0x58, 0x5a, 0x5a, 0x6e, 0xed,
-	0x5f, 0x62, 0x79, 0xa5, 0x95, 0x48, 0xb6, 0xf1, 0x4f, 0xb0, 0x94, 0x49, 0xb2, 0xb0, 0x0b, 0x16,
-	0xc2, 0x75, 0xf3, 0xbd, 0xf9, 0x1e, 0xf3, 0xfb, 0x86, 0x8f, 0x3e, 0x05, 0x54, 0x80, 0x12, 0xe3,
-	0x1c, 0x50, 0xad, 0x18, 0xaa, 0x1a, 0xa0, 0x8c, 0x97, 0xd3, 0x4c, 0x68, 0x36, 0x8d, 0x0b, 0x58,
-	0x46, 0x75, 0x03, 0x1a, 0x9c, 0xb3, 0xc1, 0x17, 0xed, 0xfb, 0xa2, 0xc1, 0xf7, 0xe4, 0xb4, 0x80,
-	0x02, 0x3a, 0x63, 0x6c, 0x4e, 0xfd, 0xce, 0xf9, 0x37, 0x42, 0x9f, 0xbd, 0xaf, 0x4b, 0x60, 0xfc,
-	0x12, 0x50, 0x2d, 0x18, 0xaa, 0x6b, 0x80, 0xf2, 0x12, 0xb8, 0xb8, 0xa8, 0xf8, 0xe2, 0x46, 0x6a,
-	0xf1, 0x56, 0xa2, 0xbe, 0x6e, 0xa0, 0x06, 0x64, 0xa5, 0x73, 0x4a, 0x8f, 0xb4, 0xd4, 0xa5, 0x70,
-	0x49, 0x40, 0xc2, 0x93, 0xa4, 0x17, 0x4e, 0x40, 0x27, 0x5c, 0x60, 0xde, 0xc8, 0x5a, 0x4b, 0xa8,
-	0xdc, 0x7b, 0xdd, 0xdd, 0xfe, 0xc8, 0x79, 0x45, 0x1f, 0x1a, 0xa0, 0x34, 0x5b, 0x6b, 0x91, 0xe6,
-	0xc0, 0x85, 0x3b, 0x0a, 0x48, 0x68, 0xcf, 0x1e, 0xb5, 0x3f, 0x7d, 0x7b, 0x71, 0xf1, 0x6e, 0x3e,
-	0x5b, 0x6b, 0x61, 0x5e, 0x4d, 0x6c, 0xe3, 0xdb, 0xa9, 0x37, 0xf6, 0xe7, 0x8d, 0x6f, 0x7d, 0xdd,
-	0xf8, 0xd6, 0xef, 0x8d, 0x4f, 0xce, 0xff, 0x10, 0x7a, 0x36, 0x97, 0x45, 0xc3, 0xb4, 0xe8, 0x29,
-	0x2b, 0xdd, 0xb0, 0x5c, 0xe3, 0x9d, 0xf1, 0x1e, 0xd3, 0x07, 0xe6, 0xaf, 0x52, 0xc9, 0xd1, 0x1d,
-	0x05, 0xa3, 0x70, 0x9c, 0xdc, 0x37, 0xfa, 0x8a, 0xa3, 0xe3, 0xd1, 0x49, 0x25, 0x56, 0x1d, 0x73,
-	0x2a, 0xb9, 0x3b, 0x0e, 0x48, 0x38, 0x4e, 0x4e, 0x2a, 0xb1, 0x32, 0x7c, 0x57, 0xfc, 0x1f, 0xc9,
-	0x8e, 0xfe, 0x27, 0x99, 0xe3, 0xd3, 0x89, 0xea, 0xa3, 0xa4, 0x0a, 0x0b, 0xf7, 0xd8, 0x2c, 0x25,
-	0x74, 0x18, 0xcd, 0xb1, 0x38, 0x8c, 0x3e, 0x4b, 0xbe, 0xb7, 0x1e, 0xb9, 0x6d, 0x3d, 0xf2, 0xab,
-	0xf5, 0xc8, 0x97, 0xad, 0x67, 0xdd, 0x6e, 0x3d, 0xeb, 0xc7, 0xd6, 0xb3, 0x3e, 0xbc, 0x2e, 0xa4,
-	0xbe, 0xf9, 0x98, 0x45, 0x39, 0xa8, 0x78, 0x28, 0xc0, 0xf3, 0x92, 0x65, 0xb8, 0x13, 0xf1, 0xf2,
-	0xc5, 0x34, 0xfe, 0x74, 0xd8, 0x1d, 0xbd, 0xae, 0x05, 0x66, 0xc7, 0x5d, 0x05, 0x5e, 0xfe, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0x97, 0x91, 0xcc, 0x60, 0x02, 0x00, 0x00,
+	// 441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xa5, 0x52, 0x3d, 0x4b, 0x03, 0x41,
+	0x10, 0xf5, 0x4c, 0x4c, 0xe2, 0xe6, 0x10, 0x39, 0x02, 0x9e, 0x22, 0x89, 0x28, 0x88, 0x08, 0xe6,
+	0x88, 0xa2, 0x88, 0x9d, 0xb1, 0x31, 0x60, 0x40, 0x4e, 0x24, 0x60, 0x73, 0xdc, 0xc7, 0x72, 0xb7,
+	0x78, 0x97, 0x3d, 0x6e, 0xd6, 0x68, 0x7a, 0x0b, 0x4b, 0x4b, 0x4b, 0x6b, 0x1b, 0xff, 0x86, 0xa5,
+	0xa5, 0x95, 0x88, 0x36, 0xfe, 0x0c, 0x67, 0x37, 0x89, 0xa8, 0x58, 0x08, 0x16, 0x03, 0x3b, 0x33,
+	0xef, 0xed, 0xbe, 0x79, 0x3b, 0x64, 0x99, 0x43, 0xc2, 0x81, 0x81, 0xe5, 0xe3, 0xe1, 0xdc, 0x85,
+	0x24, 0xe5, 0x3c, 0xb6, 0x7a, 0x0d, 0x8f, 0x0a, 0xb7, 0x61, 0x85, 0xbc, 0x57, 0x4f, 0x33, 0x2e,
+	0xb8, 0x31, 0x3f, 0xc4, 0xd5, 0xbf, 0xe2, 0xea, 0x43, 0xdc, 0x5c, 0x25, 0xe4, 0x21, 0x57, 0x40,
+	0x4b, 0x9e, 0x06, 0x9c, 0xc5, 0x3b, 0x8d, 0xac, 0x1e, 0xa7, 0x31, 0x77, 0x83, 0x3d, 0x24, 0x75,
+	0x90, 0x74, 0x88, 0xa4, 0x3d, 0x1e, 0xd0, 0xdd, 0x6e, 0xd0, 0x89, 0x98, 0xa0, 0x07, 0x0c, 0xc4,
+	0x61, 0xc6, 0x53, 0x0e, 0x6e, 0x6c, 0x54, 0xc8, 0x84, 0x60, 0x22, 0xa6, 0xa6, 0xb6, 0xa0, 0xad,
+	0x4c, 0xda, 0x83, 0xc4, 0x58, 0x20, 0xe5, 0x80, 0x82, 0x9f, 0xb1, 0x54, 0x30, 0xde, 0x35, 0xc7,
+	0x55, 0xef, 0x6b, 0xc9, 0xd8, 0x22, 0x53, 0x52, 0x90, 0xe3, 0xf5, 0x05, 0x75, 0x7c, 0xbc, 0xdd,
+	0xcc, 0x21, 0x48, 0x6f, 0x4e, 0xbf, 0x3e, 0xd7, 0xf4, 0xce, 0xee, 0x51, 0xbb, 0x89, 0x0d, 0xf9,
+	0xaa, 0xad, 0x4b, 0xdc, 0x28, 0xdb, 0xd1, 0xaf, 0x6e, 0x6b, 0x63, 0x37, 0x18, 0xef, 0xb7, 0x35,
+	0x6d, 0xf1, 0x7e, 0x9c, 0xcc, 0xb7, 0x59, 0x98, 0xb9, 0x82, 0x0e, 0x54, 0x76, 0x45, 0xe6, 0xfa,
+	0x02, 0xfe, 0x2d, 0x6f, 0x96, 0x94, 0xa4, 0x57, 0x0e, 0x0b, 0x00, 0x85, 0xe5, 0x56, 0xf2, 0x76,
+	0x51, 0xe6, 0xad, 0x00, 0x8c, 0x2a, 0x29, 0x77, 0xe9, 0xb9, 0xd2, 0x8c, 0x6d, 0x33, 0x8f, 0xe4,
+	0xbc, 0x3d, 0x89, 0x25, 0xa9, 0xaf, 0x15, 0xfc, 0x32, 0xd9, 0xc4, 0x5f, 0x26, 0x33, 0x6a, 0xa4,
+	0x9c, 0x0c, 0x46, 0x71, 0x12, 0x08, 0xcd, 0x82, 0x24, 0xd9, 0x64, 0x58, 0x6a, 0x43, 0x68, 0x6c,
+	0x92, 0x19, 0x3f, 0xa2, 0xfe, 0xa9, 0xa3, 0x94, 0x81, 0x90, 0xc8, 0xc8, 0x85, 0x88, 0x82, 0x59,
+	0x44, 0x70, 0xc9, 0xae, 0xa8, 0xb6, 0x34, 0xe2, 0x48, 0x36, 0xf7, 0x55, 0xef, 0x87, 0x63, 0x97,
+	0x1a, 0x59, 0xfa, 0xfc, 0xc5, 0x9f, 0x3f, 0xdc, 0x0a, 0xfe, 0x6d, 0xdc, 0x0c, 0x29, 0x8e, 0x9c,
+	0xc9, 0x29, 0x67, 0x0a, 0xbe, 0xba, 0x78, 0x47, 0x97, 0xcf, 0x8f, 0xa4, 0x34, 0xed, 0x87, 0xd7,
+	0xaa, 0xf6, 0x88, 0xf1, 0x82, 0x71, 0xfd, 0x56, 0x1d, 0x7b, 0xc4, 0x78, 0xc2, 0x38, 0xd9, 0x0e,
+	0x99, 0x88, 0xce, 0x3c, 0x5c, 0xd9, 0xc4, 0x1a, 0xae, 0xef, 0x5a, 0xec, 0x7a, 0x30, 0x4a, 0xac,
+	0xde, 0x7a, 0xc3, 0xba, 0xf8, 0xbe, 0xf9, 0xa2, 0x9f, 0x52, 0xf0, 0x0a, 0x6a, 0x81, 0x37, 0x3e,
+	0x00, 0xc4, 0x4c, 0x61, 0x32, 0x1e, 0x03, 0x00, 0x00,
 }
 
 func (this *UploadCosmWasmPoolCodeAndWhiteListProposal) Equal(that interface{}) bool {
@@ -255,6 +308,39 @@ func (this *MigratePoolContractsProposal) Equal(that interface{}) bool {
 	if !bytes.Equal(this.MigrateMsg, that1.MigrateMsg) {
 		return false
 	}
+	if this.CheckPoolStateHashes != that1.CheckPoolStateHashes {
+		return false
+	}
+	return true
+}
+func (this *WhiteListCosmWasmPoolCodeIdProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WhiteListCosmWasmPoolCodeIdProposal)
+	if !ok {
+		that2, ok := that.(WhiteListCosmWasmPoolCodeIdProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.CodeId != that1.CodeId {
+		return false
+	}
 	return true
 }
 func (m *UploadCosmWasmPoolCodeAndWhiteListProposal) Marshal() (dAtA []byte, err error) {
@@ -321,6 +407,16 @@ func (m *MigratePoolContractsProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.CheckPoolStateHashes {
+		i--
+		if m.CheckPoolStateHashes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.MigrateMsg) > 0 {
 		i -= len(m.MigrateMsg)
 		copy(dAtA[i:], m.MigrateMsg)
@@ -375,6 +471,48 @@ func (m *MigratePoolContractsProposal) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *WhiteListCosmWasmPoolCodeIdProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhiteListCosmWasmPoolCodeIdProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhiteListCosmWasmPoolCodeIdProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -439,6 +577,29 @@ func (m *MigratePoolContractsProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.CheckPoolStateHashes {
+		n += 2
+	}
+	return n
+}
+
+func (m *WhiteListCosmWasmPoolCodeIdProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovGov(uint64(m.CodeId))
+	}
 	return n
 }
 
@@ -852,6 +1013,26 @@ func (m *MigratePoolContractsProposal) Unmarshal(dAtA []byte) error {
 				m.MigrateMsg = []byte{}
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckPoolStateHashes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckPoolStateHashes = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -873,6 +1054,140 @@ func (m *MigratePoolContractsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WhiteListCosmWasmPoolCodeIdProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhiteListCosmWasmPoolCodeIdProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhiteListCosmWasmPoolCodeIdProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
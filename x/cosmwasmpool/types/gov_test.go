@@ -102,3 +102,38 @@ func (s *CWPoolGovTypesSuite) TestValidateMigrationProposalCondiguration() {
 		})
 	}
 }
+
+func (s *CWPoolGovTypesSuite) TestWhiteListCosmWasmPoolCodeIdProposalValidateBasic() {
+	tests := []struct {
+		name   string
+		codeId uint64
+
+		expectErr bool
+	}{
+		{
+			name:   "success: code id is set",
+			codeId: 1,
+		},
+		{
+			name:   "error: code id is zero",
+			codeId: 0,
+
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			proposal := types.NewWhiteListCosmWasmPoolCodeIdProposal("title", "description", tc.codeId)
+
+			err := proposal.ValidateBasic()
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
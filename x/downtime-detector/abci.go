@@ -20,12 +20,10 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 }
 
 // saveDowntimeUpdates saves the current block time as the
-// last time the chain was down for all downtime lengths that are LTE the provided downtime.
+// last time the chain was down for all downtime lengths and windows that are LTE the provided downtime.
+// The Downtime enum lengths are always tracked, since RecoveredSinceDowntimeOfLength can be queried
+// for any of them regardless of the configured downtime windows.
 func (k *Keeper) saveDowntimeUpdates(ctx sdk.Context, downtime time.Duration) {
-	// minimum stored downtime is 30S, so if downtime is less than that, don't update anything.
-	if downtime < 30*time.Second {
-		return
-	}
 	types.DowntimeToDuration.Ascend(0, func(downType types.Downtime, duration time.Duration) bool {
 		// if downtime < duration of this entry, stop iterating further, don't update this entry.
 		if downtime < duration {
@@ -34,4 +32,11 @@ func (k *Keeper) saveDowntimeUpdates(ctx sdk.Context, downtime time.Duration) {
 		k.StoreLastDowntimeOfLength(ctx, downType, ctx.BlockTime())
 		return true
 	})
+	for _, window := range k.GetParams(ctx).DowntimeWindows {
+		// windows matching a Downtime enum length share its state entry, which is updated above.
+		if _, err := types.DowntimeByDuration(window); err == nil || downtime < window {
+			continue
+		}
+		k.StoreLastDowntimeOfWindow(ctx, window, ctx.BlockTime())
+	}
 }
@@ -14,6 +14,11 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, RecoveredSinceQueryCmd)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, RecoveredSinceWindowQueryCmd)
+	cmd.AddCommand(
+		osmocli.GetParams[*queryproto.ParamsRequest](
+			types.ModuleName, queryproto.NewQueryClient),
+	)
 
 	return cmd
 }
@@ -30,6 +35,17 @@ downtime-duration is a duration, but is restricted to a smaller set. Heres a few
 	}, &queryproto.RecoveredSinceDowntimeOfLengthRequest{}
 }
 
+func RecoveredSinceWindowQueryCmd() (*osmocli.QueryDescriptor, *queryproto.RecoveredSinceDowntimeOfWindowRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "recovered-since-window window recovery-duration",
+		Short: "Queries if it has been at least <recovery-duration> since the chain was down for <window>",
+		Long: `{{.Short}}
+window must be one of the downtime windows configured in the module params.
+{{.ExampleHeader}}
+{{.CommandPrefix}} recovered-since-window 24h 30m`,
+	}, &queryproto.RecoveredSinceDowntimeOfWindowRequest{}
+}
+
 //nolint:unparam
 func parseDowntimeDuration(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
 	dur, err := time.ParseDuration(arg)
@@ -53,3 +53,17 @@ func TestRecoveredSinceQueryCmd(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestRecoveredSinceWindowQueryCmd(t *testing.T) {
+	desc, _ := cli.RecoveredSinceWindowQueryCmd()
+	tcs := map[string]osmocli.QueryCliTestCase[*queryproto.RecoveredSinceDowntimeOfWindowRequest]{
+		"basic test": {
+			Cmd: "45m 10m",
+			ExpectedQuery: &queryproto.RecoveredSinceDowntimeOfWindowRequest{
+				Window:   time.Minute * 45,
+				Recovery: time.Minute * 10,
+			},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
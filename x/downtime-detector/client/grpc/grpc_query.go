@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) RecoveredSinceDowntimeOfWindow(grpcCtx context.Context,
+	req *queryproto.RecoveredSinceDowntimeOfWindowRequest,
+) (*queryproto.RecoveredSinceDowntimeOfWindowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.RecoveredSinceDowntimeOfWindow(ctx, *req)
+}
+
 func (q Querier) RecoveredSinceDowntimeOfLength(grpcCtx context.Context,
 	req *queryproto.RecoveredSinceDowntimeOfLengthRequest,
 ) (*queryproto.RecoveredSinceDowntimeOfLengthResponse, error) {
@@ -31,3 +41,13 @@ func (q Querier) RecoveredSinceDowntimeOfLength(grpcCtx context.Context,
 	return q.Q.RecoveredSinceDowntimeOfLength(ctx, *req)
 }
 
+func (q Querier) Params(grpcCtx context.Context,
+	req *queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Params(ctx, *req)
+}
+
@@ -20,3 +20,19 @@ func (querier *Querier) RecoveredSinceDowntimeOfLength(ctx sdk.Context, req quer
 		SuccesfullyRecovered: val,
 	}, nil
 }
+
+func (querier *Querier) RecoveredSinceDowntimeOfWindow(ctx sdk.Context, req queryproto.RecoveredSinceDowntimeOfWindowRequest) (*queryproto.RecoveredSinceDowntimeOfWindowResponse, error) {
+	recovered, lastDowntime, err := querier.K.RecoveredSinceDowntimeOfWindow(ctx, req.Window, req.Recovery)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.RecoveredSinceDowntimeOfWindowResponse{
+		Recovered:    recovered,
+		LastDowntime: lastDowntime,
+	}, nil
+}
+
+func (querier *Querier) Params(ctx sdk.Context, req queryproto.ParamsRequest) (*queryproto.ParamsResponse, error) {
+	params := querier.K.GetParams(ctx)
+	return &queryproto.ParamsResponse{Params: params}, nil
+}
@@ -139,9 +139,203 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) GetSuccesfullyRecovered() bool
 	return false
 }
 
+// Query for has it been at least $RECOVERY_DURATION units of time,
+// since the chain has been down for $WINDOW, where $WINDOW is one of the
+// downtime windows configured in the module params.
+type RecoveredSinceDowntimeOfWindowRequest struct {
+	Window   time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	Recovery time.Duration `protobuf:"bytes,2,opt,name=recovery,proto3,stdduration" json:"recovery" yaml:"recovery_duration"`
+}
+
+func (m *RecoveredSinceDowntimeOfWindowRequest) Reset()         { *m = RecoveredSinceDowntimeOfWindowRequest{} }
+func (m *RecoveredSinceDowntimeOfWindowRequest) String() string { return proto.CompactTextString(m) }
+func (*RecoveredSinceDowntimeOfWindowRequest) ProtoMessage()    {}
+func (*RecoveredSinceDowntimeOfWindowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f82bc400cce002f, []int{2}
+}
+func (m *RecoveredSinceDowntimeOfWindowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveredSinceDowntimeOfWindowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveredSinceDowntimeOfWindowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveredSinceDowntimeOfWindowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveredSinceDowntimeOfWindowRequest.Merge(m, src)
+}
+func (m *RecoveredSinceDowntimeOfWindowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveredSinceDowntimeOfWindowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveredSinceDowntimeOfWindowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveredSinceDowntimeOfWindowRequest proto.InternalMessageInfo
+
+func (m *RecoveredSinceDowntimeOfWindowRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RecoveredSinceDowntimeOfWindowRequest) GetRecovery() time.Duration {
+	if m != nil {
+		return m.Recovery
+	}
+	return 0
+}
+
+type RecoveredSinceDowntimeOfWindowResponse struct {
+	Recovered bool `protobuf:"varint,1,opt,name=recovered,proto3" json:"recovered,omitempty"`
+	// last_downtime is the last time the chain was down for at least the window.
+	LastDowntime time.Time `protobuf:"bytes,2,opt,name=last_downtime,json=lastDowntime,proto3,stdtime" json:"last_downtime" yaml:"last_downtime"`
+}
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) Reset() {
+	*m = RecoveredSinceDowntimeOfWindowResponse{}
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) String() string { return proto.CompactTextString(m) }
+func (*RecoveredSinceDowntimeOfWindowResponse) ProtoMessage()    {}
+func (*RecoveredSinceDowntimeOfWindowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f82bc400cce002f, []int{3}
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecoveredSinceDowntimeOfWindowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecoveredSinceDowntimeOfWindowResponse.Merge(m, src)
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecoveredSinceDowntimeOfWindowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecoveredSinceDowntimeOfWindowResponse proto.InternalMessageInfo
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) GetRecovered() bool {
+	if m != nil {
+		return m.Recovered
+	}
+	return false
+}
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) GetLastDowntime() time.Time {
+	if m != nil {
+		return m.LastDowntime
+	}
+	return time.Time{}
+}
+
+type ParamsRequest struct {
+}
+
+func (m *ParamsRequest) Reset()         { *m = ParamsRequest{} }
+func (m *ParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*ParamsRequest) ProtoMessage()    {}
+func (*ParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f82bc400cce002f, []int{4}
+}
+func (m *ParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsRequest.Merge(m, src)
+}
+func (m *ParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsRequest proto.InternalMessageInfo
+
+type ParamsResponse struct {
+	Params types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *ParamsResponse) Reset()         { *m = ParamsResponse{} }
+func (m *ParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*ParamsResponse) ProtoMessage()    {}
+func (*ParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f82bc400cce002f, []int{5}
+}
+func (m *ParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamsResponse.Merge(m, src)
+}
+func (m *ParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamsResponse proto.InternalMessageInfo
+
+func (m *ParamsResponse) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
 func init() {
 	proto.RegisterType((*RecoveredSinceDowntimeOfLengthRequest)(nil), "osmosis.downtimedetector.v1beta1.RecoveredSinceDowntimeOfLengthRequest")
 	proto.RegisterType((*RecoveredSinceDowntimeOfLengthResponse)(nil), "osmosis.downtimedetector.v1beta1.RecoveredSinceDowntimeOfLengthResponse")
+	proto.RegisterType((*RecoveredSinceDowntimeOfWindowRequest)(nil), "osmosis.downtimedetector.v1beta1.RecoveredSinceDowntimeOfWindowRequest")
+	proto.RegisterType((*RecoveredSinceDowntimeOfWindowResponse)(nil), "osmosis.downtimedetector.v1beta1.RecoveredSinceDowntimeOfWindowResponse")
+	proto.RegisterType((*ParamsRequest)(nil), "osmosis.downtimedetector.v1beta1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "osmosis.downtimedetector.v1beta1.ParamsResponse")
 }
 
 func init() {
@@ -149,38 +343,49 @@ func init() {
 }
 
 var fileDescriptor_3f82bc400cce002f = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0x16, 0x94, 0x25, 0x82, 0x42, 0x5c, 0xa1, 0x5b, 0x24, 0x29, 0x41, 0x65, 0x59, 0xdc,
-	0x19, 0xda, 0xbd, 0x88, 0x37, 0xeb, 0x82, 0x2e, 0x08, 0x62, 0xbc, 0x29, 0x52, 0x26, 0xd3, 0xd7,
-	0xec, 0x40, 0x32, 0x93, 0xcd, 0x4c, 0xaa, 0xb9, 0x7a, 0xf2, 0x28, 0x78, 0xf1, 0x27, 0xed, 0x71,
-	0xc1, 0x8b, 0xa7, 0xaa, 0xad, 0xbf, 0x60, 0x7f, 0x80, 0x48, 0x32, 0x99, 0x50, 0xa2, 0x6c, 0x04,
-	0x4f, 0xed, 0xf0, 0x7d, 0xef, 0x7b, 0xdf, 0xf7, 0x5e, 0x9e, 0x7d, 0x5f, 0xc8, 0x44, 0x48, 0x26,
-	0xf1, 0x4c, 0xbc, 0xe5, 0x8a, 0x25, 0x30, 0x03, 0x05, 0x54, 0x89, 0x0c, 0x2f, 0x46, 0x21, 0x28,
-	0x32, 0xc2, 0xa7, 0x39, 0x64, 0x05, 0x4a, 0x33, 0xa1, 0x84, 0x33, 0xac, 0xd9, 0xa8, 0xcd, 0x46,
-	0x35, 0x7b, 0xb0, 0x13, 0x89, 0x48, 0x54, 0x64, 0x5c, 0xfe, 0xd3, 0x75, 0x03, 0xd4, 0xd9, 0x25,
-	0x02, 0x0e, 0xa5, 0xb0, 0xe6, 0x3f, 0xe8, 0xe4, 0x1b, 0x60, 0x3a, 0xcb, 0x33, 0xa2, 0x98, 0xe0,
-	0x75, 0xa5, 0x4b, 0xab, 0x52, 0x1c, 0x12, 0x09, 0x0d, 0x99, 0x0a, 0x66, 0xf0, 0xfd, 0x4d, 0xbc,
-	0x8a, 0xd6, 0xb0, 0x52, 0x12, 0x31, 0xbe, 0xa9, 0x75, 0x3b, 0x12, 0x22, 0x8a, 0x01, 0x93, 0x94,
-	0x61, 0xc2, 0xb9, 0x50, 0x15, 0x68, 0x3c, 0xee, 0xd6, 0x68, 0xf5, 0x0a, 0xf3, 0x39, 0x26, 0xbc,
-	0x30, 0x90, 0x6e, 0x32, 0xd5, 0x73, 0xd0, 0x0f, 0xe3, 0xaf, 0x5d, 0xd5, 0xf2, 0xef, 0xb5, 0xf1,
-	0x32, 0xa4, 0x54, 0x24, 0x49, 0x35, 0xc1, 0xff, 0x61, 0xd9, 0x77, 0x03, 0xa0, 0x62, 0x01, 0x19,
-	0xcc, 0x5e, 0x32, 0x4e, 0xe1, 0xa8, 0x1e, 0xc5, 0xf3, 0xf9, 0x33, 0xe0, 0x91, 0x3a, 0x09, 0xe0,
-	0x34, 0x07, 0xa9, 0x9c, 0xd7, 0xf6, 0xb6, 0x99, 0x52, 0xdf, 0x1a, 0x5a, 0x7b, 0xd7, 0xc7, 0xfb,
-	0xa8, 0x6b, 0x7f, 0xc8, 0x88, 0x4d, 0x6e, 0x5e, 0x2c, 0xbd, 0x1b, 0x05, 0x49, 0xe2, 0x87, 0xbe,
-	0x21, 0xfb, 0x41, 0x23, 0x58, 0x8a, 0x67, 0xda, 0x45, 0xd1, 0xdf, 0x1a, 0x5a, 0x7b, 0xd7, 0xc6,
-	0xbb, 0x48, 0x5b, 0x47, 0xc6, 0x3a, 0x3a, 0xaa, 0xa3, 0x4d, 0xee, 0x9c, 0x2d, 0xbd, 0xde, 0xc5,
-	0xd2, 0xeb, 0x6b, 0x3d, 0x53, 0xd8, 0xec, 0xce, 0xff, 0xfc, 0xcd, 0xb3, 0x82, 0x46, 0xd0, 0x7f,
-	0x63, 0xdf, 0xeb, 0x8a, 0x28, 0x53, 0xc1, 0x25, 0x38, 0x87, 0xf6, 0x2d, 0x99, 0x53, 0x0a, 0x72,
-	0x9e, 0xc7, 0x71, 0x31, 0xcd, 0x4c, 0x55, 0x15, 0x78, 0x3b, 0xd8, 0xd9, 0x00, 0x1b, 0xc5, 0xf1,
-	0x87, 0x2d, 0xfb, 0xca, 0x8b, 0x72, 0xf5, 0xce, 0x2f, 0xcb, 0x76, 0x2f, 0xef, 0xe4, 0x3c, 0xe9,
-	0x9e, 0xd9, 0x3f, 0xad, 0x63, 0xf0, 0xf4, 0xff, 0x85, 0x74, 0x68, 0xff, 0xf8, 0xfd, 0x97, 0x9f,
-	0x9f, 0xb6, 0x1e, 0x3b, 0x8f, 0x70, 0xfb, 0x4c, 0x0e, 0xfe, 0xb8, 0x93, 0xcb, 0x25, 0x27, 0xf4,
-	0x6c, 0xe5, 0x5a, 0xe7, 0x2b, 0xd7, 0xfa, 0xbe, 0x72, 0xad, 0x8f, 0x6b, 0xb7, 0x77, 0xbe, 0x76,
-	0x7b, 0x5f, 0xd7, 0x6e, 0xef, 0xd5, 0x71, 0xc4, 0xd4, 0x49, 0x1e, 0x22, 0x2a, 0x12, 0xd3, 0xe6,
-	0x20, 0x26, 0xa1, 0x6c, 0x7a, 0x2e, 0xc6, 0x23, 0xfc, 0xee, 0x2f, 0x9d, 0x69, 0xcc, 0x80, 0x2b,
-	0x7d, 0x5b, 0xfa, 0x53, 0xb8, 0x5a, 0xfd, 0x1c, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xd1,
-	0xaf, 0xc5, 0x6c, 0x04, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6b, 0xd4, 0x40,
+	0x18, 0xdd, 0x29, 0x76, 0xa9, 0xa3, 0x6d, 0x21, 0x56, 0xd8, 0x2e, 0x25, 0xbb, 0x04, 0x2d, 0xb5,
+	0xda, 0xc4, 0xdd, 0x5e, 0xc4, 0x9b, 0x6b, 0xd1, 0x16, 0x0a, 0x6a, 0x14, 0x14, 0x45, 0x96, 0xd9,
+	0xec, 0x34, 0x0d, 0x24, 0x33, 0x69, 0x66, 0xd2, 0xba, 0x57, 0x7f, 0x41, 0xc1, 0x8b, 0x27, 0xef,
+	0xfa, 0x4b, 0x7a, 0x2c, 0x7a, 0xf1, 0x54, 0x75, 0xd7, 0x5f, 0xd0, 0x1f, 0x20, 0x92, 0xcc, 0x4c,
+	0xdc, 0xa6, 0xb2, 0x59, 0x58, 0x3d, 0xb5, 0xf9, 0xbe, 0xef, 0xbd, 0x79, 0xef, 0xe5, 0x9b, 0x2c,
+	0xbc, 0x45, 0x59, 0x40, 0x99, 0xc7, 0xac, 0x2e, 0x3d, 0x20, 0xdc, 0x0b, 0x70, 0x17, 0x73, 0xec,
+	0x70, 0x1a, 0x59, 0xfb, 0x8d, 0x0e, 0xe6, 0xa8, 0x61, 0xed, 0xc5, 0x38, 0xea, 0x99, 0x61, 0x44,
+	0x39, 0xd5, 0xea, 0x72, 0xda, 0xcc, 0x4f, 0x9b, 0x72, 0xba, 0xba, 0xe0, 0x52, 0x97, 0xa6, 0xc3,
+	0x56, 0xf2, 0x9f, 0xc0, 0x55, 0xcd, 0xc2, 0x53, 0x5c, 0x4c, 0x70, 0x42, 0x2c, 0xe6, 0xef, 0x14,
+	0xce, 0xab, 0x46, 0xbb, 0x1b, 0x47, 0x88, 0x7b, 0x94, 0x48, 0xa4, 0xee, 0xa4, 0x50, 0xab, 0x83,
+	0x18, 0xce, 0x86, 0x1d, 0xea, 0xa9, 0xfe, 0xea, 0x70, 0x3f, 0xb5, 0x96, 0x4d, 0x85, 0xc8, 0xf5,
+	0xc8, 0x30, 0xd7, 0x92, 0x4b, 0xa9, 0xeb, 0x63, 0x0b, 0x85, 0x9e, 0x85, 0x08, 0xa1, 0x3c, 0x6d,
+	0x2a, 0x8d, 0x8b, 0xb2, 0x9b, 0x3e, 0x75, 0xe2, 0x1d, 0x0b, 0x91, 0x9e, 0x6a, 0x89, 0x43, 0xda,
+	0x22, 0x07, 0xf1, 0xa0, 0xf4, 0xe5, 0x51, 0x39, 0xfd, 0xb5, 0x7c, 0x3f, 0x31, 0xc9, 0x38, 0x0a,
+	0x42, 0x31, 0x60, 0xfc, 0x00, 0xf0, 0xba, 0x8d, 0x1d, 0xba, 0x8f, 0x23, 0xdc, 0x7d, 0xea, 0x11,
+	0x07, 0x6f, 0xc8, 0x28, 0x1e, 0xed, 0x6c, 0x63, 0xe2, 0xf2, 0x5d, 0x1b, 0xef, 0xc5, 0x98, 0x71,
+	0xed, 0x15, 0x9c, 0x51, 0x29, 0x55, 0x40, 0x1d, 0xac, 0xcc, 0x35, 0x57, 0xcd, 0xa2, 0xf7, 0x67,
+	0x2a, 0xb2, 0xd6, 0x95, 0xd3, 0x93, 0xda, 0x7c, 0x0f, 0x05, 0xfe, 0x5d, 0x43, 0x0d, 0x1b, 0x76,
+	0x46, 0x98, 0x90, 0x47, 0x42, 0x45, 0xaf, 0x32, 0x55, 0x07, 0x2b, 0x97, 0x9a, 0x8b, 0xa6, 0x90,
+	0x6e, 0x2a, 0xe9, 0xe6, 0x86, 0xb4, 0xd6, 0xba, 0x76, 0x74, 0x52, 0x2b, 0x9d, 0x9e, 0xd4, 0x2a,
+	0x82, 0x4f, 0x01, 0xb3, 0x77, 0x67, 0xbc, 0xff, 0x56, 0x03, 0x76, 0x46, 0x68, 0xbc, 0x86, 0xcb,
+	0x45, 0x16, 0x59, 0x48, 0x09, 0xc3, 0xda, 0x3a, 0xbc, 0xca, 0x62, 0xc7, 0xc1, 0x6c, 0x27, 0xf6,
+	0xfd, 0x5e, 0x3b, 0x52, 0xa8, 0xd4, 0xf0, 0x8c, 0xbd, 0x30, 0xd4, 0xcc, 0x18, 0x8d, 0xcf, 0x23,
+	0x22, 0x7c, 0xee, 0x91, 0x2e, 0x3d, 0x50, 0x11, 0x6e, 0xc3, 0xf2, 0x41, 0x5a, 0xa8, 0x80, 0x22,
+	0x8f, 0x8b, 0xd2, 0xe3, 0xac, 0xf0, 0x28, 0x60, 0xc2, 0x98, 0xe4, 0xf8, 0xbf, 0x99, 0x7d, 0x04,
+	0x70, 0xb9, 0xc8, 0x94, 0x0c, 0x6d, 0x09, 0x5e, 0xcc, 0x07, 0xf5, 0xa7, 0xa0, 0x21, 0x38, 0xeb,
+	0x23, 0xc6, 0xdb, 0xd9, 0xee, 0x08, 0xa9, 0xd5, 0x73, 0x52, 0x9f, 0xa9, 0xcd, 0x6c, 0xd5, 0xa5,
+	0xd6, 0x05, 0xa1, 0xf5, 0x0c, 0xdc, 0x38, 0x4c, 0x74, 0x5e, 0x4e, 0x6a, 0x4a, 0x90, 0x31, 0x0f,
+	0x67, 0x1f, 0xa3, 0x08, 0x05, 0x4c, 0xe6, 0x6c, 0xbc, 0x80, 0x73, 0xaa, 0x20, 0x35, 0x3e, 0x80,
+	0xe5, 0x30, 0xad, 0xc8, 0xe4, 0x57, 0x8a, 0x57, 0x57, 0x30, 0xb4, 0x2e, 0x24, 0x62, 0x6c, 0x89,
+	0x6e, 0x7e, 0x9a, 0x86, 0xd3, 0x4f, 0x92, 0x6b, 0xae, 0xfd, 0x02, 0x50, 0x1f, 0xbd, 0x55, 0xda,
+	0xc3, 0xe2, 0x43, 0xc6, 0xba, 0x7a, 0xd5, 0xcd, 0xc9, 0x89, 0x44, 0x0e, 0xc6, 0xd6, 0xdb, 0x2f,
+	0x3f, 0xdf, 0x4d, 0xdd, 0xd7, 0xee, 0x59, 0xf9, 0x4f, 0xe2, 0xda, 0xb9, 0x6f, 0x62, 0x81, 0xbb,
+	0x51, 0x01, 0x88, 0x0d, 0x99, 0x24, 0x80, 0x33, 0x17, 0xa7, 0xba, 0x39, 0x39, 0xd1, 0x3f, 0x0c,
+	0x40, 0xba, 0xfb, 0x00, 0x60, 0x59, 0x2c, 0x89, 0x66, 0x8d, 0xbb, 0x4e, 0xca, 0xd0, 0xed, 0xf1,
+	0x01, 0x52, 0x78, 0x23, 0x15, 0x7e, 0x53, 0xbb, 0x31, 0x86, 0x70, 0xb9, 0xba, 0xce, 0x51, 0x5f,
+	0x07, 0xc7, 0x7d, 0x1d, 0x7c, 0xef, 0xeb, 0xe0, 0x70, 0xa0, 0x97, 0x8e, 0x07, 0x7a, 0xe9, 0xeb,
+	0x40, 0x2f, 0xbd, 0xdc, 0x72, 0x3d, 0xbe, 0x1b, 0x77, 0x4c, 0x87, 0x06, 0x8a, 0x6e, 0xcd, 0x47,
+	0x1d, 0x96, 0x71, 0xef, 0x37, 0x1b, 0xd6, 0x9b, 0xbf, 0x9c, 0xe0, 0xf8, 0x1e, 0x26, 0x5c, 0xfc,
+	0xd2, 0x89, 0x9b, 0x5b, 0x4e, 0xff, 0xac, 0xff, 0x1e, 0x00, 0x60, 0x1d, 0xe0, 0xff, 0xfa, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -196,6 +401,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	RecoveredSinceDowntimeOfLength(ctx context.Context, in *RecoveredSinceDowntimeOfLengthRequest, opts ...grpc.CallOption) (*RecoveredSinceDowntimeOfLengthResponse, error)
+	RecoveredSinceDowntimeOfWindow(ctx context.Context, in *RecoveredSinceDowntimeOfWindowRequest, opts ...grpc.CallOption) (*RecoveredSinceDowntimeOfWindowResponse, error)
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
 }
 
 type queryClient struct {
@@ -215,9 +422,29 @@ func (c *queryClient) RecoveredSinceDowntimeOfLength(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *queryClient) RecoveredSinceDowntimeOfWindow(ctx context.Context, in *RecoveredSinceDowntimeOfWindowRequest, opts ...grpc.CallOption) (*RecoveredSinceDowntimeOfWindowResponse, error) {
+	out := new(RecoveredSinceDowntimeOfWindowResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.downtimedetector.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	RecoveredSinceDowntimeOfLength(context.Context, *RecoveredSinceDowntimeOfLengthRequest) (*RecoveredSinceDowntimeOfLengthResponse, error)
+	RecoveredSinceDowntimeOfWindow(context.Context, *RecoveredSinceDowntimeOfWindowRequest) (*RecoveredSinceDowntimeOfWindowResponse, error)
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -227,6 +454,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) RecoveredSinceDowntimeOfLength(ctx context.Context, req *RecoveredSinceDowntimeOfLengthRequest) (*RecoveredSinceDowntimeOfLengthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoveredSinceDowntimeOfLength not implemented")
 }
+func (*UnimplementedQueryServer) RecoveredSinceDowntimeOfWindow(ctx context.Context, req *RecoveredSinceDowntimeOfWindowRequest) (*RecoveredSinceDowntimeOfWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoveredSinceDowntimeOfWindow not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -250,6 +483,42 @@ func _Query_RecoveredSinceDowntimeOfLength_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RecoveredSinceDowntimeOfWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoveredSinceDowntimeOfWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecoveredSinceDowntimeOfWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecoveredSinceDowntimeOfWindow(ctx, req.(*RecoveredSinceDowntimeOfWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.downtimedetector.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.downtimedetector.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -258,6 +527,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoveredSinceDowntimeOfLength",
 			Handler:    _Query_RecoveredSinceDowntimeOfLength_Handler,
 		},
+		{
+			MethodName: "RecoveredSinceDowntimeOfWindow",
+			Handler:    _Query_RecoveredSinceDowntimeOfWindow_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/downtimedetector/v1beta1/query.proto",
@@ -332,6 +609,142 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
+func (m *RecoveredSinceDowntimeOfWindowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveredSinceDowntimeOfWindowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveredSinceDowntimeOfWindowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Recovery, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Recovery):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDowntime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if m.Recovered {
+		i--
+		if m.Recovered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -369,8 +782,55 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RecoveredSinceDowntimeOfWindowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Recovery)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RecoveredSinceDowntimeOfWindowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Recovered {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -547,6 +1007,358 @@ func (m *RecoveredSinceDowntimeOfLengthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RecoveredSinceDowntimeOfWindowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveredSinceDowntimeOfWindowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveredSinceDowntimeOfWindowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Recovery, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecoveredSinceDowntimeOfWindowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecoveredSinceDowntimeOfWindowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecoveredSinceDowntimeOfWindowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recovered = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDowntime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
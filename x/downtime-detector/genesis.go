@@ -7,11 +7,18 @@ import (
 )
 
 func (k *Keeper) InitGenesis(ctx sdk.Context, gen *types.GenesisState) {
+	// genesis files predating the downtime windows params use the default windows.
+	if len(gen.Params.DowntimeWindows) > 0 {
+		k.SetParams(ctx, gen.Params)
+	}
 	k.StoreLastBlockTime(ctx, gen.LastBlockTime)
 	// set all default genesis down times, in case the provided list in genesis misses some.
 	k.setGenDowntimes(ctx, types.DefaultGenesis().GetDowntimes())
 	// override with genesis list
 	k.setGenDowntimes(ctx, gen.Downtimes)
+	for _, windowDowntime := range gen.WindowDowntimes {
+		k.StoreLastDowntimeOfWindow(ctx, windowDowntime.Window, windowDowntime.LastDowntime)
+	}
 }
 
 func (k *Keeper) setGenDowntimes(ctx sdk.Context, genDowntimes []types.GenesisDowntimeEntry) {
@@ -27,8 +34,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 	return &types.GenesisState{
-		Downtimes:     k.getGenDowntimes(ctx),
-		LastBlockTime: t,
+		Downtimes:       k.getGenDowntimes(ctx),
+		LastBlockTime:   t,
+		Params:          k.GetParams(ctx),
+		WindowDowntimes: k.getGenWindowDowntimes(ctx),
 	}
 }
 
@@ -46,3 +55,23 @@ func (k *Keeper) getGenDowntimes(ctx sdk.Context) []types.GenesisDowntimeEntry {
 	}
 	return downtimes
 }
+
+// getGenWindowDowntimes returns the last downtimes of the configured windows that
+// are not already exported as a Downtime enum entry.
+func (k *Keeper) getGenWindowDowntimes(ctx sdk.Context) []types.WindowDowntimeEntry {
+	windowDowntimes := []types.WindowDowntimeEntry{}
+	for _, window := range k.GetParams(ctx).DowntimeWindows {
+		if _, err := types.DowntimeByDuration(window); err == nil {
+			continue
+		}
+		t, err := k.GetLastDowntimeOfWindow(ctx, window)
+		if err != nil {
+			panic(err)
+		}
+		windowDowntimes = append(windowDowntimes, types.WindowDowntimeEntry{
+			Window:       window,
+			LastDowntime: t,
+		})
+	}
+	return windowDowntimes
+}
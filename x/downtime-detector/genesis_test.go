@@ -44,3 +44,19 @@ func (s *KeeperTestSuite) TestImportExport() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestImportExportWindows() {
+	windows := []time.Duration{30 * time.Second, 7 * time.Minute}
+	genState := types.DefaultGenesis()
+	genState.LastBlockTime = baseTime
+	genState.Params = types.NewParams(windows)
+	genState.WindowDowntimes = []types.WindowDowntimeEntry{
+		{Window: 7 * time.Minute, LastDowntime: baseTime.Add(-time.Hour)},
+	}
+
+	s.App.DowntimeKeeper.InitGenesis(s.Ctx, genState)
+	exportedState := s.App.DowntimeKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(genState.Params, exportedState.Params)
+	// 30s is exported as a Downtime enum entry.
+	s.Require().Equal(genState.WindowDowntimes, exportedState.WindowDowntimes)
+}
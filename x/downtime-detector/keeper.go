@@ -2,12 +2,35 @@ package downtimedetector
 
 import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/downtime-detector/types"
 )
 
 type Keeper struct {
-	storeKey storetypes.StoreKey
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+}
+
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{storeKey: storeKey, paramSpace: paramSpace}
+}
+
+// GetParams returns the total set of downtime-detector parameters.
+// Chains that have not set the parameters yet get the default downtime windows.
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+	return params
 }
 
-func NewKeeper(storeKey storetypes.StoreKey) *Keeper {
-	return &Keeper{storeKey: storeKey}
+// SetParams sets the total set of downtime-detector parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
@@ -44,6 +44,7 @@ var (
 
 func (s *KeeperTestSuite) TestBeginBlock() {
 	tests := map[string]struct {
+		windows   []time.Duration
 		times     blocktimes
 		downtimes []types.GenesisDowntimeEntry
 	}{
@@ -65,9 +66,22 @@ func (s *KeeperTestSuite) TestBeginBlock() {
 				types.NewGenesisDowntimeEntry(types.Downtime_DURATION_10M, tenMinEndtime),
 			},
 		},
+		"windows excluding the downtime lengths, 10 min halt, then 5 min halt": {
+			windows: []time.Duration{7 * min},
+			times:   abruptRecovery5minDowntime10min,
+			downtimes: []types.GenesisDowntimeEntry{
+				types.NewGenesisDowntimeEntry(types.Downtime_DURATION_1M, fifteenMinEndtime),
+				types.NewGenesisDowntimeEntry(types.Downtime_DURATION_5M, fifteenMinEndtime),
+				types.NewGenesisDowntimeEntry(types.Downtime_DURATION_10M, tenMinEndtime),
+			},
+		},
 	}
 	for name, test := range tests {
 		s.Run(name, func() {
+			s.SetupTest()
+			if test.windows != nil {
+				s.App.DowntimeKeeper.SetParams(s.Ctx, types.NewParams(test.windows))
+			}
 			s.runBlocktimes(test.times)
 			s.Require().Equal(test.times.EndTime(), s.Ctx.BlockTime())
 			for _, downtime := range test.downtimes {
@@ -150,6 +164,66 @@ func (s *KeeperTestSuite) TestRecoveryQueryErrors() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRecoveredSinceDowntimeOfWindow() {
+	type queryTestcase struct {
+		window          time.Duration
+		recovTime       time.Duration
+		expectRecovered bool
+		expectErr       bool
+	}
+
+	tests := map[string]struct {
+		windows []time.Duration
+		times   blocktimes
+		cases   []queryTestcase
+	}{
+		"default windows, 10 min halt, then 5 min halt": {
+			windows: types.DefaultParams().DowntimeWindows,
+			times:   abruptRecovery5minDowntime10min,
+			cases: []queryTestcase{
+				{window: 10 * min, recovTime: 5 * min, expectRecovered: true},
+				{window: 10 * min, recovTime: 6 * min, expectRecovered: false},
+				{window: 7 * min, recovTime: 5 * min, expectErr: true},
+			},
+		},
+		"custom windows, 10 min halt, then 5 min halt": {
+			windows: []time.Duration{7 * min, 30 * sec, 11 * min},
+			times:   abruptRecovery5minDowntime10min,
+			cases: []queryTestcase{
+				{window: 30 * sec, recovTime: min, expectRecovered: false},
+				{window: 7 * min, recovTime: 5 * min, expectRecovered: true},
+				{window: 7 * min, recovTime: 6 * min, expectRecovered: false},
+				// the last downtime of 11 minutes is the first block.
+				{window: 11 * min, recovTime: 15 * min, expectRecovered: true},
+				{window: 11 * min, recovTime: 16 * min, expectRecovered: false},
+				{window: 10 * min, recovTime: 5 * min, expectErr: true},
+				{window: 7 * min, recovTime: 0, expectErr: true},
+			},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.DowntimeKeeper.SetParams(s.Ctx, types.NewParams(test.windows))
+			s.runBlocktimes(test.times)
+			for _, query := range test.cases {
+				recovered, lastDowntime, err := s.App.DowntimeKeeper.RecoveredSinceDowntimeOfWindow(
+					s.Ctx, query.window, query.recovTime)
+				if query.expectErr {
+					s.Require().Error(err)
+					continue
+				}
+				s.Require().NoError(err)
+				s.Require().Equal(query.expectRecovered, recovered)
+
+				expectedLastDowntime, err := s.App.DowntimeKeeper.GetLastDowntimeOfWindow(s.Ctx, query.window)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedLastDowntime, lastDowntime)
+			}
+		})
+	}
+}
+
 type KeeperTestSuite struct {
 	apptesting.KeeperTestHelper
 }
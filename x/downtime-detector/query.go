@@ -2,6 +2,7 @@ package downtimedetector
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +15,37 @@ func (k *Keeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtime types.
 	if err != nil {
 		return false, err
 	}
+	return recoveredSince(ctx, lastDowntime, recoveryDuration)
+}
+
+// RecoveredSinceDowntimeOfWindow returns whether it has been at least recoveryDuration since the chain
+// was last down for at least window, along with the time of that downtime.
+// Returns an error if window is not one of the downtime windows configured in the params.
+func (k *Keeper) RecoveredSinceDowntimeOfWindow(ctx sdk.Context, window time.Duration, recoveryDuration time.Duration) (bool, time.Time, error) {
+	if !k.isDowntimeWindow(ctx, window) {
+		return false, time.Time{}, fmt.Errorf("downtime window of %s is not configured", window)
+	}
+	lastDowntime, err := k.GetLastDowntimeOfWindow(ctx, window)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	recovered, err := recoveredSince(ctx, lastDowntime, recoveryDuration)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	return recovered, lastDowntime, nil
+}
+
+func (k *Keeper) isDowntimeWindow(ctx sdk.Context, window time.Duration) bool {
+	for _, configuredWindow := range k.GetParams(ctx).DowntimeWindows {
+		if configuredWindow == window {
+			return true
+		}
+	}
+	return false
+}
+
+func recoveredSince(ctx sdk.Context, lastDowntime time.Time, recoveryDuration time.Duration) (bool, error) {
 	if recoveryDuration == time.Duration(0) {
 		return false, errors.New("invalid recovery duration of 0")
 	}
@@ -47,3 +47,21 @@ func (k *Keeper) StoreLastDowntimeOfLength(ctx sdk.Context, dur types.Downtime,
 	timeBz := osmoutils.FormatTimeString(t)
 	store.Set(types.GetLastDowntimeOfLengthKey(dur), []byte(timeBz))
 }
+
+// GetLastDowntimeOfWindow returns the last time the chain was down for at least the given window.
+// Returns DefaultLastDowntime if no downtime has been recorded for the window, e.g. for a window
+// that has just been added to the params.
+func (k *Keeper) GetLastDowntimeOfWindow(ctx sdk.Context, window time.Duration) (time.Time, error) {
+	store := ctx.KVStore(k.storeKey)
+	timeBz := store.Get(types.GetLastDowntimeOfWindowKey(window))
+	if len(timeBz) == 0 {
+		return types.DefaultLastDowntime, nil
+	}
+	return osmoutils.ParseTimeString(string(timeBz))
+}
+
+func (k *Keeper) StoreLastDowntimeOfWindow(ctx sdk.Context, window time.Duration, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	timeBz := osmoutils.FormatTimeString(t)
+	store.Set(types.GetLastDowntimeOfWindowKey(window), []byte(timeBz))
+}
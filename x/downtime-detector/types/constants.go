@@ -17,6 +17,10 @@ const (
 	QuerierRoute = ModuleName
 )
 
+// MinDowntimeWindow is the smallest downtime window that can be configured.
+// Shorter windows would be hit by regular variance in block times.
+const MinDowntimeWindow = 30 * time.Second
+
 var (
 	DowntimeToDuration  = btree.NewMap[Downtime, time.Duration](16)
 	DefaultLastDowntime = time.Unix(0, 0)
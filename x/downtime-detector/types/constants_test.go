@@ -17,3 +17,26 @@ func TestDowntimeToDurationAscending(t *testing.T) {
 	})
 	require.Equal(t, numEntries, 25)
 }
+
+func TestValidateParams(t *testing.T) {
+	tests := map[string]struct {
+		windows   []time.Duration
+		expectErr bool
+	}{
+		"default":           {windows: DefaultParams().DowntimeWindows},
+		"custom":            {windows: []time.Duration{45 * time.Minute, 30 * time.Second}},
+		"empty":             {windows: []time.Duration{}, expectErr: true},
+		"below minimum":     {windows: []time.Duration{29 * time.Second}, expectErr: true},
+		"duplicate windows": {windows: []time.Duration{time.Minute, time.Minute}, expectErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := NewParams(test.windows).Validate()
+			if test.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -11,13 +11,19 @@ func DefaultGenesis() *GenesisState {
 		})
 	}
 	return &GenesisState{
-		Downtimes:     genDowntimes,
-		LastBlockTime: time.Unix(0, 0),
+		Downtimes:       genDowntimes,
+		LastBlockTime:   time.Unix(0, 0),
+		Params:          DefaultParams(),
+		WindowDowntimes: []WindowDowntimeEntry{},
 	}
 }
 
 func (g *GenesisState) Validate() error {
-	return nil
+	// empty params fall back to the default downtime windows.
+	if len(g.Params.DowntimeWindows) == 0 {
+		return nil
+	}
+	return g.Params.Validate()
 }
 
 func NewGenesisDowntimeEntry(dur Downtime, time time.Time) GenesisDowntimeEntry {
@@ -82,17 +82,123 @@ func (m *GenesisDowntimeEntry) GetLastDowntime() time.Time {
 	return time.Time{}
 }
 
+// WindowDowntimeEntry is the last time the chain was down for at least the
+// given downtime window.
+type WindowDowntimeEntry struct {
+	Window       time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	LastDowntime time.Time     `protobuf:"bytes,2,opt,name=last_downtime,json=lastDowntime,proto3,stdtime" json:"last_downtime" yaml:"last_downtime"`
+}
+
+func (m *WindowDowntimeEntry) Reset()         { *m = WindowDowntimeEntry{} }
+func (m *WindowDowntimeEntry) String() string { return proto.CompactTextString(m) }
+func (*WindowDowntimeEntry) ProtoMessage()    {}
+func (*WindowDowntimeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d44d4cc05d2cb13, []int{1}
+}
+func (m *WindowDowntimeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowDowntimeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowDowntimeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WindowDowntimeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowDowntimeEntry.Merge(m, src)
+}
+func (m *WindowDowntimeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *WindowDowntimeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowDowntimeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowDowntimeEntry proto.InternalMessageInfo
+
+func (m *WindowDowntimeEntry) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *WindowDowntimeEntry) GetLastDowntime() time.Time {
+	if m != nil {
+		return m.LastDowntime
+	}
+	return time.Time{}
+}
+
+// Params holds parameters for the downtime-detector module
+type Params struct {
+	// downtime_windows are the downtime lengths the module keeps track of.
+	// A downtime is recorded for every window that is less than or equal to the
+	// time between two consecutive blocks.
+	DowntimeWindows []time.Duration `protobuf:"bytes,1,rep,name=downtime_windows,json=downtimeWindows,proto3,stdduration" json:"downtime_windows" yaml:"downtime_windows"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d44d4cc05d2cb13, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDowntimeWindows() []time.Duration {
+	if m != nil {
+		return m.DowntimeWindows
+	}
+	return nil
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	Downtimes     []GenesisDowntimeEntry `protobuf:"bytes,1,rep,name=downtimes,proto3" json:"downtimes"`
 	LastBlockTime time.Time              `protobuf:"bytes,2,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time" yaml:"last_block_time"`
+	Params        Params                 `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// window_downtimes are the last downtimes of the downtime windows that do
+	// not correspond to a Downtime enum value.
+	WindowDowntimes []WindowDowntimeEntry `protobuf:"bytes,4,rep,name=window_downtimes,json=windowDowntimes,proto3" json:"window_downtimes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d44d4cc05d2cb13, []int{1}
+	return fileDescriptor_3d44d4cc05d2cb13, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,8 +241,24 @@ func (m *GenesisState) GetLastBlockTime() time.Time {
 	return time.Time{}
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetWindowDowntimes() []WindowDowntimeEntry {
+	if m != nil {
+		return m.WindowDowntimes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisDowntimeEntry)(nil), "osmosis.downtimedetector.v1beta1.GenesisDowntimeEntry")
+	proto.RegisterType((*WindowDowntimeEntry)(nil), "osmosis.downtimedetector.v1beta1.WindowDowntimeEntry")
+	proto.RegisterType((*Params)(nil), "osmosis.downtimedetector.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.downtimedetector.v1beta1.GenesisState")
 }
 
@@ -145,33 +267,40 @@ func init() {
 }
 
 var fileDescriptor_3d44d4cc05d2cb13 = []byte{
-	// 405 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6a, 0xe2, 0x40,
-	0x1c, 0xc6, 0x33, 0xbb, 0xcb, 0xb2, 0x1b, 0xdd, 0x15, 0xb2, 0xb2, 0x58, 0x0f, 0x49, 0xc8, 0x49,
-	0x0a, 0xce, 0x60, 0x0a, 0xa5, 0x14, 0x7a, 0x09, 0x2d, 0xbd, 0xdb, 0x42, 0xc1, 0x1e, 0xc2, 0x24,
-	0x8e, 0x69, 0x68, 0x92, 0x91, 0xcc, 0x68, 0x9b, 0xb7, 0xf0, 0xb1, 0x3c, 0x4a, 0x0f, 0xa5, 0x27,
-	0x5b, 0xf4, 0x0d, 0x7c, 0x82, 0x92, 0x64, 0x46, 0xab, 0x14, 0xec, 0x2d, 0xff, 0xfc, 0xbf, 0xef,
-	0xe3, 0xfb, 0xfd, 0x19, 0x15, 0x52, 0x16, 0x53, 0x16, 0x32, 0xd4, 0xa7, 0x0f, 0x09, 0x0f, 0x63,
-	0xd2, 0x27, 0x9c, 0xf8, 0x9c, 0xa6, 0x68, 0xdc, 0xf1, 0x08, 0xc7, 0x1d, 0x14, 0x90, 0x84, 0xb0,
-	0x90, 0xc1, 0x61, 0x4a, 0x39, 0xd5, 0x4c, 0xa1, 0x87, 0xbb, 0x7a, 0x28, 0xf4, 0xcd, 0x7a, 0x40,
-	0x03, 0x5a, 0x88, 0x51, 0xfe, 0x55, 0xfa, 0x9a, 0x07, 0x01, 0xa5, 0x41, 0x44, 0x50, 0x31, 0x79,
-	0xa3, 0x01, 0xc2, 0x49, 0x26, 0x57, 0x7e, 0x91, 0xe9, 0x96, 0x9e, 0x72, 0x10, 0x2b, 0x7d, 0xd7,
-	0xd5, 0x1f, 0xa5, 0x98, 0x87, 0x34, 0x11, 0x7b, 0x63, 0x77, 0x9f, 0x37, 0x62, 0x1c, 0xc7, 0x43,
-	0x21, 0x38, 0xd9, 0x8b, 0x27, 0x17, 0xee, 0x76, 0xb4, 0xf5, 0x0c, 0xd4, 0xfa, 0x65, 0x89, 0x7e,
-	0x2e, 0x24, 0x17, 0x09, 0x4f, 0x33, 0xed, 0x56, 0xfd, 0x25, 0xa5, 0x0d, 0x60, 0x82, 0xd6, 0x5f,
-	0xfb, 0x10, 0xee, 0x3b, 0x0a, 0x94, 0x11, 0xce, 0xbf, 0xd5, 0xdc, 0xa8, 0x65, 0x38, 0x8e, 0x4e,
-	0x2d, 0x99, 0x62, 0x75, 0xd7, 0x81, 0x1a, 0x56, 0xff, 0x44, 0x98, 0x71, 0x57, 0x06, 0x35, 0xbe,
-	0x99, 0xa0, 0x55, 0xb1, 0x9b, 0xb0, 0x04, 0x85, 0x12, 0x14, 0x5e, 0x4b, 0x50, 0xc7, 0x9c, 0xce,
-	0x0d, 0x65, 0x35, 0x37, 0xea, 0x65, 0xea, 0x96, 0xdd, 0x9a, 0xbc, 0x1a, 0xa0, 0x5b, 0xcd, 0xff,
-	0xc9, 0x06, 0xd6, 0x13, 0x50, 0xab, 0x02, 0xec, 0x8a, 0x63, 0x4e, 0xb4, 0x9e, 0xfa, 0x5b, 0xea,
-	0x59, 0x03, 0x98, 0xdf, 0x5b, 0x15, 0xfb, 0x78, 0x3f, 0xd1, 0x67, 0xb7, 0x71, 0x7e, 0xe4, 0x5d,
-	0xba, 0x9b, 0x38, 0x6d, 0xa0, 0xd6, 0x8a, 0x42, 0x5e, 0x44, 0xfd, 0x7b, 0xf7, 0x8b, 0x44, 0x96,
-	0x20, 0xfa, 0xff, 0x81, 0x68, 0x13, 0x50, 0x32, 0x15, 0x67, 0x72, 0xf2, 0x9f, 0xb9, 0xcf, 0xb9,
-	0x99, 0x2e, 0x74, 0x30, 0x5b, 0xe8, 0xe0, 0x6d, 0xa1, 0x83, 0xc9, 0x52, 0x57, 0x66, 0x4b, 0x5d,
-	0x79, 0x59, 0xea, 0x4a, 0xef, 0x2c, 0x08, 0xf9, 0xdd, 0xc8, 0x83, 0x3e, 0x8d, 0x91, 0x80, 0x6a,
-	0x47, 0xd8, 0x63, 0x72, 0x40, 0x63, 0xbb, 0x83, 0x1e, 0xd7, 0xcf, 0xa0, 0xbd, 0x7e, 0x20, 0x3c,
-	0x1b, 0x12, 0xe6, 0xfd, 0x2c, 0xfa, 0x1d, 0xbd, 0x07, 0x00, 0x00, 0xff, 0xff, 0xee, 0x88, 0xb6,
-	0xa4, 0x28, 0x03, 0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0x66, 0x64, 0x43, 0x74, 0x76, 0x57, 0x36, 0x5d, 0xa2, 0xc0, 0xa1, 0x6d, 0xea, 0x85, 0x98,
+	0xec, 0x34, 0x60, 0x34, 0xc6, 0xc4, 0x4b, 0xb3, 0xea, 0xc5, 0x83, 0x41, 0x13, 0x92, 0xf5, 0xd0,
+	0x4c, 0xa1, 0xd4, 0xc6, 0xb6, 0x43, 0x3a, 0xc3, 0x22, 0x57, 0x7f, 0xc1, 0x1e, 0xfd, 0x45, 0x66,
+	0x8f, 0x7b, 0x32, 0x9e, 0xd0, 0xc0, 0x3f, 0xd8, 0x5f, 0x60, 0x3a, 0xf3, 0x06, 0x04, 0x37, 0xd6,
+	0x93, 0x37, 0xe6, 0xbd, 0xef, 0x7d, 0xdf, 0xfb, 0xbe, 0x19, 0x8a, 0x09, 0xe3, 0x29, 0xe3, 0x31,
+	0x77, 0x47, 0x6c, 0x96, 0x89, 0x38, 0x0d, 0x47, 0xa1, 0x08, 0x87, 0x82, 0xe5, 0xee, 0x79, 0x37,
+	0x08, 0x05, 0xed, 0xba, 0x51, 0x98, 0x85, 0x3c, 0xe6, 0x64, 0x92, 0x33, 0xc1, 0x0c, 0x1b, 0xf0,
+	0x64, 0x17, 0x4f, 0x00, 0xdf, 0x6e, 0x44, 0x2c, 0x62, 0x12, 0xec, 0x16, 0xbf, 0xd4, 0x5c, 0xbb,
+	0x15, 0x31, 0x16, 0x25, 0xa1, 0x2b, 0x4f, 0xc1, 0x74, 0xec, 0xd2, 0x6c, 0xae, 0x5b, 0x43, 0xc9,
+	0xe9, 0xab, 0x19, 0x75, 0x80, 0x96, 0xb9, 0x3b, 0x35, 0x9a, 0xe6, 0x54, 0xc4, 0x2c, 0x83, 0xbe,
+	0xb5, 0xdb, 0x2f, 0x36, 0xe2, 0x82, 0xa6, 0x13, 0x00, 0x3c, 0x2d, 0xb5, 0xa7, 0x1b, 0xfe, 0x36,
+	0xb5, 0xf3, 0x0d, 0xe1, 0xc6, 0x2b, 0x65, 0xfd, 0x14, 0x20, 0x2f, 0x32, 0x91, 0xcf, 0x8d, 0xf7,
+	0xf8, 0xb6, 0x86, 0x36, 0x91, 0x8d, 0x3a, 0x77, 0x7b, 0x0f, 0x49, 0x59, 0x28, 0x44, 0x53, 0x78,
+	0xc7, 0xd7, 0x0b, 0xab, 0x3e, 0xa7, 0x69, 0xf2, 0xcc, 0xd1, 0x2c, 0x4e, 0x7f, 0x4d, 0x68, 0x50,
+	0x7c, 0x98, 0x50, 0x2e, 0x7c, 0x4d, 0xd4, 0xbc, 0x65, 0xa3, 0xce, 0x7e, 0xaf, 0x4d, 0x94, 0x51,
+	0xa2, 0x8d, 0x92, 0x77, 0xda, 0xa8, 0x67, 0x5f, 0x2e, 0xac, 0xca, 0xf5, 0xc2, 0x6a, 0x28, 0xd6,
+	0xad, 0x71, 0xe7, 0xe2, 0x87, 0x85, 0xfa, 0x07, 0x45, 0x4d, 0x6f, 0xe0, 0x7c, 0x45, 0xf8, 0x78,
+	0x10, 0x67, 0x23, 0x36, 0xdb, 0xf6, 0xf5, 0x1a, 0xd7, 0x66, 0xb2, 0x2c, 0x5d, 0xed, 0xf7, 0x5a,
+	0x7f, 0x68, 0x9e, 0xc2, 0x96, 0x5e, 0x0b, 0x24, 0x0f, 0x95, 0xa4, 0x1a, 0x73, 0xbe, 0x14, 0x5a,
+	0xc0, 0xf1, 0x3f, 0x8c, 0x70, 0x5c, 0x7b, 0x43, 0x73, 0x9a, 0x72, 0x23, 0xc6, 0x47, 0xeb, 0x6b,
+	0x54, 0xfa, 0xbc, 0x89, 0xec, 0xea, 0xdf, 0x4d, 0x3c, 0x00, 0xb9, 0xfb, 0x70, 0x1b, 0x3b, 0x04,
+	0xca, 0x4e, 0x5d, 0x97, 0x07, 0x50, 0xfd, 0x5c, 0xc5, 0x07, 0xf0, 0x2c, 0xde, 0x0a, 0x2a, 0x42,
+	0xe3, 0x0c, 0xdf, 0xd1, 0x18, 0x2d, 0xfa, 0xa4, 0xfc, 0x3d, 0xdc, 0xf4, 0xb2, 0xbc, 0xbd, 0x62,
+	0xa3, 0xfe, 0x86, 0xce, 0x18, 0xe3, 0xba, 0x4c, 0x21, 0x48, 0xd8, 0xf0, 0xa3, 0xff, 0x8f, 0x31,
+	0x3a, 0xe0, 0xeb, 0xde, 0x6f, 0x31, 0x6e, 0x08, 0x54, 0x90, 0xf2, 0x6e, 0xbc, 0xa2, 0x58, 0xcc,
+	0x19, 0x2f, 0x71, 0x6d, 0x22, 0x93, 0x6c, 0x56, 0x25, 0x7d, 0xa7, 0xdc, 0x80, 0x4a, 0x1e, 0x56,
+	0x86, 0x69, 0x63, 0x8c, 0x8f, 0x54, 0x7a, 0xfe, 0x26, 0x92, 0x3d, 0x19, 0xc9, 0xe3, 0x72, 0xc6,
+	0x1b, 0xde, 0x24, 0xd0, 0xd7, 0x67, 0x5b, 0x2d, 0xee, 0x0d, 0x2e, 0x97, 0x26, 0xba, 0x5a, 0x9a,
+	0xe8, 0xe7, 0xd2, 0x44, 0x17, 0x2b, 0xb3, 0x72, 0xb5, 0x32, 0x2b, 0xdf, 0x57, 0x66, 0xe5, 0xec,
+	0x79, 0x14, 0x8b, 0x0f, 0xd3, 0x80, 0x0c, 0x59, 0xea, 0x82, 0xe2, 0x49, 0x42, 0x03, 0xae, 0x0f,
+	0xee, 0x79, 0xaf, 0xeb, 0x7e, 0x5a, 0xff, 0xe9, 0x4f, 0xd6, 0x9f, 0x03, 0x31, 0x9f, 0x84, 0x3c,
+	0xa8, 0xc9, 0x3c, 0x1f, 0xfd, 0x1a, 0x00, 0x78, 0x54, 0x75, 0x6a, 0x16, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisDowntimeEntry) Marshal() (dAtA []byte, err error) {
@@ -210,7 +339,7 @@ func (m *GenesisDowntimeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+func (m *WindowDowntimeEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -220,17 +349,17 @@ func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+func (m *WindowDowntimeEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *WindowDowntimeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime):])
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastDowntime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntime):])
 	if err2 != nil {
 		return 0, err2
 	}
@@ -238,6 +367,104 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i = encodeVarintGenesis(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DowntimeWindows) > 0 {
+		for iNdEx := len(m.DowntimeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeWindows[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeWindows[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintGenesis(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WindowDowntimes) > 0 {
+		for iNdEx := len(m.WindowDowntimes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WindowDowntimes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
 	if len(m.Downtimes) > 0 {
 		for iNdEx := len(m.Downtimes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -280,6 +507,34 @@ func (m *GenesisDowntimeEntry) Size() (n int) {
 	return n
 }
 
+func (m *WindowDowntimeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastDowntime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DowntimeWindows) > 0 {
+		for _, e := range m.DowntimeWindows {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -294,6 +549,14 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastBlockTime)
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.WindowDowntimes) > 0 {
+		for _, e := range m.WindowDowntimes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -405,6 +668,206 @@ func (m *GenesisDowntimeEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WindowDowntimeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowDowntimeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowDowntimeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDowntime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastDowntime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeWindows = append(m.DowntimeWindows, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.DowntimeWindows[len(m.DowntimeWindows)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -501,6 +964,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDowntimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowDowntimes = append(m.WindowDowntimes, WindowDowntimeEntry{})
+			if err := m.WindowDowntimes[len(m.WindowDowntimes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,11 +1,15 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+)
 
 // There are few of these keys, so we don't concern ourselves with small key names.
 var (
 	lastBlockTimestampKey      = []byte("last_block_timestamp")
 	lastDowntimeOfLengthPrefix = "last_downtime_of_length/%s"
+	lastDowntimeOfWindowPrefix = "last_downtime_of_window/%d"
 )
 
 func GetLastBlockTimestampKey() []byte { return lastBlockTimestampKey }
@@ -13,3 +17,13 @@ func GetLastBlockTimestampKey() []byte { return lastBlockTimestampKey }
 func GetLastDowntimeOfLengthKey(downtimeDur Downtime) []byte {
 	return []byte(fmt.Sprintf(lastDowntimeOfLengthPrefix, downtimeDur.String()))
 }
+
+// GetLastDowntimeOfWindowKey returns the key of the last downtime of the given window.
+// Windows that correspond to a Downtime enum value share the key of that enum value,
+// so that configuring them as params does not require migrating existing state.
+func GetLastDowntimeOfWindowKey(window time.Duration) []byte {
+	if downtime, err := DowntimeByDuration(window); err == nil {
+		return GetLastDowntimeOfLengthKey(downtime)
+	}
+	return []byte(fmt.Sprintf(lastDowntimeOfWindowPrefix, window.Nanoseconds()))
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyDowntimeWindows = []byte("DowntimeWindows")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+// ParamTable for downtime-detector module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(downtimeWindows []time.Duration) Params {
+	return Params{
+		DowntimeWindows: downtimeWindows,
+	}
+}
+
+// default downtime-detector module parameters.
+// The default downtime windows are the durations of the Downtime enum.
+func DefaultParams() Params {
+	return Params{
+		DowntimeWindows: DowntimeToDuration.Values(),
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	return validateDowntimeWindows(p.DowntimeWindows)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyDowntimeWindows, &p.DowntimeWindows, validateDowntimeWindows),
+	}
+}
+
+func validateDowntimeWindows(i interface{}) error {
+	windows, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(windows) == 0 {
+		return fmt.Errorf("at least one downtime window is required")
+	}
+
+	seen := make(map[time.Duration]struct{}, len(windows))
+	for _, window := range windows {
+		if window < MinDowntimeWindow {
+			return fmt.Errorf("downtime window must be at least %s, got %s", MinDowntimeWindow, window)
+		}
+		if _, ok := seen[window]; ok {
+			return fmt.Errorf("duplicate downtime window %s", window)
+		}
+		seen[window] = struct{}{}
+	}
+
+	return nil
+}
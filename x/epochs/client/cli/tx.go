@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := osmocli.TxIndexCmd(types.ModuleName)
+	osmocli.AddTxCmd(cmd, NewForceEpochEndCmd)
+
+	return cmd
+}
+
+// NewForceEpochEndCmd ends the current epoch of the given identifier immediately.
+// The sender must be in the force epoch end allowlist param.
+func NewForceEpochEndCmd() (*osmocli.TxCliDesc, *types.MsgForceEpochEnd) {
+	return &osmocli.TxCliDesc{
+		Use:     "force-epoch-end",
+		Short:   "end the current epoch of the given identifier immediately",
+		Long:    "end the current epoch of the given identifier immediately and start the next one. Only addresses in the force epoch end allowlist param may do so, which is empty unless set by governance.",
+		Example: "osmosisd tx epochs force-epoch-end day --from val --chain-id osmosis-1",
+	}, &types.MsgForceEpochEnd{}
+}
@@ -63,3 +63,42 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		return false
 	})
 }
+
+// ForceEpochEnd ends the current epoch of the given identifier at the current block and starts the next one,
+// emitting the same events and running the same hooks as the BeginBlocker.
+// The next epoch starts at the current block time, so that it lasts a full epoch duration.
+// Returns the number of the started epoch.
+// Returns error if the epoch does not exist or has not started yet.
+func (k Keeper) ForceEpochEnd(ctx sdk.Context, identifier string) (int64, error) {
+	epochInfo := k.GetEpochInfo(ctx, identifier)
+	if epochInfo.Identifier == "" {
+		return 0, types.EpochNotFoundError{Identifier: identifier}
+	}
+	if !epochInfo.EpochCountingStarted {
+		return 0, types.EpochNotStartedError{Identifier: identifier}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
+		),
+	)
+	k.AfterEpochEnd(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+	epochInfo.CurrentEpoch += 1
+	epochInfo.CurrentEpochStartTime = ctx.BlockTime()
+	epochInfo.CurrentEpochStartHeight = ctx.BlockHeight()
+	k.Logger(ctx).Info(fmt.Sprintf("Forced start of epoch with identifier %s epoch number %d", epochInfo.Identifier, epochInfo.CurrentEpoch))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochStart,
+			sdk.NewAttribute(types.AttributeEpochNumber, fmt.Sprintf("%d", epochInfo.CurrentEpoch)),
+			sdk.NewAttribute(types.AttributeEpochStartTime, fmt.Sprintf("%d", epochInfo.CurrentEpochStartTime.Unix())),
+		),
+	)
+	k.setEpochInfo(ctx, epochInfo)
+	k.BeforeEpochStart(ctx, epochInfo.Identifier, epochInfo.CurrentEpoch)
+
+	return epochInfo.CurrentEpoch, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/stretchr/testify/suite"
 
@@ -45,8 +46,12 @@ func TestKeeperTestSuite(t *testing.T) {
 
 func Setup() (sdk.Context, *epochskeeper.Keeper) {
 	epochsStoreKey := sdk.NewKVStoreKey(types.StoreKey)
-	ctx := testutil.DefaultContext(epochsStoreKey, sdk.NewTransientStoreKey("transient_test"))
-	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey)
+	transientStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(epochsStoreKey, transientStoreKey)
+	// the params subspace shares the test stores, its keys are prefixed by the module name.
+	encCfg := app.MakeEncodingConfig()
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, epochsStoreKey, transientStoreKey, types.ModuleName)
+	epochsKeeper := epochskeeper.NewKeeper(epochsStoreKey, paramSpace)
 	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx.WithBlockHeight(1).WithChainID("osmosis-1").WithBlockTime(time.Now().UTC())
 	epochsKeeper.InitGenesis(ctx, *types.DefaultGenesis())
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+// NewMsgServerImpl returns an instance of MsgServer.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ForceEpochEnd ends the current epoch of the given identifier immediately if the sender is in the
+// force epoch end allowlist. Governance enables the message by adding addresses to the allowlist.
+func (server msgServer) ForceEpochEnd(goCtx context.Context, msg *types.MsgForceEpochEnd) (*types.MsgForceEpochEndResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !server.keeper.GetParams(ctx).IsForceEpochEndAllowed(msg.Sender) {
+		return nil, types.ForceEpochEndNotAllowedError{Address: msg.Sender}
+	}
+
+	currentEpoch, err := server.keeper.ForceEpochEnd(ctx, msg.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgForceEpochEndResponse{CurrentEpoch: currentEpoch}, nil
+}
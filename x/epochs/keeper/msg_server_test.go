@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochskeeper "github.com/osmosis-labs/osmosis/x/epochs/keeper"
+	"github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestForceEpochEnd() {
+	allowedAddr := sdk.AccAddress([]byte("allowed-------------"))
+	otherAddr := sdk.AccAddress([]byte("other---------------"))
+	msgServer := epochskeeper.NewMsgServerImpl(s.EpochsKeeper)
+
+	// forcing epoch ends is disabled by default
+	_, err := msgServer.ForceEpochEnd(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceEpochEnd(allowedAddr, "day"))
+	s.Require().ErrorIs(err, types.ForceEpochEndNotAllowedError{Address: allowedAddr.String()})
+
+	s.EpochsKeeper.SetParams(s.Ctx, types.NewParams([]string{allowedAddr.String()}))
+
+	// addresses outside of the allowlist are rejected
+	_, err = msgServer.ForceEpochEnd(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceEpochEnd(otherAddr, "day"))
+	s.Require().ErrorIs(err, types.ForceEpochEndNotAllowedError{Address: otherAddr.String()})
+
+	// unknown epochs and epochs that have not started cannot be ended
+	_, err = msgServer.ForceEpochEnd(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceEpochEnd(allowedAddr, "month"))
+	s.Require().ErrorIs(err, types.EpochNotFoundError{Identifier: "month"})
+	_, err = msgServer.ForceEpochEnd(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceEpochEnd(allowedAddr, "day"))
+	s.Require().ErrorIs(err, types.EpochNotStartedError{Identifier: "day"})
+
+	// start epoch counting
+	s.EpochsKeeper.BeginBlocker(s.Ctx)
+	epochInfo := s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().Equal(int64(1), epochInfo.CurrentEpoch)
+
+	// the allowed address ends the epoch long before its duration elapsed
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	res, err := msgServer.ForceEpochEnd(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceEpochEnd(allowedAddr, "day"))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), res.CurrentEpoch)
+
+	epochInfo = s.EpochsKeeper.GetEpochInfo(s.Ctx, "day")
+	s.Require().Equal(int64(2), epochInfo.CurrentEpoch)
+	s.Require().Equal(s.Ctx.BlockTime(), epochInfo.CurrentEpochStartTime)
+	s.Require().Equal(s.Ctx.BlockHeight(), epochInfo.CurrentEpochStartHeight)
+
+	// other epochs are not affected
+	s.Require().Equal(int64(1), s.EpochsKeeper.GetEpochInfo(s.Ctx, "week").CurrentEpoch)
+
+	// the forced epoch lasts a full duration
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(epochInfo.Duration))
+	s.EpochsKeeper.BeginBlocker(s.Ctx)
+	s.Require().Equal(int64(2), s.EpochsKeeper.GetEpochInfo(s.Ctx, "day").CurrentEpoch)
+
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.EpochsKeeper.BeginBlocker(s.Ctx)
+	s.Require().Equal(int64(3), s.EpochsKeeper.GetEpochInfo(s.Ctx, "day").CurrentEpoch)
+}
@@ -0,0 +1,27 @@
+package types
+
+import "fmt"
+
+type ForceEpochEndNotAllowedError struct {
+	Address string
+}
+
+func (e ForceEpochEndNotAllowedError) Error() string {
+	return fmt.Sprintf("address %s is not allowed to force epoch ends", e.Address)
+}
+
+type EpochNotFoundError struct {
+	Identifier string
+}
+
+func (e EpochNotFoundError) Error() string {
+	return fmt.Sprintf("epoch with identifier %s does not exist", e.Identifier)
+}
+
+type EpochNotStartedError struct {
+	Identifier string
+}
+
+func (e EpochNotStartedError) Error() string {
+	return fmt.Sprintf("epoch with identifier %s has not started yet", e.Identifier)
+}
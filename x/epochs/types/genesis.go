@@ -9,7 +9,7 @@ import (
 const DefaultIndex uint64 = 1
 
 func NewGenesisState(epochs []EpochInfo) *GenesisState {
-	return &GenesisState{Epochs: epochs}
+	return &GenesisState{Epochs: epochs, Params: DefaultParams()}
 }
 
 // DefaultGenesis returns the default Capability genesis state.
@@ -25,6 +25,9 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	epochIdentifiers := map[string]bool{}
 	for _, epoch := range gs.Epochs {
 		if err := epoch.Validate(); err != nil {
@@ -158,6 +158,7 @@ func (m *EpochInfo) GetCurrentEpochStartHeight() int64 {
 // GenesisState defines the epochs module's genesis state.
 type GenesisState struct {
 	Epochs []EpochInfo `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Params Params      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -200,9 +201,66 @@ func (m *GenesisState) GetEpochs() []EpochInfo {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params holds parameters for the epochs module
+type Params struct {
+	// force_epoch_end_allowlist is the list of addresses allowed to end an epoch
+	// immediately with MsgForceEpochEnd. Forcing epoch ends is disabled when the
+	// list is empty, which is the default. It is meant for testnets and devnets
+	// only.
+	ForceEpochEndAllowlist []string `protobuf:"bytes,1,rep,name=force_epoch_end_allowlist,json=forceEpochEndAllowlist,proto3" json:"force_epoch_end_allowlist,omitempty" yaml:"force_epoch_end_allowlist"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dd2db84ad8300ca, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetForceEpochEndAllowlist() []string {
+	if m != nil {
+		return m.ForceEpochEndAllowlist
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EpochInfo)(nil), "osmosis.epochs.v1beta1.EpochInfo")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.epochs.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "osmosis.epochs.v1beta1.Params")
 }
 
 func init() {
@@ -210,37 +268,41 @@ func init() {
 }
 
 var fileDescriptor_7dd2db84ad8300ca = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x6b, 0x5a, 0x4a, 0xea, 0x3b, 0x04, 0x44, 0xc7, 0x11, 0x2a, 0x91, 0x84, 0xc0, 0x10,
-	0x09, 0x70, 0xd4, 0x83, 0x09, 0x06, 0xa4, 0x02, 0xe2, 0x60, 0x41, 0x4a, 0x19, 0x10, 0x4b, 0x95,
-	0xb4, 0x6e, 0x62, 0xa9, 0x89, 0xa3, 0xf8, 0x05, 0xd1, 0x8d, 0x8f, 0xd0, 0x91, 0x8f, 0x74, 0xe3,
-	0x8d, 0x4c, 0x05, 0xb5, 0x1b, 0xe3, 0x7d, 0x02, 0x14, 0xdb, 0x29, 0x85, 0x3b, 0xc4, 0x16, 0xfb,
-	0xff, 0x7b, 0xff, 0xbf, 0xdf, 0xd3, 0x0b, 0xbe, 0xcf, 0x45, 0xc6, 0x05, 0x13, 0x01, 0x2d, 0xf8,
-	0x24, 0x15, 0xc1, 0xa7, 0x41, 0x4c, 0x21, 0x1a, 0x04, 0x09, 0xcd, 0xa9, 0x60, 0x82, 0x14, 0x25,
-	0x07, 0x6e, 0x1e, 0x6a, 0x8a, 0x28, 0x8a, 0x68, 0xaa, 0x7f, 0x90, 0xf0, 0x84, 0x4b, 0x24, 0xa8,
-	0xbf, 0x14, 0xdd, 0xb7, 0x13, 0xce, 0x93, 0x39, 0x0d, 0xe4, 0x29, 0xae, 0x66, 0xc1, 0xb4, 0x2a,
-	0x23, 0x60, 0x3c, 0xd7, 0xba, 0xf3, 0xb7, 0x0e, 0x2c, 0xa3, 0x02, 0xa2, 0xac, 0x50, 0x80, 0xb7,
-	0xec, 0xe0, 0xde, 0xab, 0x3a, 0xe9, 0x4d, 0x3e, 0xe3, 0xa6, 0x8d, 0x31, 0x9b, 0xd2, 0x1c, 0xd8,
-	0x8c, 0xd1, 0xd2, 0x42, 0x2e, 0xf2, 0x7b, 0xe1, 0xce, 0x8d, 0xf9, 0x01, 0x63, 0x01, 0x51, 0x09,
-	0xe3, 0xda, 0xc6, 0xba, 0xe4, 0x22, 0x7f, 0xef, 0xa8, 0x4f, 0x54, 0x06, 0x69, 0x32, 0xc8, 0xfb,
-	0x26, 0x63, 0x78, 0xe7, 0x64, 0xe5, 0xb4, 0xce, 0x56, 0xce, 0x8d, 0x45, 0x94, 0xcd, 0x9f, 0x7a,
-	0xbf, 0x6b, 0xbd, 0xe5, 0x77, 0x07, 0x85, 0x3d, 0x79, 0x51, 0xe3, 0x66, 0x8a, 0x8d, 0xe6, 0xe9,
-	0x56, 0x5b, 0xfa, 0xde, 0x3e, 0xe7, 0xfb, 0x52, 0x03, 0xc3, 0x41, 0x6d, 0xfb, 0x73, 0xe5, 0x98,
-	0x4d, 0xc9, 0x43, 0x9e, 0x31, 0xa0, 0x59, 0x01, 0x8b, 0xb3, 0x95, 0x73, 0x4d, 0x85, 0x35, 0x9a,
-	0xf7, 0xb5, 0x8e, 0xda, 0xba, 0x9b, 0xf7, 0xf0, 0xd5, 0x49, 0x55, 0x96, 0x34, 0x87, 0xb1, 0x1c,
-	0xb1, 0xd5, 0x71, 0x91, 0xdf, 0x0e, 0xf7, 0xf5, 0xa5, 0x1c, 0x86, 0xf9, 0x05, 0x61, 0xeb, 0x0f,
-	0x6a, 0xbc, 0xd3, 0xf7, 0xe5, 0xff, 0xf6, 0xfd, 0x40, 0xf7, 0xed, 0xa8, 0xa7, 0xfc, 0xcb, 0x49,
-	0x4d, 0xe1, 0xe6, 0x6e, 0xf2, 0x68, 0x3b, 0x91, 0x27, 0xf8, 0x50, 0xf1, 0x13, 0x5e, 0xe5, 0xc0,
-	0xf2, 0x44, 0x15, 0xd2, 0xa9, 0xd5, 0x75, 0x91, 0x6f, 0x84, 0x07, 0x52, 0x7d, 0xa1, 0xc5, 0x91,
-	0xd2, 0xcc, 0x67, 0xb8, 0x7f, 0x51, 0x5a, 0x4a, 0x59, 0x92, 0x82, 0x65, 0xc8, 0x56, 0x6f, 0x9d,
-	0x0b, 0x3c, 0x96, 0xf2, 0xdb, 0x8e, 0x71, 0xe5, 0xba, 0xe1, 0xbd, 0xc3, 0xfb, 0xaf, 0xd5, 0x4a,
-	0x8e, 0x20, 0x02, 0x6a, 0x3e, 0xc7, 0x5d, 0xb5, 0x8b, 0x16, 0x72, 0xdb, 0xfe, 0xde, 0xd1, 0x5d,
-	0x72, 0xf1, 0x8a, 0x92, 0xed, 0x1e, 0x0d, 0x3b, 0x75, 0xff, 0xa1, 0x2e, 0x1b, 0x1e, 0x9f, 0xac,
-	0x6d, 0x74, 0xba, 0xb6, 0xd1, 0x8f, 0xb5, 0x8d, 0x96, 0x1b, 0xbb, 0x75, 0xba, 0xb1, 0x5b, 0xdf,
-	0x36, 0x76, 0xeb, 0x23, 0x49, 0x18, 0xa4, 0x55, 0x4c, 0x26, 0x3c, 0x0b, 0xb4, 0xe9, 0xa3, 0x79,
-	0x14, 0x8b, 0xe6, 0x10, 0x7c, 0x6e, 0x7e, 0x16, 0x58, 0x14, 0x54, 0xc4, 0x5d, 0x39, 0xeb, 0xc7,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x53, 0xe7, 0xa2, 0xc2, 0x4b, 0x03, 0x00, 0x00,
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x85, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0x49, 0x48, 0x9d, 0xa5, 0x08, 0xba, 0x2a, 0xc1, 0x8d, 0x84, 0x5d, 0x4c, 0x0f, 0x48,
+	0xc0, 0x5a, 0x01, 0x4e, 0x80, 0x84, 0x30, 0x54, 0x14, 0x4e, 0xc8, 0xe5, 0x80, 0xb8, 0x58, 0xfe,
+	0xd8, 0xd8, 0x2b, 0xd9, 0x5e, 0xcb, 0x5e, 0x03, 0xb9, 0xf1, 0x07, 0x90, 0x72, 0xe4, 0x27, 0xf5,
+	0xd8, 0x23, 0xa7, 0x80, 0xe0, 0xc6, 0x91, 0x5f, 0xc0, 0x7a, 0x77, 0x1d, 0x02, 0x6d, 0xc4, 0x61,
+	0x24, 0xef, 0xbc, 0x37, 0xf3, 0x66, 0x9e, 0xc6, 0x60, 0x9f, 0xd6, 0x39, 0xad, 0x49, 0xed, 0xe0,
+	0x92, 0x46, 0x69, 0xed, 0xbc, 0x9b, 0x84, 0x98, 0x05, 0x13, 0x27, 0xc1, 0x05, 0xe6, 0x69, 0x54,
+	0x56, 0x94, 0x51, 0x38, 0x52, 0x2c, 0x24, 0x59, 0x48, 0xb1, 0xc6, 0x3b, 0x09, 0x4d, 0xa8, 0xa0,
+	0x38, 0xed, 0x97, 0x64, 0x8f, 0xcd, 0x84, 0xd2, 0x24, 0xc3, 0x8e, 0x78, 0x85, 0xcd, 0xd4, 0x89,
+	0x9b, 0x2a, 0x60, 0x84, 0x16, 0x0a, 0xb7, 0xfe, 0xc5, 0x19, 0xc9, 0x71, 0xcd, 0x82, 0xbc, 0x94,
+	0x04, 0x7b, 0xde, 0x07, 0xc3, 0x83, 0x56, 0xe9, 0x45, 0x31, 0xa5, 0xd0, 0x04, 0x80, 0xc4, 0xb8,
+	0x60, 0x64, 0x4a, 0x70, 0x65, 0x68, 0x7b, 0xda, 0xcd, 0xa1, 0xb7, 0x92, 0x81, 0x6f, 0x00, 0xe0,
+	0xc5, 0x15, 0xf3, 0xdb, 0x36, 0xc6, 0x39, 0x8e, 0x5f, 0xb8, 0x3b, 0x46, 0x52, 0x03, 0x75, 0x1a,
+	0xe8, 0x75, 0xa7, 0xe1, 0x5e, 0x3b, 0x5e, 0x58, 0x1b, 0xbf, 0x16, 0xd6, 0xf6, 0x2c, 0xc8, 0xb3,
+	0x07, 0xf6, 0x9f, 0x5a, 0x7b, 0xfe, 0xd5, 0xd2, 0xbc, 0xa1, 0x48, 0xb4, 0x74, 0x98, 0x02, 0xbd,
+	0x1b, 0xdd, 0xe8, 0x89, 0xbe, 0xbb, 0xa7, 0xfa, 0x3e, 0x53, 0x04, 0x77, 0xd2, 0xb6, 0xfd, 0xb9,
+	0xb0, 0x60, 0x57, 0x72, 0x9b, 0xe6, 0x84, 0xe1, 0xbc, 0x64, 0x33, 0x2e, 0x76, 0x49, 0x8a, 0x75,
+	0x98, 0xfd, 0xb9, 0x95, 0x5a, 0x76, 0x87, 0x37, 0xc0, 0xc5, 0xa8, 0xa9, 0x2a, 0xbe, 0x93, 0x2f,
+	0x2c, 0x36, 0xfa, 0x5c, 0xae, 0xe7, 0x6d, 0xa9, 0xa4, 0x30, 0x03, 0x7e, 0xd4, 0x80, 0xf1, 0x17,
+	0xcb, 0x5f, 0xd9, 0xfb, 0xfc, 0x7f, 0xf7, 0xbe, 0xa5, 0xf6, 0xb6, 0xe4, 0x28, 0xeb, 0x3a, 0x49,
+	0x17, 0xae, 0xac, 0x2a, 0x1f, 0x2d, 0x1d, 0xb9, 0x0f, 0x46, 0x92, 0x1f, 0xd1, 0x86, 0xfb, 0x5f,
+	0x24, 0xb2, 0x10, 0xc7, 0xc6, 0x80, 0xeb, 0xeb, 0xde, 0x8e, 0x40, 0x9f, 0x2a, 0xf0, 0x48, 0x62,
+	0xf0, 0x21, 0x18, 0x9f, 0xa5, 0x96, 0x62, 0x92, 0xa4, 0xcc, 0xd0, 0xc5, 0xaa, 0x57, 0x4f, 0x09,
+	0x1e, 0x0a, 0xf8, 0x65, 0x5f, 0xdf, 0xbc, 0xac, 0xdb, 0x9f, 0x34, 0xb0, 0xf5, 0x5c, 0xde, 0x24,
+	0x07, 0x19, 0x86, 0x8f, 0xc1, 0x40, 0x1e, 0x23, 0xbf, 0x88, 0x1e, 0xdf, 0xfc, 0x3a, 0x3a, 0xfb,
+	0x46, 0xd1, 0xf2, 0x90, 0xdc, 0x7e, 0x6b, 0x80, 0xa7, 0xca, 0xe0, 0x23, 0x30, 0x28, 0x83, 0x2a,
+	0xc8, 0x6b, 0x75, 0x32, 0xe6, 0xba, 0x06, 0xaf, 0x04, 0xab, 0xab, 0x96, 0x35, 0x36, 0x01, 0x03,
+	0x99, 0x87, 0x3e, 0xd8, 0x9d, 0xd2, 0x2a, 0xc2, 0x6a, 0x35, 0x5c, 0xc4, 0x7e, 0x90, 0x65, 0xf4,
+	0x7d, 0x46, 0x6a, 0x26, 0x66, 0x1b, 0xba, 0xfb, 0xdc, 0xf5, 0x3d, 0xe9, 0xfa, 0x5a, 0xaa, 0xed,
+	0x8d, 0x04, 0x26, 0xc6, 0x3d, 0x28, 0xe2, 0x27, 0x1d, 0xe0, 0x1e, 0x1e, 0x7f, 0x37, 0xb5, 0x13,
+	0x1e, 0xdf, 0x78, 0xcc, 0x7f, 0x98, 0x1b, 0x27, 0x3c, 0xbe, 0xf0, 0x78, 0x8b, 0x12, 0xc2, 0xd2,
+	0x26, 0x44, 0x11, 0xcd, 0x1d, 0x35, 0xfc, 0x9d, 0x2c, 0x08, 0xeb, 0xee, 0xe1, 0x7c, 0xe8, 0x7e,
+	0x6b, 0x36, 0x2b, 0x71, 0x1d, 0x0e, 0xc4, 0x55, 0xdc, 0xfb, 0x0d, 0xae, 0x75, 0x6c, 0xde, 0xf5,
+	0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -337,6 +399,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Epochs) > 0 {
 		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -354,6 +426,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForceEpochEndAllowlist) > 0 {
+		for iNdEx := len(m.ForceEpochEndAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForceEpochEndAllowlist[iNdEx])
+			copy(dAtA[i:], m.ForceEpochEndAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForceEpochEndAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -405,6 +509,23 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForceEpochEndAllowlist) > 0 {
+		for _, s := range m.ForceEpochEndAllowlist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -716,6 +837,121 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceEpochEndAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceEpochEndAllowlist = append(m.ForceEpochEndAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -737,6 +973,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
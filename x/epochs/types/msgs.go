@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants.
+const (
+	TypeMsgForceEpochEnd = "force_epoch_end"
+)
+
+var _ sdk.Msg = &MsgForceEpochEnd{}
+
+// NewMsgForceEpochEnd creates a message to end the current epoch of the given identifier immediately.
+func NewMsgForceEpochEnd(sender sdk.AccAddress, identifier string) *MsgForceEpochEnd {
+	return &MsgForceEpochEnd{
+		Sender:     sender.String(),
+		Identifier: identifier,
+	}
+}
+
+func (m MsgForceEpochEnd) Route() string { return RouterKey }
+func (m MsgForceEpochEnd) Type() string  { return TypeMsgForceEpochEnd }
+func (m MsgForceEpochEnd) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return fmt.Errorf("invalid sender address (%s)", err)
+	}
+
+	return ValidateEpochIdentifierString(m.Identifier)
+}
+
+func (m MsgForceEpochEnd) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgForceEpochEnd) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyForceEpochEndAllowlist = []byte("ForceEpochEndAllowlist")
+
+	_ paramtypes.ParamSet = &Params{}
+)
+
+// ParamTable for epochs module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(forceEpochEndAllowlist []string) Params {
+	return Params{
+		ForceEpochEndAllowlist: forceEpochEndAllowlist,
+	}
+}
+
+// default epochs module parameters.
+// Forcing epoch ends is disabled by default.
+func DefaultParams() Params {
+	return Params{
+		ForceEpochEndAllowlist: []string{},
+	}
+}
+
+// validate params.
+func (p Params) Validate() error {
+	return validateForceEpochEndAllowlist(p.ForceEpochEndAllowlist)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyForceEpochEndAllowlist, &p.ForceEpochEndAllowlist, validateForceEpochEndAllowlist),
+	}
+}
+
+// IsForceEpochEndAllowed returns true if the given address may force epoch ends.
+// No address is allowed when the allowlist is empty.
+func (p Params) IsForceEpochEndAllowed(address string) bool {
+	for _, allowed := range p.ForceEpochEndAllowlist {
+		if allowed == address {
+			return true
+		}
+	}
+	return false
+}
+
+func validateForceEpochEndAllowlist(i interface{}) error {
+	allowlist, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(allowlist))
+	for _, address := range allowlist {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid force epoch end allowlist address %s: %w", address, err)
+		}
+		if _, ok := seen[address]; ok {
+			return fmt.Errorf("duplicate force epoch end allowlist address %s", address)
+		}
+		seen[address] = struct{}{}
+	}
+
+	return nil
+}
@@ -200,7 +200,7 @@ Ex) 2,4,1,5 -> [(Balancer 2, CL 4), (Balancer 1, CL 5)]
 
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -217,7 +217,7 @@ Ex) 2,4,1,5 -> [(Balancer 2, CL 4), (Balancer 1, CL 5)]
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -247,7 +247,7 @@ Ex) 2,4,1,5 -> [(Balancer 2, CL 4), (Balancer 1, CL 5)]
 
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -264,7 +264,7 @@ Ex) 2,4,1,5 -> [(Balancer 2, CL 4), (Balancer 1, CL 5)]
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -289,7 +289,7 @@ func NewCmdSubmitCreateCLPoolAndLinkToCFMMProposal() *cobra.Command {
 		Short: "Submit a create clpool and link to cfmm proposal",
 		Long:  strings.TrimSpace(`submit a proposal to create CL pool and link to Balancer pool.`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -306,7 +306,7 @@ func NewCmdSubmitCreateCLPoolAndLinkToCFMMProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -334,29 +334,29 @@ func NewCmdSubmitSetScalingFactorControllerProposal() *cobra.Command {
 Sample proposal file:
 {
 	"title": "Set Scaling Factor Controller Proposal",
-	"description": "Change scaling factor controller address from osmoXXX to osmoYYY"
-	"pool-id": 1,
-	"controller-address": "osmoYYY"
+	"summary": "Change scaling factor controller address from osmoXXX to osmoYYY",
+	"deposit": "1600000000uosmo",
+	"pool_id": 1,
+	"controller_address": "osmoYYY"
 }
 >>> osmosisd tx gov submit-proposal set-scaling-factor-controller-proposal \
-        --proposal proposal.json \
-		--deposit 1600000000uosmo \
+		--proposal proposal.json
 
 Sample proposal with flags
 >>> osmosisd tx gov submit-proposal set-scaling-factor-controller-proposal \
-        --title "Set Scaling Factor Controller Proposal" \
-		--summary "Change scaling factor controller address from osmoXXX to osmoYYY"
-		--deposit 1600000000uosmo
-		--pool-id 1
+		--title "Set Scaling Factor Controller Proposal" \
+		--summary "Change scaling factor controller address from osmoXXX to osmoYYY" \
+		--deposit 1600000000uosmo \
+		--pool-id 1 \
 		--controller-address osmoYYY
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
 
-			content, err := parseSetScalingFactorControllerArgsToContent(cmd)
+			content, err := parseSetScalingFactorControllerArgsToContent(cmd, proposalTitle, summary)
 			if err != nil {
 				return err
 			}
@@ -368,7 +368,7 @@ Sample proposal with flags
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -887,33 +887,47 @@ func parsePoolRecordsWithCFMMLink(cmd *cobra.Command) ([]types.PoolRecordWithCFM
 	return finalPoolRecords, nil
 }
 
-func parseSetScalingFactorControllerArgsToContent(cmd *cobra.Command) (govtypesv1beta1.Content, error) {
+// scalingFactorControllerProposalFile holds the fields of a set scaling factor controller proposal
+// that are read from the JSON file supplied with the --proposal flag. The same file holds the fields
+// common to all proposals, which are read by osmocli.GetProposalInfo.
+type scalingFactorControllerProposalFile struct {
+	PoolId            uint64 `json:"pool_id"`
+	ControllerAddress string `json:"controller_address"`
+}
+
+// parseSetScalingFactorControllerArgsToContent returns the content of a set scaling factor controller proposal
+// with the given title and description. The pool id and controller address are read from the JSON file supplied
+// with the --proposal flag if set, and from the respective flags otherwise.
+// Returns error if the --proposal flag is set alongside any of the respective flags.
+func parseSetScalingFactorControllerArgsToContent(cmd *cobra.Command, title, description string) (govtypesv1beta1.Content, error) {
 	proposalFile, err := cmd.Flags().GetString(govcli.FlagProposal) //nolint:staticcheck
 	if err != nil {
 		return nil, err
 	}
 
 	if proposalFile != "" {
+		for _, flag := range []string{FlagPoolId, FlagScalingFactorControllerAddress} {
+			if cmd.Flags().Changed(flag) {
+				return nil, fmt.Errorf("--%s flag provided alongside --%s, which is a noop", flag, govcli.FlagProposal) //nolint:staticcheck
+			}
+		}
+
 		contents, err := os.ReadFile(proposalFile)
 		if err != nil {
 			return nil, err
 		}
 
-		var proposal types.SetScalingFactorControllerProposal
+		var proposal scalingFactorControllerProposalFile
 		if err := json.Unmarshal(contents, &proposal); err != nil {
 			return nil, err
 		}
-		return &proposal, nil
-	}
 
-	title, err := cmd.Flags().GetString(govcli.FlagTitle)
-	if err != nil {
-		return nil, err
-	}
-
-	description, err := cmd.Flags().GetString(govcli.FlagSummary)
-	if err != nil {
-		return nil, err
+		return &types.SetScalingFactorControllerProposal{
+			Title:             title,
+			Description:       description,
+			PoolId:            proposal.PoolId,
+			ControllerAddress: proposal.ControllerAddress,
+		}, nil
 	}
 
 	poolId, err := cmd.Flags().GetUint64(FlagPoolId)
@@ -1,6 +1,8 @@
 package cli_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/client/cli"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 )
 
 func TestParseCoinsNoSort(t *testing.T) {
@@ -69,3 +72,77 @@ func TestParseCoinsNoSort(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSetScalingFactorControllerArgsToContent(t *testing.T) {
+	const (
+		title   = "Set Scaling Factor Controller Proposal"
+		summary = "Change scaling factor controller address from osmoXXX to osmoYYY"
+	)
+
+	// The proposal file also holds the fields common to all proposals, which are read by osmocli.GetProposalInfo.
+	proposalJSON := `{
+	"title": "Set Scaling Factor Controller Proposal",
+	"summary": "Change scaling factor controller address from osmoXXX to osmoYYY",
+	"deposit": "1600000000uosmo",
+	"pool_id": 1,
+	"controller_address": "osmoYYY"
+}`
+
+	tests := map[string]struct {
+		args          []string
+		proposalFile  string
+		expected      *types.SetScalingFactorControllerProposal
+		expectedError bool
+	}{
+		"from flags": {
+			args: []string{"--pool-id=2", "--controller-address=osmoZZZ"},
+			expected: &types.SetScalingFactorControllerProposal{
+				Title:             title,
+				Description:       summary,
+				PoolId:            2,
+				ControllerAddress: "osmoZZZ",
+			},
+		},
+		"from proposal file": {
+			proposalFile: proposalJSON,
+			expected: &types.SetScalingFactorControllerProposal{
+				Title:             title,
+				Description:       summary,
+				PoolId:            1,
+				ControllerAddress: "osmoYYY",
+			},
+		},
+		"proposal file alongside flag": {
+			args:          []string{"--pool-id=2"},
+			proposalFile:  proposalJSON,
+			expectedError: true,
+		},
+		"invalid proposal file": {
+			proposalFile:  "{",
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cmd := cli.NewCmdSubmitSetScalingFactorControllerProposal()
+
+			args := tc.args
+			if tc.proposalFile != "" {
+				path := filepath.Join(t.TempDir(), "proposal.json")
+				require.NoError(t, os.WriteFile(path, []byte(tc.proposalFile), 0o600))
+				args = append(args, "--proposal="+path)
+			}
+			require.NoError(t, cmd.Flags().Parse(args))
+
+			content, err := cli.ParseSetScalingFactorControllerArgsToContent(cmd, title, summary)
+			if tc.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, content)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +11,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
@@ -70,6 +73,7 @@ func (k Keeper) CalculateSpotPrice(
 // - Sets bank metadata for the LP denom
 // - Records total liquidity increase
 // - Calls the AfterPoolCreated hook
+// - Emits the smooth weight change schedule of balancer pools that have one
 func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sender sdk.AccAddress) (err error) {
 	cfmmPool, err := asCFMMPool(pool)
 	if err != nil {
@@ -120,9 +124,38 @@ func (k Keeper) InitializePool(ctx sdk.Context, pool poolmanagertypes.PoolI, sen
 	// create gauges.
 	k.hooks.AfterCFMMPoolCreated(ctx, sender, pool.GetId())
 	k.RecordTotalLiquidityIncrease(ctx, cfmmPool.GetTotalPoolLiquidity(ctx))
+
+	if balancerPool, ok := pool.(*balancer.Pool); ok && balancerPool.PoolParams.SmoothWeightChangeParams != nil {
+		emitSmoothWeightChangeEvent(ctx, balancerPool.Id, *balancerPool.PoolParams.SmoothWeightChangeParams)
+	}
 	return nil
 }
 
+// emitSmoothWeightChangeEvent emits the schedule of a liquidity bootstrapping style weight change,
+// so that indexers can follow the weights, and hence the spot price, of the pool over time
+// without re-implementing the interpolation of PokePool.
+func emitSmoothWeightChangeEvent(ctx sdk.Context, poolId uint64, params balancer.SmoothWeightChangeParams) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSmoothWeightChange,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, params.StartTime.String()),
+		sdk.NewAttribute(types.AttributeKeyEndTime, params.StartTime.Add(params.Duration).String()),
+		sdk.NewAttribute(types.AttributeKeyInitialWeights, formatPoolAssetWeights(params.InitialPoolWeights)),
+		sdk.NewAttribute(types.AttributeKeyTargetWeights, formatPoolAssetWeights(params.TargetPoolWeights)),
+	))
+}
+
+// formatPoolAssetWeights formats the weights of the given pool assets as a comma-separated list of
+// weighted denoms, e.g. "1073741824000000uatom,3221225472000000uosmo".
+func formatPoolAssetWeights(poolAssets []balancer.PoolAsset) string {
+	weights := make([]string, len(poolAssets))
+	for i, poolAsset := range poolAssets {
+		weights[i] = poolAsset.Weight.String() + poolAsset.Token.Denom
+	}
+	return strings.Join(weights, ",")
+}
+
 // JoinPoolNoSwap aims to LP exactly enough to pool #{poolId} to get shareOutAmount number of LP shares.
 // If the required tokens is greater than tokenInMaxs, returns an error & the message reverts.
 // Leftover tokens that weren't LP'd (due to being at inexact ratios) remain in the sender account.
@@ -403,7 +436,7 @@ func (k Keeper) ExitSwapExactAmountOut(
 		return osmomath.Int{}, err
 	}
 
-	extendedPool, ok := pool.(types.PoolAmountOutExtension)
+	extendedPool, ok := pool.(types.PoolSingleAssetExitExtension)
 	if !ok {
 		return osmomath.Int{}, fmt.Errorf("pool with id %d does not support this kind of exit", poolId)
 	}
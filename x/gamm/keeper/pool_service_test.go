@@ -387,6 +387,77 @@ func (s *KeeperTestSuite) TestInitializePool() {
 	}
 }
 
+// validates that initializing a balancer pool with smooth weight change params emits the weight change schedule,
+// and that the spot price follows the weights from the initial to the target weights.
+func (s *KeeperTestSuite) TestInitializePool_SmoothWeightChange() {
+	duration := 100 * time.Second
+	tests := map[string]struct {
+		smoothWeightChangeParams *balancer.SmoothWeightChangeParams
+		expectedEvents           int
+	}{
+		"no smooth weight change": {
+			expectedEvents: 0,
+		},
+		"smooth weight change from 10:1 to 1:1": {
+			smoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+				Duration: duration,
+				TargetPoolWeights: []balancer.PoolAsset{
+					{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("foo", osmomath.ZeroInt())},
+					{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("bar", osmomath.ZeroInt())},
+				},
+			},
+			expectedEvents: 1,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			poolAssets := []balancer.PoolAsset{
+				{Weight: osmomath.NewInt(10), Token: sdk.NewCoin("foo", osmomath.NewInt(10000))},
+				{Weight: osmomath.NewInt(1), Token: sdk.NewCoin("bar", osmomath.NewInt(10000))},
+			}
+			poolParams := balancer.PoolParams{
+				SwapFee:                  defaultSpreadFactor,
+				ExitFee:                  defaultZeroExitFee,
+				SmoothWeightChangeParams: tc.smoothWeightChangeParams,
+			}
+			balancerPool, err := balancer.NewBalancerPool(defaultPoolId, poolParams, poolAssets, "", s.Ctx.BlockTime())
+			s.Require().NoError(err)
+
+			s.App.PoolManagerKeeper.SetPoolRoute(s.Ctx, defaultPoolId, poolmanagertypes.Balancer)
+			err = s.App.GAMMKeeper.InitializePool(s.Ctx, &balancerPool, s.TestAccs[0])
+			s.Require().NoError(err)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSmoothWeightChange, tc.expectedEvents)
+
+			// foo is 10 times as heavy as bar, with the same supply.
+			spotPrice, err := s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, defaultPoolId, "bar", "foo")
+			s.Require().NoError(err)
+			s.Require().Equal(osmomath.NewBigDec(10).String(), spotPrice.String())
+			if tc.smoothWeightChangeParams == nil {
+				return
+			}
+
+			// Halfway through, foo is 5.5 times as heavy as bar.
+			startTime := balancerPool.PoolParams.SmoothWeightChangeParams.StartTime
+			s.Ctx = s.Ctx.WithBlockTime(startTime.Add(duration / 2))
+			spotPrice, err = s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, defaultPoolId, "bar", "foo")
+			s.Require().NoError(err)
+			s.Require().Equal(osmomath.MustNewBigDecFromStr("5.5").String(), spotPrice.String())
+
+			// Once the weight change has ended, the target weights remain.
+			for _, blockTime := range []time.Time{startTime.Add(duration), startTime.Add(2 * duration)} {
+				s.Ctx = s.Ctx.WithBlockTime(blockTime)
+				spotPrice, err = s.App.GAMMKeeper.CalculateSpotPrice(s.Ctx, defaultPoolId, "bar", "foo")
+				s.Require().NoError(err)
+				s.Require().Equal(osmomath.OneBigDec().String(), spotPrice.String())
+			}
+		})
+	}
+}
+
 // This test creates several pools, and tests that:
 // the condition is in a case where the balancer return value returns an overflowing value
 // the SpotPrice query does not
@@ -925,6 +996,91 @@ func (s *KeeperTestSuite) TestJoinSwapExactAmountInConsistency() {
 	}
 }
 
+// Tests that stableswap pools support single asset exits with an exact amount out,
+// and that the shares exited yield at least the same amount when exited via ExitSwapShareAmountIn.
+func (s *KeeperTestSuite) TestExitSwapExactAmountOut_Stableswap() {
+	testCases := []struct {
+		name             string
+		tokenOut         sdk.Coin
+		shareInMaxAmount osmomath.Int
+		expectedErr      error
+	}{
+		{
+			name:             "exit to exact amount of one asset",
+			tokenOut:         sdk.NewCoin("foo", osmomath.NewInt(1_000_000)),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+		},
+		{
+			name:             "exit to small amount of one asset",
+			tokenOut:         sdk.NewCoin("bar", osmomath.NewInt(10)),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+		},
+		{
+			name:             "error: shares in exceed max amount",
+			tokenOut:         sdk.NewCoin("foo", osmomath.NewInt(1_000_000)),
+			shareInMaxAmount: osmomath.OneInt(),
+			expectedErr:      types.ErrLimitMaxAmount,
+		},
+		{
+			name:             "error: denom not in pool",
+			tokenOut:         sdk.NewCoin("uosmo", osmomath.NewInt(1_000_000)),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectedErr:      types.ErrDenomNotFoundInPool,
+		},
+		{
+			name:             "error: token out exceeds pool liquidity",
+			tokenOut:         sdk.NewCoin("foo", osmomath.NewInt(10_000_000)),
+			shareInMaxAmount: types.InitPoolSharesSupply,
+			expectedErr:      types.ErrInvalidMathApprox,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			gammKeeper := s.App.GAMMKeeper
+			testAccount := s.TestAccs[0]
+
+			poolId := s.PrepareBasicStableswapPool()
+			poolBefore, err := gammKeeper.GetCFMMPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+
+			// Exit the same shares with ExitSwapShareAmountIn in a cache context for comparison.
+			cacheCtx, _ := s.Ctx.CacheContext()
+
+			sharesIn, err := gammKeeper.ExitSwapExactAmountOut(s.Ctx, testAccount, poolId, tc.tokenOut, tc.shareInMaxAmount)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(sharesIn.IsPositive())
+			s.Require().True(sharesIn.LTE(tc.shareInMaxAmount))
+
+			// Exactly tokenOut is sent to the sender, in exchange for sharesIn.
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+			s.Require().Equal(tc.tokenOut.Amount, balancesAfter.AmountOf(tc.tokenOut.Denom).Sub(balancesBefore.AmountOf(tc.tokenOut.Denom)))
+			shareDenom := types.GetPoolShareDenom(poolId)
+			s.Require().Equal(sharesIn, balancesBefore.AmountOf(shareDenom).Sub(balancesAfter.AmountOf(shareDenom)))
+
+			// Only tokenOut leaves the pool.
+			poolAfter, err := gammKeeper.GetCFMMPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(poolBefore.GetTotalPoolLiquidity(s.Ctx).Sub(tc.tokenOut), poolAfter.GetTotalPoolLiquidity(s.Ctx))
+			s.Require().Equal(poolBefore.GetTotalShares().Sub(sharesIn), poolAfter.GetTotalShares())
+
+			// Exiting the same shares to the same denom yields at least tokenOut.
+			tokenOutAmount, err := gammKeeper.ExitSwapShareAmountIn(cacheCtx, testAccount, poolId, tc.tokenOut.Denom, sharesIn, osmomath.ZeroInt())
+			s.Require().NoError(err)
+			s.Require().True(tokenOutAmount.GTE(tc.tokenOut.Amount), "expected at least %s, got %s", tc.tokenOut.Amount, tokenOutAmount)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetPoolDenom() {
 	// setup pool with denoms
 	s.FundAcc(s.TestAccs[0], defaultAcctFunds)
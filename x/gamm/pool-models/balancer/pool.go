@@ -424,7 +424,7 @@ func (p *Pool) PokePool(blockTime time.Time) {
 		return
 
 	case blockTime.After(params.StartTime.Add(params.Duration)):
-		// case 2: start_time < t <= start_time + duration:
+		// case 3: t > start_time + duration: w(t) = target_pool_weights
 
 		// Update weights to be the target weights.
 		//
@@ -437,7 +437,7 @@ func (p *Pool) PokePool(blockTime time.Time) {
 		return
 
 	default:
-		// case 3: t > start_time + duration: w(t) = target_pool_weights
+		// case 2: start_time < t <= start_time + duration:
 
 		shiftedBlockTime := blockTime.Sub(params.StartTime).Milliseconds()
 		percentDurationElapsed := osmomath.NewDec(shiftedBlockTime).QuoInt64(params.Duration.Milliseconds())
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/pool-models/internal/cfmm_common"
 	types "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
@@ -252,6 +254,80 @@ func (p *Pool) calcSingleAssetJoinShares(tokenIn sdk.Coin, spreadFactor osmomath
 	return cfmm_common.BinarySearchSingleAssetJoin(p, sdk.NewCoin(tokenIn.Denom, tokenInAmtAfterFee), poolWithAddedLiquidityAndShares)
 }
 
+// calcSingleAssetExitShares calculates the minimal number of LP shares that must be exited
+// to get at least the passed in single-token output (non-mutative).
+// These are the shares that, if exited pro-rata and all the other exited tokens swapped
+// against the pool to tokenOut's denom, yield tokenOut or more. This matches the amount out of
+// exiting the same shares with an ExitSwapShareAmountIn.
+// Returns error if the pool does not have enough liquidity to get tokenOut out.
+func (p *Pool) calcSingleAssetExitShares(tokenOut sdk.Coin, spreadFactor, exitFee osmomath.Dec) (osmomath.Int, error) {
+	// use dummy context
+	ctx := sdk.Context{}
+
+	// Returns how many tokens you'd get, if you exited `sharesIn` shares
+	// and swapped all exited tokens to tokenOut.Denom.
+	estimateCoinOutGivenShares := func(sharesIn osmomath.Int) (osmomath.Int, error) {
+		// new pool copy, which we can mutate.
+		poolCopy := p.Copy()
+		exitedCoins, err := poolCopy.ExitPool(ctx, sharesIn, exitFee)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+
+		return cfmm_common.SwapAllCoinsToSingleAsset(&poolCopy, ctx, exitedCoins, tokenOut.Denom, spreadFactor)
+	}
+
+	existingTokenLiquidity := p.GetTotalPoolLiquidity(ctx).AmountOfNoDenomValidation(tokenOut.Denom)
+	if tokenOut.Amount.GTE(existingTokenLiquidity) {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "token out amount (%s) must be less than the pool liquidity (%s)", tokenOut.Amount, existingTokenLiquidity)
+	}
+
+	// Shares can not exceed the total shares minus one, since the pool must never be fully exited.
+	LPShareMax := p.GetTotalShares().Sub(osmomath.OneInt())
+
+	// Exiting existingShares * tokenOut.Amount / pool.totalLiquidity.AmountOf(tokenOut.Denom) shares yields
+	// tokenOut pro-rata, before any swap. The exit fee may make it fall short, in which case the upper bound
+	// is doubled until its estimate covers tokenOut.
+	LPShareUpperBound := p.GetTotalShares().Mul(tokenOut.Amount).ToLegacyDec().QuoInt(existingTokenLiquidity).Ceil().TruncateInt()
+	for {
+		if LPShareUpperBound.GTE(LPShareMax) {
+			LPShareUpperBound = LPShareMax
+		}
+
+		estimate, err := estimateCoinOutGivenShares(LPShareUpperBound)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+		if estimate.GTE(tokenOut.Amount) {
+			break
+		}
+		if LPShareUpperBound.Equal(LPShareMax) {
+			return osmomath.Int{}, errorsmod.Wrapf(types.ErrInvalidMathApprox, "exiting all shares but one yields %s, less than the token out amount (%s)", estimate, tokenOut.Amount)
+		}
+		LPShareUpperBound = LPShareUpperBound.MulRaw(2)
+	}
+
+	// Binary search the minimal shares whose estimate covers tokenOut, keeping the invariant that
+	// the estimate of the lower bound falls short of tokenOut and the one of the upper bound does not.
+	// Since the estimate only changes in whole tokens, searching within a tolerance may not converge.
+	LPShareLowerBound := osmomath.ZeroInt()
+	for LPShareUpperBound.Sub(LPShareLowerBound).GT(osmomath.OneInt()) {
+		LPShareMid := LPShareLowerBound.Add(LPShareUpperBound).QuoRaw(2)
+		estimate, err := estimateCoinOutGivenShares(LPShareMid)
+		if err != nil {
+			return osmomath.Int{}, err
+		}
+
+		if estimate.GTE(tokenOut.Amount) {
+			LPShareUpperBound = LPShareMid
+		} else {
+			LPShareLowerBound = LPShareMid
+		}
+	}
+
+	return LPShareUpperBound, nil
+}
+
 // returns the ratio of input asset liquidity, to total liquidity in pool, post-scaling.
 // We use this as the portion of input liquidity to apply a spread factor too, for single asset joins.
 // So if a pool is currently comprised of 80% of asset A, and 20% of asset B (post-scaling),
@@ -19,6 +19,8 @@ import (
 var (
 	_ poolmanagertypes.PoolI = &Pool{}
 	_ types.CFMMPoolI        = &Pool{}
+
+	_ types.PoolSingleAssetExitExtension = &Pool{}
 )
 
 // NewStableswapPool returns a stableswap pool
@@ -396,6 +398,45 @@ func (p Pool) CalcExitPoolCoinsFromShares(ctx sdk.Context, exitingShares osmomat
 	return cfmm_common.CalcExitPool(ctx, &p, exitingShares, exitFee)
 }
 
+// ExitSwapExactAmountOut exits the minimal amount of shares that, if exited pro-rata and all the
+// other exited tokens swapped against the pool to tokenOut's denom, yield at least tokenOut.
+// Only tokenOut leaves the pool; any excess from rounding stays in it.
+// Returns error if tokenOut's denom is not in the pool, if the shares needed are not positive
+// or exceed shareInMaxAmount, or if the pool liquidity is invalid after the exit.
+func (p *Pool) ExitSwapExactAmountOut(
+	ctx sdk.Context,
+	tokenOut sdk.Coin,
+	shareInMaxAmount osmomath.Int,
+) (shareInAmount osmomath.Int, err error) {
+	if !p.PoolLiquidity.AmountOfNoDenomValidation(tokenOut.Denom).IsPositive() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrDenomNotFoundInPool, "(%s) does not exist in the pool", tokenOut.Denom)
+	}
+	if !tokenOut.Amount.IsPositive() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrNotPositiveRequireAmount, "token out amount (%s) must be positive", tokenOut.Amount)
+	}
+
+	sharesIn, err := p.calcSingleAssetExitShares(tokenOut, p.GetSpreadFactor(ctx), p.GetExitFee(ctx))
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+
+	if !sharesIn.IsPositive() {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrNotPositiveRequireAmount, "shares amount must be positive, was %s", sharesIn)
+	}
+	if sharesIn.GT(shareInMaxAmount) {
+		return osmomath.Int{}, errorsmod.Wrapf(types.ErrLimitMaxAmount, "%s resulted shares is larger than the max amount of %s", sharesIn, shareInMaxAmount)
+	}
+
+	tokensOut := sdk.NewCoins(tokenOut)
+	if err := validatePoolLiquidity(p.PoolLiquidity.Sub(tokensOut...), p.ScalingFactors); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	p.updatePoolLiquidityForExit(tokensOut, sharesIn)
+
+	return sharesIn, nil
+}
+
 // SetScalingFactors sets scaling factors for pool to the given amount
 // It should only be able to be successfully called by the pool's ScalingFactorGovernor
 // TODO: move commented test for this function from x/gamm/keeper/pool_service_test.go once a pool_test.go file has been created for stableswap
@@ -6,6 +6,8 @@ const (
 	TypeEvtTokenSwapped  = "token_swapped"
 	TypeEvtMigrateShares = "migrate_shares"
 
+	TypeEvtSmoothWeightChange = "smooth_weight_change"
+
 	AttributeValueCategory     = ModuleName
 	AttributeKeyPoolId         = "pool_id"
 	AttributeKeyPoolIdEntering = "pool_id_entering"
@@ -13,6 +15,10 @@ const (
 	AttributeKeySwapFee        = "swap_fee"
 	AttributeKeyTokensIn       = "tokens_in"
 	AttributeKeyTokensOut      = "tokens_out"
+	AttributeKeyStartTime      = "start_time"
+	AttributeKeyEndTime        = "end_time"
+	AttributeKeyInitialWeights = "initial_weights"
+	AttributeKeyTargetWeights  = "target_weights"
 
 	AttributePositionId = "position_id"
 	AttributeAmount0    = "amount0"
@@ -67,7 +67,7 @@ type CFMMPoolI interface {
 // amount of coins to get out.
 // See definitions below.
 type PoolAmountOutExtension interface {
-	CFMMPoolI
+	PoolSingleAssetExitExtension
 
 	// CalcTokenInShareAmountOut returns the number of tokenInDenom tokens
 	// that would be returned if swapped for an exact number of shares (shareOutAmount).
@@ -89,6 +89,16 @@ type PoolAmountOutExtension interface {
 		shareOutAmount osmomath.Int,
 	) (tokenInAmount osmomath.Int, err error)
 
+	// IncreaseLiquidity increases the pool's liquidity by the specified sharesOut and coinsIn.
+	IncreaseLiquidity(sharesOut osmomath.Int, coinsIn sdk.Coins)
+}
+
+// PoolSingleAssetExitExtension is an extension of the CFMMPoolI
+// interface for pools that support exiting with an exact amount
+// of a single token out.
+type PoolSingleAssetExitExtension interface {
+	CFMMPoolI
+
 	// ExitSwapExactAmountOut removes liquidity from a specified pool with a maximum amount of LP shares (shareInMaxAmount)
 	// and swaps to an exact amount of one of the token pairs (tokenOut).
 	ExitSwapExactAmountOut(
@@ -96,9 +106,6 @@ type PoolAmountOutExtension interface {
 		tokenOut sdk.Coin,
 		shareInMaxAmount osmomath.Int,
 	) (shareInAmount osmomath.Int, err error)
-
-	// IncreaseLiquidity increases the pool's liquidity by the specified sharesOut and coinsIn.
-	IncreaseLiquidity(sharesOut osmomath.Int, coinsIn sdk.Coins)
 }
 
 // WeightedPoolExtension is an extension of the PoolI interface
@@ -57,8 +57,10 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // GetParams returns the total set of the module's parameters.
+// Parameters missing from the store, e.g. ones added after genesis, are set to their defaults.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -14,4 +14,14 @@ const (
 	AttributePacketSequence = "sequence"
 
 	SenderPrefix = "ibc-wasm-hook-intermediary"
+
+	// Telemetry keys and label values for wasm hook failures.
+	MetricKeyHookFailure             = "hook_failure"
+	MetricLabelContract              = "contract"
+	MetricLabelReason                = "reason"
+	HookFailureReasonMemoTooLarge    = "memo_too_large"
+	HookFailureReasonOutOfGas        = "out_of_gas"
+	HookFailureReasonExecution       = "execution"
+	HookFailureReasonAckCallback     = "ack_callback"
+	HookFailureReasonTimeoutCallback = "timeout_callback"
 )
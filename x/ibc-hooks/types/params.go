@@ -9,6 +9,13 @@ import (
 // Parameter store keys.
 var (
 	KeyAsyncAckAllowList = []byte("AsyncAckAllowList")
+	KeyMaxMemoSize       = []byte("MaxMemoSize")
+	KeyHookGasLimit      = []byte("HookGasLimit")
+
+	// DefaultMaxMemoSize is the default maximum size in bytes of a wasm routed memo.
+	DefaultMaxMemoSize = uint64(32_768)
+	// DefaultHookGasLimit is the default gas limit for a contract execution triggered by a hook.
+	DefaultHookGasLimit = uint64(10_000_000)
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -17,9 +24,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(allowedAsyncAckContracts []string) Params {
+func NewParams(allowedAsyncAckContracts []string, maxMemoSize, hookGasLimit uint64) Params {
 	return Params{
 		AllowedAsyncAckContracts: allowedAsyncAckContracts,
+		MaxMemoSize:              maxMemoSize,
+		HookGasLimit:             hookGasLimit,
 	}
 }
 
@@ -27,6 +36,8 @@ func NewParams(allowedAsyncAckContracts []string) Params {
 func DefaultParams() Params {
 	return Params{
 		AllowedAsyncAckContracts: []string{},
+		MaxMemoSize:              DefaultMaxMemoSize,
+		HookGasLimit:             DefaultHookGasLimit,
 	}
 }
 
@@ -34,6 +45,8 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAsyncAckAllowList, &p.AllowedAsyncAckContracts, validateAsyncAckAllowList),
+		paramtypes.NewParamSetPair(KeyMaxMemoSize, &p.MaxMemoSize, validatePositiveUint64),
+		paramtypes.NewParamSetPair(KeyHookGasLimit, &p.HookGasLimit, validatePositiveUint64),
 	}
 }
 
@@ -42,6 +55,12 @@ func (p Params) Validate() error {
 	if err := validateAsyncAckAllowList(p.AllowedAsyncAckContracts); err != nil {
 		return err
 	}
+	if err := validatePositiveUint64(p.MaxMemoSize); err != nil {
+		return err
+	}
+	if err := validatePositiveUint64(p.HookGasLimit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -60,3 +79,16 @@ func validateAsyncAckAllowList(i interface{}) error {
 
 	return nil
 }
+
+func validatePositiveUint64(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("parameter must be positive")
+	}
+
+	return nil
+}
@@ -27,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
 	AllowedAsyncAckContracts []string `protobuf:"bytes,1,rep,name=allowed_async_ack_contracts,json=allowedAsyncAckContracts,proto3" json:"allowed_async_ack_contracts,omitempty" yaml:"allowed_async_ack_contracts"`
+	// max_memo_size is the maximum size in bytes of the memo of a wasm routed
+	// ICS20 packet. Packets with larger memos are rejected with an error ack.
+	MaxMemoSize uint64 `protobuf:"varint,2,opt,name=max_memo_size,json=maxMemoSize,proto3" json:"max_memo_size,omitempty" yaml:"max_memo_size"`
+	// hook_gas_limit is the maximum amount of gas a contract execution
+	// triggered by a wasm routed ICS20 packet may consume.
+	HookGasLimit uint64 `protobuf:"varint,3,opt,name=hook_gas_limit,json=hookGasLimit,proto3" json:"hook_gas_limit,omitempty" yaml:"hook_gas_limit"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +75,20 @@ func (m *Params) GetAllowedAsyncAckContracts() []string {
 	return nil
 }
 
+func (m *Params) GetMaxMemoSize() uint64 {
+	if m != nil {
+		return m.MaxMemoSize
+	}
+	return 0
+}
+
+func (m *Params) GetHookGasLimit() uint64 {
+	if m != nil {
+		return m.HookGasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.ibchooks.Params")
 }
@@ -76,23 +96,28 @@ func init() {
 func init() { proto.RegisterFile("osmosis/ibchooks/params.proto", fileDescriptor_970ea72aec489f5a) }
 
 var fileDescriptor_970ea72aec489f5a = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0x4c, 0x4a, 0xce, 0xc8, 0xcf, 0xcf, 0x2e, 0xd6, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x4a, 0xeb, 0xc1, 0xa4,
-	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94, 0x64, 0x32,
-	0x58, 0x61, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x4a, 0xc9, 0xa5, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea,
-	0x83, 0x79, 0x49, 0xa5, 0x69, 0xfa, 0x29, 0xa5, 0x45, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x10, 0x79,
-	0xa5, 0x7c, 0x2e, 0xb6, 0x00, 0xb0, 0x95, 0x42, 0xa9, 0x5c, 0xd2, 0x89, 0x39, 0x39, 0xf9, 0xe5,
-	0xa9, 0x29, 0xf1, 0x89, 0xc5, 0x95, 0x79, 0xc9, 0xf1, 0x89, 0xc9, 0xd9, 0xf1, 0xc9, 0xf9, 0x79,
-	0x25, 0x45, 0x89, 0xc9, 0x25, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x6a, 0x9f, 0xee,
-	0xc9, 0x2b, 0x55, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xe1, 0x51, 0xac, 0x14, 0x24, 0x01, 0x95, 0x75,
-	0x04, 0x49, 0x3a, 0x26, 0x67, 0x3b, 0xc3, 0xa4, 0x9c, 0xfc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x1f, 0xea, 0x71, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xc8, 0x50, 0xbf, 0x02,
-	0x14, 0x54, 0xba, 0x90, 0xb0, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc4, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x10, 0x6e, 0xfd, 0x4c, 0x01, 0x00, 0x00,
+	// 322 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x7d, 0x91, 0xbf, 0x4b, 0x03, 0x31,
+	0x14, 0xc7, 0x3d, 0x2b, 0x05, 0xcf, 0x1f, 0xc8, 0x51, 0xe1, 0x5a, 0xb1, 0x96, 0x0c, 0xd2, 0xa5,
+	0x17, 0x54, 0x5c, 0x44, 0x90, 0xd6, 0xc1, 0x45, 0x51, 0xea, 0xe6, 0x12, 0x72, 0xe9, 0x79, 0x0d,
+	0x97, 0xf4, 0x1d, 0x97, 0x54, 0x5b, 0xff, 0x0a, 0xff, 0x2c, 0xc7, 0x8e, 0x4e, 0x22, 0xba, 0x3b,
+	0xf8, 0x17, 0x98, 0xbb, 0xdc, 0x0d, 0x5d, 0x1c, 0xbe, 0x90, 0x4f, 0x3e, 0xef, 0xbd, 0xc0, 0x8b,
+	0xbb, 0x0f, 0x4a, 0x82, 0xe2, 0x0a, 0xf3, 0x90, 0x8d, 0x01, 0x12, 0x85, 0x53, 0x9a, 0x51, 0xa9,
+	0x82, 0x34, 0x03, 0x0d, 0xde, 0x4e, 0xa9, 0x83, 0x4a, 0xb7, 0x1a, 0x31, 0xc4, 0x50, 0x48, 0x9c,
+	0x9f, 0x6c, 0x5d, 0xab, 0xc9, 0x8a, 0x42, 0x62, 0x85, 0x85, 0x52, 0xb5, 0x63, 0x80, 0x58, 0x44,
+	0xb8, 0xa0, 0x70, 0xfa, 0x88, 0x47, 0xd3, 0x8c, 0x6a, 0x0e, 0x13, 0xeb, 0xd1, 0x8f, 0xe3, 0xd6,
+	0xef, 0x8a, 0x37, 0xbd, 0xc8, 0xdd, 0xa3, 0x42, 0xc0, 0x73, 0x34, 0x22, 0x54, 0xcd, 0x27, 0x8c,
+	0x50, 0x96, 0x10, 0x06, 0x13, 0x9d, 0x51, 0xa6, 0x95, 0xef, 0x74, 0x6a, 0xdd, 0xf5, 0xc1, 0xe1,
+	0xef, 0xc7, 0x01, 0x9a, 0x53, 0x29, 0xce, 0xd0, 0x3f, 0xc5, 0x68, 0xe8, 0x97, 0xb6, 0x9f, 0xcb,
+	0x3e, 0x4b, 0x2e, 0x2b, 0xe5, 0x9d, 0xbb, 0x5b, 0x92, 0xce, 0x88, 0x8c, 0x24, 0x10, 0xc5, 0x5f,
+	0x22, 0x7f, 0xb5, 0xe3, 0x74, 0xd7, 0x06, 0xbe, 0x19, 0xdc, 0xb0, 0x83, 0x97, 0x34, 0x1a, 0x6e,
+	0x18, 0xbe, 0x31, 0x78, 0x6f, 0xc8, 0xbb, 0x70, 0xb7, 0xf3, 0x4d, 0x90, 0x98, 0x2a, 0x22, 0xb8,
+	0xe4, 0xda, 0xaf, 0x15, 0xed, 0x4d, 0xd3, 0xbe, 0x6b, 0xdb, 0x97, 0x3d, 0x1a, 0x6e, 0xe6, 0x17,
+	0x57, 0x54, 0x5d, 0xe7, 0x38, 0xb8, 0x7d, 0xfb, 0x6a, 0x3b, 0x0b, 0x93, 0x4f, 0x93, 0xd7, 0xef,
+	0xf6, 0xca, 0xc2, 0xe4, 0xdd, 0xe4, 0xe1, 0x34, 0xe6, 0x7a, 0x3c, 0x0d, 0x03, 0x06, 0x12, 0x97,
+	0x8b, 0xef, 0x09, 0x1a, 0xaa, 0x0a, 0xf0, 0xd3, 0xf1, 0x11, 0x9e, 0xe5, 0x5f, 0xd5, 0xb3, 0x7f,
+	0xa5, 0xe7, 0x69, 0xa4, 0xc2, 0x7a, 0xb1, 0xc8, 0x93, 0x3f, 0x55, 0x70, 0x97, 0x0c, 0xcc, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +140,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HookGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HookGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxMemoSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMemoSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AllowedAsyncAckContracts) > 0 {
 		for iNdEx := len(m.AllowedAsyncAckContracts) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAsyncAckContracts[iNdEx])
@@ -150,6 +185,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.MaxMemoSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxMemoSize))
+	}
+	if m.HookGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.HookGasLimit))
+	}
 	return n
 }
 
@@ -220,6 +261,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAsyncAckContracts = append(m.AllowedAsyncAckContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoSize", wireType)
+			}
+			m.MaxMemoSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookGasLimit", wireType)
+			}
+			m.HookGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HookGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
@@ -60,6 +62,12 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	if err != nil {
 		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
 	}
+
+	params := h.ibcHooksKeeper.GetParams(ctx)
+	if memoSize := uint64(len(data.GetMemo())); memoSize > params.MaxMemoSize {
+		incrHookFailureCounter(contractAddr.String(), types.HookFailureReasonMemoTooLarge)
+		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMemoTooLarge, fmt.Sprintf("memo size %d exceeds max memo size %d", memoSize, params.MaxMemoSize))
+	}
 	if msgBytes == nil || contractAddr == nil { // This should never happen
 		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
 	}
@@ -109,8 +117,13 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 		Msg:      msgBytes,
 		Funds:    funds,
 	}
-	response, err := h.execWasmMsg(ctx, &execMsg)
+	response, err := h.execWasmMsg(ctx, &execMsg, params.HookGasLimit)
 	if err != nil {
+		if errorsmod.IsOf(err, types.ErrHookGasLimitExceeded) {
+			incrHookFailureCounter(execMsg.Contract, types.HookFailureReasonOutOfGas)
+			return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrHookGasLimitExceeded, err.Error())
+		}
+		incrHookFailureCounter(execMsg.Contract, types.HookFailureReasonExecution)
 		return osmoutils.NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
 	}
 
@@ -140,12 +153,29 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
-func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
+// execWasmMsg executes the contract with a gas meter limited to gasLimit. Running out of gas
+// is returned as ErrHookGasLimitExceeded instead of panicking, so that the packet receives an
+// error ack. The gas consumed by the execution is charged to the parent context.
+func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract, gasLimit uint64) (response *wasmtypes.MsgExecuteContractResponse, err error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
 	}
+
+	childCtx := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+	defer func() {
+		if r := recover(); r != nil {
+			isOutOfGas, descriptor := osmoutils.IsOutOfGasError(r)
+			if !isOutOfGas {
+				panic(r)
+			}
+			response, err = nil, errorsmod.Wrapf(types.ErrHookGasLimitExceeded, "gas limit %d, %s", gasLimit, descriptor)
+		}
+		// consume gas used for executing the contract to the parent ctx
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "ibc hook contract execution")
+	}()
+
 	wasmMsgServer := wasmkeeper.NewMsgServerImpl(h.ContractKeeper)
-	return wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(ctx), execMsg)
+	return wasmMsgServer.ExecuteContract(sdk.WrapSDKContext(childCtx), execMsg)
 }
 
 func isIcs20Packet(data []byte) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
@@ -334,6 +364,7 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 	if err != nil {
 		// error processing the callback
 		// ToDo: Open Question: Should we also delete the callback here?
+		incrHookFailureCounter(contract, types.HookFailureReasonAckCallback)
 		return errorsmod.Wrap(err, "Ack callback error")
 	}
 	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
@@ -370,6 +401,7 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		// error processing the callback. This could be because the contract doesn't implement the message type to
 		// process the callback. Retrying this will not help, so we can delete the callback from storage.
 		// Since the packet has timed out, we don't expect any other responses that may trigger the callback.
+		incrHookFailureCounter(contract, types.HookFailureReasonTimeoutCallback)
 		ctx.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
 				"ibc-timeout-callback-error",
@@ -382,3 +414,16 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	return nil
 }
+
+// incrHookFailureCounter increments the telemetry counter of wasm hook failures, labeled
+// with the contract address and the reason of the failure.
+func incrHookFailureCounter(contract, reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{types.ModuleName, types.MetricKeyHookFailure},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.MetricLabelContract, contract),
+			telemetry.NewLabel(types.MetricLabelReason, reason),
+		},
+	)
+}
@@ -12,6 +12,7 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetRateLimits)
 	cmd.AddCommand(
 		osmocli.GetParams[*queryproto.ParamsRequest](
 			types.ModuleName, queryproto.NewQueryClient),
@@ -19,3 +20,12 @@ func GetQueryCmd() *cobra.Command {
 
 	return cmd
 }
+
+func GetRateLimits() (*osmocli.QueryDescriptor, *queryproto.RateLimitsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rate-limits",
+		Short: "Query the current usage and time until reset of the quotas of a channel and denom",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} rate-limits channel-0 uosmo`,
+	}, &queryproto.RateLimitsRequest{}
+}
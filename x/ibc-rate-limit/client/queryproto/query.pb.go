@@ -114,9 +114,109 @@ func (m *ParamsResponse) GetParams() types.Params {
 	return types.Params{}
 }
 
+// RateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type RateLimitsRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *RateLimitsRequest) Reset()         { *m = RateLimitsRequest{} }
+func (m *RateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsRequest) ProtoMessage()    {}
+func (*RateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{2}
+}
+func (m *RateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsRequest.Merge(m, src)
+}
+func (m *RateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsRequest proto.InternalMessageInfo
+
+func (m *RateLimitsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimitsResponse is the response type for the Query/RateLimits RPC method.
+type RateLimitsResponse struct {
+	RateLimits []types.RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits" yaml:"rate_limits"`
+}
+
+func (m *RateLimitsResponse) Reset()         { *m = RateLimitsResponse{} }
+func (m *RateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitsResponse) ProtoMessage()    {}
+func (*RateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6904fea69f32464e, []int{3}
+}
+func (m *RateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitsResponse.Merge(m, src)
+}
+func (m *RateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitsResponse proto.InternalMessageInfo
+
+func (m *RateLimitsResponse) GetRateLimits() []types.RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.ibcratelimit.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.ibcratelimit.v1beta1.ParamsResponse")
+	proto.RegisterType((*RateLimitsRequest)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsRequest")
+	proto.RegisterType((*RateLimitsResponse)(nil), "osmosis.ibcratelimit.v1beta1.RateLimitsResponse")
 }
 
 func init() {
@@ -124,28 +224,38 @@ func init() {
 }
 
 var fileDescriptor_6904fea69f32464e = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3f, 0x4b, 0x03, 0x31,
-	0x18, 0xc6, 0x2f, 0xa2, 0x1d, 0x4e, 0x54, 0x38, 0x1c, 0xa4, 0x94, 0x28, 0x45, 0xa4, 0xfe, 0x69,
-	0x42, 0xeb, 0x37, 0xe8, 0xe0, 0xac, 0xc5, 0xc9, 0xc9, 0xe4, 0x08, 0x31, 0x70, 0x97, 0xf7, 0x7a,
-	0x49, 0x8b, 0xae, 0x7e, 0x02, 0xa1, 0xb3, 0xdf, 0xa7, 0x63, 0xc1, 0xc5, 0x49, 0xa4, 0xf5, 0x83,
-	0xc8, 0x25, 0x69, 0x29, 0x22, 0xa5, 0xd3, 0x5d, 0x92, 0xdf, 0xf3, 0xbc, 0x4f, 0x9e, 0xc4, 0x2d,
-	0x30, 0x39, 0x18, 0x65, 0xa8, 0xe2, 0x69, 0xc9, 0xac, 0xc8, 0x54, 0xae, 0x2c, 0x1d, 0x75, 0xb8,
-	0xb0, 0xac, 0x43, 0x07, 0x43, 0x51, 0xbe, 0x90, 0xa2, 0x04, 0x0b, 0x49, 0x23, 0x90, 0x64, 0x95,
-	0x24, 0x81, 0xac, 0x1f, 0x4a, 0x90, 0xe0, 0x40, 0x5a, 0xfd, 0x79, 0x4d, 0xbd, 0x21, 0x01, 0x64,
-	0x26, 0x28, 0x2b, 0x14, 0x65, 0x5a, 0x83, 0x65, 0x56, 0x81, 0x36, 0xe1, 0xf4, 0x22, 0x75, 0x96,
-	0x94, 0x33, 0x23, 0xfc, 0xa8, 0xe5, 0xe0, 0x82, 0x49, 0xa5, 0x1d, 0x1c, 0xd8, 0xf3, 0xb5, 0x39,
-	0x0b, 0x56, 0xb2, 0x3c, 0xd8, 0x36, 0x0f, 0xe2, 0xbd, 0x5b, 0xb7, 0xee, 0x8b, 0xc1, 0x50, 0x18,
-	0xdb, 0xbc, 0x8f, 0xf7, 0x17, 0x1b, 0xa6, 0x00, 0x6d, 0x44, 0xd2, 0x8b, 0x6b, 0x5e, 0x72, 0x84,
-	0x4e, 0x50, 0x6b, 0xb7, 0x7b, 0x4a, 0xd6, 0x5d, 0x8e, 0x78, 0x75, 0x6f, 0x7b, 0xf2, 0x75, 0x1c,
-	0xf5, 0x83, 0xb2, 0xfb, 0x8e, 0xe2, 0x9d, 0xbb, 0x2a, 0x74, 0x32, 0x46, 0x71, 0xcd, 0x23, 0xc9,
-	0xe5, 0x26, 0x46, 0x21, 0x57, 0xfd, 0x6a, 0x33, 0xd8, 0x67, 0x6e, 0x92, 0xd7, 0x8f, 0x9f, 0xf1,
-	0x56, 0x2b, 0x39, 0xa3, 0x2b, 0x55, 0xb4, 0x2b, 0x59, 0xfb, 0xbf, 0x32, 0x7a, 0x8f, 0x93, 0x19,
-	0x46, 0xd3, 0x19, 0x46, 0xdf, 0x33, 0x8c, 0xde, 0xe6, 0x38, 0x9a, 0xce, 0x71, 0xf4, 0x39, 0xc7,
-	0xd1, 0xc3, 0x8d, 0x54, 0xf6, 0x69, 0xc8, 0x49, 0x0a, 0xf9, 0xc2, 0xab, 0x9d, 0x31, 0x6e, 0x96,
-	0xc6, 0xa3, 0x6e, 0x87, 0x3e, 0xff, 0xb5, 0x4f, 0x33, 0x25, 0xb4, 0xf5, 0xef, 0xe4, 0x8a, 0xe6,
-	0x35, 0xf7, 0xb9, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x11, 0xb0, 0xb4, 0xc5, 0x44, 0x02, 0x00,
-	0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0x6e, 0x0a, 0xab, 0x34, 0x97, 0x7f, 0xb3, 0x40, 0x9a, 0xaa, 0x29, 0x45, 0x16, 0x1a, 0x05,
+	0xd6, 0x98, 0x16, 0x4e, 0x9c, 0x50, 0x0e, 0x08, 0x24, 0x0e, 0x10, 0x71, 0xe2, 0x32, 0x9c, 0xd4,
+	0xca, 0x2c, 0x25, 0x76, 0x1a, 0xbb, 0x13, 0x63, 0xda, 0x85, 0x27, 0x40, 0xda, 0xd3, 0xf0, 0x06,
+	0x3b, 0x4e, 0xe2, 0x02, 0x97, 0x0a, 0xb5, 0x3c, 0x41, 0x9f, 0x00, 0xc5, 0x76, 0xd3, 0x08, 0xa1,
+	0x2c, 0xa7, 0x36, 0xf6, 0xf7, 0xfd, 0xbe, 0x3f, 0xb6, 0xc1, 0x40, 0xc8, 0x54, 0x48, 0x26, 0x31,
+	0x0b, 0xa3, 0x9c, 0x28, 0x9a, 0xb0, 0x94, 0x29, 0x7c, 0x3c, 0x0a, 0xa9, 0x22, 0x23, 0x3c, 0x9d,
+	0xd1, 0xfc, 0xc4, 0xcb, 0x72, 0xa1, 0x04, 0xdc, 0xb3, 0x48, 0xaf, 0x8a, 0xf4, 0x2c, 0xb2, 0x77,
+	0x37, 0x16, 0xb1, 0xd0, 0x40, 0x5c, 0xfc, 0x33, 0x9c, 0xde, 0x5e, 0x2c, 0x44, 0x9c, 0x50, 0x4c,
+	0x32, 0x86, 0x09, 0xe7, 0x42, 0x11, 0xc5, 0x04, 0x97, 0x76, 0xf7, 0x71, 0xa4, 0x47, 0xe2, 0x90,
+	0x48, 0x6a, 0xa4, 0x4a, 0xe1, 0x8c, 0xc4, 0x8c, 0x6b, 0xb0, 0xc5, 0x3e, 0xaa, 0xf5, 0x99, 0x91,
+	0x9c, 0xa4, 0xeb, 0xb1, 0x07, 0xb5, 0xd0, 0x8d, 0x75, 0x8d, 0x46, 0xb7, 0xc1, 0xcd, 0x77, 0x9a,
+	0x1d, 0xd0, 0xe9, 0x8c, 0x4a, 0x85, 0x3e, 0x80, 0x5b, 0xeb, 0x05, 0x99, 0x09, 0x2e, 0x29, 0xf4,
+	0x41, 0xc7, 0x08, 0xec, 0x3a, 0xf7, 0x9d, 0x41, 0x77, 0xfc, 0xc0, 0xab, 0xab, 0xc2, 0x33, 0x6c,
+	0xff, 0xfa, 0xc5, 0xbc, 0xdf, 0x0a, 0x2c, 0x13, 0x4d, 0xc1, 0x4e, 0x40, 0x14, 0x7d, 0x5b, 0x20,
+	0xd7, 0x52, 0xf0, 0x39, 0x00, 0xd1, 0x11, 0xe1, 0x9c, 0x26, 0x87, 0x6c, 0xa2, 0x87, 0x6f, 0xfb,
+	0xf7, 0x56, 0xf3, 0xfe, 0xce, 0x09, 0x49, 0x93, 0x17, 0x68, 0xb3, 0x87, 0x82, 0x6d, 0xfb, 0xf1,
+	0x66, 0x02, 0xf7, 0xc1, 0xd6, 0x84, 0x72, 0x91, 0xee, 0xb6, 0x35, 0xe1, 0xce, 0x6a, 0xde, 0xbf,
+	0x61, 0x08, 0x7a, 0x19, 0x05, 0x66, 0x1b, 0x7d, 0x01, 0xb0, 0x2a, 0x69, 0xc3, 0x4c, 0x40, 0xb7,
+	0xb0, 0x7c, 0xa8, 0x3d, 0x17, 0x89, 0xae, 0x0d, 0xba, 0xe3, 0x87, 0xf5, 0x89, 0xca, 0x31, 0x7e,
+	0xaf, 0x08, 0xb5, 0x9a, 0xf7, 0xa1, 0x11, 0xac, 0x4c, 0x42, 0x01, 0xc8, 0x4b, 0xb5, 0xf1, 0xaf,
+	0x36, 0xd8, 0x7a, 0x5f, 0x9c, 0x28, 0x3c, 0x77, 0x40, 0xc7, 0x34, 0x02, 0x9f, 0x34, 0xe9, 0xcd,
+	0x76, 0xd3, 0x3b, 0x68, 0x06, 0x36, 0xa9, 0x90, 0xf7, 0xf5, 0xc7, 0x9f, 0xf3, 0xf6, 0x00, 0xee,
+	0xe3, 0xca, 0xe1, 0x0f, 0x0b, 0xda, 0xf0, 0x7f, 0x37, 0x05, 0x7e, 0x77, 0x00, 0xd8, 0x94, 0x03,
+	0x71, 0xc3, 0xfc, 0xa5, 0xbb, 0xa7, 0xcd, 0x09, 0xd6, 0xe1, 0x6b, 0xed, 0xd0, 0x87, 0x2f, 0xaf,
+	0x72, 0x58, 0xe9, 0x14, 0x9f, 0x6e, 0xae, 0xc0, 0x19, 0x3e, 0xd5, 0xc7, 0x7a, 0xe6, 0x7f, 0xba,
+	0x58, 0xb8, 0xce, 0xe5, 0xc2, 0x75, 0x7e, 0x2f, 0x5c, 0xe7, 0xdb, 0xd2, 0x6d, 0x5d, 0x2e, 0xdd,
+	0xd6, 0xcf, 0xa5, 0xdb, 0xfa, 0xf8, 0x2a, 0x66, 0xea, 0x68, 0x16, 0x7a, 0x91, 0x48, 0xd7, 0x2a,
+	0xc3, 0x84, 0x84, 0xb2, 0x94, 0x3c, 0x1e, 0x8f, 0xf0, 0xe7, 0x7f, 0x85, 0xa3, 0x84, 0x51, 0xae,
+	0xcc, 0x03, 0xd4, 0x6f, 0x22, 0xec, 0xe8, 0x9f, 0x67, 0x7f, 0x07, 0x00, 0x54, 0xb6, 0x71, 0xb4,
+	0x1d, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -163,6 +273,9 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RateLimits returns the current usage and time until reset of every quota
+	// configured for a path (channel and denom pair).
+	RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -182,11 +295,23 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RateLimits(ctx context.Context, in *RateLimitsRequest, opts ...grpc.CallOption) (*RateLimitsResponse, error) {
+	out := new(RateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.ibcratelimit.v1beta1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibc-rate-limit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RateLimits returns the current usage and time until reset of every quota
+	// configured for a path (channel and denom pair).
+	RateLimits(context.Context, *RateLimitsRequest) (*RateLimitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -196,6 +321,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *RateLimitsRequest) (*RateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -219,6 +347,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.ibcratelimit.v1beta1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*RateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.ibcratelimit.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -227,6 +373,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/ibcratelimit/v1beta1/query.proto",
@@ -288,6 +438,80 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -319,6 +543,38 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *RateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +714,204 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, types.RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,7 +7,9 @@ import (
 	"testing"
 	"time"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -20,6 +22,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v21/tests/osmosisibctesting"
+	ibc_rate_limit "github.com/osmosis-labs/osmosis/v21/x/ibc-rate-limit"
 	"github.com/osmosis-labs/osmosis/v21/x/ibc-rate-limit/types"
 )
 
@@ -542,3 +545,86 @@ func (suite *MiddlewareTestSuite) TestUnsetRateLimitingContract() {
 	// N.B.: this panics if validation fails.
 	paramSpace.SetParamSet(suite.chainA.GetContext(), &params)
 }
+
+// Test that rate limits can be managed through the module's messages by the governance module account
+func (suite *MiddlewareTestSuite) TestManageRateLimits() {
+	osmosisApp := suite.chainA.GetOsmosisApp()
+	ctx := suite.chainA.GetContext()
+	msgServer := ibc_rate_limit.NewMsgServerImpl(osmosisApp.RateLimitingICS4Wrapper)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	channel := "channel-0"
+	denom := sdk.DefaultBondDenom
+	dailyQuota := types.Quota{Name: "daily", Duration: 86400, MaxPercentageSend: 10, MaxPercentageRecv: 10}
+	weeklyQuota := types.Quota{Name: "weekly", Duration: 604800, MaxPercentageSend: 20, MaxPercentageRecv: 30}
+
+	// Managing rate limits fails without a contract
+	_, err := msgServer.AddRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgAddRateLimit(govAddr, channel, denom, []types.Quota{dailyQuota}))
+	suite.Require().ErrorIs(err, types.ErrContractNotConfigured)
+
+	// Setup contract
+	suite.chainA.StoreContractCode(&suite.Suite, "./bytecode/rate_limiter.wasm")
+	addr := suite.chainA.InstantiateRLContract(&suite.Suite, "")
+	suite.chainA.RegisterRateLimitingContract(addr)
+	ctx = suite.chainA.GetContext()
+
+	rateLimits, err := osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(ctx, channel, denom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rateLimits)
+
+	// Only the governance module account is authorized
+	_, err = msgServer.AddRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgAddRateLimit(suite.chainA.SenderAccount.GetAddress().String(), channel, denom, []types.Quota{dailyQuota}))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Updating, resetting and removing a missing rate limit fails
+	_, err = msgServer.UpdateRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgUpdateRateLimit(govAddr, channel, denom, []types.Quota{weeklyQuota}))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	_, err = msgServer.ResetRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgResetRateLimit(govAddr, channel, denom, dailyQuota.Name))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+	_, err = msgServer.RemoveRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveRateLimit(govAddr, channel, denom))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+
+	// Add
+	_, err = msgServer.AddRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgAddRateLimit(govAddr, channel, denom, []types.Quota{dailyQuota}))
+	suite.Require().NoError(err)
+
+	rateLimits, err = osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(ctx, channel, denom)
+	suite.Require().NoError(err)
+	suite.Require().Len(rateLimits, 1)
+	suite.Require().Equal(dailyQuota, rateLimits[0].Quota)
+	suite.Require().Equal(channel, rateLimits[0].ChannelId)
+	suite.Require().Equal(denom, rateLimits[0].Denom)
+	suite.Require().True(rateLimits[0].Inflow.IsZero())
+	suite.Require().True(rateLimits[0].Outflow.IsZero())
+	suite.Require().Equal(ctx.BlockTime().Add(24*time.Hour).Unix(), rateLimits[0].PeriodEnd.Unix())
+	suite.Require().Equal(24*time.Hour, rateLimits[0].TimeUntilReset)
+
+	// Adding an existing rate limit fails
+	_, err = msgServer.AddRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgAddRateLimit(govAddr, channel, denom, []types.Quota{weeklyQuota}))
+	suite.Require().ErrorIs(err, types.ErrRateLimitAlreadyExists)
+
+	// Update
+	_, err = msgServer.UpdateRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgUpdateRateLimit(govAddr, channel, denom, []types.Quota{dailyQuota, weeklyQuota}))
+	suite.Require().NoError(err)
+
+	rateLimits, err = osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(ctx, channel, denom)
+	suite.Require().NoError(err)
+	suite.Require().Len(rateLimits, 2)
+	suite.Require().ElementsMatch([]types.Quota{dailyQuota, weeklyQuota}, []types.Quota{rateLimits[0].Quota, rateLimits[1].Quota})
+
+	// Reset
+	_, err = msgServer.ResetRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgResetRateLimit(govAddr, channel, denom, weeklyQuota.Name))
+	suite.Require().NoError(err)
+
+	// Remove
+	_, err = msgServer.RemoveRateLimit(sdk.WrapSDKContext(ctx), types.NewMsgRemoveRateLimit(govAddr, channel, denom))
+	suite.Require().NoError(err)
+
+	rateLimits, err = osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(ctx, channel, denom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(rateLimits)
+
+	// Query errors other than a path without quotas are returned, e.g. if there is no contract at the address
+	suite.chainA.RegisterRateLimitingContract(suite.chainA.SenderAccount.GetAddress())
+	_, err = osmosisApp.RateLimitingICS4Wrapper.GetRateLimits(suite.chainA.GetContext(), channel, denom)
+	suite.Require().ErrorIs(err, types.ErrContractError)
+}
@@ -27,6 +27,7 @@ type ICS4Wrapper struct {
 	accountKeeper  *authkeeper.AccountKeeper
 	bankKeeper     *bankkeeper.BaseKeeper
 	ContractKeeper *wasmkeeper.PermissionedKeeper
+	WasmKeeper     *wasmkeeper.Keeper
 	paramSpace     paramtypes.Subspace
 }
 
@@ -0,0 +1,267 @@
+package ibc_rate_limit
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/ibc-rate-limit/types"
+)
+
+// contractNotFoundError is the message of the error returned by the contract when loading a missing entry.
+const contractNotFoundError = "not found"
+
+// The messages below mirror the ExecuteMsg and QueryMsg variants of the rate limiting contract
+// used to manage the quotas of a path (channel and denom pair).
+
+type AddPathMsg struct {
+	AddPath PathMsg `json:"add_path"`
+}
+
+type RemovePathMsg struct {
+	RemovePath PathIdMsg `json:"remove_path"`
+}
+
+type ResetPathQuotaMsg struct {
+	ResetPathQuota ResetQuotaMsg `json:"reset_path_quota"`
+}
+
+type GetQuotasMsg struct {
+	GetQuotas PathIdMsg `json:"get_quotas"`
+}
+
+type PathIdMsg struct {
+	ChannelId string `json:"channel_id"`
+	Denom     string `json:"denom"`
+}
+
+type PathMsg struct {
+	ChannelId string     `json:"channel_id"`
+	Denom     string     `json:"denom"`
+	Quotas    []QuotaMsg `json:"quotas"`
+}
+
+type ResetQuotaMsg struct {
+	ChannelId string `json:"channel_id"`
+	Denom     string `json:"denom"`
+	QuotaId   string `json:"quota_id"`
+}
+
+type QuotaMsg struct {
+	Name     string    `json:"name"`
+	Duration uint64    `json:"duration"`
+	SendRecv [2]uint32 `json:"send_recv"`
+}
+
+// ContractRateLimit is the rate limit of a quota as returned by the contract's get_quotas query.
+type ContractRateLimit struct {
+	Quota struct {
+		Name              string        `json:"name"`
+		MaxPercentageSend uint32        `json:"max_percentage_send"`
+		MaxPercentageRecv uint32        `json:"max_percentage_recv"`
+		Duration          uint64        `json:"duration"`
+		ChannelValue      *osmomath.Int `json:"channel_value"`
+	} `json:"quota"`
+	Flow struct {
+		Inflow    osmomath.Int `json:"inflow"`
+		Outflow   osmomath.Int `json:"outflow"`
+		PeriodEnd string       `json:"period_end"`
+	} `json:"flow"`
+}
+
+// AddRateLimit adds the given quotas for the path identified by channelId and denom.
+// Returns an error if the path already has quotas configured.
+func (i *ICS4Wrapper) AddRateLimit(ctx sdk.Context, channelId, denom string, quotas []types.Quota) error {
+	rateLimits, err := i.GetRateLimits(ctx, channelId, denom)
+	if err != nil {
+		return err
+	}
+	if len(rateLimits) > 0 {
+		return errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "channel %s, denom %s", channelId, denom)
+	}
+
+	return i.addPath(ctx, channelId, denom, quotas)
+}
+
+// UpdateRateLimit overwrites the quotas of the path identified by channelId and denom.
+// The flow of the new quotas starts from zero.
+// Returns an error if the path has no quotas configured.
+func (i *ICS4Wrapper) UpdateRateLimit(ctx sdk.Context, channelId, denom string, quotas []types.Quota) error {
+	if err := i.requireRateLimit(ctx, channelId, denom); err != nil {
+		return err
+	}
+
+	// The contract's add_path overwrites all the quotas of an existing path.
+	return i.addPath(ctx, channelId, denom, quotas)
+}
+
+// RemoveRateLimit removes all the quotas of the path identified by channelId and denom.
+// Returns an error if the path has no quotas configured.
+func (i *ICS4Wrapper) RemoveRateLimit(ctx sdk.Context, channelId, denom string) error {
+	if err := i.requireRateLimit(ctx, channelId, denom); err != nil {
+		return err
+	}
+
+	return i.executeAsGov(ctx, RemovePathMsg{RemovePath: PathIdMsg{ChannelId: channelId, Denom: denom}})
+}
+
+// ResetRateLimit resets the flow of the quota named quotaName for the path identified by channelId and denom.
+// Returns an error if the path has no quotas configured.
+func (i *ICS4Wrapper) ResetRateLimit(ctx sdk.Context, channelId, denom, quotaName string) error {
+	if err := i.requireRateLimit(ctx, channelId, denom); err != nil {
+		return err
+	}
+
+	return i.executeAsGov(ctx, ResetPathQuotaMsg{ResetPathQuota: ResetQuotaMsg{ChannelId: channelId, Denom: denom, QuotaId: quotaName}})
+}
+
+// GetRateLimits returns the current state of every quota configured for the path identified by channelId and denom.
+// Returns an empty slice if the contract is not configured or the path has no quotas.
+// Returns ErrContractError if the contract query fails for any other reason.
+func (i *ICS4Wrapper) GetRateLimits(ctx sdk.Context, channelId, denom string) ([]types.RateLimit, error) {
+	contractAddr, err := i.getContractAccAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if contractAddr == nil {
+		return []types.RateLimit{}, nil
+	}
+
+	query, err := json.Marshal(GetQuotasMsg{GetQuotas: PathIdMsg{ChannelId: channelId, Denom: denom}})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := i.WasmKeeper.QuerySmart(ctx, contractAddr, query)
+	if err != nil {
+		// The contract errors with a not found error when querying a path that has no quotas.
+		if isContractNotFoundError(err) {
+			return []types.RateLimit{}, nil
+		}
+		return nil, errorsmod.Wrap(types.ErrContractError, err.Error())
+	}
+
+	var contractRateLimits []ContractRateLimit
+	if err := json.Unmarshal(res, &contractRateLimits); err != nil {
+		return nil, errorsmod.Wrap(types.ErrContractError, err.Error())
+	}
+
+	rateLimits := make([]types.RateLimit, 0, len(contractRateLimits))
+	for _, contractRateLimit := range contractRateLimits {
+		rateLimit, err := contractRateLimit.toRateLimit(ctx, channelId, denom)
+		if err != nil {
+			return nil, err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits, nil
+}
+
+// isContractNotFoundError returns whether err is a not found error returned by the contract,
+// as opposed to e.g. running out of gas or the contract not existing.
+func isContractNotFoundError(err error) bool {
+	return errors.Is(err, wasmtypes.ErrQueryFailed) && strings.Contains(err.Error(), contractNotFoundError)
+}
+
+func (i *ICS4Wrapper) requireRateLimit(ctx sdk.Context, channelId, denom string) error {
+	rateLimits, err := i.GetRateLimits(ctx, channelId, denom)
+	if err != nil {
+		return err
+	}
+	if len(rateLimits) == 0 {
+		return errorsmod.Wrapf(types.ErrRateLimitNotFound, "channel %s, denom %s", channelId, denom)
+	}
+	return nil
+}
+
+func (i *ICS4Wrapper) addPath(ctx sdk.Context, channelId, denom string, quotas []types.Quota) error {
+	quotaMsgs := make([]QuotaMsg, 0, len(quotas))
+	for _, quota := range quotas {
+		quotaMsgs = append(quotaMsgs, QuotaMsg{
+			Name:     quota.Name,
+			Duration: quota.Duration,
+			SendRecv: [2]uint32{quota.MaxPercentageSend, quota.MaxPercentageRecv},
+		})
+	}
+
+	return i.executeAsGov(ctx, AddPathMsg{AddPath: PathMsg{ChannelId: channelId, Denom: denom, Quotas: quotaMsgs}})
+}
+
+// executeAsGov executes the given message on the rate limiting contract with the governance
+// module account as the sender, which the contract authorizes to manage quotas.
+func (i *ICS4Wrapper) executeAsGov(ctx sdk.Context, msg interface{}) error {
+	contractAddr, err := i.getContractAccAddress(ctx)
+	if err != nil {
+		return err
+	}
+	if contractAddr == nil {
+		return types.ErrContractNotConfigured
+	}
+
+	asJson, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	_, err = i.ContractKeeper.Execute(ctx, contractAddr, govAddr, asJson, sdk.NewCoins())
+	if err != nil {
+		return errorsmod.Wrap(types.ErrContractError, err.Error())
+	}
+
+	return nil
+}
+
+// getContractAccAddress returns the address of the rate limiting contract or nil if it has not been configured.
+func (i *ICS4Wrapper) getContractAccAddress(ctx sdk.Context) (sdk.AccAddress, error) {
+	contract := i.GetContractAddress(ctx)
+	if contract == "" {
+		return nil, nil
+	}
+	return sdk.AccAddressFromBech32(contract)
+}
+
+func (r ContractRateLimit) toRateLimit(ctx sdk.Context, channelId, denom string) (types.RateLimit, error) {
+	// The contract serializes timestamps as a string of nanoseconds since the unix epoch.
+	periodEndNanos, err := strconv.ParseInt(r.Flow.PeriodEnd, 10, 64)
+	if err != nil {
+		return types.RateLimit{}, errorsmod.Wrap(types.ErrContractError, err.Error())
+	}
+	periodEnd := time.Unix(0, periodEndNanos).UTC()
+
+	timeUntilReset := periodEnd.Sub(ctx.BlockTime())
+	if timeUntilReset < 0 {
+		timeUntilReset = 0
+	}
+
+	channelValue := osmomath.ZeroInt()
+	if r.Quota.ChannelValue != nil {
+		channelValue = *r.Quota.ChannelValue
+	}
+
+	return types.RateLimit{
+		ChannelId: channelId,
+		Denom:     denom,
+		Quota: types.Quota{
+			Name:              r.Quota.Name,
+			Duration:          r.Quota.Duration,
+			MaxPercentageSend: r.Quota.MaxPercentageSend,
+			MaxPercentageRecv: r.Quota.MaxPercentageRecv,
+		},
+		ChannelValue:   channelValue,
+		Inflow:         r.Flow.Inflow,
+		Outflow:        r.Flow.Outflow,
+		PeriodEnd:      periodEnd,
+		TimeUntilReset: timeUntilReset,
+	}, nil
+}
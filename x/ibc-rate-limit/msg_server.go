@@ -0,0 +1,89 @@
+package ibc_rate_limit
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/ibc-rate-limit/types"
+)
+
+type msgServer struct {
+	ics4wrapper *ICS4Wrapper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided ICS4Wrapper.
+func NewMsgServerImpl(ics4wrapper *ICS4Wrapper) types.MsgServer {
+	return &msgServer{ics4wrapper: ics4wrapper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// AddRateLimit adds the quotas for a path. Only the governance module account is authorized.
+func (server msgServer) AddRateLimit(goCtx context.Context, msg *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.ics4wrapper.AddRateLimit(ctx, msg.ChannelId, msg.Denom, msg.Quotas); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit overwrites the quotas for a path. Only the governance module account is authorized.
+func (server msgServer) UpdateRateLimit(goCtx context.Context, msg *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.ics4wrapper.UpdateRateLimit(ctx, msg.ChannelId, msg.Denom, msg.Quotas); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes the quotas of a path. Only the governance module account is authorized.
+func (server msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.ics4wrapper.RemoveRateLimit(ctx, msg.ChannelId, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit resets the flow of a quota of a path. Only the governance module account is authorized.
+func (server msgServer) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := server.ics4wrapper.ResetRateLimit(ctx, msg.ChannelId, msg.Denom, msg.QuotaName); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// checkAuthority returns an error if the given authority is not the governance module account.
+func checkAuthority(authority string) error {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if authority != govAddr {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", govAddr, authority)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
+
+const (
+	// msgs
+	addRateLimit    = "osmosis/ibc-rate-limit/add-rate-limit"
+	updateRateLimit = "osmosis/ibc-rate-limit/update-rate-limit"
+	removeRateLimit = "osmosis/ibc-rate-limit/remove-rate-limit"
+	resetRateLimit  = "osmosis/ibc-rate-limit/reset-rate-limit"
+)
+
+func init() {
+	RegisterCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgAddRateLimit{}, addRateLimit, nil)
+	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, updateRateLimit, nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, removeRateLimit, nil)
+	cdc.RegisterConcrete(&MsgResetRateLimit{}, resetRateLimit, nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddRateLimit{},
+		&MsgUpdateRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimit{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
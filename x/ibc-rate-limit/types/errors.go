@@ -8,4 +8,10 @@ var (
 	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 2, "rate limit exceeded")
 	ErrBadMessage        = errorsmod.Register(ModuleName, 3, "bad message")
 	ErrContractError     = errorsmod.Register(ModuleName, 4, "contract error")
+
+	ErrContractNotConfigured  = errorsmod.Register(ModuleName, 5, "rate limiting contract is not configured")
+	ErrRateLimitAlreadyExists = errorsmod.Register(ModuleName, 6, "rate limit already exists")
+	ErrRateLimitNotFound      = errorsmod.Register(ModuleName, 7, "rate limit not found")
+	ErrUnauthorized           = errorsmod.Register(ModuleName, 8, "unauthorized")
+	ErrInvalidQuota           = errorsmod.Register(ModuleName, 9, "invalid quota")
 )
@@ -0,0 +1,231 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+var (
+	_ sdk.Msg = &MsgAddRateLimit{}
+	_ sdk.Msg = &MsgUpdateRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+const (
+	TypeMsgAddRateLimit    = "add_rate_limit"
+	TypeMsgUpdateRateLimit = "update_rate_limit"
+	TypeMsgRemoveRateLimit = "remove_rate_limit"
+	TypeMsgResetRateLimit  = "reset_rate_limit"
+)
+
+// ---------------------- Interface for MsgAddRateLimit ---------------------- //
+// NewMsgAddRateLimit creates a new MsgAddRateLimit instance
+func NewMsgAddRateLimit(authority, channelId, denom string, quotas []Quota) *MsgAddRateLimit {
+	return &MsgAddRateLimit{
+		Authority: authority,
+		ChannelId: channelId,
+		Denom:     denom,
+		Quotas:    quotas,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgAddRateLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgAddRateLimit) Type() string {
+	return TypeMsgAddRateLimit
+}
+
+// ValidateBasic validates the MsgAddRateLimit
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if err := validatePath(msg.Authority, msg.ChannelId, msg.Denom); err != nil {
+		return err
+	}
+
+	return validateQuotas(msg.Quotas)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAddRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ---------------------- Interface for MsgUpdateRateLimit ---------------------- //
+// NewMsgUpdateRateLimit creates a new MsgUpdateRateLimit instance
+func NewMsgUpdateRateLimit(authority, channelId, denom string, quotas []Quota) *MsgUpdateRateLimit {
+	return &MsgUpdateRateLimit{
+		Authority: authority,
+		ChannelId: channelId,
+		Denom:     denom,
+		Quotas:    quotas,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgUpdateRateLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgUpdateRateLimit) Type() string {
+	return TypeMsgUpdateRateLimit
+}
+
+// ValidateBasic validates the MsgUpdateRateLimit
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if err := validatePath(msg.Authority, msg.ChannelId, msg.Denom); err != nil {
+		return err
+	}
+
+	return validateQuotas(msg.Quotas)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ---------------------- Interface for MsgRemoveRateLimit ---------------------- //
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(authority, channelId, denom string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		ChannelId: channelId,
+		Denom:     denom,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgRemoveRateLimit) Type() string {
+	return TypeMsgRemoveRateLimit
+}
+
+// ValidateBasic validates the MsgRemoveRateLimit
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	return validatePath(msg.Authority, msg.ChannelId, msg.Denom)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ---------------------- Interface for MsgResetRateLimit ---------------------- //
+// NewMsgResetRateLimit creates a new MsgResetRateLimit instance
+func NewMsgResetRateLimit(authority, channelId, denom, quotaName string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Authority: authority,
+		ChannelId: channelId,
+		Denom:     denom,
+		QuotaName: quotaName,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgResetRateLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgResetRateLimit) Type() string {
+	return TypeMsgResetRateLimit
+}
+
+// ValidateBasic validates the MsgResetRateLimit
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	if err := validatePath(msg.Authority, msg.ChannelId, msg.Denom); err != nil {
+		return err
+	}
+
+	if msg.QuotaName == "" {
+		return errorsmod.Wrap(ErrInvalidQuota, "quota name cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgResetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// validatePath validates the authority address and the path (channel and denom pair) of a management message.
+func validatePath(authority, channelId, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address (must be bech32)")
+	}
+
+	if err := host.ChannelIdentifierValidator(channelId); err != nil {
+		return err
+	}
+
+	if denom == "" {
+		return fmt.Errorf("denom cannot be empty")
+	}
+
+	return nil
+}
+
+// validateQuotas validates that there is at least one quota, that quota names are unique,
+// and that every quota has a non-zero duration and percentages of at most 100.
+func validateQuotas(quotas []Quota) error {
+	if len(quotas) == 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "at least one quota is required")
+	}
+
+	names := make(map[string]struct{}, len(quotas))
+	for _, quota := range quotas {
+		if quota.Name == "" {
+			return errorsmod.Wrap(ErrInvalidQuota, "quota name cannot be empty")
+		}
+		if _, ok := names[quota.Name]; ok {
+			return errorsmod.Wrapf(ErrInvalidQuota, "duplicate quota name %s", quota.Name)
+		}
+		names[quota.Name] = struct{}{}
+
+		if quota.Duration == 0 {
+			return errorsmod.Wrapf(ErrInvalidQuota, "quota %s duration must be positive", quota.Name)
+		}
+		if quota.MaxPercentageSend > 100 || quota.MaxPercentageRecv > 100 {
+			return errorsmod.Wrapf(ErrInvalidQuota, "quota %s percentages must be at most 100", quota.Name)
+		}
+	}
+
+	return nil
+}
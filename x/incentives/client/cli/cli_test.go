@@ -95,3 +95,16 @@ func TestGetCmdUpcomingGaugesPerDenom(t *testing.T) {
 	}
 	osmocli.RunQueryTestCases(t, desc, tcs)
 }
+
+func TestGetCmdNextGaugeStartTimes(t *testing.T) {
+	desc, _ := GetCmdNextGaugeStartTimes()
+	tcs := map[string]osmocli.QueryCliTestCase[*types.QueryNextGaugeStartTimesRequest]{
+		"basic test": {
+			Cmd: "", ExpectedQuery: &types.QueryNextGaugeStartTimesRequest{},
+		},
+		"with num": {
+			Cmd: "--num=10", ExpectedQuery: &types.QueryNextGaugeStartTimesRequest{Num: 10},
+		},
+	}
+	osmocli.RunQueryTestCases(t, desc, tcs)
+}
@@ -16,6 +16,7 @@ const (
 	FlagOwner     = "owner"
 	FlagLockIds   = "lock-ids"
 	FlagEndEpoch  = "end-epoch"
+	FlagNum       = "num"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -24,8 +25,15 @@ func FlagSetCreateGauge() *flag.FlagSet {
 
 	dur, _ := time.ParseDuration("24h")
 	fs.Duration(FlagDuration, dur, "The duration token to be locked, default 1d(24h). Other examples are 7d(168h), 14d(336h). Maximum unit is hour.")
-	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
+	fs.String(FlagStartTime, "", "Timestamp to begin distribution. If in the future, must be one of the next-gauge-start-times")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
 	return fs
 }
+
+// FlagSetNextGaugeStartTimes returns flags for querying the next gauge start times.
+func FlagSetNextGaugeStartTimes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagNum, 0, "Number of start times to return, default 5, at most 100")
+	return fs
+}
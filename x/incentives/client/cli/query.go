@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
@@ -34,6 +35,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdAllGroupsWithGauge)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdGroupByGroupGaugeID)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdCurrentWeightByGroupGaugeID)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdGaugeDistributionProjection)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdNextGaugeStartTimes)
 	cmd.AddCommand(GetCmdRewardsEst())
 
 	return cmd
@@ -114,6 +117,28 @@ func GetCmdCurrentWeightByGroupGaugeID() (*osmocli.QueryDescriptor, *types.Query
 	}, &types.QueryCurrentWeightByGroupGaugeIDRequest{}
 }
 
+// GetCmdGaugeDistributionProjection returns the projected next epoch distribution of a gauge.
+func GetCmdGaugeDistributionProjection() (*osmocli.QueryDescriptor, *types.QueryGaugeDistributionProjectionRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "gauge-distribution-projection",
+		Short: "Query the coins a gauge is expected to distribute at the next epoch and the projected share of each receiving lock or position",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} gauge-distribution-projection 1`,
+	}, &types.QueryGaugeDistributionProjectionRequest{}
+}
+
+// GetCmdNextGaugeStartTimes returns the next start times that external gauges can be created with.
+func GetCmdNextGaugeStartTimes() (*osmocli.QueryDescriptor, *types.QueryNextGaugeStartTimesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "next-gauge-start-times",
+		Short: "Query the next start times that gauges can be created with, i.e. the upcoming distribution epoch boundaries",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} next-gauge-start-times --num 10`,
+		Flags:               osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetNextGaugeStartTimes()}},
+		CustomFlagOverrides: map[string]string{"num": FlagNum},
+	}, &types.QueryNextGaugeStartTimesRequest{}
+}
+
 // GetCmdRewardsEst returns rewards estimation.
 func GetCmdRewardsEst() *cobra.Command {
 	cmd := &cobra.Command{
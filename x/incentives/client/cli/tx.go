@@ -166,7 +166,7 @@ Group 3: Pool IDs 6, 7
 
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -183,7 +183,7 @@ Group 3: Pool IDs 6, 7
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
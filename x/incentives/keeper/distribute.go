@@ -3,14 +3,18 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	db "github.com/cometbft/cometbft-db"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/coinutil"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
@@ -301,6 +305,20 @@ type distributionInfo struct {
 	idToBech32Addr                []string
 	idToDecodedRewardReceiverAddr []sdk.AccAddress
 	idToDistrCoins                []sdk.Coins
+	// lockDistrs records the rewards of each lock if recordLockDistrs is set, which is only used to project
+	// distributions, or of the locks in recordLockIds otherwise.
+	recordLockDistrs bool
+	recordLockIds    map[uint64]struct{}
+	lockDistrs       []types.LockDistributionProjection
+}
+
+// shouldRecordLockDistr returns true if the rewards distributed to the given lock are recorded.
+func (d *distributionInfo) shouldRecordLockDistr(lockId uint64) bool {
+	if d.recordLockDistrs {
+		return true
+	}
+	_, ok := d.recordLockIds[lockId]
+	return ok
 }
 
 // newDistributionInfo creates a new distributionInfo struct
@@ -618,6 +636,13 @@ func (k Keeper) distributeInternal(
 			)
 
 			ctx.Logger().Info(fmt.Sprintf("distributeInternal CL for pool id %d finished", pool.GetId()))
+			// If the pool has reached the cap on active incentive records, we skip the coin instead of
+			// failing the distribution of every gauge. The coin is left in the gauge, to be distributed
+			// once the pool's active incentive records finish emitting.
+			if errors.As(err, &cltypes.IncentiveRecordCapReachedError{}) {
+				ctx.Logger().Error("distributeInternal, incentive record cap reached, skipping", "module", types.ModuleName, "gaugeId", gauge.Id, "poolId", pool.GetId(), "error", err.Error())
+				continue
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -626,7 +651,7 @@ func (k Keeper) distributeInternal(
 	} else {
 		// This is a standard lock distribution flow that assumes that we have locks associated with the gauge.
 		if len(locks) == 0 {
-			return nil, nil
+			return nil, k.handleUnfilledGauge(ctx, gauge, remainCoins)
 		}
 
 		// In this case, remove redundant cases.
@@ -650,7 +675,7 @@ func (k Keeper) distributeInternal(
 		lockSum := lockuptypes.SumLocksByDenom(locks, denom)
 
 		if lockSum.IsZero() {
-			return nil, nil
+			return nil, k.handleUnfilledGauge(ctx, gauge, remainCoins)
 		}
 
 		for _, lock := range locks {
@@ -680,6 +705,13 @@ func (k Keeper) distributeInternal(
 			if err != nil {
 				return nil, err
 			}
+			if distrInfo.shouldRecordLockDistr(lock.ID) {
+				distrInfo.lockDistrs = append(distrInfo.lockDistrs, types.LockDistributionProjection{
+					LockId:         lock.ID,
+					RewardReceiver: rewardReceiver,
+					Coins:          distrCoins,
+				})
+			}
 
 			totalDistrCoins = totalDistrCoins.Add(distrCoins...)
 		}
@@ -689,6 +721,41 @@ func (k Keeper) distributeInternal(
 	return totalDistrCoins, err
 }
 
+// handleUnfilledGauge handles the remaining coins of a gauge that has no qualifying locks to distribute to.
+// Non-perpetual gauges are left unchanged.
+// For perpetual gauges created with MsgCreateGauge, if the refund_unfilled_perpetual_gauges param is enabled,
+// the remaining coins are sent back to the gauge creator and removed from the gauge.
+// Otherwise, the coins are left in the gauge and rolled over to the next epoch, since perpetual gauges
+// distribute all of their remaining coins at every epoch.
+func (k Keeper) handleUnfilledGauge(ctx sdk.Context, gauge types.Gauge, remainCoins sdk.Coins) error {
+	if !gauge.IsPerpetual || remainCoins.Empty() {
+		return nil
+	}
+
+	creator, found := k.GetGaugeCreator(ctx, gauge.Id)
+	refund := found && k.GetParams(ctx).RefundUnfilledPerpetualGauges
+	if refund {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, remainCoins); err != nil {
+			return err
+		}
+		gauge.Coins = gauge.Coins.Sub(remainCoins...)
+		if err := k.setGauge(ctx, &gauge); err != nil {
+			return err
+		}
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeGaugeID, osmoutils.Uint64ToString(gauge.Id)),
+		sdk.NewAttribute(types.AttributeAmount, remainCoins.String()),
+		sdk.NewAttribute(types.AttributeRefunded, strconv.FormatBool(refund)),
+	}
+	if refund {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeReceiver, creator.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.TypeEvtUnfilledPerpetualGauge, attributes...))
+	return nil
+}
+
 // updateGaugePostDistribute increments the gauge's filled epochs field.
 // Also adds the coins that were just distributed to the gauge's distributed coins field.
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
@@ -757,7 +824,29 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 // CONTRACT: gauges must be active.
 func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, error) {
 	distrInfo := newDistributionInfo()
+	return k.distribute(ctx, gauges, &distrInfo)
+}
+
+// DistributeAndGetLockRewards distributes the given gauges like Distribute, and additionally returns the rewards
+// distributed to each of the given locks, in the order they were distributed. Locks that receive no rewards are omitted,
+// and a lock receiving rewards from multiple gauges appears once per gauge.
+// CONTRACT: gauges must be active.
+func (k Keeper) DistributeAndGetLockRewards(ctx sdk.Context, gauges []types.Gauge, lockIds []uint64) (sdk.Coins, []types.LockDistributionProjection, error) {
+	distrInfo := newDistributionInfo()
+	distrInfo.recordLockIds = make(map[uint64]struct{}, len(lockIds))
+	for _, lockId := range lockIds {
+		distrInfo.recordLockIds[lockId] = struct{}{}
+	}
 
+	totalDistributedCoins, err := k.distribute(ctx, gauges, &distrInfo)
+	if err != nil {
+		return nil, nil, err
+	}
+	return totalDistributedCoins, distrInfo.lockDistrs, nil
+}
+
+// distribute distributes the given gauges, recording the rewards of the locks selected by distrInfo.
+func (k Keeper) distribute(ctx sdk.Context, gauges []types.Gauge, distrInfo *distributionInfo) (sdk.Coins, error) {
 	locksByDenomCache := make(map[string][]lockuptypes.PeriodLock)
 	totalDistributedCoins := sdk.NewCoins()
 
@@ -768,14 +857,14 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 		var err error
 		if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
 			ctx.Logger().Debug("distributeSyntheticInternal, gauge id %d, %d", "module", types.ModuleName, "gaugeId", gauge.Id, "height", ctx.BlockHeight())
-			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, &distrInfo)
+			gaugeDistributedCoins, err = k.distributeSyntheticInternal(ctx, gauge, filteredLocks, distrInfo)
 		} else {
 			// Do not distribute if LockQueryType = Group, because if we distribute here we will be double distributing.
 			if gauge.DistributeTo.LockQueryType == lockuptypes.ByGroup {
 				continue
 			}
 
-			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, &distrInfo)
+			gaugeDistributedCoins, err = k.distributeInternal(ctx, gauge, filteredLocks, distrInfo)
 		}
 		if err != nil {
 			return nil, err
@@ -784,7 +873,7 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 		totalDistributedCoins = totalDistributedCoins.Add(gaugeDistributedCoins...)
 	}
 
-	err := k.doDistributionSends(ctx, &distrInfo)
+	err := k.doDistributionSends(ctx, distrInfo)
 	if err != nil {
 		// TODO: add test case to cover this
 		return nil, err
@@ -796,6 +885,89 @@ func (k Keeper) Distribute(ctx sdk.Context, gauges []types.Gauge) (sdk.Coins, er
 	return totalDistributedCoins, nil
 }
 
+// ProjectGaugeDistribution returns the coins that the given gauge is expected to distribute at the next epoch,
+// along with the projected rewards of each receiving lock. For NoLock gauges, it instead returns the concentrated
+// liquidity pool receiving the rewards and the projected rewards of each position in range at the current tick,
+// proportional to its share of the pool's current tick liquidity. The latter assumes that the current tick does
+// not move over the epoch, since the incentives are emitted to in range positions continuously.
+// The distribution is run in a cached context, so no state is written.
+// Returns an empty projection if the gauge will not be active at the next epoch.
+// Returns error if the gauge does not exist or is a group gauge, which distributes to other gauges rather than locks.
+func (k Keeper) ProjectGaugeDistribution(ctx sdk.Context, gaugeId uint64) (*types.QueryGaugeDistributionProjectionResponse, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByGroup {
+		return nil, fmt.Errorf("gauge with id of %d is a group gauge, which distributes to its underlying gauges", gaugeId)
+	}
+
+	projection := &types.QueryGaugeDistributionProjectionResponse{
+		Coins:     sdk.NewCoins(),
+		Locks:     []types.LockDistributionProjection{},
+		Positions: []types.PositionDistributionProjection{},
+	}
+
+	// The next epoch ends no earlier than the current block, even if it is overdue.
+	epochInfo := k.GetEpochInfo(ctx)
+	nextEpochTime := epochInfo.CurrentEpochStartTime.Add(epochInfo.Duration)
+	if nextEpochTime.Before(ctx.BlockTime()) {
+		nextEpochTime = ctx.BlockTime()
+	}
+	if !gauge.IsActiveGauge(nextEpochTime) {
+		return projection, nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	distrInfo := newDistributionInfo()
+	distrInfo.recordLockDistrs = true
+	filteredLocks := k.getDistributeToBaseLocks(cacheCtx, *gauge, make(map[string][]lockuptypes.PeriodLock))
+	var distributedCoins sdk.Coins
+	if lockuptypes.IsSyntheticDenom(gauge.DistributeTo.Denom) {
+		distributedCoins, err = k.distributeSyntheticInternal(cacheCtx, *gauge, filteredLocks, &distrInfo)
+	} else {
+		distributedCoins, err = k.distributeInternal(cacheCtx, *gauge, filteredLocks, &distrInfo)
+	}
+	if err != nil {
+		return nil, err
+	}
+	projection.Coins = projection.Coins.Add(distributedCoins...)
+	projection.Locks = append(projection.Locks, distrInfo.lockDistrs...)
+
+	if gauge.DistributeTo.LockQueryType != lockuptypes.NoLock {
+		return projection, nil
+	}
+
+	pool, err := k.GetPoolFromGaugeId(ctx, gauge.Id, gauge.DistributeTo.Duration)
+	if err != nil {
+		return nil, err
+	}
+	projection.PoolId = pool.GetId()
+
+	positions, _, err := k.clk.GetPositionsByPool(ctx, pool.GetId(), &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		return nil, err
+	}
+	for _, position := range positions {
+		if !position.InRange {
+			continue
+		}
+		positionCoins := sdk.NewCoins()
+		for _, coin := range projection.Coins {
+			amt := position.LiquidityShare.MulInt(coin.Amount).TruncateInt()
+			positionCoins = positionCoins.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+		projection.Positions = append(projection.Positions, types.PositionDistributionProjection{
+			PositionId: position.Position.PositionId,
+			Owner:      position.Position.Address,
+			Coins:      positionCoins,
+		})
+	}
+
+	return projection, nil
+}
+
 // GetPoolFromGaugeId returns a pool associated with the given gauge id.
 // Returns error if there is no link between pool id and gauge id.
 // Returns error if pool is not saved in state.
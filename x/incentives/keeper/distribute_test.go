@@ -233,6 +233,38 @@ func (s *KeeperTestSuite) TestDistribute() {
 	}
 }
 
+// TestDistributeAndGetLockRewards tests that the rewards distributed to the requested locks are returned
+// for every gauge they receive rewards from, and that the distribution itself is unchanged.
+func (s *KeeperTestSuite) TestDistributeAndGetLockRewards() {
+	s.SetupTest()
+	defaultGauge := perpGaugeDesc{
+		lockDenom:    defaultLPDenom,
+		lockDuration: defaultLockDuration,
+		rewardAmount: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)},
+	}
+	doubleLengthGauge := perpGaugeDesc{
+		lockDenom:    defaultLPDenom,
+		lockDuration: 2 * defaultLockDuration,
+		rewardAmount: sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)},
+	}
+	// Lock 1 belongs to the first user, locks 2 and 3 to the second user.
+	gauges := s.SetupGauges([]perpGaugeDesc{defaultGauge, doubleLengthGauge}, defaultLPDenom)
+	addrs := s.SetupUserLocks([]userLocks{oneLockupUser, twoLockupUser})
+
+	// System under test.
+	totalDistributedCoins, lockRewards, err := s.App.IncentivesKeeper.DistributeAndGetLockRewards(s.Ctx, gauges, []uint64{1, 3})
+	s.Require().NoError(err)
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 6000)), totalDistributedCoins)
+	s.Require().Equal([]types.LockDistributionProjection{
+		{LockId: 1, RewardReceiver: addrs[0].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000))},
+		{LockId: 3, RewardReceiver: addrs[1].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000))},
+		{LockId: 3, RewardReceiver: addrs[1].String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 3000))},
+	}, lockRewards)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 1000)), s.App.BankKeeper.GetAllBalances(s.Ctx, addrs[0]))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(defaultRewardDenom, 5000)), s.App.BankKeeper.GetAllBalances(s.Ctx, addrs[1]))
+}
+
 func (s *KeeperTestSuite) TestDistribute_InternalIncentives_NoLock() {
 	fiveKRewardCoins := sdk.NewInt64Coin(defaultRewardDenom, 5000)
 	fiveKRewardCoinsUosmo := sdk.NewInt64Coin(appParams.BaseCoinUnit, 5000)
@@ -849,6 +881,87 @@ func (s *KeeperTestSuite) TestByDurationNonPerpetualGaugeDistribution_NoLockNoOp
 	s.ValidateNotDistributedGauge(gaugeID)
 }
 
+// TestByDurationPerpetualGaugeDistribution_Unfilled tests that the coins of a perpetual gauge with no locks to distribute to
+// are refunded to its creator if the refund_unfilled_perpetual_gauges param is enabled and rolled over otherwise.
+func (s *KeeperTestSuite) TestByDurationPerpetualGaugeDistribution_Unfilled() {
+	tests := map[string]struct {
+		isPerpetual     bool
+		refundEnabled   bool
+		creatorRecorded bool
+		expectRefund    bool
+		expectEvent     bool
+	}{
+		"perpetual gauge, refund enabled, creator recorded: refunded": {
+			isPerpetual:     true,
+			refundEnabled:   true,
+			creatorRecorded: true,
+			expectRefund:    true,
+			expectEvent:     true,
+		},
+		"perpetual gauge, refund disabled: rolled over": {
+			isPerpetual:     true,
+			creatorRecorded: true,
+			expectEvent:     true,
+		},
+		"perpetual gauge, refund enabled, creator not recorded: rolled over": {
+			isPerpetual:   true,
+			refundEnabled: true,
+			expectEvent:   true,
+		},
+		"non-perpetual gauge: unchanged": {
+			refundEnabled:   true,
+			creatorRecorded: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			creator := s.TestAccs[0]
+			params := s.App.IncentivesKeeper.GetParams(s.Ctx)
+			params.RefundUnfilledPerpetualGauges = tc.refundEnabled
+			s.App.IncentivesKeeper.SetParams(s.Ctx, params)
+
+			coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+			gaugeID, _, _, startTime := s.SetupNewGauge(tc.isPerpetual, coins)
+			if tc.creatorRecorded {
+				s.App.IncentivesKeeper.SetGaugeCreator(s.Ctx, gaugeID, creator)
+			}
+
+			s.Ctx = s.Ctx.WithBlockTime(startTime).WithEventManager(sdk.NewEventManager())
+			gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+			s.Require().NoError(err)
+			err = s.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(s.Ctx, *gauge)
+			s.Require().NoError(err)
+			creatorBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, creator)
+
+			// System under test.
+			distrCoins, err := s.App.IncentivesKeeper.Distribute(s.Ctx, []types.Gauge{*gauge})
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.Coins{}, distrCoins)
+
+			gaugeAfter, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, gaugeID)
+			s.Require().NoError(err)
+			creatorBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, creator)
+			if tc.expectRefund {
+				s.Require().Equal(creatorBalanceBefore.Add(coins...).String(), creatorBalanceAfter.String())
+				s.Require().True(gaugeAfter.Coins.Empty())
+				s.Require().True(s.App.IncentivesKeeper.GetModuleToDistributeCoins(s.Ctx).Empty())
+			} else {
+				s.Require().Equal(creatorBalanceBefore.String(), creatorBalanceAfter.String())
+				s.Require().Equal(coins, gaugeAfter.Coins)
+			}
+			s.ValidateNotDistributedGauge(gaugeID)
+
+			expectedEvents := 0
+			if tc.expectEvent {
+				expectedEvents = 1
+			}
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtUnfilledPerpetualGauge, expectedEvents)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetPoolFromGaugeId() {
 	const (
 		poolIdOne   = uint64(1)
@@ -21,6 +21,11 @@ func (k Keeper) DeleteGaugeRefByKey(ctx sdk.Context, key []byte, guageID uint64)
 	return k.deleteGaugeRefByKey(ctx, key, guageID)
 }
 
+// SetGaugeCreator sets the address that created the given gauge.
+func (k Keeper) SetGaugeCreator(ctx sdk.Context, gaugeID uint64, creator sdk.AccAddress) {
+	k.setGaugeCreator(ctx, gaugeID, creator)
+}
+
 // GetGaugeRefs returns the gauge IDs specified by the provided key.
 func (k Keeper) GetGaugeRefs(ctx sdk.Context, key []byte) []uint64 {
 	return k.getGaugeRefs(ctx, key)
@@ -120,6 +120,7 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 //
 // Returns error if:
 // - attempts to create non-perpetual gauge with numEpochsPaidOver of 0
+// - attempts to create lockuptypes.ByDuration gauge with a duration that is not a lockable duration
 //
 // On success, returns the gauge ID.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, poolId uint64) (uint64, error) {
@@ -128,17 +129,9 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	}
 
 	// Ensure that this gauge's duration is one of the allowed durations on chain
-	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
-		durationOk := false
-		for _, duration := range durations {
-			if duration == distrTo.Duration {
-				durationOk = true
-				break
-			}
-		}
-		if !durationOk {
-			return 0, fmt.Errorf("invalid duration: %d", distrTo.Duration)
+		if err := k.validateGaugeDuration(ctx, distrTo.Duration); err != nil {
+			return 0, err
 		}
 	}
 
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// Gauges are moved from upcoming to active, and distribute, at the end of the distribution epoch.
+// External gauges are therefore required to start at a distribution epoch boundary, so that the time
+// they start at is the time they first distribute at. Start times at or before the current block time
+// are always accepted, the gauge starting at the end of the current epoch.
+
+// ValidateExternalGauge validates the lock duration and start time of a gauge created with MsgCreateGauge.
+// Returns error if:
+// - the gauge is a lockuptypes.ByDuration gauge with a duration that is not a lockable duration
+// - the start time is after the current block time and is not a distribution epoch boundary
+func (k Keeper) ValidateExternalGauge(ctx sdk.Context, distrTo lockuptypes.QueryCondition, startTime time.Time) error {
+	if distrTo.LockQueryType == lockuptypes.ByDuration {
+		if err := k.validateGaugeDuration(ctx, distrTo.Duration); err != nil {
+			return err
+		}
+	}
+	return k.validateGaugeStartTime(ctx, startTime)
+}
+
+// GetNextGaugeStartTimes returns the given number of upcoming distribution epoch boundaries after the
+// current block time, in ascending order.
+func (k Keeper) GetNextGaugeStartTimes(ctx sdk.Context, num uint64) []time.Time {
+	epochStart, epochDuration := k.getDistrEpochAnchor(ctx)
+	startTime := nextEpochBoundary(epochStart, epochDuration, ctx.BlockTime().Add(time.Nanosecond))
+
+	startTimes := make([]time.Time, 0, num)
+	for i := uint64(0); i < num; i++ {
+		startTimes = append(startTimes, startTime)
+		startTime = startTime.Add(epochDuration)
+	}
+	return startTimes
+}
+
+// validateGaugeDuration returns types.UnauthorizedGaugeDurationError if the given duration is not one of
+// the lockable durations.
+func (k Keeper) validateGaugeDuration(ctx sdk.Context, duration time.Duration) error {
+	lockableDurations := k.GetLockableDurations(ctx)
+	for _, lockableDuration := range lockableDurations {
+		if lockableDuration == duration {
+			return nil
+		}
+	}
+	return types.UnauthorizedGaugeDurationError{Duration: duration, LockableDurations: lockableDurations}
+}
+
+// validateGaugeStartTime returns types.MisalignedGaugeStartTimeError if the given start time is after the
+// current block time and is not a distribution epoch boundary.
+func (k Keeper) validateGaugeStartTime(ctx sdk.Context, startTime time.Time) error {
+	if !startTime.After(ctx.BlockTime()) {
+		return nil
+	}
+
+	epochStart, epochDuration := k.getDistrEpochAnchor(ctx)
+	nextValidStartTime := nextEpochBoundary(epochStart, epochDuration, startTime)
+	if !nextValidStartTime.Equal(startTime) {
+		return types.MisalignedGaugeStartTimeError{StartTime: startTime, NextValidStartTime: nextValidStartTime}
+	}
+	return nil
+}
+
+// getDistrEpochAnchor returns the start time of the current distribution epoch, or the start time of
+// the first one if the epoch has not started counting yet, along with the epoch duration.
+func (k Keeper) getDistrEpochAnchor(ctx sdk.Context) (time.Time, time.Duration) {
+	epochInfo := k.GetEpochInfo(ctx)
+	if !epochInfo.EpochCountingStarted {
+		return epochInfo.StartTime, epochInfo.Duration
+	}
+	return epochInfo.CurrentEpochStartTime, epochInfo.Duration
+}
+
+// nextEpochBoundary returns the first epoch end at or after the given time, for epochs of the given
+// duration starting at epochStart.
+func nextEpochBoundary(epochStart time.Time, epochDuration time.Duration, t time.Time) time.Time {
+	elapsed := t.Sub(epochStart)
+	if elapsed <= 0 {
+		return epochStart.Add(epochDuration)
+	}
+
+	numEpochs := elapsed / epochDuration
+	if elapsed%epochDuration != 0 {
+		numEpochs++
+	}
+	return epochStart.Add(numEpochs * epochDuration)
+}
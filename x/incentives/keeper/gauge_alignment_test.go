@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// setDistrEpoch overwrites the distribution epoch so that its current epoch started an hour before the block time,
+// or starts an hour after the block time if counting has not started, and lasts a day.
+func (s *KeeperTestSuite) setDistrEpoch(countingStarted bool) time.Time {
+	epochInfo := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx)
+	epochInfo.Duration = 24 * time.Hour
+	epochInfo.EpochCountingStarted = countingStarted
+	if countingStarted {
+		epochInfo.CurrentEpochStartTime = s.Ctx.BlockTime().Add(-time.Hour)
+	} else {
+		epochInfo.StartTime = s.Ctx.BlockTime().Add(time.Hour)
+	}
+	s.App.EpochsKeeper.DeleteEpochInfo(s.Ctx, epochInfo.Identifier)
+	err := s.App.EpochsKeeper.AddEpochInfo(s.Ctx, epochInfo)
+	s.Require().NoError(err)
+
+	// The end of the current epoch, or of the first one.
+	return s.Ctx.BlockTime().Add(23 * time.Hour)
+}
+
+func (s *KeeperTestSuite) TestGetNextGaugeStartTimes() {
+	for _, countingStarted := range []bool{true, false} {
+		s.SetupTest()
+		epochEnd := s.setDistrEpoch(countingStarted)
+		if !countingStarted {
+			epochEnd = epochEnd.Add(2 * time.Hour)
+		}
+
+		startTimes := s.App.IncentivesKeeper.GetNextGaugeStartTimes(s.Ctx, 3)
+		s.Require().Len(startTimes, 3)
+		for i, startTime := range startTimes {
+			s.Require().True(epochEnd.Add(time.Duration(i)*24*time.Hour).Equal(startTime), "start time %d: %s", i, startTime)
+		}
+
+		// Epoch ends that are past due, e.g. after a halt, are not returned.
+		s.Ctx = s.Ctx.WithBlockTime(epochEnd.Add(24*time.Hour + time.Minute))
+		startTimes = s.App.IncentivesKeeper.GetNextGaugeStartTimes(s.Ctx, 1)
+		s.Require().Len(startTimes, 1)
+		s.Require().True(epochEnd.Add(48 * time.Hour).Equal(startTimes[0]))
+	}
+}
+
+func (s *KeeperTestSuite) TestValidateExternalGauge() {
+	tests := map[string]struct {
+		countingNotStarted bool
+		distrTo            lockuptypes.QueryCondition
+		// offset of the start time from the end of the current epoch
+		startTimeOffset time.Duration
+		startNow        bool
+		// offset of the expected next valid start time from the end of the current epoch
+		expectedNextValidStartTimeOffset *time.Duration
+		expectUnauthorizedDuration       bool
+	}{
+		"start time at the end of the current epoch": {},
+		"start time at the end of a later epoch": {
+			startTimeOffset: 48 * time.Hour,
+		},
+		"start time at the end of the first epoch": {
+			countingNotStarted: true,
+			startTimeOffset:    2 * time.Hour,
+		},
+		"start time at the block time": {
+			startNow: true,
+		},
+		"start time in the past": {
+			startTimeOffset: -24 * time.Hour,
+		},
+		"no lock gauge": {
+			distrTo:         lockuptypes.QueryCondition{LockQueryType: lockuptypes.NoLock},
+			startTimeOffset: 24 * time.Hour,
+		},
+		"error: start time within the current epoch": {
+			startTimeOffset:                  -time.Minute,
+			expectedNextValidStartTimeOffset: durationPtr(0),
+		},
+		"error: start time within a later epoch": {
+			startTimeOffset:                  24*time.Hour + time.Minute,
+			expectedNextValidStartTimeOffset: durationPtr(48 * time.Hour),
+		},
+		"error: start time before the first epoch ends": {
+			countingNotStarted:               true,
+			startTimeOffset:                  time.Minute,
+			expectedNextValidStartTimeOffset: durationPtr(2 * time.Hour),
+		},
+		"error: no lock gauge start time within a later epoch": {
+			distrTo:                          lockuptypes.QueryCondition{LockQueryType: lockuptypes.NoLock},
+			startTimeOffset:                  time.Hour,
+			expectedNextValidStartTimeOffset: durationPtr(24 * time.Hour),
+		},
+		"error: duration is not a lockable duration": {
+			distrTo: lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         defaultLPDenom,
+				Duration:      defaultLockDuration / 2,
+			},
+			expectUnauthorizedDuration: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			epochEnd := s.setDistrEpoch(!tc.countingNotStarted)
+
+			distrTo := tc.distrTo
+			if distrTo.LockQueryType == lockuptypes.ByDuration && distrTo.Duration == 0 {
+				distrTo = lockuptypes.QueryCondition{
+					LockQueryType: lockuptypes.ByDuration,
+					Denom:         defaultLPDenom,
+					Duration:      defaultLockDuration,
+				}
+			}
+			startTime := epochEnd.Add(tc.startTimeOffset)
+			if tc.startNow {
+				startTime = s.Ctx.BlockTime()
+			}
+
+			err := s.App.IncentivesKeeper.ValidateExternalGauge(s.Ctx, distrTo, startTime)
+			if tc.expectUnauthorizedDuration {
+				unauthorizedDurationErr := types.UnauthorizedGaugeDurationError{}
+				s.Require().ErrorAs(err, &unauthorizedDurationErr)
+				s.Require().Equal(distrTo.Duration, unauthorizedDurationErr.Duration)
+				s.Require().Equal(s.App.IncentivesKeeper.GetLockableDurations(s.Ctx), unauthorizedDurationErr.LockableDurations)
+				return
+			}
+			if tc.expectedNextValidStartTimeOffset != nil {
+				misalignedErr := types.MisalignedGaugeStartTimeError{}
+				s.Require().ErrorAs(err, &misalignedErr)
+				s.Require().True(startTime.Equal(misalignedErr.StartTime))
+				s.Require().True(epochEnd.Add(*tc.expectedNextValidStartTimeOffset).Equal(misalignedErr.NextValidStartTime))
+				return
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
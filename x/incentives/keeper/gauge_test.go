@@ -61,7 +61,7 @@ func (s *KeeperTestSuite) TestInvalidDurationGaugeCreationValidation() {
 		Duration:      defaultLockDuration / 2, // 0.5 second, invalid duration
 	}
 	_, err := s.App.IncentivesKeeper.CreateGauge(s.Ctx, false, addrs[0], defaultLiquidTokens, distrTo, time.Time{}, 1, 0)
-	s.Require().Error(err)
+	s.Require().ErrorAs(err, &types.UnauthorizedGaugeDurationError{})
 
 	distrTo.Duration = defaultLockDuration
 	_, err = s.App.IncentivesKeeper.CreateGauge(s.Ctx, false, addrs[0], defaultLiquidTokens, distrTo, time.Time{}, 1, 0)
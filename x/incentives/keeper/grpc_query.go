@@ -8,12 +8,12 @@ import (
 	"google.golang.org/grpc/status"
 
 	errorsmod "cosmossdk.io/errors"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v21/x/incentives/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 )
@@ -235,6 +235,41 @@ func (q Querier) CurrentWeightByGroupGaugeID(goCtx context.Context, req *types.Q
 	return &types.QueryCurrentWeightByGroupGaugeIDResponse{GaugeWeight: gaugeWeights}, nil
 }
 
+// GaugeDistributionProjection returns the coins that a gauge is expected to distribute at the next epoch,
+// along with the projected share of each receiving lock or concentrated liquidity position.
+func (q Querier) GaugeDistributionProjection(goCtx context.Context, req *types.QueryGaugeDistributionProjectionRequest) (*types.QueryGaugeDistributionProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	projection, err := q.Keeper.ProjectGaugeDistribution(ctx, req.GaugeId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return projection, nil
+}
+
+// NextGaugeStartTimes returns the next start times that external gauges can be created with,
+// i.e. the upcoming distribution epoch boundaries.
+func (q Querier) NextGaugeStartTimes(goCtx context.Context, req *types.QueryNextGaugeStartTimesRequest) (*types.QueryNextGaugeStartTimesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	num := req.Num
+	if num == 0 {
+		num = types.DefaultNumNextGaugeStartTimes
+	}
+	if num > types.MaxNumNextGaugeStartTimes {
+		return nil, status.Error(codes.InvalidArgument, types.InvalidNumGaugeStartTimesError{Num: num, Max: types.MaxNumNextGaugeStartTimes}.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryNextGaugeStartTimesResponse{StartTimes: q.Keeper.GetNextGaugeStartTimes(ctx, num)}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from the json bytes of gaugeIDs.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
@@ -259,32 +294,37 @@ func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]ty
 
 // filterByPrefixAndDenom filters gauges based on a given key prefix and denom
 func (q Querier) filterByPrefixAndDenom(ctx sdk.Context, prefixType []byte, denom string, pagination *query.PageRequest) (*query.PageResponse, []types.Gauge, error) {
-	gauges := []types.Gauge{}
-	store := ctx.KVStore(q.Keeper.storeKey)
-	valStore := prefix.NewStore(store, prefixType)
-
-	pageRes, err := query.FilteredPaginate(valStore, pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		// this may return multiple gauges at once if two gauges start at the same time.
-		// for now this is treated as an edge case that is not of importance
-		newGauges, err := q.getGaugeFromIDJsonBytes(ctx, value)
+	// this may return multiple gauges at once if two gauges start at the same time.
+	// for now this is treated as an edge case that is not of importance
+	parseGauges := func(_ []byte, value []byte) ([]types.Gauge, error) {
+		return q.getGaugeFromIDJsonBytes(ctx, value)
+	}
+	hasDenom := func(_ []byte, value []byte) (bool, error) {
+		if denom == "" {
+			return true, nil
+		}
+		gauges, err := q.getGaugeFromIDJsonBytes(ctx, value)
 		if err != nil {
 			return false, err
 		}
-		if accumulate {
-			if denom != "" {
-				for _, gauge := range newGauges {
-					if gauge.DistributeTo.Denom != denom {
-						return false, nil
-					}
-					gauges = append(gauges, gauge)
-				}
-			} else {
-				gauges = append(gauges, newGauges...)
+		for _, gauge := range gauges {
+			if gauge.DistributeTo.Denom != denom {
+				return false, nil
 			}
 		}
 		return true, nil
-	})
-	return pageRes, gauges, err
+	}
+
+	gaugeGroups, pageRes, err := osmoutils.GatherValuesFromStorePrefixFilteredPaginated(ctx.KVStore(q.Keeper.storeKey), prefixType, pagination, parseGauges, hasDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gauges := []types.Gauge{}
+	for _, gaugeGroup := range gaugeGroups {
+		gauges = append(gauges, gaugeGroup...)
+	}
+	return pageRes, gauges, nil
 }
 
 // queryWeightSplitGroup calculates the ratio of volume for each gauge in a group since the last epoch.
@@ -485,6 +485,75 @@ func (s *KeeperTestSuite) TestGRPCToDistributeCoins() {
 	s.Require().Equal(res.Coins, sdk.Coins{})
 }
 
+// TestGRPCGaugeDistributionProjection tests that the projected next epoch distribution of a gauge matches the actual distribution
+// and does not modify state.
+func (s *KeeperTestSuite) TestGRPCGaugeDistributionProjection() {
+	s.SetupTest()
+
+	// querying a gauge that does not exist returns an error.
+	_, err := s.querier.GaugeDistributionProjection(sdk.WrapSDKContext(s.Ctx), &types.QueryGaugeDistributionProjectionRequest{GaugeId: 1000})
+	s.Require().Error(err)
+
+	// create two locks with different durations that both qualify for the gauge
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	s.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, time.Second)
+	s.LockTokens(addr2, sdk.Coins{sdk.NewInt64Coin("lptoken", 10)}, 2*time.Second)
+
+	// setup a non perpetual gauge paid over two epochs
+	gaugeID, _, _, startTime := s.SetupNewGauge(false, sdk.Coins{sdk.NewInt64Coin("stake", 10)})
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+
+	// 5 stake are distributed per epoch, evenly split between the locks and truncated
+	res, err := s.querier.GaugeDistributionProjection(sdk.WrapSDKContext(s.Ctx), &types.QueryGaugeDistributionProjectionRequest{GaugeId: gaugeID})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, res.Coins)
+	s.Require().Len(res.Locks, 2)
+	receivers := []string{}
+	for _, lock := range res.Locks {
+		s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 2)}, lock.Coins)
+		receivers = append(receivers, lock.RewardReceiver)
+	}
+	s.Require().ElementsMatch([]string{addr1.String(), addr2.String()}, receivers)
+	s.Require().Empty(res.Positions)
+
+	// the projection does not modify the gauge
+	gauge, err := s.querier.GetGaugeByID(s.Ctx, gaugeID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), gauge.FilledEpochs)
+	s.Require().True(gauge.DistributedCoins.Empty())
+
+	// the projection matches the actual distribution
+	distrCoins, err := s.querier.Distribute(s.Ctx, []types.Gauge{*gauge})
+	s.Require().NoError(err)
+	s.Require().Equal(res.Coins, distrCoins)
+
+	// setup a no lock gauge paid over two epochs to a pool with two equal full range positions
+	clPool := s.PrepareConcentratedPool()
+	positionCoins := sdk.NewCoins(sdk.NewCoin("eth", osmomath.NewInt(1_000_000)), sdk.NewCoin("usdc", osmomath.NewInt(5_000_000_000)))
+	positionId1, _ := s.CreateFullRangePosition(clPool, positionCoins)
+	positionId2, _ := s.CreateFullRangePosition(clPool, positionCoins)
+
+	gaugeCoins := sdk.NewCoins(sdk.NewCoin("eth", osmomath.NewInt(1_000)))
+	s.FundAcc(s.TestAccs[1], gaugeCoins)
+	noLockGaugeID := s.CreateNoLockExternalGauges(clPool.GetId(), gaugeCoins, s.TestAccs[1], 2)
+
+	res, err = s.querier.GaugeDistributionProjection(sdk.WrapSDKContext(s.Ctx), &types.QueryGaugeDistributionProjectionRequest{GaugeId: noLockGaugeID})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("eth", osmomath.NewInt(500))), res.Coins)
+	s.Require().Empty(res.Locks)
+	s.Require().Equal(clPool.GetId(), res.PoolId)
+	s.Require().Equal([]types.PositionDistributionProjection{
+		{PositionId: positionId1, Owner: s.TestAccs[0].String(), Coins: sdk.NewCoins(sdk.NewCoin("eth", osmomath.NewInt(250)))},
+		{PositionId: positionId2, Owner: s.TestAccs[0].String(), Coins: sdk.NewCoins(sdk.NewCoin("eth", osmomath.NewInt(250)))},
+	}, res.Positions)
+
+	// no incentive record is created by the projection
+	incentiveRecords, err := s.App.ConcentratedLiquidityKeeper.GetAllIncentiveRecordsForPool(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Empty(incentiveRecords)
+}
+
 // TestGRPCDistributedCoins tests querying coins that have been distributed via gRPC returns the correct response.
 func (s *KeeperTestSuite) TestGRPCDistributedCoins() {
 	s.SetupTest()
@@ -527,3 +596,26 @@ func (s *KeeperTestSuite) TestGRPCDistributedCoins() {
 	s.Require().NoError(err)
 	s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 6)}, distrCoins)
 }
+
+// TestGRPCNextGaugeStartTimes tests querying the next gauge start times.
+func (s *KeeperTestSuite) TestGRPCNextGaugeStartTimes() {
+	s.SetupTest()
+
+	// the default number of start times is returned if none is given
+	res, err := s.querier.NextGaugeStartTimes(sdk.WrapSDKContext(s.Ctx), &types.QueryNextGaugeStartTimesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.StartTimes, int(types.DefaultNumNextGaugeStartTimes))
+
+	epochDuration := s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).Duration
+	s.Require().True(res.StartTimes[0].After(s.Ctx.BlockTime()))
+	for i := 1; i < len(res.StartTimes); i++ {
+		s.Require().Equal(epochDuration, res.StartTimes[i].Sub(res.StartTimes[i-1]))
+	}
+
+	res, err = s.querier.NextGaugeStartTimes(sdk.WrapSDKContext(s.Ctx), &types.QueryNextGaugeStartTimesRequest{Num: types.MaxNumNextGaugeStartTimes})
+	s.Require().NoError(err)
+	s.Require().Len(res.StartTimes, int(types.MaxNumNextGaugeStartTimes))
+
+	_, err = s.querier.NextGaugeStartTimes(sdk.WrapSDKContext(s.Ctx), &types.QueryNextGaugeStartTimesRequest{Num: types.MaxNumNextGaugeStartTimes + 1})
+	s.Require().Error(err)
+}
@@ -34,6 +34,10 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
+	if err := server.keeper.ValidateExternalGauge(ctx, msg.DistributeTo, msg.StartTime); err != nil {
+		return nil, err
+	}
+
 	if err := server.keeper.chargeFeeIfSufficientFeeDenomBalance(ctx, owner, types.CreateGaugeFee, msg.Coins); err != nil {
 		return nil, err
 	}
@@ -43,6 +47,11 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	// Record the creator of perpetual gauges so that their coins can be refunded if there is nothing to distribute to.
+	if msg.IsPerpetual {
+		server.keeper.setGaugeCreator(ctx, gaugeID, owner)
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeEvtCreateGauge,
@@ -114,7 +114,7 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			Owner:             testAccountAddress.String(),
 			DistributeTo:      distrTo,
 			Coins:             tc.gaugeAddition,
-			StartTime:         time.Now(),
+			StartTime:         ctx.BlockTime(),
 			NumEpochsPaidOver: 1,
 		}
 		// System under test.
@@ -135,10 +135,54 @@ func (s *KeeperTestSuite) TestCreateGauge_Fee() {
 			accountBalance := tc.accountBalanceToFund.Sub(tc.gaugeAddition...)
 			finalAccountBalance := accountBalance.Sub(fee...)
 			s.Require().Equal(finalAccountBalance.String(), balanceAmount.String(), "test: %v", tc.name)
+
+			// Only the creators of perpetual gauges are recorded.
+			creator, found := s.App.IncentivesKeeper.GetGaugeCreator(ctx, s.App.IncentivesKeeper.GetLastGaugeID(ctx))
+			s.Require().Equal(tc.isPerpetual, found, "test: %v", tc.name)
+			if tc.isPerpetual {
+				s.Require().Equal(testAccountAddress, creator, "test: %v", tc.name)
+			}
 		}
 	}
 }
 
+// validates that gauges starting in the future are only created at distribution epoch boundaries,
+// and that no fee is charged for the misaligned ones.
+func (s *KeeperTestSuite) TestCreateGauge_StartTimeAlignment() {
+	s.SetupTest()
+	msgServer := keeper.NewMsgServerImpl(s.App.IncentivesKeeper)
+	owner := s.TestAccs[0]
+	s.FundAcc(owner, seventyTokens)
+	s.SetupManyLocks(1, defaultLiquidTokens, defaultLPTokens, defaultLockDuration)
+
+	nextStartTimes := s.App.IncentivesKeeper.GetNextGaugeStartTimes(s.Ctx, 2)
+	msg := &types.MsgCreateGauge{
+		Owner: owner.String(),
+		DistributeTo: lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         defaultLPDenom,
+			Duration:      defaultLockDuration,
+		},
+		Coins:             tenTokens,
+		StartTime:         nextStartTimes[1].Add(-time.Second),
+		NumEpochsPaidOver: 1,
+	}
+	_, err := msgServer.CreateGauge(sdk.WrapSDKContext(s.Ctx), msg)
+	misalignedErr := types.MisalignedGaugeStartTimeError{}
+	s.Require().ErrorAs(err, &misalignedErr)
+	s.Require().True(nextStartTimes[1].Equal(misalignedErr.NextValidStartTime))
+	s.Require().Equal(seventyTokens.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, owner).String())
+
+	msg.StartTime = misalignedErr.NextValidStartTime
+	_, err = msgServer.CreateGauge(sdk.WrapSDKContext(s.Ctx), msg)
+	s.Require().NoError(err)
+
+	gauge, err := s.App.IncentivesKeeper.GetGaugeByID(s.Ctx, s.App.IncentivesKeeper.GetLastGaugeID(s.Ctx))
+	s.Require().NoError(err)
+	s.Require().True(gauge.IsUpcomingGauge(s.Ctx.BlockTime()))
+	s.Require().True(nextStartTimes[1].Equal(gauge.StartTime))
+}
+
 func (s *KeeperTestSuite) TestAddToGauge_Fee() {
 	tests := []struct {
 		name                 string
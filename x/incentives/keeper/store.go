@@ -32,11 +32,31 @@ func (k Keeper) SetLastGaugeID(ctx sdk.Context, ID uint64) {
 	store.Set(types.KeyLastGaugeID, sdk.Uint64ToBigEndian(ID))
 }
 
+// GetGaugeCreator returns the address that created the given perpetual gauge with MsgCreateGauge and true if found.
+// False otherwise, including for gauges created by other modules.
+func (k Keeper) GetGaugeCreator(ctx sdk.Context, gaugeID uint64) (sdk.AccAddress, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(gaugeCreatorStoreKey(gaugeID))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// setGaugeCreator sets the address that created the given gauge.
+func (k Keeper) setGaugeCreator(ctx sdk.Context, gaugeID uint64, creator sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(gaugeCreatorStoreKey(gaugeID), creator)
+}
+
 // gaugeStoreKey returns the combined byte array (store key) of the provided gauge ID's key prefix and the ID itself.
 func gaugeStoreKey(ID uint64) []byte {
 	return combineKeys(types.KeyPrefixPeriodGauge, sdk.Uint64ToBigEndian(ID))
 }
 
+// gaugeCreatorStoreKey returns the combined byte array (store key) of the provided gauge creator key prefix and the gauge ID.
+func gaugeCreatorStoreKey(ID uint64) []byte {
+	return combineKeys(types.KeyPrefixGaugeCreator, sdk.Uint64ToBigEndian(ID))
+}
+
 // gaugeDenomStoreKey returns the combined byte array (store key) of the provided gauge denom key prefix and the denom itself.
 func gaugeDenomStoreKey(denom string) []byte {
 	return combineKeys(types.KeyPrefixGaugesByDenom, []byte(denom))
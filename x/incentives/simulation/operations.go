@@ -136,8 +136,9 @@ func SimulateMsgCreateGauge(ak stakingTypes.AccountKeeper, bk osmosimtypes.BankK
 		isPerpetual := r.Int()%2 == 0
 		distributeTo := genQueryCondition(r, ctx.BlockTime(), simCoins, types.DefaultGenesis().LockableDurations)
 		rewards := genRewardCoins(r, simCoins, types.CreateGaugeFee)
-		startTimeSecs := r.Intn(1 * 60 * 60 * 24 * 7) // range of 1 week
-		startTime := ctx.BlockTime().Add(time.Duration(startTimeSecs) * time.Second)
+		// gauges starting in the future must start at one of the next distribution epoch boundaries
+		startTimes := k.GetNextGaugeStartTimes(ctx, 7)
+		startTime := startTimes[r.Intn(len(startTimes))]
 		durationSecs := r.Intn(1*60*60*24*7) + 1*60*60*24 // range of 1 week, min 1 day
 		numEpochsPaidOver := uint64(r.Int63n(int64(durationSecs)/(ek.GetEpochInfo(ctx, k.GetParams(ctx).DistrEpochIdentifier).Duration.Milliseconds()/1000))) + 1
 
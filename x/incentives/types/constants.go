@@ -13,4 +13,10 @@ var (
 	// for a gauge to be perpetual. For any other number of epochs
 	// other than zero, the gauge is non-perpetual. Zero is invalid.
 	PerpetualNumEpochsPaidOver = uint64(0)
+
+	// DefaultNumNextGaugeStartTimes is the number of start times returned by the NextGaugeStartTimes query
+	// when the request does not specify one.
+	DefaultNumNextGaugeStartTimes = uint64(5)
+	// MaxNumNextGaugeStartTimes is the maximum number of start times returned by the NextGaugeStartTimes query.
+	MaxNumNextGaugeStartTimes = uint64(100)
 )
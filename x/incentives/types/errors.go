@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"time"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
@@ -103,3 +104,30 @@ type DuplicatePoolIDError struct {
 func (e DuplicatePoolIDError) Error() string {
 	return fmt.Sprintf("one or more pool IDs provided in the pool ID array contains a duplicate: %d", e.PoolIDs)
 }
+
+type UnauthorizedGaugeDurationError struct {
+	Duration          time.Duration
+	LockableDurations []time.Duration
+}
+
+func (e UnauthorizedGaugeDurationError) Error() string {
+	return fmt.Sprintf("gauge duration (%s) is not one of the lockable durations %v", e.Duration, e.LockableDurations)
+}
+
+type MisalignedGaugeStartTimeError struct {
+	StartTime          time.Time
+	NextValidStartTime time.Time
+}
+
+func (e MisalignedGaugeStartTimeError) Error() string {
+	return fmt.Sprintf("gauge start time (%s) is not aligned with the distribution epoch boundaries, the next valid start time is %s", e.StartTime, e.NextValidStartTime)
+}
+
+type InvalidNumGaugeStartTimesError struct {
+	Num uint64
+	Max uint64
+}
+
+func (e InvalidNumGaugeStartTimesError) Error() string {
+	return fmt.Sprintf("number of gauge start times (%d) exceeds the maximum (%d)", e.Num, e.Max)
+}
@@ -7,9 +7,12 @@ const (
 	TypeEvtCreateGroup  = "create_group"
 	TypeEvtDistribution = "distribution"
 
+	TypeEvtUnfilledPerpetualGauge = "unfilled_perpetual_gauge"
+
 	AttributeGaugeID     = "gauge_id"
 	AttributeGroupID     = "group_id"
 	AttributeLockedDenom = "denom"
 	AttributeReceiver    = "receiver"
 	AttributeAmount      = "amount"
+	AttributeRefunded    = "refunded"
 )
@@ -48,6 +48,9 @@ var (
 	// KeyPrefixGroup defines prefix key for storing groups.
 	KeyPrefixGroup = []byte{0x08}
 
+	// KeyPrefixGaugeCreator defines prefix key for storing the creators of perpetual gauges created by MsgCreateGauge.
+	KeyPrefixGaugeCreator = []byte{0x09}
+
 	// LockableDurationsKey defines key for storing valid durations for giving incentives.
 	LockableDurationsKey = []byte("lockable_durations")
 
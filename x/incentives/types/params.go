@@ -13,9 +13,10 @@ import (
 
 // Incentives parameters key store.
 var (
-	KeyDistrEpochIdentifier = []byte("DistrEpochIdentifier")
-	KeyGroupCreationFee     = []byte("GroupCreationFee")
-	KeyCreatorWhitelist     = []byte("CreatorWhitelist")
+	KeyDistrEpochIdentifier          = []byte("DistrEpochIdentifier")
+	KeyGroupCreationFee              = []byte("GroupCreationFee")
+	KeyCreatorWhitelist              = []byte("CreatorWhitelist")
+	KeyRefundUnfilledPerpetualGauges = []byte("RefundUnfilledPerpetualGauges")
 
 	// 100 OSMO
 	DefaultGroupCreationFee = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(100_000_000)))
@@ -77,11 +78,19 @@ func ValidateGroupCreationFee(i interface{}) error {
 	return v.Validate()
 }
 
+func validateRefundUnfilledPerpetualGauges(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 // ParamSetPairs takes the parameter struct and associates the paramsubspace key and field of the parameters as a KVStore.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyDistrEpochIdentifier, &p.DistrEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyGroupCreationFee, &p.GroupCreationFee, ValidateGroupCreaionFee),
 		paramtypes.NewParamSetPair(KeyCreatorWhitelist, &p.UnrestrictedCreatorWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyRefundUnfilledPerpetualGauges, &p.RefundUnfilledPerpetualGauges, validateRefundUnfilledPerpetualGauges),
 	}
 }
@@ -43,6 +43,11 @@ type Params struct {
 	// At the same time, it prevents spam by having a fee for all
 	// other users.
 	UnrestrictedCreatorWhitelist []string `protobuf:"bytes,3,rep,name=unrestricted_creator_whitelist,json=unrestrictedCreatorWhitelist,proto3" json:"unrestricted_creator_whitelist,omitempty" yaml:"unrestricted_creator_whitelist"`
+	// refund_unfilled_perpetual_gauges determines what happens to the coins of
+	// a perpetual gauge created by MsgCreateGauge that has no qualifying locks
+	// at distribution time. If true, the coins are refunded to the gauge
+	// creator. Otherwise, they are rolled over to the next epoch.
+	RefundUnfilledPerpetualGauges bool `protobuf:"varint,4,opt,name=refund_unfilled_perpetual_gauges,json=refundUnfilledPerpetualGauges,proto3" json:"refund_unfilled_perpetual_gauges,omitempty" yaml:"refund_unfilled_perpetual_gauges"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -99,6 +104,13 @@ func (m *Params) GetUnrestrictedCreatorWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetRefundUnfilledPerpetualGauges() bool {
+	if m != nil {
+		return m.RefundUnfilledPerpetualGauges
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.incentives.Params")
 }
@@ -106,30 +118,33 @@ func init() {
 func init() { proto.RegisterFile("osmosis/incentives/params.proto", fileDescriptor_1cc8b460d089f845) }
 
 var fileDescriptor_1cc8b460d089f845 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
-	0x14, 0xc5, 0x93, 0x16, 0x0a, 0xcd, 0xb7, 0xf9, 0x08, 0x45, 0x6a, 0xd1, 0x49, 0x0d, 0x08, 0x71,
-	0xd1, 0x8c, 0xad, 0xe0, 0xc2, 0x65, 0x8a, 0x82, 0xbb, 0xd2, 0x4d, 0xc1, 0x4d, 0xc8, 0x9f, 0xdb,
-	0x74, 0xb0, 0xc9, 0x84, 0x99, 0x69, 0xb5, 0x6f, 0xe1, 0x73, 0xf8, 0x24, 0xdd, 0x08, 0x5d, 0xba,
-	0xaa, 0xd2, 0xbe, 0x41, 0x9f, 0x40, 0x32, 0x93, 0x6a, 0x17, 0xe2, 0x6a, 0x66, 0xee, 0xf9, 0xcd,
-	0xbd, 0xe7, 0x70, 0x0d, 0x8b, 0xf2, 0x94, 0x72, 0xc2, 0x31, 0xc9, 0x22, 0xc8, 0x04, 0x99, 0x03,
-	0xc7, 0x79, 0xc0, 0x82, 0x94, 0xbb, 0x39, 0xa3, 0x82, 0x9a, 0x66, 0x09, 0xb8, 0x3f, 0x40, 0xab,
-	0x91, 0xd0, 0x84, 0x4a, 0x19, 0x17, 0x37, 0x45, 0xb6, 0x50, 0x24, 0x51, 0x1c, 0x06, 0x1c, 0xf0,
-	0xbc, 0x1b, 0x82, 0x08, 0xba, 0x38, 0xa2, 0x24, 0x53, 0xba, 0xfd, 0x56, 0x31, 0x6a, 0x03, 0xd9,
-	0xda, 0x1c, 0x19, 0x47, 0x31, 0xe1, 0x82, 0xf9, 0x90, 0xd3, 0x68, 0xe2, 0x93, 0xb8, 0xe8, 0x3c,
-	0x26, 0xc0, 0x9a, 0x7a, 0x5b, 0x77, 0xea, 0xde, 0xd9, 0x6e, 0x6d, 0x9d, 0x2e, 0x82, 0x74, 0x7a,
-	0x63, 0xff, 0xce, 0xd9, 0xc3, 0x86, 0x14, 0x6e, 0x8b, 0xfa, 0xfd, 0x77, 0xd9, 0x5c, 0x18, 0x66,
-	0xc2, 0xe8, 0x2c, 0xf7, 0x23, 0x06, 0x81, 0x20, 0x34, 0xf3, 0xc7, 0x00, 0xcd, 0x4a, 0xbb, 0xea,
-	0xfc, 0xeb, 0x1d, 0xbb, 0xca, 0xa0, 0x5b, 0x18, 0x74, 0x4b, 0x83, 0x6e, 0x9f, 0x92, 0xcc, 0xbb,
-	0x5c, 0xae, 0x2d, 0xed, 0xf5, 0xc3, 0x72, 0x12, 0x22, 0x26, 0xb3, 0xd0, 0x8d, 0x68, 0x8a, 0xcb,
-	0x34, 0xea, 0xe8, 0xf0, 0xf8, 0x11, 0x8b, 0x45, 0x0e, 0x5c, 0x7e, 0xe0, 0xc3, 0xff, 0x72, 0x4c,
-	0xbf, 0x9c, 0x72, 0x07, 0x60, 0x52, 0x03, 0xcd, 0x32, 0x06, 0x5c, 0x30, 0x12, 0x09, 0x88, 0x95,
-	0x03, 0xca, 0xfc, 0xa7, 0x09, 0x11, 0x30, 0x25, 0x5c, 0x34, 0xab, 0xed, 0xaa, 0x53, 0xf7, 0x2e,
-	0x76, 0x6b, 0xeb, 0x5c, 0x65, 0xfb, 0x9b, 0xb7, 0x87, 0x27, 0x87, 0x40, 0x5f, 0xe9, 0xa3, 0xbd,
-	0xec, 0x0d, 0x96, 0x1b, 0xa4, 0xaf, 0x36, 0x48, 0xff, 0xdc, 0x20, 0xfd, 0x65, 0x8b, 0xb4, 0xd5,
-	0x16, 0x69, 0xef, 0x5b, 0xa4, 0x3d, 0x5c, 0x1f, 0xc4, 0x28, 0xd7, 0xd7, 0x99, 0x06, 0x21, 0xdf,
-	0x3f, 0xf0, 0xbc, 0xd7, 0xc5, 0xcf, 0x87, 0x2b, 0x97, 0xd1, 0xc2, 0x9a, 0x5c, 0xd4, 0xd5, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0xaa, 0xbf, 0x8b, 0x15, 0x02, 0x00, 0x00,
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x85, 0x92, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xad, 0x15, 0xd1, 0x78, 0x91, 0x20, 0x52, 0x45, 0xd3, 0x1a, 0x10, 0x2b, 0x62, 0xd6,
+	0x56, 0xf0, 0xe0, 0x31, 0x45, 0xc5, 0x5b, 0x09, 0x88, 0xe0, 0x25, 0x6c, 0x92, 0x49, 0xba, 0x98,
+	0x66, 0xc3, 0xee, 0xa6, 0xda, 0xb7, 0xf0, 0x39, 0x7c, 0x0d, 0x2f, 0x3d, 0xf6, 0xe8, 0x49, 0x45,
+	0xdf, 0xc0, 0x27, 0x70, 0xbb, 0x9b, 0x6a, 0x0f, 0xa2, 0x87, 0x21, 0xd9, 0xfd, 0xbf, 0x9d, 0xf9,
+	0x67, 0x18, 0xa3, 0x4e, 0x79, 0x9f, 0x72, 0xc2, 0x11, 0xc9, 0x42, 0xc8, 0x04, 0x19, 0x00, 0x47,
+	0x39, 0x66, 0xb8, 0xcf, 0x9d, 0x9c, 0x51, 0x41, 0x4d, 0xb3, 0x04, 0x9c, 0x1f, 0x60, 0x73, 0x2d,
+	0xa1, 0x09, 0x55, 0x32, 0x9a, 0xfc, 0x69, 0x72, 0xd3, 0x0a, 0x15, 0x8a, 0x02, 0xcc, 0x01, 0x0d,
+	0x5a, 0x01, 0x08, 0xdc, 0x42, 0x21, 0x25, 0x99, 0xd6, 0xed, 0xa7, 0xaa, 0xb1, 0xd8, 0x55, 0xa9,
+	0xcd, 0x6b, 0x63, 0x3d, 0x22, 0x5c, 0x30, 0x1f, 0x72, 0x1a, 0xf6, 0x7c, 0x12, 0x4d, 0x32, 0xc7,
+	0x04, 0x58, 0xad, 0xd2, 0xa8, 0x34, 0x97, 0xdd, 0x9d, 0xcf, 0x97, 0xfa, 0xf6, 0x10, 0xf7, 0xd3,
+	0x53, 0xfb, 0x77, 0xce, 0xf6, 0xd6, 0x94, 0x70, 0x36, 0xb9, 0xbf, 0xfc, 0xbe, 0x36, 0x87, 0x86,
+	0x99, 0x30, 0x5a, 0xe4, 0x7e, 0xc8, 0x00, 0x0b, 0x42, 0x33, 0x3f, 0x06, 0xa8, 0xcd, 0x37, 0xaa,
+	0xcd, 0x95, 0xf6, 0x86, 0xa3, 0x0d, 0x3a, 0x13, 0x83, 0x4e, 0x69, 0xd0, 0xe9, 0x48, 0x83, 0xee,
+	0xd1, 0xe8, 0xa5, 0x3e, 0xf7, 0xf8, 0x5a, 0x6f, 0x26, 0x44, 0xf4, 0x8a, 0x40, 0x82, 0x7d, 0x54,
+	0x76, 0xa3, 0x3f, 0x87, 0x3c, 0xba, 0x45, 0x62, 0x98, 0x03, 0x57, 0x0f, 0xb8, 0xb7, 0xaa, 0xca,
+	0x74, 0xca, 0x2a, 0xe7, 0x00, 0x26, 0x35, 0xac, 0x22, 0x63, 0x20, 0x4d, 0x91, 0x50, 0x40, 0xa4,
+	0x1d, 0x50, 0xe6, 0xdf, 0xf5, 0x88, 0x80, 0x54, 0x9a, 0xad, 0x55, 0xa5, 0x8d, 0x65, 0x77, 0x5f,
+	0xf6, 0xb6, 0xab, 0x7b, 0xfb, 0x9b, 0xb7, 0xbd, 0xad, 0x59, 0xa0, 0xa3, 0xf5, 0xeb, 0xa9, 0x6c,
+	0x0a, 0xa3, 0xc1, 0x20, 0x2e, 0xb2, 0xc8, 0x2f, 0xb2, 0x98, 0xa4, 0xa9, 0xcc, 0x91, 0x03, 0xcb,
+	0x41, 0x14, 0x38, 0xf5, 0x13, 0x5c, 0x24, 0xc0, 0x6b, 0x0b, 0x72, 0x9c, 0x4b, 0xee, 0x81, 0x2c,
+	0xb9, 0xa7, 0x4b, 0xfe, 0xf7, 0xc2, 0xf6, 0xb6, 0x35, 0x72, 0x55, 0x12, 0xdd, 0x29, 0x70, 0xa1,
+	0x74, 0xb7, 0x3b, 0x7a, 0xb7, 0x2a, 0x63, 0x19, 0x6f, 0x32, 0x1e, 0x3e, 0xac, 0xb9, 0xb1, 0x8c,
+	0x67, 0x19, 0x37, 0x27, 0x33, 0xc3, 0x2b, 0x97, 0xe6, 0x30, 0xc5, 0x01, 0x9f, 0x1e, 0xd0, 0xa0,
+	0xdd, 0x42, 0xf7, 0xb3, 0x8b, 0xa6, 0x06, 0x1a, 0x2c, 0xaa, 0xf5, 0x38, 0xfe, 0x02, 0x9c, 0x28,
+	0x5a, 0x82, 0x8b, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -152,6 +167,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RefundUnfilledPerpetualGauges {
+		i--
+		if m.RefundUnfilledPerpetualGauges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.UnrestrictedCreatorWhitelist) > 0 {
 		for iNdEx := len(m.UnrestrictedCreatorWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.UnrestrictedCreatorWhitelist[iNdEx])
@@ -218,6 +243,9 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.RefundUnfilledPerpetualGauges {
+		n += 2
+	}
 	return n
 }
 
@@ -354,6 +382,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.UnrestrictedCreatorWhitelist = append(m.UnrestrictedCreatorWhitelist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundUnfilledPerpetualGauges", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RefundUnfilledPerpetualGauges = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
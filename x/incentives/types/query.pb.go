@@ -20,6 +20,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -1378,6 +1379,338 @@ func (m *GaugeWeight) GetGaugeId() uint64 {
 	return 0
 }
 
+type QueryGaugeDistributionProjectionRequest struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *QueryGaugeDistributionProjectionRequest) Reset() {
+	*m = QueryGaugeDistributionProjectionRequest{}
+}
+func (m *QueryGaugeDistributionProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeDistributionProjectionRequest) ProtoMessage()    {}
+func (*QueryGaugeDistributionProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{29}
+}
+func (m *QueryGaugeDistributionProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeDistributionProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeDistributionProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeDistributionProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeDistributionProjectionRequest.Merge(m, src)
+}
+func (m *QueryGaugeDistributionProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeDistributionProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeDistributionProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeDistributionProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryGaugeDistributionProjectionRequest) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type QueryGaugeDistributionProjectionResponse struct {
+	// Coins expected to be distributed by the gauge at the next epoch
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// Projected rewards of each lock, for gauges distributing to locks
+	Locks []LockDistributionProjection `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+	// Concentrated liquidity pool receiving the rewards, for NoLock gauges
+	PoolId uint64 `protobuf:"varint,3,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// Projected rewards of each position in range at the current tick of the
+	// pool, for NoLock gauges
+	Positions []PositionDistributionProjection `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions"`
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) Reset() {
+	*m = QueryGaugeDistributionProjectionResponse{}
+}
+func (m *QueryGaugeDistributionProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGaugeDistributionProjectionResponse) ProtoMessage()    {}
+func (*QueryGaugeDistributionProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{30}
+}
+func (m *QueryGaugeDistributionProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGaugeDistributionProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGaugeDistributionProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGaugeDistributionProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGaugeDistributionProjectionResponse.Merge(m, src)
+}
+func (m *QueryGaugeDistributionProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGaugeDistributionProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGaugeDistributionProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGaugeDistributionProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryGaugeDistributionProjectionResponse) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) GetLocks() []LockDistributionProjection {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) GetPositions() []PositionDistributionProjection {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+type LockDistributionProjection struct {
+	LockId         uint64                                   `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	RewardReceiver string                                   `protobuf:"bytes,2,opt,name=reward_receiver,json=rewardReceiver,proto3" json:"reward_receiver,omitempty"`
+	Coins          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *LockDistributionProjection) Reset()         { *m = LockDistributionProjection{} }
+func (m *LockDistributionProjection) String() string { return proto.CompactTextString(m) }
+func (*LockDistributionProjection) ProtoMessage()    {}
+func (*LockDistributionProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{31}
+}
+func (m *LockDistributionProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockDistributionProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockDistributionProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockDistributionProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockDistributionProjection.Merge(m, src)
+}
+func (m *LockDistributionProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockDistributionProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockDistributionProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockDistributionProjection proto.InternalMessageInfo
+
+func (m *LockDistributionProjection) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockDistributionProjection) GetRewardReceiver() string {
+	if m != nil {
+		return m.RewardReceiver
+	}
+	return ""
+}
+
+func (m *LockDistributionProjection) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type PositionDistributionProjection struct {
+	PositionId uint64                                   `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Owner      string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Coins      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *PositionDistributionProjection) Reset()         { *m = PositionDistributionProjection{} }
+func (m *PositionDistributionProjection) String() string { return proto.CompactTextString(m) }
+func (*PositionDistributionProjection) ProtoMessage()    {}
+func (*PositionDistributionProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{32}
+}
+func (m *PositionDistributionProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PositionDistributionProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PositionDistributionProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PositionDistributionProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionDistributionProjection.Merge(m, src)
+}
+func (m *PositionDistributionProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *PositionDistributionProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionDistributionProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PositionDistributionProjection proto.InternalMessageInfo
+
+func (m *PositionDistributionProjection) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *PositionDistributionProjection) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PositionDistributionProjection) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+type QueryNextGaugeStartTimesRequest struct {
+	// Number of start times to return. Defaults to 5 if zero, at most 100
+	Num uint64 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+}
+
+func (m *QueryNextGaugeStartTimesRequest) Reset()         { *m = QueryNextGaugeStartTimesRequest{} }
+func (m *QueryNextGaugeStartTimesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextGaugeStartTimesRequest) ProtoMessage()    {}
+func (*QueryNextGaugeStartTimesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{33}
+}
+func (m *QueryNextGaugeStartTimesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextGaugeStartTimesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextGaugeStartTimesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextGaugeStartTimesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextGaugeStartTimesRequest.Merge(m, src)
+}
+func (m *QueryNextGaugeStartTimesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextGaugeStartTimesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextGaugeStartTimesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextGaugeStartTimesRequest proto.InternalMessageInfo
+
+func (m *QueryNextGaugeStartTimesRequest) GetNum() uint64 {
+	if m != nil {
+		return m.Num
+	}
+	return 0
+}
+
+type QueryNextGaugeStartTimesResponse struct {
+	// Upcoming distribution epoch boundaries that external gauges can start at,
+	// in ascending order
+	StartTimes []time.Time `protobuf:"bytes,1,rep,name=start_times,json=startTimes,proto3,stdtime" json:"start_times" yaml:"start_times"`
+}
+
+func (m *QueryNextGaugeStartTimesResponse) Reset()         { *m = QueryNextGaugeStartTimesResponse{} }
+func (m *QueryNextGaugeStartTimesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextGaugeStartTimesResponse) ProtoMessage()    {}
+func (*QueryNextGaugeStartTimesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{34}
+}
+func (m *QueryNextGaugeStartTimesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextGaugeStartTimesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextGaugeStartTimesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextGaugeStartTimesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextGaugeStartTimesResponse.Merge(m, src)
+}
+func (m *QueryNextGaugeStartTimesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextGaugeStartTimesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextGaugeStartTimesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextGaugeStartTimesResponse proto.InternalMessageInfo
+
+func (m *QueryNextGaugeStartTimesResponse) GetStartTimes() []time.Time {
+	if m != nil {
+		return m.StartTimes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -1408,105 +1741,130 @@ func init() {
 	proto.RegisterType((*QueryCurrentWeightByGroupGaugeIDRequest)(nil), "osmosis.incentives.QueryCurrentWeightByGroupGaugeIDRequest")
 	proto.RegisterType((*QueryCurrentWeightByGroupGaugeIDResponse)(nil), "osmosis.incentives.QueryCurrentWeightByGroupGaugeIDResponse")
 	proto.RegisterType((*GaugeWeight)(nil), "osmosis.incentives.GaugeWeight")
+	proto.RegisterType((*QueryGaugeDistributionProjectionRequest)(nil), "osmosis.incentives.QueryGaugeDistributionProjectionRequest")
+	proto.RegisterType((*QueryGaugeDistributionProjectionResponse)(nil), "osmosis.incentives.QueryGaugeDistributionProjectionResponse")
+	proto.RegisterType((*LockDistributionProjection)(nil), "osmosis.incentives.LockDistributionProjection")
+	proto.RegisterType((*PositionDistributionProjection)(nil), "osmosis.incentives.PositionDistributionProjection")
+	proto.RegisterType((*QueryNextGaugeStartTimesRequest)(nil), "osmosis.incentives.QueryNextGaugeStartTimesRequest")
+	proto.RegisterType((*QueryNextGaugeStartTimesResponse)(nil), "osmosis.incentives.QueryNextGaugeStartTimesResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcd, 0x6f, 0xd4, 0xc6,
-	0x1b, 0xc7, 0x33, 0x79, 0x01, 0xf2, 0x24, 0xbf, 0x40, 0x26, 0xbc, 0x24, 0x1b, 0xd8, 0xcd, 0x6f,
-	0x0a, 0x61, 0x81, 0xc6, 0x66, 0x13, 0x02, 0x14, 0xda, 0xaa, 0x2c, 0xa1, 0x14, 0x09, 0x04, 0xac,
-	0x8a, 0xa2, 0x56, 0x42, 0x96, 0x77, 0x3d, 0x75, 0xac, 0xec, 0x7a, 0x96, 0xb5, 0x4d, 0x58, 0x45,
-	0xb9, 0x54, 0x95, 0x7a, 0xa8, 0x84, 0xfa, 0x82, 0xaa, 0x1e, 0xf8, 0x0b, 0xda, 0x5b, 0x2b, 0x55,
-	0x3d, 0xf5, 0xd0, 0x13, 0x47, 0xa4, 0x5e, 0xaa, 0x1e, 0x42, 0x05, 0xbd, 0x57, 0xe2, 0x2f, 0xa8,
-	0x3c, 0x33, 0xde, 0xb5, 0x77, 0x6d, 0x6f, 0x82, 0x0a, 0xe2, 0x94, 0xcc, 0x3e, 0x6f, 0x9f, 0xe7,
-	0xf1, 0x8c, 0xe7, 0x6b, 0xc8, 0x32, 0xa7, 0xc6, 0x1c, 0xcb, 0x51, 0x2d, 0xbb, 0x42, 0x6d, 0xd7,
-	0xba, 0x4b, 0x1d, 0xf5, 0x8e, 0x47, 0x1b, 0x4d, 0xa5, 0xde, 0x60, 0x2e, 0xc3, 0x58, 0xda, 0x95,
-	0xb6, 0x3d, 0xb3, 0xd7, 0x64, 0x26, 0xe3, 0x66, 0xd5, 0xff, 0x4f, 0x78, 0x66, 0x0e, 0x9a, 0x8c,
-	0x99, 0x55, 0xaa, 0xea, 0x75, 0x4b, 0xd5, 0x6d, 0x9b, 0xb9, 0xba, 0x6b, 0x31, 0xdb, 0x91, 0xd6,
-	0xac, 0xb4, 0xf2, 0x55, 0xd9, 0xfb, 0x44, 0x35, 0xbc, 0x06, 0x77, 0x08, 0xec, 0x15, 0x5e, 0x48,
-	0x2d, 0xeb, 0x0e, 0x55, 0xef, 0x16, 0xca, 0xd4, 0xd5, 0x0b, 0x6a, 0x85, 0x59, 0x81, 0xfd, 0x78,
-	0xd8, 0xce, 0x01, 0x5b, 0x5e, 0x75, 0xdd, 0xb4, 0xec, 0x48, 0xae, 0x98, 0x9e, 0x4c, 0xdd, 0x33,
-	0xa9, 0xb4, 0x4f, 0x05, 0xf6, 0x2a, 0xab, 0xac, 0x7a, 0x75, 0xfe, 0x27, 0x2d, 0xb4, 0xc1, 0xbc,
-	0xba, 0xb0, 0x93, 0x19, 0xc8, 0x5e, 0x63, 0x86, 0x57, 0xa5, 0x1f, 0xb2, 0x25, 0xcb, 0x71, 0x1b,
-	0x56, 0xd9, 0x73, 0xe9, 0x45, 0x66, 0xd9, 0x4e, 0x89, 0xde, 0xf1, 0xa8, 0xe3, 0x92, 0xcf, 0x10,
-	0xe4, 0x12, 0x5d, 0x9c, 0x3a, 0xb3, 0x1d, 0x8a, 0x75, 0x18, 0xf2, 0x5b, 0x73, 0x26, 0xd1, 0xcc,
-	0x40, 0x7e, 0x64, 0x7e, 0x4a, 0x11, 0xcd, 0x29, 0x7e, 0x73, 0x8a, 0x6c, 0x4b, 0xf1, 0x43, 0x8a,
-	0x27, 0x1f, 0x6d, 0xe6, 0xfa, 0xbe, 0x7f, 0x92, 0xcb, 0x9b, 0x96, 0xbb, 0xe2, 0x95, 0x95, 0x0a,
-	0xab, 0xa9, 0x72, 0x12, 0xe2, 0xcf, 0x9c, 0x63, 0xac, 0xaa, 0x6e, 0xb3, 0x4e, 0x1d, 0x45, 0xd4,
-	0x10, 0x99, 0x09, 0x81, 0x3d, 0x97, 0xfd, 0x96, 0x8b, 0xcd, 0x2b, 0x4b, 0x12, 0x0d, 0x8f, 0x41,
-	0xbf, 0x65, 0x4c, 0xa2, 0x19, 0x94, 0x1f, 0x2c, 0xf5, 0x5b, 0x06, 0x59, 0x82, 0xf1, 0x90, 0x8f,
-	0x64, 0x53, 0x61, 0x88, 0xcf, 0x8a, 0xfb, 0xf9, 0x6c, 0xdd, 0x1b, 0x40, 0xe1, 0x51, 0x25, 0xe1,
-	0x47, 0x96, 0xe1, 0x7f, 0x7c, 0x1d, 0x4c, 0x00, 0xbf, 0x0f, 0xd0, 0x7e, 0x24, 0x32, 0xcd, 0x6c,
-	0xa4, 0x45, 0xb1, 0xc1, 0x82, 0x46, 0x6f, 0xe8, 0x26, 0x95, 0xb1, 0xa5, 0x50, 0x24, 0xb9, 0x8f,
-	0x60, 0x2c, 0xc8, 0x2c, 0xe1, 0x16, 0x60, 0xd0, 0xd0, 0x5d, 0xbd, 0x35, 0xb7, 0x24, 0xb6, 0xe2,
-	0xa0, 0x3f, 0xb7, 0x12, 0x77, 0xc6, 0x97, 0x23, 0x3c, 0xfd, 0x9c, 0xe7, 0x68, 0x4f, 0x1e, 0x51,
-	0x31, 0x02, 0x74, 0x1b, 0x26, 0x2e, 0x54, 0xfc, 0x2a, 0x2f, 0xa7, 0xdf, 0x07, 0x08, 0xf6, 0x46,
-	0xf3, 0xbf, 0x16, 0x5d, 0xaf, 0xc3, 0x74, 0x98, 0xea, 0x06, 0x6d, 0x2c, 0x51, 0x9b, 0xd5, 0x82,
-	0xee, 0xf7, 0xc2, 0x90, 0xe1, 0xaf, 0x79, 0xe3, 0xc3, 0x25, 0xb1, 0xe8, 0x98, 0x49, 0xff, 0x0b,
-	0xcf, 0xe4, 0x21, 0x82, 0x83, 0xf1, 0xd5, 0x5f, 0x8b, 0xd9, 0x68, 0xb0, 0xef, 0x56, 0xbd, 0xc2,
-	0x6a, 0x96, 0x6d, 0xbe, 0x9c, 0x3d, 0xf1, 0x2d, 0x82, 0xfd, 0x9d, 0x15, 0x5e, 0x8b, 0xce, 0x37,
-	0xe0, 0x50, 0x94, 0xeb, 0xd5, 0xee, 0x8b, 0x9f, 0x10, 0x64, 0x93, 0xea, 0xcb, 0xf9, 0x7c, 0x00,
-	0xbb, 0x3d, 0xe9, 0xa1, 0xf1, 0x37, 0x95, 0xb3, 0xd5, 0x51, 0x8d, 0x79, 0x91, 0xcc, 0xff, 0xdd,
-	0xd0, 0x1c, 0x18, 0x2f, 0xd1, 0x35, 0xbd, 0x61, 0x38, 0x97, 0x1c, 0x37, 0x18, 0xd4, 0x2c, 0x0c,
-	0xb1, 0x35, 0x9b, 0x36, 0xc4, 0xa0, 0x8a, 0x7b, 0x9e, 0x6f, 0xe6, 0x46, 0x9b, 0x7a, 0xad, 0x7a,
-	0x8e, 0xf0, 0x9f, 0x49, 0x49, 0x98, 0xf1, 0x14, 0xec, 0xf2, 0x2f, 0x2a, 0xcd, 0x32, 0x9c, 0xc9,
-	0xfe, 0x99, 0x81, 0xfc, 0x60, 0x69, 0xa7, 0xbf, 0xbe, 0x62, 0x38, 0x78, 0x1a, 0x86, 0xa9, 0x6d,
-	0x68, 0xb4, 0xce, 0x2a, 0x2b, 0x93, 0x03, 0x33, 0x28, 0x3f, 0x50, 0xda, 0x45, 0x6d, 0xe3, 0x92,
-	0xbf, 0x26, 0x6b, 0x80, 0xc3, 0x45, 0x5f, 0xdd, 0x15, 0x94, 0x83, 0x43, 0x37, 0xfd, 0xb9, 0x5c,
-	0x65, 0x95, 0x55, 0xbd, 0x5c, 0xa5, 0x4b, 0xf2, 0xc6, 0x6f, 0x5d, 0x95, 0x5f, 0x21, 0xc8, 0x26,
-	0x79, 0x48, 0x4c, 0x06, 0xb8, 0x2a, 0x8d, 0x5a, 0xa0, 0x18, 0xda, 0xcc, 0x42, 0x53, 0x28, 0x81,
-	0xa6, 0x50, 0x82, 0xf8, 0xe2, 0x11, 0x9f, 0xf9, 0xf9, 0x66, 0x6e, 0x4a, 0x0c, 0xb2, 0x3b, 0x05,
-	0xf9, 0xee, 0x49, 0x0e, 0x95, 0xc6, 0xab, 0x9d, 0x85, 0xc9, 0x01, 0xd8, 0xc7, 0x91, 0x2e, 0x54,
-	0xab, 0x97, 0xfd, 0x7b, 0xbf, 0x05, 0x7b, 0x13, 0xf6, 0x77, 0x1a, 0x24, 0xe3, 0x19, 0xd8, 0xc1,
-	0x25, 0x42, 0xfa, 0xfe, 0xf2, 0x3d, 0xe4, 0xfe, 0x92, 0xee, 0xe4, 0x10, 0x4c, 0x47, 0x53, 0x46,
-	0xde, 0x21, 0x64, 0x19, 0x0e, 0xc6, 0x9b, 0x43, 0x75, 0xb7, 0xb5, 0xaf, 0xa5, 0xbb, 0x2f, 0x62,
-	0xa2, 0x89, 0x97, 0x2d, 0x77, 0x45, 0xdc, 0xe9, 0xb2, 0xf4, 0x3d, 0xc8, 0x25, 0x7a, 0xc8, 0xea,
-	0xb7, 0x60, 0x5c, 0xb4, 0xa1, 0xad, 0x59, 0xee, 0x8a, 0x16, 0x68, 0x06, 0x1f, 0xe4, 0x8d, 0xc4,
-	0x01, 0xb4, 0xf3, 0x48, 0xa4, 0xdd, 0x66, 0xf4, 0x67, 0x52, 0x90, 0x95, 0xc5, 0xbc, 0xc4, 0x1f,
-	0x6e, 0x49, 0x96, 0x31, 0x1f, 0xc1, 0x4c, 0x72, 0x88, 0xa4, 0x5d, 0x84, 0x21, 0x5e, 0x29, 0x55,
-	0xd5, 0x84, 0x1e, 0x91, 0xf0, 0x26, 0xd7, 0xe1, 0x28, 0x4f, 0x7d, 0xd1, 0x6b, 0x34, 0xa8, 0xed,
-	0x2e, 0x53, 0xcb, 0x5c, 0x71, 0xe3, 0xa9, 0x0e, 0xc3, 0x18, 0x8f, 0x11, 0x93, 0xd0, 0x5a, 0x84,
-	0xa3, 0x66, 0xdb, 0xd9, 0x20, 0x2e, 0xe4, 0x7b, 0x27, 0x6c, 0xbd, 0xc0, 0x46, 0x45, 0xae, 0x35,
-	0xee, 0x25, 0x87, 0x9b, 0x4b, 0x7c, 0xca, 0x32, 0x99, 0x68, 0x60, 0xc4, 0x6c, 0xff, 0x44, 0x3e,
-	0x47, 0x30, 0x12, 0x72, 0xf1, 0x5f, 0x25, 0x1d, 0x94, 0x3b, 0x4d, 0x01, 0x88, 0x6f, 0xc3, 0xa8,
-	0x28, 0xa7, 0xf1, 0x13, 0xc1, 0xdf, 0x76, 0xc3, 0xc5, 0x73, 0x7e, 0xce, 0x3f, 0x37, 0x73, 0xd3,
-	0xe2, 0xc4, 0x3b, 0xc6, 0xaa, 0x62, 0x31, 0xb5, 0xa6, 0xbb, 0x2b, 0xca, 0x55, 0x6a, 0xea, 0x95,
-	0xe6, 0x12, 0xad, 0x3c, 0xdf, 0xcc, 0x4d, 0x88, 0xe3, 0x16, 0x4e, 0x40, 0x4a, 0x23, 0x62, 0x59,
-	0xf2, 0x57, 0xf3, 0x5f, 0x4c, 0xc0, 0x10, 0x1f, 0x00, 0xfe, 0x0d, 0xc1, 0x81, 0x04, 0x9d, 0x8c,
-	0xe7, 0xe3, 0x7a, 0x4c, 0xd7, 0xdd, 0x99, 0x85, 0x6d, 0xc5, 0x88, 0x11, 0x93, 0x77, 0x3f, 0xfd,
-	0xfd, 0xef, 0x6f, 0xfa, 0xcf, 0xe2, 0xd3, 0x6a, 0x8c, 0xee, 0x0f, 0xbe, 0x2f, 0x6a, 0x3c, 0x89,
-	0xe6, 0x32, 0xcd, 0x68, 0xa5, 0xd1, 0xf8, 0x2b, 0x0e, 0xdf, 0x47, 0x30, 0xdc, 0x92, 0xd0, 0xf8,
-	0x70, 0xf2, 0x01, 0x6c, 0xab, 0xf0, 0xcc, 0x91, 0x1e, 0x5e, 0x12, 0xed, 0x14, 0x47, 0x53, 0xf0,
-	0x9b, 0x69, 0x68, 0xe2, 0x29, 0x96, 0x9b, 0x9a, 0x65, 0xa8, 0xeb, 0x96, 0xb1, 0x81, 0xd7, 0x61,
-	0x87, 0xbc, 0xb4, 0xfe, 0x9f, 0x58, 0xa6, 0x35, 0x32, 0x92, 0xe6, 0x22, 0x31, 0x8e, 0x73, 0x8c,
-	0xc3, 0x98, 0xf4, 0xc4, 0x70, 0xf0, 0x03, 0x04, 0xa3, 0x61, 0xb1, 0x86, 0x8f, 0xc6, 0x15, 0x88,
-	0x91, 0xd0, 0x99, 0x7c, 0x6f, 0x47, 0xc9, 0x53, 0xe0, 0x3c, 0x27, 0xf0, 0xb1, 0x34, 0x1e, 0x9d,
-	0x47, 0xca, 0x5b, 0x1f, 0xff, 0xdc, 0xa1, 0xab, 0x03, 0xa5, 0x80, 0xd5, 0x5e, 0x55, 0x3b, 0x34,
-	0x4d, 0xe6, 0xe4, 0xd6, 0x03, 0x24, 0xee, 0x79, 0x8e, 0xbb, 0x88, 0x17, 0xb6, 0x8c, 0xab, 0xd5,
-	0x69, 0x43, 0x13, 0x62, 0xe9, 0x21, 0x82, 0xb1, 0xa8, 0xc8, 0xc1, 0xc7, 0xe2, 0x08, 0x62, 0x25,
-	0x68, 0xe6, 0xf8, 0x56, 0x5c, 0x25, 0xe6, 0x02, 0xc7, 0x9c, 0xc3, 0x27, 0xd2, 0x30, 0x3b, 0xd4,
-	0x14, 0xfe, 0xb5, 0x4b, 0x9b, 0xb6, 0x26, 0x5b, 0xe8, 0x5d, 0xbb, 0x73, 0xb6, 0xf3, 0xdb, 0x09,
-	0x91, 0xd8, 0xef, 0x70, 0xec, 0x33, 0x78, 0x71, 0x1b, 0xd8, 0xa1, 0xf9, 0x3e, 0x40, 0x00, 0x6d,
-	0x69, 0x84, 0x63, 0x0f, 0x66, 0x97, 0x5e, 0xcb, 0xcc, 0xf6, 0x72, 0x93, 0x70, 0x67, 0x38, 0x5c,
-	0x01, 0xab, 0x69, 0x70, 0x0d, 0x11, 0xa7, 0x51, 0xc7, 0x55, 0xd7, 0xb9, 0xce, 0xdb, 0xc0, 0x3f,
-	0x22, 0x18, 0xef, 0x52, 0x44, 0xf1, 0x23, 0x4d, 0xd5, 0x57, 0xf1, 0x23, 0x4d, 0x17, 0x5c, 0xe4,
-	0x34, 0xa7, 0x3e, 0x89, 0x95, 0x34, 0xea, 0x6e, 0x3d, 0x85, 0xbf, 0x46, 0x30, 0xdc, 0x52, 0x0b,
-	0xf1, 0xdb, 0x34, 0x56, 0x57, 0xc5, 0x6f, 0xd3, 0x78, 0xa5, 0x45, 0x14, 0x0e, 0x97, 0xc7, 0xb3,
-	0xa9, 0xa7, 0xa9, 0x5a, 0xd5, 0x84, 0xaa, 0xc0, 0x3f, 0x20, 0xd8, 0xdd, 0xa1, 0x9e, 0xe2, 0x0f,
-	0x7d, 0x8a, 0x0c, 0x8b, 0x3f, 0xf4, 0x69, 0xc2, 0x8c, 0x2c, 0x72, 0x4c, 0x15, 0xcf, 0x6d, 0x0d,
-	0x33, 0x38, 0x4f, 0xbf, 0x20, 0xc0, 0xdd, 0x82, 0x0b, 0xcf, 0xf7, 0xae, 0xdf, 0xa9, 0xdf, 0xe2,
-	0x2f, 0xc3, 0x1e, 0x8a, 0x8e, 0xbc, 0xc5, 0xb1, 0x17, 0x70, 0x61, 0x8b, 0xd8, 0x6d, 0xdd, 0xe7,
-	0x5f, 0xe6, 0x13, 0x31, 0xf2, 0x0b, 0x27, 0x73, 0x24, 0xeb, 0xbb, 0xcc, 0xa9, 0xed, 0x05, 0x49,
-	0xfa, 0xf7, 0x38, 0xfd, 0x39, 0x7c, 0x36, 0xf5, 0xa2, 0xe2, 0x0a, 0xad, 0xdc, 0xd4, 0xa2, 0x52,
-	0x4d, 0xdc, 0x9d, 0xff, 0x20, 0x98, 0x4e, 0xd1, 0x65, 0xf8, 0x7c, 0x22, 0x57, 0x6f, 0x79, 0x98,
-	0x79, 0xfb, 0xc5, 0x82, 0x65, 0x73, 0xb7, 0x78, 0x73, 0xd7, 0xf1, 0xb5, 0xb4, 0xe6, 0x2a, 0x22,
-	0x91, 0x94, 0x8b, 0x71, 0x5d, 0x46, 0xd7, 0x1b, 0xc5, 0x1b, 0x8f, 0x9e, 0x66, 0xd1, 0xe3, 0xa7,
-	0x59, 0xf4, 0xd7, 0xd3, 0x2c, 0xfa, 0xf2, 0x59, 0xb6, 0xef, 0xf1, 0xb3, 0x6c, 0xdf, 0x1f, 0xcf,
-	0xb2, 0x7d, 0x1f, 0x9f, 0x0e, 0x7d, 0xec, 0xc9, 0x92, 0x73, 0x55, 0xbd, 0xec, 0xb4, 0xea, 0xdf,
-	0x9d, 0x2f, 0xa8, 0xf7, 0xc2, 0x14, 0xfc, 0x03, 0xb0, 0xbc, 0x83, 0x7f, 0x8b, 0x2d, 0xfc, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0xea, 0x08, 0x19, 0x57, 0x16, 0x00, 0x00,
+	// 1787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0x67, 0xe3, 0x24, 0x6d, 0x5e, 0x42, 0xd2, 0x4c, 0xd3, 0x8f, 0x38, 0xad, 0x5d, 0x86, 0x36,
+	0x49, 0x5b, 0xb2, 0x5b, 0x3b, 0x4d, 0x5b, 0x52, 0x40, 0xd4, 0x75, 0x5b, 0x8a, 0x5a, 0x9a, 0x9a,
+	0x96, 0x0a, 0x50, 0xb5, 0x5a, 0xdb, 0xcb, 0x66, 0xa9, 0xed, 0x35, 0xbb, 0xeb, 0xa6, 0x51, 0x14,
+	0x21, 0x21, 0x24, 0x6e, 0xa8, 0x7c, 0x08, 0x71, 0xe0, 0x2f, 0x80, 0x1b, 0x95, 0x10, 0x42, 0x08,
+	0x09, 0x4e, 0x15, 0xa7, 0x4a, 0x5c, 0x10, 0x87, 0x16, 0x01, 0xe2, 0x8a, 0xc4, 0x5f, 0xc0, 0x7c,
+	0xed, 0x7a, 0x6d, 0xef, 0xae, 0x9d, 0x8a, 0x46, 0x3d, 0x58, 0xf6, 0xcc, 0xbc, 0x79, 0xef, 0xf7,
+	0xde, 0xbc, 0x99, 0xf7, 0x7e, 0x86, 0x94, 0xe5, 0x54, 0x2d, 0xc7, 0x74, 0x14, 0xb3, 0x56, 0xd2,
+	0x6b, 0xae, 0x79, 0x53, 0x77, 0x94, 0x77, 0x1a, 0xba, 0xbd, 0x2a, 0xd7, 0x6d, 0xcb, 0xb5, 0x10,
+	0x12, 0xeb, 0x72, 0x73, 0x3d, 0x39, 0x61, 0x58, 0x86, 0xc5, 0x96, 0x15, 0xfa, 0x8b, 0x4b, 0x26,
+	0xf7, 0x18, 0x96, 0x65, 0x54, 0x74, 0x45, 0xab, 0x9b, 0x8a, 0x56, 0xab, 0x59, 0xae, 0xe6, 0x9a,
+	0x56, 0xcd, 0x11, 0xab, 0x29, 0xb1, 0xca, 0x46, 0xc5, 0xc6, 0x5b, 0x4a, 0xb9, 0x61, 0x33, 0x01,
+	0x6f, 0xbd, 0xc4, 0x0c, 0x29, 0x45, 0xcd, 0xd1, 0x95, 0x9b, 0x99, 0xa2, 0xee, 0x6a, 0x19, 0xa5,
+	0x64, 0x99, 0xde, 0xfa, 0xa1, 0xe0, 0x3a, 0x03, 0xe8, 0x4b, 0xd5, 0x35, 0xc3, 0xac, 0xb5, 0xe8,
+	0x0a, 0xf1, 0xc9, 0xd0, 0x1a, 0x86, 0x2e, 0xd6, 0x27, 0xbd, 0xf5, 0x8a, 0x55, 0xba, 0xd1, 0xa8,
+	0xb3, 0xaf, 0xb8, 0xad, 0xb6, 0xd5, 0xa8, 0x8b, 0xf5, 0x74, 0xbb, 0x1b, 0xae, 0x59, 0xd5, 0x1d,
+	0x57, 0xab, 0x0a, 0x01, 0xbc, 0x0f, 0x52, 0x17, 0xad, 0x72, 0xa3, 0xa2, 0x5f, 0xb1, 0xf2, 0xa6,
+	0xe3, 0xda, 0x66, 0xb1, 0xe1, 0xea, 0xa7, 0x89, 0x1f, 0x4e, 0x41, 0x27, 0xb0, 0x1d, 0x17, 0xbf,
+	0x2f, 0x41, 0x3a, 0x52, 0xc4, 0xa9, 0x93, 0x90, 0xe9, 0x48, 0x83, 0x01, 0xea, 0xbb, 0xb3, 0x5b,
+	0xda, 0x97, 0x98, 0x1d, 0xce, 0x4e, 0xca, 0xdc, 0x7b, 0x99, 0x7a, 0x2f, 0x0b, 0xbf, 0x65, 0xba,
+	0x25, 0x77, 0xe4, 0xee, 0xfd, 0xf4, 0x13, 0x5f, 0x3e, 0x48, 0xcf, 0x1a, 0xa6, 0xbb, 0xdc, 0x28,
+	0x12, 0xc1, 0xaa, 0x22, 0x42, 0xc5, 0xbf, 0xe6, 0x9c, 0xf2, 0x0d, 0xc5, 0x5d, 0xad, 0xeb, 0x8e,
+	0xcc, 0x6d, 0x70, 0xcd, 0x18, 0xc3, 0xb6, 0x73, 0x34, 0x26, 0xb9, 0xd5, 0xf3, 0x79, 0x01, 0x0d,
+	0x8d, 0x42, 0x9f, 0x59, 0x26, 0x36, 0xa5, 0xd9, 0xfe, 0x02, 0xf9, 0x85, 0xf3, 0x30, 0x1e, 0x90,
+	0x11, 0xd8, 0x14, 0x18, 0x60, 0xc1, 0x64, 0x72, 0x14, 0x5b, 0x67, 0x86, 0xc8, 0x6c, 0x57, 0x81,
+	0xcb, 0xe1, 0x6b, 0xf0, 0x24, 0x1b, 0x7b, 0x11, 0x40, 0x67, 0x01, 0x9a, 0x67, 0x26, 0xd4, 0x4c,
+	0xb7, 0xb8, 0xc8, 0x33, 0xd0, 0x73, 0x74, 0x49, 0x23, 0xca, 0xf8, 0xde, 0x42, 0x60, 0x27, 0xfe,
+	0x50, 0x82, 0x51, 0x4f, 0xb3, 0x00, 0x37, 0x0f, 0xfd, 0x65, 0xcd, 0xd5, 0xfc, 0xb8, 0x45, 0x61,
+	0xcb, 0xf5, 0xd3, 0xb8, 0x15, 0x98, 0x30, 0x3a, 0xd7, 0x82, 0xa7, 0x8f, 0xe1, 0x99, 0xe9, 0x8a,
+	0x87, 0x5b, 0x6c, 0x01, 0x74, 0x1d, 0xb6, 0x9f, 0x2a, 0x51, 0x2b, 0x8f, 0xc6, 0xdf, 0x4f, 0x25,
+	0x98, 0x68, 0xd5, 0xff, 0x58, 0x78, 0xbd, 0x06, 0x53, 0x41, 0x54, 0x4b, 0xba, 0x9d, 0xd7, 0x6b,
+	0x56, 0xd5, 0xf3, 0x7e, 0x02, 0x06, 0xca, 0x74, 0xcc, 0x1c, 0x1f, 0x2a, 0xf0, 0x41, 0x5b, 0x4c,
+	0xfa, 0x1e, 0x3a, 0x26, 0x5f, 0x48, 0xb0, 0x27, 0xdc, 0xfa, 0x63, 0x11, 0x1b, 0x15, 0x76, 0x5c,
+	0xad, 0x93, 0x3b, 0x69, 0xd6, 0x8c, 0x47, 0x93, 0x13, 0x9f, 0x49, 0xb0, 0xb3, 0xdd, 0xc2, 0x63,
+	0xe1, 0xf9, 0x3a, 0xec, 0x6d, 0xc5, 0xb5, 0xb9, 0x79, 0x71, 0x47, 0x82, 0x54, 0x94, 0x7d, 0x11,
+	0x9f, 0x97, 0x60, 0xac, 0x21, 0x24, 0x54, 0xf6, 0x52, 0x39, 0xbd, 0x86, 0x6a, 0xb4, 0xd1, 0xa2,
+	0xf9, 0xff, 0x0b, 0x9a, 0x03, 0xe3, 0x05, 0x7d, 0x45, 0xb3, 0xcb, 0xce, 0x19, 0xe2, 0x8f, 0x08,
+	0xd4, 0x34, 0x0c, 0x58, 0x2b, 0x35, 0xdd, 0xe6, 0x81, 0xca, 0x6d, 0xfb, 0xf7, 0x7e, 0x7a, 0x64,
+	0x55, 0xab, 0x56, 0x16, 0x31, 0x9b, 0xc6, 0x05, 0xbe, 0x8c, 0x26, 0x61, 0x2b, 0xad, 0x64, 0xaa,
+	0x59, 0x76, 0x08, 0x86, 0x04, 0x79, 0xc3, 0xb7, 0xd0, 0xf1, 0xf9, 0xb2, 0x83, 0xa6, 0x60, 0x48,
+	0xaf, 0x95, 0x55, 0xbd, 0x6e, 0x95, 0x96, 0x77, 0x27, 0x88, 0x9a, 0x44, 0x61, 0x2b, 0x99, 0x38,
+	0x43, 0xc7, 0x78, 0x05, 0x50, 0xd0, 0xe8, 0xe6, 0x95, 0xa0, 0x34, 0xec, 0xbd, 0x4c, 0xe3, 0x72,
+	0x81, 0xa0, 0xd4, 0x8a, 0x15, 0x3d, 0x2f, 0x5a, 0x02, 0xbf, 0x54, 0x7e, 0x44, 0x0e, 0x31, 0x4a,
+	0x42, 0xc0, 0xb4, 0x00, 0x55, 0xc4, 0xa2, 0xea, 0xb5, 0x14, 0x4d, 0xcc, 0xbc, 0x5a, 0xcb, 0x5e,
+	0xb5, 0x96, 0xbd, 0xfd, 0xb9, 0x03, 0x14, 0x33, 0x09, 0xe4, 0x24, 0x0f, 0x64, 0xa7, 0x0a, 0xfc,
+	0xf9, 0x83, 0xb4, 0x54, 0x18, 0xaf, 0xb4, 0x1b, 0xc6, 0xbb, 0x60, 0x07, 0x83, 0x74, 0xaa, 0x52,
+	0x39, 0x47, 0x1b, 0x03, 0x1f, 0xec, 0x65, 0xd8, 0xd9, 0xbe, 0x20, 0x30, 0x1e, 0x87, 0x41, 0xd6,
+	0x43, 0xc4, 0xe7, 0x17, 0x95, 0x10, 0xf9, 0x25, 0xc4, 0xf1, 0x5e, 0x98, 0x6a, 0x55, 0xd9, 0xf2,
+	0x86, 0x90, 0xc2, 0xba, 0x27, 0x7c, 0x39, 0x60, 0x77, 0x43, 0x79, 0x2d, 0xc4, 0x69, 0x13, 0xd3,
+	0xaa, 0xf8, 0x1a, 0x39, 0x59, 0x5e, 0xd3, 0x85, 0xe9, 0x5b, 0x90, 0x8e, 0x94, 0x10, 0xd6, 0xaf,
+	0xc2, 0x38, 0x77, 0x43, 0x5d, 0x21, 0x6b, 0xaa, 0xd7, 0x33, 0x50, 0x20, 0x4f, 0x47, 0x06, 0xa0,
+	0xa9, 0x47, 0x40, 0x1a, 0x33, 0x5a, 0xa7, 0x71, 0x46, 0x58, 0xe6, 0xf1, 0xe2, 0x5f, 0x6c, 0x25,
+	0xba, 0x8d, 0x79, 0x1d, 0xf6, 0x45, 0x6f, 0x11, 0x68, 0x17, 0x48, 0x57, 0x43, 0xe7, 0x63, 0xbb,
+	0x9a, 0xc0, 0x11, 0x71, 0x69, 0x7c, 0x09, 0x66, 0x98, 0xea, 0xd3, 0x0d, 0xdb, 0x26, 0x62, 0xd7,
+	0x74, 0xd3, 0x58, 0x76, 0xc3, 0x51, 0xed, 0x87, 0x51, 0xb6, 0x87, 0x47, 0x42, 0xf5, 0x11, 0x8e,
+	0x18, 0x4d, 0xe1, 0x32, 0x76, 0x61, 0xb6, 0xbb, 0x42, 0xff, 0x01, 0x1b, 0xe1, 0xba, 0x56, 0x98,
+	0x94, 0x08, 0x6e, 0x3a, 0xf2, 0x94, 0x85, 0x32, 0xee, 0xc0, 0xb0, 0xd1, 0x9c, 0xc2, 0x1f, 0x48,
+	0x30, 0x1c, 0x10, 0xa1, 0x4f, 0x49, 0x1b, 0xca, 0x2d, 0x06, 0x07, 0x88, 0xae, 0xc3, 0x08, 0x37,
+	0xa7, 0xb2, 0x1b, 0xc1, 0x5e, 0xbb, 0xa1, 0xdc, 0x22, 0xd5, 0xf9, 0xdb, 0xfd, 0xf4, 0x14, 0xbf,
+	0xf1, 0xe4, 0xc2, 0xcb, 0xa6, 0xa5, 0x54, 0x35, 0x77, 0x59, 0xbe, 0xa0, 0x1b, 0x5a, 0x69, 0x35,
+	0xaf, 0x97, 0xc8, 0x75, 0xdb, 0xce, 0xaf, 0x5b, 0x50, 0x01, 0x2e, 0x0c, 0xf3, 0x61, 0x81, 0x8d,
+	0xf2, 0x22, 0xa0, 0x0c, 0x8d, 0xdf, 0x1e, 0x93, 0x9b, 0xb7, 0x64, 0x5b, 0x6f, 0xeb, 0x25, 0xfa,
+	0xcb, 0x0b, 0x68, 0x34, 0x48, 0xfc, 0x73, 0x9f, 0x08, 0x63, 0xac, 0x9a, 0x4d, 0x7b, 0xe9, 0xd0,
+	0xcb, 0x30, 0x40, 0x5f, 0x12, 0xfe, 0x2e, 0x0f, 0x67, 0xe5, 0xb0, 0x23, 0xa2, 0x6f, 0x5c, 0x38,
+	0x52, 0x2f, 0xe5, 0x98, 0x0a, 0xb4, 0x0b, 0xb6, 0xd4, 0x2d, 0xab, 0x42, 0xbd, 0x4e, 0x30, 0xaf,
+	0x07, 0xe9, 0x90, 0x9c, 0xcc, 0x6b, 0x30, 0x54, 0x27, 0x4a, 0xf9, 0x0b, 0xd8, 0xcf, 0x0c, 0x65,
+	0xc3, 0x0c, 0x2d, 0x09, 0xa1, 0x58, 0x63, 0x4d, 0x55, 0xf8, 0x47, 0x09, 0x92, 0xd1, 0xe0, 0x28,
+	0x1e, 0x51, 0x76, 0xc4, 0x29, 0x0c, 0xf2, 0xaa, 0x83, 0x66, 0x60, 0xcc, 0x66, 0x75, 0x45, 0xb5,
+	0xf5, 0x92, 0x4e, 0x4c, 0xdb, 0x3c, 0x59, 0x0a, 0xa3, 0x7c, 0xba, 0x20, 0x66, 0x9b, 0x07, 0x90,
+	0x78, 0x64, 0xa5, 0xe6, 0x3b, 0x52, 0x49, 0xe2, 0xfd, 0x46, 0x69, 0x18, 0xf6, 0x7c, 0x6e, 0xfa,
+	0x02, 0xde, 0x14, 0xf1, 0x67, 0xc2, 0xab, 0xc3, 0xdc, 0x0b, 0x51, 0x75, 0x37, 0x01, 0xfc, 0xbc,
+	0x78, 0xf2, 0x5e, 0xd1, 0x6f, 0xb9, 0x2c, 0xa1, 0x5f, 0x75, 0x35, 0xdb, 0xbd, 0x42, 0x99, 0xa7,
+	0x77, 0x17, 0xb6, 0x41, 0xa2, 0xd6, 0xa8, 0x0a, 0xd0, 0xf4, 0x27, 0x7e, 0x57, 0x3c, 0x7a, 0xa1,
+	0x9b, 0x44, 0xe6, 0xbf, 0x09, 0xc3, 0x0e, 0x9d, 0x55, 0x19, 0x8b, 0x15, 0xf9, 0x9f, 0xec, 0xa8,
+	0x9a, 0x57, 0x3c, 0x8e, 0x9b, 0x4b, 0x89, 0xb2, 0x89, 0xf8, 0x3d, 0x0e, 0x6c, 0xc6, 0xb7, 0x69,
+	0xbd, 0x04, 0xc7, 0x37, 0x92, 0xbd, 0xb3, 0x13, 0x06, 0x18, 0x02, 0xf4, 0x93, 0x04, 0xbb, 0x22,
+	0x18, 0x2f, 0x0a, 0xcd, 0xd0, 0x78, 0x06, 0x9d, 0x9c, 0xdf, 0xd0, 0x1e, 0xee, 0x2b, 0x7e, 0xe1,
+	0xbd, 0x5f, 0xfe, 0xfa, 0xa4, 0xef, 0x04, 0x3a, 0xa6, 0x84, 0x50, 0x7c, 0xef, 0xaf, 0x84, 0x2a,
+	0x53, 0xa2, 0xba, 0x96, 0x5a, 0xf6, 0xd5, 0xa8, 0xfc, 0x0a, 0x13, 0xb2, 0x39, 0xe4, 0x93, 0x61,
+	0xb4, 0x3f, 0xba, 0x94, 0x36, 0xf9, 0x74, 0xf2, 0x40, 0x17, 0x29, 0x01, 0xed, 0x28, 0x83, 0x26,
+	0xa3, 0x67, 0xe2, 0xa0, 0xf1, 0xa7, 0xae, 0xb8, 0x4a, 0x72, 0x53, 0x59, 0x33, 0xcb, 0xeb, 0x68,
+	0x0d, 0x06, 0x45, 0xfb, 0xf9, 0x54, 0xa4, 0x19, 0x3f, 0x64, 0x38, 0x4e, 0x44, 0xc0, 0x38, 0xc4,
+	0x60, 0xec, 0x47, 0xb8, 0x2b, 0x0c, 0x07, 0x11, 0x2a, 0x3a, 0x12, 0xa4, 0x5d, 0x68, 0x26, 0xcc,
+	0x40, 0x08, 0x19, 0x4e, 0xce, 0x76, 0x17, 0x14, 0x78, 0x32, 0x0c, 0xcf, 0x61, 0x74, 0x30, 0x0e,
+	0x8f, 0xc6, 0x76, 0x8a, 0xfe, 0x1d, 0x7d, 0xd3, 0xc6, 0x90, 0xbd, 0x9e, 0x1f, 0x29, 0xdd, 0xac,
+	0xb6, 0xb1, 0x93, 0xe4, 0x91, 0xde, 0x37, 0x08, 0xb8, 0x27, 0x19, 0xdc, 0x05, 0x34, 0xdf, 0x33,
+	0x5c, 0xb5, 0xae, 0xdb, 0x2a, 0xa7, 0x3d, 0x84, 0xc6, 0x8e, 0xb6, 0xd2, 0x15, 0x74, 0x30, 0x0c,
+	0x41, 0x28, 0x99, 0x4c, 0x1e, 0xea, 0x45, 0x54, 0xc0, 0x9c, 0x67, 0x30, 0xe7, 0xd0, 0xe1, 0x38,
+	0x98, 0x6d, 0xbc, 0x08, 0xfd, 0xd0, 0xc1, 0x32, 0xfd, 0xc8, 0x66, 0xba, 0xdb, 0x6e, 0x8f, 0x6d,
+	0x76, 0x23, 0x5b, 0x04, 0xec, 0xe7, 0x19, 0xec, 0xe3, 0x68, 0x61, 0x03, 0xb0, 0x03, 0xf1, 0x25,
+	0xf9, 0x0a, 0x4d, 0x92, 0x83, 0x42, 0x2f, 0x66, 0x07, 0xf3, 0x4a, 0x4e, 0x77, 0x13, 0x13, 0xe0,
+	0x8e, 0x33, 0x70, 0x19, 0xa4, 0xc4, 0x81, 0xe3, 0x45, 0xcf, 0x51, 0x89, 0x62, 0x65, 0x8d, 0xd5,
+	0x8e, 0x75, 0xf4, 0xb5, 0x04, 0xe3, 0x1d, 0xdc, 0x26, 0x3c, 0xa4, 0xb1, 0x4c, 0x29, 0x3c, 0xa4,
+	0xf1, 0xd4, 0x09, 0x1f, 0x63, 0xa8, 0x8f, 0x20, 0x39, 0x0e, 0x75, 0x27, 0x33, 0x42, 0x1f, 0x93,
+	0x97, 0xd0, 0xef, 0xfb, 0xc3, 0xd3, 0x34, 0x94, 0x21, 0x85, 0xa7, 0x69, 0x38, 0x67, 0xc2, 0x32,
+	0x03, 0x37, 0x8b, 0xa6, 0x63, 0x6f, 0x53, 0xa5, 0xa2, 0x72, 0x7e, 0x80, 0xbe, 0x92, 0x60, 0xac,
+	0x8d, 0x07, 0x85, 0x5f, 0xfa, 0x18, 0x42, 0x15, 0x7e, 0xe9, 0xe3, 0x28, 0x16, 0x5e, 0x60, 0x30,
+	0x15, 0x34, 0xd7, 0x1b, 0x4c, 0xef, 0x3e, 0x7d, 0x2b, 0x01, 0xea, 0xa4, 0x4e, 0x28, 0xdb, 0xdd,
+	0x7e, 0x3b, 0x13, 0x0b, 0x2f, 0x86, 0x5d, 0xb8, 0x19, 0x7e, 0x96, 0xc1, 0x9e, 0x47, 0x99, 0x1e,
+	0x61, 0x37, 0x19, 0x1c, 0x2d, 0xe6, 0xdb, 0x43, 0x88, 0x14, 0x8a, 0xc6, 0x11, 0xcd, 0xd4, 0x92,
+	0x47, 0x37, 0xb6, 0x49, 0xa0, 0x7f, 0x91, 0xa1, 0x5f, 0x44, 0x27, 0x62, 0x0b, 0x15, 0xe3, 0x5a,
+	0xa4, 0x5e, 0xb6, 0x92, 0x2e, 0x5e, 0x3b, 0xff, 0x91, 0x60, 0x2a, 0x86, 0x61, 0xa1, 0x93, 0x91,
+	0xb8, 0xba, 0x13, 0xbd, 0xe4, 0x73, 0x0f, 0xb7, 0x59, 0x38, 0x77, 0x95, 0x39, 0x77, 0x09, 0x5d,
+	0x8c, 0x73, 0xae, 0xc4, 0x15, 0x09, 0xe2, 0x17, 0xe6, 0x65, 0xeb, 0x78, 0x1d, 0xfd, 0x4d, 0x3c,
+	0x8e, 0x21, 0x43, 0x31, 0x1e, 0x77, 0x67, 0x62, 0x31, 0x1e, 0xf7, 0xc0, 0xbf, 0xf0, 0x05, 0xe6,
+	0xf1, 0x59, 0x94, 0xef, 0xde, 0xfe, 0x94, 0x03, 0x9a, 0xd4, 0xba, 0xaf, 0x8a, 0xb8, 0xeb, 0x3b,
+	0xfa, 0x3d, 0xc9, 0xcf, 0x90, 0x9e, 0x37, 0x26, 0x3f, 0xa3, 0xdb, 0xea, 0x98, 0xfc, 0x8c, 0x69,
+	0xab, 0xf1, 0x22, 0x73, 0xe8, 0x28, 0xca, 0xc6, 0x39, 0x54, 0x23, 0x0a, 0xc4, 0xf9, 0x04, 0xda,
+	0xe8, 0xdc, 0xd2, 0xdd, 0x3f, 0x52, 0xd2, 0x3d, 0xf2, 0xf9, 0x9d, 0x7c, 0x6e, 0xff, 0x99, 0x7a,
+	0xe2, 0x1e, 0xf9, 0xfc, 0x4a, 0x3e, 0x6f, 0x1c, 0x0b, 0xd0, 0x06, 0xa1, 0x77, 0xae, 0xa2, 0x15,
+	0x1d, 0xdf, 0xc8, 0xcd, 0x6c, 0x46, 0xb9, 0x15, 0x34, 0xc5, 0xa8, 0x44, 0x71, 0x90, 0xf5, 0xf1,
+	0xf3, 0xff, 0x01, 0x55, 0xce, 0x02, 0xce, 0xea, 0x1b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1554,6 +1912,13 @@ type QueryClient interface {
 	// CurrentWeightByGroupGaugeID returns the current weight since the
 	// the last epoch given a group gauge ID
 	CurrentWeightByGroupGaugeID(ctx context.Context, in *QueryCurrentWeightByGroupGaugeIDRequest, opts ...grpc.CallOption) (*QueryCurrentWeightByGroupGaugeIDResponse, error)
+	// GaugeDistributionProjection returns the coins that a gauge is expected to
+	// distribute at the next epoch along with the projected share of each
+	// receiving lock or concentrated liquidity position
+	GaugeDistributionProjection(ctx context.Context, in *QueryGaugeDistributionProjectionRequest, opts ...grpc.CallOption) (*QueryGaugeDistributionProjectionResponse, error)
+	// NextGaugeStartTimes returns the next start times that external gauges can
+	// be created with, i.e. the upcoming distribution epoch boundaries
+	NextGaugeStartTimes(ctx context.Context, in *QueryNextGaugeStartTimesRequest, opts ...grpc.CallOption) (*QueryNextGaugeStartTimesResponse, error)
 }
 
 type queryClient struct {
@@ -1690,6 +2055,24 @@ func (c *queryClient) CurrentWeightByGroupGaugeID(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) GaugeDistributionProjection(ctx context.Context, in *QueryGaugeDistributionProjectionRequest, opts ...grpc.CallOption) (*QueryGaugeDistributionProjectionResponse, error) {
+	out := new(QueryGaugeDistributionProjectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugeDistributionProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextGaugeStartTimes(ctx context.Context, in *QueryNextGaugeStartTimesRequest, opts ...grpc.CallOption) (*QueryNextGaugeStartTimesResponse, error) {
+	out := new(QueryNextGaugeStartTimesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/NextGaugeStartTimes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ModuleToDistributeCoins returns coins that are going to be distributed
@@ -1725,6 +2108,13 @@ type QueryServer interface {
 	// CurrentWeightByGroupGaugeID returns the current weight since the
 	// the last epoch given a group gauge ID
 	CurrentWeightByGroupGaugeID(context.Context, *QueryCurrentWeightByGroupGaugeIDRequest) (*QueryCurrentWeightByGroupGaugeIDResponse, error)
+	// GaugeDistributionProjection returns the coins that a gauge is expected to
+	// distribute at the next epoch along with the projected share of each
+	// receiving lock or concentrated liquidity position
+	GaugeDistributionProjection(context.Context, *QueryGaugeDistributionProjectionRequest) (*QueryGaugeDistributionProjectionResponse, error)
+	// NextGaugeStartTimes returns the next start times that external gauges can
+	// be created with, i.e. the upcoming distribution epoch boundaries
+	NextGaugeStartTimes(context.Context, *QueryNextGaugeStartTimesRequest) (*QueryNextGaugeStartTimesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1773,6 +2163,12 @@ func (*UnimplementedQueryServer) GroupByGroupGaugeID(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) CurrentWeightByGroupGaugeID(ctx context.Context, req *QueryCurrentWeightByGroupGaugeIDRequest) (*QueryCurrentWeightByGroupGaugeIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentWeightByGroupGaugeID not implemented")
 }
+func (*UnimplementedQueryServer) GaugeDistributionProjection(ctx context.Context, req *QueryGaugeDistributionProjectionRequest) (*QueryGaugeDistributionProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugeDistributionProjection not implemented")
+}
+func (*UnimplementedQueryServer) NextGaugeStartTimes(ctx context.Context, req *QueryNextGaugeStartTimesRequest) (*QueryNextGaugeStartTimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextGaugeStartTimes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2030,6 +2426,42 @@ func _Query_CurrentWeightByGroupGaugeID_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GaugeDistributionProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGaugeDistributionProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugeDistributionProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/GaugeDistributionProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugeDistributionProjection(ctx, req.(*QueryGaugeDistributionProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextGaugeStartTimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextGaugeStartTimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextGaugeStartTimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/NextGaugeStartTimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextGaugeStartTimes(ctx, req.(*QueryNextGaugeStartTimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2090,6 +2522,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CurrentWeightByGroupGaugeID",
 			Handler:    _Query_CurrentWeightByGroupGaugeID_Handler,
 		},
+		{
+			MethodName: "GaugeDistributionProjection",
+			Handler:    _Query_GaugeDistributionProjection_Handler,
+		},
+		{
+			MethodName: "NextGaugeStartTimes",
+			Handler:    _Query_NextGaugeStartTimes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -3144,15 +3584,274 @@ func (m *GaugeWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGaugeDistributionProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeDistributionProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeDistributionProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockDistributionProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockDistributionProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockDistributionProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardReceiver) > 0 {
+		i -= len(m.RewardReceiver)
+		copy(dAtA[i:], m.RewardReceiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionDistributionProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionDistributionProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionDistributionProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextGaugeStartTimesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextGaugeStartTimesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextGaugeStartTimesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Num != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextGaugeStartTimesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextGaugeStartTimesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextGaugeStartTimesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartTimes) > 0 {
+		for iNdEx := len(m.StartTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTimes[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTimes[iNdEx]):])
+			if err14 != nil {
+				return 0, err14
+			}
+			i -= n14
+			i = encodeVarintQuery(dAtA, i, uint64(n14))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
@@ -3570,19 +4269,132 @@ func (m *GaugeWeight) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryGaugeDistributionProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryGaugeDistributionProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *LockDistributionProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	l = len(m.RewardReceiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PositionDistributionProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextGaugeStartTimesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovQuery(uint64(m.Num))
+	}
+	return n
+}
+
+func (m *QueryNextGaugeStartTimesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StartTimes) > 0 {
+		for _, e := range m.StartTimes {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdTime(e)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleToDistributeCoinsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
@@ -6164,6 +6976,675 @@ func (m *GaugeWeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGaugeDistributionProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeDistributionProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeDistributionProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryGaugeDistributionProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGaugeDistributionProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGaugeDistributionProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, LockDistributionProjection{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, PositionDistributionProjection{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *LockDistributionProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockDistributionProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockDistributionProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PositionDistributionProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionDistributionProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionDistributionProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryNextGaugeStartTimesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextGaugeStartTimesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextGaugeStartTimesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Num", wireType)
+			}
+			m.Num = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Num |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryNextGaugeStartTimesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextGaugeStartTimesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextGaugeStartTimesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartTimes = append(m.StartTimes, time.Time{})
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&(m.StartTimes[len(m.StartTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
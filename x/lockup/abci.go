@@ -26,6 +26,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 
 	// withdraw and delete locks
 	k.WithdrawAllMaturedLocks(ctx)
+
+	// continue the lock duration migrations started by governance
+	k.MigratePendingLockDurations(ctx)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -45,6 +45,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdSyntheticLockupsByLockupID(),
 		GetCmdSyntheticLockupByLockupID(),
 		GetCmdAccountLockedDuration(),
+		GetCmdAccountLockedDurationBuckets(),
 		GetCmdNextLockID(),
 		osmocli.GetParams[*types.QueryParamsRequest](
 			types.ModuleName, types.NewQueryClient),
@@ -247,6 +248,15 @@ func GetCmdAccountLockedDuration() *cobra.Command {
 {{.CommandPrefix}} account-locked-duration osmo1yl6hdjhmkf37639730gffanpzndzdpmhxy9ep3 604800s`, types.ModuleName, types.NewQueryClient)
 }
 
+// GetCmdAccountLockedDurationBuckets returns account locked coins grouped by lock duration.
+func GetCmdAccountLockedDurationBuckets() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AccountLockedDurationBucketsRequest](
+		"account-locked-duration-buckets",
+		"Query account locked coins grouped by lock duration",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} account-locked-duration-buckets osmo1yl6hdjhmkf37639730gffanpzndzdpmhxy9ep3`, types.ModuleName, types.NewQueryClient)
+}
+
 // GetCmdAccountLockedLongerDurationNotUnlockingOnly returns account locked records with longer duration from unlocking only queue.
 func GetCmdAccountLockedLongerDurationNotUnlockingOnly() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.AccountLockedLongerDurationNotUnlockingOnlyRequest](
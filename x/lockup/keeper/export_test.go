@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,3 +31,11 @@ func (k Keeper) Lock(ctx sdk.Context, lock types.PeriodLock, tokensToLock sdk.Co
 func (k Keeper) UnlockMaturedLockInternalLogic(ctx sdk.Context, lock types.PeriodLock) error {
 	return k.unlockMaturedLockInternalLogic(ctx, lock)
 }
+
+func (k Keeper) MigrateLockDurationBatch(ctx sdk.Context, fromDuration, toDuration time.Duration, startLockId, limit uint64) ([]uint64, uint64, error) {
+	return k.migrateLockDurationBatch(ctx, fromDuration, toDuration, startLockId, limit)
+}
+
+func (k Keeper) SetLockDurationMigration(ctx sdk.Context, fromDuration, toDuration time.Duration, nextLockId uint64) {
+	k.setLockDurationMigration(ctx, fromDuration, toDuration, nextLockId)
+}
@@ -242,6 +242,26 @@ func (q Querier) AccountLockedLongerDuration(goCtx context.Context, req *types.A
 	return &types.AccountLockedLongerDurationResponse{Locks: locks}, nil
 }
 
+// AccountLockedDurationBuckets returns the locked coins of an account grouped by lock duration.
+func (q Querier) AccountLockedDurationBuckets(goCtx context.Context, req *types.AccountLockedDurationBucketsRequest) (*types.AccountLockedDurationBucketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.Owner) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty owner")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := q.Keeper.GetAccountLockedDurationBuckets(ctx, owner)
+	return &types.AccountLockedDurationBucketsResponse{Buckets: buckets}, nil
+}
+
 // AccountLockedLongerDurationDenom returns locks of an account with duration longer than specified with specific denom.
 func (q Querier) AccountLockedLongerDurationDenom(goCtx context.Context, req *types.AccountLockedLongerDurationDenomRequest) (*types.AccountLockedLongerDurationDenomResponse, error) {
 	if req == nil {
@@ -444,6 +444,45 @@ func (s *KeeperTestSuite) TestAccountLockedLongerDuration() {
 	s.Require().Len(res.Locks, 0)
 }
 
+func (s *KeeperTestSuite) TestAccountLockedDurationBuckets() {
+	s.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// empty address duration buckets check
+	_, err := s.querier.AccountLockedDurationBuckets(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedDurationBucketsRequest{Owner: ""})
+	s.Require().Error(err)
+
+	// initial check
+	res, err := s.querier.AccountLockedDurationBuckets(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedDurationBucketsRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Buckets, 0)
+
+	// lock coins, creating the longer lock first to check that buckets are sorted by duration
+	s.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 5)}, 2*time.Second)
+	s.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 10)}, time.Second)
+	s.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("stake", 20)}, time.Second)
+
+	expectedBuckets := []types.LockedDurationBucket{
+		{Duration: time.Second, Coins: sdk.Coins{sdk.NewInt64Coin("stake", 30)}, NumLocks: 2},
+		{Duration: 2 * time.Second, Coins: sdk.Coins{sdk.NewInt64Coin("foo", 5)}, NumLocks: 1},
+	}
+	res, err = s.querier.AccountLockedDurationBuckets(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedDurationBucketsRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedBuckets, res.Buckets)
+
+	// unlocking locks are still included until they mature
+	s.BeginUnlocking(addr1)
+	res, err = s.querier.AccountLockedDurationBuckets(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedDurationBucketsRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedBuckets, res.Buckets)
+
+	// matured locks are excluded
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second + time.Second/2))
+	res, err = s.querier.AccountLockedDurationBuckets(sdk.WrapSDKContext(s.Ctx), &types.AccountLockedDurationBucketsRequest{Owner: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedBuckets[1:], res.Buckets)
+}
+
 func (s *KeeperTestSuite) TestAccountLockedLongerDurationNotUnlockingOnly() {
 	s.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
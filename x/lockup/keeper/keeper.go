@@ -23,6 +23,8 @@ type Keeper struct {
 	ak types.AccountKeeper
 	bk types.BankKeeper
 	ck types.CommunityPoolKeeper
+	// ldks are the keepers of the lockable durations, which are kept equal. The first one is read from.
+	ldks []types.LockableDurationsKeeper
 }
 
 // NewKeeper returns an instance of Keeper.
@@ -77,6 +79,12 @@ func (k *Keeper) SetHooks(lh types.LockupHooks) *Keeper {
 	return k
 }
 
+// Set the keepers of the lockable durations. The lockable durations are read from the first one
+// and updated in all of them.
+func (k *Keeper) SetLockableDurationsKeepers(lockableDurationsKeepers ...types.LockableDurationsKeeper) {
+	k.ldks = lockableDurationsKeepers
+}
+
 // AdminKeeper defines a god privilege keeper functions to remove tokens from locks and create new locks
 // For the governance system of token pools, we want a "ragequit" feature
 // So governance changes will take 1 week to go into effect
@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+// UpdateLockableDurations adds and removes the durations that gauges can distribute incentives to,
+// in every lockable durations keeper, i.e. both the durations of incentives and the ones pool incentives
+// creates gauges of new pools for. Existing gauges and locks are left as is. Locks of a removed duration can be moved to a lockable
+// duration with MigrateLockDuration.
+// Returns the lockable durations after the update, sorted in ascending order.
+// Returns error if:
+// - any duration to add is not positive or is already lockable
+// - any duration to remove is not lockable
+// - no lockable durations would be left
+func (k Keeper) UpdateLockableDurations(ctx sdk.Context, addDurations, removeDurations []time.Duration) ([]time.Duration, error) {
+	lockableDurations := make(map[time.Duration]bool)
+	for _, duration := range k.getLockableDurations(ctx) {
+		lockableDurations[duration] = true
+	}
+
+	for _, duration := range addDurations {
+		if duration <= 0 {
+			return nil, fmt.Errorf("lockable duration should be positive: %s", duration)
+		}
+		if lockableDurations[duration] {
+			return nil, errorsmod.Wrapf(types.ErrLockableDurationExists, "duration %s", duration)
+		}
+		lockableDurations[duration] = true
+	}
+
+	for _, duration := range removeDurations {
+		if !lockableDurations[duration] {
+			return nil, errorsmod.Wrapf(types.ErrLockableDurationNotFound, "duration %s", duration)
+		}
+		delete(lockableDurations, duration)
+	}
+
+	if len(lockableDurations) == 0 {
+		return nil, types.ErrNoLockableDurations
+	}
+
+	updatedDurations := make([]time.Duration, 0, len(lockableDurations))
+	for duration := range lockableDurations {
+		updatedDurations = append(updatedDurations, duration)
+	}
+	sort.Slice(updatedDurations, func(i, j int) bool { return updatedDurations[i] < updatedDurations[j] })
+	for _, ldk := range k.ldks {
+		ldk.SetLockableDurations(ctx, updatedDurations)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtUpdateLockableDurations,
+		sdk.NewAttribute(types.AttributeAddedDurations, formatDurations(addDurations)),
+		sdk.NewAttribute(types.AttributeRemovedDurations, formatDurations(removeDurations)),
+		sdk.NewAttribute(types.AttributeLockableDurations, formatDurations(updatedDurations)),
+	))
+
+	return updatedDurations, nil
+}
+
+// MigrateLockDuration moves the locks of fromDuration that are not unlocking to the shorter toDuration,
+// updating their lock refs and the accumulation stores of their denoms. This realigns the locks of
+// a duration that is no longer lockable with a nearby lockable duration, so that they keep receiving incentives.
+// Locks are only ever moved to a shorter duration, so that the commitment of their owners is not lengthened.
+// Locks with a synthetic lockup are skipped, since their duration is bound to the synthetic lockup.
+// At most types.MaxLockDurationMigrationsPerBlock locks are migrated right away. The remaining ones are
+// migrated in batches at the end of the following blocks, see MigratePendingLockDurations.
+// Emits a migrate_lock_duration event and calls the OnLockupExtend hook for every migrated lock.
+// Returns the IDs of the locks migrated right away in ascending order.
+// Returns error if:
+// - toDuration is not shorter than fromDuration
+// - fromDuration is still lockable
+// - toDuration is not lockable
+// - the locks of fromDuration are already being migrated
+func (k Keeper) MigrateLockDuration(ctx sdk.Context, fromDuration, toDuration time.Duration) ([]uint64, error) {
+	if toDuration >= fromDuration {
+		return nil, fmt.Errorf("to duration %s should be shorter than from duration %s", toDuration, fromDuration)
+	}
+
+	lockableDurations := k.getLockableDurations(ctx)
+	if osmoutils.Contains(lockableDurations, fromDuration) {
+		return nil, fmt.Errorf("cannot migrate locks of lockable duration %s", fromDuration)
+	}
+	if !osmoutils.Contains(lockableDurations, toDuration) {
+		return nil, errorsmod.Wrapf(types.ErrLockableDurationNotFound, "duration %s", toDuration)
+	}
+	if ctx.KVStore(k.storeKey).Has(lockDurationMigrationStoreKey(fromDuration)) {
+		return nil, fmt.Errorf("locks of duration %s are already being migrated", fromDuration)
+	}
+
+	migratedLockIds, nextLockId, err := k.migrateLockDurationBatch(ctx, fromDuration, toDuration, 0, types.MaxLockDurationMigrationsPerBlock)
+	if err != nil {
+		return nil, err
+	}
+	if nextLockId != 0 {
+		k.setLockDurationMigration(ctx, fromDuration, toDuration, nextLockId)
+	}
+
+	return migratedLockIds, nil
+}
+
+// MigratePendingLockDurations continues the oldest pending lock duration migration started by MigrateLockDuration,
+// iterating over at most types.MaxLockDurationMigrationsPerBlock of its locks. The migration is deleted once all its
+// locks are migrated. A migration that fails is logged and deleted, leaving its remaining locks as is.
+func (k Keeper) MigratePendingLockDurations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixLockDurationMigration)
+	if !iterator.Valid() {
+		iterator.Close()
+		return
+	}
+	key, value := iterator.Key(), iterator.Value()
+	iterator.Close()
+
+	fromDuration := time.Duration(sdk.BigEndianToUint64(key[len(types.KeyPrefixLockDurationMigration):]))
+	toDuration := time.Duration(sdk.BigEndianToUint64(value[:8]))
+	startLockId := sdk.BigEndianToUint64(value[8:])
+
+	var nextLockId uint64
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		var err error
+		_, nextLockId, err = k.migrateLockDurationBatch(cacheCtx, fromDuration, toDuration, startLockId, types.MaxLockDurationMigrationsPerBlock)
+		return err
+	})
+	if err != nil || nextLockId == 0 {
+		store.Delete(lockDurationMigrationStoreKey(fromDuration))
+		return
+	}
+	k.setLockDurationMigration(ctx, fromDuration, toDuration, nextLockId)
+}
+
+// migrateLockDurationBatch migrates the locks of fromDuration that are not unlocking, starting from the lock with
+// ID startLockId, to toDuration. At most limit locks are iterated over, including the ones skipped because they
+// have a synthetic lockup.
+// Returns the IDs of the migrated locks in ascending order, and the ID of the lock the next batch starts from,
+// which is zero if no locks of fromDuration are left to iterate over.
+func (k Keeper) migrateLockDurationBatch(ctx sdk.Context, fromDuration, toDuration time.Duration, startLockId, limit uint64) ([]uint64, uint64, error) {
+	// Gather the lock IDs first, since migrating a lock modifies the lock refs being iterated over.
+	lockRefPrefix := combineKeys(types.KeyPrefixNotUnlocking, types.KeyPrefixLockDuration, getDurationKey(fromDuration))
+	iterator := ctx.KVStore(k.storeKey).Iterator(combineKeys(lockRefPrefix, sdk.Uint64ToBigEndian(startLockId)), storetypes.PrefixEndBytes(lockRefPrefix))
+	lockIds := []uint64{}
+	for ; iterator.Valid() && uint64(len(lockIds)) < limit; iterator.Next() {
+		lockIds = append(lockIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	nextLockId := uint64(0)
+	if iterator.Valid() {
+		nextLockId = sdk.BigEndianToUint64(iterator.Value())
+	}
+	iterator.Close()
+
+	migratedLockIds := []uint64{}
+	for _, lockId := range lockIds {
+		if k.HasAnySyntheticLockups(ctx, lockId) {
+			continue
+		}
+
+		lock, err := k.GetLockByID(ctx, lockId)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := k.migrateLockDuration(ctx, *lock, toDuration); err != nil {
+			return nil, 0, err
+		}
+		k.hooks.OnLockupExtend(ctx, lock.ID, fromDuration, toDuration)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtMigrateLockDuration,
+			sdk.NewAttribute(types.AttributePeriodLockID, osmoutils.Uint64ToString(lock.ID)),
+			sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+			sdk.NewAttribute(types.AttributePeriodLockPrevDuration, fromDuration.String()),
+			sdk.NewAttribute(types.AttributePeriodLockDuration, toDuration.String()),
+		))
+		migratedLockIds = append(migratedLockIds, lock.ID)
+	}
+
+	return migratedLockIds, nextLockId, nil
+}
+
+// setLockDurationMigration stores the pending migration of the locks of fromDuration to toDuration,
+// which continues from the lock with ID nextLockId.
+func (k Keeper) setLockDurationMigration(ctx sdk.Context, fromDuration, toDuration time.Duration, nextLockId uint64) {
+	value := append(sdk.Uint64ToBigEndian(uint64(toDuration)), sdk.Uint64ToBigEndian(nextLockId)...)
+	ctx.KVStore(k.storeKey).Set(lockDurationMigrationStoreKey(fromDuration), value)
+}
+
+// lockDurationMigrationStoreKey returns the store key of the pending migration of the locks of fromDuration.
+func lockDurationMigrationStoreKey(fromDuration time.Duration) []byte {
+	return append(append([]byte{}, types.KeyPrefixLockDurationMigration...), sdk.Uint64ToBigEndian(uint64(fromDuration))...)
+}
+
+// migrateLockDuration sets the duration of the given lock that is not unlocking to newDuration,
+// moving its lock refs and its coins in the accumulation stores from the old duration to the new one.
+func (k Keeper) migrateLockDuration(ctx sdk.Context, lock types.PeriodLock, newDuration time.Duration) error {
+	if err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock); err != nil {
+		return err
+	}
+
+	for _, coin := range lock.Coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(newDuration), coin.Amount)
+	}
+
+	lock.Duration = newDuration
+	if err := k.addLockRefs(ctx, lock); err != nil {
+		return err
+	}
+	return k.setLock(ctx, lock)
+}
+
+// getLockableDurations returns the lockable durations of the first lockable durations keeper.
+func (k Keeper) getLockableDurations(ctx sdk.Context) []time.Duration {
+	return k.ldks[0].GetLockableDurations(ctx)
+}
+
+// formatDurations returns the given durations as a comma separated string.
+func formatDurations(durations []time.Duration) string {
+	formatted := make([]string, len(durations))
+	for i, duration := range durations {
+		formatted[i] = duration.String()
+	}
+	return strings.Join(formatted, ",")
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/lockup/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+)
+
+var govAddr = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+func (s *KeeperTestSuite) TestMsgUpdateLockableDurations() {
+	initialDurations := []time.Duration{time.Hour, time.Hour * 24, time.Hour * 24 * 14}
+
+	tests := map[string]struct {
+		authority         string
+		addDurations      []time.Duration
+		removeDurations   []time.Duration
+		expectedDurations []time.Duration
+		expectedErr       error
+	}{
+		"add a duration": {
+			addDurations:      []time.Duration{time.Hour * 24 * 7},
+			expectedDurations: []time.Duration{time.Hour, time.Hour * 24, time.Hour * 24 * 7, time.Hour * 24 * 14},
+		},
+		"remove a duration": {
+			removeDurations:   []time.Duration{time.Hour},
+			expectedDurations: []time.Duration{time.Hour * 24, time.Hour * 24 * 14},
+		},
+		"add and remove durations": {
+			addDurations:      []time.Duration{time.Hour * 24 * 7, time.Minute},
+			removeDurations:   []time.Duration{time.Hour * 24},
+			expectedDurations: []time.Duration{time.Minute, time.Hour, time.Hour * 24 * 7, time.Hour * 24 * 14},
+		},
+		"error: authority is not the governance module account": {
+			authority:    s.TestAccs[0].String(),
+			addDurations: []time.Duration{time.Hour * 24 * 7},
+			expectedErr:  types.ErrUnauthorized,
+		},
+		"error: duration to add is already lockable": {
+			addDurations: []time.Duration{time.Hour * 24},
+			expectedErr:  types.ErrLockableDurationExists,
+		},
+		"error: duration to remove is not lockable": {
+			removeDurations: []time.Duration{time.Hour * 24 * 7},
+			expectedErr:     types.ErrLockableDurationNotFound,
+		},
+		"error: no lockable durations left": {
+			removeDurations: initialDurations,
+			expectedErr:     types.ErrNoLockableDurations,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.IncentivesKeeper.SetLockableDurations(s.Ctx, initialDurations)
+			s.App.PoolIncentivesKeeper.SetLockableDurations(s.Ctx, initialDurations)
+			msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+
+			authority := govAddr
+			if tc.authority != "" {
+				authority = tc.authority
+			}
+
+			_, err := msgServer.UpdateLockableDurations(sdk.WrapSDKContext(s.Ctx), types.NewMsgUpdateLockableDurations(authority, tc.addDurations, tc.removeDurations))
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(initialDurations, s.App.IncentivesKeeper.GetLockableDurations(s.Ctx))
+				s.Require().Equal(initialDurations, s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedDurations, s.App.IncentivesKeeper.GetLockableDurations(s.Ctx))
+			// Pool incentives creates the gauges of new pools for the updated durations.
+			s.Require().Equal(tc.expectedDurations, s.App.PoolIncentivesKeeper.GetLockableDurations(s.Ctx))
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtUpdateLockableDurations, 1)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgMigrateLockDuration() {
+	const denom = "stake"
+	fromDuration, toDuration := time.Hour*24*10, time.Hour*24*7
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+
+	tests := map[string]struct {
+		authority    string
+		fromDuration time.Duration
+		toDuration   time.Duration
+		expectedErr  error
+	}{
+		"migrate locks of a removed duration": {
+			fromDuration: fromDuration,
+			toDuration:   toDuration,
+		},
+		"error: authority is not the governance module account": {
+			authority:    s.TestAccs[0].String(),
+			fromDuration: fromDuration,
+			toDuration:   toDuration,
+			expectedErr:  types.ErrUnauthorized,
+		},
+		"error: to duration is not lockable": {
+			fromDuration: fromDuration,
+			toDuration:   time.Hour * 24 * 5,
+			expectedErr:  types.ErrLockableDurationNotFound,
+		},
+		"error: to duration is longer than from duration": {
+			fromDuration: fromDuration,
+			toDuration:   time.Hour * 24 * 14,
+			expectedErr:  errors.New("to duration 336h0m0s should be shorter than from duration 240h0m0s"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.IncentivesKeeper.SetLockableDurations(s.Ctx, []time.Duration{time.Hour * 24, toDuration, time.Hour * 24 * 14})
+			msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+
+			// Locks of the removed duration, one of which is unlocking and one of which has a synthetic lockup.
+			lockIds := []uint64{}
+			for _, owner := range s.TestAccs {
+				s.FundAcc(owner, coins)
+				lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, coins, fromDuration)
+				s.Require().NoError(err)
+				lockIds = append(lockIds, lock.ID)
+			}
+			_, err := s.App.LockupKeeper.BeginUnlock(s.Ctx, lockIds[1], nil)
+			s.Require().NoError(err)
+			err = s.App.LockupKeeper.CreateSyntheticLockup(s.Ctx, lockIds[2], "synthetic", time.Hour, false)
+			s.Require().NoError(err)
+
+			// A lock of the lockable duration is left as is.
+			s.FundAcc(s.TestAccs[0], coins)
+			lockableDurationLock, err := s.App.LockupKeeper.CreateLock(s.Ctx, s.TestAccs[0], coins, toDuration)
+			s.Require().NoError(err)
+
+			authority := govAddr
+			if tc.authority != "" {
+				authority = tc.authority
+			}
+
+			resp, err := msgServer.MigrateLockDuration(sdk.WrapSDKContext(s.Ctx), types.NewMsgMigrateLockDuration(authority, tc.fromDuration, tc.toDuration))
+			if tc.expectedErr != nil {
+				s.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal([]uint64{lockIds[0]}, resp.MigratedLockIds)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtMigrateLockDuration, 1)
+
+			expectedDurations := map[uint64]time.Duration{
+				lockIds[0]:              toDuration,
+				lockIds[1]:              fromDuration,
+				lockIds[2]:              fromDuration,
+				lockableDurationLock.ID: toDuration,
+			}
+			for lockId, expectedDuration := range expectedDurations {
+				lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+				s.Require().NoError(err)
+				s.Require().Equal(expectedDuration, lock.Duration)
+			}
+
+			// The lock refs and the accumulation store follow the migrated lock.
+			s.Require().Len(s.App.LockupKeeper.GetAccountLockedDuration(s.Ctx, s.TestAccs[0], toDuration), 2)
+			s.Require().Empty(s.App.LockupKeeper.GetAccountLockedDuration(s.Ctx, s.TestAccs[0], fromDuration))
+			lockedAtToDuration := s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, types.QueryCondition{
+				LockQueryType: types.ByDuration,
+				Denom:         denom,
+				Duration:      toDuration,
+			})
+			s.Require().Equal(osmomath.NewInt(20).String(), lockedAtToDuration.String())
+		})
+	}
+
+	s.Run("error: from duration is lockable", func() {
+		s.SetupTest()
+		s.App.IncentivesKeeper.SetLockableDurations(s.Ctx, []time.Duration{fromDuration, toDuration})
+		msgServer := keeper.NewMsgServerImpl(s.App.LockupKeeper)
+
+		_, err := msgServer.MigrateLockDuration(sdk.WrapSDKContext(s.Ctx), types.NewMsgMigrateLockDuration(govAddr, fromDuration, toDuration))
+		s.Require().Error(err)
+	})
+}
+
+// Tests that the locks that are not migrated right away are migrated at the end of the following blocks.
+func (s *KeeperTestSuite) TestMigratePendingLockDurations() {
+	s.SetupTest()
+	fromDuration, toDuration := time.Hour*24*10, time.Hour*24*7
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	lockIds := []uint64{}
+	for _, owner := range s.TestAccs {
+		s.FundAcc(owner, coins)
+		lock, err := s.App.LockupKeeper.CreateLock(s.Ctx, owner, coins, fromDuration)
+		s.Require().NoError(err)
+		lockIds = append(lockIds, lock.ID)
+	}
+
+	// Migrate the first lock and leave the rest pending.
+	migratedLockIds, nextLockId, err := s.App.LockupKeeper.MigrateLockDurationBatch(s.Ctx, fromDuration, toDuration, 0, 1)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{lockIds[0]}, migratedLockIds)
+	s.Require().Equal(lockIds[1], nextLockId)
+	s.App.LockupKeeper.SetLockDurationMigration(s.Ctx, fromDuration, toDuration, nextLockId)
+
+	s.App.LockupKeeper.MigratePendingLockDurations(s.Ctx)
+	for _, lockId := range lockIds {
+		lock, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+		s.Require().NoError(err)
+		s.Require().Equal(toDuration, lock.Duration)
+	}
+
+	// The migration is deleted once all its locks are migrated, so that another one can be started.
+	s.App.IncentivesKeeper.SetLockableDurations(s.Ctx, []time.Duration{time.Hour * 24, toDuration})
+	_, err = s.App.LockupKeeper.MigrateLockDuration(s.Ctx, fromDuration, toDuration)
+	s.Require().NoError(err)
+}
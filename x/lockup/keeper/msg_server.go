@@ -10,6 +10,8 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -248,3 +250,43 @@ func (server msgServer) SetRewardReceiverAddress(goCtx context.Context, msg *typ
 
 	return &types.MsgSetRewardReceiverAddressResponse{Success: true}, nil
 }
+
+// UpdateLockableDurations adds and removes the durations that gauges can distribute incentives to,
+// e.g. to realign incentives without an upgrade. Only the governance module account is authorized.
+func (server msgServer) UpdateLockableDurations(goCtx context.Context, msg *types.MsgUpdateLockableDurations) (*types.MsgUpdateLockableDurationsResponse, error) {
+	if err := checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := server.keeper.UpdateLockableDurations(ctx, msg.AddDurations, msg.RemoveDurations); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateLockableDurationsResponse{}, nil
+}
+
+// MigrateLockDuration moves the locks of a duration that is no longer lockable to a shorter nearby lockable duration.
+// Only the governance module account is authorized.
+func (server msgServer) MigrateLockDuration(goCtx context.Context, msg *types.MsgMigrateLockDuration) (*types.MsgMigrateLockDurationResponse, error) {
+	if err := checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	migratedLockIds, err := server.keeper.MigrateLockDuration(ctx, msg.FromDuration, msg.ToDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateLockDurationResponse{MigratedLockIds: migratedLockIds}, nil
+}
+
+// checkAuthority returns an error if the given authority is not the governance module account.
+func checkAuthority(authority string) error {
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	if authority != govAddr {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", govAddr, authority)
+	}
+	return nil
+}
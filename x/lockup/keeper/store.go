@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -203,6 +204,28 @@ func (k Keeper) GetAccountLockedLongerDurationDenomNotUnlockingOnly(ctx sdk.Cont
 	return k.getLocksFromIterator(ctx, k.AccountLockIteratorLongerDurationDenom(ctx, false, addr, denom, duration))
 }
 
+// GetAccountLockedDurationBuckets Returns the locked coins of an account grouped by lock duration, sorted by ascending duration.
+// Both locks that have started unlocking and those that have not are included, as long as they have not matured yet.
+func (k Keeper) GetAccountLockedDurationBuckets(ctx sdk.Context, addr sdk.AccAddress) []types.LockedDurationBucket {
+	buckets := []types.LockedDurationBucket{}
+	bucketIndexes := map[time.Duration]int{}
+	for _, lock := range k.GetAccountLockedPastTime(ctx, addr, ctx.BlockTime()) {
+		index, ok := bucketIndexes[lock.Duration]
+		if !ok {
+			index = len(buckets)
+			bucketIndexes[lock.Duration] = index
+			buckets = append(buckets, types.LockedDurationBucket{Duration: lock.Duration, Coins: sdk.NewCoins()})
+		}
+		buckets[index].Coins = buckets[index].Coins.Add(lock.Coins...)
+		buckets[index].NumLocks++
+	}
+
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Duration < buckets[j].Duration
+	})
+	return buckets
+}
+
 // GetLocksPastTimeDenom Returns the locks whose unlock time is beyond timestamp.
 func (k Keeper) GetLocksPastTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []types.PeriodLock {
 	// returns both unlocking started and not started assuming it started unlocking current time
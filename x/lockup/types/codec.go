@@ -15,6 +15,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgExtendLockup{}, "osmosis/lockup/extend-lockup", nil)
 	cdc.RegisterConcrete(&MsgForceUnlock{}, "osmosis/lockup/force-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgSetRewardReceiverAddress{}, "osmosis/lockup/set-reward-receiver-address", nil)
+	cdc.RegisterConcrete(&MsgUpdateLockableDurations{}, "osmosis/lockup/update-lockable-durations", nil)
+	cdc.RegisterConcrete(&MsgMigrateLockDuration{}, "osmosis/lockup/migrate-lock-duration", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,6 +28,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgExtendLockup{},
 		&MsgForceUnlock{},
 		&MsgSetRewardReceiverAddress{},
+		&MsgUpdateLockableDurations{},
+		&MsgMigrateLockDuration{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
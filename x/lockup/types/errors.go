@@ -13,4 +13,8 @@ var (
 	ErrSyntheticDurationLongerThanNative = errorsmod.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = errorsmod.Register(ModuleName, 4, "lockup not found")
 	ErrRewardReceiverIsSame              = errorsmod.Register(ModuleName, 5, "reward receiver is the same")
+	ErrUnauthorized                      = errorsmod.Register(ModuleName, 6, "unauthorized")
+	ErrLockableDurationExists            = errorsmod.Register(ModuleName, 7, "lockable duration already exists")
+	ErrLockableDurationNotFound          = errorsmod.Register(ModuleName, 8, "lockable duration not found")
+	ErrNoLockableDurations               = errorsmod.Register(ModuleName, 9, "at least one lockable duration is required")
 )
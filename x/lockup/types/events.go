@@ -7,10 +7,18 @@ const (
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
 
+	TypeEvtUpdateLockableDurations = "update_lockable_durations"
+	TypeEvtMigrateLockDuration     = "migrate_lock_duration"
+
 	AttributePeriodLockID         = "period_lock_id"
 	AttributePeriodLockOwner      = "owner"
 	AttributePeriodLockAmount     = "amount"
 	AttributePeriodLockDuration   = "duration"
 	AttributePeriodLockUnlockTime = "unlock_time"
 	AttributeUnlockedCoins        = "unlocked_coins"
+
+	AttributeAddedDurations         = "added_durations"
+	AttributeRemovedDurations       = "removed_durations"
+	AttributeLockableDurations      = "lockable_durations"
+	AttributePeriodLockPrevDuration = "prev_duration"
 )
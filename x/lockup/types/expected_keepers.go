@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -24,3 +26,9 @@ type BankKeeper interface {
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// LockableDurationsKeeper defines the expected interface needed to manage the durations that gauges can distribute incentives to.
+type LockableDurationsKeeper interface {
+	GetLockableDurations(ctx sdk.Context) []time.Duration
+	SetLockableDurations(ctx sdk.Context, lockableDurations []time.Duration)
+}
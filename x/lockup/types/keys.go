@@ -61,9 +61,15 @@ var (
 	// KeyPrefixSyntheticLockTimestamp defines prefix for the iteration of synthetic lockups by timestamp.
 	KeyPrefixSyntheticLockTimestamp = []byte{0x10}
 
+	// KeyPrefixLockDurationMigration defines prefix to store the pending lock duration migrations by from duration.
+	KeyPrefixLockDurationMigration = []byte{0x11}
+
 	// KeyPrefixLockAccumulation defines prefix for the lock accumulation store.
 	KeyPrefixLockAccumulation = []byte{0x20}
 
 	// KeyIndexSeparator defines separator between keys when combine, it should be one that is not used in denom expression.
 	KeyIndexSeparator = []byte{0xFF}
 )
+
+// MaxLockDurationMigrationsPerBlock bounds the number of locks a lock duration migration iterates over in a block.
+const MaxLockDurationMigrationsPerBlock = uint64(500)
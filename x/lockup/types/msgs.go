@@ -17,6 +17,8 @@ const (
 	TypeMsgExtendLockup             = "edit_lockup"
 	TypeForceUnlock                 = "force_unlock"
 	TypeMsgSetRewardReceiverAddress = "set_reward_receiver_address"
+	TypeMsgUpdateLockableDurations  = "update_lockable_durations"
+	TypeMsgMigrateLockDuration      = "migrate_lock_duration"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -242,3 +244,83 @@ func (m MsgSetRewardReceiverAddress) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgUpdateLockableDurations{}
+
+// NewMsgUpdateLockableDurations creates a message for adding and removing lockable durations via governance.
+func NewMsgUpdateLockableDurations(authority string, addDurations, removeDurations []time.Duration) *MsgUpdateLockableDurations {
+	return &MsgUpdateLockableDurations{
+		Authority:       authority,
+		AddDurations:    addDurations,
+		RemoveDurations: removeDurations,
+	}
+}
+
+func (m MsgUpdateLockableDurations) Route() string { return RouterKey }
+func (m MsgUpdateLockableDurations) Type() string  { return TypeMsgUpdateLockableDurations }
+func (m MsgUpdateLockableDurations) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if len(m.AddDurations) == 0 && len(m.RemoveDurations) == 0 {
+		return fmt.Errorf("at least one duration to add or remove is required")
+	}
+
+	for _, duration := range m.AddDurations {
+		if duration <= 0 {
+			return fmt.Errorf("duration should be positive: %d < 0", duration)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgUpdateLockableDurations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgUpdateLockableDurations) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgMigrateLockDuration{}
+
+// NewMsgMigrateLockDuration creates a message for migrating locks between durations via governance.
+func NewMsgMigrateLockDuration(authority string, fromDuration, toDuration time.Duration) *MsgMigrateLockDuration {
+	return &MsgMigrateLockDuration{
+		Authority:    authority,
+		FromDuration: fromDuration,
+		ToDuration:   toDuration,
+	}
+}
+
+func (m MsgMigrateLockDuration) Route() string { return RouterKey }
+func (m MsgMigrateLockDuration) Type() string  { return TypeMsgMigrateLockDuration }
+func (m MsgMigrateLockDuration) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", err)
+	}
+
+	if m.FromDuration <= 0 || m.ToDuration <= 0 {
+		return fmt.Errorf("durations should be positive: from %d, to %d", m.FromDuration, m.ToDuration)
+	}
+
+	if m.ToDuration >= m.FromDuration {
+		return fmt.Errorf("to duration should be shorter than from duration: from %d, to %d", m.FromDuration, m.ToDuration)
+	}
+
+	return nil
+}
+
+func (m MsgMigrateLockDuration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMigrateLockDuration) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{authority}
+}
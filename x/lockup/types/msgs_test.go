@@ -263,6 +263,130 @@ func TestMsgExtendLockup(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateLockableDurations(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgUpdateLockableDurations
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgUpdateLockableDurations{
+				Authority:       addr1,
+				AddDurations:    []time.Duration{time.Hour * 24 * 7},
+				RemoveDurations: []time.Duration{time.Hour},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateLockableDurations{
+				Authority:    invalidAddr,
+				AddDurations: []time.Duration{time.Hour * 24 * 7},
+			},
+		},
+		{
+			name: "no durations",
+			msg: types.MsgUpdateLockableDurations{
+				Authority: addr1,
+			},
+		},
+		{
+			name: "invalid duration to add",
+			msg: types.MsgUpdateLockableDurations{
+				Authority:    addr1,
+				AddDurations: []time.Duration{0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "update_lockable_durations")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
+func TestMsgMigrateLockDuration(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	addr1, invalidAddr := apptesting.GenerateTestAddrs()
+
+	tests := []struct {
+		name       string
+		msg        types.MsgMigrateLockDuration
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgMigrateLockDuration{
+				Authority:    addr1,
+				FromDuration: time.Hour * 24 * 10,
+				ToDuration:   time.Hour * 24 * 7,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgMigrateLockDuration{
+				Authority:    invalidAddr,
+				FromDuration: time.Hour * 24 * 3,
+				ToDuration:   time.Hour * 24 * 7,
+			},
+		},
+		{
+			name: "invalid duration",
+			msg: types.MsgMigrateLockDuration{
+				Authority:    addr1,
+				FromDuration: time.Hour * 24 * 3,
+				ToDuration:   -1,
+			},
+		},
+		{
+			name: "same durations",
+			msg: types.MsgMigrateLockDuration{
+				Authority:    addr1,
+				FromDuration: time.Hour * 24 * 7,
+				ToDuration:   time.Hour * 24 * 7,
+			},
+		},
+		{
+			name: "longer to duration",
+			msg: types.MsgMigrateLockDuration{
+				Authority:    addr1,
+				FromDuration: time.Hour * 24 * 3,
+				ToDuration:   time.Hour * 24 * 7,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expectPass {
+				require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+				require.Equal(t, test.msg.Route(), types.RouterKey)
+				require.Equal(t, test.msg.Type(), "migrate_lock_duration")
+				signers := test.msg.GetSigners()
+				require.Equal(t, len(signers), 1)
+				require.Equal(t, signers[0].String(), addr1)
+			} else {
+				require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			}
+		})
+	}
+}
+
 // // Test authz serialize and de-serializes for lockup msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -1868,6 +1868,159 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+type AccountLockedDurationBucketsRequest struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+}
+
+func (m *AccountLockedDurationBucketsRequest) Reset()         { *m = AccountLockedDurationBucketsRequest{} }
+func (m *AccountLockedDurationBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountLockedDurationBucketsRequest) ProtoMessage()    {}
+func (*AccountLockedDurationBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{40}
+}
+func (m *AccountLockedDurationBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLockedDurationBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLockedDurationBucketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLockedDurationBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLockedDurationBucketsRequest.Merge(m, src)
+}
+func (m *AccountLockedDurationBucketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLockedDurationBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLockedDurationBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLockedDurationBucketsRequest proto.InternalMessageInfo
+
+func (m *AccountLockedDurationBucketsRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+type AccountLockedDurationBucketsResponse struct {
+	Buckets []LockedDurationBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *AccountLockedDurationBucketsResponse) Reset()         { *m = AccountLockedDurationBucketsResponse{} }
+func (m *AccountLockedDurationBucketsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountLockedDurationBucketsResponse) ProtoMessage()    {}
+func (*AccountLockedDurationBucketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{41}
+}
+func (m *AccountLockedDurationBucketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountLockedDurationBucketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountLockedDurationBucketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountLockedDurationBucketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountLockedDurationBucketsResponse.Merge(m, src)
+}
+func (m *AccountLockedDurationBucketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountLockedDurationBucketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountLockedDurationBucketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountLockedDurationBucketsResponse proto.InternalMessageInfo
+
+func (m *AccountLockedDurationBucketsResponse) GetBuckets() []LockedDurationBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// LockedDurationBucket aggregates the locks of an account sharing the same
+// duration
+type LockedDurationBucket struct {
+	// Duration shared by all locks of the bucket
+	Duration time.Duration `protobuf:"bytes,1,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// Total coins locked with the duration
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// Number of locks with the duration
+	NumLocks uint64 `protobuf:"varint,3,opt,name=num_locks,json=numLocks,proto3" json:"num_locks,omitempty" yaml:"num_locks"`
+}
+
+func (m *LockedDurationBucket) Reset()         { *m = LockedDurationBucket{} }
+func (m *LockedDurationBucket) String() string { return proto.CompactTextString(m) }
+func (*LockedDurationBucket) ProtoMessage()    {}
+func (*LockedDurationBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{42}
+}
+func (m *LockedDurationBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedDurationBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedDurationBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedDurationBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedDurationBucket.Merge(m, src)
+}
+func (m *LockedDurationBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedDurationBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedDurationBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedDurationBucket proto.InternalMessageInfo
+
+func (m *LockedDurationBucket) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *LockedDurationBucket) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *LockedDurationBucket) GetNumLocks() uint64 {
+	if m != nil {
+		return m.NumLocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
@@ -1909,117 +2062,127 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
+	proto.RegisterType((*AccountLockedDurationBucketsRequest)(nil), "osmosis.lockup.AccountLockedDurationBucketsRequest")
+	proto.RegisterType((*AccountLockedDurationBucketsResponse)(nil), "osmosis.lockup.AccountLockedDurationBucketsResponse")
+	proto.RegisterType((*LockedDurationBucket)(nil), "osmosis.lockup.LockedDurationBucket")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0x14, 0x55,
-	0x1c, 0xef, 0x2b, 0xb4, 0xca, 0x17, 0x5b, 0xf0, 0x51, 0xb0, 0x9d, 0x96, 0xdd, 0xf2, 0x80, 0x52,
-	0xb5, 0x9d, 0xa1, 0xa5, 0x29, 0x88, 0xfc, 0x5c, 0x4a, 0x4d, 0xb1, 0x22, 0x2c, 0xa8, 0xf1, 0x57,
-	0x36, 0xb3, 0xbb, 0x8f, 0x65, 0xd2, 0xdd, 0x99, 0x65, 0x67, 0xb6, 0xb0, 0x12, 0x24, 0x82, 0x07,
-	0x0f, 0x1e, 0x30, 0x5e, 0x8c, 0x07, 0xa3, 0xde, 0xd4, 0xc4, 0x78, 0xf1, 0x40, 0xbc, 0x2b, 0xf1,
-	0x60, 0x48, 0xbc, 0x18, 0x0f, 0xc5, 0x50, 0xff, 0x02, 0x4e, 0x26, 0x5e, 0xcc, 0xbc, 0xf7, 0x66,
-	0xbb, 0xf3, 0x73, 0x67, 0xbb, 0xd2, 0xf4, 0xd4, 0xce, 0x7c, 0x7f, 0x7d, 0x3e, 0xdf, 0xf7, 0xf3,
-	0x33, 0x0b, 0x92, 0x61, 0x96, 0x0c, 0x53, 0x33, 0x95, 0xa2, 0x91, 0x5b, 0xa8, 0x96, 0x95, 0x2b,
-	0x55, 0x5a, 0xa9, 0xc9, 0xe5, 0x8a, 0x61, 0x19, 0xb8, 0x57, 0xd8, 0x64, 0x6e, 0x93, 0xfa, 0x0a,
-	0x46, 0xc1, 0x60, 0x26, 0xc5, 0xfe, 0x8f, 0x7b, 0x49, 0x89, 0x1c, 0x73, 0x53, 0xb2, 0xaa, 0x49,
-	0x95, 0xc5, 0x89, 0x2c, 0xb5, 0xd4, 0x09, 0x25, 0x67, 0x68, 0xba, 0xb0, 0x0f, 0x15, 0x0c, 0xa3,
-	0x50, 0xa4, 0x8a, 0x5a, 0xd6, 0x14, 0x55, 0xd7, 0x0d, 0x4b, 0xb5, 0x34, 0x43, 0x37, 0x85, 0x35,
-	0x29, 0xac, 0xec, 0x29, 0x5b, 0xbd, 0xa4, 0x58, 0x5a, 0x89, 0x9a, 0x96, 0x5a, 0x2a, 0x3b, 0xe9,
-	0xbd, 0x0e, 0xf9, 0x6a, 0x85, 0x65, 0x10, 0xf6, 0x01, 0x0f, 0x01, 0xfb, 0x8f, 0x30, 0x0d, 0x7a,
-	0x4c, 0x65, 0xb5, 0xa2, 0x96, 0x44, 0x61, 0xb2, 0x03, 0xfa, 0x5e, 0x31, 0xf2, 0xd5, 0x22, 0x4d,
-	0xa9, 0x45, 0x55, 0xcf, 0xd1, 0x34, 0xbd, 0x52, 0xa5, 0xa6, 0x45, 0xde, 0x83, 0xed, 0x9e, 0xf7,
-	0x66, 0xd9, 0xd0, 0x4d, 0x8a, 0x55, 0xe8, 0xb2, 0x59, 0x99, 0xfd, 0x68, 0x78, 0xc3, 0xe8, 0xe6,
-	0xc9, 0x01, 0x99, 0xf3, 0x96, 0x6d, 0xde, 0xb2, 0xe0, 0x2d, 0x9f, 0x32, 0x34, 0x3d, 0xb5, 0xff,
-	0xde, 0x52, 0xb2, 0xe3, 0xdb, 0x07, 0xc9, 0xd1, 0x82, 0x66, 0x5d, 0xae, 0x66, 0xe5, 0x9c, 0x51,
-	0x52, 0x44, 0x93, 0xf8, 0x9f, 0x71, 0x33, 0xbf, 0xa0, 0x58, 0xb5, 0x32, 0x35, 0x59, 0x80, 0x99,
-	0xe6, 0x99, 0xc9, 0x20, 0x0c, 0xf0, 0xda, 0xf3, 0x46, 0x6e, 0x81, 0xe6, 0x4f, 0x96, 0x8c, 0xaa,
-	0x6e, 0x39, 0xc0, 0x6e, 0x82, 0x14, 0x64, 0x5c, 0x3b, 0x74, 0x2f, 0xc1, 0xce, 0x93, 0xb9, 0x9c,
-	0x5d, 0xf5, 0x35, 0xdd, 0xee, 0xa8, 0x9a, 0x2d, 0x52, 0xee, 0xc0, 0x11, 0xe2, 0x11, 0xe8, 0x32,
-	0xae, 0xea, 0xb4, 0xd2, 0x8f, 0x86, 0xd1, 0xe8, 0xa6, 0xd4, 0xd6, 0x47, 0x4b, 0xc9, 0xa7, 0x6a,
-	0x6a, 0xa9, 0x78, 0x98, 0xb0, 0xd7, 0x24, 0xcd, 0xcd, 0xe4, 0x36, 0x82, 0x44, 0x58, 0xa6, 0xb5,
-	0xa3, 0x33, 0x0b, 0x43, 0x2e, 0x10, 0x9a, 0x5e, 0x58, 0x15, 0x9b, 0x5b, 0xc8, 0xd3, 0x97, 0x95,
-	0x44, 0x6b, 0x47, 0xe6, 0x14, 0x0c, 0x08, 0x0c, 0x7c, 0x76, 0xac, 0x8a, 0xc9, 0x4d, 0x90, 0x82,
-	0x92, 0xac, 0x1d, 0x8b, 0x2f, 0x50, 0x7d, 0x4c, 0x38, 0x82, 0x73, 0xaa, 0x69, 0x5d, 0xd4, 0x4a,
-	0xb4, 0x45, 0x26, 0xf8, 0x75, 0xd8, 0x54, 0xdf, 0x47, 0xfa, 0x3b, 0x87, 0xd1, 0xe8, 0xe6, 0x49,
-	0x49, 0xe6, 0x1b, 0x89, 0xec, 0x6c, 0x24, 0xf2, 0x45, 0xc7, 0x23, 0x35, 0x64, 0x03, 0x7e, 0xb4,
-	0x94, 0xdc, 0xca, 0x73, 0xd5, 0x43, 0xc9, 0x9d, 0x07, 0x49, 0x94, 0x5e, 0x49, 0x45, 0xde, 0xa8,
-	0x0f, 0xb5, 0x17, 0x9f, 0x68, 0xd2, 0x34, 0x74, 0xd9, 0x53, 0xc0, 0x69, 0x92, 0x24, 0xbb, 0xb7,
-	0x50, 0xf9, 0x1c, 0xad, 0x68, 0x46, 0xde, 0x0e, 0x4e, 0x6d, 0xb4, 0x8b, 0xa6, 0xb9, 0x3b, 0xf9,
-	0x1e, 0xc1, 0x58, 0x60, 0xe6, 0xb3, 0xc6, 0xca, 0xac, 0x7a, 0x55, 0x2f, 0xd6, 0xd6, 0x4b, 0x27,
-	0x0a, 0x30, 0x1e, 0x13, 0x6f, 0x9b, 0x9d, 0xf9, 0x1a, 0xc1, 0xb0, 0x6b, 0x79, 0xd1, 0x7c, 0x8a,
-	0x5e, 0x32, 0x2a, 0x74, 0x3d, 0xcd, 0x8b, 0xb7, 0x61, 0x57, 0x04, 0xc6, 0x36, 0x3b, 0x70, 0x17,
-	0xd5, 0xb3, 0xbb, 0x7b, 0x3d, 0x43, 0x75, 0xa3, 0xb4, 0x4e, 0x5a, 0x80, 0xfb, 0xa0, 0x2b, 0x6f,
-	0xe3, 0xe9, 0xdf, 0x60, 0xd7, 0x4f, 0xf3, 0x07, 0xf2, 0x0e, 0x90, 0x28, 0xe8, 0x6d, 0x76, 0xe6,
-	0x7d, 0xc0, 0x3c, 0xad, 0xab, 0x13, 0x75, 0x24, 0xa8, 0x01, 0x09, 0x4e, 0xc3, 0x93, 0xce, 0xcd,
-	0x41, 0xd0, 0x1e, 0xf0, 0xd1, 0x9e, 0x11, 0x0e, 0xa9, 0x41, 0xc1, 0x7a, 0x0b, 0x67, 0xed, 0x04,
-	0x92, 0xcf, 0x6c, 0xd2, 0xf5, 0x3c, 0xe4, 0x5d, 0xd8, 0xe6, 0xaa, 0x2f, 0xe8, 0xcc, 0x42, 0xb7,
-	0xca, 0x4e, 0x67, 0x31, 0x16, 0xb2, 0x9d, 0xed, 0xcf, 0xa5, 0xe4, 0x76, 0xbe, 0xfb, 0x99, 0xf9,
-	0x05, 0x59, 0x33, 0x94, 0x92, 0x6a, 0x5d, 0x96, 0xe7, 0x74, 0xeb, 0xd1, 0x52, 0xb2, 0x87, 0x97,
-	0xe1, 0x41, 0x24, 0x2d, 0xa2, 0xc9, 0x28, 0xf4, 0xf0, 0xf4, 0x0e, 0xb3, 0x67, 0xe0, 0x09, 0x9b,
-	0x78, 0x46, 0xcb, 0xb3, 0xcc, 0x1b, 0xd3, 0xdd, 0xf6, 0xe3, 0x5c, 0x9e, 0x9c, 0x80, 0x5e, 0xc7,
-	0x53, 0x60, 0x90, 0x61, 0xa3, 0x6d, 0x63, 0x7e, 0x91, 0x1d, 0x4d, 0x33, 0x3f, 0x32, 0x05, 0x03,
-	0xec, 0x89, 0x5e, 0x55, 0x2b, 0xf9, 0x34, 0xcd, 0x51, 0x6d, 0x91, 0x56, 0x9a, 0xd6, 0x3d, 0x0d,
-	0x52, 0x50, 0x94, 0xc0, 0xb0, 0x0f, 0xb6, 0x54, 0x98, 0x25, 0x53, 0x11, 0x26, 0x31, 0x24, 0xbd,
-	0x15, 0x57, 0x00, 0xd9, 0x06, 0x4f, 0x9f, 0xa5, 0xd7, 0xd8, 0x14, 0x99, 0x9b, 0x71, 0xee, 0x3b,
-	0xe3, 0x80, 0x1b, 0x5f, 0x8a, 0x9c, 0x11, 0x2d, 0xd8, 0x75, 0xa1, 0xa6, 0x5b, 0x97, 0xa9, 0xa5,
-	0xe5, 0xe6, 0x19, 0x49, 0x33, 0x55, 0xe3, 0xff, 0xd4, 0x73, 0x86, 0x46, 0x1f, 0xee, 0xec, 0x47,
-	0x64, 0x11, 0x48, 0x54, 0x06, 0x01, 0x60, 0x1e, 0xb6, 0x98, 0x8e, 0x57, 0xa6, 0x71, 0xd6, 0xee,
-	0xf4, 0xf6, 0xd8, 0x95, 0x4c, 0x4c, 0xdc, 0x5e, 0xb3, 0xf1, 0xa5, 0xc9, 0xea, 0xbe, 0x08, 0xc3,
-	0x9e, 0xba, 0xf1, 0x81, 0x13, 0xc3, 0x47, 0x3b, 0x00, 0xf3, 0x19, 0xe8, 0x75, 0x63, 0x16, 0xd3,
-	0x22, 0x16, 0xe4, 0x1e, 0x17, 0x64, 0xf2, 0x25, 0xf2, 0x2c, 0xe9, 0x79, 0x43, 0x2f, 0xd0, 0x8a,
-	0xb3, 0x74, 0x5a, 0xdd, 0x8e, 0x1e, 0xcf, 0xb2, 0xdc, 0x1d, 0x89, 0xb0, 0xcd, 0x5d, 0xe7, 0x73,
-	0xef, 0x2d, 0x65, 0x3d, 0x71, 0xf7, 0xde, 0x50, 0xfe, 0x37, 0xd6, 0x3f, 0x20, 0x98, 0x8c, 0xe8,
-	0x6a, 0xbb, 0xf7, 0x94, 0xc7, 0xd1, 0x8b, 0x12, 0x1c, 0x68, 0x09, 0x71, 0x9b, 0x1d, 0xfa, 0x09,
-	0xc1, 0xbe, 0x88, 0x7a, 0xab, 0x3a, 0xad, 0x1f, 0x43, 0x5b, 0x42, 0x4e, 0xea, 0x2c, 0x8c, 0x36,
-	0x07, 0xdf, 0x66, 0x87, 0xfa, 0x00, 0x9f, 0xaf, 0xd2, 0x4a, 0xed, 0x1c, 0x13, 0xe2, 0xce, 0x46,
-	0xff, 0x32, 0x6c, 0x73, 0xbd, 0x15, 0x45, 0xa6, 0xa0, 0x9b, 0x0b, 0x76, 0xb1, 0x59, 0xed, 0xf0,
-	0x55, 0x61, 0x56, 0x51, 0x41, 0xf8, 0x4e, 0xfe, 0x3b, 0x04, 0x5d, 0x2c, 0x1b, 0xfe, 0x18, 0x41,
-	0x8f, 0x4b, 0xc9, 0xe3, 0x3d, 0xde, 0x0c, 0x41, 0x1f, 0x00, 0xa4, 0xbd, 0x4d, 0xbc, 0x38, 0x3c,
-	0x22, 0xdf, 0xfa, 0xfd, 0xef, 0x4f, 0x3b, 0x47, 0xf1, 0x88, 0xe2, 0xf9, 0xca, 0xe0, 0x7c, 0x02,
-	0x29, 0xb1, 0xb0, 0x4c, 0x56, 0x14, 0xff, 0x0a, 0x01, 0xf6, 0xeb, 0x77, 0xfc, 0x6c, 0x70, 0xb5,
-	0x80, 0x0f, 0x00, 0xd2, 0x73, 0x71, 0x5c, 0x05, 0xba, 0x29, 0x86, 0x4e, 0xc6, 0x63, 0x4d, 0xd0,
-	0xf1, 0xcb, 0x6a, 0x86, 0x5f, 0x38, 0xf0, 0x5d, 0x04, 0x3b, 0x82, 0x85, 0x39, 0x1e, 0xf7, 0x16,
-	0x8f, 0xfc, 0x14, 0x20, 0xc9, 0x71, 0xdd, 0x05, 0xde, 0x13, 0x0c, 0xef, 0x61, 0x7c, 0x28, 0x0c,
-	0xaf, 0xca, 0xe3, 0x33, 0xd5, 0x7a, 0x82, 0x0c, 0xd3, 0x8c, 0xca, 0x75, 0xb6, 0x50, 0x6e, 0xe0,
-	0x1f, 0x11, 0x6c, 0x0f, 0x94, 0xe1, 0x78, 0x2c, 0x12, 0x8b, 0x47, 0xf6, 0x4b, 0xe3, 0x31, 0xbd,
-	0x05, 0xf0, 0xe3, 0x0c, 0xf8, 0x0b, 0xf8, 0x60, 0x3c, 0xe0, 0x9a, 0x5e, 0xf0, 0xe0, 0xfe, 0x06,
-	0x01, 0xf6, 0xab, 0x6e, 0xff, 0xbc, 0x08, 0x95, 0xf7, 0xfe, 0x79, 0x11, 0x2e, 0xe2, 0xc9, 0x11,
-	0x06, 0x77, 0x1a, 0x4f, 0x35, 0x83, 0x2b, 0x26, 0x46, 0x68, 0x8f, 0xdd, 0xd7, 0xf9, 0xd0, 0x1e,
-	0x07, 0xca, 0xf8, 0xd0, 0x1e, 0x07, 0x8b, 0xea, 0xf8, 0x3d, 0x16, 0xa0, 0xcb, 0xaa, 0x69, 0xd9,
-	0xc2, 0xa4, 0x8e, 0xfb, 0x1f, 0x04, 0x7b, 0x63, 0xa9, 0x55, 0x7c, 0x24, 0x16, 0xb2, 0x90, 0xc3,
-	0x4e, 0x3a, 0xba, 0xca, 0x68, 0xc1, 0x33, 0xcd, 0x78, 0xce, 0xe3, 0x33, 0x2d, 0xf2, 0xcc, 0xe8,
-	0x46, 0xe3, 0xfc, 0x32, 0xf4, 0x62, 0xad, 0x4e, 0xfd, 0x67, 0x54, 0xff, 0x32, 0xe4, 0x97, 0xa6,
-	0x78, 0x7f, 0xe4, 0x64, 0x0f, 0x50, 0xda, 0xd2, 0x44, 0x0b, 0x11, 0x82, 0xd6, 0x0c, 0xa3, 0x75,
-	0x0c, 0x1f, 0x89, 0xb7, 0x44, 0x68, 0x3e, 0x93, 0x65, 0x49, 0x32, 0xae, 0x31, 0xfc, 0x15, 0x79,
-	0xbe, 0x4e, 0xb9, 0xa4, 0x24, 0x9e, 0x88, 0xd5, 0xfa, 0xc6, 0x33, 0x58, 0x9a, 0x6c, 0x25, 0x44,
-	0x70, 0x39, 0xcd, 0xb8, 0x1c, 0xc7, 0x47, 0x5b, 0x1d, 0x22, 0x76, 0xc8, 0xd6, 0xc9, 0x7c, 0x88,
-	0x60, 0x73, 0x83, 0x72, 0xc4, 0xc4, 0x0b, 0xc5, 0x2f, 0x6b, 0xa5, 0xdd, 0x91, 0x3e, 0x02, 0xdf,
-	0x18, 0xc3, 0x37, 0x82, 0xf7, 0x84, 0xe1, 0x13, 0xb8, 0xb8, 0x26, 0xbe, 0x8d, 0x00, 0x78, 0x96,
-	0x54, 0x6d, 0x6e, 0x06, 0xef, 0x0c, 0xae, 0xe0, 0x00, 0x48, 0x84, 0x99, 0x45, 0xed, 0x69, 0x56,
-	0x7b, 0x3f, 0x96, 0x9b, 0xd4, 0xce, 0xd6, 0x32, 0x5a, 0x5e, 0xb9, 0x2e, 0xf4, 0xcc, 0x0d, 0xfc,
-	0x1d, 0xe2, 0x32, 0xde, 0xad, 0x22, 0xfd, 0x3b, 0x60, 0xa8, 0x3e, 0xf5, 0xef, 0x80, 0xe1, 0xa2,
-	0x94, 0x1c, 0x63, 0x28, 0x0f, 0xe1, 0xe9, 0x28, 0x94, 0x19, 0x8f, 0x6e, 0x6d, 0x40, 0xfb, 0x01,
-	0x02, 0x58, 0xd1, 0xa5, 0x78, 0x97, 0xb7, 0xb4, 0x4f, 0xc8, 0x4a, 0x24, 0xca, 0x25, 0xee, 0xb8,
-	0xe9, 0xf4, 0x1a, 0x9f, 0x54, 0x19, 0x2d, 0x8f, 0x7f, 0x43, 0x20, 0x85, 0x4b, 0x55, 0xff, 0x5a,
-	0x68, 0x2a, 0x8c, 0xfd, 0x6b, 0xa1, 0xb9, 0x12, 0x26, 0x73, 0x0c, 0xf3, 0x09, 0x7c, 0x2c, 0x0c,
-	0xb3, 0x5b, 0x73, 0x56, 0xcb, 0xa6, 0x3d, 0xf4, 0x82, 0xc3, 0x4a, 0x47, 0x3f, 0xea, 0x44, 0xf8,
-	0x17, 0x04, 0x03, 0xa1, 0x32, 0xd6, 0xbf, 0x4b, 0x35, 0x93, 0xcb, 0xd2, 0x44, 0x0b, 0x11, 0x71,
-	0x57, 0xb6, 0x97, 0x4d, 0x20, 0x19, 0x7b, 0x68, 0x06, 0x23, 0xee, 0xd1, 0x38, 0x7a, 0xd3, 0x09,
-	0xd4, 0xd2, 0xd2, 0x81, 0x96, 0x62, 0x04, 0x9f, 0xd9, 0x66, 0xa3, 0xe3, 0xd9, 0xa9, 0x8a, 0x2c,
-	0x4d, 0xc6, 0x51, 0x09, 0xe1, 0x67, 0x7e, 0x9d, 0x4a, 0xf4, 0x99, 0xef, 0x25, 0x31, 0x1e, 0xd3,
-	0x7b, 0x95, 0x67, 0xbe, 0x0f, 0xf7, 0x27, 0x9d, 0xf0, 0x7c, 0x0b, 0xea, 0x0f, 0xa7, 0x5a, 0x68,
-	0x72, 0xd8, 0xf9, 0x7f, 0xaa, 0xad, 0x1c, 0x82, 0xf9, 0x9b, 0x8c, 0xf9, 0x05, 0x7c, 0x7e, 0x75,
-	0x03, 0x17, 0x75, 0x19, 0x58, 0x5e, 0xf9, 0x96, 0x1e, 0x2a, 0xf2, 0xf0, 0xc1, 0x16, 0x48, 0xb8,
-	0x0e, 0xa8, 0x43, 0xad, 0x07, 0x0a, 0xca, 0xf3, 0x8c, 0xf2, 0x2c, 0x9e, 0x59, 0x25, 0x65, 0xf7,
-	0xe1, 0x5a, 0x83, 0x6e, 0x2e, 0x0d, 0xfd, 0xc7, 0xaa, 0x5f, 0x7d, 0xfa, 0x8f, 0xd5, 0x00, 0x2d,
-	0x4a, 0x46, 0x18, 0xc0, 0x61, 0x9c, 0x08, 0x03, 0xc8, 0xd5, 0x67, 0x6a, 0xfe, 0xde, 0xc3, 0x04,
-	0xba, 0xff, 0x30, 0x81, 0xfe, 0x7a, 0x98, 0x40, 0x77, 0x96, 0x13, 0x1d, 0xf7, 0x97, 0x13, 0x1d,
-	0x7f, 0x2c, 0x27, 0x3a, 0xde, 0x9a, 0x6c, 0xf8, 0x2d, 0x4c, 0xe4, 0x18, 0x2f, 0xaa, 0x59, 0xb3,
-	0x9e, 0x70, 0x71, 0x72, 0x42, 0xb9, 0xe6, 0xa4, 0x65, 0xbf, 0x8d, 0x65, 0xbb, 0x99, 0xc4, 0x3f,
-	0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xa4, 0x1a, 0x7b, 0xa4, 0x1f, 0x00, 0x00,
+	// 1778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x59, 0xcb, 0x73, 0x14, 0x45,
+	0x1c, 0x66, 0x42, 0x12, 0xe0, 0x87, 0x09, 0xd8, 0x04, 0x4c, 0x26, 0x90, 0x84, 0x06, 0x42, 0xd4,
+	0x64, 0x86, 0x3c, 0x2a, 0x20, 0xf2, 0x5c, 0x42, 0xa8, 0x60, 0x40, 0x58, 0x50, 0xcb, 0x57, 0x6d,
+	0xcd, 0xee, 0x0e, 0xcb, 0x16, 0xbb, 0x33, 0xeb, 0xce, 0x6c, 0x60, 0xa5, 0xf0, 0x81, 0x1e, 0x3c,
+	0x78, 0xc0, 0xf2, 0x62, 0x79, 0xb0, 0xd4, 0x9b, 0x5a, 0x65, 0x79, 0xf1, 0x60, 0x79, 0x57, 0xca,
+	0x83, 0x45, 0xe9, 0xc5, 0xf2, 0x00, 0x96, 0xf8, 0x17, 0x78, 0xf2, 0x68, 0x4f, 0x77, 0xcf, 0x64,
+	0xe7, 0xb9, 0x33, 0xbb, 0x24, 0x95, 0xc3, 0x54, 0x76, 0xa6, 0x7f, 0x8f, 0xef, 0xfb, 0xf5, 0xfb,
+	0x0b, 0x88, 0xba, 0x51, 0xd6, 0x8d, 0xa2, 0x21, 0x97, 0xf4, 0xdc, 0xb5, 0x5a, 0x45, 0x7e, 0xa3,
+	0xa6, 0x56, 0xeb, 0x52, 0xa5, 0xaa, 0x9b, 0x3a, 0xea, 0xe5, 0x6d, 0x12, 0x6b, 0x13, 0xfb, 0x0a,
+	0x7a, 0x41, 0xa7, 0x4d, 0xb2, 0xf5, 0x8b, 0x59, 0x89, 0x43, 0x39, 0x6a, 0x26, 0x67, 0x15, 0x43,
+	0x95, 0x97, 0x26, 0xb3, 0xaa, 0xa9, 0x4c, 0xca, 0x39, 0xbd, 0xa8, 0xf1, 0xf6, 0x9d, 0x05, 0x5d,
+	0x2f, 0x94, 0x54, 0x59, 0xa9, 0x14, 0x65, 0x45, 0xd3, 0x74, 0x53, 0x31, 0x8b, 0xba, 0x66, 0xf0,
+	0xd6, 0x61, 0xde, 0x4a, 0xdf, 0xb2, 0xb5, 0x2b, 0xb2, 0x59, 0x2c, 0xab, 0x86, 0xa9, 0x94, 0x2b,
+	0x76, 0x78, 0xaf, 0x41, 0xbe, 0x56, 0xa5, 0x11, 0x78, 0xfb, 0x80, 0x87, 0x80, 0xf5, 0x87, 0x37,
+	0x0d, 0x7a, 0x9a, 0x2a, 0x4a, 0x55, 0x29, 0xf3, 0xc4, 0x78, 0x07, 0xf4, 0x9d, 0xd3, 0xf3, 0xb5,
+	0x92, 0x9a, 0x52, 0x4a, 0x8a, 0x96, 0x53, 0xd3, 0x2a, 0xa1, 0x6e, 0x98, 0xf8, 0x4d, 0xd8, 0xee,
+	0xf9, 0x6e, 0x54, 0x08, 0x5c, 0x15, 0x29, 0xd0, 0x65, 0xb1, 0x32, 0xfa, 0x85, 0x91, 0xf5, 0x63,
+	0x9b, 0xa7, 0x06, 0x24, 0xc6, 0x5b, 0xb2, 0x78, 0x4b, 0x9c, 0xb7, 0x74, 0x8a, 0x58, 0xa4, 0x0e,
+	0xdc, 0xbd, 0x3f, 0xbc, 0xee, 0xeb, 0x07, 0xc3, 0x63, 0x85, 0xa2, 0x79, 0xb5, 0x96, 0x25, 0x86,
+	0x65, 0x99, 0x17, 0x89, 0xfd, 0x99, 0x30, 0xf2, 0xd7, 0x64, 0xb3, 0x5e, 0x51, 0x0d, 0xea, 0x60,
+	0xa4, 0x59, 0x64, 0x3c, 0x08, 0x03, 0x2c, 0xf7, 0x22, 0x01, 0xac, 0xe6, 0x4f, 0x96, 0xf5, 0x9a,
+	0x66, 0xda, 0xc0, 0xde, 0x06, 0x31, 0xa8, 0x71, 0xf5, 0xd0, 0x9d, 0x81, 0x5d, 0x27, 0x73, 0x39,
+	0x2b, 0xeb, 0x0b, 0x9a, 0x55, 0x51, 0x25, 0x5b, 0x52, 0x99, 0x01, 0x43, 0x88, 0x46, 0xa1, 0x4b,
+	0xbf, 0xae, 0xa9, 0x55, 0x82, 0x41, 0x18, 0xdb, 0x94, 0xda, 0xfa, 0xef, 0xfd, 0xe1, 0xc7, 0xea,
+	0x4a, 0xb9, 0x74, 0x18, 0xd3, 0xcf, 0x38, 0xcd, 0x9a, 0xf1, 0x7b, 0x02, 0x0c, 0x85, 0x45, 0x5a,
+	0x3d, 0x3a, 0xf3, 0xb0, 0xd3, 0x05, 0xa2, 0xa8, 0x15, 0x5a, 0x62, 0x73, 0x5b, 0xf0, 0xd4, 0x65,
+	0x39, 0xd0, 0xea, 0x91, 0x39, 0x05, 0x03, 0x1c, 0x03, 0x1b, 0x1d, 0x2d, 0x31, 0x21, 0x23, 0x2c,
+	0x28, 0xc8, 0xea, 0xb1, 0xf8, 0x4c, 0x70, 0xfa, 0x84, 0x21, 0xb8, 0xa0, 0x18, 0xe6, 0x65, 0xb2,
+	0x20, 0x24, 0x64, 0x82, 0x5e, 0x84, 0x4d, 0xce, 0x3a, 0xd2, 0xdf, 0x41, 0x6c, 0x37, 0x4f, 0x89,
+	0x12, 0x5b, 0x48, 0x24, 0x7b, 0x21, 0x91, 0x2e, 0xdb, 0x16, 0xa9, 0x9d, 0x16, 0x60, 0x12, 0x6b,
+	0x2b, 0x8b, 0xe5, 0xb8, 0xe2, 0x3b, 0x0f, 0x86, 0x85, 0xf4, 0x72, 0x28, 0xfc, 0x92, 0xd3, 0xd5,
+	0x5e, 0x7c, 0xbc, 0x48, 0xb3, 0xd0, 0x65, 0x0d, 0x01, 0xbb, 0x48, 0xa2, 0xe4, 0x5e, 0x42, 0xa5,
+	0x0b, 0x6a, 0xb5, 0xa8, 0xe7, 0x2d, 0xe7, 0x54, 0xa7, 0x95, 0x34, 0xcd, 0xcc, 0xf1, 0xb7, 0x02,
+	0x8c, 0x07, 0x46, 0x3e, 0xaf, 0x2f, 0x8f, 0xaa, 0xe7, 0xb5, 0x52, 0x7d, 0xad, 0x54, 0xa2, 0x00,
+	0x13, 0x31, 0xf1, 0xb6, 0x59, 0x99, 0x2f, 0x05, 0x18, 0x71, 0x4d, 0x2f, 0x35, 0x9f, 0x52, 0xaf,
+	0xe8, 0x55, 0x75, 0x2d, 0x8d, 0x8b, 0x57, 0x61, 0x77, 0x04, 0xc6, 0x36, 0x2b, 0xf0, 0x83, 0xe0,
+	0x44, 0x77, 0xd7, 0x7a, 0x4e, 0xd5, 0xf4, 0xf2, 0x1a, 0x29, 0x01, 0xea, 0x83, 0xae, 0xbc, 0x85,
+	0xa7, 0x7f, 0xbd, 0x95, 0x3f, 0xcd, 0x5e, 0xf0, 0x6b, 0x80, 0xa3, 0xa0, 0xb7, 0x59, 0x99, 0xb7,
+	0x00, 0xb1, 0xb0, 0xae, 0x4a, 0x38, 0x48, 0x84, 0x06, 0x24, 0x28, 0x0d, 0x1b, 0xed, 0x93, 0x03,
+	0xa7, 0x3d, 0xe0, 0xa3, 0x3d, 0xc7, 0x0d, 0x52, 0x83, 0x9c, 0xf5, 0x16, 0xc6, 0xda, 0x76, 0xc4,
+	0x9f, 0x58, 0xa4, 0x9d, 0x38, 0xf8, 0x75, 0xd8, 0xe6, 0xca, 0xcf, 0xe9, 0xcc, 0x43, 0xb7, 0x42,
+	0x77, 0x67, 0xde, 0x17, 0x92, 0x15, 0xed, 0xcf, 0xfb, 0xc3, 0xdb, 0xd9, 0xea, 0x47, 0x16, 0x3f,
+	0xa9, 0xa8, 0xcb, 0x65, 0xc5, 0xbc, 0x2a, 0x2d, 0x68, 0x26, 0x49, 0xd3, 0xc3, 0xd2, 0x30, 0x27,
+	0x9c, 0xe6, 0xde, 0x78, 0x0c, 0x7a, 0x58, 0x78, 0x9b, 0xd9, 0x13, 0xb0, 0xc1, 0x22, 0x9e, 0x29,
+	0xe6, 0x69, 0xe4, 0xce, 0x74, 0xb7, 0xf5, 0xba, 0x90, 0xc7, 0x27, 0xa0, 0xd7, 0xb6, 0xe4, 0x18,
+	0x24, 0xe8, 0xb4, 0xda, 0xa8, 0x5d, 0x64, 0x45, 0xd3, 0xd4, 0x0e, 0xcf, 0xc0, 0x00, 0x7d, 0x53,
+	0xaf, 0x2b, 0x55, 0x12, 0x25, 0xa7, 0x16, 0x97, 0xd4, 0x6a, 0xd3, 0xbc, 0xa7, 0x41, 0x0c, 0xf2,
+	0xe2, 0x18, 0xf6, 0xc3, 0x96, 0x2a, 0x6d, 0xc9, 0x54, 0x79, 0x13, 0xef, 0x92, 0xde, 0xaa, 0xcb,
+	0x01, 0x6f, 0x83, 0xc7, 0xcf, 0xab, 0x37, 0xe8, 0x10, 0x59, 0x98, 0xb3, 0xcf, 0x3b, 0x13, 0x80,
+	0x1a, 0x3f, 0xf2, 0x98, 0x11, 0x25, 0xd8, 0x7d, 0xa9, 0xae, 0x99, 0x57, 0x55, 0xb3, 0x98, 0x5b,
+	0xa4, 0x24, 0x8d, 0x54, 0x9d, 0xfd, 0x70, 0x62, 0x86, 0x7a, 0x1f, 0xee, 0xe8, 0x17, 0xf0, 0x12,
+	0xe0, 0xa8, 0x08, 0x1c, 0xc0, 0x22, 0x6c, 0x31, 0x6c, 0xab, 0x4c, 0xe3, 0xa8, 0xdd, 0xe5, 0xad,
+	0xb1, 0x2b, 0x18, 0x1f, 0xb8, 0xbd, 0x46, 0xe3, 0x47, 0x83, 0xe6, 0x7d, 0x16, 0x46, 0x3c, 0x79,
+	0xe3, 0x03, 0xc7, 0xba, 0x8f, 0x76, 0x00, 0xe6, 0xb3, 0xd0, 0xeb, 0xc6, 0xcc, 0x87, 0x45, 0x2c,
+	0xc8, 0x3d, 0x2e, 0xc8, 0xf8, 0x73, 0xc1, 0x33, 0xa5, 0x17, 0x75, 0xad, 0xa0, 0x56, 0xed, 0xa9,
+	0x93, 0x74, 0x39, 0x5a, 0x99, 0x69, 0xb9, 0x27, 0x12, 0x61, 0x9b, 0xab, 0xce, 0xa7, 0xde, 0x53,
+	0xca, 0x5a, 0xe2, 0xee, 0x3d, 0xa1, 0x3c, 0x32, 0xd6, 0xdf, 0x09, 0x30, 0x15, 0x51, 0xd5, 0x76,
+	0xcf, 0x29, 0x2b, 0x51, 0x8b, 0x32, 0x4c, 0x27, 0x42, 0xdc, 0x66, 0x85, 0x7e, 0x14, 0x60, 0x7f,
+	0x44, 0xbe, 0x96, 0x76, 0xeb, 0x15, 0x28, 0x4b, 0xc8, 0x4e, 0x9d, 0x85, 0xb1, 0xe6, 0xe0, 0xdb,
+	0xac, 0x50, 0x1f, 0xa0, 0x8b, 0x96, 0xbe, 0x70, 0x81, 0x5e, 0xc4, 0xed, 0x85, 0xfe, 0x39, 0xd8,
+	0xe6, 0xfa, 0xca, 0x93, 0xcc, 0x40, 0x37, 0xbb, 0xb0, 0xf3, 0xc5, 0x6a, 0x87, 0x2f, 0x0b, 0x6d,
+	0xe5, 0x19, 0xb8, 0x2d, 0x3e, 0xe7, 0x99, 0xfb, 0x4e, 0x71, 0x6a, 0xe4, 0xcd, 0x4c, 0x7c, 0x25,
+	0x2a, 0xc1, 0xde, 0xe8, 0x70, 0x1c, 0xec, 0x1c, 0x6c, 0xc8, 0xb2, 0x4f, 0xbc, 0x26, 0x7b, 0xbd,
+	0x68, 0x83, 0xfc, 0x39, 0x76, 0xdb, 0x15, 0xbf, 0xd3, 0x01, 0x7d, 0x41, 0x76, 0xae, 0x61, 0x20,
+	0x3c, 0xa2, 0x61, 0xe0, 0xdc, 0xe7, 0x3a, 0x56, 0xea, 0x3e, 0x87, 0x26, 0x61, 0x93, 0x56, 0x2b,
+	0xf3, 0x5d, 0xd2, 0x1a, 0x6d, 0x9d, 0xa9, 0xbe, 0xe5, 0xb3, 0xa4, 0xd3, 0x84, 0xd3, 0x1b, 0xc9,
+	0x6f, 0xba, 0x21, 0x4e, 0xdd, 0x1b, 0x82, 0x2e, 0x3a, 0x1a, 0xd0, 0x87, 0x02, 0xf4, 0xb8, 0x94,
+	0x18, 0xe4, 0xab, 0x69, 0x90, 0x80, 0x23, 0xee, 0x6b, 0x62, 0xc5, 0x7a, 0x0c, 0x4b, 0xb7, 0x7f,
+	0xff, 0xe7, 0xe3, 0x8e, 0x31, 0x34, 0x2a, 0x7b, 0x54, 0x22, 0x5b, 0xc2, 0x2a, 0x53, 0xb7, 0x4c,
+	0x96, 0x27, 0xff, 0x42, 0x00, 0xe4, 0xd7, 0x5f, 0xd0, 0x93, 0xc1, 0xd9, 0x02, 0x04, 0x1c, 0xf1,
+	0xa9, 0x38, 0xa6, 0x1c, 0xdd, 0x0c, 0x45, 0x27, 0xa1, 0xf1, 0x26, 0xe8, 0xd8, 0x65, 0x23, 0xc3,
+	0x0e, 0x8c, 0x88, 0xdc, 0x14, 0x76, 0x04, 0x0b, 0x2b, 0x68, 0xc2, 0x9b, 0x3c, 0x52, 0xca, 0x11,
+	0xa5, 0xb8, 0xe6, 0x1c, 0xef, 0x09, 0x8a, 0xf7, 0x30, 0x3a, 0x14, 0x86, 0x57, 0x61, 0xfe, 0x99,
+	0x9a, 0x13, 0x20, 0x43, 0xc7, 0x88, 0x7c, 0x93, 0x4e, 0xb4, 0x5b, 0xe8, 0x7b, 0x01, 0xb6, 0x07,
+	0xca, 0x28, 0x68, 0x3c, 0x12, 0x8b, 0x47, 0xb6, 0x11, 0x27, 0x62, 0x5a, 0x73, 0xe0, 0xc7, 0x29,
+	0xf0, 0x67, 0xd0, 0xc1, 0x78, 0xc0, 0x89, 0xbf, 0x07, 0xf7, 0x57, 0x64, 0x5c, 0xf8, 0x55, 0x13,
+	0xff, 0xb8, 0x08, 0x95, 0x67, 0xfc, 0xe3, 0x22, 0x5c, 0x84, 0xc1, 0x47, 0x28, 0xdc, 0x59, 0x34,
+	0xd3, 0x0c, 0x2e, 0x1f, 0x18, 0xa1, 0x35, 0x76, 0x5f, 0xc7, 0x42, 0x6b, 0x1c, 0x28, 0xc3, 0x84,
+	0xd6, 0x38, 0x58, 0x14, 0x89, 0x5f, 0x63, 0x0e, 0xba, 0x42, 0x02, 0x58, 0x17, 0x4b, 0x07, 0xf7,
+	0x7f, 0x02, 0xec, 0x8b, 0xa5, 0x36, 0xa0, 0x23, 0xb1, 0x90, 0x85, 0x1c, 0x56, 0xc4, 0xa3, 0x2d,
+	0x7a, 0x73, 0x9e, 0x69, 0xca, 0x73, 0x11, 0x9d, 0x4d, 0xc8, 0x33, 0xa3, 0xe9, 0x8d, 0xe3, 0x4b,
+	0x27, 0x31, 0x1d, 0xea, 0x3f, 0x09, 0x8e, 0xb2, 0xe7, 0x97, 0x16, 0xd0, 0x81, 0xc8, 0xc1, 0x1e,
+	0xa0, 0x94, 0x88, 0x93, 0x09, 0x3c, 0x38, 0xad, 0x39, 0x4a, 0xeb, 0x18, 0x3a, 0x12, 0x6f, 0x8a,
+	0x10, 0x62, 0x59, 0x1a, 0x24, 0xe3, 0xea, 0xc3, 0x5f, 0x04, 0x8f, 0xba, 0xe8, 0x92, 0x02, 0xd0,
+	0x64, 0xac, 0xd2, 0x37, 0x9e, 0xa1, 0xc4, 0xa9, 0x24, 0x2e, 0x9c, 0xcb, 0x69, 0xca, 0xe5, 0x38,
+	0x3a, 0x9a, 0xb4, 0x8b, 0xe8, 0x21, 0xc9, 0x21, 0xf3, 0xbe, 0x00, 0x9b, 0x1b, 0x6e, 0xfe, 0x08,
+	0x87, 0xec, 0xf6, 0x8d, 0x70, 0xf7, 0x44, 0xda, 0x70, 0x7c, 0xe3, 0x14, 0xdf, 0x28, 0xda, 0x1b,
+	0x86, 0x8f, 0xe3, 0x62, 0x9a, 0xc6, 0x7b, 0x02, 0x00, 0x8b, 0x92, 0xaa, 0x2f, 0xcc, 0xa1, 0x5d,
+	0xc1, 0x19, 0x6c, 0x00, 0x43, 0x61, 0xcd, 0x3c, 0xf7, 0x2c, 0xcd, 0x7d, 0x00, 0x49, 0x4d, 0x72,
+	0x67, 0xeb, 0xe4, 0x1a, 0x2a, 0xdf, 0xe4, 0xf7, 0xd1, 0x5b, 0xe8, 0x1b, 0x81, 0xc9, 0x30, 0x6e,
+	0x15, 0xc0, 0xbf, 0x02, 0x86, 0xea, 0x0b, 0xfe, 0x15, 0x30, 0x5c, 0x54, 0xc0, 0xc7, 0x28, 0xca,
+	0x43, 0x68, 0x36, 0x0a, 0x65, 0xc6, 0xa3, 0x3b, 0x34, 0xa0, 0x7d, 0x97, 0xd4, 0x6c, 0x59, 0x57,
+	0x40, 0xbb, 0xbd, 0xa9, 0x7d, 0x42, 0x84, 0x88, 0xa3, 0x4c, 0xe2, 0xf6, 0x9b, 0x46, 0x7c, 0x32,
+	0x1c, 0x04, 0xfa, 0x95, 0xcc, 0x85, 0x70, 0xa9, 0xc1, 0x3f, 0x17, 0x9a, 0x0a, 0x1b, 0xfe, 0xb9,
+	0xd0, 0x5c, 0xc9, 0xc0, 0x0b, 0x14, 0xf3, 0x09, 0x74, 0x2c, 0x0c, 0xb3, 0x5b, 0x33, 0x20, 0x41,
+	0xac, 0xae, 0xe7, 0x1c, 0x96, 0x2b, 0xfa, 0x41, 0x87, 0x80, 0x7e, 0x26, 0xab, 0x54, 0xa8, 0x0c,
+	0xe1, 0x5f, 0xa5, 0x9a, 0xc9, 0x1d, 0xe2, 0x64, 0x02, 0x8f, 0xb8, 0x33, 0xdb, 0xcb, 0x26, 0x90,
+	0x8c, 0xd5, 0x35, 0x83, 0x11, 0xf7, 0x20, 0x14, 0xbd, 0xe8, 0x04, 0x6a, 0x21, 0xe2, 0x74, 0x22,
+	0x1f, 0xce, 0x67, 0xbe, 0x59, 0xef, 0x78, 0x56, 0xaa, 0x12, 0x0d, 0x93, 0xb1, 0x8f, 0xf7, 0xe1,
+	0x7b, 0xbe, 0x43, 0x25, 0x7a, 0xcf, 0xf7, 0x92, 0x98, 0x88, 0x69, 0xdd, 0xe2, 0x9e, 0xef, 0xc3,
+	0xfd, 0x51, 0x07, 0x3c, 0x9d, 0xe0, 0xf6, 0x8e, 0x52, 0x09, 0x8a, 0x1c, 0xb6, 0xff, 0x9f, 0x6a,
+	0x2b, 0x06, 0x67, 0xfe, 0x32, 0x65, 0x7e, 0x09, 0x5d, 0x6c, 0xad, 0xe3, 0xa2, 0x0e, 0x03, 0x0f,
+	0x97, 0xff, 0x17, 0x12, 0x7a, 0x49, 0x47, 0x07, 0x13, 0x90, 0x70, 0x6d, 0x50, 0x87, 0x92, 0x3b,
+	0x72, 0xca, 0x8b, 0x94, 0xf2, 0x3c, 0x9a, 0x6b, 0x91, 0xb2, 0x7b, 0x73, 0xad, 0x43, 0x37, 0xbb,
+	0xda, 0xfb, 0xb7, 0x55, 0xbf, 0x7a, 0xe0, 0xdf, 0x56, 0x03, 0xb4, 0x04, 0x3c, 0x4a, 0x01, 0x8e,
+	0xa0, 0xa1, 0x30, 0x80, 0x4c, 0x3d, 0x40, 0xbf, 0x85, 0x49, 0x7b, 0xfc, 0xbe, 0x8f, 0xa6, 0x63,
+	0xcd, 0x02, 0xb7, 0xd8, 0x20, 0xce, 0x24, 0x73, 0xe2, 0x98, 0xcf, 0x50, 0xcc, 0x27, 0xd1, 0xf1,
+	0x84, 0x33, 0x28, 0xc3, 0xd5, 0x04, 0xbb, 0x9e, 0xa9, 0xc5, 0xbb, 0x7f, 0x0f, 0x09, 0xf7, 0xc8,
+	0xf3, 0x17, 0x79, 0xee, 0x3c, 0x1c, 0x5a, 0x77, 0x8f, 0x3c, 0x7f, 0x90, 0xe7, 0x95, 0xa9, 0x86,
+	0x0b, 0x3d, 0x4f, 0x32, 0x51, 0x52, 0xb2, 0x86, 0x93, 0x71, 0x69, 0x6a, 0x52, 0xbe, 0x61, 0xe7,
+	0xa5, 0x17, 0xfc, 0x6c, 0x37, 0x15, 0x1c, 0xa6, 0xff, 0x07, 0x5a, 0x15, 0x59, 0xa9, 0x39, 0x22,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2077,6 +2240,9 @@ type QueryClient interface {
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
 	// Params returns lockup params.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns account's locked coins grouped by lock duration along with the
+	// number of locks in each bucket
+	AccountLockedDurationBuckets(ctx context.Context, in *AccountLockedDurationBucketsRequest, opts ...grpc.CallOption) (*AccountLockedDurationBucketsResponse, error)
 }
 
 type queryClient struct {
@@ -2268,6 +2434,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) AccountLockedDurationBuckets(ctx context.Context, in *AccountLockedDurationBucketsRequest, opts ...grpc.CallOption) (*AccountLockedDurationBucketsResponse, error) {
+	out := new(AccountLockedDurationBucketsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/AccountLockedDurationBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Return full balance of the module
@@ -2313,6 +2488,9 @@ type QueryServer interface {
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
 	// Params returns lockup params.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns account's locked coins grouped by lock duration along with the
+	// number of locks in each bucket
+	AccountLockedDurationBuckets(context.Context, *AccountLockedDurationBucketsRequest) (*AccountLockedDurationBucketsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2379,6 +2557,9 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Co
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) AccountLockedDurationBuckets(ctx context.Context, req *AccountLockedDurationBucketsRequest) (*AccountLockedDurationBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedDurationBuckets not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2744,6 +2925,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AccountLockedDurationBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountLockedDurationBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountLockedDurationBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/AccountLockedDurationBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountLockedDurationBuckets(ctx, req.(*AccountLockedDurationBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2828,6 +3027,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "AccountLockedDurationBuckets",
+			Handler:    _Query_AccountLockedDurationBuckets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/query.proto",
@@ -4193,6 +4396,123 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountLockedDurationBucketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLockedDurationBucketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLockedDurationBucketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountLockedDurationBucketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountLockedDurationBucketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountLockedDurationBucketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockedDurationBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockedDurationBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockedDurationBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumLocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumLocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4751,6 +5071,54 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *AccountLockedDurationBucketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountLockedDurationBucketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockedDurationBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NumLocks != 0 {
+		n += 1 + sovQuery(uint64(m.NumLocks))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8241,6 +8609,311 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountLockedDurationBucketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLockedDurationBucketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLockedDurationBucketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccountLockedDurationBucketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountLockedDurationBucketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountLockedDurationBucketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, LockedDurationBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *LockedDurationBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedDurationBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedDurationBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLocks", wireType)
+			}
+			m.NumLocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
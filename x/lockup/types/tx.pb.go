@@ -6,8 +6,10 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -659,6 +661,219 @@ func (m *MsgSetRewardReceiverAddressResponse) GetSuccess() bool {
 	return false
 }
 
+// MsgUpdateLockableDurations adds and removes the lockable durations that
+// gauges can distribute incentives to, via governance.
+type MsgUpdateLockableDurations struct {
+	// authority is the address of the governance module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// add_durations are the durations to add. Fails if any is already lockable.
+	AddDurations []time.Duration `protobuf:"bytes,2,rep,name=add_durations,json=addDurations,proto3,stdduration" json:"add_durations" yaml:"add_durations"`
+	// remove_durations are the durations to remove. Fails if any is not
+	// lockable.
+	RemoveDurations []time.Duration `protobuf:"bytes,3,rep,name=remove_durations,json=removeDurations,proto3,stdduration" json:"remove_durations" yaml:"remove_durations"`
+}
+
+func (m *MsgUpdateLockableDurations) Reset()         { *m = MsgUpdateLockableDurations{} }
+func (m *MsgUpdateLockableDurations) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLockableDurations) ProtoMessage()    {}
+func (*MsgUpdateLockableDurations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{12}
+}
+func (m *MsgUpdateLockableDurations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLockableDurations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLockableDurations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLockableDurations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLockableDurations.Merge(m, src)
+}
+func (m *MsgUpdateLockableDurations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLockableDurations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLockableDurations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLockableDurations proto.InternalMessageInfo
+
+func (m *MsgUpdateLockableDurations) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateLockableDurations) GetAddDurations() []time.Duration {
+	if m != nil {
+		return m.AddDurations
+	}
+	return nil
+}
+
+func (m *MsgUpdateLockableDurations) GetRemoveDurations() []time.Duration {
+	if m != nil {
+		return m.RemoveDurations
+	}
+	return nil
+}
+
+type MsgUpdateLockableDurationsResponse struct {
+}
+
+func (m *MsgUpdateLockableDurationsResponse) Reset()         { *m = MsgUpdateLockableDurationsResponse{} }
+func (m *MsgUpdateLockableDurationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLockableDurationsResponse) ProtoMessage()    {}
+func (*MsgUpdateLockableDurationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{13}
+}
+func (m *MsgUpdateLockableDurationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateLockableDurationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateLockableDurationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateLockableDurationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLockableDurationsResponse.Merge(m, src)
+}
+func (m *MsgUpdateLockableDurationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateLockableDurationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLockableDurationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateLockableDurationsResponse proto.InternalMessageInfo
+
+// MsgMigrateLockDuration moves all locks of a duration that is no longer
+// lockable and that are not unlocking to a shorter lockable duration, via
+// governance. Locks with a synthetic lockup are skipped. The locks that are not
+// migrated right away are migrated in batches at the end of the following
+// blocks.
+type MsgMigrateLockDuration struct {
+	// authority is the address of the governance module account.
+	Authority    string        `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	FromDuration time.Duration `protobuf:"bytes,2,opt,name=from_duration,json=fromDuration,proto3,stdduration" json:"from_duration,omitempty" yaml:"from_duration"`
+	ToDuration   time.Duration `protobuf:"bytes,3,opt,name=to_duration,json=toDuration,proto3,stdduration" json:"to_duration,omitempty" yaml:"to_duration"`
+}
+
+func (m *MsgMigrateLockDuration) Reset()         { *m = MsgMigrateLockDuration{} }
+func (m *MsgMigrateLockDuration) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockDuration) ProtoMessage()    {}
+func (*MsgMigrateLockDuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{14}
+}
+func (m *MsgMigrateLockDuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockDuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockDuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockDuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockDuration.Merge(m, src)
+}
+func (m *MsgMigrateLockDuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockDuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockDuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockDuration proto.InternalMessageInfo
+
+func (m *MsgMigrateLockDuration) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgMigrateLockDuration) GetFromDuration() time.Duration {
+	if m != nil {
+		return m.FromDuration
+	}
+	return 0
+}
+
+func (m *MsgMigrateLockDuration) GetToDuration() time.Duration {
+	if m != nil {
+		return m.ToDuration
+	}
+	return 0
+}
+
+type MsgMigrateLockDurationResponse struct {
+	// migrated_lock_ids are the IDs of the locks migrated right away.
+	MigratedLockIds []uint64 `protobuf:"varint,1,rep,packed,name=migrated_lock_ids,json=migratedLockIds,proto3" json:"migrated_lock_ids,omitempty" yaml:"migrated_lock_ids"`
+}
+
+func (m *MsgMigrateLockDurationResponse) Reset()         { *m = MsgMigrateLockDurationResponse{} }
+func (m *MsgMigrateLockDurationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLockDurationResponse) ProtoMessage()    {}
+func (*MsgMigrateLockDurationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bcdad5af0d24735f, []int{15}
+}
+func (m *MsgMigrateLockDurationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLockDurationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLockDurationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLockDurationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLockDurationResponse.Merge(m, src)
+}
+func (m *MsgMigrateLockDurationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLockDurationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLockDurationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLockDurationResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateLockDurationResponse) GetMigratedLockIds() []uint64 {
+	if m != nil {
+		return m.MigratedLockIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgLockTokens)(nil), "osmosis.lockup.MsgLockTokens")
 	proto.RegisterType((*MsgLockTokensResponse)(nil), "osmosis.lockup.MsgLockTokensResponse")
@@ -672,64 +887,87 @@ func init() {
 	proto.RegisterType((*MsgForceUnlockResponse)(nil), "osmosis.lockup.MsgForceUnlockResponse")
 	proto.RegisterType((*MsgSetRewardReceiverAddress)(nil), "osmosis.lockup.MsgSetRewardReceiverAddress")
 	proto.RegisterType((*MsgSetRewardReceiverAddressResponse)(nil), "osmosis.lockup.MsgSetRewardReceiverAddressResponse")
+	proto.RegisterType((*MsgUpdateLockableDurations)(nil), "osmosis.lockup.MsgUpdateLockableDurations")
+	proto.RegisterType((*MsgUpdateLockableDurationsResponse)(nil), "osmosis.lockup.MsgUpdateLockableDurationsResponse")
+	proto.RegisterType((*MsgMigrateLockDuration)(nil), "osmosis.lockup.MsgMigrateLockDuration")
+	proto.RegisterType((*MsgMigrateLockDurationResponse)(nil), "osmosis.lockup.MsgMigrateLockDurationResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/tx.proto", fileDescriptor_bcdad5af0d24735f) }
 
 var fileDescriptor_bcdad5af0d24735f = []byte{
-	// 827 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
-	0x18, 0x8e, 0x13, 0x3e, 0x07, 0x9a, 0x14, 0x8b, 0x42, 0x70, 0xa9, 0x0d, 0x6e, 0x4b, 0x52, 0x8a,
-	0xed, 0x26, 0xf4, 0x94, 0x4b, 0x45, 0xa0, 0x95, 0x90, 0x88, 0x54, 0xb9, 0x20, 0x55, 0x3d, 0x14,
-	0x39, 0xce, 0x60, 0xac, 0x24, 0x9e, 0xc8, 0x63, 0xf3, 0x21, 0xf5, 0x17, 0xf4, 0xd4, 0x63, 0xff,
-	0xc0, 0x5e, 0xf6, 0xb4, 0x3f, 0x83, 0x23, 0xab, 0x5d, 0xad, 0xf6, 0xb0, 0x0a, 0x2b, 0x38, 0xac,
-	0xb4, 0x47, 0x7e, 0xc1, 0x6a, 0x66, 0x6c, 0xcb, 0x71, 0x42, 0x92, 0x5d, 0x69, 0x57, 0x7b, 0x89,
-	0x3d, 0xf3, 0x3e, 0xef, 0x33, 0xef, 0xf3, 0xe4, 0x9d, 0x37, 0x01, 0xcb, 0x08, 0xb7, 0x11, 0xb6,
-	0xb1, 0xd6, 0x42, 0x66, 0xd3, 0xef, 0x68, 0xde, 0x85, 0xda, 0x71, 0x91, 0x87, 0xf8, 0x6c, 0x10,
-	0x50, 0x59, 0x40, 0x58, 0xb4, 0x90, 0x85, 0x68, 0x48, 0x23, 0x6f, 0x0c, 0x25, 0x2c, 0x18, 0x6d,
-	0xdb, 0x41, 0x1a, 0xfd, 0x0c, 0xb6, 0x44, 0x0b, 0x21, 0xab, 0x05, 0x35, 0xba, 0xaa, 0xfb, 0x27,
-	0x5a, 0xc3, 0x77, 0x0d, 0xcf, 0x46, 0x4e, 0x18, 0x37, 0x29, 0xb3, 0x56, 0x37, 0x30, 0xd4, 0xce,
-	0x4a, 0x75, 0xe8, 0x19, 0x25, 0xcd, 0x44, 0x76, 0x18, 0x5f, 0x49, 0x54, 0x44, 0x1e, 0x2c, 0x24,
-	0x3f, 0x4a, 0x83, 0x2f, 0x6a, 0xd8, 0x3a, 0x40, 0x66, 0xf3, 0x10, 0x35, 0xa1, 0x83, 0xf9, 0x0d,
-	0x30, 0x89, 0xce, 0x1d, 0xe8, 0xe6, 0xb9, 0x35, 0xae, 0x38, 0x5b, 0xfd, 0xf2, 0xbe, 0x2b, 0xcd,
-	0x5f, 0x1a, 0xed, 0x56, 0x45, 0xa6, 0xdb, 0xb2, 0xce, 0xc2, 0xfc, 0x29, 0x98, 0x09, 0xcb, 0xc8,
-	0xa7, 0xd7, 0xb8, 0xe2, 0x5c, 0x79, 0x45, 0x65, 0x75, 0xaa, 0x61, 0x9d, 0xea, 0x5e, 0x00, 0xa8,
-	0x96, 0xae, 0xba, 0x52, 0xea, 0x6d, 0x57, 0xe2, 0xc3, 0x94, 0x2d, 0xd4, 0xb6, 0x3d, 0xd8, 0xee,
-	0x78, 0x97, 0xf7, 0x5d, 0x29, 0xc7, 0xf8, 0xc3, 0x98, 0xfc, 0xff, 0x8d, 0xc4, 0xe9, 0x11, 0x3b,
-	0x6f, 0x80, 0x49, 0x22, 0x06, 0xe7, 0x33, 0x6b, 0x19, 0x7a, 0x0c, 0x93, 0xab, 0x12, 0xb9, 0x6a,
-	0x20, 0x57, 0xdd, 0x45, 0xb6, 0x53, 0xfd, 0x89, 0x1c, 0xf3, 0xf8, 0x46, 0x2a, 0x5a, 0xb6, 0x77,
-	0xea, 0xd7, 0x55, 0x13, 0xb5, 0xb5, 0xc0, 0x1b, 0xf6, 0x50, 0x70, 0xa3, 0xa9, 0x79, 0x97, 0x1d,
-	0x88, 0x69, 0x02, 0xd6, 0x19, 0x73, 0x45, 0xfa, 0xf7, 0xcd, 0x93, 0x4d, 0x61, 0x80, 0x4d, 0x8a,
-	0x47, 0x5d, 0x91, 0x0b, 0xe0, 0xab, 0x1e, 0x9b, 0x74, 0x88, 0x3b, 0xc8, 0xc1, 0x90, 0xcf, 0x82,
-	0xf4, 0xfe, 0x1e, 0xf5, 0x6a, 0x42, 0x4f, 0xef, 0xef, 0xc9, 0x16, 0x58, 0xac, 0x61, 0xab, 0x0a,
-	0x2d, 0xdb, 0x39, 0x72, 0x08, 0x83, 0xed, 0x58, 0x3b, 0xad, 0xd6, 0xb8, 0xb6, 0x56, 0x0a, 0xa4,
-	0x12, 0x39, 0x51, 0x49, 0x9d, 0xd0, 0x29, 0xbe, 0x13, 0xaf, 0xe8, 0x10, 0xac, 0x0e, 0x3a, 0x28,
-	0x2a, 0xec, 0x67, 0x30, 0xcd, 0x12, 0x70, 0x9e, 0xa3, 0xbe, 0x09, 0x6a, 0x6f, 0xff, 0xa9, 0xbf,
-	0x43, 0xd7, 0x46, 0x0d, 0xa2, 0x49, 0x0f, 0xa1, 0xf2, 0x2b, 0x0e, 0x2c, 0xf4, 0xd1, 0x8e, 0xdd,
-	0x13, 0xcc, 0x8c, 0x74, 0x68, 0xc6, 0xa7, 0xf8, 0xe6, 0xb6, 0x88, 0x5f, 0x85, 0x61, 0x7e, 0x75,
-	0xa8, 0x4c, 0x85, 0xbc, 0xcb, 0xc7, 0x60, 0xa5, 0x4f, 0x5d, 0xe4, 0x58, 0x1e, 0x4c, 0x63, 0xdf,
-	0x34, 0x21, 0xc6, 0x54, 0xe7, 0x8c, 0x1e, 0x2e, 0xf9, 0x22, 0xc8, 0xf9, 0x21, 0x9c, 0xf8, 0x15,
-	0x89, 0x4c, 0x6e, 0xcb, 0x2f, 0x38, 0x90, 0xab, 0x61, 0xeb, 0xd7, 0x0b, 0x0f, 0x3a, 0xd4, 0x5a,
-	0xbf, 0xf3, 0xc1, 0xee, 0xc5, 0x6f, 0x58, 0xe6, 0x63, 0xde, 0xb0, 0xca, 0x3a, 0x31, 0x71, 0x35,
-	0x61, 0x22, 0xa4, 0x1a, 0x14, 0xb6, 0x92, 0xb7, 0xc1, 0x72, 0x42, 0xd7, 0x68, 0xdf, 0xe4, 0xe7,
-	0x1c, 0xc8, 0xd6, 0xb0, 0xf5, 0x1b, 0x72, 0x4d, 0xc8, 0xfc, 0xfe, 0x9c, 0x5b, 0x69, 0xe0, 0xd5,
-	0x3b, 0x21, 0xb5, 0x27, 0xae, 0x5e, 0x19, 0x2c, 0xf5, 0xaa, 0x1a, 0xc3, 0x8a, 0x67, 0x1c, 0xf8,
-	0xba, 0x86, 0xad, 0x3f, 0xa0, 0xa7, 0xc3, 0x73, 0xc3, 0x6d, 0xe8, 0xd0, 0x84, 0xf6, 0x19, 0x74,
-	0x77, 0x1a, 0x0d, 0x97, 0xb4, 0xd8, 0xb8, 0xbe, 0x2c, 0x81, 0xa9, 0x56, 0xbc, 0x03, 0x83, 0x15,
-	0xbf, 0x0b, 0x72, 0x2e, 0x25, 0x3e, 0x76, 0x03, 0x66, 0xda, 0x33, 0xb3, 0x55, 0xe1, 0xbe, 0x2b,
-	0x2d, 0x31, 0xa6, 0x04, 0x40, 0xd6, 0xb3, 0x6e, 0x4f, 0x2d, 0x15, 0x8d, 0x38, 0xb0, 0x99, 0x70,
-	0x00, 0x43, 0x4f, 0x61, 0x38, 0x25, 0xcc, 0x54, 0x0c, 0x56, 0xb5, 0xfc, 0x0b, 0xf8, 0x76, 0x88,
-	0xa8, 0xd1, 0xb6, 0x94, 0x9f, 0x4e, 0x80, 0x4c, 0x0d, 0x5b, 0xbc, 0x0e, 0x40, 0xec, 0x37, 0xe8,
-	0x9b, 0xe4, 0xa8, 0xea, 0x99, 0xbd, 0xc2, 0xf7, 0x43, 0xc3, 0xd1, 0xa9, 0x16, 0x58, 0xe8, 0x9f,
-	0xc3, 0xdf, 0x0d, 0xc8, 0xed, 0x43, 0x09, 0x5b, 0xe3, 0xa0, 0xa2, 0x83, 0xfe, 0x06, 0xd9, 0xc4,
-	0xc0, 0x5c, 0x1f, 0x99, 0x2f, 0xfc, 0x30, 0x12, 0x12, 0xf1, 0xff, 0x09, 0xe6, 0x7b, 0x06, 0x8a,
-	0x34, 0x20, 0x35, 0x0e, 0x10, 0x0a, 0x23, 0x00, 0x11, 0xf3, 0x11, 0x98, 0x8b, 0x5f, 0x4e, 0x71,
-	0x40, 0x5e, 0x2c, 0x2e, 0x6c, 0x0c, 0x8f, 0x47, 0xb4, 0xff, 0x80, 0xfc, 0x83, 0x8d, 0xfe, 0xe3,
-	0x00, 0x8e, 0x87, 0xc0, 0xc2, 0xf6, 0x7b, 0x80, 0xc3, 0xd3, 0xab, 0x07, 0x57, 0xb7, 0x22, 0x77,
-	0x7d, 0x2b, 0x72, 0xaf, 0x6f, 0x45, 0xee, 0xbf, 0x3b, 0x31, 0x75, 0x7d, 0x27, 0xa6, 0x5e, 0xde,
-	0x89, 0xa9, 0xbf, 0xca, 0xb1, 0x91, 0x10, 0x10, 0x2b, 0x2d, 0xa3, 0x8e, 0xc3, 0x85, 0x76, 0x56,
-	0x2e, 0x69, 0x17, 0xd1, 0x1f, 0x37, 0x32, 0x22, 0xea, 0x53, 0x74, 0xd6, 0x6e, 0xbf, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0xef, 0x64, 0xae, 0x60, 0xd7, 0x09, 0x00, 0x00,
+	// 1124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xae, 0xed, 0x36, 0x6d, 0x5e, 0x7e, 0xb8, 0x59, 0xd2, 0xd8, 0x59, 0x42, 0x9c, 0x6e, 0x43,
+	0x13, 0x42, 0xd6, 0x4b, 0x1c, 0x44, 0x25, 0xf7, 0x80, 0xea, 0x16, 0x04, 0x52, 0x2b, 0x55, 0xdb,
+	0x46, 0xaa, 0x38, 0x60, 0xd6, 0xf6, 0x64, 0xb3, 0xc4, 0xeb, 0xb1, 0x76, 0xd6, 0x69, 0x22, 0x38,
+	0x71, 0xe4, 0xc4, 0x91, 0x7f, 0x80, 0x0b, 0x27, 0x0e, 0xdc, 0xf8, 0x07, 0x7a, 0xac, 0x00, 0x21,
+	0x0e, 0x28, 0x45, 0x70, 0x40, 0xea, 0x91, 0x2b, 0x17, 0xe6, 0xe7, 0x66, 0x77, 0xbd, 0x71, 0x4c,
+	0x25, 0x10, 0x87, 0x8d, 0x77, 0xe6, 0x7d, 0xef, 0x7b, 0xef, 0x7d, 0x6f, 0x66, 0x76, 0x02, 0x25,
+	0x4c, 0x7c, 0x4c, 0x3c, 0x62, 0x75, 0x71, 0x7b, 0x7f, 0xd0, 0xb7, 0xc2, 0xc3, 0x6a, 0x3f, 0xc0,
+	0x21, 0xd6, 0x66, 0xa5, 0xa1, 0x2a, 0x0c, 0xfa, 0xbc, 0x8b, 0x5d, 0xcc, 0x4d, 0x16, 0x7b, 0x13,
+	0x28, 0x7d, 0xce, 0xf1, 0xbd, 0x1e, 0xb6, 0xf8, 0x5f, 0x39, 0xb5, 0xec, 0x62, 0xec, 0x76, 0x91,
+	0xc5, 0x47, 0xad, 0xc1, 0xae, 0xd5, 0x19, 0x04, 0x4e, 0xe8, 0xe1, 0x9e, 0xb2, 0xb7, 0x39, 0xb3,
+	0xd5, 0x72, 0x08, 0xb2, 0x0e, 0xb6, 0x5a, 0x28, 0x74, 0xb6, 0xac, 0x36, 0xf6, 0x94, 0x7d, 0x31,
+	0x95, 0x11, 0xfb, 0x91, 0xa6, 0x92, 0x74, 0xf5, 0x89, 0x4b, 0x3d, 0xd9, 0x8f, 0xf2, 0x11, 0x86,
+	0xa6, 0xc8, 0x4f, 0x0c, 0x84, 0xc9, 0xf8, 0x2a, 0x0f, 0x33, 0xf7, 0x88, 0x7b, 0x97, 0xb2, 0x3c,
+	0xc4, 0xfb, 0xa8, 0x47, 0xb4, 0xeb, 0x70, 0x01, 0x3f, 0xee, 0xa1, 0xa0, 0x9c, 0x5b, 0xc9, 0xad,
+	0x4f, 0x36, 0x2e, 0xff, 0x79, 0x5c, 0x99, 0x3e, 0x72, 0xfc, 0x6e, 0xdd, 0xe0, 0xd3, 0x86, 0x2d,
+	0xcc, 0xda, 0x1e, 0x5c, 0x52, 0xa9, 0x97, 0xf3, 0x14, 0x3a, 0x55, 0x5b, 0xac, 0x8a, 0xda, 0xaa,
+	0xaa, 0xb6, 0xea, 0x1d, 0x09, 0x68, 0x6c, 0x3d, 0x39, 0xae, 0x9c, 0x7b, 0x7e, 0x5c, 0xd1, 0x94,
+	0xcb, 0x26, 0xf6, 0xbd, 0x10, 0xf9, 0xfd, 0xf0, 0x88, 0xf2, 0x17, 0x05, 0xbf, 0xb2, 0x19, 0x5f,
+	0x3e, 0xab, 0xe4, 0xec, 0x88, 0x5d, 0x73, 0xe0, 0x02, 0x13, 0x80, 0x94, 0x0b, 0x2b, 0x05, 0x1e,
+	0x46, 0x56, 0xc0, 0x24, 0xaa, 0x4a, 0x89, 0xaa, 0xb7, 0x29, 0xa2, 0xf1, 0x06, 0x0b, 0xf3, 0xf5,
+	0xb3, 0xca, 0xba, 0xeb, 0x85, 0x7b, 0x83, 0x16, 0x05, 0xfa, 0xb2, 0x5c, 0xf9, 0x63, 0x92, 0xce,
+	0xbe, 0x15, 0x1e, 0xf5, 0x11, 0xe1, 0x0e, 0xc4, 0x16, 0xcc, 0xf5, 0xca, 0xe7, 0x7f, 0x7c, 0xb3,
+	0xa1, 0x67, 0x48, 0x6b, 0x86, 0x5c, 0x15, 0x63, 0x0d, 0xae, 0x24, 0x64, 0xb2, 0x11, 0xe9, 0xe3,
+	0x1e, 0x41, 0xda, 0x2c, 0xe4, 0xdf, 0xbf, 0xc3, 0xb5, 0x3a, 0x6f, 0xd3, 0x37, 0xc3, 0x85, 0x79,
+	0x0a, 0x6c, 0x20, 0xd7, 0xeb, 0xed, 0xf4, 0x18, 0x83, 0xd7, 0x73, 0x6f, 0x75, 0xbb, 0xe3, 0xca,
+	0x5a, 0x5f, 0x63, 0x99, 0x18, 0xa9, 0x4c, 0x5a, 0x8c, 0xce, 0x1c, 0xf4, 0xe2, 0x19, 0x3d, 0x84,
+	0xa5, 0xac, 0x40, 0x51, 0x62, 0x6f, 0xc2, 0x45, 0xe1, 0x40, 0x68, 0x48, 0xa6, 0x9b, 0x5e, 0x4d,
+	0xae, 0xd9, 0xea, 0x7d, 0x14, 0x78, 0xb8, 0xc3, 0x6a, 0xb2, 0x15, 0xd4, 0xf8, 0x25, 0x07, 0x73,
+	0x43, 0xb4, 0x63, 0xaf, 0x09, 0x21, 0x46, 0x5e, 0x89, 0xf1, 0x5f, 0x74, 0x6e, 0x93, 0xe9, 0xb5,
+	0x36, 0x4a, 0xaf, 0x3e, 0x2f, 0xd3, 0x64, 0xef, 0x46, 0x13, 0x16, 0x87, 0xaa, 0x8b, 0x14, 0x2b,
+	0xc3, 0x45, 0x32, 0x68, 0xb7, 0x11, 0x21, 0xbc, 0xce, 0x4b, 0xb6, 0x1a, 0x6a, 0xeb, 0x50, 0x1c,
+	0x28, 0x38, 0xd3, 0x2b, 0x2a, 0x32, 0x3d, 0x6d, 0xfc, 0x94, 0x83, 0x22, 0x8d, 0xf0, 0xce, 0x61,
+	0x88, 0x7a, 0x5c, 0xda, 0x41, 0xff, 0x85, 0xd5, 0x8b, 0xef, 0xb0, 0xc2, 0xbf, 0xb9, 0xc3, 0xea,
+	0x57, 0x99, 0x88, 0x4b, 0x29, 0x11, 0x11, 0xaf, 0xc1, 0x14, 0x23, 0x63, 0x1b, 0x4a, 0xa9, 0xba,
+	0xce, 0xd6, 0xcd, 0xf8, 0x31, 0x07, 0xb3, 0xd4, 0xeb, 0x5d, 0x1c, 0xb4, 0x91, 0xd0, 0xfb, 0xff,
+	0xbc, 0x94, 0x32, 0xb7, 0xde, 0x2e, 0xcb, 0x3d, 0xb5, 0xf5, 0x6a, 0xb0, 0x90, 0xac, 0x6a, 0x0c,
+	0x29, 0x7e, 0xc8, 0xc1, 0xcb, 0xd4, 0xe9, 0x01, 0x0a, 0x6d, 0xf4, 0xd8, 0x09, 0x3a, 0x36, 0x6a,
+	0x23, 0xef, 0x00, 0x05, 0xb7, 0x3a, 0x9d, 0x80, 0x2d, 0xb1, 0x71, 0x75, 0x59, 0x80, 0x89, 0x6e,
+	0x7c, 0x05, 0xca, 0x91, 0x76, 0x1b, 0x8a, 0x01, 0x27, 0x6e, 0x06, 0x92, 0x99, 0xaf, 0x99, 0xc9,
+	0x86, 0x4e, 0x99, 0x16, 0x04, 0x53, 0x0a, 0x60, 0xd8, 0xb3, 0x41, 0x22, 0x97, 0xba, 0xc5, 0x14,
+	0xd8, 0x48, 0x29, 0x40, 0x50, 0x68, 0x0a, 0x9c, 0xa9, 0x3c, 0x4d, 0x47, 0x64, 0x6d, 0xbc, 0x0d,
+	0xd7, 0x46, 0x14, 0x35, 0x86, 0x2c, 0xc7, 0x79, 0xd0, 0x29, 0xc3, 0x4e, 0xbf, 0xe3, 0x84, 0x88,
+	0xad, 0x2b, 0xa7, 0xd5, 0x45, 0x6a, 0x51, 0x13, 0xed, 0x2d, 0x98, 0x74, 0x06, 0xe1, 0x1e, 0x0e,
+	0xbc, 0xf0, 0x48, 0x2a, 0x53, 0xfe, 0xfe, 0x5b, 0x73, 0x5e, 0x36, 0x5f, 0xc6, 0x79, 0x10, 0x06,
+	0x6c, 0x1f, 0x9f, 0x40, 0xb5, 0x8f, 0x60, 0x86, 0xa6, 0xd8, 0x54, 0x0b, 0x9c, 0x50, 0xb1, 0x0a,
+	0xa3, 0xf7, 0xcf, 0x0a, 0x5b, 0x35, 0x54, 0xaa, 0x79, 0x21, 0x55, 0xc2, 0x5b, 0x6c, 0x97, 0x69,
+	0x3a, 0x77, 0x92, 0x99, 0x07, 0x97, 0x03, 0xe4, 0xe3, 0x03, 0x14, 0x0b, 0x52, 0x38, 0x2b, 0xc8,
+	0x35, 0x19, 0xa4, 0xa4, 0xfa, 0x91, 0x24, 0x10, 0x71, 0x8a, 0x62, 0x3a, 0x0a, 0x55, 0xbf, 0xf9,
+	0x19, 0xed, 0xca, 0x49, 0x71, 0xac, 0x47, 0xeb, 0xa9, 0x1e, 0x0d, 0xb8, 0x7e, 0x7c, 0xaf, 0x32,
+	0x01, 0xcd, 0x13, 0xca, 0x55, 0x30, 0x4e, 0xd7, 0x57, 0x35, 0xc8, 0xf8, 0x2b, 0xcf, 0x97, 0xf4,
+	0x3d, 0xcf, 0x0d, 0x24, 0x4e, 0x61, 0x5e, 0xb8, 0x05, 0x9f, 0xc0, 0xcc, 0x6e, 0x80, 0xfd, 0xe6,
+	0xf8, 0x97, 0x84, 0x9b, 0xf2, 0x08, 0x2b, 0x25, 0xfc, 0x12, 0xe7, 0x98, 0xec, 0x4e, 0x02, 0x20,
+	0xbb, 0xc3, 0xe6, 0xa2, 0xa4, 0x09, 0x4c, 0x85, 0xb8, 0x39, 0xfe, 0xe9, 0x79, 0x43, 0x86, 0xbe,
+	0x12, 0xf3, 0x4a, 0x04, 0xd6, 0x44, 0xe0, 0x98, 0x59, 0x84, 0x85, 0x10, 0x2b, 0x92, 0xfa, 0x8d,
+	0xe1, 0x3e, 0xad, 0xa6, 0xfa, 0xe4, 0x0b, 0x81, 0x79, 0xa3, 0xa2, 0x26, 0x19, 0x1f, 0xc3, 0x72,
+	0xb6, 0xf8, 0xd1, 0x06, 0x7a, 0x0f, 0xe6, 0xa4, 0x6b, 0xa7, 0xc9, 0x7c, 0x9b, 0x5e, 0x47, 0x7c,
+	0xd6, 0xcf, 0x37, 0x96, 0x68, 0x76, 0x65, 0x91, 0xdd, 0x10, 0xc4, 0xb0, 0x8b, 0x6a, 0x8e, 0x7f,
+	0x9f, 0x3a, 0xa4, 0xf6, 0xdd, 0x04, 0x14, 0x68, 0x30, 0xcd, 0x06, 0x88, 0x5d, 0xfa, 0x5e, 0x49,
+	0xdf, 0x0d, 0x12, 0x97, 0x1d, 0xfd, 0xd5, 0x91, 0xe6, 0x28, 0x4b, 0x17, 0xe6, 0x86, 0x2f, 0x3e,
+	0xab, 0x19, 0xbe, 0x43, 0x28, 0x7d, 0x73, 0x1c, 0x54, 0x14, 0xe8, 0x43, 0x98, 0x4d, 0xdd, 0x50,
+	0xae, 0x9e, 0xe9, 0xaf, 0xbf, 0x76, 0x26, 0x24, 0xe2, 0x7f, 0x04, 0xd3, 0x89, 0x2f, 0x78, 0x25,
+	0xc3, 0x35, 0x0e, 0xd0, 0xd7, 0xce, 0x00, 0x44, 0xcc, 0x3b, 0x30, 0x15, 0xff, 0x1a, 0x2e, 0x67,
+	0xf8, 0xc5, 0xec, 0xfa, 0xf5, 0xd1, 0xf6, 0x88, 0xf6, 0x53, 0x28, 0x9f, 0xfa, 0x65, 0x79, 0x3d,
+	0x83, 0xe3, 0x34, 0xb0, 0xbe, 0xfd, 0x0f, 0xc0, 0x51, 0xf4, 0x23, 0x28, 0x9d, 0x76, 0x80, 0x6f,
+	0x64, 0xf0, 0x9d, 0x82, 0xd5, 0x6b, 0xe3, 0x63, 0xa3, 0xd0, 0x3e, 0xbc, 0x94, 0x75, 0x68, 0x65,
+	0xe9, 0x96, 0x81, 0xd3, 0xab, 0xe3, 0xe1, 0x54, 0xb8, 0xc6, 0xdd, 0x27, 0xbf, 0x2d, 0xe7, 0x9e,
+	0xd2, 0xe7, 0x57, 0xfa, 0x7c, 0xf1, 0xfb, 0xf2, 0xb9, 0xa7, 0xf4, 0xf9, 0x99, 0x3e, 0x1f, 0xd4,
+	0x62, 0xb7, 0x0d, 0xc9, 0x69, 0x76, 0x9d, 0x16, 0x51, 0x03, 0xeb, 0xa0, 0xb6, 0x65, 0x1d, 0x46,
+	0xff, 0x47, 0xb2, 0xdb, 0x47, 0x6b, 0x82, 0x1f, 0x44, 0xdb, 0x7f, 0x03, 0x6d, 0x6f, 0x86, 0x3a,
+	0x66, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -755,6 +993,14 @@ type MsgClient interface {
 	ForceUnlock(ctx context.Context, in *MsgForceUnlock, opts ...grpc.CallOption) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewardReceiverAddress, opts ...grpc.CallOption) (*MsgSetRewardReceiverAddressResponse, error)
+	// UpdateLockableDurations adds and removes the lockable durations that
+	// gauges can distribute incentives to. Only the governance module account
+	// is authorized.
+	UpdateLockableDurations(ctx context.Context, in *MsgUpdateLockableDurations, opts ...grpc.CallOption) (*MsgUpdateLockableDurationsResponse, error)
+	// MigrateLockDuration moves all locks of an unsupported duration that are
+	// not unlocking to a shorter nearby lockable duration. Only the governance
+	// module account is authorized.
+	MigrateLockDuration(ctx context.Context, in *MsgMigrateLockDuration, opts ...grpc.CallOption) (*MsgMigrateLockDurationResponse, error)
 }
 
 type msgClient struct {
@@ -819,6 +1065,24 @@ func (c *msgClient) SetRewardReceiverAddress(ctx context.Context, in *MsgSetRewa
 	return out, nil
 }
 
+func (c *msgClient) UpdateLockableDurations(ctx context.Context, in *MsgUpdateLockableDurations, opts ...grpc.CallOption) (*MsgUpdateLockableDurationsResponse, error) {
+	out := new(MsgUpdateLockableDurationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/UpdateLockableDurations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateLockDuration(ctx context.Context, in *MsgMigrateLockDuration, opts ...grpc.CallOption) (*MsgMigrateLockDurationResponse, error) {
+	out := new(MsgMigrateLockDurationResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Msg/MigrateLockDuration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// LockTokens lock tokens
@@ -832,6 +1096,14 @@ type MsgServer interface {
 	ForceUnlock(context.Context, *MsgForceUnlock) (*MsgForceUnlockResponse, error)
 	// SetRewardReceiverAddress edits the reward receiver for the given lock ID
 	SetRewardReceiverAddress(context.Context, *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error)
+	// UpdateLockableDurations adds and removes the lockable durations that
+	// gauges can distribute incentives to. Only the governance module account
+	// is authorized.
+	UpdateLockableDurations(context.Context, *MsgUpdateLockableDurations) (*MsgUpdateLockableDurationsResponse, error)
+	// MigrateLockDuration moves all locks of an unsupported duration that are
+	// not unlocking to a shorter nearby lockable duration. Only the governance
+	// module account is authorized.
+	MigrateLockDuration(context.Context, *MsgMigrateLockDuration) (*MsgMigrateLockDurationResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -856,6 +1128,12 @@ func (*UnimplementedMsgServer) ForceUnlock(ctx context.Context, req *MsgForceUnl
 func (*UnimplementedMsgServer) SetRewardReceiverAddress(ctx context.Context, req *MsgSetRewardReceiverAddress) (*MsgSetRewardReceiverAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRewardReceiverAddress not implemented")
 }
+func (*UnimplementedMsgServer) UpdateLockableDurations(ctx context.Context, req *MsgUpdateLockableDurations) (*MsgUpdateLockableDurationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLockableDurations not implemented")
+}
+func (*UnimplementedMsgServer) MigrateLockDuration(ctx context.Context, req *MsgMigrateLockDuration) (*MsgMigrateLockDurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateLockDuration not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -969,6 +1247,42 @@ func _Msg_SetRewardReceiverAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateLockableDurations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLockableDurations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLockableDurations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/UpdateLockableDurations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLockableDurations(ctx, req.(*MsgUpdateLockableDurations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateLockDuration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateLockDuration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateLockDuration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Msg/MigrateLockDuration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateLockDuration(ctx, req.(*MsgMigrateLockDuration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -997,6 +1311,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetRewardReceiverAddress",
 			Handler:    _Msg_SetRewardReceiverAddress_Handler,
 		},
+		{
+			MethodName: "UpdateLockableDurations",
+			Handler:    _Msg_UpdateLockableDurations_Handler,
+		},
+		{
+			MethodName: "MigrateLockDuration",
+			Handler:    _Msg_MigrateLockDuration_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/tx.proto",
@@ -1469,66 +1791,230 @@ func (m *MsgSetRewardReceiverAddressResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateLockableDurations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgLockTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateLockableDurations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLockableDurations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RemoveDurations) > 0 {
+		for iNdEx := len(m.RemoveDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RemoveDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemoveDurations[iNdEx]):])
+			if err3 != nil {
+				return 0, err3
+			}
+			i -= n3
+			i = encodeVarintTx(dAtA, i, uint64(n3))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.AddDurations) > 0 {
+		for iNdEx := len(m.AddDurations) - 1; iNdEx >= 0; iNdEx-- {
+			n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AddDurations[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AddDurations[iNdEx]):])
+			if err4 != nil {
+				return 0, err4
+			}
+			i -= n4
+			i = encodeVarintTx(dAtA, i, uint64(n4))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgLockTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovTx(uint64(m.ID))
+func (m *MsgUpdateLockableDurationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgBeginUnlockingAll) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateLockableDurationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBeginUnlockingAllResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateLockDuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockDuration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockDuration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ToDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ToDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FromDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FromDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLockDurationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLockDurationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLockDurationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MigratedLockIds) > 0 {
+		dAtA8 := make([]byte, len(m.MigratedLockIds)*10)
+		var j7 int
+		for _, num := range m.MigratedLockIds {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgLockTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgLockTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTx(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBeginUnlockingAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1674,6 +2160,73 @@ func (m *MsgSetRewardReceiverAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateLockableDurations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddDurations) > 0 {
+		for _, e := range m.AddDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveDurations) > 0 {
+		for _, e := range m.RemoveDurations {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(e)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateLockableDurationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateLockDuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FromDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ToDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateLockDurationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MigratedLockIds) > 0 {
+		l = 0
+		for _, e := range m.MigratedLockIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1911,27 +2464,468 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unlocks = append(m.Unlocks, &PeriodLock{})
+			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
+			}
+			m.UnlockingLockID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockingLockID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAll: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1941,23 +2935,24 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1980,7 +2975,7 @@ func (m *MsgBeginUnlockingAll) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,17 +2998,17 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unlocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2023,26 +3018,12 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Unlocks = append(m.Unlocks, &PeriodLock{})
-			if err := m.Unlocks[len(m.Unlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2064,7 +3045,7 @@ func (m *MsgBeginUnlockingAllResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2087,10 +3068,10 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlocking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2199,7 +3180,7 @@ func (m *MsgBeginUnlocking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,10 +3203,10 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBeginUnlockingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2248,25 +3229,6 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Success = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnlockingLockID", wireType)
-			}
-			m.UnlockingLockID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnlockingLockID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2288,7 +3250,7 @@ func (m *MsgBeginUnlockingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2311,10 +3273,10 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockup: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2351,9 +3313,9 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
 			}
-			m.ID = 0
+			m.LockID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2363,16 +3325,16 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				m.LockID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2382,24 +3344,23 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2422,7 +3383,7 @@ func (m *MsgExtendLockup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2445,10 +3406,10 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExtendLockupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2492,7 +3453,7 @@ func (m *MsgExtendLockupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateLockableDurations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2515,15 +3476,15 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLockableDurations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLockableDurations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2551,13 +3512,13 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddDurations", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2567,14 +3528,29 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddDurations = append(m.AddDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.AddDurations[len(m.AddDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDurations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2601,8 +3577,8 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemoveDurations = append(m.RemoveDurations, time.Duration(0))
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&(m.RemoveDurations[len(m.RemoveDurations)-1]), dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2627,7 +3603,8 @@ func (m *MsgForceUnlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
+
+func (m *MsgUpdateLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2650,32 +3627,12 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateLockableDurationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgForceUnlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateLockableDurationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2697,7 +3654,8 @@ func (m *MsgForceUnlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
+
+func (m *MsgMigrateLockDuration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2720,15 +3678,15 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateLockDuration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateLockDuration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2756,13 +3714,13 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDuration", wireType)
 			}
-			m.LockID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2772,16 +3730,30 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockID |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FromDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDuration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2791,23 +3763,24 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardReceiver = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ToDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2830,7 +3803,8 @@ func (m *MsgSetRewardReceiverAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
+
+func (m *MsgMigrateLockDurationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2853,32 +3827,88 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateLockDurationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetRewardReceiverAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateLockDurationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MigratedLockIds = append(m.MigratedLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MigratedLockIds) == 0 {
+					m.MigratedLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MigratedLockIds = append(m.MigratedLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigratedLockIds", wireType)
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2900,6 +3930,7 @@ func (m *MsgSetRewardReceiverAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
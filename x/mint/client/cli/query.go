@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +20,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryEpochProvisions(),
+		GetCmdQueryEmissionSchedule(),
+		GetCmdQueryDeveloperVesting(),
 	)
 
 	return cmd
@@ -81,3 +84,67 @@ func GetCmdQueryEpochProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryEmissionSchedule implements a command to return the projected
+// emissions of the next epochs.
+func GetCmdQueryEmissionSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "emission-schedule [num-epochs]",
+		Short:   "Query the projected emissions of the next num-epochs epochs",
+		Example: "osmosisd query mint emission-schedule 365",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numEpochs, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryEmissionScheduleRequest{NumEpochs: numEpochs}
+			res, err := queryClient.EmissionSchedule(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryDeveloperVesting implements a command to return the remaining
+// developer vesting amount.
+func GetCmdQueryDeveloperVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-vesting",
+		Short: "Query the remaining developer vesting amount",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDeveloperVestingRequest{}
+			res, err := queryClient.DeveloperVesting(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
+)
+
+// maxEmissionScheduleEpochs bounds the number of epochs that can be projected in a single query.
+// It corresponds to 10 years of daily epochs.
+const maxEmissionScheduleEpochs = 3650
+
+type invalidNumEpochsError struct {
+	NumEpochs int64
+}
+
+func (e invalidNumEpochsError) Error() string {
+	return fmt.Sprintf("number of epochs to project must be in (0, %d], got %d", maxEmissionScheduleEpochs, e.NumEpochs)
+}
+
+// emissionState is the state that AfterEpochEnd reads and updates when minting.
+type emissionState struct {
+	epochProvisions         osmomath.Dec
+	lastReductionEpoch      int64
+	developerVestingBalance osmomath.Int
+}
+
+// GetEmissionSchedule returns the projected emissions of the next numEpochs mint epochs, starting from the current epoch.
+// The projection replays the minting and reduction logic of AfterEpochEnd against the stored minter and params,
+// assuming that the params do not change. Clients should use this instead of reimplementing the emission curve.
+// Returns error if numEpochs is not positive or greater than maxEmissionScheduleEpochs.
+func (k Keeper) GetEmissionSchedule(ctx sdk.Context, numEpochs int64) ([]types.EpochEmission, error) {
+	if numEpochs <= 0 || numEpochs > maxEmissionScheduleEpochs {
+		return nil, invalidNumEpochsError{NumEpochs: numEpochs}
+	}
+
+	params := k.GetParams(ctx)
+	state := k.getEmissionState(ctx, params)
+	currentEpoch := k.epochKeeper.GetEpochInfo(ctx, params.EpochIdentifier).CurrentEpoch
+
+	return projectEmissions(params, state, currentEpoch, numEpochs), nil
+}
+
+// GetDeveloperVesting returns the remaining developer vesting amount, the amount vested at the end of the
+// current epoch and the next epoch at which the epoch provisions are reduced.
+func (k Keeper) GetDeveloperVesting(ctx sdk.Context) (remaining osmomath.Int, epochVesting osmomath.Int, nextReductionEpoch int64) {
+	params := k.GetParams(ctx)
+	state := k.getEmissionState(ctx, params)
+	currentEpoch := k.epochKeeper.GetEpochInfo(ctx, params.EpochIdentifier).CurrentEpoch
+
+	nextEmission := projectEmissions(params, state, currentEpoch, 1)[0]
+	nextReductionEpoch = state.lastReductionEpoch + params.ReductionPeriodInEpochs
+	if currentEpoch < params.MintingRewardsDistributionStartEpoch {
+		nextReductionEpoch = params.MintingRewardsDistributionStartEpoch + params.ReductionPeriodInEpochs
+	}
+
+	return state.developerVestingBalance, nextEmission.DeveloperVestingProvisions, nextReductionEpoch
+}
+
+func (k Keeper) getEmissionState(ctx sdk.Context, params types.Params) emissionState {
+	developerVestingAddress := k.accountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)
+	return emissionState{
+		epochProvisions:         k.GetMinter(ctx).EpochProvisions,
+		lastReductionEpoch:      k.getLastReductionEpochNum(ctx),
+		developerVestingBalance: k.bankKeeper.GetBalance(ctx, developerVestingAddress, params.MintDenom).Amount,
+	}
+}
+
+// projectEmissions projects the emissions of numEpochs epochs starting from startEpoch given the emission state
+// at the start. It mirrors AfterEpochEnd:
+// - no coins are minted before the minting rewards distribution start epoch.
+// - the last reduction epoch is reset at the start epoch.
+// - the epoch provisions are reduced by the reduction factor every reduction period.
+// - the developer vesting provisions are truncated the same way as in distributeDeveloperRewards and capped
+// at the remaining developer vesting balance.
+func projectEmissions(params types.Params, state emissionState, startEpoch int64, numEpochs int64) []types.EpochEmission {
+	emissions := make([]types.EpochEmission, 0, numEpochs)
+	for epochNumber := startEpoch; epochNumber < startEpoch+numEpochs; epochNumber++ {
+		emission := types.EpochEmission{
+			EpochNumber:                epochNumber,
+			EpochProvisions:            osmomath.ZeroInt(),
+			DeveloperVestingProvisions: osmomath.ZeroInt(),
+			DeveloperVestingRemaining:  state.developerVestingBalance,
+		}
+
+		if epochNumber < params.MintingRewardsDistributionStartEpoch {
+			emissions = append(emissions, emission)
+			continue
+		} else if epochNumber == params.MintingRewardsDistributionStartEpoch {
+			state.lastReductionEpoch = epochNumber
+		}
+
+		if epochNumber >= params.ReductionPeriodInEpochs+state.lastReductionEpoch {
+			state.epochProvisions = state.epochProvisions.Mul(params.ReductionFactor)
+			state.lastReductionEpoch = epochNumber
+			emission.IsReductionEpoch = true
+		}
+
+		emission.EpochProvisions = state.epochProvisions.TruncateInt()
+
+		developerVestingProvisions := emission.EpochProvisions.ToLegacyDec().Mul(params.DistributionProportions.DeveloperRewards).TruncateInt()
+		developerVestingProvisions = osmomath.MinInt(developerVestingProvisions, state.developerVestingBalance)
+		state.developerVestingBalance = state.developerVestingBalance.Sub(developerVestingProvisions)
+
+		emission.DeveloperVestingProvisions = developerVestingProvisions
+		emission.DeveloperVestingRemaining = state.developerVestingBalance
+
+		emissions = append(emissions, emission)
+	}
+
+	return emissions
+}
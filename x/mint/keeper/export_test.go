@@ -10,15 +10,26 @@ import (
 type (
 	ErrInvalidRatio                  = invalidRatioError
 	ErrInsufficientDevVestingBalance = insufficientDevVestingBalanceError
+	ErrInvalidNumEpochs              = invalidNumEpochsError
 )
 
 const (
 	EmptyWeightedAddressReceiver = emptyWeightedAddressReceiver
 	DeveloperVestingAmount       = developerVestingAmount
+	MaxEmissionScheduleEpochs    = maxEmissionScheduleEpochs
 )
 
 var GetProportions = getProportions
 
+func ProjectEmissions(params types.Params, epochProvisions osmomath.Dec, lastReductionEpoch int64, developerVestingBalance osmomath.Int, startEpoch int64, numEpochs int64) []types.EpochEmission {
+	state := emissionState{
+		epochProvisions:         epochProvisions,
+		lastReductionEpoch:      lastReductionEpoch,
+		developerVestingBalance: developerVestingBalance,
+	}
+	return projectEmissions(params, state, startEpoch, numEpochs)
+}
+
 func (k Keeper) CreateDeveloperVestingModuleAccount(ctx sdk.Context, amount sdk.Coin) error {
 	return k.createDeveloperVestingModuleAccount(ctx, amount)
 }
@@ -31,6 +42,10 @@ func (k Keeper) DistributeDeveloperRewards(ctx sdk.Context, totalMintedCoin sdk.
 	return k.distributeDeveloperRewards(ctx, totalMintedCoin, developerRewardsProportion, developerRewardsReceivers)
 }
 
+func (k Keeper) DistributeCommunityPoolFunds(ctx sdk.Context, communityPoolCoin sdk.Coin, fundingStreams []types.FundingStream) error {
+	return k.distributeCommunityPoolFunds(ctx, communityPoolCoin, fundingStreams)
+}
+
 func (k Keeper) GetLastReductionEpochNum(ctx sdk.Context) int64 {
 	return k.getLastReductionEpochNum(ctx)
 }
@@ -34,7 +34,8 @@ var customGenesis = types.NewGenesisState(
 				Weight:  osmomath.NewDecWithPrec(4, 1),
 			},
 		},
-		2), // minting reward distribution start epoch
+		2,                        // minting reward distribution start epoch
+		[]types.FundingStream{}), // community pool funding streams
 	3) // halven started epoch
 
 // TestMintInitGenesis tests that genesis is initialized correctly
@@ -4,7 +4,10 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
 )
 
@@ -35,3 +38,35 @@ func (q Querier) EpochProvisions(c context.Context, _ *types.QueryEpochProvision
 
 	return &types.QueryEpochProvisionsResponse{EpochProvisions: minter.EpochProvisions}, nil
 }
+
+// EmissionSchedule returns the projected emissions of the next req.NumEpochs epochs of the mint module.
+func (q Querier) EmissionSchedule(c context.Context, req *types.QueryEmissionScheduleRequest) (*types.QueryEmissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	emissions, err := q.Keeper.GetEmissionSchedule(ctx, req.NumEpochs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	totalProvisions := osmomath.ZeroInt()
+	for _, emission := range emissions {
+		totalProvisions = totalProvisions.Add(emission.EpochProvisions)
+	}
+
+	return &types.QueryEmissionScheduleResponse{Emissions: emissions, TotalProvisions: totalProvisions}, nil
+}
+
+// DeveloperVesting returns the remaining developer vesting amount of the mint module.
+func (q Querier) DeveloperVesting(c context.Context, _ *types.QueryDeveloperVestingRequest) (*types.QueryDeveloperVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	remaining, epochVesting, nextReductionEpoch := q.Keeper.GetDeveloperVesting(ctx)
+
+	return &types.QueryDeveloperVestingResponse{
+		RemainingAmount:        remaining,
+		EpochVestingProvisions: epochVesting,
+		NextReductionEpoch:     nextReductionEpoch,
+	}, nil
+}
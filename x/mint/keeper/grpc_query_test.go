@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"context"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/mint/keeper"
 	"github.com/osmosis-labs/osmosis/v21/x/mint/types"
 )
 
@@ -15,3 +17,33 @@ func (s *KeeperTestSuite) TestGRPCParams() {
 	_, err = queryClient.EpochProvisions(context.Background(), &types.QueryEpochProvisionsRequest{})
 	s.Require().NoError(err)
 }
+
+func (s *KeeperTestSuite) TestGRPCEmissionSchedule() {
+	queryClient := s.queryClient
+
+	res, err := queryClient.EmissionSchedule(context.Background(), &types.QueryEmissionScheduleRequest{NumEpochs: 20})
+	s.Require().NoError(err)
+	s.Require().Len(res.Emissions, 20)
+
+	totalProvisions := osmomath.ZeroInt()
+	for _, emission := range res.Emissions {
+		totalProvisions = totalProvisions.Add(emission.EpochProvisions)
+	}
+	s.Require().Equal(totalProvisions, res.TotalProvisions)
+
+	_, err = queryClient.EmissionSchedule(context.Background(), &types.QueryEmissionScheduleRequest{NumEpochs: 0})
+	s.Require().Error(err)
+
+	_, err = queryClient.EmissionSchedule(context.Background(), &types.QueryEmissionScheduleRequest{NumEpochs: keeper.MaxEmissionScheduleEpochs + 1})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCDeveloperVesting() {
+	params := s.App.MintKeeper.GetParams(s.Ctx)
+	devVestingAddr := s.App.AccountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)
+
+	res, err := s.queryClient.DeveloperVesting(context.Background(), &types.QueryDeveloperVestingRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(s.App.BankKeeper.GetBalance(s.Ctx, devVestingAddr, params.MintDenom).Amount, res.RemainingAmount)
+	s.Require().Equal(s.App.MintKeeper.GetLastReductionEpochNum(s.Ctx)+params.ReductionPeriodInEpochs, res.NextReductionEpoch)
+}
@@ -145,7 +145,7 @@ func (k Keeper) DistributeMintedCoin(ctx sdk.Context, mintedCoin sdk.Coin) error
 
 	// subtract from original provision to ensure no coins left over after the allocations
 	communityPoolAmount := mintedCoin.Amount.Sub(stakingIncentivesAmount).Sub(poolIncentivesAmount).Sub(devRewardAmount)
-	err = k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(params.MintDenom, communityPoolAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
+	err = k.distributeCommunityPoolFunds(ctx, sdk.NewCoin(params.MintDenom, communityPoolAmount), params.CommunityPoolFundingStreams)
 	if err != nil {
 		return err
 	}
@@ -279,6 +279,55 @@ func (k Keeper) distributeDeveloperRewards(ctx sdk.Context, totalMintedCoin sdk.
 	return devRewardCoin.Amount, nil
 }
 
+// distributeCommunityPoolFunds distributes the community pool allocation of the minted coin
+// to the respective module accounts and addresses by weight (fundingStreams).
+// Funding streams with neither a module name nor an address, as well as the amount left
+// over from rounding down each stream's portion, fund the community pool.
+// If no funding streams given, funds the community pool with the whole allocation.
+// Returns nil on success, error otherwise.
+// With respect to input parameters, errors occur when:
+// - a stream weight is greater than 1.
+// - a stream module account does not exist.
+// - invalid address in funding streams.
+// CONTRACT:
+// - weights in fundingStreams add up to 1.
+func (k Keeper) distributeCommunityPoolFunds(ctx sdk.Context, communityPoolCoin sdk.Coin, fundingStreams []types.FundingStream) error {
+	remainingAmount := communityPoolCoin.Amount
+	for _, s := range fundingStreams {
+		if s.ModuleName == "" && s.Address == "" {
+			continue
+		}
+
+		streamCoin, err := getProportions(communityPoolCoin, s.Weight)
+		if err != nil {
+			return err
+		}
+		if streamCoin.IsZero() {
+			continue
+		}
+
+		if s.ModuleName != "" {
+			if k.accountKeeper.GetModuleAddress(s.ModuleName) == nil {
+				return errorsmod.Wrapf(types.ErrModuleDoesnotExist, "funding stream module account %s", s.ModuleName)
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, s.ModuleName, sdk.NewCoins(streamCoin))
+		} else {
+			var streamAddr sdk.AccAddress
+			streamAddr, err = sdk.AccAddressFromBech32(s.Address)
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, streamAddr, sdk.NewCoins(streamCoin))
+		}
+		if err != nil {
+			return err
+		}
+		remainingAmount = remainingAmount.Sub(streamCoin.Amount)
+	}
+
+	return k.communityPoolKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(communityPoolCoin.Denom, remainingAmount)), k.accountKeeper.GetModuleAddress(types.ModuleName))
+}
+
 // getProportions gets the balance of the `MintedDenom` from minted coins and returns coins according to the
 // allocation ratio. Returns error if ratio is greater than 1.
 // TODO: this currently rounds down and is the cause of rounding discrepancies.
@@ -428,6 +428,88 @@ func (s *KeeperTestSuite) TestDistributeToModule() {
 	}
 }
 
+// TestDistributeCommunityPoolFunds tests that the community pool allocation is split
+// between the funding streams by weight, and that the community pool receives the
+// portions of the streams without recipient and the amount left over from rounding.
+func (s *KeeperTestSuite) TestDistributeCommunityPoolFunds() {
+	communityPoolCoin := sdk.NewCoin(sdk.DefaultBondDenom, osmomath.NewInt(1001))
+
+	tests := map[string]struct {
+		fundingStreams []types.FundingStream
+
+		expectedModuleAmount        osmomath.Int
+		expectedAddressAmount       osmomath.Int
+		expectedCommunityPoolAmount osmomath.Int
+		expectedError               error
+	}{
+		"no funding streams - all to community pool": {
+			fundingStreams: []types.FundingStream{},
+
+			expectedModuleAmount:        osmomath.ZeroInt(),
+			expectedAddressAmount:       osmomath.ZeroInt(),
+			expectedCommunityPoolAmount: communityPoolCoin.Amount,
+		},
+		"module account and address": {
+			fundingStreams: []types.FundingStream{
+				{ModuleName: poolincentivestypes.ModuleName, Weight: osmomath.NewDecWithPrec(5, 1)},
+				{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(5, 1)},
+			},
+
+			expectedModuleAmount:  osmomath.NewInt(500),
+			expectedAddressAmount: osmomath.NewInt(500),
+			// rounding remainder
+			expectedCommunityPoolAmount: osmomath.NewInt(1),
+		},
+		"module account, address and community pool": {
+			fundingStreams: []types.FundingStream{
+				{ModuleName: poolincentivestypes.ModuleName, Weight: osmomath.NewDecWithPrec(6, 1)},
+				{Address: testAddressOne.String(), Weight: osmomath.NewDecWithPrec(3, 1)},
+				{Weight: osmomath.NewDecWithPrec(1, 1)},
+			},
+
+			expectedModuleAmount:        osmomath.NewInt(600),
+			expectedAddressAmount:       osmomath.NewInt(300),
+			expectedCommunityPoolAmount: osmomath.NewInt(101),
+		},
+		"module account does not exist - error": {
+			fundingStreams: []types.FundingStream{
+				{ModuleName: "moduleAccountDoesNotExist", Weight: osmomath.OneDec()},
+			},
+
+			expectedError: types.ErrModuleDoesnotExist,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			mintKeeper := s.App.MintKeeper
+			bankKeeper := s.App.BankKeeper
+			accountKeeper := s.App.AccountKeeper
+
+			s.MintCoins(sdk.NewCoins(communityPoolCoin))
+			oldModuleBalance := bankKeeper.GetBalance(s.Ctx, accountKeeper.GetModuleAddress(poolincentivestypes.ModuleName), sdk.DefaultBondDenom)
+			oldCommunityPoolBalance := bankKeeper.GetBalance(s.Ctx, accountKeeper.GetModuleAddress(distributiontypes.ModuleName), sdk.DefaultBondDenom)
+
+			// System under test.
+			err := mintKeeper.DistributeCommunityPoolFunds(s.Ctx, communityPoolCoin, tc.fundingStreams)
+
+			if tc.expectedError != nil {
+				s.Require().ErrorIs(err, tc.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			actualModuleBalance := bankKeeper.GetBalance(s.Ctx, accountKeeper.GetModuleAddress(poolincentivestypes.ModuleName), sdk.DefaultBondDenom)
+			s.Require().Equal(tc.expectedModuleAmount, actualModuleBalance.Amount.Sub(oldModuleBalance.Amount))
+			s.Require().Equal(tc.expectedAddressAmount, bankKeeper.GetBalance(s.Ctx, testAddressOne, sdk.DefaultBondDenom).Amount)
+			actualCommunityPoolBalance := bankKeeper.GetBalance(s.Ctx, accountKeeper.GetModuleAddress(distributiontypes.ModuleName), sdk.DefaultBondDenom)
+			s.Require().Equal(tc.expectedCommunityPoolAmount, actualCommunityPoolBalance.Amount.Sub(oldCommunityPoolBalance.Amount))
+			s.Require().True(bankKeeper.GetBalance(s.Ctx, accountKeeper.GetModuleAddress(types.ModuleName), sdk.DefaultBondDenom).IsZero())
+		})
+	}
+}
+
 // TestDistributeDeveloperRewards tests the following:
 // - distribution from developer module account to the given weighted addressed occurs.
 // - developer vesting module account balance is correctly updated.
@@ -720,3 +802,64 @@ func (s *KeeperTestSuite) TestDistributeDeveloperRewards() {
 		})
 	}
 }
+
+// TestProjectEmissions tests that the projected emissions match the ones minted by AfterEpochEnd,
+// including reduction epochs and epochs before the minting rewards distribution start epoch.
+func (s *KeeperTestSuite) TestProjectEmissions() {
+	tests := map[string]struct {
+		mintStartEpoch     int64
+		lastReductionEpoch int64
+		startEpoch         int64
+		numEpochs          int64
+	}{
+		"start from distribution start epoch, crosses reduction epochs": {
+			mintStartEpoch: 1,
+			startEpoch:     1,
+			numEpochs:      10,
+		},
+		"start before distribution start epoch": {
+			mintStartEpoch: 4,
+			startEpoch:     1,
+			numEpochs:      10,
+		},
+		"start after last reduction epoch": {
+			mintStartEpoch:     1,
+			lastReductionEpoch: 4,
+			startEpoch:         5,
+			numEpochs:          6,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			mintKeeper := s.App.MintKeeper
+			devVestingAddr := s.App.AccountKeeper.GetModuleAddress(types.DeveloperVestingModuleAcctName)
+
+			params := mintKeeper.GetParams(s.Ctx)
+			params.ReductionPeriodInEpochs = 3
+			params.ReductionFactor = osmomath.NewDecWithPrec(5, 1)
+			params.MintingRewardsDistributionStartEpoch = tc.mintStartEpoch
+			mintKeeper.SetParams(s.Ctx, params)
+			mintKeeper.SetLastReductionEpochNum(s.Ctx, tc.lastReductionEpoch)
+			minter := mintKeeper.GetMinter(s.Ctx)
+			devVestingBalance := s.App.BankKeeper.GetBalance(s.Ctx, devVestingAddr, params.MintDenom).Amount
+
+			emissions := keeper.ProjectEmissions(params, minter.EpochProvisions, tc.lastReductionEpoch, devVestingBalance, tc.startEpoch, tc.numEpochs)
+			s.Require().Len(emissions, int(tc.numEpochs))
+
+			for _, emission := range emissions {
+				lastReductionEpochBefore := mintKeeper.GetLastReductionEpochNum(s.Ctx)
+				s.Require().NoError(mintKeeper.AfterEpochEnd(s.Ctx, params.EpochIdentifier, emission.EpochNumber))
+
+				if emission.EpochNumber < tc.mintStartEpoch {
+					s.Require().Equal(osmomath.ZeroInt(), emission.EpochProvisions)
+				} else {
+					s.Require().Equal(mintKeeper.GetMinter(s.Ctx).EpochProvisions.TruncateInt(), emission.EpochProvisions)
+				}
+				s.Require().Equal(lastReductionEpochBefore != mintKeeper.GetLastReductionEpochNum(s.Ctx) && emission.EpochNumber != tc.mintStartEpoch, emission.IsReductionEpoch)
+				s.Require().Equal(s.App.BankKeeper.GetBalance(s.Ctx, devVestingAddr, params.MintDenom).Amount, emission.DeveloperVestingRemaining)
+			}
+		})
+	}
+}
@@ -133,7 +133,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		reductionPeriodInEpochs,
 		distributionProportions,
 		weightedDevRewardReceivers,
-		mintintRewardsDistributionStartEpoch)
+		mintintRewardsDistributionStartEpoch,
+		[]types.FundingStream{})
 
 	minter := types.NewMinter(epochProvisions)
 
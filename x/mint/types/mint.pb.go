@@ -191,6 +191,10 @@ type Params struct {
 	// minting_rewards_distribution_start_epoch start epoch to distribute minting
 	// rewards
 	MintingRewardsDistributionStartEpoch int64 `protobuf:"varint,8,opt,name=minting_rewards_distribution_start_epoch,json=mintingRewardsDistributionStartEpoch,proto3" json:"minting_rewards_distribution_start_epoch,omitempty" yaml:"minting_rewards_distribution_start_epoch"`
+	// community_pool_funding_streams splits the community pool proportion of the
+	// minted denom between module accounts and addresses by weight. The weights
+	// must add up to 1. If empty, the whole proportion funds the community pool.
+	CommunityPoolFundingStreams []FundingStream `protobuf:"bytes,9,rep,name=community_pool_funding_streams,json=communityPoolFundingStreams,proto3" json:"community_pool_funding_streams" yaml:"community_pool_funding_streams"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -267,66 +271,138 @@ func (m *Params) GetMintingRewardsDistributionStartEpoch() int64 {
 	return 0
 }
 
+func (m *Params) GetCommunityPoolFundingStreams() []FundingStream {
+	if m != nil {
+		return m.CommunityPoolFundingStreams
+	}
+	return nil
+}
+
+// FundingStream directs a weighted portion of the community pool proportion of
+// the minted denom to a module account or an address.
+type FundingStream struct {
+	// module_name is the name of the module account receiving the funds.
+	// Mutually exclusive with address. If both are empty, the funds go to the
+	// community pool.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty" yaml:"module_name"`
+	// address is the bech32 address receiving the funds.
+	Address string                      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Weight  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight" yaml:"weight"`
+}
+
+func (m *FundingStream) Reset()         { *m = FundingStream{} }
+func (m *FundingStream) String() string { return proto.CompactTextString(m) }
+func (*FundingStream) ProtoMessage()    {}
+func (*FundingStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccb38f8335e0f45b, []int{4}
+}
+func (m *FundingStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStream.Merge(m, src)
+}
+func (m *FundingStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingStream proto.InternalMessageInfo
+
+func (m *FundingStream) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *FundingStream) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "osmosis.mint.v1beta1.Minter")
 	proto.RegisterType((*WeightedAddress)(nil), "osmosis.mint.v1beta1.WeightedAddress")
 	proto.RegisterType((*DistributionProportions)(nil), "osmosis.mint.v1beta1.DistributionProportions")
 	proto.RegisterType((*Params)(nil), "osmosis.mint.v1beta1.Params")
+	proto.RegisterType((*FundingStream)(nil), "osmosis.mint.v1beta1.FundingStream")
 }
 
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/mint.proto", fileDescriptor_ccb38f8335e0f45b) }
 
 var fileDescriptor_ccb38f8335e0f45b = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x4f, 0xdb, 0x48,
-	0x18, 0x8d, 0x37, 0x6c, 0x58, 0x06, 0x41, 0x58, 0x8b, 0x25, 0x5e, 0xd0, 0xc6, 0x60, 0x81, 0x94,
-	0x95, 0x96, 0x78, 0x81, 0xdd, 0x0b, 0xda, 0x1f, 0xda, 0x28, 0x8b, 0x44, 0x45, 0xd5, 0x68, 0x7a,
-	0xa8, 0xd4, 0x8b, 0xe5, 0xd8, 0x13, 0x67, 0x44, 0xec, 0xb1, 0x66, 0x26, 0xa1, 0xb9, 0xf5, 0x5e,
-	0x55, 0xea, 0xb1, 0xc7, 0xf6, 0xd0, 0xff, 0x85, 0x23, 0xc7, 0xaa, 0x87, 0xa8, 0x82, 0x53, 0xaf,
-	0xf9, 0x0b, 0xaa, 0xf9, 0xe1, 0x04, 0x0c, 0x91, 0xa2, 0xde, 0x3c, 0xef, 0x7b, 0xf3, 0xde, 0x97,
-	0x6f, 0xe6, 0x4d, 0x80, 0x4d, 0x58, 0x4c, 0x18, 0x66, 0x6e, 0x8c, 0x13, 0xee, 0x0e, 0x0e, 0xda,
-	0x88, 0xfb, 0x07, 0x72, 0x51, 0x4f, 0x29, 0xe1, 0xc4, 0x5c, 0xd7, 0x84, 0xba, 0xc4, 0x34, 0x61,
-	0x73, 0x3d, 0x22, 0x11, 0x91, 0x04, 0x57, 0x7c, 0x29, 0xee, 0xa6, 0x1d, 0x11, 0x12, 0xf5, 0x90,
-	0x2b, 0x57, 0xed, 0x7e, 0xc7, 0xe5, 0x38, 0x46, 0x8c, 0xfb, 0x71, 0xaa, 0x09, 0x3f, 0xe7, 0x09,
-	0x7e, 0x32, 0xd4, 0xa5, 0x6a, 0xbe, 0x14, 0xf6, 0xa9, 0xcf, 0x31, 0x49, 0x54, 0xdd, 0x61, 0xa0,
-	0xf4, 0x18, 0x27, 0x1c, 0x51, 0x13, 0x83, 0x35, 0x94, 0x92, 0xa0, 0xeb, 0xa5, 0x94, 0x0c, 0x30,
-	0xc3, 0x24, 0x61, 0x96, 0xb1, 0x6d, 0xd4, 0x96, 0x1a, 0xff, 0x5c, 0x8e, 0xec, 0xc2, 0xa7, 0x91,
-	0xbd, 0x15, 0xc8, 0xa6, 0x59, 0x78, 0x5e, 0xc7, 0xc4, 0x8d, 0x7d, 0xde, 0xad, 0x9f, 0xa1, 0xc8,
-	0x0f, 0x86, 0x4d, 0x14, 0x8c, 0x47, 0x76, 0x65, 0xe8, 0xc7, 0xbd, 0x63, 0x27, 0x2f, 0xe2, 0xc0,
-	0xb2, 0x84, 0x5a, 0x53, 0xe4, 0xb5, 0x01, 0xca, 0xcf, 0x10, 0x8e, 0xba, 0x1c, 0x85, 0xff, 0x85,
-	0x21, 0x45, 0x8c, 0x99, 0xbf, 0x81, 0x45, 0x5f, 0x7d, 0x6a, 0x57, 0x73, 0x3c, 0xb2, 0x57, 0x95,
-	0xa4, 0x2e, 0x38, 0x30, 0xa3, 0x98, 0x67, 0xa0, 0x74, 0x21, 0x05, 0xac, 0xef, 0x24, 0xf9, 0x8f,
-	0xf9, 0x5a, 0x5c, 0x51, 0x7a, 0x6a, 0xab, 0x03, 0xb5, 0x86, 0xf3, 0xa1, 0x08, 0x2a, 0x4d, 0xcc,
-	0x38, 0xc5, 0xed, 0xbe, 0x98, 0x4d, 0x8b, 0x92, 0x94, 0x50, 0xf1, 0xc5, 0xcc, 0x27, 0x60, 0x91,
-	0x71, 0xff, 0x1c, 0x27, 0x91, 0xee, 0xeb, 0xcf, 0xf9, 0xac, 0x74, 0xeb, 0x7a, 0xaf, 0x03, 0x33,
-	0x15, 0xb3, 0x03, 0xca, 0x29, 0x21, 0x3d, 0x0f, 0x27, 0x01, 0x4a, 0x38, 0x1e, 0x20, 0xa6, 0x7f,
-	0xc3, 0xdf, 0xf3, 0x09, 0x6f, 0x28, 0xe1, 0x9c, 0x86, 0x03, 0x57, 0x05, 0x72, 0x3a, 0x01, 0xcc,
-	0x1e, 0xf8, 0x31, 0x44, 0x03, 0xd4, 0x23, 0x29, 0xa2, 0x1e, 0x45, 0x17, 0x3e, 0x0d, 0x99, 0x55,
-	0x94, 0x4e, 0xff, 0xce, 0xe7, 0x64, 0x29, 0xa7, 0x7b, 0x2a, 0x0e, 0x5c, 0x9b, 0x60, 0x50, 0x41,
-	0x66, 0x00, 0x56, 0x03, 0x12, 0xc7, 0xfd, 0x04, 0xf3, 0xa1, 0x27, 0x3a, 0xb1, 0x16, 0xa4, 0xd5,
-	0x5f, 0xf3, 0x59, 0xfd, 0xa4, 0xac, 0xee, 0x4a, 0x38, 0x70, 0x65, 0x02, 0xb4, 0xc4, 0xfa, 0x4b,
-	0x09, 0x94, 0x5a, 0x3e, 0xf5, 0x63, 0x66, 0xfe, 0x02, 0x80, 0x48, 0x8e, 0x17, 0xa2, 0x84, 0xc4,
-	0xea, 0x64, 0xe0, 0x92, 0x40, 0x9a, 0x02, 0x30, 0x5f, 0x1a, 0xc0, 0x8a, 0x50, 0x82, 0x18, 0x66,
-	0xde, 0xbd, 0x5b, 0xad, 0xc6, 0x7d, 0x32, 0x5f, 0x67, 0xb6, 0xea, 0x6c, 0x96, 0x98, 0x03, 0x37,
-	0x74, 0xe9, 0xff, 0xbb, 0x97, 0xdc, 0x3c, 0xc9, 0xf2, 0x84, 0x43, 0x71, 0x24, 0x1d, 0x8c, 0xa8,
-	0x1e, 0xff, 0x56, 0x3e, 0x2c, 0x53, 0x46, 0x16, 0x96, 0xd3, 0x09, 0x62, 0xb6, 0xc1, 0x26, 0x45,
-	0x61, 0x3f, 0x10, 0xd7, 0xd1, 0x4b, 0x11, 0xc5, 0x24, 0xf4, 0x70, 0xa2, 0x1a, 0x61, 0x72, 0xca,
-	0xc5, 0xc6, 0xde, 0x78, 0x64, 0xef, 0x28, 0xc5, 0xd9, 0x5c, 0x07, 0x56, 0x26, 0xc5, 0x96, 0xac,
-	0x9d, 0x26, 0xb2, 0x69, 0x26, 0xb2, 0x3f, 0xdd, 0xd7, 0xf1, 0x03, 0x4e, 0xa8, 0xf5, 0xfd, 0x37,
-	0x64, 0x3f, 0x2f, 0xe2, 0xc0, 0xf2, 0x04, 0x3a, 0x91, 0x88, 0x99, 0x00, 0x2b, 0xbc, 0x15, 0x35,
-	0x31, 0xca, 0x2c, 0x6b, 0x56, 0x69, 0xdb, 0xa8, 0x2d, 0x1f, 0xee, 0xd7, 0x1f, 0x7a, 0x1b, 0xeb,
-	0x33, 0x02, 0xda, 0x58, 0x10, 0x1d, 0xc2, 0x4a, 0x38, 0x23, 0xbf, 0xef, 0x0d, 0xb0, 0x7b, 0xa1,
-	0xdf, 0x1a, 0xef, 0xde, 0x55, 0xf6, 0x28, 0x0a, 0x10, 0x1e, 0x20, 0xca, 0xac, 0xc5, 0xed, 0x62,
-	0x6d, 0xf9, 0x70, 0xef, 0x61, 0xf3, 0xdc, 0x6b, 0xd5, 0xf8, 0x55, 0x98, 0x4e, 0x87, 0x3e, 0x5b,
-	0xd7, 0x81, 0x3b, 0x99, 0x7b, 0x33, 0x97, 0x19, 0x98, 0x59, 0x9b, 0xaf, 0x0c, 0x50, 0x13, 0x76,
-	0x38, 0x89, 0x26, 0x02, 0x77, 0x86, 0xc4, 0xb8, 0x4f, 0xb9, 0x3a, 0x46, 0xeb, 0x07, 0x79, 0xe2,
-	0x47, 0xe3, 0x91, 0xed, 0x2a, 0xf3, 0x79, 0x77, 0x3a, 0x70, 0x57, 0x53, 0x75, 0x03, 0xb7, 0x27,
-	0xfa, 0x54, 0xf0, 0xe4, 0x6d, 0x38, 0x5e, 0x78, 0xfb, 0xce, 0x2e, 0x34, 0x1e, 0x5d, 0x5e, 0x57,
-	0x8d, 0xab, 0xeb, 0xaa, 0xf1, 0xf9, 0xba, 0x6a, 0xbc, 0xb9, 0xa9, 0x16, 0xae, 0x6e, 0xaa, 0x85,
-	0x8f, 0x37, 0xd5, 0xc2, 0xf3, 0xdf, 0x23, 0xcc, 0xbb, 0xfd, 0x76, 0x3d, 0x20, 0xb1, 0xab, 0x87,
-	0xb5, 0xdf, 0xf3, 0xdb, 0x2c, 0x5b, 0xb8, 0x83, 0xc3, 0x03, 0xf7, 0x85, 0xfa, 0xe7, 0xe3, 0xc3,
-	0x14, 0xb1, 0x76, 0x49, 0xfe, 0xd7, 0x1c, 0x7d, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x11, 0x85, 0x4a,
-	0x5d, 0x16, 0x07, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x9d, 0x56, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0xad, 0x9b, 0x92, 0xb6, 0x53, 0xf5, 0xc1, 0xa8, 0x34, 0xa6, 0x15, 0x49, 0x6b, 0x5a, 0xa9,
+	0x48, 0x34, 0xee, 0x03, 0x84, 0x54, 0xf1, 0x10, 0x51, 0xa8, 0x28, 0x2a, 0x10, 0xb9, 0x0b, 0x24,
+	0x36, 0x96, 0x63, 0x4f, 0x9c, 0x51, 0x63, 0x4f, 0xe4, 0x71, 0x52, 0xb2, 0x43, 0x62, 0x89, 0x90,
+	0xd8, 0xc1, 0x12, 0x16, 0xfc, 0x07, 0xcb, 0x2e, 0xbb, 0x44, 0x2c, 0x2a, 0x04, 0x7f, 0xc0, 0x17,
+	0x30, 0xaf, 0x24, 0x8d, 0x9b, 0x88, 0xa8, 0x0b, 0x2b, 0x9e, 0x7b, 0xef, 0x9c, 0x73, 0x7d, 0x66,
+	0xce, 0x4c, 0x40, 0x8e, 0xd0, 0x80, 0x50, 0x4c, 0xcd, 0x00, 0x87, 0xb1, 0xd9, 0xdc, 0x2a, 0xa3,
+	0xd8, 0xd9, 0x12, 0x83, 0x7c, 0x3d, 0x22, 0x31, 0x81, 0xf3, 0xaa, 0x20, 0x2f, 0x62, 0xaa, 0x60,
+	0x71, 0xde, 0x27, 0x3e, 0x11, 0x05, 0x26, 0x7f, 0x93, 0xb5, 0x8b, 0x39, 0x9f, 0x10, 0xbf, 0x86,
+	0x4c, 0x31, 0x2a, 0x37, 0x2a, 0x66, 0x8c, 0x03, 0x44, 0x63, 0x27, 0xa8, 0xab, 0x82, 0xeb, 0xc9,
+	0x02, 0x27, 0x6c, 0xa9, 0x54, 0x36, 0x99, 0xf2, 0x1a, 0x91, 0x13, 0x63, 0x12, 0xca, 0xbc, 0x41,
+	0x41, 0xfa, 0x39, 0xeb, 0x00, 0x45, 0x10, 0x83, 0x39, 0x54, 0x27, 0x6e, 0xd5, 0x66, 0x89, 0x26,
+	0xa6, 0xac, 0x84, 0xea, 0xda, 0xb2, 0xb6, 0x3e, 0x59, 0x78, 0x78, 0x72, 0x96, 0x1b, 0xf9, 0x79,
+	0x96, 0x5b, 0x72, 0x45, 0xd3, 0xd4, 0x3b, 0xca, 0x63, 0x62, 0x06, 0x4e, 0x5c, 0xcd, 0x1f, 0x20,
+	0xdf, 0x71, 0x5b, 0x45, 0xe4, 0xfe, 0x3d, 0xcb, 0x65, 0x5a, 0x4e, 0x50, 0xdb, 0x35, 0x92, 0x20,
+	0x86, 0x35, 0x2b, 0x42, 0xa5, 0x6e, 0xe4, 0x83, 0x06, 0x66, 0x5f, 0x21, 0xec, 0x57, 0x63, 0xe4,
+	0x3d, 0xf6, 0xbc, 0x08, 0x51, 0x0a, 0x6f, 0x83, 0x71, 0x47, 0xbe, 0x2a, 0x56, 0xc8, 0x20, 0x67,
+	0x24, 0xa4, 0x4a, 0x18, 0x56, 0xbb, 0x04, 0x1e, 0x80, 0xf4, 0xb1, 0x00, 0xd0, 0x47, 0x45, 0xf1,
+	0x9d, 0xe1, 0x5a, 0x9c, 0x96, 0x78, 0x72, 0xaa, 0x61, 0x29, 0x0c, 0xe3, 0x5b, 0x0a, 0x64, 0x8a,
+	0x98, 0xc6, 0x11, 0x2e, 0x37, 0xb8, 0x36, 0xac, 0xd5, 0x3a, 0x89, 0xf8, 0x1b, 0x85, 0x2f, 0xc1,
+	0x38, 0x93, 0xfa, 0x08, 0x87, 0xbe, 0xea, 0xeb, 0xee, 0x70, 0x54, 0xaa, 0x75, 0x35, 0x97, 0xb5,
+	0xae, 0xde, 0x60, 0x05, 0xcc, 0xd6, 0x09, 0xa9, 0xd9, 0x38, 0x74, 0x51, 0x18, 0xe3, 0x26, 0xa2,
+	0xea, 0x1b, 0x1e, 0x0c, 0x07, 0xbc, 0x20, 0x81, 0x13, 0x18, 0x86, 0x35, 0xc3, 0x23, 0xfb, 0x9d,
+	0x00, 0xac, 0x81, 0xab, 0x1e, 0x6a, 0xa2, 0x1a, 0xa9, 0xa3, 0xc8, 0x8e, 0xd0, 0xb1, 0x13, 0x79,
+	0x54, 0x4f, 0x09, 0xa6, 0x47, 0xc3, 0x31, 0xe9, 0x92, 0xe9, 0x02, 0x8a, 0x61, 0xcd, 0x75, 0x62,
+	0x96, 0x0c, 0x41, 0x17, 0xcc, 0xb8, 0x24, 0x08, 0x1a, 0x21, 0x8e, 0x5b, 0x36, 0xef, 0x44, 0x1f,
+	0x13, 0x54, 0xf7, 0x87, 0xa3, 0xba, 0x26, 0xa9, 0x7a, 0x21, 0x0c, 0x6b, 0xba, 0x13, 0x28, 0xf1,
+	0xf1, 0xbb, 0x09, 0x90, 0x2e, 0x39, 0x91, 0x13, 0x50, 0x78, 0x03, 0x00, 0xee, 0x1c, 0xdb, 0x43,
+	0x21, 0x09, 0xe4, 0xca, 0x58, 0x93, 0x3c, 0x52, 0xe4, 0x01, 0xf8, 0x56, 0x03, 0xba, 0x8f, 0x42,
+	0xc4, 0x1c, 0x66, 0x5f, 0xd8, 0xd5, 0x52, 0xee, 0xbd, 0xe1, 0x3a, 0xcb, 0xc9, 0xce, 0x06, 0x81,
+	0x19, 0xd6, 0x82, 0x4a, 0x3d, 0xe9, 0xdd, 0xe4, 0x70, 0xaf, 0xed, 0x27, 0xec, 0xf1, 0x25, 0xa9,
+	0x60, 0x14, 0x29, 0xf9, 0x97, 0x92, 0x66, 0xe9, 0x56, 0xb4, 0xcd, 0xb2, 0xdf, 0x89, 0xc0, 0x32,
+	0x58, 0x8c, 0x90, 0xd7, 0x70, 0xf9, 0x76, 0xb4, 0x99, 0xe2, 0x98, 0x78, 0x6c, 0xdd, 0x65, 0x23,
+	0x54, 0xa8, 0x9c, 0x2a, 0xac, 0x31, 0xc4, 0x15, 0x89, 0x38, 0xb8, 0xd6, 0xb0, 0x32, 0x9d, 0x64,
+	0x49, 0xe4, 0xf6, 0x43, 0xd1, 0x34, 0xe5, 0xde, 0xef, 0xce, 0xab, 0x38, 0x6e, 0x4c, 0x22, 0xfd,
+	0xca, 0x25, 0xbc, 0x9f, 0x04, 0x61, 0x9f, 0xd3, 0x09, 0xed, 0x89, 0x08, 0x0c, 0x81, 0xee, 0x9d,
+	0xb3, 0x1a, 0x97, 0xb2, 0xed, 0x35, 0x3d, 0xcd, 0x28, 0xa7, 0xb6, 0x37, 0xf2, 0xfd, 0xce, 0xc6,
+	0xfc, 0x00, 0x83, 0x16, 0xc6, 0x78, 0x87, 0x56, 0xc6, 0x1b, 0xe0, 0xdf, 0xaf, 0x1a, 0x58, 0x3d,
+	0x56, 0x67, 0x8d, 0x7d, 0x61, 0x2b, 0xb3, 0x5f, 0x17, 0x31, 0xbb, 0x44, 0x54, 0x1f, 0x5f, 0x4e,
+	0x31, 0xf2, 0xb5, 0xfe, 0xe4, 0x89, 0xd3, 0xaa, 0x70, 0x8b, 0x93, 0x76, 0x45, 0x1f, 0x8c, 0x6b,
+	0x58, 0x2b, 0x6d, 0xf6, 0x62, 0xc2, 0x33, 0x56, 0x9b, 0x1a, 0xbe, 0xd7, 0xc0, 0x3a, 0xa7, 0x63,
+	0xc7, 0x43, 0x07, 0xa0, 0x47, 0x24, 0x76, 0x76, 0x44, 0xb1, 0x5c, 0x46, 0x7d, 0x42, 0xac, 0xf8,
+	0x0e, 0x23, 0x37, 0x25, 0xf9, 0xb0, 0x33, 0x0d, 0x6b, 0x55, 0x95, 0xaa, 0x06, 0xce, 0x2b, 0x7a,
+	0xc8, 0xeb, 0xc4, 0x6e, 0x80, 0x9f, 0x34, 0x90, 0xed, 0x35, 0xa2, 0x5d, 0x69, 0x84, 0x1e, 0xa7,
+	0x60, 0xf5, 0x88, 0xb9, 0x4f, 0x9f, 0x14, 0x5a, 0xdd, 0xec, 0xaf, 0xd5, 0x9e, 0x2c, 0x3e, 0x14,
+	0xb5, 0x85, 0x0d, 0xa5, 0xd4, 0x5a, 0x3f, 0x87, 0x27, 0x81, 0x0d, 0x6b, 0xa9, 0xc7, 0xf1, 0x3d,
+	0x50, 0x74, 0x77, 0xec, 0xf3, 0x97, 0xdc, 0x88, 0xf1, 0x5d, 0x03, 0xd3, 0x3d, 0x09, 0x78, 0x0f,
+	0x4c, 0x05, 0xc4, 0x6b, 0xd4, 0x90, 0x1d, 0x3a, 0x01, 0x52, 0xe7, 0xf4, 0x02, 0x23, 0x85, 0x4a,
+	0xa1, 0x6e, 0xd2, 0xb0, 0x80, 0x1c, 0xbd, 0x60, 0x83, 0xf3, 0x97, 0xce, 0xe8, 0xff, 0x2f, 0x9d,
+	0xa7, 0x9d, 0x4b, 0x47, 0xfa, 0x78, 0xf3, 0xb2, 0x17, 0x4e, 0xe1, 0xd9, 0xc9, 0xef, 0xac, 0x76,
+	0xca, 0x9e, 0x5f, 0xec, 0xf9, 0xf8, 0x27, 0x3b, 0x72, 0xca, 0x9e, 0x1f, 0xec, 0x79, 0xbd, 0xe9,
+	0xe3, 0xb8, 0xda, 0x28, 0xe7, 0x99, 0x0c, 0xa6, 0x52, 0x77, 0xa3, 0xe6, 0x94, 0x69, 0x7b, 0x60,
+	0x36, 0xb7, 0xb7, 0xcc, 0x37, 0xf2, 0x6f, 0x45, 0xdc, 0xaa, 0x23, 0x5a, 0x4e, 0x8b, 0x8b, 0x7c,
+	0xe7, 0x1f, 0x6a, 0x75, 0x9f, 0x91, 0x73, 0x08, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -485,6 +561,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommunityPoolFundingStreams) > 0 {
+		for iNdEx := len(m.CommunityPoolFundingStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPoolFundingStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.MintingRewardsDistributionStartEpoch))
 		i--
@@ -556,6 +646,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FundingStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -642,6 +779,31 @@ func (m *Params) Size() (n int) {
 	if m.MintingRewardsDistributionStartEpoch != 0 {
 		n += 1 + sovMint(uint64(m.MintingRewardsDistributionStartEpoch))
 	}
+	if len(m.CommunityPoolFundingStreams) > 0 {
+		for _, e := range m.CommunityPoolFundingStreams {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -1303,6 +1465,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolFundingStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolFundingStreams = append(m.CommunityPoolFundingStreams, FundingStream{})
+			if err := m.CommunityPoolFundingStreams[len(m.CommunityPoolFundingStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -1324,6 +1520,155 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FundingStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -24,6 +24,7 @@ var (
 	KeyPoolAllocationRatio                  = []byte("PoolAllocationRatio")
 	KeyDeveloperRewardsReceiver             = []byte("DeveloperRewardsReceiver")
 	KeyMintingRewardsDistributionStartEpoch = []byte("MintingRewardsDistributionStartEpoch")
+	KeyCommunityPoolFundingStreams          = []byte("CommunityPoolFundingStreams")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -38,6 +39,7 @@ func NewParams(
 	mintDenom string, genesisEpochProvisions osmomath.Dec, epochIdentifier string,
 	ReductionFactor osmomath.Dec, reductionPeriodInEpochs int64, distrProportions DistributionProportions,
 	weightedDevRewardsReceivers []WeightedAddress, mintingRewardsDistributionStartEpoch int64,
+	communityPoolFundingStreams []FundingStream,
 ) Params {
 	return Params{
 		MintDenom:                            mintDenom,
@@ -48,6 +50,7 @@ func NewParams(
 		DistributionProportions:              distrProportions,
 		WeightedDeveloperRewardsReceivers:    weightedDevRewardsReceivers,
 		MintingRewardsDistributionStartEpoch: mintingRewardsDistributionStartEpoch,
+		CommunityPoolFundingStreams:          communityPoolFundingStreams,
 	}
 }
 
@@ -67,6 +70,7 @@ func DefaultParams() Params {
 		},
 		WeightedDeveloperRewardsReceivers:    []WeightedAddress{},
 		MintingRewardsDistributionStartEpoch: 0,
+		CommunityPoolFundingStreams:          []FundingStream{},
 	}
 }
 
@@ -97,6 +101,9 @@ func (p Params) Validate() error {
 	if err := validateMintingRewardsDistributionStartEpoch(p.MintingRewardsDistributionStartEpoch); err != nil {
 		return err
 	}
+	if err := validateCommunityPoolFundingStreams(p.CommunityPoolFundingStreams); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -118,6 +125,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPoolAllocationRatio, &p.DistributionProportions, validateDistributionProportions),
 		paramtypes.NewParamSetPair(KeyDeveloperRewardsReceiver, &p.WeightedDeveloperRewardsReceivers, validateWeightedDeveloperRewardsReceivers),
 		paramtypes.NewParamSetPair(KeyMintingRewardsDistributionStartEpoch, &p.MintingRewardsDistributionStartEpoch, validateMintingRewardsDistributionStartEpoch),
+		paramtypes.NewParamSetPair(KeyCommunityPoolFundingStreams, &p.CommunityPoolFundingStreams, validateCommunityPoolFundingStreams),
 	}
 }
 
@@ -271,3 +279,42 @@ func validateMintingRewardsDistributionStartEpoch(i interface{}) error {
 
 	return nil
 }
+
+func validateCommunityPoolFundingStreams(i interface{}) error {
+	v, ok := i.([]FundingStream)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// fund community pool when there are no funding streams
+	if len(v) == 0 {
+		return nil
+	}
+
+	weightSum := osmomath.NewDec(0)
+	for i, s := range v {
+		// we allow both module name and address to be "" to go to community pool
+		if s.ModuleName != "" && s.Address != "" {
+			return fmt.Errorf("both module name and address set at %dth", i)
+		}
+		if s.Address != "" {
+			_, err := sdk.AccAddressFromBech32(s.Address)
+			if err != nil {
+				return fmt.Errorf("invalid address at %dth", i)
+			}
+		}
+		if s.Weight.IsNil() || !s.Weight.IsPositive() {
+			return fmt.Errorf("non-positive weight at %dth", i)
+		}
+		if s.Weight.GT(osmomath.NewDec(1)) {
+			return fmt.Errorf("more than 1 weight at %dth", i)
+		}
+		weightSum = weightSum.Add(s.Weight)
+	}
+
+	if !weightSum.Equal(osmomath.NewDec(1)) {
+		return fmt.Errorf("invalid weight sum: %s", weightSum.String())
+	}
+
+	return nil
+}
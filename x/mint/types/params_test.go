@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
@@ -40,3 +41,65 @@ func TestGetDeveloperVestingProportion(t *testing.T) {
 	actualDevVestingProportion := params.GetDeveloperVestingProportion()
 	require.Equal(t, expectedDevVestingProportion, actualDevVestingProportion)
 }
+
+// TestValidateCommunityPoolFundingStreams tests that the community pool funding streams
+// are validated when validating params.
+func TestValidateCommunityPoolFundingStreams(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1---------------")).String()
+
+	tests := map[string]struct {
+		streams   []types.FundingStream
+		expectErr bool
+	}{
+		"no streams": {
+			streams: []types.FundingStream{},
+		},
+		"module account, address and community pool": {
+			streams: []types.FundingStream{
+				{ModuleName: "security_budget", Weight: osmomath.NewDecWithPrec(5, 1)},
+				{Address: addr, Weight: osmomath.NewDecWithPrec(3, 1)},
+				{Weight: osmomath.NewDecWithPrec(2, 1)},
+			},
+		},
+		"both module name and address set": {
+			streams: []types.FundingStream{
+				{ModuleName: "security_budget", Address: addr, Weight: osmomath.OneDec()},
+			},
+			expectErr: true,
+		},
+		"invalid address": {
+			streams: []types.FundingStream{
+				{Address: "invalid", Weight: osmomath.OneDec()},
+			},
+			expectErr: true,
+		},
+		"non-positive weight": {
+			streams: []types.FundingStream{
+				{ModuleName: "security_budget", Weight: osmomath.OneDec()},
+				{Address: addr, Weight: osmomath.ZeroDec()},
+			},
+			expectErr: true,
+		},
+		"weights do not add up to 1": {
+			streams: []types.FundingStream{
+				{ModuleName: "security_budget", Weight: osmomath.NewDecWithPrec(5, 1)},
+				{Address: addr, Weight: osmomath.NewDecWithPrec(4, 1)},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.CommunityPoolFundingStreams = tc.streams
+
+			err := params.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
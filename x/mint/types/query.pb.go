@@ -191,42 +191,327 @@ func (m *QueryEpochProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEpochProvisionsResponse proto.InternalMessageInfo
 
+// QueryEmissionScheduleRequest is the request type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleRequest struct {
+	// num_epochs is the number of mint epochs to project, starting from the
+	// current epoch.
+	NumEpochs int64 `protobuf:"varint,1,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty" yaml:"num_epochs"`
+}
+
+func (m *QueryEmissionScheduleRequest) Reset()         { *m = QueryEmissionScheduleRequest{} }
+func (m *QueryEmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleRequest) ProtoMessage()    {}
+func (*QueryEmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{4}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleRequest.Merge(m, src)
+}
+func (m *QueryEmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleRequest) GetNumEpochs() int64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+// EpochEmission is the projected emission of a single mint epoch.
+type EpochEmission struct {
+	// epoch_number is the number of the epoch at the end of which the
+	// emission is minted.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty" yaml:"epoch_number"`
+	// epoch_provisions is the amount minted at the end of the epoch.
+	EpochProvisions cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=epoch_provisions,json=epochProvisions,proto3,customtype=cosmossdk.io/math.Int" json:"epoch_provisions" yaml:"epoch_provisions"`
+	// is_reduction_epoch is true if the epoch provisions are reduced by the
+	// reduction factor at this epoch.
+	IsReductionEpoch bool `protobuf:"varint,3,opt,name=is_reduction_epoch,json=isReductionEpoch,proto3" json:"is_reduction_epoch,omitempty" yaml:"is_reduction_epoch"`
+	// developer_vesting_provisions is the part of the epoch provisions
+	// distributed from the developer vesting module account.
+	DeveloperVestingProvisions cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=developer_vesting_provisions,json=developerVestingProvisions,proto3,customtype=cosmossdk.io/math.Int" json:"developer_vesting_provisions" yaml:"developer_vesting_provisions"`
+	// developer_vesting_remaining is the balance of the developer vesting
+	// module account after the epoch.
+	DeveloperVestingRemaining cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=developer_vesting_remaining,json=developerVestingRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"developer_vesting_remaining" yaml:"developer_vesting_remaining"`
+}
+
+func (m *EpochEmission) Reset()         { *m = EpochEmission{} }
+func (m *EpochEmission) String() string { return proto.CompactTextString(m) }
+func (*EpochEmission) ProtoMessage()    {}
+func (*EpochEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{5}
+}
+func (m *EpochEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEmission.Merge(m, src)
+}
+func (m *EpochEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochEmission proto.InternalMessageInfo
+
+func (m *EpochEmission) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EpochEmission) GetIsReductionEpoch() bool {
+	if m != nil {
+		return m.IsReductionEpoch
+	}
+	return false
+}
+
+// QueryEmissionScheduleResponse is the response type for the
+// Query/EmissionSchedule RPC method.
+type QueryEmissionScheduleResponse struct {
+	Emissions []EpochEmission `protobuf:"bytes,1,rep,name=emissions,proto3" json:"emissions" yaml:"emissions"`
+	// total_provisions is the sum of the provisions of all projected epochs.
+	TotalProvisions cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=total_provisions,json=totalProvisions,proto3,customtype=cosmossdk.io/math.Int" json:"total_provisions" yaml:"total_provisions"`
+}
+
+func (m *QueryEmissionScheduleResponse) Reset()         { *m = QueryEmissionScheduleResponse{} }
+func (m *QueryEmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionScheduleResponse) ProtoMessage()    {}
+func (*QueryEmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{6}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionScheduleResponse.Merge(m, src)
+}
+func (m *QueryEmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionScheduleResponse) GetEmissions() []EpochEmission {
+	if m != nil {
+		return m.Emissions
+	}
+	return nil
+}
+
+// QueryDeveloperVestingRequest is the request type for the
+// Query/DeveloperVesting RPC method.
+type QueryDeveloperVestingRequest struct {
+}
+
+func (m *QueryDeveloperVestingRequest) Reset()         { *m = QueryDeveloperVestingRequest{} }
+func (m *QueryDeveloperVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperVestingRequest) ProtoMessage()    {}
+func (*QueryDeveloperVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{7}
+}
+func (m *QueryDeveloperVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperVestingRequest.Merge(m, src)
+}
+func (m *QueryDeveloperVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperVestingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperVestingRequest proto.InternalMessageInfo
+
+// QueryDeveloperVestingResponse is the response type for the
+// Query/DeveloperVesting RPC method.
+type QueryDeveloperVestingResponse struct {
+	// remaining_amount is the current balance of the developer vesting module
+	// account.
+	RemainingAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=remaining_amount,json=remainingAmount,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_amount" yaml:"remaining_amount"`
+	// epoch_vesting_provisions is the amount vested at the end of the next
+	// mint epoch.
+	EpochVestingProvisions cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=epoch_vesting_provisions,json=epochVestingProvisions,proto3,customtype=cosmossdk.io/math.Int" json:"epoch_vesting_provisions" yaml:"epoch_vesting_provisions"`
+	// next_reduction_epoch is the epoch at which the epoch provisions are
+	// next reduced by the reduction factor.
+	NextReductionEpoch int64 `protobuf:"varint,3,opt,name=next_reduction_epoch,json=nextReductionEpoch,proto3" json:"next_reduction_epoch,omitempty" yaml:"next_reduction_epoch"`
+}
+
+func (m *QueryDeveloperVestingResponse) Reset()         { *m = QueryDeveloperVestingResponse{} }
+func (m *QueryDeveloperVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDeveloperVestingResponse) ProtoMessage()    {}
+func (*QueryDeveloperVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd2f42111e753fbb, []int{8}
+}
+func (m *QueryDeveloperVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDeveloperVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDeveloperVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDeveloperVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDeveloperVestingResponse.Merge(m, src)
+}
+func (m *QueryDeveloperVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDeveloperVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDeveloperVestingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDeveloperVestingResponse proto.InternalMessageInfo
+
+func (m *QueryDeveloperVestingResponse) GetNextReductionEpoch() int64 {
+	if m != nil {
+		return m.NextReductionEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.mint.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryEpochProvisionsRequest)(nil), "osmosis.mint.v1beta1.QueryEpochProvisionsRequest")
 	proto.RegisterType((*QueryEpochProvisionsResponse)(nil), "osmosis.mint.v1beta1.QueryEpochProvisionsResponse")
+	proto.RegisterType((*QueryEmissionScheduleRequest)(nil), "osmosis.mint.v1beta1.QueryEmissionScheduleRequest")
+	proto.RegisterType((*EpochEmission)(nil), "osmosis.mint.v1beta1.EpochEmission")
+	proto.RegisterType((*QueryEmissionScheduleResponse)(nil), "osmosis.mint.v1beta1.QueryEmissionScheduleResponse")
+	proto.RegisterType((*QueryDeveloperVestingRequest)(nil), "osmosis.mint.v1beta1.QueryDeveloperVestingRequest")
+	proto.RegisterType((*QueryDeveloperVestingResponse)(nil), "osmosis.mint.v1beta1.QueryDeveloperVestingResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/mint/v1beta1/query.proto", fileDescriptor_cd2f42111e753fbb) }
 
 var fileDescriptor_cd2f42111e753fbb = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4a, 0xc3, 0x40,
-	0x1c, 0xc6, 0x93, 0xa2, 0x1d, 0x4e, 0xa1, 0x72, 0x76, 0x90, 0x34, 0xa6, 0x25, 0x8a, 0xd4, 0xc1,
-	0x3b, 0x13, 0x37, 0xc7, 0xa2, 0x8b, 0x88, 0xb4, 0x1d, 0x5d, 0xe4, 0x12, 0x8f, 0x34, 0xd8, 0xe4,
-	0xd2, 0xdc, 0xb5, 0xd8, 0x55, 0x5f, 0x40, 0xf0, 0x25, 0x7c, 0x04, 0x1f, 0xa1, 0x63, 0xc1, 0x45,
-	0x1c, 0x8a, 0xb4, 0x3e, 0x88, 0xe4, 0x92, 0x16, 0x5a, 0x83, 0xe8, 0x16, 0xee, 0xff, 0xfd, 0xbf,
-	0xef, 0x77, 0x5f, 0x0e, 0xd4, 0x18, 0x0f, 0x18, 0xf7, 0x39, 0x0e, 0xfc, 0x50, 0xe0, 0x81, 0xe5,
-	0x50, 0x41, 0x2c, 0xdc, 0xeb, 0xd3, 0x78, 0x88, 0xa2, 0x98, 0x09, 0x06, 0xcb, 0x99, 0x02, 0x25,
-	0x0a, 0x94, 0x29, 0xb4, 0xb2, 0xc7, 0x3c, 0x26, 0x05, 0x38, 0xf9, 0x4a, 0xb5, 0x9a, 0xee, 0x31,
-	0xe6, 0x75, 0x29, 0x26, 0x91, 0x8f, 0x49, 0x18, 0x32, 0x41, 0x84, 0xcf, 0x42, 0x9e, 0x4d, 0xab,
-	0xb9, 0x59, 0xd2, 0x56, 0x0a, 0xcc, 0x32, 0x80, 0xad, 0x24, 0xb9, 0x49, 0x62, 0x12, 0xf0, 0x36,
-	0xed, 0xf5, 0x29, 0x17, 0x66, 0x0b, 0x6c, 0x2f, 0x9d, 0xf2, 0x88, 0x85, 0x9c, 0xc2, 0x53, 0x50,
-	0x8c, 0xe4, 0xc9, 0x8e, 0x5a, 0x53, 0xeb, 0x1b, 0xb6, 0x8e, 0xf2, 0x40, 0x51, 0xba, 0xd5, 0x58,
-	0x1b, 0x4d, 0xaa, 0x4a, 0x3b, 0xdb, 0x30, 0x77, 0x41, 0x45, 0x5a, 0x9e, 0x47, 0xcc, 0xed, 0x34,
-	0x63, 0x36, 0xf0, 0x79, 0xc2, 0x39, 0x4f, 0x0c, 0x81, 0x9e, 0x3f, 0xce, 0xa2, 0xaf, 0xc0, 0x16,
-	0x4d, 0x46, 0x37, 0xd1, 0x62, 0x26, 0x21, 0x36, 0x1b, 0x7b, 0x49, 0xcc, 0xc7, 0xa4, 0x5a, 0x71,
-	0x25, 0x0c, 0xbf, 0xbd, 0x43, 0x3e, 0xc3, 0x01, 0x11, 0x1d, 0x74, 0x49, 0x3d, 0xe2, 0x0e, 0xcf,
-	0xa8, 0xdb, 0x2e, 0xd1, 0x65, 0x5f, 0xfb, 0xb5, 0x00, 0xd6, 0x65, 0x20, 0x7c, 0x54, 0x41, 0x31,
-	0x25, 0x86, 0xf5, 0xfc, 0xfb, 0xfc, 0x2c, 0x48, 0x3b, 0xfc, 0x83, 0x32, 0x25, 0x37, 0xf7, 0x1f,
-	0xde, 0xbe, 0x9e, 0x0b, 0x06, 0xd4, 0x71, 0xee, 0xbf, 0x48, 0xeb, 0x81, 0x2f, 0x2a, 0x28, 0xad,
-	0xdc, 0x1d, 0x5a, 0xbf, 0x84, 0xe4, 0xd7, 0xa8, 0xd9, 0xff, 0x59, 0xc9, 0x00, 0x91, 0x04, 0xac,
-	0xc3, 0x83, 0x7c, 0xc0, 0xd5, 0xda, 0x1b, 0x17, 0xa3, 0xa9, 0xa1, 0x8e, 0xa7, 0x86, 0xfa, 0x39,
-	0x35, 0xd4, 0xa7, 0x99, 0xa1, 0x8c, 0x67, 0x86, 0xf2, 0x3e, 0x33, 0x94, 0xeb, 0x63, 0xcf, 0x17,
-	0x9d, 0xbe, 0x83, 0x5c, 0x16, 0xcc, 0xbd, 0x8e, 0xba, 0xc4, 0xe1, 0x0b, 0xe3, 0x81, 0x6d, 0xe1,
-	0xfb, 0xd4, 0x5e, 0x0c, 0x23, 0xca, 0x9d, 0xa2, 0x7c, 0x85, 0x27, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x0a, 0x30, 0xd6, 0x1a, 0x14, 0x03, 0x00, 0x00,
+	// 825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcb, 0x6e, 0xf3, 0x44,
+	0x14, 0xc7, 0xe3, 0xa6, 0xad, 0xe8, 0xf4, 0x43, 0x09, 0xd3, 0x94, 0xa6, 0xb9, 0xd8, 0xd1, 0x14,
+	0x41, 0xba, 0xc0, 0x26, 0x29, 0x0b, 0xd4, 0x15, 0x98, 0x76, 0xc1, 0x45, 0x55, 0x6b, 0x10, 0x0b,
+	0x58, 0x44, 0x8e, 0x33, 0x72, 0x2c, 0x62, 0x8f, 0xeb, 0xb1, 0xa3, 0x86, 0x25, 0x48, 0xac, 0x58,
+	0x20, 0x21, 0xf1, 0x0c, 0x2c, 0x78, 0x90, 0x2e, 0x2b, 0x75, 0x83, 0x58, 0x58, 0xd0, 0xf2, 0x04,
+	0x79, 0x02, 0xe4, 0x99, 0x49, 0x9a, 0xc4, 0x4e, 0xda, 0x7c, 0x3b, 0xe7, 0x5c, 0xfe, 0xe7, 0x37,
+	0xe3, 0x73, 0x8e, 0x03, 0x1a, 0x84, 0xba, 0x84, 0x3a, 0x54, 0x73, 0x1d, 0x2f, 0xd4, 0x86, 0xad,
+	0x2e, 0x0e, 0xcd, 0x96, 0x76, 0x1d, 0xe1, 0x60, 0xa4, 0xfa, 0x01, 0x09, 0x09, 0x2c, 0x89, 0x08,
+	0x35, 0x89, 0x50, 0x45, 0x44, 0xa5, 0x64, 0x13, 0x9b, 0xb0, 0x00, 0x2d, 0x79, 0xe2, 0xb1, 0x95,
+	0x9a, 0x4d, 0x88, 0x3d, 0xc0, 0x9a, 0xe9, 0x3b, 0x9a, 0xe9, 0x79, 0x24, 0x34, 0x43, 0x87, 0x78,
+	0x54, 0x78, 0x95, 0xcc, 0x5a, 0x4c, 0x96, 0x05, 0xa0, 0x12, 0x80, 0x57, 0x49, 0xe5, 0x4b, 0x33,
+	0x30, 0x5d, 0x6a, 0xe0, 0xeb, 0x08, 0xd3, 0x10, 0x5d, 0x81, 0xbd, 0x39, 0x2b, 0xf5, 0x89, 0x47,
+	0x31, 0x3c, 0x05, 0xdb, 0x3e, 0xb3, 0x94, 0xa5, 0x86, 0xd4, 0xdc, 0x6d, 0xd7, 0xd4, 0x2c, 0x50,
+	0x95, 0x67, 0xe9, 0x9b, 0xb7, 0xb1, 0x92, 0x33, 0x44, 0x06, 0xaa, 0x83, 0x2a, 0x93, 0x3c, 0xf7,
+	0x89, 0xd5, 0xbf, 0x0c, 0xc8, 0xd0, 0xa1, 0x09, 0xe7, 0xa4, 0xa2, 0x07, 0x6a, 0xd9, 0x6e, 0x51,
+	0xfa, 0x02, 0x14, 0x71, 0xe2, 0xea, 0xf8, 0x53, 0x1f, 0x83, 0x78, 0xa5, 0x1f, 0x25, 0x65, 0xfe,
+	0x8e, 0x95, 0xaa, 0xc5, 0x60, 0x68, 0xef, 0x7b, 0xd5, 0x21, 0x9a, 0x6b, 0x86, 0x7d, 0xf5, 0x4b,
+	0x6c, 0x9b, 0xd6, 0xe8, 0x0c, 0x5b, 0x46, 0x01, 0xcf, 0xeb, 0xa2, 0xaf, 0x27, 0xf5, 0x5c, 0x87,
+	0x26, 0x96, 0xaf, 0xac, 0x3e, 0xee, 0x45, 0x03, 0x2c, 0x78, 0xe0, 0x87, 0x00, 0x78, 0x91, 0xdb,
+	0x61, 0x69, 0xbc, 0x52, 0x5e, 0xdf, 0x1f, 0xc7, 0xca, 0x5b, 0x23, 0xd3, 0x1d, 0x9c, 0xa2, 0x27,
+	0x1f, 0x32, 0x76, 0xbc, 0xc8, 0x3d, 0xe7, 0xcf, 0xbf, 0x6c, 0x82, 0x37, 0xd9, 0xe3, 0x44, 0x16,
+	0x9e, 0x82, 0x57, 0x9c, 0xdb, 0x8b, 0xdc, 0x2e, 0x0e, 0x84, 0xd2, 0xc1, 0x38, 0x56, 0xf6, 0xb8,
+	0xd2, 0xac, 0x17, 0x19, 0xbb, 0xec, 0xe7, 0x05, 0xfb, 0x05, 0xad, 0x8c, 0x33, 0x6f, 0x34, 0xa4,
+	0xe6, 0x8e, 0xfe, 0x91, 0x38, 0xf3, 0x7e, 0xfa, 0xcc, 0x9f, 0x79, 0xe1, 0x38, 0x56, 0x0e, 0x66,
+	0xc5, 0x9f, 0xd2, 0x51, 0xea, 0x22, 0xe0, 0x17, 0x00, 0x3a, 0xb4, 0x13, 0xe0, 0x5e, 0x64, 0x25,
+	0x8d, 0xc3, 0x4f, 0x55, 0xce, 0x37, 0xa4, 0xe6, 0x1b, 0x7a, 0x7d, 0x1c, 0x2b, 0x87, 0x5c, 0x29,
+	0x1d, 0x83, 0x8c, 0xa2, 0x43, 0x8d, 0x89, 0x8d, 0x9d, 0x1a, 0xfe, 0x2c, 0x81, 0x5a, 0x0f, 0x0f,
+	0xf1, 0x80, 0xf8, 0x38, 0xe8, 0x0c, 0x31, 0x0d, 0x1d, 0xcf, 0x9e, 0xc5, 0xdf, 0x64, 0xf8, 0x67,
+	0xcf, 0xe1, 0x1f, 0xf1, 0xa2, 0xab, 0xa4, 0x90, 0x51, 0x99, 0xba, 0xbf, 0xe1, 0xde, 0x99, 0x53,
+	0xfd, 0x24, 0x81, 0x6a, 0x3a, 0x3b, 0xc0, 0xae, 0xe9, 0x78, 0x8e, 0x67, 0x97, 0xb7, 0x18, 0xc7,
+	0xa7, 0xcf, 0x71, 0xa0, 0x65, 0x1c, 0x53, 0x25, 0x64, 0x1c, 0x2e, 0x62, 0x18, 0x53, 0xdf, 0xbf,
+	0x12, 0xa8, 0x2f, 0xe9, 0x32, 0xd1, 0xd6, 0xdf, 0x81, 0x1d, 0x2c, 0x7c, 0x49, 0x97, 0xe5, 0x9b,
+	0xbb, 0xed, 0xa3, 0xec, 0xa1, 0x9a, 0x6b, 0x2b, 0xbd, 0x9c, 0x90, 0x8f, 0x63, 0xa5, 0x28, 0xde,
+	0xf3, 0x44, 0x03, 0x19, 0x4f, 0x7a, 0x49, 0xff, 0x84, 0x24, 0x34, 0x07, 0xaf, 0xdf, 0x3f, 0x8b,
+	0xe9, 0xc8, 0x28, 0x30, 0xd3, 0xcc, 0x20, 0xc9, 0x62, 0x90, 0xce, 0x52, 0xb7, 0xc0, 0x07, 0xfb,
+	0x7e, 0x03, 0xd4, 0x97, 0x04, 0x88, 0x3b, 0xb0, 0x40, 0x71, 0x7a, 0x9d, 0x1d, 0xd3, 0x25, 0x91,
+	0x17, 0x96, 0xa5, 0xb5, 0x30, 0x17, 0xd3, 0x91, 0x51, 0x98, 0x9a, 0x3e, 0x61, 0x16, 0xf8, 0x03,
+	0x28, 0xf3, 0x61, 0xc8, 0x68, 0x4a, 0x7e, 0x27, 0x1f, 0x3f, 0x57, 0x4c, 0x99, 0x9d, 0xa9, 0xac,
+	0x86, 0x7c, 0x9b, 0xb9, 0xd2, 0xcd, 0x78, 0x05, 0x4a, 0x1e, 0xbe, 0x09, 0x33, 0x87, 0x2c, 0xaf,
+	0x2b, 0xe3, 0x58, 0xa9, 0x72, 0xe9, 0xac, 0x28, 0x64, 0xc0, 0xc4, 0x3c, 0x3f, 0x68, 0xed, 0xdf,
+	0xb7, 0xc0, 0x16, 0xbb, 0xd5, 0xa4, 0xd3, 0xb7, 0xf9, 0xc2, 0x85, 0xcd, 0xec, 0xce, 0x49, 0xef,
+	0xf7, 0xca, 0xf1, 0x0b, 0x22, 0xf9, 0xdb, 0x41, 0xef, 0xfc, 0x78, 0xff, 0xdf, 0x6f, 0x1b, 0x32,
+	0xac, 0x69, 0x99, 0x9f, 0x12, 0xbe, 0xdd, 0xe1, 0x1f, 0x12, 0x28, 0x2c, 0xac, 0x6e, 0xd8, 0x5a,
+	0x51, 0x24, 0xfb, 0x2b, 0x50, 0x69, 0xaf, 0x93, 0x22, 0x00, 0x55, 0x06, 0xd8, 0x84, 0xef, 0x66,
+	0x03, 0x2e, 0xae, 0x40, 0xf8, 0xa7, 0x04, 0x8a, 0x8b, 0xf3, 0x08, 0x57, 0x16, 0xce, 0xfe, 0x44,
+	0x54, 0x4e, 0xd6, 0xca, 0x11, 0xb4, 0x1a, 0xa3, 0x3d, 0x86, 0xef, 0x2d, 0xa1, 0x15, 0x79, 0x1d,
+	0x3a, 0x21, 0x4b, 0x70, 0x17, 0x47, 0x67, 0x25, 0xee, 0x92, 0x41, 0xac, 0x9c, 0xac, 0x95, 0xf3,
+	0x32, 0xdc, 0xd4, 0x62, 0xd4, 0x3f, 0xbf, 0x7d, 0x90, 0xa5, 0xbb, 0x07, 0x59, 0xfa, 0xe7, 0x41,
+	0x96, 0x7e, 0x7d, 0x94, 0x73, 0x77, 0x8f, 0x72, 0xee, 0xaf, 0x47, 0x39, 0xf7, 0xed, 0x07, 0xb6,
+	0x13, 0xf6, 0xa3, 0xae, 0x6a, 0x11, 0x77, 0x22, 0xf6, 0xfe, 0xc0, 0xec, 0xd2, 0xa9, 0xf2, 0xb0,
+	0xdd, 0xd2, 0x6e, 0xb8, 0x7e, 0x38, 0xf2, 0x31, 0xed, 0x6e, 0xb3, 0xbf, 0x28, 0x27, 0xff, 0x0f,
+	0x00, 0xbd, 0x50, 0x85, 0x42, 0x31, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -245,6 +530,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// EpochProvisions returns the current minting epoch provisions value.
 	EpochProvisions(ctx context.Context, in *QueryEpochProvisionsRequest, opts ...grpc.CallOption) (*QueryEpochProvisionsResponse, error)
+	// EmissionSchedule returns the projected emissions for the next num_epochs
+	// mint epochs, computed from the current minter and params.
+	EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error)
+	// DeveloperVesting returns the remaining developer vesting amount and the
+	// amount vested per epoch under the current minter and params.
+	DeveloperVesting(ctx context.Context, in *QueryDeveloperVestingRequest, opts ...grpc.CallOption) (*QueryDeveloperVestingResponse, error)
 }
 
 type queryClient struct {
@@ -273,12 +564,36 @@ func (c *queryClient) EpochProvisions(ctx context.Context, in *QueryEpochProvisi
 	return out, nil
 }
 
+func (c *queryClient) EmissionSchedule(ctx context.Context, in *QueryEmissionScheduleRequest, opts ...grpc.CallOption) (*QueryEmissionScheduleResponse, error) {
+	out := new(QueryEmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Query/EmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DeveloperVesting(ctx context.Context, in *QueryDeveloperVestingRequest, opts ...grpc.CallOption) (*QueryDeveloperVestingResponse, error) {
+	out := new(QueryDeveloperVestingResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.mint.v1beta1.Query/DeveloperVesting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// EpochProvisions returns the current minting epoch provisions value.
 	EpochProvisions(context.Context, *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error)
+	// EmissionSchedule returns the projected emissions for the next num_epochs
+	// mint epochs, computed from the current minter and params.
+	EmissionSchedule(context.Context, *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error)
+	// DeveloperVesting returns the remaining developer vesting amount and the
+	// amount vested per epoch under the current minter and params.
+	DeveloperVesting(context.Context, *QueryDeveloperVestingRequest) (*QueryDeveloperVestingResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -291,6 +606,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) EpochProvisions(ctx context.Context, req *QueryEpochProvisionsRequest) (*QueryEpochProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochProvisions not implemented")
 }
+func (*UnimplementedQueryServer) EmissionSchedule(ctx context.Context, req *QueryEmissionScheduleRequest) (*QueryEmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedule not implemented")
+}
+func (*UnimplementedQueryServer) DeveloperVesting(ctx context.Context, req *QueryDeveloperVestingRequest) (*QueryDeveloperVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeveloperVesting not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -332,6 +653,42 @@ func _Query_EpochProvisions_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Query/EmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedule(ctx, req.(*QueryEmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DeveloperVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDeveloperVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DeveloperVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.mint.v1beta1.Query/DeveloperVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DeveloperVesting(ctx, req.(*QueryDeveloperVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -344,6 +701,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochProvisions",
 			Handler:    _Query_EpochProvisions_Handler,
 		},
+		{
+			MethodName: "EmissionSchedule",
+			Handler:    _Query_EmissionSchedule_Handler,
+		},
+		{
+			MethodName: "DeveloperVesting",
+			Handler:    _Query_DeveloperVesting_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/mint/v1beta1/query.proto",
@@ -461,85 +826,374 @@ func (m *QueryEpochProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryEmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryEmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.NumEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.EpochProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EpochEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
+
+func (m *EpochEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeveloperVestingRemaining.Size()
+		i -= size
+		if _, err := m.DeveloperVestingRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.DeveloperVestingProvisions.Size()
+		i -= size
+		if _, err := m.DeveloperVestingProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.IsReductionEpoch {
+		i--
+		if m.IsReductionEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.EpochProvisions.Size()
+		i -= size
+		if _, err := m.EpochProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalProvisions.Size()
+		i -= size
+		if _, err := m.TotalProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Emissions) > 0 {
+		for iNdEx := len(m.Emissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Emissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDeveloperVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDeveloperVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDeveloperVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextReductionEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextReductionEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.EpochVestingProvisions.Size()
+		i -= size
+		if _, err := m.EpochVestingProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.RemainingAmount.Size()
+		i -= size
+		if _, err := m.RemainingAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEpochProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEpochProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		n += 1 + sovQuery(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *EpochEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNumber))
+	}
+	l = m.EpochProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsReductionEpoch {
+		n += 2
+	}
+	l = m.DeveloperVestingProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.DeveloperVestingRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Emissions) > 0 {
+		for _, e := range m.Emissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDeveloperVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDeveloperVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochVestingProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextReductionEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.NextReductionEpoch))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
 		if wireType == 4 {
 			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
@@ -784,6 +1438,571 @@ func (m *QueryEpochProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEmissionScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReductionEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReductionEpoch = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperVestingProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperVestingProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperVestingRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperVestingRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEmissionScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Emissions = append(m.Emissions, EpochEmission{})
+			if err := m.Emissions[len(m.Emissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperVestingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperVestingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDeveloperVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDeveloperVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDeveloperVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochVestingProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochVestingProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReductionEpoch", wireType)
+			}
+			m.NextReductionEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReductionEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21,7 +21,7 @@ func NewCmdSubmitUpdatePoolIncentivesProposal() *cobra.Command {
 		Args:  cobra.ExactArgs(2),
 		Short: "Submit an update to the records for pool incentives",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -61,7 +61,7 @@ func NewCmdSubmitUpdatePoolIncentivesProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -83,7 +83,7 @@ func NewCmdSubmitReplacePoolIncentivesProposal() *cobra.Command {
 		Args:  cobra.ExactArgs(2),
 		Short: "Submit a full replacement to the records for pool incentives",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -123,7 +123,7 @@ func NewCmdSubmitReplacePoolIncentivesProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -181,6 +181,16 @@ func TestNewSwapExactAmountInCmd(t *testing.T) {
 				TokenOutMinAmount: osmomath.NewIntFromUint64(3),
 			},
 		},
+		"swap exact amount in with ibc unwrap": {
+			Cmd: "10stake 3 --swap-route-pool-ids=1 --swap-route-denoms=ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --ibc-unwrap-receiver=cosmos1receiver --from=" + testAddresses[0].String(),
+			ExpectedMsg: &types.MsgSwapExactAmountIn{
+				Sender:            testAddresses[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}},
+				TokenIn:           sdk.NewInt64Coin("stake", 10),
+				TokenOutMinAmount: osmomath.NewIntFromUint64(3),
+				IbcUnwrapReceiver: "cosmos1receiver",
+			},
+		},
 	}
 	osmocli.RunTxTestCases(t, desc, tcs)
 }
@@ -27,6 +27,8 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// Will be parsed to string.
 	FlagRoutesFile = "routes-file"
+	// Will be parsed to string.
+	FlagIbcUnwrapReceiver = "ibc-unwrap-receiver"
 )
 
 type createBalancerPoolInputs struct {
@@ -106,3 +108,10 @@ func FlagSetCreateRoutes() *flag.FlagSet {
 	fs.String(FlagRoutesFile, "", "Routes json file path (if this path is given, other routes flags should not be used)")
 	return fs
 }
+
+func FlagSetIbcUnwrap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagIbcUnwrapReceiver, "", "Address on the origin chain of the output token to send the output to over IBC")
+	return fs
+}
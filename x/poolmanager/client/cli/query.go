@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPool)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTotalVolumeForPool)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairTakerFee)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdTradingPairVolume)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPoolTypeVolumes)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdEstimateTradeBasedOnPriceImpact)
 	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdListPoolsByDenom)
 	cmd.AddCommand(
@@ -201,6 +203,24 @@ func GetCmdTradingPairTakerFee() (*osmocli.QueryDescriptor, *queryproto.TradingP
 	}, &queryproto.TradingPairTakerFeeRequest{}
 }
 
+func GetCmdTradingPairVolume() (*osmocli.QueryDescriptor, *queryproto.TradingPairVolumeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "trading-pair-volume",
+		Short: "Query the total and 24h swap volume of a trading pair",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} trading-pair-volume uosmo uatom`,
+	}, &queryproto.TradingPairVolumeRequest{}
+}
+
+func GetCmdPoolTypeVolumes() (*osmocli.QueryDescriptor, *queryproto.PoolTypeVolumesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-type-volumes",
+		Short: "Query the total swap volume of every pool type",
+		Long: `{{.Short}}
+		{{.CommandPrefix}} pool-type-volumes`,
+	}, &queryproto.PoolTypeVolumesRequest{}
+}
+
 func GetCmdEstimateTradeBasedOnPriceImpact() (
 	*osmocli.QueryDescriptor, *queryproto.EstimateTradeBasedOnPriceImpactRequest,
 ) {
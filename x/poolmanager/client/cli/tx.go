@@ -46,6 +46,10 @@ func NewTxCmd() *cobra.Command {
 	return txCmd
 }
 
+var ibcUnwrapFlagOverride = map[string]string{
+	"ibcunwrapreceiver": FlagIbcUnwrapReceiver,
+}
+
 func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn) {
 	return &osmocli.TxCliDesc{
 		Use:     "swap-exact-amount-in",
@@ -54,7 +58,8 @@ func NewSwapExactAmountInCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountIn)
 		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{
 			"Routes": osmocli.FlagOnlyParser(swapAmountInRoutes),
 		},
-		Flags: osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}},
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetMultihopSwapRoutes()}, OptionalFlags: []*flag.FlagSet{FlagSetIbcUnwrap()}},
+		CustomFlagOverrides: ibcUnwrapFlagOverride,
 	}, &types.MsgSwapExactAmountIn{}
 }
 
@@ -112,7 +117,9 @@ func NewSplitRouteSwapExactAmountIn() (*osmocli.TxCliDesc, *types.MsgSplitRouteS
 		},
 		Flags: osmocli.FlagDesc{
 			RequiredFlags: []*flag.FlagSet{FlagSetCreateRoutes()},
+			OptionalFlags: []*flag.FlagSet{FlagSetIbcUnwrap()},
 		},
+		CustomFlagOverrides: ibcUnwrapFlagOverride,
 	}, &types.MsgSplitRouteSwapExactAmountIn{}
 }
 
@@ -474,7 +481,7 @@ Ex) denom-pair-taker-fee-proposal uion,uosmo,0.0016,stake,uosmo,0.005,uatom,uosm
 
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -491,7 +498,7 @@ Ex) denom-pair-taker-fee-proposal uion,uosmo,0.0016,stake,uosmo,0.005,uatom,uosm
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -21,6 +21,16 @@ type Querier struct {
 
 var _ queryproto.QueryServer = Querier{}
 
+func (q Querier) TradingPairVolume(grpcCtx context.Context,
+	req *queryproto.TradingPairVolumeRequest,
+) (*queryproto.TradingPairVolumeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.TradingPairVolume(ctx, *req)
+}
+
 func (q Querier) TradingPairTakerFee(grpcCtx context.Context,
 	req *queryproto.TradingPairTakerFeeRequest,
 ) (*queryproto.TradingPairTakerFeeResponse, error) {
@@ -71,6 +81,16 @@ func (q Querier) SpotPrice(grpcCtx context.Context,
 	return q.Q.SpotPrice(ctx, *req)
 }
 
+func (q Querier) PoolTypeVolumes(grpcCtx context.Context,
+	req *queryproto.PoolTypeVolumesRequest,
+) (*queryproto.PoolTypeVolumesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PoolTypeVolumes(ctx, *req)
+}
+
 func (q Querier) Pool(grpcCtx context.Context,
 	req *queryproto.PoolRequest,
 ) (*queryproto.PoolResponse, error) {
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -332,6 +334,47 @@ func (q Querier) TradingPairTakerFee(ctx sdk.Context, req queryproto.TradingPair
 	}, nil
 }
 
+// TradingPairVolume returns the total and rolling 24h swap volume of the given trading pair.
+func (q Querier) TradingPairVolume(ctx sdk.Context, req queryproto.TradingPairVolumeRequest) (*queryproto.TradingPairVolumeResponse, error) {
+	if err := sdk.ValidateDenom(req.Denom_0); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := sdk.ValidateDenom(req.Denom_1); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &queryproto.TradingPairVolumeResponse{
+		TotalVolume: q.K.GetTotalVolumeForDenomPair(ctx, req.Denom_0, req.Denom_1),
+		Volume_24H:  q.K.GetRollingVolumeForDenomPair(ctx, req.Denom_0, req.Denom_1),
+	}, nil
+}
+
+// PoolTypeVolumes returns the total swap volume of every pool type, sorted by pool type,
+// and the sum of these volumes.
+func (q Querier) PoolTypeVolumes(ctx sdk.Context, req queryproto.PoolTypeVolumesRequest) (*queryproto.PoolTypeVolumesResponse, error) {
+	poolTypes := make([]int32, 0, len(types.PoolType_name))
+	for poolType := range types.PoolType_name {
+		poolTypes = append(poolTypes, poolType)
+	}
+	sort.Slice(poolTypes, func(i, j int) bool { return poolTypes[i] < poolTypes[j] })
+
+	poolTypeVolumes := make([]queryproto.PoolTypeVolume, 0, len(poolTypes))
+	totalVolume := sdk.NewCoins()
+	for _, poolType := range poolTypes {
+		volume := q.K.GetTotalVolumeForPoolType(ctx, types.PoolType(poolType))
+		poolTypeVolumes = append(poolTypeVolumes, queryproto.PoolTypeVolume{
+			PoolType: types.PoolType(poolType),
+			Volume:   volume,
+		})
+		totalVolume = totalVolume.Add(volume...)
+	}
+
+	return &queryproto.PoolTypeVolumesResponse{
+		PoolTypeVolumes: poolTypeVolumes,
+		TotalVolume:     totalVolume,
+	}, nil
+}
+
 // EstimateTradeBasedOnPriceImpact returns the input and output amount of coins for a pool trade
 // based on external price and maximum price impact.
 func (q Querier) EstimateTradeBasedOnPriceImpact(
@@ -1529,6 +1529,255 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) GetOutputCoin() types2.Coin {
 	return types2.Coin{}
 }
 
+type TradingPairVolumeRequest struct {
+	Denom_0 string `protobuf:"bytes,1,opt,name=denom_0,json=denom0,proto3" json:"denom_0,omitempty" yaml:"denom_0"`
+	Denom_1 string `protobuf:"bytes,2,opt,name=denom_1,json=denom1,proto3" json:"denom_1,omitempty" yaml:"denom_1"`
+}
+
+func (m *TradingPairVolumeRequest) Reset()         { *m = TradingPairVolumeRequest{} }
+func (m *TradingPairVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*TradingPairVolumeRequest) ProtoMessage()    {}
+func (*TradingPairVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{30}
+}
+func (m *TradingPairVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingPairVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingPairVolumeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingPairVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingPairVolumeRequest.Merge(m, src)
+}
+func (m *TradingPairVolumeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingPairVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingPairVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingPairVolumeRequest proto.InternalMessageInfo
+
+func (m *TradingPairVolumeRequest) GetDenom_0() string {
+	if m != nil {
+		return m.Denom_0
+	}
+	return ""
+}
+
+func (m *TradingPairVolumeRequest) GetDenom_1() string {
+	if m != nil {
+		return m.Denom_1
+	}
+	return ""
+}
+
+type TradingPairVolumeResponse struct {
+	// total_volume is the total historical volume of the pair, denominated in
+	// the tokens swapped in.
+	TotalVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_volume,json=totalVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_volume" yaml:"total_volume"`
+	// volume_24h is the volume of the pair over the last 24 hours, denominated in
+	// the tokens swapped in.
+	Volume_24H github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume_24h,json=volume24h,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume_24h" yaml:"volume_24h"`
+}
+
+func (m *TradingPairVolumeResponse) Reset()         { *m = TradingPairVolumeResponse{} }
+func (m *TradingPairVolumeResponse) String() string { return proto.CompactTextString(m) }
+func (*TradingPairVolumeResponse) ProtoMessage()    {}
+func (*TradingPairVolumeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{31}
+}
+func (m *TradingPairVolumeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingPairVolumeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingPairVolumeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TradingPairVolumeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingPairVolumeResponse.Merge(m, src)
+}
+func (m *TradingPairVolumeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingPairVolumeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingPairVolumeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradingPairVolumeResponse proto.InternalMessageInfo
+
+func (m *TradingPairVolumeResponse) GetTotalVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalVolume
+	}
+	return nil
+}
+
+func (m *TradingPairVolumeResponse) GetVolume_24H() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume_24H
+	}
+	return nil
+}
+
+type PoolTypeVolumesRequest struct {
+}
+
+func (m *PoolTypeVolumesRequest) Reset()         { *m = PoolTypeVolumesRequest{} }
+func (m *PoolTypeVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeVolumesRequest) ProtoMessage()    {}
+func (*PoolTypeVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{32}
+}
+func (m *PoolTypeVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeVolumesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeVolumesRequest.Merge(m, src)
+}
+func (m *PoolTypeVolumesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeVolumesRequest proto.InternalMessageInfo
+
+type PoolTypeVolume struct {
+	PoolType types.PoolType                           `protobuf:"varint,1,opt,name=pool_type,json=poolType,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_type,omitempty" yaml:"pool_type"`
+	Volume   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume" yaml:"volume"`
+}
+
+func (m *PoolTypeVolume) Reset()         { *m = PoolTypeVolume{} }
+func (m *PoolTypeVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeVolume) ProtoMessage()    {}
+func (*PoolTypeVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{33}
+}
+func (m *PoolTypeVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeVolume.Merge(m, src)
+}
+func (m *PoolTypeVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeVolume proto.InternalMessageInfo
+
+func (m *PoolTypeVolume) GetPoolType() types.PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return types.Balancer
+}
+
+func (m *PoolTypeVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type PoolTypeVolumesResponse struct {
+	PoolTypeVolumes []PoolTypeVolume `protobuf:"bytes,1,rep,name=pool_type_volumes,json=poolTypeVolumes,proto3" json:"pool_type_volumes" yaml:"pool_type_volumes"`
+	// total_volume is the sum of the volumes of all pool types.
+	TotalVolume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_volume,json=totalVolume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_volume" yaml:"total_volume"`
+}
+
+func (m *PoolTypeVolumesResponse) Reset()         { *m = PoolTypeVolumesResponse{} }
+func (m *PoolTypeVolumesResponse) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeVolumesResponse) ProtoMessage()    {}
+func (*PoolTypeVolumesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6256a4106f701b7d, []int{34}
+}
+func (m *PoolTypeVolumesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeVolumesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeVolumesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeVolumesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeVolumesResponse.Merge(m, src)
+}
+func (m *PoolTypeVolumesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeVolumesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeVolumesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeVolumesResponse proto.InternalMessageInfo
+
+func (m *PoolTypeVolumesResponse) GetPoolTypeVolumes() []PoolTypeVolume {
+	if m != nil {
+		return m.PoolTypeVolumes
+	}
+	return nil
+}
+
+func (m *PoolTypeVolumesResponse) GetTotalVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalVolume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.poolmanager.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.poolmanager.v1beta1.ParamsResponse")
@@ -1560,6 +1809,11 @@ func init() {
 	proto.RegisterType((*TradingPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairTakerFeeResponse")
 	proto.RegisterType((*EstimateTradeBasedOnPriceImpactRequest)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactRequest")
 	proto.RegisterType((*EstimateTradeBasedOnPriceImpactResponse)(nil), "osmosis.poolmanager.v1beta1.EstimateTradeBasedOnPriceImpactResponse")
+	proto.RegisterType((*TradingPairVolumeRequest)(nil), "osmosis.poolmanager.v1beta1.TradingPairVolumeRequest")
+	proto.RegisterType((*TradingPairVolumeResponse)(nil), "osmosis.poolmanager.v1beta1.TradingPairVolumeResponse")
+	proto.RegisterType((*PoolTypeVolumesRequest)(nil), "osmosis.poolmanager.v1beta1.PoolTypeVolumesRequest")
+	proto.RegisterType((*PoolTypeVolume)(nil), "osmosis.poolmanager.v1beta1.PoolTypeVolume")
+	proto.RegisterType((*PoolTypeVolumesResponse)(nil), "osmosis.poolmanager.v1beta1.PoolTypeVolumesResponse")
 }
 
 func init() {
@@ -1567,135 +1821,148 @@ func init() {
 }
 
 var fileDescriptor_6256a4106f701b7d = []byte{
-	// 2041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x73, 0x1b, 0x49,
-	0xf5, 0xcf, 0xc8, 0x3f, 0xd6, 0x7a, 0x8e, 0x65, 0xa5, 0x13, 0x27, 0xb6, 0x92, 0xaf, 0xe5, 0xed,
-	0xec, 0x37, 0xeb, 0x8d, 0xa3, 0x51, 0x6c, 0x27, 0x24, 0x04, 0x76, 0x83, 0x64, 0x3b, 0x1b, 0x41,
-	0x20, 0xde, 0x89, 0xf7, 0x07, 0x0b, 0x61, 0x6a, 0x6c, 0x75, 0x94, 0x21, 0x9a, 0x19, 0x45, 0xd3,
-	0xe3, 0x58, 0x45, 0xed, 0x85, 0x2a, 0x0a, 0x2e, 0x50, 0x0b, 0x1c, 0xf6, 0xc0, 0x81, 0xe2, 0xc0,
-	0x85, 0x1f, 0x37, 0x38, 0x70, 0xe7, 0x90, 0xa2, 0x0a, 0x2a, 0x55, 0x70, 0xa0, 0x38, 0x08, 0x2a,
-	0xe1, 0x40, 0x15, 0x14, 0x07, 0xf1, 0x0f, 0x50, 0xfd, 0x63, 0x46, 0xd2, 0x58, 0x1a, 0x8d, 0xe4,
-	0x1c, 0x38, 0x45, 0xee, 0x7e, 0xef, 0xf5, 0xfb, 0x7c, 0xfa, 0x7d, 0xba, 0xfb, 0x4d, 0xe0, 0x75,
-	0xc7, 0xb5, 0x1c, 0xd7, 0x74, 0xf3, 0x35, 0xc7, 0xa9, 0x5a, 0x86, 0x6d, 0x54, 0x48, 0x3d, 0xbf,
-	0xbf, 0xba, 0x4b, 0xa8, 0xb1, 0x9a, 0x7f, 0xec, 0x91, 0x7a, 0x43, 0xad, 0xd5, 0x1d, 0xea, 0xa0,
-	0xb3, 0xd2, 0x50, 0xed, 0x30, 0x54, 0xa5, 0x61, 0xe6, 0x54, 0xc5, 0xa9, 0x38, 0xdc, 0x2e, 0xcf,
-	0x7e, 0x09, 0x97, 0xcc, 0x1b, 0x51, 0xb1, 0x2b, 0xc4, 0x26, 0x3c, 0x1c, 0x37, 0x7d, 0x2d, 0xca,
-	0x94, 0x1e, 0x48, 0xab, 0x4b, 0x51, 0x56, 0xee, 0x13, 0xa3, 0xa6, 0xd7, 0x1d, 0x8f, 0x12, 0x69,
-	0xbd, 0xb8, 0xc7, 0xcd, 0xf3, 0xbb, 0x86, 0x4b, 0x02, 0xab, 0x3d, 0xc7, 0xb4, 0xe5, 0xfc, 0xc5,
-	0xce, 0x79, 0x0e, 0x35, 0xb0, 0xaa, 0x19, 0x15, 0xd3, 0x36, 0xa8, 0xe9, 0xf8, 0xb6, 0xe7, 0x2a,
-	0x8e, 0x53, 0xa9, 0x92, 0xbc, 0x51, 0x33, 0xf3, 0x86, 0x6d, 0x3b, 0x94, 0x4f, 0xfa, 0xd9, 0x2f,
-	0xc8, 0x59, 0xfe, 0xd7, 0xae, 0xf7, 0x20, 0x6f, 0xd8, 0x0d, 0x7f, 0x4a, 0x2c, 0xa2, 0x0b, 0x72,
-	0xc4, 0x1f, 0x72, 0x2a, 0x1b, 0xf6, 0xa2, 0xa6, 0x45, 0x5c, 0x6a, 0x58, 0x35, 0x61, 0x80, 0x67,
-	0x61, 0x66, 0xdb, 0xa8, 0x1b, 0x96, 0xab, 0x91, 0xc7, 0x1e, 0x71, 0x29, 0xbe, 0x07, 0x29, 0x7f,
-	0xc0, 0xad, 0x39, 0xb6, 0x4b, 0x50, 0x01, 0x26, 0x6b, 0x7c, 0x64, 0x5e, 0x59, 0x52, 0x96, 0xa7,
-	0xd7, 0xce, 0xab, 0x11, 0xdb, 0xa4, 0x0a, 0xe7, 0xe2, 0xf8, 0xd3, 0x66, 0xf6, 0x98, 0x26, 0x1d,
-	0xf1, 0xbf, 0x15, 0x58, 0xda, 0x72, 0xa9, 0x69, 0x19, 0x94, 0xdc, 0x7b, 0x62, 0xd4, 0xb6, 0x0e,
-	0x8c, 0x3d, 0x5a, 0xb0, 0x1c, 0xcf, 0xa6, 0x25, 0x5b, 0xae, 0x8c, 0x72, 0xf0, 0x0a, 0x0b, 0xa8,
-	0x9b, 0xe5, 0xf9, 0xc4, 0x92, 0xb2, 0x3c, 0x5e, 0x3c, 0xd5, 0x6a, 0x66, 0x53, 0x0d, 0xc3, 0xaa,
-	0xde, 0xc0, 0x72, 0x02, 0xcf, 0x2b, 0xda, 0x24, 0xfb, 0x5d, 0x2a, 0x23, 0x15, 0xa6, 0xa8, 0xf3,
-	0x88, 0xd8, 0xba, 0x69, 0xcf, 0x8f, 0x2d, 0x29, 0xcb, 0xc9, 0xe2, 0xc9, 0x56, 0x33, 0x3b, 0x2b,
-	0xec, 0xfd, 0x19, 0xac, 0xbd, 0xc2, 0x7f, 0x96, 0x6c, 0x74, 0x1f, 0x26, 0xf9, 0xce, 0xb9, 0xf3,
-	0xe3, 0x4b, 0x63, 0xcb, 0xd3, 0x6b, 0x6a, 0x24, 0x0c, 0x96, 0x65, 0x90, 0x20, 0x73, 0x2b, 0xce,
-	0x31, 0x44, 0xad, 0x66, 0x76, 0x46, 0xac, 0x20, 0x62, 0x61, 0x4d, 0x06, 0xfd, 0xfc, 0xf8, 0x94,
-	0x92, 0x4e, 0x68, 0x93, 0x2e, 0xb1, 0xcb, 0xa4, 0x8e, 0x7f, 0x91, 0x80, 0xb5, 0xbe, 0x80, 0xdf,
-	0x37, 0xe9, 0xc3, 0xed, 0xba, 0x69, 0x99, 0xd4, 0xdc, 0x27, 0x3b, 0x8d, 0x1a, 0x71, 0x7b, 0x50,
-	0xa0, 0x0c, 0x49, 0x41, 0x22, 0x06, 0x05, 0x37, 0x21, 0x25, 0xb2, 0xd5, 0xfd, 0x55, 0xc6, 0x96,
-	0xc6, 0x96, 0xc7, 0x8b, 0x0b, 0xad, 0x66, 0x76, 0xae, 0x13, 0x96, 0x3f, 0x8f, 0xb5, 0xe3, 0x62,
-	0x60, 0x5b, 0x2c, 0xf8, 0x1e, 0x9c, 0x96, 0x06, 0x22, 0xba, 0xe3, 0x51, 0xbd, 0x4c, 0x6c, 0xc7,
-	0xe2, 0x9c, 0x26, 0x8b, 0xaf, 0xb6, 0x9a, 0xd9, 0xff, 0xeb, 0x0a, 0x14, 0xb2, 0xc3, 0xda, 0x49,
-	0x31, 0xb1, 0xc3, 0xc6, 0xef, 0x7a, 0x74, 0x93, 0x8f, 0xfe, 0x5e, 0x81, 0x8b, 0x01, 0x5d, 0xa6,
-	0x5d, 0xa9, 0x12, 0xb6, 0x60, 0xdf, 0x4a, 0x59, 0x09, 0xd3, 0x84, 0x0e, 0xd3, 0x34, 0x32, 0x49,
-	0x45, 0x98, 0x0d, 0x83, 0x13, 0xe5, 0x95, 0x69, 0x35, 0xb3, 0xa7, 0x3b, 0xdd, 0x3a, 0x50, 0xcd,
-	0xd0, 0x2e, 0x3c, 0xdf, 0x56, 0xe0, 0xd5, 0x88, 0x7a, 0x97, 0xc2, 0xda, 0x85, 0x74, 0x3b, 0x90,
-	0xc1, 0x67, 0x39, 0x9e, 0x64, 0xf1, 0x3a, 0xab, 0xb5, 0xbf, 0x34, 0xb3, 0x73, 0x42, 0xcc, 0x6e,
-	0xf9, 0x91, 0x6a, 0x3a, 0x79, 0xcb, 0xa0, 0x0f, 0xd5, 0x92, 0x4d, 0x5b, 0xcd, 0xec, 0x99, 0x70,
-	0x1e, 0xc2, 0x1d, 0x6b, 0x29, 0x3f, 0x11, 0xb1, 0x1a, 0xfe, 0x4f, 0xff, 0x4c, 0xee, 0x7a, 0x74,
-	0x44, 0xe9, 0x7d, 0x2d, 0x90, 0xd2, 0x18, 0x97, 0x52, 0x3e, 0xa6, 0x94, 0xd8, 0x8a, 0x31, 0xb4,
-	0x84, 0x56, 0x21, 0x19, 0x20, 0x9b, 0x1f, 0xe7, 0x8c, 0xb0, 0x84, 0xd2, 0x21, 0xd0, 0x58, 0x9b,
-	0xf2, 0xd1, 0x86, 0xe4, 0xf7, 0xcb, 0x04, 0xac, 0xf7, 0x47, 0xfd, 0xd2, 0xf4, 0x77, 0x58, 0x4f,
-	0x89, 0xe1, 0xf4, 0x74, 0x0f, 0xe6, 0xba, 0x74, 0x62, 0xda, 0x41, 0xc5, 0x31, 0x39, 0x2d, 0xb5,
-	0x9a, 0xd9, 0x73, 0x3d, 0xe4, 0xe4, 0x9b, 0x61, 0x0d, 0x75, 0xa8, 0xa9, 0x64, 0xf3, 0xe2, 0x1b,
-	0x81, 0x3d, 0xfc, 0x07, 0x05, 0x56, 0x06, 0xea, 0xaf, 0xa3, 0x5e, 0x86, 0x12, 0xe0, 0x4d, 0x48,
-	0x85, 0xd0, 0x09, 0x19, 0x76, 0xb0, 0x14, 0x86, 0x75, 0x9c, 0xf6, 0x05, 0x34, 0x16, 0x0b, 0xd0,
-	0xb7, 0x14, 0xc0, 0x51, 0x65, 0x2f, 0x15, 0xa8, 0xfb, 0x5a, 0x37, 0xed, 0x6e, 0x01, 0x5e, 0x1b,
-	0x24, 0xc0, 0xd3, 0xa1, 0xc4, 0x7d, 0xfd, 0xcd, 0xc8, 0xcc, 0xa5, 0xfc, 0x4e, 0xc0, 0xec, 0x97,
-	0x3c, 0x8b, 0x91, 0x19, 0x5c, 0xb0, 0x5b, 0x90, 0x6e, 0x0f, 0xc9, 0x3c, 0x56, 0x21, 0x69, 0x7b,
-	0x16, 0xaf, 0x12, 0xb7, 0xa3, 0xf2, 0x24, 0xc2, 0x60, 0x0a, 0x6b, 0x53, 0xb6, 0x74, 0xc5, 0x37,
-	0x60, 0x9a, 0xfd, 0x18, 0x65, 0x47, 0xf0, 0x06, 0x1c, 0x17, 0xbe, 0x72, 0xf9, 0x75, 0x18, 0x67,
-	0x33, 0xf2, 0x7e, 0x3f, 0xa5, 0x8a, 0x47, 0x83, 0xea, 0x3f, 0x1a, 0xd4, 0x82, 0xdd, 0x28, 0x26,
-	0x7f, 0xf7, 0xab, 0xdc, 0x04, 0x2f, 0x5b, 0x8d, 0x1b, 0x33, 0x68, 0x85, 0x6a, 0xb5, 0x0b, 0x5a,
-	0x09, 0xd2, 0xed, 0x21, 0x19, 0xfb, 0x2a, 0x4c, 0xf8, 0xb0, 0xc6, 0xe2, 0x04, 0x17, 0xd6, 0xb8,
-	0x00, 0x67, 0xee, 0x98, 0x2e, 0xe5, 0xb1, 0x8a, 0x0d, 0x5e, 0x07, 0x3e, 0xd4, 0x0b, 0x30, 0x21,
-	0xca, 0x48, 0x6c, 0x55, 0xba, 0xd5, 0xcc, 0x1e, 0x17, 0x40, 0x65, 0xf5, 0x88, 0x69, 0xfc, 0x0e,
-	0xcc, 0x1f, 0x0e, 0x71, 0xb4, 0xac, 0x9e, 0x29, 0x90, 0xbe, 0x57, 0x73, 0xe8, 0x76, 0xdd, 0xdc,
-	0x23, 0x23, 0x89, 0x61, 0x0b, 0xd2, 0xec, 0x2d, 0xa8, 0x1b, 0xae, 0x4b, 0x68, 0x97, 0x1c, 0xce,
-	0xb6, 0x8f, 0xf5, 0xb0, 0x05, 0xd6, 0x52, 0x6c, 0xa8, 0xc0, 0x46, 0x84, 0x24, 0x6e, 0xc3, 0x89,
-	0xc7, 0x9e, 0x43, 0xbb, 0xe3, 0x08, 0x69, 0x9c, 0x6b, 0x35, 0xb3, 0xf3, 0x22, 0xce, 0x21, 0x13,
-	0xac, 0xcd, 0xf2, 0xb1, 0x76, 0x24, 0x5c, 0x82, 0x13, 0x1d, 0x88, 0x24, 0x3d, 0x57, 0x00, 0xdc,
-	0x9a, 0x43, 0xf5, 0x1a, 0x1b, 0x95, 0x3c, 0xcf, 0xb5, 0x9a, 0xd9, 0x13, 0x22, 0x6e, 0x7b, 0x0e,
-	0x6b, 0x49, 0xd7, 0xf7, 0xc6, 0xb7, 0x61, 0x61, 0xc7, 0xa1, 0x06, 0x2f, 0x80, 0x3b, 0xe6, 0x63,
-	0xcf, 0x2c, 0x9b, 0xb4, 0x31, 0x52, 0x81, 0xfe, 0x48, 0x81, 0x4c, 0xaf, 0x50, 0x32, 0xbd, 0x8f,
-	0x20, 0x59, 0xf5, 0x07, 0xe5, 0x0e, 0x2e, 0xa8, 0xf2, 0xdd, 0xcb, 0x88, 0x0a, 0xae, 0x9e, 0x0d,
-	0xc7, 0xb4, 0x8b, 0x9b, 0xf2, 0xb2, 0x91, 0x6a, 0x0a, 0x3c, 0xf1, 0xcf, 0xfe, 0x9a, 0x5d, 0xae,
-	0x98, 0xf4, 0xa1, 0xb7, 0xab, 0xee, 0x39, 0x96, 0x7c, 0x38, 0xcb, 0x7f, 0x72, 0x6e, 0xf9, 0x51,
-	0x9e, 0xb2, 0xbb, 0x81, 0x07, 0x71, 0xb5, 0xf6, 0x8a, 0xf8, 0x0c, 0xcc, 0xf1, 0xe4, 0xc2, 0x18,
-	0xf1, 0x27, 0x0a, 0x9c, 0x0e, 0xcf, 0xfc, 0x6f, 0xa4, 0xec, 0x6f, 0xcd, 0x7b, 0x4e, 0xd5, 0xb3,
-	0xc8, 0x2d, 0xa7, 0x3e, 0xf2, 0xd9, 0xf1, 0x03, 0x7f, 0x6b, 0x42, 0xa1, 0x24, 0x4e, 0x0a, 0x93,
-	0xfb, 0x7c, 0x62, 0x30, 0xc8, 0x42, 0xf7, 0x23, 0x40, 0xb8, 0x0d, 0x87, 0x50, 0xae, 0x85, 0xf7,
-	0x21, 0xb3, 0x53, 0x37, 0xca, 0xa6, 0x5d, 0xd9, 0x36, 0xcc, 0xfa, 0x8e, 0xf1, 0x88, 0xd4, 0x6f,
-	0x91, 0x4e, 0x81, 0xf2, 0xea, 0xd7, 0x2f, 0xcb, 0x52, 0xee, 0xc0, 0x27, 0x27, 0xb0, 0x36, 0xc9,
-	0x7f, 0x5d, 0x6e, 0x1b, 0xaf, 0x4a, 0x5d, 0x1e, 0x32, 0x5e, 0xf5, 0x8d, 0x57, 0xf1, 0xd7, 0xe1,
-	0x6c, 0xcf, 0x75, 0x25, 0x19, 0x5f, 0x80, 0x24, 0x65, 0x63, 0xfa, 0x03, 0xe2, 0xab, 0x48, 0x95,
-	0x17, 0xcb, 0x85, 0x18, 0x18, 0x37, 0xc9, 0x9e, 0x36, 0x45, 0x65, 0x50, 0xfc, 0xa7, 0x04, 0x5c,
-	0xf0, 0xaf, 0x34, 0xb6, 0x28, 0x29, 0x1a, 0x2e, 0x29, 0xdf, 0xb5, 0xb9, 0xf6, 0x4a, 0x56, 0xcd,
-	0xd8, 0x0b, 0xae, 0xe7, 0xcf, 0x42, 0xf2, 0x41, 0xdd, 0xb1, 0x74, 0xd6, 0x88, 0xca, 0x43, 0x3d,
-	0x62, 0x1f, 0x44, 0xab, 0x36, 0xc5, 0x3c, 0xd8, 0xdf, 0x08, 0xc3, 0x0c, 0x75, 0xb8, 0x6f, 0xe7,
-	0xf9, 0xa4, 0x4d, 0x53, 0x87, 0x4d, 0x8b, 0xf3, 0xe7, 0x4c, 0xbb, 0x64, 0xd8, 0xa9, 0x33, 0x1e,
-	0x9c, 0x6f, 0x1f, 0x40, 0xda, 0x32, 0x0e, 0xc4, 0xe1, 0xa0, 0x9b, 0x3c, 0x2b, 0xf9, 0x06, 0x19,
-	0x16, 0x79, 0xca, 0x32, 0x0e, 0x3a, 0xb0, 0xa1, 0x77, 0x21, 0x45, 0x0e, 0x28, 0xa9, 0xdb, 0x46,
-	0x55, 0x9e, 0x4b, 0x13, 0x23, 0xc5, 0x9d, 0xf1, 0xa3, 0x88, 0x43, 0xeb, 0xe7, 0x0a, 0xbc, 0x3e,
-	0x90, 0x56, 0xb9, 0x9f, 0x6f, 0x01, 0x98, 0x76, 0xcd, 0xa3, 0x43, 0x11, 0x9b, 0xe4, 0x2e, 0x9c,
-	0xd9, 0xcf, 0xc1, 0xb4, 0xe3, 0xd1, 0x20, 0x40, 0x22, 0x5e, 0x00, 0x10, 0x3e, 0x6c, 0x64, 0xed,
-	0xbb, 0xe7, 0x60, 0xe2, 0x1d, 0x8f, 0xd4, 0x1b, 0xe8, 0x7b, 0x0a, 0x4c, 0x8a, 0x5e, 0x1b, 0x5d,
-	0x8c, 0xd1, 0x90, 0xcb, 0xd2, 0xc8, 0xac, 0xc4, 0xb2, 0x15, 0x78, 0xf1, 0xca, 0x37, 0xff, 0xf8,
-	0xf7, 0x1f, 0x26, 0xfe, 0x1f, 0x9d, 0xcf, 0x47, 0x7d, 0x14, 0x91, 0x59, 0xfc, 0x43, 0x81, 0x85,
-	0xbe, 0x3d, 0x0f, 0x7a, 0x33, 0x72, 0xdd, 0x41, 0xdf, 0x06, 0x32, 0x6f, 0x8d, 0xea, 0x2e, 0x91,
-	0xdc, 0xe1, 0x48, 0x6e, 0xa1, 0xcd, 0x48, 0x24, 0xdf, 0x90, 0x35, 0xfd, 0x51, 0x9e, 0xc8, 0x88,
-	0xe2, 0x8b, 0x0f, 0x61, 0x31, 0xe5, 0x13, 0x4f, 0x37, 0x6d, 0xf4, 0x93, 0x44, 0xc7, 0x73, 0x79,
-	0x70, 0x77, 0x8f, 0xee, 0x8e, 0x96, 0x7d, 0xdf, 0x3e, 0xe5, 0xc8, 0x74, 0x18, 0x9c, 0x8e, 0xaf,
-	0xa0, 0x2f, 0xbf, 0x0c, 0x3a, 0xf4, 0x27, 0x26, 0x7d, 0xc8, 0x24, 0x29, 0x12, 0xd5, 0xb9, 0xd4,
-	0xd0, 0x77, 0x12, 0x70, 0x3e, 0x46, 0x4b, 0x8f, 0xde, 0x8e, 0x07, 0x65, 0xe0, 0x47, 0x81, 0x23,
-	0x73, 0xf2, 0x01, 0xe7, 0x44, 0x43, 0xdb, 0x43, 0x73, 0xc2, 0x73, 0x13, 0x2d, 0x5e, 0xcf, 0x72,
-	0xf9, 0x97, 0x02, 0x99, 0xfe, 0xcd, 0x08, 0x1a, 0x29, 0xf1, 0x76, 0x33, 0x96, 0xb9, 0x39, 0xb2,
-	0xbf, 0x44, 0xfe, 0x45, 0x8e, 0xfc, 0x6d, 0xb4, 0x75, 0xf4, 0x6a, 0x70, 0x3c, 0x8a, 0x7e, 0x9a,
-	0x80, 0x4b, 0xc3, 0x34, 0xdf, 0x68, 0x7b, 0x44, 0x00, 0xfd, 0xf5, 0x71, 0x64, 0x4a, 0x76, 0x39,
-	0x25, 0x5f, 0x45, 0x1f, 0xbe, 0x14, 0x4a, 0x7a, 0x2b, 0xe4, 0xe3, 0x04, 0xbc, 0x16, 0xa7, 0xe9,
-	0x46, 0xb7, 0x8f, 0x26, 0x91, 0x97, 0x59, 0x2a, 0xf7, 0x39, 0x2f, 0xef, 0xa3, 0x77, 0x87, 0xe4,
-	0x85, 0xb1, 0x30, 0x40, 0x28, 0xac, 0x74, 0x3e, 0x51, 0x60, 0xca, 0x6f, 0x8e, 0xd1, 0xa5, 0xc8,
-	0x64, 0x43, 0x6d, 0x75, 0x26, 0x17, 0xd3, 0x5a, 0x02, 0x51, 0x39, 0x90, 0x65, 0x74, 0x21, 0x12,
-	0x48, 0xd0, 0x79, 0xa3, 0xef, 0x2b, 0x30, 0xce, 0x22, 0xa0, 0xe5, 0xe8, 0x0b, 0xb4, 0xfd, 0xac,
-	0xce, 0xbc, 0x11, 0xc3, 0x52, 0x66, 0x73, 0x85, 0x67, 0xa3, 0xa2, 0x4b, 0x91, 0xd9, 0xf0, 0x4c,
-	0xda, 0xe4, 0x72, 0xb6, 0xfc, 0x7e, 0x7b, 0x00, 0x5b, 0xa1, 0x4e, 0x7d, 0x00, 0x5b, 0xe1, 0x26,
-	0x3e, 0x26, 0x5b, 0x46, 0xb5, 0x9a, 0x13, 0x6c, 0xfd, 0x46, 0x81, 0x74, 0xb8, 0xf7, 0x46, 0x57,
-	0x22, 0xd7, 0xec, 0xd3, 0xed, 0x67, 0xae, 0x0e, 0xe9, 0x25, 0x33, 0xbe, 0xce, 0x33, 0x5e, 0x43,
-	0x97, 0x23, 0x33, 0xae, 0x9a, 0x2e, 0x15, 0x29, 0xe7, 0x76, 0x1b, 0x39, 0xfe, 0xda, 0x45, 0x3f,
-	0x56, 0x20, 0x19, 0x74, 0xc4, 0x28, 0x9a, 0xa8, 0xf0, 0xb7, 0x80, 0x8c, 0x1a, 0xd7, 0x5c, 0xa6,
-	0xb9, 0xce, 0xd3, 0xcc, 0xa1, 0x95, 0x9e, 0x69, 0x86, 0x36, 0x3c, 0xcf, 0x9f, 0xbd, 0x2e, 0x7a,
-	0xa6, 0x00, 0x3a, 0xdc, 0x1d, 0xa3, 0x4f, 0x45, 0xae, 0xdd, 0xb7, 0x33, 0xcf, 0x5c, 0x1b, 0xda,
-	0x4f, 0x26, 0x5f, 0xe2, 0xc9, 0x6f, 0xa0, 0xc2, 0x30, 0x55, 0x9b, 0xa7, 0x2c, 0xa0, 0x38, 0x04,
-	0x82, 0xfe, 0x14, 0xfd, 0x5a, 0x81, 0x54, 0x77, 0xe7, 0x8c, 0xd6, 0x06, 0xa7, 0x75, 0x08, 0xca,
-	0xfa, 0x50, 0x3e, 0x12, 0xc6, 0x0d, 0x0e, 0xe3, 0x0a, 0x5a, 0x8b, 0x01, 0x43, 0x24, 0xdf, 0xce,
-	0xfb, 0xa9, 0xbf, 0x15, 0x5d, 0xdd, 0x70, 0x9c, 0xad, 0xe8, 0xd5, 0x89, 0xc7, 0xd9, 0x8a, 0x9e,
-	0x6d, 0x37, 0x2e, 0x70, 0x0c, 0x9f, 0x41, 0x9f, 0x1e, 0x61, 0x2b, 0x44, 0x0f, 0x8d, 0x7e, 0xab,
-	0xc0, 0xc9, 0x1e, 0xcd, 0x2c, 0x1a, 0x90, 0x53, 0xdf, 0xb6, 0x3b, 0x73, 0x7d, 0x78, 0xc7, 0xa1,
-	0x76, 0x84, 0x8a, 0x08, 0x7a, 0xcd, 0x30, 0xeb, 0x3a, 0x6f, 0x93, 0x1f, 0x10, 0x82, 0xfe, 0xa9,
-	0x40, 0x76, 0x40, 0x3f, 0x87, 0x36, 0x62, 0x5d, 0x83, 0xd1, 0x4d, 0x76, 0x66, 0xf3, 0x68, 0x41,
-	0x24, 0xd4, 0x37, 0x39, 0xd4, 0x6b, 0xe8, 0xea, 0xb0, 0x17, 0x2a, 0xe5, 0x81, 0xef, 0x3f, 0x7d,
-	0xbe, 0xa8, 0x3c, 0x7b, 0xbe, 0xa8, 0xfc, 0xed, 0xf9, 0xa2, 0xf2, 0xf1, 0x8b, 0xc5, 0x63, 0xcf,
-	0x5e, 0x2c, 0x1e, 0xfb, 0xf3, 0x8b, 0xc5, 0x63, 0x1f, 0x6e, 0x74, 0xb4, 0xc3, 0x32, 0x74, 0xae,
-	0x6a, 0xec, 0xba, 0xc1, 0x3a, 0xfb, 0x6b, 0xab, 0xf9, 0x83, 0xae, 0xd5, 0xf6, 0xaa, 0x26, 0xb1,
-	0xa9, 0xf8, 0x6f, 0x6a, 0xf1, 0x21, 0x74, 0x92, 0xff, 0xb3, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb3, 0xf8, 0xe5, 0xf8, 0xc2, 0x1f, 0x00, 0x00,
+	// 2249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x5a, 0xcd, 0x6f, 0x1b, 0xd7,
+	0x11, 0xcf, 0xae, 0x3e, 0x22, 0x8e, 0x2c, 0x89, 0x7a, 0xb6, 0x24, 0x8a, 0x76, 0x2d, 0x65, 0x9d,
+	0x38, 0x8e, 0x65, 0x2e, 0x2d, 0x4a, 0xfe, 0xa8, 0xdb, 0x24, 0x25, 0x25, 0xd9, 0x56, 0xea, 0xd4,
+	0x0a, 0xed, 0x7c, 0x34, 0xad, 0x4b, 0xac, 0xc4, 0xb5, 0xbc, 0x35, 0xb9, 0x4b, 0x73, 0x97, 0x8a,
+	0x88, 0x22, 0x28, 0xd0, 0xa2, 0x68, 0x4f, 0x45, 0xda, 0x1c, 0x72, 0x68, 0x81, 0xa2, 0x40, 0x72,
+	0xe9, 0xc7, 0x2d, 0x3d, 0x14, 0xe8, 0xb1, 0x07, 0x23, 0x40, 0x0b, 0x03, 0xcd, 0x21, 0xc8, 0x21,
+	0x0d, 0xda, 0x1e, 0x0a, 0x24, 0xe8, 0xa1, 0xf9, 0x07, 0x32, 0xef, 0x63, 0x97, 0xcb, 0x25, 0xb9,
+	0xdc, 0xa5, 0xdc, 0x8f, 0x03, 0x21, 0xf2, 0xbd, 0x99, 0x79, 0xbf, 0xdf, 0xbc, 0x99, 0xf7, 0xe6,
+	0x8d, 0x0d, 0x4f, 0x5a, 0x76, 0xd5, 0xb2, 0x0d, 0x3b, 0x5b, 0xb3, 0xac, 0x4a, 0x55, 0x33, 0xb5,
+	0x5d, 0xbd, 0x9e, 0xdd, 0x5b, 0xde, 0xd6, 0x1d, 0x6d, 0x39, 0x7b, 0xaf, 0xa1, 0xd7, 0x9b, 0x6a,
+	0xad, 0x6e, 0x39, 0x16, 0x39, 0x2a, 0x04, 0x55, 0x9f, 0xa0, 0x2a, 0x04, 0xd3, 0x47, 0x76, 0xad,
+	0x5d, 0x8b, 0xc9, 0x65, 0xe9, 0x37, 0xae, 0x92, 0x7e, 0x2a, 0xcc, 0xf6, 0xae, 0x6e, 0xea, 0xcc,
+	0x1c, 0x13, 0x7d, 0x3c, 0x4c, 0xd4, 0xd9, 0x17, 0x52, 0x67, 0xc2, 0xa4, 0xec, 0xd7, 0xb4, 0x5a,
+	0xa9, 0x6e, 0x35, 0x1c, 0x5d, 0x48, 0x1f, 0xdf, 0x61, 0xe2, 0xd9, 0x6d, 0xcd, 0xd6, 0x3d, 0xa9,
+	0x1d, 0xcb, 0x30, 0xc5, 0xfc, 0x69, 0xff, 0x3c, 0xa3, 0xea, 0x49, 0xd5, 0xb4, 0x5d, 0xc3, 0xd4,
+	0x1c, 0xc3, 0x72, 0x65, 0x8f, 0xed, 0x5a, 0xd6, 0x6e, 0x45, 0xcf, 0x6a, 0x35, 0x23, 0xab, 0x99,
+	0xa6, 0xe5, 0xb0, 0x49, 0x17, 0xfd, 0xbc, 0x98, 0x65, 0xbf, 0xb6, 0x1b, 0xb7, 0x51, 0xa4, 0xe9,
+	0x4e, 0xf1, 0x45, 0x4a, 0xdc, 0x39, 0xfc, 0x87, 0x98, 0x5a, 0x08, 0x6a, 0x39, 0x46, 0x55, 0xb7,
+	0x1d, 0xad, 0x5a, 0x13, 0x02, 0x6a, 0x18, 0xdd, 0xaa, 0x55, 0x6e, 0x54, 0x74, 0x3f, 0x61, 0x65,
+	0x0a, 0x26, 0xb6, 0xb4, 0xba, 0x56, 0xb5, 0x8b, 0x3a, 0xd2, 0xb1, 0x1d, 0xe5, 0x06, 0x4c, 0xba,
+	0x03, 0x76, 0x0d, 0xe1, 0xea, 0x24, 0x0f, 0xa3, 0x35, 0x36, 0x92, 0x92, 0x16, 0xa5, 0x53, 0xe3,
+	0xb9, 0x13, 0x6a, 0xc8, 0xb6, 0xaa, 0x5c, 0xb9, 0x30, 0x7c, 0xff, 0xa3, 0x85, 0x47, 0x8a, 0x42,
+	0x51, 0xf9, 0x97, 0x04, 0x8b, 0x1b, 0x36, 0x62, 0xd5, 0x1c, 0xfd, 0x06, 0xfa, 0x7c, 0x63, 0x5f,
+	0xdb, 0x71, 0xf2, 0x55, 0xab, 0x61, 0x3a, 0x9b, 0xa6, 0x58, 0x99, 0x64, 0xe0, 0x51, 0x6a, 0xb0,
+	0x64, 0x94, 0x53, 0x32, 0x2e, 0x34, 0x5c, 0x38, 0xf2, 0xef, 0x8f, 0x16, 0x26, 0x9b, 0x5a, 0xb5,
+	0x72, 0x49, 0x11, 0x13, 0x4a, 0x4a, 0x42, 0x9b, 0xf8, 0x7d, 0xb3, 0x4c, 0x54, 0x18, 0x73, 0xac,
+	0xbb, 0xba, 0x59, 0x32, 0xcc, 0xd4, 0x10, 0xca, 0x27, 0x0a, 0x87, 0x51, 0x7e, 0x8a, 0xcb, 0xbb,
+	0x33, 0x4a, 0xf1, 0x51, 0xf6, 0x75, 0xd3, 0x24, 0xb7, 0x60, 0x94, 0x11, 0xb7, 0x53, 0xc3, 0x8b,
+	0x43, 0x48, 0x43, 0x0d, 0xa5, 0x41, 0x51, 0x7a, 0x00, 0xa9, 0x5a, 0x61, 0x86, 0x32, 0xc2, 0x15,
+	0x26, 0xf8, 0x0a, 0xdc, 0x96, 0x52, 0x14, 0x46, 0x9f, 0x1b, 0x1e, 0x93, 0x92, 0x72, 0x71, 0xd4,
+	0xd6, 0xcd, 0xb2, 0x5e, 0x57, 0x7e, 0x23, 0x43, 0xae, 0x27, 0xe1, 0x97, 0x0d, 0xe7, 0xce, 0x56,
+	0xdd, 0xa8, 0x1a, 0x8e, 0xb1, 0xa7, 0xdf, 0x6c, 0xd6, 0x74, 0xbb, 0x8b, 0x0b, 0xa4, 0x98, 0x2e,
+	0x90, 0x23, 0xb8, 0xe0, 0x59, 0x98, 0xe4, 0x68, 0x4b, 0xee, 0x2a, 0x43, 0xe8, 0x8a, 0xe1, 0xc2,
+	0x3c, 0x6a, 0xcd, 0xf8, 0x69, 0xb9, 0xf3, 0x4a, 0xf1, 0x10, 0x1f, 0xd8, 0xe2, 0x0b, 0xbe, 0x04,
+	0xb3, 0x42, 0x80, 0x5b, 0xc7, 0xef, 0xa5, 0xb2, 0x6e, 0x5a, 0x55, 0xe6, 0xd3, 0x44, 0xe1, 0x31,
+	0x34, 0xf4, 0x85, 0x36, 0x43, 0x01, 0x39, 0xa5, 0x78, 0x98, 0x4f, 0xdc, 0xa4, 0xe3, 0xd7, 0x1b,
+	0xce, 0x3a, 0x1b, 0xfd, 0x93, 0x04, 0xa7, 0x3d, 0x77, 0x19, 0x26, 0x46, 0x38, 0x5d, 0xb0, 0x67,
+	0xa4, 0x2c, 0x05, 0xdd, 0x44, 0x3a, 0xdd, 0x34, 0xb0, 0x93, 0x0a, 0x30, 0x15, 0x24, 0xc7, 0xc3,
+	0x2b, 0x8d, 0x6a, 0xb3, 0x7e, 0x35, 0x1f, 0xab, 0x09, 0xa7, 0x8d, 0xcf, 0x0f, 0x25, 0x78, 0x2c,
+	0x24, 0xde, 0x45, 0x62, 0x6d, 0x43, 0xb2, 0x65, 0x48, 0x63, 0xb3, 0x8c, 0x4f, 0xa2, 0x70, 0x91,
+	0xc6, 0xda, 0x87, 0xb8, 0x29, 0x3c, 0xf9, 0xed, 0xf2, 0x5d, 0xd5, 0xb0, 0xb2, 0x68, 0xed, 0x8e,
+	0xba, 0x69, 0x3a, 0x88, 0x63, 0x2e, 0x88, 0x83, 0xab, 0x2b, 0xc5, 0x49, 0x17, 0x08, 0x5f, 0x4d,
+	0xf9, 0xac, 0x37, 0x12, 0x14, 0x1a, 0x30, 0xf5, 0xbe, 0xe5, 0xa5, 0xd2, 0x10, 0x4b, 0xa5, 0x6c,
+	0xc4, 0x54, 0xa2, 0x2b, 0x46, 0xc8, 0x25, 0xb2, 0x0c, 0x09, 0x8f, 0x19, 0x46, 0x16, 0xf5, 0x08,
+	0x05, 0x94, 0x0c, 0x90, 0x56, 0x8a, 0x63, 0x2e, 0xdb, 0x40, 0xfa, 0xfd, 0x56, 0x86, 0x95, 0xde,
+	0xac, 0x1f, 0x5a, 0xfe, 0x75, 0xe6, 0x93, 0x1c, 0x2f, 0x9f, 0x6e, 0xc0, 0x4c, 0x5b, 0x9e, 0x18,
+	0xa6, 0x17, 0x71, 0x34, 0x9d, 0x16, 0xd1, 0xce, 0xb1, 0x2e, 0xe9, 0xe4, 0x8a, 0x29, 0x45, 0xe2,
+	0xcb, 0xa6, 0x4d, 0x93, 0x05, 0xdf, 0x00, 0xde, 0x53, 0xfe, 0x2c, 0xc1, 0x52, 0xdf, 0xfc, 0xf3,
+	0xc5, 0x4b, 0xac, 0x04, 0x44, 0x2f, 0x05, 0xd8, 0xf1, 0x34, 0xf4, 0x79, 0x29, 0x48, 0xeb, 0x90,
+	0xd3, 0x93, 0xd0, 0x50, 0x24, 0x42, 0x3f, 0x90, 0x40, 0x09, 0x0b, 0x7b, 0x91, 0x81, 0x25, 0x37,
+	0xd7, 0x71, 0xe9, 0xb6, 0x04, 0xbc, 0xd0, 0x2f, 0x01, 0x67, 0x03, 0xc0, 0xdd, 0xfc, 0x9b, 0x10,
+	0xc8, 0x45, 0xfa, 0x4d, 0xc3, 0xd4, 0xd7, 0x1a, 0x55, 0xea, 0x4c, 0xef, 0x82, 0xdd, 0x80, 0x64,
+	0x6b, 0x48, 0xe0, 0x40, 0x86, 0x66, 0xa3, 0xca, 0xa2, 0xc4, 0xf6, 0x45, 0x9e, 0x60, 0xe8, 0x4d,
+	0x21, 0x43, 0x53, 0xa8, 0x2a, 0x97, 0x60, 0x9c, 0x7e, 0x19, 0x64, 0x47, 0x94, 0x35, 0x38, 0xc4,
+	0x75, 0xc5, 0xf2, 0x2b, 0x30, 0x4c, 0x67, 0xc4, 0xfd, 0x7e, 0x44, 0xe5, 0x45, 0x86, 0xea, 0x16,
+	0x19, 0x6a, 0xde, 0x6c, 0x16, 0x12, 0xef, 0xbd, 0x9b, 0x19, 0x61, 0x61, 0x5b, 0x64, 0xc2, 0x94,
+	0x5a, 0xbe, 0x52, 0x69, 0xa3, 0xb6, 0x09, 0xc9, 0xd6, 0x90, 0xb0, 0x7d, 0x0e, 0x46, 0x5c, 0x5a,
+	0x43, 0x51, 0x8c, 0x73, 0x69, 0x25, 0x0f, 0x73, 0xd7, 0x0c, 0xdb, 0x61, 0xb6, 0x0a, 0x4d, 0x16,
+	0x07, 0x2e, 0xd5, 0x93, 0x30, 0xc2, 0xc3, 0x88, 0x6f, 0x55, 0x12, 0x89, 0x1e, 0xe2, 0x44, 0x45,
+	0xf4, 0xf0, 0x69, 0xe5, 0x05, 0x48, 0x75, 0x9a, 0x38, 0x18, 0xaa, 0x07, 0x12, 0x24, 0x6f, 0xd4,
+	0x2c, 0x07, 0x4f, 0x8f, 0x1d, 0x7d, 0xa0, 0x64, 0xc0, 0xdd, 0xa7, 0xb5, 0x63, 0x49, 0xb3, 0x6d,
+	0xdd, 0x69, 0x4b, 0x87, 0xa3, 0xad, 0x63, 0x3d, 0x28, 0x81, 0xc7, 0x3a, 0x1d, 0xca, 0xd3, 0x11,
+	0x9e, 0x12, 0x57, 0x61, 0xfa, 0x5e, 0xc3, 0x72, 0xda, 0xed, 0xf0, 0xd4, 0x38, 0x86, 0x76, 0x52,
+	0xdc, 0x4e, 0x87, 0x88, 0x52, 0x9c, 0x62, 0x63, 0x2d, 0x4b, 0xb8, 0x67, 0xd3, 0x3e, 0x46, 0xc2,
+	0x3d, 0xab, 0x00, 0xf8, 0xcd, 0xc1, 0x0a, 0x14, 0x47, 0x85, 0x9f, 0x67, 0xd0, 0xee, 0x34, 0xb7,
+	0xdb, 0x9a, 0x53, 0x8a, 0x09, 0xdb, 0xd5, 0x56, 0xae, 0xc2, 0xfc, 0x4d, 0xac, 0x72, 0x59, 0x00,
+	0x5c, 0x33, 0xee, 0x35, 0x8c, 0xb2, 0xe1, 0x34, 0x07, 0x0a, 0xd0, 0x9f, 0x49, 0x90, 0xee, 0x66,
+	0x4a, 0xc0, 0x7b, 0x1d, 0x12, 0x15, 0x77, 0x50, 0xec, 0xe0, 0xbc, 0x2a, 0xea, 0x64, 0xea, 0x28,
+	0xef, 0xea, 0x59, 0xc3, 0xca, 0xbd, 0xb0, 0x2e, 0x2e, 0x1b, 0x91, 0x4d, 0x9e, 0xa6, 0xf2, 0xab,
+	0xbf, 0x2e, 0x9c, 0xda, 0xc5, 0xbb, 0xa0, 0xb1, 0x8d, 0xca, 0x55, 0x51, 0x68, 0x8b, 0x3f, 0x19,
+	0x4c, 0xf9, 0xac, 0x43, 0xef, 0x06, 0x66, 0xc4, 0x2e, 0xb6, 0x56, 0x54, 0xe6, 0x60, 0x86, 0x81,
+	0x0b, 0x72, 0x54, 0xde, 0x92, 0x60, 0x36, 0x38, 0xf3, 0xff, 0x01, 0xd9, 0xdd, 0x9a, 0x97, 0xac,
+	0x4a, 0xa3, 0xaa, 0x5f, 0xb6, 0xea, 0x03, 0x9f, 0x1d, 0x3f, 0x75, 0xb7, 0x26, 0x60, 0x4a, 0xf0,
+	0x74, 0x60, 0x74, 0x8f, 0x4d, 0xf4, 0x27, 0x99, 0x6f, 0x2f, 0x02, 0xb8, 0x5a, 0x3c, 0x86, 0x62,
+	0x2d, 0x65, 0x0f, 0x31, 0xd5, 0xb5, 0x32, 0xde, 0x5c, 0x5b, 0x9a, 0x51, 0xbf, 0xa9, 0xdd, 0xd5,
+	0xeb, 0x97, 0x75, 0x7f, 0x82, 0xb2, 0xe8, 0x2f, 0x9d, 0x15, 0xa1, 0xec, 0xe3, 0x27, 0x26, 0x90,
+	0x1f, 0xfb, 0x76, 0xb6, 0x25, 0xbc, 0x2c, 0xf2, 0xb2, 0x43, 0x78, 0xd9, 0x15, 0x5e, 0x56, 0xbe,
+	0x0d, 0x47, 0xbb, 0xae, 0x2b, 0x9c, 0xf1, 0x55, 0xbc, 0xb8, 0xe8, 0x58, 0xe9, 0xb6, 0xee, 0x66,
+	0x91, 0x2a, 0x2e, 0x96, 0x93, 0x11, 0x38, 0xae, 0xeb, 0x3b, 0x78, 0xa5, 0x09, 0xa3, 0xca, 0xfb,
+	0x32, 0x9c, 0x74, 0xaf, 0x34, 0xba, 0xa8, 0x5e, 0x40, 0x8f, 0x96, 0xaf, 0x9b, 0x2c, 0xf7, 0x36,
+	0xab, 0x35, 0xbc, 0xe0, 0x5c, 0xc2, 0x5f, 0x86, 0xc4, 0xed, 0x3a, 0x22, 0xa5, 0x0f, 0x57, 0x71,
+	0xa8, 0x87, 0xec, 0x03, 0x7f, 0xaa, 0x8d, 0x51, 0x0d, 0xfa, 0x9b, 0x28, 0x80, 0x97, 0x18, 0xd3,
+	0xf5, 0x9f, 0x4f, 0xc5, 0x71, 0xc7, 0xa2, 0xd3, 0xfc, 0xfc, 0x99, 0x6b, 0x85, 0x0c, 0x3d, 0x75,
+	0x86, 0xbd, 0xf3, 0xed, 0x15, 0x48, 0x56, 0xb5, 0x7d, 0x7e, 0x38, 0x94, 0x0c, 0x86, 0x4a, 0xd4,
+	0x20, 0x71, 0x99, 0x4f, 0xa2, 0x1d, 0x1f, 0x37, 0xf2, 0x22, 0x4c, 0xea, 0xfb, 0x8e, 0x5e, 0x37,
+	0xb5, 0x8a, 0x38, 0x97, 0x46, 0x06, 0xb2, 0x3b, 0xe1, 0x5a, 0xe1, 0x87, 0xd6, 0xaf, 0x25, 0x78,
+	0xb2, 0xaf, 0x5b, 0xc5, 0x7e, 0x3e, 0x03, 0x60, 0x98, 0x35, 0xac, 0xb6, 0xe3, 0x38, 0x36, 0xc1,
+	0x54, 0x98, 0x67, 0xbf, 0x02, 0xe3, 0x58, 0xa7, 0x78, 0x06, 0xe4, 0x68, 0x06, 0x80, 0xeb, 0xd0,
+	0x11, 0xc5, 0x81, 0x94, 0x2f, 0xe0, 0x78, 0x0a, 0xfe, 0xe7, 0xc3, 0xfc, 0x6d, 0x19, 0x8f, 0x8f,
+	0xce, 0x65, 0x85, 0x57, 0xb0, 0xd6, 0xc2, 0x7a, 0x0d, 0x4f, 0x84, 0x52, 0xd4, 0xcc, 0xbf, 0x22,
+	0x32, 0xff, 0xb0, 0x5b, 0x44, 0xb5, 0x94, 0xe3, 0xe5, 0xff, 0xb8, 0xd3, 0x3a, 0x89, 0xc8, 0x77,
+	0x01, 0xb8, 0x8d, 0x52, 0x6e, 0xf5, 0x0e, 0xab, 0xc4, 0x43, 0x41, 0x6c, 0x08, 0x10, 0xd3, 0xfe,
+	0xe3, 0x87, 0xaa, 0xc6, 0x3c, 0x64, 0xb9, 0x22, 0xd5, 0x4b, 0xc1, 0x2c, 0x3d, 0x0b, 0xe9, 0x8b,
+	0x82, 0x43, 0xf2, 0x0a, 0xa3, 0x8f, 0x25, 0x98, 0x6c, 0x9f, 0xc2, 0x44, 0x49, 0xb0, 0x0c, 0xa2,
+	0xb6, 0xd8, 0x7e, 0x4d, 0xe6, 0x9e, 0x08, 0x6f, 0xac, 0x08, 0x7d, 0x7f, 0x65, 0xe8, 0x59, 0xc0,
+	0xca, 0xb0, 0x26, 0xe6, 0x7d, 0x47, 0xb0, 0xfc, 0x5f, 0x3c, 0x82, 0x7f, 0x2e, 0xc3, 0x5c, 0x07,
+	0x7b, 0x11, 0x21, 0x4d, 0x98, 0xf6, 0x90, 0x8a, 0x7d, 0x76, 0x2b, 0xaf, 0xa5, 0x48, 0x9c, 0xb9,
+	0xc1, 0xc2, 0xa2, 0x80, 0x9b, 0x0a, 0xb0, 0x77, 0x6d, 0x62, 0x79, 0x53, 0x6b, 0x87, 0xd0, 0x19,
+	0x9c, 0xf2, 0xff, 0x24, 0x38, 0x73, 0xdf, 0x5f, 0x80, 0x91, 0x17, 0x68, 0xbf, 0x90, 0xfc, 0x58,
+	0x82, 0x51, 0xde, 0x24, 0x23, 0xa7, 0x23, 0x74, 0xd2, 0x44, 0x08, 0xa5, 0x97, 0x22, 0xc9, 0x72,
+	0x87, 0x2b, 0x4b, 0xdf, 0xfb, 0xcb, 0x3f, 0xde, 0x94, 0x9f, 0x20, 0x27, 0xb2, 0x61, 0xed, 0x40,
+	0x81, 0xe2, 0x9f, 0x12, 0xcc, 0xf7, 0x6c, 0x56, 0x90, 0xa7, 0x43, 0xd7, 0xed, 0xd7, 0xd4, 0x4b,
+	0x3f, 0x33, 0xa8, 0xba, 0x60, 0x72, 0x8d, 0x31, 0xb9, 0x4c, 0xd6, 0x43, 0x99, 0x7c, 0x47, 0x5c,
+	0x46, 0xaf, 0x67, 0x75, 0x61, 0x91, 0xb7, 0x76, 0x75, 0x6a, 0x53, 0xbc, 0xcd, 0xf0, 0x95, 0x46,
+	0x7e, 0x29, 0xfb, 0xde, 0xb9, 0xfd, 0xdb, 0x72, 0xe4, 0xfa, 0x60, 0xe8, 0x7b, 0x36, 0x18, 0x0e,
+	0xec, 0x0e, 0x8d, 0xb9, 0xe3, 0x1b, 0xe4, 0xeb, 0x0f, 0xc3, 0x1d, 0xa5, 0xd7, 0x10, 0x27, 0xbd,
+	0x4b, 0x39, 0x50, 0x96, 0x4a, 0x36, 0xf9, 0x91, 0x0c, 0x27, 0x22, 0xf4, 0xe2, 0xc8, 0x95, 0x68,
+	0x54, 0xfa, 0x76, 0xf3, 0x0e, 0xec, 0x93, 0x57, 0x98, 0x4f, 0x8a, 0x64, 0x2b, 0xb6, 0x4f, 0x18,
+	0x36, 0xde, 0x9b, 0xe9, 0x1a, 0x2e, 0x9f, 0x62, 0xad, 0xdb, 0xbb, 0x8b, 0x40, 0x06, 0x02, 0xde,
+	0xea, 0xa2, 0xa4, 0x9f, 0x1d, 0x58, 0x5f, 0x30, 0x7f, 0x9e, 0x31, 0xbf, 0x42, 0x36, 0x0e, 0x1e,
+	0x0d, 0x58, 0x63, 0x90, 0x77, 0x64, 0x38, 0x13, 0xa7, 0x6b, 0x46, 0xb6, 0x06, 0x24, 0xd0, 0x3b,
+	0x3f, 0x0e, 0xec, 0x92, 0x6d, 0xe6, 0x92, 0x6f, 0x92, 0x57, 0x1f, 0x8a, 0x4b, 0xba, 0x67, 0xc8,
+	0x1b, 0x32, 0x3c, 0x1e, 0xa5, 0x5b, 0x46, 0xae, 0x1e, 0x2c, 0x45, 0x1e, 0x66, 0xa8, 0xdc, 0x62,
+	0x7e, 0x79, 0x99, 0xbc, 0x18, 0xd3, 0x2f, 0xd4, 0x0b, 0x7d, 0x12, 0x85, 0x86, 0x0e, 0xbe, 0x7c,
+	0xc7, 0xdc, 0xae, 0x16, 0x39, 0x13, 0x0a, 0x36, 0xd0, 0x0f, 0x4b, 0x67, 0x22, 0x4a, 0x0b, 0x22,
+	0x2a, 0x23, 0x72, 0x8a, 0x9c, 0x0c, 0x25, 0xe2, 0xb5, 0xcc, 0xc8, 0x4f, 0x24, 0x18, 0xa6, 0x16,
+	0xc8, 0xa9, 0xbe, 0x95, 0x86, 0x8b, 0xe8, 0xa9, 0x08, 0x92, 0x02, 0xcd, 0x2a, 0x43, 0xa3, 0x92,
+	0x33, 0xa1, 0x68, 0x18, 0x92, 0x96, 0x73, 0x99, 0xb7, 0xdc, 0x46, 0x59, 0x1f, 0x6f, 0x05, 0x5a,
+	0x6c, 0x7d, 0xbc, 0x15, 0xec, 0xbe, 0x45, 0xf4, 0x96, 0x56, 0xa9, 0x64, 0xb8, 0xb7, 0x7e, 0x2f,
+	0x41, 0x32, 0xd8, 0x34, 0x23, 0xab, 0xa1, 0x6b, 0xf6, 0x68, 0xd3, 0xa5, 0xcf, 0xc5, 0xd4, 0x12,
+	0x88, 0x2f, 0x32, 0xc4, 0x39, 0x72, 0x36, 0x14, 0x71, 0x05, 0xd5, 0x39, 0xe4, 0xcc, 0x76, 0x33,
+	0xc3, 0x9e, 0x29, 0xe4, 0x17, 0x12, 0x24, 0xbc, 0x56, 0x16, 0x09, 0x77, 0x54, 0xb0, 0x89, 0x97,
+	0x56, 0xa3, 0x8a, 0x0b, 0x98, 0x2b, 0x0c, 0x66, 0x86, 0x2c, 0x75, 0x85, 0x19, 0xd8, 0xf0, 0x2c,
+	0x7b, 0xaf, 0xda, 0xe4, 0x81, 0x04, 0xa4, 0xb3, 0xad, 0x45, 0xce, 0x87, 0xae, 0xdd, 0xb3, 0xa5,
+	0x96, 0xbe, 0x10, 0x5b, 0x4f, 0x80, 0xdf, 0x64, 0xe0, 0xd7, 0x48, 0x3e, 0x4e, 0xd4, 0x66, 0x79,
+	0x25, 0xcc, 0x7e, 0x7a, 0x8d, 0x25, 0xf2, 0x3b, 0x7c, 0xd9, 0xb4, 0xb7, 0xbc, 0x48, 0xae, 0x3f,
+	0xac, 0x0e, 0x2a, 0x2b, 0xb1, 0x74, 0x04, 0x8d, 0x4b, 0x8c, 0xc6, 0x2a, 0xc9, 0x45, 0xa0, 0xc1,
+	0xc1, 0xb7, 0x70, 0xdf, 0x77, 0xb7, 0xa2, 0xad, 0x8d, 0x15, 0x65, 0x2b, 0xba, 0xb5, 0xd0, 0xa2,
+	0x6c, 0x45, 0xd7, 0x7e, 0x99, 0x92, 0x67, 0x1c, 0xbe, 0x44, 0xbe, 0x38, 0xc0, 0x56, 0xf0, 0x47,
+	0x09, 0xf9, 0xa3, 0x04, 0x87, 0xbb, 0x74, 0xa1, 0x48, 0x1f, 0x4c, 0x3d, 0xfb, 0x65, 0xe9, 0x8b,
+	0xf1, 0x15, 0x63, 0xed, 0x88, 0xc3, 0x2d, 0x94, 0x6a, 0x68, 0xa2, 0xc4, 0xfa, 0x5b, 0xb7, 0x11,
+	0xee, 0x27, 0x12, 0x2c, 0xf4, 0x69, 0xc4, 0x90, 0xb5, 0x48, 0xd7, 0x60, 0x78, 0x77, 0x2c, 0xbd,
+	0x7e, 0x30, 0x23, 0x82, 0xea, 0xd3, 0x8c, 0xea, 0x05, 0x72, 0x2e, 0xee, 0x85, 0x4a, 0xd9, 0xeb,
+	0xe4, 0x0f, 0x12, 0x4c, 0x77, 0xb4, 0x54, 0xc8, 0xb9, 0xa8, 0x9e, 0x6f, 0xeb, 0xfc, 0xa4, 0xcf,
+	0xc7, 0x55, 0x8b, 0x75, 0xd6, 0xb6, 0x6d, 0x97, 0x88, 0xb9, 0x77, 0x25, 0x98, 0x0a, 0xbc, 0xf6,
+	0xc9, 0x4a, 0x8c, 0xa7, 0xbc, 0x77, 0x9f, 0xad, 0xc6, 0x53, 0x12, 0xc0, 0xcf, 0x33, 0xe0, 0x67,
+	0x89, 0xda, 0x37, 0x6b, 0xda, 0xfa, 0x03, 0x85, 0x5b, 0xf7, 0xff, 0x76, 0x5c, 0x7a, 0x80, 0x9f,
+	0x8f, 0xf1, 0xf3, 0xc6, 0xdf, 0x8f, 0x3f, 0xf2, 0x00, 0x3f, 0x1f, 0xe0, 0xe7, 0xd5, 0x35, 0xdf,
+	0xb3, 0x5e, 0xd8, 0xcc, 0x54, 0xb4, 0x6d, 0xdb, 0x5b, 0x60, 0x2f, 0xb7, 0x9c, 0xdd, 0x6f, 0x5b,
+	0x66, 0xa7, 0x62, 0xe8, 0xa6, 0xc3, 0xff, 0x17, 0x10, 0xff, 0x77, 0xa3, 0x51, 0xf6, 0x67, 0xe5,
+	0x73, 0x35, 0x1f, 0x77, 0xcb, 0x21, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1753,6 +2020,12 @@ type QueryClient interface {
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
 	EstimateTradeBasedOnPriceImpact(ctx context.Context, in *EstimateTradeBasedOnPriceImpactRequest, opts ...grpc.CallOption) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// TradingPairVolume returns the total and rolling 24h swap volume of a given
+	// set of denoms, tracked on every route hop.
+	TradingPairVolume(ctx context.Context, in *TradingPairVolumeRequest, opts ...grpc.CallOption) (*TradingPairVolumeResponse, error)
+	// PoolTypeVolumes returns the total swap volume of every pool type and across
+	// all pool types, tracked on every route hop.
+	PoolTypeVolumes(ctx context.Context, in *PoolTypeVolumesRequest, opts ...grpc.CallOption) (*PoolTypeVolumesResponse, error)
 }
 
 type queryClient struct {
@@ -1916,6 +2189,24 @@ func (c *queryClient) EstimateTradeBasedOnPriceImpact(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *queryClient) TradingPairVolume(ctx context.Context, in *TradingPairVolumeRequest, opts ...grpc.CallOption) (*TradingPairVolumeResponse, error) {
+	out := new(TradingPairVolumeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/TradingPairVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolTypeVolumes(ctx context.Context, in *PoolTypeVolumesRequest, opts ...grpc.CallOption) (*PoolTypeVolumesResponse, error) {
+	out := new(PoolTypeVolumesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.poolmanager.v1beta1.Query/PoolTypeVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -1961,6 +2252,12 @@ type QueryServer interface {
 	// impact, if a trade cannot be estimated a 0 input and 0 output would be
 	// returned.
 	EstimateTradeBasedOnPriceImpact(context.Context, *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error)
+	// TradingPairVolume returns the total and rolling 24h swap volume of a given
+	// set of denoms, tracked on every route hop.
+	TradingPairVolume(context.Context, *TradingPairVolumeRequest) (*TradingPairVolumeResponse, error)
+	// PoolTypeVolumes returns the total swap volume of every pool type and across
+	// all pool types, tracked on every route hop.
+	PoolTypeVolumes(context.Context, *PoolTypeVolumesRequest) (*PoolTypeVolumesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2018,6 +2315,12 @@ func (*UnimplementedQueryServer) TradingPairTakerFee(ctx context.Context, req *T
 func (*UnimplementedQueryServer) EstimateTradeBasedOnPriceImpact(ctx context.Context, req *EstimateTradeBasedOnPriceImpactRequest) (*EstimateTradeBasedOnPriceImpactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateTradeBasedOnPriceImpact not implemented")
 }
+func (*UnimplementedQueryServer) TradingPairVolume(ctx context.Context, req *TradingPairVolumeRequest) (*TradingPairVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradingPairVolume not implemented")
+}
+func (*UnimplementedQueryServer) PoolTypeVolumes(ctx context.Context, req *PoolTypeVolumesRequest) (*PoolTypeVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTypeVolumes not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2329,6 +2632,42 @@ func _Query_EstimateTradeBasedOnPriceImpact_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TradingPairVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradingPairVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradingPairVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/TradingPairVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradingPairVolume(ctx, req.(*TradingPairVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolTypeVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolTypeVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolTypeVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.poolmanager.v1beta1.Query/PoolTypeVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolTypeVolumes(ctx, req.(*PoolTypeVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.poolmanager.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2401,6 +2740,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateTradeBasedOnPriceImpact",
 			Handler:    _Query_EstimateTradeBasedOnPriceImpact_Handler,
 		},
+		{
+			MethodName: "TradingPairVolume",
+			Handler:    _Query_TradingPairVolume_Handler,
+		},
+		{
+			MethodName: "PoolTypeVolumes",
+			Handler:    _Query_PoolTypeVolumes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/poolmanager/v1beta1/query.proto",
@@ -3515,55 +3862,259 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TradingPairVolumeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *TradingPairVolumeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *TradingPairVolumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom_1) > 0 {
+		i -= len(m.Denom_1)
+		copy(dAtA[i:], m.Denom_1)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Denom_0) > 0 {
+		i -= len(m.Denom_0)
+		copy(dAtA[i:], m.Denom_0)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom_0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingPairVolumeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingPairVolumeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingPairVolumeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume_24H) > 0 {
+		for iNdEx := len(m.Volume_24H) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume_24H[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TotalVolume) > 0 {
+		for iNdEx := len(m.TotalVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTypeVolumesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeVolumesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeVolumesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTypeVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTypeVolumesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeVolumesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeVolumesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalVolume) > 0 {
+		for iNdEx := len(m.TotalVolume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalVolume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PoolTypeVolumes) > 0 {
+		for iNdEx := len(m.PoolTypeVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTypeVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
@@ -3982,61 +4533,147 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
+func (m *TradingPairVolumeRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+	var l int
+	_ = l
+	l = len(m.Denom_0)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	return nil
+	l = len(m.Denom_1)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TradingPairVolumeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalVolume) > 0 {
+		for _, e := range m.TotalVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Volume_24H) > 0 {
+		for _, e := range m.Volume_24H {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolTypeVolumesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PoolTypeVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolType != 0 {
+		n += 1 + sovQuery(uint64(m.PoolType))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolTypeVolumesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolTypeVolumes) > 0 {
+		for _, e := range m.PoolTypeVolumes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalVolume) > 0 {
+		for _, e := range m.TotalVolume {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -6411,15 +7048,499 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types2.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_0 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom_1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom_1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalVolumeForPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6446,8 +7567,40 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Volume = append(m.Volume, types2.Coin{})
-			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6472,7 +7625,7 @@ func (m *TotalVolumeForPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *TradingPairVolumeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6495,10 +7648,10 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairVolumeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairVolumeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6586,7 +7739,8 @@ func (m *TradingPairTakerFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
+
+func (m *TradingPairVolumeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6609,17 +7763,17 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingPairVolumeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TradingPairTakerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingPairVolumeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVolume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6629,23 +7783,57 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalVolume = append(m.TotalVolume, types2.Coin{})
+			if err := m.TotalVolume[len(m.TotalVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume_24H", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume_24H = append(m.Volume_24H, types2.Coin{})
+			if err := m.Volume_24H[len(m.Volume_24H)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6670,7 +7858,8 @@ func (m *TradingPairTakerFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PoolTypeVolumesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6693,101 +7882,68 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTypeVolumesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTypeVolumesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PoolTypeVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceImpact", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolTypeVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolTypeVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
 			}
-			var stringLen uint64
+			m.PoolType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6797,31 +7953,16 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolType |= types.PoolType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxPriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6831,23 +7972,23 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExternalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types2.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6872,7 +8013,8 @@ func (m *EstimateTradeBasedOnPriceImpactRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
+
+func (m *PoolTypeVolumesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6895,15 +8037,15 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTypeVolumesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateTradeBasedOnPriceImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTypeVolumesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeVolumes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6930,13 +8072,14 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolTypeVolumes = append(m.PoolTypeVolumes, PoolTypeVolume{})
+			if err := m.PoolTypeVolumes[len(m.PoolTypeVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVolume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6963,7 +8106,8 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutputCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalVolume = append(m.TotalVolume, types2.Coin{})
+			if err := m.TotalVolume[len(m.TotalVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6988,6 +8132,7 @@ func (m *EstimateTradeBasedOnPriceImpactResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,111 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// denomAliases is the registry of denom aliases keyed by alias denom.
+type denomAliases map[string]types.DenomAlias
+
+// getDenomAliases returns the denom aliases param keyed by alias denom.
+// Only the denom aliases param is read to avoid charging the gas of reading all params on every route.
+func (k Keeper) getDenomAliases(ctx sdk.Context) denomAliases {
+	var aliases []types.DenomAlias
+	k.paramSpace.GetIfExists(ctx, types.KeyDenomAliases, &aliases)
+
+	aliasesByDenom := make(denomAliases, len(aliases))
+	for _, alias := range aliases {
+		aliasesByDenom[alias.AliasDenom] = alias
+	}
+	return aliasesByDenom
+}
+
+// canonicalDenom returns the canonical denom of the given denom, which is the denom itself if it is not an alias.
+func (a denomAliases) canonicalDenom(denom string) string {
+	if alias, ok := a[denom]; ok {
+		return alias.CanonicalDenom
+	}
+	return denom
+}
+
+// findInterchangeableDenom returns the first of the given pool denoms that is interchangeable with,
+// but different from, the given denom and true. Returns false if there is no such denom.
+func (a denomAliases) findInterchangeableDenom(denom string, poolDenoms []string) (string, bool) {
+	canonicalDenom := a.canonicalDenom(denom)
+	for _, poolDenom := range poolDenoms {
+		if poolDenom != denom && a.canonicalDenom(poolDenom) == canonicalDenom {
+			return poolDenom, true
+		}
+	}
+	return "", false
+}
+
+// conversionRoute returns the route converting fromDenom to the interchangeable toDenom.
+// An alias is first converted to its canonical denom through its converter pool, which
+// is then converted to the alias it is swapped to through the converter pool of that alias.
+func (a denomAliases) conversionRoute(fromDenom, toDenom string) []types.SwapAmountInRoute {
+	route := make([]types.SwapAmountInRoute, 0, 2)
+	if alias, ok := a[fromDenom]; ok {
+		route = append(route, types.SwapAmountInRoute{PoolId: alias.ConverterPoolId, TokenOutDenom: alias.CanonicalDenom})
+		fromDenom = alias.CanonicalDenom
+	}
+	if alias, ok := a[toDenom]; ok && fromDenom != toDenom {
+		route = append(route, types.SwapAmountInRoute{PoolId: alias.ConverterPoolId, TokenOutDenom: toDenom})
+	}
+	return route
+}
+
+// composeDenomAliasConversions returns the given route starting with tokenInDenom with the conversions between
+// interchangeable denoms inserted where a pool does not hold the denom swapped through it. That is,
+//   - if a pool does not hold the token in of its step but holds a denom interchangeable with it,
+//     the token in is converted to that denom before the step
+//   - if a pool does not hold the token out denom of its step but holds a denom interchangeable with it,
+//     the step swaps to that denom, which is then converted to the token out denom
+//
+// Steps that need no conversion, or for which the pool holds no interchangeable denom, are left as is.
+// The route is returned unchanged if there are no denom aliases.
+// Returns types.TooManyHopsError if the composed route goes through more pools than the max hops param,
+// and error if fails to get the denoms of a pool.
+func (k Keeper) composeDenomAliasConversions(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) ([]types.SwapAmountInRoute, error) {
+	aliases := k.getDenomAliases(ctx)
+	if len(aliases) == 0 {
+		return route, nil
+	}
+
+	composedRoute := make([]types.SwapAmountInRoute, 0, len(route))
+	currentDenom := tokenInDenom
+	for _, routeStep := range route {
+		poolDenoms, err := k.RouteGetPoolDenoms(ctx, routeStep.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		if !osmoutils.Contains(poolDenoms, currentDenom) {
+			if poolDenom, found := aliases.findInterchangeableDenom(currentDenom, poolDenoms); found {
+				composedRoute = append(composedRoute, aliases.conversionRoute(currentDenom, poolDenom)...)
+			}
+		}
+
+		if !osmoutils.Contains(poolDenoms, routeStep.TokenOutDenom) {
+			if poolDenom, found := aliases.findInterchangeableDenom(routeStep.TokenOutDenom, poolDenoms); found {
+				composedRoute = append(composedRoute, types.SwapAmountInRoute{PoolId: routeStep.PoolId, TokenOutDenom: poolDenom})
+				composedRoute = append(composedRoute, aliases.conversionRoute(poolDenom, routeStep.TokenOutDenom)...)
+				currentDenom = routeStep.TokenOutDenom
+				continue
+			}
+		}
+
+		composedRoute = append(composedRoute, routeStep)
+		currentDenom = routeStep.TokenOutDenom
+	}
+
+	// The conversions are swaps like any other, so they count towards the max hops.
+	if err := k.validateRouteHops(ctx, len(composedRoute)); err != nil {
+		return nil, err
+	}
+
+	return composedRoute, nil
+}
@@ -0,0 +1,221 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+const (
+	usdcCanonical = "uusdcnoble"
+	usdcAxl       = "uusdcaxl"
+	usdcEth       = "uusdceth"
+)
+
+// denomAliasPools are the pools used to test denom alias conversions.
+type denomAliasPools struct {
+	osmoCanonicalPoolId uint64
+	axlConverterPoolId  uint64
+	ethConverterPoolId  uint64
+	osmoAxlPoolId       uint64
+}
+
+// setupDenomAliasPools creates a pool for UOSMO and each of canonical USDC and USDC.axl,
+// and the converter pools of USDC.axl and USDC.eth to canonical USDC.
+// If withAliases is true, USDC.axl and USDC.eth are registered as aliases of canonical USDC.
+func (s *KeeperTestSuite) setupDenomAliasPools(withAliases bool) denomAliasPools {
+	coin := func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 1_000_000_000) }
+	pools := denomAliasPools{
+		osmoCanonicalPoolId: s.PrepareBalancerPoolWithCoins(coin(UOSMO), coin(usdcCanonical)),
+		axlConverterPoolId:  s.PrepareBalancerPoolWithCoins(coin(usdcAxl), coin(usdcCanonical)),
+		ethConverterPoolId:  s.PrepareBalancerPoolWithCoins(coin(usdcEth), coin(usdcCanonical)),
+		osmoAxlPoolId:       s.PrepareBalancerPoolWithCoins(coin(UOSMO), coin(usdcAxl)),
+	}
+
+	if withAliases {
+		s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyDenomAliases, []types.DenomAlias{
+			{AliasDenom: usdcAxl, CanonicalDenom: usdcCanonical, ConverterPoolId: pools.axlConverterPoolId},
+			{AliasDenom: usdcEth, CanonicalDenom: usdcCanonical, ConverterPoolId: pools.ethConverterPoolId},
+		})
+	}
+
+	return pools
+}
+
+// validates that conversions between interchangeable denoms are composed into routes
+// where a pool does not hold the denom swapped through it.
+func (s *KeeperTestSuite) TestComposeDenomAliasConversions() {
+	tests := map[string]struct {
+		noAliases    bool
+		tokenInDenom string
+		route        func(p denomAliasPools) []types.SwapAmountInRoute
+
+		expectedRoute func(p denomAliasPools) []types.SwapAmountInRoute
+	}{
+		"pool holds the denoms -> no conversion": {
+			tokenInDenom: UOSMO,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: usdcCanonical}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: usdcCanonical}}
+			},
+		},
+		"token in is an alias of the pool denom -> converted to canonical": {
+			tokenInDenom: usdcAxl,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{
+					{PoolId: p.axlConverterPoolId, TokenOutDenom: usdcCanonical},
+					{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: UOSMO},
+				}
+			},
+		},
+		"token in is canonical and pool holds an alias -> converted to alias": {
+			tokenInDenom: usdcCanonical,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoAxlPoolId, TokenOutDenom: UOSMO}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{
+					{PoolId: p.axlConverterPoolId, TokenOutDenom: usdcAxl},
+					{PoolId: p.osmoAxlPoolId, TokenOutDenom: UOSMO},
+				}
+			},
+		},
+		"token in and pool denom are aliases of the same canonical denom -> converted through canonical": {
+			tokenInDenom: usdcEth,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoAxlPoolId, TokenOutDenom: UOSMO}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{
+					{PoolId: p.ethConverterPoolId, TokenOutDenom: usdcCanonical},
+					{PoolId: p.axlConverterPoolId, TokenOutDenom: usdcAxl},
+					{PoolId: p.osmoAxlPoolId, TokenOutDenom: UOSMO},
+				}
+			},
+		},
+		"token out is an alias of the pool denom -> swapped to pool denom and converted": {
+			tokenInDenom: UOSMO,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: usdcAxl}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{
+					{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: usdcCanonical},
+					{PoolId: p.axlConverterPoolId, TokenOutDenom: usdcAxl},
+				}
+			},
+		},
+		"multihop with conversion in between": {
+			tokenInDenom: UOSMO,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{
+					{PoolId: p.osmoAxlPoolId, TokenOutDenom: usdcAxl},
+					{PoolId: p.ethConverterPoolId, TokenOutDenom: usdcEth},
+				}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{
+					{PoolId: p.osmoAxlPoolId, TokenOutDenom: usdcAxl},
+					{PoolId: p.axlConverterPoolId, TokenOutDenom: usdcCanonical},
+					{PoolId: p.ethConverterPoolId, TokenOutDenom: usdcEth},
+				}
+			},
+		},
+		"pool holds no interchangeable denom -> unchanged": {
+			tokenInDenom: FOO,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+			},
+		},
+		"no aliases -> unchanged": {
+			noAliases:    true,
+			tokenInDenom: usdcAxl,
+			route: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+			},
+
+			expectedRoute: func(p denomAliasPools) []types.SwapAmountInRoute {
+				return []types.SwapAmountInRoute{{PoolId: p.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			pools := s.setupDenomAliasPools(!tc.noAliases)
+
+			composedRoute, err := s.App.PoolManagerKeeper.ComposeDenomAliasConversions(s.Ctx, tc.route(pools), tc.tokenInDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedRoute(pools), composedRoute)
+		})
+	}
+}
+
+// validates that swapping an alias through a pool holding its canonical denom
+// converts the alias before the swap, and fails if the alias is not registered.
+func (s *KeeperTestSuite) TestRouteExactAmountIn_DenomAlias() {
+	for _, withAliases := range []bool{true, false} {
+		s.SetupTest()
+		pools := s.setupDenomAliasPools(withAliases)
+
+		tokenIn := sdk.NewInt64Coin(usdcAxl, 1_000_000)
+		s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+		route := []types.SwapAmountInRoute{{PoolId: pools.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+		osmoBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], UOSMO)
+
+		expectedTokenOut, estimateErr := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+
+		// System under test.
+		tokenOutAmount, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt())
+
+		if !withAliases {
+			s.Require().Error(estimateErr)
+			s.Require().Error(err)
+			continue
+		}
+
+		s.Require().NoError(estimateErr)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedTokenOut, tokenOutAmount)
+		s.Require().True(tokenOutAmount.IsPositive())
+		s.Require().Equal(tokenOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], UOSMO).Amount.Sub(osmoBalanceBefore.Amount))
+		s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], usdcAxl).IsZero())
+	}
+}
+
+// validates that the conversions composed into a route count towards the max hops param.
+func (s *KeeperTestSuite) TestRouteExactAmountIn_DenomAliasExceedingMaxHops() {
+	s.SetupTest()
+	pools := s.setupDenomAliasPools(true)
+	s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyMaxHops, uint64(1))
+
+	tokenIn := sdk.NewInt64Coin(usdcAxl, 1_000_000)
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+	// The conversion of USDC.axl to canonical USDC is composed before the single hop of the route.
+	route := []types.SwapAmountInRoute{{PoolId: pools.osmoCanonicalPoolId, TokenOutDenom: UOSMO}}
+	expectedError := types.TooManyHopsError{NumHops: 2, MaxHops: 1}
+
+	// System under test.
+	_, estimateErr := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, route, tokenIn)
+	_, err := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], route, tokenIn, osmomath.OneInt())
+
+	s.Require().ErrorIs(estimateErr, expectedError)
+	s.Require().ErrorIs(err, expectedError)
+}
@@ -2,10 +2,12 @@ package events
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 func EmitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
@@ -56,3 +58,26 @@ func newRemoveLiquidityEvent(sender sdk.AccAddress, poolId uint64, liquidity sdk
 		sdk.NewAttribute(types.AttributeKeyTokensOut, liquidity.String()),
 	)
 }
+
+// EmitConcentratedSwapExecutionEvent emits the indexes of the initialized ticks crossed by a swap through
+// a concentrated liquidity pool, in the order they were crossed, and the current tick of the pool after the swap.
+func EmitConcentratedSwapExecutionEvent(ctx sdk.Context, poolId uint64, crossedTicks []int64, finalTick int64) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newConcentratedSwapExecutionEvent(poolId, crossedTicks, finalTick),
+	})
+}
+
+func newConcentratedSwapExecutionEvent(poolId uint64, crossedTicks []int64, finalTick int64) sdk.Event {
+	crossedTicksStr := make([]string, len(crossedTicks))
+	for i, tick := range crossedTicks {
+		crossedTicksStr[i] = strconv.FormatInt(tick, 10)
+	}
+
+	return sdk.NewEvent(
+		poolmanagertypes.TypeEvtConcentratedSwap,
+		sdk.NewAttribute(sdk.AttributeKeyModule, poolmanagertypes.AttributeValueCategory),
+		sdk.NewAttribute(poolmanagertypes.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(poolmanagertypes.AttributeKeyCrossedTicks, strings.Join(crossedTicksStr, ",")),
+		sdk.NewAttribute(poolmanagertypes.AttributeKeyFinalTick, strconv.FormatInt(finalTick, 10)),
+	)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/events"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 type PoolManagerEventsTestSuite struct {
@@ -185,3 +186,46 @@ func (suite *PoolManagerEventsTestSuite) TestEmitRemoveLiquidityEvent() {
 		})
 	}
 }
+
+func (suite *PoolManagerEventsTestSuite) TestEmitConcentratedSwapExecutionEvent() {
+	testcases := map[string]struct {
+		ctx                  sdk.Context
+		poolId               uint64
+		crossedTicks         []int64
+		finalTick            int64
+		expectedCrossedTicks string
+	}{
+		"no ticks crossed": {
+			ctx:                  suite.CreateTestContext(),
+			poolId:               1,
+			finalTick:            -10,
+			expectedCrossedTicks: "",
+		},
+		"multiple ticks crossed": {
+			ctx:                  suite.CreateTestContext(),
+			poolId:               200,
+			crossedTicks:         []int64{100, -200, -3000},
+			finalTick:            -3001,
+			expectedCrossedTicks: "100,-200,-3000",
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					poolmanagertypes.TypeEvtConcentratedSwap,
+					sdk.NewAttribute(sdk.AttributeKeyModule, poolmanagertypes.AttributeValueCategory),
+					sdk.NewAttribute(poolmanagertypes.AttributeKeyPoolId, strconv.FormatUint(tc.poolId, 10)),
+					sdk.NewAttribute(poolmanagertypes.AttributeKeyCrossedTicks, tc.expectedCrossedTicks),
+					sdk.NewAttribute(poolmanagertypes.AttributeKeyFinalTick, strconv.FormatInt(tc.finalTick, 10)),
+				),
+			}
+
+			// System under test.
+			events.EmitConcentratedSwapExecutionEvent(tc.ctx, tc.poolId, tc.crossedTicks, tc.finalTick)
+
+			suite.Equal(expectedEvents, tc.ctx.EventManager().Events())
+		})
+	}
+}
@@ -49,6 +49,14 @@ func (k Keeper) TrackVolume(ctx sdk.Context, poolId uint64, volumeGenerated sdk.
 	k.trackVolume(ctx, poolId, volumeGenerated)
 }
 
+func (k Keeper) TrackSwapVolume(ctx sdk.Context, poolType types.PoolType, tokenIn sdk.Coin, tokenOutDenom string) {
+	k.trackSwapVolume(ctx, poolType, tokenIn, tokenOutDenom)
+}
+
 func (k Keeper) ChargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, error) {
-	return k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, exactIn)
+	return k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, exactIn, k.getStakedOsmoTakerFeeDiscount(ctx, sender))
+}
+
+func (k Keeper) ComposeDenomAliasConversions(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) ([]types.SwapAmountInRoute, error) {
+	return k.composeDenomAliasConversions(ctx, route, tokenInDenom)
 }
@@ -0,0 +1,79 @@
+package poolmanager
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// ibcUnwrap sends the given IBC token from the sender to the receiver on the chain the token originates from,
+// returning the sequence of the sent packet. Tokens that went through multiple chains to reach this one are
+// sent back along the same path, relying on the packet forward middleware of the intermediate chains.
+// Returns error if the token is not an IBC token, if its denom trace is unknown, or if the transfer fails.
+func (k Keeper) ibcUnwrap(ctx sdk.Context, sender sdk.AccAddress, token sdk.Coin, receiver string) (uint64, error) {
+	if !strings.HasPrefix(token.Denom, ibctransfertypes.DenomPrefix+"/") {
+		return 0, types.NonIbcDenomUnwrapError{Denom: token.Denom}
+	}
+
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(token.Denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return 0, err
+	}
+
+	denomTrace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return 0, fmt.Errorf("denom trace not found for (%s)", token.Denom)
+	}
+
+	hops, err := types.ParseIbcUnwrapHops(denomTrace.Path)
+	if err != nil {
+		return 0, err
+	}
+
+	// The first hop is sent from this chain, the rest are forwarded by the intermediate chains.
+	memo, err := types.BuildIbcUnwrapMemo(hops[1:], receiver)
+	if err != nil {
+		return 0, err
+	}
+
+	firstHopReceiver := receiver
+	if len(hops) > 1 {
+		firstHopReceiver = types.ForwardIntermediateReceiver
+	}
+
+	transferMsg := ibctransfertypes.NewMsgTransfer(
+		hops[0].Port,
+		hops[0].Channel,
+		token,
+		sender.String(),
+		firstHopReceiver,
+		clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(types.IbcUnwrapTimeout).UnixNano()),
+		memo,
+	)
+	if err := transferMsg.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), transferMsg)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtIbcUnwrap,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, token.String()),
+		sdk.NewAttribute(types.AttributeKeyPacketSequence, strconv.FormatUint(res.Sequence, 10)),
+	))
+
+	return res.Sequence, nil
+}
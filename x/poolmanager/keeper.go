@@ -23,6 +23,8 @@ type Keeper struct {
 	communityPoolKeeper  types.CommunityPoolI
 	stakingKeeper        types.StakingKeeper
 	protorevKeeper       types.ProtorevKeeper
+	transferKeeper       types.TransferKeeper
+	swapCircuitBreaker   types.SwapCircuitBreaker
 
 	// routes is a map to get the pool module by id.
 	routes map[types.PoolType]types.PoolModuleI
@@ -127,6 +129,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, denomPairTakerFee := range genState.DenomPairTakerFeeStore {
 		k.SetDenomPairTakerFee(ctx, denomPairTakerFee.Denom0, denomPairTakerFee.Denom1, denomPairTakerFee.TakerFee)
 	}
+
+	// Set the denom pair and pool type swap volumes KVStore.
+	for _, denomPairVolume := range genState.DenomPairVolumes {
+		k.setDenomPairVolume(ctx, denomPairVolume.Denom0, denomPairVolume.Denom1, denomPairVolume.Volume)
+	}
+	for _, denomPairHourlyVolume := range genState.DenomPairHourlyVolumes {
+		k.setDenomPairHourlyVolume(ctx, denomPairHourlyVolume.Denom0, denomPairHourlyVolume.Denom1, denomPairHourlyVolume.Hour, denomPairHourlyVolume.Volume)
+	}
+	for _, poolTypeVolume := range genState.PoolTypeVolumes {
+		k.setPoolTypeVolume(ctx, poolTypeVolume.PoolType, poolTypeVolume.Volume)
+	}
 }
 
 // ExportGenesis returns the poolmanager module's exported genesis.
@@ -152,6 +165,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	// Export the denom pair and pool type swap volumes from KVStore.
+	denomPairVolumes, err := k.GetAllDenomPairVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	denomPairHourlyVolumes, err := k.GetAllDenomPairHourlyVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	poolTypeVolumes, err := k.GetAllPoolTypeVolumes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	// Export KVStore values to the genesis state so they can be imported in init genesis.
 	takerFeesTracker := types.TakerFeesTracker{
 		TakerFeesToStakers:         k.GetTakerFeeTrackerForStakers(ctx),
@@ -165,6 +192,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		TakerFeesTracker:       &takerFeesTracker,
 		PoolVolumes:            poolVolumes,
 		DenomPairTakerFeeStore: denomPairTakerFees,
+		DenomPairVolumes:       denomPairVolumes,
+		DenomPairHourlyVolumes: denomPairHourlyVolumes,
+		PoolTypeVolumes:        poolTypeVolumes,
 	}
 }
 
@@ -196,3 +226,18 @@ func (k *Keeper) SetStakingKeeper(stakingKeeper types.StakingKeeper) {
 func (k *Keeper) SetProtorevKeeper(protorevKeeper types.ProtorevKeeper) {
 	k.protorevKeeper = protorevKeeper
 }
+
+// SetTransferKeeper sets ibc transfer keeper
+func (k *Keeper) SetTransferKeeper(transferKeeper types.TransferKeeper) {
+	k.transferKeeper = transferKeeper
+}
+
+// SetSwapCircuitBreaker sets the circuit breaker pausing swaps through pools
+func (k *Keeper) SetSwapCircuitBreaker(swapCircuitBreaker types.SwapCircuitBreaker) {
+	k.swapCircuitBreaker = swapCircuitBreaker
+}
+
+// isSwapPaused returns true if swaps through the given pool are paused by the swap circuit breaker.
+func (k Keeper) isSwapPaused(ctx sdk.Context, poolId uint64) bool {
+	return k.swapCircuitBreaker != nil && k.swapCircuitBreaker.IsSwapPaused(ctx, poolId)
+}
@@ -2,6 +2,7 @@ package poolmanager_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -61,6 +62,45 @@ var (
 		},
 	}
 
+	testDenomPairVolumes = []types.DenomPairVolume{
+		{
+			Denom0: "uatom",
+			Denom1: "uosmo",
+			Volume: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(3000)), sdk.NewCoin("uosmo", sdk.NewInt(5000))),
+		},
+		{
+			Denom0: "uion",
+			Denom1: "uosmo",
+			Volume: sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(1000))),
+		},
+	}
+
+	testDenomPairHourlyVolumes = []types.DenomPairHourlyVolume{
+		{
+			Denom0: "uion",
+			Denom1: "uosmo",
+			Hour:   475000,
+			Volume: sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(400))),
+		},
+		{
+			Denom0: "uion",
+			Denom1: "uosmo",
+			Hour:   475001,
+			Volume: sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(600))),
+		},
+	}
+
+	testPoolTypeVolumes = []types.PoolTypeVolume{
+		{
+			PoolType: types.Balancer,
+			Volume:   sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(1000))),
+		},
+		{
+			PoolType: types.Concentrated,
+			Volume:   sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(3000)), sdk.NewCoin("uosmo", sdk.NewInt(5000))),
+		},
+	}
+
 	testDenomPairTakerFees = []types.DenomPairTakerFee{
 		{
 			Denom0:   "uion",
@@ -136,6 +176,9 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		DenomPairVolumes:       testDenomPairVolumes,
+		DenomPairHourlyVolumes: testDenomPairHourlyVolumes,
+		PoolTypeVolumes:        testPoolTypeVolumes,
 	})
 
 	params := s.App.PoolManagerKeeper.GetParams(s.Ctx)
@@ -160,6 +203,15 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	takerFee, err = s.App.PoolManagerKeeper.GetTradingPairTakerFee(s.Ctx, testDenomPairTakerFees[1].Denom0, testDenomPairTakerFees[1].Denom1)
 	s.Require().NoError(err)
 	s.Require().Equal(testDenomPairTakerFees[1].TakerFee, takerFee)
+
+	s.Require().Equal(testDenomPairVolumes[0].Volume, s.App.PoolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, testDenomPairVolumes[0].Denom0, testDenomPairVolumes[0].Denom1))
+	s.Require().Equal(testDenomPairVolumes[1].Volume, s.App.PoolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, testDenomPairVolumes[1].Denom0, testDenomPairVolumes[1].Denom1))
+	s.Require().Equal(testPoolTypeVolumes[0].Volume, s.App.PoolManagerKeeper.GetTotalVolumeForPoolType(s.Ctx, testPoolTypeVolumes[0].PoolType))
+	s.Require().Equal(testPoolTypeVolumes[1].Volume, s.App.PoolManagerKeeper.GetTotalVolumeForPoolType(s.Ctx, testPoolTypeVolumes[1].PoolType))
+
+	// Both hourly volumes are within the rolling window of the last hour.
+	s.Ctx = s.Ctx.WithBlockTime(time.Unix(int64(testDenomPairHourlyVolumes[1].Hour)*3600, 0))
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin("uion", sdk.NewInt(1000))), s.App.PoolManagerKeeper.GetRollingVolumeForDenomPair(s.Ctx, "uion", "uosmo"))
 }
 
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -184,6 +236,9 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		TakerFeesTracker:       &testTakerFeesTracker,
 		PoolVolumes:            testPoolVolumes,
 		DenomPairTakerFeeStore: testDenomPairTakerFees,
+		DenomPairVolumes:       testDenomPairVolumes,
+		DenomPairHourlyVolumes: testDenomPairHourlyVolumes,
+		PoolTypeVolumes:        testPoolTypeVolumes,
 	})
 
 	genesis := s.App.PoolManagerKeeper.ExportGenesis(s.Ctx)
@@ -202,4 +257,7 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 	s.Require().Equal(testPoolVolumes[0].PoolVolume, genesis.PoolVolumes[0].PoolVolume)
 	s.Require().Equal(testPoolVolumes[1].PoolVolume, genesis.PoolVolumes[1].PoolVolume)
 	s.Require().Equal(testDenomPairTakerFees, genesis.DenomPairTakerFeeStore)
+	s.Require().Equal(testDenomPairVolumes, genesis.DenomPairVolumes)
+	s.Require().Equal(testDenomPairHourlyVolumes, genesis.DenomPairHourlyVolumes)
+	s.Require().Equal(testPoolTypeVolumes, genesis.PoolTypeVolumes)
 }
@@ -2,9 +2,13 @@ package poolmanager
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
@@ -27,11 +31,21 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	tokenOutAmount, clSwapExecutions, err := routeWithConcentratedSwapExecutions(ctx, func(ctx sdk.Context) (osmomath.Int, error) {
+		return server.keeper.RouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenIn, msg.TokenOutMinAmount)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	var ibcUnwrapSequence uint64
+	if msg.IbcUnwrapReceiver != "" {
+		ibcUnwrapSequence, err = server.keeper.ibcUnwrap(ctx, sender, sdk.NewCoin(msg.TokenOutDenom(), tokenOutAmount), msg.IbcUnwrapReceiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Swap event is handled elsewhere
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -41,7 +55,7 @@ func (server msgServer) SwapExactAmountIn(goCtx context.Context, msg *types.MsgS
 		),
 	})
 
-	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+	return &types.MsgSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount, IbcUnwrapSequence: ibcUnwrapSequence, ConcentratedSwapExecutions: clSwapExecutions}, nil
 }
 
 // TODO: spec and tests, including events
@@ -53,7 +67,9 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	tokenInAmount, clSwapExecutions, err := routeWithConcentratedSwapExecutions(ctx, func(ctx sdk.Context) (osmomath.Int, error) {
+		return server.keeper.RouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenInMaxAmount, msg.TokenOut)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +83,7 @@ func (server msgServer) SwapExactAmountOut(goCtx context.Context, msg *types.Msg
 		),
 	})
 
-	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
+	return &types.MsgSwapExactAmountOutResponse{TokenInAmount: tokenInAmount, ConcentratedSwapExecutions: clSwapExecutions}, nil
 }
 
 func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountIn) (*types.MsgSplitRouteSwapExactAmountInResponse, error) {
@@ -78,11 +94,21 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 		return nil, err
 	}
 
-	tokenOutAmount, err := server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	tokenOutAmount, clSwapExecutions, err := routeWithConcentratedSwapExecutions(ctx, func(ctx sdk.Context) (osmomath.Int, error) {
+		return server.keeper.SplitRouteExactAmountIn(ctx, sender, msg.Routes, msg.TokenInDenom, msg.TokenOutMinAmount)
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	var ibcUnwrapSequence uint64
+	if msg.IbcUnwrapReceiver != "" {
+		ibcUnwrapSequence, err = server.keeper.ibcUnwrap(ctx, sender, sdk.NewCoin(msg.TokenOutDenom(), tokenOutAmount), msg.IbcUnwrapReceiver)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Swap event is handled in each pool module's SwapExactAmountIn
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -92,7 +118,7 @@ func (server msgServer) SplitRouteSwapExactAmountIn(goCtx context.Context, msg *
 		),
 	})
 
-	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount}, nil
+	return &types.MsgSplitRouteSwapExactAmountInResponse{TokenOutAmount: tokenOutAmount, IbcUnwrapSequence: ibcUnwrapSequence, ConcentratedSwapExecutions: clSwapExecutions}, nil
 }
 
 func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg *types.MsgSplitRouteSwapExactAmountOut) (*types.MsgSplitRouteSwapExactAmountOutResponse, error) {
@@ -103,7 +129,9 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 		return nil, err
 	}
 
-	tokenInAmount, err := server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	tokenInAmount, clSwapExecutions, err := routeWithConcentratedSwapExecutions(ctx, func(ctx sdk.Context) (osmomath.Int, error) {
+		return server.keeper.SplitRouteExactAmountOut(ctx, sender, msg.Routes, msg.TokenOutDenom, msg.TokenInMaxAmount)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +145,7 @@ func (server msgServer) SplitRouteSwapExactAmountOut(goCtx context.Context, msg
 		),
 	})
 
-	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount}, nil
+	return &types.MsgSplitRouteSwapExactAmountOutResponse{TokenInAmount: tokenInAmount, ConcentratedSwapExecutions: clSwapExecutions}, nil
 }
 
 func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.MsgSetDenomPairTakerFee) (*types.MsgSetDenomPairTakerFeeResponse, error) {
@@ -141,3 +169,63 @@ func (server msgServer) SetDenomPairTakerFee(goCtx context.Context, msg *types.M
 
 	return &types.MsgSetDenomPairTakerFeeResponse{Success: true}, nil
 }
+
+// routeWithConcentratedSwapExecutions runs the given route under a fresh event manager and collects the executions
+// of its swaps through concentrated liquidity pools from the emitted events. The events of the route are then
+// emitted to the event manager of ctx, so the events of the message are unchanged.
+func routeWithConcentratedSwapExecutions(ctx sdk.Context, route func(ctx sdk.Context) (osmomath.Int, error)) (osmomath.Int, []types.ConcentratedSwapExecution, error) {
+	routeCtx := ctx.WithEventManager(sdk.NewEventManager())
+	amount, err := route(routeCtx)
+	if err != nil {
+		return osmomath.Int{}, nil, err
+	}
+
+	routeEvents := routeCtx.EventManager().Events()
+	ctx.EventManager().EmitEvents(routeEvents)
+
+	executions, err := parseConcentratedSwapExecutions(routeEvents)
+	if err != nil {
+		return osmomath.Int{}, nil, err
+	}
+	return amount, executions, nil
+}
+
+// parseConcentratedSwapExecutions returns the concentrated liquidity swap executions of the given events, in the order they were emitted.
+func parseConcentratedSwapExecutions(events sdk.Events) ([]types.ConcentratedSwapExecution, error) {
+	var executions []types.ConcentratedSwapExecution
+	for _, event := range events {
+		if event.Type != types.TypeEvtConcentratedSwap {
+			continue
+		}
+
+		var (
+			execution types.ConcentratedSwapExecution
+			err       error
+		)
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case types.AttributeKeyPoolId:
+				execution.PoolId, err = strconv.ParseUint(attr.Value, 10, 64)
+			case types.AttributeKeyFinalTick:
+				execution.FinalTick, err = strconv.ParseInt(attr.Value, 10, 64)
+			case types.AttributeKeyCrossedTicks:
+				if attr.Value == "" {
+					continue
+				}
+				for _, tickStr := range strings.Split(attr.Value, ",") {
+					tick, parseErr := strconv.ParseInt(tickStr, 10, 64)
+					if parseErr != nil {
+						err = parseErr
+						break
+					}
+					execution.CrossedTicks = append(execution.CrossedTicks, tick)
+				}
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s attribute %s: %w", event.Type, attr.Key, err)
+			}
+		}
+		executions = append(executions, execution)
+	}
+	return executions, nil
+}
@@ -2,8 +2,12 @@ package poolmanager_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
 	poolmanagerKeeper "github.com/osmosis-labs/osmosis/v21/x/poolmanager"
 	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
@@ -286,3 +290,94 @@ func (s *KeeperTestSuite) TestSetDenomPairTakerFee() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn_IbcUnwrap() {
+	denomTrace := ibctransfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	ibcDenom := denomTrace.IBCDenom()
+
+	testcases := map[string]struct {
+		tokenOutDenom  string
+		setDenomTrace  bool
+		expectedErr    error
+		expectedErrMsg string
+	}{
+		"error: output is not an ibc token": {
+			tokenOutDenom: "uosmo",
+			expectedErr:   types.NonIbcDenomUnwrapError{Denom: "uosmo"},
+		},
+		"error: denom trace not found": {
+			tokenOutDenom:  ibcDenom,
+			expectedErrMsg: "denom trace not found",
+		},
+		"error: origin channel does not exist": {
+			tokenOutDenom: ibcDenom,
+			setDenomTrace: true,
+			expectedErr:   channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for name, tc := range testcases {
+		s.Run(name, func() {
+			s.Setup()
+			msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+			poolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin("uosmo", osmomath.NewInt(1_000_000)), sdk.NewCoin(ibcDenom, osmomath.NewInt(1_000_000)))
+			if tc.setDenomTrace {
+				s.App.TransferKeeper.SetDenomTrace(s.Ctx, denomTrace)
+			}
+
+			tokenIn := sdk.NewCoin(ibcDenom, amount)
+			if tc.tokenOutDenom == ibcDenom {
+				tokenIn = sdk.NewCoin("uosmo", amount)
+			}
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+			_, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), &types.MsgSwapExactAmountIn{
+				Sender:            s.TestAccs[0].String(),
+				Routes:            []types.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: tc.tokenOutDenom}},
+				TokenIn:           tokenIn,
+				TokenOutMinAmount: osmomath.OneInt(),
+				IbcUnwrapReceiver: "cosmos1receiver",
+			})
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+			} else {
+				s.Require().ErrorContains(err, tc.expectedErrMsg)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn_ConcentratedSwapExecutions() {
+	s.Setup()
+	msgServer := poolmanagerKeeper.NewMsgServerImpl(s.App.PoolManagerKeeper)
+
+	spacing := int64(apptesting.DefaultTickSpacing)
+	clPool, tokenIn, _ := s.PrepareConcentratedPoolWithInitializedTicks(apptesting.DefaultTickSpacing, 2)
+	balancerPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewCoin(apptesting.USDC, osmomath.NewInt(1_000_000_000)), sdk.NewCoin("uosmo", osmomath.NewInt(1_000_000_000)))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	response, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(ctx), &types.MsgSwapExactAmountIn{
+		Sender:            s.TestAccs[0].String(),
+		Routes:            []types.SwapAmountInRoute{{PoolId: clPool.GetId(), TokenOutDenom: apptesting.USDC}, {PoolId: balancerPoolId, TokenOutDenom: "uosmo"}},
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: osmomath.OneInt(),
+	})
+	s.Require().NoError(err)
+
+	// Only the swap through the concentrated liquidity pool is reported.
+	s.Require().Len(response.ConcentratedSwapExecutions, 1)
+	execution := response.ConcentratedSwapExecutions[0]
+	s.Require().Equal(clPool.GetId(), execution.PoolId)
+	s.Require().GreaterOrEqual(len(execution.CrossedTicks), 2)
+	s.Require().Equal([]int64{-spacing, -2 * spacing}, execution.CrossedTicks[:2])
+
+	clPoolAfter, err := s.App.ConcentratedLiquidityKeeper.GetConcentratedPoolById(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(clPoolAfter.GetCurrentTick(), execution.FinalTick)
+
+	// The events of the route are still emitted.
+	s.AssertEventEmitted(ctx, types.TypeEvtConcentratedSwap, 1)
+	s.AssertEventEmitted(ctx, gammtypes.TypeEvtTokenSwapped, 2)
+}
@@ -0,0 +1,69 @@
+package poolmanager
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// validateRouteHops returns types.TooManyHopsError if a route going through the given number of pools
+// exceeds the max hops param. No limit is enforced if the param is zero or not set.
+// Only the max hops param is read to avoid charging the gas of reading all params on every route.
+func (k Keeper) validateRouteHops(ctx sdk.Context, numHops int) error {
+	var maxHops uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxHops, &maxHops)
+	if maxHops > 0 && uint64(numHops) > maxHops {
+		return types.TooManyHopsError{NumHops: numHops, MaxHops: maxHops}
+	}
+	return nil
+}
+
+// validateSplitRouteCount returns types.TooManyRoutesError if a split route swap across the given number of routes
+// exceeds the max routes per tx param. No limit is enforced if the param is zero or not set.
+func (k Keeper) validateSplitRouteCount(ctx sdk.Context, numRoutes int) error {
+	var maxRoutesPerTx uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxRoutesPerTx, &maxRoutesPerTx)
+	if maxRoutesPerTx > 0 && uint64(numRoutes) > maxRoutesPerTx {
+		return types.TooManyRoutesError{NumRoutes: numRoutes, MaxRoutesPerTx: maxRoutesPerTx}
+	}
+	return nil
+}
+
+// validateRouteInDenomPairs returns types.DenomPairPausedError if any pool of the given swap exact amount in route,
+// starting from the given token in denom, swaps between a paused denom pair.
+func (k Keeper) validateRouteInDenomPairs(ctx sdk.Context, route []types.SwapAmountInRoute, tokenInDenom string) error {
+	routeDenoms := make([]string, 0, len(route)+1)
+	routeDenoms = append(routeDenoms, tokenInDenom)
+	for _, routeStep := range route {
+		routeDenoms = append(routeDenoms, routeStep.TokenOutDenom)
+	}
+	return k.validateRouteDenomPairs(ctx, routeDenoms)
+}
+
+// validateRouteOutDenomPairs returns types.DenomPairPausedError if any pool of the given swap exact amount out route,
+// ending at the given token out denom, swaps between a paused denom pair.
+func (k Keeper) validateRouteOutDenomPairs(ctx sdk.Context, route []types.SwapAmountOutRoute, tokenOutDenom string) error {
+	routeDenoms := make([]string, 0, len(route)+1)
+	for _, routeStep := range route {
+		routeDenoms = append(routeDenoms, routeStep.TokenInDenom)
+	}
+	routeDenoms = append(routeDenoms, tokenOutDenom)
+	return k.validateRouteDenomPairs(ctx, routeDenoms)
+}
+
+// validateRouteDenomPairs returns types.DenomPairPausedError if any two consecutive denoms of a route, i.e. the denoms
+// a pool of the route swaps between, are a paused denom pair, in either order.
+// Only the paused denom pairs param is read to avoid charging the gas of reading all params on every route.
+func (k Keeper) validateRouteDenomPairs(ctx sdk.Context, routeDenoms []string) error {
+	var pausedPairs []types.PausedDenomPair
+	k.paramSpace.GetIfExists(ctx, types.KeyPausedDenomPairs, &pausedPairs)
+	for _, pair := range pausedPairs {
+		for i := 1; i < len(routeDenoms); i++ {
+			denomIn, denomOut := routeDenoms[i-1], routeDenoms[i]
+			if (denomIn == pair.Denom0 && denomOut == pair.Denom1) || (denomIn == pair.Denom1 && denomOut == pair.Denom0) {
+				return types.DenomPairPausedError{Denom0: pair.Denom0, Denom1: pair.Denom1}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package poolmanager_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// setupRouteLimitPools creates the FOO/BAR, BAR/BAZ and BAZ/UOSMO pools and funds the sender with FOO and UOSMO.
+// It returns the three hop route from FOO to UOSMO through the pools.
+func (s *KeeperTestSuite) setupRouteLimitPools() ([]types.SwapAmountInRoute, []types.SwapAmountOutRoute) {
+	coin := func(denom string) sdk.Coin { return sdk.NewInt64Coin(denom, 1_000_000_000) }
+	fooBarPoolId := s.PrepareBalancerPoolWithCoins(coin(FOO), coin(BAR))
+	barBazPoolId := s.PrepareBalancerPoolWithCoins(coin(BAR), coin(BAZ))
+	bazOsmoPoolId := s.PrepareBalancerPoolWithCoins(coin(BAZ), coin(UOSMO))
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(coin(FOO), coin(UOSMO)))
+
+	routeIn := []types.SwapAmountInRoute{
+		{PoolId: fooBarPoolId, TokenOutDenom: BAR},
+		{PoolId: barBazPoolId, TokenOutDenom: BAZ},
+		{PoolId: bazOsmoPoolId, TokenOutDenom: UOSMO},
+	}
+	routeOut := []types.SwapAmountOutRoute{
+		{PoolId: fooBarPoolId, TokenInDenom: FOO},
+		{PoolId: barBazPoolId, TokenInDenom: BAR},
+		{PoolId: bazOsmoPoolId, TokenInDenom: BAZ},
+	}
+	return routeIn, routeOut
+}
+
+// validates that swap and estimate routes going through more pools than the max hops param fail.
+func (s *KeeperTestSuite) TestRouteExceedingMaxHops() {
+	tests := map[string]struct {
+		maxHops       uint64
+		expectedError error
+	}{
+		"route within max hops": {
+			maxHops: 3,
+		},
+		"route exceeds max hops": {
+			maxHops:       2,
+			expectedError: types.TooManyHopsError{NumHops: 3, MaxHops: 2},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			routeIn, routeOut := s.setupRouteLimitPools()
+			s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyMaxHops, tc.maxHops)
+			tokenIn, tokenOut := sdk.NewInt64Coin(FOO, 1_000), sdk.NewInt64Coin(UOSMO, 1_000)
+
+			_, errEstimateIn := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routeIn, tokenIn)
+			_, errEstimateOut := s.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, routeOut, tokenOut)
+			_, errIn := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], routeIn, tokenIn, osmomath.OneInt())
+			_, errOut := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], routeOut, osmomath.NewInt(1_000_000), tokenOut)
+
+			for _, err := range []error{errEstimateIn, errEstimateOut, errIn, errOut} {
+				if tc.expectedError != nil {
+					s.Require().ErrorIs(err, tc.expectedError)
+				} else {
+					s.Require().NoError(err)
+				}
+			}
+		})
+	}
+}
+
+// validates that split route swaps across more routes than the max routes per tx param fail.
+func (s *KeeperTestSuite) TestSplitRouteExceedingMaxRoutesPerTx() {
+	tests := map[string]struct {
+		maxRoutesPerTx uint64
+		expectedError  error
+	}{
+		"split route within max routes per tx": {
+			maxRoutesPerTx: 2,
+		},
+		"split route exceeds max routes per tx": {
+			maxRoutesPerTx: 1,
+			expectedError:  types.TooManyRoutesError{NumRoutes: 2, MaxRoutesPerTx: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			routeIn, routeOut := s.setupRouteLimitPools()
+			fooOsmoPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(FOO, 1_000_000_000), sdk.NewInt64Coin(UOSMO, 1_000_000_000))
+			s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyMaxRoutesPerTx, tc.maxRoutesPerTx)
+
+			splitRoutesIn := []types.SwapAmountInSplitRoute{
+				{Pools: routeIn, TokenInAmount: osmomath.NewInt(1_000)},
+				{Pools: []types.SwapAmountInRoute{{PoolId: fooOsmoPoolId, TokenOutDenom: UOSMO}}, TokenInAmount: osmomath.NewInt(1_000)},
+			}
+			splitRoutesOut := []types.SwapAmountOutSplitRoute{
+				{Pools: routeOut, TokenOutAmount: osmomath.NewInt(1_000)},
+				{Pools: []types.SwapAmountOutRoute{{PoolId: fooOsmoPoolId, TokenInDenom: FOO}}, TokenOutAmount: osmomath.NewInt(1_000)},
+			}
+
+			_, errIn := s.App.PoolManagerKeeper.SplitRouteExactAmountIn(s.Ctx, s.TestAccs[0], splitRoutesIn, FOO, osmomath.OneInt())
+			_, errOut := s.App.PoolManagerKeeper.SplitRouteExactAmountOut(s.Ctx, s.TestAccs[0], splitRoutesOut, UOSMO, osmomath.NewInt(1_000_000))
+
+			for _, err := range []error{errIn, errOut} {
+				if tc.expectedError != nil {
+					s.Require().ErrorIs(err, tc.expectedError)
+				} else {
+					s.Require().NoError(err)
+				}
+			}
+		})
+	}
+}
+
+// validates that swap and estimate routes swapping between a paused denom pair fail, while liquidity
+// can still be withdrawn from the pools holding the pair.
+func (s *KeeperTestSuite) TestRouteThroughPausedDenomPair() {
+	tests := map[string]struct {
+		pausedDenomPairs []types.PausedDenomPair
+		expectedError    error
+	}{
+		"no paused denom pairs": {},
+		"paused denom pair not swapped by any pool of the route": {
+			pausedDenomPairs: []types.PausedDenomPair{{Denom0: FOO, Denom1: UOSMO}},
+		},
+		"route swaps between a paused denom pair": {
+			pausedDenomPairs: []types.PausedDenomPair{{Denom0: BAR, Denom1: BAZ}},
+			expectedError:    types.DenomPairPausedError{Denom0: BAR, Denom1: BAZ},
+		},
+		"route swaps between a paused denom pair in reverse order": {
+			pausedDenomPairs: []types.PausedDenomPair{{Denom0: FOO, Denom1: UOSMO}, {Denom0: UOSMO, Denom1: BAZ}},
+			expectedError:    types.DenomPairPausedError{Denom0: UOSMO, Denom1: BAZ},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			routeIn, routeOut := s.setupRouteLimitPools()
+			s.App.PoolManagerKeeper.SetParam(s.Ctx, types.KeyPausedDenomPairs, tc.pausedDenomPairs)
+			tokenIn, tokenOut := sdk.NewInt64Coin(FOO, 1_000), sdk.NewInt64Coin(UOSMO, 1_000)
+
+			_, errEstimateIn := s.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, routeIn, tokenIn)
+			_, errEstimateOut := s.App.PoolManagerKeeper.MultihopEstimateInGivenExactAmountOut(s.Ctx, routeOut, tokenOut)
+			_, errIn := s.App.PoolManagerKeeper.RouteExactAmountIn(s.Ctx, s.TestAccs[0], routeIn, tokenIn, osmomath.OneInt())
+			_, errOut := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0], routeOut, osmomath.NewInt(1_000_000), tokenOut)
+
+			for _, err := range []error{errEstimateIn, errEstimateOut, errIn, errOut} {
+				if tc.expectedError != nil {
+					s.Require().ErrorIs(err, tc.expectedError)
+				} else {
+					s.Require().NoError(err)
+				}
+			}
+
+			// The pools remain open to withdrawals.
+			for _, routeStep := range routeIn {
+				_, err := s.App.GAMMKeeper.ExitPool(s.Ctx, s.TestAccs[0], routeStep.PoolId, gammtypes.InitPoolSharesSupply.QuoRaw(2), sdk.Coins{})
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -31,6 +31,8 @@ var (
 // corresponding to poolID's pool type. It takes in the input denom and amount for
 // the initial swap against the first pool and chains the output as the input for the
 // next routed pool until the last pool is reached.
+// Where a pool does not hold the denom swapped through it but holds an alias of it,
+// the conversion through the converter pool of the alias is composed into the route.
 // Transaction succeeds if final amount out is greater than tokenOutMinAmount defined
 // and no errors are encountered along the way.
 func (k Keeper) RouteExactAmountIn(
@@ -40,10 +42,25 @@ func (k Keeper) RouteExactAmountIn(
 	tokenIn sdk.Coin,
 	tokenOutMinAmount osmomath.Int,
 ) (tokenOutAmount osmomath.Int, err error) {
-	// Ensure that provided route is not empty and has valid denom format.
-	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+	// Ensure that provided route is not empty, has valid denom format and does not revisit a denom.
+	if err := types.SwapAmountInRoutes(route).ValidateWithTokenIn(tokenIn.Denom); err != nil {
 		return osmomath.Int{}, err
 	}
+	if err := k.validateRouteHops(ctx, len(route)); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// Convert between interchangeable denoms where a pool does not hold the denom swapped through it.
+	route, err = k.composeDenomAliasConversions(ctx, route, tokenIn.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteInDenomPairs(ctx, route, tokenIn.Denom); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	// The sender's taker fee discount is determined once for the whole route.
+	takerFeeDiscount := k.getStakedOsmoTakerFeeDiscount(ctx, sender)
 
 	// Iterate through the route and execute a series of swaps through each pool.
 	for i, routeStep := range route {
@@ -54,7 +71,7 @@ func (k Keeper) RouteExactAmountIn(
 			_outMinAmount = tokenOutMinAmount
 		}
 
-		tokenOutAmount, err = k.SwapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount)
+		tokenOutAmount, err = k.swapExactAmountIn(ctx, sender, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, _outMinAmount, takerFeeDiscount)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
@@ -75,7 +92,10 @@ func (k Keeper) RouteExactAmountIn(
 //
 // Returns error if:
 //   - route are empty
+//   - route are more than the max routes per tx
 //   - route contain duplicate multihop paths
+//   - any multihop path revisits a denom
+//   - any multihop path goes through more pools than the max hops
 //   - last token out denom is not the same for all multihop paths in routeStep
 //   - one of the multihop swaps fails for internal reasons
 //   - final token out computed is not positive
@@ -87,7 +107,10 @@ func (k Keeper) SplitRouteExactAmountIn(
 	tokenInDenom string,
 	tokenOutMinAmount osmomath.Int,
 ) (osmomath.Int, error) {
-	if err := types.ValidateSwapAmountInSplitRoute(routes); err != nil {
+	if err := types.ValidateSwapAmountInSplitRoute(routes, tokenInDenom); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateSplitRouteCount(ctx, len(routes)); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -145,6 +168,20 @@ func (k Keeper) SwapExactAmountIn(
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	tokenOutMinAmount osmomath.Int,
+) (tokenOutAmount osmomath.Int, err error) {
+	return k.swapExactAmountIn(ctx, sender, poolId, tokenIn, tokenOutDenom, tokenOutMinAmount, k.getStakedOsmoTakerFeeDiscount(ctx, sender))
+}
+
+// swapExactAmountIn is like SwapExactAmountIn, except that the taker fee is discounted
+// by the given discount, so that it can be determined once for a whole route.
+func (k Keeper) swapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount osmomath.Int,
+	takerFeeDiscount osmomath.Dec,
 ) (tokenOutAmount osmomath.Int, err error) {
 	// Get the pool-specific module implementation to ensure that
 	// swaps are routed to the pool type corresponding to pool ID's pool.
@@ -165,7 +202,12 @@ func (k Keeper) SwapExactAmountIn(
 		return osmomath.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
 
-	tokenInAfterSubTakerFee, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true)
+	// Check if swaps through the pool are paused by the circuit breaker.
+	if k.isSwapPaused(ctx, pool.GetId()) {
+		return osmomath.Int{}, types.SwapsPausedError{PoolId: pool.GetId()}
+	}
+
+	tokenInAfterSubTakerFee, err := k.chargeTakerFee(ctx, tokenIn, tokenOutDenom, sender, true, takerFeeDiscount)
 	if err != nil {
 		return osmomath.Int{}, err
 	}
@@ -178,6 +220,7 @@ func (k Keeper) SwapExactAmountIn(
 
 	// Track volume for volume-splitting incentives
 	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackSwapVolume(ctx, pool.GetType(), tokenIn, tokenOutDenom)
 
 	return tokenOutAmount, nil
 }
@@ -214,6 +257,11 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 		return osmomath.Int{}, fmt.Errorf("pool %d is not active", pool.GetId())
 	}
 
+	// Check if swaps through the pool are paused by the circuit breaker.
+	if k.isSwapPaused(ctx, pool.GetId()) {
+		return osmomath.Int{}, types.SwapsPausedError{PoolId: pool.GetId()}
+	}
+
 	// routeStep to the pool-specific SwapExactAmountIn implementation.
 	tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, pool.GetSpreadFactor(ctx))
 	if err != nil {
@@ -222,10 +270,14 @@ func (k Keeper) SwapExactAmountInNoTakerFee(
 
 	// Track volume for volume-splitting incentives
 	k.trackVolume(ctx, pool.GetId(), tokenIn)
+	k.trackSwapVolume(ctx, pool.GetType(), tokenIn, tokenOutDenom)
 
 	return tokenOutAmount, nil
 }
 
+// MultihopEstimateOutGivenExactAmountIn estimates the amount out of a swap of tokenIn along the given route.
+// The estimate does not know the sender, so it uses the undiscounted taker fee and underestimates the
+// amount out of senders that qualify for a staked OSMO taker fee discount.
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	ctx sdk.Context,
 	route []types.SwapAmountInRoute,
@@ -239,7 +291,18 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 		}
 	}()
 
-	if err := types.SwapAmountInRoutes(route).Validate(); err != nil {
+	if err := types.SwapAmountInRoutes(route).ValidateWithTokenIn(tokenIn.Denom); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteHops(ctx, len(route)); err != nil {
+		return osmomath.Int{}, err
+	}
+
+	route, err = k.composeDenomAliasConversions(ctx, route, tokenIn.Denom)
+	if err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteInDenomPairs(ctx, route, tokenIn.Denom); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -293,8 +356,14 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	tokenOut sdk.Coin,
 ) (tokenInAmount osmomath.Int, err error) {
 	isMultiHopRouted, routeSpreadFactor, sumOfSpreadFactors := false, osmomath.Dec{}, osmomath.Dec{}
-	// Ensure that provided route is not empty and has valid denom format.
-	if err := types.SwapAmountOutRoutes(route).Validate(); err != nil {
+	// Ensure that provided route is not empty, has valid denom format and does not revisit a denom.
+	if err := types.SwapAmountOutRoutes(route).ValidateWithTokenOut(tokenOut.Denom); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteHops(ctx, len(route)); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteOutDenomPairs(ctx, route, tokenOut.Denom); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -316,6 +385,9 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	}
 	insExpected[0] = tokenInMaxAmount
 
+	// The sender's taker fee discount is determined once for the whole route.
+	takerFeeDiscount := k.getStakedOsmoTakerFeeDiscount(ctx, sender)
+
 	// Iterates through each routed pool and executes their respective swaps. Note that all of the work to get the return
 	// value of this method is done when we calculate insExpected – this for loop primarily serves to execute the actual
 	// swaps on each pool.
@@ -345,6 +417,11 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 			return osmomath.Int{}, types.InactivePoolError{PoolId: pool.GetId()}
 		}
 
+		// check if swaps through the pool are paused, if so error
+		if k.isSwapPaused(ctx, pool.GetId()) {
+			return osmomath.Int{}, types.SwapsPausedError{PoolId: pool.GetId()}
+		}
+
 		spreadFactor := pool.GetSpreadFactor(ctx)
 		// If we determined the routeStep is an osmo multi-hop and both route are incentivized,
 		// we modify the swap fee accordingly.
@@ -358,13 +435,14 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 		}
 
 		tokenIn := sdk.NewCoin(routeStep.TokenInDenom, curTokenInAmount)
-		tokenInAfterAddTakerFee, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false)
+		tokenInAfterAddTakerFee, err := k.chargeTakerFee(ctx, tokenIn, _tokenOut.Denom, sender, false, takerFeeDiscount)
 		if err != nil {
 			return osmomath.Int{}, err
 		}
 
 		// Track volume for volume-splitting incentives
 		k.trackVolume(ctx, pool.GetId(), sdk.NewCoin(routeStep.TokenInDenom, tokenIn.Amount))
+		k.trackSwapVolume(ctx, pool.GetType(), tokenIn, _tokenOut.Denom)
 
 		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
 		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
@@ -387,7 +465,10 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 //
 // Returns error if:
 //   - route are empty
+//   - route are more than the max routes per tx
 //   - route contain duplicate multihop paths
+//   - any multihop path revisits a denom
+//   - any multihop path goes through more pools than the max hops
 //   - last token out denom is not the same for all multihop paths in routeStep
 //   - one of the multihop swaps fails for internal reasons
 //   - final token out computed is not positive
@@ -399,7 +480,10 @@ func (k Keeper) SplitRouteExactAmountOut(
 	tokenOutDenom string,
 	tokenInMaxAmount osmomath.Int,
 ) (osmomath.Int, error) {
-	if err := types.ValidateSwapAmountOutSplitRoute(route); err != nil {
+	if err := types.ValidateSwapAmountOutSplitRoute(route, tokenOutDenom); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateSplitRouteCount(ctx, len(route)); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -482,6 +566,25 @@ func (k Keeper) RouteCalculateSpotPrice(
 	return price, nil
 }
 
+// RouteCalculateSpotPriceForDenomPair returns the spot price of the base asset in terms of the quote asset,
+// using the most liquid pool between the two denoms as tracked by the protorev module.
+// Returns error if the quote asset is not a protorev base denom or no pool is tracked for the pair.
+func (k Keeper) RouteCalculateSpotPriceForDenomPair(
+	ctx sdk.Context,
+	quoteAssetDenom string,
+	baseAssetDenom string,
+) (osmomath.BigDec, error) {
+	poolId, err := k.protorevKeeper.GetPoolForDenomPair(ctx, quoteAssetDenom, baseAssetDenom)
+	if err != nil {
+		return osmomath.BigDec{}, err
+	}
+
+	return k.RouteCalculateSpotPrice(ctx, poolId, quoteAssetDenom, baseAssetDenom)
+}
+
+// MultihopEstimateInGivenExactAmountOut estimates the amount in of a swap along the given route for tokenOut.
+// The estimate does not know the sender, so it uses the undiscounted taker fee and overestimates the
+// amount in of senders that qualify for a staked OSMO taker fee discount.
 func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	route []types.SwapAmountOutRoute,
@@ -498,7 +601,13 @@ func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	}()
 
 	routeStep := types.SwapAmountOutRoutes(route)
-	if err := routeStep.Validate(); err != nil {
+	if err := routeStep.ValidateWithTokenOut(tokenOut.Denom); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteHops(ctx, len(route)); err != nil {
+		return osmomath.Int{}, err
+	}
+	if err := k.validateRouteOutDenomPairs(ctx, route, tokenOut.Denom); err != nil {
 		return osmomath.Int{}, err
 	}
 
@@ -431,6 +431,30 @@ func (s *KeeperTestSuite) TestRouteCalculateSpotPrice() {
 	}
 }
 
+func (s *KeeperTestSuite) TestRouteCalculateSpotPriceForDenomPair() {
+	s.SetupTest()
+
+	// 100 foo corresponds to 1000 osmo (spot price = 10)
+	osmoPairedPoolId := s.CreatePoolFromTypeWithCoins(types.Balancer, sdk.NewCoins(
+		sdk.NewCoin(FOO, osmomath.NewInt(100)),
+		sdk.NewCoin(UOSMO, osmomath.NewInt(1000)),
+	))
+
+	// no pool tracked by protorev for the pair.
+	_, err := s.App.PoolManagerKeeper.RouteCalculateSpotPriceForDenomPair(s.Ctx, UOSMO, FOO)
+	s.Require().Error(err)
+
+	s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, UOSMO, FOO, osmoPairedPoolId)
+
+	spotPrice, err := s.App.PoolManagerKeeper.RouteCalculateSpotPriceForDenomPair(s.Ctx, UOSMO, FOO)
+	s.Require().NoError(err)
+	s.Require().Equal(osmomath.NewBigDec(10), spotPrice)
+
+	// the quote asset must be the protorev base denom of the pair.
+	_, err = s.App.PoolManagerKeeper.RouteCalculateSpotPriceForDenomPair(s.Ctx, FOO, UOSMO)
+	s.Require().Error(err)
+}
+
 // TestMultihopSwapExactAmountIn tests that the swaps are routed correctly.
 // That is:
 // - to the correct module (concentrated-liquidity or gamm)
@@ -3813,6 +3837,10 @@ func (s *KeeperTestSuite) testSwapExactAmpountInVolumeTracked(noTakerFeeVariant
 	// Validate that volume was updated
 	totalVolume = s.App.PoolManagerKeeper.GetTotalVolumeForPool(s.Ctx, concentratedPool.GetId())
 	s.Require().Equal(tokenIn.String(), totalVolume.String())
+
+	// Validate that the denom pair and pool type volumes were updated
+	s.Require().Equal(tokenIn.String(), s.App.PoolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, FOO, UOSMO).String())
+	s.Require().Equal(tokenIn.String(), s.App.PoolManagerKeeper.GetTotalVolumeForPoolType(s.Ctx, types.Concentrated).String())
 }
 
 func (suite *KeeperTestSuite) TestListPoolsByDenom() {
@@ -0,0 +1,184 @@
+package poolmanager
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// trackSwapVolume adds the given token in to the total and hourly swap volume of the denom pair it was swapped for,
+// as well as to the total swap volume of the given pool type. It is called on every route hop.
+// Hourly volumes that fall out of the rolling window of the denom pair are pruned.
+func (k Keeper) trackSwapVolume(ctx sdk.Context, poolType types.PoolType, tokenIn sdk.Coin, tokenOutDenom string) {
+	if tokenIn.Amount.IsZero() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	hour := hoursSinceEpoch(ctx.BlockTime())
+
+	addTrackedVolume(store, types.FormatDenomPairVolumeKey(tokenIn.Denom, tokenOutDenom), tokenIn)
+	addTrackedVolume(store, types.FormatDenomPairHourlyVolumeKey(tokenIn.Denom, tokenOutDenom, hour), tokenIn)
+	addTrackedVolume(store, types.FormatPoolTypeVolumeKey(poolType), tokenIn)
+
+	pruneDenomPairHourlyVolumes(store, tokenIn.Denom, tokenOutDenom, hour)
+}
+
+// GetTotalVolumeForDenomPair returns the total historical swap volume of the given denom pair.
+// The volume is denominated in the tokens swapped in, so it contains up to one coin per denom of the pair.
+func (k Keeper) GetTotalVolumeForDenomPair(ctx sdk.Context, denom0, denom1 string) sdk.Coins {
+	return getTrackedVolume(ctx.KVStore(k.storeKey), types.FormatDenomPairVolumeKey(denom0, denom1))
+}
+
+// GetRollingVolumeForDenomPair returns the swap volume of the given denom pair over the last
+// types.VolumeWindowHours hours, the current hour included.
+func (k Keeper) GetRollingVolumeForDenomPair(ctx sdk.Context, denom0, denom1 string) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	hour := hoursSinceEpoch(ctx.BlockTime())
+
+	// Hourly volumes older than the window may still be in state if the pair was not swapped since.
+	start := types.FormatDenomPairHourlyVolumePrefix(denom0, denom1)
+	if hour >= types.VolumeWindowHours {
+		start = types.FormatDenomPairHourlyVolumeKey(denom0, denom1, hour-types.VolumeWindowHours+1)
+	}
+	end := sdk.PrefixEndBytes(types.FormatDenomPairHourlyVolumePrefix(denom0, denom1))
+
+	volume := sdk.NewCoins()
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var hourlyVolume types.TrackedVolume
+		if err := hourlyVolume.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		volume = volume.Add(hourlyVolume.Amount...)
+	}
+
+	return volume
+}
+
+// GetTotalVolumeForPoolType returns the total historical swap volume across all pools of the given type.
+func (k Keeper) GetTotalVolumeForPoolType(ctx sdk.Context, poolType types.PoolType) sdk.Coins {
+	return getTrackedVolume(ctx.KVStore(k.storeKey), types.FormatPoolTypeVolumeKey(poolType))
+}
+
+// GetAllDenomPairVolumes returns the total swap volumes of all denom pairs that were swapped.
+func (k Keeper) GetAllDenomPairVolumes(ctx sdk.Context) ([]types.DenomPairVolume, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyDenomPairVolumePrefix, func(key []byte, value []byte) (types.DenomPairVolume, error) {
+		denom0, denom1, err := types.ParseDenomPairVolumeKey(key)
+		if err != nil {
+			return types.DenomPairVolume{}, err
+		}
+		volume, err := parseTrackedVolume(value)
+		if err != nil {
+			return types.DenomPairVolume{}, err
+		}
+		return types.DenomPairVolume{Denom0: denom0, Denom1: denom1, Volume: volume}, nil
+	})
+}
+
+// GetAllDenomPairHourlyVolumes returns the hourly swap volumes of all denom pairs that are in state,
+// including the ones out of the rolling window that were not pruned yet.
+func (k Keeper) GetAllDenomPairHourlyVolumes(ctx sdk.Context) ([]types.DenomPairHourlyVolume, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyDenomPairHourlyVolumePrefix, func(key []byte, value []byte) (types.DenomPairHourlyVolume, error) {
+		denom0, denom1, hour, err := types.ParseDenomPairHourlyVolumeKey(key)
+		if err != nil {
+			return types.DenomPairHourlyVolume{}, err
+		}
+		volume, err := parseTrackedVolume(value)
+		if err != nil {
+			return types.DenomPairHourlyVolume{}, err
+		}
+		return types.DenomPairHourlyVolume{Denom0: denom0, Denom1: denom1, Hour: hour, Volume: volume}, nil
+	})
+}
+
+// GetAllPoolTypeVolumes returns the total swap volumes of all pool types that were swapped through.
+func (k Keeper) GetAllPoolTypeVolumes(ctx sdk.Context) ([]types.PoolTypeVolume, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyPoolTypeVolumePrefix, func(key []byte, value []byte) (types.PoolTypeVolume, error) {
+		poolType, err := types.ParsePoolTypeVolumeKey(key)
+		if err != nil {
+			return types.PoolTypeVolume{}, err
+		}
+		volume, err := parseTrackedVolume(value)
+		if err != nil {
+			return types.PoolTypeVolume{}, err
+		}
+		return types.PoolTypeVolume{PoolType: poolType, Volume: volume}, nil
+	})
+}
+
+// setDenomPairVolume sets the total swap volume of the given denom pair.
+func (k Keeper) setDenomPairVolume(ctx sdk.Context, denom0, denom1 string, volume sdk.Coins) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatDenomPairVolumeKey(denom0, denom1), &types.TrackedVolume{Amount: volume})
+}
+
+// setDenomPairHourlyVolume sets the swap volume of the given denom pair during the given hour.
+func (k Keeper) setDenomPairHourlyVolume(ctx sdk.Context, denom0, denom1 string, hour uint64, volume sdk.Coins) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatDenomPairHourlyVolumeKey(denom0, denom1, hour), &types.TrackedVolume{Amount: volume})
+}
+
+// setPoolTypeVolume sets the total swap volume of the given pool type.
+func (k Keeper) setPoolTypeVolume(ctx sdk.Context, poolType types.PoolType, volume sdk.Coins) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatPoolTypeVolumeKey(poolType), &types.TrackedVolume{Amount: volume})
+}
+
+// pruneDenomPairHourlyVolumes deletes the hourly volumes of the given denom pair
+// that are out of the rolling window ending at the given hour.
+func pruneDenomPairHourlyVolumes(store sdk.KVStore, denom0, denom1 string, hour uint64) {
+	if hour < types.VolumeWindowHours {
+		return
+	}
+
+	start := types.FormatDenomPairHourlyVolumePrefix(denom0, denom1)
+	end := types.FormatDenomPairHourlyVolumeKey(denom0, denom1, hour-types.VolumeWindowHours+1)
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	// Collect the keys first since the store must not be mutated while iterating.
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+}
+
+// addTrackedVolume adds the given coin to the volume stored under the given key.
+func addTrackedVolume(store sdk.KVStore, key []byte, volumeGenerated sdk.Coin) {
+	newVolume := getTrackedVolume(store, key).Add(volumeGenerated)
+	osmoutils.MustSet(store, key, &types.TrackedVolume{Amount: newVolume})
+}
+
+// getTrackedVolume returns the volume stored under the given key. Returns empty coins if none is stored.
+func getTrackedVolume(store sdk.KVStore, key []byte) sdk.Coins {
+	var trackedVolume types.TrackedVolume
+	found, err := osmoutils.Get(store, key, &trackedVolume)
+	if err != nil {
+		// We can only encounter an error if a database or serialization error occurs.
+		panic(err)
+	}
+	if !found {
+		return sdk.NewCoins()
+	}
+	return trackedVolume.Amount
+}
+
+// parseTrackedVolume parses the raw bytes of a TrackedVolume into its amount.
+func parseTrackedVolume(value []byte) (sdk.Coins, error) {
+	var trackedVolume types.TrackedVolume
+	if err := trackedVolume.Unmarshal(value); err != nil {
+		return nil, err
+	}
+	return trackedVolume.Amount, nil
+}
+
+// hoursSinceEpoch returns the number of whole hours elapsed since the Unix epoch at the given time.
+func hoursSinceEpoch(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(time.Hour/time.Second))
+}
@@ -0,0 +1,60 @@
+package poolmanager_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+// validates that trackSwapVolume updates the total and rolling volume of the denom pair
+// and the total volume of the pool type, and that hourly volumes out of the window are
+// excluded from the rolling volume and pruned.
+func (s *KeeperTestSuite) TestTrackSwapVolume() {
+	s.SetupTest()
+
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	poolManagerKeeper := s.App.PoolManagerKeeper
+
+	// Swaps in both directions of the pair in the first hour.
+	poolManagerKeeper.TrackSwapVolume(s.Ctx, types.Balancer, sdk.NewInt64Coin(UOSMO, 100), FOO)
+	poolManagerKeeper.TrackSwapVolume(s.Ctx, types.Concentrated, sdk.NewInt64Coin(FOO, 50), UOSMO)
+
+	// Zero swaps are ignored.
+	poolManagerKeeper.TrackSwapVolume(s.Ctx, types.Balancer, sdk.NewInt64Coin(UOSMO, 0), FOO)
+
+	expectedFirstHourVolume := sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 100), sdk.NewInt64Coin(FOO, 50))
+	s.Require().Equal(expectedFirstHourVolume, poolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, UOSMO, FOO))
+	s.Require().Equal(expectedFirstHourVolume, poolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, FOO, UOSMO))
+	s.Require().Equal(expectedFirstHourVolume, poolManagerKeeper.GetRollingVolumeForDenomPair(s.Ctx, UOSMO, FOO))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 100)), poolManagerKeeper.GetTotalVolumeForPoolType(s.Ctx, types.Balancer))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(FOO, 50)), poolManagerKeeper.GetTotalVolumeForPoolType(s.Ctx, types.Concentrated))
+	s.Require().Equal(sdk.NewCoins(), poolManagerKeeper.GetTotalVolumeForPoolType(s.Ctx, types.Stableswap))
+
+	// Other pairs are unaffected.
+	s.Require().Equal(sdk.NewCoins(), poolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, UOSMO, BAR))
+
+	// The first hour is still in the window at the start of the last hour of the window.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add((types.VolumeWindowHours - 1) * time.Hour))
+	poolManagerKeeper.TrackSwapVolume(s.Ctx, types.Balancer, sdk.NewInt64Coin(UOSMO, 10), FOO)
+
+	s.Require().Equal(expectedFirstHourVolume.Add(sdk.NewInt64Coin(UOSMO, 10)), poolManagerKeeper.GetRollingVolumeForDenomPair(s.Ctx, UOSMO, FOO))
+
+	// Once the window moves past the first hour, its volume is excluded from the rolling volume
+	// but kept in the total volume.
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(types.VolumeWindowHours * time.Hour))
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 10)), poolManagerKeeper.GetRollingVolumeForDenomPair(s.Ctx, UOSMO, FOO))
+	s.Require().Equal(expectedFirstHourVolume.Add(sdk.NewInt64Coin(UOSMO, 10)), poolManagerKeeper.GetTotalVolumeForDenomPair(s.Ctx, UOSMO, FOO))
+
+	// The next swap of the pair prunes the hourly volume out of the window.
+	firstHourKey := types.FormatDenomPairHourlyVolumeKey(UOSMO, FOO, uint64(startTime.Unix()/3600))
+	s.Require().True(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Has(firstHourKey))
+
+	poolManagerKeeper.TrackSwapVolume(s.Ctx, types.Balancer, sdk.NewInt64Coin(FOO, 5), UOSMO)
+
+	s.Require().False(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)).Has(firstHourKey))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(UOSMO, 10), sdk.NewInt64Coin(FOO, 5)), poolManagerKeeper.GetRollingVolumeForDenomPair(s.Ctx, UOSMO, FOO))
+}
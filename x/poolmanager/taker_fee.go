@@ -105,7 +105,8 @@ func (k Keeper) GetAllTradingPairTakerFees(ctx sdk.Context) ([]types.DenomPairTa
 // module account. It returns the tokenIn after the taker fee has been extracted.
 // If the sender is in the taker fee reduced whitelisted, it returns the tokenIn without extracting the taker fee.
 // In the future, we might charge a lower taker fee as opposed to no fee at all.
-func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool) (sdk.Coin, error) {
+// Otherwise, the taker fee is discounted by the given discount, see getStakedOsmoTakerFeeDiscount.
+func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom string, sender sdk.AccAddress, exactIn bool, discount osmomath.Dec) (sdk.Coin, error) {
 	feeCollectorForStakingRewardsName := txfeestypes.FeeCollectorForStakingRewardsName
 	feeCollectorForCommunityPoolName := txfeestypes.FeeCollectorForCommunityPoolName
 	defaultTakerFeeDenom := appparams.BaseCoinUnit
@@ -121,6 +122,17 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 		return sdk.Coin{}, err
 	}
 
+	if discount.IsPositive() && takerFee.IsPositive() {
+		takerFee = takerFee.Mul(osmomath.OneDec().Sub(discount))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtTakerFeeDiscount,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyDiscount, discount.String()),
+			sdk.NewAttribute(types.AttributeKeyTakerFee, takerFee.String()),
+		))
+	}
+
 	var tokenInAfterTakerFee sdk.Coin
 	var takerFeeCoin sdk.Coin
 	if exactIn {
@@ -203,6 +215,34 @@ func (k Keeper) chargeTakerFee(ctx sdk.Context, tokenIn sdk.Coin, tokenOutDenom
 	return tokenInAfterTakerFee, nil
 }
 
+// getStakedOsmoTakerFeeDiscount returns the taker fee discount of the highest staked OSMO tier
+// the sender's bonded OSMO qualifies for. It queries the staking module, so swaps through
+// multiple pools determine it once for the whole route rather than once per pool.
+// Returns zero without querying the staking module if there are no tiers or if the sender
+// is not charged any taker fee.
+func (k Keeper) getStakedOsmoTakerFeeDiscount(ctx sdk.Context, sender sdk.AccAddress) osmomath.Dec {
+	takerFeeParams := k.GetParams(ctx).TakerFeeParams
+	if len(takerFeeParams.StakedOsmoDiscountTiers) == 0 || osmoutils.Contains(takerFeeParams.ReducedFeeWhitelist, sender.String()) {
+		return osmomath.ZeroDec()
+	}
+	bonded := k.stakingKeeper.GetDelegatorBonded(ctx, sender)
+	return GetStakedOsmoTakerFeeDiscount(takerFeeParams.StakedOsmoDiscountTiers, bonded)
+}
+
+// GetStakedOsmoTakerFeeDiscount returns the discount of the highest tier whose min staked amount
+// does not exceed the given bonded amount. Tiers are expected to be sorted by increasing min staked amount.
+// Returns zero if the bonded amount does not qualify for any tier.
+func GetStakedOsmoTakerFeeDiscount(tiers []types.TakerFeeDiscountTier, bonded osmomath.Int) osmomath.Dec {
+	discount := osmomath.ZeroDec()
+	for _, tier := range tiers {
+		if bonded.LT(tier.MinStaked) {
+			break
+		}
+		discount = tier.Discount
+	}
+	return discount
+}
+
 // Returns remaining amount in to swap, and takerFeeCoins.
 // returns (1 - takerFee) * tokenIn, takerFee * tokenIn
 func CalcTakerFeeExactIn(tokenIn sdk.Coin, takerFee osmomath.Dec) (sdk.Coin, sdk.Coin) {
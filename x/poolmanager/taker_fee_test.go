@@ -5,9 +5,12 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager"
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 )
 
 // validates that the pool manager keeper can charge taker fees correctly.
@@ -175,3 +178,169 @@ func (s *KeeperTestSuite) TestChargeTakerFee() {
 		})
 	}
 }
+
+// validates that the taker fee is discounted based on the sender's bonded OSMO
+// according to the staked OSMO discount tiers.
+func (s *KeeperTestSuite) TestChargeTakerFee_StakedOsmoDiscount() {
+	var (
+		takerFee      = osmomath.MustNewDecFromStr("0.01")
+		defaultAmount = sdk.NewInt(10000000)
+		tiers         = []types.TakerFeeDiscountTier{
+			{MinStaked: sdk.NewInt(1_000_000), Discount: osmomath.MustNewDecFromStr("0.25")},
+			{MinStaked: sdk.NewInt(10_000_000), Discount: osmomath.MustNewDecFromStr("0.5")},
+		}
+	)
+
+	tests := map[string]struct {
+		tiers            []types.TakerFeeDiscountTier
+		bonded           osmomath.Int
+		exactIn          bool
+		expectedDiscount osmomath.Dec
+	}{
+		"no tiers": {
+			bonded:           sdk.NewInt(10_000_000),
+			exactIn:          true,
+			expectedDiscount: osmomath.ZeroDec(),
+		},
+		"bonded below the lowest tier": {
+			tiers:            tiers,
+			bonded:           sdk.NewInt(999_999),
+			exactIn:          true,
+			expectedDiscount: osmomath.ZeroDec(),
+		},
+		"bonded exactly at the lowest tier": {
+			tiers:            tiers,
+			bonded:           sdk.NewInt(1_000_000),
+			exactIn:          true,
+			expectedDiscount: osmomath.MustNewDecFromStr("0.25"),
+		},
+		"bonded between tiers": {
+			tiers:            tiers,
+			bonded:           sdk.NewInt(5_000_000),
+			exactIn:          true,
+			expectedDiscount: osmomath.MustNewDecFromStr("0.25"),
+		},
+		"bonded above the highest tier": {
+			tiers:            tiers,
+			bonded:           sdk.NewInt(50_000_000),
+			exactIn:          true,
+			expectedDiscount: osmomath.MustNewDecFromStr("0.5"),
+		},
+		"bonded above the highest tier, exact out": {
+			tiers:            tiers,
+			bonded:           sdk.NewInt(50_000_000),
+			exactIn:          false,
+			expectedDiscount: osmomath.MustNewDecFromStr("0.5"),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			sender := s.TestAccs[0]
+			tokenIn := sdk.NewCoin(apptesting.ETH, defaultAmount)
+
+			poolManagerParams := poolManager.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.StakedOsmoDiscountTiers = tc.tiers
+			poolManager.SetParams(s.Ctx, poolManagerParams)
+
+			s.PrepareConcentratedPool()
+			poolManager.SetDenomPairTakerFee(s.Ctx, tokenIn.Denom, apptesting.USDC, takerFee)
+
+			// Bond the given amount of OSMO from the sender.
+			bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
+			s.FundAcc(sender, sdk.NewCoins(tokenIn, sdk.NewCoin(bondDenom, tc.bonded)))
+			validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, s.SetupValidator(stakingtypes.Bonded))
+			s.Require().True(found)
+			_, err := s.App.StakingKeeper.Delegate(s.Ctx, sender, tc.bonded, stakingtypes.Unbonded, validator, true)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			tokenInAfterTakerFee, err := poolManager.ChargeTakerFee(s.Ctx, tokenIn, apptesting.USDC, sender, tc.exactIn)
+			s.Require().NoError(err)
+
+			discountedTakerFee := takerFee.Mul(osmomath.OneDec().Sub(tc.expectedDiscount))
+			var expectedResult sdk.Coin
+			if tc.exactIn {
+				expectedResult, _ = poolmanager.CalcTakerFeeExactIn(tokenIn, discountedTakerFee)
+			} else {
+				expectedResult, _ = poolmanager.CalcTakerFeeExactOut(tokenIn, discountedTakerFee)
+			}
+			s.Require().Equal(expectedResult, tokenInAfterTakerFee)
+
+			// A discount event is only emitted if a discount was applied.
+			expectedEvents := 0
+			if tc.expectedDiscount.IsPositive() {
+				expectedEvents = 1
+			}
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtTakerFeeDiscount, expectedEvents)
+		})
+	}
+}
+
+// validates that the staked OSMO discount of the sender applies to every pool of a multihop route.
+func (s *KeeperTestSuite) TestRoute_StakedOsmoDiscount() {
+	var (
+		takerFee = osmomath.MustNewDecFromStr("0.01")
+		discount = osmomath.MustNewDecFromStr("0.5")
+		bonded   = sdk.NewInt(1_000_000)
+	)
+
+	for _, exactIn := range []bool{true, false} {
+		s.Run(fmt.Sprintf("exact in: %t", exactIn), func() {
+			s.SetupTest()
+			poolManager := s.App.PoolManagerKeeper
+			sender := s.TestAccs[1]
+
+			poolManagerParams := poolManager.GetParams(s.Ctx)
+			poolManagerParams.TakerFeeParams.StakedOsmoDiscountTiers = []types.TakerFeeDiscountTier{{MinStaked: bonded, Discount: discount}}
+			poolManager.SetParams(s.Ctx, poolManagerParams)
+
+			firstPoolId, secondPoolId := s.PrepareBalancerPool(), s.PrepareBalancerPool()
+			poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.FOO, apptesting.BAR, takerFee)
+			poolManager.SetDenomPairTakerFee(s.Ctx, apptesting.BAR, apptesting.BAZ, takerFee)
+
+			// Bond the OSMO of the lowest tier from the sender.
+			bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
+			s.FundAcc(sender, sdk.NewCoins(sdk.NewCoin(apptesting.FOO, sdk.NewInt(1_000_000)), sdk.NewCoin(bondDenom, bonded)))
+			validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, s.SetupValidator(stakingtypes.Bonded))
+			s.Require().True(found)
+			_, err := s.App.StakingKeeper.Delegate(s.Ctx, sender, bonded, stakingtypes.Unbonded, validator, true)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			if exactIn {
+				route := []types.SwapAmountInRoute{
+					{PoolId: firstPoolId, TokenOutDenom: apptesting.BAR},
+					{PoolId: secondPoolId, TokenOutDenom: apptesting.BAZ},
+				}
+				_, err = poolManager.RouteExactAmountIn(s.Ctx, sender, route, sdk.NewCoin(apptesting.FOO, sdk.NewInt(100_000)), osmomath.OneInt())
+			} else {
+				route := []types.SwapAmountOutRoute{
+					{PoolId: firstPoolId, TokenInDenom: apptesting.FOO},
+					{PoolId: secondPoolId, TokenInDenom: apptesting.BAR},
+				}
+				_, err = poolManager.RouteExactAmountOut(s.Ctx, sender, route, sdk.NewInt(1_000_000), sdk.NewCoin(apptesting.BAZ, sdk.NewInt(100_000)))
+			}
+			s.Require().NoError(err)
+
+			// The discount applies to the taker fee of both pools.
+			discountEvents := 0
+			for _, event := range s.Ctx.EventManager().Events() {
+				if event.Type != types.TypeEvtTakerFeeDiscount {
+					continue
+				}
+				discountEvents++
+				attributes := s.ExtractAttributes(event)
+				s.Require().Equal(discount.String(), attributes[types.AttributeKeyDiscount])
+				s.Require().Equal(takerFee.Mul(osmomath.OneDec().Sub(discount)).String(), attributes[types.AttributeKeyTakerFee])
+			}
+			s.Require().Equal(2, discountEvents)
+		})
+	}
+}
@@ -35,6 +35,60 @@ func (e FailedToFindRouteError) Error() string {
 	return fmt.Sprintf("failed to find route for pool id (%d)", e.PoolId)
 }
 
+type DuplicateRouteDenomError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e DuplicateRouteDenomError) Error() string {
+	return fmt.Sprintf("route visits denom (%s) more than once, found again at pool id (%d)", e.Denom, e.PoolId)
+}
+
+type RoutePoolWithTokenInDenomError struct {
+	PoolId       uint64
+	TokenInDenom string
+}
+
+func (e RoutePoolWithTokenInDenomError) Error() string {
+	return fmt.Sprintf("route has an intermediary pool (%d) that swaps back into token in denom (%s)", e.PoolId, e.TokenInDenom)
+}
+
+type RoutePoolWithTokenOutDenomError struct {
+	PoolId        uint64
+	TokenOutDenom string
+}
+
+func (e RoutePoolWithTokenOutDenomError) Error() string {
+	return fmt.Sprintf("route has an intermediary pool (%d) that swaps in from token out denom (%s)", e.PoolId, e.TokenOutDenom)
+}
+
+type TooManyHopsError struct {
+	NumHops int
+	MaxHops uint64
+}
+
+func (e TooManyHopsError) Error() string {
+	return fmt.Sprintf("route goes through (%d) pools, exceeding the max hops (%d)", e.NumHops, e.MaxHops)
+}
+
+type TooManyRoutesError struct {
+	NumRoutes      int
+	MaxRoutesPerTx uint64
+}
+
+func (e TooManyRoutesError) Error() string {
+	return fmt.Sprintf("swap is split across (%d) routes, exceeding the max routes per tx (%d)", e.NumRoutes, e.MaxRoutesPerTx)
+}
+
+type DenomPairPausedError struct {
+	Denom0 string
+	Denom1 string
+}
+
+func (e DenomPairPausedError) Error() string {
+	return fmt.Sprintf("swaps between (%s) and (%s) are paused", e.Denom0, e.Denom1)
+}
+
 type UndefinedRouteError struct {
 	PoolType PoolType
 	PoolId   uint64
@@ -133,3 +187,19 @@ type InactivePoolError struct {
 func (e InactivePoolError) Error() string {
 	return fmt.Sprintf("Pool %d is not active.", e.PoolId)
 }
+
+type SwapsPausedError struct {
+	PoolId uint64
+}
+
+func (e SwapsPausedError) Error() string {
+	return fmt.Sprintf("Swaps through pool %d are paused.", e.PoolId)
+}
+
+type NonIbcDenomUnwrapError struct {
+	Denom string
+}
+
+func (e NonIbcDenomUnwrapError) Error() string {
+	return fmt.Sprintf("cannot unwrap (%s) over IBC, it is not an IBC denom", e.Denom)
+}
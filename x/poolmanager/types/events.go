@@ -4,10 +4,18 @@ const (
 	AttributeValueCategory       = ModuleName
 	TypeEvtPoolCreated           = "pool_created"
 	TypeEvtSplitRouteSwapExactIn = "split_route_swap_exact_in"
+	TypeEvtIbcUnwrap             = "ibc_unwrap"
+	TypeEvtTakerFeeDiscount      = "taker_fee_discount"
+	TypeEvtConcentratedSwap      = "concentrated_swap_execution"
 	AttributeKeyTokensIn         = "tokens_in"
 	AttributeKeyTokensOut        = "tokens_out"
 	AttributeKeyPoolId           = "pool_id"
 	AttributeKeyDenom0           = "denom0"
 	AttributeKeyDenom1           = "denom1"
 	AttributeKeyTakerFee         = "taker_fee"
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeyPacketSequence   = "packet_sequence"
+	AttributeKeyDiscount         = "discount"
+	AttributeKeyCrossedTicks     = "crossed_ticks"
+	AttributeKeyFinalTick        = "final_tick"
 )
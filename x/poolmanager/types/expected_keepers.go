@@ -1,7 +1,11 @@
 package types
 
 import (
+	"context"
+
+	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 
@@ -107,8 +111,21 @@ type MultihopRoute interface {
 
 type StakingKeeper interface {
 	BondDenom(ctx sdk.Context) string
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) osmomath.Int
 }
 
 type ProtorevKeeper interface {
 	GetPoolForDenomPair(ctx sdk.Context, baseDenom, denomToMatch string) (uint64, error)
 }
+
+// SwapCircuitBreaker defines the contract needed to be fulfilled for pausing swaps through a pool,
+// e.g. after its spot price deviated from its recent TWAP.
+type SwapCircuitBreaker interface {
+	IsSwapPaused(ctx sdk.Context, poolId uint64) bool
+}
+
+// TransferKeeper defines the contract needed to be fulfilled for the ibc transfer keeper.
+type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+	Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error)
+}
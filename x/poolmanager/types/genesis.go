@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -28,5 +29,40 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, denomPairVolume := range gs.DenomPairVolumes {
+		if err := validateDenomPairVolume(denomPairVolume.Denom0, denomPairVolume.Denom1, denomPairVolume.Volume); err != nil {
+			return err
+		}
+	}
+	for _, denomPairHourlyVolume := range gs.DenomPairHourlyVolumes {
+		if err := validateDenomPairVolume(denomPairHourlyVolume.Denom0, denomPairHourlyVolume.Denom1, denomPairHourlyVolume.Volume); err != nil {
+			return err
+		}
+	}
+	for _, poolTypeVolume := range gs.PoolTypeVolumes {
+		if _, ok := PoolType_name[int32(poolTypeVolume.PoolType)]; !ok {
+			return fmt.Errorf("invalid pool type (%d)", poolTypeVolume.PoolType)
+		}
+		if err := poolTypeVolume.Volume.Validate(); err != nil {
+			return fmt.Errorf("invalid swap volume of pool type (%s): %w", poolTypeVolume.PoolType, err)
+		}
+	}
+	return nil
+}
+
+// validateDenomPairVolume validates the denoms of a denom pair and its swap volume.
+// The volume is denominated in the tokens swapped in, so it may only hold the denoms of the pair.
+func validateDenomPairVolume(denom0, denom1 string, volume sdk.Coins) error {
+	if _, _, err := validateDenomPair(denom0, denom1); err != nil {
+		return err
+	}
+	if err := volume.Validate(); err != nil {
+		return fmt.Errorf("invalid swap volume of denom pair (%s, %s): %w", denom0, denom1, err)
+	}
+	for _, coin := range volume {
+		if coin.Denom != denom0 && coin.Denom != denom1 {
+			return fmt.Errorf("swap volume of denom pair (%s, %s) holds unrelated denom (%s)", denom0, denom1, coin.Denom)
+		}
+	}
 	return nil
 }
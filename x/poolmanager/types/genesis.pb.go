@@ -43,6 +43,22 @@ type Params struct {
 	// orders at prices in terms of token1 (quote asset) that are easy to reason
 	// about.
 	AuthorizedQuoteDenoms []string `protobuf:"bytes,3,rep,name=authorized_quote_denoms,json=authorizedQuoteDenoms,proto3" json:"authorized_quote_denoms,omitempty" yaml:"authorized_quote_denoms"`
+	// denom_aliases is the registry of denoms that routes treat as
+	// interchangeable with their canonical denom. When a routed pool does not
+	// hold a denom but holds one of its aliases, the router converts between
+	// them through the converter pools of the aliases.
+	DenomAliases []DenomAlias `protobuf:"bytes,4,rep,name=denom_aliases,json=denomAliases,proto3" json:"denom_aliases" yaml:"denom_aliases"`
+	// max_hops is the maximum number of pools that a swap route may go
+	// through. Zero disables the limit.
+	MaxHops uint64 `protobuf:"varint,5,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+	// max_routes_per_tx is the maximum number of routes that a split route
+	// swap may be split across. Zero disables the limit.
+	MaxRoutesPerTx uint64 `protobuf:"varint,6,opt,name=max_routes_per_tx,json=maxRoutesPerTx,proto3" json:"max_routes_per_tx,omitempty" yaml:"max_routes_per_tx"`
+	// paused_denom_pairs is the list of denom pairs that no swap route may
+	// swap between, in either direction, e.g. while one of the denoms is
+	// depegged. Pools holding a paused pair remain open to liquidity
+	// withdrawals.
+	PausedDenomPairs []PausedDenomPair `protobuf:"bytes,7,rep,name=paused_denom_pairs,json=pausedDenomPairs,proto3" json:"paused_denom_pairs" yaml:"paused_denom_pairs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -99,6 +115,34 @@ func (m *Params) GetAuthorizedQuoteDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetDenomAliases() []DenomAlias {
+	if m != nil {
+		return m.DenomAliases
+	}
+	return nil
+}
+
+func (m *Params) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRoutesPerTx() uint64 {
+	if m != nil {
+		return m.MaxRoutesPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetPausedDenomPairs() []PausedDenomPair {
+	if m != nil {
+		return m.PausedDenomPairs
+	}
+	return nil
+}
+
 // GenesisState defines the poolmanager module's genesis state.
 type GenesisState struct {
 	// the next_pool_id
@@ -108,9 +152,12 @@ type GenesisState struct {
 	// pool_routes is the container of the mappings from pool id to pool type.
 	PoolRoutes []ModuleRoute `protobuf:"bytes,3,rep,name=pool_routes,json=poolRoutes,proto3" json:"pool_routes"`
 	// KVStore state
-	TakerFeesTracker       *TakerFeesTracker   `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
-	PoolVolumes            []*PoolVolume       `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
-	DenomPairTakerFeeStore []DenomPairTakerFee `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	TakerFeesTracker       *TakerFeesTracker       `protobuf:"bytes,4,opt,name=taker_fees_tracker,json=takerFeesTracker,proto3" json:"taker_fees_tracker,omitempty"`
+	PoolVolumes            []*PoolVolume           `protobuf:"bytes,5,rep,name=pool_volumes,json=poolVolumes,proto3" json:"pool_volumes,omitempty"`
+	DenomPairTakerFeeStore []DenomPairTakerFee     `protobuf:"bytes,6,rep,name=denom_pair_taker_fee_store,json=denomPairTakerFeeStore,proto3" json:"denom_pair_taker_fee_store"`
+	DenomPairVolumes       []DenomPairVolume       `protobuf:"bytes,7,rep,name=denom_pair_volumes,json=denomPairVolumes,proto3" json:"denom_pair_volumes"`
+	DenomPairHourlyVolumes []DenomPairHourlyVolume `protobuf:"bytes,8,rep,name=denom_pair_hourly_volumes,json=denomPairHourlyVolumes,proto3" json:"denom_pair_hourly_volumes"`
+	PoolTypeVolumes        []PoolTypeVolume        `protobuf:"bytes,9,rep,name=pool_type_volumes,json=poolTypeVolumes,proto3" json:"pool_type_volumes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -188,6 +235,27 @@ func (m *GenesisState) GetDenomPairTakerFeeStore() []DenomPairTakerFee {
 	return nil
 }
 
+func (m *GenesisState) GetDenomPairVolumes() []DenomPairVolume {
+	if m != nil {
+		return m.DenomPairVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomPairHourlyVolumes() []DenomPairHourlyVolume {
+	if m != nil {
+		return m.DenomPairHourlyVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolTypeVolumes() []PoolTypeVolume {
+	if m != nil {
+		return m.PoolTypeVolumes
+	}
+	return nil
+}
+
 // TakerFeeParams consolidates the taker fee parameters for the poolmanager.
 type TakerFeeParams struct {
 	// default_taker_fee is the fee used when creating a new pool that doesn't
@@ -227,6 +295,11 @@ type TakerFeeParams struct {
 	// Initially, the taker fee is allowed to be bypassed completely. However
 	// In the future, we will charge a reduced taker fee instead of no fee at all.
 	ReducedFeeWhitelist []string `protobuf:"bytes,6,rep,name=reduced_fee_whitelist,json=reducedFeeWhitelist,proto3" json:"reduced_fee_whitelist,omitempty" yaml:"reduced_fee_whitelist"`
+	// staked_osmo_discount_tiers is the taker fee discount schedule for traders
+	// with bonded OSMO. A trader gets the discount of the highest tier whose
+	// min_staked does not exceed the trader's bonded OSMO. Tiers must be sorted
+	// by strictly increasing min_staked. If empty, no discount is applied.
+	StakedOsmoDiscountTiers []TakerFeeDiscountTier `protobuf:"bytes,7,rep,name=staked_osmo_discount_tiers,json=stakedOsmoDiscountTiers,proto3" json:"staked_osmo_discount_tiers" yaml:"staked_osmo_discount_tiers"`
 }
 
 func (m *TakerFeeParams) Reset()         { *m = TakerFeeParams{} }
@@ -297,6 +370,13 @@ func (m *TakerFeeParams) GetReducedFeeWhitelist() []string {
 	return nil
 }
 
+func (m *TakerFeeParams) GetStakedOsmoDiscountTiers() []TakerFeeDiscountTier {
+	if m != nil {
+		return m.StakedOsmoDiscountTiers
+	}
+	return nil
+}
+
 // TakerFeeDistributionPercentage defines what percent of the taker fee category
 // gets distributed to the available categories.
 type TakerFeeDistributionPercentage struct {
@@ -453,6 +533,368 @@ func (m *PoolVolume) GetPoolVolume() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// TakerFeeDiscountTier defines the taker fee discount granted to traders with
+// at least min_staked bonded OSMO.
+type TakerFeeDiscountTier struct {
+	// min_staked is the minimum amount of bonded uosmo required for the tier.
+	MinStaked cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_staked,json=minStaked,proto3,customtype=cosmossdk.io/math.Int" json:"min_staked" yaml:"min_staked"`
+	// discount is the fraction of the taker fee that is waived, between 0 and 1.
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount" yaml:"discount"`
+}
+
+func (m *TakerFeeDiscountTier) Reset()         { *m = TakerFeeDiscountTier{} }
+func (m *TakerFeeDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*TakerFeeDiscountTier) ProtoMessage()    {}
+func (*TakerFeeDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{6}
+}
+func (m *TakerFeeDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakerFeeDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakerFeeDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakerFeeDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakerFeeDiscountTier.Merge(m, src)
+}
+func (m *TakerFeeDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakerFeeDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakerFeeDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakerFeeDiscountTier proto.InternalMessageInfo
+
+// DenomAlias defines a variant of a canonical denom, e.g. the same asset
+// bridged through another bridge, that is interchangeable with it through a
+// converter pool holding both, such as a transmuter pool.
+type DenomAlias struct {
+	// alias_denom is the variant of the canonical denom.
+	AliasDenom string `protobuf:"bytes,1,opt,name=alias_denom,json=aliasDenom,proto3" json:"alias_denom,omitempty" yaml:"alias_denom"`
+	// canonical_denom is the denom that the alias converts to.
+	CanonicalDenom string `protobuf:"bytes,2,opt,name=canonical_denom,json=canonicalDenom,proto3" json:"canonical_denom,omitempty" yaml:"canonical_denom"`
+	// converter_pool_id is the ID of the pool that converts between the alias
+	// and the canonical denom.
+	ConverterPoolId uint64 `protobuf:"varint,3,opt,name=converter_pool_id,json=converterPoolId,proto3" json:"converter_pool_id,omitempty" yaml:"converter_pool_id"`
+}
+
+func (m *DenomAlias) Reset()         { *m = DenomAlias{} }
+func (m *DenomAlias) String() string { return proto.CompactTextString(m) }
+func (*DenomAlias) ProtoMessage()    {}
+func (*DenomAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{7}
+}
+func (m *DenomAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAlias.Merge(m, src)
+}
+func (m *DenomAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAlias proto.InternalMessageInfo
+
+func (m *DenomAlias) GetAliasDenom() string {
+	if m != nil {
+		return m.AliasDenom
+	}
+	return ""
+}
+
+func (m *DenomAlias) GetCanonicalDenom() string {
+	if m != nil {
+		return m.CanonicalDenom
+	}
+	return ""
+}
+
+func (m *DenomAlias) GetConverterPoolId() uint64 {
+	if m != nil {
+		return m.ConverterPoolId
+	}
+	return 0
+}
+
+// PausedDenomPair defines a pair of denoms that routes may not swap between.
+// The order of the denoms is irrelevant.
+type PausedDenomPair struct {
+	// denom0 is one of the denoms of the pair.
+	Denom0 string `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty" yaml:"denom0"`
+	// denom1 is the other denom of the pair.
+	Denom1 string `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty" yaml:"denom1"`
+}
+
+func (m *PausedDenomPair) Reset()         { *m = PausedDenomPair{} }
+func (m *PausedDenomPair) String() string { return proto.CompactTextString(m) }
+func (*PausedDenomPair) ProtoMessage()    {}
+func (*PausedDenomPair) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{8}
+}
+func (m *PausedDenomPair) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedDenomPair) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedDenomPair.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedDenomPair) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedDenomPair.Merge(m, src)
+}
+func (m *PausedDenomPair) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedDenomPair) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedDenomPair.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedDenomPair proto.InternalMessageInfo
+
+func (m *PausedDenomPair) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *PausedDenomPair) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
+// DenomPairVolume stores the KVStore entry of the total swap volume of a
+// denom pair, which is used in export/import genesis.
+type DenomPairVolume struct {
+	// denom0 is the lexicographically smaller denom of the pair.
+	Denom0 string `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty"`
+	// denom1 is the lexicographically larger denom of the pair.
+	Denom1 string `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty"`
+	// volume is the total swap volume of the pair, denominated in the tokens
+	// swapped in.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *DenomPairVolume) Reset()         { *m = DenomPairVolume{} }
+func (m *DenomPairVolume) String() string { return proto.CompactTextString(m) }
+func (*DenomPairVolume) ProtoMessage()    {}
+func (*DenomPairVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{9}
+}
+func (m *DenomPairVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairVolume.Merge(m, src)
+}
+func (m *DenomPairVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairVolume proto.InternalMessageInfo
+
+func (m *DenomPairVolume) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *DenomPairVolume) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
+func (m *DenomPairVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+// DenomPairHourlyVolume stores the KVStore entry of the swap volume of a
+// denom pair during an hour, which is used in export/import genesis.
+type DenomPairHourlyVolume struct {
+	// denom0 is the lexicographically smaller denom of the pair.
+	Denom0 string `protobuf:"bytes,1,opt,name=denom0,proto3" json:"denom0,omitempty"`
+	// denom1 is the lexicographically larger denom of the pair.
+	Denom1 string `protobuf:"bytes,2,opt,name=denom1,proto3" json:"denom1,omitempty"`
+	// hour is the hour of the volume, counted in hours since the Unix epoch.
+	Hour uint64 `protobuf:"varint,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// volume is the swap volume of the pair during the hour, denominated in
+	// the tokens swapped in.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *DenomPairHourlyVolume) Reset()         { *m = DenomPairHourlyVolume{} }
+func (m *DenomPairHourlyVolume) String() string { return proto.CompactTextString(m) }
+func (*DenomPairHourlyVolume) ProtoMessage()    {}
+func (*DenomPairHourlyVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{10}
+}
+func (m *DenomPairHourlyVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPairHourlyVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPairHourlyVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPairHourlyVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPairHourlyVolume.Merge(m, src)
+}
+func (m *DenomPairHourlyVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPairHourlyVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPairHourlyVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPairHourlyVolume proto.InternalMessageInfo
+
+func (m *DenomPairHourlyVolume) GetDenom0() string {
+	if m != nil {
+		return m.Denom0
+	}
+	return ""
+}
+
+func (m *DenomPairHourlyVolume) GetDenom1() string {
+	if m != nil {
+		return m.Denom1
+	}
+	return ""
+}
+
+func (m *DenomPairHourlyVolume) GetHour() uint64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *DenomPairHourlyVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+// PoolTypeVolume stores the KVStore entry of the total swap volume of a pool
+// type, which is used in export/import genesis.
+type PoolTypeVolume struct {
+	// pool_type is the type of the pools.
+	PoolType PoolType `protobuf:"varint,1,opt,name=pool_type,json=poolType,proto3,enum=osmosis.poolmanager.v1beta1.PoolType" json:"pool_type,omitempty"`
+	// volume is the total swap volume across all pools of the type.
+	Volume github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=volume,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"volume"`
+}
+
+func (m *PoolTypeVolume) Reset()         { *m = PoolTypeVolume{} }
+func (m *PoolTypeVolume) String() string { return proto.CompactTextString(m) }
+func (*PoolTypeVolume) ProtoMessage()    {}
+func (*PoolTypeVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa099d9fbdf68b35, []int{11}
+}
+func (m *PoolTypeVolume) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolTypeVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolTypeVolume.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolTypeVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolTypeVolume.Merge(m, src)
+}
+func (m *PoolTypeVolume) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolTypeVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolTypeVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolTypeVolume proto.InternalMessageInfo
+
+func (m *PoolTypeVolume) GetPoolType() PoolType {
+	if m != nil {
+		return m.PoolType
+	}
+	return Balancer
+}
+
+func (m *PoolTypeVolume) GetVolume() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.poolmanager.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.poolmanager.v1beta1.GenesisState")
@@ -460,6 +902,12 @@ func init() {
 	proto.RegisterType((*TakerFeeDistributionPercentage)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDistributionPercentage")
 	proto.RegisterType((*TakerFeesTracker)(nil), "osmosis.poolmanager.v1beta1.TakerFeesTracker")
 	proto.RegisterType((*PoolVolume)(nil), "osmosis.poolmanager.v1beta1.PoolVolume")
+	proto.RegisterType((*TakerFeeDiscountTier)(nil), "osmosis.poolmanager.v1beta1.TakerFeeDiscountTier")
+	proto.RegisterType((*DenomAlias)(nil), "osmosis.poolmanager.v1beta1.DenomAlias")
+	proto.RegisterType((*PausedDenomPair)(nil), "osmosis.poolmanager.v1beta1.PausedDenomPair")
+	proto.RegisterType((*DenomPairVolume)(nil), "osmosis.poolmanager.v1beta1.DenomPairVolume")
+	proto.RegisterType((*DenomPairHourlyVolume)(nil), "osmosis.poolmanager.v1beta1.DenomPairHourlyVolume")
+	proto.RegisterType((*PoolTypeVolume)(nil), "osmosis.poolmanager.v1beta1.PoolTypeVolume")
 }
 
 func init() {
@@ -467,75 +915,106 @@ func init() {
 }
 
 var fileDescriptor_aa099d9fbdf68b35 = []byte{
-	// 1085 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0xbb, 0xe9, 0xa2, 0x4c, 0x42, 0x92, 0x0e, 0xa4, 0x71, 0x93, 0xb2, 0x5e, 0xb9, 0x95,
-	0x58, 0x84, 0x62, 0x93, 0x20, 0x15, 0x09, 0xe8, 0x21, 0x9b, 0x28, 0x08, 0x54, 0xda, 0xd4, 0x89,
-	0x40, 0x2a, 0x07, 0x6b, 0xd6, 0x7e, 0xf1, 0x5a, 0x6b, 0x7b, 0x96, 0x99, 0x71, 0xfe, 0x70, 0xe0,
-	0x0b, 0x54, 0x48, 0x48, 0xbd, 0x72, 0xe6, 0xc0, 0x8d, 0x6f, 0xd1, 0x63, 0x8f, 0x88, 0xc3, 0x16,
-	0x6d, 0xce, 0x5c, 0xf6, 0x13, 0x20, 0xcf, 0xcc, 0xfe, 0x6d, 0xb2, 0x84, 0x3f, 0x3d, 0xed, 0xfa,
-	0xbd, 0xdf, 0xfb, 0xf9, 0xf7, 0xde, 0x9b, 0x37, 0xcf, 0xe8, 0x3d, 0xca, 0x53, 0xca, 0x63, 0xee,
-	0xb6, 0x29, 0x4d, 0x52, 0x92, 0x91, 0x08, 0x98, 0x7b, 0xbc, 0xd9, 0x00, 0x41, 0x36, 0xdd, 0x08,
-	0x32, 0xe0, 0x31, 0x77, 0xda, 0x8c, 0x0a, 0x8a, 0xd7, 0x35, 0xd4, 0x19, 0x81, 0x3a, 0x1a, 0xba,
-	0xf6, 0x76, 0x44, 0x23, 0x2a, 0x71, 0x6e, 0xf1, 0x4f, 0x85, 0xac, 0xdd, 0x8a, 0x28, 0x8d, 0x12,
-	0x70, 0xe5, 0x53, 0x23, 0x3f, 0x72, 0x49, 0x76, 0xd6, 0x77, 0x05, 0x92, 0xce, 0x57, 0x31, 0xea,
-	0x41, 0xbb, 0x2a, 0x93, 0x51, 0x61, 0xce, 0x88, 0x88, 0x69, 0xd6, 0xf7, 0x2b, 0xb4, 0xdb, 0x20,
-	0x1c, 0x06, 0x5a, 0x03, 0x1a, 0xf7, 0xfd, 0xce, 0xb4, 0x9c, 0x52, 0x1a, 0xe6, 0x09, 0xf8, 0x8c,
-	0xe6, 0x02, 0x34, 0xfe, 0xee, 0x34, 0xbc, 0x38, 0x55, 0x28, 0xbb, 0x77, 0x0d, 0x95, 0xf7, 0x09,
-	0x23, 0x29, 0xc7, 0xcf, 0x0c, 0x74, 0xa3, 0xc0, 0xfa, 0x01, 0x03, 0x29, 0xcc, 0x3f, 0x02, 0x30,
-	0x8d, 0x6a, 0xa9, 0x36, 0xbf, 0x75, 0xcb, 0xd1, 0xb9, 0x14, 0xea, 0xfa, 0xe5, 0x71, 0x76, 0x68,
-	0x9c, 0xd5, 0x1f, 0x3c, 0xef, 0x58, 0x33, 0xbd, 0x8e, 0x65, 0x9e, 0x91, 0x34, 0xf9, 0xd8, 0x7e,
-	0x85, 0xc1, 0xfe, 0xe5, 0xa5, 0x55, 0x8b, 0x62, 0xd1, 0xcc, 0x1b, 0x4e, 0x40, 0x53, 0x5d, 0x14,
-	0xfd, 0xb3, 0xc1, 0xc3, 0x96, 0x2b, 0xce, 0xda, 0xc0, 0x25, 0x19, 0xf7, 0x96, 0x8a, 0xf8, 0x1d,
-	0x1d, 0xbe, 0x07, 0x80, 0x8f, 0xd1, 0xb2, 0x20, 0x2d, 0x60, 0x05, 0x95, 0xdf, 0x96, 0x4a, 0xcd,
-	0x6b, 0x55, 0xa3, 0x36, 0xbf, 0xf5, 0xbe, 0x33, 0xa5, 0x75, 0xce, 0x61, 0x11, 0xb4, 0x07, 0xa0,
-	0x92, 0xab, 0x5b, 0x5a, 0xe5, 0xaa, 0x52, 0x39, 0x49, 0x69, 0x7b, 0x8b, 0x62, 0x2c, 0x00, 0x3f,
-	0x41, 0xab, 0x24, 0x17, 0x4d, 0xca, 0xe2, 0xef, 0x20, 0xf4, 0xbf, 0xcd, 0xa9, 0x00, 0x3f, 0x84,
-	0x8c, 0xa6, 0xdc, 0x2c, 0x55, 0x4b, 0xb5, 0xb9, 0xba, 0xdd, 0xeb, 0x58, 0x15, 0xc5, 0x76, 0x09,
-	0xd0, 0xf6, 0x56, 0x86, 0x9e, 0xc7, 0x85, 0x63, 0x57, 0xd9, 0x5f, 0x96, 0xd0, 0xc2, 0x67, 0xea,
-	0x14, 0x1e, 0x08, 0x22, 0x00, 0x57, 0xd1, 0x42, 0x06, 0xa7, 0xc2, 0x97, 0xc5, 0x8b, 0x43, 0xd3,
-	0xa8, 0x1a, 0xb5, 0x59, 0x0f, 0x15, 0xb6, 0x7d, 0x4a, 0x93, 0xcf, 0x43, 0xbc, 0x8d, 0xca, 0x63,
-	0xc9, 0xdf, 0x99, 0x9a, 0xbc, 0x4e, 0x7a, 0xb6, 0x48, 0xda, 0xd3, 0x81, 0xf8, 0x11, 0x9a, 0x97,
-	0xfc, 0xf2, 0x90, 0xa8, 0x2c, 0xe6, 0xb7, 0x6a, 0x53, 0x79, 0xbe, 0x94, 0xc7, 0xca, 0x2b, 0x02,
-	0x34, 0x19, 0x2a, 0x60, 0xd2, 0xc0, 0xf1, 0x37, 0x08, 0x0f, 0xea, 0xc8, 0x7d, 0xc1, 0x48, 0xd0,
-	0x02, 0x66, 0xce, 0x4a, 0x7d, 0x1b, 0x57, 0x6a, 0x0e, 0x3f, 0x54, 0x41, 0xde, 0xb2, 0x98, 0xb0,
-	0xe0, 0x2f, 0xd0, 0x82, 0x54, 0x7b, 0x4c, 0x93, 0x3c, 0x05, 0x6e, 0x5e, 0x97, 0x72, 0xdf, 0x9d,
-	0x9e, 0x36, 0xa5, 0xc9, 0x57, 0x12, 0xef, 0xc9, 0x54, 0xd5, 0x7f, 0x8e, 0xdb, 0x68, 0x4d, 0x76,
-	0xc4, 0x6f, 0x93, 0x98, 0xf9, 0xc3, 0xde, 0x73, 0x41, 0x19, 0x98, 0x65, 0xc9, 0xec, 0x4c, 0x65,
-	0x96, 0x8d, 0xdb, 0x27, 0x31, 0xeb, 0x2b, 0xd7, 0xe5, 0xb8, 0x19, 0x4e, 0x3a, 0x0e, 0x0a, 0x4e,
-	0xfb, 0x69, 0x19, 0x2d, 0x8e, 0x9f, 0x40, 0xdc, 0x40, 0x37, 0x42, 0x38, 0x22, 0x79, 0x22, 0x86,
-	0x0a, 0x64, 0xa3, 0xe7, 0xea, 0xf7, 0x0a, 0xae, 0xdf, 0x3b, 0xd6, 0xba, 0x1a, 0x0a, 0x1e, 0xb6,
-	0x9c, 0x98, 0xba, 0x29, 0x11, 0x4d, 0xe7, 0x01, 0x44, 0x24, 0x38, 0xdb, 0x85, 0xa0, 0xdb, 0xb1,
-	0x96, 0x76, 0x55, 0x7c, 0x9f, 0xd8, 0x5b, 0x0a, 0xc7, 0x0d, 0xf8, 0x27, 0x03, 0xc9, 0xfb, 0x6c,
-	0x24, 0xc7, 0x30, 0xe6, 0x82, 0xc5, 0x8d, 0xbc, 0x98, 0x27, 0x7d, 0x76, 0x3e, 0xb9, 0x52, 0x6f,
-	0x76, 0x47, 0x02, 0xf7, 0x81, 0x05, 0x90, 0x09, 0x12, 0x41, 0xbd, 0x5a, 0x68, 0xed, 0x76, 0x2c,
-	0xf3, 0x11, 0x4f, 0xe9, 0x45, 0x58, 0xcf, 0xa4, 0x97, 0x78, 0xf0, 0xcf, 0x06, 0xb2, 0x32, 0x9a,
-	0xf9, 0xd3, 0x24, 0x96, 0xfe, 0xbb, 0xc4, 0x3b, 0x5a, 0xe2, 0xfa, 0x43, 0x9a, 0x5d, 0xaa, 0x72,
-	0x3d, 0xbb, 0xdc, 0x89, 0x77, 0xd0, 0x12, 0x09, 0xd3, 0x38, 0xf3, 0x49, 0x18, 0x32, 0xe0, 0x1c,
-	0xb8, 0x39, 0x2b, 0x87, 0x7e, 0xad, 0xd7, 0xb1, 0x6e, 0xea, 0xa1, 0x1f, 0x07, 0xd8, 0xde, 0xa2,
-	0xb4, 0x6c, 0xf7, 0x0d, 0xf8, 0x57, 0x03, 0xdd, 0x0b, 0x68, 0x9a, 0xe6, 0x59, 0x2c, 0xce, 0xd4,
-	0x68, 0xab, 0x53, 0x28, 0xa8, 0xcf, 0x4f, 0x48, 0xdb, 0x2f, 0x4a, 0x71, 0xd2, 0x8c, 0x05, 0x24,
-	0x31, 0x17, 0x10, 0xfa, 0x84, 0x73, 0x10, 0xdc, 0x17, 0xd4, 0xbc, 0x2e, 0x8f, 0xc5, 0x76, 0xaf,
-	0x63, 0xdd, 0x57, 0x2f, 0xfb, 0x77, 0x3c, 0xb6, 0xe7, 0x0c, 0x02, 0x8b, 0xd9, 0x90, 0xa7, 0xf8,
-	0x90, 0x1e, 0x9c, 0x90, 0xf6, 0x43, 0x9a, 0x7d, 0x3d, 0x0c, 0xd9, 0x96, 0x11, 0x87, 0x14, 0x1f,
-	0xa2, 0x15, 0x06, 0x61, 0x1e, 0x40, 0x28, 0x3b, 0x33, 0x60, 0x95, 0x43, 0x32, 0x57, 0xaf, 0xf6,
-	0x3a, 0xd6, 0x6d, 0xa5, 0xe8, 0x42, 0x98, 0xed, 0xbd, 0xa5, 0xed, 0x7b, 0x00, 0x03, 0x7e, 0xfb,
-	0x4f, 0x03, 0x55, 0xa6, 0xf7, 0x0c, 0x1f, 0xa1, 0x25, 0x2e, 0x48, 0x2b, 0xce, 0x22, 0x9f, 0xc1,
-	0x09, 0x61, 0x21, 0xd7, 0xb3, 0x71, 0xff, 0x0a, 0xb3, 0x31, 0x6c, 0xca, 0x04, 0x87, 0xed, 0x2d,
-	0x6a, 0x8b, 0xa7, 0x0c, 0x38, 0x40, 0x8b, 0xe3, 0xb5, 0x94, 0x33, 0x31, 0x57, 0xff, 0xf4, 0x6a,
-	0xaf, 0x59, 0xb9, 0xa8, 0x1d, 0xb6, 0xf7, 0xe6, 0x58, 0x99, 0xed, 0x1f, 0x4a, 0x68, 0x79, 0xf2,
-	0x8a, 0xc3, 0xdf, 0xa3, 0x95, 0xd1, 0xdb, 0x92, 0xfa, 0x5c, 0x3e, 0xf2, 0xbf, 0xdf, 0xb0, 0x1f,
-	0x14, 0xda, 0xfe, 0xd1, 0x16, 0xc5, 0xc3, 0xeb, 0x94, 0x1e, 0xa8, 0xd7, 0xe0, 0xa7, 0x06, 0xba,
-	0x3d, 0x2e, 0xe0, 0x95, 0x42, 0xfc, 0xef, 0x3a, 0xcc, 0x11, 0x1d, 0x3b, 0xa3, 0x25, 0xc2, 0x2d,
-	0xf4, 0x4e, 0x13, 0xe2, 0xa8, 0x29, 0x7c, 0x12, 0x04, 0x34, 0xcf, 0x44, 0xd1, 0x35, 0x2e, 0x08,
-	0x13, 0xdc, 0x3f, 0x62, 0x34, 0x95, 0xf7, 0x40, 0xa9, 0x5e, 0xeb, 0x75, 0xac, 0xbb, 0xaa, 0xe6,
-	0x53, 0xe1, 0xb6, 0xb7, 0xa6, 0xfc, 0xdb, 0x03, 0xf7, 0x81, 0xf4, 0xee, 0x15, 0xce, 0x67, 0x06,
-	0x42, 0xc3, 0xdd, 0x80, 0x57, 0xd1, 0x1b, 0xe3, 0x8b, 0xb6, 0xdc, 0x56, 0x4b, 0x36, 0xd1, 0x1b,
-	0x52, 0xed, 0x9c, 0xd7, 0x51, 0x10, 0x34, 0x5c, 0x4b, 0xf5, 0xc7, 0xcf, 0xbb, 0x15, 0xe3, 0x45,
-	0xb7, 0x62, 0xfc, 0xd1, 0xad, 0x18, 0x3f, 0x9e, 0x57, 0x66, 0x5e, 0x9c, 0x57, 0x66, 0x7e, 0x3b,
-	0xaf, 0xcc, 0x3c, 0xf9, 0x68, 0x84, 0x4f, 0xdf, 0x83, 0x1b, 0x09, 0x69, 0xf0, 0xfe, 0x83, 0x7b,
-	0xbc, 0xb5, 0xe9, 0x9e, 0x8e, 0x7d, 0xd8, 0xc9, 0x97, 0x34, 0xca, 0xf2, 0xa3, 0xee, 0xc3, 0xbf,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xd5, 0xc5, 0xf0, 0xa5, 0x00, 0x0b, 0x00, 0x00,
+	// 1583 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xb5, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xaf, 0xeb, 0xd4, 0x89, 0x27, 0x69, 0x9c, 0x4c, 0x9b, 0xc4, 0xf9, 0x20, 0x49, 0xb7, 0x45,
+	0xa4, 0x2a, 0xb5, 0xeb, 0x20, 0xb5, 0x12, 0xd0, 0x43, 0x36, 0x51, 0x3f, 0x50, 0x3f, 0xd2, 0x8d,
+	0x05, 0x52, 0x11, 0x5a, 0xd6, 0xde, 0x89, 0xbd, 0xd4, 0xde, 0x31, 0x3b, 0xb3, 0x69, 0x82, 0x04,
+	0xff, 0x00, 0x42, 0x42, 0x42, 0x1c, 0x90, 0x38, 0x83, 0xc4, 0x8d, 0x03, 0x07, 0x6e, 0x1c, 0x7b,
+	0xec, 0x01, 0xa1, 0x8a, 0x43, 0x41, 0xe5, 0xcc, 0x85, 0xbf, 0x80, 0x37, 0x1f, 0xbb, 0xde, 0x75,
+	0x12, 0xc7, 0x85, 0xf6, 0xb0, 0xca, 0xee, 0xfb, 0xfc, 0xbd, 0x37, 0xef, 0xbd, 0x79, 0x0e, 0x3a,
+	0x4f, 0x59, 0x9b, 0x32, 0x8f, 0x95, 0x3b, 0x94, 0xb6, 0xda, 0x8e, 0xef, 0x34, 0x48, 0x50, 0xde,
+	0xa9, 0xd4, 0x08, 0x77, 0x2a, 0xe5, 0x06, 0xf1, 0x09, 0xf0, 0x4a, 0x9d, 0x80, 0x72, 0x8a, 0xe7,
+	0xb5, 0x68, 0x29, 0x21, 0x5a, 0xd2, 0xa2, 0x73, 0xa7, 0x1b, 0xb4, 0x41, 0xa5, 0x5c, 0x59, 0xbc,
+	0x29, 0x95, 0xb9, 0xd9, 0x06, 0xa5, 0x8d, 0x16, 0x29, 0xcb, 0xaf, 0x5a, 0xb8, 0x5d, 0x76, 0xfc,
+	0xbd, 0x88, 0x55, 0x97, 0xe6, 0x6c, 0xa5, 0xa3, 0x3e, 0x34, 0x6b, 0xb1, 0x57, 0xcb, 0x0d, 0x03,
+	0x87, 0x7b, 0xd4, 0x8f, 0xf8, 0x4a, 0xba, 0x5c, 0x73, 0x18, 0x89, 0xb1, 0xd6, 0xa9, 0x17, 0xf1,
+	0x4b, 0xfd, 0x62, 0x6a, 0x53, 0x37, 0x6c, 0x11, 0x3b, 0xa0, 0x21, 0x27, 0x5a, 0xfe, 0x5c, 0x3f,
+	0x79, 0xbe, 0xab, 0xa4, 0x8c, 0xdf, 0x4e, 0xa0, 0xdc, 0xa6, 0x13, 0x38, 0x6d, 0x86, 0xbf, 0xca,
+	0xa0, 0x49, 0x21, 0x6b, 0xd7, 0x03, 0x22, 0x81, 0xd9, 0xdb, 0x84, 0x14, 0x33, 0xcb, 0xd9, 0x95,
+	0xd1, 0xd5, 0xd9, 0x92, 0x8e, 0x45, 0xa0, 0x8b, 0xd2, 0x53, 0x5a, 0x07, 0x74, 0xe6, 0xad, 0x47,
+	0x4f, 0x97, 0x8e, 0xfd, 0xf3, 0x74, 0xa9, 0xb8, 0xe7, 0xb4, 0x5b, 0x6f, 0x1a, 0xfb, 0x2c, 0x18,
+	0x3f, 0xfc, 0xb1, 0xb4, 0xd2, 0xf0, 0x78, 0x33, 0xac, 0x81, 0x91, 0xb6, 0x4e, 0x8a, 0xfe, 0x73,
+	0x91, 0xb9, 0x0f, 0xca, 0x7c, 0xaf, 0x43, 0x98, 0x34, 0xc6, 0xac, 0x82, 0xd0, 0x5f, 0xd7, 0xea,
+	0xd7, 0x08, 0xc1, 0x3b, 0x68, 0x82, 0x3b, 0x0f, 0x48, 0x20, 0x4c, 0xd9, 0x1d, 0x89, 0xb4, 0x78,
+	0x7c, 0x39, 0x03, 0x98, 0x2e, 0x94, 0xfa, 0x1c, 0x5d, 0xa9, 0x2a, 0x94, 0xc0, 0x80, 0x0a, 0xce,
+	0x5c, 0xd2, 0x28, 0x67, 0x14, 0xca, 0x5e, 0x93, 0x86, 0x35, 0xce, 0x53, 0x0a, 0xf8, 0x3e, 0x9a,
+	0x71, 0x42, 0xde, 0xa4, 0x81, 0xf7, 0x09, 0x71, 0xed, 0x8f, 0x43, 0xca, 0x89, 0xed, 0x12, 0x9f,
+	0x82, 0xfb, 0x2c, 0xa4, 0x24, 0x6f, 0x1a, 0x60, 0x6d, 0x51, 0x59, 0x3b, 0x44, 0xd0, 0xb0, 0xa6,
+	0xba, 0x9c, 0x7b, 0x82, 0xb1, 0x21, 0xe9, 0xf8, 0x23, 0x74, 0x52, 0x4a, 0xd8, 0x4e, 0xcb, 0x83,
+	0x7c, 0xb2, 0xe2, 0x90, 0x4c, 0xf2, 0x6b, 0x7d, 0x03, 0x92, 0xba, 0x6b, 0x42, 0xc1, 0x5c, 0xd0,
+	0xc1, 0x9c, 0x56, 0xee, 0x53, 0xb6, 0x0c, 0x6b, 0xcc, 0x8d, 0x25, 0x09, 0xc3, 0x25, 0x34, 0xd2,
+	0x76, 0x76, 0xed, 0x26, 0xed, 0xb0, 0xe2, 0x09, 0xc8, 0xdb, 0x90, 0x79, 0x0a, 0x34, 0x0b, 0x4a,
+	0x33, 0xe2, 0x18, 0xd6, 0x30, 0xbc, 0xde, 0x80, 0x37, 0x7c, 0x1d, 0x4d, 0x0a, 0xaa, 0xac, 0x24,
+	0xa8, 0x63, 0xc8, 0x12, 0xdf, 0x2d, 0xe6, 0xa4, 0xe2, 0x42, 0xf7, 0x94, 0xf7, 0x89, 0x40, 0x02,
+	0x81, 0x66, 0x49, 0xd2, 0x26, 0x09, 0xaa, 0xbb, 0xf8, 0x53, 0x84, 0x3b, 0x4e, 0xc8, 0x20, 0x27,
+	0x0a, 0x5f, 0xc7, 0xf1, 0x02, 0x56, 0x1c, 0x96, 0x91, 0xbe, 0xde, 0x37, 0xd2, 0x4d, 0xa9, 0x26,
+	0xe3, 0xdd, 0x04, 0x25, 0xf3, 0x8c, 0x0e, 0x77, 0x56, 0x57, 0xd8, 0x3e, 0xab, 0x86, 0x35, 0xd1,
+	0x49, 0xeb, 0x30, 0xe3, 0xeb, 0x1c, 0x1a, 0xbb, 0xae, 0x3a, 0x7d, 0x8b, 0x3b, 0x9c, 0xe0, 0x65,
+	0x34, 0xe6, 0x93, 0x5d, 0x6e, 0xcb, 0x02, 0xf5, 0x5c, 0x28, 0x6c, 0x88, 0xc9, 0x42, 0x82, 0xb6,
+	0x09, 0xa4, 0x9b, 0x2e, 0x5e, 0x43, 0xb9, 0x54, 0x81, 0x9d, 0x3d, 0x02, 0xa5, 0x2c, 0xac, 0x21,
+	0x01, 0xce, 0xd2, 0x8a, 0xf8, 0x2e, 0x1a, 0x95, 0xf6, 0x55, 0x6e, 0x64, 0xa5, 0x8c, 0xae, 0xae,
+	0xf4, 0xb5, 0x73, 0x5b, 0xb6, 0xae, 0xcc, 0x9c, 0x36, 0x86, 0x84, 0x98, 0x4a, 0x25, 0x7e, 0x1f,
+	0xe1, 0xb8, 0x56, 0x99, 0xcd, 0x03, 0xa7, 0x0e, 0x1f, 0x50, 0x2f, 0x02, 0xdf, 0xc5, 0x81, 0x1a,
+	0x80, 0x55, 0x95, 0x92, 0x35, 0xc1, 0x7b, 0x28, 0xf8, 0x1d, 0x34, 0x26, 0xd1, 0xee, 0xd0, 0x56,
+	0xd8, 0x26, 0xa2, 0x3e, 0x8e, 0x2e, 0x43, 0x91, 0xab, 0x77, 0xa5, 0xbc, 0x25, 0x43, 0x55, 0xef,
+	0x0c, 0x77, 0xd0, 0x5c, 0xf7, 0x44, 0xec, 0x6e, 0x7f, 0x31, 0x4e, 0x03, 0x02, 0x05, 0x24, 0x2c,
+	0x97, 0x8e, 0x2e, 0x70, 0x71, 0x78, 0x11, 0x72, 0x9d, 0x8e, 0x69, 0xb7, 0x97, 0xb1, 0x25, 0x6c,
+	0xe2, 0x0f, 0x11, 0x4e, 0x78, 0x8c, 0x62, 0x18, 0xa4, 0xc0, 0x62, 0x4f, 0x0a, 0xbc, 0xf6, 0x33,
+	0xe1, 0xa6, 0xc9, 0x0c, 0x33, 0x34, 0x9b, 0xf0, 0xd0, 0xa4, 0x61, 0xd0, 0xda, 0x8b, 0x1d, 0x8d,
+	0x48, 0x47, 0xab, 0x83, 0x39, 0xba, 0x21, 0x75, 0x53, 0xee, 0xba, 0x61, 0x25, 0x99, 0x0c, 0x7f,
+	0xa0, 0xa7, 0xb0, 0x98, 0x8a, 0xb1, 0xb3, 0xbc, 0x74, 0x76, 0xe1, 0xc8, 0x93, 0xa9, 0x82, 0x52,
+	0xca, 0x8b, 0x9c, 0xa7, 0x5d, 0x2a, 0x33, 0xbe, 0x1f, 0x46, 0xe3, 0xe9, 0xd9, 0x88, 0x6b, 0x68,
+	0xd2, 0x25, 0xdb, 0x4e, 0xd8, 0xe2, 0xdd, 0x73, 0x93, 0xed, 0x91, 0x37, 0x2f, 0x0b, 0x23, 0xbf,
+	0x3f, 0x5d, 0x9a, 0x57, 0xe3, 0x1a, 0xa6, 0x75, 0xc9, 0xa3, 0xe5, 0xb6, 0xc3, 0x9b, 0xa5, 0x5b,
+	0xa4, 0xe1, 0xd4, 0xf7, 0x36, 0x48, 0xfd, 0x19, 0x8c, 0x93, 0x0d, 0xa5, 0x1f, 0x19, 0xb6, 0x0a,
+	0x6e, 0x9a, 0x80, 0xbf, 0xcd, 0x20, 0x79, 0xd3, 0x26, 0x2a, 0xc3, 0xf5, 0x18, 0x0f, 0xbc, 0x5a,
+	0x28, 0x26, 0xbd, 0xee, 0xb8, 0xb7, 0x06, 0xaa, 0xe8, 0x8d, 0x84, 0x22, 0xcc, 0x9a, 0x3a, 0xf1,
+	0x39, 0xc8, 0x99, 0xcb, 0x02, 0x2b, 0x80, 0x29, 0xde, 0x05, 0x1b, 0x07, 0xc9, 0x5a, 0x45, 0x7a,
+	0x08, 0x07, 0x7f, 0x97, 0x41, 0x4b, 0x3e, 0xdc, 0x57, 0xfd, 0x20, 0x66, 0xff, 0x3f, 0xc4, 0xb3,
+	0x1a, 0xe2, 0xfc, 0x1d, 0xea, 0x1f, 0x8a, 0x72, 0xde, 0x3f, 0x9c, 0x89, 0xd7, 0x51, 0xc1, 0x71,
+	0xdb, 0x9e, 0x6f, 0x3b, 0xae, 0x1b, 0x10, 0x16, 0x5d, 0x1e, 0x79, 0x73, 0x0e, 0x06, 0xe4, 0xb4,
+	0xbe, 0x8e, 0xd2, 0x02, 0x30, 0x9a, 0x25, 0x65, 0x2d, 0x22, 0xe0, 0x1f, 0x33, 0xe8, 0x32, 0x5c,
+	0xc5, 0xed, 0xd0, 0xf7, 0xf8, 0x9e, 0x1a, 0x88, 0xaa, 0xce, 0x39, 0xb5, 0xd9, 0x43, 0xa7, 0x63,
+	0x8b, 0x54, 0x3c, 0x6c, 0x7a, 0x9c, 0xb4, 0xc0, 0x37, 0xcc, 0x5a, 0x07, 0xd4, 0x38, 0x8c, 0x1f,
+	0x2a, 0xaf, 0x90, 0xbc, 0xb9, 0x06, 0xce, 0xae, 0x2a, 0x67, 0xff, 0xcd, 0x8e, 0x61, 0x95, 0x62,
+	0x45, 0x51, 0xb7, 0xb2, 0x51, 0xaa, 0x74, 0x0b, 0x94, 0x20, 0x35, 0xef, 0x75, 0x55, 0xd6, 0xa4,
+	0x46, 0x95, 0xe2, 0x2a, 0x9a, 0x0a, 0x88, 0x1b, 0xd6, 0xc1, 0x8a, 0x38, 0x99, 0xd8, 0xaa, 0x1c,
+	0x2d, 0x79, 0x73, 0x19, 0x10, 0x2d, 0x28, 0x44, 0x07, 0x8a, 0x19, 0xd6, 0x29, 0x4d, 0x87, 0x8c,
+	0xc6, 0xf6, 0xf1, 0x37, 0x19, 0x34, 0xc7, 0xc4, 0x79, 0xbb, 0xea, 0xe8, 0xe1, 0xc0, 0xeb, 0x34,
+	0xf4, 0xa1, 0x11, 0x3c, 0x12, 0xdf, 0x56, 0x95, 0x41, 0x8f, 0x5c, 0xaa, 0x56, 0x41, 0xd3, 0x3c,
+	0xaf, 0xaf, 0xac, 0x33, 0x0a, 0xd2, 0xe1, 0x2e, 0x0c, 0x6b, 0x46, 0x31, 0xc5, 0x89, 0x27, 0x4d,
+	0x30, 0xe3, 0xef, 0x0c, 0x5a, 0xec, 0x5f, 0x4f, 0x78, 0x1b, 0x15, 0x84, 0xb6, 0xe7, 0x37, 0xec,
+	0x80, 0x3c, 0x74, 0x02, 0x97, 0xe9, 0xbe, 0xbd, 0x3a, 0x40, 0xdf, 0x76, 0x0b, 0xa6, 0xc7, 0x06,
+	0x14, 0x8c, 0xa6, 0x58, 0x8a, 0x80, 0xeb, 0x68, 0x3c, 0x7d, 0xce, 0xb2, 0x5f, 0xf3, 0xe6, 0xdb,
+	0x83, 0xb9, 0x99, 0x3a, 0xa8, 0x54, 0x0c, 0xeb, 0x64, 0xaa, 0x04, 0x8c, 0x2f, 0xb2, 0x68, 0xa2,
+	0xf7, 0xd2, 0xc2, 0x9f, 0xa1, 0xa9, 0xe4, 0xfd, 0x07, 0x75, 0x25, 0x3f, 0xd9, 0xd1, 0x7b, 0xe9,
+	0x25, 0x81, 0xed, 0xb9, 0x76, 0x4f, 0xdc, 0xbd, 0x20, 0xe9, 0x96, 0x72, 0x83, 0x3f, 0xcf, 0xa0,
+	0x85, 0x34, 0x80, 0x7d, 0x89, 0x78, 0xe1, 0x38, 0x8a, 0x09, 0x1c, 0xeb, 0xc9, 0x14, 0xe1, 0x07,
+	0xe8, 0x95, 0x26, 0xf1, 0x1a, 0x4d, 0x6e, 0x3b, 0x75, 0x59, 0x29, 0xe2, 0xd4, 0x20, 0x23, 0x01,
+	0x34, 0xd5, 0x76, 0x40, 0xdb, 0x72, 0x46, 0x65, 0xcd, 0x15, 0xc8, 0xf9, 0x39, 0x95, 0xf3, 0xbe,
+	0xe2, 0x86, 0x35, 0xa7, 0xf8, 0x6b, 0x31, 0x7b, 0x4b, 0x72, 0xaf, 0x09, 0x26, 0xfc, 0x1e, 0x40,
+	0xdd, 0xdb, 0x1e, 0xcf, 0xa0, 0xe1, 0xf4, 0xea, 0x94, 0xeb, 0xa8, 0xb5, 0xa9, 0xa5, 0x77, 0x1e,
+	0x75, 0x57, 0xbd, 0x8c, 0x84, 0xa0, 0xee, 0xa2, 0x61, 0xfc, 0x94, 0x41, 0xa7, 0x0f, 0x6a, 0x39,
+	0x7c, 0x1b, 0x21, 0x31, 0xf6, 0x54, 0x37, 0xe9, 0x36, 0x28, 0x41, 0x6d, 0x4e, 0xed, 0xaf, 0xcd,
+	0x9b, 0x3e, 0x87, 0x0c, 0x4d, 0xea, 0x55, 0x36, 0x56, 0x32, 0xac, 0x3c, 0x7c, 0xc8, 0x93, 0x77,
+	0xf1, 0x1d, 0x34, 0x12, 0x75, 0xaa, 0x2e, 0xf6, 0xd5, 0xa3, 0x0b, 0x5d, 0xaf, 0xd5, 0x91, 0xa2,
+	0x61, 0xc5, 0x36, 0x8c, 0x5f, 0x21, 0x9b, 0xdd, 0x15, 0x1e, 0x5f, 0x41, 0xa3, 0x72, 0x61, 0x57,
+	0x03, 0x53, 0xc3, 0x9d, 0x06, 0x13, 0x58, 0xcf, 0xf0, 0x2e, 0xd3, 0xb0, 0x90, 0xfc, 0x92, 0xda,
+	0xe2, 0x02, 0xa8, 0x3b, 0x30, 0x57, 0xbd, 0xba, 0xa3, 0xa7, 0xad, 0x86, 0x97, 0xb8, 0x00, 0x7a,
+	0x04, 0xa0, 0x9f, 0x63, 0x8a, 0x32, 0x72, 0x03, 0x4d, 0xd6, 0xa9, 0xbf, 0x43, 0x02, 0x0e, 0x85,
+	0x1d, 0x9d, 0x6a, 0xb6, 0x77, 0xc9, 0xdf, 0x27, 0x62, 0x58, 0x85, 0x98, 0xa6, 0x76, 0x66, 0xa3,
+	0x81, 0x0a, 0x3d, 0xeb, 0x3a, 0x3e, 0x8f, 0x72, 0xd2, 0xed, 0x25, 0x1d, 0xd5, 0x24, 0x58, 0x3c,
+	0x99, 0xf8, 0xa5, 0x72, 0xc9, 0xb0, 0xb4, 0x40, 0x2c, 0x5a, 0xd1, 0x31, 0xf4, 0x8a, 0x56, 0x22,
+	0xd1, 0x8a, 0x01, 0x37, 0x74, 0xa1, 0x67, 0x6f, 0xc3, 0xd3, 0x69, 0x4f, 0xb1, 0xd9, 0xe9, 0xb4,
+	0xd9, 0xc8, 0x06, 0x8c, 0xb1, 0x9c, 0x2e, 0xd2, 0xec, 0x8b, 0x2f, 0x52, 0x6d, 0xda, 0xf8, 0x25,
+	0x83, 0xa6, 0x0e, 0xdc, 0xfb, 0x9e, 0x1b, 0x2e, 0x46, 0x43, 0x62, 0xe7, 0x54, 0x07, 0x63, 0xc9,
+	0xf7, 0x44, 0x08, 0x43, 0x2f, 0x2f, 0x84, 0x9f, 0x33, 0x68, 0x3c, 0xbd, 0x4d, 0x62, 0x13, 0xe5,
+	0xe3, 0xad, 0x54, 0xc2, 0x1f, 0x5f, 0x7d, 0x75, 0xa0, 0x6d, 0xd4, 0x1a, 0x89, 0x36, 0xd0, 0x04,
+	0xf6, 0xe3, 0x2f, 0x0d, 0xbb, 0x79, 0xef, 0xd1, 0xb3, 0xc5, 0xcc, 0x63, 0x78, 0xfe, 0x84, 0xe7,
+	0xcb, 0xbf, 0x16, 0x8f, 0x3d, 0x86, 0xe7, 0x09, 0x3c, 0xf7, 0xaf, 0x24, 0x6c, 0x69, 0xe4, 0x17,
+	0x5b, 0x4e, 0x8d, 0x45, 0x1f, 0xe5, 0x9d, 0xd5, 0x4a, 0x79, 0x37, 0xf5, 0xef, 0x12, 0xe9, 0xa0,
+	0x96, 0x93, 0xff, 0x2a, 0x79, 0xe3, 0x5f, 0x09, 0x7c, 0xd2, 0x4f, 0x56, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -558,22 +1037,60 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.AuthorizedQuoteDenoms) > 0 {
-		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
-			copy(dAtA[i:], m.AuthorizedQuoteDenoms[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuthorizedQuoteDenoms[iNdEx])))
+	if len(m.PausedDenomPairs) > 0 {
+		for iNdEx := len(m.PausedDenomPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedDenomPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x3a
 		}
 	}
-	{
-		size, err := m.TakerFeeParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	if m.MaxRoutesPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRoutesPerTx))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxHops != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DenomAliases) > 0 {
+		for iNdEx := len(m.DenomAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AuthorizedQuoteDenoms) > 0 {
+		for iNdEx := len(m.AuthorizedQuoteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuthorizedQuoteDenoms[iNdEx])
+			copy(dAtA[i:], m.AuthorizedQuoteDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AuthorizedQuoteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.TakerFeeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
@@ -614,6 +1131,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolTypeVolumes) > 0 {
+		for iNdEx := len(m.PoolTypeVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTypeVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DenomPairHourlyVolumes) > 0 {
+		for iNdEx := len(m.DenomPairHourlyVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairHourlyVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DenomPairVolumes) > 0 {
+		for iNdEx := len(m.DenomPairVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPairVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.DenomPairTakerFeeStore) > 0 {
 		for iNdEx := len(m.DenomPairTakerFeeStore) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -706,6 +1265,20 @@ func (m *TakerFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StakedOsmoDiscountTiers) > 0 {
+		for iNdEx := len(m.StakedOsmoDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakedOsmoDiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ReducedFeeWhitelist) > 0 {
 		for iNdEx := len(m.ReducedFeeWhitelist) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReducedFeeWhitelist[iNdEx])
@@ -905,140 +1478,453 @@ func (m *PoolVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TakerFeeDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TakerFeeDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakerFeeDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.TakerFeeParams.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.AuthorizedQuoteDenoms) > 0 {
-		for _, s := range m.AuthorizedQuoteDenoms {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinStaked.Size()
+		i -= size
+		if _, err := m.MinStaked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NextPoolId != 0 {
-		n += 1 + sovGenesis(uint64(m.NextPoolId))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.PoolRoutes) > 0 {
-		for _, e := range m.PoolRoutes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.TakerFeesTracker != nil {
-		l = m.TakerFeesTracker.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.ConverterPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ConverterPoolId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.PoolVolumes) > 0 {
-		for _, e := range m.PoolVolumes {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.CanonicalDenom) > 0 {
+		i -= len(m.CanonicalDenom)
+		copy(dAtA[i:], m.CanonicalDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.CanonicalDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.DenomPairTakerFeeStore) > 0 {
-		for _, e := range m.DenomPairTakerFeeStore {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.AliasDenom) > 0 {
+		i -= len(m.AliasDenom)
+		copy(dAtA[i:], m.AliasDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AliasDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PausedDenomPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedDenomPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedDenomPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.DefaultTakerFee.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.OsmoTakerFeeDistribution.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.NonOsmoTakerFeeDistribution.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.AdminAddresses) > 0 {
-		for _, s := range m.AdminAddresses {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	l = len(m.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ReducedFeeWhitelist) > 0 {
-		for _, s := range m.ReducedFeeWhitelist {
-			l = len(s)
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TakerFeeDistributionPercentage) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomPairVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.StakingRewards.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.CommunityPool.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TakerFeesTracker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DenomPairVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TakerFeesToStakers) > 0 {
-		for _, e := range m.TakerFeesToStakers {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.TakerFeesToCommunityPool) > 0 {
-		for _, e := range m.TakerFeesToCommunityPool {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.HeightAccountingStartsFrom != 0 {
-		n += 1 + sovGenesis(uint64(m.HeightAccountingStartsFrom))
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomPairHourlyVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPairHourlyVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPairHourlyVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Hour != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Hour))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom1) > 0 {
+		i -= len(m.Denom1)
+		copy(dAtA[i:], m.Denom1)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom1)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom0) > 0 {
+		i -= len(m.Denom0)
+		copy(dAtA[i:], m.Denom0)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom0)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolTypeVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolTypeVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolTypeVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Volume) > 0 {
+		for iNdEx := len(m.Volume) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Volume[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TakerFeeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AuthorizedQuoteDenoms) > 0 {
+		for _, s := range m.AuthorizedQuoteDenoms {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomAliases) > 0 {
+		for _, e := range m.DenomAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxHops))
+	}
+	if m.MaxRoutesPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRoutesPerTx))
+	}
+	if len(m.PausedDenomPairs) > 0 {
+		for _, e := range m.PausedDenomPairs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPoolId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRoutes) > 0 {
+		for _, e := range m.PoolRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TakerFeesTracker != nil {
+		l = m.TakerFeesTracker.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolVolumes) > 0 {
+		for _, e := range m.PoolVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPairTakerFeeStore) > 0 {
+		for _, e := range m.DenomPairTakerFeeStore {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPairVolumes) > 0 {
+		for _, e := range m.DenomPairVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomPairHourlyVolumes) > 0 {
+		for _, e := range m.DenomPairHourlyVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolTypeVolumes) > 0 {
+		for _, e := range m.PoolTypeVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakerFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DefaultTakerFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.OsmoTakerFeeDistribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.NonOsmoTakerFeeDistribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AdminAddresses) > 0 {
+		for _, s := range m.AdminAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = len(m.CommunityPoolDenomToSwapNonWhitelistedAssetsTo)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ReducedFeeWhitelist) > 0 {
+		for _, s := range m.ReducedFeeWhitelist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StakedOsmoDiscountTiers) > 0 {
+		for _, e := range m.StakedOsmoDiscountTiers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TakerFeeDistributionPercentage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StakingRewards.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *TakerFeesTracker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TakerFeesToStakers) > 0 {
+		for _, e := range m.TakerFeesToStakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakerFeesToCommunityPool) > 0 {
+		for _, e := range m.TakerFeesToCommunityPool {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HeightAccountingStartsFrom != 0 {
+		n += 1 + sovGenesis(uint64(m.HeightAccountingStartsFrom))
 	}
 	return n
 }
@@ -1058,16 +1944,1010 @@ func (m *PoolVolume) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *TakerFeeDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinStaked.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DenomAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AliasDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.CanonicalDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ConverterPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.ConverterPoolId))
+	}
+	return n
+}
+
+func (m *PausedDenomPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *DenomPairVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomPairHourlyVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom0)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom1)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Hour != 0 {
+		n += 1 + sovGenesis(uint64(m.Hour))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolTypeVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolType != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolType))
+	}
+	if len(m.Volume) > 0 {
+		for _, e := range m.Volume {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedQuoteDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomAliases = append(m.DenomAliases, DenomAlias{})
+			if err := m.DenomAliases[len(m.DenomAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoutesPerTx", wireType)
+			}
+			m.MaxRoutesPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoutesPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDenomPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedDenomPairs = append(m.PausedDenomPairs, PausedDenomPair{})
+			if err := m.PausedDenomPairs[len(m.PausedDenomPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+			}
+			m.NextPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
+			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TakerFeesTracker == nil {
+				m.TakerFeesTracker = &TakerFeesTracker{}
+			}
+			if err := m.TakerFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVolumes = append(m.PoolVolumes, &PoolVolume{})
+			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFeeStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairTakerFeeStore = append(m.DenomPairTakerFeeStore, DenomPairTakerFee{})
+			if err := m.DenomPairTakerFeeStore[len(m.DenomPairTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairVolumes = append(m.DenomPairVolumes, DenomPairVolume{})
+			if err := m.DenomPairVolumes[len(m.DenomPairVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairHourlyVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPairHourlyVolumes = append(m.DenomPairHourlyVolumes, DenomPairHourlyVolume{})
+			if err := m.DenomPairHourlyVolumes[len(m.DenomPairHourlyVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTypeVolumes = append(m.PoolTypeVolumes, PoolTypeVolume{})
+			if err := m.PoolTypeVolumes[len(m.PoolTypeVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DefaultTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoTakerFeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonOsmoTakerFeeDistribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NonOsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddresses = append(m.AdminAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolDenomToSwapNonWhitelistedAssetsTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPoolDenomToSwapNonWhitelistedAssetsTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReducedFeeWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReducedFeeWhitelist = append(m.ReducedFeeWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedOsmoDiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedOsmoDiscountTiers = append(m.StakedOsmoDiscountTiers, TakerFeeDiscountTier{})
+			if err := m.StakedOsmoDiscountTiers[len(m.StakedOsmoDiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1090,17 +2970,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeeDistributionPercentage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeeDistributionPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1110,62 +2990,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TakerFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedQuoteDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1193,7 +3040,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizedQuoteDenoms = append(m.AuthorizedQuoteDenoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1216,7 +3065,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1239,17 +3088,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakerFeesTracker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakerFeesTracker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesToStakers", wireType)
 			}
-			m.NextPoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1259,14 +3108,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextPoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakerFeesToStakers = append(m.TakerFeesToStakers, types.Coin{})
+			if err := m.TakerFeesToStakers[len(m.TakerFeesToStakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesToCommunityPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1293,15 +3157,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakerFeesToCommunityPool = append(m.TakerFeesToCommunityPool, types.Coin{})
+			if err := m.TakerFeesToCommunityPool[len(m.TakerFeesToCommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightAccountingStartsFrom", wireType)
 			}
-			var msglen int
+			m.HeightAccountingStartsFrom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1311,29 +3176,83 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HeightAccountingStartsFrom |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
-			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1360,18 +3279,66 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TakerFeesTracker == nil {
-				m.TakerFeesTracker = &TakerFeesTracker{}
-			}
-			if err := m.TakerFeesTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PoolVolume = append(m.PoolVolume, types.Coin{})
+			if err := m.PoolVolume[len(m.PoolVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakerFeeDiscountTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakerFeeDiscountTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakerFeeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolumes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStaked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1381,31 +3348,31 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolVolumes = append(m.PoolVolumes, &PoolVolume{})
-			if err := m.PoolVolumes[len(m.PoolVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinStaked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomPairTakerFeeStore", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1415,23 +3382,23 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomPairTakerFeeStore = append(m.DenomPairTakerFeeStore, DenomPairTakerFee{})
-			if err := m.DenomPairTakerFeeStore[len(m.DenomPairTakerFeeStore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1456,7 +3423,8 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
+
+func (m *DenomAlias) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,15 +3447,15 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomAlias: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomAlias: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultTakerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AliasDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1515,15 +3483,13 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DefaultTakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AliasDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsmoTakerFeeDistribution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1533,30 +3499,29 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanonicalDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonOsmoTakerFeeDistribution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConverterPoolId", wireType)
 			}
-			var msglen int
+			m.ConverterPoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1566,60 +3531,65 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConverterPoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenesis
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NonOsmoTakerFeeDistribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddresses", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenesis
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PausedDenomPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAddresses = append(m.AdminAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedDenomPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedDenomPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolDenomToSwapNonWhitelistedAssetsTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1647,11 +3617,11 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPoolDenomToSwapNonWhitelistedAssetsTo = string(dAtA[iNdEx:postIndex])
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReducedFeeWhitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1679,7 +3649,7 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReducedFeeWhitelist = append(m.ReducedFeeWhitelist, string(dAtA[iNdEx:postIndex]))
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1702,7 +3672,8 @@ func (m *TakerFeeParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
+
+func (m *DenomPairVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,15 +3696,15 @@ func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeeDistributionPercentage: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPairVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeeDistributionPercentage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPairVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1761,13 +3732,11 @@ func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1795,7 +3764,39 @@ func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1820,7 +3821,8 @@ func (m *TakerFeeDistributionPercentage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
+
+func (m *DenomPairHourlyVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1843,17 +3845,17 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TakerFeesTracker: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomPairHourlyVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TakerFeesTracker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomPairHourlyVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesToStakers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom0", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1863,31 +3865,29 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeesToStakers = append(m.TakerFeesToStakers, types.Coin{})
-			if err := m.TakerFeesToStakers[len(m.TakerFeesToStakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom0 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeesToCommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom1", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1897,31 +3897,29 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TakerFeesToCommunityPool = append(m.TakerFeesToCommunityPool, types.Coin{})
-			if err := m.TakerFeesToCommunityPool[len(m.TakerFeesToCommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeightAccountingStartsFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hour", wireType)
 			}
-			m.HeightAccountingStartsFrom = 0
+			m.Hour = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -1931,11 +3929,45 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeightAccountingStartsFrom |= int64(b&0x7F) << shift
+				m.Hour |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1957,7 +3989,8 @@ func (m *TakerFeesTracker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolVolume) Unmarshal(dAtA []byte) error {
+
+func (m *PoolTypeVolume) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1980,17 +4013,17 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolVolume: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolTypeVolume: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolTypeVolume: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolType", wireType)
 			}
-			m.PoolId = 0
+			m.PoolType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2000,14 +4033,14 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PoolType |= PoolType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolVolume", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2034,8 +4067,8 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolVolume = append(m.PoolVolume, types.Coin{})
-			if err := m.PoolVolume[len(m.PoolVolume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Volume = append(m.Volume, types.Coin{})
+			if err := m.Volume[len(m.Volume)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2060,6 +4093,7 @@ func (m *PoolVolume) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+const (
+	// IbcUnwrapTimeout is the timeout of the IBC packets sending swap outputs to their origin chain,
+	// relative to the block time.
+	IbcUnwrapTimeout = 10 * time.Minute
+
+	// ForwardIntermediateReceiver is the receiver of the IBC packets at the intermediate chains when
+	// unwrapping tokens that went through multiple hops. The packet forward middleware of these chains
+	// ignores it and forwards the tokens to the next hop.
+	ForwardIntermediateReceiver = "pfm"
+)
+
+// IbcHop is a hop of the path a token took to reach this chain, as seen from the chain receiving it.
+type IbcHop struct {
+	Port    string
+	Channel string
+}
+
+// ParseIbcUnwrapHops parses the path of an IBC denom trace into the hops to send the token back to its
+// origin chain, in order. The first hop is on this chain.
+// Returns error if the path is empty or malformed.
+func ParseIbcUnwrapHops(path string) ([]IbcHop, error) {
+	if path == "" {
+		return nil, fmt.Errorf("empty denom trace path, token is native to this chain")
+	}
+
+	identifiers := strings.Split(path, "/")
+	if len(identifiers)%2 != 0 {
+		return nil, fmt.Errorf("invalid denom trace path (%s), must be a list of port and channel pairs", path)
+	}
+
+	hops := make([]IbcHop, 0, len(identifiers)/2)
+	for i := 0; i < len(identifiers); i += 2 {
+		if identifiers[i] == "" || identifiers[i+1] == "" {
+			return nil, fmt.Errorf("invalid denom trace path (%s), empty port or channel", path)
+		}
+		hops = append(hops, IbcHop{Port: identifiers[i], Channel: identifiers[i+1]})
+	}
+	return hops, nil
+}
+
+// forwardMetadata is the packet forward middleware metadata of a single hop.
+type forwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Next     *packetMetadata `json:"next,omitempty"`
+}
+
+// packetMetadata is the memo understood by the packet forward middleware.
+type packetMetadata struct {
+	Forward *forwardMetadata `json:"forward"`
+}
+
+// BuildIbcUnwrapMemo returns the memo instructing the packet forward middleware of the intermediate chains
+// to forward the token along the given hops, which exclude the first hop on this chain, to the receiver.
+// Returns an empty memo if there are no intermediate chains.
+func BuildIbcUnwrapMemo(hops []IbcHop, receiver string) (string, error) {
+	var next *packetMetadata
+	for i := len(hops) - 1; i >= 0; i-- {
+		hopReceiver := ForwardIntermediateReceiver
+		if i == len(hops)-1 {
+			hopReceiver = receiver
+		}
+		next = &packetMetadata{Forward: &forwardMetadata{
+			Receiver: hopReceiver,
+			Port:     hops[i].Port,
+			Channel:  hops[i].Channel,
+			Next:     next,
+		}}
+	}
+
+	if next == nil {
+		return "", nil
+	}
+
+	memo, err := json.Marshal(next)
+	if err != nil {
+		return "", err
+	}
+	return string(memo), nil
+}
+
+// validateIbcUnwrap validates that the output of a swap can be unwrapped to the given receiver.
+// It is a no-op if the receiver is not set.
+func validateIbcUnwrap(receiver string, tokenOutDenom string) error {
+	if receiver == "" {
+		return nil
+	}
+
+	if strings.TrimSpace(receiver) == "" {
+		return fmt.Errorf("ibc unwrap receiver cannot be blank")
+	}
+
+	if !strings.HasPrefix(tokenOutDenom, ibctransfertypes.DenomPrefix+"/") {
+		return NonIbcDenomUnwrapError{Denom: tokenOutDenom}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
+
+func TestParseIbcUnwrapHops(t *testing.T) {
+	tests := map[string]struct {
+		path         string
+		expectedHops []types.IbcHop
+		expectError  bool
+	}{
+		"single hop": {
+			path:         "transfer/channel-0",
+			expectedHops: []types.IbcHop{{Port: "transfer", Channel: "channel-0"}},
+		},
+		"multiple hops": {
+			path: "transfer/channel-0/transfer/channel-141",
+			expectedHops: []types.IbcHop{
+				{Port: "transfer", Channel: "channel-0"},
+				{Port: "transfer", Channel: "channel-141"},
+			},
+		},
+		"error: native token": {
+			path:        "",
+			expectError: true,
+		},
+		"error: odd number of identifiers": {
+			path:        "transfer/channel-0/transfer",
+			expectError: true,
+		},
+		"error: empty channel": {
+			path:        "transfer//transfer/channel-1",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			hops, err := types.ParseIbcUnwrapHops(tc.path)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHops, hops)
+		})
+	}
+}
+
+func TestBuildIbcUnwrapMemo(t *testing.T) {
+	tests := map[string]struct {
+		hops         []types.IbcHop
+		expectedMemo string
+	}{
+		"no intermediate chains": {
+			expectedMemo: "",
+		},
+		"one intermediate chain": {
+			hops:         []types.IbcHop{{Port: "transfer", Channel: "channel-141"}},
+			expectedMemo: `{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-141"}}`,
+		},
+		"two intermediate chains": {
+			hops: []types.IbcHop{
+				{Port: "transfer", Channel: "channel-141"},
+				{Port: "transfer", Channel: "channel-2"},
+			},
+			expectedMemo: `{"forward":{"receiver":"pfm","port":"transfer","channel":"channel-141","next":{"forward":{"receiver":"receiver","port":"transfer","channel":"channel-2"}}}}`,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			memo, err := types.BuildIbcUnwrapMemo(tc.hops, "receiver")
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedMemo, memo)
+		})
+	}
+}
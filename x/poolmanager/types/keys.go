@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,8 +40,20 @@ var (
 
 	// KeyTakerFeeProtoRevAccountingHeight defines key to store the accounting height for the above taker fee trackers.
 	KeyTakerFeeProtoRevAccountingHeight = []byte{0x07}
+
+	// KeyDenomPairVolumePrefix defines prefix to store the total swap volume of a denom pair.
+	KeyDenomPairVolumePrefix = []byte{0x08}
+
+	// KeyDenomPairHourlyVolumePrefix defines prefix to store the swap volume of a denom pair per hour.
+	KeyDenomPairHourlyVolumePrefix = []byte{0x09}
+
+	// KeyPoolTypeVolumePrefix defines prefix to store the total swap volume of a pool type.
+	KeyPoolTypeVolumePrefix = []byte{0x0A}
 )
 
+// VolumeWindowHours is the number of hourly buckets summed up for the rolling swap volume of a denom pair.
+const VolumeWindowHours = 24
+
 // ModuleRouteToBytes serializes moduleRoute to bytes.
 func FormatModuleRouteKey(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", SwapModuleRouterPrefix, poolId))
@@ -70,6 +83,91 @@ func KeyPoolVolume(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d%s", KeyPoolVolumePrefix, KeySeparator, poolId, KeySeparator))
 }
 
+// FormatDenomPairVolumeKey returns the key for the total swap volume of the given denom pair.
+// Denom pair is automatically sorted lexicographically.
+func FormatDenomPairVolumeKey(denom0, denom1 string) []byte {
+	denoms := []string{denom0, denom1}
+	sort.Strings(denoms)
+	return []byte(fmt.Sprintf("%s%s%s%s%s", KeyDenomPairVolumePrefix, KeySeparator, denoms[0], KeySeparator, denoms[1]))
+}
+
+// FormatDenomPairHourlyVolumePrefix returns the prefix of the hourly swap volumes of the given denom pair.
+// Denom pair is automatically sorted lexicographically.
+func FormatDenomPairHourlyVolumePrefix(denom0, denom1 string) []byte {
+	denoms := []string{denom0, denom1}
+	sort.Strings(denoms)
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s", KeyDenomPairHourlyVolumePrefix, KeySeparator, denoms[0], KeySeparator, denoms[1], KeySeparator))
+}
+
+// FormatDenomPairHourlyVolumeKey returns the key for the swap volume of the given denom pair during the given hour,
+// counted in hours since the Unix epoch. The hour is zero-padded so that keys are ordered chronologically.
+func FormatDenomPairHourlyVolumeKey(denom0, denom1 string, hour uint64) []byte {
+	return append(FormatDenomPairHourlyVolumePrefix(denom0, denom1), []byte(fmt.Sprintf("%020d", hour))...)
+}
+
+// FormatPoolTypeVolumeKey returns the key for the total swap volume of the given pool type.
+func FormatPoolTypeVolumeKey(poolType PoolType) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", KeyPoolTypeVolumePrefix, KeySeparator, poolType))
+}
+
+// ParseDenomPairVolumeKey parses the raw bytes of the DenomPairVolumeKey into a denom pair.
+func ParseDenomPairVolumeKey(key []byte) (denom0, denom1 string, err error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 3 {
+		return "", "", fmt.Errorf("invalid denom pair volume key (%s)", key)
+	}
+
+	return validateDenomPair(parts[1], parts[2])
+}
+
+// ParseDenomPairHourlyVolumeKey parses the raw bytes of the DenomPairHourlyVolumeKey into a denom pair
+// and the hour of the volume.
+func ParseDenomPairHourlyVolumeKey(key []byte) (denom0, denom1 string, hour uint64, err error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 4 {
+		return "", "", 0, fmt.Errorf("invalid denom pair hourly volume key (%s)", key)
+	}
+
+	hour, err = strconv.ParseUint(parts[3], 10, 64)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	denom0, denom1, err = validateDenomPair(parts[1], parts[2])
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	return denom0, denom1, hour, nil
+}
+
+// ParsePoolTypeVolumeKey parses the raw bytes of the PoolTypeVolumeKey into a pool type.
+func ParsePoolTypeVolumeKey(key []byte) (PoolType, error) {
+	parts := strings.Split(string(key), KeySeparator)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid pool type volume key (%s)", key)
+	}
+
+	poolType, err := strconv.ParseInt(parts[1], 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return PoolType(poolType), nil
+}
+
+// validateDenomPair returns the given denoms if both are valid.
+func validateDenomPair(denom0, denom1 string) (string, string, error) {
+	if err := sdk.ValidateDenom(denom0); err != nil {
+		return "", "", err
+	}
+	if err := sdk.ValidateDenom(denom1); err != nil {
+		return "", "", err
+	}
+
+	return denom0, denom1, nil
+}
+
 // ParseDenomTradePairKey parses the raw bytes of the DenomTradePairKey into a denom trade pair.
 func ParseDenomTradePairKey(key []byte) (denom0, denom1 string, err error) {
 	keyStr := string(key)
@@ -63,3 +63,55 @@ func TestParseDenomTradePairKey(t *testing.T) {
 		t.Errorf("Expected error, got nil")
 	}
 }
+
+func TestFormatDenomPairHourlyVolumeKey(t *testing.T) {
+	tests := map[string]struct {
+		denom0      string
+		denom1      string
+		hour        uint64
+		expectedKey string
+	}{
+		"happy path": {
+			denom0:      "uosmo",
+			denom1:      "uion",
+			hour:        475000,
+			expectedKey: "\x09|uion|uosmo|00000000000000475000",
+		},
+		"reversed denoms get reordered": {
+			denom0:      "uion",
+			denom1:      "uosmo",
+			hour:        475000,
+			expectedKey: "\x09|uion|uosmo|00000000000000475000",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			key := types.FormatDenomPairHourlyVolumeKey(tc.denom0, tc.denom1, tc.hour)
+			require.Equal(t, tc.expectedKey, string(key))
+		})
+	}
+}
+
+func TestParseVolumeKeys(t *testing.T) {
+	denom0, denom1, err := types.ParseDenomPairVolumeKey(types.FormatDenomPairVolumeKey("uosmo", "uion"))
+	require.NoError(t, err)
+	require.Equal(t, "uion", denom0)
+	require.Equal(t, "uosmo", denom1)
+
+	denom0, denom1, hour, err := types.ParseDenomPairHourlyVolumeKey(types.FormatDenomPairHourlyVolumeKey("uosmo", "uion", 475000))
+	require.NoError(t, err)
+	require.Equal(t, "uion", denom0)
+	require.Equal(t, "uosmo", denom1)
+	require.Equal(t, uint64(475000), hour)
+
+	poolType, err := types.ParsePoolTypeVolumeKey(types.FormatPoolTypeVolumeKey(types.Concentrated))
+	require.NoError(t, err)
+	require.Equal(t, types.Concentrated, poolType)
+
+	_, _, err = types.ParseDenomPairVolumeKey([]byte("\x08|uion"))
+	require.Error(t, err)
+
+	_, _, _, err = types.ParseDenomPairHourlyVolumeKey([]byte("\x09|uion|uosmo|hour"))
+	require.Error(t, err)
+}
@@ -19,6 +19,12 @@ func (msg MsgSplitRouteSwapExactAmountIn) GetSwapMsgs() []SwapMsgRoute {
 	return routes
 }
 
+// TokenOutDenom returns the denom of the output of the swap. All routes are validated to end with it.
+func (msg MsgSplitRouteSwapExactAmountIn) TokenOutDenom() string {
+	pools := msg.Routes[0].Pools
+	return pools[len(pools)-1].TokenOutDenom
+}
+
 func (msg MsgSplitRouteSwapExactAmountOut) GetSwapMsgs() []SwapMsgRoute {
 	routes := make([]SwapMsgRoute, len(msg.Routes))
 	for i := 0; i < len(msg.Routes); i++ {
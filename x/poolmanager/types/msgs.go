@@ -25,7 +25,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
-	err = SwapAmountInRoutes(msg.Routes).Validate()
+	err = SwapAmountInRoutes(msg.Routes).ValidateWithTokenIn(msg.TokenIn.Denom)
 	if err != nil {
 		return err
 	}
@@ -39,7 +39,7 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return validateIbcUnwrap(msg.IbcUnwrapReceiver, msg.TokenOutDenom())
 }
 
 func (msg MsgSwapExactAmountIn) GetSignBytes() []byte {
@@ -64,7 +64,7 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
-	err = SwapAmountOutRoutes(msg.Routes).Validate()
+	err = SwapAmountOutRoutes(msg.Routes).ValidateWithTokenOut(msg.TokenOut.Denom)
 	if err != nil {
 		return err
 	}
@@ -107,7 +107,7 @@ func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
 		return err
 	}
 
-	if err := ValidateSwapAmountInSplitRoute(msg.Routes); err != nil {
+	if err := ValidateSwapAmountInSplitRoute(msg.Routes, msg.TokenInDenom); err != nil {
 		return err
 	}
 
@@ -115,7 +115,7 @@ func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
 		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
 	}
 
-	return nil
+	return validateIbcUnwrap(msg.IbcUnwrapReceiver, msg.TokenOutDenom())
 }
 
 func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
@@ -145,7 +145,7 @@ func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
 		return err
 	}
 
-	if err := ValidateSwapAmountOutSplitRoute(msg.Routes); err != nil {
+	if err := ValidateSwapAmountOutSplitRoute(msg.Routes, msg.TokenOutDenom); err != nil {
 		return err
 	}
 
@@ -31,6 +31,8 @@ var (
 		TokenOutDenom: "uatom",
 	}}
 
+	ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
 	validSwapRoutePoolThreeAmountOut = types.SwapAmountOutRoute{
 		PoolId:       3,
 		TokenInDenom: "uatom",
@@ -157,6 +159,32 @@ func TestMsgSwapExactAmountIn(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "ibc unwrap of ibc token out",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.Routes = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ibcDenom}}
+				msg.IbcUnwrapReceiver = "cosmos1receiver"
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "ibc unwrap of native token out",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.IbcUnwrapReceiver = "cosmos1receiver"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "ibc unwrap to blank receiver",
+			msg: createMsg(properMsg, func(msg types.MsgSwapExactAmountIn) types.MsgSwapExactAmountIn {
+				msg.Routes = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: ibcDenom}}
+				msg.IbcUnwrapReceiver = " "
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -439,6 +467,13 @@ func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
 			}),
 			expectError: true,
 		},
+		"ibc unwrap of native token out": {
+			msg: createMsg(defaultValidMsg, func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.IbcUnwrapReceiver = "cosmos1receiver"
+				return msg
+			}),
+			expectError: true,
+		},
 	}
 
 	for name, tc := range tests {
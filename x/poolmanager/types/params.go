@@ -21,6 +21,18 @@ var (
 	KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo = []byte("CommunityPoolDenomToSwapNonWhitelistedAssetsTo")
 	KeyAuthorizedQuoteDenoms                          = []byte("AuthorizedQuoteDenoms")
 	KeyReducedTakerFeeByWhitelist                     = []byte("ReducedTakerFeeByWhitelist")
+	KeyStakedOsmoTakerFeeDiscountTiers                = []byte("StakedOsmoTakerFeeDiscountTiers")
+	KeyDenomAliases                                   = []byte("DenomAliases")
+	KeyMaxHops                                        = []byte("MaxHops")
+	KeyMaxRoutesPerTx                                 = []byte("MaxRoutesPerTx")
+	KeyPausedDenomPairs                               = []byte("PausedDenomPairs")
+)
+
+const (
+	// DefaultMaxHops is the default max number of pools that a swap route may go through.
+	DefaultMaxHops = uint64(10)
+	// DefaultMaxRoutesPerTx is the default max number of routes that a split route swap may be split across.
+	DefaultMaxRoutesPerTx = uint64(10)
 )
 
 // ParamTable for gamm module.
@@ -63,6 +75,7 @@ func DefaultParams() Params {
 			AdminAddresses: []string{},
 			CommunityPoolDenomToSwapNonWhitelistedAssetsTo: "ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 			ReducedFeeWhitelist:                            []string{},
+			StakedOsmoDiscountTiers:                        []TakerFeeDiscountTier{},
 		},
 		AuthorizedQuoteDenoms: []string{
 			"uosmo",
@@ -70,6 +83,10 @@ func DefaultParams() Params {
 			"ibc/0CD3A0285E1341859B5E86B6AB7682F023D03E97607CCC1DC95706411D866DF7", // DAI
 			"ibc/D189335C6E4A68B513C10AB227BF1C1D38C746766278BA3EEB4FB14124F1D858", // USDC
 		},
+		DenomAliases:     []DenomAlias{},
+		MaxHops:          DefaultMaxHops,
+		MaxRoutesPerTx:   DefaultMaxRoutesPerTx,
+		PausedDenomPairs: []PausedDenomPair{},
 	}
 }
 
@@ -96,9 +113,24 @@ func (p Params) Validate() error {
 	if err := osmoutils.ValidateAddressList(p.TakerFeeParams.ReducedFeeWhitelist); err != nil {
 		return err
 	}
+	if err := validateStakedOsmoTakerFeeDiscountTiers(p.TakerFeeParams.StakedOsmoDiscountTiers); err != nil {
+		return err
+	}
 	if err := validateAuthorizedQuoteDenoms(p.AuthorizedQuoteDenoms); err != nil {
 		return err
 	}
+	if err := validateDenomAliases(p.DenomAliases); err != nil {
+		return err
+	}
+	if err := validateMaxHops(p.MaxHops); err != nil {
+		return err
+	}
+	if err := validateMaxRoutesPerTx(p.MaxRoutesPerTx); err != nil {
+		return err
+	}
+	if err := validatePausedDenomPairs(p.PausedDenomPairs); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -114,6 +146,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyCommunityPoolDenomToSwapNonWhitelistedAssetsTo, &p.TakerFeeParams.CommunityPoolDenomToSwapNonWhitelistedAssetsTo, validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo),
 		paramtypes.NewParamSetPair(KeyAuthorizedQuoteDenoms, &p.AuthorizedQuoteDenoms, validateAuthorizedQuoteDenoms),
 		paramtypes.NewParamSetPair(KeyReducedTakerFeeByWhitelist, &p.TakerFeeParams.ReducedFeeWhitelist, osmoutils.ValidateAddressList),
+		paramtypes.NewParamSetPair(KeyStakedOsmoTakerFeeDiscountTiers, &p.TakerFeeParams.StakedOsmoDiscountTiers, validateStakedOsmoTakerFeeDiscountTiers),
+		paramtypes.NewParamSetPair(KeyDenomAliases, &p.DenomAliases, validateDenomAliases),
+		paramtypes.NewParamSetPair(KeyMaxHops, &p.MaxHops, validateMaxHops),
+		paramtypes.NewParamSetPair(KeyMaxRoutesPerTx, &p.MaxRoutesPerTx, validateMaxRoutesPerTx),
+		paramtypes.NewParamSetPair(KeyPausedDenomPairs, &p.PausedDenomPairs, validatePausedDenomPairs),
 	}
 }
 
@@ -224,6 +261,119 @@ func validateCommunityPoolDenomToSwapNonWhitelistedAssetsTo(i interface{}) error
 	return nil
 }
 
+// validateStakedOsmoTakerFeeDiscountTiers validates that the tiers are sorted by strictly increasing
+// min staked amount and that every discount is between 0 and 1.
+func validateStakedOsmoTakerFeeDiscountTiers(i interface{}) error {
+	tiers, ok := i.([]TakerFeeDiscountTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, tier := range tiers {
+		if tier.MinStaked.IsNil() || tier.MinStaked.IsNegative() {
+			return fmt.Errorf("taker fee discount tier min staked must be non-negative: %s", tier.MinStaked)
+		}
+		if i > 0 && tier.MinStaked.LTE(tiers[i-1].MinStaked) {
+			return fmt.Errorf("taker fee discount tiers must be sorted by strictly increasing min staked, got %s after %s", tier.MinStaked, tiers[i-1].MinStaked)
+		}
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GT(osmomath.OneDec()) {
+			return fmt.Errorf("taker fee discount must be between 0 and 1: %s", tier.Discount)
+		}
+	}
+
+	return nil
+}
+
+// validateDenomAliases validates that every alias has valid denoms and a converter pool,
+// that no denom is aliased more than once and that no canonical denom is itself an alias.
+func validateDenomAliases(i interface{}) error {
+	aliases, ok := i.([]DenomAlias)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	aliasDenoms := make(map[string]struct{}, len(aliases))
+	for _, alias := range aliases {
+		if err := sdk.ValidateDenom(alias.AliasDenom); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(alias.CanonicalDenom); err != nil {
+			return err
+		}
+		if alias.AliasDenom == alias.CanonicalDenom {
+			return fmt.Errorf("denom alias (%s) must be different from its canonical denom", alias.AliasDenom)
+		}
+		if alias.ConverterPoolId == 0 {
+			return fmt.Errorf("denom alias (%s) must have a converter pool", alias.AliasDenom)
+		}
+		if _, ok := aliasDenoms[alias.AliasDenom]; ok {
+			return fmt.Errorf("denom (%s) is aliased more than once", alias.AliasDenom)
+		}
+		aliasDenoms[alias.AliasDenom] = struct{}{}
+	}
+
+	for _, alias := range aliases {
+		if _, ok := aliasDenoms[alias.CanonicalDenom]; ok {
+			return fmt.Errorf("canonical denom (%s) of denom alias (%s) must not be an alias", alias.CanonicalDenom, alias.AliasDenom)
+		}
+	}
+
+	return nil
+}
+
+// validateMaxHops validates the type of the max number of hops of a route.
+// Zero disables the limit.
+func validateMaxHops(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateMaxRoutesPerTx validates the type of the max number of routes of a split route swap.
+// Zero disables the limit.
+func validateMaxRoutesPerTx(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validatePausedDenomPairs validates that every paused pair consists of two different valid denoms
+// and that no pair is paused more than once, in either order.
+func validatePausedDenomPairs(i interface{}) error {
+	pairs, ok := i.([]PausedDenomPair)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	pausedPairs := make(map[[2]string]struct{}, len(pairs))
+	for _, pair := range pairs {
+		if err := sdk.ValidateDenom(pair.Denom0); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(pair.Denom1); err != nil {
+			return err
+		}
+		if pair.Denom0 == pair.Denom1 {
+			return fmt.Errorf("paused denom pair denoms must be different: %s", pair.Denom0)
+		}
+		if _, ok := pausedPairs[[2]string{pair.Denom1, pair.Denom0}]; ok {
+			return fmt.Errorf("denom pair (%s, %s) is paused more than once", pair.Denom0, pair.Denom1)
+		}
+		if _, ok := pausedPairs[[2]string{pair.Denom0, pair.Denom1}]; ok {
+			return fmt.Errorf("denom pair (%s, %s) is paused more than once", pair.Denom0, pair.Denom1)
+		}
+		pausedPairs[[2]string{pair.Denom0, pair.Denom1}] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateDenomPairTakerFees(pairs []DenomPairTakerFee) error {
 	if len(pairs) == 0 {
 		return fmt.Errorf("Empty denom pair taker fee")
@@ -8,16 +8,41 @@ import (
 
 type SwapAmountInRoutes []SwapAmountInRoute
 
+// Validate returns an error if the routes are empty, any token out denom is invalid
+// or any token out denom is visited more than once.
 func (routes SwapAmountInRoutes) Validate() error {
 	if len(routes) == 0 {
 		return ErrEmptyRoutes
 	}
 
+	visitedDenoms := make(map[string]struct{}, len(routes))
 	for _, route := range routes {
 		err := sdk.ValidateDenom(route.TokenOutDenom)
 		if err != nil {
 			return err
 		}
+
+		if _, ok := visitedDenoms[route.TokenOutDenom]; ok {
+			return DuplicateRouteDenomError{PoolId: route.PoolId, Denom: route.TokenOutDenom}
+		}
+		visitedDenoms[route.TokenOutDenom] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateWithTokenIn validates the routes and additionally returns an error if
+// any intermediary pool swaps back into the given token in denom, creating a cycle.
+// Swapping back into the token in denom at the last pool is allowed for cyclic arbitrage.
+func (routes SwapAmountInRoutes) ValidateWithTokenIn(tokenInDenom string) error {
+	if err := routes.Validate(); err != nil {
+		return err
+	}
+
+	for _, route := range routes[:len(routes)-1] {
+		if route.TokenOutDenom == tokenInDenom {
+			return RoutePoolWithTokenInDenomError{PoolId: route.PoolId, TokenInDenom: tokenInDenom}
+		}
 	}
 
 	return nil
@@ -49,16 +74,41 @@ func (routes SwapAmountInRoutes) Length() int {
 
 type SwapAmountOutRoutes []SwapAmountOutRoute
 
+// Validate returns an error if the routes are empty, any token in denom is invalid
+// or any token in denom is visited more than once.
 func (routes SwapAmountOutRoutes) Validate() error {
 	if len(routes) == 0 {
 		return ErrEmptyRoutes
 	}
 
+	visitedDenoms := make(map[string]struct{}, len(routes))
 	for _, route := range routes {
 		err := sdk.ValidateDenom(route.TokenInDenom)
 		if err != nil {
 			return err
 		}
+
+		if _, ok := visitedDenoms[route.TokenInDenom]; ok {
+			return DuplicateRouteDenomError{PoolId: route.PoolId, Denom: route.TokenInDenom}
+		}
+		visitedDenoms[route.TokenInDenom] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidateWithTokenOut validates the routes and additionally returns an error if
+// any intermediary pool swaps in from the given token out denom, creating a cycle.
+// Swapping in from the token out denom at the first pool is allowed for cyclic arbitrage.
+func (routes SwapAmountOutRoutes) ValidateWithTokenOut(tokenOutDenom string) error {
+	if err := routes.Validate(); err != nil {
+		return err
+	}
+
+	for _, route := range routes[1:] {
+		if route.TokenInDenom == tokenOutDenom {
+			return RoutePoolWithTokenOutDenomError{PoolId: route.PoolId, TokenOutDenom: tokenOutDenom}
+		}
 	}
 
 	return nil
@@ -92,10 +142,10 @@ func (routes SwapAmountOutRoutes) Length() int {
 //
 // returns an error if any of the following are true:
 // - the slice is empty
-// - any SwapAmountInRoute in the slice is invalid
+// - any SwapAmountInRoute in the slice is invalid or swaps back into tokenInDenom before its last pool
 // - the last TokenOutDenom of any SwapAmountInRoute in the slice does not match the TokenOutDenom of the previous SwapAmountInRoute in the slice
 // - there are duplicate SwapAmountInRoutes in the slice
-func ValidateSwapAmountInSplitRoute(splitRoutes []SwapAmountInSplitRoute) error {
+func ValidateSwapAmountInSplitRoute(splitRoutes []SwapAmountInSplitRoute, tokenInDenom string) error {
 	if len(splitRoutes) == 0 {
 		return ErrEmptyRoutes
 	}
@@ -106,7 +156,7 @@ func ValidateSwapAmountInSplitRoute(splitRoutes []SwapAmountInSplitRoute) error
 	for _, splitRoute := range splitRoutes {
 		multihopRoute := splitRoute.Pools
 
-		err := SwapAmountInRoutes(multihopRoute).Validate()
+		err := SwapAmountInRoutes(multihopRoute).ValidateWithTokenIn(tokenInDenom)
 		if err != nil {
 			return err
 		}
@@ -131,10 +181,10 @@ func ValidateSwapAmountInSplitRoute(splitRoutes []SwapAmountInSplitRoute) error
 
 // ValidateSwapAmountOutSplitRoute validates a slice of SwapAmountOutSplitRoute and returns an error if any of the following are true:
 // - the slice is empty
-// - any SwapAmountOutRoute in the slice is invalid
+// - any SwapAmountOutRoute in the slice is invalid or swaps in from tokenOutDenom after its first pool
 // - the first TokenInDenom of any SwapAmountOutRoute in the slice does not match the TokenInDenom of the previous SwapAmountOutRoute in the slice
 // - there are duplicate SwapAmountOutRoutes in the slice
-func ValidateSwapAmountOutSplitRoute(splitRoutes []SwapAmountOutSplitRoute) error {
+func ValidateSwapAmountOutSplitRoute(splitRoutes []SwapAmountOutSplitRoute, tokenOutDenom string) error {
 	if len(splitRoutes) == 0 {
 		return ErrEmptyRoutes
 	}
@@ -145,7 +195,7 @@ func ValidateSwapAmountOutSplitRoute(splitRoutes []SwapAmountOutSplitRoute) erro
 	for _, splitRoute := range splitRoutes {
 		multihopRoute := splitRoute.Pools
 
-		err := SwapAmountOutRoutes(multihopRoute).Validate()
+		err := SwapAmountOutRoutes(multihopRoute).ValidateWithTokenOut(tokenOutDenom)
 		if err != nil {
 			return err
 		}
@@ -190,11 +190,34 @@ func TestValidateSwapAmountInSplitRoute(t *testing.T) {
 			},
 			expectErr: ErrDuplicateRoutesNotAllowed,
 		},
+		{
+			name: "multihop route swaps back into token in",
+			routes: []SwapAmountInSplitRoute{
+				{
+					Pools: []SwapAmountInRoute{
+						{
+							PoolId:        fooBarPoolId,
+							TokenOutDenom: bar,
+						},
+						{
+							PoolId:        fooBarPoolId,
+							TokenOutDenom: foo,
+						},
+						{
+							PoolId:        fooBazPoolId,
+							TokenOutDenom: baz,
+						},
+					},
+					TokenInAmount: osmomath.OneInt(),
+				},
+			},
+			expectErr: RoutePoolWithTokenInDenomError{PoolId: fooBarPoolId, TokenInDenom: foo},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidateSwapAmountInSplitRoute(tt.routes)
+			err := ValidateSwapAmountInSplitRoute(tt.routes, foo)
 
 			if tt.expectErr != nil {
 				require.Error(t, err)
@@ -272,11 +295,34 @@ func TestValidateSwapAmountOutSplitRoute(t *testing.T) {
 			},
 			expectErr: ErrDuplicateRoutesNotAllowed,
 		},
+		{
+			name: "multihop route swaps in from token out",
+			routes: []SwapAmountOutSplitRoute{
+				{
+					Pools: []SwapAmountOutRoute{
+						{
+							PoolId:       fooBazPoolId,
+							TokenInDenom: foo,
+						},
+						{
+							PoolId:       barBazPoolId,
+							TokenInDenom: baz,
+						},
+						{
+							PoolId:       barBazPoolId,
+							TokenInDenom: bar,
+						},
+					},
+					TokenOutAmount: osmomath.OneInt(),
+				},
+			},
+			expectErr: RoutePoolWithTokenOutDenomError{PoolId: barBazPoolId, TokenOutDenom: baz},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := ValidateSwapAmountOutSplitRoute(tt.routes)
+			err := ValidateSwapAmountOutSplitRoute(tt.routes, baz)
 
 			if tt.expectErr != nil {
 				require.Error(t, err)
@@ -288,6 +334,106 @@ func TestValidateSwapAmountOutSplitRoute(t *testing.T) {
 	}
 }
 
+func TestSwapAmountInRoutesValidateWithTokenIn(t *testing.T) {
+	tests := map[string]struct {
+		route     SwapAmountInRoutes
+		expectErr error
+	}{
+		"valid route": {
+			route: defaultTwoHopRoutesAmountIn,
+		},
+		"cyclic arbitrage route ending in token in": {
+			route: []SwapAmountInRoute{
+				{PoolId: fooBarPoolId, TokenOutDenom: bar},
+				{PoolId: barBazPoolId, TokenOutDenom: baz},
+				{PoolId: fooBazPoolId, TokenOutDenom: foo},
+			},
+		},
+		"empty route": {
+			route:     []SwapAmountInRoute{},
+			expectErr: ErrEmptyRoutes,
+		},
+		"route revisits intermediary denom": {
+			route: []SwapAmountInRoute{
+				{PoolId: fooBarPoolId, TokenOutDenom: bar},
+				{PoolId: barBazPoolId, TokenOutDenom: baz},
+				{PoolId: barBazPoolId, TokenOutDenom: bar},
+				{PoolId: barUosmoPoolId, TokenOutDenom: uosmo},
+			},
+			expectErr: DuplicateRouteDenomError{PoolId: barBazPoolId, Denom: bar},
+		},
+		"intermediary pool swaps back into token in": {
+			route: []SwapAmountInRoute{
+				{PoolId: fooBarPoolId, TokenOutDenom: bar},
+				{PoolId: fooBarPoolId, TokenOutDenom: foo},
+				{PoolId: fooUosmoPoolId, TokenOutDenom: uosmo},
+			},
+			expectErr: RoutePoolWithTokenInDenomError{PoolId: fooBarPoolId, TokenInDenom: foo},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.route.ValidateWithTokenIn(foo)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSwapAmountOutRoutesValidateWithTokenOut(t *testing.T) {
+	tests := map[string]struct {
+		route     SwapAmountOutRoutes
+		expectErr error
+	}{
+		"valid route": {
+			route: defaultTwoHopRoutesAmountOut,
+		},
+		"cyclic arbitrage route starting from token out": {
+			route: []SwapAmountOutRoute{
+				{PoolId: fooBazPoolId, TokenInDenom: baz},
+				{PoolId: fooBarPoolId, TokenInDenom: foo},
+				{PoolId: barBazPoolId, TokenInDenom: bar},
+			},
+		},
+		"empty route": {
+			route:     []SwapAmountOutRoute{},
+			expectErr: ErrEmptyRoutes,
+		},
+		"route revisits intermediary denom": {
+			route: []SwapAmountOutRoute{
+				{PoolId: fooBarPoolId, TokenInDenom: foo},
+				{PoolId: fooBarPoolId, TokenInDenom: bar},
+				{PoolId: fooUosmoPoolId, TokenInDenom: foo},
+				{PoolId: bazUosmoPoolId, TokenInDenom: uosmo},
+			},
+			expectErr: DuplicateRouteDenomError{PoolId: fooUosmoPoolId, Denom: foo},
+		},
+		"intermediary pool swaps in from token out": {
+			route: []SwapAmountOutRoute{
+				{PoolId: fooBazPoolId, TokenInDenom: foo},
+				{PoolId: fooBazPoolId, TokenInDenom: baz},
+				{PoolId: barBazPoolId, TokenInDenom: bar},
+			},
+			expectErr: RoutePoolWithTokenOutDenomError{PoolId: fooBazPoolId, TokenOutDenom: baz},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.route.ValidateWithTokenOut(baz)
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestIntermediateDenoms(t *testing.T) {
 
 	tests := map[string]struct {
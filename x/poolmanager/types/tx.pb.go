@@ -37,6 +37,10 @@ type MsgSwapExactAmountIn struct {
 	Routes            []SwapAmountInRoute   `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenIn           types.Coin            `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
 	TokenOutMinAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// ibc_unwrap_receiver, if set, is the address on the origin chain of the
+	// output token that the output is sent to over IBC, unwrapping it in the same
+	// transaction. The output token must be an IBC token.
+	IbcUnwrapReceiver string `protobuf:"bytes,5,opt,name=ibc_unwrap_receiver,json=ibcUnwrapReceiver,proto3" json:"ibc_unwrap_receiver,omitempty" yaml:"ibc_unwrap_receiver"`
 }
 
 func (m *MsgSwapExactAmountIn) Reset()         { *m = MsgSwapExactAmountIn{} }
@@ -93,8 +97,21 @@ func (m *MsgSwapExactAmountIn) GetTokenIn() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgSwapExactAmountIn) GetIbcUnwrapReceiver() string {
+	if m != nil {
+		return m.IbcUnwrapReceiver
+	}
+	return ""
+}
+
 type MsgSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// ibc_unwrap_sequence is the sequence of the IBC packet sending the output
+	// to its origin chain. It is only set if ibc_unwrap_receiver was set.
+	IbcUnwrapSequence uint64 `protobuf:"varint,2,opt,name=ibc_unwrap_sequence,json=ibcUnwrapSequence,proto3" json:"ibc_unwrap_sequence,omitempty" yaml:"ibc_unwrap_sequence"`
+	// concentrated_swap_executions are the executions of the swaps through
+	// concentrated liquidity pools, in the order they were executed.
+	ConcentratedSwapExecutions []ConcentratedSwapExecution `protobuf:"bytes,3,rep,name=concentrated_swap_executions,json=concentratedSwapExecutions,proto3" json:"concentrated_swap_executions" yaml:"concentrated_swap_executions"`
 }
 
 func (m *MsgSwapExactAmountInResponse) Reset()         { *m = MsgSwapExactAmountInResponse{} }
@@ -130,12 +147,30 @@ func (m *MsgSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountInResponse proto.InternalMessageInfo
 
+func (m *MsgSwapExactAmountInResponse) GetIbcUnwrapSequence() uint64 {
+	if m != nil {
+		return m.IbcUnwrapSequence
+	}
+	return 0
+}
+
+func (m *MsgSwapExactAmountInResponse) GetConcentratedSwapExecutions() []ConcentratedSwapExecution {
+	if m != nil {
+		return m.ConcentratedSwapExecutions
+	}
+	return nil
+}
+
 // ===================== MsgSplitRouteSwapExactAmountIn
 type MsgSplitRouteSwapExactAmountIn struct {
 	Sender            string                   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Routes            []SwapAmountInSplitRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
 	TokenInDenom      string                   `protobuf:"bytes,3,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
 	TokenOutMinAmount cosmossdk_io_math.Int    `protobuf:"bytes,4,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	// ibc_unwrap_receiver, if set, is the address on the origin chain of the
+	// output token that the output is sent to over IBC, unwrapping it in the same
+	// transaction. The output token must be an IBC token.
+	IbcUnwrapReceiver string `protobuf:"bytes,5,opt,name=ibc_unwrap_receiver,json=ibcUnwrapReceiver,proto3" json:"ibc_unwrap_receiver,omitempty" yaml:"ibc_unwrap_receiver"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountIn) Reset()         { *m = MsgSplitRouteSwapExactAmountIn{} }
@@ -192,8 +227,21 @@ func (m *MsgSplitRouteSwapExactAmountIn) GetTokenInDenom() string {
 	return ""
 }
 
+func (m *MsgSplitRouteSwapExactAmountIn) GetIbcUnwrapReceiver() string {
+	if m != nil {
+		return m.IbcUnwrapReceiver
+	}
+	return ""
+}
+
 type MsgSplitRouteSwapExactAmountInResponse struct {
 	TokenOutAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// ibc_unwrap_sequence is the sequence of the IBC packet sending the output
+	// to its origin chain. It is only set if ibc_unwrap_receiver was set.
+	IbcUnwrapSequence uint64 `protobuf:"varint,2,opt,name=ibc_unwrap_sequence,json=ibcUnwrapSequence,proto3" json:"ibc_unwrap_sequence,omitempty" yaml:"ibc_unwrap_sequence"`
+	// concentrated_swap_executions are the executions of the swaps through
+	// concentrated liquidity pools, in the order they were executed.
+	ConcentratedSwapExecutions []ConcentratedSwapExecution `protobuf:"bytes,3,rep,name=concentrated_swap_executions,json=concentratedSwapExecutions,proto3" json:"concentrated_swap_executions" yaml:"concentrated_swap_executions"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountInResponse) Reset() {
@@ -231,6 +279,20 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountInResponse proto.InternalMessageInfo
 
+func (m *MsgSplitRouteSwapExactAmountInResponse) GetIbcUnwrapSequence() uint64 {
+	if m != nil {
+		return m.IbcUnwrapSequence
+	}
+	return 0
+}
+
+func (m *MsgSplitRouteSwapExactAmountInResponse) GetConcentratedSwapExecutions() []ConcentratedSwapExecution {
+	if m != nil {
+		return m.ConcentratedSwapExecutions
+	}
+	return nil
+}
+
 // ===================== MsgSwapExactAmountOut
 type MsgSwapExactAmountOut struct {
 	Sender           string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -295,6 +357,9 @@ func (m *MsgSwapExactAmountOut) GetTokenOut() types.Coin {
 
 type MsgSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	// concentrated_swap_executions are the executions of the swaps through
+	// concentrated liquidity pools, in the order they were executed.
+	ConcentratedSwapExecutions []ConcentratedSwapExecution `protobuf:"bytes,2,rep,name=concentrated_swap_executions,json=concentratedSwapExecutions,proto3" json:"concentrated_swap_executions" yaml:"concentrated_swap_executions"`
 }
 
 func (m *MsgSwapExactAmountOutResponse) Reset()         { *m = MsgSwapExactAmountOutResponse{} }
@@ -330,6 +395,13 @@ func (m *MsgSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapExactAmountOutResponse proto.InternalMessageInfo
 
+func (m *MsgSwapExactAmountOutResponse) GetConcentratedSwapExecutions() []ConcentratedSwapExecution {
+	if m != nil {
+		return m.ConcentratedSwapExecutions
+	}
+	return nil
+}
+
 // ===================== MsgSplitRouteSwapExactAmountOut
 type MsgSplitRouteSwapExactAmountOut struct {
 	Sender           string                    `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -394,6 +466,9 @@ func (m *MsgSplitRouteSwapExactAmountOut) GetTokenOutDenom() string {
 
 type MsgSplitRouteSwapExactAmountOutResponse struct {
 	TokenInAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=cosmossdk.io/math.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	// concentrated_swap_executions are the executions of the swaps through
+	// concentrated liquidity pools, in the order they were executed.
+	ConcentratedSwapExecutions []ConcentratedSwapExecution `protobuf:"bytes,2,rep,name=concentrated_swap_executions,json=concentratedSwapExecutions,proto3" json:"concentrated_swap_executions" yaml:"concentrated_swap_executions"`
 }
 
 func (m *MsgSplitRouteSwapExactAmountOutResponse) Reset() {
@@ -431,6 +506,13 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSplitRouteSwapExactAmountOutResponse proto.InternalMessageInfo
 
+func (m *MsgSplitRouteSwapExactAmountOutResponse) GetConcentratedSwapExecutions() []ConcentratedSwapExecution {
+	if m != nil {
+		return m.ConcentratedSwapExecutions
+	}
+	return nil
+}
+
 // ===================== MsgSetDenomPairTakerFee
 type MsgSetDenomPairTakerFee struct {
 	Sender            string              `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
@@ -583,6 +665,71 @@ func (m *DenomPairTakerFee) GetDenom1() string {
 	return ""
 }
 
+// ConcentratedSwapExecution is the execution of a swap through a concentrated
+// liquidity pool.
+type ConcentratedSwapExecution struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// crossed_ticks are the indexes of the initialized ticks crossed by the swap,
+	// in the order they were crossed.
+	CrossedTicks []int64 `protobuf:"varint,2,rep,packed,name=crossed_ticks,json=crossedTicks,proto3" json:"crossed_ticks,omitempty" yaml:"crossed_ticks"`
+	// final_tick is the current tick of the pool after the swap.
+	FinalTick int64 `protobuf:"varint,3,opt,name=final_tick,json=finalTick,proto3" json:"final_tick,omitempty" yaml:"final_tick"`
+}
+
+func (m *ConcentratedSwapExecution) Reset()         { *m = ConcentratedSwapExecution{} }
+func (m *ConcentratedSwapExecution) String() string { return proto.CompactTextString(m) }
+func (*ConcentratedSwapExecution) ProtoMessage()    {}
+func (*ConcentratedSwapExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acd130b4825d67dc, []int{11}
+}
+func (m *ConcentratedSwapExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConcentratedSwapExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConcentratedSwapExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConcentratedSwapExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConcentratedSwapExecution.Merge(m, src)
+}
+func (m *ConcentratedSwapExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConcentratedSwapExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConcentratedSwapExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConcentratedSwapExecution proto.InternalMessageInfo
+
+func (m *ConcentratedSwapExecution) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *ConcentratedSwapExecution) GetCrossedTicks() []int64 {
+	if m != nil {
+		return m.CrossedTicks
+	}
+	return nil
+}
+
+func (m *ConcentratedSwapExecution) GetFinalTick() int64 {
+	if m != nil {
+		return m.FinalTick
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSwapExactAmountIn)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountIn")
 	proto.RegisterType((*MsgSwapExactAmountInResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSwapExactAmountInResponse")
@@ -595,6 +742,7 @@ func init() {
 	proto.RegisterType((*MsgSetDenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFee")
 	proto.RegisterType((*MsgSetDenomPairTakerFeeResponse)(nil), "osmosis.poolmanager.v1beta1.MsgSetDenomPairTakerFeeResponse")
 	proto.RegisterType((*DenomPairTakerFee)(nil), "osmosis.poolmanager.v1beta1.DenomPairTakerFee")
+	proto.RegisterType((*ConcentratedSwapExecution)(nil), "osmosis.poolmanager.v1beta1.ConcentratedSwapExecution")
 }
 
 func init() {
@@ -602,69 +750,82 @@ func init() {
 }
 
 var fileDescriptor_acd130b4825d67dc = []byte{
-	// 991 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xc7, 0x33, 0x4e, 0x48, 0x93, 0x29, 0x6d, 0xe3, 0xc5, 0x21, 0xae, 0x53, 0xec, 0x68, 0x5b,
-	0x81, 0x83, 0xd8, 0x5d, 0xec, 0x56, 0x2a, 0x38, 0x91, 0x10, 0x6e, 0x40, 0x8a, 0x54, 0xcb, 0xed,
-	0xd2, 0x13, 0x97, 0xd5, 0xd8, 0x19, 0xdc, 0x25, 0xd9, 0x1d, 0xcb, 0x33, 0xdb, 0x3a, 0x37, 0x40,
-	0x3d, 0x45, 0x1c, 0xf8, 0x06, 0x48, 0x7c, 0x02, 0xbe, 0x01, 0xd7, 0x1e, 0x7b, 0x44, 0x1c, 0x2c,
-	0x94, 0x20, 0x71, 0xcf, 0x09, 0x09, 0x04, 0x68, 0x5e, 0x76, 0x6d, 0xaf, 0x37, 0x7e, 0x49, 0xd4,
-	0x5c, 0x22, 0xef, 0xec, 0xf3, 0xfa, 0x7f, 0x7e, 0xfb, 0x68, 0x02, 0xef, 0x10, 0xea, 0x11, 0xea,
-	0x52, 0xab, 0x4d, 0xc8, 0x81, 0x87, 0x7c, 0xd4, 0xc2, 0x1d, 0xeb, 0x59, 0xa9, 0x81, 0x19, 0x2a,
-	0x59, 0xac, 0x6b, 0xb6, 0x3b, 0x84, 0x11, 0x6d, 0x5d, 0x59, 0x99, 0x03, 0x56, 0xa6, 0xb2, 0xca,
-	0x65, 0x5a, 0xa4, 0x45, 0x84, 0x9d, 0xc5, 0x7f, 0x49, 0x97, 0x5c, 0x1a, 0x79, 0xae, 0x4f, 0x2c,
-	0xf1, 0x57, 0x1d, 0xe5, 0x9b, 0x22, 0x8c, 0xd5, 0x40, 0x14, 0x47, 0x39, 0x9a, 0xc4, 0xf5, 0xd5,
-	0xfb, 0x0f, 0xc6, 0xd5, 0x42, 0x9f, 0xa3, 0xb6, 0xd3, 0x21, 0x01, 0xc3, 0xd2, 0x5a, 0xff, 0x27,
-	0x05, 0x33, 0x35, 0xda, 0xfa, 0xe2, 0x39, 0x6a, 0x7f, 0xd6, 0x45, 0x4d, 0xf6, 0xa9, 0x47, 0x02,
-	0x9f, 0xed, 0xfa, 0xda, 0x26, 0x5c, 0xa4, 0xd8, 0xdf, 0xc3, 0x9d, 0x2c, 0xd8, 0x00, 0xc5, 0xe5,
-	0x6a, 0xfa, 0xb4, 0x57, 0xb8, 0x76, 0x88, 0xbc, 0x83, 0x8a, 0x2e, 0xcf, 0x75, 0x5b, 0x19, 0x68,
-	0x0f, 0xe1, 0xa2, 0x08, 0x49, 0xb3, 0xa9, 0x8d, 0xf9, 0xe2, 0xd5, 0xb2, 0x69, 0x8e, 0x69, 0xd4,
-	0xe4, 0xa9, 0xc2, 0x2c, 0x36, 0x77, 0xab, 0x2e, 0xbc, 0xec, 0x15, 0xe6, 0x6c, 0x15, 0x43, 0xab,
-	0xc1, 0x25, 0x46, 0xf6, 0xb1, 0xef, 0xb8, 0x7e, 0x76, 0x7e, 0x03, 0x14, 0xaf, 0x96, 0x6f, 0x9a,
-	0xb2, 0x65, 0x93, 0xb7, 0x1c, 0xc5, 0x79, 0x40, 0x5c, 0xbf, 0xba, 0xc6, 0x5d, 0x4f, 0x7b, 0x85,
-	0x1b, 0xb2, 0xb2, 0xd0, 0x51, 0xb7, 0xaf, 0x88, 0x9f, 0xbb, 0xbe, 0xe6, 0xc1, 0x8c, 0x3c, 0x25,
-	0x01, 0x73, 0x3c, 0xd7, 0x77, 0x90, 0xc8, 0x9d, 0x5d, 0x10, 0x5d, 0x6d, 0x73, 0xff, 0xdf, 0x7a,
-	0x85, 0x55, 0x99, 0x81, 0xee, 0xed, 0x9b, 0x2e, 0xb1, 0x3c, 0xc4, 0x9e, 0x9a, 0xbb, 0x3e, 0x3b,
-	0xed, 0x15, 0xd6, 0x07, 0x03, 0x0f, 0x87, 0xd0, 0xed, 0xb4, 0x38, 0xae, 0x07, 0xac, 0xe6, 0xfa,
-	0xb2, 0xa5, 0x8a, 0x71, 0xf4, 0xe7, 0xcf, 0xef, 0x17, 0x93, 0x46, 0xc0, 0xa5, 0x37, 0x30, 0xd7,
-	0xd8, 0x90, 0xfe, 0x86, 0xeb, 0xeb, 0xdf, 0x01, 0x78, 0x2b, 0x49, 0x7e, 0x1b, 0xd3, 0x36, 0xf1,
-	0x29, 0xd6, 0x1a, 0x70, 0xa5, 0x9f, 0x5b, 0x95, 0x2e, 0x07, 0xf2, 0xd1, 0xa4, 0xd2, 0xd7, 0xe2,
-	0xa5, 0x87, 0x65, 0x5f, 0x0f, 0xcb, 0x96, 0xd9, 0xf4, 0xbf, 0x52, 0x30, 0xcf, 0x8b, 0x68, 0x1f,
-	0xb8, 0x4c, 0x4c, 0xe4, 0x42, 0x34, 0x3c, 0x8e, 0xd1, 0x70, 0x77, 0x6a, 0x1a, 0xfa, 0x05, 0xc4,
-	0x90, 0xf8, 0x04, 0x5e, 0x0f, 0x27, 0xeb, 0xec, 0x61, 0x9f, 0x78, 0x02, 0x8c, 0xe5, 0xea, 0xcd,
-	0xd3, 0x5e, 0x61, 0x75, 0x78, 0xf2, 0xf2, 0xbd, 0x6e, 0xbf, 0xa9, 0xe6, 0xbf, 0xc3, 0x1f, 0x2f,
-	0x1b, 0x82, 0x22, 0x87, 0xe0, 0x76, 0x22, 0x04, 0xbc, 0xc5, 0x81, 0xf9, 0x7f, 0x0f, 0xe0, 0xbb,
-	0xe3, 0xa5, 0xbf, 0x54, 0x12, 0xfe, 0x4b, 0xc1, 0xd5, 0x51, 0x1c, 0xeb, 0x01, 0x9b, 0x05, 0x80,
-	0x5a, 0x0c, 0x00, 0x6b, 0x4a, 0x00, 0xea, 0x41, 0xe2, 0xf0, 0xbf, 0x86, 0x6f, 0x45, 0xc3, 0xf5,
-	0x50, 0x37, 0x6c, 0x5d, 0x12, 0xb0, 0x35, 0xa9, 0xf5, 0x5c, 0x0c, 0x8f, 0x7e, 0x04, 0xdd, 0x5e,
-	0x51, 0x8c, 0xd4, 0x50, 0x57, 0x56, 0xa0, 0x3d, 0x82, 0xcb, 0x91, 0x48, 0x02, 0x8e, 0xb1, 0xcb,
-	0x27, 0xab, 0x96, 0xcf, 0x4a, 0x4c, 0x5e, 0xdd, 0x5e, 0x0a, 0x75, 0xad, 0x98, 0x1c, 0x85, 0xcd,
-	0xe9, 0xf6, 0x01, 0x77, 0xfd, 0x06, 0xc0, 0x77, 0x12, 0x27, 0x10, 0x71, 0xe0, 0xc0, 0x1b, 0x51,
-	0x37, 0x43, 0x18, 0xdc, 0x9f, 0xa4, 0xc5, 0xdb, 0x31, 0x2d, 0x42, 0x1d, 0xae, 0x29, 0x1d, 0x14,
-	0x04, 0x7f, 0xa7, 0x60, 0x61, 0x1c, 0x93, 0x33, 0xe2, 0x60, 0xc7, 0x70, 0xb8, 0x37, 0x3d, 0x0e,
-	0x67, 0x2e, 0x84, 0x6a, 0xa8, 0x01, 0x87, 0x79, 0x70, 0x23, 0xe4, 0xe2, 0x6d, 0x46, 0x06, 0x61,
-	0x9b, 0xf5, 0x80, 0xc9, 0x9d, 0x70, 0x06, 0x57, 0x0b, 0xaf, 0x81, 0xab, 0xca, 0x26, 0xa7, 0xe0,
-	0xce, 0xc4, 0x85, 0xc0, 0x01, 0x38, 0x02, 0xf0, 0xbd, 0x09, 0xea, 0x5f, 0x1e, 0x0a, 0xff, 0x02,
-	0xb8, 0xc6, 0x8b, 0xc1, 0x52, 0xb3, 0x47, 0xc8, 0xed, 0x3c, 0x41, 0xfb, 0xb8, 0xf3, 0x39, 0xc6,
-	0xb3, 0x20, 0xf0, 0x02, 0xc0, 0x8c, 0x18, 0x82, 0xd3, 0x46, 0x6e, 0xc7, 0x61, 0x3c, 0x84, 0xf3,
-	0x15, 0xc6, 0x53, 0xdd, 0x17, 0x46, 0x32, 0x57, 0x6f, 0xab, 0xef, 0x4e, 0xad, 0xe5, 0xa4, 0xc8,
-	0xba, 0x9d, 0xde, 0x8b, 0xfb, 0x55, 0x4a, 0x7c, 0x0a, 0x89, 0xd7, 0x23, 0x8a, 0x99, 0x21, 0xec,
-	0x0d, 0x1e, 0xc6, 0x10, 0x61, 0x0c, 0x1e, 0x66, 0x4b, 0x7e, 0x0a, 0x09, 0xfd, 0x47, 0x43, 0xc8,
-	0xc2, 0x2b, 0x34, 0x68, 0x36, 0x31, 0xa5, 0x42, 0x88, 0x25, 0x3b, 0x7c, 0xd4, 0x7f, 0x01, 0x30,
-	0x9d, 0xa8, 0x9b, 0x48, 0xf5, 0xe1, 0xa8, 0x6e, 0xf2, 0x5c, 0xb7, 0x95, 0x41, 0x64, 0x5a, 0xca,
-	0xa6, 0x12, 0x4d, 0x4b, 0xa1, 0x69, 0x49, 0x7b, 0x02, 0x97, 0xfb, 0xb2, 0xce, 0x0f, 0x41, 0xb0,
-	0x3e, 0x0a, 0xc1, 0x43, 0xdc, 0x42, 0xcd, 0xc3, 0x1d, 0xdc, 0x1c, 0xd8, 0x5e, 0x7d, 0xe9, 0x96,
-	0x98, 0xaa, 0xb5, 0xfc, 0xc7, 0x1b, 0x70, 0xbe, 0x46, 0x5b, 0xda, 0xb7, 0x00, 0xa6, 0x47, 0x2f,
-	0x05, 0xa5, 0xb1, 0x73, 0x4b, 0xba, 0xd6, 0xe4, 0x3e, 0x9e, 0xd9, 0x25, 0xd2, 0xf9, 0x05, 0x80,
-	0x5a, 0xc2, 0x26, 0x2a, 0xcf, 0x18, 0xb1, 0x1e, 0xb0, 0x5c, 0x65, 0x76, 0x9f, 0xa8, 0x8c, 0x1f,
-	0x01, 0x5c, 0x1f, 0x77, 0x53, 0xda, 0x9a, 0x18, 0xfb, 0x6c, 0xe7, 0xdc, 0x83, 0x0b, 0x38, 0x47,
-	0x15, 0xfe, 0x04, 0xe0, 0xad, 0xb1, 0xcb, 0x7b, 0xfb, 0xdc, 0x59, 0xb8, 0x78, 0x3b, 0x17, 0xf1,
-	0x8e, 0x8a, 0x3c, 0x02, 0x30, 0x93, 0xb8, 0x56, 0xee, 0x4d, 0x0c, 0x9f, 0xe0, 0x95, 0xdb, 0x3e,
-	0x8f, 0x57, 0x58, 0x4c, 0xf5, 0xf1, 0xcb, 0xe3, 0x3c, 0x78, 0x75, 0x9c, 0x07, 0xbf, 0x1f, 0xe7,
-	0xc1, 0x0f, 0x27, 0xf9, 0xb9, 0x57, 0x27, 0xf9, 0xb9, 0x5f, 0x4f, 0xf2, 0x73, 0x5f, 0xde, 0x6f,
-	0xb9, 0xec, 0x69, 0xd0, 0x30, 0x9b, 0xc4, 0xb3, 0x54, 0x06, 0xe3, 0x00, 0x35, 0x68, 0xf8, 0x60,
-	0x3d, 0x2b, 0x97, 0xac, 0xee, 0xd0, 0x2e, 0x61, 0x87, 0x6d, 0x4c, 0x1b, 0x8b, 0xe2, 0xdf, 0xab,
-	0xbb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x7e, 0x3e, 0x7d, 0x1a, 0x0e, 0x00, 0x00,
+	// 1195 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xed, 0x58, 0xcd, 0x6f, 0xdb, 0x64,
+	0x1c, 0x5e, 0x3e, 0xd6, 0x8f, 0x77, 0xb4, 0x6b, 0xbc, 0x94, 0xa6, 0xe9, 0x48, 0x27, 0x77, 0x82,
+	0x94, 0x11, 0x9b, 0x74, 0x15, 0x83, 0xb4, 0x08, 0xe1, 0x16, 0xa4, 0x4a, 0x0b, 0x6c, 0x5e, 0xb9,
+	0x70, 0xb1, 0x1c, 0xe7, 0x5d, 0x66, 0x1a, 0xdb, 0x21, 0x76, 0xba, 0xf4, 0x88, 0xb4, 0xd3, 0x4e,
+	0xfc, 0x07, 0x48, 0x48, 0x48, 0x1c, 0x11, 0x77, 0xc4, 0xb5, 0x07, 0x0e, 0x3b, 0x22, 0x0e, 0x15,
+	0x02, 0x24, 0xee, 0x1c, 0x41, 0x02, 0x7e, 0xef, 0x87, 0x9d, 0xc4, 0x71, 0x9c, 0x64, 0x15, 0x08,
+	0xa4, 0x1d, 0x52, 0xd9, 0xaf, 0x7f, 0x9f, 0xcf, 0xef, 0x79, 0xde, 0xd7, 0x2e, 0xba, 0xee, 0xb8,
+	0x96, 0xe3, 0x9a, 0xae, 0xdc, 0x72, 0x9c, 0xa6, 0xa5, 0xdb, 0x7a, 0x03, 0xb7, 0xe5, 0xe3, 0x72,
+	0x0d, 0x7b, 0x7a, 0x59, 0xf6, 0xba, 0x52, 0xab, 0xed, 0x78, 0x8e, 0xb0, 0xc6, 0xad, 0xa4, 0x3e,
+	0x2b, 0x89, 0x5b, 0xe5, 0xb3, 0x0d, 0xa7, 0xe1, 0x50, 0x3b, 0x99, 0x5c, 0x31, 0x97, 0x7c, 0x46,
+	0xb7, 0x4c, 0xdb, 0x91, 0xe9, 0x5f, 0xbe, 0x54, 0x30, 0x68, 0x18, 0xb9, 0xa6, 0xbb, 0x38, 0xc8,
+	0x61, 0x38, 0xa6, 0xcd, 0x9f, 0xbf, 0x12, 0x57, 0x8b, 0xfb, 0x50, 0x6f, 0x69, 0x6d, 0xa7, 0xe3,
+	0x61, 0x66, 0x2d, 0x7e, 0x97, 0x42, 0xd9, 0xaa, 0xdb, 0xb8, 0x07, 0xeb, 0xef, 0x74, 0x75, 0xc3,
+	0x7b, 0xdb, 0x72, 0x3a, 0xb6, 0x77, 0x60, 0x0b, 0x9b, 0x68, 0xc6, 0xc5, 0x76, 0x1d, 0xb7, 0x73,
+	0x89, 0x6b, 0x89, 0xe2, 0xbc, 0x92, 0xf9, 0xed, 0x6c, 0x7d, 0xe1, 0x44, 0xb7, 0x9a, 0x15, 0x91,
+	0xad, 0x8b, 0x2a, 0x37, 0x10, 0x6e, 0xa3, 0x19, 0x1a, 0xd2, 0xcd, 0x25, 0xaf, 0xa5, 0x8a, 0x97,
+	0xb6, 0x24, 0x29, 0xa6, 0x51, 0x89, 0xa4, 0xf2, 0xb3, 0xa8, 0xc4, 0x4d, 0x49, 0x9f, 0x9e, 0xad,
+	0x5f, 0x50, 0x79, 0x0c, 0xa1, 0x8a, 0xe6, 0x3c, 0xe7, 0x08, 0xdb, 0x9a, 0x69, 0xe7, 0x52, 0x90,
+	0xfa, 0xd2, 0xd6, 0xaa, 0xc4, 0x5a, 0x96, 0x48, 0xcb, 0x41, 0x9c, 0x3d, 0x68, 0x59, 0x59, 0x21,
+	0xae, 0x50, 0xd9, 0x65, 0x56, 0x99, 0xef, 0x28, 0xaa, 0xb3, 0xf4, 0x12, 0xfa, 0xb0, 0x50, 0x96,
+	0xad, 0x42, 0x74, 0x0d, 0x60, 0xd4, 0x74, 0x9a, 0x3b, 0x97, 0xa6, 0x5d, 0xed, 0x12, 0xff, 0x1f,
+	0xce, 0xd6, 0x97, 0x59, 0x06, 0xb7, 0x7e, 0x24, 0x99, 0x8e, 0x6c, 0xe9, 0xde, 0x03, 0xe9, 0xc0,
+	0xf6, 0x20, 0xf0, 0x5a, 0x7f, 0xe0, 0xc1, 0x10, 0xa2, 0x9a, 0xa1, 0xcb, 0xef, 0x77, 0xbc, 0xaa,
+	0x69, 0xb3, 0x96, 0x84, 0xf7, 0xd0, 0x15, 0xb3, 0x66, 0x68, 0x1d, 0xfb, 0x61, 0x9b, 0x20, 0x8d,
+	0x0d, 0x6c, 0x1e, 0x03, 0x86, 0x17, 0x69, 0xb6, 0x02, 0x04, 0xcc, 0xb3, 0x80, 0x11, 0x46, 0x10,
+	0x0f, 0x56, 0x3f, 0xa0, 0x8b, 0x2a, 0x5f, 0xab, 0x94, 0x1e, 0xff, 0xfa, 0xd5, 0xcb, 0xc5, 0xa8,
+	0x91, 0x92, 0x51, 0x96, 0x30, 0x99, 0x59, 0x89, 0xd5, 0x53, 0x82, 0xbe, 0x7f, 0x4f, 0xa2, 0xab,
+	0x51, 0xe3, 0x54, 0xb1, 0xdb, 0x72, 0x6c, 0x17, 0x0b, 0x35, 0xb4, 0xd4, 0xeb, 0x85, 0x43, 0xc1,
+	0x06, 0xfc, 0xfa, 0x38, 0x28, 0x56, 0xc2, 0x50, 0xf8, 0x30, 0x2c, 0xfa, 0x30, 0x44, 0x62, 0xe0,
+	0xe2, 0x8f, 0x3b, 0xd8, 0x36, 0x30, 0x90, 0x23, 0x51, 0x4c, 0x8f, 0xc0, 0xc0, 0x37, 0xea, 0xc7,
+	0xe0, 0x1e, 0x5f, 0x13, 0xbe, 0x48, 0xa0, 0xab, 0x86, 0x03, 0x57, 0xb6, 0xd7, 0xd6, 0x3d, 0x5c,
+	0xd7, 0x28, 0x8b, 0x71, 0x17, 0x1b, 0x1d, 0xcf, 0x84, 0xae, 0x80, 0x26, 0x84, 0x76, 0xaf, 0xc5,
+	0xd2, 0x6e, 0xaf, 0x2f, 0x00, 0x83, 0x87, 0xbb, 0x2b, 0x37, 0x38, 0x87, 0x36, 0x58, 0x55, 0x71,
+	0x99, 0x44, 0x35, 0x6f, 0x8c, 0x8a, 0xe3, 0x8a, 0xa7, 0x29, 0x54, 0x20, 0xe0, 0xb7, 0x9a, 0xa6,
+	0x47, 0x99, 0x7d, 0x2e, 0x55, 0xdd, 0x0d, 0xa9, 0xea, 0xe6, 0xc4, 0xaa, 0xea, 0x15, 0x10, 0x92,
+	0xd6, 0x5b, 0x68, 0xd1, 0x57, 0x88, 0x56, 0xc7, 0xb6, 0x63, 0x51, 0x81, 0xcd, 0x2b, 0xab, 0x50,
+	0xc5, 0xf2, 0xa0, 0x82, 0xd8, 0x73, 0x51, 0x7d, 0x8e, 0xeb, 0x68, 0x9f, 0xdc, 0xfe, 0xdf, 0xc5,
+	0x54, 0x24, 0x62, 0xda, 0x88, 0x14, 0x13, 0x81, 0xac, 0x4f, 0x47, 0x8f, 0x52, 0xe8, 0xc5, 0xf8,
+	0x51, 0x3e, 0x53, 0xd4, 0xbf, 0xa0, 0xa8, 0xbf, 0x92, 0x68, 0x79, 0x78, 0x3b, 0x03, 0x5c, 0xa6,
+	0x11, 0x52, 0x35, 0x24, 0x24, 0x79, 0x42, 0x21, 0x41, 0x9a, 0x28, 0x11, 0x7d, 0x84, 0xae, 0x04,
+	0x22, 0xb1, 0xf4, 0xae, 0x3f, 0x72, 0xa6, 0xa4, 0x9d, 0x71, 0x23, 0xcf, 0x87, 0x64, 0xd6, 0x8b,
+	0x20, 0xaa, 0x4b, 0x5c, 0x6b, 0x55, 0xbd, 0xcb, 0xe7, 0x7e, 0x07, 0xcd, 0x07, 0xe4, 0xa0, 0x22,
+	0x8b, 0x3d, 0x0c, 0x73, 0x1c, 0xf6, 0xa5, 0x10, 0xad, 0x44, 0x75, 0xce, 0xe7, 0x53, 0x45, 0x22,
+	0x12, 0xd8, 0x9c, 0xec, 0x3c, 0x21, 0xae, 0x5f, 0x26, 0xd1, 0x0b, 0x91, 0x13, 0x08, 0xf8, 0xaf,
+	0xa1, 0xcb, 0x41, 0x37, 0x03, 0xf4, 0xbf, 0x35, 0x0e, 0x8b, 0xe7, 0x43, 0x58, 0xf8, 0x38, 0x2c,
+	0x70, 0x1c, 0x38, 0x08, 0x63, 0xc9, 0x9a, 0xfc, 0x6f, 0x90, 0xf5, 0x8f, 0x24, 0x5a, 0x8f, 0xdb,
+	0x33, 0xa6, 0xa4, 0xad, 0x1a, 0xa2, 0xed, 0xf6, 0xe4, 0xb4, 0x1d, 0x79, 0x00, 0x28, 0xfe, 0xac,
+	0xc8, 0x66, 0xd3, 0x7f, 0x02, 0xe4, 0xc3, 0xe3, 0x08, 0x0c, 0xfc, 0x71, 0x40, 0x58, 0x76, 0x06,
+	0x8c, 0xe0, 0x7f, 0xfa, 0x1f, 0xe0, 0x7f, 0x65, 0x93, 0xb0, 0xf5, 0xfa, 0xd8, 0x0d, 0x9b, 0x10,
+	0xf5, 0xeb, 0x24, 0x7a, 0x69, 0x0c, 0xfa, 0xcf, 0x28, 0x1b, 0xa6, 0xec, 0x9f, 0x09, 0xb4, 0x42,
+	0x40, 0xc3, 0x6c, 0xb6, 0x77, 0x74, 0xb3, 0x7d, 0xa8, 0x1f, 0xe1, 0xf6, 0xbb, 0x18, 0x4f, 0x43,
+	0xd5, 0x47, 0x09, 0x94, 0xa5, 0x64, 0xd1, 0x5a, 0x10, 0x41, 0xf3, 0x48, 0x08, 0xed, 0x3e, 0xc6,
+	0x13, 0x7d, 0x0f, 0x0c, 0x65, 0x56, 0x36, 0x78, 0x7b, 0xfc, 0x75, 0x21, 0x2a, 0x32, 0x9c, 0x6a,
+	0xf5, 0xb0, 0x5f, 0xa5, 0x4c, 0xd8, 0x12, 0xf9, 0xf9, 0xe3, 0x62, 0xaf, 0x44, 0xed, 0x4b, 0x24,
+	0x4c, 0x89, 0x86, 0x29, 0x91, 0x30, 0x3b, 0x4c, 0xb2, 0x11, 0xfd, 0x07, 0x64, 0xc9, 0xa1, 0x59,
+	0xb7, 0x63, 0x18, 0xd8, 0x75, 0x29, 0x10, 0x73, 0xaa, 0x7f, 0x2b, 0x7e, 0x9b, 0x40, 0x99, 0x48,
+	0xdc, 0x68, 0xaa, 0x57, 0x87, 0x71, 0x63, 0xeb, 0x80, 0x1b, 0xbb, 0x08, 0x4c, 0xcb, 0xf4, 0x24,
+	0x1f, 0x36, 0x2d, 0xfb, 0xa6, 0x65, 0xe1, 0x10, 0x4e, 0x82, 0x00, 0xd6, 0xd4, 0x00, 0x59, 0xd7,
+	0x86, 0xc9, 0x7a, 0x1b, 0x37, 0x74, 0xe3, 0x64, 0x1f, 0x1b, 0x7d, 0xa7, 0x41, 0x0f, 0xba, 0x39,
+	0x8f, 0xd7, 0x2a, 0x7e, 0x93, 0x40, 0xab, 0x23, 0x69, 0x26, 0xdc, 0x40, 0xb3, 0x04, 0x44, 0xcd,
+	0xac, 0xd3, 0x56, 0xd2, 0x8a, 0x00, 0xe1, 0x16, 0x59, 0x38, 0xfe, 0x00, 0x0a, 0x24, 0x57, 0x07,
+	0x75, 0xe1, 0x4d, 0xb4, 0x60, 0xb4, 0xa1, 0x0a, 0xa0, 0xa0, 0x67, 0x1a, 0x47, 0x8c, 0xe2, 0x29,
+	0x25, 0x07, 0x2e, 0x59, 0x4e, 0xd3, 0xfe, 0xc7, 0xf0, 0x66, 0xc9, 0xef, 0x0f, 0xc9, 0xad, 0xb0,
+	0x8d, 0xd0, 0x7d, 0xd3, 0xd6, 0x9b, 0xf4, 0x29, 0x6d, 0x30, 0xa5, 0x2c, 0x83, 0x6f, 0x86, 0xf9,
+	0xf6, 0x9e, 0x89, 0xea, 0x3c, 0xbd, 0x21, 0x6e, 0x5b, 0xbf, 0x5c, 0x44, 0x29, 0x98, 0x9f, 0xf0,
+	0x09, 0x4c, 0x62, 0xf8, 0x65, 0xbb, 0x1c, 0xcb, 0xbb, 0xa8, 0xcf, 0xa4, 0xfc, 0x1b, 0x53, 0xbb,
+	0x04, 0x3c, 0x01, 0x11, 0x08, 0x11, 0x3b, 0xfe, 0xd6, 0x94, 0x11, 0xc1, 0x27, 0x5f, 0x99, 0xde,
+	0x27, 0x28, 0xe3, 0xb3, 0x04, 0x5a, 0x8b, 0xfb, 0x02, 0xd9, 0x19, 0x1b, 0x7b, 0xb4, 0x73, 0x7e,
+	0xef, 0x1c, 0xce, 0x41, 0x85, 0x9f, 0xc3, 0xe6, 0x18, 0x7b, 0x48, 0xee, 0x3e, 0x75, 0x16, 0x02,
+	0xde, 0xfe, 0x79, 0xbc, 0x83, 0x22, 0x1f, 0xc3, 0x96, 0x16, 0xb9, 0x2d, 0x6e, 0x8f, 0x0d, 0x1f,
+	0xe1, 0x95, 0xdf, 0x7d, 0x1a, 0x2f, 0xbf, 0x18, 0xe5, 0xee, 0xe9, 0x4f, 0x85, 0xc4, 0x13, 0xf8,
+	0xfd, 0x08, 0xbf, 0x4f, 0x7f, 0x2e, 0x5c, 0x78, 0x02, 0xbf, 0xef, 0xe1, 0xf7, 0xe1, 0xad, 0x86,
+	0xe9, 0x3d, 0xe8, 0xd4, 0xe0, 0x9d, 0xd0, 0x92, 0x79, 0x86, 0x52, 0x53, 0xaf, 0xb9, 0xfe, 0x8d,
+	0x7c, 0xbc, 0x55, 0x96, 0xbb, 0x03, 0x7b, 0xa1, 0x77, 0xd2, 0xc2, 0x6e, 0x6d, 0x86, 0xfe, 0xfb,
+	0xe7, 0xe6, 0xdf, 0xf8, 0x85, 0xdb, 0xbb, 0xba, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -911,6 +1072,13 @@ func (m *MsgSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcUnwrapReceiver) > 0 {
+		i -= len(m.IbcUnwrapReceiver)
+		copy(dAtA[i:], m.IbcUnwrapReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcUnwrapReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -975,6 +1143,25 @@ func (m *MsgSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for iNdEx := len(m.ConcentratedSwapExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedSwapExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.IbcUnwrapSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IbcUnwrapSequence))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.TokenOutAmount.Size()
 		i -= size
@@ -1008,6 +1195,13 @@ func (m *MsgSplitRouteSwapExactAmountIn) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcUnwrapReceiver) > 0 {
+		i -= len(m.IbcUnwrapReceiver)
+		copy(dAtA[i:], m.IbcUnwrapReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IbcUnwrapReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.TokenOutMinAmount.Size()
 		i -= size
@@ -1069,6 +1263,25 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byt
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for iNdEx := len(m.ConcentratedSwapExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedSwapExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.IbcUnwrapSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IbcUnwrapSequence))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.TokenOutAmount.Size()
 		i -= size
@@ -1166,6 +1379,20 @@ func (m *MsgSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for iNdEx := len(m.ConcentratedSwapExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedSwapExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.TokenInAmount.Size()
 		i -= size
@@ -1260,6 +1487,20 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for iNdEx := len(m.ConcentratedSwapExecutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConcentratedSwapExecutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.TokenInAmount.Size()
 		i -= size
@@ -1397,6 +1638,58 @@ func (m *DenomPairTakerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConcentratedSwapExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConcentratedSwapExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConcentratedSwapExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FinalTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CrossedTicks) > 0 {
+		dAtA2 := make([]byte, len(m.CrossedTicks)*10)
+		var j1 int
+		for _, num1 := range m.CrossedTicks {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1428,6 +1721,10 @@ func (m *MsgSwapExactAmountIn) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.IbcUnwrapReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1439,6 +1736,15 @@ func (m *MsgSwapExactAmountInResponse) Size() (n int) {
 	_ = l
 	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.IbcUnwrapSequence != 0 {
+		n += 1 + sovTx(uint64(m.IbcUnwrapSequence))
+	}
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for _, e := range m.ConcentratedSwapExecutions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1464,6 +1770,10 @@ func (m *MsgSplitRouteSwapExactAmountIn) Size() (n int) {
 	}
 	l = m.TokenOutMinAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = len(m.IbcUnwrapReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1475,6 +1785,15 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Size() (n int) {
 	_ = l
 	l = m.TokenOutAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.IbcUnwrapSequence != 0 {
+		n += 1 + sovTx(uint64(m.IbcUnwrapSequence))
+	}
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for _, e := range m.ConcentratedSwapExecutions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1509,6 +1828,12 @@ func (m *MsgSwapExactAmountOutResponse) Size() (n int) {
 	_ = l
 	l = m.TokenInAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for _, e := range m.ConcentratedSwapExecutions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1545,6 +1870,12 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Size() (n int) {
 	_ = l
 	l = m.TokenInAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.ConcentratedSwapExecutions) > 0 {
+		for _, e := range m.ConcentratedSwapExecutions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1598,6 +1929,28 @@ func (m *DenomPairTakerFee) Size() (n int) {
 	return n
 }
 
+func (m *ConcentratedSwapExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.CrossedTicks) > 0 {
+		l = 0
+		for _, e := range m.CrossedTicks {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.FinalTick != 0 {
+		n += 1 + sovTx(uint64(m.FinalTick))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1766,6 +2119,38 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcUnwrapReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcUnwrapReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1850,6 +2235,59 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcUnwrapSequence", wireType)
+			}
+			m.IbcUnwrapSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcUnwrapSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedSwapExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcentratedSwapExecutions = append(m.ConcentratedSwapExecutions, ConcentratedSwapExecution{})
+			if err := m.ConcentratedSwapExecutions[len(m.ConcentratedSwapExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2032,6 +2470,38 @@ func (m *MsgSplitRouteSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcUnwrapReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcUnwrapReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2116,6 +2586,59 @@ func (m *MsgSplitRouteSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcUnwrapSequence", wireType)
+			}
+			m.IbcUnwrapSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcUnwrapSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedSwapExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcentratedSwapExecutions = append(m.ConcentratedSwapExecutions, ConcentratedSwapExecution{})
+			if err := m.ConcentratedSwapExecutions[len(m.ConcentratedSwapExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2383,6 +2906,40 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedSwapExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcentratedSwapExecutions = append(m.ConcentratedSwapExecutions, ConcentratedSwapExecution{})
+			if err := m.ConcentratedSwapExecutions[len(m.ConcentratedSwapExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2649,6 +3206,40 @@ func (m *MsgSplitRouteSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcentratedSwapExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcentratedSwapExecutions = append(m.ConcentratedSwapExecutions, ConcentratedSwapExecution{})
+			if err := m.ConcentratedSwapExecutions[len(m.ConcentratedSwapExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3004,6 +3595,171 @@ func (m *DenomPairTakerFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConcentratedSwapExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConcentratedSwapExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConcentratedSwapExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CrossedTicks = append(m.CrossedTicks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CrossedTicks) == 0 {
+					m.CrossedTicks = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CrossedTicks = append(m.CrossedTicks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossedTicks", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalTick", wireType)
+			}
+			m.FinalTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/types"
+)
+
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPortfolio)
+	return cmd
+}
+
+func GetCmdPortfolio() (*osmocli.QueryDescriptor, *queryproto.PortfolioRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "portfolio",
+		Short: "Query the balances, locks, positions, delegations and unbondings of an address",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} portfolio osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj`,
+	}, &queryproto.PortfolioRequest{}
+}
@@ -0,0 +1,32 @@
+package grpc
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/portfolio/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client/queryproto"
+)
+
+type Querier struct {
+	Q client.Querier
+}
+
+var _ queryproto.QueryServer = Querier{}
+
+func (q Querier) Portfolio(grpcCtx context.Context,
+	req *queryproto.PortfolioRequest,
+) (*queryproto.PortfolioResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Portfolio(ctx, *req)
+}
+
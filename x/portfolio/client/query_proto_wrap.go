@@ -0,0 +1,20 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client/queryproto"
+)
+
+type Querier struct {
+	K portfolio.Keeper
+}
+
+func (querier *Querier) Portfolio(ctx sdk.Context, req queryproto.PortfolioRequest) (*queryproto.PortfolioResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	return querier.K.GetPortfolio(ctx, addr)
+}
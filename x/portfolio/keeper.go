@@ -0,0 +1,88 @@
+package portfolio
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
+)
+
+// Keeper aggregates the state of an address across modules. It holds no state of its own.
+type Keeper struct {
+	bankKeeper        types.BankKeeper
+	lockupKeeper      types.LockupKeeper
+	clKeeper          types.ConcentratedLiquidityKeeper
+	stakingKeeper     types.StakingKeeper
+	superfluidQuerier types.SuperfluidQuerier
+}
+
+func NewKeeper(bankKeeper types.BankKeeper, lockupKeeper types.LockupKeeper, clKeeper types.ConcentratedLiquidityKeeper, stakingKeeper types.StakingKeeper, superfluidQuerier types.SuperfluidQuerier) *Keeper {
+	return &Keeper{
+		bankKeeper:        bankKeeper,
+		lockupKeeper:      lockupKeeper,
+		clKeeper:          clKeeper,
+		stakingKeeper:     stakingKeeper,
+		superfluidQuerier: superfluidQuerier,
+	}
+}
+
+// GetPortfolio returns the balances, locks, concentrated liquidity positions, delegations
+// and unbondings of the given address.
+// Unlike the module queries it is composed of, none of the returned lists are paginated.
+func (k Keeper) GetPortfolio(ctx sdk.Context, addr sdk.AccAddress) (*queryproto.PortfolioResponse, error) {
+	// Positions are only paginated by the concentrated liquidity module, so we request all of them in a single page.
+	positions, _, err := k.clKeeper.GetUserPositionsSerialized(ctx, addr, 0, &query.PageRequest{Limit: math.MaxUint64})
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, err := k.getDelegations(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	superfluidDelegations, err := k.superfluidQuerier.SuperfluidDelegationsByDelegator(sdk.WrapSDKContext(ctx), &superfluidtypes.SuperfluidDelegationsByDelegatorRequest{DelegatorAddress: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	superfluidUndelegations, err := k.superfluidQuerier.SuperfluidUndelegationsByDelegator(sdk.WrapSDKContext(ctx), &superfluidtypes.SuperfluidUndelegationsByDelegatorRequest{DelegatorAddress: addr.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &queryproto.PortfolioResponse{
+		Balances:                k.bankKeeper.GetAllBalances(ctx, addr),
+		Locks:                   k.lockupKeeper.GetAccountPeriodLocks(ctx, addr),
+		Positions:               positions,
+		Delegations:             delegations,
+		SuperfluidDelegations:   superfluidDelegations.SuperfluidDelegationRecords,
+		UnbondingDelegations:    k.stakingKeeper.GetUnbondingDelegations(ctx, addr, math.MaxUint16),
+		SuperfluidUndelegations: superfluidUndelegations.SuperfluidDelegationRecords,
+	}, nil
+}
+
+// getDelegations returns the native staking delegations of the given address, along with
+// the amount of tokens they are worth at the current exchange rate of their validator.
+func (k Keeper) getDelegations(ctx sdk.Context, addr sdk.AccAddress) ([]stakingtypes.DelegationResponse, error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	delegations := k.stakingKeeper.GetAllDelegatorDelegations(ctx, addr)
+	responses := make([]stakingtypes.DelegationResponse, 0, len(delegations))
+	for _, delegation := range delegations {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return nil, fmt.Errorf("validator %s of delegation not found", delegation.ValidatorAddress)
+		}
+
+		balance := sdk.NewCoin(bondDenom, validator.TokensFromShares(delegation.Shares).TruncateInt())
+		responses = append(responses, stakingtypes.NewDelegationResp(addr, delegation.GetValidatorAddr(), delegation.Shares, balance))
+	}
+	return responses, nil
+}
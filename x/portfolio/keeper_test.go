@@ -0,0 +1,84 @@
+package portfolio_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestGetPortfolio() {
+	addr := s.TestAccs[0]
+
+	// Empty portfolio.
+	portfolio, err := s.App.PortfolioKeeper.GetPortfolio(s.Ctx, s.TestAccs[1])
+	s.Require().NoError(err)
+	s.Require().Empty(portfolio.Balances)
+	s.Require().Empty(portfolio.Locks)
+	s.Require().Empty(portfolio.Positions)
+	s.Require().Empty(portfolio.Delegations)
+	s.Require().Empty(portfolio.UnbondingDelegations)
+
+	// Concentrated liquidity position.
+	pool := s.PrepareConcentratedPool()
+	positionId, _ := s.CreateFullRangePosition(pool, sdk.NewCoins(sdk.NewCoin(apptesting.ETH, osmomath.NewInt(1_000_000)), sdk.NewCoin(apptesting.USDC, osmomath.NewInt(5_000_000_000))))
+
+	// Lock.
+	lockCoins := sdk.NewCoins(sdk.NewCoin("foo", osmomath.NewInt(100)))
+	lockId := s.LockTokens(addr, lockCoins, time.Hour)
+
+	// Balance.
+	balance := sdk.NewCoins(sdk.NewCoin("bar", osmomath.NewInt(200)))
+	s.FundAcc(addr, balance)
+
+	// Delegation, half of which is unbonding.
+	valAddr := s.SetupValidator(stakingtypes.Bonded)
+	validator, found := s.App.StakingKeeper.GetValidator(s.Ctx, valAddr)
+	s.Require().True(found)
+	bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
+	s.FundAcc(addr, sdk.NewCoins(sdk.NewCoin(bondDenom, osmomath.NewInt(1_000_000))))
+	shares, err := s.App.StakingKeeper.Delegate(s.Ctx, addr, osmomath.NewInt(1_000_000), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+	_, err = s.App.StakingKeeper.Undelegate(s.Ctx, addr, valAddr, shares.QuoInt64(2))
+	s.Require().NoError(err)
+
+	portfolio, err = s.App.PortfolioKeeper.GetPortfolio(s.Ctx, addr)
+	s.Require().NoError(err)
+
+	// Position creation may refund part of the provided liquidity, so we only check the funded denom.
+	s.Require().Equal(balance.AmountOf("bar"), portfolio.Balances.AmountOf("bar"))
+
+	s.Require().Len(portfolio.Locks, 1)
+	s.Require().Equal(lockId, portfolio.Locks[0].ID)
+	s.Require().Equal(lockCoins, portfolio.Locks[0].Coins)
+
+	s.Require().Len(portfolio.Positions, 1)
+	s.Require().Equal(positionId, portfolio.Positions[0].Position.PositionId)
+
+	s.Require().Len(portfolio.Delegations, 1)
+	s.Require().Equal(valAddr.String(), portfolio.Delegations[0].Delegation.ValidatorAddress)
+	s.Require().Equal(sdk.NewCoin(bondDenom, osmomath.NewInt(500_000)), portfolio.Delegations[0].Balance)
+
+	s.Require().Len(portfolio.UnbondingDelegations, 1)
+	s.Require().Equal(valAddr.String(), portfolio.UnbondingDelegations[0].ValidatorAddress)
+
+	s.Require().Empty(portfolio.SuperfluidDelegations)
+	s.Require().Empty(portfolio.SuperfluidUndelegations)
+}
@@ -0,0 +1,96 @@
+package portfoliomodule
+
+import (
+	"context"
+	"encoding/json"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio"
+	portfolioclient "github.com/osmosis-labs/osmosis/v21/x/portfolio/client"
+	portfoliocli "github.com/osmosis-labs/osmosis/v21/x/portfolio/client/cli"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client/grpc"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/portfolio/types"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+type AppModuleBasic struct{}
+
+func (AppModuleBasic) Name() string { return types.ModuleName }
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+}
+
+// DefaultGenesis returns an empty genesis, the module holds no state.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return nil
+}
+
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	return nil
+}
+
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	queryproto.RegisterQueryHandlerClient(context.Background(), mux, queryproto.NewQueryClient(clientCtx)) //nolint:errcheck
+}
+
+func (b AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+func (b AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return portfoliocli.GetQueryCmd()
+}
+
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+}
+
+type AppModule struct {
+	AppModuleBasic
+
+	k portfolio.Keeper
+}
+
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	queryproto.RegisterQueryServer(cfg.QueryServer(), grpc.Querier{Q: portfolioclient.Querier{K: am.k}})
+}
+
+func NewAppModule(k portfolio.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		k:              k,
+	}
+}
+
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+
+func (AppModule) QuerierRoute() string { return types.RouterKey }
+
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return nil
+}
+
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {}
+
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return []abci.ValidatorUpdate{}
+}
+
+func (AppModule) ConsensusVersion() uint64 { return 1 }
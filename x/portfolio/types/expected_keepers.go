@@ -0,0 +1,42 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/model"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
+)
+
+// BankKeeper defines the expected interface needed to retrieve account balances.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// LockupKeeper defines the expected interface needed to retrieve account locks.
+type LockupKeeper interface {
+	GetAccountPeriodLocks(ctx sdk.Context, addr sdk.AccAddress) []lockuptypes.PeriodLock
+}
+
+// ConcentratedLiquidityKeeper defines the expected interface needed to retrieve concentrated liquidity positions.
+type ConcentratedLiquidityKeeper interface {
+	GetUserPositionsSerialized(ctx sdk.Context, addr sdk.AccAddress, poolId uint64, pagination *query.PageRequest) ([]model.FullPositionBreakdown, *query.PageResponse, error)
+}
+
+// StakingKeeper defines the expected interface needed to retrieve delegations and unbondings.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+	GetUnbondingDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []stakingtypes.UnbondingDelegation
+}
+
+// SuperfluidQuerier defines the expected interface needed to retrieve superfluid delegations and undelegations.
+type SuperfluidQuerier interface {
+	SuperfluidDelegationsByDelegator(ctx context.Context, req *superfluidtypes.SuperfluidDelegationsByDelegatorRequest) (*superfluidtypes.SuperfluidDelegationsByDelegatorResponse, error)
+	SuperfluidUndelegationsByDelegator(ctx context.Context, req *superfluidtypes.SuperfluidUndelegationsByDelegatorRequest) (*superfluidtypes.SuperfluidUndelegationsByDelegatorResponse, error)
+}
@@ -0,0 +1,8 @@
+package types
+
+const (
+	ModuleName = "portfolio"
+	RouterKey  = ModuleName
+
+	QuerierRoute = ModuleName
+)
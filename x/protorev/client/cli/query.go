@@ -31,6 +31,8 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryInfoByPoolTypeCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProtocolRevenueCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryTokenPairArbRoutesByDenomsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolPointsConsumptionCmd)
 
 	return cmd
 }
@@ -166,6 +168,23 @@ func NewQueryAllProtocolRevenueCmd() (*osmocli.QueryDescriptor, *types.QueryGetA
 	}, &types.QueryGetAllProtocolRevenueRequest{}
 }
 
+// NewQueryTokenPairArbRoutesByDenomsCmd returns the command to query the hot routes of a token pair
+func NewQueryTokenPairArbRoutesByDenomsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "hot-routes-by-denoms",
+		Short: "Query the ProtoRev hot routes currently being used for a token pair",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} hot-routes-by-denoms uosmo ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2`,
+	}, &types.QueryGetProtoRevTokenPairArbRoutesByDenomsRequest{}
+}
+
+// NewQueryPoolPointsConsumptionCmd returns the command to query the pool points consumed in the latest block
+func NewQueryPoolPointsConsumptionCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevPoolPointsConsumptionRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-points-consumption",
+		Short: "Query the number of pool points consumed in the latest block protorev ran on",
+	}, &types.QueryGetProtoRevPoolPointsConsumptionRequest{}
+}
+
 // convert a string array "[1,2,3]" to []uint64
 //
 //nolint:unparam
@@ -248,7 +248,7 @@ func CmdSetProtoRevEnabledProposal() *cobra.Command {
 
 // ProposalExecute is a helper function to execute a proposal command. It takes in a function to create the proposal content.
 func ProposalExecute(cmd *cobra.Command, args []string, createContent func(title string, description string, args ...string) (govtypesv1beta1.Content, error)) error {
-	clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+	clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 	if err != nil {
 		return err
 	}
@@ -265,7 +265,7 @@ func ProposalExecute(cmd *cobra.Command, args []string, createContent func(title
 
 	msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-	proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+	proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 	if err != nil {
 		return err
 	}
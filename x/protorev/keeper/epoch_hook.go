@@ -82,13 +82,22 @@ func (k Keeper) UpdatePools(ctx sdk.Context) error {
 // UpdateHighestLiquidityPools updates the baseDenomPools map (passed in by reference) with the
 // highest liquidity pools for each base denom by iterating through all pools, getting the
 // total liquidity for each pool, and updating the highest liquidity pools based upon comparing total liquidity.
+// Cosmwasm pools are only considered if their contract has a weight in the pool info.
 func (k Keeper) UpdateHighestLiquidityPools(ctx sdk.Context, baseDenomPools map[string]map[string]LiquidityPoolStruct) error {
 	pools, err := k.poolmanagerKeeper.AllPools(ctx)
 	if err != nil {
 		return err
 	}
 
+	infoByPoolType := k.GetInfoByPoolType(ctx)
+
 	for _, pool := range pools {
+		// Skip the pools that cannot be routed through, i.e. the cosmwasm pools whose contract
+		// does not have a weight. Otherwise, they would shadow lower liquidity pools that can.
+		if _, err := getPoolPoints(pool, infoByPoolType); err != nil {
+			continue
+		}
+
 		coins, err := k.poolmanagerKeeper.GetTotalPoolLiquidity(ctx, pool.GetId())
 		if err != nil {
 			return err
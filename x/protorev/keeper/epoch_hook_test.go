@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
@@ -181,6 +183,38 @@ func (s *KeeperTestSuite) TestUpdateHighestLiquidityPools() {
 	}
 }
 
+// TestUpdateHighestLiquidityPools_CosmwasmPoolWeight tests that cosmwasm pools are only considered
+// by UpdateHighestLiquidityPools if their contract has a weight, since routes cannot go through them otherwise.
+func (s *KeeperTestSuite) TestUpdateHighestLiquidityPools_CosmwasmPoolWeight() {
+	s.SetupTest()
+
+	cwFunds := sdk.NewCoins(sdk.NewCoin("epochThree", osmomath.NewInt(1000000)), sdk.NewCoin("uosmo", osmomath.NewInt(1000000)))
+	s.FundAcc(s.TestAccs[0], cwFunds)
+	cwPool := s.PrepareCustomTransmuterPool(s.TestAccs[0], []string{"epochThree", "uosmo"})
+	s.JoinTransmuterPool(s.TestAccs[0], cwPool.GetId(), cwFunds)
+
+	// The contract of the pool does not have a weight, so the pool is skipped.
+	baseDenomPools := map[string]map[string]keeper.LiquidityPoolStruct{"epochThree": {}}
+	err := s.App.ProtoRevKeeper.UpdateHighestLiquidityPools(s.Ctx, baseDenomPools)
+	s.Require().NoError(err)
+	s.Require().Empty(baseDenomPools["epochThree"])
+
+	// Once the contract has a weight, the pool is considered.
+	poolInfo := s.App.ProtoRevKeeper.GetInfoByPoolType(s.Ctx)
+	poolInfo.Cosmwasm.WeightMaps = append(poolInfo.Cosmwasm.WeightMaps, types.WeightMap{
+		ContractAddress: cwPool.GetContractAddress(),
+		Weight:          4,
+	})
+	s.App.ProtoRevKeeper.SetInfoByPoolType(s.Ctx, poolInfo)
+
+	baseDenomPools = map[string]map[string]keeper.LiquidityPoolStruct{"epochThree": {}}
+	err = s.App.ProtoRevKeeper.UpdateHighestLiquidityPools(s.Ctx, baseDenomPools)
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]keeper.LiquidityPoolStruct{
+		"uosmo": {Liquidity: osmomath.NewInt(1000000 * 1000000), PoolId: cwPool.GetId()},
+	}, baseDenomPools["epochThree"])
+}
+
 func contains(baseDenoms []types.BaseDenom, denomToMatch string) bool {
 	for _, baseDenom := range baseDenoms {
 		if baseDenom.Denom == denomToMatch {
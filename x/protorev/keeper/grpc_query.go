@@ -254,3 +254,47 @@ func (q Querier) GetAllProtocolRevenue(c context.Context, req *types.QueryGetAll
 
 	return &types.QueryGetAllProtocolRevenueResponse{AllProtocolRevenue: allProtocolRevenue}, nil
 }
+
+// GetProtoRevTokenPairArbRoutesByDenoms queries the hot routes that the module is currently arbitraging for a given token pair
+func (q Querier) GetProtoRevTokenPairArbRoutesByDenoms(c context.Context, req *types.QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) (*types.QueryGetProtoRevTokenPairArbRoutesByDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	routes, err := q.Keeper.GetTokenPairArbRoutes(ctx, req.TokenIn, req.TokenOut)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevTokenPairArbRoutesByDenomsResponse{Routes: routes}, nil
+}
+
+// GetProtoRevPoolPointsConsumption queries the number of pool points consumed in the latest block the module ran on
+func (q Querier) GetProtoRevPoolPointsConsumption(c context.Context, req *types.QueryGetProtoRevPoolPointsConsumptionRequest) (*types.QueryGetProtoRevPoolPointsConsumptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	blockHeight, err := q.Keeper.GetLatestBlockHeight(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pointsConsumed, err := q.Keeper.GetPointCountForBlock(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	maxPointsPerBlock, err := q.Keeper.GetMaxPointsPerBlock(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevPoolPointsConsumptionResponse{
+		BlockHeight:           blockHeight,
+		PoolPointsConsumed:    pointsConsumed,
+		MaxPoolPointsPerBlock: maxPointsPerBlock,
+	}, nil
+}
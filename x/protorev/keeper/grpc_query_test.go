@@ -256,6 +256,38 @@ func (s *KeeperTestSuite) TestGetProtoRevTokenPairArbRoutes() {
 	}
 }
 
+// TestGetProtoRevTokenPairArbRoutesByDenoms tests the query to retrieve the hot routes of a single token pair
+func (s *KeeperTestSuite) TestGetProtoRevTokenPairArbRoutesByDenoms() {
+	// Request for a token pair without hot routes should return an error
+	req := &types.QueryGetProtoRevTokenPairArbRoutesByDenomsRequest{TokenIn: "akash", TokenOut: "bitcoin"}
+	res, err := s.queryClient.GetProtoRevTokenPairArbRoutesByDenoms(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().Error(err)
+	s.Require().Nil(res)
+
+	// Hot routes are set at startup for the test suite
+	for _, tokenPairArbRoutes := range s.tokenPairArbRoutes {
+		req = &types.QueryGetProtoRevTokenPairArbRoutesByDenomsRequest{TokenIn: tokenPairArbRoutes.TokenIn, TokenOut: tokenPairArbRoutes.TokenOut}
+		res, err = s.queryClient.GetProtoRevTokenPairArbRoutesByDenoms(sdk.WrapSDKContext(s.Ctx), req)
+		s.Require().NoError(err)
+		s.Require().Equal(tokenPairArbRoutes, res.Routes)
+	}
+}
+
+// TestGetProtoRevPoolPointsConsumption tests the query to retrieve the pool points consumed in the latest block
+func (s *KeeperTestSuite) TestGetProtoRevPoolPointsConsumption() {
+	s.App.AppKeepers.ProtoRevKeeper.SetLatestBlockHeight(s.Ctx, 10)
+	s.App.AppKeepers.ProtoRevKeeper.SetPointCountForBlock(s.Ctx, 25)
+	err := s.App.AppKeepers.ProtoRevKeeper.SetMaxPointsPerBlock(s.Ctx, 40)
+	s.Require().NoError(err)
+
+	req := &types.QueryGetProtoRevPoolPointsConsumptionRequest{}
+	res, err := s.queryClient.GetProtoRevPoolPointsConsumption(sdk.WrapSDKContext(s.Ctx), req)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), res.BlockHeight)
+	s.Require().Equal(uint64(25), res.PoolPointsConsumed)
+	s.Require().Equal(uint64(40), res.MaxPoolPointsPerBlock)
+}
+
 // TestGetProtoRevAdminAccount tests the query to retrieve the admin account
 func (s *KeeperTestSuite) TestGetProtoRevAdminAccount() {
 	req := &types.QueryGetProtoRevAdminAccountRequest{}
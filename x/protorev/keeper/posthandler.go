@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/protorev/types"
 )
 
 type SwapToBackrun struct {
@@ -46,6 +49,9 @@ func (protoRevDec ProtoRevDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simu
 
 	// Check if the protorev posthandler can be executed
 	if err := protoRevDec.ProtoRevKeeper.AnteHandleCheck(cacheCtx); err != nil {
+		if errors.As(err, &types.MaxPoolPointsPerBlockReachedError{}) {
+			telemetry.IncrCounter(1, types.ModuleName, types.MetricKeyBlockPoolPointsExhausted)
+		}
 		return next(ctx, tx, success, simulate)
 	}
 
@@ -59,6 +65,7 @@ func (protoRevDec ProtoRevDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simu
 	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools); err == nil {
 		write()
 		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		protoRevDec.ProtoRevKeeper.emitPoolPointsTelemetry(ctx)
 	} else {
 		ctx.Logger().Error("ProtoRevTrade failed with error: " + err.Error())
 	}
@@ -97,7 +104,7 @@ func (k Keeper) AnteHandleCheck(ctx sdk.Context) error {
 	blockHeight := uint64(ctx.BlockHeight())
 	if blockHeight == latestBlockHeight {
 		if currentRouteCount >= maxRouteCount {
-			return fmt.Errorf("max pool points for the current block has been reached")
+			return types.MaxPoolPointsPerBlockReachedError{MaxPoolPointsPerBlock: maxRouteCount}
 		}
 	} else {
 		// Reset the current pool point count
@@ -108,6 +115,27 @@ func (k Keeper) AnteHandleCheck(ctx sdk.Context) error {
 	return nil
 }
 
+// emitPoolPointsTelemetry sets the gauges of pool points consumed and remaining in the current block.
+func (k Keeper) emitPoolPointsTelemetry(ctx sdk.Context) {
+	pointCount, err := k.GetPointCountForBlock(ctx)
+	if err != nil {
+		return
+	}
+
+	maxPointCount, err := k.GetMaxPointsPerBlock(ctx)
+	if err != nil {
+		return
+	}
+
+	remainingPointCount := uint64(0)
+	if maxPointCount > pointCount {
+		remainingPointCount = maxPointCount - pointCount
+	}
+
+	telemetry.ModuleSetGauge(types.ModuleName, float32(pointCount), types.MetricKeyBlockPoolPointsConsumed)
+	telemetry.ModuleSetGauge(types.ModuleName, float32(remainingPointCount), types.MetricKeyBlockPoolPointsRemaining)
+}
+
 // ProtoRevTrade wraps around the build routes, iterate routes, and execute trade functionality to execute cyclic arbitrage trades
 // if they exist. It returns an error if there was an issue executing any single trade.
 func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (err error) {
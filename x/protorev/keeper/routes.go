@@ -236,30 +236,12 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 			return 0, err
 		}
 
-		switch pool.GetType() {
-		case poolmanagertypes.Balancer:
-			totalWeight += infoByPoolType.Balancer.Weight
-		case poolmanagertypes.Stableswap:
-			totalWeight += infoByPoolType.Stable.Weight
-		case poolmanagertypes.Concentrated:
-			totalWeight += infoByPoolType.Concentrated.Weight
-		case poolmanagertypes.CosmWasm:
-			weight, ok := uint64(0), false
-			for _, weightMap := range infoByPoolType.Cosmwasm.WeightMaps {
-				if weightMap.ContractAddress == pool.GetAddress().String() {
-					weight = weightMap.Weight
-					ok = true
-					break
-				}
-			}
-			if !ok {
-				return 0, fmt.Errorf("cosmwasm pool %d does not have a weight", poolId)
-			}
-
-			totalWeight += weight
-		default:
-			return 0, fmt.Errorf("invalid pool type")
+		poolPoints, err := getPoolPoints(pool, infoByPoolType)
+		if err != nil {
+			return 0, err
 		}
+
+		totalWeight += poolPoints
 	}
 
 	remainingPoolPoints, _, err := k.GetRemainingPoolPoints(ctx)
@@ -275,6 +257,28 @@ func (k Keeper) CalculateRoutePoolPoints(ctx sdk.Context, route poolmanagertypes
 	return totalWeight, nil
 }
 
+// getPoolPoints returns the number of pool points consumed by simulating and executing a swap through the given pool.
+// Returns error if the pool is a cosmwasm pool whose contract does not have a weight, since such pools cannot be routed through.
+func getPoolPoints(pool poolmanagertypes.PoolI, infoByPoolType types.InfoByPoolType) (uint64, error) {
+	switch pool.GetType() {
+	case poolmanagertypes.Balancer:
+		return infoByPoolType.Balancer.Weight, nil
+	case poolmanagertypes.Stableswap:
+		return infoByPoolType.Stable.Weight, nil
+	case poolmanagertypes.Concentrated:
+		return infoByPoolType.Concentrated.Weight, nil
+	case poolmanagertypes.CosmWasm:
+		for _, weightMap := range infoByPoolType.Cosmwasm.WeightMaps {
+			if weightMap.ContractAddress == pool.GetAddress().String() {
+				return weightMap.Weight, nil
+			}
+		}
+		return 0, fmt.Errorf("cosmwasm pool %d does not have a weight", pool.GetId())
+	default:
+		return 0, fmt.Errorf("invalid pool type")
+	}
+}
+
 // IsValidPool checks if the pool is active and exists
 func (k Keeper) IsValidPool(ctx sdk.Context, poolID uint64) error {
 	pool, err := k.poolmanagerKeeper.GetPool(ctx, poolID)
@@ -44,3 +44,15 @@ const (
 
 // All other years (5% of total profit)
 const ProfitSplitPhase3 int64 = 5
+
+// ---------------------- Module Telemetry Keys ---------------------- //
+
+const (
+	// MetricKeyBlockPoolPointsConsumed is the gauge of pool points consumed in the current block
+	MetricKeyBlockPoolPointsConsumed = "block_pool_points_consumed"
+	// MetricKeyBlockPoolPointsRemaining is the gauge of pool points left to consume in the current block
+	MetricKeyBlockPoolPointsRemaining = "block_pool_points_remaining"
+	// MetricKeyBlockPoolPointsExhausted counts the transactions that were not backrun because
+	// the pool points of the current block were all consumed
+	MetricKeyBlockPoolPointsExhausted = "block_pool_points_exhausted"
+)
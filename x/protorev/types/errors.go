@@ -10,3 +10,11 @@ type NoPoolForDenomPairError struct {
 func (e NoPoolForDenomPairError) Error() string {
 	return fmt.Sprintf("highest liquidity pool between base %s and match denom %s not found", e.BaseDenom, e.MatchDenom)
 }
+
+type MaxPoolPointsPerBlockReachedError struct {
+	MaxPoolPointsPerBlock uint64
+}
+
+func (e MaxPoolPointsPerBlockReachedError) Error() string {
+	return fmt.Sprintf("max pool points for the current block has been reached (%d)", e.MaxPoolPointsPerBlock)
+}
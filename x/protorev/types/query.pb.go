@@ -1469,6 +1469,229 @@ func (m *QueryGetAllProtocolRevenueResponse) GetAllProtocolRevenue() AllProtocol
 	return AllProtocolRevenue{}
 }
 
+// QueryGetProtoRevTokenPairArbRoutesByDenomsRequest is request type for the
+// Query/GetProtoRevTokenPairArbRoutesByDenoms RPC method.
+type QueryGetProtoRevTokenPairArbRoutesByDenomsRequest struct {
+	// token_in is the denom swapped into the pool whose hot routes are queried
+	TokenIn string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	// token_out is the denom swapped out of the pool whose hot routes are queried
+	TokenOut string `protobuf:"bytes,2,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty" yaml:"token_out"`
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) Reset() {
+	*m = QueryGetProtoRevTokenPairArbRoutesByDenomsRequest{}
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) ProtoMessage() {}
+func (*QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) GetTokenOut() string {
+	if m != nil {
+		return m.TokenOut
+	}
+	return ""
+}
+
+// QueryGetProtoRevTokenPairArbRoutesByDenomsResponse is response type for the
+// Query/GetProtoRevTokenPairArbRoutesByDenoms RPC method.
+type QueryGetProtoRevTokenPairArbRoutesByDenomsResponse struct {
+	// routes are the hot routes configured for the token pair
+	Routes TokenPairArbRoutes `protobuf:"bytes,1,opt,name=routes,proto3" json:"routes" yaml:"routes"`
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) Reset() {
+	*m = QueryGetProtoRevTokenPairArbRoutesByDenomsResponse{}
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) ProtoMessage() {}
+func (*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevTokenPairArbRoutesByDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) GetRoutes() TokenPairArbRoutes {
+	if m != nil {
+		return m.Routes
+	}
+	return TokenPairArbRoutes{}
+}
+
+// QueryGetProtoRevPoolPointsConsumptionRequest is request type for the
+// Query/GetProtoRevPoolPointsConsumption RPC method.
+type QueryGetProtoRevPoolPointsConsumptionRequest struct {
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) Reset() {
+	*m = QueryGetProtoRevPoolPointsConsumptionRequest{}
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevPoolPointsConsumptionRequest) ProtoMessage() {}
+func (*QueryGetProtoRevPoolPointsConsumptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevPoolPointsConsumptionResponse is response type for the
+// Query/GetProtoRevPoolPointsConsumption RPC method.
+type QueryGetProtoRevPoolPointsConsumptionResponse struct {
+	// block_height is the latest block height the module ran on
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty" yaml:"block_height"`
+	// pool_points_consumed is the number of pool points consumed in that block
+	PoolPointsConsumed uint64 `protobuf:"varint,2,opt,name=pool_points_consumed,json=poolPointsConsumed,proto3" json:"pool_points_consumed,omitempty" yaml:"pool_points_consumed"`
+	// max_pool_points_per_block is the maximum number of pool points that can be
+	// consumed per block
+	MaxPoolPointsPerBlock uint64 `protobuf:"varint,3,opt,name=max_pool_points_per_block,json=maxPoolPointsPerBlock,proto3" json:"max_pool_points_per_block,omitempty" yaml:"max_pool_points_per_block"`
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) Reset() {
+	*m = QueryGetProtoRevPoolPointsConsumptionResponse{}
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevPoolPointsConsumptionResponse) ProtoMessage() {}
+func (*QueryGetProtoRevPoolPointsConsumptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevPoolPointsConsumptionResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) GetPoolPointsConsumed() uint64 {
+	if m != nil {
+		return m.PoolPointsConsumed
+	}
+	return 0
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) GetMaxPoolPointsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPoolPointsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
@@ -1502,6 +1725,10 @@ func init() {
 	proto.RegisterType((*QueryGetProtoRevPoolResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolResponse")
 	proto.RegisterType((*QueryGetAllProtocolRevenueRequest)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueRequest")
 	proto.RegisterType((*QueryGetAllProtocolRevenueResponse)(nil), "osmosis.protorev.v1beta1.QueryGetAllProtocolRevenueResponse")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesByDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesByDenomsRequest")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesByDenomsResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolPointsConsumptionRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolPointsConsumptionRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolPointsConsumptionResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolPointsConsumptionResponse")
 }
 
 func init() {
@@ -1509,108 +1736,121 @@ func init() {
 }
 
 var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6f, 0xdb, 0xd4,
-	0x1b, 0xae, 0xf7, 0xd1, 0xfe, 0x76, 0xf6, 0xf1, 0x5b, 0x0f, 0x6d, 0x97, 0xba, 0x5d, 0x92, 0x9e,
-	0xb6, 0xeb, 0xd7, 0x9a, 0xb0, 0x0f, 0x60, 0x7c, 0x0c, 0xa8, 0x57, 0x98, 0xaa, 0x89, 0x35, 0x98,
-	0x72, 0x03, 0x12, 0xc1, 0x49, 0xdc, 0xce, 0x9a, 0xe3, 0xe3, 0xd9, 0x4e, 0xd5, 0xdc, 0x32, 0x09,
-	0x84, 0x84, 0xc4, 0xd7, 0x1f, 0x00, 0xd7, 0x88, 0x7f, 0x80, 0x4b, 0xb8, 0x9a, 0xe0, 0x66, 0x08,
-	0x09, 0xa1, 0x81, 0x22, 0xb4, 0x71, 0xc1, 0x75, 0xfe, 0x00, 0x84, 0x7c, 0xce, 0xeb, 0xc4, 0xf1,
-	0xb1, 0xd3, 0xb8, 0x95, 0xb8, 0x4b, 0x7c, 0xde, 0xf7, 0x79, 0x9f, 0xe7, 0x7c, 0x3e, 0x2f, 0x9a,
-	0xa3, 0x6e, 0x9d, 0xba, 0x86, 0x5b, 0xb4, 0x1d, 0xea, 0x51, 0x47, 0xdf, 0x2d, 0xee, 0x5e, 0xaa,
-	0xe8, 0x9e, 0x76, 0xa9, 0x78, 0xaf, 0xa1, 0x3b, 0xcd, 0x02, 0xfb, 0x8c, 0x33, 0x10, 0x55, 0x08,
-	0xa2, 0x0a, 0x10, 0x25, 0x8f, 0xed, 0xd0, 0x1d, 0xca, 0xbe, 0x16, 0xfd, 0x5f, 0x3c, 0x40, 0x9e,
-	0xde, 0xa1, 0x74, 0xc7, 0xd4, 0x8b, 0x9a, 0x6d, 0x14, 0x35, 0xcb, 0xa2, 0x9e, 0xe6, 0x19, 0xd4,
-	0x82, 0x74, 0x79, 0xb9, 0xca, 0xe0, 0x8a, 0x15, 0xcd, 0xd5, 0x79, 0x99, 0x4e, 0x51, 0x5b, 0xdb,
-	0x31, 0x2c, 0x16, 0x0c, 0xb1, 0xf3, 0x89, 0xfc, 0x6c, 0xcd, 0xd1, 0xea, 0x01, 0xe4, 0x42, 0x72,
-	0x58, 0xc0, 0x98, 0x07, 0x66, 0xc3, 0xb5, 0x83, 0x98, 0x2a, 0x35, 0xa0, 0x1e, 0x19, 0x43, 0xf8,
-	0x4d, 0x9f, 0x51, 0x89, 0xa1, 0xab, 0xfa, 0xbd, 0x86, 0xee, 0x7a, 0x64, 0x1b, 0x3d, 0xd5, 0xf3,
-	0xd5, 0xb5, 0xa9, 0xe5, 0xea, 0x78, 0x13, 0x0d, 0x73, 0x16, 0x19, 0x29, 0x2f, 0x2d, 0x9e, 0xbc,
-	0x9c, 0x2f, 0x24, 0xcd, 0x53, 0x81, 0x67, 0x2a, 0xe3, 0x0f, 0x5a, 0xb9, 0xa1, 0x76, 0x2b, 0x77,
-	0xba, 0xa9, 0xd5, 0xcd, 0x17, 0x08, 0xcf, 0x26, 0x2a, 0xc0, 0x90, 0x05, 0x34, 0xcf, 0xea, 0xdc,
-	0xd4, 0xbd, 0x92, 0x8f, 0xa0, 0xea, 0xbb, 0xb7, 0x1b, 0xf5, 0x8a, 0xee, 0x6c, 0x6e, 0x6f, 0x39,
-	0x5a, 0x4d, 0xef, 0x10, 0xfa, 0x44, 0x42, 0x17, 0xf6, 0x8b, 0x04, 0x92, 0x15, 0x74, 0xd6, 0x62,
-	0x23, 0x65, 0xba, 0x5d, 0xf6, 0xd8, 0x18, 0xa3, 0x7b, 0x42, 0xb9, 0xe6, 0x93, 0x79, 0xd4, 0xca,
-	0x8d, 0xf3, 0x39, 0x71, 0x6b, 0x77, 0x0b, 0x06, 0x2d, 0xd6, 0x35, 0xef, 0x4e, 0x61, 0xc3, 0xf2,
-	0xda, 0xad, 0xdc, 0x39, 0xce, 0x32, 0x9a, 0x4e, 0xd4, 0x33, 0x56, 0x4f, 0x2d, 0xb2, 0x29, 0xf2,
-	0x2e, 0x39, 0x74, 0xdb, 0xf0, 0x5c, 0xa5, 0xb9, 0xae, 0x5b, 0xb4, 0x0e, 0xbc, 0xf1, 0x05, 0x74,
-	0xbc, 0xe6, 0xff, 0x07, 0x06, 0x67, 0xdb, 0xad, 0xdc, 0x29, 0x5e, 0x84, 0x7d, 0x26, 0x2a, 0x1f,
-	0x26, 0x96, 0x28, 0x2f, 0x0a, 0x08, 0xf2, 0xd6, 0xd1, 0xb0, 0xcd, 0x46, 0x60, 0x0d, 0x26, 0x0b,
-	0x5c, 0x4d, 0xc1, 0x5f, 0xe1, 0xce, 0xf4, 0xdf, 0xa0, 0x86, 0xa5, 0x8c, 0x86, 0x26, 0x9e, 0xa5,
-	0xf8, 0x13, 0xcf, 0x7f, 0xcc, 0xa2, 0x99, 0x68, 0xbd, 0x35, 0xd3, 0x84, 0x92, 0xc1, 0xa4, 0xdf,
-	0x43, 0xa4, 0x5f, 0x10, 0x10, 0xba, 0x85, 0x46, 0x38, 0xa8, 0x3f, 0xcd, 0x47, 0xfb, 0x33, 0x9a,
-	0x80, 0xed, 0x70, 0x26, 0xcc, 0xca, 0x25, 0xea, 0x48, 0xe7, 0x17, 0x5a, 0x8c, 0x96, 0x7c, 0xcb,
-	0x3f, 0x4c, 0xae, 0x67, 0x54, 0x5d, 0xa5, 0xa9, 0xd2, 0x86, 0xa7, 0x87, 0xe6, 0xd6, 0xf1, 0xff,
-	0xb3, 0xb2, 0xc7, 0xc2, 0x73, 0xcb, 0x3e, 0x13, 0x95, 0x0f, 0x93, 0xcf, 0x25, 0xb4, 0x34, 0x00,
-	0x28, 0xc8, 0xa9, 0x21, 0xe4, 0x76, 0x06, 0x61, 0x8e, 0x97, 0x92, 0xf7, 0x39, 0x4b, 0x0e, 0xa1,
-	0x4d, 0x82, 0xc2, 0x51, 0xce, 0xa4, 0x0b, 0x45, 0xd4, 0x10, 0x2e, 0x59, 0x11, 0x29, 0xad, 0x99,
-	0x66, 0x04, 0x2c, 0x58, 0x87, 0x2f, 0x24, 0xb4, 0x3c, 0x48, 0x74, 0x82, 0x82, 0xa3, 0xff, 0x95,
-	0x82, 0x2d, 0x7a, 0x57, 0xb7, 0x4a, 0x9a, 0xe1, 0xac, 0x39, 0x15, 0x86, 0xda, 0x51, 0xf0, 0x71,
-	0x8c, 0x82, 0xb8, 0x68, 0x50, 0xf0, 0x2e, 0x1a, 0x66, 0x4b, 0x17, 0xb0, 0xbf, 0x98, 0xcc, 0x5e,
-	0x44, 0x89, 0xde, 0x39, 0x1c, 0x89, 0xa8, 0x00, 0x49, 0xe6, 0xd1, 0xac, 0x30, 0x99, 0xb5, 0xba,
-	0x61, 0xad, 0x55, 0xab, 0xb4, 0x61, 0x79, 0x01, 0x65, 0x1d, 0xcd, 0xf5, 0x0f, 0x03, 0xae, 0xd7,
-	0xd1, 0x69, 0xcd, 0xff, 0x5e, 0xd6, 0xf8, 0x00, 0x9c, 0xf4, 0x4c, 0xbb, 0x95, 0x1b, 0xe3, 0x04,
-	0x7a, 0x86, 0x89, 0x7a, 0x4a, 0x0b, 0xc1, 0x90, 0x25, 0xb4, 0x10, 0x2d, 0xb3, 0xae, 0xef, 0xea,
-	0x26, 0xb5, 0x75, 0x27, 0xc2, 0xa8, 0x21, 0x9e, 0x0d, 0x31, 0x14, 0x58, 0x6d, 0xa0, 0xd1, 0x5a,
-	0x30, 0x16, 0x61, 0x36, 0xdd, 0x6e, 0xe5, 0x32, 0xc1, 0x1d, 0x14, 0x09, 0x21, 0xea, 0xd9, 0x5a,
-	0x04, 0x32, 0xee, 0x8e, 0xde, 0xb0, 0xb6, 0xa9, 0xd2, 0x2c, 0x51, 0x6a, 0x6e, 0x35, 0xed, 0xe0,
-	0x3c, 0x92, 0xaf, 0x62, 0xee, 0xe8, 0x68, 0x24, 0xd0, 0x6b, 0xa0, 0x51, 0xc3, 0xda, 0xa6, 0xe5,
-	0x4a, 0xb3, 0x6c, 0x53, 0x6a, 0x96, 0xbd, 0xa6, 0xad, 0xc3, 0x59, 0x5b, 0x4c, 0x5e, 0xeb, 0x5e,
-	0x30, 0x25, 0x0f, 0xeb, 0x0c, 0x62, 0x04, 0x40, 0xa2, 0x9e, 0x31, 0x7a, 0x32, 0x48, 0x01, 0x5d,
-	0x8c, 0x12, 0x7c, 0x43, 0xdb, 0xf3, 0x87, 0x4b, 0xd4, 0xb0, 0x3c, 0xb7, 0xa4, 0x3b, 0x8a, 0x49,
-	0xab, 0x77, 0x03, 0x45, 0x9f, 0x4a, 0x68, 0x75, 0xc0, 0x04, 0x10, 0xf6, 0x1e, 0x9a, 0xac, 0x6b,
-	0x7b, 0x9c, 0x83, 0xcd, 0x42, 0xca, 0xfe, 0xf4, 0x56, 0xfc, 0x20, 0x26, 0xf0, 0x98, 0x32, 0xd7,
-	0x6e, 0xe5, 0xf2, 0x9c, 0x72, 0x62, 0x28, 0x51, 0xc7, 0xeb, 0x71, 0x75, 0xe2, 0x4e, 0x5d, 0x94,
-	0xd0, 0xd6, 0x5e, 0x40, 0xff, 0x7e, 0xcc, 0xa9, 0x8b, 0x8b, 0x06, 0xee, 0x6f, 0xa3, 0x89, 0x38,
-	0x42, 0xde, 0x1e, 0x10, 0x9f, 0x69, 0xb7, 0x72, 0xe7, 0x93, 0x89, 0x7b, 0x7b, 0x44, 0xc5, 0x75,
-	0x01, 0x3e, 0xee, 0xa9, 0x51, 0x34, 0x57, 0x67, 0xaf, 0x5a, 0xe7, 0x82, 0xf8, 0x50, 0x12, 0xdf,
-	0x9a, 0x70, 0x14, 0x50, 0x7c, 0x1f, 0x9d, 0xf4, 0x1f, 0x95, 0x32, 0x7b, 0x34, 0x83, 0xdb, 0x61,
-	0x36, 0x79, 0xc7, 0x74, 0x20, 0x14, 0x19, 0x36, 0x0b, 0xe6, 0x02, 0x42, 0x28, 0x44, 0x45, 0x95,
-	0x4e, 0x25, 0x92, 0x47, 0xd9, 0x28, 0x8f, 0xd7, 0x2c, 0xad, 0x62, 0xea, 0xb5, 0x80, 0xea, 0x26,
-	0xca, 0x25, 0x46, 0x00, 0xcd, 0x8b, 0x68, 0x44, 0xe7, 0x9f, 0xd8, 0xd4, 0xfd, 0x4f, 0xc1, 0xdd,
-	0x37, 0x0f, 0x06, 0x88, 0x1a, 0x84, 0xf8, 0xde, 0x66, 0x4a, 0x78, 0xfc, 0x29, 0x35, 0x83, 0x77,
-	0xee, 0x2a, 0x42, 0x5d, 0xba, 0x70, 0x88, 0xc7, 0xbb, 0x17, 0x74, 0x77, 0x8c, 0xa8, 0x27, 0x3a,
-	0x4a, 0xf0, 0x73, 0xe8, 0x24, 0xf5, 0xee, 0xe8, 0x0e, 0xa4, 0x1d, 0x61, 0x69, 0x13, 0xdd, 0x19,
-	0x08, 0x0d, 0x12, 0x15, 0xb1, 0x7f, 0x2c, 0x91, 0xdc, 0x42, 0xd3, 0xf1, 0x6c, 0x40, 0xdc, 0x0a,
-	0x1a, 0x61, 0x4b, 0x6f, 0xd4, 0x60, 0x5f, 0x84, 0xc4, 0xc1, 0x80, 0xef, 0x33, 0x28, 0x35, 0x37,
-	0x6a, 0xe1, 0xc5, 0xe7, 0xd6, 0xc1, 0xa3, 0x55, 0x1f, 0x6b, 0x57, 0xb7, 0x1a, 0x9d, 0x8b, 0xe3,
-	0x9b, 0xd0, 0xe2, 0xc7, 0x45, 0x41, 0xe1, 0xfb, 0x12, 0x1a, 0xd3, 0x4c, 0xb3, 0x6c, 0xc3, 0x78,
-	0xd9, 0xe1, 0x01, 0x70, 0x71, 0xf4, 0x79, 0x24, 0x44, 0x50, 0x65, 0x16, 0xf6, 0xc3, 0x14, 0xdc,
-	0xd1, 0x31, 0xb8, 0x44, 0xc5, 0x9a, 0x90, 0x78, 0xf9, 0x9f, 0x0c, 0x3a, 0xce, 0xc8, 0xe2, 0x8f,
-	0x24, 0x34, 0xcc, 0x6d, 0x2e, 0xee, 0x53, 0x5b, 0x74, 0xd7, 0xf2, 0xea, 0x80, 0xd1, 0x5c, 0x37,
-	0xc9, 0x7f, 0xf0, 0xcb, 0x5f, 0x5f, 0x1e, 0x91, 0x71, 0xa6, 0x28, 0x98, 0x7e, 0x6e, 0xa3, 0xf1,
-	0x8f, 0x12, 0x9a, 0x4c, 0x34, 0xc6, 0xf8, 0x95, 0x7d, 0xca, 0xed, 0x67, 0xbe, 0xe5, 0x57, 0x0f,
-	0x0e, 0x00, 0x12, 0x96, 0x99, 0x84, 0x39, 0x4c, 0x44, 0x09, 0x51, 0xb3, 0x1d, 0x15, 0xd3, 0x6b,
-	0x83, 0xd3, 0x88, 0x89, 0x75, 0xe4, 0x69, 0xc4, 0xc4, 0x3b, 0xf0, 0x7e, 0x62, 0xc0, 0xc6, 0xfa,
-	0xcf, 0x10, 0x3b, 0x59, 0xf8, 0x3b, 0x09, 0x8d, 0xc7, 0xda, 0x67, 0xfc, 0xe2, 0xe0, 0x3c, 0x04,
-	0x67, 0x2e, 0xbf, 0x74, 0xb0, 0x64, 0x10, 0x30, 0xcf, 0x04, 0xe4, 0xf0, 0x79, 0x51, 0x00, 0x9c,
-	0x03, 0xc6, 0xf0, 0x57, 0x09, 0x4d, 0xf7, 0xb3, 0xcc, 0x58, 0x19, 0x9c, 0x45, 0x92, 0x89, 0x97,
-	0x6f, 0x1c, 0x0a, 0x03, 0x04, 0xad, 0x32, 0x41, 0x0b, 0x78, 0x5e, 0x14, 0xd4, 0x75, 0xac, 0xfe,
-	0xa2, 0x30, 0x0b, 0x88, 0x1f, 0x49, 0xe8, 0x7c, 0x5f, 0x2b, 0x8d, 0x6f, 0xa4, 0x9a, 0xdf, 0x78,
-	0xdb, 0x2e, 0xaf, 0x1f, 0x0e, 0x04, 0xb4, 0x15, 0x98, 0xb6, 0x45, 0x7c, 0x21, 0x7e, 0xb1, 0x98,
-	0xa2, 0x72, 0x57, 0x25, 0xfe, 0xbd, 0x57, 0x9c, 0xe8, 0x8f, 0xd3, 0x88, 0x4b, 0x74, 0xf4, 0x69,
-	0xc4, 0x25, 0x1b, 0x7d, 0x52, 0x64, 0xe2, 0x96, 0xf0, 0x82, 0x28, 0xce, 0xf3, 0xb3, 0xca, 0xb6,
-	0x66, 0x38, 0x65, 0xcd, 0xa9, 0x70, 0x9d, 0x2e, 0xfe, 0x5e, 0x42, 0xe7, 0x12, 0x1c, 0x39, 0xbe,
-	0x9e, 0x62, 0xbe, 0x45, 0xc3, 0x2f, 0xbf, 0x7c, 0xd0, 0x74, 0xd0, 0xb2, 0xc0, 0xb4, 0xcc, 0xe0,
-	0x5c, 0xcc, 0x42, 0x85, 0x3b, 0x00, 0xfc, 0xb3, 0x84, 0xa6, 0xfa, 0x78, 0x78, 0xbc, 0x36, 0x38,
-	0x91, 0x84, 0x56, 0x41, 0x56, 0x0e, 0x03, 0x01, 0x7a, 0x56, 0x98, 0x9e, 0x79, 0x3c, 0x2b, 0xea,
-	0x11, 0xfa, 0x06, 0xfc, 0x53, 0xef, 0xa5, 0xdd, 0xeb, 0xd4, 0xd3, 0x5c, 0xda, 0xb1, 0xad, 0x45,
-	0x9a, 0x4b, 0x3b, 0xbe, 0xe3, 0xe8, 0xa7, 0x46, 0x68, 0x1c, 0xf0, 0x1f, 0xbd, 0x67, 0x48, 0xf4,
-	0xcc, 0x69, 0xce, 0x50, 0xa2, 0x3f, 0x4f, 0x73, 0x86, 0x92, 0x6d, 0x3b, 0x79, 0x9a, 0x29, 0x5b,
-	0xc6, 0x8b, 0xa2, 0xb2, 0x78, 0x9b, 0x8e, 0xff, 0x96, 0x50, 0x7e, 0xbf, 0x8e, 0x06, 0xbf, 0x7e,
-	0x70, 0x72, 0xe1, 0x1e, 0x4a, 0xbe, 0x79, 0x68, 0x1c, 0xd0, 0x79, 0x85, 0xe9, 0x5c, 0xc5, 0x2b,
-	0x83, 0xe9, 0x64, 0x7d, 0x54, 0xf4, 0xfd, 0xed, 0xb6, 0x14, 0x69, 0xde, 0x5f, 0xa1, 0x5d, 0x49,
-	0xf3, 0xfe, 0x8a, 0x5d, 0x4c, 0xbf, 0xf7, 0x37, 0xd4, 0x97, 0xe0, 0x6f, 0x25, 0x84, 0xc5, 0x26,
-	0x03, 0x5f, 0x1b, 0xbc, 0x76, 0x6f, 0xe7, 0x22, 0x3f, 0x7f, 0x80, 0x4c, 0xa0, 0x3c, 0xc3, 0x28,
-	0x4f, 0xe1, 0x49, 0x91, 0x32, 0xb4, 0x31, 0xf8, 0x6b, 0x09, 0xfd, 0x3f, 0xd2, 0x33, 0xe0, 0x67,
-	0x52, 0x98, 0xad, 0x6e, 0xc7, 0x23, 0x3f, 0x9b, 0x36, 0x0d, 0x58, 0x66, 0x19, 0xcb, 0x0c, 0x9e,
-	0x88, 0x71, 0x66, 0x3e, 0x9d, 0x1f, 0xf8, 0x6e, 0x10, 0xdb, 0x81, 0x41, 0x76, 0x43, 0x62, 0xff,
-	0x32, 0xc8, 0x6e, 0x48, 0x6e, 0x6b, 0xf6, 0x7b, 0xe0, 0xa3, 0x5d, 0x89, 0x72, 0xfb, 0xc1, 0xe3,
-	0xac, 0xf4, 0xf0, 0x71, 0x56, 0xfa, 0xf3, 0x71, 0x56, 0xfa, 0xec, 0x49, 0x76, 0xe8, 0xe1, 0x93,
-	0xec, 0xd0, 0x6f, 0x4f, 0xb2, 0x43, 0xef, 0x5c, 0xdd, 0x31, 0xbc, 0x3b, 0x8d, 0x4a, 0xa1, 0x4a,
-	0xeb, 0x01, 0xd6, 0xaa, 0xa9, 0x55, 0xdc, 0x0e, 0xf0, 0xee, 0xe5, 0x4b, 0xc5, 0xbd, 0xd0, 0x13,
-	0xdb, 0xb4, 0x75, 0xb7, 0x32, 0xcc, 0xfe, 0x5f, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xd3,
-	0xb1, 0x5a, 0x1a, 0x19, 0x00, 0x00,
+	// 1810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xbd, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0xa6, 0x6d, 0xda, 0x4e, 0xfa, 0x91, 0x4c, 0x93, 0x34, 0xd9, 0xa6, 0x71, 0x32, 0x89,
+	0x9b, 0xaf, 0xc6, 0xc6, 0x69, 0x81, 0xb6, 0x50, 0x20, 0x9b, 0x40, 0x89, 0x0a, 0x4d, 0x6a, 0xc2,
+	0x05, 0x24, 0xcc, 0xda, 0xde, 0x38, 0xab, 0xda, 0xbb, 0xae, 0x77, 0x1d, 0x25, 0x57, 0x90, 0x40,
+	0x48, 0x48, 0x7c, 0x49, 0x1c, 0xa1, 0x67, 0xc4, 0x3f, 0xc0, 0x11, 0x4e, 0x15, 0x5c, 0x8a, 0x90,
+	0x10, 0x2a, 0xa8, 0x42, 0x80, 0x80, 0x33, 0x77, 0x24, 0x66, 0x67, 0xde, 0xda, 0xeb, 0x9d, 0x59,
+	0xc7, 0x9b, 0x54, 0x1c, 0x2c, 0xef, 0xee, 0xbc, 0xf7, 0xe6, 0xf7, 0x7b, 0x33, 0xf3, 0xde, 0xfc,
+	0xd0, 0xa4, 0xed, 0x54, 0x6c, 0xc7, 0x74, 0xd2, 0xd5, 0x9a, 0xed, 0xda, 0x35, 0x63, 0x2b, 0xbd,
+	0x95, 0xc9, 0x1b, 0xae, 0x9e, 0x49, 0xdf, 0xa9, 0x1b, 0xb5, 0x9d, 0x14, 0xfb, 0x8c, 0x87, 0xc0,
+	0x2a, 0xe5, 0x5b, 0xa5, 0xc0, 0x4a, 0xed, 0x2f, 0xd9, 0x25, 0x9b, 0x7d, 0x4d, 0x7b, 0x4f, 0xdc,
+	0x40, 0x1d, 0x29, 0xd9, 0x76, 0xa9, 0x6c, 0xa4, 0xf5, 0xaa, 0x99, 0xd6, 0x2d, 0xcb, 0x76, 0x75,
+	0xd7, 0xb4, 0x2d, 0x70, 0x57, 0x67, 0x0b, 0x2c, 0x5c, 0x3a, 0xaf, 0x3b, 0x06, 0x9f, 0xa6, 0x31,
+	0x69, 0x55, 0x2f, 0x99, 0x16, 0x33, 0x06, 0xdb, 0x64, 0x24, 0xbe, 0xaa, 0x5e, 0xd3, 0x2b, 0x7e,
+	0xc8, 0xa9, 0x68, 0x33, 0x1f, 0x31, 0x37, 0x1c, 0x0d, 0xce, 0xed, 0xdb, 0x14, 0x6c, 0x13, 0xe6,
+	0x23, 0xfd, 0x08, 0xdf, 0xf2, 0x10, 0xad, 0xb1, 0xe8, 0x59, 0x83, 0xc2, 0x73, 0x5c, 0xb2, 0x81,
+	0x4e, 0xb7, 0x7c, 0x75, 0xaa, 0x94, 0x8d, 0x81, 0x57, 0x51, 0x37, 0x47, 0x31, 0xa4, 0x8c, 0x29,
+	0xd3, 0x3d, 0x0b, 0x63, 0xa9, 0xa8, 0x3c, 0xa5, 0xb8, 0xa7, 0x36, 0x70, 0xef, 0x61, 0xe2, 0xc0,
+	0x3f, 0x0f, 0x13, 0x27, 0x76, 0xf4, 0x4a, 0xf9, 0x2a, 0xe1, 0xde, 0x24, 0x0b, 0x61, 0xc8, 0x14,
+	0x4a, 0xb2, 0x79, 0xae, 0x1b, 0xee, 0x9a, 0x17, 0x21, 0x6b, 0x6c, 0xdd, 0xac, 0x57, 0xf2, 0x46,
+	0x6d, 0x75, 0x63, 0xbd, 0xa6, 0x17, 0x8d, 0x06, 0xa0, 0xf7, 0x15, 0x74, 0x7e, 0x37, 0x4b, 0x00,
+	0x99, 0x47, 0xbd, 0x16, 0x1b, 0xc9, 0xd9, 0x1b, 0x39, 0x97, 0x8d, 0x31, 0xb8, 0xc7, 0xb4, 0xcb,
+	0x1e, 0x98, 0x07, 0x0f, 0x13, 0x03, 0x3c, 0x27, 0x4e, 0xf1, 0x76, 0xca, 0xb4, 0xd3, 0x15, 0xdd,
+	0xdd, 0x4c, 0xad, 0x58, 0x2e, 0x45, 0x79, 0x86, 0xa3, 0x0c, 0xbb, 0x93, 0xec, 0x49, 0xab, 0x65,
+	0x2e, 0xb2, 0x2a, 0xe2, 0xa6, 0xff, 0x1b, 0xa6, 0xeb, 0x68, 0x3b, 0xcb, 0x86, 0x65, 0x57, 0x00,
+	0x37, 0x3e, 0x8f, 0x0e, 0x17, 0xbd, 0x77, 0x40, 0xd0, 0x4b, 0x27, 0x39, 0xce, 0x27, 0x61, 0x9f,
+	0x49, 0x96, 0x0f, 0x13, 0x4b, 0xa4, 0x17, 0x0e, 0x08, 0xf4, 0x96, 0xe9, 0x1a, 0xb0, 0x11, 0x58,
+	0x83, 0xe1, 0x14, 0x67, 0x93, 0xf2, 0x56, 0xb8, 0x91, 0xfe, 0x25, 0xba, 0xc2, 0x5a, 0x5f, 0x20,
+	0xf1, 0xcc, 0xc5, 0x4b, 0x3c, 0x7f, 0x98, 0x40, 0xe3, 0xe1, 0xf9, 0x16, 0xcb, 0x65, 0x98, 0xd2,
+	0x4f, 0xfa, 0x1d, 0x44, 0xda, 0x19, 0x01, 0xa0, 0x1b, 0xe8, 0x08, 0x0f, 0xea, 0xa5, 0xf9, 0x60,
+	0x7b, 0x44, 0x83, 0xb0, 0x1d, 0x4e, 0x06, 0x51, 0xd1, 0xfc, 0x1e, 0x69, 0x3c, 0xa1, 0xe9, 0xf0,
+	0x94, 0xaf, 0x78, 0x87, 0xc9, 0x71, 0xcd, 0x02, 0x4d, 0x45, 0xd6, 0xae, 0xbb, 0x46, 0x20, 0xb7,
+	0x35, 0xef, 0x9d, 0x4d, 0x7b, 0x28, 0x98, 0x5b, 0xf6, 0x99, 0xe6, 0x96, 0xff, 0x7f, 0xa4, 0xa0,
+	0x99, 0x0e, 0x82, 0x02, 0x9d, 0x22, 0x42, 0x4e, 0x63, 0x10, 0x72, 0x3c, 0x13, 0xbd, 0xcf, 0x99,
+	0x73, 0x20, 0xda, 0x30, 0x30, 0xec, 0xe3, 0x48, 0x9a, 0xa1, 0x28, 0xb5, 0xc0, 0xcb, 0x9c, 0x08,
+	0x89, 0xa6, 0x36, 0x14, 0xcc, 0x5f, 0x87, 0x8f, 0x15, 0x34, 0xdb, 0x89, 0x75, 0x04, 0x83, 0x83,
+	0xff, 0x17, 0x83, 0x75, 0xfb, 0xb6, 0x61, 0xad, 0xe9, 0x66, 0x6d, 0xb1, 0x96, 0x67, 0x51, 0x1b,
+	0x0c, 0xde, 0x93, 0x30, 0x90, 0x59, 0x03, 0x83, 0xd7, 0x51, 0x37, 0x5b, 0x3a, 0x1f, 0xfd, 0x85,
+	0x68, 0xf4, 0x62, 0x94, 0x70, 0xcd, 0xe1, 0x91, 0xe8, 0xd6, 0x87, 0x87, 0x24, 0x9a, 0x10, 0x92,
+	0x59, 0xac, 0x98, 0xd6, 0x62, 0xa1, 0x60, 0xd7, 0x2d, 0xd7, 0x87, 0x6c, 0xa0, 0xc9, 0xf6, 0x66,
+	0x80, 0xf5, 0x1a, 0x3a, 0xa1, 0x7b, 0xdf, 0x73, 0x3a, 0x1f, 0x80, 0x93, 0x3e, 0x44, 0x01, 0xf4,
+	0x73, 0x00, 0x2d, 0xc3, 0x24, 0x7b, 0x5c, 0x0f, 0x84, 0x21, 0x33, 0x68, 0x2a, 0x3c, 0xcd, 0xb2,
+	0xb1, 0x65, 0x94, 0xed, 0xaa, 0x51, 0x0b, 0x21, 0xaa, 0x8b, 0x67, 0x43, 0x34, 0x05, 0x54, 0x2b,
+	0xa8, 0xaf, 0xe8, 0x8f, 0x85, 0x90, 0x8d, 0x50, 0x64, 0x43, 0x7e, 0x0d, 0x0a, 0x99, 0x90, 0x6c,
+	0x6f, 0x31, 0x14, 0x52, 0x56, 0xa3, 0x57, 0xac, 0x0d, 0x5b, 0xdb, 0x59, 0xb3, 0xed, 0xf2, 0xfa,
+	0x4e, 0xd5, 0x3f, 0x8f, 0xe4, 0x73, 0x49, 0x8d, 0x0e, 0x5b, 0x02, 0xbc, 0x3a, 0xea, 0x33, 0xe9,
+	0x48, 0x2e, 0xbf, 0x93, 0xab, 0xd2, 0xb1, 0x9c, 0x4b, 0x07, 0xe1, 0xac, 0x4d, 0x47, 0xaf, 0x75,
+	0x6b, 0x30, 0x6d, 0x0c, 0xd6, 0x19, 0xc8, 0x08, 0x01, 0x69, 0xd9, 0x36, 0x5b, 0x3c, 0x48, 0x0a,
+	0x5d, 0x08, 0x03, 0x7c, 0x59, 0xdf, 0xf6, 0x86, 0xd7, 0x68, 0x75, 0x72, 0x9d, 0x35, 0xa3, 0xa6,
+	0x95, 0xed, 0xc2, 0x6d, 0x9f, 0xd1, 0x07, 0x0a, 0x9a, 0xef, 0xd0, 0x01, 0x88, 0xbd, 0x81, 0x86,
+	0x2b, 0xfa, 0x36, 0xc7, 0x50, 0x65, 0x26, 0x39, 0x2f, 0xbd, 0x79, 0xcf, 0x88, 0x11, 0x3c, 0xa4,
+	0x4d, 0x52, 0xc8, 0x63, 0x1c, 0x72, 0xa4, 0x29, 0xc9, 0x0e, 0x54, 0x64, 0xf3, 0xc8, 0x4e, 0x5d,
+	0x18, 0xd0, 0xfa, 0xb6, 0x0f, 0xff, 0x6d, 0xc9, 0xa9, 0x93, 0x59, 0x03, 0xf6, 0x57, 0xd1, 0xa0,
+	0x0c, 0x90, 0xbb, 0x0d, 0xc0, 0xc7, 0x29, 0xf0, 0x73, 0xd1, 0xc0, 0xdd, 0x6d, 0x92, 0xc5, 0x15,
+	0x21, 0xbc, 0xac, 0xd5, 0x68, 0xb4, 0x31, 0xb0, 0xae, 0xd6, 0x28, 0x10, 0xef, 0x28, 0x62, 0xaf,
+	0x09, 0x5a, 0x01, 0xc4, 0x37, 0x51, 0x8f, 0xd7, 0x54, 0x72, 0xac, 0x69, 0xfa, 0xd5, 0x61, 0x22,
+	0x7a, 0xc7, 0x34, 0x42, 0x68, 0x2a, 0x6c, 0x16, 0xcc, 0x09, 0x04, 0xa2, 0xd0, 0xb2, 0x96, 0x6f,
+	0xcc, 0x44, 0xc6, 0xd0, 0x68, 0x18, 0xc7, 0xf3, 0x96, 0x9e, 0x2f, 0x1b, 0x45, 0x1f, 0xea, 0x2a,
+	0x4a, 0x44, 0x5a, 0x00, 0xcc, 0x0b, 0xe8, 0x88, 0xc1, 0x3f, 0xb1, 0xd4, 0x1d, 0xd5, 0x70, 0xb3,
+	0xe7, 0xc1, 0x00, 0xed, 0x79, 0xfe, 0x13, 0xbd, 0xdb, 0x9c, 0x15, 0x9a, 0x3f, 0x4d, 0xa2, 0xdf,
+	0xe7, 0x2e, 0x21, 0xd4, 0x84, 0x0b, 0x87, 0x78, 0xa0, 0x59, 0xa0, 0x9b, 0x63, 0x24, 0x7b, 0xac,
+	0xc1, 0x04, 0x3f, 0x89, 0x7a, 0x6c, 0x77, 0x93, 0xae, 0x0b, 0x77, 0xeb, 0x62, 0x6e, 0x83, 0xcd,
+	0x0c, 0x04, 0x06, 0x69, 0x06, 0xd8, 0x1b, 0x73, 0x24, 0x37, 0xd0, 0x88, 0x1c, 0x0d, 0x90, 0x9b,
+	0xa3, 0xfd, 0xde, 0x5b, 0x7a, 0xb3, 0x08, 0xfb, 0x22, 0x40, 0x0e, 0x06, 0xbc, 0x7b, 0x06, 0x7d,
+	0x5a, 0x29, 0x06, 0x17, 0x9f, 0x5f, 0x1d, 0x5c, 0xbb, 0xe0, 0xc5, 0xda, 0x32, 0xac, 0x7a, 0xa3,
+	0x70, 0x7c, 0x11, 0x58, 0x7c, 0x99, 0x15, 0x4c, 0x4c, 0xb7, 0x73, 0xbf, 0x5e, 0xa6, 0x7b, 0x0e,
+	0xc6, 0x73, 0x35, 0x6e, 0x00, 0x85, 0xa3, 0x4d, 0x93, 0x10, 0x83, 0x6a, 0x13, 0xb0, 0x1f, 0xce,
+	0x42, 0x8d, 0x96, 0xc4, 0xa5, 0xdb, 0x59, 0x17, 0x1c, 0xc9, 0xa7, 0x0a, 0xca, 0xec, 0xde, 0xca,
+	0xe0, 0xd6, 0xe6, 0xef, 0x6f, 0x9c, 0x42, 0x47, 0x5d, 0xcf, 0x28, 0x67, 0x5a, 0xb0, 0x82, 0xa7,
+	0xe9, 0xe4, 0xa7, 0xf8, 0xe4, 0xfe, 0x08, 0xdd, 0x13, 0xec, 0x71, 0xc5, 0xc2, 0x19, 0x74, 0x8c,
+	0x7f, 0xa5, 0xd1, 0x60, 0xed, 0xfa, 0xa9, 0x43, 0x6f, 0xd0, 0x81, 0x0e, 0x91, 0x2c, 0x0f, 0xbb,
+	0x4a, 0x1f, 0xe9, 0x35, 0x67, 0x21, 0x0e, 0x30, 0x49, 0xaf, 0x55, 0x1e, 0x75, 0xaf, 0x95, 0x14,
+	0xdc, 0x66, 0x79, 0x58, 0xa2, 0xf3, 0xd7, 0x2b, 0x55, 0x4f, 0xfc, 0xf8, 0x3b, 0xe1, 0xb3, 0x2e,
+	0xb1, 0xe0, 0x46, 0x38, 0x00, 0xfc, 0xab, 0xe8, 0x38, 0xab, 0x98, 0xb9, 0x4d, 0xc3, 0x2c, 0x6d,
+	0xba, 0xb0, 0x25, 0xcf, 0x50, 0x48, 0xa7, 0xe1, 0x78, 0x04, 0x46, 0x49, 0xb6, 0x87, 0xbd, 0xbe,
+	0xc8, 0xde, 0xf0, 0x2d, 0xd4, 0x1f, 0x2c, 0x62, 0x05, 0x16, 0x9e, 0x9e, 0xd9, 0x2e, 0x16, 0x23,
+	0xd1, 0xdc, 0x1d, 0x32, 0x2b, 0xba, 0x3b, 0xaa, 0x21, 0x64, 0x46, 0xb1, 0x7d, 0xfd, 0x3f, 0xb8,
+	0xef, 0xfa, 0xbf, 0xf0, 0xd7, 0x08, 0x3a, 0xcc, 0x12, 0x84, 0xdf, 0x55, 0x50, 0x37, 0x17, 0x59,
+	0xb8, 0xcd, 0x92, 0x89, 0xda, 0x4e, 0x9d, 0xef, 0xd0, 0x9a, 0x27, 0x98, 0x8c, 0xbd, 0xf5, 0xc3,
+	0x1f, 0x9f, 0x74, 0xa9, 0x78, 0x28, 0x2d, 0x48, 0x4e, 0x2e, 0xe2, 0xf0, 0xb7, 0x0a, 0x1a, 0x8e,
+	0x94, 0x65, 0xf8, 0xd9, 0x5d, 0xa6, 0xdb, 0x4d, 0xfa, 0xa9, 0xcf, 0xed, 0x3d, 0x00, 0x50, 0x98,
+	0x65, 0x14, 0x26, 0x31, 0x11, 0x29, 0x84, 0xa5, 0x5e, 0x98, 0x4c, 0xab, 0x08, 0x8b, 0x43, 0x46,
+	0xaa, 0x07, 0xe3, 0x90, 0x91, 0xeb, 0xbf, 0x76, 0x64, 0x40, 0x44, 0x79, 0x97, 0x20, 0x56, 0xd7,
+	0xf1, 0x57, 0x0a, 0x1a, 0x90, 0x8a, 0x37, 0xfc, 0x54, 0xe7, 0x38, 0x04, 0x5d, 0xa8, 0x3e, 0xbd,
+	0x37, 0x67, 0x20, 0x90, 0x64, 0x04, 0x12, 0xf8, 0x9c, 0x48, 0x00, 0xaa, 0x30, 0x43, 0xf8, 0xa3,
+	0x82, 0x46, 0xda, 0x09, 0x36, 0xac, 0x75, 0x8e, 0x22, 0x4a, 0x42, 0xaa, 0x4b, 0xfb, 0x8a, 0x01,
+	0x84, 0xe6, 0x19, 0xa1, 0x29, 0x9c, 0x14, 0x09, 0x35, 0xf5, 0x92, 0xb7, 0x28, 0xac, 0x28, 0xe2,
+	0x07, 0x0a, 0x3a, 0xd7, 0x56, 0xc8, 0xe1, 0xa5, 0x58, 0xf9, 0x95, 0x8b, 0x46, 0x75, 0x79, 0x7f,
+	0x41, 0x80, 0x5b, 0x8a, 0x71, 0x9b, 0xc6, 0xe7, 0xe5, 0x8b, 0xc5, 0x18, 0xe5, 0x9a, 0x2c, 0xf1,
+	0xcf, 0xad, 0xe4, 0xc4, 0x8e, 0x11, 0x87, 0x5c, 0xa4, 0x9e, 0x8c, 0x43, 0x2e, 0x5a, 0x66, 0x92,
+	0x34, 0x23, 0x37, 0x83, 0xa7, 0x44, 0x72, 0xbc, 0xc3, 0x56, 0xa9, 0x5b, 0x4e, 0xaf, 0xe5, 0x39,
+	0x4f, 0x07, 0x7f, 0xad, 0xa0, 0x33, 0x11, 0x7a, 0x10, 0x5f, 0x8b, 0x91, 0x6f, 0x51, 0x6e, 0xaa,
+	0xcf, 0xec, 0xd5, 0x1d, 0xb8, 0x4c, 0x31, 0x2e, 0xe3, 0x38, 0x21, 0x59, 0xa8, 0xa0, 0xfe, 0xc4,
+	0xdf, 0xd3, 0xdb, 0x66, 0x1b, 0x05, 0x89, 0x17, 0x3b, 0x07, 0x12, 0x21, 0x54, 0x55, 0x6d, 0x3f,
+	0x21, 0x80, 0xcf, 0x1c, 0xe3, 0x93, 0xc4, 0x13, 0x22, 0x1f, 0x41, 0xb5, 0xe2, 0xef, 0x5a, 0x8b,
+	0x76, 0xab, 0x4e, 0x8c, 0x53, 0xb4, 0xa5, 0xc2, 0x36, 0x4e, 0xd1, 0x96, 0xeb, 0xdd, 0x76, 0x6c,
+	0x04, 0xd9, 0x8a, 0x7f, 0x69, 0x3d, 0x43, 0xa2, 0x62, 0x8b, 0x73, 0x86, 0x22, 0xd5, 0x61, 0x9c,
+	0x33, 0x14, 0x2d, 0x1a, 0xc9, 0x63, 0x8c, 0xd9, 0x2c, 0x9e, 0x16, 0x99, 0xc9, 0x45, 0x22, 0xfe,
+	0x5b, 0x41, 0x63, 0xbb, 0xe9, 0x69, 0xfc, 0xc2, 0xde, 0xc1, 0x05, 0x15, 0xbc, 0x7a, 0x7d, 0xdf,
+	0x71, 0x80, 0xe7, 0x45, 0xc6, 0x73, 0x1e, 0xcf, 0x75, 0xc6, 0x93, 0xdd, 0xe2, 0xc2, 0xfd, 0xb7,
+	0x29, 0x68, 0xe3, 0xf4, 0x5f, 0x41, 0x2c, 0xc7, 0xe9, 0xbf, 0xa2, 0x86, 0x6e, 0xd7, 0x7f, 0x03,
+	0xaa, 0x18, 0x7f, 0xa9, 0x20, 0x2c, 0x4a, 0x5c, 0x7c, 0xb9, 0xf3, 0xb9, 0x5b, 0x75, 0xb3, 0x7a,
+	0x65, 0x0f, 0x9e, 0x00, 0x79, 0x9c, 0x41, 0x3e, 0x8b, 0x87, 0x45, 0xc8, 0x20, 0xa2, 0xf1, 0x5d,
+	0x05, 0x9d, 0x0a, 0x89, 0x06, 0xfc, 0x78, 0x8c, 0xcb, 0x56, 0x53, 0x6f, 0xab, 0x4f, 0xc4, 0x75,
+	0x03, 0x94, 0xa3, 0x0c, 0xe5, 0x10, 0x1e, 0x94, 0xdc, 0xcc, 0x3c, 0x38, 0xdf, 0xf0, 0xdd, 0x20,
+	0x8a, 0xd1, 0x4e, 0x76, 0x43, 0xa4, 0x7a, 0xee, 0x64, 0x37, 0x44, 0x8b, 0xea, 0xdd, 0x1a, 0x7c,
+	0x58, 0x13, 0xe3, 0x7f, 0x15, 0x94, 0xec, 0x48, 0x60, 0xe2, 0x1b, 0xfb, 0xe9, 0xd1, 0x21, 0xfd,
+	0xac, 0xbe, 0xf4, 0x68, 0x82, 0x01, 0xe9, 0x2b, 0x8c, 0xf4, 0x45, 0x9c, 0xe9, 0xb0, 0xf1, 0x37,
+	0x6e, 0xd4, 0x0e, 0xfe, 0xb3, 0xb5, 0x7a, 0x49, 0xc5, 0x69, 0x9c, 0xea, 0xd5, 0x4e, 0x0e, 0xc7,
+	0xa9, 0x5e, 0x6d, 0x55, 0x32, 0xc9, 0x30, 0xc2, 0x73, 0x78, 0x46, 0xbe, 0x35, 0x5b, 0xb5, 0x2d,
+	0x73, 0xd5, 0x6e, 0xde, 0xfb, 0x6d, 0x54, 0xb9, 0x4f, 0x7f, 0xbf, 0xd2, 0xdf, 0x87, 0xbf, 0x8f,
+	0x1e, 0xb8, 0x4f, 0x7f, 0x3f, 0xd1, 0xdf, 0x6b, 0x97, 0x4a, 0xa6, 0xbb, 0x59, 0xcf, 0xa7, 0x0a,
+	0x76, 0xc5, 0x0f, 0x37, 0x5f, 0xd6, 0xf3, 0x4e, 0x23, 0xf6, 0xd6, 0x42, 0x26, 0xbd, 0x1d, 0x48,
+	0x29, 0x6d, 0x6a, 0x4e, 0xbe, 0x9b, 0xbd, 0x5f, 0xfc, 0x0f, 0xbe, 0x6f, 0x19, 0x8f, 0x81, 0x1d,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1668,6 +1908,12 @@ type QueryClient interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(ctx context.Context, in *QueryGetAllProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevTokenPairArbRoutesByDenoms queries the hot routes that the module
+	// is currently arbitraging for a given token pair
+	GetProtoRevTokenPairArbRoutesByDenoms(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse, error)
+	// GetProtoRevPoolPointsConsumption queries the number of pool points consumed
+	// in the latest block the module ran on
+	GetProtoRevPoolPointsConsumption(ctx context.Context, in *QueryGetProtoRevPoolPointsConsumptionRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolPointsConsumptionResponse, error)
 }
 
 type queryClient struct {
@@ -1822,6 +2068,24 @@ func (c *queryClient) GetAllProtocolRevenue(ctx context.Context, in *QueryGetAll
 	return out, nil
 }
 
+func (c *queryClient) GetProtoRevTokenPairArbRoutesByDenoms(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse, error) {
+	out := new(QueryGetProtoRevTokenPairArbRoutesByDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutesByDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetProtoRevPoolPointsConsumption(ctx context.Context, in *QueryGetProtoRevPoolPointsConsumptionRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolPointsConsumptionResponse, error) {
+	out := new(QueryGetProtoRevPoolPointsConsumptionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolPointsConsumption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of the module.
@@ -1867,6 +2131,12 @@ type QueryServer interface {
 	// GetAllProtocolRevenue queries all of the protocol revenue that has been
 	// accumulated by any module
 	GetAllProtocolRevenue(context.Context, *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error)
+	// GetProtoRevTokenPairArbRoutesByDenoms queries the hot routes that the module
+	// is currently arbitraging for a given token pair
+	GetProtoRevTokenPairArbRoutesByDenoms(context.Context, *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) (*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse, error)
+	// GetProtoRevPoolPointsConsumption queries the number of pool points consumed
+	// in the latest block the module ran on
+	GetProtoRevPoolPointsConsumption(context.Context, *QueryGetProtoRevPoolPointsConsumptionRequest) (*QueryGetProtoRevPoolPointsConsumptionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1921,6 +2191,12 @@ func (*UnimplementedQueryServer) GetProtoRevPool(ctx context.Context, req *Query
 func (*UnimplementedQueryServer) GetAllProtocolRevenue(ctx context.Context, req *QueryGetAllProtocolRevenueRequest) (*QueryGetAllProtocolRevenueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllProtocolRevenue not implemented")
 }
+func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutesByDenoms(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) (*QueryGetProtoRevTokenPairArbRoutesByDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutesByDenoms not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevPoolPointsConsumption(ctx context.Context, req *QueryGetProtoRevPoolPointsConsumptionRequest) (*QueryGetProtoRevPoolPointsConsumptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolPointsConsumption not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2214,6 +2490,42 @@ func _Query_GetAllProtocolRevenue_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetProtoRevTokenPairArbRoutesByDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTokenPairArbRoutesByDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutesByDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutesByDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutesByDenoms(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesByDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevPoolPointsConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolPointsConsumptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPoolPointsConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolPointsConsumption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPoolPointsConsumption(ctx, req.(*QueryGetProtoRevPoolPointsConsumptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2282,6 +2594,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllProtocolRevenue",
 			Handler:    _Query_GetAllProtocolRevenue_Handler,
 		},
+		{
+			MethodName: "GetProtoRevTokenPairArbRoutesByDenoms",
+			Handler:    _Query_GetProtoRevTokenPairArbRoutesByDenoms_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPoolPointsConsumption",
+			Handler:    _Query_GetProtoRevPoolPointsConsumption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/query.proto",
@@ -3219,68 +3539,199 @@ func (m *QueryGetAllProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.NumberOfTrades.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Routes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolPointsConsumed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolPointsConsumed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
@@ -3595,6 +4046,61 @@ func (m *QueryGetAllProtocolRevenueResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Routes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.PoolPointsConsumed != 0 {
+		n += 1 + sovQuery(uint64(m.PoolPointsConsumed))
+	}
+	if m.MaxPoolPointsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5852,6 +6358,364 @@ func (m *QueryGetAllProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesByDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesByDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesByDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesByDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesByDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Routes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolPointsConsumptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolPointsConsumptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryGetProtoRevPoolPointsConsumptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolPointsConsumptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolPointsConsumptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPointsConsumed", wireType)
+			}
+			m.PoolPointsConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolPointsConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+			}
+			m.MaxPoolPointsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
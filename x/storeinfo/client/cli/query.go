@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"encoding/hex"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/types"
+)
+
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdStorePrefixes)
+	osmocli.AddQueryCmd(cmd, queryproto.NewQueryClient, GetCmdPrefixSize)
+	return cmd
+}
+
+func GetCmdStorePrefixes() (*osmocli.QueryDescriptor, *queryproto.StorePrefixesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "store-prefixes",
+		Short: "Query the named key prefixes registered for a module store",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} store-prefixes concentratedliquidity`,
+	}, &queryproto.StorePrefixesRequest{}
+}
+
+func GetCmdPrefixSize() (*osmocli.QueryDescriptor, *queryproto.PrefixSizeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "prefix-size",
+		Short: "Query the number of keys and their size in bytes under a hex encoded prefix of a module store",
+		Long: `{{.Short}}
+Only one page of keys is measured per query, the rest of the prefix is measured by following the returned next key.{{.ExampleHeader}}
+{{.CommandPrefix}} prefix-size concentratedliquidity 010000000000000001 --limit 10000`,
+		HasPagination:      true,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"Prefix": parseHexPrefix},
+	}, &queryproto.PrefixSizeRequest{}
+}
+
+func parseHexPrefix(arg string, _ *flag.FlagSet) (any, osmocli.FieldReadLocation, error) {
+	prefix, err := hex.DecodeString(arg)
+	return prefix, osmocli.UsedArg, err
+}
@@ -0,0 +1,42 @@
+package grpc
+
+// THIS FILE IS GENERATED CODE, DO NOT EDIT
+// SOURCE AT `proto/osmosis/storeinfo/v1beta1/query.yml`
+
+import (
+	context "context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/client"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/client/queryproto"
+)
+
+type Querier struct {
+	Q client.Querier
+}
+
+var _ queryproto.QueryServer = Querier{}
+
+func (q Querier) StorePrefixes(grpcCtx context.Context,
+	req *queryproto.StorePrefixesRequest,
+) (*queryproto.StorePrefixesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.StorePrefixes(ctx, *req)
+}
+
+func (q Querier) PrefixSize(grpcCtx context.Context,
+	req *queryproto.PrefixSizeRequest,
+) (*queryproto.PrefixSizeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.PrefixSize(ctx, *req)
+}
+
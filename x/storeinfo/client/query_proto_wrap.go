@@ -0,0 +1,24 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/client/queryproto"
+)
+
+type Querier struct {
+	K storeinfo.Keeper
+}
+
+func (querier *Querier) StorePrefixes(ctx sdk.Context, req queryproto.StorePrefixesRequest) (*queryproto.StorePrefixesResponse, error) {
+	prefixes, err := querier.K.GetStorePrefixes(ctx, req.StoreName)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.StorePrefixesResponse{Prefixes: prefixes}, nil
+}
+
+func (querier *Querier) PrefixSize(ctx sdk.Context, req queryproto.PrefixSizeRequest) (*queryproto.PrefixSizeResponse, error) {
+	return querier.K.GetPrefixSize(ctx, req.StoreName, req.Prefix, req.Pagination)
+}
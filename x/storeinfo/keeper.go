@@ -0,0 +1,88 @@
+package storeinfo
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/types"
+)
+
+// Keeper reports the number of keys and their approximate size under prefixes of the module stores,
+// to guide pruning and migration planning. It holds no state of its own, everything is computed at query time.
+type Keeper struct {
+	storeKeys     map[string]storetypes.StoreKey
+	namedPrefixes map[string][]queryproto.NamedPrefix
+}
+
+func NewKeeper(storeKeys map[string]storetypes.StoreKey, namedPrefixes map[string][]queryproto.NamedPrefix) *Keeper {
+	return &Keeper{
+		storeKeys:     storeKeys,
+		namedPrefixes: namedPrefixes,
+	}
+}
+
+// GetStorePrefixes returns the named prefixes registered for the given store.
+// Returns an empty list if the store exists but has no named prefixes.
+func (k Keeper) GetStorePrefixes(ctx sdk.Context, storeName string) ([]queryproto.NamedPrefix, error) {
+	if _, ok := k.storeKeys[storeName]; !ok {
+		return nil, types.UnknownStoreError{StoreName: storeName}
+	}
+	return k.namedPrefixes[storeName], nil
+}
+
+// GetPrefixSize returns the number of keys and the total length of the keys and values under keyPrefix
+// in the given store, for a single page of keys.
+// Only key based pagination is supported: iteration starts at the pagination key, which is relative to
+// keyPrefix, and stops after the page limit. The next key is returned if there are keys left to measure.
+func (k Keeper) GetPrefixSize(ctx sdk.Context, storeName string, keyPrefix []byte, pagination *query.PageRequest) (*queryproto.PrefixSizeResponse, error) {
+	storeKey, ok := k.storeKeys[storeName]
+	if !ok {
+		return nil, types.UnknownStoreError{StoreName: storeName}
+	}
+
+	startKey, limit, err := parsePagination(pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), keyPrefix)
+	iter := store.Iterator(startKey, nil)
+	defer iter.Close()
+
+	resp := &queryproto.PrefixSizeResponse{Pagination: &query.PageResponse{}}
+	for ; iter.Valid(); iter.Next() {
+		if resp.KeyCount == limit {
+			// The iterator may reuse the key slice, so we copy it before returning it.
+			resp.Pagination.NextKey = append([]byte{}, iter.Key()...)
+			break
+		}
+		resp.KeyCount++
+		resp.KeyBytes += uint64(len(keyPrefix) + len(iter.Key()))
+		resp.ValueBytes += uint64(len(iter.Value()))
+	}
+
+	return resp, nil
+}
+
+// parsePagination returns the start key and the page limit of the given page request,
+// applying the default limit if none is set.
+func parsePagination(pagination *query.PageRequest) ([]byte, uint64, error) {
+	if pagination == nil {
+		return nil, types.DefaultPageLimit, nil
+	}
+	if pagination.Offset != 0 {
+		return nil, 0, types.ErrOffsetPaginationNotSupported
+	}
+	if pagination.Limit > types.MaxPageLimit {
+		return nil, 0, types.PageLimitTooLargeError{Limit: pagination.Limit, MaxLimit: types.MaxPageLimit}
+	}
+
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = types.DefaultPageLimit
+	}
+	return pagination.Key, limit, nil
+}
@@ -0,0 +1,72 @@
+package storeinfo_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v21/app/apptesting"
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) TestGetStorePrefixes() {
+	prefixes, err := s.App.StoreInfoKeeper.GetStorePrefixes(s.Ctx, cltypes.StoreKey)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(prefixes)
+	s.Require().Equal("ticks", prefixes[0].Name)
+	s.Require().Equal(cltypes.TickPrefix, prefixes[0].Prefix)
+
+	_, err = s.App.StoreInfoKeeper.GetStorePrefixes(s.Ctx, "unknown")
+	s.Require().ErrorIs(err, types.UnknownStoreError{StoreName: "unknown"})
+}
+
+func (s *KeeperTestSuite) TestGetPrefixSize() {
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	ticksPrefix := cltypes.KeyTickPrefixByPoolId(pool.GetId())
+
+	// The default position initializes its lower and upper ticks.
+	resp, err := s.App.StoreInfoKeeper.GetPrefixSize(s.Ctx, cltypes.StoreKey, ticksPrefix, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), resp.KeyCount)
+	s.Require().Equal(uint64(2*cltypes.KeyTickLengthBytes), resp.KeyBytes)
+	s.Require().NotZero(resp.ValueBytes)
+	s.Require().Nil(resp.Pagination.NextKey)
+
+	// Measuring one key at a time yields the same totals.
+	firstPage, err := s.App.StoreInfoKeeper.GetPrefixSize(s.Ctx, cltypes.StoreKey, ticksPrefix, &query.PageRequest{Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), firstPage.KeyCount)
+	s.Require().NotNil(firstPage.Pagination.NextKey)
+
+	secondPage, err := s.App.StoreInfoKeeper.GetPrefixSize(s.Ctx, cltypes.StoreKey, ticksPrefix, &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), secondPage.KeyCount)
+	s.Require().Nil(secondPage.Pagination.NextKey)
+	s.Require().Equal(resp.KeyBytes, firstPage.KeyBytes+secondPage.KeyBytes)
+	s.Require().Equal(resp.ValueBytes, firstPage.ValueBytes+secondPage.ValueBytes)
+
+	// Invalid requests.
+	_, err = s.App.StoreInfoKeeper.GetPrefixSize(s.Ctx, "unknown", nil, nil)
+	s.Require().ErrorIs(err, types.UnknownStoreError{StoreName: "unknown"})
+
+	_, err = s.App.StoreInfoKeeper.GetPrefixSize(s.Ctx, cltypes.StoreKey, ticksPrefix, &query.PageRequest{Offset: 1})
+	s.Require().ErrorIs(err, types.ErrOffsetPaginationNotSupported)
+
+	_, err = s.App.StoreInfoKeeper.GetPrefixSize(s.Ctx, cltypes.StoreKey, ticksPrefix, &query.PageRequest{Limit: types.MaxPageLimit + 1})
+	s.Require().ErrorIs(err, types.PageLimitTooLargeError{Limit: types.MaxPageLimit + 1, MaxLimit: types.MaxPageLimit})
+}
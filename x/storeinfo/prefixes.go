@@ -0,0 +1,34 @@
+package storeinfo
+
+import (
+	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v21/x/storeinfo/client/queryproto"
+)
+
+// DefaultNamedPrefixes returns the named prefixes of the module stores that grow the most with usage,
+// keyed by store name. Prefixes of other stores can still be measured by querying them directly.
+func DefaultNamedPrefixes() map[string][]queryproto.NamedPrefix {
+	return map[string][]queryproto.NamedPrefix{
+		cltypes.StoreKey: {
+			{Name: "ticks", Prefix: cltypes.TickPrefix},
+			{Name: "positions", Prefix: cltypes.PositionIdPrefix},
+			{Name: "address_positions", Prefix: cltypes.PositionPrefix},
+			{Name: "pool_positions", Prefix: cltypes.PoolPositionPrefix},
+			{Name: "incentive_records", Prefix: cltypes.IncentivePrefix},
+			{Name: "spread_reward_position_accumulators", Prefix: cltypes.SpreadRewardPositionAccumulatorPrefix},
+			{Name: "uptime_accumulators", Prefix: cltypes.UptimeAccumulatorPrefix},
+		},
+		incentivestypes.StoreKey: {
+			{Name: "gauges", Prefix: incentivestypes.KeyPrefixPeriodGauge},
+			{Name: "gauge_references", Prefix: incentivestypes.KeyPrefixGauges},
+			{Name: "gauges_by_denom", Prefix: incentivestypes.KeyPrefixGaugesByDenom},
+		},
+		lockuptypes.StoreKey: {
+			{Name: "locks", Prefix: lockuptypes.KeyPrefixPeriodLock},
+			{Name: "synthetic_locks", Prefix: lockuptypes.KeyPrefixSyntheticLockup},
+			{Name: "lock_accumulations", Prefix: lockuptypes.KeyPrefixLockAccumulation},
+		},
+	}
+}
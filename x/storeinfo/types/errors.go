@@ -0,0 +1,25 @@
+package types
+
+import (
+	"errors"
+	fmt "fmt"
+)
+
+var ErrOffsetPaginationNotSupported = errors.New("offset pagination is not supported, use the pagination key instead")
+
+type UnknownStoreError struct {
+	StoreName string
+}
+
+func (e UnknownStoreError) Error() string {
+	return fmt.Sprintf("unknown store: %s", e.StoreName)
+}
+
+type PageLimitTooLargeError struct {
+	Limit    uint64
+	MaxLimit uint64
+}
+
+func (e PageLimitTooLargeError) Error() string {
+	return fmt.Sprintf("page limit %d exceeds the maximum of %d", e.Limit, e.MaxLimit)
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	ModuleName = "storeinfo"
+	RouterKey  = ModuleName
+
+	QuerierRoute = ModuleName
+
+	// DefaultPageLimit is the number of keys measured by a single PrefixSize query
+	// when the request does not specify a limit.
+	DefaultPageLimit = uint64(1_000)
+	// MaxPageLimit is the maximum number of keys measured by a single PrefixSize query.
+	// It bounds the work done by a query on large prefixes, such as the ticks of all pools.
+	MaxPageLimit = uint64(50_000)
+)
@@ -28,6 +28,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalSuperfluidDelegations(),
 		GetCmdTotalDelegationByDelegator(),
 		GetCmdUnpoolWhitelist(),
+		GetCmdIntermediaryAccountSlashes(),
+		GetCmdTotalSuperfluidStakeByAsset(),
+		GetCmdTotalSuperfluidStakeByValidator(),
+		GetCmdAutoCompoundLock(),
 	)
 
 	return cmd
@@ -157,3 +161,45 @@ func GetCmdUnpoolWhitelist() *cobra.Command {
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+// GetCmdIntermediaryAccountSlashes returns the history of slashes applied to the locks of an intermediary account.
+func GetCmdIntermediaryAccountSlashes() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.IntermediaryAccountSlashesRequest](
+		"intermediary-account-slashes",
+		"Query the slashes applied to the locks of an intermediary account, broken down by lock",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} intermediary-account-slashes osmo1...
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdTotalSuperfluidStakeByAsset returns the total amount of osmo superfluid staked through every superfluid asset.
+func GetCmdTotalSuperfluidStakeByAsset() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.TotalSuperfluidStakeByAssetRequest](
+		"total-superfluid-stake-by-asset",
+		"Query total amount of osmo superfluid staked through every superfluid asset", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdTotalSuperfluidStakeByValidator returns the total amount of osmo superfluid staked to every validator.
+func GetCmdTotalSuperfluidStakeByValidator() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.TotalSuperfluidStakeByValidatorRequest](
+		"total-superfluid-stake-by-validator",
+		"Query total amount of osmo superfluid staked to every validator", "",
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+// GetCmdAutoCompoundLock returns whether a lock is opted in to auto-compounding its staking rewards.
+func GetCmdAutoCompoundLock() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.AutoCompoundLockRequest](
+		"auto-compound-lock",
+		"Query whether a superfluid delegated lock is opted in to auto-compounding its staking rewards",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} auto-compound-lock 1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
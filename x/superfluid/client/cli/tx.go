@@ -37,6 +37,7 @@ func GetTxCmd() *cobra.Command {
 		NewCmdLockAndSuperfluidDelegate(),
 		NewCmdUnPoolWhitelistedPool(),
 		NewUnbondConvertAndStake(),
+		NewSetSuperfluidAutoCompoundCmd(),
 	)
 	osmocli.AddTxCmd(cmd, NewCreateFullRangePositionAndSuperfluidDelegateCmd)
 	osmocli.AddTxCmd(cmd, NewAddToConcentratedLiquiditySuperfluidPositionCmd)
@@ -94,6 +95,14 @@ func NewSuperfluidUndelegateCmd() *cobra.Command {
 	})
 }
 
+func NewSetSuperfluidAutoCompoundCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgSetSuperfluidAutoCompound](&osmocli.TxCliDesc{
+		Use:     "set-auto-compound",
+		Short:   "opt a superfluid delegated lock in or out of auto-compounding its staking rewards",
+		Example: "osmosisd tx superfluid set-auto-compound 1 true --from=val --chain-id=osmosis-1",
+	})
+}
+
 func NewSuperfluidUnbondLockCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgSuperfluidUnbondLock](&osmocli.TxCliDesc{
 		Use:   "unbond-lock",
@@ -116,7 +125,7 @@ func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 		Short: "Submit a superfluid asset set proposal",
 		Long:  "Submit a superfluid asset set proposal",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -133,7 +142,7 @@ func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -158,7 +167,7 @@ func NewCmdSubmitRemoveSuperfluidAssetsProposal() *cobra.Command {
 		Short: "Submit a superfluid asset remove proposal",
 		Long:  "Submit a superfluid asset remove proposal",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -175,7 +184,7 @@ func NewCmdSubmitRemoveSuperfluidAssetsProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -319,7 +328,7 @@ func NewCmdUpdateUnpoolWhitelistProposal() *cobra.Command {
 			"If the flag to overwrite is set, the whitelist is completely overridden. Otherwise, it is appended to the existing whitelist, having all duplicates removed.",
 		Example: "osmosisd tx gov submit-proposal update-unpool-whitelist --pool-ids \"1, 2, 3\" --title \"Title\" --summary \"Description\"",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, proposalTitle, summary, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
+			clientCtx, proposalTitle, summary, metadata, deposit, isExpedited, authority, err := osmocli.GetProposalInfo(cmd)
 			if err != nil {
 				return err
 			}
@@ -336,7 +345,7 @@ func NewCmdUpdateUnpoolWhitelistProposal() *cobra.Command {
 
 			msg := v1.NewMsgExecLegacyContent(contentMsg.Content, authority.String())
 
-			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, isExpedited)
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, proposalTitle, summary, isExpedited)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	gammtypes "github.com/osmosis-labs/osmosis/v21/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v21/x/incentives/types"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
+)
+
+// SetSuperfluidAutoCompound opts the given lock in or out of auto-compounding its superfluid staking rewards.
+// When enabled, the bond denom rewards that the lock receives at every epoch are joined into the pool of
+// the locked shares and added to the lock, increasing its superfluid delegation, instead of being left to the owner.
+// Returns error if the sender is not the lock owner.
+// Returns error when enabling if the lock is not superfluid delegated or does not lock balancer or stableswap shares.
+func (k Keeper) SetSuperfluidAutoCompound(ctx sdk.Context, sender string, lockId uint64, enabled bool) error {
+	lock, err := k.lk.GetLockByID(ctx, lockId)
+	if err != nil {
+		return err
+	}
+	if err := k.validateLockForSF(lock, sender); err != nil {
+		return err
+	}
+
+	if !enabled {
+		k.deleteAutoCompoundLock(ctx, lockId)
+		return nil
+	}
+
+	if k.GetLockIdIntermediaryAccountConnection(ctx, lockId).Empty() {
+		return types.ErrNotSuperfluidUsedLockup
+	}
+	asset, err := k.GetSuperfluidAsset(ctx, lock.Coins[0].Denom)
+	if err != nil {
+		return err
+	}
+	if asset.AssetType != types.SuperfluidAssetTypeLPShare {
+		return types.ErrAutoCompoundNotSupported
+	}
+
+	k.setAutoCompoundLock(ctx, lockId)
+	return nil
+}
+
+// IsAutoCompoundLock returns true if the given lock is opted in to auto-compounding. False otherwise.
+func (k Keeper) IsAutoCompoundLock(ctx sdk.Context, lockId uint64) bool {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompoundLock)
+	return prefixStore.Has(sdk.Uint64ToBigEndian(lockId))
+}
+
+// GetAllAutoCompoundLockIds returns the ids of all the locks opted in to auto-compounding, in ascending order.
+func (k Keeper) GetAllAutoCompoundLockIds(ctx sdk.Context) []uint64 {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompoundLock)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	lockIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		lockIds = append(lockIds, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return lockIds
+}
+
+func (k Keeper) setAutoCompoundLock(ctx sdk.Context, lockId uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompoundLock)
+	prefixStore.Set(sdk.Uint64ToBigEndian(lockId), []byte{1})
+}
+
+func (k Keeper) deleteAutoCompoundLock(ctx sdk.Context, lockId uint64) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompoundLock)
+	prefixStore.Delete(sdk.Uint64ToBigEndian(lockId))
+}
+
+// getAutoCompoundLockIdsAndPrune returns the ids of all the locks opted in to auto-compounding, in ascending order.
+// Opt-ins of locks that are no longer connected to an intermediary account are deleted and omitted,
+// since those locks are no longer superfluid delegated.
+func (k Keeper) getAutoCompoundLockIdsAndPrune(ctx sdk.Context) []uint64 {
+	lockIds := []uint64{}
+	for _, lockId := range k.GetAllAutoCompoundLockIds(ctx) {
+		if k.GetLockIdIntermediaryAccountConnection(ctx, lockId).Empty() {
+			k.deleteAutoCompoundLock(ctx, lockId)
+			continue
+		}
+		lockIds = append(lockIds, lockId)
+	}
+	return lockIds
+}
+
+// autoCompoundRewards joins the bond denom rewards that were just distributed to every auto-compounding lock into the
+// pool of its locked shares and adds the shares to the lock, which increases its superfluid delegation through the
+// lockup hooks. Rewards sent to a reward receiver other than the lock owner are skipped, since they do not end up in
+// the owner's balance.
+// Each lock is compounded in a cached context, so that if joining the pool or adding to the lock fails,
+// the rewards are left in the owner's balance as if auto-compounding was disabled.
+func (k Keeper) autoCompoundRewards(ctx sdk.Context, lockRewards []incentivestypes.LockDistributionProjection) {
+	bondDenom := k.sk.BondDenom(ctx)
+	for _, lockReward := range lockRewards {
+		reward := sdk.NewCoin(bondDenom, lockReward.Coins.AmountOf(bondDenom))
+		if reward.IsZero() {
+			continue
+		}
+
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			lock, err := k.lk.GetLockByID(cacheCtx, lockReward.LockId)
+			if err != nil {
+				return err
+			}
+			if lockReward.RewardReceiver != lock.Owner {
+				return nil
+			}
+			owner, err := sdk.AccAddressFromBech32(lock.Owner)
+			if err != nil {
+				return err
+			}
+			shareDenom := lock.Coins[0].Denom
+			poolId, err := gammtypes.GetPoolIdFromShareDenom(shareDenom)
+			if err != nil {
+				return err
+			}
+
+			sharesOut, err := k.gk.JoinSwapExactAmountIn(cacheCtx, owner, poolId, sdk.NewCoins(reward), osmomath.ZeroInt())
+			if err != nil {
+				return err
+			}
+			shares := sdk.NewCoin(shareDenom, sharesOut)
+			if _, err := k.lk.AddTokensToLockByID(cacheCtx, lock.ID, owner, shares); err != nil {
+				return err
+			}
+
+			events.EmitSuperfluidAutoCompoundEvent(cacheCtx, lock.ID, reward, shares)
+			return nil
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
+)
+
+func (s *KeeperTestSuite) TestSetSuperfluidAutoCompound() {
+	testCases := map[string]struct {
+		notSuperfluidDelegated bool
+		senderIsNotOwner       bool
+		enabled                bool
+		expectedErr            error
+	}{
+		"enable for superfluid delegated lock": {
+			enabled: true,
+		},
+		"disable for superfluid delegated lock": {
+			enabled: false,
+		},
+		"error: sender is not the lock owner": {
+			senderIsNotOwner: true,
+			enabled:          true,
+			expectedErr:      lockuptypes.ErrNotLockOwner,
+		},
+		"error: lock is not superfluid delegated": {
+			notSuperfluidDelegated: true,
+			enabled:                true,
+			expectedErr:            types.ErrNotSuperfluidUsedLockup,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+			denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+
+			var lock lockuptypes.PeriodLock
+			if tc.notSuperfluidDelegated {
+				lockId := s.LockTokens(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(denoms[0], 1000000)), s.App.StakingKeeper.GetParams(s.Ctx).UnbondingTime)
+				lockPtr, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockId)
+				s.Require().NoError(err)
+				lock = *lockPtr
+			} else {
+				_, _, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}}, denoms)
+				lock = locks[0]
+			}
+
+			sender := lock.Owner
+			if tc.senderIsNotOwner {
+				sender = s.TestAccs[1].String()
+			}
+
+			// Enable first so that disabling is observable.
+			if !tc.enabled && tc.expectedErr == nil {
+				err := s.App.SuperfluidKeeper.SetSuperfluidAutoCompound(s.Ctx, sender, lock.ID, true)
+				s.Require().NoError(err)
+			}
+
+			err := s.App.SuperfluidKeeper.SetSuperfluidAutoCompound(s.Ctx, sender, lock.ID, tc.enabled)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().False(s.App.SuperfluidKeeper.IsAutoCompoundLock(s.Ctx, lock.ID))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.enabled, s.App.SuperfluidKeeper.IsAutoCompoundLock(s.Ctx, lock.ID))
+			res, err := s.queryClient.AutoCompoundLock(sdk.WrapSDKContext(s.Ctx), &types.AutoCompoundLockRequest{LockId: lock.ID})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.enabled, res.Enabled)
+
+			// Undelegating clears the flag.
+			if tc.enabled {
+				err = s.App.SuperfluidKeeper.SuperfluidUndelegate(s.Ctx, lock.Owner, lock.ID)
+				s.Require().NoError(err)
+				s.Require().False(s.App.SuperfluidKeeper.IsAutoCompoundLock(s.Ctx, lock.ID))
+				s.Require().Empty(s.App.SuperfluidKeeper.GetAllAutoCompoundLockIds(s.Ctx))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetAutoCompoundLockIdsAndPrune() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+	_, _, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+	for _, lock := range locks {
+		err := s.App.SuperfluidKeeper.SetSuperfluidAutoCompound(s.Ctx, lock.Owner, lock.ID, true)
+		s.Require().NoError(err)
+	}
+
+	// Disconnect the second lock from its intermediary account without clearing its opt-in.
+	s.App.SuperfluidKeeper.DeleteLockIdIntermediaryAccountConnection(s.Ctx, locks[1].ID)
+
+	// System under test.
+	lockIds := s.App.SuperfluidKeeper.GetAutoCompoundLockIdsAndPrune(s.Ctx)
+
+	s.Require().Equal([]uint64{locks[0].ID}, lockIds)
+	s.Require().Equal([]uint64{locks[0].ID}, s.App.SuperfluidKeeper.GetAllAutoCompoundLockIds(s.Ctx))
+	s.Require().False(s.App.SuperfluidKeeper.IsAutoCompoundLock(s.Ctx, locks[1].ID))
+}
+
+func (s *KeeperTestSuite) TestDistributeSuperfluidGauges_AutoCompound() {
+	for _, changeRewardReceiver := range []bool{false, true} {
+		s.SetupTest()
+		valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+		denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20)})
+		delAddrs, _, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 1000000}}, denoms)
+		compoundingLock, regularLock := locks[0], locks[1]
+		bondDenom := s.App.StakingKeeper.BondDenom(s.Ctx)
+
+		err := s.App.SuperfluidKeeper.SetSuperfluidAutoCompound(s.Ctx, compoundingLock.Owner, compoundingLock.ID, true)
+		s.Require().NoError(err)
+		if changeRewardReceiver {
+			err = s.App.LockupKeeper.SetLockRewardReceiverAddress(s.Ctx, compoundingLock.ID, compoundingLock.OwnerAddress(), s.TestAccs[2].String())
+			s.Require().NoError(err)
+		}
+
+		s.AllocateRewardsToValidator(valAddrs[0], osmomath.NewInt(20000))
+		s.App.SuperfluidKeeper.MoveSuperfluidDelegationRewardToGauges(s.Ctx)
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+		err = s.App.IncentivesKeeper.AfterEpochEnd(s.Ctx, s.App.IncentivesKeeper.GetEpochInfo(s.Ctx).Identifier, 1)
+		s.Require().NoError(err)
+
+		compoundingOwnerBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, delAddrs[0], bondDenom)
+		regularOwnerBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, delAddrs[1], bondDenom)
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+		// System under test.
+		s.App.SuperfluidKeeper.DistributeSuperfluidGauges(s.Ctx)
+
+		// The regular lock owner receives the rewards.
+		regularOwnerBalanceAfter := s.App.BankKeeper.GetBalance(s.Ctx, delAddrs[1], bondDenom)
+		s.Require().True(regularOwnerBalanceAfter.Amount.GT(regularOwnerBalanceBefore.Amount))
+		regularLockAfter, err := s.App.LockupKeeper.GetLockByID(s.Ctx, regularLock.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(regularLock.Coins, regularLockAfter.Coins)
+
+		compoundingLockAfter, err := s.App.LockupKeeper.GetLockByID(s.Ctx, compoundingLock.ID)
+		s.Require().NoError(err)
+		if changeRewardReceiver {
+			// Rewards sent to another receiver are not compounded.
+			s.Require().Equal(compoundingLock.Coins, compoundingLockAfter.Coins)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidAutoCompound, 0)
+			continue
+		}
+
+		// The compounding lock owner does not keep the rewards, which are added to the lock and delegated.
+		s.Require().Equal(compoundingOwnerBalanceBefore, s.App.BankKeeper.GetBalance(s.Ctx, delAddrs[0], bondDenom))
+		s.Require().True(compoundingLockAfter.Coins.AmountOf(denoms[0]).GT(compoundingLock.Coins.AmountOf(denoms[0])))
+		synthLock, err := s.App.LockupKeeper.GetSyntheticLockupByUnderlyingLockId(s.Ctx, compoundingLock.ID)
+		s.Require().NoError(err)
+		s.Require().Equal(compoundingLockAfter.Coins.AmountOf(denoms[0]), s.App.LockupKeeper.GetPeriodLocksAccumulation(s.Ctx, lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByDuration,
+			Denom:         synthLock.SynthDenom,
+			Duration:      synthLock.Duration,
+		}).Sub(regularLock.Coins.AmountOf(denoms[0])))
+		s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidAutoCompound, 1)
+	}
+}
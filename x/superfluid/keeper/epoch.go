@@ -106,10 +106,14 @@ func (k Keeper) distributeSuperfluidGauges(ctx sdk.Context) {
 			distrGauges = append(distrGauges, gauge)
 		}
 	}
-	_, err := k.ik.Distribute(ctx, distrGauges)
+
+	// record the rewards distributed to the auto-compounding locks, so that they can be compounded.
+	autoCompoundLockIds := k.getAutoCompoundLockIdsAndPrune(ctx)
+	_, autoCompoundRewards, err := k.ik.DistributeAndGetLockRewards(ctx, distrGauges, autoCompoundLockIds)
 	if err != nil {
 		panic(err)
 	}
+	k.autoCompoundRewards(ctx, autoCompoundRewards)
 }
 
 func (k Keeper) UpdateOsmoEquivalentMultipliers(ctx sdk.Context, asset types.SuperfluidAsset, newEpochNumber int64) error {
@@ -85,3 +85,7 @@ func (k Keeper) ConvertUnlockedToStake(ctx sdk.Context, sender sdk.AccAddress, v
 func (k Keeper) DelegateBaseOnValsetPref(ctx sdk.Context, sender sdk.AccAddress, valAddr, originalSuperfluidValAddr string, totalAmtToStake osmomath.Int) error {
 	return k.delegateBaseOnValsetPref(ctx, sender, valAddr, originalSuperfluidValAddr, totalAmtToStake)
 }
+
+func (k Keeper) GetAutoCompoundLockIdsAndPrune(ctx sdk.Context) []uint64 {
+	return k.getAutoCompoundLockIdsAndPrune(ctx)
+}
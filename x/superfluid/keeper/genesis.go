@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
@@ -37,6 +39,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 		k.SetLockIdIntermediaryAccountConnection(ctx, connection.LockId, intermediaryAcc)
 	}
+
+	// initialize the locks opted in to auto-compounding
+	for _, lockId := range genState.AutoCompoundLockIds {
+		if k.GetLockIdIntermediaryAccountConnection(ctx, lockId).Empty() {
+			panic(fmt.Sprintf("auto-compounding lock %d is not superfluid delegated", lockId))
+		}
+		k.setAutoCompoundLock(ctx, lockId)
+	}
+
+	// the running totals of superfluid stake are derived from the delegations of the intermediary accounts
+	k.InitializeSuperfluidStakeTotals(ctx)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -47,5 +60,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		OsmoEquivalentMultipliers:     k.GetAllOsmoEquivalentMultipliers(ctx),
 		IntermediaryAccounts:          k.GetAllIntermediaryAccounts(ctx),
 		IntemediaryAccountConnections: k.GetAllLockIdIntermediaryAccountConnections(ctx),
+		AutoCompoundLockIds:           k.GetAllAutoCompoundLockIds(ctx),
 	}
 }
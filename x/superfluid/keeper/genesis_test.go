@@ -46,6 +46,7 @@ var testGenesis = types.GenesisState{
 			IntermediaryAccount: "osmo1hpgapnfl3thkevvl0jp3wqtk8jw7mpqumuuc2f",
 		},
 	},
+	AutoCompoundLockIds: []uint64{1},
 }
 
 func TestMarshalUnmarshalGenesis(t *testing.T) {
@@ -90,6 +91,9 @@ func TestInitGenesis(t *testing.T) {
 
 	connections := app.SuperfluidKeeper.GetAllLockIdIntermediaryAccountConnections(ctx)
 	require.Equal(t, connections, genesis.IntemediaryAccountConnections)
+
+	autoCompoundLockIds := app.SuperfluidKeeper.GetAllAutoCompoundLockIds(ctx)
+	require.Equal(t, autoCompoundLockIds, genesis.AutoCompoundLockIds)
 }
 
 func TestExportGenesis(t *testing.T) {
@@ -114,4 +118,5 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, genesis.OsmoEquivalentMultipliers, genesis.OsmoEquivalentMultipliers)
 	require.Equal(t, genesis.IntermediaryAccounts, genesis.IntermediaryAccounts)
 	require.Equal(t, genesis.IntemediaryAccountConnections, genesis.IntemediaryAccountConnections)
+	require.Equal(t, genesis.AutoCompoundLockIds, genesisExported.AutoCompoundLockIds)
 }
@@ -714,3 +714,44 @@ func (q Querier) RestSupply(goCtx context.Context, req *types.QueryRestSupplyReq
 	supply := q.bk.GetSupply(sdk.UnwrapSDKContext(goCtx), req.Denom)
 	return &types.QueryRestSupplyResponse{Amount: supply}, nil
 }
+
+// IntermediaryAccountSlashes returns the history of slashes applied to the locks of the given intermediary account.
+func (q Querier) IntermediaryAccountSlashes(goCtx context.Context, req *types.IntermediaryAccountSlashesRequest) (*types.IntermediaryAccountSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	intermediaryAccount, err := sdk.AccAddressFromBech32(req.IntermediaryAccount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slashes, pageRes, err := q.Keeper.GetIntermediaryAccountSlashes(sdk.UnwrapSDKContext(goCtx), intermediaryAccount, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.IntermediaryAccountSlashesResponse{Slashes: slashes, Pagination: pageRes}, nil
+}
+
+// TotalSuperfluidStakeByAsset returns the total amount of osmo superfluid staked through every superfluid asset.
+func (q Querier) TotalSuperfluidStakeByAsset(goCtx context.Context, _ *types.TotalSuperfluidStakeByAssetRequest) (*types.TotalSuperfluidStakeByAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.TotalSuperfluidStakeByAssetResponse{Assets: q.Keeper.GetAllSuperfluidStakeByAsset(ctx)}, nil
+}
+
+// TotalSuperfluidStakeByValidator returns the total amount of osmo superfluid staked to every validator.
+func (q Querier) TotalSuperfluidStakeByValidator(goCtx context.Context, _ *types.TotalSuperfluidStakeByValidatorRequest) (*types.TotalSuperfluidStakeByValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.TotalSuperfluidStakeByValidatorResponse{Validators: q.Keeper.GetAllSuperfluidStakeByValidator(ctx)}, nil
+}
+
+// AutoCompoundLock returns whether the given lock is opted in to auto-compounding its staking rewards.
+func (q Querier) AutoCompoundLock(goCtx context.Context, req *types.AutoCompoundLockRequest) (*types.AutoCompoundLockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.AutoCompoundLockResponse{Enabled: q.Keeper.IsAutoCompoundLock(ctx, req.LockId)}, nil
+}
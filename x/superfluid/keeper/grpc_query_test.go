@@ -272,6 +272,72 @@ func (s *KeeperTestSuite) TestGRPCQuerySuperfluidDelegationsDontIncludeUnbonding
 	s.Require().Equal(totalSuperfluidDelegationsRes.TotalDelegations, osmomath.NewInt(30000000))
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryTotalSuperfluidStake() {
+	s.SetupTest()
+
+	// setup 2 validators
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+
+	// create a delegation of 1000000 for every combination of 2 delegations, 2 validators, and 2 superfluid denoms,
+	// each worth 10000000 osmo after the risk adjustment
+	superfluidDelegations := []superfluidDelegation{
+		{0, 0, 0, 1000000},
+		{0, 1, 1, 1000000},
+		{1, 0, 1, 1000000},
+		{1, 1, 0, 1000000},
+	}
+	_, _, locks := s.setupSuperfluidDelegations(valAddrs, superfluidDelegations, denoms)
+
+	requireTotals := func(expectedByAsset []types.SuperfluidStakeByAsset, expectedByValidator []types.SuperfluidStakeByValidator) {
+		byAssetRes, err := s.queryClient.TotalSuperfluidStakeByAsset(sdk.WrapSDKContext(s.Ctx), &types.TotalSuperfluidStakeByAssetRequest{})
+		s.Require().NoError(err)
+		s.Require().ElementsMatch(expectedByAsset, byAssetRes.Assets)
+
+		byValidatorRes, err := s.queryClient.TotalSuperfluidStakeByValidator(sdk.WrapSDKContext(s.Ctx), &types.TotalSuperfluidStakeByValidatorRequest{})
+		s.Require().NoError(err)
+		s.Require().ElementsMatch(expectedByValidator, byValidatorRes.Validators)
+
+		// the running totals add up to the total computed from the delegations of the intermediary accounts
+		totalSuperfluidDelegationsRes, err := s.queryClient.TotalSuperfluidDelegations(sdk.WrapSDKContext(s.Ctx), &types.TotalSuperfluidDelegationsRequest{})
+		s.Require().NoError(err)
+		totalByAsset := osmomath.ZeroInt()
+		for _, total := range byAssetRes.Assets {
+			totalByAsset = totalByAsset.Add(total.OsmoEquivalent)
+		}
+		s.Require().Equal(totalSuperfluidDelegationsRes.TotalDelegations, totalByAsset)
+	}
+
+	requireTotals(
+		[]types.SuperfluidStakeByAsset{
+			{Denom: denoms[0], OsmoEquivalent: osmomath.NewInt(20000000)},
+			{Denom: denoms[1], OsmoEquivalent: osmomath.NewInt(20000000)},
+		},
+		[]types.SuperfluidStakeByValidator{
+			{ValAddr: valAddrs[0].String(), OsmoEquivalent: osmomath.NewInt(20000000)},
+			{ValAddr: valAddrs[1].String(), OsmoEquivalent: osmomath.NewInt(20000000)},
+		},
+	)
+
+	// undelegate the superfluid delegation of denom0 from delegator0 to validator0
+	err := s.querier.SuperfluidUndelegate(s.Ctx, locks[0].Owner, locks[0].ID)
+	s.Require().NoError(err)
+
+	expectedByAsset := []types.SuperfluidStakeByAsset{
+		{Denom: denoms[0], OsmoEquivalent: osmomath.NewInt(10000000)},
+		{Denom: denoms[1], OsmoEquivalent: osmomath.NewInt(20000000)},
+	}
+	expectedByValidator := []types.SuperfluidStakeByValidator{
+		{ValAddr: valAddrs[0].String(), OsmoEquivalent: osmomath.NewInt(10000000)},
+		{ValAddr: valAddrs[1].String(), OsmoEquivalent: osmomath.NewInt(20000000)},
+	}
+	requireTotals(expectedByAsset, expectedByValidator)
+
+	// refreshing the delegations without a change of multiplier leaves the totals unchanged
+	s.App.SuperfluidKeeper.RefreshIntermediaryDelegationAmounts(s.Ctx)
+	requireTotals(expectedByAsset, expectedByValidator)
+}
+
 func (s *KeeperTestSuite) TestUserConcentratedSuperfluidPositionsBondedAndUnbonding() {
 	s.SetupTest()
 
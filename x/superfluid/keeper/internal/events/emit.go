@@ -168,3 +168,61 @@ func newUnpoolIdEvent(sender string, lpShareDenom string, allExitedLockIDsSerial
 		sdk.NewAttribute(types.AttributeNewLockIds, string(allExitedLockIDsSerialized)),
 	)
 }
+
+func EmitSetSuperfluidAutoCompoundEvent(ctx sdk.Context, lockId uint64, enabled bool) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSetSuperfluidAutoCompoundEvent(lockId, enabled),
+	})
+}
+
+func newSetSuperfluidAutoCompoundEvent(lockId uint64, enabled bool) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSetSuperfluidAutoCompound,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeEnabled, fmt.Sprintf("%t", enabled)),
+	)
+}
+
+func EmitSuperfluidAutoCompoundEvent(ctx sdk.Context, lockId uint64, amount sdk.Coin, shares sdk.Coin) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidAutoCompoundEvent(lockId, amount, shares),
+	})
+}
+
+func newSuperfluidAutoCompoundEvent(lockId uint64, amount sdk.Coin, shares sdk.Coin) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSuperfluidAutoCompound,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeShares, shares.String()),
+	)
+}
+
+func EmitSuperfluidLockSlashEvent(ctx sdk.Context, lockId uint64, intermediaryAccount, valAddress string, slashedCoins, slashedUnderlyingCoins sdk.Coins) {
+	if ctx.EventManager() == nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSuperfluidLockSlashEvent(lockId, intermediaryAccount, valAddress, slashedCoins, slashedUnderlyingCoins),
+	})
+}
+
+func newSuperfluidLockSlashEvent(lockId uint64, intermediaryAccount, valAddress string, slashedCoins, slashedUnderlyingCoins sdk.Coins) sdk.Event {
+	return sdk.NewEvent(
+		types.TypeEvtSuperfluidLockSlash,
+		sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", lockId)),
+		sdk.NewAttribute(types.AttributeIntermediaryAccount, intermediaryAccount),
+		sdk.NewAttribute(types.AttributeValidator, valAddress),
+		sdk.NewAttribute(types.AttributeAmount, slashedCoins.String()),
+		sdk.NewAttribute(types.AttributeUnderlyingAmount, slashedUnderlyingCoins.String()),
+	)
+}
@@ -424,3 +424,110 @@ func (suite *SuperfluidEventsTestSuite) TestEmitUnpoolIdEvent() {
 		})
 	}
 }
+
+func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidAutoCompoundEvent() {
+	testcases := map[string]struct {
+		ctx    sdk.Context
+		lockID uint64
+		amount sdk.Coin
+		shares sdk.Coin
+	}{
+		"basic valid": {
+			ctx:    suite.CreateTestContext(),
+			lockID: 1,
+			amount: sdk.NewCoin(testDenomA, osmomath.NewInt(100)),
+			shares: sdk.NewCoin(testDenomB, osmomath.NewInt(10)),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSuperfluidAutoCompound,
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockID)),
+					sdk.NewAttribute(types.AttributeAmount, tc.amount.String()),
+					sdk.NewAttribute(types.AttributeShares, tc.shares.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSuperfluidAutoCompoundEvent(tc.ctx, tc.lockID, tc.amount, tc.shares)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
+func (suite *SuperfluidEventsTestSuite) TestEmitSuperfluidLockSlashEvent() {
+	testcases := map[string]struct {
+		ctx                    sdk.Context
+		lockID                 uint64
+		intermediaryAccount    string
+		valAddr                string
+		slashedCoins           sdk.Coins
+		slashedUnderlyingCoins sdk.Coins
+	}{
+		"basic valid": {
+			ctx:                 suite.CreateTestContext(),
+			lockID:              1,
+			intermediaryAccount: sdk.AccAddress([]byte(addressString)).String(),
+			valAddr:             sdk.ValAddress([]byte(addressString)).String(),
+			slashedCoins:        sdk.NewCoins(sdk.NewCoin(testDenomA, osmomath.NewInt(5))),
+		},
+		"with underlying coins": {
+			ctx:                    suite.CreateTestContext(),
+			lockID:                 2,
+			intermediaryAccount:    sdk.AccAddress([]byte(addressString)).String(),
+			valAddr:                sdk.ValAddress([]byte(addressString)).String(),
+			slashedCoins:           sdk.NewCoins(sdk.NewCoin(testDenomA, osmomath.NewInt(5))),
+			slashedUnderlyingCoins: sdk.NewCoins(sdk.NewCoin(testDenomB, osmomath.NewInt(10))),
+		},
+		"context with no event manager": {
+			ctx: sdk.Context{},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.TypeEvtSuperfluidLockSlash,
+					sdk.NewAttribute(types.AttributeLockId, fmt.Sprintf("%d", tc.lockID)),
+					sdk.NewAttribute(types.AttributeIntermediaryAccount, tc.intermediaryAccount),
+					sdk.NewAttribute(types.AttributeValidator, tc.valAddr),
+					sdk.NewAttribute(types.AttributeAmount, tc.slashedCoins.String()),
+					sdk.NewAttribute(types.AttributeUnderlyingAmount, tc.slashedUnderlyingCoins.String()),
+				),
+			}
+
+			hasNoEventManager := tc.ctx.EventManager() == nil
+
+			// System under test.
+			events.EmitSuperfluidLockSlashEvent(tc.ctx, tc.lockID, tc.intermediaryAccount, tc.valAddr, tc.slashedCoins, tc.slashedUnderlyingCoins)
+
+			// Assertions
+			if hasNoEventManager {
+				// If there is no event manager on context, this is a no-op.
+				return
+			}
+
+			eventManager := tc.ctx.EventManager()
+			actualEvents := eventManager.Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
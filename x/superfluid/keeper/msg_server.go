@@ -253,3 +253,16 @@ func (server msgServer) UnbondConvertAndStake(goCtx context.Context, msg *types.
 
 	return &types.MsgUnbondConvertAndStakeResponse{TotalAmtStaked: totalAmtConverted}, nil
 }
+
+// SetSuperfluidAutoCompound opts a superfluid delegated lock in or out of auto-compounding its staking rewards.
+func (server msgServer) SetSuperfluidAutoCompound(goCtx context.Context, msg *types.MsgSetSuperfluidAutoCompound) (*types.MsgSetSuperfluidAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SetSuperfluidAutoCompound(ctx, msg.Sender, msg.LockId, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	events.EmitSetSuperfluidAutoCompoundEvent(ctx, msg.LockId, msg.Enabled)
+	return &types.MsgSetSuperfluidAutoCompoundResponse{}, nil
+}
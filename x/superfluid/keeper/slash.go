@@ -2,16 +2,23 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	cl "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity"
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/keeper/internal/events"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // SlashLockupsForValidatorSlash should be called before the validator at valAddr is slashed.
@@ -20,6 +27,8 @@ import (
 // Furthermore, if the infraction height is sufficiently old, slashes unbondings
 // Note: Based on sdk.staking.Slash function review, slashed tokens are burnt not sent to community pool
 // we ignore that, and send the underliyng tokens to the community pool anyway.
+// The amounts slashed from the locks of every intermediary account are recorded as an IntermediaryAccountSlash
+// and emitted as one event per lock, so that the owners can reconcile the reduction of their locks.
 func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAddress, slashFactor osmomath.Dec) {
 	// Important note: The SDK slashing for historical heights is wrong.
 	// It defines a "slash amount" off of the live staked amount.
@@ -40,6 +49,8 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 	// We do these slashes as burns.
 	for _, acc := range accs {
 		locks := k.lk.GetLocksLongerThanDurationDenom(ctx, acc.Denom, time.Second)
+		sort.Slice(locks, func(i, j int) bool { return locks[i].ID < locks[j].ID })
+		lockSlashes := []types.LockSlash{}
 		for _, lock := range locks {
 			// slashing only applies to synthetic lockup amount
 			synthLock, err := k.lk.GetSyntheticLockup(ctx, lock.ID, stakingSyntheticDenom(acc.Denom, acc.ValAddr))
@@ -56,19 +67,37 @@ func (k Keeper) SlashLockupsForValidatorSlash(ctx sdk.Context, valAddr sdk.ValAd
 			// slash the lock whether its bonding or unbonding.
 			// this overslashes unbondings that started unbonding before the slash infraction,
 			// but this seems to be an acceptable trade-off based upon choices taken in the SDK.
-			k.slashSynthLock(ctx, synthLock, slashFactor)
+			lockSlash, slashed := k.slashSynthLock(ctx, acc, synthLock, slashFactor)
+			if slashed {
+				lockSlashes = append(lockSlashes, lockSlash)
+			}
+		}
+
+		if len(lockSlashes) > 0 {
+			k.setIntermediaryAccountSlash(ctx, types.IntermediaryAccountSlash{
+				Id:                  k.getNextIntermediaryAccountSlashIdAndIncrement(ctx),
+				IntermediaryAccount: acc.GetAccAddress().String(),
+				ValAddr:             acc.ValAddr,
+				Height:              ctx.BlockHeight(),
+				Time:                ctx.BlockTime(),
+				SlashFactor:         slashFactor,
+				LockSlashes:         lockSlashes,
+			})
 		}
 	}
 }
 
-func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.SyntheticLock, slashFactor osmomath.Dec) {
+// slashSynthLock slashes slashFactor of the lock underlying the given synthetic lock and returns
+// the amounts slashed from it and true. If slashing the lock fails, the lock is left unchanged and false is returned.
+func (k Keeper) slashSynthLock(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, synthLock *lockuptypes.SyntheticLock, slashFactor osmomath.Dec) (types.LockSlash, bool) {
 	// Only single token lock is allowed here
 	lock, _ := k.lk.GetLockByID(ctx, synthLock.UnderlyingLockId)
 	slashAmt := lock.Coins[0].Amount.ToLegacyDec().Mul(slashFactor)
 	lockSharesToSlash := sdk.NewCoins(sdk.NewCoin(lock.Coins[0].Denom, slashAmt.TruncateInt()))
 
 	// If the slashCoins contains a cl denom, we need to update the underlying cl position to reflect the slash.
-	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+	underlyingCoinsSlashed := sdk.Coins{}
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
 		if strings.HasPrefix(lock.Coins[0].Denom, cltypes.ConcentratedLiquidityTokenPrefix) {
 			// Run prepare logic to get the underlying coins to slash.
 			// We get the pool address here since the underlying coins will be sent directly from the pool to the community pool instead of the lock module account.
@@ -80,13 +109,30 @@ func (k Keeper) slashSynthLock(ctx sdk.Context, synthLock *lockuptypes.Synthetic
 			// Run the normal slashing logic, but instead of sending gamm shares to the community pool, we send the underlying coins
 			// the cl shares represent to the community pool and burn the cl shares from the lockup module account as well as the lock itself
 			_, err = k.lk.SlashTokensFromLockByIDSendUnderlyingAndBurn(cacheCtx, lock.ID, lockSharesToSlash, underlyingCoinsToSlash, poolAddress)
-			return err
+			if err != nil {
+				return err
+			}
+			underlyingCoinsSlashed = underlyingCoinsToSlash
 		} else {
 			// These tokens get moved to the community pool.
 			_, err := k.lk.SlashTokensFromLockByID(cacheCtx, lock.ID, lockSharesToSlash)
-			return err
+			if err != nil {
+				return err
+			}
 		}
+
+		events.EmitSuperfluidLockSlashEvent(cacheCtx, lock.ID, acc.GetAccAddress().String(), acc.ValAddr, lockSharesToSlash, underlyingCoinsSlashed)
+		return nil
 	})
+	if err != nil {
+		return types.LockSlash{}, false
+	}
+
+	return types.LockSlash{
+		LockId:                 lock.ID,
+		SlashedCoins:           lockSharesToSlash,
+		SlashedUnderlyingCoins: underlyingCoinsSlashed,
+	}, true
 }
 
 // prepareConcentratedLockForSlash is a helper function that runs pre-slash logic for concentrated lockups. This function:
@@ -143,3 +189,43 @@ func (k Keeper) prepareConcentratedLockForSlash(ctx sdk.Context, lock *lockuptyp
 
 	return concentratedPool.GetAddress(), coinsToSlash, nil
 }
+
+// GetIntermediaryAccountSlashes returns the slashes applied to the locks of the given intermediary account,
+// in ascending order of id, along with the page response.
+func (k Keeper) GetIntermediaryAccountSlashes(ctx sdk.Context, intermediaryAccount sdk.AccAddress, pagination *query.PageRequest) ([]types.IntermediaryAccountSlash, *query.PageResponse, error) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyIntermediaryAccountSlashPrefix(intermediaryAccount))
+
+	slashes := []types.IntermediaryAccountSlash{}
+	pageRes, err := query.Paginate(prefixStore, pagination, func(key, value []byte) error {
+		slash := types.IntermediaryAccountSlash{}
+		if err := proto.Unmarshal(value, &slash); err != nil {
+			return err
+		}
+		slashes = append(slashes, slash)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return slashes, pageRes, nil
+}
+
+func (k Keeper) setIntermediaryAccountSlash(ctx sdk.Context, slash types.IntermediaryAccountSlash) {
+	bz, err := proto.Marshal(&slash)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeyIntermediaryAccountSlash(sdk.MustAccAddressFromBech32(slash.IntermediaryAccount), slash.Id), bz)
+}
+
+// getNextIntermediaryAccountSlashIdAndIncrement returns the id to assign to the next intermediary account slash
+// and increments the stored id.
+func (k Keeper) getNextIntermediaryAccountSlashIdAndIncrement(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	nextId := uint64(1)
+	if bz := store.Get(types.KeyNextIntermediaryAccountSlashId); bz != nil {
+		nextId = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.KeyNextIntermediaryAccountSlashId, sdk.Uint64ToBigEndian(nextId+1))
+	return nextId
+}
@@ -9,6 +9,7 @@ import (
 	cltypes "github.com/osmosis-labs/osmosis/v21/x/concentrated-liquidity/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	"github.com/osmosis-labs/osmosis/v21/x/superfluid/keeper"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -204,6 +205,61 @@ func (s *KeeperTestSuite) TestSlashLockupsForUnbondingDelegationSlash() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSlashLockupsForValidatorSlash_RecordsLockSlashes() {
+	s.SetupTest()
+	valAddrs := s.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded, stakingtypes.Bonded})
+	denoms, _ := s.SetupGammPoolsAndSuperfluidAssets([]osmomath.Dec{osmomath.NewDec(20), osmomath.NewDec(20)})
+	_, intermediaryAccs, locks := s.setupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, 0, 0, 1000000}, {1, 0, 0, 2000000}, {2, 1, 0, 1000000}}, denoms)
+	s.Require().Len(intermediaryAccs, 2)
+	slashedAcc := intermediaryAccs[0]
+	s.Require().Equal(valAddrs[0].String(), slashedAcc.ValAddr)
+
+	slashFactor := osmomath.NewDecWithPrec(5, 2)
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+
+	// System under test.
+	s.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(s.Ctx, valAddrs[0], slashFactor)
+
+	// Only the locks delegated to the slashed validator are recorded, with the amount each of them was reduced by.
+	slashes, _, err := s.App.SuperfluidKeeper.GetIntermediaryAccountSlashes(s.Ctx, slashedAcc.GetAccAddress(), nil)
+	s.Require().NoError(err)
+	s.Require().Len(slashes, 1)
+	s.Require().Equal(uint64(1), slashes[0].Id)
+	s.Require().Equal(slashedAcc.GetAccAddress().String(), slashes[0].IntermediaryAccount)
+	s.Require().Equal(valAddrs[0].String(), slashes[0].ValAddr)
+	s.Require().Equal(int64(100), slashes[0].Height)
+	s.Require().Equal(s.Ctx.BlockTime().UTC(), slashes[0].Time.UTC())
+	s.Require().True(slashFactor.Equal(slashes[0].SlashFactor))
+	s.Require().Len(slashes[0].LockSlashes, 2)
+	for i, lockSlash := range slashes[0].LockSlashes {
+		s.Require().Equal(locks[i].ID, lockSlash.LockId)
+		lockAfter, err := s.App.LockupKeeper.GetLockByID(s.Ctx, lockSlash.LockId)
+		s.Require().NoError(err)
+		s.Require().Equal(locks[i].Coins.Sub(lockAfter.Coins...).String(), lockSlash.SlashedCoins.String())
+		s.Require().True(lockSlash.SlashedUnderlyingCoins.Empty())
+	}
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtSuperfluidLockSlash, 2)
+
+	notSlashedSlashes, _, err := s.App.SuperfluidKeeper.GetIntermediaryAccountSlashes(s.Ctx, intermediaryAccs[1].GetAccAddress(), nil)
+	s.Require().NoError(err)
+	s.Require().Empty(notSlashedSlashes)
+
+	// A later slash is appended to the history with the next id.
+	s.App.SuperfluidKeeper.SlashLockupsForValidatorSlash(s.Ctx, valAddrs[0], slashFactor)
+	res, err := s.queryClient.IntermediaryAccountSlashes(sdk.WrapSDKContext(s.Ctx), &types.IntermediaryAccountSlashesRequest{
+		IntermediaryAccount: slashedAcc.GetAccAddress().String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Slashes, 2)
+	s.Require().Equal(slashes[0], res.Slashes[0])
+	s.Require().Equal(uint64(2), res.Slashes[1].Id)
+
+	_, err = s.queryClient.IntermediaryAccountSlashes(sdk.WrapSDKContext(s.Ctx), &types.IntermediaryAccountSlashesRequest{
+		IntermediaryAccount: "invalid",
+	})
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestPrepareConcentratedLockForSlash() {
 	type prepareConcentratedLockTestCase struct {
 		name         string
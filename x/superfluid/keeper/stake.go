@@ -74,6 +74,9 @@ func (k Keeper) RefreshIntermediaryDelegationAmounts(ctx sdk.Context) {
 			currentAmount = validator.TokensFromShares(delegation.Shares).RoundInt()
 		}
 
+		// Sync the tracked amount to the actual delegation, which may have been slashed since the last refresh.
+		k.setIntermediaryAccountStake(ctx, acc, currentAmount)
+
 		refreshedAmount, err := k.GetExpectedDelegationAmount(ctx, acc)
 		if err != nil {
 			ctx.Logger().Error("Error in GetExpectedDelegationAmount (likely that underlying LP share is no longer superfluid capable), state update reverted", err)
@@ -272,6 +275,7 @@ func (k Keeper) undelegateCommon(ctx sdk.Context, sender string, lockID uint64)
 		return types.SuperfluidIntermediaryAccount{}, types.ErrNotSuperfluidUsedLockup
 	}
 	k.DeleteLockIdIntermediaryAccountConnection(ctx, lockID)
+	k.deleteAutoCompoundLock(ctx, lockID)
 
 	// Delete the old synthetic lockup
 	synthdenom := stakingSyntheticDenom(lockedCoin.Denom, intermediaryAcc.ValAddr)
@@ -472,7 +476,12 @@ func (k Keeper) mintOsmoTokensAndDelegate(ctx sdk.Context, osmoAmount osmomath.I
 		_, err = k.sk.Delegate(cacheCtx,
 			intermediaryAccount.GetAccAddress(),
 			osmoAmount, stakingtypes.Unbonded, validator, true)
-		return err
+		if err != nil {
+			return err
+		}
+
+		k.addIntermediaryAccountStake(cacheCtx, intermediaryAccount, osmoAmount)
+		return nil
 	})
 	return err
 }
@@ -515,6 +524,7 @@ func (k Keeper) forceUndelegateAndBurnOsmoTokens(ctx sdk.Context,
 		}
 		bondDenom := k.sk.BondDenom(cacheCtx)
 		k.bk.AddSupplyOffset(cacheCtx, bondDenom, undelegatedCoins.AmountOf(bondDenom))
+		k.addIntermediaryAccountStake(cacheCtx, intermediaryAcc, undelegatedCoins.AmountOf(bondDenom).Neg())
 
 		return err
 	})
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/v21/x/superfluid/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The osmo equivalent amount delegated by every intermediary account is tracked along with running totals
+// per superfluid asset and per validator, so that the totals can be queried without iterating over
+// the intermediary accounts and their delegations. The amounts are updated on every mint and burn of
+// superfluid osmo, and synced to the actual delegations when they are refreshed at epoch end, which also
+// accounts for slashes of the delegations.
+
+// GetIntermediaryAccountStake returns the osmo equivalent amount delegated by the given intermediary account.
+func (k Keeper) GetIntermediaryAccountStake(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount) osmomath.Int {
+	return k.getIntAmount(ctx, types.KeyIntermediaryAccountStake(acc.GetAccAddress()))
+}
+
+// GetSuperfluidStakeByAsset returns the total osmo equivalent amount superfluid staked through the given denom.
+func (k Keeper) GetSuperfluidStakeByAsset(ctx sdk.Context, denom string) osmomath.Int {
+	return k.getIntAmount(ctx, types.KeySuperfluidStakeByAsset(denom))
+}
+
+// GetSuperfluidStakeByValidator returns the total osmo equivalent amount superfluid staked to the given validator.
+func (k Keeper) GetSuperfluidStakeByValidator(ctx sdk.Context, valAddr string) osmomath.Int {
+	return k.getIntAmount(ctx, types.KeySuperfluidStakeByValidator(valAddr))
+}
+
+// GetAllSuperfluidStakeByAsset returns the total osmo equivalent amount superfluid staked through every denom
+// with a non-zero total, in ascending order of denom.
+func (k Keeper) GetAllSuperfluidStakeByAsset(ctx sdk.Context) []types.SuperfluidStakeByAsset {
+	totals := []types.SuperfluidStakeByAsset{}
+	k.iterateIntAmounts(ctx, types.KeyPrefixSuperfluidStakeByAsset, func(key string, amount osmomath.Int) {
+		totals = append(totals, types.SuperfluidStakeByAsset{Denom: key, OsmoEquivalent: amount})
+	})
+	return totals
+}
+
+// GetAllSuperfluidStakeByValidator returns the total osmo equivalent amount superfluid staked to every validator
+// with a non-zero total, in ascending order of validator address.
+func (k Keeper) GetAllSuperfluidStakeByValidator(ctx sdk.Context) []types.SuperfluidStakeByValidator {
+	totals := []types.SuperfluidStakeByValidator{}
+	k.iterateIntAmounts(ctx, types.KeyPrefixSuperfluidStakeByValidator, func(key string, amount osmomath.Int) {
+		totals = append(totals, types.SuperfluidStakeByValidator{ValAddr: key, OsmoEquivalent: amount})
+	})
+	return totals
+}
+
+// InitializeSuperfluidStakeTotals sets the amount delegated by every intermediary account, and the running totals,
+// from their current delegations. It is meant to be run once when the tracking is introduced or the state is imported.
+func (k Keeper) InitializeSuperfluidStakeTotals(ctx sdk.Context) {
+	for _, acc := range k.GetAllIntermediaryAccounts(ctx) {
+		valAddr, err := sdk.ValAddressFromBech32(acc.ValAddr)
+		if err != nil {
+			panic(err)
+		}
+		k.setIntermediaryAccountStake(ctx, acc, k.getDelegatedAmount(ctx, acc.GetAccAddress(), valAddr))
+	}
+}
+
+// getDelegatedAmount returns the amount of tokens the given delegator has delegated to the given validator,
+// or zero if the validator or the delegation does not exist.
+func (k Keeper) getDelegatedAmount(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress) osmomath.Int {
+	validator, found := k.sk.GetValidator(ctx, valAddr)
+	if !found {
+		return osmomath.ZeroInt()
+	}
+	delegation, found := k.sk.GetDelegation(ctx, delegator, valAddr)
+	if !found {
+		return osmomath.ZeroInt()
+	}
+	return validator.TokensFromShares(delegation.Shares).RoundInt()
+}
+
+// addIntermediaryAccountStake adds the given amount, which may be negative, to the amount delegated by
+// the given intermediary account. The amount delegated does not go below zero.
+func (k Keeper) addIntermediaryAccountStake(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, amount osmomath.Int) {
+	newStake := k.GetIntermediaryAccountStake(ctx, acc).Add(amount)
+	if newStake.IsNegative() {
+		newStake = osmomath.ZeroInt()
+	}
+	k.setIntermediaryAccountStake(ctx, acc, newStake)
+}
+
+// setIntermediaryAccountStake sets the amount delegated by the given intermediary account and updates
+// the running totals of its denom and validator by the change.
+func (k Keeper) setIntermediaryAccountStake(ctx sdk.Context, acc types.SuperfluidIntermediaryAccount, amount osmomath.Int) {
+	change := amount.Sub(k.GetIntermediaryAccountStake(ctx, acc))
+	if change.IsZero() {
+		return
+	}
+
+	k.setIntAmount(ctx, types.KeyIntermediaryAccountStake(acc.GetAccAddress()), amount)
+	k.setIntAmount(ctx, types.KeySuperfluidStakeByAsset(acc.Denom), k.GetSuperfluidStakeByAsset(ctx, acc.Denom).Add(change))
+	k.setIntAmount(ctx, types.KeySuperfluidStakeByValidator(acc.ValAddr), k.GetSuperfluidStakeByValidator(ctx, acc.ValAddr).Add(change))
+}
+
+func (k Keeper) getIntAmount(ctx sdk.Context, key []byte) osmomath.Int {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return osmomath.ZeroInt()
+	}
+
+	var amount osmomath.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// setIntAmount sets the given amount under the given key, deleting the key if the amount is not positive.
+func (k Keeper) setIntAmount(ctx sdk.Context, key []byte, amount osmomath.Int) {
+	store := ctx.KVStore(k.storeKey)
+	if !amount.IsPositive() {
+		store.Delete(key)
+		return
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+func (k Keeper) iterateIntAmounts(ctx sdk.Context, keyPrefix []byte, cb func(key string, amount osmomath.Int)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount osmomath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		cb(string(iterator.Key()), amount)
+	}
+}
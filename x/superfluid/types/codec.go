@@ -23,6 +23,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateFullRangePositionAndSuperfluidDelegate{}, "osmosis/full-range-and-sf-delegate", nil)
 	cdc.RegisterConcrete(&MsgAddToConcentratedLiquiditySuperfluidPosition{}, "osmosis/add-to-cl-superfluid-position", nil)
 	cdc.RegisterConcrete(&MsgUnbondConvertAndStake{}, "osmosis/unbond-convert-and-stake", nil)
+	cdc.RegisterConcrete(&MsgSetSuperfluidAutoCompound{}, "osmosis/set-superfluid-auto-compound", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,6 +39,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateFullRangePositionAndSuperfluidDelegate{},
 		&MsgAddToConcentratedLiquiditySuperfluidPosition{},
 		&MsgUnbondConvertAndStake{},
+		&MsgSetSuperfluidAutoCompound{},
 	)
 
 	registry.RegisterImplementations(
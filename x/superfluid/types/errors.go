@@ -27,6 +27,8 @@ var (
 	ErrPoolNotWhitelisted   = errorsmod.Register(ModuleName, 41, "pool not whitelisted to unpool")
 	ErrLockUnpoolNotAllowed = errorsmod.Register(ModuleName, 42, "lock not eligible for unpooling")
 	ErrLockLengthMismatch   = errorsmod.Register(ModuleName, 43, "lock has more than one asset")
+
+	ErrAutoCompoundNotSupported = errorsmod.Register(ModuleName, 44, "auto-compounding is only supported for superfluid delegated balancer and stableswap share locks")
 )
 
 type PositionNotSuperfluidStakedError struct {
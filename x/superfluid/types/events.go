@@ -10,6 +10,9 @@ const (
 	TypeEvtSuperfluidUnbondLock                         = "superfluid_unbond_lock"
 	TypeEvtSuperfluidUndelegateAndUnbondLock            = "superfluid_undelegate_and_unbond_lock"
 	TypeEvtAddToConcentratedLiquiditySuperfluidPosition = "add_to_concentrated_liquidity_superfluid_position"
+	TypeEvtSetSuperfluidAutoCompound                    = "set_superfluid_auto_compound"
+	TypeEvtSuperfluidAutoCompound                       = "superfluid_auto_compound"
+	TypeEvtSuperfluidLockSlash                          = "superfluid_lock_slash"
 
 	TypeEvtUnpoolId     = "unpool_pool_id"
 	AttributeNewLockIds = "new_lock_ids"
@@ -32,4 +35,8 @@ const (
 	AttributeLockId              = "lock_id"
 	AttributeValidator           = "validator"
 	AttributeAmount              = "amount"
+	AttributeEnabled             = "enabled"
+	AttributeShares              = "shares"
+	AttributeIntermediaryAccount = "intermediary_account"
+	AttributeUnderlyingAmount    = "underlying_amount"
 )
@@ -34,6 +34,7 @@ type LockupKeeper interface {
 	SplitLock(ctx sdk.Context, lock lockuptypes.PeriodLock, coins sdk.Coins, forceUnlock bool) (lockuptypes.PeriodLock, error)
 
 	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
+	AddTokensToLockByID(ctx sdk.Context, lockID uint64, owner sdk.AccAddress, tokensToAdd sdk.Coin) (*lockuptypes.PeriodLock, error)
 
 	SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sdk.Coins) (*lockuptypes.PeriodLock, error)
 	SlashTokensFromLockByIDSendUnderlyingAndBurn(ctx sdk.Context, lockID uint64, liquiditySharesInLock, underlyingPositionAssets sdk.Coins, poolAddress sdk.AccAddress) (*lockuptypes.PeriodLock, error)
@@ -55,6 +56,7 @@ type GammKeeper interface {
 	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.CFMMPoolI, err error)
 	ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount osmomath.Int, tokenOutMins sdk.Coins) (exitCoins sdk.Coins, err error)
+	JoinSwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins, shareOutMinAmount osmomath.Int) (sharesOut osmomath.Int, err error)
 	GetAllMigrationInfo(ctx sdk.Context) (gammmigration.MigrationRecords, error)
 	GetLinkedConcentratedPoolID(ctx sdk.Context, poolIdLeaving uint64) (poolIdEntering uint64, err error)
 	MigrateUnlockedPositionFromBalancerToConcentrated(ctx sdk.Context, sender sdk.AccAddress, sharesToMigrate sdk.Coin, tokenOutMins sdk.Coins) (positionData cltypes.CreateFullRangePositionData, migratedPoolIDs MigrationPoolIDs, err error)
@@ -99,6 +101,7 @@ type IncentivesKeeper interface {
 
 	GetActiveGauges(ctx sdk.Context) []incentivestypes.Gauge
 	Distribute(ctx sdk.Context, gauges []incentivestypes.Gauge) (sdk.Coins, error)
+	DistributeAndGetLockRewards(ctx sdk.Context, gauges []incentivestypes.Gauge, lockIds []uint64) (sdk.Coins, []incentivestypes.LockDistributionProjection, error)
 
 	GetParams(ctx sdk.Context) incentivestypes.Params
 }
@@ -36,6 +36,9 @@ type GenesisState struct {
 	// plays an intermediary role between validators and the delegators.
 	IntermediaryAccounts          []SuperfluidIntermediaryAccount       `protobuf:"bytes,4,rep,name=intermediary_accounts,json=intermediaryAccounts,proto3" json:"intermediary_accounts"`
 	IntemediaryAccountConnections []LockIdIntermediaryAccountConnection `protobuf:"bytes,5,rep,name=intemediary_account_connections,json=intemediaryAccountConnections,proto3" json:"intemediary_account_connections"`
+	// auto_compound_lock_ids are the ids of the superfluid delegated locks that
+	// are opted in to auto-compounding their staking rewards.
+	AutoCompoundLockIds []uint64 `protobuf:"varint,6,rep,packed,name=auto_compound_lock_ids,json=autoCompoundLockIds,proto3" json:"auto_compound_lock_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -106,6 +109,13 @@ func (m *GenesisState) GetIntemediaryAccountConnections() []LockIdIntermediaryAc
 	return nil
 }
 
+func (m *GenesisState) GetAutoCompoundLockIds() []uint64 {
+	if m != nil {
+		return m.AutoCompoundLockIds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.superfluid.GenesisState")
 }
@@ -113,31 +123,33 @@ func init() {
 func init() { proto.RegisterFile("osmosis/superfluid/genesis.proto", fileDescriptor_d5256ebb7c83fff3) }
 
 var fileDescriptor_d5256ebb7c83fff3 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xc1, 0x4e, 0xf2, 0x40,
-	0x10, 0xc7, 0xdb, 0x0f, 0x3e, 0x0e, 0xc5, 0x83, 0x36, 0x98, 0x54, 0x8c, 0x85, 0xc8, 0x85, 0x8b,
-	0x6d, 0xa8, 0x89, 0x7a, 0x05, 0x63, 0x0c, 0x89, 0x46, 0x02, 0x89, 0x07, 0x2f, 0xcd, 0x52, 0xd6,
-	0xba, 0xb1, 0xed, 0xd6, 0x9d, 0x2d, 0x81, 0x07, 0xf0, 0xce, 0x63, 0x71, 0xe4, 0xe8, 0xc9, 0x18,
-	0x78, 0x11, 0xd3, 0x76, 0x2d, 0x28, 0xd5, 0xdb, 0xb4, 0xf3, 0xfb, 0xcf, 0x6f, 0x36, 0x19, 0xa5,
-	0x4e, 0xc1, 0xa7, 0x40, 0xc0, 0x84, 0x28, 0xc4, 0xec, 0xd1, 0x8b, 0xc8, 0xc8, 0x74, 0x71, 0x80,
-	0x81, 0x80, 0x11, 0x32, 0xca, 0xa9, 0xaa, 0x0a, 0xc2, 0x58, 0x13, 0xd5, 0x8a, 0x4b, 0x5d, 0x9a,
-	0xb4, 0xcd, 0xb8, 0x4a, 0xc9, 0x6a, 0x23, 0x67, 0xd6, 0xba, 0x14, 0x50, 0x2d, 0x07, 0x0a, 0x11,
-	0x43, 0xbe, 0xf0, 0x1d, 0xcf, 0x8a, 0xca, 0xce, 0x75, 0xba, 0xc1, 0x80, 0x23, 0x8e, 0xd5, 0x0b,
-	0xa5, 0x94, 0x02, 0x9a, 0x5c, 0x97, 0x9b, 0x65, 0xab, 0x6a, 0x6c, 0x6f, 0x64, 0xf4, 0x12, 0xa2,
-	0x53, 0x9c, 0xbf, 0xd7, 0xa4, 0xbe, 0xe0, 0xd5, 0x7b, 0x65, 0x6f, 0x8d, 0xd8, 0x08, 0x00, 0x73,
-	0xd0, 0xfe, 0xd5, 0x0b, 0xcd, 0xb2, 0xd5, 0xc8, 0x1b, 0x32, 0xc8, 0xca, 0x76, 0xcc, 0x8a, 0x69,
-	0xbb, 0xf0, 0xfd, 0x37, 0xa8, 0x13, 0xe5, 0x30, 0x4e, 0xdb, 0xf8, 0x25, 0x22, 0x63, 0xe4, 0xe1,
-	0x80, 0xdb, 0x7e, 0xe4, 0x71, 0x12, 0x7a, 0x04, 0x33, 0xd0, 0x0a, 0x89, 0xc1, 0xca, 0x33, 0xdc,
-	0x81, 0x4f, 0xaf, 0xb2, 0xd4, 0x6d, 0x16, 0xea, 0x63, 0x87, 0xb2, 0x91, 0x10, 0x1e, 0xd0, 0x5f,
-	0x28, 0x50, 0x3d, 0x65, 0x9f, 0x04, 0x1c, 0x33, 0x1f, 0x8f, 0x08, 0x62, 0x53, 0x1b, 0x39, 0x0e,
-	0x8d, 0x02, 0x0e, 0x5a, 0x31, 0x71, 0xb6, 0xfe, 0x7e, 0x55, 0x77, 0x23, 0xda, 0x4e, 0x93, 0x42,
-	0x59, 0x21, 0xdb, 0x2d, 0x50, 0x5f, 0x65, 0xa5, 0x16, 0x37, 0x7e, 0xd8, 0x6c, 0x87, 0x06, 0x01,
-	0x76, 0x38, 0xa1, 0x01, 0x68, 0xff, 0x13, 0xf1, 0x79, 0x9e, 0xf8, 0x86, 0x3a, 0xcf, 0xdd, 0x3c,
-	0xe9, 0x65, 0x96, 0x17, 0xfa, 0xa3, 0x0d, 0xcb, 0x16, 0x03, 0x9d, 0xde, 0x7c, 0xa9, 0xcb, 0x8b,
-	0xa5, 0x2e, 0x7f, 0x2c, 0x75, 0x79, 0xb6, 0xd2, 0xa5, 0xc5, 0x4a, 0x97, 0xde, 0x56, 0xba, 0xf4,
-	0x70, 0xe6, 0x12, 0xfe, 0x14, 0x0d, 0x0d, 0x87, 0xfa, 0xa6, 0xd8, 0xe0, 0xc4, 0x43, 0x43, 0xf8,
-	0xfa, 0x30, 0xc7, 0x56, 0xcb, 0x9c, 0x6c, 0xde, 0x1a, 0x9f, 0x86, 0x18, 0x86, 0xa5, 0xe4, 0xd6,
-	0x4e, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x74, 0xed, 0x8a, 0x27, 0xff, 0x02, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x7d, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
+	0x10, 0x86, 0xad, 0x54, 0x0e, 0xd5, 0x83, 0x56, 0x34, 0x15, 0x23, 0x10, 0xb9, 0x78, 0xb1, 0x0d,
+	0x90, 0xa8, 0x57, 0x20, 0xc6, 0x90, 0x68, 0x24, 0x90, 0x78, 0xf0, 0xd2, 0x2c, 0xed, 0x8a, 0x1b,
+	0xdb, 0x6e, 0xed, 0x6c, 0x09, 0x3c, 0x80, 0x77, 0x5f, 0xca, 0x84, 0x23, 0x47, 0x4f, 0xc6, 0xe8,
+	0x8b, 0xb8, 0x6d, 0xd7, 0x82, 0x52, 0x3d, 0x4c, 0xb2, 0x3b, 0xff, 0x3f, 0xff, 0x37, 0x9b, 0xac,
+	0x52, 0xa1, 0xe0, 0x52, 0x20, 0x60, 0x40, 0xe8, 0xe3, 0xe0, 0xce, 0x09, 0x89, 0x6d, 0x0c, 0xb1,
+	0x87, 0x79, 0x4b, 0xf7, 0x03, 0xca, 0xa8, 0xaa, 0x0a, 0x87, 0x3e, 0x77, 0x14, 0x0b, 0x43, 0x3a,
+	0xa4, 0xb1, 0x6c, 0x44, 0xa7, 0xc4, 0x59, 0xac, 0x66, 0x64, 0xcd, 0x8f, 0xc2, 0x54, 0xce, 0x30,
+	0xf9, 0x28, 0x40, 0xae, 0xe0, 0x1d, 0xbe, 0xc8, 0xca, 0xc6, 0x45, 0xb2, 0x41, 0x9f, 0x21, 0x86,
+	0xd5, 0x33, 0x25, 0x9f, 0x18, 0x34, 0xa9, 0x22, 0x1d, 0xad, 0xd7, 0x8b, 0xfa, 0xf2, 0x46, 0x7a,
+	0x37, 0x76, 0xb4, 0xe4, 0xe9, 0x5b, 0x79, 0xa5, 0x27, 0xfc, 0xea, 0x8d, 0xb2, 0x35, 0xb7, 0x98,
+	0x08, 0x00, 0x33, 0xd0, 0x56, 0x2b, 0x39, 0x1e, 0x52, 0xcd, 0x0a, 0xe9, 0xa7, 0xc7, 0x66, 0xe4,
+	0x15, 0x69, 0x9b, 0xf0, 0xb3, 0x0d, 0xea, 0x58, 0xd9, 0x8f, 0xa6, 0x4d, 0xfc, 0x18, 0x92, 0x11,
+	0x72, 0xb0, 0xc7, 0x4c, 0x37, 0x74, 0x18, 0xf1, 0x1d, 0x82, 0x03, 0xd0, 0x72, 0x31, 0xa1, 0x9e,
+	0x45, 0xb8, 0xe6, 0xad, 0xf3, 0x74, 0xea, 0x2a, 0x1d, 0xea, 0x61, 0x8b, 0x06, 0xb6, 0x00, 0xee,
+	0xd1, 0x3f, 0x5c, 0xa0, 0x3a, 0xca, 0x0e, 0xf1, 0x18, 0x0e, 0x5c, 0x6c, 0x13, 0x14, 0x4c, 0x4c,
+	0x64, 0x59, 0x34, 0xf4, 0xf8, 0xab, 0xe4, 0x98, 0x59, 0xfb, 0xff, 0x55, 0x9d, 0x85, 0xd1, 0x66,
+	0x32, 0x29, 0x90, 0x05, 0xb2, 0x2c, 0x81, 0xfa, 0x24, 0x29, 0xe5, 0x48, 0xf8, 0x45, 0x33, 0x2d,
+	0xea, 0x79, 0xd8, 0x62, 0x84, 0x7a, 0xa0, 0xad, 0xc5, 0xe0, 0xd3, 0x2c, 0xf0, 0x25, 0xb5, 0x1e,
+	0x3a, 0x59, 0xd0, 0x76, 0x3a, 0x2f, 0xf0, 0x07, 0x0b, 0x94, 0x25, 0x0f, 0xa8, 0x0d, 0x65, 0x17,
+	0x85, 0x8c, 0x72, 0xae, 0xeb, 0x73, 0xcd, 0x36, 0x1d, 0x9e, 0x6c, 0x12, 0x1b, 0xb4, 0x3c, 0xa7,
+	0xcb, 0xbd, 0xed, 0x48, 0x6d, 0x0b, 0x31, 0xa1, 0x42, 0xab, 0x3b, 0xfd, 0x28, 0x49, 0x33, 0x5e,
+	0xef, 0xbc, 0x9e, 0x3f, 0x4b, 0x2b, 0x33, 0x5e, 0xaf, 0xbc, 0x6e, 0x4f, 0x86, 0x84, 0xdd, 0x87,
+	0x03, 0x9d, 0xc7, 0x19, 0x62, 0xed, 0x63, 0x07, 0x0d, 0xe0, 0xfb, 0x62, 0x8c, 0xea, 0x35, 0x63,
+	0xbc, 0xf8, 0x41, 0xd9, 0xc4, 0xc7, 0x30, 0xc8, 0xc7, 0x1f, 0xb4, 0xf1, 0x05, 0xc8, 0x96, 0x39,
+	0xc8, 0x34, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -160,6 +172,24 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AutoCompoundLockIds) > 0 {
+		dAtA2 := make([]byte, len(m.AutoCompoundLockIds)*10)
+		var j1 int
+		for _, num := range m.AutoCompoundLockIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.IntemediaryAccountConnections) > 0 {
 		for iNdEx := len(m.IntemediaryAccountConnections) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -272,6 +302,13 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundLockIds) > 0 {
+		l = 0
+		for _, e := range m.AutoCompoundLockIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -479,6 +516,82 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AutoCompoundLockIds = append(m.AutoCompoundLockIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AutoCompoundLockIds) == 0 {
+					m.AutoCompoundLockIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AutoCompoundLockIds = append(m.AutoCompoundLockIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundLockIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 var (
 	// ModuleName defines the module name.
 	ModuleName = "superfluid"
@@ -27,4 +32,47 @@ var (
 
 	// KeyUnpoolAllowedPools defines key to unpool allowed pools.
 	KeyUnpoolAllowedPools = []byte{0x06}
+
+	// KeyPrefixAutoCompoundLock defines prefix to mark the locks whose staking rewards are auto-compounded.
+	KeyPrefixAutoCompoundLock = []byte{0x07}
+
+	// KeyPrefixIntermediaryAccountSlash defines prefix to store the slashes applied to the locks of an intermediary account.
+	KeyPrefixIntermediaryAccountSlash = []byte{0x08}
+
+	// KeyNextIntermediaryAccountSlashId defines key to store the id of the next intermediary account slash.
+	KeyNextIntermediaryAccountSlashId = []byte{0x09}
+
+	// KeyPrefixIntermediaryAccountStake defines prefix to store the osmo equivalent amount delegated by an intermediary account.
+	KeyPrefixIntermediaryAccountStake = []byte{0x0A}
+
+	// KeyPrefixSuperfluidStakeByAsset defines prefix to store the running total of osmo superfluid staked per superfluid asset.
+	KeyPrefixSuperfluidStakeByAsset = []byte{0x0B}
+
+	// KeyPrefixSuperfluidStakeByValidator defines prefix to store the running total of osmo superfluid staked per validator.
+	KeyPrefixSuperfluidStakeByValidator = []byte{0x0C}
 )
+
+// KeyIntermediaryAccountSlashPrefix returns the prefix of the slashes applied to the locks of the given intermediary account.
+func KeyIntermediaryAccountSlashPrefix(intermediaryAccount sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixIntermediaryAccountSlash...), address.MustLengthPrefix(intermediaryAccount)...)
+}
+
+// KeyIntermediaryAccountSlash returns the key of the slash with the given id applied to the locks of the given intermediary account.
+func KeyIntermediaryAccountSlash(intermediaryAccount sdk.AccAddress, id uint64) []byte {
+	return append(KeyIntermediaryAccountSlashPrefix(intermediaryAccount), sdk.Uint64ToBigEndian(id)...)
+}
+
+// KeyIntermediaryAccountStake returns the key of the osmo equivalent amount delegated by the given intermediary account.
+func KeyIntermediaryAccountStake(intermediaryAccount sdk.AccAddress) []byte {
+	return append(append([]byte{}, KeyPrefixIntermediaryAccountStake...), address.MustLengthPrefix(intermediaryAccount)...)
+}
+
+// KeySuperfluidStakeByAsset returns the key of the total osmo superfluid staked through the given denom.
+func KeySuperfluidStakeByAsset(denom string) []byte {
+	return append(append([]byte{}, KeyPrefixSuperfluidStakeByAsset...), []byte(denom)...)
+}
+
+// KeySuperfluidStakeByValidator returns the key of the total osmo superfluid staked to the given validator.
+func KeySuperfluidStakeByValidator(valAddr string) []byte {
+	return append(append([]byte{}, KeyPrefixSuperfluidStakeByValidator...), []byte(valAddr)...)
+}
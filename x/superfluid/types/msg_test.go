@@ -69,6 +69,14 @@ func TestAuthzMsg(t *testing.T) {
 				PoolId: 1,
 			},
 		},
+		{
+			name: "MsgSetSuperfluidAutoCompound",
+			msg: &types.MsgSetSuperfluidAutoCompound{
+				Sender:  addr1,
+				LockId:  1,
+				Enabled: true,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
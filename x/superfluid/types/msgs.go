@@ -24,6 +24,7 @@ const (
 	TypeMsgCreateFullRangePositionAndSuperfluidDelegate = "create_full_range_position_and_delegate"
 	TypeMsgAddToConcentratedLiquiditySuperfluidPosition = "add_to_concentrated_liquidity_superfluid_position"
 	TypeMsgUnbondConvertAndStake                        = "unbond_convert_and_stake"
+	TypeMsgSetSuperfluidAutoCompound                    = "set_superfluid_auto_compound"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -436,3 +437,42 @@ func (msg MsgUnbondConvertAndStake) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetSuperfluidAutoCompound{}
+
+// NewMsgSetSuperfluidAutoCompound creates a message to opt a superfluid delegated lock in or out of auto-compounding.
+func NewMsgSetSuperfluidAutoCompound(sender sdk.AccAddress, lockId uint64, enabled bool) *MsgSetSuperfluidAutoCompound {
+	return &MsgSetSuperfluidAutoCompound{
+		Sender:  sender.String(),
+		LockId:  lockId,
+		Enabled: enabled,
+	}
+}
+
+func (msg MsgSetSuperfluidAutoCompound) Route() string { return RouterKey }
+func (msg MsgSetSuperfluidAutoCompound) Type() string {
+	return TypeMsgSetSuperfluidAutoCompound
+}
+
+func (msg MsgSetSuperfluidAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+	if msg.LockId == 0 {
+		return fmt.Errorf("lock id should be positive: %d < 0", msg.LockId)
+	}
+	return nil
+}
+
+func (msg MsgSetSuperfluidAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetSuperfluidAutoCompound) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -1859,1161 +1859,1586 @@ func (m *QueryRestSupplyResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
-	proto.RegisterType((*AssetTypeRequest)(nil), "osmosis.superfluid.AssetTypeRequest")
-	proto.RegisterType((*AssetTypeResponse)(nil), "osmosis.superfluid.AssetTypeResponse")
-	proto.RegisterType((*AllAssetsRequest)(nil), "osmosis.superfluid.AllAssetsRequest")
-	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
-	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
-	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
-	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
-	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
-	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
-	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
-	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
-	proto.RegisterType((*QueryTotalDelegationByValidatorForDenomRequest)(nil), "osmosis.superfluid.QueryTotalDelegationByValidatorForDenomRequest")
-	proto.RegisterType((*QueryTotalDelegationByValidatorForDenomResponse)(nil), "osmosis.superfluid.QueryTotalDelegationByValidatorForDenomResponse")
-	proto.RegisterType((*Delegations)(nil), "osmosis.superfluid.Delegations")
-	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
-	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
-	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
-	proto.RegisterType((*SuperfluidDelegationAmountResponse)(nil), "osmosis.superfluid.SuperfluidDelegationAmountResponse")
-	proto.RegisterType((*SuperfluidDelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorRequest")
-	proto.RegisterType((*SuperfluidDelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorResponse")
-	proto.RegisterType((*SuperfluidUndelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorRequest")
-	proto.RegisterType((*SuperfluidUndelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorResponse")
-	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomRequest")
-	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomResponse")
-	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomRequest")
-	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse")
-	proto.RegisterType((*QueryTotalDelegationByDelegatorRequest)(nil), "osmosis.superfluid.QueryTotalDelegationByDelegatorRequest")
-	proto.RegisterType((*QueryTotalDelegationByDelegatorResponse)(nil), "osmosis.superfluid.QueryTotalDelegationByDelegatorResponse")
-	proto.RegisterType((*QueryUnpoolWhitelistRequest)(nil), "osmosis.superfluid.QueryUnpoolWhitelistRequest")
-	proto.RegisterType((*QueryUnpoolWhitelistResponse)(nil), "osmosis.superfluid.QueryUnpoolWhitelistResponse")
-	proto.RegisterType((*UserConcentratedSuperfluidPositionsDelegatedRequest)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsDelegatedRequest")
-	proto.RegisterType((*UserConcentratedSuperfluidPositionsDelegatedResponse)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsDelegatedResponse")
-	proto.RegisterType((*UserConcentratedSuperfluidPositionsUndelegatingRequest)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsUndelegatingRequest")
-	proto.RegisterType((*UserConcentratedSuperfluidPositionsUndelegatingResponse)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsUndelegatingResponse")
-	proto.RegisterType((*QueryRestSupplyRequest)(nil), "osmosis.superfluid.QueryRestSupplyRequest")
-	proto.RegisterType((*QueryRestSupplyResponse)(nil), "osmosis.superfluid.QueryRestSupplyResponse")
+type IntermediaryAccountSlashesRequest struct {
+	IntermediaryAccount string             `protobuf:"bytes,1,opt,name=intermediary_account,json=intermediaryAccount,proto3" json:"intermediary_account,omitempty"`
+	Pagination          *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
-
-var fileDescriptor_e3d9448e4ed3943f = []byte{
-	// 2099 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
-	0xf5, 0x17, 0x25, 0x45, 0xb2, 0x9f, 0x01, 0x5b, 0x1e, 0x3b, 0x96, 0x44, 0x5b, 0x2b, 0x87, 0x72,
-	0x2c, 0xfd, 0x15, 0x9b, 0x8c, 0xe5, 0x58, 0x52, 0x9c, 0xbf, 0x8d, 0x48, 0x96, 0xe5, 0xa8, 0xb5,
-	0x63, 0x65, 0x65, 0xc9, 0xe8, 0x17, 0x58, 0x6a, 0x39, 0x5a, 0x11, 0xe2, 0x92, 0x2b, 0x0e, 0xa9,
-	0x64, 0x11, 0xb8, 0x05, 0x52, 0x14, 0x68, 0xd0, 0x43, 0x5b, 0xe4, 0x50, 0xe4, 0xd6, 0x4b, 0x0f,
-	0xcd, 0xa1, 0xbd, 0xb5, 0x28, 0xd0, 0x4b, 0xd1, 0x4b, 0x80, 0xa2, 0x40, 0x80, 0x02, 0x45, 0xd1,
-	0x83, 0x13, 0xd8, 0x3d, 0xb6, 0x97, 0x1e, 0xdb, 0x4b, 0xc1, 0x99, 0xe1, 0xc7, 0xee, 0x0e, 0xc9,
-	0xdd, 0xb5, 0x6b, 0xe7, 0xe4, 0x25, 0xe7, 0x7d, 0xfd, 0xde, 0xd7, 0xf0, 0x3d, 0x19, 0x4a, 0x2e,
-	0xa9, 0xb9, 0xc4, 0x22, 0x1a, 0x09, 0xea, 0xd8, 0xdb, 0xb1, 0x03, 0xcb, 0xd4, 0xf6, 0x03, 0xec,
-	0x35, 0xd4, 0xba, 0xe7, 0xfa, 0x2e, 0x42, 0xfc, 0x5c, 0x4d, 0xce, 0xe5, 0x93, 0x55, 0xb7, 0xea,
-	0xd2, 0x63, 0x2d, 0xfc, 0xc5, 0x28, 0xe5, 0x52, 0x85, 0x92, 0x6a, 0xdb, 0x06, 0xc1, 0xda, 0xc1,
-	0xa5, 0x6d, 0xec, 0x1b, 0x97, 0xb4, 0x8a, 0x6b, 0x39, 0xfc, 0xfc, 0x4c, 0xd5, 0x75, 0xab, 0x36,
-	0xd6, 0x8c, 0xba, 0xa5, 0x19, 0x8e, 0xe3, 0xfa, 0x86, 0x6f, 0xb9, 0x0e, 0xe1, 0xa7, 0x93, 0xfc,
-	0x94, 0x3e, 0x6d, 0x07, 0x3b, 0x9a, 0x6f, 0xd5, 0x30, 0xf1, 0x8d, 0x5a, 0x3d, 0x12, 0xdf, 0x4a,
-	0x60, 0x06, 0x1e, 0x95, 0xc0, 0xcf, 0xa7, 0x04, 0x40, 0x92, 0x9f, 0x91, 0x16, 0x01, 0x51, 0xdd,
-	0xf0, 0x8c, 0x5a, 0x64, 0xc6, 0x78, 0x44, 0x60, 0xbb, 0x95, 0xbd, 0xa0, 0x4e, 0xff, 0xe1, 0x47,
-	0xb3, 0x69, 0x7c, 0xd4, 0x45, 0x31, 0xca, 0xba, 0x51, 0xb5, 0x9c, 0xb4, 0x31, 0xe7, 0x38, 0x2d,
-	0xf1, 0x8d, 0x3d, 0xcb, 0xa9, 0xc6, 0x84, 0xfc, 0x99, 0x51, 0x29, 0x27, 0x01, 0xbd, 0x13, 0xca,
-	0x59, 0xa7, 0x16, 0x94, 0xf1, 0x7e, 0x80, 0x89, 0xaf, 0xdc, 0x85, 0x13, 0x4d, 0x6f, 0x49, 0xdd,
-	0x75, 0x08, 0x46, 0x8b, 0x30, 0xc4, 0x2c, 0x1d, 0x93, 0xce, 0x4a, 0x33, 0x47, 0xe6, 0x64, 0xb5,
-	0x3d, 0x32, 0x2a, 0xe3, 0x59, 0x1e, 0xfc, 0xf4, 0xe1, 0x64, 0x5f, 0x99, 0xd3, 0x2b, 0x33, 0x30,
-	0xb2, 0x44, 0x08, 0xf6, 0xef, 0x35, 0xea, 0x98, 0x2b, 0x41, 0x27, 0xe1, 0x05, 0x13, 0x3b, 0x6e,
-	0x8d, 0x0a, 0x3b, 0x5c, 0x66, 0x0f, 0xca, 0x37, 0xe0, 0x78, 0x8a, 0x92, 0x2b, 0x5e, 0x05, 0x30,
-	0xc2, 0x97, 0xba, 0xdf, 0xa8, 0x63, 0x4a, 0x7f, 0x74, 0x6e, 0x5a, 0xa4, 0x7c, 0x23, 0xfe, 0x99,
-	0x08, 0x39, 0x6c, 0x44, 0x3f, 0x15, 0x04, 0x23, 0x4b, 0xb6, 0x4d, 0x8f, 0x62, 0xac, 0x5b, 0x70,
-	0x3c, 0xf5, 0x8e, 0x2b, 0x5c, 0x82, 0x21, 0xca, 0x15, 0x22, 0x1d, 0x98, 0x39, 0x32, 0x37, 0xd5,
-	0x81, 0xb2, 0x08, 0x32, 0x63, 0x54, 0x54, 0x38, 0x45, 0x5f, 0xdf, 0x09, 0x6c, 0xdf, 0xaa, 0xdb,
-	0x16, 0xf6, 0xf2, 0x81, 0xff, 0x50, 0x82, 0xd1, 0x36, 0x06, 0x6e, 0x4e, 0x1d, 0xe4, 0x50, 0xbf,
-	0x8e, 0xf7, 0x03, 0xeb, 0xc0, 0xb0, 0xb1, 0xe3, 0xeb, 0xb5, 0x98, 0x8a, 0x07, 0x63, 0x4e, 0x64,
-	0xe2, 0x5d, 0x52, 0x73, 0x6f, 0xc6, 0x4c, 0x69, 0xc9, 0x15, 0xd7, 0x33, 0xcb, 0x63, 0x6e, 0xc6,
-	0xb9, 0xf2, 0xa1, 0x04, 0x2f, 0x25, 0xf8, 0xd6, 0x1c, 0x1f, 0x7b, 0x35, 0x6c, 0x5a, 0x86, 0xd7,
-	0x58, 0xaa, 0x54, 0xdc, 0xc0, 0xf1, 0xd7, 0x9c, 0x1d, 0x57, 0x8c, 0x04, 0x8d, 0xc3, 0xa1, 0x03,
-	0xc3, 0xd6, 0x0d, 0xd3, 0xf4, 0xc6, 0xfa, 0xe9, 0xc1, 0xf0, 0x81, 0x61, 0x2f, 0x99, 0xa6, 0x17,
-	0x1e, 0x55, 0x8d, 0xa0, 0x8a, 0x75, 0xcb, 0x1c, 0x1b, 0x38, 0x2b, 0xcd, 0x0c, 0x96, 0x87, 0xe9,
-	0xf3, 0x9a, 0x89, 0xc6, 0x60, 0x38, 0xe4, 0xc0, 0x84, 0x8c, 0x0d, 0x32, 0x26, 0xfe, 0xa8, 0xec,
-	0x42, 0x69, 0xc9, 0xb6, 0x05, 0x36, 0x44, 0x31, 0x0c, 0xf3, 0x23, 0xc9, 0x7f, 0xee, 0x8f, 0xf3,
-	0x2a, 0x2b, 0x00, 0x35, 0x2c, 0x16, 0x95, 0xf5, 0x13, 0x5e, 0x03, 0xea, 0xba, 0x51, 0x8d, 0xd2,
-	0xb0, 0x9c, 0xe2, 0x54, 0xfe, 0x20, 0xc1, 0x64, 0xa6, 0x2a, 0x1e, 0x8b, 0xfb, 0x70, 0xc8, 0xe0,
-	0xef, 0x78, 0x72, 0x5c, 0xc9, 0x4f, 0x8e, 0x0c, 0xe7, 0xf1, 0x74, 0x89, 0x85, 0xa1, 0x5b, 0x4d,
-	0x20, 0xfa, 0x29, 0x88, 0xe9, 0x42, 0x10, 0xcc, 0xaa, 0x26, 0x14, 0xd7, 0x61, 0xea, 0x86, 0xeb,
-	0x38, 0xb8, 0xe2, 0x63, 0x91, 0xf2, 0xc8, 0x69, 0xa3, 0x30, 0x1c, 0xb6, 0x96, 0x30, 0x14, 0x12,
-	0x0d, 0xc5, 0x50, 0xf8, 0xb8, 0x66, 0x2a, 0xef, 0xc2, 0xb9, 0x7c, 0x7e, 0xee, 0x89, 0xbb, 0x30,
-	0xcc, 0x8d, 0xe7, 0x2e, 0xef, 0xcd, 0x11, 0xe5, 0x48, 0x8a, 0xb2, 0x0a, 0x2a, 0x6d, 0x3b, 0xf7,
-	0x5c, 0xdf, 0xb0, 0x57, 0xb0, 0x8d, 0xab, 0x14, 0xd0, 0x72, 0x63, 0xcb, 0xb0, 0x2d, 0xd3, 0xf0,
-	0x5d, 0x6f, 0xd5, 0xf5, 0x56, 0xc2, 0x1c, 0xcb, 0x2f, 0xa5, 0x3a, 0x68, 0x1d, 0xcb, 0xe1, 0x58,
-	0xae, 0xb5, 0x14, 0xfc, 0xa4, 0x08, 0x4a, 0x22, 0x8a, 0xb4, 0x14, 0xfb, 0x17, 0x12, 0x1c, 0x49,
-	0x9d, 0x36, 0x95, 0x80, 0xd4, 0x5c, 0x02, 0xf7, 0xe0, 0x88, 0x51, 0x0b, 0xe1, 0xea, 0x64, 0x87,
-	0x98, 0xac, 0x40, 0x96, 0x2f, 0x87, 0xd2, 0xfe, 0xf6, 0x70, 0xf2, 0x45, 0x16, 0x6e, 0x62, 0xee,
-	0xa9, 0x96, 0xab, 0xd5, 0x0c, 0x7f, 0x57, 0x5d, 0x73, 0xfc, 0x7f, 0x3d, 0x9c, 0x44, 0x0d, 0xa3,
-	0x66, 0x5f, 0x55, 0x52, 0x9c, 0x4a, 0x19, 0xd8, 0xd3, 0xc6, 0x0e, 0x31, 0xd1, 0xb7, 0xe1, 0x58,
-	0x4b, 0x87, 0xa0, 0xf5, 0x75, 0x78, 0x79, 0xa1, 0x48, 0xf2, 0x29, 0x26, 0xb9, 0x85, 0x5b, 0x29,
-	0x1f, 0x6d, 0xee, 0x0d, 0xca, 0x14, 0xbc, 0x44, 0xfd, 0x99, 0xc4, 0x33, 0x05, 0x38, 0x6a, 0xa6,
-	0x3f, 0x95, 0x40, 0xc9, 0xa3, 0xe2, 0xde, 0xde, 0x87, 0xe3, 0x7e, 0x48, 0xa5, 0x9b, 0xc9, 0x21,
-	0xf3, 0xd3, 0xf2, 0x4a, 0x91, 0xbd, 0x53, 0xcc, 0x5e, 0xc6, 0x9f, 0x04, 0x27, 0x2d, 0x4a, 0x29,
-	0x8f, 0xf8, 0xcd, 0xa1, 0x27, 0xca, 0x47, 0x4d, 0x0d, 0x2d, 0x39, 0x59, 0xaa, 0xa5, 0x6b, 0xe2,
-	0x15, 0x38, 0xce, 0xe5, 0xb8, 0x9e, 0x1e, 0xb5, 0x23, 0x16, 0xc0, 0x91, 0xf8, 0x60, 0x89, 0xbd,
-	0x0f, 0x89, 0x0f, 0xa2, 0x84, 0x8a, 0x89, 0x59, 0xc3, 0x1b, 0x89, 0x0f, 0x22, 0xe2, 0x38, 0x53,
-	0x07, 0xd2, 0x99, 0xfa, 0xa1, 0x04, 0x4a, 0x9e, 0x55, 0xdc, 0x5f, 0x15, 0x18, 0x62, 0xb1, 0xe6,
-	0xd9, 0x39, 0xde, 0xd4, 0x16, 0xa2, 0x86, 0x70, 0xc3, 0xb5, 0x9c, 0xe5, 0x57, 0x43, 0xff, 0x7d,
-	0xf2, 0xf9, 0xe4, 0x4c, 0xd5, 0xf2, 0x77, 0x83, 0x6d, 0xb5, 0xe2, 0xd6, 0x34, 0xfe, 0x25, 0xc0,
-	0xfe, 0xb9, 0x48, 0xcc, 0x3d, 0x2d, 0xbc, 0x47, 0x09, 0x65, 0x20, 0x65, 0x2e, 0x5a, 0xd9, 0x82,
-	0x69, 0x61, 0xd4, 0x96, 0x1b, 0x2b, 0x11, 0xf2, 0x5e, 0xdc, 0xa4, 0xfc, 0x66, 0x00, 0x66, 0x8a,
-	0x05, 0x73, 0xa4, 0xef, 0xc1, 0x84, 0x30, 0xa6, 0xba, 0x47, 0x6f, 0xac, 0xa8, 0x3c, 0xd5, 0xfc,
-	0x4e, 0x93, 0x28, 0x61, 0x17, 0x1d, 0xaf, 0xd6, 0xd3, 0x24, 0x93, 0x82, 0xa0, 0xef, 0xc2, 0x8b,
-	0x4d, 0x39, 0x89, 0x4d, 0x3d, 0xfc, 0x72, 0x0c, 0x23, 0xfa, 0xd4, 0x5d, 0x7e, 0x22, 0x9d, 0x9e,
-	0xd8, 0xa4, 0x2f, 0xd1, 0x8f, 0x24, 0x28, 0x31, 0x0b, 0x52, 0xd7, 0x7c, 0xf8, 0xb5, 0x86, 0x4d,
-	0x9d, 0x47, 0x7f, 0x80, 0xb6, 0xd9, 0x1c, 0x53, 0x34, 0x6e, 0xca, 0x74, 0x87, 0xa6, 0x94, 0x4f,
-	0x53, 0x8d, 0x49, 0x99, 0x6f, 0x50, 0x7d, 0x2c, 0xfd, 0x14, 0x07, 0xfe, 0x2f, 0xf1, 0xe9, 0xa6,
-	0x63, 0x3e, 0xb5, 0x9c, 0x48, 0xaa, 0xa1, 0x3f, 0x5d, 0x0d, 0xff, 0xee, 0x87, 0xd9, 0x4e, 0x14,
-	0x3e, 0xf7, 0x5c, 0xf9, 0x9e, 0x04, 0xa3, 0x2c, 0x54, 0x81, 0xf3, 0x0c, 0xd2, 0x85, 0x25, 0xe6,
-	0x66, 0xa2, 0x8a, 0x25, 0xcc, 0x6d, 0x38, 0x46, 0x1a, 0x8e, 0xbf, 0x8b, 0x7d, 0xab, 0xa2, 0x87,
-	0x77, 0x37, 0x19, 0x1b, 0xa0, 0xca, 0x27, 0x62, 0xc4, 0x6c, 0x84, 0x50, 0x37, 0x22, 0xb2, 0xdb,
-	0x6e, 0x65, 0x8f, 0x03, 0x3c, 0x4a, 0xd2, 0x2f, 0x89, 0xb2, 0x0f, 0x17, 0x32, 0xaa, 0x34, 0xbe,
-	0x35, 0x9b, 0xae, 0x5e, 0x61, 0xf7, 0x93, 0x8a, 0xba, 0x5f, 0x53, 0xbc, 0x7f, 0x21, 0xc1, 0xc5,
-	0x0e, 0x75, 0x3e, 0xef, 0x90, 0x2b, 0x0f, 0x60, 0xf1, 0x26, 0xf1, 0xad, 0x9a, 0xe1, 0xe3, 0x36,
-	0x41, 0x51, 0xc1, 0xfc, 0x0f, 0x5d, 0xf5, 0x3b, 0x09, 0x5e, 0xef, 0x41, 0x3f, 0x77, 0x5b, 0x66,
-	0x6f, 0x93, 0x9e, 0x4d, 0x6f, 0x53, 0x36, 0xe1, 0xbc, 0xf8, 0x8b, 0xec, 0xc9, 0xae, 0x96, 0x8f,
-	0x07, 0x61, 0xba, 0x50, 0xee, 0x73, 0xef, 0x16, 0x06, 0x9c, 0x68, 0x52, 0xc7, 0x0c, 0xe2, 0x8d,
-	0x62, 0x36, 0xf2, 0x7d, 0x34, 0x97, 0x47, 0xee, 0x4f, 0xcb, 0x61, 0x1c, 0x5c, 0x17, 0x32, 0xdb,
-	0x4e, 0xb2, 0x03, 0x3c, 0xf0, 0xe5, 0xb9, 0xbc, 0x06, 0x9f, 0xed, 0xe5, 0x35, 0x01, 0xa7, 0x69,
-	0x6a, 0x6c, 0x3a, 0x75, 0xd7, 0xb5, 0xef, 0xef, 0x5a, 0x3e, 0xb6, 0x2d, 0x12, 0x7d, 0xe9, 0x29,
-	0xaf, 0xc3, 0x19, 0xf1, 0x31, 0xf7, 0xe8, 0x38, 0x1c, 0x0a, 0x0f, 0x74, 0x8b, 0x67, 0xc6, 0x60,
-	0x79, 0x38, 0x7c, 0x5e, 0x33, 0x89, 0xb2, 0x0d, 0x97, 0x37, 0x09, 0xf6, 0x6e, 0xb8, 0x4e, 0x05,
-	0x3b, 0xbe, 0x17, 0x3a, 0x21, 0x49, 0x90, 0x75, 0x97, 0x58, 0xb4, 0x87, 0xc5, 0x0e, 0xea, 0x29,
-	0xb3, 0x7f, 0x2d, 0xc1, 0x6b, 0xdd, 0x29, 0xe1, 0x76, 0x7f, 0x07, 0x26, 0x2a, 0xb6, 0x4e, 0x4d,
-	0x0f, 0x08, 0xf6, 0xf4, 0x3a, 0x27, 0x6d, 0x49, 0xf3, 0x79, 0x51, 0x9a, 0xa7, 0x95, 0xad, 0xbb,
-	0xae, 0x1d, 0x1a, 0x10, 0xa9, 0x6a, 0x4a, 0xf7, 0xf1, 0x8a, 0x2d, 0x3e, 0x27, 0x0a, 0x86, 0xf9,
-	0x0e, 0xec, 0x4e, 0xee, 0x76, 0xa7, 0xda, 0x93, 0x7f, 0x7e, 0x2b, 0xc1, 0x42, 0xd7, 0x7a, 0xbe,
-	0x24, 0x2e, 0x52, 0xe1, 0x14, 0x4d, 0xbd, 0x32, 0x26, 0xfe, 0x46, 0x50, 0xaf, 0xdb, 0x8d, 0xfc,
-	0x71, 0xb6, 0x0c, 0xa3, 0x6d, 0xf4, 0x1c, 0xca, 0x42, 0x6a, 0x30, 0x28, 0xa8, 0xae, 0x68, 0x60,
-	0xa5, 0xe4, 0x73, 0x7f, 0x99, 0x80, 0x17, 0xa8, 0x50, 0xf4, 0x7d, 0x09, 0x86, 0xd8, 0xce, 0x0e,
-	0x9d, 0x17, 0x21, 0x6e, 0x5f, 0x0f, 0xca, 0xd3, 0x85, 0x74, 0xcc, 0x3c, 0x65, 0xf6, 0x83, 0x3f,
-	0xff, 0xfd, 0xa3, 0xfe, 0x73, 0x48, 0xd1, 0x04, 0x4b, 0xcf, 0x64, 0x73, 0x49, 0x95, 0xff, 0x40,
-	0x82, 0xc3, 0xf1, 0xd2, 0x0e, 0x9d, 0x13, 0xa9, 0x68, 0x5d, 0x21, 0xca, 0x2f, 0x17, 0x50, 0x71,
-	0x33, 0x54, 0x6a, 0xc6, 0x0c, 0x3a, 0x9f, 0x67, 0x46, 0xb2, 0x60, 0x64, 0xa6, 0x44, 0x3b, 0xc1,
-	0x0c, 0x53, 0x5a, 0xd6, 0x88, 0x19, 0xa6, 0xb4, 0x2e, 0x16, 0x3b, 0x34, 0xc5, 0xb6, 0x75, 0xb6,
-	0x58, 0x40, 0x3f, 0x93, 0xe0, 0x58, 0xcb, 0x56, 0x10, 0xcd, 0x66, 0xa2, 0x6e, 0xdb, 0x35, 0xca,
-	0xaf, 0x74, 0x44, 0xcb, 0x8d, 0x7b, 0x8d, 0x1a, 0xa7, 0xa2, 0x0b, 0xc5, 0x7e, 0x4a, 0xd6, 0x8f,
-	0xe8, 0xf7, 0x12, 0x8c, 0x66, 0x2c, 0xcd, 0xd0, 0x5c, 0x86, 0x57, 0x72, 0x96, 0x79, 0xf2, 0xe5,
-	0xae, 0x78, 0xb8, 0xe9, 0xd7, 0xa8, 0xe9, 0x0b, 0xe8, 0x4a, 0x91, 0x5f, 0xad, 0x94, 0x14, 0x3d,
-	0xde, 0xbd, 0x7d, 0x2e, 0xc1, 0x99, 0xbc, 0x9d, 0x17, 0x5a, 0xc8, 0x68, 0x06, 0x45, 0x5b, 0x36,
-	0x79, 0xb1, 0x7b, 0x46, 0x0e, 0xe9, 0x36, 0x85, 0xb4, 0x8a, 0x56, 0xf2, 0x20, 0x55, 0x22, 0x49,
-	0x42, 0x60, 0xda, 0xfb, 0x7c, 0xc3, 0xf7, 0x00, 0xfd, 0x2a, 0xda, 0xcc, 0xe4, 0xee, 0xc3, 0xd0,
-	0x72, 0x66, 0x69, 0x77, 0xbc, 0x94, 0x93, 0x6f, 0x3c, 0x91, 0x0c, 0x8e, 0xbe, 0x0f, 0xfd, 0x51,
-	0x02, 0x39, 0x7b, 0x97, 0x84, 0x84, 0xcb, 0xc6, 0xc2, 0x0d, 0x95, 0x3c, 0xdf, 0x2d, 0x1b, 0xb7,
-	0xe7, 0x3a, 0x8d, 0xc6, 0x22, 0x9a, 0x2f, 0x4a, 0x30, 0xf1, 0x4a, 0x0a, 0xfd, 0x49, 0x02, 0x39,
-	0x7b, 0xd3, 0x83, 0xae, 0x74, 0xfa, 0xd9, 0xd9, 0xb4, 0xaf, 0x12, 0xa3, 0x29, 0x5e, 0x28, 0x29,
-	0x6f, 0x52, 0x34, 0x57, 0xd1, 0x62, 0x1e, 0x1a, 0xf1, 0xe7, 0x32, 0xbb, 0x40, 0xd0, 0x3f, 0x25,
-	0x38, 0x5b, 0xb4, 0xd5, 0x41, 0x6f, 0x74, 0x6a, 0x9e, 0x60, 0xa1, 0x20, 0xff, 0x7f, 0x6f, 0xcc,
-	0x1c, 0xe1, 0xdb, 0x14, 0xe1, 0x5b, 0x68, 0xb5, 0x6b, 0x84, 0x44, 0x7b, 0xbf, 0xed, 0x3b, 0xe4,
-	0x01, 0xfa, 0xa0, 0x3f, 0xbd, 0xa9, 0xcb, 0xda, 0x4d, 0xa0, 0x6b, 0xf9, 0x46, 0x17, 0x2c, 0x51,
-	0xe4, 0xeb, 0xbd, 0xb2, 0x73, 0xd4, 0xdf, 0xa2, 0xa8, 0xef, 0xa3, 0xcd, 0x0e, 0x51, 0x07, 0x69,
-	0x81, 0xfa, 0x76, 0x43, 0x8f, 0x91, 0x0b, 0x9d, 0xf0, 0x1f, 0x09, 0x5e, 0xee, 0x68, 0x60, 0x47,
-	0x6f, 0x76, 0x11, 0x3c, 0xe1, 0xd0, 0x2c, 0x2f, 0x3d, 0x81, 0x04, 0xee, 0x8d, 0x3b, 0xd4, 0x1b,
-	0xb7, 0xd0, 0xcd, 0xee, 0x73, 0x20, 0xf4, 0x45, 0x32, 0xb3, 0xb3, 0xbf, 0x6b, 0xfd, 0xb2, 0x1f,
-	0x2e, 0x75, 0x3d, 0x83, 0xa3, 0xdb, 0x22, 0x1c, 0xbd, 0xae, 0x12, 0xe4, 0x3b, 0x4f, 0x49, 0x1a,
-	0xf7, 0xd0, 0x37, 0xa9, 0x87, 0xb6, 0xd0, 0xbd, 0x3c, 0x0f, 0x61, 0x2e, 0x5e, 0xcf, 0x6b, 0x08,
-	0x22, 0x87, 0xfd, 0x23, 0xea, 0xe0, 0xc2, 0xc9, 0x1c, 0x5d, 0xed, 0xfc, 0x9e, 0x68, 0x2b, 0x94,
-	0x37, 0x7a, 0xe2, 0xe5, 0xa8, 0x37, 0x29, 0xea, 0xbb, 0xe8, 0x4e, 0x1e, 0xea, 0xd6, 0x3f, 0x50,
-	0x14, 0x57, 0xc7, 0x27, 0x12, 0x1c, 0x6b, 0x19, 0x27, 0x91, 0x96, 0x69, 0xa7, 0x78, 0x2e, 0x95,
-	0x5f, 0xed, 0x9c, 0xa1, 0x9b, 0xaf, 0xb6, 0x80, 0x32, 0xeb, 0xef, 0xc6, 0x86, 0x7d, 0xdc, 0x0f,
-	0x17, 0xba, 0x19, 0x30, 0xd1, 0x2d, 0x91, 0x61, 0x3d, 0xcc, 0xc1, 0xf2, 0x5b, 0x4f, 0x2e, 0x88,
-	0x23, 0xdf, 0xa2, 0xc8, 0xd7, 0xd1, 0xdb, 0xb9, 0x77, 0x32, 0xfb, 0x14, 0x4a, 0x6f, 0x46, 0xec,
-	0x78, 0xe4, 0x13, 0xf7, 0xfa, 0x9f, 0xf7, 0x83, 0xd6, 0xe5, 0x70, 0x89, 0xbe, 0xd2, 0x23, 0x2a,
-	0xc1, 0x24, 0x2c, 0x7f, 0xf5, 0xa9, 0xc8, 0xe2, 0x4e, 0xfa, 0x1a, 0x75, 0xd2, 0x06, 0x7a, 0xa7,
-	0x13, 0x27, 0x05, 0x29, 0x09, 0xc5, 0x7e, 0xfa, 0x89, 0x04, 0x90, 0x0c, 0xa5, 0xe2, 0xb9, 0x44,
-	0x3c, 0xe9, 0x8a, 0xe7, 0x92, 0x8c, 0x29, 0xb7, 0xb3, 0x31, 0x92, 0x50, 0x9e, 0xe5, 0xf5, 0x4f,
-	0x1f, 0x95, 0xa4, 0xcf, 0x1e, 0x95, 0xa4, 0x2f, 0x1e, 0x95, 0xa4, 0x1f, 0x3f, 0x2e, 0xf5, 0x7d,
-	0xf6, 0xb8, 0xd4, 0xf7, 0xd7, 0xc7, 0xa5, 0xbe, 0xaf, 0xcf, 0xa7, 0xd6, 0x4a, 0x5c, 0xce, 0x45,
-	0xdb, 0xd8, 0x26, 0xb1, 0xd0, 0x83, 0xb9, 0x4b, 0xda, 0x7b, 0x69, 0xd1, 0x74, 0xd5, 0xb4, 0x3d,
-	0x44, 0xff, 0xa7, 0xcc, 0xe5, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xaa, 0xdb, 0x79, 0xa6, 0xa7,
-	0x24, 0x00, 0x00,
+func (m *IntermediaryAccountSlashesRequest) Reset()         { *m = IntermediaryAccountSlashesRequest{} }
+func (m *IntermediaryAccountSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*IntermediaryAccountSlashesRequest) ProtoMessage()    {}
+func (*IntermediaryAccountSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{38}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of superfluid parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Returns superfluid asset type, whether if it's a native asset or an lp
-	// share.
-	AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error)
-	// Returns all registered superfluid assets.
-	AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error)
-	// Returns the osmo equivalent multiplier used in the most recent epoch.
-	AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error)
-	// Returns all superfluid intermediary accounts.
-	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
-	// Returns intermediary account connected to a superfluid staked lock by id
-	ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error)
-	// Returns the amount of delegations of specific denom for all validators
-	TotalDelegationByValidatorForDenom(ctx context.Context, in *QueryTotalDelegationByValidatorForDenomRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByValidatorForDenomResponse, error)
-	// Returns the total amount of osmo superfluidly staked.
-	// Response is denominated in uosmo.
-	TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error)
-	// Returns the coins superfluid delegated for the delegator, validator, denom
-	// triplet
-	SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error)
-	// Returns all the delegated superfluid poistions for a specific delegator.
-	SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error)
-	// Returns all the undelegating superfluid poistions for a specific delegator.
-	SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error)
-	// Returns all the superfluid positions of a specific denom delegated to one
-	// validator
-	SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error)
-	// Returns the amount of a specific denom delegated to a specific validator
-	// This is labeled an estimate, because the way it calculates the amount can
-	// lead rounding errors from the true delegated amount
-	EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
-	// Returns the specified delegations for a specific delegator
-	TotalDelegationByDelegator(ctx context.Context, in *QueryTotalDelegationByDelegatorRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByDelegatorResponse, error)
-	// Returns a list of whitelisted pool ids to unpool.
-	UnpoolWhitelist(ctx context.Context, in *QueryUnpoolWhitelistRequest, opts ...grpc.CallOption) (*QueryUnpoolWhitelistResponse, error)
-	// Returns all of a user's full range CL positions that are superfluid staked.
-	UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, in *UserConcentratedSuperfluidPositionsDelegatedRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error)
-	UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, in *UserConcentratedSuperfluidPositionsUndelegatingRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error)
-	RestSupply(ctx context.Context, in *QueryRestSupplyRequest, opts ...grpc.CallOption) (*QueryRestSupplyResponse, error)
+func (m *IntermediaryAccountSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *IntermediaryAccountSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntermediaryAccountSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *IntermediaryAccountSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediaryAccountSlashesRequest.Merge(m, src)
+}
+func (m *IntermediaryAccountSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntermediaryAccountSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediaryAccountSlashesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IntermediaryAccountSlashesRequest proto.InternalMessageInfo
+
+func (m *IntermediaryAccountSlashesRequest) GetIntermediaryAccount() string {
+	if m != nil {
+		return m.IntermediaryAccount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error) {
-	out := new(AssetTypeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetType", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IntermediaryAccountSlashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error) {
-	out := new(AllAssetsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllAssets", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type IntermediaryAccountSlashesResponse struct {
+	Slashes    []IntermediaryAccountSlash `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	Pagination *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error) {
-	out := new(AssetMultiplierResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetMultiplier", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IntermediaryAccountSlashesResponse) Reset()         { *m = IntermediaryAccountSlashesResponse{} }
+func (m *IntermediaryAccountSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*IntermediaryAccountSlashesResponse) ProtoMessage()    {}
+func (*IntermediaryAccountSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{39}
+}
+func (m *IntermediaryAccountSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntermediaryAccountSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntermediaryAccountSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *IntermediaryAccountSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediaryAccountSlashesResponse.Merge(m, src)
+}
+func (m *IntermediaryAccountSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntermediaryAccountSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediaryAccountSlashesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error) {
-	out := new(AllIntermediaryAccountsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllIntermediaryAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IntermediaryAccountSlashesResponse proto.InternalMessageInfo
+
+func (m *IntermediaryAccountSlashesResponse) GetSlashes() []IntermediaryAccountSlash {
+	if m != nil {
+		return m.Slashes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error) {
-	out := new(ConnectedIntermediaryAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/ConnectedIntermediaryAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IntermediaryAccountSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TotalDelegationByValidatorForDenom(ctx context.Context, in *QueryTotalDelegationByValidatorForDenomRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByValidatorForDenomResponse, error) {
-	out := new(QueryTotalDelegationByValidatorForDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalDelegationByValidatorForDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TotalSuperfluidStakeByAssetRequest struct {
 }
 
-func (c *queryClient) TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error) {
-	out := new(TotalSuperfluidDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TotalSuperfluidStakeByAssetRequest) Reset()         { *m = TotalSuperfluidStakeByAssetRequest{} }
+func (m *TotalSuperfluidStakeByAssetRequest) String() string { return proto.CompactTextString(m) }
+func (*TotalSuperfluidStakeByAssetRequest) ProtoMessage()    {}
+func (*TotalSuperfluidStakeByAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{40}
+}
+func (m *TotalSuperfluidStakeByAssetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalSuperfluidStakeByAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalSuperfluidStakeByAssetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TotalSuperfluidStakeByAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalSuperfluidStakeByAssetRequest.Merge(m, src)
+}
+func (m *TotalSuperfluidStakeByAssetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalSuperfluidStakeByAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalSuperfluidStakeByAssetRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error) {
-	out := new(SuperfluidDelegationAmountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_TotalSuperfluidStakeByAssetRequest proto.InternalMessageInfo
+
+type TotalSuperfluidStakeByAssetResponse struct {
+	Assets []SuperfluidStakeByAsset `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets"`
 }
 
-func (c *queryClient) SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error) {
-	out := new(SuperfluidDelegationsByDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TotalSuperfluidStakeByAssetResponse) Reset()         { *m = TotalSuperfluidStakeByAssetResponse{} }
+func (m *TotalSuperfluidStakeByAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*TotalSuperfluidStakeByAssetResponse) ProtoMessage()    {}
+func (*TotalSuperfluidStakeByAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{41}
+}
+func (m *TotalSuperfluidStakeByAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalSuperfluidStakeByAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalSuperfluidStakeByAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TotalSuperfluidStakeByAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalSuperfluidStakeByAssetResponse.Merge(m, src)
+}
+func (m *TotalSuperfluidStakeByAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalSuperfluidStakeByAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalSuperfluidStakeByAssetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error) {
-	out := new(SuperfluidUndelegationsByDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TotalSuperfluidStakeByAssetResponse proto.InternalMessageInfo
+
+func (m *TotalSuperfluidStakeByAssetResponse) GetAssets() []SuperfluidStakeByAsset {
+	if m != nil {
+		return m.Assets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
-	out := new(SuperfluidDelegationsByValidatorDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SuperfluidStakeByAsset struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// osmo_equivalent is the amount of osmo delegated through the intermediary
+	// accounts of the denom.
+	OsmoEquivalent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=osmo_equivalent,json=osmoEquivalent,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_equivalent" yaml:"osmo_equivalent"`
 }
 
-func (c *queryClient) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
-	out := new(EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SuperfluidStakeByAsset) Reset()         { *m = SuperfluidStakeByAsset{} }
+func (m *SuperfluidStakeByAsset) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidStakeByAsset) ProtoMessage()    {}
+func (*SuperfluidStakeByAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{42}
+}
+func (m *SuperfluidStakeByAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperfluidStakeByAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidStakeByAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *SuperfluidStakeByAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidStakeByAsset.Merge(m, src)
+}
+func (m *SuperfluidStakeByAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperfluidStakeByAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidStakeByAsset.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalDelegationByDelegator(ctx context.Context, in *QueryTotalDelegationByDelegatorRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByDelegatorResponse, error) {
-	out := new(QueryTotalDelegationByDelegatorResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalDelegationByDelegator", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SuperfluidStakeByAsset proto.InternalMessageInfo
+
+func (m *SuperfluidStakeByAsset) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) UnpoolWhitelist(ctx context.Context, in *QueryUnpoolWhitelistRequest, opts ...grpc.CallOption) (*QueryUnpoolWhitelistResponse, error) {
-	out := new(QueryUnpoolWhitelistResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UnpoolWhitelist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TotalSuperfluidStakeByValidatorRequest struct {
 }
 
-func (c *queryClient) UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, in *UserConcentratedSuperfluidPositionsDelegatedRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error) {
-	out := new(UserConcentratedSuperfluidPositionsDelegatedResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsDelegated", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TotalSuperfluidStakeByValidatorRequest) Reset() {
+	*m = TotalSuperfluidStakeByValidatorRequest{}
+}
+func (m *TotalSuperfluidStakeByValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*TotalSuperfluidStakeByValidatorRequest) ProtoMessage()    {}
+func (*TotalSuperfluidStakeByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{43}
+}
+func (m *TotalSuperfluidStakeByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalSuperfluidStakeByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalSuperfluidStakeByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TotalSuperfluidStakeByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalSuperfluidStakeByValidatorRequest.Merge(m, src)
+}
+func (m *TotalSuperfluidStakeByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalSuperfluidStakeByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalSuperfluidStakeByValidatorRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, in *UserConcentratedSuperfluidPositionsUndelegatingRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error) {
-	out := new(UserConcentratedSuperfluidPositionsUndelegatingResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsUndelegating", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_TotalSuperfluidStakeByValidatorRequest proto.InternalMessageInfo
+
+type TotalSuperfluidStakeByValidatorResponse struct {
+	Validators []SuperfluidStakeByValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
 }
 
-func (c *queryClient) RestSupply(ctx context.Context, in *QueryRestSupplyRequest, opts ...grpc.CallOption) (*QueryRestSupplyResponse, error) {
-	out := new(QueryRestSupplyResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/RestSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *TotalSuperfluidStakeByValidatorResponse) Reset() {
+	*m = TotalSuperfluidStakeByValidatorResponse{}
+}
+func (m *TotalSuperfluidStakeByValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*TotalSuperfluidStakeByValidatorResponse) ProtoMessage()    {}
+func (*TotalSuperfluidStakeByValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{44}
+}
+func (m *TotalSuperfluidStakeByValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalSuperfluidStakeByValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalSuperfluidStakeByValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *TotalSuperfluidStakeByValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalSuperfluidStakeByValidatorResponse.Merge(m, src)
+}
+func (m *TotalSuperfluidStakeByValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalSuperfluidStakeByValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalSuperfluidStakeByValidatorResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of superfluid parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Returns superfluid asset type, whether if it's a native asset or an lp
-	// share.
-	AssetType(context.Context, *AssetTypeRequest) (*AssetTypeResponse, error)
-	// Returns all registered superfluid assets.
-	AllAssets(context.Context, *AllAssetsRequest) (*AllAssetsResponse, error)
-	// Returns the osmo equivalent multiplier used in the most recent epoch.
-	AssetMultiplier(context.Context, *AssetMultiplierRequest) (*AssetMultiplierResponse, error)
-	// Returns all superfluid intermediary accounts.
-	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
-	// Returns intermediary account connected to a superfluid staked lock by id
-	ConnectedIntermediaryAccount(context.Context, *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error)
-	// Returns the amount of delegations of specific denom for all validators
-	TotalDelegationByValidatorForDenom(context.Context, *QueryTotalDelegationByValidatorForDenomRequest) (*QueryTotalDelegationByValidatorForDenomResponse, error)
-	// Returns the total amount of osmo superfluidly staked.
-	// Response is denominated in uosmo.
-	TotalSuperfluidDelegations(context.Context, *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error)
-	// Returns the coins superfluid delegated for the delegator, validator, denom
-	// triplet
-	SuperfluidDelegationAmount(context.Context, *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error)
-	// Returns all the delegated superfluid poistions for a specific delegator.
-	SuperfluidDelegationsByDelegator(context.Context, *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error)
-	// Returns all the undelegating superfluid poistions for a specific delegator.
-	SuperfluidUndelegationsByDelegator(context.Context, *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error)
-	// Returns all the superfluid positions of a specific denom delegated to one
-	// validator
-	SuperfluidDelegationsByValidatorDenom(context.Context, *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error)
-	// Returns the amount of a specific denom delegated to a specific validator
-	// This is labeled an estimate, because the way it calculates the amount can
-	// lead rounding errors from the true delegated amount
-	EstimateSuperfluidDelegatedAmountByValidatorDenom(context.Context, *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
-	// Returns the specified delegations for a specific delegator
-	TotalDelegationByDelegator(context.Context, *QueryTotalDelegationByDelegatorRequest) (*QueryTotalDelegationByDelegatorResponse, error)
-	// Returns a list of whitelisted pool ids to unpool.
-	UnpoolWhitelist(context.Context, *QueryUnpoolWhitelistRequest) (*QueryUnpoolWhitelistResponse, error)
-	// Returns all of a user's full range CL positions that are superfluid staked.
-	UserConcentratedSuperfluidPositionsDelegated(context.Context, *UserConcentratedSuperfluidPositionsDelegatedRequest) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error)
-	UserConcentratedSuperfluidPositionsUndelegating(context.Context, *UserConcentratedSuperfluidPositionsUndelegatingRequest) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error)
-	RestSupply(context.Context, *QueryRestSupplyRequest) (*QueryRestSupplyResponse, error)
+var xxx_messageInfo_TotalSuperfluidStakeByValidatorResponse proto.InternalMessageInfo
+
+func (m *TotalSuperfluidStakeByValidatorResponse) GetValidators() []SuperfluidStakeByValidator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type SuperfluidStakeByValidator struct {
+	ValAddr string `protobuf:"bytes,1,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	// osmo_equivalent is the amount of osmo delegated to the validator through
+	// intermediary accounts of all denoms.
+	OsmoEquivalent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=osmo_equivalent,json=osmoEquivalent,proto3,customtype=cosmossdk.io/math.Int" json:"osmo_equivalent" yaml:"osmo_equivalent"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SuperfluidStakeByValidator) Reset()         { *m = SuperfluidStakeByValidator{} }
+func (m *SuperfluidStakeByValidator) String() string { return proto.CompactTextString(m) }
+func (*SuperfluidStakeByValidator) ProtoMessage()    {}
+func (*SuperfluidStakeByValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{45}
 }
-func (*UnimplementedQueryServer) AssetType(ctx context.Context, req *AssetTypeRequest) (*AssetTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetType not implemented")
+func (m *SuperfluidStakeByValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllAssets(ctx context.Context, req *AllAssetsRequest) (*AllAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllAssets not implemented")
+func (m *SuperfluidStakeByValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperfluidStakeByValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AssetMultiplier(ctx context.Context, req *AssetMultiplierRequest) (*AssetMultiplierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplier not implemented")
+func (m *SuperfluidStakeByValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperfluidStakeByValidator.Merge(m, src)
 }
-func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, req *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllIntermediaryAccounts not implemented")
+func (m *SuperfluidStakeByValidator) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ConnectedIntermediaryAccount(ctx context.Context, req *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectedIntermediaryAccount not implemented")
+func (m *SuperfluidStakeByValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperfluidStakeByValidator.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) TotalDelegationByValidatorForDenom(ctx context.Context, req *QueryTotalDelegationByValidatorForDenomRequest) (*QueryTotalDelegationByValidatorForDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalDelegationByValidatorForDenom not implemented")
+
+var xxx_messageInfo_SuperfluidStakeByValidator proto.InternalMessageInfo
+
+func (m *SuperfluidStakeByValidator) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) TotalSuperfluidDelegations(ctx context.Context, req *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidDelegations not implemented")
+
+type AutoCompoundLockRequest struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
-func (*UnimplementedQueryServer) SuperfluidDelegationAmount(ctx context.Context, req *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationAmount not implemented")
+
+func (m *AutoCompoundLockRequest) Reset()         { *m = AutoCompoundLockRequest{} }
+func (m *AutoCompoundLockRequest) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundLockRequest) ProtoMessage()    {}
+func (*AutoCompoundLockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{46}
 }
-func (*UnimplementedQueryServer) SuperfluidDelegationsByDelegator(ctx context.Context, req *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByDelegator not implemented")
+func (m *AutoCompoundLockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) SuperfluidUndelegationsByDelegator(ctx context.Context, req *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegationsByDelegator not implemented")
+func (m *AutoCompoundLockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundLockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) SuperfluidDelegationsByValidatorDenom(ctx context.Context, req *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByValidatorDenom not implemented")
+func (m *AutoCompoundLockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundLockRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, req *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSuperfluidDelegatedAmountByValidatorDenom not implemented")
+func (m *AutoCompoundLockRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TotalDelegationByDelegator(ctx context.Context, req *QueryTotalDelegationByDelegatorRequest) (*QueryTotalDelegationByDelegatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalDelegationByDelegator not implemented")
+func (m *AutoCompoundLockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundLockRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) UnpoolWhitelist(ctx context.Context, req *QueryUnpoolWhitelistRequest) (*QueryUnpoolWhitelistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnpoolWhitelist not implemented")
+
+var xxx_messageInfo_AutoCompoundLockRequest proto.InternalMessageInfo
+
+func (m *AutoCompoundLockRequest) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, req *UserConcentratedSuperfluidPositionsDelegatedRequest) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserConcentratedSuperfluidPositionsDelegated not implemented")
+
+type AutoCompoundLockResponse struct {
+	// enabled is true if the lock is opted in to auto-compounding.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
-func (*UnimplementedQueryServer) UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, req *UserConcentratedSuperfluidPositionsUndelegatingRequest) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserConcentratedSuperfluidPositionsUndelegating not implemented")
+
+func (m *AutoCompoundLockResponse) Reset()         { *m = AutoCompoundLockResponse{} }
+func (m *AutoCompoundLockResponse) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundLockResponse) ProtoMessage()    {}
+func (*AutoCompoundLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3d9448e4ed3943f, []int{47}
 }
-func (*UnimplementedQueryServer) RestSupply(ctx context.Context, req *QueryRestSupplyRequest) (*QueryRestSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RestSupply not implemented")
+func (m *AutoCompoundLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *AutoCompoundLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundLockResponse.Merge(m, src)
+}
+func (m *AutoCompoundLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundLockResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_AutoCompoundLockResponse proto.InternalMessageInfo
+
+func (m *AutoCompoundLockResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_AssetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetTypeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetType(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AssetType",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetType(ctx, req.(*AssetTypeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.superfluid.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.superfluid.QueryParamsResponse")
+	proto.RegisterType((*AssetTypeRequest)(nil), "osmosis.superfluid.AssetTypeRequest")
+	proto.RegisterType((*AssetTypeResponse)(nil), "osmosis.superfluid.AssetTypeResponse")
+	proto.RegisterType((*AllAssetsRequest)(nil), "osmosis.superfluid.AllAssetsRequest")
+	proto.RegisterType((*AllAssetsResponse)(nil), "osmosis.superfluid.AllAssetsResponse")
+	proto.RegisterType((*AssetMultiplierRequest)(nil), "osmosis.superfluid.AssetMultiplierRequest")
+	proto.RegisterType((*AssetMultiplierResponse)(nil), "osmosis.superfluid.AssetMultiplierResponse")
+	proto.RegisterType((*SuperfluidIntermediaryAccountInfo)(nil), "osmosis.superfluid.SuperfluidIntermediaryAccountInfo")
+	proto.RegisterType((*AllIntermediaryAccountsRequest)(nil), "osmosis.superfluid.AllIntermediaryAccountsRequest")
+	proto.RegisterType((*AllIntermediaryAccountsResponse)(nil), "osmosis.superfluid.AllIntermediaryAccountsResponse")
+	proto.RegisterType((*ConnectedIntermediaryAccountRequest)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountRequest")
+	proto.RegisterType((*ConnectedIntermediaryAccountResponse)(nil), "osmosis.superfluid.ConnectedIntermediaryAccountResponse")
+	proto.RegisterType((*QueryTotalDelegationByValidatorForDenomRequest)(nil), "osmosis.superfluid.QueryTotalDelegationByValidatorForDenomRequest")
+	proto.RegisterType((*QueryTotalDelegationByValidatorForDenomResponse)(nil), "osmosis.superfluid.QueryTotalDelegationByValidatorForDenomResponse")
+	proto.RegisterType((*Delegations)(nil), "osmosis.superfluid.Delegations")
+	proto.RegisterType((*TotalSuperfluidDelegationsRequest)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsRequest")
+	proto.RegisterType((*TotalSuperfluidDelegationsResponse)(nil), "osmosis.superfluid.TotalSuperfluidDelegationsResponse")
+	proto.RegisterType((*SuperfluidDelegationAmountRequest)(nil), "osmosis.superfluid.SuperfluidDelegationAmountRequest")
+	proto.RegisterType((*SuperfluidDelegationAmountResponse)(nil), "osmosis.superfluid.SuperfluidDelegationAmountResponse")
+	proto.RegisterType((*SuperfluidDelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidDelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidUndelegationsByDelegatorRequest)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorRequest")
+	proto.RegisterType((*SuperfluidUndelegationsByDelegatorResponse)(nil), "osmosis.superfluid.SuperfluidUndelegationsByDelegatorResponse")
+	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomRequest)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomRequest")
+	proto.RegisterType((*SuperfluidDelegationsByValidatorDenomResponse)(nil), "osmosis.superfluid.SuperfluidDelegationsByValidatorDenomResponse")
+	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomRequest")
+	proto.RegisterType((*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)(nil), "osmosis.superfluid.EstimateSuperfluidDelegatedAmountByValidatorDenomResponse")
+	proto.RegisterType((*QueryTotalDelegationByDelegatorRequest)(nil), "osmosis.superfluid.QueryTotalDelegationByDelegatorRequest")
+	proto.RegisterType((*QueryTotalDelegationByDelegatorResponse)(nil), "osmosis.superfluid.QueryTotalDelegationByDelegatorResponse")
+	proto.RegisterType((*QueryUnpoolWhitelistRequest)(nil), "osmosis.superfluid.QueryUnpoolWhitelistRequest")
+	proto.RegisterType((*QueryUnpoolWhitelistResponse)(nil), "osmosis.superfluid.QueryUnpoolWhitelistResponse")
+	proto.RegisterType((*UserConcentratedSuperfluidPositionsDelegatedRequest)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsDelegatedRequest")
+	proto.RegisterType((*UserConcentratedSuperfluidPositionsDelegatedResponse)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsDelegatedResponse")
+	proto.RegisterType((*UserConcentratedSuperfluidPositionsUndelegatingRequest)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsUndelegatingRequest")
+	proto.RegisterType((*UserConcentratedSuperfluidPositionsUndelegatingResponse)(nil), "osmosis.superfluid.UserConcentratedSuperfluidPositionsUndelegatingResponse")
+	proto.RegisterType((*QueryRestSupplyRequest)(nil), "osmosis.superfluid.QueryRestSupplyRequest")
+	proto.RegisterType((*QueryRestSupplyResponse)(nil), "osmosis.superfluid.QueryRestSupplyResponse")
+	proto.RegisterType((*IntermediaryAccountSlashesRequest)(nil), "osmosis.superfluid.IntermediaryAccountSlashesRequest")
+	proto.RegisterType((*IntermediaryAccountSlashesResponse)(nil), "osmosis.superfluid.IntermediaryAccountSlashesResponse")
+	proto.RegisterType((*TotalSuperfluidStakeByAssetRequest)(nil), "osmosis.superfluid.TotalSuperfluidStakeByAssetRequest")
+	proto.RegisterType((*TotalSuperfluidStakeByAssetResponse)(nil), "osmosis.superfluid.TotalSuperfluidStakeByAssetResponse")
+	proto.RegisterType((*SuperfluidStakeByAsset)(nil), "osmosis.superfluid.SuperfluidStakeByAsset")
+	proto.RegisterType((*TotalSuperfluidStakeByValidatorRequest)(nil), "osmosis.superfluid.TotalSuperfluidStakeByValidatorRequest")
+	proto.RegisterType((*TotalSuperfluidStakeByValidatorResponse)(nil), "osmosis.superfluid.TotalSuperfluidStakeByValidatorResponse")
+	proto.RegisterType((*SuperfluidStakeByValidator)(nil), "osmosis.superfluid.SuperfluidStakeByValidator")
+	proto.RegisterType((*AutoCompoundLockRequest)(nil), "osmosis.superfluid.AutoCompoundLockRequest")
+	proto.RegisterType((*AutoCompoundLockResponse)(nil), "osmosis.superfluid.AutoCompoundLockResponse")
 }
 
-func _Query_AllAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllAssetsRequest)
-	if err := dec(in); err != nil {
+func init() { proto.RegisterFile("osmosis/superfluid/query.proto", fileDescriptor_e3d9448e4ed3943f) }
+
+var fileDescriptor_e3d9448e4ed3943f = []byte{
+	// 2417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x5a, 0x5f, 0x6c, 0x14, 0xc7,
+	0x19, 0x67, 0xcf, 0x8e, 0x8d, 0x3f, 0x24, 0x30, 0x03, 0x01, 0xb3, 0x80, 0x9d, 0xac, 0x1d, 0xdb,
+	0x75, 0xcc, 0x6d, 0x6c, 0xc0, 0x76, 0xa0, 0xd0, 0xf8, 0x6c, 0x0c, 0x6e, 0x4d, 0x6c, 0xce, 0x36,
+	0xa8, 0x6d, 0xaa, 0xed, 0xfa, 0x76, 0x39, 0xaf, 0xd8, 0xdb, 0x3d, 0x6e, 0xf7, 0x48, 0x4e, 0x88,
+	0x46, 0x4a, 0x55, 0xa9, 0x55, 0x23, 0xb5, 0x51, 0x14, 0x55, 0x79, 0xa9, 0xf2, 0x92, 0x87, 0xa6,
+	0x52, 0xfb, 0xd6, 0x2a, 0x4a, 0x5f, 0xaa, 0xbe, 0x44, 0xaa, 0x2a, 0x45, 0xca, 0x4b, 0xd5, 0x07,
+	0x12, 0x35, 0x79, 0x6c, 0x5f, 0xfa, 0xd8, 0xbe, 0x74, 0x76, 0x66, 0xf6, 0xcf, 0xdd, 0xcd, 0xfe,
+	0xb9, 0xc3, 0x81, 0x3c, 0x58, 0xdc, 0xec, 0x7c, 0xff, 0xbf, 0x6f, 0xbe, 0x99, 0xf9, 0x0d, 0x30,
+	0x6c, 0x3b, 0x15, 0xdb, 0x31, 0x1c, 0xd9, 0xa9, 0x57, 0xf5, 0xda, 0x6d, 0xb3, 0x6e, 0x68, 0xf2,
+	0xdd, 0xba, 0x5e, 0x6b, 0xe4, 0xab, 0x35, 0xdb, 0xb5, 0x11, 0x62, 0xf3, 0xf9, 0x70, 0x5e, 0x3c,
+	0x5a, 0xb6, 0xcb, 0x36, 0x99, 0x96, 0xbd, 0x5f, 0x94, 0x52, 0x1c, 0x2e, 0x11, 0x52, 0x79, 0x47,
+	0x75, 0x74, 0xf9, 0xde, 0xcc, 0x8e, 0xee, 0xaa, 0x33, 0x72, 0xc9, 0x36, 0x2c, 0x36, 0x7f, 0xaa,
+	0x6c, 0xdb, 0x65, 0x53, 0x97, 0xd5, 0xaa, 0x21, 0xab, 0x96, 0x65, 0xbb, 0xaa, 0x6b, 0xd8, 0x96,
+	0xc3, 0x66, 0x47, 0xd8, 0x2c, 0x19, 0xed, 0xd4, 0x6f, 0xcb, 0xae, 0x51, 0xd1, 0x1d, 0x57, 0xad,
+	0x54, 0x7d, 0xf1, 0xad, 0x04, 0x5a, 0xbd, 0x46, 0x24, 0xb0, 0xf9, 0x51, 0x8e, 0x23, 0xe1, 0x4f,
+	0x5f, 0x0b, 0x87, 0xa8, 0xaa, 0xd6, 0xd4, 0x8a, 0x6f, 0xc6, 0x09, 0x9f, 0xc0, 0xb4, 0x4b, 0x77,
+	0xea, 0x55, 0xf2, 0x0f, 0x9b, 0x9a, 0x8a, 0xfa, 0x47, 0x42, 0x14, 0x78, 0x59, 0x55, 0xcb, 0x86,
+	0x15, 0x35, 0x66, 0x8c, 0xd1, 0x62, 0x07, 0xee, 0x18, 0x56, 0x39, 0x20, 0x64, 0x63, 0x4a, 0x25,
+	0x1d, 0x05, 0x74, 0xc3, 0x93, 0xb3, 0x41, 0x2c, 0x28, 0xea, 0x58, 0xa8, 0xe3, 0x4a, 0xeb, 0x70,
+	0xa4, 0xe9, 0xab, 0x53, 0xc5, 0x51, 0xd2, 0xd1, 0x02, 0xf4, 0x51, 0x4b, 0x87, 0x84, 0x67, 0x84,
+	0xc9, 0x03, 0xb3, 0x62, 0xbe, 0x3d, 0x33, 0x79, 0xca, 0x53, 0xe8, 0xfd, 0xf8, 0xe1, 0xc8, 0xbe,
+	0x22, 0xa3, 0x97, 0x26, 0x61, 0x70, 0xd1, 0x71, 0x74, 0x77, 0xab, 0x51, 0xd5, 0x99, 0x12, 0x74,
+	0x14, 0x9e, 0xd2, 0x74, 0xcb, 0xae, 0x10, 0x61, 0x03, 0x45, 0x3a, 0x90, 0xbe, 0x0f, 0x87, 0x23,
+	0x94, 0x4c, 0xf1, 0x0a, 0x80, 0xea, 0x7d, 0x54, 0x5c, 0xfc, 0x95, 0xd0, 0x1f, 0x9c, 0x9d, 0xe0,
+	0x29, 0xdf, 0x0c, 0x7e, 0x86, 0x42, 0x06, 0x54, 0xff, 0xa7, 0x84, 0xb0, 0x19, 0xa6, 0x49, 0xa6,
+	0x02, 0x5f, 0x6f, 0x62, 0x85, 0xe1, 0x37, 0xa6, 0x70, 0x11, 0xfa, 0x08, 0x97, 0xe7, 0x69, 0x0f,
+	0xf6, 0x74, 0x34, 0x83, 0x32, 0xdf, 0x65, 0xca, 0x28, 0xe5, 0xe1, 0x18, 0xf9, 0x7c, 0xbd, 0x6e,
+	0xba, 0x46, 0xd5, 0x34, 0xf4, 0x5a, 0xb2, 0xe3, 0x3f, 0x17, 0xe0, 0x78, 0x1b, 0x03, 0x33, 0xa7,
+	0x0a, 0xa2, 0xa7, 0x5f, 0xc1, 0x02, 0x8c, 0x7b, 0xaa, 0xa9, 0x5b, 0xae, 0x52, 0x09, 0xa8, 0x58,
+	0x32, 0x66, 0x79, 0x26, 0xae, 0xe3, 0x4f, 0x57, 0x02, 0xa6, 0xa8, 0xe4, 0x92, 0x5d, 0xd3, 0x8a,
+	0x43, 0x76, 0xcc, 0xbc, 0xf4, 0x33, 0x01, 0x9e, 0x0d, 0xfd, 0x5b, 0xb5, 0x5c, 0xbd, 0x56, 0xd1,
+	0x35, 0x43, 0xad, 0x35, 0x16, 0x4b, 0x25, 0xbb, 0x6e, 0xb9, 0xab, 0xd6, 0x6d, 0x9b, 0xef, 0x09,
+	0x3a, 0x01, 0xfb, 0xb1, 0x3c, 0x45, 0xd5, 0xb4, 0xda, 0x50, 0x8e, 0x4c, 0xf4, 0xe3, 0xf1, 0x22,
+	0x1e, 0x7a, 0x53, 0x65, 0xb5, 0x5e, 0xd6, 0x15, 0x43, 0x1b, 0xea, 0xc1, 0x53, 0xbd, 0xc5, 0x7e,
+	0x32, 0x5e, 0xd5, 0xd0, 0x10, 0xf4, 0x7b, 0x1c, 0xba, 0xe3, 0x0c, 0xf5, 0x52, 0x26, 0x36, 0x94,
+	0x76, 0x61, 0x18, 0x67, 0x88, 0x63, 0x83, 0x9f, 0x43, 0xaf, 0x3e, 0xc2, 0xfa, 0x67, 0xf1, 0x18,
+	0xcf, 0xd3, 0x05, 0x90, 0xf7, 0x16, 0x4b, 0x9e, 0xf6, 0x13, 0xb6, 0x06, 0x70, 0x8d, 0x96, 0xfd,
+	0x32, 0x2c, 0x46, 0x38, 0xa5, 0xbf, 0x08, 0x30, 0x12, 0xab, 0x8a, 0xe5, 0xe2, 0x16, 0xec, 0x57,
+	0xd9, 0x37, 0x56, 0x1c, 0xe7, 0x93, 0x8b, 0x23, 0x26, 0x78, 0xac, 0x5c, 0x02, 0x61, 0xe8, 0x6a,
+	0x93, 0x13, 0x39, 0xe2, 0xc4, 0x44, 0xaa, 0x13, 0xd4, 0xaa, 0x26, 0x2f, 0x2e, 0xc3, 0xe8, 0x92,
+	0x6d, 0x59, 0x7a, 0xc9, 0xd5, 0x79, 0xca, 0xfd, 0xa0, 0x1d, 0x87, 0x7e, 0xaf, 0xb5, 0x78, 0xa9,
+	0x10, 0x48, 0x2a, 0xfa, 0xbc, 0xe1, 0xaa, 0x26, 0xbd, 0x0a, 0x63, 0xc9, 0xfc, 0x2c, 0x12, 0xeb,
+	0x38, 0x63, 0xf4, 0x13, 0x0b, 0x79, 0x77, 0x81, 0x28, 0xfa, 0x52, 0xa4, 0x15, 0xc8, 0x93, 0xb6,
+	0xb3, 0x85, 0x1b, 0xb3, 0xb9, 0xac, 0x9b, 0x7a, 0x99, 0x38, 0x54, 0x68, 0xdc, 0x54, 0x4d, 0x43,
+	0x53, 0x5d, 0xbb, 0xb6, 0x62, 0xd7, 0x96, 0xbd, 0x1a, 0x4b, 0x5e, 0x4a, 0x55, 0x90, 0x33, 0xcb,
+	0x61, 0xbe, 0x5c, 0x6a, 0x59, 0xf0, 0x23, 0x3c, 0x57, 0x42, 0x51, 0x4e, 0xcb, 0x62, 0xff, 0x5c,
+	0x80, 0x03, 0x91, 0xd9, 0xa6, 0x25, 0x20, 0x34, 0x2f, 0x81, 0x2d, 0x38, 0xa0, 0x56, 0x3c, 0x77,
+	0x15, 0xe7, 0xb6, 0xa3, 0xd1, 0x05, 0x52, 0x38, 0xeb, 0x49, 0xfb, 0xc7, 0xc3, 0x91, 0xa7, 0x69,
+	0xba, 0x1d, 0xed, 0x4e, 0xde, 0xb0, 0xe5, 0x8a, 0xea, 0xee, 0xe6, 0x71, 0xd4, 0xfe, 0xf3, 0x70,
+	0x04, 0x35, 0xd4, 0x8a, 0x79, 0x41, 0x8a, 0x70, 0x4a, 0x45, 0xa0, 0xa3, 0x4d, 0x3c, 0x40, 0x3f,
+	0x84, 0x43, 0x2d, 0x1d, 0x82, 0xac, 0xaf, 0x81, 0xc2, 0x7c, 0x9a, 0xe4, 0x63, 0x54, 0x72, 0x0b,
+	0xb7, 0x54, 0x3c, 0xd8, 0xdc, 0x1b, 0xa4, 0x51, 0x78, 0x96, 0xc4, 0x33, 0xcc, 0x67, 0xc4, 0x61,
+	0xbf, 0x99, 0xfe, 0x4a, 0x00, 0x29, 0x89, 0x8a, 0x45, 0xfb, 0x2e, 0x1c, 0x76, 0x3d, 0x2a, 0x45,
+	0x0b, 0x27, 0x69, 0x9c, 0x0a, 0xcb, 0x69, 0xf6, 0x8e, 0x52, 0x7b, 0x29, 0x7f, 0x98, 0x9c, 0xa8,
+	0x28, 0xa9, 0x38, 0xe8, 0x36, 0xa7, 0xde, 0x91, 0xde, 0x6e, 0x6a, 0x68, 0xe1, 0xcc, 0x62, 0x25,
+	0xba, 0x26, 0x9e, 0x87, 0xc3, 0x4c, 0x8e, 0x5d, 0x53, 0xfc, 0x76, 0x44, 0x13, 0x38, 0x18, 0x4c,
+	0x2c, 0xd2, 0xef, 0x1e, 0xf1, 0x3d, 0xbf, 0xa0, 0x02, 0x62, 0xda, 0xf0, 0x06, 0x83, 0x09, 0x9f,
+	0x38, 0xa8, 0xd4, 0x9e, 0x68, 0xa5, 0xe2, 0x36, 0x2b, 0x25, 0x59, 0xc5, 0xe2, 0x55, 0xc2, 0xd5,
+	0x59, 0x61, 0x0b, 0xcd, 0xab, 0xce, 0x13, 0x4d, 0x6d, 0xc1, 0x6f, 0x08, 0x4b, 0xf8, 0xa0, 0x53,
+	0x78, 0xc1, 0x8b, 0xdf, 0x07, 0x9f, 0x8d, 0x4c, 0x96, 0x0d, 0x77, 0xb7, 0xbe, 0x83, 0x09, 0x2b,
+	0x32, 0x3b, 0x09, 0xd0, 0x7f, 0xce, 0xe0, 0x90, 0xca, 0xde, 0x3e, 0xea, 0x10, 0x06, 0xa7, 0xc8,
+	0x44, 0xe3, 0x8d, 0x70, 0x82, 0x9b, 0xb5, 0x42, 0x63, 0xd9, 0xf7, 0xbc, 0x9b, 0x30, 0x49, 0x7f,
+	0xec, 0x81, 0xc9, 0x74, 0xc1, 0xcc, 0xd3, 0xd7, 0xe0, 0x34, 0x37, 0xa7, 0x4a, 0x8d, 0xec, 0x58,
+	0xfe, 0xf2, 0xcc, 0x27, 0x77, 0x9a, 0x50, 0x09, 0xdd, 0xe8, 0xd8, 0x6a, 0x3d, 0xe9, 0xc4, 0x52,
+	0x38, 0xe8, 0x75, 0x78, 0xba, 0xa9, 0x26, 0x75, 0x4d, 0xf1, 0x4e, 0x8e, 0x5e, 0x46, 0xf7, 0x3c,
+	0xe4, 0x47, 0xa2, 0xe5, 0xa9, 0x6b, 0xe4, 0x23, 0xfa, 0x85, 0x00, 0xc3, 0xd4, 0x82, 0xc8, 0x36,
+	0xef, 0x9d, 0xd6, 0xb0, 0x25, 0x2c, 0xfb, 0x3d, 0xa4, 0xcd, 0x26, 0x98, 0x22, 0x33, 0x53, 0x26,
+	0x32, 0x9a, 0x52, 0x3c, 0x49, 0x34, 0x86, 0xcb, 0x7c, 0x93, 0xe8, 0xa3, 0xe5, 0x27, 0x59, 0xf0,
+	0x8d, 0x30, 0xa6, 0xdb, 0x96, 0xb6, 0x67, 0x35, 0x11, 0xae, 0x86, 0x5c, 0x74, 0x35, 0xfc, 0x37,
+	0x07, 0x53, 0x59, 0x14, 0x3e, 0xf1, 0x5a, 0xf9, 0x31, 0x3e, 0xab, 0xd1, 0x54, 0xd5, 0xad, 0xc7,
+	0x50, 0x2e, 0xb4, 0x30, 0xb7, 0x43, 0x55, 0xb4, 0x60, 0xd6, 0xe0, 0x90, 0xd3, 0xb0, 0xdc, 0x5d,
+	0xdd, 0x35, 0x4a, 0x8a, 0xb7, 0x77, 0x3b, 0xb8, 0x40, 0x3c, 0xe5, 0xa7, 0x03, 0x8f, 0xe9, 0x15,
+	0x22, 0xbf, 0xe9, 0x93, 0xad, 0xe1, 0x31, 0x73, 0xf0, 0xa0, 0x13, 0xfd, 0xe8, 0x48, 0x77, 0x61,
+	0x3a, 0x66, 0x95, 0x06, 0xbb, 0x66, 0xd3, 0xd6, 0xcb, 0xed, 0x7e, 0x42, 0x5a, 0xf7, 0x6b, 0xca,
+	0xf7, 0x6f, 0x04, 0x38, 0x93, 0x51, 0xe7, 0x93, 0x4e, 0xb9, 0xf4, 0x00, 0x16, 0xae, 0x38, 0xf8,
+	0x42, 0x88, 0xc3, 0xdf, 0x26, 0xc8, 0x5f, 0x30, 0x5f, 0x61, 0xa8, 0xfe, 0x24, 0xc0, 0x8b, 0x5d,
+	0xe8, 0x67, 0x61, 0x8b, 0xed, 0x6d, 0xc2, 0xe3, 0xe9, 0x6d, 0xd2, 0x36, 0x8c, 0xf3, 0x4f, 0x64,
+	0x8f, 0xb6, 0xb5, 0xbc, 0xdb, 0x0b, 0x13, 0xa9, 0x72, 0x9f, 0x78, 0xb7, 0x50, 0xe1, 0x48, 0x93,
+	0x3a, 0x6a, 0x10, 0x6b, 0x14, 0x53, 0x7e, 0xec, 0xfd, 0x7b, 0xb9, 0x1f, 0xfe, 0xa8, 0x1c, 0xca,
+	0xc1, 0x74, 0x21, 0xad, 0x6d, 0x26, 0x3e, 0xc1, 0x3d, 0x5f, 0x9f, 0xcd, 0xab, 0xf7, 0xf1, 0x6e,
+	0x5e, 0xa7, 0xe1, 0x24, 0x29, 0x8d, 0x6d, 0xab, 0x6a, 0xdb, 0xe6, 0xad, 0x5d, 0xc3, 0xd5, 0x4d,
+	0xc3, 0xf1, 0x4f, 0x7a, 0xd2, 0x8b, 0x70, 0x8a, 0x3f, 0xcd, 0x22, 0x8a, 0x4f, 0xf0, 0xde, 0x04,
+	0xbe, 0x1d, 0xd1, 0xca, 0xc0, 0x37, 0x55, 0x6f, 0xbc, 0x8a, 0x5b, 0xc1, 0x0e, 0x9c, 0xdd, 0x76,
+	0xf4, 0x1a, 0xbe, 0x23, 0x95, 0xb0, 0xd2, 0x9a, 0x17, 0x84, 0xb0, 0x40, 0x36, 0x70, 0xed, 0x90,
+	0x1e, 0x16, 0x04, 0xa8, 0xab, 0xca, 0xfe, 0x83, 0x00, 0xe7, 0x3a, 0x53, 0xc2, 0xec, 0xfe, 0x11,
+	0x9c, 0x2e, 0x99, 0x0a, 0x31, 0xbd, 0x8e, 0xf9, 0xf1, 0x2f, 0x4a, 0xda, 0x52, 0xe6, 0x73, 0xbc,
+	0x32, 0x8f, 0x2a, 0xdb, 0xc0, 0x12, 0x3c, 0x03, 0x7c, 0x55, 0x4d, 0xe5, 0x7e, 0xa2, 0x64, 0xf2,
+	0xe7, 0x1d, 0x49, 0x87, 0xb9, 0x0c, 0x76, 0x87, 0x7b, 0xbb, 0x55, 0xee, 0x2a, 0x3e, 0x1f, 0x0a,
+	0x30, 0xdf, 0xb1, 0x9e, 0xaf, 0x49, 0x88, 0xf2, 0x70, 0x8c, 0x94, 0x1e, 0x36, 0xc8, 0xc5, 0x36,
+	0x57, 0xcd, 0x46, 0xf2, 0x75, 0xb6, 0x08, 0xc7, 0xdb, 0xe8, 0x99, 0x2b, 0xf3, 0x91, 0x8b, 0x41,
+	0xca, 0xea, 0xf2, 0x2f, 0xac, 0x74, 0x75, 0xfc, 0x1a, 0x5f, 0x87, 0x38, 0xf7, 0xf1, 0x4d, 0x53,
+	0x75, 0x76, 0xf5, 0x00, 0x57, 0x99, 0x81, 0xa3, 0x46, 0x84, 0x48, 0x89, 0x5e, 0xf7, 0x07, 0x8a,
+	0x47, 0x8c, 0x76, 0x01, 0x2d, 0x50, 0x4c, 0xae, 0x6b, 0x28, 0xe6, 0x23, 0x7c, 0x33, 0x4a, 0x32,
+	0x90, 0x05, 0x60, 0x0d, 0xfa, 0x1d, 0xfa, 0x89, 0x65, 0x6d, 0x9a, 0x97, 0xb5, 0x38, 0x41, 0x2c,
+	0x28, 0xbe, 0x88, 0xbd, 0x83, 0x60, 0xc6, 0xda, 0xae, 0xc1, 0xa4, 0x37, 0x15, 0x1a, 0x04, 0xe1,
+	0xf3, 0x7b, 0x90, 0x0d, 0xa3, 0x89, 0x54, 0xcc, 0xc7, 0x6b, 0x2d, 0xd8, 0xc4, 0x54, 0xf2, 0x16,
+	0x15, 0x95, 0xd1, 0x02, 0x53, 0xbc, 0x29, 0xc0, 0x31, 0x3e, 0x61, 0x0c, 0x94, 0xf7, 0x4a, 0x3b,
+	0xac, 0xc0, 0x00, 0x8b, 0xbd, 0x80, 0x14, 0x26, 0x61, 0x9c, 0xef, 0x7f, 0x70, 0x8c, 0xf1, 0x23,
+	0xf5, 0x3a, 0x4c, 0xa4, 0x52, 0xb2, 0x68, 0x6d, 0x01, 0x04, 0x67, 0xaa, 0x8c, 0x9b, 0x7a, 0xab,
+	0x2c, 0x16, 0xb5, 0x88, 0x1c, 0xe9, 0x1d, 0x01, 0xc4, 0x78, 0x86, 0x24, 0xbc, 0xe7, 0xab, 0x0d,
+	0xe1, 0x2c, 0x1c, 0x5f, 0xac, 0xbb, 0xf6, 0x92, 0x5d, 0xa9, 0xe2, 0xaa, 0xd6, 0xbc, 0xa3, 0x7c,
+	0x2a, 0xbe, 0x77, 0x0e, 0x86, 0xda, 0x79, 0x58, 0xf4, 0x86, 0xa0, 0x5f, 0xb7, 0xd4, 0x1d, 0x53,
+	0xa7, 0x4c, 0xfb, 0x8b, 0xfe, 0x70, 0xf6, 0xb7, 0x63, 0xf0, 0x14, 0x69, 0x43, 0xe8, 0x27, 0x02,
+	0xf4, 0x51, 0x94, 0x1f, 0x8d, 0xf3, 0x02, 0xdb, 0xfe, 0xa0, 0x20, 0x4e, 0xa4, 0xd2, 0x51, 0xfd,
+	0xd2, 0xd4, 0x1b, 0x9f, 0x7e, 0xf9, 0x76, 0x6e, 0x0c, 0x49, 0x32, 0xe7, 0x99, 0x24, 0x7c, 0xeb,
+	0x20, 0xca, 0x7f, 0x2a, 0xc0, 0x40, 0x00, 0xf3, 0xa3, 0x31, 0x9e, 0x8a, 0xd6, 0x47, 0x07, 0xf1,
+	0xb9, 0x14, 0x2a, 0x66, 0x46, 0x9e, 0x98, 0x31, 0x89, 0xc6, 0x93, 0xcc, 0x08, 0x9f, 0x24, 0xa8,
+	0x29, 0xfe, 0x2b, 0x42, 0x8c, 0x29, 0x2d, 0x0f, 0x0f, 0x31, 0xa6, 0xb4, 0x3e, 0x45, 0x64, 0x34,
+	0xc5, 0xc4, 0xc5, 0x47, 0x95, 0xbf, 0x27, 0xc0, 0xa1, 0x96, 0x77, 0x04, 0x34, 0x15, 0xeb, 0x75,
+	0xdb, 0xeb, 0x84, 0xf8, 0x7c, 0x26, 0x5a, 0x66, 0xdc, 0x39, 0x62, 0x5c, 0x1e, 0x4d, 0xa7, 0xc7,
+	0x29, 0x7c, 0xb0, 0x40, 0x7f, 0xf6, 0x9e, 0x3a, 0xf8, 0x30, 0x3b, 0x9a, 0x8d, 0x89, 0x4a, 0x02,
+	0xfc, 0x2f, 0x9e, 0xed, 0x88, 0x87, 0x99, 0x7e, 0x89, 0x98, 0x3e, 0x8f, 0xce, 0xa7, 0xc5, 0x95,
+	0xb7, 0x03, 0x3a, 0xe8, 0x33, 0x01, 0x4e, 0x25, 0xa1, 0xe4, 0x68, 0x3e, 0xe6, 0xf8, 0x90, 0x86,
+	0xcb, 0x8b, 0x0b, 0x9d, 0x33, 0x32, 0x97, 0xd6, 0x88, 0x4b, 0x2b, 0x68, 0x39, 0xc9, 0xa5, 0x92,
+	0x2f, 0x89, 0xeb, 0x98, 0x7c, 0x9f, 0xf5, 0x8c, 0x07, 0xe8, 0xf7, 0x3e, 0x96, 0x9b, 0x88, 0xa0,
+	0xa3, 0x42, 0xec, 0xd2, 0xce, 0x0c, 0xe3, 0x8b, 0x4b, 0x8f, 0x24, 0x83, 0x79, 0xbf, 0x0f, 0xfd,
+	0x15, 0x37, 0xe9, 0x78, 0xf4, 0x19, 0x71, 0x9f, 0x27, 0x52, 0x31, 0x6d, 0x71, 0xae, 0x53, 0x36,
+	0x66, 0xcf, 0x65, 0x92, 0x8d, 0x05, 0x34, 0x97, 0x56, 0x60, 0x7c, 0x10, 0x1b, 0xfd, 0xad, 0x69,
+	0xcb, 0x69, 0xc5, 0x86, 0xd1, 0xf9, 0xac, 0x17, 0xd5, 0x26, 0x84, 0x9b, 0xef, 0x4d, 0x3a, 0x04,
+	0x2d, 0xbd, 0x44, 0xbc, 0xb9, 0x80, 0x16, 0x92, 0xbc, 0xe1, 0x5f, 0xb0, 0xe9, 0x91, 0x13, 0xfd,
+	0x5b, 0x80, 0x67, 0xd2, 0x70, 0x60, 0x74, 0x31, 0xab, 0x79, 0x1c, 0x08, 0x52, 0xfc, 0x66, 0x77,
+	0xcc, 0xcc, 0xc3, 0x97, 0x89, 0x87, 0xd7, 0xd0, 0x4a, 0xc7, 0x1e, 0x3a, 0xf2, 0xfd, 0xb6, 0x9b,
+	0xcb, 0x03, 0xf4, 0x46, 0x2e, 0x8a, 0xed, 0xc7, 0xa1, 0x99, 0xe8, 0x52, 0xb2, 0xd1, 0x29, 0xb0,
+	0xab, 0x78, 0xb9, 0x5b, 0x76, 0xe6, 0xf5, 0x0f, 0x88, 0xd7, 0xb7, 0xd0, 0x76, 0x46, 0xaf, 0xeb,
+	0x51, 0x81, 0xca, 0x4e, 0x43, 0x09, 0x3c, 0xe7, 0x06, 0xe1, 0x7f, 0x02, 0x3c, 0x97, 0x09, 0xe2,
+	0x43, 0x2f, 0x75, 0x90, 0x3c, 0x2e, 0xcc, 0x26, 0x2e, 0x3e, 0x82, 0x04, 0x16, 0x8d, 0xeb, 0x24,
+	0x1a, 0x57, 0xd1, 0x95, 0xce, 0x6b, 0xc0, 0x8b, 0x45, 0x88, 0xf2, 0xd1, 0xe3, 0xf3, 0xef, 0x72,
+	0x30, 0xd3, 0x31, 0x6a, 0x87, 0xd6, 0x78, 0x7e, 0x74, 0x0b, 0x3e, 0x8a, 0xd7, 0xf7, 0x48, 0x1a,
+	0x8b, 0xd0, 0x2b, 0x24, 0x42, 0x37, 0xd1, 0x56, 0x52, 0x84, 0x74, 0x26, 0x5e, 0x49, 0x6a, 0x08,
+	0xbc, 0x80, 0xfd, 0xcb, 0xef, 0xe0, 0x5c, 0x2c, 0x0f, 0x5d, 0xc8, 0xbe, 0x4f, 0xb4, 0x2d, 0x94,
+	0x8b, 0x5d, 0xf1, 0x32, 0xaf, 0xb7, 0x89, 0xd7, 0xeb, 0xe8, 0x7a, 0x92, 0xd7, 0xad, 0x4f, 0x9a,
+	0xe9, 0xab, 0xe3, 0x03, 0x7c, 0x56, 0x6b, 0x01, 0xa0, 0x90, 0x1c, 0x6b, 0x27, 0x1f, 0xc9, 0x12,
+	0x5f, 0xc8, 0xce, 0xd0, 0xc9, 0xa9, 0xad, 0x4e, 0x98, 0x95, 0x57, 0x03, 0xc3, 0xde, 0xcd, 0xc1,
+	0x74, 0x27, 0x90, 0x14, 0xba, 0xca, 0x33, 0xac, 0x0b, 0xe4, 0x4c, 0xbc, 0xf6, 0xe8, 0x82, 0x98,
+	0xe7, 0x37, 0x89, 0xe7, 0x1b, 0xe8, 0xe5, 0xc4, 0x3d, 0x99, 0x1e, 0x85, 0xa2, 0x58, 0xaa, 0x19,
+	0x80, 0x44, 0xfc, 0x5e, 0xff, 0x7e, 0x0e, 0xe4, 0x0e, 0xe1, 0x28, 0xf4, 0xed, 0x2e, 0xbd, 0xe2,
+	0x60, 0x67, 0xe2, 0x77, 0xf6, 0x44, 0x16, 0x0b, 0xd2, 0x77, 0x49, 0x90, 0x36, 0xd1, 0x8d, 0x2c,
+	0x41, 0xaa, 0x47, 0x24, 0xa4, 0xc7, 0xe9, 0x2d, 0x01, 0x20, 0x84, 0xb1, 0xf8, 0xf7, 0x12, 0x3e,
+	0x36, 0xc6, 0xbf, 0x97, 0xc4, 0xe0, 0x62, 0xd9, 0xae, 0x91, 0x0e, 0x35, 0xe2, 0x4b, 0xdc, 0x73,
+	0xe2, 0x91, 0x26, 0xfe, 0x39, 0x2b, 0x15, 0x3a, 0xe3, 0x9f, 0xb3, 0xd2, 0x01, 0x2d, 0xe9, 0x16,
+	0xb1, 0xfc, 0x06, 0x5a, 0x4f, 0xb2, 0x9c, 0x77, 0x72, 0x57, 0x18, 0x88, 0x25, 0xdf, 0xe7, 0xcd,
+	0x3e, 0x40, 0x9f, 0x0a, 0x70, 0x32, 0x01, 0x6d, 0x42, 0x59, 0x8e, 0xb9, 0x1c, 0x10, 0x4b, 0x9c,
+	0xef, 0x98, 0x8f, 0x79, 0xba, 0x44, 0x3c, 0xbd, 0x84, 0x2e, 0xa6, 0xf7, 0xd4, 0xc8, 0x36, 0x42,
+	0xde, 0x14, 0xbc, 0xce, 0x4a, 0x6e, 0x95, 0xe8, 0x0b, 0x01, 0x46, 0x52, 0x90, 0x21, 0xfe, 0xae,
+	0x91, 0x0d, 0x78, 0xe2, 0xef, 0x1a, 0x19, 0xa1, 0x28, 0xe9, 0x2a, 0xf1, 0x70, 0x11, 0x7d, 0xab,
+	0x3b, 0x0f, 0x83, 0xcd, 0xd1, 0xbb, 0x8a, 0x0d, 0xb6, 0x42, 0x36, 0x88, 0x7f, 0x51, 0xe7, 0x83,
+	0x41, 0xe2, 0x74, 0x36, 0xe2, 0x4e, 0x0e, 0xfb, 0x2a, 0xe6, 0x56, 0x4a, 0x8c, 0x9d, 0xbc, 0x3f,
+	0x87, 0x97, 0xc7, 0xc2, 0xc6, 0xc7, 0xff, 0x1c, 0x16, 0x3e, 0xc1, 0x7f, 0x9f, 0xe3, 0xbf, 0x5f,
+	0x7e, 0x31, 0xbc, 0xef, 0x13, 0xfc, 0xf7, 0x77, 0xfc, 0xf7, 0xbd, 0xb9, 0xc8, 0xeb, 0x0e, 0x93,
+	0x7e, 0xc6, 0x54, 0x77, 0x9c, 0x40, 0xd5, 0xbd, 0xd9, 0x19, 0xf9, 0xb5, 0xa8, 0x42, 0xf2, 0xe2,
+	0xb3, 0xd3, 0x47, 0xfe, 0xc3, 0xea, 0xd9, 0xff, 0x03, 0xcc, 0x92, 0xf7, 0x68, 0x2e, 0x2c, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of superfluid parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns superfluid asset type, whether if it's a native asset or an lp
+	// share.
+	AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error)
+	// Returns all registered superfluid assets.
+	AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error)
+	// Returns the osmo equivalent multiplier used in the most recent epoch.
+	AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error)
+	// Returns all superfluid intermediary accounts.
+	AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error)
+	// Returns intermediary account connected to a superfluid staked lock by id
+	ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error)
+	// Returns the amount of delegations of specific denom for all validators
+	TotalDelegationByValidatorForDenom(ctx context.Context, in *QueryTotalDelegationByValidatorForDenomRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByValidatorForDenomResponse, error)
+	// Returns the total amount of osmo superfluidly staked.
+	// Response is denominated in uosmo.
+	TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error)
+	// Returns the coins superfluid delegated for the delegator, validator, denom
+	// triplet
+	SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error)
+	// Returns all the delegated superfluid poistions for a specific delegator.
+	SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error)
+	// Returns all the undelegating superfluid poistions for a specific delegator.
+	SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error)
+	// Returns all the superfluid positions of a specific denom delegated to one
+	// validator
+	SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error)
+	// Returns the amount of a specific denom delegated to a specific validator
+	// This is labeled an estimate, because the way it calculates the amount can
+	// lead rounding errors from the true delegated amount
+	EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+	// Returns the specified delegations for a specific delegator
+	TotalDelegationByDelegator(ctx context.Context, in *QueryTotalDelegationByDelegatorRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByDelegatorResponse, error)
+	// Returns a list of whitelisted pool ids to unpool.
+	UnpoolWhitelist(ctx context.Context, in *QueryUnpoolWhitelistRequest, opts ...grpc.CallOption) (*QueryUnpoolWhitelistResponse, error)
+	// Returns all of a user's full range CL positions that are superfluid staked.
+	UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, in *UserConcentratedSuperfluidPositionsDelegatedRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error)
+	UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, in *UserConcentratedSuperfluidPositionsUndelegatingRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error)
+	RestSupply(ctx context.Context, in *QueryRestSupplyRequest, opts ...grpc.CallOption) (*QueryRestSupplyResponse, error)
+	// Returns the history of slashes applied to the locks superfluid delegated
+	// through an intermediary account, broken down by lock.
+	IntermediaryAccountSlashes(ctx context.Context, in *IntermediaryAccountSlashesRequest, opts ...grpc.CallOption) (*IntermediaryAccountSlashesResponse, error)
+	// Returns the total amount of osmo superfluid staked through every superfluid
+	// asset. Response is denominated in uosmo.
+	TotalSuperfluidStakeByAsset(ctx context.Context, in *TotalSuperfluidStakeByAssetRequest, opts ...grpc.CallOption) (*TotalSuperfluidStakeByAssetResponse, error)
+	// Returns the total amount of osmo superfluid staked to every validator.
+	// Response is denominated in uosmo.
+	TotalSuperfluidStakeByValidator(ctx context.Context, in *TotalSuperfluidStakeByValidatorRequest, opts ...grpc.CallOption) (*TotalSuperfluidStakeByValidatorResponse, error)
+	// Returns whether a superfluid delegated lock is opted in to auto-compounding
+	// its staking rewards.
+	AutoCompoundLock(ctx context.Context, in *AutoCompoundLockRequest, opts ...grpc.CallOption) (*AutoCompoundLockResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllAssets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AllAssets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllAssets(ctx, req.(*AllAssetsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AssetMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssetMultiplierRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AssetType(ctx context.Context, in *AssetTypeRequest, opts ...grpc.CallOption) (*AssetTypeResponse, error) {
+	out := new(AssetTypeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetType", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AssetMultiplier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AssetMultiplier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AssetMultiplier(ctx, req.(*AssetMultiplierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AllIntermediaryAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AllIntermediaryAccountsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllAssets(ctx context.Context, in *AllAssetsRequest, opts ...grpc.CallOption) (*AllAssetsResponse, error) {
+	out := new(AllAssetsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllAssets", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllIntermediaryAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/AllIntermediaryAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllIntermediaryAccounts(ctx, req.(*AllIntermediaryAccountsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ConnectedIntermediaryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConnectedIntermediaryAccountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AssetMultiplier(ctx context.Context, in *AssetMultiplierRequest, opts ...grpc.CallOption) (*AssetMultiplierResponse, error) {
+	out := new(AssetMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AssetMultiplier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/ConnectedIntermediaryAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, req.(*ConnectedIntermediaryAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalDelegationByValidatorForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalDelegationByValidatorForDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) AllIntermediaryAccounts(ctx context.Context, in *AllIntermediaryAccountsRequest, opts ...grpc.CallOption) (*AllIntermediaryAccountsResponse, error) {
+	out := new(AllIntermediaryAccountsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AllIntermediaryAccounts", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalDelegationByValidatorForDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/TotalDelegationByValidatorForDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalDelegationByValidatorForDenom(ctx, req.(*QueryTotalDelegationByValidatorForDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalSuperfluidDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TotalSuperfluidDelegationsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ConnectedIntermediaryAccount(ctx context.Context, in *ConnectedIntermediaryAccountRequest, opts ...grpc.CallOption) (*ConnectedIntermediaryAccountResponse, error) {
+	out := new(ConnectedIntermediaryAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/ConnectedIntermediaryAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/TotalSuperfluidDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, req.(*TotalSuperfluidDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidDelegationAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationAmountRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalDelegationByValidatorForDenom(ctx context.Context, in *QueryTotalDelegationByValidatorForDenomRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByValidatorForDenomResponse, error) {
+	out := new(QueryTotalDelegationByValidatorForDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalDelegationByValidatorForDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, req.(*SuperfluidDelegationAmountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidDelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationsByDelegatorRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) TotalSuperfluidDelegations(ctx context.Context, in *TotalSuperfluidDelegationsRequest, opts ...grpc.CallOption) (*TotalSuperfluidDelegationsResponse, error) {
+	out := new(TotalSuperfluidDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidDelegations", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator",
+	return out, nil
+}
+
+func (c *queryClient) SuperfluidDelegationAmount(ctx context.Context, in *SuperfluidDelegationAmountRequest, opts ...grpc.CallOption) (*SuperfluidDelegationAmountResponse, error) {
+	out := new(SuperfluidDelegationAmountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, req.(*SuperfluidDelegationsByDelegatorRequest))
+	return out, nil
+}
+
+func (c *queryClient) SuperfluidDelegationsByDelegator(ctx context.Context, in *SuperfluidDelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByDelegatorResponse, error) {
+	out := new(SuperfluidDelegationsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidUndelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidUndelegationsByDelegatorRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) SuperfluidUndelegationsByDelegator(ctx context.Context, in *SuperfluidUndelegationsByDelegatorRequest, opts ...grpc.CallOption) (*SuperfluidUndelegationsByDelegatorResponse, error) {
+	out := new(SuperfluidUndelegationsByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) SuperfluidDelegationsByValidatorDenom(ctx context.Context, in *SuperfluidDelegationsByValidatorDenomRequest, opts ...grpc.CallOption) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
+	out := new(SuperfluidDelegationsByValidatorDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator",
+	return out, nil
+}
+
+func (c *queryClient) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, in *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest, opts ...grpc.CallOption) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
+	out := new(EstimateSuperfluidDelegatedAmountByValidatorDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, req.(*SuperfluidUndelegationsByDelegatorRequest))
+	return out, nil
+}
+
+func (c *queryClient) TotalDelegationByDelegator(ctx context.Context, in *QueryTotalDelegationByDelegatorRequest, opts ...grpc.CallOption) (*QueryTotalDelegationByDelegatorResponse, error) {
+	out := new(QueryTotalDelegationByDelegatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalDelegationByDelegator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SuperfluidDelegationsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SuperfluidDelegationsByValidatorDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) UnpoolWhitelist(ctx context.Context, in *QueryUnpoolWhitelistRequest, opts ...grpc.CallOption) (*QueryUnpoolWhitelistResponse, error) {
+	out := new(QueryUnpoolWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UnpoolWhitelist", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, in *UserConcentratedSuperfluidPositionsDelegatedRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error) {
+	out := new(UserConcentratedSuperfluidPositionsDelegatedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsDelegated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom",
+	return out, nil
+}
+
+func (c *queryClient) UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, in *UserConcentratedSuperfluidPositionsUndelegatingRequest, opts ...grpc.CallOption) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error) {
+	out := new(UserConcentratedSuperfluidPositionsUndelegatingResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsUndelegating", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, req.(*SuperfluidDelegationsByValidatorDenomRequest))
+	return out, nil
+}
+
+func (c *queryClient) RestSupply(ctx context.Context, in *QueryRestSupplyRequest, opts ...grpc.CallOption) (*QueryRestSupplyResponse, error) {
+	out := new(QueryRestSupplyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/RestSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) IntermediaryAccountSlashes(ctx context.Context, in *IntermediaryAccountSlashesRequest, opts ...grpc.CallOption) (*IntermediaryAccountSlashesResponse, error) {
+	out := new(IntermediaryAccountSlashesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/IntermediaryAccountSlashes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) TotalSuperfluidStakeByAsset(ctx context.Context, in *TotalSuperfluidStakeByAssetRequest, opts ...grpc.CallOption) (*TotalSuperfluidStakeByAssetResponse, error) {
+	out := new(TotalSuperfluidStakeByAssetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidStakeByAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom",
+	return out, nil
+}
+
+func (c *queryClient) TotalSuperfluidStakeByValidator(ctx context.Context, in *TotalSuperfluidStakeByValidatorRequest, opts ...grpc.CallOption) (*TotalSuperfluidStakeByValidatorResponse, error) {
+	out := new(TotalSuperfluidStakeByValidatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/TotalSuperfluidStakeByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, req.(*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest))
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundLock(ctx context.Context, in *AutoCompoundLockRequest, opts ...grpc.CallOption) (*AutoCompoundLockResponse, error) {
+	out := new(AutoCompoundLockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Query/AutoCompoundLock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_TotalDelegationByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalDelegationByDelegatorRequest)
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of superfluid parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns superfluid asset type, whether if it's a native asset or an lp
+	// share.
+	AssetType(context.Context, *AssetTypeRequest) (*AssetTypeResponse, error)
+	// Returns all registered superfluid assets.
+	AllAssets(context.Context, *AllAssetsRequest) (*AllAssetsResponse, error)
+	// Returns the osmo equivalent multiplier used in the most recent epoch.
+	AssetMultiplier(context.Context, *AssetMultiplierRequest) (*AssetMultiplierResponse, error)
+	// Returns all superfluid intermediary accounts.
+	AllIntermediaryAccounts(context.Context, *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error)
+	// Returns intermediary account connected to a superfluid staked lock by id
+	ConnectedIntermediaryAccount(context.Context, *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error)
+	// Returns the amount of delegations of specific denom for all validators
+	TotalDelegationByValidatorForDenom(context.Context, *QueryTotalDelegationByValidatorForDenomRequest) (*QueryTotalDelegationByValidatorForDenomResponse, error)
+	// Returns the total amount of osmo superfluidly staked.
+	// Response is denominated in uosmo.
+	TotalSuperfluidDelegations(context.Context, *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error)
+	// Returns the coins superfluid delegated for the delegator, validator, denom
+	// triplet
+	SuperfluidDelegationAmount(context.Context, *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error)
+	// Returns all the delegated superfluid poistions for a specific delegator.
+	SuperfluidDelegationsByDelegator(context.Context, *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error)
+	// Returns all the undelegating superfluid poistions for a specific delegator.
+	SuperfluidUndelegationsByDelegator(context.Context, *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error)
+	// Returns all the superfluid positions of a specific denom delegated to one
+	// validator
+	SuperfluidDelegationsByValidatorDenom(context.Context, *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error)
+	// Returns the amount of a specific denom delegated to a specific validator
+	// This is labeled an estimate, because the way it calculates the amount can
+	// lead rounding errors from the true delegated amount
+	EstimateSuperfluidDelegatedAmountByValidatorDenom(context.Context, *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error)
+	// Returns the specified delegations for a specific delegator
+	TotalDelegationByDelegator(context.Context, *QueryTotalDelegationByDelegatorRequest) (*QueryTotalDelegationByDelegatorResponse, error)
+	// Returns a list of whitelisted pool ids to unpool.
+	UnpoolWhitelist(context.Context, *QueryUnpoolWhitelistRequest) (*QueryUnpoolWhitelistResponse, error)
+	// Returns all of a user's full range CL positions that are superfluid staked.
+	UserConcentratedSuperfluidPositionsDelegated(context.Context, *UserConcentratedSuperfluidPositionsDelegatedRequest) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error)
+	UserConcentratedSuperfluidPositionsUndelegating(context.Context, *UserConcentratedSuperfluidPositionsUndelegatingRequest) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error)
+	RestSupply(context.Context, *QueryRestSupplyRequest) (*QueryRestSupplyResponse, error)
+	// Returns the history of slashes applied to the locks superfluid delegated
+	// through an intermediary account, broken down by lock.
+	IntermediaryAccountSlashes(context.Context, *IntermediaryAccountSlashesRequest) (*IntermediaryAccountSlashesResponse, error)
+	// Returns the total amount of osmo superfluid staked through every superfluid
+	// asset. Response is denominated in uosmo.
+	TotalSuperfluidStakeByAsset(context.Context, *TotalSuperfluidStakeByAssetRequest) (*TotalSuperfluidStakeByAssetResponse, error)
+	// Returns the total amount of osmo superfluid staked to every validator.
+	// Response is denominated in uosmo.
+	TotalSuperfluidStakeByValidator(context.Context, *TotalSuperfluidStakeByValidatorRequest) (*TotalSuperfluidStakeByValidatorResponse, error)
+	// Returns whether a superfluid delegated lock is opted in to auto-compounding
+	// its staking rewards.
+	AutoCompoundLock(context.Context, *AutoCompoundLockRequest) (*AutoCompoundLockResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AssetType(ctx context.Context, req *AssetTypeRequest) (*AssetTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetType not implemented")
+}
+func (*UnimplementedQueryServer) AllAssets(ctx context.Context, req *AllAssetsRequest) (*AllAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllAssets not implemented")
+}
+func (*UnimplementedQueryServer) AssetMultiplier(ctx context.Context, req *AssetMultiplierRequest) (*AssetMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetMultiplier not implemented")
+}
+func (*UnimplementedQueryServer) AllIntermediaryAccounts(ctx context.Context, req *AllIntermediaryAccountsRequest) (*AllIntermediaryAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllIntermediaryAccounts not implemented")
+}
+func (*UnimplementedQueryServer) ConnectedIntermediaryAccount(ctx context.Context, req *ConnectedIntermediaryAccountRequest) (*ConnectedIntermediaryAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectedIntermediaryAccount not implemented")
+}
+func (*UnimplementedQueryServer) TotalDelegationByValidatorForDenom(ctx context.Context, req *QueryTotalDelegationByValidatorForDenomRequest) (*QueryTotalDelegationByValidatorForDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalDelegationByValidatorForDenom not implemented")
+}
+func (*UnimplementedQueryServer) TotalSuperfluidDelegations(ctx context.Context, req *TotalSuperfluidDelegationsRequest) (*TotalSuperfluidDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidDelegations not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidDelegationAmount(ctx context.Context, req *SuperfluidDelegationAmountRequest) (*SuperfluidDelegationAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationAmount not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidDelegationsByDelegator(ctx context.Context, req *SuperfluidDelegationsByDelegatorRequest) (*SuperfluidDelegationsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidUndelegationsByDelegator(ctx context.Context, req *SuperfluidUndelegationsByDelegatorRequest) (*SuperfluidUndelegationsByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidUndelegationsByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) SuperfluidDelegationsByValidatorDenom(ctx context.Context, req *SuperfluidDelegationsByValidatorDenomRequest) (*SuperfluidDelegationsByValidatorDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidDelegationsByValidatorDenom not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx context.Context, req *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) (*EstimateSuperfluidDelegatedAmountByValidatorDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSuperfluidDelegatedAmountByValidatorDenom not implemented")
+}
+func (*UnimplementedQueryServer) TotalDelegationByDelegator(ctx context.Context, req *QueryTotalDelegationByDelegatorRequest) (*QueryTotalDelegationByDelegatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalDelegationByDelegator not implemented")
+}
+func (*UnimplementedQueryServer) UnpoolWhitelist(ctx context.Context, req *QueryUnpoolWhitelistRequest) (*QueryUnpoolWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpoolWhitelist not implemented")
+}
+func (*UnimplementedQueryServer) UserConcentratedSuperfluidPositionsDelegated(ctx context.Context, req *UserConcentratedSuperfluidPositionsDelegatedRequest) (*UserConcentratedSuperfluidPositionsDelegatedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserConcentratedSuperfluidPositionsDelegated not implemented")
+}
+func (*UnimplementedQueryServer) UserConcentratedSuperfluidPositionsUndelegating(ctx context.Context, req *UserConcentratedSuperfluidPositionsUndelegatingRequest) (*UserConcentratedSuperfluidPositionsUndelegatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserConcentratedSuperfluidPositionsUndelegating not implemented")
+}
+func (*UnimplementedQueryServer) RestSupply(ctx context.Context, req *QueryRestSupplyRequest) (*QueryRestSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestSupply not implemented")
+}
+func (*UnimplementedQueryServer) IntermediaryAccountSlashes(ctx context.Context, req *IntermediaryAccountSlashesRequest) (*IntermediaryAccountSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntermediaryAccountSlashes not implemented")
+}
+func (*UnimplementedQueryServer) TotalSuperfluidStakeByAsset(ctx context.Context, req *TotalSuperfluidStakeByAssetRequest) (*TotalSuperfluidStakeByAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidStakeByAsset not implemented")
+}
+func (*UnimplementedQueryServer) TotalSuperfluidStakeByValidator(ctx context.Context, req *TotalSuperfluidStakeByValidatorRequest) (*TotalSuperfluidStakeByValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalSuperfluidStakeByValidator not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundLock(ctx context.Context, req *AutoCompoundLockRequest) (*AutoCompoundLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundLock not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).TotalDelegationByDelegator(ctx, in)
+		return srv.(QueryServer).Params(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/TotalDelegationByDelegator",
+		FullMethod: "/osmosis.superfluid.Query/Params",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalDelegationByDelegator(ctx, req.(*QueryTotalDelegationByDelegatorRequest))
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_UnpoolWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUnpoolWhitelistRequest)
+func _Query_AssetType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).UnpoolWhitelist(ctx, in)
+		return srv.(QueryServer).AssetType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/UnpoolWhitelist",
+		FullMethod: "/osmosis.superfluid.Query/AssetType",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UnpoolWhitelist(ctx, req.(*QueryUnpoolWhitelistRequest))
+		return srv.(QueryServer).AssetType(ctx, req.(*AssetTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_UserConcentratedSuperfluidPositionsDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserConcentratedSuperfluidPositionsDelegatedRequest)
+func _Query_AllAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).UserConcentratedSuperfluidPositionsDelegated(ctx, in)
+		return srv.(QueryServer).AllAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsDelegated",
+		FullMethod: "/osmosis.superfluid.Query/AllAssets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserConcentratedSuperfluidPositionsDelegated(ctx, req.(*UserConcentratedSuperfluidPositionsDelegatedRequest))
+		return srv.(QueryServer).AllAssets(ctx, req.(*AllAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_UserConcentratedSuperfluidPositionsUndelegating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserConcentratedSuperfluidPositionsUndelegatingRequest)
+func _Query_AssetMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetMultiplierRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).UserConcentratedSuperfluidPositionsUndelegating(ctx, in)
+		return srv.(QueryServer).AssetMultiplier(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsUndelegating",
+		FullMethod: "/osmosis.superfluid.Query/AssetMultiplier",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserConcentratedSuperfluidPositionsUndelegating(ctx, req.(*UserConcentratedSuperfluidPositionsUndelegatingRequest))
+		return srv.(QueryServer).AssetMultiplier(ctx, req.(*AssetMultiplierRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_RestSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRestSupplyRequest)
+func _Query_AllIntermediaryAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllIntermediaryAccountsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).RestSupply(ctx, in)
+		return srv.(QueryServer).AllIntermediaryAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/osmosis.superfluid.Query/RestSupply",
+		FullMethod: "/osmosis.superfluid.Query/AllIntermediaryAccounts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RestSupply(ctx, req.(*QueryRestSupplyRequest))
+		return srv.(QueryServer).AllIntermediaryAccounts(ctx, req.(*AllIntermediaryAccountsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.superfluid.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AssetType",
-			Handler:    _Query_AssetType_Handler,
-		},
-		{
-			MethodName: "AllAssets",
-			Handler:    _Query_AllAssets_Handler,
-		},
-		{
-			MethodName: "AssetMultiplier",
-			Handler:    _Query_AssetMultiplier_Handler,
-		},
-		{
-			MethodName: "AllIntermediaryAccounts",
-			Handler:    _Query_AllIntermediaryAccounts_Handler,
-		},
-		{
-			MethodName: "ConnectedIntermediaryAccount",
-			Handler:    _Query_ConnectedIntermediaryAccount_Handler,
-		},
-		{
-			MethodName: "TotalDelegationByValidatorForDenom",
-			Handler:    _Query_TotalDelegationByValidatorForDenom_Handler,
-		},
-		{
-			MethodName: "TotalSuperfluidDelegations",
-			Handler:    _Query_TotalSuperfluidDelegations_Handler,
-		},
-		{
-			MethodName: "SuperfluidDelegationAmount",
-			Handler:    _Query_SuperfluidDelegationAmount_Handler,
-		},
-		{
-			MethodName: "SuperfluidDelegationsByDelegator",
-			Handler:    _Query_SuperfluidDelegationsByDelegator_Handler,
-		},
-		{
-			MethodName: "SuperfluidUndelegationsByDelegator",
-			Handler:    _Query_SuperfluidUndelegationsByDelegator_Handler,
-		},
-		{
-			MethodName: "SuperfluidDelegationsByValidatorDenom",
-			Handler:    _Query_SuperfluidDelegationsByValidatorDenom_Handler,
-		},
-		{
-			MethodName: "EstimateSuperfluidDelegatedAmountByValidatorDenom",
-			Handler:    _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler,
-		},
-		{
-			MethodName: "TotalDelegationByDelegator",
-			Handler:    _Query_TotalDelegationByDelegator_Handler,
-		},
-		{
-			MethodName: "UnpoolWhitelist",
-			Handler:    _Query_UnpoolWhitelist_Handler,
-		},
-		{
-			MethodName: "UserConcentratedSuperfluidPositionsDelegated",
-			Handler:    _Query_UserConcentratedSuperfluidPositionsDelegated_Handler,
-		},
-		{
-			MethodName: "UserConcentratedSuperfluidPositionsUndelegating",
-			Handler:    _Query_UserConcentratedSuperfluidPositionsUndelegating_Handler,
-		},
-		{
-			MethodName: "RestSupply",
-			Handler:    _Query_RestSupply_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/superfluid/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ConnectedIntermediaryAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectedIntermediaryAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/ConnectedIntermediaryAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectedIntermediaryAccount(ctx, req.(*ConnectedIntermediaryAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_TotalDelegationByValidatorForDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalDelegationByValidatorForDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalDelegationByValidatorForDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/TotalDelegationByValidatorForDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalDelegationByValidatorForDenom(ctx, req.(*QueryTotalDelegationByValidatorForDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalSuperfluidDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalSuperfluidDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/TotalSuperfluidDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSuperfluidDelegations(ctx, req.(*TotalSuperfluidDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_SuperfluidDelegationAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationAmount(ctx, req.(*SuperfluidDelegationAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_SuperfluidDelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationsByDelegatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationsByDelegator(ctx, req.(*SuperfluidDelegationsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetTypeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SuperfluidUndelegationsByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidUndelegationsByDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidUndelegationsByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidUndelegationsByDelegator(ctx, req.(*SuperfluidUndelegationsByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetTypeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AssetTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func _Query_SuperfluidDelegationsByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuperfluidDelegationsByValidatorDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/SuperfluidDelegationsByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SuperfluidDelegationsByValidatorDenom(ctx, req.(*SuperfluidDelegationsByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetTypeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSuperfluidDelegatedAmountByValidatorDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AssetTypeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AssetTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AssetType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/EstimateSuperfluidDelegatedAmountByValidatorDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSuperfluidDelegatedAmountByValidatorDenom(ctx, req.(*EstimateSuperfluidDelegatedAmountByValidatorDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllAssetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalDelegationByDelegator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalDelegationByDelegatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AllAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AllAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalDelegationByDelegator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/TotalDelegationByDelegator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalDelegationByDelegator(ctx, req.(*QueryTotalDelegationByDelegatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllAssetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_UnpoolWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnpoolWhitelistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).UnpoolWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/UnpoolWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnpoolWhitelist(ctx, req.(*QueryUnpoolWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_UserConcentratedSuperfluidPositionsDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserConcentratedSuperfluidPositionsDelegatedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserConcentratedSuperfluidPositionsDelegated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsDelegated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserConcentratedSuperfluidPositionsDelegated(ctx, req.(*UserConcentratedSuperfluidPositionsDelegatedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AllAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_UserConcentratedSuperfluidPositionsUndelegating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserConcentratedSuperfluidPositionsUndelegatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).UserConcentratedSuperfluidPositionsUndelegating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/UserConcentratedSuperfluidPositionsUndelegating",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserConcentratedSuperfluidPositionsUndelegating(ctx, req.(*UserConcentratedSuperfluidPositionsUndelegatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RestSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRestSupplyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RestSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/RestSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RestSupply(ctx, req.(*QueryRestSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_IntermediaryAccountSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntermediaryAccountSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntermediaryAccountSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/IntermediaryAccountSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntermediaryAccountSlashes(ctx, req.(*IntermediaryAccountSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func _Query_TotalSuperfluidStakeByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalSuperfluidStakeByAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSuperfluidStakeByAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/TotalSuperfluidStakeByAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSuperfluidStakeByAsset(ctx, req.(*TotalSuperfluidStakeByAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TotalSuperfluidStakeByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalSuperfluidStakeByValidatorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).TotalSuperfluidStakeByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/TotalSuperfluidStakeByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalSuperfluidStakeByValidator(ctx, req.(*TotalSuperfluidStakeByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AutoCompoundLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoCompoundLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Query/AutoCompoundLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundLock(ctx, req.(*AutoCompoundLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AssetMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.superfluid.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AssetType",
+			Handler:    _Query_AssetType_Handler,
+		},
+		{
+			MethodName: "AllAssets",
+			Handler:    _Query_AllAssets_Handler,
+		},
+		{
+			MethodName: "AssetMultiplier",
+			Handler:    _Query_AssetMultiplier_Handler,
+		},
+		{
+			MethodName: "AllIntermediaryAccounts",
+			Handler:    _Query_AllIntermediaryAccounts_Handler,
+		},
+		{
+			MethodName: "ConnectedIntermediaryAccount",
+			Handler:    _Query_ConnectedIntermediaryAccount_Handler,
+		},
+		{
+			MethodName: "TotalDelegationByValidatorForDenom",
+			Handler:    _Query_TotalDelegationByValidatorForDenom_Handler,
+		},
+		{
+			MethodName: "TotalSuperfluidDelegations",
+			Handler:    _Query_TotalSuperfluidDelegations_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegationAmount",
+			Handler:    _Query_SuperfluidDelegationAmount_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegationsByDelegator",
+			Handler:    _Query_SuperfluidDelegationsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidUndelegationsByDelegator",
+			Handler:    _Query_SuperfluidUndelegationsByDelegator_Handler,
+		},
+		{
+			MethodName: "SuperfluidDelegationsByValidatorDenom",
+			Handler:    _Query_SuperfluidDelegationsByValidatorDenom_Handler,
+		},
+		{
+			MethodName: "EstimateSuperfluidDelegatedAmountByValidatorDenom",
+			Handler:    _Query_EstimateSuperfluidDelegatedAmountByValidatorDenom_Handler,
+		},
+		{
+			MethodName: "TotalDelegationByDelegator",
+			Handler:    _Query_TotalDelegationByDelegator_Handler,
+		},
+		{
+			MethodName: "UnpoolWhitelist",
+			Handler:    _Query_UnpoolWhitelist_Handler,
+		},
+		{
+			MethodName: "UserConcentratedSuperfluidPositionsDelegated",
+			Handler:    _Query_UserConcentratedSuperfluidPositionsDelegated_Handler,
+		},
+		{
+			MethodName: "UserConcentratedSuperfluidPositionsUndelegating",
+			Handler:    _Query_UserConcentratedSuperfluidPositionsUndelegating_Handler,
+		},
+		{
+			MethodName: "RestSupply",
+			Handler:    _Query_RestSupply_Handler,
+		},
+		{
+			MethodName: "IntermediaryAccountSlashes",
+			Handler:    _Query_IntermediaryAccountSlashes_Handler,
+		},
+		{
+			MethodName: "TotalSuperfluidStakeByAsset",
+			Handler:    _Query_TotalSuperfluidStakeByAsset_Handler,
+		},
+		{
+			MethodName: "TotalSuperfluidStakeByValidator",
+			Handler:    _Query_TotalSuperfluidStakeByValidator_Handler,
+		},
+		{
+			MethodName: "AutoCompoundLock",
+			Handler:    _Query_AutoCompoundLock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/superfluid/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OsmoEquivalentMultiplier != nil {
-		{
-			size, err := m.OsmoEquivalentMultiplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3023,46 +3448,30 @@ func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.GaugeId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ValAddr) > 0 {
-		i -= len(m.ValAddr)
-		copy(dAtA[i:], m.ValAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *AllIntermediaryAccountsRequest) Marshal() (dAtA []byte, err error) {
+func (m *AssetTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3072,32 +3481,27 @@ func (m *AllIntermediaryAccountsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllIntermediaryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetTypeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllIntermediaryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllIntermediaryAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssetTypeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3107,46 +3511,25 @@ func (m *AllIntermediaryAccountsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllIntermediaryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetTypeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllIntermediaryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.AssetType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AssetType))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConnectedIntermediaryAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllAssetsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3156,25 +3539,20 @@ func (m *ConnectedIntermediaryAccountRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *ConnectedIntermediaryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllAssetsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConnectedIntermediaryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllAssetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LockId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConnectedIntermediaryAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllAssetsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3184,32 +3562,34 @@ func (m *ConnectedIntermediaryAccountResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *ConnectedIntermediaryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllAssetsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConnectedIntermediaryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllAssetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalDelegationByValidatorForDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *AssetMultiplierRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3219,12 +3599,12 @@ func (m *QueryTotalDelegationByValidatorForDenomRequest) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalDelegationByValidatorForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetMultiplierRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalDelegationByValidatorForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetMultiplierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3239,7 +3619,7 @@ func (m *QueryTotalDelegationByValidatorForDenomRequest) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalDelegationByValidatorForDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *AssetMultiplierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3249,34 +3629,32 @@ func (m *QueryTotalDelegationByValidatorForDenomResponse) Marshal() (dAtA []byte
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalDelegationByValidatorForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AssetMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalDelegationByValidatorForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AssetMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Assets) > 0 {
-		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.OsmoEquivalentMultiplier != nil {
+		{
+			size, err := m.OsmoEquivalentMultiplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Delegations) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidIntermediaryAccountInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3286,47 +3664,46 @@ func (m *Delegations) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Delegations) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidIntermediaryAccountInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Delegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidIntermediaryAccountInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.OsmoEquivalent.Size()
-		i -= size
-		if _, err := m.OsmoEquivalent.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.AmountSfsd.Size()
-		i -= size
-		if _, err := m.AmountSfsd.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.GaugeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.ValAddr) > 0 {
 		i -= len(m.ValAddr)
 		copy(dAtA[i:], m.ValAddr)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalSuperfluidDelegationsRequest) Marshal() (dAtA []byte, err error) {
+func (m *AllIntermediaryAccountsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3336,20 +3713,32 @@ func (m *TotalSuperfluidDelegationsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TotalSuperfluidDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllIntermediaryAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalSuperfluidDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllIntermediaryAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TotalSuperfluidDelegationsResponse) Marshal() (dAtA []byte, err error) {
+func (m *AllIntermediaryAccountsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3359,30 +3748,46 @@ func (m *TotalSuperfluidDelegationsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *TotalSuperfluidDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AllIntermediaryAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TotalSuperfluidDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AllIntermediaryAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.TotalDelegations.Size()
-		i -= size
-		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationAmountRequest) Marshal() (dAtA []byte, err error) {
+func (m *ConnectedIntermediaryAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3392,41 +3797,25 @@ func (m *SuperfluidDelegationAmountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConnectedIntermediaryAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConnectedIntermediaryAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationAmountResponse) Marshal() (dAtA []byte, err error) {
+func (m *ConnectedIntermediaryAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3436,34 +3825,32 @@ func (m *SuperfluidDelegationAmountResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConnectedIntermediaryAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConnectedIntermediaryAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalDelegationByValidatorForDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3473,27 +3860,27 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalDelegationByValidatorForDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalDelegationByValidatorForDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTotalDelegationByValidatorForDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3503,44 +3890,20 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTotalDelegationByValidatorForDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTotalDelegationByValidatorForDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TotalEquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TotalDelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3554,7 +3917,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *Delegations) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3564,99 +3927,103 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Delegations) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Delegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.OsmoEquivalent.Size()
+		i -= size
+		if _, err := m.OsmoEquivalent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SuperfluidUndelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AmountSfsd.Size()
+		i -= size
+		if _, err := m.AmountSfsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalSuperfluidDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *TotalSuperfluidDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TotalSuperfluidDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SyntheticLocks) > 0 {
-		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SyntheticLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TotalUndelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalUndelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalUndelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalSuperfluidDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalDelegations.Size()
+		i -= size
+		if _, err := m.TotalDelegations.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidDelegationAmountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3666,12 +4033,12 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, e
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationAmountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3681,19 +4048,26 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA
 		copy(dAtA[i:], m.Denom)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidDelegationAmountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3703,20 +4077,20 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationAmountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.SuperfluidDelegationRecords) > 0 {
-		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3730,7 +4104,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidDelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3740,34 +4114,27 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dA
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidDelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3777,16 +4144,26 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (d
 	return dAtA[:n], nil
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TotalEquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.TotalDelegatedCoins) > 0 {
 		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3798,13 +4175,27 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSiz
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
 			dAtA[i] = 0xa
 		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalDelegationByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidUndelegationsByDelegatorRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3814,16 +4205,23 @@ func (m *QueryTotalDelegationByDelegatorRequest) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalDelegationByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalDelegationByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidUndelegationsByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.DelegatorAddress) > 0 {
 		i -= len(m.DelegatorAddress)
 		copy(dAtA[i:], m.DelegatorAddress)
@@ -3834,7 +4232,7 @@ func (m *QueryTotalDelegationByDelegatorRequest) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalDelegationByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidUndelegationsByDelegatorResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3844,30 +4242,20 @@ func (m *QueryTotalDelegationByDelegatorResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalDelegationByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalDelegationByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidUndelegationsByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.TotalEquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.TotalDelegatedCoins) > 0 {
-		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SyntheticLocks) > 0 {
+		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SyntheticLocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3878,10 +4266,10 @@ func (m *QueryTotalDelegationByDelegatorResponse) MarshalToSizedBuffer(dAtA []by
 			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.DelegationResponse) > 0 {
-		for iNdEx := len(m.DelegationResponse) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.TotalUndelegatedCoins) > 0 {
+		for iNdEx := len(m.TotalUndelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.DelegationResponse[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.TotalUndelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3909,7 +4297,7 @@ func (m *QueryTotalDelegationByDelegatorResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnpoolWhitelistRequest) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3919,20 +4307,34 @@ func (m *QueryUnpoolWhitelistRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUnpoolWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUnpoolWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryUnpoolWhitelistResponse) Marshal() (dAtA []byte, err error) {
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3942,28 +4344,267 @@ func (m *QueryUnpoolWhitelistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryUnpoolWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryUnpoolWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SuperfluidDelegationsByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PoolIds) > 0 {
-		dAtA9 := make([]byte, len(m.PoolIds)*10)
-		var j8 int
-		for _, num := range m.PoolIds {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalDelegatedCoins) > 0 {
+		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalDelegationByDelegatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalDelegationByDelegatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalDelegationByDelegatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalDelegationByDelegatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalDelegationByDelegatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalDelegationByDelegatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalEquivalentStakedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TotalDelegatedCoins) > 0 {
+		for iNdEx := len(m.TotalDelegatedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegatedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DelegationResponse) > 0 {
+		for iNdEx := len(m.DelegationResponse) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationResponse[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SuperfluidDelegationRecords) > 0 {
+		for iNdEx := len(m.SuperfluidDelegationRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuperfluidDelegationRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnpoolWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnpoolWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnpoolWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnpoolWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnpoolWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnpoolWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA9 := make([]byte, len(m.PoolIds)*10)
+		var j8 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
 		i -= j8
 		copy(dAtA[i:], dAtA9[:j8])
 		i = encodeVarintQuery(dAtA, i, uint64(j8))
@@ -4170,157 +4811,509 @@ func (m *QueryRestSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IntermediaryAccountSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *IntermediaryAccountSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AssetTypeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IntermediaryAccountSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.IntermediaryAccount) > 0 {
+		i -= len(m.IntermediaryAccount)
+		copy(dAtA[i:], m.IntermediaryAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IntermediaryAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetTypeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IntermediaryAccountSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IntermediaryAccountSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntermediaryAccountSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AssetType != 0 {
-		n += 1 + sovQuery(uint64(m.AssetType))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AllAssetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalSuperfluidStakeByAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidStakeByAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidStakeByAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllAssetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalSuperfluidStakeByAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidStakeByAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidStakeByAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Assets) > 0 {
-		for _, e := range m.Assets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AssetMultiplierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SuperfluidStakeByAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AssetMultiplierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.OsmoEquivalentMultiplier != nil {
-		l = m.OsmoEquivalentMultiplier.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SuperfluidStakeByAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SuperfluidStakeByAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ValAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GaugeId != 0 {
-		n += 1 + sovQuery(uint64(m.GaugeId))
+	{
+		size := m.OsmoEquivalent.Size()
+		i -= size
+		if _, err := m.OsmoEquivalent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllIntermediaryAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalSuperfluidStakeByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidStakeByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidStakeByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllIntermediaryAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalSuperfluidStakeByValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalSuperfluidStakeByValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalSuperfluidStakeByValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for _, e := range m.Accounts {
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuperfluidStakeByValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperfluidStakeByValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuperfluidStakeByValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OsmoEquivalent.Size()
+		i -= size
+		if _, err := m.OsmoEquivalent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundLockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundLockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundLockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoCompoundLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AssetTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AssetType != 0 {
+		n += 1 + sovQuery(uint64(m.AssetType))
+	}
+	return n
+}
+
+func (m *AllAssetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AllAssetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AssetMultiplierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OsmoEquivalentMultiplier != nil {
+		l = m.OsmoEquivalentMultiplier.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperfluidIntermediaryAccountInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovQuery(uint64(m.GaugeId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllIntermediaryAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AllIntermediaryAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -4722,40 +5715,1135 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) Size() (n int)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryRestSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *QueryRestSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRestSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *IntermediaryAccountSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IntermediaryAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *IntermediaryAccountSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TotalSuperfluidStakeByAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TotalSuperfluidStakeByAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidStakeByAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.OsmoEquivalent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TotalSuperfluidStakeByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TotalSuperfluidStakeByValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SuperfluidStakeByValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.OsmoEquivalent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *AutoCompoundLockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovQuery(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *AutoCompoundLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllAssetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllAssetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, SuperfluidAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OsmoEquivalentMultiplier == nil {
+				m.OsmoEquivalentMultiplier = &OsmoEquivalentMultiplierRecord{}
+			}
+			if err := m.OsmoEquivalentMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, SuperfluidIntermediaryAccountInfo{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryRestSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4778,12 +6866,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4805,7 +6912,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4828,15 +6935,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4863,7 +6970,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Account == nil {
+				m.Account = &SuperfluidIntermediaryAccountInfo{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4888,7 +6998,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalDelegationByValidatorForDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4911,10 +7021,10 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetTypeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4970,7 +7080,7 @@ func (m *AssetTypeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalDelegationByValidatorForDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4993,17 +7103,17 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetTypeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			m.AssetType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5013,11 +7123,26 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AssetType |= SuperfluidAssetType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, Delegations{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5039,7 +7164,7 @@ func (m *AssetTypeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
+func (m *Delegations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5062,67 +7187,83 @@ func (m *AllAssetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllAssetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllAssetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountSfsd", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllAssetsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllAssetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountSfsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5132,23 +7273,23 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, SuperfluidAsset{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OsmoEquivalent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5173,7 +7314,7 @@ func (m *AllAssetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
+func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5196,44 +7337,12 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetMultiplierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetMultiplierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5255,7 +7364,7 @@ func (m *AssetMultiplierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5278,17 +7387,17 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssetMultiplierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssetMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalentMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5298,25 +7407,23 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OsmoEquivalentMultiplier == nil {
-				m.OsmoEquivalentMultiplier = &OsmoEquivalentMultiplierRecord{}
-			}
-			if err := m.OsmoEquivalentMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5341,7 +7448,7 @@ func (m *AssetMultiplierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5364,15 +7471,15 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidIntermediaryAccountInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5400,11 +7507,11 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5432,30 +7539,11 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
-			}
-			m.GaugeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GaugeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5483,7 +7571,7 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5506,7 +7594,7 @@ func (m *SuperfluidIntermediaryAccountInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5529,15 +7617,15 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5564,10 +7652,8 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5592,7 +7678,7 @@ func (m *AllIntermediaryAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5615,51 +7701,17 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllIntermediaryAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Accounts = append(m.Accounts, SuperfluidIntermediaryAccountInfo{})
-			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5669,27 +7721,23 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5712,7 +7760,7 @@ func (m *AllIntermediaryAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5735,17 +7783,17 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			m.LockId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5755,64 +7803,63 @@ func (m *ConnectedIntermediaryAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LockId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
+			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectedIntermediaryAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEquivalentStakedAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5839,10 +7886,7 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &SuperfluidIntermediaryAccountInfo{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalEquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5867,7 +7911,7 @@ func (m *ConnectedIntermediaryAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalDelegationByValidatorForDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5890,15 +7934,15 @@ func (m *QueryTotalDelegationByValidatorForDenomRequest) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5926,63 +7970,13 @@ func (m *QueryTotalDelegationByValidatorForDenomRequest) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalDelegationByValidatorForDenomResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDelegationByValidatorForDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5992,25 +7986,23 @@ func (m *QueryTotalDelegationByValidatorForDenomResponse) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assets = append(m.Assets, Delegations{})
-			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6033,7 +8025,7 @@ func (m *QueryTotalDelegationByValidatorForDenomResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *Delegations) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6056,17 +8048,17 @@ func (m *Delegations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegations: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6076,29 +8068,31 @@ func (m *Delegations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountSfsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegatedCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6108,31 +8102,31 @@ func (m *Delegations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountSfsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalUndelegatedCoins = append(m.TotalUndelegatedCoins, types.Coin{})
+			if err := m.TotalUndelegatedCoins[len(m.TotalUndelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6142,23 +8136,23 @@ func (m *Delegations) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OsmoEquivalent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SyntheticLocks = append(m.SyntheticLocks, types1.SyntheticLock{})
+			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6183,7 +8177,7 @@ func (m *Delegations) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6206,12 +8200,76 @@ func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6233,7 +8291,7 @@ func (m *TotalSuperfluidDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6256,17 +8314,17 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalSuperfluidDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6276,23 +8334,23 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalDelegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
+			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6317,7 +8375,7 @@ func (m *TotalSuperfluidDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6340,45 +8398,13 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -6410,7 +8436,7 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -6463,7 +8489,7 @@ func (m *SuperfluidDelegationAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6486,15 +8512,15 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6521,8 +8547,8 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
+			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6547,7 +8573,7 @@ func (m *SuperfluidDelegationAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalDelegationByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6570,10 +8596,10 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6629,7 +8655,7 @@ func (m *SuperfluidDelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6652,10 +8678,10 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6693,6 +8719,40 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationResponse = append(m.DelegationResponse, types2.DelegationResponse{})
+			if err := m.DelegationResponse[len(m.DelegationResponse)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
 			}
@@ -6726,7 +8786,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TotalEquivalentStakedAmount", wireType)
 			}
@@ -6780,7 +8840,7 @@ func (m *SuperfluidDelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUnpoolWhitelistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6803,76 +8863,138 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnpoolWhitelistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnpoolWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnpoolWhitelistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnpoolWhitelistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnpoolWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6894,7 +9016,7 @@ func (m *SuperfluidUndelegationsByDelegatorRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6917,85 +9039,17 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidUndelegationsByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalUndelegatedCoins", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalUndelegatedCoins = append(m.TotalUndelegatedCoins, types.Coin{})
-			if err := m.TotalUndelegatedCoins[len(m.TotalUndelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyntheticLocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7005,25 +9059,23 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SyntheticLocks = append(m.SyntheticLocks, types1.SyntheticLock{})
-			if err := m.SyntheticLocks[len(m.SyntheticLocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7046,7 +9098,7 @@ func (m *SuperfluidUndelegationsByDelegatorResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7069,17 +9121,17 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClPoolUserPositionRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7089,55 +9141,25 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ClPoolUserPositionRecords = append(m.ClPoolUserPositionRecords, ConcentratedPoolUserPositionRecord{})
+			if err := m.ClPoolUserPositionRecords[len(m.ClPoolUserPositionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7160,7 +9182,7 @@ func (m *SuperfluidDelegationsByValidatorDenomRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *UserConcentratedSuperfluidPositionsUndelegatingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7183,17 +9205,17 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperfluidDelegationsByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7203,25 +9225,23 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7244,7 +9264,7 @@ func (m *SuperfluidDelegationsByValidatorDenomResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7267,17 +9287,17 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClPoolUserPositionRecords", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7287,55 +9307,25 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ClPoolUserPositionRecords = append(m.ClPoolUserPositionRecords, ConcentratedPoolUserPositionRecord{})
+			if err := m.ClPoolUserPositionRecords[len(m.ClPoolUserPositionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7358,7 +9348,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomRequest) Unmarshal(dAt
 	}
 	return nil
 }
-func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRestSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7381,17 +9371,17 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dA
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRestSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EstimateSuperfluidDelegatedAmountByValidatorDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRestSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7401,25 +9391,23 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dA
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
-			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7442,7 +9430,7 @@ func (m *EstimateSuperfluidDelegatedAmountByValidatorDenomResponse) Unmarshal(dA
 	}
 	return nil
 }
-func (m *QueryTotalDelegationByDelegatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRestSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7465,17 +9453,17 @@ func (m *QueryTotalDelegationByDelegatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRestSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRestSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7485,23 +9473,24 @@ func (m *QueryTotalDelegationByDelegatorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7524,7 +9513,7 @@ func (m *QueryTotalDelegationByDelegatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
+func (m *IntermediaryAccountSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7547,17 +9536,17 @@ func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IntermediaryAccountSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalDelegationByDelegatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IntermediaryAccountSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperfluidDelegationRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7567,29 +9556,27 @@ func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuperfluidDelegationRecords = append(m.SuperfluidDelegationRecords, SuperfluidDelegationRecord{})
-			if err := m.SuperfluidDelegationRecords[len(m.SuperfluidDelegationRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IntermediaryAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegationResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7616,14 +9603,67 @@ func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegationResponse = append(m.DelegationResponse, types2.DelegationResponse{})
-			if err := m.DelegationResponse[len(m.DelegationResponse)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IntermediaryAccountSlashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntermediaryAccountSlashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntermediaryAccountSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7650,14 +9690,14 @@ func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatedCoins = append(m.TotalDelegatedCoins, types.Coin{})
-			if err := m.TotalDelegatedCoins[len(m.TotalDelegatedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Slashes = append(m.Slashes, IntermediaryAccountSlash{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalEquivalentStakedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7684,7 +9724,10 @@ func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalEquivalentStakedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7709,161 +9752,36 @@ func (m *QueryTotalDelegationByDelegatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUnpoolWhitelistRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnpoolWhitelistRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnpoolWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryUnpoolWhitelistResponse) Unmarshal(dAtA []byte) error {
+func (m *TotalSuperfluidStakeByAssetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUnpoolWhitelistResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUnpoolWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PoolIds = append(m.PoolIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PoolIds) == 0 {
-					m.PoolIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PoolIds = append(m.PoolIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalSuperfluidStakeByAssetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalSuperfluidStakeByAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7885,7 +9803,8 @@ func (m *QueryUnpoolWhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) Unmarshal(dAtA []byte) error {
+
+func (m *TotalSuperfluidStakeByAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7908,17 +9827,17 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) Unmarshal(dAtA []b
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSuperfluidStakeByAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSuperfluidStakeByAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7928,23 +9847,25 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) Unmarshal(dAtA []b
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Assets = append(m.Assets, SuperfluidStakeByAsset{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7967,7 +9888,8 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedRequest) Unmarshal(dAtA []b
 	}
 	return nil
 }
-func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) Unmarshal(dAtA []byte) error {
+
+func (m *SuperfluidStakeByAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7990,17 +9912,17 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) Unmarshal(dAtA []
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidStakeByAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsDelegatedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidStakeByAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClPoolUserPositionRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8010,23 +9932,55 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) Unmarshal(dAtA []
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClPoolUserPositionRecords = append(m.ClPoolUserPositionRecords, ConcentratedPoolUserPositionRecord{})
-			if err := m.ClPoolUserPositionRecords[len(m.ClPoolUserPositionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoEquivalent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8051,7 +10005,8 @@ func (m *UserConcentratedSuperfluidPositionsDelegatedResponse) Unmarshal(dAtA []
 	}
 	return nil
 }
-func (m *UserConcentratedSuperfluidPositionsUndelegatingRequest) Unmarshal(dAtA []byte) error {
+
+func (m *TotalSuperfluidStakeByValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8074,44 +10029,12 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingRequest) Unmarshal(dAtA
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSuperfluidStakeByValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSuperfluidStakeByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8133,7 +10056,8 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingRequest) Unmarshal(dAtA
 	}
 	return nil
 }
-func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) Unmarshal(dAtA []byte) error {
+
+func (m *TotalSuperfluidStakeByValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8156,15 +10080,15 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) Unmarshal(dAtA
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TotalSuperfluidStakeByValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserConcentratedSuperfluidPositionsUndelegatingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TotalSuperfluidStakeByValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClPoolUserPositionRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8191,8 +10115,8 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) Unmarshal(dAtA
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClPoolUserPositionRecords = append(m.ClPoolUserPositionRecords, ConcentratedPoolUserPositionRecord{})
-			if err := m.ClPoolUserPositionRecords[len(m.ClPoolUserPositionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, SuperfluidStakeByValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8217,7 +10141,8 @@ func (m *UserConcentratedSuperfluidPositionsUndelegatingResponse) Unmarshal(dAtA
 	}
 	return nil
 }
-func (m *QueryRestSupplyRequest) Unmarshal(dAtA []byte) error {
+
+func (m *SuperfluidStakeByValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8240,15 +10165,15 @@ func (m *QueryRestSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRestSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SuperfluidStakeByValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRestSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SuperfluidStakeByValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8276,7 +10201,41 @@ func (m *QueryRestSupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsmoEquivalent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OsmoEquivalent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8299,7 +10258,8 @@ func (m *QueryRestSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRestSupplyResponse) Unmarshal(dAtA []byte) error {
+
+func (m *AutoCompoundLockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8322,17 +10282,17 @@ func (m *QueryRestSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRestSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoCompoundLockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRestSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoCompoundLockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
 			}
-			var msglen int
+			m.LockId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8342,25 +10302,82 @@ func (m *QueryRestSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LockId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AutoCompoundLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8382,6 +10399,7 @@ func (m *QueryRestSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
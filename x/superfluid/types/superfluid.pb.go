@@ -9,18 +9,21 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/osmosis-labs/osmosis/v21/x/lockup/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -478,6 +481,161 @@ func (m *ConcentratedPoolUserPositionRecord) GetEquivalentStakedAmount() *types.
 	return nil
 }
 
+// LockSlash is the amount slashed from a single lock superfluid delegated
+// through an intermediary account.
+type LockSlash struct {
+	LockId uint64 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	// slashed_coins are the locked coins slashed from the lock.
+	SlashedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=slashed_coins,json=slashedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed_coins"`
+	// slashed_underlying_coins are the coins of the concentrated liquidity
+	// position backing the lock that were slashed along with the locked shares.
+	// Empty for locks that are not concentrated liquidity shares.
+	SlashedUnderlyingCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=slashed_underlying_coins,json=slashedUnderlyingCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"slashed_underlying_coins"`
+}
+
+func (m *LockSlash) Reset()         { *m = LockSlash{} }
+func (m *LockSlash) String() string { return proto.CompactTextString(m) }
+func (*LockSlash) ProtoMessage()    {}
+func (*LockSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{7}
+}
+func (m *LockSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockSlash.Merge(m, src)
+}
+func (m *LockSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockSlash proto.InternalMessageInfo
+
+func (m *LockSlash) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *LockSlash) GetSlashedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SlashedCoins
+	}
+	return nil
+}
+
+func (m *LockSlash) GetSlashedUnderlyingCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SlashedUnderlyingCoins
+	}
+	return nil
+}
+
+// IntermediaryAccountSlash records a slash of the locks superfluid delegated
+// through an intermediary account, applied when its validator got slashed.
+type IntermediaryAccountSlash struct {
+	Id                  uint64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	IntermediaryAccount string                      `protobuf:"bytes,2,opt,name=intermediary_account,json=intermediaryAccount,proto3" json:"intermediary_account,omitempty"`
+	ValAddr             string                      `protobuf:"bytes,3,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty"`
+	Height              int64                       `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Time                time.Time                   `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	SlashFactor         cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_factor,json=slashFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_factor"`
+	// lock_slashes are the amounts slashed from each of the locks, in ascending
+	// order of lock id.
+	LockSlashes []LockSlash `protobuf:"bytes,7,rep,name=lock_slashes,json=lockSlashes,proto3" json:"lock_slashes"`
+}
+
+func (m *IntermediaryAccountSlash) Reset()         { *m = IntermediaryAccountSlash{} }
+func (m *IntermediaryAccountSlash) String() string { return proto.CompactTextString(m) }
+func (*IntermediaryAccountSlash) ProtoMessage()    {}
+func (*IntermediaryAccountSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_79d3c29d82dbb734, []int{8}
+}
+func (m *IntermediaryAccountSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntermediaryAccountSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntermediaryAccountSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntermediaryAccountSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediaryAccountSlash.Merge(m, src)
+}
+func (m *IntermediaryAccountSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntermediaryAccountSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediaryAccountSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntermediaryAccountSlash proto.InternalMessageInfo
+
+func (m *IntermediaryAccountSlash) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *IntermediaryAccountSlash) GetIntermediaryAccount() string {
+	if m != nil {
+		return m.IntermediaryAccount
+	}
+	return ""
+}
+
+func (m *IntermediaryAccountSlash) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+func (m *IntermediaryAccountSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IntermediaryAccountSlash) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *IntermediaryAccountSlash) GetLockSlashes() []LockSlash {
+	if m != nil {
+		return m.LockSlashes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.superfluid.SuperfluidAssetType", SuperfluidAssetType_name, SuperfluidAssetType_value)
 	proto.RegisterType((*SuperfluidAsset)(nil), "osmosis.superfluid.SuperfluidAsset")
@@ -487,6 +645,8 @@ func init() {
 	proto.RegisterType((*LockIdIntermediaryAccountConnection)(nil), "osmosis.superfluid.LockIdIntermediaryAccountConnection")
 	proto.RegisterType((*UnpoolWhitelistedPools)(nil), "osmosis.superfluid.UnpoolWhitelistedPools")
 	proto.RegisterType((*ConcentratedPoolUserPositionRecord)(nil), "osmosis.superfluid.ConcentratedPoolUserPositionRecord")
+	proto.RegisterType((*LockSlash)(nil), "osmosis.superfluid.LockSlash")
+	proto.RegisterType((*IntermediaryAccountSlash)(nil), "osmosis.superfluid.IntermediaryAccountSlash")
 }
 
 func init() {
@@ -494,59 +654,70 @@ func init() {
 }
 
 var fileDescriptor_79d3c29d82dbb734 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0x6e, 0xd2, 0x4c, 0xa0, 0xb8, 0xdb, 0xa8, 0x24, 0x41, 0xd9, 0x0d, 0x5b, 0xa4,
-	0x5a, 0xad, 0xba, 0xab, 0x04, 0x09, 0xa1, 0xde, 0x9c, 0x14, 0x24, 0xa3, 0x50, 0xac, 0x35, 0x15,
-	0x88, 0xcb, 0x6a, 0xbc, 0xf3, 0xba, 0x1e, 0x79, 0x76, 0x67, 0xbb, 0x33, 0x6b, 0xf0, 0x8d, 0x03,
-	0x87, 0x1e, 0xf9, 0x13, 0x2a, 0x71, 0xe3, 0xca, 0x3f, 0xd1, 0x63, 0x25, 0x2e, 0x88, 0x43, 0x40,
-	0xc9, 0x85, 0x73, 0xff, 0x02, 0x34, 0xb3, 0x3f, 0xbc, 0x69, 0x1c, 0x21, 0x2e, 0x70, 0xf2, 0xcc,
-	0xfb, 0xde, 0xbc, 0xf7, 0x7d, 0xf3, 0x3e, 0xcf, 0xa2, 0x3b, 0x5c, 0xc4, 0x5c, 0x50, 0xe1, 0x89,
-	0x3c, 0x85, 0xec, 0x29, 0xcb, 0x29, 0x69, 0x2c, 0xdd, 0x34, 0xe3, 0x92, 0x9b, 0x66, 0x99, 0xe4,
-	0x2e, 0x91, 0xdd, 0xad, 0x88, 0x47, 0x5c, 0xc3, 0x9e, 0x5a, 0x15, 0x99, 0xbb, 0x56, 0xc4, 0x79,
-	0xc4, 0xc0, 0xd3, 0xbb, 0x49, 0xfe, 0xd4, 0x23, 0x79, 0x86, 0x25, 0xe5, 0x49, 0x89, 0xdb, 0x6f,
-	0xe2, 0x92, 0xc6, 0x20, 0x24, 0x8e, 0xd3, 0xaa, 0x40, 0xa8, 0x7b, 0x79, 0x13, 0x2c, 0xc0, 0x9b,
-	0x1f, 0x4c, 0x40, 0xe2, 0x03, 0x2f, 0xe4, 0xb4, 0x2a, 0xb0, 0x53, 0xf1, 0x65, 0x3c, 0x9c, 0xe5,
-	0xa9, 0xfe, 0x29, 0x20, 0x67, 0x81, 0xde, 0x19, 0xd7, 0xfc, 0x06, 0x42, 0x80, 0x34, 0xb7, 0xd0,
-	0x35, 0x02, 0x09, 0x8f, 0xb7, 0x8d, 0x7d, 0xa3, 0xbf, 0xe1, 0x17, 0x1b, 0xf3, 0x53, 0x84, 0xb0,
-	0x82, 0x03, 0xb9, 0x48, 0x61, 0xbb, 0xbd, 0x6f, 0xf4, 0x6f, 0x1c, 0xde, 0x75, 0x2f, 0x6b, 0x74,
-	0xdf, 0x28, 0xf7, 0xe5, 0x22, 0x05, 0x7f, 0x03, 0x57, 0xcb, 0x87, 0xd7, 0x9f, 0xbf, 0xb0, 0x5b,
-	0x7f, 0xbd, 0xb0, 0x0d, 0x67, 0x86, 0xf6, 0x96, 0xb9, 0xc3, 0x44, 0x42, 0x16, 0x03, 0xa1, 0x38,
-	0x5b, 0x0c, 0xc2, 0x90, 0xe7, 0xc9, 0x55, 0x44, 0x76, 0xd0, 0xf5, 0x39, 0x66, 0x01, 0x26, 0x24,
-	0xd3, 0x34, 0x36, 0xfc, 0xf5, 0x39, 0x66, 0x03, 0x42, 0x32, 0x05, 0x45, 0x38, 0x8f, 0x20, 0xa0,
-	0x64, 0xbb, 0xb3, 0x6f, 0xf4, 0xbb, 0xfe, 0xba, 0xde, 0x0f, 0x89, 0xf3, 0x8b, 0x81, 0xac, 0x2f,
-	0x44, 0xcc, 0x3f, 0x79, 0x96, 0xd3, 0x39, 0x66, 0x90, 0xc8, 0xcf, 0x73, 0x26, 0x69, 0xca, 0x28,
-	0x64, 0x3e, 0x84, 0x3c, 0x23, 0xe6, 0xfb, 0xe8, 0x2d, 0x48, 0x79, 0x38, 0x0d, 0x92, 0x3c, 0x9e,
-	0x40, 0xa6, 0xbb, 0x76, 0xfc, 0x4d, 0x1d, 0x7b, 0xac, 0x43, 0x4b, 0x46, 0xed, 0x26, 0xa3, 0xaf,
-	0x11, 0x8a, 0xeb, 0x62, 0xba, 0xf1, 0xc6, 0xd1, 0xc7, 0x2f, 0x4f, 0xed, 0xd6, 0xef, 0xa7, 0xf6,
-	0x7b, 0xc5, 0x68, 0x04, 0x99, 0xb9, 0x94, 0x7b, 0x31, 0x96, 0x53, 0xf7, 0x04, 0x22, 0x1c, 0x2e,
-	0x1e, 0x41, 0xf8, 0xfa, 0xd4, 0xbe, 0xb9, 0xc0, 0x31, 0x7b, 0xe8, 0x2c, 0x8f, 0x3b, 0x7e, 0xa3,
-	0x96, 0xf3, 0xba, 0x8d, 0x76, 0x97, 0x77, 0xf4, 0x08, 0x18, 0x44, 0xda, 0x18, 0x25, 0xe3, 0xfb,
-	0xe8, 0x26, 0x29, 0x62, 0x3c, 0xd3, 0x17, 0x02, 0x42, 0x94, 0x97, 0xd5, 0xab, 0x81, 0x41, 0x11,
-	0x57, 0xc9, 0x73, 0xcc, 0x28, 0xb9, 0x90, 0x5c, 0xe8, 0xe8, 0xd5, 0x40, 0x95, 0xfc, 0x6d, 0x5d,
-	0x99, 0xf2, 0x24, 0xc0, 0xb1, 0x9a, 0x87, 0x56, 0xb6, 0x79, 0xb8, 0xe3, 0x16, 0x92, 0x5c, 0xe5,
-	0x36, 0xb7, 0x74, 0x9b, 0x7b, 0xcc, 0x69, 0x72, 0xe4, 0x29, 0xd1, 0x3f, 0xff, 0x61, 0xdf, 0x8d,
-	0xa8, 0x9c, 0xe6, 0x13, 0x37, 0xe4, 0xb1, 0x57, 0x5a, 0xb3, 0xf8, 0x79, 0x20, 0xc8, 0xcc, 0x53,
-	0x06, 0x12, 0xfa, 0x40, 0xcd, 0x92, 0xf2, 0x64, 0xa0, 0x7b, 0x98, 0xdf, 0x1b, 0x68, 0x1b, 0xea,
-	0x19, 0x05, 0x42, 0xe2, 0x19, 0x90, 0x8a, 0x40, 0xf7, 0x9f, 0x08, 0xdc, 0xff, 0x37, 0xcd, 0x6f,
-	0x2f, 0xfb, 0x8c, 0x75, 0x9b, 0x82, 0x82, 0xf3, 0x0c, 0xdd, 0x39, 0xe1, 0xe1, 0x6c, 0xb8, 0xca,
-	0x93, 0xc7, 0x3c, 0x49, 0x20, 0x54, 0x7c, 0xcd, 0x77, 0xd1, 0xba, 0xfa, 0x1f, 0x29, 0xaf, 0x19,
-	0xda, 0x6b, 0x6b, 0x4c, 0x9f, 0x32, 0x0f, 0xd0, 0x16, 0x6d, 0x9c, 0x0c, 0x70, 0x71, 0xb4, 0xbc,
-	0xeb, 0x5b, 0xf4, 0x72, 0x55, 0xe7, 0x1e, 0xba, 0xfd, 0x24, 0x49, 0x39, 0x67, 0x5f, 0x4d, 0xa9,
-	0x04, 0x46, 0x85, 0x04, 0x32, 0xe2, 0x9c, 0x09, 0xb3, 0x87, 0x3a, 0x94, 0xa8, 0xa1, 0x76, 0xfa,
-	0x5d, 0x5f, 0x2d, 0x9d, 0x5f, 0x3b, 0xc8, 0x39, 0xe6, 0x49, 0x08, 0x89, 0xcc, 0x70, 0x99, 0xf7,
-	0x44, 0x40, 0x36, 0xe2, 0x82, 0x5e, 0xf4, 0xc6, 0xe5, 0x71, 0x1b, 0x57, 0x8c, 0xdb, 0x46, 0x9b,
-	0x69, 0x79, 0x5c, 0xe9, 0x69, 0x6b, 0x3d, 0xa8, 0x0a, 0x0d, 0x49, 0x53, 0x6c, 0xe7, 0x82, 0xd8,
-	0xcf, 0xd0, 0x0d, 0xb1, 0x48, 0xe4, 0x14, 0x24, 0x0d, 0x03, 0x15, 0x2b, 0x87, 0xb4, 0x57, 0x3f,
-	0x0d, 0xc5, 0x9b, 0xe3, 0x8e, 0xab, 0x2c, 0x75, 0xb7, 0x47, 0x5d, 0xe5, 0x14, 0xff, 0x6d, 0xd1,
-	0x0c, 0xae, 0x36, 0xdd, 0xb5, 0xff, 0xdb, 0x74, 0x6b, 0xff, 0x85, 0xe9, 0xee, 0xfd, 0x60, 0xa0,
-	0x5b, 0x2b, 0x5e, 0x4e, 0x73, 0x0f, 0xed, 0xac, 0x08, 0x3f, 0xc6, 0x92, 0xce, 0xa1, 0xd7, 0x32,
-	0xad, 0xe6, 0xfb, 0x50, 0xc3, 0x27, 0xa3, 0xf1, 0x14, 0x67, 0xd0, 0x33, 0xcc, 0x3e, 0xfa, 0x60,
-	0x05, 0xde, 0xb4, 0x4f, 0x91, 0xd9, 0xde, 0xed, 0x3e, 0xff, 0xc9, 0x6a, 0x1d, 0x8d, 0x5e, 0x9e,
-	0x59, 0xc6, 0xab, 0x33, 0xcb, 0xf8, 0xf3, 0xcc, 0x32, 0x7e, 0x3c, 0xb7, 0x5a, 0xaf, 0xce, 0xad,
-	0xd6, 0x6f, 0xe7, 0x56, 0xeb, 0x9b, 0x8f, 0x1a, 0x0a, 0xcb, 0xd1, 0x3e, 0x60, 0x78, 0x22, 0xaa,
-	0x8d, 0x37, 0x3f, 0x3c, 0xf0, 0xbe, 0x6b, 0x7e, 0x11, 0xb5, 0xea, 0xc9, 0x9a, 0xfe, 0xce, 0x7c,
-	0xf8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x4c, 0xfb, 0xd1, 0x34, 0x07, 0x00, 0x00,
+	// 1000 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xc5, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0xaf, 0xb7, 0x49, 0x76, 0x36, 0x0d, 0x5b, 0x37, 0x0a, 0x9b, 0x45, 0x59, 0x53, 0x17,
+	0xa9, 0x51, 0xab, 0xda, 0x24, 0x48, 0xa8, 0xea, 0x6d, 0xb7, 0xa5, 0x52, 0x50, 0x28, 0x91, 0xb7,
+	0x11, 0x15, 0x17, 0x6b, 0xd6, 0x9e, 0xd8, 0xa3, 0xd8, 0x1e, 0xd7, 0x63, 0x07, 0xf6, 0xc6, 0x01,
+	0xa4, 0x1e, 0xfb, 0x0b, 0x50, 0x25, 0x6e, 0x5c, 0xf9, 0x13, 0x3d, 0x56, 0x42, 0x42, 0x88, 0x43,
+	0x8a, 0xe0, 0xc2, 0xb9, 0xbf, 0x80, 0xf9, 0xb0, 0xbd, 0xde, 0x66, 0x43, 0x01, 0x55, 0x70, 0xb0,
+	0x76, 0xde, 0xaf, 0x79, 0xbf, 0x9e, 0x77, 0xde, 0x05, 0x57, 0x09, 0x8d, 0x08, 0xc5, 0xd4, 0xa2,
+	0x79, 0x82, 0xd2, 0xa3, 0x30, 0xc7, 0x5e, 0xed, 0x68, 0x26, 0x29, 0xc9, 0x88, 0xa6, 0x15, 0x4a,
+	0xe6, 0x4c, 0xd2, 0x5f, 0xf7, 0x89, 0x4f, 0x84, 0xd8, 0xe2, 0x27, 0xa9, 0xd9, 0x1f, 0xf8, 0x84,
+	0xf8, 0x21, 0xb2, 0x04, 0x35, 0xc9, 0x8f, 0x2c, 0x2f, 0x4f, 0x61, 0x86, 0x49, 0x5c, 0xc8, 0xf5,
+	0x57, 0xe5, 0x19, 0x8e, 0x10, 0xcd, 0x60, 0x94, 0x94, 0x17, 0xb8, 0xc2, 0x97, 0x35, 0x81, 0x14,
+	0x59, 0x27, 0x3b, 0x13, 0x94, 0xc1, 0x1d, 0xcb, 0x25, 0xb8, 0xbc, 0x60, 0xb3, 0x8c, 0x37, 0x24,
+	0xee, 0x71, 0x9e, 0x88, 0x1f, 0x29, 0x32, 0xa6, 0xe0, 0xad, 0x71, 0x15, 0xdf, 0x90, 0x52, 0x94,
+	0x69, 0xeb, 0xe0, 0x82, 0x87, 0x62, 0x12, 0xf5, 0x94, 0x77, 0x95, 0xed, 0xb6, 0x2d, 0x09, 0xed,
+	0x1e, 0x00, 0x90, 0x8b, 0x9d, 0x6c, 0x9a, 0xa0, 0x5e, 0x93, 0x89, 0xd6, 0x76, 0xaf, 0x99, 0x67,
+	0x73, 0x34, 0x5f, 0xb9, 0xee, 0x01, 0x53, 0xb7, 0xdb, 0xb0, 0x3c, 0xde, 0x5e, 0x79, 0xfc, 0x54,
+	0x6f, 0xfc, 0xf1, 0x54, 0x57, 0x8c, 0x63, 0xb0, 0x35, 0xd3, 0xdd, 0x8b, 0x33, 0x94, 0x46, 0xc8,
+	0xc3, 0x30, 0x9d, 0x0e, 0x5d, 0x97, 0xe4, 0xf1, 0x79, 0x81, 0x6c, 0x82, 0x95, 0x13, 0x18, 0x3a,
+	0xd0, 0xf3, 0x52, 0x11, 0x46, 0xdb, 0x5e, 0x66, 0xf4, 0x90, 0x91, 0x5c, 0xe4, 0xc3, 0xdc, 0x47,
+	0x0e, 0xf6, 0x7a, 0x2a, 0x13, 0xb5, 0xec, 0x65, 0x41, 0xef, 0x79, 0xc6, 0x0f, 0x0a, 0x18, 0x7c,
+	0xca, 0x82, 0xfd, 0xe8, 0x51, 0x8e, 0x99, 0x3a, 0x8a, 0xb3, 0x4f, 0xf2, 0x30, 0xc3, 0x49, 0x88,
+	0x51, 0x6a, 0x23, 0x97, 0xa4, 0x9e, 0x76, 0x05, 0xac, 0xa2, 0x84, 0xb8, 0x81, 0x13, 0xe7, 0xd1,
+	0x04, 0xa5, 0xc2, 0xab, 0x6a, 0x77, 0x04, 0xef, 0xbe, 0x60, 0xcd, 0x22, 0x6a, 0xd6, 0x23, 0x7a,
+	0x08, 0x40, 0x54, 0x5d, 0x26, 0x1c, 0xb7, 0x47, 0xb7, 0x9e, 0x9d, 0xea, 0x8d, 0x5f, 0x4e, 0xf5,
+	0x77, 0x64, 0x6b, 0xa8, 0x77, 0x6c, 0x62, 0x62, 0x45, 0x30, 0x0b, 0xcc, 0x7d, 0xe4, 0x43, 0x77,
+	0x7a, 0x17, 0xb9, 0x2f, 0x4f, 0xf5, 0x4b, 0x53, 0x18, 0x85, 0xb7, 0x8d, 0x99, 0xb9, 0x61, 0xd7,
+	0xee, 0x32, 0x5e, 0x36, 0x41, 0x7f, 0x56, 0xa3, 0xbb, 0x28, 0x64, 0xa6, 0x1c, 0x18, 0x45, 0xc4,
+	0x37, 0xc0, 0x25, 0x4f, 0xf2, 0x48, 0x2a, 0x0a, 0x82, 0x28, 0x2d, 0x8a, 0xd5, 0xad, 0x04, 0x43,
+	0xc9, 0xe7, 0xca, 0x2c, 0x71, 0xec, 0xcd, 0x29, 0xcb, 0x3c, 0xba, 0x95, 0xa0, 0x54, 0xfe, 0xa2,
+	0xba, 0x99, 0x79, 0x73, 0x60, 0xc4, 0xfb, 0x21, 0x32, 0xeb, 0xec, 0x6e, 0x9a, 0x32, 0x25, 0x93,
+	0xa3, 0xcd, 0x2c, 0xd0, 0x66, 0xde, 0x61, 0x68, 0x1b, 0x59, 0x3c, 0xe9, 0xef, 0x5f, 0xe8, 0xd7,
+	0x7c, 0x9c, 0x05, 0xf9, 0x84, 0x29, 0x46, 0x56, 0x01, 0x4d, 0xf9, 0x73, 0x93, 0xd5, 0xc1, 0xe2,
+	0x00, 0xa2, 0xc2, 0xa0, 0x8a, 0x92, 0x39, 0x19, 0x0a, 0x1f, 0xda, 0x57, 0x0a, 0xe8, 0xa1, 0xaa,
+	0x47, 0x0e, 0x43, 0xf9, 0x31, 0xf2, 0xca, 0x00, 0x5a, 0xaf, 0x0b, 0xe0, 0xc6, 0x3f, 0x71, 0xbe,
+	0x31, 0xf3, 0x33, 0x16, 0x6e, 0x64, 0x08, 0xc6, 0x23, 0x70, 0x75, 0x9f, 0x0d, 0xc8, 0xde, 0x22,
+	0x4c, 0xde, 0x21, 0x71, 0x8c, 0x5c, 0x1e, 0xaf, 0xf6, 0x36, 0x58, 0xe6, 0x73, 0xc4, 0xb1, 0xa6,
+	0x08, 0xac, 0x2d, 0x85, 0xc2, 0x4a, 0xdb, 0x01, 0xeb, 0xb8, 0x66, 0xe9, 0x40, 0x69, 0x5a, 0xd4,
+	0xfa, 0x32, 0x3e, 0x7b, 0xab, 0x71, 0x1d, 0x6c, 0x1c, 0xc6, 0x09, 0x21, 0xe1, 0x67, 0x01, 0xce,
+	0x50, 0x88, 0x69, 0x86, 0xbc, 0x03, 0x46, 0x52, 0xad, 0x0b, 0x54, 0xec, 0xf1, 0xa6, 0xaa, 0xcc,
+	0x03, 0x3f, 0x1a, 0x3f, 0xaa, 0xc0, 0x60, 0x61, 0xb8, 0x2c, 0x6c, 0xf6, 0x4a, 0x48, 0xbd, 0x43,
+	0x8a, 0xd2, 0x03, 0x36, 0x88, 0xf3, 0xd8, 0x38, 0xdb, 0x6e, 0xe5, 0x9c, 0x76, 0xeb, 0xa0, 0x93,
+	0x14, 0xe6, 0x3c, 0x9f, 0xa6, 0xc8, 0x07, 0x94, 0x2c, 0x96, 0x53, 0x2d, 0x59, 0x75, 0x2e, 0xd9,
+	0x8f, 0xc1, 0x1a, 0x9d, 0xc6, 0x59, 0x80, 0x32, 0xec, 0x3a, 0x9c, 0x57, 0x34, 0x69, 0xab, 0x7a,
+	0x1a, 0xe4, 0x9b, 0x63, 0x8e, 0x4b, 0x2d, 0x5e, 0xdb, 0x51, 0x8b, 0x23, 0xc5, 0xbe, 0x48, 0xeb,
+	0xcc, 0xc5, 0xa0, 0xbb, 0xf0, 0x7f, 0x83, 0x6e, 0xe9, 0x3f, 0x01, 0xdd, 0xb7, 0x4d, 0xd0, 0xe6,
+	0x45, 0x18, 0x87, 0x90, 0x06, 0xe7, 0x63, 0x2b, 0x01, 0x17, 0x29, 0xd7, 0x60, 0xe1, 0xf1, 0xf7,
+	0x9d, 0x0f, 0xb0, 0xfa, 0xd7, 0xd1, 0xbd, 0x5f, 0x94, 0x67, 0xfb, 0x6f, 0x46, 0x48, 0xed, 0xd5,
+	0xc2, 0x83, 0xa0, 0xb4, 0x6f, 0x58, 0x6d, 0x4a, 0x97, 0x79, 0xec, 0xa1, 0x34, 0x9c, 0xe2, 0xd8,
+	0x2f, 0xbc, 0xab, 0x6f, 0xde, 0xfb, 0x46, 0xe1, 0xec, 0xb0, 0xf2, 0x25, 0xf8, 0xc6, 0x4f, 0x4d,
+	0xd0, 0x5b, 0x30, 0x90, 0xb2, 0x5e, 0x6b, 0xa0, 0x59, 0x95, 0x8a, 0x9d, 0xfe, 0xc5, 0x08, 0xce,
+	0xad, 0x15, 0x75, 0x7e, 0xad, 0x6c, 0x80, 0xa5, 0x00, 0x61, 0x3f, 0x90, 0x0f, 0x90, 0x6a, 0x17,
+	0x94, 0x76, 0x0b, 0xb4, 0xf8, 0x26, 0x2e, 0x20, 0xda, 0x37, 0xe5, 0x9a, 0x36, 0xcb, 0x35, 0x6d,
+	0x3e, 0x28, 0xd7, 0xf4, 0x68, 0x85, 0x97, 0xe1, 0xc9, 0x0b, 0x5d, 0xb1, 0x85, 0x85, 0x36, 0x02,
+	0xb2, 0xc8, 0xce, 0x11, 0x74, 0xd9, 0x18, 0x0a, 0x8c, 0xb5, 0x47, 0xfa, 0x6b, 0xf6, 0x85, 0xdd,
+	0x11, 0x46, 0xf7, 0x84, 0x0d, 0x5b, 0xc8, 0xab, 0x02, 0x23, 0xb2, 0x5e, 0xb4, 0xb7, 0x2c, 0x7a,
+	0xb1, 0xb5, 0x68, 0x25, 0x57, 0xc0, 0x2a, 0xe6, 0xae, 0x13, 0x96, 0x0c, 0x44, 0xaf, 0x7f, 0xad,
+	0x80, 0xcb, 0x0b, 0x76, 0xb6, 0xb6, 0x05, 0x36, 0x17, 0xb0, 0xef, 0xb3, 0xb9, 0x39, 0x41, 0xdd,
+	0x86, 0x36, 0xa8, 0x6f, 0xa6, 0x4a, 0xbc, 0x7f, 0x30, 0x0e, 0x60, 0x8a, 0xba, 0x8a, 0xb6, 0x0d,
+	0xde, 0x5b, 0x20, 0xaf, 0x3f, 0x5c, 0x52, 0xb3, 0xd9, 0x6f, 0x3d, 0xfe, 0x6e, 0xd0, 0x18, 0x1d,
+	0x3c, 0xfb, 0x6d, 0xa0, 0x3c, 0x67, 0xdf, 0xaf, 0xec, 0x7b, 0xf2, 0xfb, 0xa0, 0xf1, 0x9c, 0x7d,
+	0x3f, 0xb3, 0xef, 0xf3, 0x0f, 0x6b, 0xd8, 0x29, 0x92, 0xbb, 0x19, 0xc2, 0x09, 0x2d, 0x09, 0xeb,
+	0x64, 0x77, 0xc7, 0xfa, 0xb2, 0xfe, 0x5f, 0x4c, 0xe0, 0x69, 0xb2, 0x24, 0x1a, 0xf1, 0xc1, 0x9f,
+	0x8e, 0x87, 0x8d, 0x70, 0xae, 0x09, 0x00, 0x00,
 }
 
 func (this *SuperfluidAsset) Equal(that interface{}) bool {
@@ -905,6 +1076,141 @@ func (m *ConcentratedPoolUserPositionRecord) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *LockSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashedUnderlyingCoins) > 0 {
+		for iNdEx := len(m.SlashedUnderlyingCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedUnderlyingCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SlashedCoins) > 0 {
+		for iNdEx := len(m.SlashedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.LockId != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntermediaryAccountSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntermediaryAccountSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntermediaryAccountSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockSlashes) > 0 {
+		for iNdEx := len(m.LockSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.SlashFactor.Size()
+		i -= size
+		if _, err := m.SlashFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSuperfluid(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSuperfluid(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IntermediaryAccount) > 0 {
+		i -= len(m.IntermediaryAccount)
+		copy(dAtA[i:], m.IntermediaryAccount)
+		i = encodeVarintSuperfluid(dAtA, i, uint64(len(m.IntermediaryAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintSuperfluid(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSuperfluid(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSuperfluid(v)
 	base := offset
@@ -1052,6 +1358,63 @@ func (m *ConcentratedPoolUserPositionRecord) Size() (n int) {
 	return n
 }
 
+func (m *LockSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovSuperfluid(uint64(m.LockId))
+	}
+	if len(m.SlashedCoins) > 0 {
+		for _, e := range m.SlashedCoins {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	if len(m.SlashedUnderlyingCoins) > 0 {
+		for _, e := range m.SlashedUnderlyingCoins {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IntermediaryAccountSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovSuperfluid(uint64(m.Id))
+	}
+	l = len(m.IntermediaryAccount)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovSuperfluid(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSuperfluid(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSuperfluid(uint64(l))
+	l = m.SlashFactor.Size()
+	n += 1 + l + sovSuperfluid(uint64(l))
+	if len(m.LockSlashes) > 0 {
+		for _, e := range m.LockSlashes {
+			l = e.Size()
+			n += 1 + l + sovSuperfluid(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovSuperfluid(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2059,6 +2422,398 @@ func (m *ConcentratedPoolUserPositionRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LockSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedCoins = append(m.SlashedCoins, types.Coin{})
+			if err := m.SlashedCoins[len(m.SlashedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedUnderlyingCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashedUnderlyingCoins = append(m.SlashedUnderlyingCoins, types.Coin{})
+			if err := m.SlashedUnderlyingCoins[len(m.SlashedUnderlyingCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IntermediaryAccountSlash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSuperfluid
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntermediaryAccountSlash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntermediaryAccountSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediaryAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntermediaryAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSuperfluid
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockSlashes = append(m.LockSlashes, LockSlash{})
+			if err := m.LockSlashes[len(m.LockSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSuperfluid(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSuperfluid
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipSuperfluid(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
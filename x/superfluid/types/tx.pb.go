@@ -1139,6 +1139,105 @@ func (m *MsgUnbondConvertAndStakeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUnbondConvertAndStakeResponse proto.InternalMessageInfo
 
+// ===================== MsgSetSuperfluidAutoCompound
+type MsgSetSuperfluidAutoCompound struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LockId uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+	// enabled indicates whether the staking rewards of the lock are added to
+	// the lock at every epoch instead of being sent to the owner.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetSuperfluidAutoCompound) Reset()         { *m = MsgSetSuperfluidAutoCompound{} }
+func (m *MsgSetSuperfluidAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSuperfluidAutoCompound) ProtoMessage()    {}
+func (*MsgSetSuperfluidAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{20}
+}
+func (m *MsgSetSuperfluidAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSuperfluidAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSuperfluidAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSuperfluidAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSuperfluidAutoCompound.Merge(m, src)
+}
+func (m *MsgSetSuperfluidAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSuperfluidAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSuperfluidAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSuperfluidAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetSuperfluidAutoCompound) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetSuperfluidAutoCompound) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+func (m *MsgSetSuperfluidAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetSuperfluidAutoCompoundResponse struct {
+}
+
+func (m *MsgSetSuperfluidAutoCompoundResponse) Reset()         { *m = MsgSetSuperfluidAutoCompoundResponse{} }
+func (m *MsgSetSuperfluidAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSuperfluidAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetSuperfluidAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{21}
+}
+func (m *MsgSetSuperfluidAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSuperfluidAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSuperfluidAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSuperfluidAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSuperfluidAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetSuperfluidAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSuperfluidAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSuperfluidAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSuperfluidAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -1160,107 +1259,112 @@ func init() {
 	proto.RegisterType((*MsgAddToConcentratedLiquiditySuperfluidPositionResponse)(nil), "osmosis.superfluid.MsgAddToConcentratedLiquiditySuperfluidPositionResponse")
 	proto.RegisterType((*MsgUnbondConvertAndStake)(nil), "osmosis.superfluid.MsgUnbondConvertAndStake")
 	proto.RegisterType((*MsgUnbondConvertAndStakeResponse)(nil), "osmosis.superfluid.MsgUnbondConvertAndStakeResponse")
+	proto.RegisterType((*MsgSetSuperfluidAutoCompound)(nil), "osmosis.superfluid.MsgSetSuperfluidAutoCompound")
+	proto.RegisterType((*MsgSetSuperfluidAutoCompoundResponse)(nil), "osmosis.superfluid.MsgSetSuperfluidAutoCompoundResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 1520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x13, 0x47,
-	0x1b, 0xcf, 0xda, 0x21, 0x81, 0x09, 0x09, 0xc9, 0xbe, 0x04, 0x8c, 0x01, 0xdb, 0x0c, 0xf0, 0x12,
-	0x3e, 0xbc, 0x1b, 0x87, 0xf7, 0x85, 0x28, 0x3d, 0x94, 0x38, 0x56, 0x2b, 0x97, 0x44, 0x45, 0x4b,
-	0x50, 0xa5, 0x5e, 0xdc, 0xb5, 0x67, 0xb2, 0xd9, 0x66, 0x77, 0xc7, 0x78, 0xc6, 0x21, 0x51, 0x4f,
-	0x6d, 0xa5, 0x56, 0xe2, 0x84, 0x7a, 0x69, 0x2f, 0x55, 0xcf, 0xad, 0xaa, 0x8a, 0x3f, 0xa1, 0x47,
-	0x8e, 0x1c, 0xab, 0x56, 0x0a, 0x15, 0x1c, 0x7a, 0xcf, 0xa5, 0xd7, 0x6a, 0x76, 0x67, 0xd7, 0xeb,
-	0x78, 0x37, 0xf6, 0x9a, 0x5c, 0x7a, 0x49, 0x76, 0x66, 0x9e, 0x8f, 0xdf, 0xf3, 0x3d, 0x63, 0x70,
-	0x9e, 0x50, 0x9b, 0x50, 0x93, 0xaa, 0xb4, 0xdd, 0xc4, 0xad, 0x0d, 0xab, 0x6d, 0x22, 0x95, 0xed,
-	0x28, 0xcd, 0x16, 0x61, 0x44, 0x96, 0xc5, 0xa1, 0xd2, 0x39, 0xcc, 0x9e, 0x36, 0x88, 0x41, 0xdc,
-	0x63, 0x95, 0x7f, 0x79, 0x94, 0xd9, 0x19, 0xdd, 0x36, 0x1d, 0xa2, 0xba, 0x7f, 0xc5, 0x56, 0xce,
-	0x20, 0xc4, 0xb0, 0xb0, 0xea, 0xae, 0xea, 0xed, 0x0d, 0x15, 0xb5, 0x5b, 0x3a, 0x33, 0x89, 0xe3,
-	0x9f, 0x37, 0x5c, 0xe9, 0x6a, 0x5d, 0xa7, 0x58, 0xdd, 0x2e, 0xd5, 0x31, 0xd3, 0x4b, 0x6a, 0x83,
-	0x98, 0xfe, 0x79, 0xfe, 0x20, 0x3f, 0x33, 0x6d, 0x4c, 0x99, 0x6e, 0x37, 0x05, 0xc1, 0xe5, 0x08,
-	0xe8, 0x9d, 0x4f, 0x8f, 0x08, 0x7e, 0x27, 0x81, 0xd9, 0x35, 0x6a, 0x3c, 0x0c, 0xf6, 0x2b, 0xd8,
-	0xc2, 0x86, 0xce, 0xb0, 0x7c, 0x1d, 0x8c, 0x51, 0xec, 0x20, 0xdc, 0xca, 0x48, 0x05, 0x69, 0xee,
-	0x44, 0x79, 0x66, 0x7f, 0x2f, 0x3f, 0xb9, 0xab, 0xdb, 0xd6, 0x12, 0xf4, 0xf6, 0xa1, 0x26, 0x08,
-	0xe4, 0xb3, 0x60, 0xdc, 0x22, 0x8d, 0xad, 0x9a, 0x89, 0x32, 0xa9, 0x82, 0x34, 0x37, 0xaa, 0x8d,
-	0xf1, 0x65, 0x15, 0xc9, 0xe7, 0xc0, 0xf1, 0x6d, 0xdd, 0xaa, 0xe9, 0x08, 0xb5, 0x32, 0x69, 0x2e,
-	0x45, 0x1b, 0xdf, 0xd6, 0xad, 0x65, 0x84, 0x5a, 0x4b, 0x85, 0xa7, 0x7f, 0x3d, 0xbf, 0x11, 0xe1,
-	0xdd, 0x22, 0x12, 0x00, 0x60, 0x1e, 0x5c, 0x8c, 0x44, 0xa6, 0x61, 0xda, 0x24, 0x0e, 0xc5, 0xf0,
-	0x73, 0x09, 0x9c, 0xed, 0xa2, 0x78, 0xe4, 0xa0, 0x23, 0x44, 0xbf, 0x04, 0x39, 0xc4, 0x8b, 0x11,
-	0x10, 0xdb, 0x81, 0x1e, 0x78, 0x09, 0xe4, 0x63, 0x20, 0x04, 0x30, 0xbf, 0xe8, 0x85, 0x59, 0x27,
-	0x0e, 0x5a, 0x25, 0x8d, 0xad, 0x23, 0x81, 0x79, 0x99, 0xc3, 0xcc, 0x45, 0xc2, 0xe4, 0x7a, 0x8a,
-	0x9c, 0x2c, 0x02, 0xa7, 0x8f, 0x21, 0xc0, 0xf9, 0x8b, 0x04, 0xae, 0xc4, 0xd8, 0xb2, 0xec, 0x1c,
-	0x31, 0x68, 0xb9, 0x0c, 0x46, 0x79, 0x2e, 0xbb, 0x59, 0x31, 0xb1, 0x70, 0x4e, 0xf1, 0x92, 0x5d,
-	0xe1, 0xc9, 0xae, 0x88, 0x64, 0x57, 0x56, 0x88, 0xe9, 0x94, 0xff, 0xf3, 0x62, 0x2f, 0x3f, 0xb2,
-	0xbf, 0x97, 0x9f, 0xf0, 0x14, 0x70, 0x26, 0xa8, 0xb9, 0xbc, 0xf0, 0x7d, 0x70, 0x6b, 0x10, 0xbc,
-	0xbe, 0x81, 0x61, 0x30, 0x52, 0x18, 0x0c, 0xdc, 0x97, 0xc0, 0x85, 0x35, 0x6a, 0x70, 0xe2, 0x65,
-	0x07, 0xbd, 0x5d, 0x2d, 0xe8, 0xe0, 0x18, 0x07, 0x47, 0x33, 0xa9, 0x42, 0xfa, 0x70, 0xcb, 0xe6,
-	0xb9, 0x65, 0x3f, 0xbd, 0xca, 0xcf, 0x19, 0x26, 0xdb, 0x6c, 0xd7, 0x95, 0x06, 0xb1, 0x55, 0x51,
-	0xf3, 0xde, 0xbf, 0x22, 0x45, 0x5b, 0x2a, 0xdb, 0x6d, 0x62, 0xea, 0x32, 0x50, 0xcd, 0x93, 0x7c,
-	0x58, 0x55, 0x5d, 0xe7, 0xb9, 0x70, 0xc5, 0xcf, 0x05, 0x6e, 0x5e, 0x51, 0x77, 0x50, 0x31, 0xaa,
-	0xbc, 0xee, 0xb8, 0xd1, 0x8e, 0xb5, 0x39, 0xf0, 0xda, 0x14, 0x48, 0x55, 0x2b, 0xc2, 0x61, 0xa9,
-	0x6a, 0x05, 0x3e, 0x4f, 0x01, 0x75, 0x8d, 0x1a, 0x2b, 0x2d, 0xac, 0x33, 0xfc, 0x5e, 0xdb, 0xb2,
-	0x34, 0xdd, 0x31, 0xf0, 0x03, 0x42, 0x4d, 0xde, 0xbc, 0xfe, 0xdd, 0xfe, 0x93, 0x6f, 0x82, 0xf1,
-	0x26, 0x21, 0x16, 0x4f, 0x91, 0x51, 0x6e, 0x71, 0x59, 0xde, 0xdf, 0xcb, 0x4f, 0x79, 0x48, 0xc5,
-	0x01, 0xd4, 0xc6, 0xf8, 0x57, 0x15, 0x2d, 0x5d, 0xe3, 0xce, 0x86, 0xbe, 0xb3, 0x37, 0xda, 0x96,
-	0x55, 0x6c, 0x71, 0x5f, 0x78, 0x2e, 0xdf, 0xe8, 0xb8, 0xfa, 0x31, 0xb8, 0x9b, 0xd0, 0x63, 0x81,
-	0xf7, 0xcf, 0x00, 0x2f, 0x49, 0x2b, 0x5d, 0x29, 0x5b, 0x91, 0x73, 0x00, 0x34, 0x85, 0x80, 0x6a,
-	0x45, 0xd4, 0x56, 0x68, 0x87, 0xf7, 0xf5, 0xcc, 0x1a, 0x35, 0x1e, 0x39, 0x0f, 0x08, 0xb1, 0x3e,
-	0xda, 0x34, 0x19, 0xb6, 0x4c, 0xca, 0x30, 0xe2, 0xcb, 0x24, 0xe1, 0x08, 0x39, 0x24, 0xd5, 0xd7,
-	0x21, 0x57, 0xb8, 0x43, 0xf2, 0xbe, 0x43, 0xda, 0x0e, 0xdf, 0x2e, 0x3e, 0xe9, 0x28, 0x2f, 0xf2,
-	0x0d, 0xf8, 0x01, 0x28, 0xc4, 0x21, 0x0b, 0xcc, 0xfe, 0x2f, 0x38, 0x85, 0x77, 0x4c, 0x86, 0x51,
-	0x4d, 0x54, 0x2c, 0xcd, 0x48, 0x85, 0xf4, 0xdc, 0xa8, 0x36, 0xe9, 0x6d, 0xaf, 0xba, 0x85, 0x4b,
-	0xe1, 0x8f, 0x69, 0xb0, 0xe8, 0x0a, 0xb3, 0xbc, 0x3c, 0x5e, 0x33, 0x8d, 0x96, 0xce, 0xf0, 0xc3,
-	0x4d, 0xbd, 0x85, 0xe9, 0x3a, 0x09, 0x9c, 0xbd, 0x42, 0x9c, 0x06, 0x76, 0x18, 0x3f, 0x43, 0xbe,
-	0xe3, 0x13, 0xba, 0x21, 0xdc, 0xc7, 0xd2, 0x61, 0x37, 0x88, 0x03, 0x18, 0xf4, 0x36, 0x03, 0xcc,
-	0x50, 0x17, 0x40, 0x8d, 0x91, 0x9a, 0xed, 0x21, 0xea, 0xdf, 0xe8, 0x0a, 0xa2, 0xd1, 0x65, 0x04,
-	0x82, 0x83, 0x12, 0xa0, 0x76, 0x8a, 0x0a, 0xb3, 0x84, 0x95, 0xf2, 0x53, 0x09, 0x4c, 0x31, 0xb2,
-	0x85, 0x9d, 0x1a, 0x69, 0xb3, 0x9a, 0xcd, 0xab, 0x66, 0xb4, 0x5f, 0xd5, 0x54, 0x85, 0x9a, 0x59,
-	0x4f, 0x4d, 0x37, 0x3b, 0x4c, 0x54, 0x4e, 0x27, 0x5d, 0xe6, 0x0f, 0xdb, 0x6c, 0xcd, 0x74, 0xe8,
-	0x52, 0x9e, 0x07, 0x3f, 0xdb, 0x09, 0x7e, 0xd0, 0x7c, 0x7c, 0xfc, 0xdf, 0xa7, 0xc1, 0xbd, 0x61,
-	0x63, 0x15, 0x24, 0x46, 0x15, 0x8c, 0xeb, 0x36, 0x69, 0x3b, 0x6c, 0x5e, 0x04, 0x4d, 0xe5, 0xf6,
-	0xfc, 0xbe, 0x97, 0x9f, 0xf5, 0x40, 0x52, 0xb4, 0xa5, 0x98, 0x44, 0xb5, 0x75, 0xb6, 0xa9, 0x54,
-	0x1d, 0xd6, 0x89, 0x92, 0xe0, 0x82, 0x9a, 0xcf, 0xdf, 0x11, 0x55, 0x72, 0x63, 0x9a, 0x54, 0x54,
-	0x29, 0x10, 0x55, 0x92, 0x2d, 0x30, 0x63, 0x99, 0x8f, 0xdb, 0x26, 0x32, 0xd9, 0x6e, 0xad, 0xe1,
-	0xd6, 0x39, 0xf2, 0x5a, 0x4b, 0xf9, 0x5d, 0x21, 0xf4, 0x7c, 0xaf, 0xd0, 0x55, 0x6c, 0xe8, 0x8d,
-	0xdd, 0x0a, 0x6e, 0x74, 0xa2, 0xde, 0x23, 0x05, 0x6a, 0xd3, 0xc1, 0x9e, 0xd7, 0x40, 0x90, 0xfc,
-	0x08, 0x9c, 0xf8, 0x94, 0x98, 0x4e, 0x8d, 0x5f, 0xf8, 0xdc, 0x36, 0x35, 0xb1, 0x90, 0x55, 0xbc,
-	0xdb, 0xa0, 0xe2, 0xdf, 0x06, 0x95, 0x75, 0xff, 0x36, 0x58, 0xbe, 0x20, 0x22, 0x3e, 0xed, 0xa9,
-	0x08, 0x58, 0xe1, 0xb3, 0x57, 0x79, 0x49, 0x3b, 0xce, 0xd7, 0x9c, 0x18, 0x7e, 0x99, 0x76, 0x1b,
-	0xfb, 0x32, 0x42, 0xeb, 0x24, 0x1c, 0x83, 0x55, 0x5f, 0x7f, 0xa7, 0x4d, 0x05, 0x25, 0x74, 0x17,
-	0x4c, 0xf8, 0x4d, 0x27, 0x18, 0xab, 0xe5, 0x33, 0xfb, 0x7b, 0x79, 0xd9, 0x6f, 0x11, 0xc1, 0x21,
-	0x0c, 0xf5, 0x27, 0x14, 0xaa, 0xbd, 0x54, 0xbf, 0xda, 0xab, 0xf9, 0x49, 0x8e, 0x30, 0x35, 0x5b,
-	0x18, 0xcd, 0xf7, 0xaf, 0xa5, 0x8b, 0x51, 0x49, 0xee, 0xb3, 0x43, 0x6d, 0xd2, 0xdd, 0xa8, 0x88,
-	0x75, 0x8f, 0x82, 0x92, 0x70, 0xea, 0x90, 0x0a, 0x4a, 0x07, 0x14, 0x94, 0x96, 0x6e, 0xf0, 0xd2,
-	0xb8, 0xea, 0x97, 0x86, 0x8e, 0x50, 0x91, 0x91, 0x62, 0xc3, 0x0a, 0x8f, 0x65, 0xdf, 0x35, 0xf0,
-	0xdb, 0xb4, 0x3b, 0x2c, 0x92, 0x44, 0x21, 0x28, 0x8e, 0xa1, 0xa3, 0x11, 0xaa, 0xaa, 0xd4, 0xd1,
-	0x55, 0x55, 0xfa, 0x2d, 0xab, 0xea, 0x13, 0x30, 0xe9, 0xe0, 0x27, 0xb5, 0x20, 0xff, 0x33, 0xc7,
-	0x5c, 0x81, 0xef, 0x0c, 0x56, 0x51, 0xa7, 0x3d, 0xb1, 0x5d, 0x12, 0xa0, 0x76, 0xd2, 0xc1, 0x4f,
-	0x02, 0x57, 0x86, 0xdb, 0x7a, 0xcf, 0xb8, 0x3f, 0xd8, 0xd6, 0xe1, 0xcf, 0x69, 0x31, 0x52, 0xf9,
-	0xc5, 0x72, 0x85, 0x38, 0xdb, 0xb8, 0xc5, 0xf8, 0xf0, 0x66, 0xfa, 0x16, 0x0e, 0x4b, 0x92, 0xfa,
-	0x49, 0x4a, 0x92, 0xfc, 0x87, 0xdc, 0x55, 0x74, 0x30, 0x6d, 0x9b, 0x4e, 0x4d, 0xb7, 0x19, 0x9f,
-	0x12, 0x94, 0xc3, 0x70, 0xad, 0x38, 0x51, 0x5e, 0xec, 0xe7, 0xf2, 0xb3, 0x9e, 0xb2, 0x83, 0xec,
-	0x50, 0x9b, 0xb4, 0x4d, 0x67, 0xd9, 0x66, 0xeb, 0xc4, 0xb3, 0xea, 0x1b, 0x29, 0x3c, 0xca, 0x1a,
-	0x9e, 0xcd, 0x6e, 0x18, 0x0e, 0xad, 0x8e, 0xfb, 0x71, 0xa3, 0x4c, 0x48, 0xe0, 0x63, 0xe6, 0xda,
-	0x80, 0x63, 0xa6, 0x33, 0xf5, 0x84, 0xcb, 0x97, 0xae, 0xf2, 0x6a, 0x2a, 0x74, 0x06, 0x8d, 0xfb,
-	0xc8, 0x11, 0x92, 0xbd, 0xab, 0x97, 0x6b, 0xcb, 0x57, 0x92, 0xb8, 0x67, 0x44, 0x84, 0x2b, 0xa8,
-	0x98, 0x3a, 0x98, 0x66, 0x84, 0x71, 0x07, 0xdb, 0xcc, 0xf3, 0x01, 0x12, 0x73, 0x65, 0x50, 0x1f,
-	0x1e, 0x64, 0x87, 0xda, 0x94, 0xbb, 0xb5, 0x6c, 0x33, 0x57, 0x15, 0x5a, 0xf8, 0x7b, 0x02, 0xa4,
-	0xd7, 0xa8, 0x21, 0xb7, 0x80, 0x1c, 0x75, 0x35, 0x56, 0x7a, 0x7f, 0x44, 0x50, 0x22, 0xdf, 0xbd,
-	0xd9, 0xd2, 0xc0, 0xa4, 0x81, 0x7d, 0x3b, 0xe0, 0x74, 0xe4, 0xf3, 0xf8, 0x66, 0x5f, 0x51, 0x1d,
-	0xe2, 0xec, 0xed, 0x04, 0xc4, 0x71, 0x9a, 0x83, 0xc7, 0xe3, 0x20, 0x9a, 0x7d, 0xe2, 0x81, 0x34,
-	0xf7, 0x3c, 0xf3, 0x7e, 0x90, 0xc0, 0xa5, 0xfe, 0x8f, 0xd8, 0xc5, 0x04, 0x46, 0x75, 0x71, 0x66,
-	0xef, 0x0d, 0xcb, 0x19, 0x20, 0xfc, 0x5a, 0x02, 0xe7, 0xe2, 0x1f, 0x9b, 0xf3, 0x31, 0xf2, 0x63,
-	0x39, 0xb2, 0x8b, 0x49, 0x39, 0x02, 0x24, 0xbf, 0x4a, 0xe0, 0x56, 0xa2, 0x97, 0xdc, 0x4a, 0x8c,
-	0xaa, 0x24, 0x42, 0xb2, 0xf7, 0x8f, 0x40, 0x48, 0x60, 0xc2, 0x67, 0x60, 0x36, 0xfa, 0x95, 0x73,
-	0x2b, 0x46, 0x4b, 0x24, 0x75, 0xf6, 0x7f, 0x49, 0xa8, 0x03, 0xe5, 0x7f, 0x48, 0xe0, 0xff, 0xc3,
-	0x3d, 0x3e, 0x56, 0x63, 0xf5, 0x0d, 0x21, 0x2d, 0xbb, 0x7e, 0x94, 0xd2, 0xba, 0xb2, 0x23, 0xd1,
-	0x75, 0x30, 0x2e, 0x3b, 0x92, 0x08, 0x89, 0xcd, 0x8e, 0xa1, 0xae, 0x44, 0x6e, 0x76, 0x44, 0x0d,
-	0xec, 0xf8, 0xec, 0x88, 0xa0, 0x3e, 0x24, 0x3b, 0x0e, 0x99, 0x2e, 0xe5, 0x07, 0x2f, 0x5e, 0xe7,
-	0xa4, 0x97, 0xaf, 0x73, 0xd2, 0x9f, 0xaf, 0x73, 0xd2, 0xb3, 0x37, 0xb9, 0x91, 0x97, 0x6f, 0x72,
-	0x23, 0xbf, 0xbd, 0xc9, 0x8d, 0x7c, 0x7c, 0x27, 0x34, 0xfd, 0x84, 0xe4, 0xa2, 0xa5, 0xd7, 0xa9,
-	0xbf, 0x50, 0xb7, 0x17, 0x4a, 0xea, 0x4e, 0xd7, 0x8f, 0xce, 0x7c, 0x22, 0xd6, 0xc7, 0xdc, 0xfb,
-	0xfd, 0xed, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x85, 0xea, 0xaa, 0x00, 0x97, 0x16, 0x00, 0x00,
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xd5, 0x58, 0x4b, 0x6f, 0x1b, 0x45,
+	0x1c, 0xef, 0xc6, 0x69, 0xd3, 0x4e, 0x9a, 0x34, 0x59, 0xfa, 0x48, 0xdd, 0x36, 0x4e, 0xa7, 0xef,
+	0x36, 0xf6, 0xc6, 0x29, 0xb4, 0x51, 0x38, 0xd0, 0x38, 0x11, 0x28, 0x34, 0x15, 0xd5, 0x26, 0x15,
+	0x12, 0x17, 0xb3, 0xf6, 0x4e, 0x9c, 0x25, 0xeb, 0x1d, 0xd7, 0x3b, 0x4e, 0x13, 0x71, 0x2a, 0x48,
+	0x20, 0xf5, 0x54, 0x71, 0x81, 0x0b, 0xe2, 0x0c, 0x42, 0xa8, 0x1f, 0x81, 0x63, 0x6f, 0xf4, 0x88,
+	0x40, 0x6a, 0x11, 0x1c, 0xb8, 0xf7, 0x13, 0x30, 0xaf, 0x1d, 0xaf, 0xed, 0x5d, 0xdb, 0xeb, 0xe6,
+	0xc2, 0xc1, 0x89, 0x67, 0xe6, 0xff, 0xf8, 0xfd, 0xdf, 0x33, 0x06, 0x67, 0xb0, 0x5f, 0xc5, 0xbe,
+	0xe3, 0x1b, 0x7e, 0xa3, 0x86, 0xea, 0x9b, 0x6e, 0xc3, 0xb1, 0x0d, 0xb2, 0x9b, 0xab, 0xd5, 0x31,
+	0xc1, 0xba, 0x2e, 0x0f, 0x73, 0xcd, 0xc3, 0xf4, 0xf1, 0x0a, 0xae, 0x60, 0x7e, 0x6c, 0xb0, 0x6f,
+	0x82, 0x32, 0x3d, 0x69, 0x55, 0x1d, 0x0f, 0x1b, 0xfc, 0xaf, 0xdc, 0x9a, 0xae, 0x60, 0x5c, 0x71,
+	0x91, 0xc1, 0x57, 0xa5, 0xc6, 0xa6, 0x61, 0x37, 0xea, 0x16, 0x71, 0xb0, 0x17, 0x9c, 0x97, 0xb9,
+	0x74, 0xa3, 0x64, 0xf9, 0xc8, 0xd8, 0xc9, 0x97, 0x10, 0xb1, 0xf2, 0x46, 0x19, 0x3b, 0xc1, 0x79,
+	0xa6, 0x9d, 0x9f, 0x38, 0x55, 0xe4, 0x13, 0xab, 0x5a, 0x93, 0x04, 0x17, 0x22, 0xa0, 0x37, 0xbf,
+	0x0a, 0x22, 0xf8, 0x9d, 0x06, 0x4e, 0xdc, 0xf3, 0x2b, 0xeb, 0x6a, 0x7f, 0x05, 0xb9, 0xa8, 0x62,
+	0x11, 0xa4, 0x5f, 0x03, 0x87, 0x7c, 0xe4, 0xd9, 0xa8, 0x3e, 0xa5, 0xcd, 0x68, 0x57, 0x8f, 0x14,
+	0x26, 0x5f, 0xbf, 0xcc, 0x8c, 0xed, 0x59, 0x55, 0x77, 0x11, 0x8a, 0x7d, 0x68, 0x4a, 0x02, 0xfd,
+	0x14, 0x18, 0x71, 0x71, 0x79, 0xbb, 0xe8, 0xd8, 0x53, 0x43, 0x94, 0x76, 0xd8, 0x3c, 0xc4, 0x96,
+	0xab, 0xb6, 0x7e, 0x1a, 0x1c, 0xde, 0xb1, 0xdc, 0xa2, 0x65, 0xdb, 0xf5, 0xa9, 0x14, 0x93, 0x62,
+	0x8e, 0xd0, 0xf5, 0x12, 0x5d, 0x2e, 0xce, 0x3c, 0xf9, 0xf7, 0xd9, 0xf5, 0x08, 0xef, 0x66, 0x6d,
+	0x09, 0x00, 0x66, 0xc0, 0xb9, 0x48, 0x64, 0x26, 0xf2, 0x6b, 0xd8, 0xf3, 0x11, 0x7c, 0xac, 0x81,
+	0x53, 0x2d, 0x14, 0x0f, 0x3c, 0x7b, 0x1f, 0xd1, 0x2f, 0x42, 0x06, 0xf1, 0x5c, 0x04, 0xc4, 0x86,
+	0xd2, 0x03, 0xcf, 0x83, 0x4c, 0x0c, 0x04, 0x05, 0xf3, 0x8b, 0x4e, 0x98, 0x25, 0xec, 0xd9, 0x6b,
+	0x54, 0xc9, 0xbe, 0xc0, 0xbc, 0xc0, 0x60, 0x4e, 0x47, 0xc2, 0x64, 0x7a, 0xb2, 0x8c, 0x2c, 0x02,
+	0x67, 0x80, 0x41, 0xe1, 0xfc, 0x45, 0x03, 0x17, 0x63, 0x6c, 0x59, 0xf2, 0xf6, 0x19, 0xb4, 0x5e,
+	0x00, 0xc3, 0x2c, 0x97, 0x79, 0x56, 0x8c, 0xce, 0x9f, 0xce, 0x89, 0x64, 0xcf, 0xb1, 0x64, 0xcf,
+	0xc9, 0x64, 0xcf, 0x2d, 0x53, 0x82, 0xc2, 0x5b, 0xcf, 0x5f, 0x66, 0x0e, 0x50, 0x05, 0xa3, 0x42,
+	0x01, 0x63, 0x82, 0x26, 0xe7, 0x85, 0x1f, 0x80, 0xd9, 0x7e, 0xf0, 0x06, 0x06, 0x86, 0xc1, 0x68,
+	0x61, 0x30, 0xf0, 0xb5, 0x06, 0xce, 0x52, 0x49, 0x8c, 0x98, 0x72, 0xbe, 0x59, 0x2d, 0x58, 0xe0,
+	0x20, 0x03, 0xe7, 0x53, 0x7b, 0x53, 0xdd, 0x2d, 0x9b, 0x63, 0x96, 0xfd, 0xf4, 0x2a, 0x73, 0xb5,
+	0xe2, 0x90, 0xad, 0x46, 0x89, 0x12, 0x56, 0x0d, 0x59, 0xf3, 0xe2, 0x5f, 0xd6, 0xb7, 0xb7, 0x0d,
+	0xb2, 0x57, 0x43, 0x3e, 0x67, 0xf0, 0x4d, 0x21, 0xb9, 0x5b, 0x55, 0x5d, 0x63, 0xb9, 0x70, 0x31,
+	0xc8, 0x05, 0x66, 0x5e, 0xd6, 0xa2, 0x29, 0x10, 0x55, 0x5e, 0xb7, 0x78, 0xb4, 0x63, 0x6d, 0x56,
+	0x5e, 0x1b, 0x07, 0x43, 0xab, 0x2b, 0xd2, 0x61, 0xf4, 0x1b, 0x7c, 0x36, 0x04, 0x0c, 0xca, 0xb8,
+	0x5c, 0x47, 0x94, 0xea, 0xfd, 0x86, 0xeb, 0x9a, 0x96, 0x57, 0x41, 0xf7, 0xa9, 0x42, 0xd6, 0xbc,
+	0xfe, 0xdf, 0xfe, 0xd3, 0x6f, 0x80, 0x91, 0x1a, 0xc6, 0x2e, 0x4b, 0x91, 0x61, 0x66, 0x71, 0x41,
+	0xa7, 0x48, 0xc7, 0x05, 0x52, 0x79, 0x40, 0xa1, 0xb2, 0x6f, 0xb4, 0xf0, 0xae, 0x30, 0x67, 0xc3,
+	0xc0, 0xd9, 0x9b, 0xd4, 0x15, 0xd9, 0x3a, 0xf3, 0x85, 0x70, 0xf9, 0x66, 0xd3, 0xd5, 0x0f, 0xc1,
+	0xed, 0x84, 0x1e, 0x53, 0xde, 0x3f, 0x09, 0x44, 0x92, 0xae, 0xb4, 0xa4, 0xec, 0x8a, 0x3e, 0x0d,
+	0x40, 0x4d, 0x0a, 0xa0, 0x67, 0xa2, 0xb6, 0x42, 0x3b, 0xac, 0xaf, 0x4f, 0x51, 0x9d, 0x0f, 0xbc,
+	0xfb, 0x14, 0xeb, 0xc7, 0x5b, 0x0e, 0x41, 0xae, 0xe3, 0x13, 0x64, 0xb3, 0x65, 0x92, 0x70, 0x84,
+	0x1c, 0x32, 0xd4, 0xd3, 0x21, 0x17, 0x99, 0x43, 0x32, 0x81, 0x43, 0x1a, 0x1e, 0xdb, 0xce, 0x3e,
+	0x6a, 0x2a, 0xcf, 0xb2, 0x0d, 0xf8, 0x21, 0x98, 0x89, 0x43, 0xa6, 0xcc, 0xbe, 0x0c, 0x8e, 0xa1,
+	0x5d, 0x7a, 0x64, 0x17, 0x65, 0xc5, 0xfa, 0x14, 0x6a, 0x8a, 0xda, 0x38, 0x26, 0xb6, 0xd7, 0x78,
+	0xe1, 0xfa, 0xf0, 0xc7, 0x14, 0x58, 0xe0, 0xc2, 0x5c, 0x91, 0xc7, 0xf7, 0x9c, 0x0a, 0x1d, 0xa2,
+	0x68, 0x7d, 0xcb, 0xaa, 0x23, 0x7f, 0x03, 0x2b, 0x67, 0x2f, 0x63, 0xaf, 0x8c, 0x3c, 0xc2, 0xce,
+	0xec, 0xc0, 0xf1, 0x09, 0xdd, 0x10, 0xee, 0x63, 0xa9, 0xb0, 0x1b, 0xe4, 0x01, 0x54, 0xbd, 0xad,
+	0x02, 0x26, 0x7d, 0x0e, 0xa0, 0x48, 0x70, 0xb1, 0x2a, 0x10, 0xf5, 0x6e, 0x74, 0x33, 0xb2, 0xd1,
+	0x4d, 0x49, 0x04, 0xed, 0x12, 0xa0, 0x79, 0xcc, 0x97, 0x66, 0x49, 0x2b, 0xf5, 0x27, 0x1a, 0x18,
+	0x27, 0x78, 0x1b, 0x79, 0x45, 0xdc, 0x20, 0x94, 0x8e, 0x56, 0xcd, 0x70, 0xaf, 0xaa, 0x59, 0x95,
+	0x6a, 0x4e, 0x08, 0x35, 0xad, 0xec, 0x30, 0x51, 0x39, 0x1d, 0xe5, 0xcc, 0x1f, 0x35, 0xc8, 0x3d,
+	0xba, 0x5a, 0xcc, 0xb0, 0xe0, 0xa7, 0x9b, 0xc1, 0x57, 0xcd, 0x27, 0xc0, 0xff, 0x7d, 0x0a, 0xdc,
+	0x19, 0x34, 0x56, 0x2a, 0x31, 0x56, 0xc1, 0x88, 0x55, 0xc5, 0x0d, 0x8f, 0xcc, 0xc9, 0xa0, 0x19,
+	0xcc, 0x9e, 0x3f, 0xa8, 0x3d, 0x02, 0x24, 0xc5, 0x98, 0x73, 0xb0, 0x51, 0xb5, 0xc8, 0x56, 0x6e,
+	0xd5, 0x23, 0xcd, 0x28, 0x49, 0x2e, 0x68, 0x06, 0xfc, 0x4d, 0x51, 0x79, 0x1e, 0xd3, 0xa4, 0xa2,
+	0xf2, 0x4a, 0x54, 0x5e, 0x77, 0xc1, 0xa4, 0xeb, 0x3c, 0xa4, 0xf5, 0xeb, 0x90, 0xbd, 0x62, 0x99,
+	0xd7, 0xb9, 0x2d, 0x5a, 0x4b, 0xe1, 0x3d, 0x29, 0xf4, 0x4c, 0xa7, 0xd0, 0x35, 0x5a, 0xea, 0xe5,
+	0xbd, 0x15, 0x54, 0x6e, 0x46, 0xbd, 0x43, 0x0a, 0x34, 0x27, 0xd4, 0x9e, 0x68, 0x20, 0xb6, 0xfe,
+	0x00, 0x1c, 0xf9, 0x8c, 0x06, 0xa0, 0xc8, 0x2e, 0x7c, 0xbc, 0x4d, 0x8d, 0xce, 0xa7, 0x73, 0xe2,
+	0x36, 0x98, 0x0b, 0x6e, 0x83, 0xb9, 0x8d, 0xe0, 0x36, 0x58, 0x38, 0x2b, 0x23, 0x3e, 0x21, 0x54,
+	0x28, 0x56, 0xf8, 0xf4, 0x55, 0x46, 0x33, 0x0f, 0xb3, 0x35, 0x23, 0x86, 0x5f, 0xa6, 0x78, 0x63,
+	0xa7, 0x7d, 0x70, 0x03, 0x87, 0x63, 0xb0, 0x16, 0xe8, 0x6f, 0xb6, 0x29, 0x55, 0x42, 0xb7, 0xc1,
+	0x68, 0xd0, 0x74, 0xd4, 0x58, 0x2d, 0x9c, 0xa4, 0xca, 0xf4, 0xa0, 0x45, 0xa8, 0x43, 0x18, 0xea,
+	0x4f, 0x76, 0xa8, 0xf6, 0x86, 0x7a, 0xd5, 0x5e, 0x31, 0x48, 0x72, 0x1b, 0xf9, 0x4e, 0x1d, 0xd9,
+	0x73, 0xbd, 0x6b, 0xe9, 0x5c, 0x54, 0x92, 0x07, 0xec, 0xd0, 0x1c, 0xe3, 0x1b, 0x2b, 0x72, 0xdd,
+	0xa1, 0x20, 0x2f, 0x9d, 0x3a, 0xa0, 0x82, 0x7c, 0x9b, 0x82, 0xfc, 0xe2, 0x75, 0x56, 0x1a, 0x97,
+	0x82, 0xd2, 0xa0, 0x73, 0x27, 0x4b, 0x70, 0xb6, 0xec, 0x86, 0xc7, 0x72, 0xe0, 0x1a, 0xf8, 0x6d,
+	0x8a, 0x0f, 0x8b, 0x24, 0x51, 0x50, 0xc5, 0x31, 0x70, 0x34, 0x42, 0x55, 0x35, 0xb4, 0x7f, 0x55,
+	0x95, 0x7a, 0xc3, 0xaa, 0xfa, 0x14, 0x8c, 0x79, 0xe8, 0x51, 0x51, 0xe5, 0xff, 0xd4, 0x41, 0x2e,
+	0xf0, 0xdd, 0xfe, 0x2a, 0xea, 0xb8, 0x10, 0xdb, 0x22, 0x01, 0x9a, 0x47, 0xe9, 0x5a, 0xb9, 0x32,
+	0xdc, 0xd6, 0x3b, 0xc6, 0x7d, 0x7b, 0x5b, 0x87, 0x3f, 0xa7, 0xe4, 0x48, 0x65, 0x17, 0x4b, 0x1a,
+	0x9a, 0x1d, 0x54, 0x27, 0x6c, 0x78, 0x13, 0x6b, 0x1b, 0x85, 0x25, 0x69, 0xbd, 0x24, 0x25, 0x49,
+	0xfe, 0x2e, 0x77, 0x15, 0x0b, 0x4c, 0xd0, 0x9e, 0x5d, 0xb4, 0xaa, 0x84, 0x4d, 0x09, 0x9f, 0xc1,
+	0xe0, 0x56, 0x1c, 0x29, 0x2c, 0xf4, 0x72, 0xf9, 0x29, 0xa1, 0xac, 0x9d, 0x9d, 0x26, 0x2e, 0xdd,
+	0x5a, 0xaa, 0x92, 0x0d, 0x2c, 0xac, 0xfa, 0x46, 0x0b, 0x8f, 0xb2, 0xb2, 0xb0, 0x99, 0x87, 0xa1,
+	0x6b, 0x75, 0xdc, 0x8d, 0x1b, 0x65, 0x52, 0x02, 0x1b, 0x33, 0x57, 0xfa, 0x1c, 0x33, 0xcd, 0xa9,
+	0x27, 0x5d, 0xbe, 0x78, 0x89, 0x55, 0xd3, 0x4c, 0x73, 0xd0, 0xf0, 0x47, 0x8e, 0x94, 0x2c, 0xae,
+	0x5e, 0xdc, 0x96, 0xaf, 0x34, 0x79, 0xcf, 0x88, 0x08, 0x97, 0xaa, 0x98, 0x12, 0x98, 0x20, 0x98,
+	0x30, 0x07, 0x53, 0x37, 0x70, 0x3e, 0x5b, 0xce, 0x95, 0x7e, 0x7d, 0xd8, 0xce, 0x0e, 0xcd, 0x71,
+	0xbe, 0x45, 0xbd, 0xb8, 0x2e, 0x36, 0x7e, 0x13, 0xaf, 0x8b, 0x75, 0x44, 0x9a, 0xa5, 0xbb, 0xd4,
+	0x20, 0xd4, 0x9e, 0x6a, 0x8d, 0x26, 0xba, 0xfd, 0x06, 0xf7, 0x90, 0xee, 0x69, 0x36, 0x0b, 0x46,
+	0x90, 0x67, 0x95, 0x5c, 0x39, 0x8b, 0x0e, 0x87, 0x89, 0xe5, 0x01, 0xad, 0x36, 0xf9, 0xad, 0xed,
+	0xe9, 0xe0, 0x23, 0x12, 0x6e, 0x4f, 0x56, 0x83, 0x75, 0x2c, 0x09, 0x18, 0x5e, 0x16, 0x0f, 0xc5,
+	0x38, 0x83, 0x02, 0xef, 0xce, 0x3f, 0x1e, 0x03, 0x29, 0x4a, 0xa8, 0xd7, 0x81, 0x1e, 0xf5, 0x28,
+	0xc8, 0x75, 0xfe, 0x7c, 0x92, 0x8b, 0x7c, 0xf1, 0xa7, 0xf3, 0x7d, 0x93, 0xaa, 0xc8, 0xee, 0x82,
+	0xe3, 0x91, 0x3f, 0x0c, 0xdc, 0xe8, 0x29, 0xaa, 0x49, 0x9c, 0xbe, 0x99, 0x80, 0x38, 0x4e, 0xb3,
+	0x7a, 0x36, 0xf7, 0xa3, 0x39, 0x20, 0xee, 0x4b, 0x73, 0xc7, 0x03, 0xf7, 0x07, 0x0d, 0x9c, 0xef,
+	0xfd, 0x7c, 0x5f, 0x48, 0x60, 0x54, 0x0b, 0x67, 0xfa, 0xce, 0xa0, 0x9c, 0x0a, 0xe1, 0xd7, 0x1a,
+	0x38, 0x1d, 0xff, 0xcc, 0x9e, 0x8b, 0x91, 0x1f, 0xcb, 0x91, 0x5e, 0x48, 0xca, 0xa1, 0x90, 0xfc,
+	0xaa, 0x81, 0xd9, 0x44, 0x6f, 0xd8, 0xe5, 0x18, 0x55, 0x49, 0x84, 0xa4, 0xef, 0xee, 0x83, 0x10,
+	0x65, 0xc2, 0xe7, 0xe0, 0x44, 0xf4, 0xfb, 0x6e, 0x36, 0x46, 0x4b, 0x24, 0x75, 0xfa, 0xed, 0x24,
+	0xd4, 0x4a, 0xf9, 0x9f, 0x1a, 0x78, 0x67, 0xb0, 0x67, 0xd7, 0x5a, 0xac, 0xbe, 0x01, 0xa4, 0xa5,
+	0x37, 0xf6, 0x53, 0x5a, 0x4b, 0x76, 0x24, 0xba, 0x08, 0xc7, 0x65, 0x47, 0x12, 0x21, 0xb1, 0xd9,
+	0x31, 0xd0, 0x65, 0x90, 0x67, 0x47, 0xd4, 0x55, 0x25, 0x3e, 0x3b, 0x22, 0xa8, 0xbb, 0x64, 0x47,
+	0xb7, 0xb9, 0xca, 0xea, 0x3c, 0x7e, 0xe0, 0xc5, 0xd5, 0x79, 0x2c, 0x47, 0x6c, 0x9d, 0xf7, 0x9c,
+	0x41, 0x85, 0xfb, 0xcf, 0xff, 0x9e, 0xd6, 0x5e, 0xd0, 0xcf, 0x5f, 0xf4, 0xf3, 0xf4, 0x9f, 0xe9,
+	0x03, 0x2f, 0xe8, 0xe7, 0x77, 0xfa, 0xf9, 0xe4, 0x56, 0xe8, 0x06, 0x22, 0xa5, 0x67, 0x5d, 0xab,
+	0xe4, 0x07, 0x0b, 0x63, 0x67, 0x3e, 0x6f, 0xec, 0xb6, 0xfc, 0xf0, 0xcf, 0x6e, 0x25, 0xa5, 0x43,
+	0xfc, 0x8d, 0x75, 0xf3, 0x3f, 0x5a, 0x05, 0x34, 0xd6, 0x1b, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1293,6 +1397,9 @@ type MsgClient interface {
 	// UnbondConvertAndStake breaks all locks / superfluid staked assets,
 	// converts them to osmo then stakes the osmo to the designated validator.
 	UnbondConvertAndStake(ctx context.Context, in *MsgUnbondConvertAndStake, opts ...grpc.CallOption) (*MsgUnbondConvertAndStakeResponse, error)
+	// SetSuperfluidAutoCompound opts a superfluid delegated lock in or out of
+	// auto-compounding its staking rewards into the lock.
+	SetSuperfluidAutoCompound(ctx context.Context, in *MsgSetSuperfluidAutoCompound, opts ...grpc.CallOption) (*MsgSetSuperfluidAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -1393,6 +1500,15 @@ func (c *msgClient) UnbondConvertAndStake(ctx context.Context, in *MsgUnbondConv
 	return out, nil
 }
 
+func (c *msgClient) SetSuperfluidAutoCompound(ctx context.Context, in *MsgSetSuperfluidAutoCompound, opts ...grpc.CallOption) (*MsgSetSuperfluidAutoCompoundResponse, error) {
+	out := new(MsgSetSuperfluidAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/SetSuperfluidAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
@@ -1413,6 +1529,9 @@ type MsgServer interface {
 	// UnbondConvertAndStake breaks all locks / superfluid staked assets,
 	// converts them to osmo then stakes the osmo to the designated validator.
 	UnbondConvertAndStake(context.Context, *MsgUnbondConvertAndStake) (*MsgUnbondConvertAndStakeResponse, error)
+	// SetSuperfluidAutoCompound opts a superfluid delegated lock in or out of
+	// auto-compounding its staking rewards into the lock.
+	SetSuperfluidAutoCompound(context.Context, *MsgSetSuperfluidAutoCompound) (*MsgSetSuperfluidAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1449,6 +1568,9 @@ func (*UnimplementedMsgServer) AddToConcentratedLiquiditySuperfluidPosition(ctx
 func (*UnimplementedMsgServer) UnbondConvertAndStake(ctx context.Context, req *MsgUnbondConvertAndStake) (*MsgUnbondConvertAndStakeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnbondConvertAndStake not implemented")
 }
+func (*UnimplementedMsgServer) SetSuperfluidAutoCompound(ctx context.Context, req *MsgSetSuperfluidAutoCompound) (*MsgSetSuperfluidAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSuperfluidAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1634,6 +1756,24 @@ func _Msg_UnbondConvertAndStake_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSuperfluidAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSuperfluidAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSuperfluidAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/SetSuperfluidAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSuperfluidAutoCompound(ctx, req.(*MsgSetSuperfluidAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1678,6 +1818,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnbondConvertAndStake",
 			Handler:    _Msg_UnbondConvertAndStake_Handler,
 		},
+		{
+			MethodName: "SetSuperfluidAutoCompound",
+			Handler:    _Msg_SetSuperfluidAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -2514,6 +2658,74 @@ func (m *MsgUnbondConvertAndStakeResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSuperfluidAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSuperfluidAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSuperfluidAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSuperfluidAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSuperfluidAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSuperfluidAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2859,6 +3071,34 @@ func (m *MsgUnbondConvertAndStakeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSuperfluidAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetSuperfluidAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5246,6 +5486,179 @@ func (m *MsgUnbondConvertAndStakeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSuperfluidAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSuperfluidAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSuperfluidAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgSetSuperfluidAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSuperfluidAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSuperfluidAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -19,7 +19,8 @@ func GetTxCmd() *cobra.Command {
 		NewMintCmd(),
 		NewBurnCmd(),
 		// NewForceTransferCmd(),
-		NewChangeAdminCmd(),
+		NewProposeAdminTransferCmd(),
+		NewAcceptAdminCmd(),
 		NewSetBeforeSendHookCmd(),
 	)
 
@@ -47,10 +48,18 @@ func NewBurnCmd() *cobra.Command {
 	})
 }
 
-func NewChangeAdminCmd() *cobra.Command {
-	return osmocli.BuildTxCli[*types.MsgChangeAdmin](&osmocli.TxCliDesc{
-		Use:   "change-admin",
-		Short: "Changes the admin address for a factory-created denom. Must have admin authority to do so.",
+func NewProposeAdminTransferCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgProposeAdminTransfer](&osmocli.TxCliDesc{
+		Use:   "propose-admin-transfer",
+		Short: "Proposes a new admin address for a factory-created denom. Must have admin authority to do so.",
+		Long:  "Proposes a new admin address for a factory-created denom. The adminship is only transferred once the new admin accepts it with accept-admin.",
+	})
+}
+
+func NewAcceptAdminCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgAcceptAdmin](&osmocli.TxCliDesc{
+		Use:   "accept-admin",
+		Short: "Accepts the adminship of a factory-created denom. Must have been proposed as its new admin to do so.",
 	})
 }
 
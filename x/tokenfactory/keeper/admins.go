@@ -44,6 +44,19 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 	}
 
 	metadata.Admin = admin
+	// Changing the admin in any way discards a pending admin transfer.
+	metadata.PendingAdmin = ""
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+func (k Keeper) setPendingAdmin(ctx sdk.Context, denom string, pendingAdmin string) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	metadata.PendingAdmin = pendingAdmin
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
@@ -49,7 +49,9 @@ func (s *KeeperTestSuite) TestAdminMsgs() {
 	s.Require().True(bankKeeper.GetBalance(s.Ctx, s.TestAccs[1], s.defaultDenom).Amount.Int64() == addr1bal)
 
 	// Test Change Admin
-	_, err = s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAdmin(s.TestAccs[0].String(), s.defaultDenom, s.TestAccs[1].String()))
+	_, err = s.msgServer.ProposeAdminTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgProposeAdminTransfer(s.TestAccs[0].String(), s.defaultDenom, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AcceptAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgAcceptAdmin(s.TestAccs[1].String(), s.defaultDenom))
 	s.Require().NoError(err)
 	queryRes, err = s.queryClient.DenomAuthorityMetadata(s.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
 		Denom: s.defaultDenom,
@@ -364,16 +366,16 @@ func (s *KeeperTestSuite) TestChangeAdminDenom() {
 			expectedAdminIndex:      0,
 		},
 		{
-			desc: "success change admin",
+			desc: "admin can't directly change the admin to another account",
 			msgChangeAdmin: func(denom string) *types.MsgChangeAdmin {
 				return types.NewMsgChangeAdmin(s.TestAccs[0].String(), denom, s.TestAccs[1].String())
 			},
-			expectedAdminIndex:      1,
-			expectedChangeAdminPass: true,
+			expectedAdminIndex:      0,
+			expectedChangeAdminPass: false,
 			msgMint: func(denom string) *types.MsgMint {
 				return types.NewMsgMint(s.TestAccs[1].String(), sdk.NewInt64Coin(denom, 5))
 			},
-			expectedMintPass: true,
+			expectedMintPass: false,
 		},
 	} {
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
@@ -422,6 +424,110 @@ func (s *KeeperTestSuite) TestChangeAdminDenom() {
 	}
 }
 
+func (s *KeeperTestSuite) TestAdminTransferDenom() {
+	type adminTransferAction struct {
+		propose    bool
+		renounce   bool
+		senderIdx  int
+		newAdmin   int
+		expectPass bool
+	}
+	propose := func(senderIdx, newAdmin int, expectPass bool) adminTransferAction {
+		return adminTransferAction{propose: true, senderIdx: senderIdx, newAdmin: newAdmin, expectPass: expectPass}
+	}
+	accept := func(senderIdx int, expectPass bool) adminTransferAction {
+		return adminTransferAction{senderIdx: senderIdx, expectPass: expectPass}
+	}
+	renounce := func(senderIdx int) adminTransferAction {
+		return adminTransferAction{renounce: true, senderIdx: senderIdx, expectPass: true}
+	}
+
+	for _, tc := range []struct {
+		desc                 string
+		actions              []adminTransferAction
+		expectedAdminIndex   int
+		expectedPendingAdmin string
+	}{
+		{
+			desc:                 "proposing an admin transfer doesn't change the admin",
+			actions:              []adminTransferAction{propose(0, 1, true)},
+			expectedAdminIndex:   0,
+			expectedPendingAdmin: s.TestAccs[1].String(),
+		},
+		{
+			desc:               "non-admins can't propose an admin transfer",
+			actions:            []adminTransferAction{propose(1, 2, false)},
+			expectedAdminIndex: 0,
+		},
+		{
+			desc:               "accepting without a pending admin transfer fails",
+			actions:            []adminTransferAction{accept(1, false)},
+			expectedAdminIndex: 0,
+		},
+		{
+			desc:                 "only the proposed admin can accept",
+			actions:              []adminTransferAction{propose(0, 1, true), accept(2, false), accept(0, false)},
+			expectedAdminIndex:   0,
+			expectedPendingAdmin: s.TestAccs[1].String(),
+		},
+		{
+			desc:               "success admin transfer",
+			actions:            []adminTransferAction{propose(0, 1, true), accept(1, true)},
+			expectedAdminIndex: 1,
+		},
+		{
+			desc:               "a new proposal replaces the pending one",
+			actions:            []adminTransferAction{propose(0, 1, true), propose(0, 2, true), accept(1, false), accept(2, true)},
+			expectedAdminIndex: 2,
+		},
+		{
+			desc:               "renouncing the adminship discards the pending admin transfer",
+			actions:            []adminTransferAction{propose(0, 1, true), renounce(0), accept(1, false)},
+			expectedAdminIndex: -1,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			// setup test
+			s.SetupTest()
+
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+			s.Require().NoError(err)
+			testDenom := res.GetNewTokenDenom()
+
+			for _, action := range tc.actions {
+				sender := s.TestAccs[action.senderIdx].String()
+				switch {
+				case action.propose:
+					_, err = s.msgServer.ProposeAdminTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgProposeAdminTransfer(sender, testDenom, s.TestAccs[action.newAdmin].String()))
+				case action.renounce:
+					_, err = s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAdmin(sender, testDenom, ""))
+				default:
+					_, err = s.msgServer.AcceptAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgAcceptAdmin(sender, testDenom))
+				}
+				if action.expectPass {
+					s.Require().NoError(err)
+				} else {
+					s.Require().Error(err)
+				}
+			}
+
+			queryRes, err := s.queryClient.DenomAuthorityMetadata(s.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+				Denom: testDenom,
+			})
+			s.Require().NoError(err)
+
+			// expectedAdminIndex with negative value is assumed as admin with value of ""
+			const emptyStringAdminIndexFlag = -1
+			if tc.expectedAdminIndex == emptyStringAdminIndexFlag {
+				s.Require().Equal("", queryRes.AuthorityMetadata.Admin)
+			} else {
+				s.Require().Equal(s.TestAccs[tc.expectedAdminIndex].String(), queryRes.AuthorityMetadata.Admin)
+			}
+			s.Require().Equal(tc.expectedPendingAdmin, queryRes.AuthorityMetadata.PendingAdmin)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSetDenomMetaData() {
 	// setup test
 	s.SetupTest()
@@ -159,6 +159,12 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 		return nil, types.ErrUnauthorized
 	}
 
+	// The adminship can only be renounced directly, transfers go through
+	// ProposeAdminTransfer and AcceptAdmin.
+	if msg.NewAdmin != "" {
+		return nil, types.ErrDirectAdminChange
+	}
+
 	err = server.Keeper.setAdmin(ctx, msg.Denom, msg.NewAdmin)
 	if err != nil {
 		return nil, err
@@ -174,6 +180,64 @@ func (server msgServer) ChangeAdmin(goCtx context.Context, msg *types.MsgChangeA
 	return &types.MsgChangeAdminResponse{}, nil
 }
 
+func (server msgServer) ProposeAdminTransfer(goCtx context.Context, msg *types.MsgProposeAdminTransfer) (*types.MsgProposeAdminTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setPendingAdmin(ctx, msg.Denom, msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgProposeAdminTransfer,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePendingAdmin, msg.NewAdmin),
+		),
+	})
+
+	return &types.MsgProposeAdminTransferResponse{}, nil
+}
+
+func (server msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.GetPendingAdmin() == "" {
+		return nil, types.ErrNoPendingAdminTransfer
+	}
+
+	if msg.Sender != authorityMetadata.GetPendingAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setAdmin(ctx, msg.Denom, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgAcceptAdmin,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeNewAdmin, msg.Sender),
+		),
+	})
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
 func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
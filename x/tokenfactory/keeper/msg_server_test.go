@@ -158,17 +158,25 @@ func (s *KeeperTestSuite) TestChangeAdminDenomMsg() {
 			expectedAdminIndex:      0,
 		},
 		{
-			desc: "success change admin",
+			desc: "admin can't directly change the admin to another account",
 			msgChangeAdmin: func(denom string) *types.MsgChangeAdmin {
 				return types.NewMsgChangeAdmin(s.TestAccs[0].String(), denom, s.TestAccs[1].String())
 			},
-			expectedAdminIndex:      1,
+			expectedAdminIndex:      0,
+			expectedChangeAdminPass: false,
+		},
+		{
+			desc: "success renounce admin",
+			msgChangeAdmin: func(denom string) *types.MsgChangeAdmin {
+				return types.NewMsgChangeAdmin(s.TestAccs[0].String(), denom, "")
+			},
+			expectedAdminIndex:      -1,
 			expectedChangeAdminPass: true,
 			expectedMessageEvents:   1,
 			msgMint: func(denom string) *types.MsgMint {
-				return types.NewMsgMint(s.TestAccs[1].String(), sdk.NewInt64Coin(denom, 5))
+				return types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 5))
 			},
-			expectedMintPass: true,
+			expectedMintPass: false,
 		},
 	} {
 		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
@@ -195,6 +203,28 @@ func (s *KeeperTestSuite) TestChangeAdminDenomMsg() {
 	}
 }
 
+// TestAdminTransferMsgs tests TypeMsgProposeAdminTransfer and TypeMsgAcceptAdmin messages are emitted on a successful admin transfer
+func (s *KeeperTestSuite) TestAdminTransferMsgs() {
+	s.SetupTest()
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().Equal(0, len(ctx.EventManager().Events()))
+	// Create a denom
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	testDenom := res.GetNewTokenDenom()
+
+	// Test propose admin transfer message
+	_, err = s.msgServer.ProposeAdminTransfer(sdk.WrapSDKContext(ctx), types.NewMsgProposeAdminTransfer(s.TestAccs[0].String(), testDenom, s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, types.TypeMsgProposeAdminTransfer, 1)
+	s.AssertEventEmitted(ctx, types.TypeMsgAcceptAdmin, 0)
+
+	// Test accept admin message
+	_, err = s.msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), types.NewMsgAcceptAdmin(s.TestAccs[1].String(), testDenom))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, types.TypeMsgAcceptAdmin, 1)
+}
+
 // TestSetDenomMetaDataMsg tests TypeMsgSetDenomMetadata message is emitted on a successful denom metadata change
 func (s *KeeperTestSuite) TestSetDenomMetaDataMsg() {
 	// setup test
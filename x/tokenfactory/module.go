@@ -183,6 +183,7 @@ func (am AppModule) Actions() []simtypes.Action {
 		simtypes.NewMsgBasedAction("create token factory token", am.keeper, simulation.RandomMsgCreateDenom),
 		simtypes.NewMsgBasedAction("mint token factory token", am.keeper, simulation.RandomMsgMintDenom),
 		simtypes.NewMsgBasedAction("burn token factory token", am.keeper, simulation.RandomMsgBurnDenom),
-		simtypes.NewMsgBasedAction("change admin token factory token", am.keeper, simulation.RandomMsgChangeAdmin),
+		simtypes.NewMsgBasedAction("propose admin transfer token factory token", am.keeper, simulation.RandomMsgProposeAdminTransfer),
+		simtypes.NewMsgBasedAction("accept admin token factory token", am.keeper, simulation.RandomMsgAcceptAdmin),
 	}
 }
@@ -79,8 +79,8 @@ func RandomMsgBurnDenom(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context)
 	}, nil
 }
 
-// RandomMsgChangeAdmin takes a random denom that has been created and changes the admin to another random account
-func RandomMsgChangeAdmin(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context) (*types.MsgChangeAdmin, error) {
+// RandomMsgProposeAdminTransfer takes a random denom that has been created and proposes another random account as its admin
+func RandomMsgProposeAdminTransfer(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context) (*types.MsgProposeAdminTransfer, error) {
 	acc, senderExists := sim.RandomSimAccountWithConstraint(accountCreatedTokenFactoryDenom(k, ctx))
 	if !senderExists {
 		return nil, errors.New("no addr has created a tokenfactory coin")
@@ -99,13 +99,38 @@ func RandomMsgChangeAdmin(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context
 		return nil, errors.New("new admin cannot be the same as current admin")
 	}
 
-	return &types.MsgChangeAdmin{
+	return &types.MsgProposeAdminTransfer{
 		Sender:   addr.String(),
 		Denom:    denom,
 		NewAdmin: newAdmin.Address.String(),
 	}, nil
 }
 
+// RandomMsgAcceptAdmin takes a random denom that has been created and has its pending admin accept the adminship
+func RandomMsgAcceptAdmin(k keeper.Keeper, sim *simtypes.SimCtx, ctx sdk.Context) (*types.MsgAcceptAdmin, error) {
+	acc, senderExists := sim.RandomSimAccountWithConstraint(accountCreatedTokenFactoryDenom(k, ctx))
+	if !senderExists {
+		return nil, errors.New("no addr has created a tokenfactory coin")
+	}
+
+	store := k.GetCreatorPrefixStore(ctx, acc.Address.String())
+	denoms := osmoutils.GatherAllKeysFromStore(store)
+	denom := simtypes.RandSelect(sim, denoms...)
+
+	authData, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	if authData.PendingAdmin == "" {
+		return nil, errors.New("denom has no pending admin transfer")
+	}
+
+	return &types.MsgAcceptAdmin{
+		Sender: authData.PendingAdmin,
+		Denom:  denom,
+	}, nil
+}
+
 func accountCreatedTokenFactoryDenom(k keeper.Keeper, ctx sdk.Context) simtypes.SimAccountConstraint {
 	return func(acc legacysimulationtype.Account) bool {
 		store := k.GetCreatorPrefixStore(ctx, acc.Address.String())
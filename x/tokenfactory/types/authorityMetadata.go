@@ -11,5 +11,11 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+	if metadata.PendingAdmin != "" {
+		_, err := sdk.AccAddressFromBech32(metadata.PendingAdmin)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
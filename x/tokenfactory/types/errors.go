@@ -21,4 +21,6 @@ var (
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
 	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
+	ErrDirectAdminChange        = errorsmod.Register(ModuleName, 13, "admin can only be transferred with MsgProposeAdminTransfer and MsgAcceptAdmin")
+	ErrNoPendingAdminTransfer   = errorsmod.Register(ModuleName, 14, "no pending admin transfer")
 )
@@ -12,6 +12,7 @@ const (
 	AttributeTransferToAddress     = "transfer_to_address"
 	AttributeDenom                 = "denom"
 	AttributeNewAdmin              = "new_admin"
+	AttributePendingAdmin          = "pending_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
 )
@@ -45,6 +45,13 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.AuthorityMetadata.PendingAdmin != "" {
+			_, err = sdk.AccAddressFromBech32(denom.AuthorityMetadata.PendingAdmin)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid pending admin address (%s)", err)
+			}
+		}
 	}
 
 	return nil
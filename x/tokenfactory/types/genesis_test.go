@@ -86,6 +86,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "pending admin transfer",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							PendingAdmin: "osmo1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jrx5fj9",
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid pending admin",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:        "osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44",
+							PendingAdmin: "moose",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "multiple denoms",
 			genState: &types.GenesisState{
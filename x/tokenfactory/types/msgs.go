@@ -11,13 +11,15 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom       = "create_denom"
-	TypeMsgMint              = "tf_mint"
-	TypeMsgBurn              = "tf_burn"
-	TypeMsgForceTransfer     = "force_transfer"
-	TypeMsgChangeAdmin       = "change_admin"
-	TypeMsgSetDenomMetadata  = "set_denom_metadata"
-	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgCreateDenom          = "create_denom"
+	TypeMsgMint                 = "tf_mint"
+	TypeMsgBurn                 = "tf_burn"
+	TypeMsgForceTransfer        = "force_transfer"
+	TypeMsgChangeAdmin          = "change_admin"
+	TypeMsgSetDenomMetadata     = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook    = "set_before_send_hook"
+	TypeMsgProposeAdminTransfer = "propose_admin_transfer"
+	TypeMsgAcceptAdmin          = "accept_admin"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -187,7 +189,9 @@ func (m MsgForceTransfer) GetSigners() []sdk.AccAddress {
 
 var _ sdk.Msg = &MsgChangeAdmin{}
 
-// NewMsgChangeAdmin creates a message to burn tokens
+// NewMsgChangeAdmin creates a message to renounce the adminship of a denom.
+// Transferring the adminship to another account is done with
+// MsgProposeAdminTransfer and MsgAcceptAdmin instead.
 func NewMsgChangeAdmin(sender, denom, newAdmin string) *MsgChangeAdmin {
 	return &MsgChangeAdmin{
 		Sender:   sender,
@@ -204,9 +208,10 @@ func (m MsgChangeAdmin) ValidateBasic() error {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
-	_, err = sdk.AccAddressFromBech32(m.NewAdmin)
-	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	// Adminship can only be renounced directly, so that it can't be
+	// irrecoverably sent to a wrong address.
+	if m.NewAdmin != "" {
+		return errorsmod.Wrapf(ErrDirectAdminChange, "new admin must be empty to renounce the adminship, got %s", m.NewAdmin)
 	}
 
 	_, _, err = DeconstructDenom(m.Denom)
@@ -308,3 +313,79 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgProposeAdminTransfer{}
+
+// NewMsgProposeAdminTransfer creates a message to propose a new admin for a denom
+func NewMsgProposeAdminTransfer(sender, denom, newAdmin string) *MsgProposeAdminTransfer {
+	return &MsgProposeAdminTransfer{
+		Sender:   sender,
+		Denom:    denom,
+		NewAdmin: newAdmin,
+	}
+}
+
+func (m MsgProposeAdminTransfer) Route() string { return RouterKey }
+func (m MsgProposeAdminTransfer) Type() string  { return TypeMsgProposeAdminTransfer }
+func (m MsgProposeAdminTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.NewAdmin)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgProposeAdminTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgProposeAdminTransfer) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgAcceptAdmin{}
+
+// NewMsgAcceptAdmin creates a message to accept the adminship of a denom
+func NewMsgAcceptAdmin(sender, denom string) *MsgAcceptAdmin {
+	return &MsgAcceptAdmin{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+func (m MsgAcceptAdmin) Route() string { return RouterKey }
+func (m MsgAcceptAdmin) Type() string  { return TypeMsgAcceptAdmin }
+func (m MsgAcceptAdmin) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
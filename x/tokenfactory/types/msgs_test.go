@@ -54,6 +54,21 @@ func TestAuthzMsg(t *testing.T) {
 				NewAdmin: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
 			},
 		},
+		{
+			name: "MsgProposeAdminTransfer",
+			msg: &types.MsgProposeAdminTransfer{
+				Sender:   addr1,
+				Denom:    "denom",
+				NewAdmin: "osmo1q8tq5qhrhw6t970egemuuwywhlhpnmdmts6xnu",
+			},
+		},
+		{
+			name: "MsgAcceptAdmin",
+			msg: &types.MsgAcceptAdmin{
+				Sender: addr1,
+				Denom:  "denom",
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -283,7 +298,7 @@ func TestMsgChangeAdmin(t *testing.T) {
 	baseMsg := types.NewMsgChangeAdmin(
 		addr1.String(),
 		tokenFactoryDenom,
-		addr2.String(),
+		"",
 	)
 
 	// validate changeAdmin message was created as intended
@@ -316,10 +331,10 @@ func TestMsgChangeAdmin(t *testing.T) {
 			expectPass: false,
 		},
 		{
-			name: "empty newAdmin",
+			name: "non empty newAdmin",
 			msg: func() *types.MsgChangeAdmin {
 				msg := baseMsg
-				msg.NewAdmin = ""
+				msg.NewAdmin = addr2.String()
 				return msg
 			},
 			expectPass: false,
@@ -344,6 +359,107 @@ func TestMsgChangeAdmin(t *testing.T) {
 	}
 }
 
+// TestMsgProposeAdminTransfer tests if valid/invalid propose admin transfer messages are properly validated/invalidated
+func TestMsgProposeAdminTransfer(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate proposeAdminTransfer message was created as intended
+	baseMsg := types.NewMsgProposeAdminTransfer(addr1.String(), tokenFactoryDenom, addr2.String())
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "propose_admin_transfer")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgProposeAdminTransfer
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.NewMsgProposeAdminTransfer(addr1.String(), tokenFactoryDenom, addr2.String()),
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			msg:        types.NewMsgProposeAdminTransfer("", tokenFactoryDenom, addr2.String()),
+			expectPass: false,
+		},
+		{
+			name:       "empty newAdmin",
+			msg:        types.NewMsgProposeAdminTransfer(addr1.String(), tokenFactoryDenom, ""),
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			msg:        types.NewMsgProposeAdminTransfer(addr1.String(), "bitcoin", addr2.String()),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgAcceptAdmin tests if valid/invalid accept admin messages are properly validated/invalidated
+func TestMsgAcceptAdmin(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// validate acceptAdmin message was created as intended
+	baseMsg := types.NewMsgAcceptAdmin(addr2.String(), tokenFactoryDenom)
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "accept_admin")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr2.String())
+
+	tests := []struct {
+		name       string
+		msg        *types.MsgAcceptAdmin
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        types.NewMsgAcceptAdmin(addr2.String(), tokenFactoryDenom),
+			expectPass: true,
+		},
+		{
+			name:       "empty sender",
+			msg:        types.NewMsgAcceptAdmin("", tokenFactoryDenom),
+			expectPass: false,
+		},
+		{
+			name:       "invalid denom",
+			msg:        types.NewMsgAcceptAdmin(addr2.String(), "bitcoin"),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 // TestMsgSetDenomMetadata tests if valid/invalid create denom messages are properly validated/invalidated
 func TestMsgSetDenomMetadata(t *testing.T) {
 	// generate a private/public key pair and get the respective address
@@ -339,8 +339,9 @@ func (m *MsgBurnResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgBurnResponse proto.InternalMessageInfo
 
-// MsgChangeAdmin is the sdk.Msg type for allowing an admin account to reassign
-// adminship of a denom to a new account
+// MsgChangeAdmin is the sdk.Msg type for allowing an admin account to renounce
+// adminship of a denom. new_admin must be empty, adminship is transferred to a
+// new account with MsgProposeAdminTransfer and MsgAcceptAdmin instead.
 type MsgChangeAdmin struct {
 	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
@@ -735,6 +736,199 @@ func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
 
+// MsgProposeAdminTransfer is the sdk.Msg type for allowing an admin account to
+// propose a new admin for a denom. The adminship is only transferred once the
+// proposed account accepts it with MsgAcceptAdmin.
+type MsgProposeAdminTransfer struct {
+	Sender   string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom    string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	NewAdmin string `protobuf:"bytes,3,opt,name=new_admin,json=newAdmin,proto3" json:"new_admin,omitempty" yaml:"new_admin"`
+}
+
+func (m *MsgProposeAdminTransfer) Reset()         { *m = MsgProposeAdminTransfer{} }
+func (m *MsgProposeAdminTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminTransfer) ProtoMessage()    {}
+func (*MsgProposeAdminTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{14}
+}
+func (m *MsgProposeAdminTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdminTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdminTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeAdminTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminTransfer.Merge(m, src)
+}
+func (m *MsgProposeAdminTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdminTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeAdminTransfer proto.InternalMessageInfo
+
+func (m *MsgProposeAdminTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgProposeAdminTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgProposeAdminTransfer) GetNewAdmin() string {
+	if m != nil {
+		return m.NewAdmin
+	}
+	return ""
+}
+
+// MsgProposeAdminTransferResponse defines the response structure for an
+// executed MsgProposeAdminTransfer message.
+type MsgProposeAdminTransferResponse struct {
+}
+
+func (m *MsgProposeAdminTransferResponse) Reset()         { *m = MsgProposeAdminTransferResponse{} }
+func (m *MsgProposeAdminTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeAdminTransferResponse) ProtoMessage()    {}
+func (*MsgProposeAdminTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{15}
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeAdminTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeAdminTransferResponse.Merge(m, src)
+}
+func (m *MsgProposeAdminTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeAdminTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeAdminTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeAdminTransferResponse proto.InternalMessageInfo
+
+// MsgAcceptAdmin is the sdk.Msg type for allowing the account proposed with
+// MsgProposeAdminTransfer to accept the adminship of a denom
+type MsgAcceptAdmin struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgAcceptAdmin) Reset()         { *m = MsgAcceptAdmin{} }
+func (m *MsgAcceptAdmin) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdmin) ProtoMessage()    {}
+func (*MsgAcceptAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{16}
+}
+func (m *MsgAcceptAdmin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdmin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdmin.Merge(m, src)
+}
+func (m *MsgAcceptAdmin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAdmin proto.InternalMessageInfo
+
+func (m *MsgAcceptAdmin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAcceptAdmin) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgAcceptAdminResponse defines the response structure for an executed
+// MsgAcceptAdmin message.
+type MsgAcceptAdminResponse struct {
+}
+
+func (m *MsgAcceptAdminResponse) Reset()         { *m = MsgAcceptAdminResponse{} }
+func (m *MsgAcceptAdminResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAdminResponse) ProtoMessage()    {}
+func (*MsgAcceptAdminResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{17}
+}
+func (m *MsgAcceptAdminResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAdminResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAdminResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAdminResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAdminResponse.Merge(m, src)
+}
+func (m *MsgAcceptAdminResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAdminResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAdminResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAdminResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDenom)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenom")
 	proto.RegisterType((*MsgCreateDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgCreateDenomResponse")
@@ -750,6 +944,10 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransfer")
 	proto.RegisterType((*MsgForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransferResponse")
+	proto.RegisterType((*MsgProposeAdminTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgProposeAdminTransfer")
+	proto.RegisterType((*MsgProposeAdminTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgProposeAdminTransferResponse")
+	proto.RegisterType((*MsgAcceptAdmin)(nil), "osmosis.tokenfactory.v1beta1.MsgAcceptAdmin")
+	proto.RegisterType((*MsgAcceptAdminResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgAcceptAdminResponse")
 }
 
 func init() {
@@ -757,63 +955,68 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x36, 0x93, 0xd4, 0x75, 0x2e, 0x75, 0x25, 0xd1, 0x6e, 0xa2, 0x30, 0x0e, 0x99, 0x5e, 0x91,
-	0xc0, 0x2d, 0x2a, 0x12, 0x72, 0x8b, 0xa2, 0xd5, 0xd4, 0x28, 0x85, 0x91, 0xa1, 0x5a, 0x18, 0x4f,
-	0x45, 0x00, 0xe1, 0x28, 0x9d, 0x68, 0x42, 0xe1, 0x9d, 0xcb, 0x3b, 0x45, 0xf1, 0x56, 0xa0, 0x5b,
-	0xa7, 0x0e, 0xf9, 0x27, 0xba, 0xf5, 0x2f, 0xe8, 0x9c, 0x31, 0x40, 0x97, 0x4e, 0x84, 0x61, 0x03,
-	0xed, 0xce, 0xbf, 0xa0, 0xb8, 0x1f, 0xa4, 0x44, 0x4a, 0xb0, 0xa5, 0x21, 0xc8, 0x62, 0x98, 0x77,
-	0xdf, 0xf7, 0xee, 0x7d, 0xdf, 0xbd, 0xf7, 0x4e, 0xe0, 0x21, 0x65, 0x31, 0x65, 0x11, 0xf3, 0x38,
-	0x1d, 0x63, 0x32, 0x42, 0x03, 0x4e, 0x93, 0x53, 0xef, 0x65, 0x3b, 0xc0, 0x1c, 0xb5, 0x3d, 0xfe,
-	0xca, 0x3d, 0x49, 0x28, 0xa7, 0xe6, 0x9e, 0x86, 0xb9, 0xf3, 0x30, 0x57, 0xc3, 0xac, 0xdd, 0x90,
-	0x86, 0x54, 0x02, 0x3d, 0xf1, 0x9f, 0xe2, 0x58, 0x0d, 0x14, 0x47, 0x84, 0x7a, 0xf2, 0xaf, 0x5e,
-	0xb2, 0x07, 0x32, 0x8e, 0x17, 0x20, 0x86, 0x8b, 0x43, 0x06, 0x34, 0x22, 0x0b, 0xfb, 0x64, 0x5c,
-	0xec, 0x8b, 0x0f, 0xb5, 0x0f, 0x5f, 0x1b, 0xe0, 0xe3, 0x1e, 0x0b, 0x9f, 0x24, 0x18, 0x71, 0xfc,
-	0x03, 0x26, 0x34, 0x36, 0x3f, 0x07, 0x9b, 0x0c, 0x93, 0x21, 0x4e, 0x9a, 0xc6, 0x03, 0x63, 0xff,
-	0x66, 0xb7, 0x91, 0xa5, 0xce, 0xf6, 0x29, 0x8a, 0x5f, 0x74, 0xa0, 0x5a, 0x87, 0xbe, 0x06, 0x98,
-	0x1e, 0xd8, 0x62, 0x93, 0x60, 0x28, 0x68, 0xcd, 0x6b, 0x12, 0xbc, 0x93, 0xa5, 0x4e, 0x4d, 0x83,
-	0xf5, 0x0e, 0xf4, 0x0b, 0x50, 0xe7, 0xd1, 0x6f, 0xff, 0xfd, 0xf9, 0xc5, 0xa7, 0x4b, 0x1d, 0x1a,
-	0xc8, 0x14, 0x5a, 0x8a, 0xf2, 0x1c, 0xdc, 0x2e, 0x67, 0xe5, 0x63, 0x76, 0x42, 0x09, 0xc3, 0x66,
-	0x17, 0xd4, 0x08, 0x9e, 0xf6, 0x25, 0xb5, 0xaf, 0x4e, 0x56, 0x69, 0x5a, 0x59, 0xea, 0xdc, 0x56,
-	0x27, 0x57, 0x00, 0xd0, 0xdf, 0x26, 0x78, 0x7a, 0x24, 0x16, 0x64, 0x2c, 0x78, 0x66, 0x80, 0x0f,
-	0x7b, 0x2c, 0xec, 0x45, 0x84, 0xaf, 0xa3, 0xf6, 0x29, 0xd8, 0x44, 0x31, 0x9d, 0x10, 0x2e, 0xb5,
-	0xde, 0x3a, 0xb8, 0xeb, 0x2a, 0x73, 0x5d, 0x61, 0x7e, 0x7e, 0x75, 0xee, 0x13, 0x1a, 0x91, 0xee,
-	0x27, 0x6f, 0x52, 0x67, 0x63, 0x16, 0x49, 0xd1, 0xa0, 0xaf, 0xf9, 0xe6, 0xf7, 0x60, 0x3b, 0x8e,
-	0x08, 0x3f, 0xa2, 0x8f, 0x87, 0xc3, 0x04, 0x33, 0xd6, 0xbc, 0x5e, 0x95, 0x20, 0xb6, 0xfb, 0x9c,
-	0xf6, 0x91, 0x02, 0x40, 0xbf, 0x4c, 0xe8, 0xd8, 0xc2, 0xc8, 0xbb, 0x4b, 0x8d, 0x14, 0x40, 0xd8,
-	0x00, 0x35, 0xad, 0x30, 0x77, 0x0e, 0xfe, 0xab, 0x54, 0x77, 0x27, 0x09, 0x79, 0x3f, 0xaa, 0x0f,
-	0x41, 0x2d, 0x98, 0x24, 0xe4, 0x30, 0xa1, 0x71, 0x59, 0xf7, 0x5e, 0x96, 0x3a, 0x4d, 0xc5, 0x11,
-	0x80, 0xfe, 0x28, 0xa1, 0xf1, 0x4c, 0x79, 0x95, 0x74, 0x99, 0x76, 0x01, 0xd5, 0xda, 0x85, 0xce,
-	0x42, 0xfb, 0x5f, 0xba, 0xcc, 0x8f, 0x11, 0x09, 0xf1, 0xe3, 0x61, 0x1c, 0xad, 0x65, 0xc1, 0x23,
-	0xf0, 0xc1, 0x7c, 0x8d, 0xd7, 0xb3, 0xd4, 0xf9, 0x48, 0x21, 0x75, 0x7d, 0xa9, 0x6d, 0xb3, 0x0d,
-	0x6e, 0x8a, 0xd2, 0x43, 0x22, 0xbe, 0x96, 0xb6, 0x9b, 0xa5, 0x4e, 0x7d, 0x56, 0x95, 0x72, 0x0b,
-	0xfa, 0x5b, 0x04, 0x4f, 0x65, 0x16, 0x97, 0x36, 0x84, 0x4c, 0xb6, 0xa5, 0x28, 0x4d, 0xd5, 0x10,
-	0xb3, 0xfc, 0x0b, 0x69, 0x67, 0x06, 0xd8, 0xed, 0xb1, 0xf0, 0x19, 0xe6, 0x5d, 0x3c, 0xa2, 0x09,
-	0x7e, 0x86, 0xc9, 0xf0, 0x29, 0xa5, 0xe3, 0x77, 0x21, 0xf0, 0x10, 0xd4, 0xc5, 0xe5, 0x4f, 0x11,
-	0x2b, 0xee, 0x47, 0xeb, 0xbc, 0x97, 0xa5, 0xce, 0x1d, 0x45, 0xa9, 0x22, 0xa0, 0x5f, 0xcb, 0x97,
-	0xf2, 0x1b, 0x6c, 0x09, 0xd5, 0xfb, 0x4b, 0x55, 0x33, 0xcc, 0x5b, 0x81, 0x14, 0x22, 0x72, 0x6b,
-	0x1d, 0x53, 0x3a, 0x86, 0x36, 0xd8, 0x5b, 0xa6, 0xb0, 0xb0, 0xe0, 0xb5, 0x01, 0x76, 0x14, 0x40,
-	0xf6, 0x77, 0x0f, 0x73, 0x34, 0x44, 0x1c, 0xad, 0xe3, 0x80, 0x0f, 0xb6, 0x62, 0x4d, 0xd3, 0x75,
-	0x7e, 0x7f, 0x56, 0xe7, 0x64, 0x5c, 0xd4, 0x79, 0x1e, 0xbb, 0x7b, 0x47, 0xd7, 0xba, 0x1e, 0x76,
-	0x39, 0x19, 0xfa, 0x45, 0x1c, 0x78, 0x1f, 0xdc, 0x5b, 0x92, 0x55, 0x91, 0xf5, 0xdf, 0xd7, 0x40,
-	0xbd, 0xc7, 0xc2, 0x43, 0x9a, 0x0c, 0xf0, 0x51, 0x82, 0x08, 0x1b, 0xe1, 0xe4, 0xfd, 0x34, 0xa6,
-	0x0f, 0x76, 0xb8, 0x4e, 0x60, 0xb1, 0x39, 0x1f, 0x64, 0xa9, 0xb3, 0xa7, 0x78, 0x39, 0xa8, 0xd2,
-	0xa0, 0xcb, 0xc8, 0xe6, 0x8f, 0xa0, 0x91, 0x2f, 0xcf, 0xc6, 0xdc, 0x0d, 0x19, 0xd1, 0xce, 0x52,
-	0xc7, 0xaa, 0x44, 0x9c, 0x1f, 0x75, 0x8b, 0xc4, 0xce, 0xbe, 0x28, 0x98, 0xcf, 0x96, 0x16, 0xcc,
-	0x48, 0xf8, 0xd7, 0xca, 0x29, 0xd0, 0x02, 0xcd, 0xaa, 0xa9, 0xb9, 0xe3, 0x07, 0x7f, 0x6c, 0x82,
-	0xeb, 0x3d, 0x16, 0x9a, 0x3f, 0x83, 0x5b, 0xf3, 0x0f, 0xde, 0x97, 0xee, 0x65, 0x6f, 0xb1, 0x5b,
-	0x7e, 0x88, 0xac, 0xaf, 0xd7, 0x41, 0x17, 0xcf, 0xd6, 0x73, 0x70, 0x43, 0x3e, 0x37, 0x0f, 0xaf,
-	0x64, 0x0b, 0x98, 0xd5, 0x5a, 0x09, 0x36, 0x1f, 0x5d, 0x8e, 0xf5, 0xab, 0xa3, 0x0b, 0xd8, 0x0a,
-	0xd1, 0xe7, 0x87, 0xa7, 0xb4, 0x6b, 0x6e, 0x70, 0xae, 0x60, 0xd7, 0x0c, 0xbd, 0x8a, 0x5d, 0x8b,
-	0x43, 0xcd, 0xfc, 0xc5, 0x00, 0xf5, 0x85, 0x76, 0x6e, 0x5f, 0x19, 0xaa, 0x4a, 0xb1, 0xbe, 0x5b,
-	0x9b, 0x52, 0xa4, 0xf0, 0xab, 0x01, 0x1a, 0x8b, 0x43, 0xf5, 0x60, 0x95, 0x80, 0x65, 0x8e, 0xd5,
-	0x59, 0x9f, 0x53, 0x64, 0x31, 0x05, 0xdb, 0xe5, 0x01, 0xe1, 0x5e, 0x19, 0xac, 0x84, 0xb7, 0xbe,
-	0x59, 0x0f, 0x9f, 0x1f, 0xdc, 0xf5, 0xdf, 0x9c, 0xdb, 0xc6, 0xdb, 0x73, 0xdb, 0x38, 0x3b, 0xb7,
-	0x8d, 0xdf, 0x2f, 0xec, 0x8d, 0xb7, 0x17, 0xf6, 0xc6, 0x3f, 0x17, 0xf6, 0xc6, 0x4f, 0xdf, 0x86,
-	0x11, 0x3f, 0x9e, 0x04, 0xee, 0x80, 0xc6, 0x9e, 0x8e, 0xdd, 0x7a, 0x81, 0x02, 0x96, 0x7f, 0x78,
-	0x2f, 0x0f, 0xda, 0xde, 0xab, 0x72, 0x93, 0xf2, 0xd3, 0x13, 0xcc, 0x82, 0x4d, 0xf9, 0x9b, 0xf3,
-	0xab, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x99, 0x19, 0x55, 0x23, 0x0b, 0x00, 0x00,
+	// 967 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xc5, 0x57, 0xcd, 0x4f, 0x13, 0x41,
+	0x14, 0xa7, 0x80, 0x08, 0x83, 0x48, 0xbb, 0x20, 0x94, 0x05, 0x0a, 0xac, 0x81, 0xe0, 0x47, 0x77,
+	0x2d, 0x7e, 0x44, 0x9b, 0x98, 0x48, 0x31, 0x84, 0x83, 0x24, 0xa6, 0x70, 0x32, 0x24, 0x64, 0xdb,
+	0x4e, 0x4b, 0x03, 0xdd, 0xa9, 0x3b, 0xcb, 0xd7, 0xcd, 0xc4, 0x9b, 0x7a, 0xf0, 0xe0, 0x7f, 0xe1,
+	0xc5, 0xbf, 0xc0, 0x33, 0x47, 0x8c, 0x17, 0x4f, 0x84, 0x68, 0xa2, 0x77, 0xff, 0x02, 0xdf, 0x7c,
+	0xec, 0x76, 0xb7, 0x5d, 0x69, 0x4b, 0x42, 0x38, 0x6c, 0xb3, 0x3b, 0xef, 0xf7, 0x7b, 0xf3, 0x7e,
+	0x6f, 0xde, 0xbc, 0x99, 0xa2, 0x59, 0x42, 0x2b, 0x84, 0x96, 0xa9, 0xe1, 0x90, 0x6d, 0x6c, 0x15,
+	0xcd, 0xbc, 0x43, 0xec, 0x43, 0x63, 0x2f, 0x95, 0xc3, 0x8e, 0x99, 0x32, 0x9c, 0x03, 0xbd, 0x6a,
+	0x13, 0x87, 0x28, 0x13, 0x12, 0xa6, 0xfb, 0x61, 0xba, 0x84, 0xa9, 0xc3, 0x25, 0x52, 0x22, 0x1c,
+	0x68, 0xb0, 0x37, 0xc1, 0x51, 0x63, 0x66, 0xa5, 0x6c, 0x11, 0x83, 0xff, 0xca, 0xa1, 0x44, 0x9e,
+	0xfb, 0x31, 0x72, 0x26, 0xc5, 0xde, 0x24, 0x79, 0x52, 0xb6, 0x1a, 0xec, 0xd6, 0xb6, 0x67, 0x67,
+	0x1f, 0xc2, 0xae, 0x7d, 0x8a, 0xa0, 0xeb, 0xab, 0xb4, 0xb4, 0x64, 0x63, 0xd3, 0xc1, 0xcf, 0xb1,
+	0x45, 0x2a, 0xca, 0x2d, 0xd4, 0x43, 0xb1, 0x55, 0xc0, 0x76, 0x3c, 0x32, 0x1d, 0x99, 0xef, 0xcb,
+	0xc4, 0xfe, 0x9e, 0x4c, 0x0d, 0x1c, 0x9a, 0x95, 0x9d, 0xb4, 0x26, 0xc6, 0xb5, 0xac, 0x04, 0x28,
+	0x06, 0xea, 0xa5, 0xbb, 0xb9, 0x02, 0xa3, 0xc5, 0x3b, 0x39, 0x78, 0x08, 0xc0, 0x83, 0x12, 0x2c,
+	0x2d, 0x5a, 0xd6, 0x03, 0xa5, 0xe7, 0xde, 0xfd, 0xf9, 0x72, 0x7b, 0x26, 0x34, 0x43, 0x79, 0x1e,
+	0x42, 0x52, 0x50, 0x36, 0xd0, 0x48, 0x30, 0xaa, 0x2c, 0xa6, 0x55, 0x62, 0x51, 0xac, 0x64, 0xd0,
+	0xa0, 0x85, 0xf7, 0x37, 0x39, 0x75, 0x53, 0xcc, 0x2c, 0xc2, 0x54, 0x61, 0xe6, 0x11, 0x31, 0x73,
+	0x1d, 0x40, 0xcb, 0x0e, 0xc0, 0xc8, 0x3a, 0x1b, 0xe0, 0xbe, 0xb4, 0xd3, 0x08, 0xba, 0x0a, 0xee,
+	0x57, 0xcb, 0x96, 0xd3, 0x8e, 0xda, 0x15, 0xd4, 0x63, 0x56, 0xc8, 0xae, 0xe5, 0x70, 0xad, 0xfd,
+	0x0b, 0x63, 0xba, 0x48, 0xae, 0xce, 0x92, 0xef, 0x2e, 0x9d, 0xbe, 0x04, 0xc9, 0xcf, 0xdc, 0x38,
+	0x3a, 0x99, 0xea, 0xa8, 0x79, 0x12, 0x34, 0xf0, 0x24, 0x5e, 0x94, 0x67, 0x68, 0x00, 0xd6, 0xd0,
+	0x59, 0x27, 0x8b, 0x85, 0x82, 0x8d, 0x29, 0x8d, 0x77, 0xd5, 0x4b, 0x60, 0x66, 0xd0, 0xb0, 0x69,
+	0x0a, 0x00, 0x48, 0x08, 0x10, 0xd2, 0x09, 0x96, 0xc8, 0xb1, 0xd0, 0x44, 0x32, 0xa0, 0x16, 0x43,
+	0x83, 0x52, 0xa1, 0x9b, 0x39, 0xed, 0xb7, 0x50, 0x9d, 0xd9, 0xb5, 0xad, 0xcb, 0x51, 0xbd, 0x8c,
+	0x06, 0x73, 0x30, 0xf9, 0xb2, 0x4d, 0x2a, 0x41, 0xdd, 0x13, 0xc0, 0x89, 0x0b, 0x0e, 0x03, 0x6c,
+	0x16, 0x01, 0x51, 0x53, 0x5e, 0x4f, 0x3a, 0x4b, 0x3b, 0x83, 0x4a, 0xed, 0x4c, 0xa7, 0xa7, 0xfd,
+	0xab, 0x2c, 0xf3, 0x2d, 0xd3, 0x2a, 0xe1, 0xc5, 0x02, 0xa4, 0xa8, 0x9d, 0x14, 0xcc, 0xa1, 0x2b,
+	0xfe, 0x1a, 0x8f, 0x02, 0xf2, 0x9a, 0x40, 0xca, 0xfa, 0x12, 0x66, 0x25, 0x85, 0xfa, 0x58, 0xe9,
+	0x99, 0xcc, 0xbf, 0x94, 0x36, 0x0c, 0xd8, 0x68, 0xad, 0x2a, 0xb9, 0x09, 0x36, 0x04, 0xbc, 0xf3,
+	0x28, 0xce, 0xdc, 0x10, 0x3c, 0xd8, 0xa4, 0xa0, 0xc4, 0xc5, 0x86, 0xa8, 0xc5, 0xef, 0x49, 0x83,
+	0x62, 0x1e, 0x06, 0xd3, 0x1a, 0x76, 0x32, 0xb8, 0x48, 0x6c, 0xbc, 0x06, 0x31, 0xaf, 0x10, 0xb2,
+	0x7d, 0x11, 0x02, 0x97, 0x51, 0x94, 0x2d, 0xfe, 0xbe, 0x49, 0xbd, 0xf5, 0x91, 0x3a, 0xc7, 0x81,
+	0x32, 0x2a, 0x28, 0xf5, 0x08, 0x58, 0x41, 0x77, 0xc8, 0x5d, 0xc1, 0x24, 0x53, 0x3d, 0x1f, 0xaa,
+	0x9a, 0x62, 0x27, 0x99, 0xe3, 0x42, 0x58, 0x6c, 0xc9, 0x2d, 0x50, 0xa2, 0x25, 0xd0, 0x44, 0x98,
+	0x42, 0x2f, 0x05, 0xd0, 0xc4, 0x86, 0x04, 0x80, 0xef, 0xef, 0x55, 0xa8, 0xc8, 0x82, 0xe9, 0x98,
+	0xed, 0x64, 0x20, 0x8b, 0x7a, 0x2b, 0x92, 0x26, 0xeb, 0x7c, 0xb2, 0x56, 0xe7, 0xd0, 0x2d, 0xdd,
+	0x3a, 0x77, 0x7d, 0x67, 0x46, 0x65, 0xad, 0xcb, 0x66, 0xe7, 0x92, 0x61, 0x6d, 0xbd, 0xd7, 0x49,
+	0x34, 0x1e, 0x12, 0x95, 0x17, 0xf5, 0xf7, 0x4e, 0x14, 0x05, 0xfb, 0x32, 0xb1, 0xf3, 0x78, 0xdd,
+	0x36, 0x2d, 0x5a, 0x84, 0x38, 0x2e, 0x65, 0x63, 0x66, 0xd1, 0x90, 0x23, 0x03, 0x68, 0xdc, 0x9c,
+	0xd3, 0xc0, 0x9b, 0x10, 0x3c, 0x17, 0x54, 0xb7, 0x41, 0xc3, 0xc8, 0xca, 0x0b, 0x14, 0x73, 0x87,
+	0x6b, 0x6d, 0xae, 0x9b, 0x7b, 0x4c, 0x80, 0x47, 0xb5, 0xce, 0xa3, 0xbf, 0xd5, 0x35, 0x12, 0xd3,
+	0xf3, 0xac, 0x60, 0x6e, 0x86, 0x16, 0x4c, 0x91, 0xe5, 0x2f, 0xe9, 0x52, 0x34, 0x15, 0xc5, 0xeb,
+	0x93, 0xea, 0x65, 0xfc, 0x5b, 0x04, 0x8d, 0x82, 0xf1, 0xa5, 0x4d, 0xaa, 0x84, 0x8a, 0x6d, 0x74,
+	0x9e, 0xc4, 0x5f, 0x60, 0x3b, 0xb8, 0xc7, 0x74, 0xde, 0x09, 0xd5, 0x59, 0x15, 0x51, 0x8b, 0x7e,
+	0x50, 0xd3, 0x3b, 0x83, 0xa6, 0xfe, 0x23, 0xc9, 0x93, 0xfd, 0x5e, 0x34, 0xbf, 0xc5, 0x7c, 0x1e,
+	0x57, 0x9d, 0x8b, 0x6a, 0x7e, 0x67, 0x75, 0x32, 0x93, 0xcf, 0x1c, 0xe8, 0x64, 0xbe, 0x60, 0xdc,
+	0x38, 0x17, 0x3e, 0xf7, 0xa2, 0x2e, 0x30, 0x29, 0xaf, 0x51, 0xbf, 0xff, 0x3e, 0x72, 0x57, 0x3f,
+	0xeb, 0xaa, 0xa4, 0x07, 0xef, 0x09, 0xea, 0x83, 0x76, 0xd0, 0xde, 0xad, 0x62, 0x03, 0x75, 0xf3,
+	0xdb, 0xc0, 0x6c, 0x53, 0x36, 0x83, 0xa9, 0xc9, 0x96, 0x60, 0x7e, 0xef, 0xfc, 0xd4, 0x6d, 0xee,
+	0x9d, 0xc1, 0x5a, 0xf0, 0xee, 0x3f, 0xdb, 0x78, 0xba, 0x7c, 0xe7, 0x5a, 0x0b, 0xe9, 0xaa, 0xa1,
+	0x5b, 0x49, 0x57, 0xe3, 0x99, 0xa3, 0xbc, 0x89, 0xa0, 0x68, 0x43, 0xb7, 0x4d, 0x35, 0x75, 0x55,
+	0x4f, 0x51, 0x9f, 0xb4, 0x4d, 0xf1, 0x42, 0x78, 0x1b, 0x41, 0xb1, 0xc6, 0x33, 0x6f, 0xa1, 0x15,
+	0x87, 0x41, 0x8e, 0x9a, 0x6e, 0x9f, 0xe3, 0x45, 0xb1, 0x8f, 0x06, 0x82, 0xfd, 0x5b, 0x6f, 0xea,
+	0x2c, 0x80, 0x57, 0x1f, 0xb5, 0x87, 0xf7, 0x26, 0xfe, 0x00, 0xa7, 0x7e, 0x68, 0x1f, 0x7b, 0xd8,
+	0xd4, 0x61, 0x18, 0x4d, 0x7d, 0x7a, 0x2e, 0x9a, 0xbf, 0x06, 0xfd, 0xed, 0xa5, 0x79, 0x0d, 0xfa,
+	0xd0, 0x2d, 0xd4, 0x60, 0x48, 0xb7, 0xc8, 0x64, 0x8f, 0x7e, 0x26, 0x22, 0xc7, 0xf0, 0x9c, 0xc2,
+	0xf3, 0xf1, 0x57, 0xa2, 0xe3, 0x18, 0x9e, 0x1f, 0xf0, 0xbc, 0x7a, 0x5c, 0x2a, 0x3b, 0x5b, 0xbb,
+	0x39, 0x38, 0x0e, 0x2b, 0x86, 0xf4, 0x9c, 0xdc, 0x31, 0x73, 0xd4, 0xfd, 0x30, 0xf6, 0x16, 0x52,
+	0xc6, 0x41, 0xb0, 0x45, 0x39, 0x87, 0x55, 0x4c, 0x73, 0x3d, 0xfc, 0x4f, 0xd1, 0xfd, 0x7f, 0xf4,
+	0x32, 0x3b, 0x1d, 0xc4, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -835,6 +1038,8 @@ type MsgClient interface {
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	ProposeAdminTransfer(ctx context.Context, in *MsgProposeAdminTransfer, opts ...grpc.CallOption) (*MsgProposeAdminTransferResponse, error)
+	AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error)
 }
 
 type msgClient struct {
@@ -908,6 +1113,24 @@ func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opt
 	return out, nil
 }
 
+func (c *msgClient) ProposeAdminTransfer(ctx context.Context, in *MsgProposeAdminTransfer, opts ...grpc.CallOption) (*MsgProposeAdminTransferResponse, error) {
+	out := new(MsgProposeAdminTransferResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/ProposeAdminTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptAdmin(ctx context.Context, in *MsgAcceptAdmin, opts ...grpc.CallOption) (*MsgAcceptAdminResponse, error) {
+	out := new(MsgAcceptAdminResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/AcceptAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -917,6 +1140,8 @@ type MsgServer interface {
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	ProposeAdminTransfer(context.Context, *MsgProposeAdminTransfer) (*MsgProposeAdminTransferResponse, error)
+	AcceptAdmin(context.Context, *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -944,6 +1169,12 @@ func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
 }
+func (*UnimplementedMsgServer) ProposeAdminTransfer(ctx context.Context, req *MsgProposeAdminTransfer) (*MsgProposeAdminTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeAdminTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptAdmin(ctx context.Context, req *MsgAcceptAdmin) (*MsgAcceptAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAdmin not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1075,6 +1306,42 @@ func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeAdminTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeAdminTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeAdminTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/ProposeAdminTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeAdminTransfer(ctx, req.(*MsgProposeAdminTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/AcceptAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAdmin(ctx, req.(*MsgAcceptAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1107,6 +1374,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransfer",
 			Handler:    _Msg_ForceTransfer_Handler,
 		},
+		{
+			MethodName: "ProposeAdminTransfer",
+			Handler:    _Msg_ProposeAdminTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptAdmin",
+			Handler:    _Msg_AcceptAdmin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/tx.proto",
@@ -1593,54 +1868,181 @@ func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgProposeAdminTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgProposeAdminTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewAdmin) > 0 {
+		i -= len(m.NewAdmin)
+		copy(dAtA[i:], m.NewAdmin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewAdmin)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Subdenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgProposeAdminTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeAdminTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeAdminTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NewTokenDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgMint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptAdmin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdmin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewTokenDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1806,6 +2208,62 @@ func (m *MsgForceTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgProposeAdminTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAdmin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeAdminTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAdmin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3188,6 +3646,370 @@ func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgProposeAdminTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeAdminTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeAdminTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgProposeAdminTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeAdminTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeAdminTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAcceptAdmin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAdmin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MsgAcceptAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
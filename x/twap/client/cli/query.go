@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	cmd.AddCommand(GetQueryArithmeticCommand())
 	cmd.AddCommand(GetQueryGeometricCommand())
+	cmd.AddCommand(GetQuerySpotPriceAnomaliesCommand())
 
 	return cmd
 }
@@ -134,6 +135,17 @@ Example:
 	return cmd
 }
 
+// GetQuerySpotPriceAnomaliesCommand returns a command querying the pools flagged by their spot price guard.
+func GetQuerySpotPriceAnomaliesCommand() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.SpotPriceAnomaliesRequest](
+		"spot-price-anomalies",
+		"Query the pools flagged by their spot price guard",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} spot-price-anomalies`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
+
 // getQuoteDenomFromLiquidity gets the quote liquidity denom from the pool. In addition, validates that base denom
 // exists in the pool. Fails if not.
 func getQuoteDenomFromLiquidity(ctx context.Context, clientCtx client.Context, poolId uint64, baseDenom string) (string, error) {
@@ -67,3 +67,10 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &queryproto.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) SpotPriceAnomalies(ctx sdk.Context,
+	req queryproto.SpotPriceAnomaliesRequest,
+) (*queryproto.SpotPriceAnomaliesResponse, error) {
+	anomalies, err := q.K.GetAllSpotPriceAnomalies(ctx)
+	return &queryproto.SpotPriceAnomaliesResponse{Anomalies: anomalies}, err
+}
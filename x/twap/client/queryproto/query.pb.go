@@ -555,6 +555,86 @@ func (m *ParamsResponse) GetParams() types.Params {
 	return types.Params{}
 }
 
+type SpotPriceAnomaliesRequest struct {
+}
+
+func (m *SpotPriceAnomaliesRequest) Reset()         { *m = SpotPriceAnomaliesRequest{} }
+func (m *SpotPriceAnomaliesRequest) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceAnomaliesRequest) ProtoMessage()    {}
+func (*SpotPriceAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{10}
+}
+func (m *SpotPriceAnomaliesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceAnomaliesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceAnomaliesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceAnomaliesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceAnomaliesRequest.Merge(m, src)
+}
+func (m *SpotPriceAnomaliesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceAnomaliesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceAnomaliesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPriceAnomaliesRequest proto.InternalMessageInfo
+
+type SpotPriceAnomaliesResponse struct {
+	Anomalies []types.SpotPriceAnomaly `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies"`
+}
+
+func (m *SpotPriceAnomaliesResponse) Reset()         { *m = SpotPriceAnomaliesResponse{} }
+func (m *SpotPriceAnomaliesResponse) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceAnomaliesResponse) ProtoMessage()    {}
+func (*SpotPriceAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141a22dba58615af, []int{11}
+}
+func (m *SpotPriceAnomaliesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceAnomaliesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceAnomaliesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceAnomaliesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceAnomaliesResponse.Merge(m, src)
+}
+func (m *SpotPriceAnomaliesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceAnomaliesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceAnomaliesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPriceAnomaliesResponse proto.InternalMessageInfo
+
+func (m *SpotPriceAnomaliesResponse) GetAnomalies() []types.SpotPriceAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ArithmeticTwapRequest)(nil), "osmosis.twap.v1beta1.ArithmeticTwapRequest")
 	proto.RegisterType((*ArithmeticTwapResponse)(nil), "osmosis.twap.v1beta1.ArithmeticTwapResponse")
@@ -566,62 +646,67 @@ func init() {
 	proto.RegisterType((*GeometricTwapToNowResponse)(nil), "osmosis.twap.v1beta1.GeometricTwapToNowResponse")
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.twap.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.twap.v1beta1.ParamsResponse")
+	proto.RegisterType((*SpotPriceAnomaliesRequest)(nil), "osmosis.twap.v1beta1.SpotPriceAnomaliesRequest")
+	proto.RegisterType((*SpotPriceAnomaliesResponse)(nil), "osmosis.twap.v1beta1.SpotPriceAnomaliesResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/twap/v1beta1/query.proto", fileDescriptor_141a22dba58615af) }
 
 var fileDescriptor_141a22dba58615af = []byte{
-	// 800 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x41, 0x4f, 0xe3, 0x46,
-	0x14, 0xc7, 0x33, 0x29, 0x84, 0x66, 0x10, 0x41, 0x9d, 0x02, 0x05, 0x03, 0x76, 0x64, 0x28, 0x4a,
-	0x09, 0xb5, 0x49, 0x7a, 0x43, 0xf4, 0x40, 0x54, 0xa9, 0xaa, 0x84, 0xaa, 0xd6, 0x42, 0x55, 0xd5,
-	0x4b, 0x34, 0x71, 0x06, 0x63, 0x35, 0xf6, 0x18, 0x7b, 0x02, 0x8d, 0xd4, 0x43, 0x5b, 0xa9, 0x77,
-	0xd4, 0xaa, 0x87, 0x1e, 0xda, 0x7b, 0x0f, 0xfd, 0x1e, 0x9c, 0x5a, 0xa4, 0xbd, 0xac, 0xf6, 0x90,
-	0x5d, 0xc1, 0x7e, 0x02, 0x3e, 0xc1, 0xca, 0x33, 0xe3, 0x6c, 0x9c, 0xb5, 0x58, 0xef, 0x05, 0x09,
-	0x69, 0x4f, 0x30, 0xf3, 0xfe, 0xef, 0xfd, 0x7f, 0xf3, 0x66, 0x3c, 0x13, 0x58, 0xa5, 0x91, 0x47,
-	0x23, 0x37, 0x32, 0xd9, 0x39, 0x0e, 0xcc, 0xb3, 0x46, 0x87, 0x30, 0xdc, 0x30, 0x4f, 0xfb, 0x24,
-	0x1c, 0x18, 0x41, 0x48, 0x19, 0x45, 0x0b, 0x52, 0x61, 0xc4, 0x0a, 0x43, 0x2a, 0x94, 0x05, 0x87,
-	0x3a, 0x94, 0x0b, 0xcc, 0xf8, 0x3f, 0xa1, 0x55, 0xb6, 0x32, 0xab, 0xc5, 0x83, 0x76, 0x48, 0x6c,
-	0x1a, 0x76, 0xa5, 0x4e, 0xcf, 0xd4, 0x39, 0xc4, 0x27, 0xb1, 0x91, 0xd0, 0xa8, 0x36, 0x17, 0x99,
-	0x1d, 0x1c, 0x91, 0x91, 0xc4, 0xa6, 0xae, 0x2f, 0xe3, 0xdb, 0xe3, 0x71, 0x0e, 0x3c, 0x52, 0x05,
-	0xd8, 0x71, 0x7d, 0xcc, 0x5c, 0x9a, 0x68, 0xd7, 0x1c, 0x4a, 0x9d, 0x1e, 0x31, 0x71, 0xe0, 0x9a,
-	0xd8, 0xf7, 0x29, 0xe3, 0xc1, 0xc4, 0x69, 0x45, 0x46, 0xf9, 0xa8, 0xd3, 0x3f, 0x36, 0xb1, 0x3f,
-	0x48, 0x42, 0xc2, 0xa4, 0x2d, 0x56, 0x2a, 0x06, 0x32, 0xa4, 0x4d, 0x66, 0x31, 0xd7, 0x23, 0x11,
-	0xc3, 0x5e, 0x20, 0x04, 0xfa, 0xdf, 0x45, 0xb8, 0x78, 0x10, 0xba, 0xec, 0xc4, 0x23, 0xcc, 0xb5,
-	0x8f, 0xce, 0x71, 0x60, 0x91, 0xd3, 0x3e, 0x89, 0x18, 0xfa, 0x00, 0xce, 0x04, 0x94, 0xf6, 0xda,
-	0x6e, 0x77, 0x19, 0x54, 0x41, 0x6d, 0xca, 0x2a, 0xc5, 0xc3, 0x2f, 0xba, 0x68, 0x1d, 0xc2, 0x78,
-	0x39, 0x6d, 0x1c, 0x45, 0x84, 0x2d, 0x17, 0xab, 0xa0, 0x56, 0xb6, 0xca, 0xf1, 0xcc, 0x41, 0x3c,
-	0x81, 0x34, 0x38, 0x7b, 0xda, 0xa7, 0x2c, 0x89, 0xbf, 0xc3, 0xe3, 0x90, 0x4f, 0x09, 0xc1, 0xb7,
-	0x10, 0x46, 0x0c, 0x87, 0xac, 0x1d, 0xb3, 0x2c, 0x4f, 0x55, 0x41, 0x6d, 0xb6, 0xa9, 0x18, 0x02,
-	0xd4, 0x48, 0x40, 0x8d, 0xa3, 0x04, 0xb4, 0xb5, 0x7e, 0x39, 0xd4, 0x0a, 0xb7, 0x43, 0xed, 0xbd,
-	0x01, 0xf6, 0x7a, 0x7b, 0xfa, 0xcb, 0x5c, 0xfd, 0xe2, 0xa9, 0x06, 0xac, 0x32, 0x9f, 0x88, 0xe5,
-	0xc8, 0x82, 0xef, 0x12, 0xbf, 0x2b, 0xea, 0x4e, 0xbf, 0xb6, 0xee, 0xea, 0xe5, 0x50, 0x03, 0xb7,
-	0x43, 0x6d, 0x5e, 0xd4, 0x4d, 0x32, 0x45, 0xd5, 0x19, 0xe2, 0x77, 0x63, 0xa9, 0xfe, 0x13, 0x80,
-	0x4b, 0x93, 0x0d, 0x8a, 0x02, 0xea, 0x47, 0x04, 0x1d, 0xc3, 0x79, 0x3c, 0x8a, 0xb4, 0xe3, 0x53,
-	0xc2, 0x3b, 0x55, 0x6e, 0x7d, 0x1a, 0x13, 0x3f, 0x19, 0x6a, 0xab, 0x62, 0x2f, 0xa2, 0xee, 0xf7,
-	0x86, 0x4b, 0x4d, 0x0f, 0xb3, 0x13, 0xe3, 0x90, 0x38, 0xd8, 0x1e, 0x7c, 0x46, 0xec, 0xdb, 0xa1,
-	0xb6, 0x24, 0x8c, 0x27, 0x6a, 0xe8, 0x56, 0x05, 0xa7, 0xfc, 0xf4, 0xff, 0x01, 0x54, 0xd2, 0x08,
-	0x47, 0xf4, 0x4b, 0x7a, 0xfe, 0x70, 0x37, 0x4a, 0xff, 0x15, 0xc0, 0xd5, 0xcc, 0x15, 0xdd, 0x73,
-	0x67, 0xff, 0x2a, 0xc2, 0x85, 0xcf, 0x09, 0xf5, 0x08, 0x0b, 0xdf, 0x1e, 0xfe, 0x8c, 0xc3, 0xff,
-	0x23, 0x5c, 0x9c, 0x68, 0x8f, 0xdc, 0x20, 0x1b, 0x56, 0x9c, 0x24, 0x30, 0xbe, 0x3f, 0xfb, 0xf9,
-	0xf6, 0x67, 0x51, 0xb8, 0xa6, 0x4b, 0xe8, 0xd6, 0x9c, 0x33, 0x6e, 0xa6, 0xff, 0x07, 0xe0, 0x4a,
-	0xca, 0xfe, 0xa1, 0x1f, 0xfb, 0x9f, 0x01, 0x54, 0xb2, 0x16, 0x74, 0x9f, 0x4d, 0x9d, 0x87, 0x73,
-	0x5f, 0xe1, 0x10, 0x7b, 0x91, 0xec, 0xa3, 0x7e, 0x08, 0x2b, 0xc9, 0x84, 0xe4, 0xd8, 0x83, 0xa5,
-	0x80, 0xcf, 0x70, 0xff, 0xd9, 0xe6, 0x9a, 0x91, 0xf5, 0xba, 0x1a, 0x22, 0xab, 0x35, 0x15, 0xd3,
-	0x59, 0x32, 0xa3, 0xf9, 0x5b, 0x09, 0x4e, 0x7f, 0x1d, 0xbf, 0x73, 0x68, 0x00, 0x4b, 0x42, 0x81,
-	0x36, 0xee, 0xca, 0x97, 0x18, 0xca, 0xe6, 0xdd, 0x22, 0x81, 0xa6, 0x6f, 0xfe, 0xf2, 0xe8, 0xf9,
-	0xef, 0x45, 0x15, 0xad, 0x99, 0x99, 0x8f, 0xb3, 0x34, 0xfc, 0x13, 0xc0, 0x4a, 0xfa, 0x7a, 0x41,
-	0xf5, 0xec, 0xf2, 0x99, 0x4f, 0x9f, 0xb2, 0x93, 0x4f, 0x2c, 0x99, 0x76, 0x38, 0xd3, 0x16, 0xda,
-	0xcc, 0x66, 0x9a, 0x00, 0xf9, 0x17, 0xc0, 0xf7, 0x33, 0xae, 0x3e, 0xb4, 0x9b, 0xc7, 0x73, 0xfc,
-	0x03, 0x50, 0x1a, 0x6f, 0x90, 0x21, 0x51, 0x1b, 0x1c, 0xb5, 0x8e, 0x3e, 0xca, 0x83, 0x2a, 0xb8,
-	0xfe, 0x00, 0x70, 0x2e, 0x75, 0x66, 0xd1, 0x76, 0xb6, 0x6f, 0xd6, 0x3d, 0xaa, 0xd4, 0x73, 0x69,
-	0x25, 0x5d, 0x9d, 0xd3, 0x7d, 0x88, 0x36, 0xb2, 0xe9, 0xd2, 0x14, 0xff, 0x00, 0x88, 0x5e, 0xfd,
-	0x96, 0x90, 0x99, 0xc3, 0x30, 0xd5, 0xc5, 0xdd, 0xfc, 0x09, 0x12, 0x73, 0x97, 0x63, 0x6e, 0xa3,
-	0x5a, 0x0e, 0x4c, 0x9e, 0xd9, 0xfa, 0xe6, 0xf2, 0x5a, 0x05, 0x57, 0xd7, 0x2a, 0x78, 0x76, 0xad,
-	0x82, 0x8b, 0x1b, 0xb5, 0x70, 0x75, 0xa3, 0x16, 0x1e, 0xdf, 0xa8, 0x85, 0xef, 0xf6, 0x1d, 0x97,
-	0x9d, 0xf4, 0x3b, 0x86, 0x4d, 0xbd, 0xa4, 0xda, 0xc7, 0x3d, 0xdc, 0x89, 0x46, 0xa5, 0xcf, 0x9a,
-	0x0d, 0xf3, 0x07, 0x61, 0x60, 0xf7, 0x5c, 0xe2, 0x33, 0xf1, 0x2b, 0x52, 0x5c, 0x43, 0x25, 0xfe,
-	0xe7, 0x93, 0x17, 0x01, 0x00, 0x00, 0xff, 0xff, 0x05, 0xf5, 0xca, 0xdf, 0x20, 0x0b, 0x00, 0x00,
+	// 845 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xed, 0x96, 0xcf, 0x4f, 0xd3, 0x60,
+	0x18, 0xc7, 0xe9, 0x80, 0xe1, 0x5e, 0xc2, 0x88, 0xaf, 0x80, 0xd0, 0xc1, 0x46, 0x0a, 0x12, 0x64,
+	0xd8, 0xb2, 0x79, 0x23, 0x78, 0x60, 0x31, 0x31, 0x1a, 0x62, 0x70, 0x12, 0x63, 0xbc, 0x2c, 0xef,
+	0xba, 0x97, 0xd2, 0xb8, 0xf6, 0x2d, 0x6d, 0x07, 0x2e, 0xf1, 0xa0, 0x26, 0xde, 0x49, 0x8c, 0x07,
+	0x0f, 0x7a, 0xf7, 0xe0, 0xbf, 0xe0, 0x99, 0x93, 0x92, 0x78, 0x31, 0x1e, 0xd0, 0xa8, 0x7f, 0x81,
+	0x7f, 0x81, 0x6f, 0xfb, 0xbe, 0x1d, 0xeb, 0xe8, 0xa0, 0x5e, 0x48, 0x48, 0x3c, 0x34, 0xdb, 0xfb,
+	0x3e, 0xdf, 0xe7, 0xf9, 0x7e, 0xde, 0x9f, 0x2d, 0x98, 0x26, 0x8e, 0x41, 0x1c, 0xdd, 0x51, 0xdc,
+	0x5d, 0x64, 0x29, 0x3b, 0x85, 0x2a, 0x76, 0x51, 0x41, 0xd9, 0x6e, 0x60, 0xbb, 0x29, 0x5b, 0x36,
+	0x71, 0x09, 0x1c, 0xe1, 0x0a, 0xd9, 0x53, 0xc8, 0x5c, 0x21, 0x8e, 0x68, 0x44, 0x23, 0xbe, 0x40,
+	0xf1, 0xfe, 0x31, 0xad, 0x38, 0x17, 0x59, 0xcd, 0x6b, 0x54, 0x6c, 0xac, 0x12, 0xbb, 0xc6, 0x75,
+	0x52, 0xa4, 0x4e, 0xc3, 0x26, 0xf6, 0x8c, 0x98, 0x26, 0xab, 0xfa, 0x22, 0xa5, 0x8a, 0x1c, 0xdc,
+	0x92, 0xa8, 0x44, 0x37, 0x79, 0x7c, 0xa1, 0x3d, 0xee, 0x03, 0xb7, 0x54, 0x16, 0xd2, 0x74, 0x13,
+	0xb9, 0x3a, 0x09, 0xb4, 0x93, 0x1a, 0x21, 0x5a, 0x1d, 0x2b, 0xc8, 0xd2, 0x15, 0x64, 0x9a, 0xc4,
+	0xf5, 0x83, 0x81, 0xd3, 0x04, 0x8f, 0xfa, 0xad, 0x6a, 0x63, 0x93, 0x4a, 0x9a, 0x41, 0x88, 0x99,
+	0x54, 0xd8, 0x48, 0x59, 0x83, 0x87, 0x72, 0x9d, 0x59, 0xae, 0x6e, 0x60, 0xc7, 0x45, 0x86, 0xc5,
+	0x04, 0xd2, 0xbb, 0x04, 0x18, 0x5d, 0xb5, 0x75, 0x77, 0xcb, 0xc0, 0xae, 0xae, 0x6e, 0xd0, 0x91,
+	0x96, 0x31, 0xe5, 0x74, 0x5c, 0x78, 0x19, 0x0c, 0x58, 0x84, 0xd4, 0x2b, 0x7a, 0x6d, 0x5c, 0x98,
+	0x16, 0xe6, 0xfb, 0xca, 0x49, 0xaf, 0x79, 0xbb, 0x06, 0xa7, 0x00, 0xf0, 0x86, 0x53, 0x41, 0x8e,
+	0x83, 0xdd, 0xf1, 0x04, 0x8d, 0xa5, 0xca, 0x29, 0xaf, 0x67, 0xd5, 0xeb, 0x80, 0x39, 0x30, 0xb8,
+	0xdd, 0x20, 0x6e, 0x10, 0xef, 0xf5, 0xe3, 0xc0, 0xef, 0x62, 0x82, 0x87, 0x00, 0x50, 0x02, 0xdb,
+	0xad, 0x78, 0x2c, 0xe3, 0x7d, 0x34, 0x3e, 0x58, 0x14, 0x65, 0x06, 0x2a, 0x07, 0xa0, 0xf2, 0x46,
+	0x00, 0x5a, 0x9a, 0xda, 0x3f, 0xcc, 0xf5, 0xfc, 0x39, 0xcc, 0x5d, 0x6c, 0x22, 0xa3, 0xbe, 0x2c,
+	0x1d, 0xe5, 0x4a, 0x7b, 0xdf, 0x73, 0x42, 0x39, 0xe5, 0x77, 0x78, 0x72, 0x58, 0x06, 0x17, 0xb0,
+	0x59, 0x63, 0x75, 0xfb, 0x4f, 0xad, 0x9b, 0xa1, 0x75, 0x05, 0x5a, 0x77, 0x98, 0xd5, 0x0d, 0x32,
+	0x59, 0xd5, 0x01, 0xda, 0xf4, 0xa4, 0xd2, 0x33, 0x01, 0x8c, 0x75, 0x4e, 0x90, 0x63, 0xd1, 0x75,
+	0xc1, 0x70, 0x13, 0x0c, 0xa3, 0x56, 0xa4, 0xe2, 0xed, 0x12, 0x7f, 0xa6, 0x52, 0xa5, 0x1b, 0x1e,
+	0xf1, 0xb7, 0xc3, 0x5c, 0x86, 0xad, 0x85, 0x53, 0x7b, 0x2c, 0xeb, 0x44, 0x31, 0x90, 0xbb, 0x25,
+	0xaf, 0x61, 0x0d, 0xa9, 0xcd, 0x9b, 0x58, 0xa5, 0xc6, 0x63, 0xcc, 0xb8, 0xa3, 0x86, 0x54, 0x4e,
+	0xa3, 0x90, 0x9f, 0xf4, 0x59, 0x00, 0x62, 0x18, 0x61, 0x83, 0xdc, 0x25, 0xbb, 0xe7, 0x77, 0xa1,
+	0xa4, 0x97, 0x02, 0xc8, 0x44, 0x8e, 0xe8, 0x8c, 0x67, 0xf6, 0x6d, 0x02, 0x8c, 0xdc, 0xc2, 0x84,
+	0x76, 0xd8, 0xff, 0x37, 0x7f, 0xc4, 0xe6, 0x7f, 0x0a, 0x46, 0x3b, 0xa6, 0x87, 0x2f, 0x90, 0x0a,
+	0xd2, 0x5a, 0x10, 0x68, 0x5f, 0x9f, 0x95, 0x78, 0xeb, 0x33, 0xca, 0x5c, 0xc3, 0x25, 0xa4, 0xf2,
+	0x90, 0xd6, 0x6e, 0x26, 0x7d, 0x12, 0xc0, 0x44, 0xc8, 0xfe, 0xbc, 0x6f, 0xfb, 0xe7, 0xf4, 0x20,
+	0x47, 0x0d, 0xe8, 0x2c, 0x27, 0x75, 0x18, 0x0c, 0xad, 0x23, 0x1b, 0x19, 0x0e, 0x9f, 0x47, 0x69,
+	0x0d, 0xa4, 0x83, 0x0e, 0xce, 0xb1, 0x0c, 0x92, 0x96, 0xdf, 0xe3, 0xfb, 0x0f, 0x16, 0x27, 0xe5,
+	0xa8, 0xb7, 0xab, 0xcc, 0xb2, 0x4a, 0x7d, 0x1e, 0x5d, 0x99, 0x67, 0x48, 0x19, 0x30, 0x71, 0xdf,
+	0x22, 0xee, 0x3a, 0xb5, 0xc3, 0xab, 0x26, 0x31, 0x50, 0x5d, 0xc7, 0x2d, 0xab, 0x2d, 0x20, 0x46,
+	0x05, 0xb9, 0xed, 0x1d, 0x90, 0x42, 0x41, 0x27, 0x75, 0xee, 0xa5, 0xce, 0x73, 0xd1, 0xce, 0x1d,
+	0x45, 0x9a, 0x9c, 0xe1, 0x28, 0xbd, 0xf8, 0x71, 0x00, 0xf4, 0xdf, 0xf3, 0x5e, 0xb7, 0xb0, 0x09,
+	0x92, 0x0c, 0x14, 0xce, 0x9c, 0x34, 0x0c, 0x8e, 0x28, 0xce, 0x9e, 0x2c, 0x62, 0xa8, 0xd2, 0xec,
+	0x8b, 0x2f, 0xbf, 0x5f, 0x25, 0xb2, 0x70, 0x52, 0x89, 0xfc, 0x46, 0xe0, 0x86, 0x6f, 0x04, 0x90,
+	0x0e, 0xdf, 0x72, 0x30, 0x1f, 0x5d, 0x3e, 0xf2, 0x0d, 0x2c, 0x2e, 0xc6, 0x13, 0x73, 0xa6, 0x45,
+	0x9f, 0x69, 0x0e, 0xce, 0x46, 0x33, 0x75, 0x80, 0x7c, 0x10, 0xc0, 0xa5, 0x88, 0x1b, 0x18, 0x2e,
+	0xc5, 0xf1, 0x6c, 0x3f, 0x87, 0x62, 0xe1, 0x1f, 0x32, 0x38, 0x6a, 0xc1, 0x47, 0xcd, 0xc3, 0xab,
+	0x71, 0x50, 0x19, 0xd7, 0x6b, 0x01, 0x0c, 0x85, 0x8e, 0x0e, 0x5c, 0x88, 0xf6, 0x8d, 0xba, 0xce,
+	0xc5, 0x7c, 0x2c, 0x2d, 0xa7, 0xcb, 0xfb, 0x74, 0x57, 0xe0, 0x4c, 0x34, 0x5d, 0x98, 0xe2, 0xbd,
+	0x00, 0xe0, 0xf1, 0x23, 0x0d, 0x95, 0x18, 0x86, 0xa1, 0x59, 0x5c, 0x8a, 0x9f, 0xc0, 0x31, 0x97,
+	0x7c, 0xcc, 0x05, 0x38, 0x1f, 0x03, 0x93, 0x41, 0x79, 0xac, 0xc7, 0xcf, 0x5f, 0x37, 0xd6, 0xae,
+	0xc7, 0xb8, 0x1b, 0x6b, 0xf7, 0xa3, 0x7d, 0x1a, 0xeb, 0xf1, 0xcc, 0xd2, 0x83, 0xfd, 0x9f, 0x59,
+	0xe1, 0x80, 0x3e, 0x3f, 0xe8, 0xb3, 0xf7, 0x2b, 0xdb, 0x73, 0x40, 0x9f, 0xaf, 0xf4, 0x79, 0xb4,
+	0xa2, 0xd1, 0xdd, 0xd1, 0xa8, 0xca, 0x2a, 0x31, 0x82, 0x6a, 0xd7, 0xea, 0xa8, 0xea, 0xb4, 0x4a,
+	0xef, 0x14, 0x0b, 0xca, 0x13, 0x66, 0xa0, 0xd2, 0x5a, 0xa6, 0xcb, 0x3e, 0xbc, 0xd9, 0xcd, 0x9d,
+	0xf4, 0x7f, 0xae, 0xff, 0x05, 0xfc, 0x66, 0x12, 0xcb, 0x53, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -641,6 +726,9 @@ type QueryClient interface {
 	ArithmeticTwapToNow(ctx context.Context, in *ArithmeticTwapToNowRequest, opts ...grpc.CallOption) (*ArithmeticTwapToNowResponse, error)
 	GeometricTwap(ctx context.Context, in *GeometricTwapRequest, opts ...grpc.CallOption) (*GeometricTwapResponse, error)
 	GeometricTwapToNow(ctx context.Context, in *GeometricTwapToNowRequest, opts ...grpc.CallOption) (*GeometricTwapToNowResponse, error)
+	// SpotPriceAnomalies returns the pools currently flagged by their spot price
+	// guard.
+	SpotPriceAnomalies(ctx context.Context, in *SpotPriceAnomaliesRequest, opts ...grpc.CallOption) (*SpotPriceAnomaliesResponse, error)
 }
 
 type queryClient struct {
@@ -696,6 +784,15 @@ func (c *queryClient) GeometricTwapToNow(ctx context.Context, in *GeometricTwapT
 	return out, nil
 }
 
+func (c *queryClient) SpotPriceAnomalies(ctx context.Context, in *SpotPriceAnomaliesRequest, opts ...grpc.CallOption) (*SpotPriceAnomaliesResponse, error) {
+	out := new(SpotPriceAnomaliesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.twap.v1beta1.Query/SpotPriceAnomalies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -703,6 +800,9 @@ type QueryServer interface {
 	ArithmeticTwapToNow(context.Context, *ArithmeticTwapToNowRequest) (*ArithmeticTwapToNowResponse, error)
 	GeometricTwap(context.Context, *GeometricTwapRequest) (*GeometricTwapResponse, error)
 	GeometricTwapToNow(context.Context, *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error)
+	// SpotPriceAnomalies returns the pools currently flagged by their spot price
+	// guard.
+	SpotPriceAnomalies(context.Context, *SpotPriceAnomaliesRequest) (*SpotPriceAnomaliesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -724,6 +824,9 @@ func (*UnimplementedQueryServer) GeometricTwap(ctx context.Context, req *Geometr
 func (*UnimplementedQueryServer) GeometricTwapToNow(ctx context.Context, req *GeometricTwapToNowRequest) (*GeometricTwapToNowResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeometricTwapToNow not implemented")
 }
+func (*UnimplementedQueryServer) SpotPriceAnomalies(ctx context.Context, req *SpotPriceAnomaliesRequest) (*SpotPriceAnomaliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpotPriceAnomalies not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -819,6 +922,24 @@ func _Query_GeometricTwapToNow_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SpotPriceAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpotPriceAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpotPriceAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.twap.v1beta1.Query/SpotPriceAnomalies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpotPriceAnomalies(ctx, req.(*SpotPriceAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.twap.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -843,6 +964,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GeometricTwapToNow",
 			Handler:    _Query_GeometricTwapToNow_Handler,
 		},
+		{
+			MethodName: "SpotPriceAnomalies",
+			Handler:    _Query_SpotPriceAnomalies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/twap/v1beta1/query.proto",
@@ -1256,6 +1381,66 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SpotPriceAnomaliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceAnomaliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceAnomaliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SpotPriceAnomaliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceAnomaliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceAnomaliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Anomalies) > 0 {
+		for iNdEx := len(m.Anomalies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Anomalies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1427,6 +1612,30 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *SpotPriceAnomaliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SpotPriceAnomaliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Anomalies) > 0 {
+		for _, e := range m.Anomalies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2638,6 +2847,142 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SpotPriceAnomaliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotPriceAnomaliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotPriceAnomaliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *SpotPriceAnomaliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpotPriceAnomaliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpotPriceAnomaliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anomalies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Anomalies = append(m.Anomalies, types.SpotPriceAnomaly{})
+			if err := m.Anomalies[len(m.Anomalies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
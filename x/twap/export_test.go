@@ -41,7 +41,7 @@ func (k Keeper) UpdateRecords(ctx sdk.Context, poolId uint64) error {
 }
 
 func (k Keeper) PruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, nil)
 }
 
 func (k Keeper) PruneRecords(ctx sdk.Context) error {
@@ -111,3 +111,7 @@ func (k *Keeper) AfterCreatePool(ctx sdk.Context, poolId uint64) error {
 func (k Keeper) GetAllHistoricalPoolIndexedTWAPs(ctx sdk.Context) ([]types.TwapRecord, error) {
 	return k.getAllHistoricalPoolIndexedTWAPs(ctx)
 }
+
+func (k Keeper) SetSpotPriceAnomaly(ctx sdk.Context, anomaly types.SpotPriceAnomaly) {
+	k.setSpotPriceAnomaly(ctx, anomaly)
+}
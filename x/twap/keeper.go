@@ -32,8 +32,11 @@ func NewKeeper(storeKey storetypes.StoreKey, transientKey *storetypes.TransientS
 }
 
 // GetParams returns the total set of twap parameters.
+// Parameters that have not been set yet, such as the pool record retentions on chains
+// predating them, keep their default value.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	params = types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
@@ -74,6 +77,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, twap := range genState.Twaps {
 		k.StoreNewRecord(ctx, twap)
 	}
+
+	for _, anomaly := range genState.SpotPriceAnomalies {
+		k.setSpotPriceAnomaly(ctx, anomaly)
+	}
 }
 
 // ExportGenesis returns the twap module's exported genesis.
@@ -85,9 +92,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	spotPriceAnomalies, err := k.GetAllSpotPriceAnomalies(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
-		Twaps:  twapRecords,
+		Params:             k.GetParams(ctx),
+		Twaps:              twapRecords,
+		SpotPriceAnomalies: spotPriceAnomalies,
 	}
 }
 
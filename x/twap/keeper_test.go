@@ -71,6 +71,24 @@ var (
 			mostRecentRecordPoolOne,
 		})
 
+	spotPriceAnomaliesGenesis = &types.GenesisState{
+		Params: basicParams,
+		Twaps:  []types.TwapRecord{mostRecentRecordPoolOne},
+		SpotPriceAnomalies: []types.SpotPriceAnomaly{
+			{
+				PoolId:      basePoolId,
+				Asset0Denom: denom0,
+				Asset1Denom: denom1,
+				SpotPrice:   osmomath.NewDec(2),
+				Twap:        osmomath.OneDec(),
+				Deviation:   osmomath.OneDec(),
+				Height:      3,
+				Time:        tPlusOne.Add(time.Second),
+				PausedUntil: tPlusOneMin,
+			},
+		},
+	}
+
 	increasingOrderByTimeRecordsPoolOne = types.NewGenesisState(
 		basicParams,
 		[]types.TwapRecord{
@@ -280,6 +298,9 @@ func (s *TestSuite) TestTWAPExportGenesis() {
 		"custom multi-record; decreasing": {
 			expectedGenesis: decreasingOrderByTimeRecordsPoolTwo,
 		},
+		"custom genesis with spot price anomalies": {
+			expectedGenesis: spotPriceAnomaliesGenesis,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -305,6 +326,7 @@ func (s *TestSuite) TestTWAPExportGenesis() {
 			})
 
 			s.Require().Equal(tc.expectedGenesis.Twaps, actualGenesis.Twaps)
+			s.Require().ElementsMatch(tc.expectedGenesis.SpotPriceAnomalies, actualGenesis.SpotPriceAnomalies)
 		})
 	}
 }
@@ -107,12 +107,23 @@ func (k Keeper) EndBlock(ctx sdk.Context) {
 	// get changed pools grabs all altered pool ids from the transient store.
 	// 'altered pool ids' gets automatically cleared on commit by being a transient store
 	changedPoolIds := k.getChangedPools(ctx)
+	spotPriceGuards := k.getSpotPriceGuards(ctx)
 	for _, id := range changedPoolIds {
 		err := k.updateRecords(ctx, id)
 		if err != nil {
 			ctx.Logger().Error(fmt.Errorf(
 				"error in TWAP end block, for updating records for pool id %d."+
 					" Skipping record update. Underlying err: %w", id, err).Error())
+			continue
+		}
+
+		// check the updated spot prices of guarded pools against their recent TWAP.
+		if guard, ok := spotPriceGuards[id]; ok {
+			if err := k.checkSpotPriceGuard(ctx, guard); err != nil {
+				ctx.Logger().Error(fmt.Errorf(
+					"error in TWAP end block, for checking the spot price guard of pool id %d."+
+						" Skipping spot price guard check. Underlying err: %w", id, err).Error())
+			}
 		}
 	}
 }
@@ -195,16 +206,32 @@ func (k Keeper) updateRecord(ctx sdk.Context, record types.TwapRecord) (types.Tw
 	return newRecord, nil
 }
 
+// poolRetention is the record retention of a pool overriding the default one,
+// resolved against the current block time.
+type poolRetention struct {
+	lastKeptTime time.Time
+	archival     bool
+}
+
 // pruneRecords prunes twap records that happened earlier than recordHistoryKeepPeriod
 // before current block time while preserving the most recent record before the threshold.
 // Such record is preserved for each pool.
 // See TWAP keeper's `pruneRecordsBeforeTimeButNewest(...)` for more details about the reasons for
 // keeping this record.
+// Pools with a record retention override in params are pruned using their own keep period,
+// and archival ones keep a daily record instead of having all older records deleted.
 func (k Keeper) pruneRecords(ctx sdk.Context) error {
-	recordHistoryKeepPeriod := k.RecordHistoryKeepPeriod(ctx)
-
-	lastKeptTime := ctx.BlockTime().Add(-recordHistoryKeepPeriod)
-	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime)
+	params := k.GetParams(ctx)
+
+	lastKeptTime := ctx.BlockTime().Add(-params.RecordHistoryKeepPeriod)
+	poolRetentions := make(map[uint64]poolRetention, len(params.PoolRecordRetentions))
+	for _, retention := range params.PoolRecordRetentions {
+		poolRetentions[retention.PoolId] = poolRetention{
+			lastKeptTime: ctx.BlockTime().Add(-retention.RecordHistoryKeepPeriod),
+			archival:     retention.Archival,
+		}
+	}
+	return k.pruneRecordsBeforeTimeButNewest(ctx, lastKeptTime, poolRetentions)
 }
 
 // recordWithUpdatedAccumulators returns a record, with updated accumulator values and time for provided newTime,
@@ -629,6 +629,58 @@ func (s *TestSuite) TestPruneRecords() {
 	s.validateExpectedRecords(expectedKeptRecords)
 }
 
+// TestPruneRecords_PoolRecordRetentions tests that pools with a record retention override
+// are pruned using their own keep period, and that archival pools keep the newest record
+// of every day before their keep period instead of only the newest one.
+func (s *TestSuite) TestPruneRecords_PoolRecordRetentions() {
+	s.SetupTest()
+	blockTime := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	params := s.twapkeeper.GetParams(s.Ctx)
+	params.RecordHistoryKeepPeriod = 48 * time.Hour
+	params.PoolRecordRetentions = []types.PoolRecordRetention{
+		{PoolId: 1, RecordHistoryKeepPeriod: time.Hour},
+		{PoolId: 2, RecordHistoryKeepPeriod: 48 * time.Hour, Archival: true},
+	}
+	s.twapkeeper.SetParams(s.Ctx, params)
+
+	// pool 1 keeps records for an hour.
+	pool1Min3HRecord := newEmptyPriceRecord(1, blockTime.Add(-3*time.Hour), denom0, denom1)     // deleted
+	pool1Min2HRecord := newEmptyPriceRecord(1, blockTime.Add(-2*time.Hour), denom0, denom1)     // kept as newest under keep period
+	pool1Min30MRecord := newEmptyPriceRecord(1, blockTime.Add(-30*time.Minute), denom0, denom1) // kept as within keep period
+
+	// pool 2 is archival and keeps records for 48 hours.
+	pool2Jan5Record := newEmptyPriceRecord(2, time.Date(2024, 1, 5, 1, 0, 0, 0, time.UTC), denom0, denom1)         // kept as newest of its day
+	pool2Jan7MorningRecord := newEmptyPriceRecord(2, time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC), denom0, denom1) // deleted
+	pool2Jan7EveningRecord := newEmptyPriceRecord(2, time.Date(2024, 1, 7, 20, 0, 0, 0, time.UTC), denom0, denom1) // kept as newest of its day
+	pool2Jan8MorningRecord := newEmptyPriceRecord(2, time.Date(2024, 1, 8, 6, 0, 0, 0, time.UTC), denom0, denom1)  // deleted
+	pool2Jan8NoonRecord := newEmptyPriceRecord(2, time.Date(2024, 1, 8, 11, 0, 0, 0, time.UTC), denom0, denom1)    // kept as newest under keep period
+
+	// pool 3 uses the default keep period.
+	pool3Jan7MorningRecord := newEmptyPriceRecord(3, time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC), denom0, denom1) // deleted
+	pool3Jan7EveningRecord := newEmptyPriceRecord(3, time.Date(2024, 1, 7, 20, 0, 0, 0, time.UTC), denom0, denom1) // kept as newest under keep period
+	pool3Min3HRecord := newEmptyPriceRecord(3, blockTime.Add(-3*time.Hour), denom0, denom1)                        // kept as within keep period
+
+	s.preSetRecords([]types.TwapRecord{
+		pool1Min30MRecord, pool1Min3HRecord, pool1Min2HRecord,
+		pool2Jan8MorningRecord, pool2Jan5Record, pool2Jan7EveningRecord, pool2Jan8NoonRecord, pool2Jan7MorningRecord,
+		pool3Min3HRecord, pool3Jan7MorningRecord, pool3Jan7EveningRecord,
+	})
+
+	err := s.twapkeeper.PruneRecords(s.Ctx.WithBlockTime(blockTime))
+	s.Require().NoError(err)
+
+	s.validateExpectedRecords([]types.TwapRecord{
+		pool2Jan5Record,
+		pool2Jan7EveningRecord,
+		pool3Jan7EveningRecord,
+		pool2Jan8NoonRecord,
+		pool3Min3HRecord,
+		pool1Min2HRecord,
+		pool1Min30MRecord,
+	})
+}
+
 // TestUpdateRecords tests that the records are updated correctly.
 // It tests the following:
 // - two-asset pools
@@ -0,0 +1,126 @@
+package twap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v21/x/twap/types"
+)
+
+// getSpotPriceGuards returns the spot price guards of the params, by pool id.
+func (k Keeper) getSpotPriceGuards(ctx sdk.Context) map[uint64]types.SpotPriceGuard {
+	guards := make(map[uint64]types.SpotPriceGuard)
+	for _, guard := range k.GetParams(ctx).SpotPriceGuards {
+		guards[guard.PoolId] = guard
+	}
+	return guards
+}
+
+// checkSpotPriceGuard compares the spot prices of the guarded pool, as updated in this block,
+// to their arithmetic TWAP over the twap window of the guard.
+// If any of them deviates from its TWAP by more than the max deviation of the guard, the pool is flagged
+// with a spot price anomaly, and swaps through it are paused for the pause duration of the guard.
+// Otherwise, the pool is unflagged once its pause is over.
+// The TWAP does not account for the spot prices of this block, since the records updated in this block
+// only start accumulating them from the current block time.
+// Returns error if the TWAP of the pool cannot be computed over the twap window, e.g. because the pool
+// is younger than the window or its spot price errored within the window.
+func (k Keeper) checkSpotPriceGuard(ctx sdk.Context, guard types.SpotPriceGuard) error {
+	records, err := k.GetAllMostRecentRecordsForPool(ctx, guard.PoolId)
+	if err != nil {
+		return err
+	}
+
+	startTime := ctx.BlockTime().Add(-guard.TwapWindow)
+	var anomaly *types.SpotPriceAnomaly
+	for _, record := range records {
+		// twap0 is the TWAP of P0LastSpotPrice, that is with asset0 as the quote asset.
+		twap0, err := k.GetArithmeticTwapToNow(ctx, guard.PoolId, record.Asset1Denom, record.Asset0Denom, startTime)
+		if err != nil {
+			return err
+		}
+		twap1, err := k.GetArithmeticTwapToNow(ctx, guard.PoolId, record.Asset0Denom, record.Asset1Denom, startTime)
+		if err != nil {
+			return err
+		}
+		if twap0.IsZero() || twap1.IsZero() {
+			return fmt.Errorf("twap of pool %d over the last %s is zero for pair (%s, %s)", guard.PoolId, guard.TwapWindow, record.Asset0Denom, record.Asset1Denom)
+		}
+
+		deviation := osmomath.MaxDec(spotPriceDeviation(record.P0LastSpotPrice, twap0), spotPriceDeviation(record.P1LastSpotPrice, twap1))
+		if deviation.LTE(guard.MaxDeviation) || (anomaly != nil && deviation.LTE(anomaly.Deviation)) {
+			continue
+		}
+		anomaly = &types.SpotPriceAnomaly{
+			PoolId:      guard.PoolId,
+			Asset0Denom: record.Asset0Denom,
+			Asset1Denom: record.Asset1Denom,
+			SpotPrice:   record.P0LastSpotPrice,
+			Twap:        twap0,
+			Deviation:   deviation,
+			Height:      ctx.BlockHeight(),
+			Time:        ctx.BlockTime(),
+			PausedUntil: ctx.BlockTime().Add(guard.PauseDuration),
+		}
+	}
+
+	if anomaly == nil {
+		if !k.IsSwapPaused(ctx, guard.PoolId) {
+			k.deleteSpotPriceAnomaly(ctx, guard.PoolId)
+		}
+		return nil
+	}
+
+	k.setSpotPriceAnomaly(ctx, *anomaly)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSpotPriceAnomaly,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, osmoutils.Uint64ToString(anomaly.PoolId)),
+		sdk.NewAttribute(types.AttributeKeyAsset0Denom, anomaly.Asset0Denom),
+		sdk.NewAttribute(types.AttributeKeyAsset1Denom, anomaly.Asset1Denom),
+		sdk.NewAttribute(types.AttributeKeySpotPrice, anomaly.SpotPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyTwap, anomaly.Twap.String()),
+		sdk.NewAttribute(types.AttributeKeyDeviation, anomaly.Deviation.String()),
+		sdk.NewAttribute(types.AttributeKeyPausedUntil, anomaly.PausedUntil.String()),
+	))
+	return nil
+}
+
+// spotPriceDeviation returns the relative deviation of the spot price from the given positive twap,
+// that is |spotPrice - twap| / twap.
+func spotPriceDeviation(spotPrice, twap osmomath.Dec) osmomath.Dec {
+	return spotPrice.Sub(twap).Abs().Quo(twap)
+}
+
+// IsSwapPaused returns true if swaps through the given pool are paused,
+// following a spot price anomaly flagged by its spot price guard.
+func (k Keeper) IsSwapPaused(ctx sdk.Context, poolId uint64) bool {
+	anomaly, found := k.GetSpotPriceAnomaly(ctx, poolId)
+	return found && ctx.BlockTime().Before(anomaly.PausedUntil)
+}
+
+// GetSpotPriceAnomaly returns the spot price anomaly flagging the given pool, if any.
+func (k Keeper) GetSpotPriceAnomaly(ctx sdk.Context, poolId uint64) (types.SpotPriceAnomaly, bool) {
+	anomaly := types.SpotPriceAnomaly{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.FormatSpotPriceAnomalyKey(poolId), &anomaly)
+	if err != nil {
+		panic(err)
+	}
+	return anomaly, found
+}
+
+// GetAllSpotPriceAnomalies returns the spot price anomalies of all flagged pools, in ascending order of pool id.
+func (k Keeper) GetAllSpotPriceAnomalies(ctx sdk.Context) ([]types.SpotPriceAnomaly, error) {
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), []byte(types.SpotPriceAnomalyPrefix), osmoutils.ProtoValueParser[types.SpotPriceAnomaly]())
+}
+
+func (k Keeper) setSpotPriceAnomaly(ctx sdk.Context, anomaly types.SpotPriceAnomaly) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.FormatSpotPriceAnomalyKey(anomaly.PoolId), &anomaly)
+}
+
+func (k Keeper) deleteSpotPriceAnomaly(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Delete(types.FormatSpotPriceAnomalyKey(poolId))
+}
@@ -0,0 +1,137 @@
+package twap_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v21/x/twap/types"
+)
+
+func (s *TestSuite) TestSpotPriceGuard() {
+	const (
+		smallSwapAmount = 1_000_000
+		largeSwapAmount = 1_000_000_000
+	)
+	guard := types.SpotPriceGuard{
+		PoolId:        basePoolId,
+		MaxDeviation:  osmomath.MustNewDecFromStr("0.1"),
+		TwapWindow:    time.Hour,
+		PauseDuration: time.Hour,
+	}
+	withGuard := func(modify func(*types.SpotPriceGuard)) types.SpotPriceGuard {
+		guard := guard
+		modify(&guard)
+		return guard
+	}
+
+	tests := map[string]struct {
+		guard              types.SpotPriceGuard
+		swapAmount         int64
+		preexistingAnomaly bool
+		expectFlagged      bool
+		expectPaused       bool
+	}{
+		"spot price within max deviation": {
+			guard:      guard,
+			swapAmount: smallSwapAmount,
+		},
+		"spot price beyond max deviation flags pool and pauses swaps": {
+			guard:         guard,
+			swapAmount:    largeSwapAmount,
+			expectFlagged: true,
+			expectPaused:  true,
+		},
+		"spot price beyond max deviation flags pool without pausing swaps": {
+			guard:         withGuard(func(g *types.SpotPriceGuard) { g.PauseDuration = 0 }),
+			swapAmount:    largeSwapAmount,
+			expectFlagged: true,
+		},
+		"spot price back within max deviation unflags pool": {
+			guard:              guard,
+			swapAmount:         smallSwapAmount,
+			preexistingAnomaly: true,
+		},
+		"no spot price guard for pool": {
+			guard:      withGuard(func(g *types.SpotPriceGuard) { g.PoolId = basePoolId + 1 }),
+			swapAmount: largeSwapAmount,
+		},
+		"twap window older than pool history skips check": {
+			guard:      withGuard(func(g *types.SpotPriceGuard) { g.TwapWindow = 2 * time.Hour }),
+			swapAmount: largeSwapAmount,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			params := s.twapkeeper.GetParams(s.Ctx)
+			params.SpotPriceGuards = []types.SpotPriceGuard{tc.guard}
+			s.twapkeeper.SetParams(s.Ctx, params)
+
+			poolId, _, _ := s.setupDefaultPool()
+			s.Require().Equal(basePoolId, poolId)
+			s.twapkeeper.EndBlock(s.Ctx)
+			s.Commit()
+
+			s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Hour))
+			if tc.preexistingAnomaly {
+				s.twapkeeper.SetSpotPriceAnomaly(s.Ctx, types.SpotPriceAnomaly{
+					PoolId:      poolId,
+					Asset0Denom: denom0,
+					Asset1Denom: denom1,
+					SpotPrice:   osmomath.NewDec(4),
+					Twap:        osmomath.OneDec(),
+					Deviation:   osmomath.NewDec(3),
+					Height:      s.Ctx.BlockHeight(),
+					Time:        baseTime,
+					PausedUntil: baseTime.Add(time.Minute),
+				})
+			}
+
+			tokenIn := sdk.NewInt64Coin(denom0, tc.swapAmount)
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+			_, err := s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], poolId, tokenIn, denom1, osmomath.OneInt())
+			s.Require().NoError(err)
+
+			s.twapkeeper.EndBlock(s.Ctx)
+
+			anomaly, found := s.twapkeeper.GetSpotPriceAnomaly(s.Ctx, poolId)
+			s.Require().Equal(tc.expectFlagged, found)
+			s.Require().Equal(tc.expectPaused, s.twapkeeper.IsSwapPaused(s.Ctx, poolId))
+			anomalies, err := s.twapkeeper.GetAllSpotPriceAnomalies(s.Ctx)
+			s.Require().NoError(err)
+			if !tc.expectFlagged {
+				s.Require().Empty(anomalies)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtSpotPriceAnomaly, 0)
+				return
+			}
+			s.Require().Equal([]types.SpotPriceAnomaly{anomaly}, anomalies)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtSpotPriceAnomaly, 1)
+
+			s.Require().Equal(denom0, anomaly.Asset0Denom)
+			s.Require().Equal(denom1, anomaly.Asset1Denom)
+			s.Require().Equal(osmomath.OneDec(), anomaly.Twap)
+			s.Require().True(anomaly.Deviation.GT(tc.guard.MaxDeviation))
+			s.Require().Equal(s.Ctx.BlockTime(), anomaly.Time)
+			s.Require().Equal(s.Ctx.BlockTime().Add(tc.guard.PauseDuration), anomaly.PausedUntil)
+
+			// Swaps through the pool fail until the pause is over.
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+			_, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], poolId, tokenIn, denom1, osmomath.OneInt())
+			_, errOut := s.App.PoolManagerKeeper.RouteExactAmountOut(s.Ctx, s.TestAccs[0],
+				[]poolmanagertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: denom0}}, tokenIn.Amount, sdk.NewInt64Coin(denom1, smallSwapAmount))
+			if tc.expectPaused {
+				s.Require().ErrorIs(err, poolmanagertypes.SwapsPausedError{PoolId: poolId})
+				s.Require().ErrorIs(errOut, poolmanagertypes.SwapsPausedError{PoolId: poolId})
+
+				s.Ctx = s.Ctx.WithBlockTime(anomaly.PausedUntil)
+				s.Require().False(s.twapkeeper.IsSwapPaused(s.Ctx, poolId))
+				_, err = s.App.PoolManagerKeeper.SwapExactAmountIn(s.Ctx, s.TestAccs[0], poolId, tokenIn, denom1, osmomath.OneInt())
+			}
+			s.Require().NoError(err)
+		})
+	}
+}
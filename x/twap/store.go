@@ -73,25 +73,40 @@ func (k Keeper) StoreHistoricalTWAP(ctx sdk.Context, twap types.TwapRecord) {
 // So, in order to have correct behavior for the desired guarantee,
 // we keep the newest record that is older than the pruning time.
 // This is why we would keep the -50 hour and -1hour twaps despite a 48hr pruning period
-func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time) error {
+//
+// Pools present in poolRetentions use their own last kept time instead of the given one.
+// Archival pools additionally keep the newest record of every UTC day before their last kept time,
+// so that long window TWAPs remain computable at a daily granularity.
+func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime time.Time, poolRetentions map[uint64]poolRetention) error {
 	store := ctx.KVStore(k.storeKey)
 
+	// Pools may keep their records for a shorter period than the default one,
+	// so we start iterating from the latest last kept time.
+	iterEndTime := lastKeptTime
+	for _, retention := range poolRetentions {
+		if retention.lastKeptTime.After(iterEndTime) {
+			iterEndTime = retention.lastKeptTime
+		}
+	}
+
 	// Reverse iterator guarantees that we iterate through the newest per pool first.
 	// Due to how it is indexed, we will only iterate times starting from
-	// lastKeptTime exclusively down to the oldest record.
+	// iterEndTime exclusively down to the oldest record.
 	iter := store.ReverseIterator(
 		[]byte(types.HistoricalTWAPTimeIndexPrefix),
-		types.FormatHistoricalTimeIndexTWAPKey(lastKeptTime, 0, "", ""))
+		types.FormatHistoricalTimeIndexTWAPKey(iterEndTime, 0, "", ""))
 	defer iter.Close()
 
-	// We mark what (pool id, asset 0, asset 1) triplets we've seen.
-	// We prune all records for a triplet that we haven't already seen.
+	// We mark what (pool id, asset 0, asset 1) triplets we've seen, along with
+	// the day of the last record kept for them.
+	// We prune all records for a triplet that we haven't already seen,
+	// unless the pool is archival and the record is the newest one of its day.
 	type uniqueTriplet struct {
 		poolId uint64
 		asset0 string
 		asset1 string
 	}
-	seenPoolAssetTriplets := map[uniqueTriplet]struct{}{}
+	seenPoolAssetTriplets := map[uniqueTriplet]time.Time{}
 
 	for ; iter.Valid(); iter.Next() {
 		twapToRemove, err := types.ParseTwapFromBz(iter.Value())
@@ -99,14 +114,23 @@ func (k Keeper) pruneRecordsBeforeTimeButNewest(ctx sdk.Context, lastKeptTime ti
 			return err
 		}
 
+		retention, ok := poolRetentions[twapToRemove.PoolId]
+		if !ok {
+			retention = poolRetention{lastKeptTime: lastKeptTime}
+		}
+		if !twapToRemove.Time.Before(retention.lastKeptTime) {
+			continue
+		}
+
 		poolKey := uniqueTriplet{
 			poolId: twapToRemove.PoolId,
 			asset0: twapToRemove.Asset0Denom,
 			asset1: twapToRemove.Asset1Denom,
 		}
-		_, hasSeenPoolRecord := seenPoolAssetTriplets[poolKey]
-		if !hasSeenPoolRecord {
-			seenPoolAssetTriplets[poolKey] = struct{}{}
+		recordDay := twapToRemove.Time.UTC().Truncate(24 * time.Hour)
+		lastKeptDay, hasSeenPoolRecord := seenPoolAssetTriplets[poolKey]
+		if !hasSeenPoolRecord || (retention.archival && recordDay.Before(lastKeptDay)) {
+			seenPoolAssetTriplets[poolKey] = recordDay
 			continue
 		}
 
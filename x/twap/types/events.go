@@ -0,0 +1,14 @@
+package types
+
+const (
+	TypeEvtSpotPriceAnomaly = "spot_price_anomaly"
+
+	AttributeValueCategory  = ModuleName
+	AttributeKeyPoolId      = "pool_id"
+	AttributeKeyAsset0Denom = "asset0_denom"
+	AttributeKeyAsset1Denom = "asset1_denom"
+	AttributeKeySpotPrice   = "spot_price"
+	AttributeKeyTwap        = "twap"
+	AttributeKeyDeviation   = "deviation"
+	AttributeKeyPausedUntil = "paused_until"
+)
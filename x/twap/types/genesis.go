@@ -29,6 +29,34 @@ func (g *GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	seenAnomalies := make(map[uint64]struct{}, len(g.SpotPriceAnomalies))
+	for _, anomaly := range g.SpotPriceAnomalies {
+		if _, ok := seenAnomalies[anomaly.PoolId]; ok {
+			return fmt.Errorf("duplicate spot price anomaly for pool id %d", anomaly.PoolId)
+		}
+		seenAnomalies[anomaly.PoolId] = struct{}{}
+
+		if err := anomaly.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate validates the spot price anomaly, returns nil on success, error otherwise.
+func (a SpotPriceAnomaly) validate() error {
+	if a.PoolId == 0 {
+		return errors.New("spot price anomaly pool id cannot be 0")
+	}
+
+	if a.Asset0Denom == "" || a.Asset1Denom == "" {
+		return fmt.Errorf("spot price anomaly denoms cannot be empty, were (%s, %s)", a.Asset0Denom, a.Asset1Denom)
+	}
+
+	if a.PausedUntil.Before(a.Time) {
+		return fmt.Errorf("spot price anomaly paused until time (%s) cannot be before its time (%s)", a.PausedUntil, a.Time)
+	}
 	return nil
 }
 
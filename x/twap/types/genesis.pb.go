@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
@@ -11,6 +12,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -33,6 +35,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	PruneEpochIdentifier    string        `protobuf:"bytes,1,opt,name=prune_epoch_identifier,json=pruneEpochIdentifier,proto3" json:"prune_epoch_identifier,omitempty"`
 	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// pool_record_retentions overrides the record retention of specific pools.
+	PoolRecordRetentions []PoolRecordRetention `protobuf:"bytes,3,rep,name=pool_record_retentions,json=poolRecordRetentions,proto3" json:"pool_record_retentions" yaml:"pool_record_retentions"`
+	// spot_price_guards are the pools whose spot price is checked against their
+	// recent TWAP at the end of every block they change in.
+	SpotPriceGuards []SpotPriceGuard `protobuf:"bytes,4,rep,name=spot_price_guards,json=spotPriceGuards,proto3" json:"spot_price_guards" yaml:"spot_price_guards"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,12 +89,29 @@ func (m *Params) GetRecordHistoryKeepPeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetPoolRecordRetentions() []PoolRecordRetention {
+	if m != nil {
+		return m.PoolRecordRetentions
+	}
+	return nil
+}
+
+func (m *Params) GetSpotPriceGuards() []SpotPriceGuard {
+	if m != nil {
+		return m.SpotPriceGuards
+	}
+	return nil
+}
+
 // GenesisState defines the twap module's genesis state.
 type GenesisState struct {
 	// twaps is the collection of all twap records.
 	Twaps []TwapRecord `protobuf:"bytes,1,rep,name=twaps,proto3" json:"twaps"`
 	// params is the container of twap parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// spot_price_anomalies are the pools currently flagged by their spot price
+	// guard.
+	SpotPriceAnomalies []SpotPriceAnomaly `protobuf:"bytes,3,rep,name=spot_price_anomalies,json=spotPriceAnomalies,proto3" json:"spot_price_anomalies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -137,9 +161,251 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSpotPriceAnomalies() []SpotPriceAnomaly {
+	if m != nil {
+		return m.SpotPriceAnomalies
+	}
+	return nil
+}
+
+// PoolRecordRetention overrides how long the TWAP records of a pool are kept
+type PoolRecordRetention struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// record_history_keep_period overrides the default record history keep period
+	// for the pool.
+	RecordHistoryKeepPeriod time.Duration `protobuf:"bytes,2,opt,name=record_history_keep_period,json=recordHistoryKeepPeriod,proto3,stdduration" json:"record_history_keep_period" yaml:"record_history_keep_period"`
+	// archival keeps the last record of every day for records older than the keep
+	// period instead of deleting them, so that long window TWAPs of the pool
+	// remain computable at a daily granularity.
+	Archival bool `protobuf:"varint,3,opt,name=archival,proto3" json:"archival,omitempty" yaml:"archival"`
+}
+
+func (m *PoolRecordRetention) Reset()         { *m = PoolRecordRetention{} }
+func (m *PoolRecordRetention) String() string { return proto.CompactTextString(m) }
+func (*PoolRecordRetention) ProtoMessage()    {}
+func (*PoolRecordRetention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{2}
+}
+func (m *PoolRecordRetention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolRecordRetention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolRecordRetention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolRecordRetention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRecordRetention.Merge(m, src)
+}
+func (m *PoolRecordRetention) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolRecordRetention) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRecordRetention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRecordRetention proto.InternalMessageInfo
+
+func (m *PoolRecordRetention) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolRecordRetention) GetRecordHistoryKeepPeriod() time.Duration {
+	if m != nil {
+		return m.RecordHistoryKeepPeriod
+	}
+	return 0
+}
+
+func (m *PoolRecordRetention) GetArchival() bool {
+	if m != nil {
+		return m.Archival
+	}
+	return false
+}
+
+// SpotPriceGuard flags a pool, and optionally pauses swaps through it, when
+// its spot price deviates from its recent TWAP by more than a threshold.
+type SpotPriceGuard struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// max_deviation is the largest relative deviation of the spot price from the
+	// TWAP over twap_window that is tolerated before the pool is flagged.
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation" yaml:"max_deviation"`
+	// twap_window is the duration of the arithmetic TWAP that the spot price is
+	// compared to. It should be well within the record history keep period.
+	TwapWindow time.Duration `protobuf:"bytes,3,opt,name=twap_window,json=twapWindow,proto3,stdduration" json:"twap_window" yaml:"twap_window"`
+	// pause_duration is how long swaps through the pool are paused for once it is
+	// flagged. Zero only flags the pool without pausing swaps.
+	PauseDuration time.Duration `protobuf:"bytes,4,opt,name=pause_duration,json=pauseDuration,proto3,stdduration" json:"pause_duration" yaml:"pause_duration"`
+}
+
+func (m *SpotPriceGuard) Reset()         { *m = SpotPriceGuard{} }
+func (m *SpotPriceGuard) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceGuard) ProtoMessage()    {}
+func (*SpotPriceGuard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{3}
+}
+func (m *SpotPriceGuard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceGuard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceGuard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceGuard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceGuard.Merge(m, src)
+}
+func (m *SpotPriceGuard) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceGuard) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceGuard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPriceGuard proto.InternalMessageInfo
+
+func (m *SpotPriceGuard) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SpotPriceGuard) GetTwapWindow() time.Duration {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+func (m *SpotPriceGuard) GetPauseDuration() time.Duration {
+	if m != nil {
+		return m.PauseDuration
+	}
+	return 0
+}
+
+// SpotPriceAnomaly records that the spot price of a pool deviated from its
+// recent TWAP by more than the threshold of its spot price guard.
+type SpotPriceAnomaly struct {
+	PoolId      uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Asset0Denom string `protobuf:"bytes,2,opt,name=asset0_denom,json=asset0Denom,proto3" json:"asset0_denom,omitempty" yaml:"asset0_denom"`
+	Asset1Denom string `protobuf:"bytes,3,opt,name=asset1_denom,json=asset1Denom,proto3" json:"asset1_denom,omitempty" yaml:"asset1_denom"`
+	// spot_price is the spot price of asset0 in terms of asset1 when the pool was
+	// flagged.
+	SpotPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=spot_price,json=spotPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"spot_price" yaml:"spot_price"`
+	// twap is the arithmetic TWAP of asset0 in terms of asset1 over the twap
+	// window of the guard when the pool was flagged.
+	Twap cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=twap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"twap" yaml:"twap"`
+	// deviation is the relative deviation of the spot price from the TWAP, in
+	// the direction of the pair that deviated the most.
+	Deviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=deviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation" yaml:"deviation"`
+	Height    int64                       `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Time      time.Time                   `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// paused_until is the time until which swaps through the pool are paused.
+	// Equal to time when the guard of the pool does not pause swaps.
+	PausedUntil time.Time `protobuf:"bytes,9,opt,name=paused_until,json=pausedUntil,proto3,stdtime" json:"paused_until" yaml:"paused_until"`
+}
+
+func (m *SpotPriceAnomaly) Reset()         { *m = SpotPriceAnomaly{} }
+func (m *SpotPriceAnomaly) String() string { return proto.CompactTextString(m) }
+func (*SpotPriceAnomaly) ProtoMessage()    {}
+func (*SpotPriceAnomaly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f4bdf49b69bd63c, []int{4}
+}
+func (m *SpotPriceAnomaly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpotPriceAnomaly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpotPriceAnomaly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpotPriceAnomaly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpotPriceAnomaly.Merge(m, src)
+}
+func (m *SpotPriceAnomaly) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpotPriceAnomaly) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpotPriceAnomaly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpotPriceAnomaly proto.InternalMessageInfo
+
+func (m *SpotPriceAnomaly) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *SpotPriceAnomaly) GetAsset0Denom() string {
+	if m != nil {
+		return m.Asset0Denom
+	}
+	return ""
+}
+
+func (m *SpotPriceAnomaly) GetAsset1Denom() string {
+	if m != nil {
+		return m.Asset1Denom
+	}
+	return ""
+}
+
+func (m *SpotPriceAnomaly) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SpotPriceAnomaly) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *SpotPriceAnomaly) GetPausedUntil() time.Time {
+	if m != nil {
+		return m.PausedUntil
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.twap.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.twap.v1beta1.GenesisState")
+	proto.RegisterType((*PoolRecordRetention)(nil), "osmosis.twap.v1beta1.PoolRecordRetention")
+	proto.RegisterType((*SpotPriceGuard)(nil), "osmosis.twap.v1beta1.SpotPriceGuard")
+	proto.RegisterType((*SpotPriceAnomaly)(nil), "osmosis.twap.v1beta1.SpotPriceAnomaly")
 }
 
 func init() {
@@ -147,32 +413,64 @@ func init() {
 }
 
 var fileDescriptor_3f4bdf49b69bd63c = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xbd, 0x0e, 0xd3, 0x30,
-	0x14, 0x85, 0x63, 0x7e, 0x2a, 0x91, 0x32, 0x45, 0x15, 0xb4, 0x15, 0x4a, 0x43, 0x06, 0xd4, 0xa5,
-	0x36, 0x2d, 0x4c, 0x15, 0x53, 0x05, 0xe2, 0x6f, 0xa9, 0x02, 0x13, 0x4b, 0xe4, 0x24, 0xb7, 0xa9,
-	0x45, 0x13, 0x5b, 0xb6, 0xd3, 0x92, 0x07, 0x40, 0x62, 0x64, 0xe4, 0x89, 0x50, 0xc7, 0x8e, 0x4c,
-	0x05, 0xb5, 0x6f, 0xc0, 0x13, 0xa0, 0xc4, 0x2e, 0x03, 0x2a, 0x5b, 0xae, 0xbe, 0x73, 0x8e, 0x4e,
-	0xee, 0xb5, 0x1b, 0x72, 0x55, 0x70, 0xc5, 0x14, 0xd1, 0x3b, 0x2a, 0xc8, 0x76, 0x9a, 0x80, 0xa6,
-	0x53, 0x92, 0x43, 0x09, 0x8a, 0x29, 0x2c, 0x24, 0xd7, 0xdc, 0xeb, 0x59, 0x0d, 0x6e, 0x34, 0xd8,
-	0x6a, 0x86, 0xbd, 0x9c, 0xe7, 0xbc, 0x15, 0x90, 0xe6, 0xcb, 0x68, 0x87, 0x8f, 0xae, 0xe6, 0x35,
-	0x43, 0x2c, 0x21, 0xe5, 0x32, 0xb3, 0xba, 0x41, 0xce, 0x79, 0xbe, 0x01, 0xd2, 0x4e, 0x49, 0xb5,
-	0x22, 0xb4, 0xac, 0x2f, 0x28, 0x6d, 0x33, 0x62, 0x93, 0x6d, 0x06, 0x8b, 0xfc, 0x7f, 0x5d, 0x59,
-	0x25, 0xa9, 0x66, 0xbc, 0x34, 0x3c, 0xfc, 0x8e, 0xdc, 0xce, 0x92, 0x4a, 0x5a, 0x28, 0xef, 0xa9,
-	0x7b, 0x4f, 0xc8, 0xaa, 0x84, 0x18, 0x04, 0x4f, 0xd7, 0x31, 0xcb, 0xa0, 0xd4, 0x6c, 0xc5, 0x40,
-	0xf6, 0x51, 0x80, 0xc6, 0x77, 0xa2, 0x5e, 0x4b, 0x5f, 0x34, 0xf0, 0xf5, 0x5f, 0xe6, 0x7d, 0x46,
-	0xee, 0xd0, 0xf4, 0x8c, 0xd7, 0x4c, 0x69, 0x2e, 0xeb, 0xf8, 0x23, 0x80, 0x88, 0x05, 0x48, 0xc6,
-	0xb3, 0xfe, 0x8d, 0x00, 0x8d, 0xbb, 0xb3, 0x01, 0x36, 0x35, 0xf0, 0xa5, 0x06, 0x7e, 0x6e, 0x6b,
-	0x2c, 0x26, 0xfb, 0xe3, 0xc8, 0xf9, 0x7d, 0x1c, 0x3d, 0xac, 0x69, 0xb1, 0x99, 0x87, 0xff, 0x8f,
-	0x0a, 0xbf, 0xfd, 0x1c, 0xa1, 0xe8, 0xbe, 0x11, 0xbc, 0x32, 0xfc, 0x2d, 0x80, 0x58, 0x1a, 0xfa,
-	0x05, 0xb9, 0x77, 0x5f, 0x9a, 0x23, 0xbc, 0xd3, 0x54, 0x83, 0xf7, 0xcc, 0xbd, 0xdd, 0x2c, 0x51,
-	0xf5, 0x51, 0x70, 0x73, 0xdc, 0x9d, 0x05, 0xf8, 0xda, 0x4d, 0xf0, 0xfb, 0x1d, 0x15, 0x51, 0x1b,
-	0xb9, 0xb8, 0xd5, 0x34, 0x89, 0x8c, 0xc9, 0x9b, 0xbb, 0x1d, 0xd1, 0xae, 0xc5, 0xfe, 0xc1, 0x83,
-	0xeb, 0x76, 0xb3, 0x3a, 0x6b, 0xb5, 0x8e, 0xc5, 0x9b, 0xfd, 0xc9, 0x47, 0x87, 0x93, 0x8f, 0x7e,
-	0x9d, 0x7c, 0xf4, 0xf5, 0xec, 0x3b, 0x87, 0xb3, 0xef, 0xfc, 0x38, 0xfb, 0xce, 0x87, 0xc7, 0x39,
-	0xd3, 0xeb, 0x2a, 0xc1, 0x29, 0x2f, 0x88, 0xcd, 0x9b, 0x6c, 0x68, 0xa2, 0x2e, 0x03, 0xd9, 0xce,
-	0xa6, 0xe4, 0x93, 0x79, 0x09, 0xba, 0x16, 0xa0, 0x92, 0x4e, 0xbb, 0xb1, 0x27, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x49, 0x4a, 0x6c, 0x7a, 0x76, 0x02, 0x00, 0x00,
+	// 906 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xcd, 0x56, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0x66, 0xb1, 0x31, 0xf1, 0x38, 0x09, 0x64, 0xe2, 0x36, 0x4b, 0xda, 0xc6, 0x61, 0x04, 0x08,
+	0x54, 0xb1, 0x8b, 0x01, 0xa9, 0x52, 0xd4, 0x0b, 0xab, 0x54, 0x29, 0x6d, 0x0f, 0x61, 0x03, 0x42,
+	0x42, 0x88, 0xd5, 0x78, 0x77, 0x58, 0x8f, 0xf0, 0x7a, 0x56, 0x3b, 0xe3, 0x04, 0xff, 0x01, 0xed,
+	0x99, 0x63, 0xff, 0x9f, 0x5e, 0x38, 0x72, 0xac, 0xaa, 0x2a, 0x54, 0xe5, 0xc2, 0xb9, 0x97, 0x1e,
+	0x7a, 0xe9, 0xdb, 0x99, 0xd9, 0xc4, 0x4e, 0x4c, 0x2d, 0x6e, 0x3d, 0x8c, 0xbc, 0xef, 0xc7, 0xf7,
+	0xbd, 0x37, 0xef, 0xbd, 0x7d, 0x6b, 0x44, 0x84, 0xcc, 0x84, 0xe4, 0xd2, 0x57, 0x07, 0x34, 0xf7,
+	0xf7, 0xbb, 0x3d, 0xa6, 0x68, 0xd7, 0x4f, 0xd9, 0x90, 0x81, 0xd2, 0xcb, 0x0b, 0xa1, 0x04, 0x6e,
+	0x5b, 0x1f, 0xaf, 0xf4, 0xf1, 0xac, 0xcf, 0x7a, 0x3b, 0x15, 0xa9, 0xd0, 0x0e, 0x7e, 0xf9, 0x64,
+	0x7c, 0xd7, 0xaf, 0xcd, 0xe4, 0x2b, 0x85, 0xa8, 0x60, 0xb1, 0x28, 0x12, 0xeb, 0x77, 0x29, 0x15,
+	0x22, 0x1d, 0x30, 0x5f, 0x4b, 0xbd, 0xd1, 0x73, 0x9f, 0x0e, 0xc7, 0x95, 0x29, 0xd6, 0x1c, 0x91,
+	0xe1, 0x36, 0x82, 0x35, 0x6d, 0x9c, 0x44, 0x25, 0xa3, 0x82, 0x2a, 0x2e, 0x86, 0xd6, 0xde, 0x39,
+	0x69, 0x57, 0x3c, 0x63, 0x52, 0xd1, 0x2c, 0x37, 0x0e, 0xe4, 0x97, 0x1a, 0x6a, 0xec, 0xd2, 0x82,
+	0x66, 0x12, 0xdf, 0x45, 0x9f, 0xe6, 0xc5, 0x68, 0xc8, 0x22, 0x96, 0x8b, 0xb8, 0x1f, 0xf1, 0x84,
+	0x0d, 0x15, 0x7f, 0xce, 0x59, 0xe1, 0x3a, 0x9b, 0xce, 0xf5, 0x66, 0xd8, 0xd6, 0xd6, 0x6f, 0x4a,
+	0xe3, 0xfd, 0x23, 0x1b, 0xfe, 0xd1, 0x41, 0xeb, 0xe6, 0x22, 0x51, 0x9f, 0x4b, 0x25, 0x8a, 0x71,
+	0xf4, 0x82, 0xb1, 0x3c, 0xca, 0x59, 0xc1, 0x45, 0xe2, 0x9e, 0x05, 0x68, 0xeb, 0xf6, 0x25, 0xcf,
+	0xe4, 0xe1, 0x55, 0x79, 0x78, 0xdb, 0x36, 0xcf, 0xe0, 0xe6, 0xeb, 0xc3, 0xce, 0x99, 0xbf, 0x0e,
+	0x3b, 0x97, 0xc7, 0x34, 0x1b, 0x6c, 0x91, 0x0f, 0x53, 0x91, 0x9f, 0xdf, 0x76, 0x9c, 0x70, 0xcd,
+	0x38, 0x7c, 0x6b, 0xec, 0xdf, 0x83, 0x79, 0x57, 0x5b, 0xf1, 0x4f, 0x0e, 0xa4, 0x2f, 0xc4, 0xc0,
+	0x56, 0x15, 0x7e, 0x54, 0x99, 0xa3, 0x18, 0x4a, 0xb7, 0xb6, 0x59, 0x83, 0x1c, 0x6e, 0x78, 0xb3,
+	0xba, 0xe6, 0xed, 0x02, 0x26, 0xd4, 0x90, 0xb0, 0x42, 0x04, 0x57, 0x6d, 0x4e, 0x5f, 0x98, 0x9c,
+	0x66, 0xd3, 0x12, 0x28, 0xc8, 0x69, 0xac, 0xc4, 0x05, 0x5a, 0x91, 0xb9, 0x50, 0xd0, 0x2d, 0x1e,
+	0xb3, 0x28, 0x1d, 0xd1, 0x22, 0x91, 0x6e, 0x5d, 0xa7, 0x70, 0x65, 0x76, 0x0a, 0x7b, 0xe0, 0xbe,
+	0x5b, 0x7a, 0xef, 0x94, 0xce, 0xc1, 0xa6, 0x8d, 0xee, 0x9a, 0xe8, 0xa7, 0xc8, 0x48, 0x78, 0x41,
+	0x4e, 0x21, 0x24, 0x79, 0xef, 0xa0, 0xc5, 0x1d, 0x33, 0xa2, 0x7b, 0x8a, 0x2a, 0x86, 0xbf, 0x46,
+	0xe7, 0xca, 0x10, 0x12, 0x5a, 0x57, 0x06, 0xde, 0x9c, 0x1d, 0xf8, 0x21, 0x08, 0x26, 0xff, 0xa0,
+	0x5e, 0x06, 0x0d, 0x0d, 0x08, 0x6f, 0xa1, 0x46, 0xae, 0x67, 0xc2, 0xb6, 0xef, 0xf3, 0x0f, 0x94,
+	0x4e, 0xfb, 0x58, 0xa8, 0x45, 0xe0, 0x67, 0xa8, 0x3d, 0x91, 0x31, 0x1d, 0x8a, 0x8c, 0x0e, 0x38,
+	0xab, 0x9a, 0x70, 0x6d, 0x4e, 0x05, 0xee, 0x69, 0xff, 0xb1, 0xe5, 0xc4, 0x72, 0x5a, 0x0f, 0x3c,
+	0xe4, 0x1f, 0x07, 0xad, 0xce, 0xe8, 0x19, 0xfe, 0x12, 0x9d, 0xd7, 0x7d, 0xe2, 0x89, 0x1e, 0xd7,
+	0x7a, 0x80, 0xa1, 0x84, 0xcb, 0x13, 0x0d, 0xe4, 0x09, 0x81, 0x24, 0xe1, 0xe9, 0x7e, 0xf2, 0xbf,
+	0x19, 0x5a, 0x1f, 0x2d, 0xd0, 0x22, 0xee, 0xf3, 0x7d, 0x3a, 0x80, 0x02, 0x39, 0xd7, 0x17, 0x82,
+	0x55, 0x60, 0xbd, 0x60, 0x58, 0x2b, 0x0b, 0x09, 0x8f, 0x9c, 0xc8, 0xef, 0x67, 0xd1, 0xf2, 0xf4,
+	0xb8, 0x7c, 0xdc, 0xc5, 0x9f, 0xa2, 0xa5, 0x8c, 0xbe, 0x8c, 0x12, 0xb6, 0xcf, 0xf5, 0x4d, 0xf4,
+	0x55, 0x9b, 0xc1, 0x57, 0xbf, 0x1d, 0x76, 0x3e, 0x33, 0x8b, 0x45, 0x26, 0x2f, 0x3c, 0x2e, 0xfc,
+	0x8c, 0xaa, 0xbe, 0xf7, 0x03, 0x4b, 0x69, 0x3c, 0xde, 0x66, 0x31, 0x30, 0xb6, 0x0d, 0xe3, 0x14,
+	0x9a, 0x84, 0x8b, 0x20, 0x6f, 0x57, 0x22, 0x7e, 0x82, 0x5a, 0x7a, 0xb1, 0x1d, 0xf0, 0x61, 0x22,
+	0x0e, 0xf4, 0x8d, 0xfe, 0xb3, 0x8c, 0x1b, 0xb6, 0x8c, 0xd8, 0x70, 0x4f, 0x60, 0x4d, 0xdd, 0x50,
+	0xa9, 0x79, 0xac, 0x15, 0x38, 0x46, 0xcb, 0x39, 0x1d, 0x49, 0x16, 0x55, 0x1b, 0x0e, 0xde, 0xa9,
+	0x39, 0xf4, 0x97, 0x2d, 0xfd, 0x27, 0xb6, 0x18, 0x53, 0x70, 0x13, 0x61, 0x49, 0x2b, 0x2b, 0x04,
+	0xf9, 0xbb, 0x8e, 0x2e, 0x9e, 0x9c, 0xc5, 0x8f, 0x2b, 0xf0, 0x16, 0x5a, 0xa4, 0x52, 0x32, 0x75,
+	0x0b, 0xaa, 0x04, 0x78, 0x5b, 0xdf, 0x35, 0x40, 0xac, 0xda, 0xae, 0x4e, 0x58, 0x49, 0xd8, 0x32,
+	0xe2, 0x76, 0x29, 0x1d, 0x61, 0xbb, 0x16, 0x5b, 0x9b, 0x89, 0xed, 0x4e, 0x63, 0xbb, 0x06, 0xbb,
+	0x87, 0xd0, 0xf1, 0x6b, 0xa7, 0x4b, 0xd3, 0x0c, 0xee, 0xce, 0xef, 0xea, 0xca, 0xc9, 0x1d, 0x43,
+	0xc2, 0xe6, 0xd1, 0x4b, 0x87, 0x03, 0x54, 0x2f, 0x3b, 0xe0, 0x9e, 0xd3, 0x74, 0xde, 0x7c, 0xba,
+	0xd6, 0x71, 0x23, 0x49, 0xa8, 0xb1, 0xf8, 0x01, 0x6a, 0x1e, 0x4f, 0x5b, 0x43, 0x13, 0xdd, 0x99,
+	0x4f, 0x74, 0xd1, 0x10, 0x4d, 0x4c, 0xda, 0x31, 0x0b, 0xbe, 0x81, 0x1a, 0x7d, 0xc6, 0xd3, 0xbe,
+	0x72, 0xcf, 0x03, 0x5f, 0x2d, 0x58, 0x01, 0xc0, 0x92, 0x01, 0x18, 0x3d, 0xb4, 0xc3, 0x3c, 0xe0,
+	0x1d, 0xb8, 0x01, 0x7c, 0xf1, 0xdc, 0x05, 0x3d, 0x2b, 0xeb, 0xa7, 0x66, 0xe5, 0x61, 0xf5, 0x39,
+	0x0c, 0xd6, 0xec, 0xb0, 0x54, 0x57, 0x00, 0x03, 0x79, 0x55, 0x8e, 0x88, 0x26, 0x80, 0xb5, 0xb6,
+	0xa8, 0x47, 0x25, 0x89, 0x46, 0xb0, 0x6f, 0x06, 0x6e, 0x73, 0x2e, 0x61, 0xc7, 0x12, 0xae, 0x4e,
+	0x4c, 0x9f, 0x45, 0x1b, 0xe2, 0x96, 0x51, 0x3d, 0x2a, 0x35, 0xc1, 0x77, 0xaf, 0xff, 0xdc, 0x70,
+	0xde, 0xc0, 0xf9, 0x03, 0xce, 0xab, 0x77, 0x1b, 0x67, 0xde, 0xc0, 0xf9, 0x15, 0xce, 0x93, 0x5b,
+	0x29, 0x57, 0xfd, 0x51, 0xcf, 0x8b, 0x45, 0xe6, 0xdb, 0xe5, 0x79, 0x73, 0x40, 0x7b, 0xb2, 0x12,
+	0xfc, 0xfd, 0xdb, 0x5d, 0xff, 0xa5, 0xf9, 0x7b, 0xa1, 0xc6, 0x39, 0x93, 0xbd, 0x86, 0xce, 0xe6,
+	0xce, 0xbf, 0x9c, 0xdb, 0x75, 0xd7, 0xcb, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -195,6 +493,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpotPriceGuards) > 0 {
+		for iNdEx := len(m.SpotPriceGuards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotPriceGuards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PoolRecordRetentions) > 0 {
+		for iNdEx := len(m.PoolRecordRetentions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRecordRetentions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -233,6 +559,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpotPriceAnomalies) > 0 {
+		for iNdEx := len(m.SpotPriceAnomalies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpotPriceAnomalies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -260,56 +600,792 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PoolRecordRetention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PoolRecordRetention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolRecordRetention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PruneEpochIdentifier)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if m.Archival {
+		i--
+		if m.Archival {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecordHistoryKeepPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SpotPriceGuard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SpotPriceGuard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceGuard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Twaps) > 0 {
-		for _, e := range m.Twaps {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PauseDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PauseDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TwapWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintGenesis(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *SpotPriceAnomaly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *SpotPriceAnomaly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpotPriceAnomaly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedUntil):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x4a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintGenesis(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Asset1Denom) > 0 {
+		i -= len(m.Asset1Denom)
+		copy(dAtA[i:], m.Asset1Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Asset1Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset0Denom) > 0 {
+		i -= len(m.Asset0Denom)
+		copy(dAtA[i:], m.Asset0Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Asset0Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PruneEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRecordRetentions) > 0 {
+		for _, e := range m.PoolRecordRetentions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SpotPriceGuards) > 0 {
+		for _, e := range m.SpotPriceGuards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Twaps) > 0 {
+		for _, e := range m.Twaps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SpotPriceAnomalies) > 0 {
+		for _, e := range m.SpotPriceAnomalies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolRecordRetention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecordHistoryKeepPeriod)
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Archival {
+		n += 2
+	}
+	return n
+}
+
+func (m *SpotPriceGuard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TwapWindow)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PauseDuration)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *SpotPriceAnomaly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = len(m.Asset0Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Asset1Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Twap.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Deviation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedUntil)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRecordRetentions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRecordRetentions = append(m.PoolRecordRetentions, PoolRecordRetention{})
+			if err := m.PoolRecordRetentions[len(m.PoolRecordRetentions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceGuards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPriceGuards = append(m.SpotPriceGuards, SpotPriceGuard{})
+			if err := m.SpotPriceGuards[len(m.SpotPriceGuards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Twaps = append(m.Twaps, TwapRecord{})
+			if err := m.Twaps[len(m.Twaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceAnomalies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpotPriceAnomalies = append(m.SpotPriceAnomalies, SpotPriceAnomaly{})
+			if err := m.SpotPriceAnomalies[len(m.SpotPriceAnomalies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolRecordRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolRecordRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolRecordRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Archival", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Archival = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+
+func (m *SpotPriceGuard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -332,15 +1408,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceGuard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceGuard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneEpochIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -368,11 +1463,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PruneEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistoryKeepPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -399,7 +1496,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecordHistoryKeepPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TwapWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PauseDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -424,7 +1554,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+
+func (m *SpotPriceAnomaly) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -447,15 +1578,219 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpotPriceAnomaly: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpotPriceAnomaly: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Twaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset0Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset0Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset1Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset1Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -482,14 +1817,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Twaps = append(m.Twaps, TwapRecord{})
-			if err := m.Twaps[len(m.Twaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -516,7 +1850,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -541,6 +1875,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
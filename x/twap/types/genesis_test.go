@@ -37,6 +37,35 @@ func TestGenesisState_Validate(t *testing.T) {
 	var (
 		basicParams = NewParams("week", 48*time.Hour)
 
+		baseGuard = SpotPriceGuard{
+			PoolId:        basePoolId,
+			MaxDeviation:  osmomath.MustNewDecFromStr("0.1"),
+			TwapWindow:    time.Minute,
+			PauseDuration: time.Hour,
+		}
+		withGuard = func(modify func(*SpotPriceGuard)) SpotPriceGuard {
+			guard := baseGuard
+			modify(&guard)
+			return guard
+		}
+
+		baseAnomaly = SpotPriceAnomaly{
+			PoolId:      basePoolId,
+			Asset0Denom: denom0,
+			Asset1Denom: denom1,
+			SpotPrice:   osmomath.NewDec(2),
+			Twap:        osmomath.OneDec(),
+			Deviation:   osmomath.OneDec(),
+			Height:      3,
+			Time:        baseTime,
+			PausedUntil: baseTime.Add(time.Hour),
+		}
+		withAnomaly = func(modify func(*SpotPriceAnomaly)) SpotPriceAnomaly {
+			anomaly := baseAnomaly
+			modify(&anomaly)
+			return anomaly
+		}
+
 		basicCustomGenesis = NewGenesisState(
 			basicParams,
 			[]TwapRecord{
@@ -138,6 +167,114 @@ func TestGenesisState_Validate(t *testing.T) {
 					baseRecord,
 				}),
 
+			expectedErr: true,
+		},
+		"valid pool record retentions": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordRetentions(basicParams, PoolRecordRetention{PoolId: 1, RecordHistoryKeepPeriod: time.Hour, Archival: true}),
+				[]TwapRecord{
+					baseRecord,
+				}),
+		},
+		"invalid pool record retention pool id - error": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordRetentions(basicParams, PoolRecordRetention{PoolId: 0, RecordHistoryKeepPeriod: time.Hour}), // invalid pool id
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid pool record retention duplicate pool id - error": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordRetentions(basicParams,
+					PoolRecordRetention{PoolId: 1, RecordHistoryKeepPeriod: time.Hour},
+					PoolRecordRetention{PoolId: 1, RecordHistoryKeepPeriod: 2 * time.Hour}), // duplicate pool id
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid pool record retention period - error": {
+			twapGenesis: NewGenesisState(
+				withPoolRecordRetentions(basicParams, PoolRecordRetention{PoolId: 1, RecordHistoryKeepPeriod: -1 * time.Hour}), // invalid duration
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"valid spot price guards": {
+			twapGenesis: NewGenesisState(
+				withSpotPriceGuards(basicParams,
+					baseGuard,
+					SpotPriceGuard{PoolId: 2, MaxDeviation: osmomath.OneDec(), TwapWindow: time.Minute}), // no pause
+				[]TwapRecord{
+					baseRecord,
+				}),
+		},
+		"invalid spot price guard pool id - error": {
+			twapGenesis: NewGenesisState(
+				withSpotPriceGuards(basicParams, withGuard(func(g *SpotPriceGuard) { g.PoolId = 0 })), // invalid pool id
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid spot price guard duplicate pool id - error": {
+			twapGenesis: NewGenesisState(
+				withSpotPriceGuards(basicParams, baseGuard, baseGuard), // duplicate pool id
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid spot price guard max deviation - error": {
+			twapGenesis: NewGenesisState(
+				withSpotPriceGuards(basicParams, withGuard(func(g *SpotPriceGuard) { g.MaxDeviation = osmomath.ZeroDec() })), // invalid max deviation
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid spot price guard twap window - error": {
+			twapGenesis: NewGenesisState(
+				withSpotPriceGuards(basicParams, withGuard(func(g *SpotPriceGuard) { g.TwapWindow = 0 })), // invalid twap window
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"invalid spot price guard pause duration - error": {
+			twapGenesis: NewGenesisState(
+				withSpotPriceGuards(basicParams, withGuard(func(g *SpotPriceGuard) { g.PauseDuration = -time.Hour })), // invalid pause duration
+				[]TwapRecord{
+					baseRecord,
+				}),
+
+			expectedErr: true,
+		},
+		"valid spot price anomalies": {
+			twapGenesis: withSpotPriceAnomalies(basicCustomGenesis, baseAnomaly),
+		},
+		"invalid spot price anomaly duplicate pool id - error": {
+			twapGenesis: withSpotPriceAnomalies(basicCustomGenesis, baseAnomaly, baseAnomaly), // duplicate pool id
+
+			expectedErr: true,
+		},
+		"invalid spot price anomaly empty denom - error": {
+			twapGenesis: withSpotPriceAnomalies(basicCustomGenesis, withAnomaly(func(a *SpotPriceAnomaly) { a.Asset1Denom = "" })), // empty denom
+
+			expectedErr: true,
+		},
+		"invalid spot price anomaly paused until before time - error": {
+			twapGenesis: withSpotPriceAnomalies(basicCustomGenesis, withAnomaly(func(a *SpotPriceAnomaly) { a.PausedUntil = baseTime.Add(-time.Second) })), // paused until before time
+
 			expectedErr: true,
 		},
 	}
@@ -387,3 +524,19 @@ func TestTWAPRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func withPoolRecordRetentions(params Params, retentions ...PoolRecordRetention) Params {
+	params.PoolRecordRetentions = retentions
+	return params
+}
+
+func withSpotPriceGuards(params Params, guards ...SpotPriceGuard) Params {
+	params.SpotPriceGuards = guards
+	return params
+}
+
+func withSpotPriceAnomalies(genesis *GenesisState, anomalies ...SpotPriceAnomaly) *GenesisState {
+	withAnomalies := *genesis
+	withAnomalies.SpotPriceAnomalies = anomalies
+	return &withAnomalies
+}
@@ -28,6 +28,7 @@ var (
 	mostRecentTWAPsNoSeparator         = "recent_twap"
 	historicalTWAPTimeIndexNoSeparator = "historical_time_index"
 	historicalTWAPPoolIndexNoSeparator = "historical_pool_index"
+	spotPriceAnomalyNoSeparator        = "spot_price_anomaly"
 
 	// We do key management to let us easily meet the goals of (AKA minimal iteration):
 	// * Get most recent twap for a (pool id, asset 1, asset 2) with no iteration
@@ -43,6 +44,9 @@ var (
 	// format is pool id | denom1 | denom2 | time
 	// made for efficiently getting records given (pool id, denom1, denom2) and time bounds
 	HistoricalTWAPPoolIndexPrefix = historicalTWAPPoolIndexNoSeparator + KeySeparator
+	// format is pool id
+	// made for getting the spot price anomaly flagging a pool
+	SpotPriceAnomalyPrefix = spotPriceAnomalyNoSeparator + KeySeparator
 )
 
 // TODO: make utility command to automatically interlace separators
@@ -56,6 +60,10 @@ func FormatMostRecentTWAPKey(poolId uint64, denom1, denom2 string) []byte {
 	return []byte(fmt.Sprintf("%s%s%s%s%s%s", mostRecentTWAPsPrefix, poolIdS, KeySeparator, denom1, KeySeparator, denom2))
 }
 
+func FormatSpotPriceAnomalyKey(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s", SpotPriceAnomalyPrefix, osmoutils.FormatFixedLengthU64(poolId)))
+}
+
 // TODO: Replace historical management with ORM, we currently accept 2x write amplification right now.
 func FormatHistoricalTimeIndexTWAPKey(accumulatorWriteTime time.Time, poolId uint64, denom1, denom2 string) []byte {
 	timeS := osmoutils.FormatTimeString(accumulatorWriteTime)
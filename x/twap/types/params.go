@@ -13,6 +13,8 @@ import (
 var (
 	KeyPruneEpochIdentifier    = []byte("PruneEpochIdentifier")
 	KeyRecordHistoryKeepPeriod = []byte("RecordHistoryKeepPeriod")
+	KeyPoolRecordRetentions    = []byte("PoolRecordRetentions")
+	KeySpotPriceGuards         = []byte("SpotPriceGuards")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -52,6 +54,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validatePoolRecordRetentions(p.PoolRecordRetentions); err != nil {
+		return err
+	}
+
+	if err := validateSpotPriceGuards(p.SpotPriceGuards); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -60,6 +70,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPruneEpochIdentifier, &p.PruneEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 		paramtypes.NewParamSetPair(KeyRecordHistoryKeepPeriod, &p.RecordHistoryKeepPeriod, validatePeriod),
+		paramtypes.NewParamSetPair(KeyPoolRecordRetentions, &p.PoolRecordRetentions, validatePoolRecordRetentions),
+		paramtypes.NewParamSetPair(KeySpotPriceGuards, &p.SpotPriceGuards, validateSpotPriceGuards),
 	}
 }
 
@@ -75,3 +87,59 @@ func validatePeriod(i interface{}) error {
 
 	return nil
 }
+
+func validatePoolRecordRetentions(i interface{}) error {
+	retentions, ok := i.([]PoolRecordRetention)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]struct{}, len(retentions))
+	for _, retention := range retentions {
+		if retention.PoolId == 0 {
+			return fmt.Errorf("pool record retention pool id cannot be 0")
+		}
+		if _, ok := seen[retention.PoolId]; ok {
+			return fmt.Errorf("duplicate pool record retention for pool id %d", retention.PoolId)
+		}
+		seen[retention.PoolId] = struct{}{}
+
+		if err := validatePeriod(retention.RecordHistoryKeepPeriod); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSpotPriceGuards(i interface{}) error {
+	guards, ok := i.([]SpotPriceGuard)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]struct{}, len(guards))
+	for _, guard := range guards {
+		if guard.PoolId == 0 {
+			return fmt.Errorf("spot price guard pool id cannot be 0")
+		}
+		if _, ok := seen[guard.PoolId]; ok {
+			return fmt.Errorf("duplicate spot price guard for pool id %d", guard.PoolId)
+		}
+		seen[guard.PoolId] = struct{}{}
+
+		if guard.MaxDeviation.IsNil() || !guard.MaxDeviation.IsPositive() {
+			return fmt.Errorf("spot price guard max deviation must be positive, was (%s)", guard.MaxDeviation)
+		}
+
+		if err := validatePeriod(guard.TwapWindow); err != nil {
+			return err
+		}
+
+		if guard.PauseDuration < 0 {
+			return fmt.Errorf("spot price guard pause duration cannot be negative: %d", guard.PauseDuration)
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,15 @@
 package keeper
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
+)
 
 func (k Keeper) SwapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) {
 	k.swapNonNativeFeeToDenom(ctx, denomToSwapTo, feeCollectorAddress)
 }
+
+func (k Keeper) GetFeeSwapRoute(ctx sdk.Context, tokenIn sdk.Coin, denomToSwapTo string) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	return k.getFeeSwapRoute(ctx, tokenIn, denomToSwapTo)
+}
@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v21/x/poolmanager/types"
 	txfeestypes "github.com/osmosis-labs/osmosis/v21/x/txfees/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 )
@@ -77,8 +80,9 @@ func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumbe
 
 // swapNonNativeFeeToDenom swaps the given non-native fees into the given denom from the given fee collector address.
 // If an error in swap occurs for a given denom, it will be silently skipped.
-// CONTRACT: a pool must exist between each denom in the balance and denomToSwapTo. If doesn't exist. Silently skip swap.
-// CONTRACT: protorev must be configured to have a pool for the given denom pair. Otherwise, the denom will be skipped.
+// Each denom is swapped along the route with the largest liquidity of at most two hops, see getFeeSwapRoute.
+// CONTRACT: a route must exist between each denom in the balance and denomToSwapTo. If doesn't exist. Silently skip swap.
+// CONTRACT: protorev must be configured to have a pool for every denom pair of the route. Otherwise, the denom will be skipped.
 func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, feeCollectorAddress sdk.AccAddress) {
 	feeCollectorBalance := k.bankKeeper.GetAllBalances(ctx, feeCollectorAddress)
 
@@ -87,20 +91,19 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 			continue
 		}
 
-		// Search for the denom pair route via the protorev store.
-		// Since OSMO is one of the protorev denoms, many of the routes will exist in this store.
+		// Search for the route with the largest liquidity to the denom to swap to, going through at most one
+		// intermediary denom. The pools of the route are taken from the protorev store. Since OSMO is one of
+		// the protorev denoms, many of the routes will exist in this store.
 		// There will be times when this store does not know about a route, but this is acceptable
 		// since this will likely be a very small value of a relatively unknown token. If this begins
 		// to accrue more value, we can always manually register the route and it will get swapped in
 		// the next epoch.
-		poolId, err := k.protorevKeeper.GetPoolForDenomPairNoOrder(ctx, denomToSwapTo, coin.Denom)
+		route, err := k.getFeeSwapRoute(ctx, coin, denomToSwapTo)
 		if err != nil {
-			if err != nil {
-				// The pool route either doesn't exist or is disabled in protorev.
-				// It will just accrue in the non-native fee collector account.
-				// Skip this denom and move on to the next one.
-				continue
-			}
+			// The pool route either doesn't exist or is disabled in protorev.
+			// It will just accrue in the non-native fee collector account.
+			// Skip this denom and move on to the next one.
+			continue
 		}
 
 		// Do the swap of this fee token denom to base denom.
@@ -113,8 +116,87 @@ func (k Keeper) swapNonNativeFeeToDenom(ctx sdk.Context, denomToSwapTo string, f
 
 			// We swap without charging a taker fee / sending to the non native fee collector, since these are funds that
 			// are accruing from the taker fee itself.
-			_, err := k.poolManager.SwapExactAmountInNoTakerFee(cacheCtx, feeCollectorAddress, poolId, coin, denomToSwapTo, minAmountOut)
-			return err
+			tokenIn := coin
+			for _, routeStep := range route {
+				tokenOutAmount, err := k.poolManager.SwapExactAmountInNoTakerFee(cacheCtx, feeCollectorAddress, routeStep.PoolId, tokenIn, routeStep.TokenOutDenom, minAmountOut)
+				if err != nil {
+					return err
+				}
+				tokenIn = sdk.NewCoin(routeStep.TokenOutDenom, tokenOutAmount)
+			}
+			return nil
 		})
 	}
 }
+
+// getFeeSwapRoute returns the route used to swap the given fee token into the given denom.
+// The candidate routes are the direct route and the two hop routes through the base denom and
+// every authorized quote denom, where each hop goes through the highest liquidity pool stored in
+// protorev for its denom pair. Out of these, the route with the largest estimated amount out is
+// returned, as it is the one with the most liquidity relative to the amount swapped.
+// Returns an error if no candidate route exists.
+func (k Keeper) getFeeSwapRoute(ctx sdk.Context, tokenIn sdk.Coin, denomToSwapTo string) ([]poolmanagertypes.SwapAmountInRoute, error) {
+	candidateRoutes := [][]poolmanagertypes.SwapAmountInRoute{}
+
+	if poolId, err := k.protorevKeeper.GetPoolForDenomPairNoOrder(ctx, denomToSwapTo, tokenIn.Denom); err == nil {
+		candidateRoutes = append(candidateRoutes, []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: denomToSwapTo}})
+	}
+
+	for _, intermediaryDenom := range k.getFeeSwapIntermediaryDenoms(ctx) {
+		if intermediaryDenom == tokenIn.Denom || intermediaryDenom == denomToSwapTo {
+			continue
+		}
+
+		firstPoolId, err := k.protorevKeeper.GetPoolForDenomPairNoOrder(ctx, intermediaryDenom, tokenIn.Denom)
+		if err != nil {
+			continue
+		}
+		secondPoolId, err := k.protorevKeeper.GetPoolForDenomPairNoOrder(ctx, denomToSwapTo, intermediaryDenom)
+		if err != nil {
+			continue
+		}
+
+		candidateRoutes = append(candidateRoutes, []poolmanagertypes.SwapAmountInRoute{
+			{PoolId: firstPoolId, TokenOutDenom: intermediaryDenom},
+			{PoolId: secondPoolId, TokenOutDenom: denomToSwapTo},
+		})
+	}
+
+	if len(candidateRoutes) == 0 {
+		return nil, fmt.Errorf("no route found to swap %s to %s", tokenIn.Denom, denomToSwapTo)
+	}
+
+	// A single candidate route is used as is, leaving any failure to the swap itself.
+	if len(candidateRoutes) == 1 {
+		return candidateRoutes[0], nil
+	}
+
+	bestRoute, bestAmountOut := candidateRoutes[0], osmomath.ZeroInt()
+	for _, route := range candidateRoutes {
+		amountOut, err := k.poolManager.MultihopEstimateOutGivenExactAmountIn(ctx, route, tokenIn)
+		if err != nil {
+			continue
+		}
+		if amountOut.GT(bestAmountOut) {
+			bestRoute, bestAmountOut = route, amountOut
+		}
+	}
+
+	return bestRoute, nil
+}
+
+// getFeeSwapIntermediaryDenoms returns the denoms that fees may be swapped through on their way to
+// the denom to swap to, that is the base denom followed by the authorized quote denoms.
+func (k Keeper) getFeeSwapIntermediaryDenoms(ctx sdk.Context) []string {
+	intermediaryDenoms := []string{}
+	if baseDenom, err := k.GetBaseDenom(ctx); err == nil {
+		intermediaryDenoms = append(intermediaryDenoms, baseDenom)
+	}
+
+	for _, quoteDenom := range k.poolManager.GetParams(ctx).AuthorizedQuoteDenoms {
+		if !osmoutils.Contains(intermediaryDenoms, quoteDenom) {
+			intermediaryDenoms = append(intermediaryDenoms, quoteDenom)
+		}
+	}
+	return intermediaryDenoms
+}
@@ -390,6 +390,74 @@ func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_SimpleCases() {
 // - All non-native rewards that have a pool with liquidity and a link set in protorev get swapped to a denom configured by parameter.
 // - All resulting parameter denom tokens get sent to the community pool.
 // - Any non-native tokens that did not have associated pool stay in the balance of community pool fee collector.
+// TestSwapNonNativeFeeToDenom_MultiHop tests that fees are swapped along the route with the largest
+// liquidity, going through the base denom when there is no direct pool or when it is deeper.
+func (s *KeeperTestSuite) TestSwapNonNativeFeeToDenom_MultiHop() {
+	const denomToSwapTo = "swapto"
+
+	tests := map[string]struct {
+		directPoolAmount   int64
+		twoHopPoolAmount   int64
+		expectedRouteHops  int
+		expectNoRouteFound bool
+	}{
+		"only two hop route": {
+			twoHopPoolAmount:  1_000_000,
+			expectedRouteHops: 2,
+		},
+		"two hop route deeper than direct route": {
+			directPoolAmount:  1_000,
+			twoHopPoolAmount:  1_000_000,
+			expectedRouteHops: 2,
+		},
+		"direct route deeper than two hop route": {
+			directPoolAmount:  1_000_000,
+			twoHopPoolAmount:  1_000,
+			expectedRouteHops: 1,
+		},
+		"no route": {
+			expectNoRouteFound: true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			baseDenom, _ := s.App.TxFeesKeeper.GetBaseDenom(s.Ctx)
+
+			if tc.directPoolAmount > 0 {
+				poolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(preSwapDenom, tc.directPoolAmount), sdk.NewInt64Coin(denomToSwapTo, tc.directPoolAmount))
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, denomToSwapTo, preSwapDenom, poolId)
+			}
+			if tc.twoHopPoolAmount > 0 {
+				firstPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(preSwapDenom, tc.twoHopPoolAmount), sdk.NewInt64Coin(baseDenom, tc.twoHopPoolAmount))
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, baseDenom, preSwapDenom, firstPoolId)
+				secondPoolId := s.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin(baseDenom, tc.twoHopPoolAmount), sdk.NewInt64Coin(denomToSwapTo, tc.twoHopPoolAmount))
+				s.App.ProtoRevKeeper.SetPoolForDenomPair(s.Ctx, baseDenom, denomToSwapTo, secondPoolId)
+			}
+
+			tokenIn := sdk.NewInt64Coin(preSwapDenom, 500)
+			route, err := s.App.TxFeesKeeper.GetFeeSwapRoute(s.Ctx, tokenIn, denomToSwapTo)
+			if tc.expectNoRouteFound {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(route, tc.expectedRouteHops)
+
+			// Fees are swapped along the route into the denom to swap to.
+			testAccount := apptesting.CreateRandomAccounts(1)[0]
+			s.FundAcc(testAccount, sdk.NewCoins(tokenIn))
+
+			s.App.TxFeesKeeper.SwapNonNativeFeeToDenom(s.Ctx, denomToSwapTo, testAccount)
+
+			balances := s.App.BankKeeper.GetAllBalances(s.Ctx, testAccount)
+			s.Require().Len(balances, 1)
+			s.Require().Equal(denomToSwapTo, balances[0].Denom)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestAfterEpochEnd() {
 	s.Setup()
 
@@ -41,6 +41,12 @@ type PoolManager interface {
 		tokenOutMinAmount osmomath.Int,
 	) (osmomath.Int, error)
 
+	MultihopEstimateOutGivenExactAmountIn(
+		ctx sdk.Context,
+		route []poolmanagertypes.SwapAmountInRoute,
+		tokenIn sdk.Coin,
+	) (tokenOutAmount osmomath.Int, err error)
+
 	GetParams(ctx sdk.Context) (params poolmanagertypes.Params)
 }
 
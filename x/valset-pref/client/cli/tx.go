@@ -25,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, NewUndelRebalancedValSetCmd)
 	osmocli.AddTxCmd(txCmd, NewReDelValSetCmd)
 	osmocli.AddTxCmd(txCmd, NewWithRewValSetCmd)
+	osmocli.AddTxCmd(txCmd, NewRebalanceValSetCmd)
 	return txCmd
 }
 
@@ -87,6 +88,15 @@ func NewWithRewValSetCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawDelegationRewa
 	}, &types.MsgWithdrawDelegationRewards{}
 }
 
+func NewRebalanceValSetCmd() (*osmocli.TxCliDesc, *types.MsgRebalanceDelegations) {
+	return &osmocli.TxCliDesc{
+		Use:     "rebalance-valset",
+		Short:   "Redelegate the minimal amount of existing delegations to match the validator set preference.",
+		Example: "osmosisd tx valset-pref rebalance-valset osmo1...",
+		NumArgs: 1,
+	}, &types.MsgRebalanceDelegations{}
+}
+
 func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
 	delAddr, err := sdk.AccAddressFromBech32(args[0])
 	if err != nil {
@@ -145,3 +145,16 @@ func (server msgServer) DelegateBondedTokens(goCtx context.Context, msg *types.M
 
 	return &types.MsgDelegateBondedTokensResponse{}, nil
 }
+
+// RebalanceDelegations redelegates the minimal amount of the delegator's existing delegations
+// needed to match the weights of their validator set preference.
+func (server msgServer) RebalanceDelegations(goCtx context.Context, msg *types.MsgRebalanceDelegations) (*types.MsgRebalanceDelegationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.RebalanceDelegations(ctx, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRebalanceDelegationsResponse{}, nil
+}
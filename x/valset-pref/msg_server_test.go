@@ -1116,3 +1116,113 @@ func (s *KeeperTestSuite) TestDelegateBondedTokens() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRebalanceDelegations() {
+	delegator := sdk.AccAddress([]byte("addr1---------------"))
+
+	tests := []struct {
+		name string
+		// amounts delegated to each of the 4 validators before rebalancing
+		existingDelegations []int64
+		// redelegations made before rebalancing, as {source index, target index, amount}
+		priorRedelegations  [][3]int64
+		setValSetPreference bool
+		// expected amounts delegated to each of the 4 validators after rebalancing
+		expectedDelegations   []int64
+		expectedRedelegations int
+		expectedErr           error
+	}{
+		{
+			name:                  "delegations already match preference",
+			existingDelegations:   []int64{10_000_000, 6_000_000, 4_000_000, 0},
+			setValSetPreference:   true,
+			expectedDelegations:   []int64{10_000_000, 6_000_000, 4_000_000, 0},
+			expectedRedelegations: 0,
+		},
+		{
+			name:                  "only the difference to the target is redelegated",
+			existingDelegations:   []int64{12_000_000, 6_000_000, 0, 2_000_000},
+			setValSetPreference:   true,
+			expectedDelegations:   []int64{10_000_000, 6_000_000, 4_000_000, 0},
+			expectedRedelegations: 2, // valA -> valC, valD -> valC; valB is untouched
+		},
+		{
+			name:                  "largest source fills largest destination first",
+			existingDelegations:   []int64{0, 0, 0, 20_000_000},
+			setValSetPreference:   true,
+			expectedDelegations:   []int64{10_000_000, 6_000_000, 4_000_000, 0},
+			expectedRedelegations: 3,
+		},
+		{
+			name:                "source receiving a redelegation is skipped",
+			existingDelegations: []int64{0, 0, 0, 20_000_000},
+			priorRedelegations:  [][3]int64{{3, 0, 20_000_000}},
+			setValSetPreference: true,
+			expectedErr:         types.NoRebalancePossibleError{DelegatorAddr: delegator.String()},
+		},
+		{
+			name:                "no validator set preference",
+			existingDelegations: []int64{10_000_000, 6_000_000, 4_000_000, 0},
+			expectedErr:         types.NoValidatorSetPreferenceError{DelegatorAddr: delegator.String()},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			valAddrs := s.SetupMultipleValidators(4)
+			preferences := []types.ValidatorPreference{
+				{ValOperAddress: valAddrs[0], Weight: osmomath.NewDecWithPrec(5, 1)},
+				{ValOperAddress: valAddrs[1], Weight: osmomath.NewDecWithPrec(3, 1)},
+				{ValOperAddress: valAddrs[2], Weight: osmomath.NewDecWithPrec(2, 1)},
+			}
+
+			s.FundAcc(delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)})
+			for i, amount := range test.existingDelegations {
+				if amount == 0 {
+					continue
+				}
+				err := s.PrepareExistingDelegations(s.Ctx, []string{valAddrs[i]}, delegator, osmomath.NewInt(amount))
+				s.Require().NoError(err)
+			}
+
+			for _, redelegation := range test.priorRedelegations {
+				valSource, err := sdk.ValAddressFromBech32(valAddrs[redelegation[0]])
+				s.Require().NoError(err)
+				valTarget, err := sdk.ValAddressFromBech32(valAddrs[redelegation[1]])
+				s.Require().NoError(err)
+				_, err = s.App.StakingKeeper.BeginRedelegation(s.Ctx, delegator, valSource, valTarget, osmomath.NewDec(redelegation[2]))
+				s.Require().NoError(err)
+			}
+			redelegationsBefore := len(s.App.StakingKeeper.GetRedelegations(s.Ctx, delegator, 100))
+
+			if test.setValSetPreference {
+				s.App.ValidatorSetPreferenceKeeper.SetValidatorSetPreferences(s.Ctx, delegator.String(), types.ValidatorSetPreferences{Preferences: preferences})
+			}
+
+			msgServer := valPref.NewMsgServerImpl(s.App.ValidatorSetPreferenceKeeper)
+			_, err := msgServer.RebalanceDelegations(sdk.WrapSDKContext(s.Ctx), types.NewMsgRebalanceDelegations(delegator))
+			if test.expectedErr != nil {
+				s.Require().ErrorIs(err, test.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			for i, expectedAmount := range test.expectedDelegations {
+				valAddr, err := sdk.ValAddressFromBech32(valAddrs[i])
+				s.Require().NoError(err)
+
+				delegation, found := s.App.StakingKeeper.GetDelegation(s.Ctx, delegator, valAddr)
+				if expectedAmount == 0 {
+					s.Require().False(found)
+					continue
+				}
+				s.Require().True(found)
+				s.Require().Equal(osmomath.NewDec(expectedAmount), delegation.Shares)
+			}
+
+			redelegationsAfter := len(s.App.StakingKeeper.GetRedelegations(s.Ctx, delegator, 100))
+			s.Require().Equal(test.expectedRedelegations, redelegationsAfter-redelegationsBefore)
+		})
+	}
+}
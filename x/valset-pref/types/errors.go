@@ -43,3 +43,19 @@ type ValidatorNotFoundError struct {
 func (e ValidatorNotFoundError) Error() string {
 	return fmt.Sprintf("validator %s not found", e.ValidatorAddr)
 }
+
+type NoValidatorSetPreferenceError struct {
+	DelegatorAddr string
+}
+
+func (e NoValidatorSetPreferenceError) Error() string {
+	return fmt.Sprintf("user %s doesn't have a validator set preference", e.DelegatorAddr)
+}
+
+type NoRebalancePossibleError struct {
+	DelegatorAddr string
+}
+
+func (e NoRebalancePossibleError) Error() string {
+	return fmt.Sprintf("no redelegation is possible to rebalance the delegations of user %s, try again once the pending redelegations complete", e.DelegatorAddr)
+}
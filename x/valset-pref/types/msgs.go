@@ -329,3 +329,37 @@ func (m MsgDelegateBondedTokens) GetSigners() []sdk.AccAddress {
 	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
 	return []sdk.AccAddress{delegator}
 }
+
+// constants
+const (
+	TypeMsgRebalanceDelegations = "rebalance_delegations"
+)
+
+var _ sdk.Msg = &MsgRebalanceDelegations{}
+
+// NewMsgRebalanceDelegations creates a msg to rebalance the delegations to match the validator set preference.
+func NewMsgRebalanceDelegations(delegator sdk.AccAddress) *MsgRebalanceDelegations {
+	return &MsgRebalanceDelegations{
+		Delegator: delegator.String(),
+	}
+}
+
+func (m MsgRebalanceDelegations) Route() string { return RouterKey }
+func (m MsgRebalanceDelegations) Type() string  { return TypeMsgRebalanceDelegations }
+func (m MsgRebalanceDelegations) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgRebalanceDelegations) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRebalanceDelegations) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
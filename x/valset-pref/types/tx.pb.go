@@ -679,6 +679,90 @@ func (m *MsgDelegateBondedTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateBondedTokensResponse proto.InternalMessageInfo
 
+// MsgRebalanceDelegations redelegates the user's existing delegations so that
+// they match the weights of their validator-set preference, moving as few
+// tokens as possible.
+type MsgRebalanceDelegations struct {
+	// delegator is the user who is trying to rebalance their delegations.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+}
+
+func (m *MsgRebalanceDelegations) Reset()         { *m = MsgRebalanceDelegations{} }
+func (m *MsgRebalanceDelegations) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceDelegations) ProtoMessage()    {}
+func (*MsgRebalanceDelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff1326c2fd6b4c, []int{14}
+}
+func (m *MsgRebalanceDelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceDelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceDelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceDelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceDelegations.Merge(m, src)
+}
+func (m *MsgRebalanceDelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceDelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceDelegations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceDelegations proto.InternalMessageInfo
+
+func (m *MsgRebalanceDelegations) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+type MsgRebalanceDelegationsResponse struct {
+}
+
+func (m *MsgRebalanceDelegationsResponse) Reset()         { *m = MsgRebalanceDelegationsResponse{} }
+func (m *MsgRebalanceDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRebalanceDelegationsResponse) ProtoMessage()    {}
+func (*MsgRebalanceDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fff1326c2fd6b4c, []int{15}
+}
+func (m *MsgRebalanceDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRebalanceDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRebalanceDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRebalanceDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRebalanceDelegationsResponse.Merge(m, src)
+}
+func (m *MsgRebalanceDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRebalanceDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRebalanceDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRebalanceDelegationsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetValidatorSetPreference)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreference")
 	proto.RegisterType((*MsgSetValidatorSetPreferenceResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreferenceResponse")
@@ -694,6 +778,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawDelegationRewardsResponse)(nil), "osmosis.valsetpref.v1beta1.MsgWithdrawDelegationRewardsResponse")
 	proto.RegisterType((*MsgDelegateBondedTokens)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateBondedTokens")
 	proto.RegisterType((*MsgDelegateBondedTokensResponse)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateBondedTokensResponse")
+	proto.RegisterType((*MsgRebalanceDelegations)(nil), "osmosis.valsetpref.v1beta1.MsgRebalanceDelegations")
+	proto.RegisterType((*MsgRebalanceDelegationsResponse)(nil), "osmosis.valsetpref.v1beta1.MsgRebalanceDelegationsResponse")
 }
 
 func init() {
@@ -701,54 +787,57 @@ func init() {
 }
 
 var fileDescriptor_3fff1326c2fd6b4c = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcb, 0x4f, 0xd4, 0x5e,
-	0x14, 0x9e, 0x0b, 0x84, 0xfc, 0xb8, 0x6c, 0x7e, 0x36, 0x04, 0xa1, 0xd1, 0x19, 0x28, 0x4f, 0x49,
-	0xe8, 0xcd, 0x0c, 0x1a, 0x15, 0x63, 0xa2, 0x03, 0x31, 0x31, 0x66, 0x12, 0x2d, 0xa8, 0x89, 0x0b,
-	0x93, 0x3b, 0xd3, 0x43, 0x69, 0x68, 0x7b, 0x27, 0xbd, 0x97, 0xd7, 0xc2, 0xbd, 0x71, 0x61, 0xdc,
-	0x99, 0xf8, 0x27, 0xb8, 0x72, 0xe9, 0xca, 0x35, 0x4b, 0x76, 0xba, 0x02, 0x03, 0x89, 0x6e, 0x5c,
-	0xe1, 0x3f, 0x60, 0xfa, 0x98, 0x52, 0x43, 0x1f, 0x58, 0xd1, 0xb8, 0x99, 0x99, 0xce, 0x79, 0x7d,
-	0xe7, 0x3b, 0x3d, 0xdf, 0xbd, 0x78, 0x8c, 0x71, 0x9b, 0x71, 0x93, 0x93, 0x0d, 0x6a, 0x71, 0x10,
-	0x6d, 0x17, 0x56, 0xc8, 0x46, 0xb5, 0x09, 0x82, 0x56, 0x89, 0xd8, 0x52, 0xdb, 0x2e, 0x13, 0x4c,
-	0x92, 0x43, 0x27, 0xf5, 0xd8, 0x49, 0x0d, 0x9d, 0xe4, 0x01, 0x83, 0x19, 0xcc, 0x77, 0x23, 0xde,
-	0xaf, 0x20, 0x42, 0x3e, 0x47, 0x6d, 0xd3, 0x61, 0xc4, 0xff, 0x0c, 0xff, 0xaa, 0x18, 0x8c, 0x19,
-	0x16, 0x10, 0xff, 0xa9, 0xb9, 0xbe, 0x42, 0x84, 0x69, 0x03, 0x17, 0xd4, 0x6e, 0x87, 0x0e, 0xe5,
-	0x96, 0x5f, 0x86, 0x34, 0x29, 0x87, 0x08, 0x43, 0x8b, 0x99, 0x4e, 0x68, 0x9f, 0xcc, 0x80, 0xca,
-	0x05, 0x15, 0x10, 0xf8, 0x29, 0xdf, 0x10, 0xbe, 0xd0, 0xe0, 0xc6, 0x12, 0x88, 0x47, 0xd4, 0x32,
-	0x75, 0x2a, 0x98, 0xbb, 0x04, 0xe2, 0xbe, 0x0b, 0x2b, 0xe0, 0x82, 0xd3, 0x02, 0xa9, 0x86, 0xfb,
-	0x74, 0xb0, 0xc0, 0xf0, 0x2c, 0x43, 0x68, 0x04, 0x4d, 0xf7, 0xd5, 0x07, 0x8e, 0xf6, 0x2a, 0xff,
-	0x6f, 0x53, 0xdb, 0x9a, 0x57, 0x22, 0x93, 0xa2, 0x1d, 0xbb, 0x49, 0x36, 0xee, 0x6f, 0x47, 0x19,
-	0xf8, 0x50, 0xd7, 0x48, 0xf7, 0x74, 0x7f, 0x8d, 0xa8, 0xe9, 0xc4, 0xa8, 0x51, 0xf1, 0xe3, 0xca,
-	0x75, 0x79, 0x67, 0xaf, 0x52, 0x3a, 0xda, 0xab, 0x48, 0x41, 0xa9, 0x58, 0x46, 0x45, 0x8b, 0xe7,
-	0x9f, 0xbf, 0xf4, 0xe2, 0xeb, 0xbb, 0x99, 0xf1, 0x4e, 0xc3, 0x59, 0xdd, 0x28, 0x93, 0x78, 0x3c,
-	0xcb, 0xae, 0x01, 0x6f, 0x33, 0x87, 0x83, 0xf2, 0x11, 0xe1, 0xe1, 0x06, 0x37, 0x16, 0x83, 0x96,
-	0x60, 0x99, 0xc5, 0xfd, 0x0b, 0x71, 0xf2, 0x14, 0xf7, 0x78, 0xe3, 0x19, 0xea, 0x1a, 0x41, 0xd3,
-	0xfd, 0xb5, 0x61, 0x35, 0x98, 0x9f, 0xea, 0xcd, 0x2f, 0x62, 0x61, 0x81, 0x99, 0x4e, 0x9d, 0x78,
-	0x6d, 0xbf, 0xdd, 0xaf, 0x4c, 0x19, 0xa6, 0x58, 0x5d, 0x6f, 0xaa, 0x2d, 0x66, 0x93, 0x70, 0xd8,
-	0xc1, 0xd7, 0x2c, 0xd7, 0xd7, 0x88, 0xd8, 0x6e, 0x03, 0xf7, 0x03, 0x34, 0x3f, 0xef, 0xfc, 0xa4,
-	0x47, 0xc2, 0x68, 0x8c, 0x84, 0x64, 0xec, 0xca, 0x18, 0x1e, 0x4d, 0x35, 0x46, 0xed, 0xef, 0x23,
-	0x7c, 0xb1, 0xc1, 0x8d, 0x87, 0x4e, 0x88, 0x1f, 0xee, 0xb8, 0xcc, 0x3e, 0x33, 0x0a, 0xba, 0xff,
-	0x10, 0x05, 0x33, 0x1e, 0x05, 0x13, 0x31, 0x0a, 0xd2, 0xf1, 0x2b, 0x53, 0x78, 0x22, 0xd3, 0x21,
-	0xa2, 0xe2, 0x3b, 0xc2, 0x53, 0x27, 0x3c, 0x35, 0x68, 0x52, 0x8b, 0x3a, 0x2d, 0xd0, 0xff, 0xf9,
-	0xf7, 0xe2, 0xb2, 0x47, 0x0a, 0x49, 0x25, 0x25, 0xb9, 0x13, 0xa5, 0x8a, 0x4f, 0xeb, 0x1a, 0x11,
-	0xf5, 0x25, 0x58, 0x19, 0x0d, 0x3a, 0x31, 0xbf, 0x4d, 0xcd, 0x5f, 0x96, 0x91, 0x13, 0x1b, 0x94,
-	0xdc, 0x4a, 0xb8, 0x41, 0xc9, 0xc6, 0x88, 0x8d, 0x67, 0xbe, 0xac, 0x3e, 0x36, 0xc5, 0xaa, 0xee,
-	0xd2, 0xcd, 0x70, 0xdd, 0x4c, 0xe6, 0x68, 0xb0, 0x49, 0x5d, 0x9d, 0x17, 0xe1, 0xe3, 0xa4, 0xce,
-	0xa5, 0xa6, 0x0f, 0x75, 0x2e, 0xd5, 0x1e, 0xc1, 0x04, 0x7c, 0x3e, 0xa6, 0x06, 0x75, 0xe6, 0xe8,
-	0xa0, 0x2f, 0xb3, 0x35, 0x70, 0x0a, 0x21, 0x94, 0x06, 0x71, 0xaf, 0xc5, 0x5a, 0x6b, 0x77, 0x17,
-	0xfd, 0xd7, 0xb9, 0x47, 0x0b, 0x9f, 0x94, 0x51, 0x5c, 0x49, 0x29, 0xd3, 0x41, 0x52, 0xfb, 0xf0,
-	0x1f, 0xee, 0x6e, 0x70, 0x43, 0x7a, 0x8d, 0xf0, 0x70, 0xfa, 0x69, 0x74, 0x2d, 0x6b, 0xfa, 0x59,
-	0xca, 0x2e, 0xdf, 0x2a, 0x1a, 0xd9, 0x41, 0x28, 0xbd, 0x44, 0x78, 0x30, 0xe5, 0x40, 0xb8, 0x92,
-	0x93, 0x3c, 0x39, 0x4c, 0xbe, 0x59, 0x28, 0x2c, 0x02, 0xf4, 0x06, 0x61, 0x39, 0x43, 0xa2, 0xaf,
-	0xe7, 0x64, 0x4f, 0x0f, 0x95, 0x6f, 0x17, 0x0e, 0x8d, 0xc0, 0xbd, 0x47, 0x78, 0xfc, 0x54, 0xa2,
-	0xb9, 0xf0, 0x4b, 0xb5, 0x92, 0x93, 0xc8, 0xf7, 0xce, 0x20, 0xc9, 0x4f, 0x83, 0x4e, 0x91, 0xb1,
-	0xbc, 0x41, 0x27, 0x87, 0xe5, 0x0e, 0x3a, 0x5b, 0x4c, 0xfc, 0x9d, 0x48, 0x97, 0x92, 0xbc, 0x9d,
-	0x48, 0x8d, 0xcc, 0xdd, 0x89, 0x5c, 0xfd, 0x90, 0x9e, 0x23, 0x3c, 0x90, 0xa8, 0x1e, 0x73, 0xa7,
-	0x7c, 0xb5, 0xe3, 0x41, 0xf2, 0x8d, 0x02, 0x41, 0x1d, 0x28, 0xf5, 0x07, 0x3b, 0x07, 0x65, 0xb4,
-	0x7b, 0x50, 0x46, 0x9f, 0x0f, 0xca, 0xe8, 0xd5, 0x61, 0xb9, 0xb4, 0x7b, 0x58, 0x2e, 0x7d, 0x3a,
-	0x2c, 0x97, 0x9e, 0x5c, 0x8d, 0x9d, 0x98, 0x61, 0x81, 0x59, 0x8b, 0x36, 0x79, 0x74, 0x2a, 0x6e,
-	0xd4, 0xaa, 0x64, 0x2b, 0xbc, 0x29, 0xcf, 0xfa, 0x57, 0x65, 0xff, 0x18, 0x6d, 0xf6, 0xfa, 0x77,
-	0xe4, 0xb9, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x8f, 0xd2, 0xc1, 0xf8, 0x0b, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x4f, 0xd4, 0x4e,
+	0x14, 0xdf, 0x01, 0x42, 0xc2, 0x70, 0xf9, 0x7e, 0x37, 0x84, 0x2f, 0x34, 0x5f, 0x77, 0xa1, 0x2c,
+	0x3f, 0x24, 0xa1, 0x93, 0x5d, 0x34, 0x2a, 0x84, 0x04, 0x17, 0x62, 0x62, 0xcc, 0x26, 0x5a, 0x50,
+	0x13, 0x0f, 0x26, 0xb3, 0xdb, 0xa1, 0x34, 0xb4, 0x9d, 0x4d, 0x67, 0xf8, 0x75, 0xf0, 0x6e, 0x3c,
+	0x18, 0x6f, 0x26, 0xfe, 0x09, 0x9e, 0x3c, 0xfa, 0x27, 0xe0, 0x8d, 0x9b, 0x9e, 0xc0, 0x40, 0xa2,
+	0x17, 0x4f, 0xf8, 0x0f, 0x98, 0xb6, 0xb3, 0xb3, 0x25, 0xdb, 0x76, 0xb1, 0xa2, 0xf1, 0xb2, 0xbb,
+	0xdd, 0xf7, 0x3e, 0xef, 0x7d, 0xde, 0xe7, 0xcd, 0xbc, 0xe9, 0xc0, 0x09, 0xca, 0x1c, 0xca, 0x2c,
+	0x86, 0x76, 0xb0, 0xcd, 0x08, 0x6f, 0x7a, 0x64, 0x03, 0xed, 0x94, 0xeb, 0x84, 0xe3, 0x32, 0xe2,
+	0x7b, 0x5a, 0xd3, 0xa3, 0x9c, 0xe6, 0x15, 0xe1, 0xa4, 0xb5, 0x9d, 0x34, 0xe1, 0xa4, 0x0c, 0x99,
+	0xd4, 0xa4, 0x81, 0x1b, 0xf2, 0x7f, 0x85, 0x08, 0xe5, 0x5f, 0xec, 0x58, 0x2e, 0x45, 0xc1, 0xa7,
+	0xf8, 0xab, 0x68, 0x52, 0x6a, 0xda, 0x04, 0x05, 0x4f, 0xf5, 0xed, 0x0d, 0xc4, 0x2d, 0x87, 0x30,
+	0x8e, 0x9d, 0xa6, 0x70, 0x28, 0x34, 0x82, 0x34, 0xa8, 0x8e, 0x19, 0x91, 0x1c, 0x1a, 0xd4, 0x72,
+	0x85, 0x7d, 0x2a, 0x85, 0x2a, 0xe3, 0x98, 0x93, 0xd0, 0x4f, 0xfd, 0x06, 0xe0, 0xff, 0x35, 0x66,
+	0xae, 0x11, 0xfe, 0x08, 0xdb, 0x96, 0x81, 0x39, 0xf5, 0xd6, 0x08, 0xbf, 0xef, 0x91, 0x0d, 0xe2,
+	0x11, 0xb7, 0x41, 0xf2, 0x15, 0x38, 0x60, 0x10, 0x9b, 0x98, 0xbe, 0x65, 0x04, 0x8c, 0x81, 0x99,
+	0x81, 0xea, 0xd0, 0xd9, 0x51, 0xf1, 0x9f, 0x7d, 0xec, 0xd8, 0x0b, 0xaa, 0x34, 0xa9, 0x7a, 0xdb,
+	0x2d, 0xef, 0xc0, 0xc1, 0xa6, 0x8c, 0xc0, 0x46, 0x7a, 0xc6, 0x7a, 0x67, 0x06, 0x2b, 0x48, 0x4b,
+	0x16, 0x46, 0x93, 0xc9, 0xdb, 0x99, 0xab, 0xca, 0xc1, 0x51, 0x31, 0x77, 0x76, 0x54, 0xcc, 0x87,
+	0xa9, 0x22, 0x11, 0x55, 0x3d, 0x1a, 0x7f, 0xe1, 0xea, 0x8b, 0xaf, 0xef, 0x66, 0x4b, 0xad, 0x82,
+	0xd3, 0xaa, 0x51, 0xa7, 0x60, 0x29, 0xcd, 0xae, 0x13, 0xd6, 0xa4, 0x2e, 0x23, 0xea, 0x47, 0x00,
+	0x47, 0x6b, 0xcc, 0x5c, 0x0d, 0x4b, 0x22, 0xeb, 0x34, 0xea, 0x9f, 0x49, 0x93, 0xa7, 0xb0, 0xcf,
+	0x6f, 0xcf, 0x48, 0xcf, 0x18, 0x98, 0x19, 0xac, 0x8c, 0x6a, 0x61, 0xff, 0x34, 0xbf, 0x7f, 0x52,
+	0x85, 0x15, 0x6a, 0xb9, 0x55, 0xe4, 0x97, 0xfd, 0xf6, 0xb8, 0x38, 0x6d, 0x5a, 0x7c, 0x73, 0xbb,
+	0xae, 0x35, 0xa8, 0x83, 0x44, 0xb3, 0xc3, 0xaf, 0x39, 0x66, 0x6c, 0x21, 0xbe, 0xdf, 0x24, 0x2c,
+	0x00, 0xe8, 0x41, 0xdc, 0x85, 0x29, 0x5f, 0x84, 0xf1, 0x88, 0x08, 0xf1, 0xdc, 0xd5, 0x09, 0x38,
+	0x9e, 0x68, 0x94, 0xe5, 0x1f, 0x03, 0x78, 0xa5, 0xc6, 0xcc, 0x87, 0xae, 0xe0, 0x4f, 0xee, 0x78,
+	0xd4, 0xb9, 0x34, 0x09, 0x7a, 0x7f, 0x93, 0x04, 0xb3, 0xbe, 0x04, 0x93, 0x11, 0x09, 0x92, 0xf9,
+	0xab, 0xd3, 0x70, 0x32, 0xd5, 0x41, 0x4a, 0xf1, 0x1d, 0xc0, 0xe9, 0x0e, 0x4f, 0x9d, 0xd4, 0xb1,
+	0x8d, 0xdd, 0x06, 0x31, 0xfe, 0xfa, 0x75, 0x71, 0xcd, 0x17, 0x05, 0x25, 0x8a, 0x12, 0x5f, 0x89,
+	0x5a, 0x86, 0x17, 0x75, 0x95, 0x42, 0x7d, 0x09, 0xb7, 0x8c, 0x4e, 0x5a, 0x98, 0x5f, 0x96, 0xe6,
+	0x0f, 0x8f, 0x91, 0x8e, 0x1d, 0x14, 0x5f, 0x8a, 0xd8, 0x41, 0xf1, 0x46, 0xa9, 0xc6, 0xb3, 0x60,
+	0xac, 0x3e, 0xb6, 0xf8, 0xa6, 0xe1, 0xe1, 0x5d, 0xb1, 0xdd, 0x2c, 0xea, 0xea, 0x64, 0x17, 0x7b,
+	0x06, 0xcb, 0xa2, 0x47, 0xe7, 0x9c, 0x4b, 0x0c, 0x2f, 0xe6, 0x5c, 0xa2, 0x5d, 0xd2, 0x24, 0xf0,
+	0xbf, 0xc8, 0x34, 0xa8, 0x52, 0xd7, 0x20, 0xc6, 0x3a, 0xdd, 0x22, 0x6e, 0x26, 0x86, 0xf9, 0x61,
+	0xd8, 0x6f, 0xd3, 0xc6, 0xd6, 0xdd, 0xd5, 0x60, 0x39, 0xf7, 0xe9, 0xe2, 0x49, 0x1d, 0x87, 0xc5,
+	0x84, 0x34, 0x92, 0x09, 0x0b, 0x98, 0xc8, 0x35, 0xd6, 0xa6, 0x9c, 0x4d, 0xab, 0x92, 0xaf, 0x55,
+	0xf1, 0x5c, 0x33, 0x3b, 0x23, 0x0b, 0x5e, 0x71, 0xa6, 0x16, 0xaf, 0xca, 0x87, 0x01, 0xd8, 0x5b,
+	0x63, 0x66, 0xfe, 0x35, 0x80, 0xa3, 0xc9, 0xa7, 0xe4, 0xcd, 0xb4, 0x55, 0x99, 0x76, 0xe2, 0x28,
+	0xcb, 0x59, 0x91, 0x2d, 0x86, 0xf9, 0x97, 0x00, 0x0e, 0x27, 0x1c, 0x54, 0xd7, 0xbb, 0x04, 0x8f,
+	0x87, 0x29, 0x4b, 0x99, 0x60, 0x92, 0xd0, 0x1b, 0x00, 0x95, 0x94, 0xa3, 0xe3, 0x56, 0x97, 0xe8,
+	0xc9, 0x50, 0xe5, 0x76, 0x66, 0xa8, 0x24, 0xf7, 0x1e, 0xc0, 0xd2, 0x85, 0x86, 0xf9, 0xca, 0x4f,
+	0xe5, 0x8a, 0x0f, 0xa2, 0xdc, 0xbb, 0x84, 0x20, 0xe7, 0x1a, 0x9d, 0x30, 0x5e, 0xbb, 0x35, 0x3a,
+	0x1e, 0xa6, 0x2c, 0x65, 0x82, 0x49, 0x42, 0xfe, 0x9e, 0x48, 0x1e, 0x71, 0xdd, 0xf6, 0x44, 0x22,
+	0x52, 0x59, 0xce, 0x8a, 0x94, 0xcc, 0x9e, 0x03, 0x38, 0x14, 0x3b, 0xd5, 0xe6, 0x2f, 0xb8, 0xb4,
+	0xa3, 0x20, 0x65, 0x31, 0x03, 0xe8, 0x1c, 0x95, 0xd8, 0xb1, 0x36, 0xdf, 0x55, 0xfc, 0x4e, 0x90,
+	0xb2, 0x98, 0x01, 0xd4, 0xa2, 0x52, 0x7d, 0x70, 0x70, 0x52, 0x00, 0x87, 0x27, 0x05, 0xf0, 0xf9,
+	0xa4, 0x00, 0x5e, 0x9d, 0x16, 0x72, 0x87, 0xa7, 0x85, 0xdc, 0xa7, 0xd3, 0x42, 0xee, 0xc9, 0x8d,
+	0xc8, 0x4b, 0x85, 0x48, 0x30, 0x67, 0xe3, 0x3a, 0x43, 0xf2, 0x1a, 0x51, 0x29, 0xa3, 0x3d, 0x71,
+	0x99, 0x98, 0x0b, 0x6e, 0x13, 0xc1, 0x9b, 0x46, 0xbd, 0x3f, 0xb8, 0x46, 0xcc, 0xff, 0x18, 0x00,
+	0x89, 0x83, 0x81, 0x77, 0x1b, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -786,6 +875,9 @@ type MsgClient interface {
 	// DelegateBondedTokens allows users to break the lockup bond and delegate
 	// osmo tokens to a predefined validator-set.
 	DelegateBondedTokens(ctx context.Context, in *MsgDelegateBondedTokens, opts ...grpc.CallOption) (*MsgDelegateBondedTokensResponse, error)
+	// RebalanceDelegations redelegates the minimal amount of the user's existing
+	// delegations needed to match the weights of their validator-set preference.
+	RebalanceDelegations(ctx context.Context, in *MsgRebalanceDelegations, opts ...grpc.CallOption) (*MsgRebalanceDelegationsResponse, error)
 }
 
 type msgClient struct {
@@ -859,6 +951,15 @@ func (c *msgClient) DelegateBondedTokens(ctx context.Context, in *MsgDelegateBon
 	return out, nil
 }
 
+func (c *msgClient) RebalanceDelegations(ctx context.Context, in *MsgRebalanceDelegations, opts ...grpc.CallOption) (*MsgRebalanceDelegationsResponse, error) {
+	out := new(MsgRebalanceDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/RebalanceDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetValidatorSetPreference creates a set of validator preference.
@@ -884,6 +985,9 @@ type MsgServer interface {
 	// DelegateBondedTokens allows users to break the lockup bond and delegate
 	// osmo tokens to a predefined validator-set.
 	DelegateBondedTokens(context.Context, *MsgDelegateBondedTokens) (*MsgDelegateBondedTokensResponse, error)
+	// RebalanceDelegations redelegates the minimal amount of the user's existing
+	// delegations needed to match the weights of their validator-set preference.
+	RebalanceDelegations(context.Context, *MsgRebalanceDelegations) (*MsgRebalanceDelegationsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -911,6 +1015,9 @@ func (*UnimplementedMsgServer) WithdrawDelegationRewards(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateBondedTokens(ctx context.Context, req *MsgDelegateBondedTokens) (*MsgDelegateBondedTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateBondedTokens not implemented")
 }
+func (*UnimplementedMsgServer) RebalanceDelegations(ctx context.Context, req *MsgRebalanceDelegations) (*MsgRebalanceDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebalanceDelegations not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1042,6 +1149,24 @@ func _Msg_DelegateBondedTokens_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RebalanceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRebalanceDelegations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RebalanceDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Msg/RebalanceDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RebalanceDelegations(ctx, req.(*MsgRebalanceDelegations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.valsetpref.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1074,6 +1199,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateBondedTokens",
 			Handler:    _Msg_DelegateBondedTokens_Handler,
 		},
+		{
+			MethodName: "RebalanceDelegations",
+			Handler:    _Msg_RebalanceDelegations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/valsetpref/v1beta1/tx.proto",
@@ -1513,6 +1642,59 @@ func (m *MsgDelegateBondedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRebalanceDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRebalanceDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRebalanceDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRebalanceDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1699,6 +1881,28 @@ func (m *MsgDelegateBondedTokensResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRebalanceDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRebalanceDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2815,6 +3019,138 @@ func (m *MsgDelegateBondedTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRebalanceDelegations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceDelegations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRebalanceDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRebalanceDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRebalanceDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"time"
 
@@ -467,6 +468,161 @@ func (k Keeper) getValTargetAndSource(ctx sdk.Context, valSource, valTarget stri
 	return validatorSource, validatorTarget, nil
 }
 
+// RebalanceDelegations redelegates the delegator's existing delegations so that they match the weights of
+// their validator-set preference, while moving as few tokens as possible.
+// Unlike PreformRedelegation, delegations that are already at their target amount are left untouched and
+// delegations to validators outside the preference are treated as a source to redelegate from.
+//
+// The algorithm:
+// 1. compute the target amount of every validator in the preference as weight * total delegated tokens.
+// Delegations to validators outside the preference have a target of zero.
+// 2. split the validators into sources (delegated more than target) and destinations (delegated less than target).
+// 3. sort both by the amount to move in descending order and greedily fill the largest destination from the
+// largest source. This yields at most len(sources) + len(destinations) - 1 redelegations.
+//
+// Source and destination pairs that would fail in the staking module are skipped: sources that are receiving
+// a redelegation (transitive redelegation) and pairs that have reached the maximum number of redelegation entries.
+// Returns an error if the delegations are not balanced and no redelegation could be made.
+func (k Keeper) RebalanceDelegations(ctx sdk.Context, delegatorAddr string) error {
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	valSetPreference, found := k.GetValidatorSetPreference(ctx, delegatorAddr)
+	if !found {
+		return types.NoValidatorSetPreferenceError{DelegatorAddr: delegatorAddr}
+	}
+
+	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, math.MaxUint16)
+	if len(delegations) == 0 {
+		return types.ErrNoDelegation
+	}
+
+	currentAmounts := make(map[string]osmomath.Int, len(delegations))
+	totalTokens := osmomath.ZeroInt()
+	for _, delegation := range delegations {
+		_, validator, err := k.getValAddrAndVal(ctx, delegation.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+
+		tokens := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		currentAmounts[delegation.ValidatorAddress] = tokens
+		totalTokens = totalTokens.Add(tokens)
+	}
+
+	sources, destinations := getRebalanceSourcesAndDestinations(currentAmounts, valSetPreference.Preferences, totalTokens)
+	if len(destinations) == 0 {
+		return nil
+	}
+
+	redelegationCount := 0
+	for _, source := range sources {
+		valSource, _, err := k.GetValidatorInfo(ctx, source.ValAddr)
+		if err != nil {
+			return err
+		}
+
+		// redelegating from a validator that is receiving a redelegation is not allowed.
+		if k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, valSource) {
+			continue
+		}
+
+		for _, destination := range destinations {
+			if source.Amount.IsZero() {
+				break
+			}
+			if destination.Amount.IsZero() {
+				continue
+			}
+
+			valTarget, _, err := k.GetValidatorInfo(ctx, destination.ValAddr)
+			if err != nil {
+				return err
+			}
+
+			if k.stakingKeeper.HasMaxRedelegationEntries(ctx, delegator, valSource, valTarget) {
+				continue
+			}
+
+			transferAmount := osmomath.MinInt(source.Amount, destination.Amount)
+			shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, delegator, valSource, transferAmount)
+			if err != nil {
+				return err
+			}
+
+			_, err = k.stakingKeeper.BeginRedelegation(ctx, delegator, valSource, valTarget, shares)
+			if err != nil {
+				return err
+			}
+
+			source.Amount = source.Amount.Sub(transferAmount)
+			destination.Amount = destination.Amount.Sub(transferAmount)
+			redelegationCount++
+		}
+	}
+
+	if redelegationCount == 0 {
+		return types.NoRebalancePossibleError{DelegatorAddr: delegatorAddr}
+	}
+
+	return nil
+}
+
+// valAmount is the amount of tokens to move out of or into a validator.
+type valAmount struct {
+	ValAddr string
+	Amount  osmomath.Int
+}
+
+// getRebalanceSourcesAndDestinations returns the validators that hold more tokens than their target (sources)
+// and the validators that hold less tokens than their target (destinations), given the current delegated amounts,
+// the preferences and the total delegated tokens. The amount of each entry is the absolute difference to the target.
+// Both are sorted by amount in descending order, ties are broken by validator address for determinism.
+func getRebalanceSourcesAndDestinations(currentAmounts map[string]osmomath.Int, preferences []types.ValidatorPreference, totalTokens osmomath.Int) (sources, destinations []*valAmount) {
+	targetAmounts := make(map[string]osmomath.Int, len(preferences))
+	for _, preference := range preferences {
+		targetAmounts[preference.ValOperAddress] = preference.Weight.MulInt(totalTokens).TruncateInt()
+	}
+
+	for valAddr, current := range currentAmounts {
+		target, ok := targetAmounts[valAddr]
+		if !ok {
+			target = osmomath.ZeroInt()
+		}
+
+		if current.GT(target) {
+			sources = append(sources, &valAmount{ValAddr: valAddr, Amount: current.Sub(target)})
+		}
+	}
+
+	for valAddr, target := range targetAmounts {
+		current, ok := currentAmounts[valAddr]
+		if !ok {
+			current = osmomath.ZeroInt()
+		}
+
+		if target.GT(current) {
+			destinations = append(destinations, &valAmount{ValAddr: valAddr, Amount: target.Sub(current)})
+		}
+	}
+
+	sortValAmountsDesc(sources)
+	sortValAmountsDesc(destinations)
+
+	return sources, destinations
+}
+
+func sortValAmountsDesc(valAmounts []*valAmount) {
+	sort.Slice(valAmounts, func(i, j int) bool {
+		if !valAmounts[i].Amount.Equal(valAmounts[j].Amount) {
+			return valAmounts[i].Amount.GT(valAmounts[j].Amount)
+		}
+		return valAmounts[i].ValAddr < valAmounts[j].ValAddr
+	})
+}
+
 // WithdrawDelegationRewards withdraws all the delegation rewards from all validators the user is delegated to, disregarding the val-set.
 // If the valset does not exist, it withdraws from existing staking position.
 // Delegation reward is collected by the validator and in doing so, they can charge commission to the delegators.